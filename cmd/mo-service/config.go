@@ -43,6 +43,8 @@ import (
 var (
 	defaultMaxClockOffset = time.Millisecond * 500
 	defaultMemoryLimit    = 1 << 40
+	// defaultSnapshotRetention is the same as the default ttl of the TAE gc
+	defaultSnapshotRetention = time.Hour
 
 	supportServiceTypes = map[string]metadata.ServiceType{
 		metadata.ServiceType_CN.String():  metadata.ServiceType_CN,
@@ -82,6 +84,10 @@ type Config struct {
 	CN cnservice.Config `toml:"cn"`
 	// Observability parameters for the metric/trace
 	Observability config.ObservabilityParameters `toml:"observability"`
+	// SnapshotRetention how long the history is retained for the queries reading at a
+	// past timestamp, the DN keeps the checkpoints and data files within it and the CN
+	// rejects the timestamps older than it. Default is 1h.
+	SnapshotRetention tomlutil.Duration `toml:"snapshot-retention"`
 
 	// Clock txn clock type. [LOCAL|HLC]. Default is LOCAL.
 	Clock struct {
@@ -149,6 +155,9 @@ func (c *Config) validate() error {
 	if c.Limit.Memory == 0 {
 		c.Limit.Memory = tomlutil.ByteSize(defaultMemoryLimit)
 	}
+	if c.SnapshotRetention.Duration == 0 {
+		c.SnapshotRetention.Duration = defaultSnapshotRetention
+	}
 	return nil
 }
 
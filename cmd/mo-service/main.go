@@ -285,13 +285,12 @@ func initTraceMetric(ctx context.Context, st metadata.ServiceType, cfg *Config,
 	}
 	UUID = strings.ReplaceAll(UUID, " ", "_") // remove space in UUID for filename
 
-	if !SV.DisableTrace || !SV.DisableMetric {
-		writerFactory = export.GetWriterFactory(fs, UUID, nodeRole, SV.LogsExtension)
-		_ = table.SetPathBuilder(ctx, SV.PathBuilder)
-	}
+	// the audit log is written even if the trace and the metric are disabled
+	writerFactory = export.GetWriterFactory(fs, UUID, nodeRole, SV.LogsExtension)
+	_ = table.SetPathBuilder(ctx, SV.PathBuilder)
+	initWG.Add(1)
+	collector := export.NewMOCollector(ctx)
 	if !SV.DisableTrace {
-		initWG.Add(1)
-		collector := export.NewMOCollector(ctx)
 		stopper.RunNamedTask("trace", func(ctx context.Context) {
 			if err = motrace.InitWithConfig(ctx,
 				&SV,
@@ -309,8 +308,25 @@ func initTraceMetric(ctx context.Context, st metadata.ServiceType, cfg *Config,
 				logutil.Warn("Shutdown trace", logutil.ErrorField(err), logutil.NoReportFiled())
 			}
 		})
-		initWG.Wait()
+	} else {
+		stopper.RunNamedTask("audit", func(ctx context.Context) {
+			if err = motrace.InitAuditWithConfig(ctx,
+				&SV,
+				motrace.WithNode(UUID, nodeRole),
+				motrace.WithBatchProcessor(collector),
+				motrace.WithFSWriterFactory(writerFactory),
+			); err != nil {
+				panic(err)
+			}
+			initWG.Done()
+			<-ctx.Done()
+			// flush the audit log
+			if err = motrace.Shutdown(ctx); err != nil {
+				logutil.Warn("Shutdown audit", logutil.ErrorField(err), logutil.NoReportFiled())
+			}
+		})
 	}
+	initWG.Wait()
 	if !SV.DisableMetric {
 		stopper.RunNamedTask("metric", func(ctx context.Context) {
 			mometric.InitMetric(ctx, nil, &SV, UUID, nodeRole, mometric.WithWriterFactory(writerFactory))
@@ -79,10 +79,12 @@ func newRuntime(cfg *Config, stopper *stopper.Stopper) (runtime.Runtime, error)
 		return nil, err
 	}
 
-	return runtime.NewRuntime(cfg.mustGetServiceType(),
+	r := runtime.NewRuntime(cfg.mustGetServiceType(),
 		cfg.mustGetServiceUUID(),
 		logger,
-		runtime.WithClock(clock)), nil
+		runtime.WithClock(clock))
+	r.SetGlobalVariables(runtime.SnapshotRetention, cfg.SnapshotRetention.Duration)
+	return r, nil
 }
 
 func getClock(cfg *Config, stopper *stopper.Stopper) (clock.Clock, error) {
@@ -0,0 +1,210 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// alterColumnWidening lists the types a column can be modified to from each
+// type, every value of the old type is a value of the new one
+var alterColumnWidening = map[types.T][]types.T{
+	types.T_int8:      {types.T_int16, types.T_int32, types.T_int64},
+	types.T_int16:     {types.T_int32, types.T_int64},
+	types.T_int32:     {types.T_int64},
+	types.T_uint8:     {types.T_uint16, types.T_uint32, types.T_uint64, types.T_int16, types.T_int32, types.T_int64},
+	types.T_uint16:    {types.T_uint32, types.T_uint64, types.T_int32, types.T_int64},
+	types.T_uint32:    {types.T_uint64, types.T_int64},
+	types.T_float32:   {types.T_float64},
+	types.T_char:      {types.T_varchar, types.T_text},
+	types.T_varchar:   {types.T_text},
+	types.T_binary:    {types.T_varbinary, types.T_blob},
+	types.T_varbinary: {types.T_blob},
+}
+
+// IsAlterColumnTypeCompatible reports whether a column of type from can be
+// modified to type to by ALTER TABLE. The data written before is kept as it
+// is and converted by ConvertAlteredColumn when it is read.
+func IsAlterColumnTypeCompatible(from, to types.Type) bool {
+	if from.Oid == to.Oid {
+		if from.Scale != to.Scale {
+			return false
+		}
+		if from.Width == to.Width {
+			return true
+		}
+		switch from.Oid {
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
+			return to.Width > from.Width
+		}
+		return false
+	}
+	for _, oid := range alterColumnWidening[from.Oid] {
+		if oid != to.Oid {
+			continue
+		}
+		switch to.Oid {
+		case types.T_varchar, types.T_varbinary:
+			return to.Width >= from.Width
+		}
+		return true
+	}
+	return false
+}
+
+// ConvertAlteredColumn converts vec, the data of a column written before the
+// type of the column is modified, into typ. vec is returned as it is if its
+// type is typ, otherwise the returned vector is a new one.
+func ConvertAlteredColumn(vec *vector.Vector, typ types.Type, mp *mpool.MPool) (*vector.Vector, error) {
+	from := *vec.GetType()
+	if from.Eq(typ) {
+		return vec, nil
+	}
+	if !IsAlterColumnTypeCompatible(from, typ) {
+		return nil, moerr.NewInternalErrorNoCtx("cannot convert column from %s to %s", from.String(), typ.String())
+	}
+	if from.IsVarlen() {
+		// string types share the same layout
+		converted, err := vec.Dup(mp)
+		if err != nil {
+			return nil, err
+		}
+		converted.SetType(typ)
+		return converted, nil
+	}
+	switch from.Oid {
+	case types.T_int8:
+		return widenColumn[int8](vec, typ, mp)
+	case types.T_int16:
+		return widenColumn[int16](vec, typ, mp)
+	case types.T_int32:
+		return widenColumn[int32](vec, typ, mp)
+	case types.T_uint8:
+		return widenColumn[uint8](vec, typ, mp)
+	case types.T_uint16:
+		return widenColumn[uint16](vec, typ, mp)
+	case types.T_uint32:
+		return widenColumn[uint32](vec, typ, mp)
+	case types.T_float32:
+		return widenColumn[float32](vec, typ, mp)
+	}
+	// the same fixed type of a larger width
+	converted, err := vec.Dup(mp)
+	if err != nil {
+		return nil, err
+	}
+	converted.SetType(typ)
+	return converted, nil
+}
+
+type widenable interface {
+	int8 | int16 | int32 | uint8 | uint16 | uint32 | float32
+}
+
+func widenColumn[F widenable](vec *vector.Vector, typ types.Type, mp *mpool.MPool) (*vector.Vector, error) {
+	switch typ.Oid {
+	case types.T_int16:
+		return widenColumnTo[F, int16](vec, typ, mp)
+	case types.T_int32:
+		return widenColumnTo[F, int32](vec, typ, mp)
+	case types.T_int64:
+		return widenColumnTo[F, int64](vec, typ, mp)
+	case types.T_uint16:
+		return widenColumnTo[F, uint16](vec, typ, mp)
+	case types.T_uint32:
+		return widenColumnTo[F, uint32](vec, typ, mp)
+	case types.T_uint64:
+		return widenColumnTo[F, uint64](vec, typ, mp)
+	case types.T_float64:
+		return widenColumnTo[F, float64](vec, typ, mp)
+	}
+	return nil, moerr.NewInternalErrorNoCtx("cannot convert column from %s to %s", vec.GetType().String(), typ.String())
+}
+
+func widenColumnTo[F widenable, T int16 | int32 | int64 | uint16 | uint32 | uint64 | float64](
+	vec *vector.Vector, typ types.Type, mp *mpool.MPool) (*vector.Vector, error) {
+	converted := vector.NewVec(typ)
+	if vec.IsConstNull() {
+		if err := vector.AppendMultiFixed(converted, T(0), true, vec.Length(), mp); err != nil {
+			converted.Free(mp)
+			return nil, err
+		}
+		return converted, nil
+	}
+	col := vector.MustFixedCol[F](vec)
+	nsp := vec.GetNulls()
+	for i := 0; i < vec.Length(); i++ {
+		v := col[0]
+		if !vec.IsConst() {
+			v = col[i]
+		}
+		if err := vector.AppendFixed(converted, T(v), nsp.Contains(uint64(i)), mp); err != nil {
+			converted.Free(mp)
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// DroppedColumn is a column dropped by ALTER TABLE, which is still kept in
+// the storage layout of the table
+type DroppedColumn struct {
+	// Pos is the position of the column in the storage layout
+	Pos int
+	Typ types.T
+}
+
+// EncodeDroppedColumns encodes cols as the value of the table property
+// PropDroppedColumns
+func EncodeDroppedColumns(cols []DroppedColumn) string {
+	strs := make([]string, len(cols))
+	for i, col := range cols {
+		strs[i] = fmt.Sprintf("%d:%d", col.Pos, col.Typ)
+	}
+	return strings.Join(strs, ",")
+}
+
+// DecodeDroppedColumns decodes the value of the table property
+// PropDroppedColumns
+func DecodeDroppedColumns(value string) ([]DroppedColumn, error) {
+	if value == "" {
+		return nil, nil
+	}
+	strs := strings.Split(value, ",")
+	cols := make([]DroppedColumn, len(strs))
+	for i, str := range strs {
+		pos, typ, ok := strings.Cut(str, ":")
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtx("invalid dropped column '%s'", str)
+		}
+		p, err := strconv.Atoi(pos)
+		if err != nil {
+			return nil, err
+		}
+		t, err := strconv.ParseUint(typ, 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = DroppedColumn{Pos: p, Typ: types.T(t)}
+	}
+	return cols, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAlterColumnTypeCompatible(t *testing.T) {
+	require.True(t, IsAlterColumnTypeCompatible(types.T_int32.ToType(), types.T_int64.ToType()))
+	require.True(t, IsAlterColumnTypeCompatible(types.T_uint16.ToType(), types.T_int32.ToType()))
+	require.True(t, IsAlterColumnTypeCompatible(types.T_float32.ToType(), types.T_float64.ToType()))
+	require.True(t, IsAlterColumnTypeCompatible(types.New(types.T_varchar, 10, 0), types.New(types.T_varchar, 20, 0)))
+	require.True(t, IsAlterColumnTypeCompatible(types.New(types.T_char, 10, 0), types.New(types.T_varchar, 10, 0)))
+	require.False(t, IsAlterColumnTypeCompatible(types.T_int64.ToType(), types.T_int32.ToType()))
+	require.False(t, IsAlterColumnTypeCompatible(types.T_int32.ToType(), types.T_uint32.ToType()))
+	require.False(t, IsAlterColumnTypeCompatible(types.New(types.T_varchar, 20, 0), types.New(types.T_varchar, 10, 0)))
+	require.False(t, IsAlterColumnTypeCompatible(types.New(types.T_char, 20, 0), types.New(types.T_varchar, 10, 0)))
+	require.False(t, IsAlterColumnTypeCompatible(types.New(types.T_decimal64, 10, 2), types.New(types.T_decimal64, 10, 3)))
+	require.False(t, IsAlterColumnTypeCompatible(types.T_varchar.ToType(), types.T_int64.ToType()))
+}
+
+func TestConvertAlteredColumn(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_int32.ToType())
+	require.NoError(t, vector.AppendFixed(vec, int32(-1), false, mp))
+	require.NoError(t, vector.AppendFixed(vec, int32(0), true, mp))
+	require.NoError(t, vector.AppendFixed(vec, int32(7), false, mp))
+
+	same, err := ConvertAlteredColumn(vec, types.T_int32.ToType(), mp)
+	require.NoError(t, err)
+	require.True(t, same == vec)
+
+	converted, err := ConvertAlteredColumn(vec, types.T_int64.ToType(), mp)
+	require.NoError(t, err)
+	require.Equal(t, types.T_int64, converted.GetType().Oid)
+	require.Equal(t, []int64{-1, 0, 7}, vector.MustFixedCol[int64](converted))
+	require.True(t, converted.GetNulls().Contains(1))
+	require.False(t, converted.GetNulls().Contains(0))
+	converted.Free(mp)
+
+	_, err = ConvertAlteredColumn(vec, types.T_int16.ToType(), mp)
+	require.Error(t, err)
+	vec.Free(mp)
+
+	constNull := vector.NewConstNull(types.T_uint8.ToType(), 2, mp)
+	converted, err = ConvertAlteredColumn(constNull, types.T_int16.ToType(), mp)
+	require.NoError(t, err)
+	require.Equal(t, 2, converted.Length())
+	require.True(t, converted.GetNulls().Contains(0))
+	require.True(t, converted.GetNulls().Contains(1))
+	converted.Free(mp)
+	constNull.Free(mp)
+
+	strs := vector.NewVec(types.New(types.T_char, 5, 0))
+	require.NoError(t, vector.AppendBytes(strs, []byte("abc"), false, mp))
+	converted, err = ConvertAlteredColumn(strs, types.New(types.T_varchar, 10, 0), mp)
+	require.NoError(t, err)
+	require.Equal(t, types.T_varchar, converted.GetType().Oid)
+	require.Equal(t, []string{"abc"}, vector.MustStrCol(converted))
+	converted.Free(mp)
+	strs.Free(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestDroppedColumns(t *testing.T) {
+	cols := []DroppedColumn{{Pos: 2, Typ: types.T_int32}, {Pos: 5, Typ: types.T_varchar}}
+	decoded, err := DecodeDroppedColumns(EncodeDroppedColumns(cols))
+	require.NoError(t, err)
+	require.Equal(t, cols, decoded)
+	decoded, err = DecodeDroppedColumns("")
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+	_, err = DecodeDroppedColumns("2")
+	require.Error(t, err)
+}
@@ -88,6 +88,12 @@ func ParseEntryList(es []*api.Entry) (any, []*api.Entry, error) {
 			return genDropOrTruncateTables(GenRows(bat)), es[1:], nil
 		} else if e.EntryType == api.Entry_Update {
 			return genUpdateConstraint(GenRows(bat)), es[1:], nil
+		} else if e.EntryType == api.Entry_Alter {
+			cmds, err := genAlterTable(GenRows(bat))
+			if err != nil {
+				return nil, nil, err
+			}
+			return cmds, es[1:], nil
 		}
 		cmds := genCreateTables(GenRows(bat))
 		idx := 0
@@ -204,6 +210,21 @@ func genUpdateConstraint(rows [][]any) []UpdateConstraint {
 	return cmds
 }
 
+func genAlterTable(rows [][]any) ([]AlterTable, error) {
+	cmds := make([]AlterTable, len(rows))
+	for i, row := range rows {
+		cmds[i].TableId = row[MO_TABLES_REL_ID_IDX].(uint64)
+		cmds[i].DatabaseId = row[MO_TABLES_RELDATABASE_ID_IDX].(uint64)
+		cmds[i].TableName = string(row[MO_TABLES_REL_NAME_IDX].([]byte))
+		cmds[i].DatabaseName = string(row[MO_TABLES_RELDATABASE_IDX].([]byte))
+		cmds[i].Req = new(api.AlterTableReq)
+		if err := cmds[i].Req.Unmarshal(row[MO_TABLES_ALTER_TABLE].([]byte)); err != nil {
+			return nil, err
+		}
+	}
+	return cmds, nil
+}
+
 func genDropOrTruncateTables(rows [][]any) []DropOrTruncateTable {
 	cmds := make([]DropOrTruncateTable, len(rows))
 	for i, row := range rows {
@@ -256,7 +277,7 @@ func genTableDefs(row []any) (engine.TableDef, error) {
 	var attr engine.Attribute
 
 	attr.Name = string(row[MO_COLUMNS_ATTNAME_IDX].([]byte))
-	attr.Alg = compress.Lz4
+	attr.Alg = compress.T(row[MO_COLUMNS_ATT_COMPRESSION_IDX].(int8))
 	if err := types.Decode(row[MO_COLUMNS_ATTTYP_IDX].([]byte), &attr.Type); err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -28,6 +29,15 @@ const (
 	PrefixPriColName     = "__mo_cpkey_"
 	PrefixCBColName      = "__mo_cbkey_"
 	PrefixIndexTableName = "__mo_index_"
+	// PrefixPartitionTableName names the hidden tables storing the partitions
+	// of a partitioned table
+	PrefixPartitionTableName = "__mo_partition_"
+	// PrefixDroppedColName renames a column dropped by ALTER TABLE, which is
+	// still kept in the storage layout of the table
+	PrefixDroppedColName = "__mo_dropped_"
+	// PropDroppedColumns is the table property of the positions and types of
+	// the dropped columns, see EncodeDroppedColumns
+	PropDroppedColumns = "dropped_columns"
 	// Compound primary key column name, which is a hidden column
 	CPrimaryKeyColName = "__mo_cpkey_col"
 	// IndexTable has two column at most, the first is idx col, the second is origin table primary col
@@ -40,12 +50,18 @@ const (
 
 var AutoIncrColumnNames = []string{Row_ID, "name", "offset", "step"}
 
+// IsDroppedColName reports whether the column is dropped by ALTER TABLE
+func IsDroppedColName(col string) bool {
+	return strings.HasPrefix(col, PrefixDroppedColName)
+}
+
 func ContainExternalHidenCol(col string) bool {
 	return col == ExternalFilePath
 }
 
 func IsHiddenTable(name string) bool {
-	if strings.HasPrefix(name, IndexTableNamePrefix) {
+	if strings.HasPrefix(name, IndexTableNamePrefix) ||
+		strings.HasPrefix(name, PrefixPartitionTableName) {
 		return true
 	}
 	return strings.EqualFold(name, AutoIncrTableName)
@@ -94,6 +110,7 @@ const (
 	SystemRelAttr_Partition   = "partitioned"
 	SystemRelAttr_ViewDef     = "viewdef"
 	SystemRelAttr_Constraint  = "constraint"
+	SystemRelAttr_AlterTable  = "alter_table"
 
 	// 'mo_columns' table
 	SystemColAttr_UniqName        = "att_uniq_name"
@@ -118,6 +135,7 @@ const (
 	SystemColAttr_HasUpdate       = "attr_has_update"
 	SystemColAttr_Update          = "attr_update"
 	SystemColAttr_IsClusterBy     = "attr_is_clusterby"
+	SystemColAttr_Compression     = "attr_compression"
 
 	BlockMeta_ID              = "block_id"
 	BlockMeta_EntryState      = "entry_state"
@@ -159,6 +177,11 @@ const (
 	MO_TABLES_UPDATE_CONSTRAINT = 4
 )
 
+// index use to alter table
+const (
+	MO_TABLES_ALTER_TABLE = 4
+)
+
 // column's index in catalog table
 const (
 	MO_DATABASE_DAT_ID_IDX           = 0
@@ -208,6 +231,7 @@ const (
 	MO_COLUMNS_ATT_HAS_UPDATE_IDX        = 19
 	MO_COLUMNS_ATT_UPDATE_IDX            = 20
 	MO_COLUMNS_ATT_IS_CLUSTERBY          = 21
+	MO_COLUMNS_ATT_COMPRESSION_IDX       = 22
 
 	BLOCKMETA_ID_IDX         = 0
 	BLOCKMETA_ENTRYSTATE_IDX = 1
@@ -271,6 +295,14 @@ type UpdateConstraint struct {
 	Constraint   []byte
 }
 
+type AlterTable struct {
+	DatabaseId   uint64
+	TableId      uint64
+	TableName    string
+	DatabaseName string
+	Req          *api.AlterTableReq
+}
+
 type DropOrTruncateTable struct {
 	IsDrop       bool // true for Drop and false for Truncate
 	Id           uint64
@@ -331,6 +363,7 @@ var (
 		SystemColAttr_HasUpdate,
 		SystemColAttr_Update,
 		SystemColAttr_IsClusterBy,
+		SystemColAttr_Compression,
 	}
 	MoTableMetaSchema = []string{
 		BlockMeta_ID,
@@ -391,6 +424,7 @@ var (
 		types.New(types.T_int8, 0, 0),       // att_has_update
 		types.New(types.T_varchar, 2048, 0), // att_update
 		types.New(types.T_int8, 0, 0),       // att_is_clusterby
+		types.New(types.T_int8, 0, 0),       // att_compression
 	}
 	MoTableMetaTypes = []types.Type{
 		types.New(types.T_uint64, 0, 0),                    // block_id
@@ -0,0 +1,169 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const connector = "matrixone"
+
+// envelope is the debezium envelope of a change event without the schema,
+// the same as the one written by debezium with schemas.enable=false.
+type envelope struct {
+	Before map[string]any `json:"before"`
+	After  map[string]any `json:"after"`
+	Source source         `json:"source"`
+	Op     Op             `json:"op"`
+	TsMs   int64          `json:"ts_ms"`
+}
+
+type source struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	Db        string `json:"db"`
+	Table     string `json:"table"`
+	// CommitTs is the full commit timestamp of the transaction, ts_ms loses
+	// the logical part of it.
+	CommitTs string `json:"commit_ts"`
+}
+
+// Encoder encodes the change events of a table into debezium envelopes.
+type Encoder struct {
+	feed  string
+	db    string
+	table string
+	now   func() time.Time
+}
+
+func NewEncoder(feed, db, table string) *Encoder {
+	return &Encoder{
+		feed:  feed,
+		db:    db,
+		table: table,
+		now:   time.Now,
+	}
+}
+
+// Encode encodes the event, the key of the message is the primary key of the
+// row in json, and the value is the envelope.
+func (e *Encoder) Encode(ev *Event) (Message, error) {
+	var err error
+	msg := Message{CommitTs: ev.CommitTs}
+	if ev.Key != nil {
+		if msg.Key, err = json.Marshal(ev.Key); err != nil {
+			return msg, err
+		}
+	}
+	msg.Value, err = json.Marshal(&envelope{
+		Before: ev.Before,
+		After:  ev.After,
+		Source: source{
+			Connector: connector,
+			Name:      e.feed,
+			TsMs:      ev.CommitTs.PhysicalTime / int64(time.Millisecond),
+			Snapshot:  "false",
+			Db:        e.db,
+			Table:     e.table,
+			CommitTs:  FormatCheckpoint(ev.CommitTs),
+		},
+		Op:   ev.Op,
+		TsMs: e.now().UnixMilli(),
+	})
+	return msg, err
+}
+
+// rowValues returns the values of the columns of the row, the value of the
+// column whose vector is nil is omitted.
+func rowValues(vecs []*vector.Vector, row int, columns []string) map[string]any {
+	values := make(map[string]any, len(columns))
+	for i, vec := range vecs {
+		if vec == nil {
+			continue
+		}
+		values[columns[i]] = value(vec, row)
+	}
+	return values
+}
+
+// value converts the value of the row in the vector to the json value written
+// by debezium: decimals and times are strings, and binaries are base64 strings.
+func value(vec *vector.Vector, row int) any {
+	if vec.IsConstNull() {
+		return nil
+	}
+	if vec.IsConst() {
+		row = 0
+	}
+	if nulls.Contains(vec.GetNulls(), uint64(row)) {
+		return nil
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		return vector.GetFixedAt[bool](vec, row)
+	case types.T_int8:
+		return vector.GetFixedAt[int8](vec, row)
+	case types.T_int16:
+		return vector.GetFixedAt[int16](vec, row)
+	case types.T_int32:
+		return vector.GetFixedAt[int32](vec, row)
+	case types.T_int64:
+		return vector.GetFixedAt[int64](vec, row)
+	case types.T_uint8:
+		return vector.GetFixedAt[uint8](vec, row)
+	case types.T_uint16:
+		return vector.GetFixedAt[uint16](vec, row)
+	case types.T_uint32:
+		return vector.GetFixedAt[uint32](vec, row)
+	case types.T_uint64:
+		return vector.GetFixedAt[uint64](vec, row)
+	case types.T_float32:
+		return vector.GetFixedAt[float32](vec, row)
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, row)
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, row).ToStringWithScale(typ.Scale)
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, row).ToStringWithScale(typ.Scale)
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, row).String()
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, row).String2(typ.Scale)
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, row).String2(typ.Scale)
+	case types.T_timestamp:
+		return vector.GetFixedAt[types.Timestamp](vec, row).String2(time.UTC, typ.Scale)
+	case types.T_uuid:
+		return vector.GetFixedAt[types.Uuid](vec, row).ToString()
+	case types.T_char, types.T_varchar, types.T_text:
+		return vec.GetStringAt(row)
+	case types.T_json:
+		return json.RawMessage(types.DecodeJson(vec.GetBytesAt(row)).String())
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		// copied since the vector is only valid in the change handler
+		return append([]byte{}, vec.GetBytesAt(row)...)
+	default:
+		// not a type of the columns of tables
+		return nil
+	}
+}
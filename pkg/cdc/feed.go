@@ -0,0 +1,507 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"go.uber.org/zap"
+)
+
+// the interval to send the messages again after the sink fails
+var sendRetryInterval = time.Second
+
+// the max number of the messages not sent yet, the feed stops receiving the changes
+// until the sink catches up.
+var maxPendingMessages = 1 << 20
+
+// FeedSpec is the definition of a changefeed.
+type FeedSpec struct {
+	Name         string
+	AccountId    uint32
+	DatabaseName string
+	TableName    string
+	DatabaseId   uint64
+	TableId      uint64
+	// Columns are the columns of the table captured.
+	Columns []string
+	// PrimaryKeys are the primary key columns of the table.
+	PrimaryKeys []string
+	SinkURI     string
+	// StartTs is the time the changefeed is created, the changes committed
+	// no later than it are not captured.
+	StartTs timestamp.Timestamp
+}
+
+// Feed captures the changes of a table and sends them to the sink in the
+// order of the commit timestamps. The deletes and the inserts of the same
+// primary key in a transaction are sent as the updates.
+//
+// The changes of a transaction are sent to the sink with the checkpoint after
+// them. When the feed is resumed, the changes replayed by the logtail or read
+// from the checkpoint of the dn are skipped if they are committed no later than
+// the checkpoint. The changes folded into the checkpoint of the dn can not be
+// captured, so the feed fails if the checkpoint starts after the changes the
+// feed has received, and it must be created again.
+//
+// If the sink can not keep up with the changes, Handle blocks until the pending
+// messages are sent, which stops the logtail being consumed.
+type Feed struct {
+	spec    FeedSpec
+	sink    Sink
+	encoder *Encoder
+	// the indexes of the primary keys in the columns
+	pks []int
+
+	unsubscribe func()
+	cancel      context.CancelFunc
+	notify      chan struct{}
+	// sent is notified after the pending messages are sent
+	sent chan struct{}
+	done chan struct{}
+
+	mu struct {
+		sync.Mutex
+		// pending are the messages not sent yet
+		pending []Message
+		// pendingTs is the checkpoint after the pending messages
+		pendingTs timestamp.Timestamp
+		// received is the commit timestamp of the last change received
+		received   timestamp.Timestamp
+		checkpoint timestamp.Timestamp
+		err        error
+		// failed is the error the feed stops with
+		failed error
+	}
+}
+
+// NewFeed creates the feed sending the changes to the sink, which resumes from
+// the checkpoint of the sink.
+func NewFeed(ctx context.Context, spec FeedSpec, sink Sink) (*Feed, error) {
+	checkpoint, err := sink.Checkpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if spec.StartTs.Greater(checkpoint) {
+		checkpoint = spec.StartTs
+	}
+	f := &Feed{
+		spec:    spec,
+		sink:    sink,
+		encoder: NewEncoder(spec.Name, spec.DatabaseName, spec.TableName),
+		notify:  make(chan struct{}, 1),
+		sent:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	for _, pk := range spec.PrimaryKeys {
+		for i, col := range spec.Columns {
+			if col == pk {
+				f.pks = append(f.pks, i)
+				break
+			}
+		}
+	}
+	if len(f.pks) != len(spec.PrimaryKeys) {
+		return nil, moerr.NewInvalidInput(ctx, "primary keys %v are not in the columns of changefeed %s",
+			spec.PrimaryKeys, spec.Name)
+	}
+	f.mu.received = checkpoint
+	f.mu.checkpoint = checkpoint
+	return f, nil
+}
+
+// Start subscribes the changes of the table and sends them to the sink until
+// the feed is closed.
+func (f *Feed) Start(ctx context.Context, subscriber engine.ChangeSubscriber) error {
+	f.mu.Lock()
+	from := f.mu.received
+	f.mu.Unlock()
+	unsubscribe, err := subscriber.SubscribeChanges(ctx, f.spec.DatabaseId, f.spec.TableId,
+		f.spec.Columns, from, f.Handle)
+	if err != nil {
+		return err
+	}
+	f.unsubscribe = unsubscribe
+	ctx, f.cancel = context.WithCancel(ctx)
+	go f.run(ctx)
+	return nil
+}
+
+// Close stops capturing the changes and sends the pending changes.
+func (f *Feed) Close() error {
+	if f.unsubscribe != nil {
+		f.unsubscribe()
+		f.cancel()
+		<-f.done
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sendRetryInterval)
+	defer cancel()
+	if err := f.flush(ctx); err != nil {
+		logutil.Error("send the changes of the closed changefeed failed",
+			zap.String("name", f.spec.Name), zap.Error(err))
+	}
+	return f.sink.Close()
+}
+
+func (f *Feed) Spec() FeedSpec {
+	return f.spec
+}
+
+// Status returns the checkpoint of the feed and the error it fails with, or the
+// last error of the sink.
+func (f *Feed) Status() (timestamp.Timestamp, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.mu.failed != nil {
+		return f.mu.checkpoint, f.mu.failed
+	}
+	return f.mu.checkpoint, f.mu.err
+}
+
+// fail stops the feed capturing the changes with the error, the pending messages
+// are dropped. It must be called with the lock held.
+func (f *Feed) fail(err error) error {
+	if f.mu.failed == nil {
+		f.mu.failed = err
+		f.mu.pending = nil
+		logutil.Error("changefeed failed", zap.String("name", f.spec.Name), zap.Error(err))
+	}
+	return f.mu.failed
+}
+
+// Handle is the change handler of the table, which encodes the changes and
+// queues them to be sent. It blocks while the messages not sent are more than
+// maxPendingMessages.
+func (f *Feed) Handle(ctx context.Context, changes []engine.Change, ts timestamp.Timestamp) error {
+	f.mu.Lock()
+	if f.mu.failed != nil {
+		f.mu.Unlock()
+		return f.mu.failed
+	}
+	received := f.mu.received
+	if len(changes) > 0 && changes[0].Typ == engine.ChangeCheckpoint && changes[0].CommitTs.Greater(received) {
+		err := f.fail(moerr.NewInternalError(ctx,
+			"the changes of changefeed %s after %s may be folded into the checkpoint of the dn since %s, create the changefeed again",
+			f.spec.Name, FormatCheckpoint(received), FormatCheckpoint(changes[0].CommitTs)))
+		f.mu.Unlock()
+		return err
+	}
+	f.mu.Unlock()
+
+	var msgs []Message
+	for _, ev := range f.events(changes) {
+		if ev.CommitTs.LessEq(received) {
+			continue
+		}
+		msg, err := f.encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	f.mu.Lock()
+	if f.mu.failed != nil {
+		f.mu.Unlock()
+		return f.mu.failed
+	}
+	f.mu.pending = append(f.mu.pending, msgs...)
+	f.mu.received = msgs[len(msgs)-1].CommitTs
+	if ts.Less(f.mu.received) {
+		ts = f.mu.received
+	}
+	f.mu.pendingTs = ts
+	f.mu.Unlock()
+
+	select {
+	case f.notify <- struct{}{}:
+	default:
+	}
+	return f.waitSent(ctx)
+}
+
+// waitSent waits until the messages not sent are no more than maxPendingMessages,
+// or the feed fails or stops.
+func (f *Feed) waitSent(ctx context.Context) error {
+	for {
+		f.mu.Lock()
+		pending, failed := len(f.mu.pending), f.mu.failed
+		f.mu.Unlock()
+		if failed != nil {
+			return failed
+		}
+		if pending <= maxPendingMessages {
+			return nil
+		}
+		select {
+		case <-f.sent:
+		case <-f.done:
+			// the pending messages are sent when the feed is closed
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// events converts the changes into the events in the order of the commit
+// timestamps, and pairs the deletes and the inserts of a transaction into the
+// updates.
+func (f *Feed) events(changes []engine.Change) []*Event {
+	sorted := make([]*engine.Change, len(changes))
+	for i := range changes {
+		sorted[i] = &changes[i]
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CommitTs.Less(sorted[j].CommitTs)
+	})
+
+	events := make([]*Event, 0, len(sorted))
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j].CommitTs.Equal(sorted[i].CommitTs) {
+			j++
+		}
+		events = f.txnEvents(events, sorted[i:j])
+		i = j
+	}
+	return events
+}
+
+// txnEvents appends the events of the changes of a transaction, the inserts
+// of the primary keys deleted are the updates of the rows.
+func (f *Feed) txnEvents(events []*Event, changes []*engine.Change) []*Event {
+	deletes := make(map[string]*Event)
+	for _, c := range changes {
+		if c.Typ != engine.ChangeDelete {
+			continue
+		}
+		ev := f.event(c)
+		ev.Op = OpDelete
+		ev.Before = ev.After
+		ev.After = nil
+		if key := keyString(ev.Key); key != "" {
+			deletes[key] = ev
+		}
+		events = append(events, ev)
+	}
+	for _, c := range changes {
+		if c.Typ != engine.ChangeInsert {
+			continue
+		}
+		ev := f.event(c)
+		if del, ok := deletes[keyString(ev.Key)]; ok {
+			delete(deletes, keyString(ev.Key))
+			del.Op = OpUpdate
+			del.After = ev.After
+			continue
+		}
+		ev.Op = OpCreate
+		events = append(events, ev)
+	}
+	return events
+}
+
+// event returns the event of the change with the row in After.
+func (f *Feed) event(c *engine.Change) *Event {
+	var row map[string]any
+	if c.Vecs != nil {
+		row = rowValues(c.Vecs, c.Row, f.spec.Columns)
+	}
+	return &Event{
+		CommitTs: c.CommitTs,
+		Key:      f.key(row),
+		After:    row,
+	}
+}
+
+func keyString(key map[string]any) string {
+	if key == nil {
+		return ""
+	}
+	// the keys of the map are sorted by json
+	data, _ := json.Marshal(key)
+	return string(data)
+}
+
+// key returns the primary key of the row, nil if the table has no primary key
+// or the row is not found.
+func (f *Feed) key(row map[string]any) map[string]any {
+	if len(f.pks) == 0 || row == nil {
+		return nil
+	}
+	key := make(map[string]any, len(f.pks))
+	for _, i := range f.pks {
+		name := f.spec.Columns[i]
+		v, ok := row[name]
+		if !ok {
+			return nil
+		}
+		key[name] = v
+	}
+	return key
+}
+
+func (f *Feed) run(ctx context.Context) {
+	defer close(f.done)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-f.notify:
+		}
+		for {
+			err := f.flush(ctx)
+			if err == nil {
+				break
+			}
+			logutil.Error("send the changes of changefeed failed",
+				zap.String("name", f.spec.Name), zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(sendRetryInterval):
+			}
+		}
+	}
+}
+
+// flush sends the pending messages to the sink.
+func (f *Feed) flush(ctx context.Context) error {
+	f.mu.Lock()
+	msgs := f.mu.pending
+	checkpoint := f.mu.pendingTs
+	f.mu.Unlock()
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	err := f.sink.Send(ctx, msgs, checkpoint)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.mu.failed != nil {
+		// the pending messages are dropped
+		return nil
+	}
+	f.mu.err = err
+	if err != nil {
+		return err
+	}
+	f.mu.pending = f.mu.pending[len(msgs):]
+	if len(f.mu.pending) == 0 {
+		f.mu.pending = nil
+	}
+	f.mu.checkpoint = checkpoint
+	select {
+	case f.sent <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+type feedKey struct {
+	accountId uint32
+	name      string
+}
+
+// Manager manages the feeds running on the cn.
+type Manager struct {
+	ctx context.Context
+	mu  struct {
+		sync.Mutex
+		feeds map[feedKey]*Feed
+	}
+}
+
+// NewManager creates the manager, the feeds run until the ctx is done.
+func NewManager(ctx context.Context) *Manager {
+	m := &Manager{ctx: ctx}
+	m.mu.feeds = make(map[feedKey]*Feed)
+	return m
+}
+
+// Start creates the sink of the feed and starts capturing the changes.
+func (m *Manager) Start(ctx context.Context, spec FeedSpec, subscriber engine.ChangeSubscriber) error {
+	key := feedKey{accountId: spec.AccountId, name: spec.Name}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.mu.feeds[key]; ok {
+		return moerr.NewInternalError(ctx, "changefeed %s is running", spec.Name)
+	}
+	if err := CheckSink(ctx, spec.AccountId, spec.SinkURI); err != nil {
+		return err
+	}
+	sink, err := NewSink(ctx, spec.Name, spec.SinkURI)
+	if err != nil {
+		return err
+	}
+	f, err := NewFeed(ctx, spec, sink)
+	if err != nil {
+		sink.Close()
+		return err
+	}
+	if err = f.Start(m.ctx, subscriber); err != nil {
+		sink.Close()
+		return err
+	}
+	m.mu.feeds[key] = f
+	logutil.Info("changefeed started", zap.Uint32("accountId", spec.AccountId), zap.String("name", spec.Name),
+		zap.String("sink", spec.SinkURI))
+	return nil
+}
+
+// Stop stops the feed, it does nothing if the feed is not running.
+func (m *Manager) Stop(accountId uint32, name string) error {
+	key := feedKey{accountId: accountId, name: name}
+	m.mu.Lock()
+	f, ok := m.mu.feeds[key]
+	delete(m.mu.feeds, key)
+	m.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	logutil.Info("changefeed stopped", zap.Uint32("accountId", accountId), zap.String("name", name))
+	return f.Close()
+}
+
+// Get returns the feed running, nil if not found.
+func (m *Manager) Get(accountId uint32, name string) *Feed {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mu.feeds[feedKey{accountId: accountId, name: name}]
+}
+
+// List returns the feeds running.
+func (m *Manager) List() []*Feed {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	feeds := make([]*Feed, 0, len(m.mu.feeds))
+	for _, f := range m.mu.feeds {
+		feeds = append(feeds, f)
+	}
+	return feeds
+}
@@ -0,0 +1,331 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+type testSink struct {
+	msgs       []Message
+	checkpoint timestamp.Timestamp
+}
+
+func (s *testSink) Checkpoint(_ context.Context) (timestamp.Timestamp, error) {
+	return s.checkpoint, nil
+}
+
+func (s *testSink) Send(_ context.Context, msgs []Message, checkpoint timestamp.Timestamp) error {
+	s.msgs = append(s.msgs, msgs...)
+	s.checkpoint = checkpoint
+	return nil
+}
+
+func (s *testSink) Close() error {
+	return nil
+}
+
+func newTestChangeVecs(t *testing.T, mp *mpool.MPool, ids []int64, names []string) []*vector.Vector {
+	idVec := vector.NewVec(types.T_int64.ToType())
+	nameVec := vector.NewVec(types.T_varchar.ToType())
+	for i := range ids {
+		require.NoError(t, vector.AppendFixed(idVec, ids[i], false, mp))
+		require.NoError(t, vector.AppendBytes(nameVec, []byte(names[i]), names[i] == "", mp))
+	}
+	return []*vector.Vector{idVec, nameVec}
+}
+
+func decodeEnvelope(t *testing.T, data []byte) map[string]any {
+	var env map[string]any
+	require.NoError(t, json.Unmarshal(data, &env))
+	return env
+}
+
+func TestFeedEvents(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	sink := &testSink{checkpoint: timestamp.Timestamp{PhysicalTime: 10}}
+	f, err := NewFeed(ctx, FeedSpec{
+		Name:         "feed",
+		DatabaseName: "db",
+		TableName:    "t",
+		Columns:      []string{"id", "name"},
+		PrimaryKeys:  []string{"id"},
+	}, sink)
+	require.NoError(t, err)
+
+	vecs := newTestChangeVecs(t, mp, []int64{1, 2, 1, 3, 4}, []string{"a", "b", "c", "", "d"})
+	ts := func(physical int64) timestamp.Timestamp {
+		return timestamp.Timestamp{PhysicalTime: physical}
+	}
+	changes := []engine.Change{
+		// committed before the checkpoint, replayed by the logtail
+		{Typ: engine.ChangeInsert, CommitTs: ts(5), Vecs: vecs, Row: 4},
+		{Typ: engine.ChangeInsert, CommitTs: ts(30), Vecs: vecs, Row: 2},
+		{Typ: engine.ChangeInsert, CommitTs: ts(20), Vecs: vecs, Row: 0},
+		{Typ: engine.ChangeInsert, CommitTs: ts(20), Vecs: vecs, Row: 1},
+		{Typ: engine.ChangeDelete, CommitTs: ts(30), Vecs: vecs, Row: 0},
+		{Typ: engine.ChangeInsert, CommitTs: ts(40), Vecs: vecs, Row: 3},
+		{Typ: engine.ChangeDelete, CommitTs: ts(50), Vecs: vecs, Row: 1},
+		// the row deleted is not found
+		{Typ: engine.ChangeDelete, CommitTs: ts(50)},
+	}
+	require.NoError(t, f.Handle(ctx, changes, ts(60)))
+	// replayed again
+	require.NoError(t, f.Handle(ctx, changes, ts(60)))
+	require.NoError(t, f.flush(ctx))
+
+	checkpoint, err := f.Status()
+	require.NoError(t, err)
+	require.Equal(t, ts(60), checkpoint)
+	require.Equal(t, ts(60), sink.checkpoint)
+	require.Equal(t, 6, len(sink.msgs))
+
+	expected := []struct {
+		op     string
+		key    string
+		before map[string]any
+		after  map[string]any
+	}{
+		{"c", `{"id":1}`, nil, map[string]any{"id": float64(1), "name": "a"}},
+		{"c", `{"id":2}`, nil, map[string]any{"id": float64(2), "name": "b"}},
+		{"u", `{"id":1}`, map[string]any{"id": float64(1), "name": "a"}, map[string]any{"id": float64(1), "name": "c"}},
+		{"c", `{"id":3}`, nil, map[string]any{"id": float64(3), "name": nil}},
+		{"d", `{"id":2}`, map[string]any{"id": float64(2), "name": "b"}, nil},
+		{"d", "", nil, nil},
+	}
+	for i, e := range expected {
+		env := decodeEnvelope(t, sink.msgs[i].Value)
+		require.Equal(t, e.op, env["op"])
+		require.Equal(t, e.key, string(sink.msgs[i].Key))
+		if e.before == nil {
+			require.Nil(t, env["before"])
+		} else {
+			require.Equal(t, e.before, env["before"])
+		}
+		if e.after == nil {
+			require.Nil(t, env["after"])
+		} else {
+			require.Equal(t, e.after, env["after"])
+		}
+		source := env["source"].(map[string]any)
+		require.Equal(t, "matrixone", source["connector"])
+		require.Equal(t, "feed", source["name"])
+		require.Equal(t, "db", source["db"])
+		require.Equal(t, "t", source["table"])
+		require.Equal(t, FormatCheckpoint(sink.msgs[i].CommitTs), source["commit_ts"])
+	}
+}
+
+func TestFeedFails(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	ts := func(physical int64) timestamp.Timestamp {
+		return timestamp.Timestamp{PhysicalTime: physical}
+	}
+	vecs := newTestChangeVecs(t, mp, []int64{1, 2}, []string{"a", "b"})
+	newFeed := func(sink Sink) *Feed {
+		f, err := NewFeed(ctx, FeedSpec{
+			Name:         "feed",
+			DatabaseName: "db",
+			TableName:    "t",
+			Columns:      []string{"id", "name"},
+			PrimaryKeys:  []string{"id"},
+			StartTs:      ts(10),
+		}, sink)
+		require.NoError(t, err)
+		return f
+	}
+	checkpointed := func(start int64) []engine.Change {
+		return []engine.Change{
+			{Typ: engine.ChangeCheckpoint, CommitTs: ts(start)},
+			{Typ: engine.ChangeInsert, CommitTs: ts(20), Vecs: vecs, Row: 0},
+		}
+	}
+
+	// the checkpoint of the dn is received when the new feed subscribes the table
+	sink := &testSink{}
+	f := newFeed(sink)
+	require.NoError(t, f.Handle(ctx, checkpointed(5), ts(30)))
+	require.NoError(t, f.flush(ctx))
+	require.Equal(t, 1, len(sink.msgs))
+	// the changes may be folded into the checkpoint starting after the changes received
+	require.Error(t, f.Handle(ctx, checkpointed(30), ts(40)))
+	_, err := f.Status()
+	require.Error(t, err)
+	require.NoError(t, f.flush(ctx))
+
+	// the changes in the checkpoint after the feed received are captured
+	sink = &testSink{}
+	f = newFeed(sink)
+	require.NoError(t, f.Handle(ctx, checkpointed(10), ts(30)))
+	require.NoError(t, f.flush(ctx))
+	require.Equal(t, 1, len(sink.msgs))
+	f = newFeed(&testSink{})
+	require.Error(t, f.Handle(ctx, checkpointed(15), ts(30)))
+}
+
+func TestFeedBlocksUntilSinkCatchesUp(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	ts := func(physical int64) timestamp.Timestamp {
+		return timestamp.Timestamp{PhysicalTime: physical}
+	}
+	vecs := newTestChangeVecs(t, mp, []int64{1, 2}, []string{"a", "b"})
+	defer func(n int) {
+		maxPendingMessages = n
+	}(maxPendingMessages)
+	maxPendingMessages = 1
+
+	sink := &testSink{}
+	f, err := NewFeed(ctx, FeedSpec{
+		Name:         "feed",
+		DatabaseName: "db",
+		TableName:    "t",
+		Columns:      []string{"id", "name"},
+		PrimaryKeys:  []string{"id"},
+		StartTs:      ts(10),
+	}, sink)
+	require.NoError(t, err)
+	require.NoError(t, f.Handle(ctx, []engine.Change{
+		{Typ: engine.ChangeInsert, CommitTs: ts(20), Vecs: vecs, Row: 0},
+	}, ts(20)))
+
+	handled := make(chan error, 1)
+	go func() {
+		handled <- f.Handle(ctx, []engine.Change{
+			{Typ: engine.ChangeInsert, CommitTs: ts(30), Vecs: vecs, Row: 1},
+		}, ts(30))
+	}()
+	select {
+	case <-handled:
+		require.Fail(t, "the changes are handled before the sink catches up")
+	case <-time.After(time.Millisecond * 100):
+	}
+	require.NoError(t, f.flush(ctx))
+	require.NoError(t, <-handled)
+	require.Equal(t, 2, len(sink.msgs))
+	_, err = f.Status()
+	require.NoError(t, err)
+}
+
+func TestCheckSink(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, CheckSink(ctx, 0, "file:///tmp/t.json"))
+	require.Error(t, CheckSink(ctx, 1, "file:///tmp/t.json"))
+	require.NoError(t, CheckSink(ctx, 1, "kafka://localhost:9092/topic"))
+}
+
+func TestFileSink(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "t.json")
+	sink, err := NewSink(ctx, "feed", "file://"+path)
+	require.NoError(t, err)
+	checkpoint, err := sink.Checkpoint(ctx)
+	require.NoError(t, err)
+	require.True(t, checkpoint.IsEmpty())
+
+	ts := timestamp.Timestamp{PhysicalTime: 100, LogicalTime: 1}
+	require.NoError(t, sink.Send(ctx, []Message{{Value: []byte(`{"a":1}`)}, {Value: []byte(`{"a":2}`)}}, ts))
+	// the messages written but not checkpointed before the crash
+	require.NoError(t, sink.(*fileSink).f.Sync())
+	_, err = sink.(*fileSink).f.WriteAt([]byte(`{"a":3}`+"\n"), sink.(*fileSink).size)
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+
+	sink, err = NewSink(ctx, "feed", "file://"+path)
+	require.NoError(t, err)
+	checkpoint, err = sink.Checkpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ts, checkpoint)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":1}\n{\"a\":2}\n", string(data))
+
+	require.NoError(t, sink.Send(ctx, []Message{{Value: []byte(`{"a":3}`)}}, ts.Next()))
+	require.NoError(t, sink.Close())
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 3, strings.Count(string(data), "\n"))
+
+	_, err = NewSink(ctx, "feed", "file://host/t.json")
+	require.Error(t, err)
+	_, err = NewSink(ctx, "feed", "s3://bucket/t.json")
+	require.Error(t, err)
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctx := context.Background()
+	uri := "kafka://broker1:9092,broker2:9092/orders"
+	sink, err := NewSink(ctx, "feed", uri)
+	require.NoError(t, err)
+	checkpoint, err := sink.Checkpoint(ctx)
+	require.NoError(t, err)
+	require.True(t, checkpoint.IsEmpty())
+
+	ts := timestamp.Timestamp{PhysicalTime: 100}
+	msgs := []Message{
+		{Key: []byte(`{"id":1}`), Value: []byte("1")},
+		{Key: []byte(`{"id":2}`), Value: []byte("2")},
+		{Key: []byte(`{"id":1}`), Value: []byte("3")},
+	}
+	require.NoError(t, sink.Send(ctx, msgs, ts))
+	require.NoError(t, sink.Close())
+
+	// the messages of a key are in a partition in order
+	client, err := newMemoryKafkaClient(ctx, []string{"broker2:9092", "broker1:9092"})
+	require.NoError(t, err)
+	partition := sink.(*kafkaSink).partition(msgs[0].Key)
+	kmsgs, err := client.Fetch(ctx, "orders", partition, 0)
+	require.NoError(t, err)
+	var values []string
+	for _, msg := range kmsgs {
+		if string(msg.Key) == `{"id":1}` {
+			values = append(values, string(msg.Value))
+		}
+	}
+	require.Equal(t, []string{"1", "3"}, values)
+
+	// resumed from the checkpoint in the offsets topic
+	u, err := url.Parse(uri)
+	require.NoError(t, err)
+	sink, err = newKafkaSink(ctx, "feed", u)
+	require.NoError(t, err)
+	checkpoint, err = sink.Checkpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ts, checkpoint)
+	sink, err = newKafkaSink(ctx, "other", u)
+	require.NoError(t, err)
+	checkpoint, err = sink.Checkpoint(ctx)
+	require.NoError(t, err)
+	require.True(t, checkpoint.IsEmpty())
+
+	_, err = NewSink(ctx, "feed", "kafka://broker1:9092")
+	require.Error(t, err)
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+const checkpointFileSuffix = ".checkpoint"
+
+// fileSink appends the messages to a local file of the cn as json lines, which is
+// only allowed for the sys account, e.g.
+// file:///data/t1.json. The checkpoint and the size of the file after the
+// messages of it are recorded in the checkpoint file next to it, and the file
+// is truncated to the size when the sink is opened, so no message is written
+// twice if the messages are sent again after a crash.
+type fileSink struct {
+	path       string
+	f          *os.File
+	size       int64
+	checkpoint timestamp.Timestamp
+}
+
+func newFileSink(ctx context.Context, _ string, uri *url.URL) (Sink, error) {
+	if (uri.Host != "" && uri.Host != "localhost") || uri.Path == "" {
+		return nil, moerr.NewInvalidInput(ctx, "invalid changefeed sink '%s', the path of a local file is required", uri)
+	}
+	f, err := os.OpenFile(uri.Path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	s := &fileSink{
+		path: uri.Path,
+		f:    f,
+	}
+	if err = s.recover(ctx); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// recover reads the checkpoint file and truncates the messages written after
+// the checkpoint.
+func (s *fileSink) recover(ctx context.Context) error {
+	stat, err := s.f.Stat()
+	if err != nil {
+		return err
+	}
+	s.size = stat.Size()
+	data, err := os.ReadFile(s.path + checkpointFileSuffix)
+	if os.IsNotExist(err) {
+		// a new changefeed, appends to the file
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var checkpoint string
+	var size int64
+	if _, err = fmt.Sscan(string(data), &checkpoint, &size); err != nil {
+		return moerr.NewInvalidInput(ctx, "invalid changefeed checkpoint file '%s'", s.path+checkpointFileSuffix)
+	}
+	if s.checkpoint, err = ParseCheckpoint(ctx, checkpoint); err != nil {
+		return err
+	}
+	if size > s.size {
+		return moerr.NewInternalError(ctx, "changefeed file '%s' is truncated, %d bytes are checkpointed but only %d bytes are found",
+			s.path, size, s.size)
+	}
+	if size < s.size {
+		if err = s.f.Truncate(size); err != nil {
+			return err
+		}
+		s.size = size
+	}
+	return nil
+}
+
+func (s *fileSink) Checkpoint(_ context.Context) (timestamp.Timestamp, error) {
+	return s.checkpoint, nil
+}
+
+func (s *fileSink) Send(_ context.Context, msgs []Message, checkpoint timestamp.Timestamp) error {
+	var buf bytes.Buffer
+	for _, msg := range msgs {
+		buf.Write(msg.Value)
+		buf.WriteByte('\n')
+	}
+	if _, err := s.f.WriteAt(buf.Bytes(), s.size); err != nil {
+		return err
+	}
+	if err := s.f.Sync(); err != nil {
+		return err
+	}
+	if err := s.saveCheckpoint(checkpoint, s.size+int64(buf.Len())); err != nil {
+		return err
+	}
+	s.size += int64(buf.Len())
+	s.checkpoint = checkpoint
+	return nil
+}
+
+// saveCheckpoint replaces the checkpoint file atomically.
+func (s *fileSink) saveCheckpoint(checkpoint timestamp.Timestamp, size int64) error {
+	name := s.path + checkpointFileSuffix
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".checkpoint-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = fmt.Fprintf(tmp, "%s %d\n", FormatCheckpoint(checkpoint), size); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+func (s *fileSink) Close() error {
+	return s.f.Close()
+}
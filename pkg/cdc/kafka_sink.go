@@ -0,0 +1,264 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"hash/fnv"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+// KafkaOffsetsTopic is the topic of the checkpoints of the changefeeds, keyed
+// by the changefeed name.
+const KafkaOffsetsTopic = "__mo_changefeed_offsets"
+
+// KafkaMessage is a message of a partition of a kafka topic.
+type KafkaMessage struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+}
+
+// KafkaClient is the client of a cluster speaking the kafka protocol.
+type KafkaClient interface {
+	// Partitions returns the number of the partitions of the topic, the topic
+	// is created if not exists.
+	Partitions(ctx context.Context, topic string) (int32, error)
+	// Produce appends the messages to the partitions of the topics in order,
+	// and sets the offsets of them.
+	Produce(ctx context.Context, msgs []*KafkaMessage) error
+	// Fetch reads the messages of the partition of the topic from the offset.
+	Fetch(ctx context.Context, topic string, partition int32, offset int64) ([]*KafkaMessage, error)
+	Close() error
+}
+
+// KafkaClientFactory creates the client of the cluster of the brokers.
+type KafkaClientFactory func(ctx context.Context, brokers []string) (KafkaClient, error)
+
+var kafkaClientFactory = struct {
+	sync.RWMutex
+	factory KafkaClientFactory
+}{
+	factory: newMemoryKafkaClient,
+}
+
+// RegisterKafkaClient replaces the factory of the kafka clients. The clients
+// created by default connect to the clusters simulated in memory, which are
+// the stand-in of kafka.
+func RegisterKafkaClient(factory KafkaClientFactory) {
+	kafkaClientFactory.Lock()
+	defer kafkaClientFactory.Unlock()
+	kafkaClientFactory.factory = factory
+}
+
+// kafkaSink produces the messages to the partitions of a topic by the hash of
+// the keys, e.g. kafka://broker1:9092,broker2:9092/topic, so the messages of a
+// row are always in the same partition in order. The checkpoint is produced to
+// the offsets topic after the messages, the messages may be produced again if
+// the changefeed is resumed before the checkpoint is produced, and the
+// consumers skip them by the commit_ts of the source.
+type kafkaSink struct {
+	feed       string
+	topic      string
+	client     KafkaClient
+	partitions int32
+	checkpoint *timestamp.Timestamp
+}
+
+func newKafkaSink(ctx context.Context, feed string, uri *url.URL) (Sink, error) {
+	topic := strings.Trim(uri.Path, "/")
+	if uri.Host == "" || topic == "" || strings.Contains(topic, "/") {
+		return nil, moerr.NewInvalidInput(ctx, "invalid changefeed sink '%s', kafka://brokers/topic is required", uri)
+	}
+	kafkaClientFactory.RLock()
+	factory := kafkaClientFactory.factory
+	kafkaClientFactory.RUnlock()
+	client, err := factory(ctx, strings.Split(uri.Host, ","))
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := client.Partitions(ctx, topic)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &kafkaSink{
+		feed:       feed,
+		topic:      topic,
+		client:     client,
+		partitions: partitions,
+	}, nil
+}
+
+func (s *kafkaSink) Checkpoint(ctx context.Context) (timestamp.Timestamp, error) {
+	if s.checkpoint != nil {
+		return *s.checkpoint, nil
+	}
+	var checkpoint timestamp.Timestamp
+	for offset := int64(0); ; {
+		msgs, err := s.client.Fetch(ctx, KafkaOffsetsTopic, 0, offset)
+		if err != nil {
+			return timestamp.Timestamp{}, err
+		}
+		if len(msgs) == 0 {
+			break
+		}
+		for _, msg := range msgs {
+			if string(msg.Key) != s.feed {
+				continue
+			}
+			if checkpoint, err = ParseCheckpoint(ctx, string(msg.Value)); err != nil {
+				return timestamp.Timestamp{}, err
+			}
+		}
+		offset = msgs[len(msgs)-1].Offset + 1
+	}
+	s.checkpoint = &checkpoint
+	return checkpoint, nil
+}
+
+func (s *kafkaSink) Send(ctx context.Context, msgs []Message, checkpoint timestamp.Timestamp) error {
+	kmsgs := make([]*KafkaMessage, 0, len(msgs)+1)
+	for _, msg := range msgs {
+		kmsgs = append(kmsgs, &KafkaMessage{
+			Topic:     s.topic,
+			Partition: s.partition(msg.Key),
+			Key:       msg.Key,
+			Value:     msg.Value,
+		})
+	}
+	kmsgs = append(kmsgs, &KafkaMessage{
+		Topic: KafkaOffsetsTopic,
+		Key:   []byte(s.feed),
+		Value: []byte(FormatCheckpoint(checkpoint)),
+	})
+	if err := s.client.Produce(ctx, kmsgs); err != nil {
+		return err
+	}
+	s.checkpoint = &checkpoint
+	return nil
+}
+
+// partition returns the partition of the key, the messages without keys are
+// produced to the first partition to keep them in order.
+func (s *kafkaSink) partition(key []byte) int32 {
+	if key == nil {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write(key)
+	return int32(h.Sum32() % uint32(s.partitions))
+}
+
+func (s *kafkaSink) Close() error {
+	return s.client.Close()
+}
+
+// memoryKafkaPartitions is the number of the partitions of the topics created
+// in the kafka clusters simulated in memory.
+const memoryKafkaPartitions = 4
+
+// memoryKafkaClusters are the kafka clusters simulated in memory, keyed by
+// the brokers.
+var memoryKafkaClusters = struct {
+	sync.Mutex
+	m map[string]*memoryKafkaCluster
+}{
+	m: make(map[string]*memoryKafkaCluster),
+}
+
+type memoryKafkaCluster struct {
+	sync.Mutex
+	topics map[string][][]*KafkaMessage
+}
+
+func newMemoryKafkaClient(_ context.Context, brokers []string) (KafkaClient, error) {
+	brokers = append([]string{}, brokers...)
+	sort.Strings(brokers)
+	key := strings.Join(brokers, ",")
+	memoryKafkaClusters.Lock()
+	defer memoryKafkaClusters.Unlock()
+	cluster, ok := memoryKafkaClusters.m[key]
+	if !ok {
+		cluster = &memoryKafkaCluster{
+			topics: make(map[string][][]*KafkaMessage),
+		}
+		memoryKafkaClusters.m[key] = cluster
+	}
+	return cluster, nil
+}
+
+// topic returns the partitions of the topic, the caller holds the lock.
+func (c *memoryKafkaCluster) topic(name string) [][]*KafkaMessage {
+	partitions, ok := c.topics[name]
+	if !ok {
+		partitions = make([][]*KafkaMessage, memoryKafkaPartitions)
+		c.topics[name] = partitions
+	}
+	return partitions
+}
+
+func (c *memoryKafkaCluster) Partitions(_ context.Context, topic string) (int32, error) {
+	c.Lock()
+	defer c.Unlock()
+	return int32(len(c.topic(topic))), nil
+}
+
+func (c *memoryKafkaCluster) Produce(ctx context.Context, msgs []*KafkaMessage) error {
+	c.Lock()
+	defer c.Unlock()
+	for _, msg := range msgs {
+		if msg.Partition < 0 || int(msg.Partition) >= len(c.topic(msg.Topic)) {
+			return moerr.NewInvalidInput(ctx, "invalid partition %d of kafka topic '%s'", msg.Partition, msg.Topic)
+		}
+	}
+	for _, msg := range msgs {
+		partitions := c.topic(msg.Topic)
+		msg.Offset = int64(len(partitions[msg.Partition]))
+		partitions[msg.Partition] = append(partitions[msg.Partition], &KafkaMessage{
+			Topic:     msg.Topic,
+			Partition: msg.Partition,
+			Offset:    msg.Offset,
+			Key:       append([]byte(nil), msg.Key...),
+			Value:     append([]byte(nil), msg.Value...),
+		})
+	}
+	return nil
+}
+
+func (c *memoryKafkaCluster) Fetch(ctx context.Context, topic string, partition int32, offset int64) ([]*KafkaMessage, error) {
+	c.Lock()
+	defer c.Unlock()
+	partitions := c.topic(topic)
+	if partition < 0 || int(partition) >= len(partitions) {
+		return nil, moerr.NewInvalidInput(ctx, "invalid partition %d of kafka topic '%s'", partition, topic)
+	}
+	if offset >= int64(len(partitions[partition])) {
+		return nil, nil
+	}
+	return append([]*KafkaMessage{}, partitions[partition][offset:]...), nil
+}
+
+func (c *memoryKafkaCluster) Close() error {
+	return nil
+}
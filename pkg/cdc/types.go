@@ -0,0 +1,139 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+// Op is the operation of a change event, the same as the op of the
+// debezium envelope.
+type Op string
+
+const (
+	OpCreate Op = "c"
+	OpUpdate Op = "u"
+	OpDelete Op = "d"
+)
+
+// Event is a row change of the table captured by a changefeed.
+type Event struct {
+	Op       Op
+	CommitTs timestamp.Timestamp
+	// Key is the primary key of the row changed, nil if the table has no
+	// primary key.
+	Key map[string]any
+	// Before is the row before the change, nil for the inserts and the deletes
+	// of the rows not found.
+	Before map[string]any
+	// After is the row after the change, nil for the deletes.
+	After map[string]any
+}
+
+// Message is an encoded change event sent to a sink.
+type Message struct {
+	Key      []byte
+	Value    []byte
+	CommitTs timestamp.Timestamp
+}
+
+// Sink is the destination of the change events of a changefeed.
+type Sink interface {
+	// Checkpoint returns the checkpoint of the last messages sent, the messages
+	// committed no later than it are skipped when the changefeed is resumed.
+	Checkpoint(ctx context.Context) (timestamp.Timestamp, error)
+	// Send writes the messages in order, and then records the checkpoint.
+	// The messages of a transaction are always sent in one call.
+	Send(ctx context.Context, msgs []Message, checkpoint timestamp.Timestamp) error
+	Close() error
+}
+
+// SinkFactory creates the sink of the changefeed named feed from the uri.
+type SinkFactory func(ctx context.Context, feed string, uri *url.URL) (Sink, error)
+
+var sinkFactories = struct {
+	sync.RWMutex
+	m map[string]SinkFactory
+}{
+	m: make(map[string]SinkFactory),
+}
+
+// RegisterSink registers the factory of the sinks for the uri scheme.
+func RegisterSink(scheme string, factory SinkFactory) {
+	sinkFactories.Lock()
+	defer sinkFactories.Unlock()
+	sinkFactories.m[scheme] = factory
+}
+
+// the schemes of the sinks writing to the files of the cn, which are only allowed
+// for the sys account.
+var sysSinkSchemes = map[string]bool{
+	"file": true,
+}
+
+// CheckSink checks whether the account is allowed to create the sink of the uri.
+func CheckSink(ctx context.Context, accountId uint32, uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return moerr.NewInvalidInput(ctx, "invalid changefeed sink '%s'", uri)
+	}
+	if sysSinkSchemes[u.Scheme] && accountId != catalog.System_Account {
+		return moerr.NewNotSupported(ctx, "changefeed sink '%s' for the non-sys account", u.Scheme)
+	}
+	return nil
+}
+
+// NewSink creates the sink of the changefeed named feed from the uri.
+func NewSink(ctx context.Context, feed string, uri string) (Sink, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, moerr.NewInvalidInput(ctx, "invalid changefeed sink '%s'", uri)
+	}
+	sinkFactories.RLock()
+	factory, ok := sinkFactories.m[u.Scheme]
+	sinkFactories.RUnlock()
+	if !ok {
+		return nil, moerr.NewNotSupported(ctx, "changefeed sink '%s'", u.Scheme)
+	}
+	return factory(ctx, feed, u)
+}
+
+// FormatCheckpoint formats the checkpoint stored by the sinks.
+func FormatCheckpoint(ts timestamp.Timestamp) string {
+	return ts.DebugString()
+}
+
+// ParseCheckpoint parses the checkpoint stored by the sinks.
+func ParseCheckpoint(ctx context.Context, value string) (timestamp.Timestamp, error) {
+	if value == "" {
+		return timestamp.Timestamp{}, nil
+	}
+	ts, err := timestamp.ParseTimestamp(value)
+	if err != nil {
+		return timestamp.Timestamp{}, moerr.NewInvalidInput(ctx, "invalid changefeed checkpoint '%s'", value)
+	}
+	return ts, nil
+}
+
+func init() {
+	RegisterSink("file", newFileSink)
+	RegisterSink("kafka", newKafkaSink)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
@@ -84,6 +85,9 @@ func NewService(
 	if _, err = srv.getHAKeeperClient(); err != nil {
 		return nil, err
 	}
+	if err = srv.initLockService(); err != nil {
+		return nil, err
+	}
 
 	pu := config.NewParameterUnit(
 		&cfg.Frontend,
@@ -164,6 +168,11 @@ func (s *service) Close() error {
 	if err := s.stopRPCs(); err != nil {
 		return err
 	}
+	if s.lockService != nil {
+		if err := s.closeLockService(); err != nil {
+			return err
+		}
+	}
 	return s.server.Close()
 }
 
@@ -254,7 +263,18 @@ func (s *service) initMOServer(ctx context.Context, pu *config.ParameterUnit) er
 
 	s.createMOServer(cancelMoServerCtx, pu)
 
-	return nil
+	// resume the changefeeds running on the cn before it restarts
+	moServerCtx := context.WithValue(cancelMoServerCtx, config.ParameterUnitKey, pu)
+	if err := frontend.StartChangefeeds(moServerCtx, s.cfg.UUID, pu, s.mo.GetRoutineManager().GetAutoIncrCache()); err != nil {
+		return err
+	}
+	// count the connections and the queries of the accounts in the cluster
+	rm := s.mo.GetRoutineManager()
+	if err := rm.StartAccountLeases(moServerCtx, s.cfg.UUID, pu, rm.GetAutoIncrCache()); err != nil {
+		return err
+	}
+	// keep the audit policies of all the accounts on the cn
+	return frontend.StartAuditPolicies(moServerCtx, pu, s.mo.GetRoutineManager().GetAutoIncrCache())
 }
 
 func (s *service) initEngine(
@@ -331,6 +351,60 @@ func (s *service) initClusterService() {
 	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.ClusterService, s.moCluster)
 }
 
+// lockServiceRefs counts the CNs of the process using the shared lockservice
+var lockServiceRefs struct {
+	sync.Mutex
+	n int
+}
+
+// initLockService creates the lockservice used by the txns to lock rows, the CNs
+// running in the same process share one lockservice, which is closed by the last
+// CN closed. The rows are locked in the lock server of the DN, so the pessimistic
+// txns running on all CNs are serialized by the locks.
+func (s *service) initLockService() error {
+	lockServiceRefs.Lock()
+	defer lockServiceRefs.Unlock()
+	rt := runtime.ProcessLevelRuntime()
+	if lockServiceRefs.n == 0 {
+		ls, err := lockservice.NewRemoteLockService(s.cfg.UUID, getLockServerAddress)
+		if err != nil {
+			return err
+		}
+		rt.SetGlobalVariables(runtime.LockService, ls)
+	}
+	v, _ := rt.GetGlobalVariables(runtime.LockService)
+	s.lockService = v.(lockservice.LockService)
+	lockServiceRefs.n++
+	return nil
+}
+
+func (s *service) closeLockService() error {
+	lockServiceRefs.Lock()
+	defer lockServiceRefs.Unlock()
+	lockServiceRefs.n--
+	if lockServiceRefs.n > 0 {
+		return nil
+	}
+	return s.lockService.Close()
+}
+
+// getLockServerAddress returns the address of the lock server of the DN
+func getLockServerAddress() (string, bool) {
+	address := ""
+	cluster := clusterservice.GetMOCluster()
+	cluster.GetDNService(
+		clusterservice.NewSelector(),
+		func(dn metadata.DNService) bool {
+			address = dn.LockServiceAddress
+			return address == ""
+		})
+	if address == "" {
+		cluster.ForceRefresh()
+		return "", false
+	}
+	return address, true
+}
+
 func (s *service) getTxnSender() (sender rpc.TxnSender, err error) {
 	// handleTemp is used to manipulate memorystorage stored for temporary table created by sessions.
 	// processing of temporary table is currently on local, so we need to add a WithLocalDispatch logic to service.
@@ -231,6 +231,7 @@ func (s *service) registerExecutorsLocked() {
 			if err := mometric.InitSchema(moServerCtx, ieFactory); err != nil {
 				return err
 			}
+			// only the audit log table is created if the trace is disabled
 			if err := motrace.InitSchema(moServerCtx, ieFactory); err != nil {
 				return err
 			}
@@ -245,6 +246,11 @@ func (s *service) registerExecutorsLocked() {
 				return err
 			}
 
+			// init column statistics refresh task
+			if err := frontend.CreateColumnStatsCronTask(moServerCtx, task.TaskCode_ColumnStatsRefresh, ts); err != nil {
+				return err
+			}
+
 			return nil
 		})
 
@@ -254,4 +260,7 @@ func (s *service) registerExecutorsLocked() {
 	// init metric task
 	s.task.runner.RegisterExecutor(task.TaskCode_MetricStorageUsage,
 		metric.GetMetricStorageUsageExecutor(ieFactory))
+	// init column statistics refresh task
+	s.task.runner.RegisterExecutor(task.TaskCode_ColumnStatsRefresh,
+		frontend.ColumnStatsRefreshExecutor(pu, s.mo.GetRoutineManager().GetAutoIncrCache()))
 }
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -74,6 +75,10 @@ func initTAE(
 	opts.CheckpointCfg.MinCount = cfg.Engine.MinCount
 	opts.CheckpointCfg.IncrementalInterval = cfg.Engine.IncrementalInterval.Duration
 	opts.CheckpointCfg.GlobalMinCount = cfg.Engine.GlobalMinCount
+	opts.GCCfg = &options.GCCfg{}
+	if v, ok := runtime.ProcessLevelRuntime().GetGlobalVariables(runtime.SnapshotRetention); ok {
+		opts.GCCfg.GCTTL = v.(time.Duration)
+	}
 
 	tae, err := db.Open(targetDir+"/tae", opts)
 	if err != nil {
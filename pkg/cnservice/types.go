@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
@@ -230,6 +231,7 @@ type service struct {
 	fileService            fileservice.FileService
 	pu                     *config.ParameterUnit
 	moCluster              clusterservice.MOCluster
+	lockService            lockservice.LockService
 
 	stopper *stopper.Stopper
 
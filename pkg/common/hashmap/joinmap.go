@@ -52,6 +52,14 @@ func (jm *JoinMap) Nullsels() []int32 {
 	return jm.nullSels
 }
 
+func (jm *JoinMap) Spilled() Spilled {
+	return jm.spilled
+}
+
+func (jm *JoinMap) SetSpilled(spilled Spilled) {
+	jm.spilled = spilled
+}
+
 func (jm *JoinMap) Dup() *JoinMap {
 	m0 := &StrHashMap{
 		m:             jm.mp.m,
@@ -71,6 +79,7 @@ func (jm *JoinMap) Dup() *JoinMap {
 		hasNull:  jm.hasNull,
 		cnt:      jm.cnt,
 		nullSels: jm.nullSels,
+		spilled:  jm.spilled,
 	}
 	if atomic.AddInt64(jm.dupCnt, -1) == 0 {
 		jm.mp = nil
@@ -99,6 +108,10 @@ func (jm *JoinMap) Free() {
 	jm.sels = nil
 	jm.nullSels = nil
 	jm.mp.Free()
+	if jm.spilled != nil {
+		jm.spilled.Free()
+		jm.spilled = nil
+	}
 }
 
 func (jm *JoinMap) Size() int64 {
@@ -61,6 +61,12 @@ type Iterator interface {
 	Find(start, count int, vecs []*vector.Vector, inBuckets []uint8) (vs []uint64, zvs []int64)
 }
 
+// Spilled is the build side of a join spilled to disk
+type Spilled interface {
+	// Free removes the spilled data
+	Free()
+}
+
 // JoinMap is used for join
 type JoinMap struct {
 	cnt    *int64
@@ -72,6 +78,10 @@ type JoinMap struct {
 	hasNull bool
 
 	nullSels []int32
+
+	// spilled is not nil if the build side is spilled to disk,
+	// the hash map is empty and the join is done partition by partition.
+	spilled Spilled
 }
 
 // StrHashMap key is []byte, value is an uint64 value (starting from 1)
@@ -58,6 +58,12 @@ const (
 	ErrOOM              uint16 = 20103
 	ErrQueryInterrupted uint16 = 20104
 	ErrNotSupported     uint16 = 20105
+	// ErrAccountQuotaExceeded the account consumes more than its quota allows
+	ErrAccountQuotaExceeded uint16 = 20106
+	// ErrQueryTimeout the query runs longer than its max execution time
+	ErrQueryTimeout uint16 = 20107
+	// ErrQueryMemLimitExceeded the query allocates more memory than its limit
+	ErrQueryMemLimitExceeded uint16 = 20108
 
 	// Group 2: numeric and functions
 	ErrDivByZero                   uint16 = 20200
@@ -172,10 +178,15 @@ const (
 	ErrAppendableBlockNotFound   uint16 = 20625
 	ErrTAEDebug                  uint16 = 20626
 	ErrDuplicateKey              uint16 = 20626
+	ErrTxnNeedRetry              uint16 = 20627
+	ErrSavepointNotExist         uint16 = 20628
+	ErrSnapshotTooOld            uint16 = 20629
 
 	// Group 7: lock service
 	// ErrDeadLockDetected lockservice has detected a deadlock and should abort the transaction if it receives this error
 	ErrDeadLockDetected uint16 = 20701
+	// ErrLockConflict lockservice found a lock conflict with the FastFail wait policy
+	ErrLockConflict uint16 = 20702
 
 	// ErrEnd, the max value of MOErrorCode
 	ErrEnd uint16 = 65535
@@ -200,12 +211,15 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrWarnDataTruncated: {WARN_DATA_TRUNCATED, []string{MySQLDefaultSqlState}, "warning: data truncated"},
 
 	// Group 1: Internal errors
-	ErrStart:            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: error code start"},
-	ErrInternal:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: %s"},
-	ErrNYI:              {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "%s is not yet implemented"},
-	ErrOOM:              {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "error: out of memory"},
-	ErrQueryInterrupted: {ER_QUERY_INTERRUPTED, []string{MySQLDefaultSqlState}, "query interrupted"},
-	ErrNotSupported:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "not supported: %s"},
+	ErrStart:                 {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: error code start"},
+	ErrInternal:              {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: %s"},
+	ErrNYI:                   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "%s is not yet implemented"},
+	ErrOOM:                   {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "error: out of memory"},
+	ErrQueryInterrupted:      {ER_QUERY_INTERRUPTED, []string{MySQLDefaultSqlState}, "query interrupted"},
+	ErrNotSupported:          {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "not supported: %s"},
+	ErrAccountQuotaExceeded:  {ER_USER_LIMIT_REACHED, []string{"42000"}, "account %s has exceeded the '%s' quota (current value: %d, limit: %d)"},
+	ErrQueryTimeout:          {ER_QUERY_TIMEOUT, []string{MySQLDefaultSqlState}, "Query execution was interrupted, maximum statement execution time exceeded"},
+	ErrQueryMemLimitExceeded: {ER_CAPACITY_EXCEEDED, []string{MySQLDefaultSqlState}, "Query execution was interrupted, memory of %d bytes exceeds the query_mem_limit %d bytes"},
 
 	// Group 2: numeric
 	ErrDivByZero:                   {ER_DIVISION_BY_ZERO, []string{MySQLDefaultSqlState}, "division by zero"},
@@ -306,9 +320,13 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrAppendableSegmentNotFound: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "appendable segment not found"},
 	ErrAppendableBlockNotFound:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "appendable block not found"},
 	ErrDuplicateKey:              {ER_DUP_KEYNAME, []string{MySQLDefaultSqlState}, "duplicate key name '%s'"},
+	ErrTxnNeedRetry:              {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "txn need retry"},
+	ErrSavepointNotExist:         {ER_SP_DOES_NOT_EXIST, []string{"42000"}, "SAVEPOINT %s does not exist"},
+	ErrSnapshotTooOld:            {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "snapshot %s is older than the retained history, only the last %s is retained"},
 
 	// Group 7: lock service
 	ErrDeadLockDetected: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "deadlock detected"},
+	ErrLockConflict:     {ER_LOCK_NOWAIT, []string{MySQLDefaultSqlState}, "lock conflict"},
 
 	// Group End: max value of MOErrorCode
 	ErrEnd: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: end of errcode code"},
@@ -530,6 +548,18 @@ func NewQueryInterrupted(ctx context.Context) *Error {
 	return newError(ctx, ErrQueryInterrupted)
 }
 
+func NewAccountQuotaExceeded(ctx context.Context, account string, quota string, current, limit uint64) *Error {
+	return newError(ctx, ErrAccountQuotaExceeded, account, quota, current, limit)
+}
+
+func NewQueryTimeout(ctx context.Context) *Error {
+	return newError(ctx, ErrQueryTimeout)
+}
+
+func NewQueryMemLimitExceeded(ctx context.Context, used, limit int64) *Error {
+	return newError(ctx, ErrQueryMemLimitExceeded, used, limit)
+}
+
 func NewDivByZero(ctx context.Context) *Error {
 	return newError(ctx, ErrDivByZero)
 }
@@ -891,6 +921,18 @@ func NewDuplicateKey(ctx context.Context, k string) *Error {
 	return newError(ctx, ErrDuplicateKey, k)
 }
 
+func NewTxnNeedRetry(ctx context.Context) *Error {
+	return newError(ctx, ErrTxnNeedRetry)
+}
+
+func NewSavepointNotExist(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrSavepointNotExist, name)
+}
+
+func NewSnapshotTooOld(ctx context.Context, ts string, retention string) *Error {
+	return newError(ctx, ErrSnapshotTooOld, ts, retention)
+}
+
 func NewAppendableSegmentNotFound(ctx context.Context) *Error {
 	return newError(ctx, ErrAppendableSegmentNotFound)
 }
@@ -903,6 +945,10 @@ func NewDeadLockDetected(ctx context.Context) *Error {
 	return newError(ctx, ErrDeadLockDetected)
 }
 
+func NewLockConflict(ctx context.Context) *Error {
+	return newError(ctx, ErrLockConflict)
+}
+
 var contextFunc atomic.Value
 
 func SetContextFunc(f func() context.Context) {
@@ -46,6 +46,14 @@ func NewOOMNoCtx() *Error {
 	return newError(Context(), ErrOOM)
 }
 
+func NewQueryTimeoutNoCtx() *Error {
+	return newError(Context(), ErrQueryTimeout)
+}
+
+func NewQueryMemLimitExceededNoCtx(used, limit int64) *Error {
+	return newError(Context(), ErrQueryMemLimitExceeded, used, limit)
+}
+
 func NewDivByZeroNoCtx() *Error {
 	return newError(Context(), ErrDivByZero)
 }
@@ -255,6 +263,10 @@ func NewDuplicateEntryNoCtx(entry string, key string) *Error {
 	return newError(Context(), ErrDuplicateEntry, entry, key)
 }
 
+func NewBadFieldErrorNoCtx(column, table string) *Error {
+	return newError(Context(), ErrBadFieldError, column, table)
+}
+
 func NewRoleGrantedToSelfNoCtx(from, to string) *Error {
 	return newError(Context(), ErrRoleGrantedToSelf, from, to)
 }
@@ -276,6 +288,10 @@ func NewDeadLockDetectedNoCtx() *Error {
 	return newError(Context(), ErrDeadLockDetected)
 }
 
+func NewLockConflictNoCtx() *Error {
+	return newError(Context(), ErrLockConflict)
+}
+
 func NewUDFAlreadyExistsNoCtx(f string) *Error {
 	return newError(Context(), ErrFunctionAlreadyExists, f)
 }
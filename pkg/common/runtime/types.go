@@ -26,6 +26,10 @@ const (
 	ClusterService = "cluster-service"
 	// TxnOptions options used to create txn
 	TxnOptions = "txn-options"
+	// LockService lockservice used by the txns to lock rows
+	LockService = "lock-service"
+	// SnapshotRetention how long the history is retained for reading at a past timestamp
+	SnapshotRetention = "snapshot-retention"
 )
 
 // Runtime contains the runtime environment for a MO service. Each CN/DN/LOG service
@@ -15,34 +15,98 @@
 package compress
 
 import (
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+// zstd encoder and decoder are safe for concurrent use of EncodeAll and DecodeAll,
+// they are created on the first use since the goroutines of them are never stopped.
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil)
+	})
+	return zstdErr
+}
+
+// CompressBound returns the maximum size of the compressed data of the specified size
+func CompressBound(size int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(size)
+	case Zstd:
+		// same as ZSTD_COMPRESSBOUND
+		bound := size + size>>8
+		if size < 128<<10 {
+			bound += (128<<10 - size) >> 11
+		}
+		return bound
+	case Snappy:
+		return snappy.MaxEncodedLen(size)
+	}
+	return size
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
+	case None:
+		n := copy(dst, src)
+		return dst[:n], nil
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
 		if err != nil {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	case Snappy:
+		return snappy.Encode(dst, src), nil
 	}
 	return nil, nil
 }
 
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
+	case None:
+		n := copy(dst, src)
+		return dst[:n], nil
 	case Lz4:
 		n, err := lz4.UncompressBlock(src, dst)
 		if err != nil {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	case Snappy:
+		return snappy.Decode(dst, src)
 	}
 	return nil, nil
 }
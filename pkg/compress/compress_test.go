@@ -15,6 +15,7 @@
 package compress
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"testing"
@@ -41,3 +42,28 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestCodecs(t *testing.T) {
+	xs := make([]int64, 1024)
+	for i := range xs {
+		xs[i] = int64(i % 10)
+	}
+	raw := types.EncodeSlice(xs)
+	for _, typ := range []int{None, Lz4, Zstd, Snappy} {
+		buf := make([]byte, CompressBound(len(raw), typ))
+		compressed, err := Compress(raw, buf, typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if typ != None && len(compressed) >= len(raw) {
+			t.Fatalf("%s: data not compressed", T(typ))
+		}
+		data, err := Decompress(compressed, make([]byte, len(raw)), typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(raw, data) {
+			t.Fatalf("%s: data not match", T(typ))
+		}
+	}
+}
@@ -73,6 +73,9 @@ var (
 	//process.Limitation.PartitionRows.  10 << 32 = 42949672960
 	defaultProcessLimitationPartitionRows = 42949672960
 
+	//process.Limitation.SpillSize.  4 << 30 = 4294967296
+	defaultProcessLimitationSpillSize = 4294967296
+
 	//the root directory of the storage
 	defaultStorePath = "./store"
 
@@ -139,6 +142,9 @@ var (
 
 	// defaultMergedExtension default: tae. Support val in [csv, tae]
 	defaultMergedExtension = "tae"
+
+	// defaultProtocolCompressionAlgorithms default: zlib,zstd,uncompressed.
+	defaultProtocolCompressionAlgorithms = "zlib,zstd,uncompressed"
 )
 
 // FrontendParameters of the frontend
@@ -188,6 +194,9 @@ type FrontendParameters struct {
 	//process.Limitation.PartitionRows. default: 10 << 32 = 42949672960
 	ProcessLimitationPartitionRows int64 `toml:"processLimitationPartitionRows"`
 
+	//process.Limitation.SpillSize. default: 4 << 30 = 4294967296. a negative value disables spilling
+	ProcessLimitationSpillSize int64 `toml:"processLimitationSpillSize"`
+
 	//the root directory of the storage and matrixcube's data. The actual dir is cubeDirPrefix + nodeID
 	StorePath string `toml:"storePath"`
 
@@ -251,6 +260,10 @@ type FrontendParameters struct {
 	//default is ''. Path of file that contains X509 key in PEM format for client
 	TlsKeyFile string `toml:"tlsKeyFile"`
 
+	//default is 'zlib,zstd,uncompressed'. The compression algorithms permitted for the connections
+	//from the clients, which are negotiated at handshake. 'uncompressed' only disables the compression.
+	ProtocolCompressionAlgorithms string `toml:"protocolCompressionAlgorithms"`
+
 	//default is 1
 	LogShardID uint64 `toml:"logshardid"`
 
@@ -332,6 +345,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.ProcessLimitationPartitionRows = int64(toml.ByteSize(defaultProcessLimitationPartitionRows))
 	}
 
+	if fp.ProcessLimitationSpillSize == 0 {
+		fp.ProcessLimitationSpillSize = int64(toml.ByteSize(defaultProcessLimitationSpillSize))
+	}
+
 	if fp.StorePath == "" {
 		fp.StorePath = defaultStorePath
 	}
@@ -380,6 +397,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.SessionTimeout.Duration = defaultSessionTimeout
 	}
 
+	if fp.ProtocolCompressionAlgorithms == "" {
+		fp.ProtocolCompressionAlgorithms = defaultProtocolCompressionAlgorithms
+	}
+
 	if fp.SaveQueryResult == "" {
 		fp.SaveQueryResult = "off"
 	}
@@ -16,10 +16,11 @@ package defines
 
 // Header information.
 const (
-	OKHeader          byte = 0x00
-	ErrHeader         byte = 0xff
-	EOFHeader         byte = 0xfe
-	LocalInFileHeader byte = 0xfb
+	OKHeader           byte = 0x00
+	ErrHeader          byte = 0xff
+	EOFHeader          byte = 0xfe
+	LocalInFileHeader  byte = 0xfb
+	AuthMoreDataHeader byte = 0x01
 )
 
 const (
@@ -32,6 +32,8 @@ var (
 	defaultServiceAddress        = "127.0.0.1:22000"
 	defaultLogtailListenAddress  = "0.0.0.0:22001"
 	defaultLogtailServiceAddress = "127.0.0.1:22001"
+	defaultLockListenAddress     = "0.0.0.0:22002"
+	defaultLockServiceAddress    = "127.0.0.1:22002"
 	defaultZombieTimeout         = time.Hour
 	defaultDiscoveryTimeout      = time.Second * 30
 	defaultHeatbeatInterval      = time.Second
@@ -107,6 +109,18 @@ type Config struct {
 		MaxLogtailFetchFailure     int           `toml:"max-logtail-fetch-failure"`
 	}
 
+	// LockService the lock server used by the lockservices of all CNs
+	LockService struct {
+		// ListenAddress listening address for receiving the lock requests
+		ListenAddress string `toml:"listen-address"`
+		// ServiceAddress service address for the lockservices, if this address is not set,
+		// use ListenAddress as the service address.
+		ServiceAddress string `toml:"service-address"`
+		// KeepaliveTimeout the locks of a lockservice are released if no keepalive is
+		// received from it for the timeout. Default is 10s
+		KeepaliveTimeout toml.Duration `toml:"keepalive-timeout"`
+	}
+
 	// Txn transactions configuration
 	Txn struct {
 		// ZombieTimeout A transaction timeout, if an active transaction has not operated for more
@@ -208,6 +222,13 @@ func (c *Config) Validate() error {
 	if c.LogtailServer.MaxLogtailFetchFailure <= 0 {
 		c.LogtailServer.MaxLogtailFetchFailure = defaultMaxLogtailFetchFailure
 	}
+	if c.LockService.ListenAddress == "" {
+		c.LockService.ListenAddress = defaultLockListenAddress
+		c.LockService.ServiceAddress = defaultLockServiceAddress
+	}
+	if c.LockService.ServiceAddress == "" {
+		c.LockService.ServiceAddress = c.LockService.ListenAddress
+	}
 	if c.Cluster.RefreshInterval.Duration == 0 {
 		c.Cluster.RefreshInterval.Duration = time.Second * 10
 	}
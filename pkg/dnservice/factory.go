@@ -16,9 +16,11 @@ package dnservice
 
 import (
 	"context"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
@@ -134,6 +136,10 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		IncrementalInterval: s.cfg.Ckp.IncrementalInterval.Duration,
 		GlobalMinCount:      s.cfg.Ckp.GlobalMinCount,
 	}
+	gcCfg := &options.GCCfg{}
+	if v, ok := s.rt.GetGlobalVariables(runtime.SnapshotRetention); ok {
+		gcCfg.GCTTL = v.(time.Duration)
+	}
 	logtailServerAddr := s.cfg.LogtailServer.ListenAddress
 	logtailServerCfg := &options.LogtailServerCfg{
 		RpcMaxMessageSize:        int64(s.cfg.LogtailServer.RpcMaxMessageSize),
@@ -157,6 +163,7 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		fs,
 		s.rt,
 		ckpcfg,
+		gcCfg,
 		logtailServerAddr,
 		logtailServerCfg,
 		options.LogstoreType(s.cfg.Txn.Storage.LogBackend))
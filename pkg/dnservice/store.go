@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
@@ -82,6 +83,7 @@ type store struct {
 	rt                  runtime.Runtime
 	sender              rpc.TxnSender
 	server              rpc.TxnServer
+	lockServer          lockservice.LockServer
 	hakeeperClient      logservice.DNHAKeeperClient
 	fileService         fileservice.FileService
 	metadataFileService fileservice.ReplaceableFileService
@@ -156,6 +158,9 @@ func NewService(cfg *Config,
 	if err := s.initTxnServer(); err != nil {
 		return nil, err
 	}
+	if err := s.initLockServer(); err != nil {
+		return nil, err
+	}
 	if err := s.initMetadata(); err != nil {
 		return nil, err
 	}
@@ -170,6 +175,9 @@ func (s *store) Start() error {
 	if err := s.server.Start(); err != nil {
 		return err
 	}
+	if err := s.lockServer.Start(); err != nil {
+		return err
+	}
 	s.rt.SubLogger(runtime.SystemInit).Info("dn heartbeat task started")
 	return s.stopper.RunTask(s.heartbeatTask)
 }
@@ -187,6 +195,9 @@ func (s *store) Close() error {
 	if e := s.server.Close(); e != nil {
 		err = multierr.Append(e, err)
 	}
+	if e := s.lockServer.Close(); e != nil {
+		err = multierr.Append(e, err)
+	}
 	s.replicas.Range(func(_, value any) bool {
 		r := value.(*replica)
 		if e := r.close(false); e != nil {
@@ -329,6 +340,19 @@ func (s *store) initTxnServer() error {
 	return nil
 }
 
+// initLockServer creates the lock server, the lockservices of all CNs lock the rows
+// in the lock tables of it.
+func (s *store) initLockServer() error {
+	server, err := lockservice.NewLockServer(
+		s.cfg.LockService.ListenAddress,
+		s.cfg.LockService.KeepaliveTimeout.Duration)
+	if err != nil {
+		return err
+	}
+	s.lockServer = server
+	return nil
+}
+
 func (s *store) initClocker() error {
 	if s.rt.Clock() == nil {
 		return moerr.NewBadConfigNoCtx("missing txn clock")
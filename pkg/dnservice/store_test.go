@@ -39,6 +39,7 @@ import (
 var (
 	testDNStoreAddr      = "unix:///tmp/test-dnstore.sock"
 	testDNLogtailAddress = "127.0.0.1:22001"
+	testDNLockAddress    = "unix:///tmp/test-dn-lock.sock"
 )
 
 func TestNewAndStartAndCloseService(t *testing.T) {
@@ -236,11 +237,13 @@ func newTestStore(
 	fsFactory fileservice.NewFileServicesFunc,
 	options ...Option) *store {
 	assert.NoError(t, os.RemoveAll(testDNStoreAddr[7:]))
+	assert.NoError(t, os.RemoveAll(testDNLockAddress[7:]))
 	c := &Config{
 		UUID:          uuid,
 		ListenAddress: testDNStoreAddr,
 	}
 	c.LogtailServer.ListenAddress = testDNLogtailAddress
+	c.LockService.ListenAddress = testDNLockAddress
 	fs, err := fsFactory(defines.LocalFileServiceName)
 	assert.Nil(t, err)
 
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
 )
 
 const (
@@ -41,6 +42,19 @@ type Config struct {
 	Cache CacheConfig `toml:"cache"`
 	// DataDir used to create fileservice using DISK as the backend
 	DataDir string `toml:"data-dir"`
+	// Encryption specifies configs for encryption at rest
+	Encryption EncryptionConfig `toml:"encryption"`
+}
+
+// EncryptionConfig encryption config
+type EncryptionConfig struct {
+	// Enable encrypts file contents before storing to the backend
+	Enable bool `toml:"enable"`
+	// KeyFile path of the local key file, see LocalKeyProvider for the format
+	KeyFile string `toml:"key-file"`
+	// BlockSize plaintext size of each encrypted block, default is 64KB.
+	// The sizes of the files listed are computed with it, so it should not be changed once files are written.
+	BlockSize toml.ByteSize `toml:"block-size"`
 }
 
 // NewFileServicesFunc creates a new *FileServices
@@ -48,6 +62,17 @@ type NewFileServicesFunc = func(defaultName string) (*FileServices, error)
 
 // NewFileService create file service from config
 func NewFileService(cfg Config, perfCounters []*perfcounter.Counter) (FileService, error) {
+	fs, err := newFileService(cfg, perfCounters)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Encryption.Enable {
+		return newEncryptedFileService(cfg, fs)
+	}
+	return fs, nil
+}
+
+func newFileService(cfg Config, perfCounters []*perfcounter.Counter) (FileService, error) {
 	switch strings.ToUpper(cfg.Backend) {
 	case memFileServiceBackend:
 		return newMemFileService(cfg, perfCounters)
@@ -64,6 +89,25 @@ func NewFileService(cfg Config, perfCounters []*perfcounter.Counter) (FileServic
 	}
 }
 
+func newEncryptedFileService(cfg Config, upstream FileService) (FileService, error) {
+	if strings.ToUpper(cfg.Backend) == diskETLFileServiceBackend {
+		// ETL files are read by external tools and must be stored as is
+		return nil, moerr.NewInternalErrorNoCtx("encryption is not supported by %s backend", cfg.Backend)
+	}
+	if cfg.Encryption.KeyFile == "" {
+		return nil, moerr.NewInternalErrorNoCtx("missing key file for encrypted file service %s", cfg.Name)
+	}
+	keyProvider, err := NewLocalKeyProvider(cfg.Encryption.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	fs, err := NewEncryptedFS(upstream, keyProvider, int(cfg.Encryption.BlockSize))
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
 func newMemFileService(cfg Config, _ []*perfcounter.Counter) (FileService, error) {
 	fs, err := NewMemoryFS(cfg.Name)
 	if err != nil {
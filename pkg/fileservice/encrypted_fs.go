@@ -0,0 +1,610 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"path"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/memcachepolicy/lrupolicy"
+)
+
+// EncryptedFS is a FileService wrapper that encrypts file contents at rest
+//
+// file layout:
+//
+//	header | block 0 | block 1 | ... | block n
+//
+// the plaintext is split into fixed size blocks, each block is sealed with AES-GCM independently,
+// so reading a range only needs to fetch and decrypt the blocks covering it.
+// the last block is always shorter than the block size (possibly empty) and is authenticated as the last one,
+// so truncated files are detected.
+// every file has a random salt in the header, the file key is derived from the master key and the salt.
+type EncryptedFS struct {
+	fs          FileService
+	keyProvider KeyProvider
+	blockSize   int
+	// files caches the opened files by path, so reading a file does not fetch the header again
+	files *lrupolicy.LRU
+}
+
+const (
+	_EncryptionHeaderSize     = 64
+	_EncryptionVersion        = 1
+	_EncryptionSaltSize       = 16
+	_EncryptionNonceSize      = 12
+	_EncryptionTagSize        = 16
+	_DefaultEncryptBlockSize  = 64 * 1024
+	_MaxEncryptBlockSize      = 64 * 1024 * 1024
+	_EncryptionKeyDerivation  = "matrixone fileservice encryption"
+	_EncryptionHeaderMagicLen = 4
+	// number of the opened files cached
+	_EncryptionFileCacheSize = 65536
+)
+
+var encryptionHeaderMagic = [_EncryptionHeaderMagicLen]byte{'M', 'O', 'E', 'F'}
+
+var _ FileService = new(EncryptedFS)
+
+func NewEncryptedFS(
+	fs FileService,
+	keyProvider KeyProvider,
+	blockSize int,
+) (*EncryptedFS, error) {
+	if blockSize <= 0 {
+		blockSize = _DefaultEncryptBlockSize
+	}
+	if blockSize > _MaxEncryptBlockSize {
+		return nil, moerr.NewInternalErrorNoCtx("encryption block size %d too large", blockSize)
+	}
+	return &EncryptedFS{
+		fs:          fs,
+		keyProvider: keyProvider,
+		blockSize:   blockSize,
+		files:       lrupolicy.New(_EncryptionFileCacheSize),
+	}, nil
+}
+
+func (e *EncryptedFS) Name() string {
+	return e.fs.Name()
+}
+
+func (e *EncryptedFS) Write(ctx context.Context, vector IOVector) error {
+	return e.write(ctx, vector, e.fs.Write)
+}
+
+func (e *EncryptedFS) write(
+	ctx context.Context,
+	vector IOVector,
+	fn func(context.Context, IOVector) error,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	keyID, key, err := e.keyProvider.CurrentKey(ctx)
+	if err != nil {
+		return err
+	}
+	header, err := newEncryptionHeader(keyID, e.blockSize)
+	if err != nil {
+		return err
+	}
+	aead, err := header.newAEAD(key)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(vector.Entries, func(i, j int) bool {
+		return vector.Entries[i].Offset < vector.Entries[j].Offset
+	})
+
+	return fn(ctx, IOVector{
+		FilePath: vector.FilePath,
+		Entries: []IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+				ReaderForWrite: &encryptingReader{
+					plaintext: newIOEntriesReader(ctx, vector.Entries),
+					header:    header,
+					aead:      aead,
+					block:     make([]byte, header.blockSize),
+					buf:       header.bytes[:],
+				},
+			},
+		},
+		ExpireAt: vector.ExpireAt,
+	})
+}
+
+func (e *EncryptedFS) Read(ctx context.Context, vector *IOVector) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	path, err := ParsePathAtService(vector.FilePath, e.fs.Name())
+	if err != nil {
+		return err
+	}
+
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+
+	file, err := e.open(ctx, vector.FilePath, nil)
+	if err != nil {
+		return err
+	}
+
+	// resolve ranges and collect blocks to read
+	indexes := make([]int, 0, len(vector.Entries))
+	reads := make([]IOEntry, 0, len(vector.Entries))
+	for i, entry := range vector.Entries {
+		if entry.done {
+			continue
+		}
+		if entry.Size == 0 {
+			return moerr.NewEmptyRangeNoCtx(path.File)
+		}
+		if entry.Size < 0 {
+			entry.Size = file.size - entry.Offset
+		}
+		if entry.Offset < 0 || entry.Size < 0 || entry.Offset+entry.Size > file.size {
+			return moerr.NewUnexpectedEOFNoCtx(path.File)
+		}
+		vector.Entries[i].Size = entry.Size
+		if entry.Size == 0 {
+			// reading from the end of file
+			continue
+		}
+		first, last := file.blockRange(entry.Offset, entry.Size)
+		begin, _ := file.physicalRange(first)
+		_, end := file.physicalRange(last)
+		indexes = append(indexes, i)
+		reads = append(reads, IOEntry{
+			Offset: begin,
+			Size:   end - begin,
+		})
+	}
+
+	if len(reads) > 0 {
+		if err := e.fs.Read(ctx, &IOVector{
+			FilePath: vector.FilePath,
+			Entries:  reads,
+		}); err != nil {
+			return err
+		}
+	}
+
+	decrypted := make(map[int][]byte, len(indexes))
+	for n, i := range indexes {
+		entry := vector.Entries[i]
+		first, _ := file.blockRange(entry.Offset, entry.Size)
+		plaintext, err := file.decrypt(path.File, first, reads[n].Data)
+		if err != nil {
+			return err
+		}
+		start := entry.Offset - int64(first)*int64(file.header.blockSize)
+		decrypted[i] = plaintext[start : start+entry.Size]
+	}
+
+	for i, entry := range vector.Entries {
+		if entry.done {
+			continue
+		}
+		data := decrypted[i]
+
+		setData := true
+
+		if w := vector.Entries[i].WriterForRead; w != nil {
+			setData = false
+			_, err := w.Write(data)
+			if err != nil {
+				return err
+			}
+		}
+
+		if ptr := vector.Entries[i].ReadCloserForRead; ptr != nil {
+			setData = false
+			*ptr = io.NopCloser(bytes.NewReader(data))
+		}
+
+		if setData {
+			if int64(len(entry.Data)) < entry.Size {
+				entry.Data = data
+			} else {
+				copy(entry.Data, data)
+			}
+		}
+
+		if err := entry.setObjectFromData(); err != nil {
+			return err
+		}
+
+		vector.Entries[i] = entry
+	}
+
+	return nil
+}
+
+func (e *EncryptedFS) List(ctx context.Context, dirPath string) ([]DirEntry, error) {
+	entries, err := e.fs.List(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range entries {
+		if entry.IsDir {
+			continue
+		}
+		// the size is computed from the physical size without reading the header,
+		// the file is written with the block size of the fs, or the block size in
+		// its header if the file has been opened.
+		filePath := path.Join(dirPath, entry.Name)
+		blockSize := e.blockSize
+		if v, _, ok := e.files.Get(e.fileKey(filePath)); ok {
+			blockSize = v.(*encryptedFile).header.blockSize
+		}
+		size, ok := encryptedPlaintextSize(entry.Size, blockSize)
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtx("encrypted file %s corrupted", filePath)
+		}
+		entries[i].Size = size
+	}
+	return entries, nil
+}
+
+func (e *EncryptedFS) Delete(ctx context.Context, filePaths ...string) error {
+	for _, filePath := range filePaths {
+		e.files.Delete(e.fileKey(filePath))
+	}
+	return e.fs.Delete(ctx, filePaths...)
+}
+
+func (e *EncryptedFS) StatFile(ctx context.Context, filePath string) (*DirEntry, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	file, err := e.open(ctx, filePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DirEntry{
+		Name:  file.entry.Name,
+		IsDir: false,
+		Size:  file.size,
+	}, nil
+}
+
+var _ ReplaceableFileService = new(EncryptedFS)
+
+func (e *EncryptedFS) Replace(ctx context.Context, vector IOVector) error {
+	fs, ok := e.fs.(ReplaceableFileService)
+	if !ok {
+		return moerr.NewNotSupportedNoCtx("replacing files in %s", e.fs.Name())
+	}
+	defer e.files.Delete(e.fileKey(vector.FilePath))
+	return e.write(ctx, vector, fs.Replace)
+}
+
+var _ CachingFileService = new(EncryptedFS)
+
+func (e *EncryptedFS) FlushCache() {
+	if fs, ok := e.fs.(CachingFileService); ok {
+		fs.FlushCache()
+	}
+}
+
+func (e *EncryptedFS) SetAsyncUpdate(b bool) {
+	if fs, ok := e.fs.(CachingFileService); ok {
+		fs.SetAsyncUpdate(b)
+	}
+}
+
+// open reads the header of an encrypted file and prepares for decryption,
+// the entry of the file is fetched if it is nil. Files are never modified,
+// so the opened files are cached until they are replaced or deleted.
+func (e *EncryptedFS) open(ctx context.Context, filePath string, entry *DirEntry) (*encryptedFile, error) {
+	cacheKey := e.fileKey(filePath)
+	if v, _, ok := e.files.Get(cacheKey); ok {
+		return v.(*encryptedFile), nil
+	}
+
+	if entry == nil {
+		var err error
+		entry, err = e.fs.StatFile(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vec := &IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Offset: 0,
+				Size:   _EncryptionHeaderSize,
+			},
+		},
+	}
+	if entry.Size < _EncryptionHeaderSize {
+		return nil, moerr.NewInternalErrorNoCtx("encrypted file %s corrupted", filePath)
+	}
+	if err := e.fs.Read(ctx, vec); err != nil {
+		return nil, err
+	}
+
+	header, err := parseEncryptionHeader(vec.Entries[0].Data)
+	if err != nil {
+		return nil, moerr.NewInternalErrorNoCtx("encrypted file %s corrupted: %v", filePath, err)
+	}
+	size, ok := encryptedPlaintextSize(entry.Size, header.blockSize)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("encrypted file %s corrupted", filePath)
+	}
+
+	key, err := e.keyProvider.Key(ctx, header.keyID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := header.newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &encryptedFile{
+		entry:  entry,
+		header: header,
+		aead:   aead,
+		size:   size,
+	}
+	e.files.Set(cacheKey, file, 1)
+	return file, nil
+}
+
+// fileKey returns the key of the file in the cache, the paths with and
+// without the service name are the same file.
+func (e *EncryptedFS) fileKey(filePath string) string {
+	p, err := ParsePathAtService(filePath, e.fs.Name())
+	if err != nil {
+		return filePath
+	}
+	return p.File
+}
+
+type encryptedFile struct {
+	entry  *DirEntry
+	header *encryptionHeader
+	aead   cipher.AEAD
+	// plaintext size
+	size int64
+}
+
+// blockRange returns the first and last block covering the plaintext range
+func (f *encryptedFile) blockRange(offset int64, size int64) (first int, last int) {
+	blockSize := int64(f.header.blockSize)
+	return int(offset / blockSize), int((offset + size - 1) / blockSize)
+}
+
+func (f *encryptedFile) lastBlock() int {
+	return int(f.size / int64(f.header.blockSize))
+}
+
+// physicalRange returns the ciphertext range of the block
+func (f *encryptedFile) physicalRange(block int) (begin int64, end int64) {
+	blockSize := int64(f.header.blockSize)
+	begin = _EncryptionHeaderSize + int64(block)*(blockSize+_EncryptionTagSize)
+	plaintextSize := blockSize
+	if block == f.lastBlock() {
+		plaintextSize = f.size % blockSize
+	}
+	return begin, begin + plaintextSize + _EncryptionTagSize
+}
+
+// decrypt decrypts consecutive blocks starting from the first block
+func (f *encryptedFile) decrypt(filePath string, first int, ciphertext []byte) ([]byte, error) {
+	blockSize := f.header.blockSize + _EncryptionTagSize
+	plaintext := make([]byte, 0, len(ciphertext))
+	for block := first; len(ciphertext) > 0; block++ {
+		l := blockSize
+		if l > len(ciphertext) {
+			l = len(ciphertext)
+		}
+		var err error
+		plaintext, err = f.aead.Open(
+			plaintext,
+			f.header.nonce(block),
+			ciphertext[:l],
+			f.header.additionalData(block == f.lastBlock()),
+		)
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtx("decrypt block %d of file %s failed", block, filePath)
+		}
+		ciphertext = ciphertext[l:]
+	}
+	return plaintext, nil
+}
+
+// encryptedPlaintextSize returns the plaintext size of an encrypted file of the physical size
+func encryptedPlaintextSize(physicalSize int64, blockSize int) (int64, bool) {
+	// every file has a header and at least one (possibly empty) block
+	n := physicalSize - _EncryptionHeaderSize - _EncryptionTagSize
+	if n < 0 {
+		return 0, false
+	}
+	fullBlocks := n / int64(blockSize+_EncryptionTagSize)
+	lastBlockSize := n % int64(blockSize+_EncryptionTagSize)
+	if lastBlockSize >= int64(blockSize) {
+		return 0, false
+	}
+	return fullBlocks*int64(blockSize) + lastBlockSize, true
+}
+
+// encryptionHeader layout:
+//
+//	magic(4) | version(1) | key id length(1) | block size(4) | salt(16) | key id | padding
+type encryptionHeader struct {
+	bytes     [_EncryptionHeaderSize]byte
+	keyID     string
+	blockSize int
+	salt      []byte
+}
+
+func newEncryptionHeader(keyID string, blockSize int) (*encryptionHeader, error) {
+	if len(keyID) == 0 || len(keyID) > maxEncryptionKeyIDLength {
+		return nil, moerr.NewInternalErrorNoCtx("invalid encryption key id %s", keyID)
+	}
+	h := &encryptionHeader{
+		keyID:     keyID,
+		blockSize: blockSize,
+	}
+	copy(h.bytes[:], encryptionHeaderMagic[:])
+	h.bytes[4] = _EncryptionVersion
+	h.bytes[5] = byte(len(keyID))
+	binary.LittleEndian.PutUint32(h.bytes[6:], uint32(blockSize))
+	h.salt = h.bytes[10 : 10+_EncryptionSaltSize]
+	if _, err := io.ReadFull(crand.Reader, h.salt); err != nil {
+		return nil, err
+	}
+	copy(h.bytes[10+_EncryptionSaltSize:], keyID)
+	return h, nil
+}
+
+func parseEncryptionHeader(data []byte) (*encryptionHeader, error) {
+	h := new(encryptionHeader)
+	if len(data) != len(h.bytes) {
+		return nil, moerr.NewInternalErrorNoCtx("invalid header size %d", len(data))
+	}
+	copy(h.bytes[:], data)
+	if !bytes.Equal(h.bytes[:_EncryptionHeaderMagicLen], encryptionHeaderMagic[:]) {
+		return nil, moerr.NewInternalErrorNoCtx("bad magic")
+	}
+	if h.bytes[4] != _EncryptionVersion {
+		return nil, moerr.NewInternalErrorNoCtx("unknown version %d", h.bytes[4])
+	}
+	keyIDLength := int(h.bytes[5])
+	if keyIDLength == 0 || keyIDLength > maxEncryptionKeyIDLength {
+		return nil, moerr.NewInternalErrorNoCtx("invalid key id length %d", keyIDLength)
+	}
+	h.blockSize = int(binary.LittleEndian.Uint32(h.bytes[6:]))
+	if h.blockSize <= 0 || h.blockSize > _MaxEncryptBlockSize {
+		return nil, moerr.NewInternalErrorNoCtx("invalid block size %d", h.blockSize)
+	}
+	h.salt = h.bytes[10 : 10+_EncryptionSaltSize]
+	h.keyID = string(h.bytes[10+_EncryptionSaltSize : 10+_EncryptionSaltSize+keyIDLength])
+	return h, nil
+}
+
+// newAEAD derives the file key from the master key and the salt
+func (h *encryptionHeader) newAEAD(masterKey []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, masterKey)
+	mac.Write([]byte(_EncryptionKeyDerivation))
+	mac.Write(h.salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// nonce returns the nonce of the block
+// nonces are unique since every file has its own key and files are never modified
+func (h *encryptionHeader) nonce(block int) []byte {
+	nonce := make([]byte, _EncryptionNonceSize)
+	binary.BigEndian.PutUint64(nonce[_EncryptionNonceSize-8:], uint64(block))
+	return nonce
+}
+
+// additionalData binds blocks to the header and marks the last block
+func (h *encryptionHeader) additionalData(last bool) []byte {
+	ad := make([]byte, 0, len(h.bytes)+1)
+	ad = append(ad, h.bytes[:]...)
+	if last {
+		return append(ad, 1)
+	}
+	return append(ad, 0)
+}
+
+// encryptingReader reads plaintext and produces the encrypted file content
+type encryptingReader struct {
+	plaintext io.Reader
+	header    *encryptionHeader
+	aead      cipher.AEAD
+	block     []byte
+	numBlocks int
+	sealed    []byte
+	// pending output
+	buf      []byte
+	finished bool
+}
+
+var _ io.Reader = new(encryptingReader)
+
+func (r *encryptingReader) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		if len(r.buf) == 0 {
+			if r.finished {
+				if n == 0 {
+					return 0, io.EOF
+				}
+				return n, nil
+			}
+			if err := r.sealNextBlock(); err != nil {
+				return n, err
+			}
+		}
+		copied := copy(p[n:], r.buf)
+		r.buf = r.buf[copied:]
+		n += copied
+	}
+	return n, nil
+}
+
+func (r *encryptingReader) sealNextBlock() error {
+	n, err := io.ReadFull(r.plaintext, r.block)
+	last := false
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		last = true
+	} else if err != nil {
+		return err
+	}
+	r.sealed = r.aead.Seal(
+		r.sealed[:0],
+		r.header.nonce(r.numBlocks),
+		r.block[:n],
+		r.header.additionalData(last),
+	)
+	r.buf = r.sealed
+	r.numBlocks++
+	r.finished = last
+	return nil
+}
@@ -0,0 +1,423 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	mrand "math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKeyProvider(t testing.TB, content string) KeyProvider {
+	provider, err := newLocalKeyProvider(strings.NewReader(content))
+	assert.Nil(t, err)
+	return provider
+}
+
+const testKeyFile = `
+# test keys
+k1:000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f
+`
+
+func TestEncryptedFS(t *testing.T) {
+
+	t.Run("file service on memory fs", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			upstream, err := NewMemoryFS(name)
+			assert.Nil(t, err)
+			fs, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 7)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+
+	t.Run("file service on local fs", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			upstream, err := NewLocalFS(name, t.TempDir(), -1, nil)
+			assert.Nil(t, err)
+			fs, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 0)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+
+	t.Run("replaceable file service", func(t *testing.T) {
+		testReplaceableFileService(t, func() ReplaceableFileService {
+			upstream, err := NewMemoryFS("memory")
+			assert.Nil(t, err)
+			fs, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 0)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+
+}
+
+func TestEncryptedFSRandomAccess(t *testing.T) {
+	ctx := context.Background()
+	upstream, err := NewMemoryFS("memory")
+	assert.Nil(t, err)
+	fs, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 1024)
+	assert.Nil(t, err)
+
+	for _, size := range []int{0, 1, 1023, 1024, 1025, 4096, 10000} {
+		content := make([]byte, size)
+		_, err := rand.Read(content)
+		assert.Nil(t, err)
+		err = fs.Write(ctx, IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: int64(size),
+					Data: content,
+				},
+			},
+		})
+		assert.Nil(t, err)
+
+		// stored content is not plaintext
+		vec := &IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		}
+		err = upstream.Read(ctx, vec)
+		assert.Nil(t, err)
+		if size > 16 {
+			assert.False(t, bytes.Contains(vec.Entries[0].Data, content))
+		}
+
+		entry, err := fs.StatFile(ctx, "foo")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(size), entry.Size)
+		entries, err := fs.List(ctx, "")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(entries))
+		assert.Equal(t, int64(size), entries[0].Size)
+
+		for i := 0; i < 100 && size > 0; i++ {
+			offset := mrand.Intn(size)
+			length := mrand.Intn(size-offset) + 1
+			vec := &IOVector{
+				FilePath: "foo",
+				Entries: []IOEntry{
+					{
+						Offset: int64(offset),
+						Size:   int64(length),
+					},
+				},
+			}
+			err = fs.Read(ctx, vec)
+			assert.Nil(t, err)
+			assert.Equal(t, content[offset:offset+length], vec.Entries[0].Data)
+		}
+
+		err = fs.Delete(ctx, "foo")
+		assert.Nil(t, err)
+	}
+}
+
+func TestEncryptedFSTamper(t *testing.T) {
+	ctx := context.Background()
+
+	write := func(t *testing.T) (*MemoryFS, *EncryptedFS, []byte) {
+		upstream, err := NewMemoryFS("memory")
+		assert.Nil(t, err)
+		fs, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 16)
+		assert.Nil(t, err)
+		content := bytes.Repeat([]byte("0123456789abcdef"), 4)
+		err = fs.Write(ctx, IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: int64(len(content)),
+					Data: content,
+				},
+			},
+		})
+		assert.Nil(t, err)
+		vec := &IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		}
+		err = upstream.Read(ctx, vec)
+		assert.Nil(t, err)
+		return upstream, fs, vec.Entries[0].Data
+	}
+
+	replace := func(t *testing.T, upstream *MemoryFS, data []byte) {
+		err := upstream.Replace(ctx, IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: int64(len(data)),
+					Data: data,
+				},
+			},
+		})
+		assert.Nil(t, err)
+	}
+
+	readAll := func(fs FileService) error {
+		return fs.Read(ctx, &IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		})
+	}
+
+	t.Run("flip", func(t *testing.T) {
+		upstream, fs, data := write(t)
+		data[len(data)/2] ^= 1
+		replace(t, upstream, data)
+		assert.NotNil(t, readAll(fs))
+	})
+
+	t.Run("header", func(t *testing.T) {
+		upstream, fs, data := write(t)
+		data[_EncryptionHeaderSize-1] ^= 1
+		replace(t, upstream, data)
+		assert.NotNil(t, readAll(fs))
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		for _, l := range []int{
+			_EncryptionHeaderSize + 16 + _EncryptionTagSize,
+			_EncryptionHeaderSize + 2*(16+_EncryptionTagSize),
+			_EncryptionHeaderSize + 2*(16+_EncryptionTagSize) + 5,
+		} {
+			upstream, fs, data := write(t)
+			replace(t, upstream, data[:l])
+			assert.NotNil(t, readAll(fs))
+		}
+	})
+
+	t.Run("plaintext", func(t *testing.T) {
+		upstream, fs, _ := write(t)
+		replace(t, upstream, bytes.Repeat([]byte("a"), 200))
+		assert.NotNil(t, readAll(fs))
+	})
+}
+
+func TestEncryptedFSListBlockSize(t *testing.T) {
+	ctx := context.Background()
+	upstream, err := NewMemoryFS("memory")
+	assert.Nil(t, err)
+	writer, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 16)
+	assert.Nil(t, err)
+	content := bytes.Repeat([]byte("a"), 100)
+	err = writer.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{
+				Size: int64(len(content)),
+				Data: content,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// the file is listed without reading its header
+	fs, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 16)
+	assert.Nil(t, err)
+	entries, err := fs.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, int64(len(content)), entries[0].Size)
+	_, _, ok := fs.files.Get(fs.fileKey("foo"))
+	assert.False(t, ok)
+
+	// the opened file is listed with the block size in its header
+	fs, err = NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 1024)
+	assert.Nil(t, err)
+	entry, err := fs.StatFile(ctx, "foo")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), entry.Size)
+	entries, err = fs.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, int64(len(content)), entries[0].Size)
+
+	// the cached file is dropped when the file is deleted
+	err = fs.Delete(ctx, "foo")
+	assert.Nil(t, err)
+	_, err = fs.StatFile(ctx, "foo")
+	assert.NotNil(t, err)
+
+	// the files not written by EncryptedFS are reported
+	err = upstream.Write(ctx, IOVector{
+		FilePath: "bar",
+		Entries: []IOEntry{
+			{
+				Size: 10,
+				Data: bytes.Repeat([]byte("b"), 10),
+			},
+		},
+	})
+	assert.Nil(t, err)
+	_, err = fs.List(ctx, "")
+	assert.NotNil(t, err)
+}
+
+func TestEncryptedFSKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	upstream, err := NewMemoryFS("memory")
+	assert.Nil(t, err)
+
+	fs, err := NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile), 0)
+	assert.Nil(t, err)
+	err = fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{
+				Size: 3,
+				Data: []byte("foo"),
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// new key appended
+	fs, err = NewEncryptedFS(upstream, newTestKeyProvider(t, testKeyFile+`
+k2:0f0e0d0c0b0a09080706050403020100
+`), 0)
+	assert.Nil(t, err)
+	err = fs.Write(ctx, IOVector{
+		FilePath: "bar",
+		Entries: []IOEntry{
+			{
+				Size: 3,
+				Data: []byte("bar"),
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	for _, name := range []string{"foo", "bar"} {
+		var r io.ReadCloser
+		vec := &IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{
+					Size:              -1,
+					ReadCloserForRead: &r,
+				},
+			},
+		}
+		err = fs.Read(ctx, vec)
+		assert.Nil(t, err)
+		data, err := io.ReadAll(r)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(name), data)
+	}
+
+	// old key removed
+	fs, err = NewEncryptedFS(upstream, newTestKeyProvider(t, `k2:0f0e0d0c0b0a09080706050403020100`), 0)
+	assert.Nil(t, err)
+	err = fs.Read(ctx, &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{
+				Size: -1,
+			},
+		},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestNewEncryptedFileService(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "keys")
+	err := os.WriteFile(keyFile, []byte(testKeyFile), 0600)
+	assert.Nil(t, err)
+
+	fs, err := NewFileService(Config{
+		Name:    "memory",
+		Backend: memFileServiceBackend,
+		Encryption: EncryptionConfig{
+			Enable:  true,
+			KeyFile: keyFile,
+		},
+	}, nil)
+	assert.Nil(t, err)
+	_, ok := fs.(*EncryptedFS)
+	assert.True(t, ok)
+
+	_, err = NewFileService(Config{
+		Name:    "memory",
+		Backend: memFileServiceBackend,
+		Encryption: EncryptionConfig{
+			Enable: true,
+		},
+	}, nil)
+	assert.NotNil(t, err)
+
+	_, err = NewFileService(Config{
+		Name:    "etl",
+		Backend: diskETLFileServiceBackend,
+		DataDir: t.TempDir(),
+		Encryption: EncryptionConfig{
+			Enable:  true,
+			KeyFile: keyFile,
+		},
+	}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestLocalKeyProvider(t *testing.T) {
+	ctx := context.Background()
+
+	provider := newTestKeyProvider(t, testKeyFile+"k2:0f0e0d0c0b0a09080706050403020100\n")
+	id, key, err := provider.CurrentKey(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", id)
+	assert.Equal(t, 16, len(key))
+	key, err = provider.Key(ctx, "k1")
+	assert.Nil(t, err)
+	assert.Equal(t, 32, len(key))
+	_, err = provider.Key(ctx, "k3")
+	assert.NotNil(t, err)
+
+	for _, content := range []string{
+		"",
+		"# empty",
+		"k1",
+		":00",
+		"k1:zz",
+		"k1:0001",
+		"k1:000102030405060708090a0b0c0d0e0f\nk1:000102030405060708090a0b0c0d0e0f",
+		strings.Repeat("k", 33) + ":000102030405060708090a0b0c0d0e0f",
+	} {
+		_, err := newLocalKeyProvider(strings.NewReader(content))
+		assert.NotNil(t, err, content)
+	}
+}
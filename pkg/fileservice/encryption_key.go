@@ -0,0 +1,117 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const maxEncryptionKeyIDLength = 32
+
+// KeyProvider provides master keys for EncryptedFS
+type KeyProvider interface {
+	// CurrentKey returns the key to encrypt new files with, and its id
+	// the id is stored in file headers and must be resolvable by Key until no file references it
+	CurrentKey(ctx context.Context) (id string, key []byte, err error)
+	// Key returns the key of the specified id
+	Key(ctx context.Context, id string) ([]byte, error)
+}
+
+// LocalKeyProvider is a KeyProvider backed by a local key file
+// each non-empty line of the file is <key id>:<hex encoded key>, lines starting with # are ignored
+// the key on the last line is the current key, previous keys are kept for decrypting existing files
+type LocalKeyProvider struct {
+	keys      map[string][]byte
+	currentID string
+}
+
+var _ KeyProvider = new(LocalKeyProvider)
+
+func NewLocalKeyProvider(path string) (*LocalKeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return newLocalKeyProvider(bytes.NewReader(content))
+}
+
+func newLocalKeyProvider(r io.Reader) (*LocalKeyProvider, error) {
+	provider := &LocalKeyProvider{
+		keys: make(map[string][]byte),
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		id, hexKey, ok := strings.Cut(line, ":")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" {
+			return nil, moerr.NewInternalErrorNoCtx("invalid key file line %d", lineNumber)
+		}
+		if len(id) > maxEncryptionKeyIDLength {
+			return nil, moerr.NewInternalErrorNoCtx("key id %s too long, max length is %d", id, maxEncryptionKeyIDLength)
+		}
+		key, err := hex.DecodeString(strings.TrimSpace(hexKey))
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtx("invalid key of id %s: %v", id, err)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, moerr.NewInternalErrorNoCtx("invalid key length of id %s: %d", id, len(key))
+		}
+		if _, ok := provider.keys[id]; ok {
+			return nil, moerr.NewInternalErrorNoCtx("duplicated key id %s", id)
+		}
+
+		provider.keys[id] = key
+		provider.currentID = id
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if provider.currentID == "" {
+		return nil, moerr.NewInternalErrorNoCtx("no key in key file")
+	}
+
+	return provider, nil
+}
+
+func (l *LocalKeyProvider) CurrentKey(_ context.Context) (string, []byte, error) {
+	return l.currentID, l.keys[l.currentID], nil
+}
+
+func (l *LocalKeyProvider) Key(_ context.Context, id string) ([]byte, error) {
+	key, ok := l.keys[id]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("encryption key %s not found", id)
+	}
+	return key, nil
+}
@@ -123,6 +123,17 @@ func (l *LRU) Get(key any) (value any, size int64, ok bool) {
 	return nil, 0, false
 }
 
+func (l *LRU) Delete(key any) {
+	l.Lock()
+	defer l.Unlock()
+	if elem, ok := l.kv[key]; ok {
+		item := elem.Value.(*lruItem)
+		l.size -= item.Size
+		l.evicts.Remove(elem)
+		delete(l.kv, key)
+	}
+}
+
 func (l *LRU) Flush() {
 	l.Lock()
 	defer l.Unlock()
@@ -0,0 +1,289 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	// the kinds of the slots leased
+	accountLeaseConnections = "connections"
+	accountLeaseQueries     = "queries"
+
+	getAccountLeaseHeaderFormat = `select slots from mo_catalog.mo_account_leases where account_id = %d and kind = '%s' and cn_id = '';`
+
+	insertAccountLeaseHeaderFormat = `insert into mo_catalog.mo_account_leases(account_id, kind, cn_id, slots, expired_time) values (%d, '%s', '', 1, '%s');`
+
+	updateAccountLeaseHeaderFormat = `update mo_catalog.mo_account_leases set slots = slots + 1 where account_id = %d and kind = '%s' and cn_id = '';`
+
+	getQuotaOfAccountLeaseFormat = `select %s from mo_catalog.mo_account where account_id = %d;`
+
+	getSlotsLeasedByOtherCNsFormat = `select cast(coalesce(sum(slots),0) as bigint unsigned) from mo_catalog.mo_account_leases
+			where account_id = %d and kind = '%s' and cn_id != '' and cn_id != '%s' and expired_time > '%s';`
+
+	deleteAccountLeaseFormat = `delete from mo_catalog.mo_account_leases where account_id = %d and kind = '%s' and cn_id = '%s';`
+
+	deleteAccountLeasesOfCNFormat = `delete from mo_catalog.mo_account_leases where cn_id = '%s';`
+
+	insertAccountLeasesFormat = `insert into mo_catalog.mo_account_leases(account_id, kind, cn_id, slots, expired_time) values %s;`
+
+	accountLeaseTimeFormat = "2006-01-02 15:04:05"
+)
+
+var (
+	// accountLeaseTTL is how long the slots leased by a cn are kept after it
+	// stops renewing them, e.g. the cn is down.
+	accountLeaseTTL = 30 * time.Second
+
+	// accountLeaseRenewInterval is the interval the cn renews its leases in.
+	accountLeaseRenewInterval = 10 * time.Second
+
+	// accountLeaseRetries is how many times a lease conflicting with the leases
+	// of the other cns is retried.
+	accountLeaseRetries = 3
+
+	// the quotas of the slots leased
+	accountLeaseQuotaTypes = map[string]tree.AccountQuotaType{
+		accountLeaseConnections: tree.AccountQuotaMaxConnections,
+		accountLeaseQueries:     tree.AccountQuotaMaxConcurrentQueries,
+	}
+)
+
+// accountLease is the slots of a kind leased by a cn for an account
+type accountLease struct {
+	accountId uint32
+	kind      string
+	slots     uint64
+}
+
+// accountLeases leases the slots of the connections and the running statements
+// of the accounts to the cns. The slots leased by all the cns never exceed the
+// quotas of the accounts.
+type accountLeases interface {
+	// lease sets the slots of the kind leased by this cn for the account if the
+	// slots leased by the other cns and the slots do not exceed the quota of the
+	// account. It returns whether the slots are leased, the quota and the slots
+	// leased by the other cns.
+	lease(ctx context.Context, accountId uint32, kind string, slots uint64) (bool, uint64, uint64, error)
+	// renew replaces all the slots leased by this cn with the leases, and extends
+	// the leases for accountLeaseTTL.
+	renew(ctx context.Context, leases []accountLease) error
+}
+
+// sqlAccountLeases keeps the leases in the table mo_account_leases, one row for
+// the slots of a kind leased by a cn for an account. The row of the empty cn_id
+// is updated by every lease of the kind and the account, so the concurrent leases
+// conflict with each other and only one of them commits.
+type sqlAccountLeases struct {
+	cnUUID string
+	newBh  func(context.Context) BackgroundExec
+}
+
+func (l *sqlAccountLeases) lease(ctx context.Context, accountId uint32, kind string, slots uint64) (bool, uint64, uint64, error) {
+	var err error
+	var ok bool
+	var quota, others uint64
+	ctx = l.sysContext(ctx)
+	for i := 0; i < accountLeaseRetries; i++ {
+		if ok, quota, others, err = l.leaseOnce(ctx, accountId, kind, slots); err == nil {
+			break
+		}
+	}
+	return ok, quota, others, err
+}
+
+func (l *sqlAccountLeases) leaseOnce(ctx context.Context, accountId uint32, kind string, slots uint64) (ok bool, quota, others uint64, err error) {
+	bh := l.newBh(ctx)
+	defer bh.Close()
+
+	now := time.Now().UTC()
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		if ok && err == nil {
+			err = bh.Exec(ctx, "commit;")
+		} else if rbErr := bh.Exec(ctx, "rollback;"); err == nil {
+			err = rbErr
+		}
+	}()
+	if err != nil {
+		return
+	}
+
+	// conflict with the concurrent leases
+	var rsset []ExecResult
+	if rsset, err = l.query(ctx, bh, fmt.Sprintf(getAccountLeaseHeaderFormat, accountId, kind)); err != nil {
+		return
+	}
+	if execResultArrayHasData(rsset) {
+		err = bh.Exec(ctx, fmt.Sprintf(updateAccountLeaseHeaderFormat, accountId, kind))
+	} else {
+		err = bh.Exec(ctx, fmt.Sprintf(insertAccountLeaseHeaderFormat, accountId, kind, now.Format(accountLeaseTimeFormat)))
+	}
+	if err != nil {
+		return
+	}
+
+	if rsset, err = l.query(ctx, bh, fmt.Sprintf(getQuotaOfAccountLeaseFormat, quotaColumnOfAccount[accountLeaseQuotaTypes[kind]], accountId)); err != nil {
+		return
+	}
+	if execResultArrayHasData(rsset) {
+		if quota, err = rsset[0].GetUint64(ctx, 0, 0); err != nil {
+			return
+		}
+	}
+	if rsset, err = l.query(ctx, bh, fmt.Sprintf(getSlotsLeasedByOtherCNsFormat, accountId, kind, l.cnUUID, now.Format(accountLeaseTimeFormat))); err != nil {
+		return
+	}
+	if execResultArrayHasData(rsset) {
+		if others, err = rsset[0].GetUint64(ctx, 0, 0); err != nil {
+			return
+		}
+	}
+	if quota != 0 && others+slots > quota {
+		return
+	}
+
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteAccountLeaseFormat, accountId, kind, l.cnUUID)); err != nil {
+		return
+	}
+	expired := now.Add(accountLeaseTTL).Format(accountLeaseTimeFormat)
+	if err = bh.Exec(ctx, fmt.Sprintf(insertAccountLeasesFormat, l.leaseValues(accountLease{accountId, kind, slots}, expired))); err != nil {
+		return
+	}
+	ok = true
+	return
+}
+
+func (l *sqlAccountLeases) renew(ctx context.Context, leases []accountLease) (err error) {
+	ctx = l.sysContext(ctx)
+	bh := l.newBh(ctx)
+	defer bh.Close()
+
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = bh.Exec(ctx, "commit;")
+		} else {
+			_ = bh.Exec(ctx, "rollback;")
+		}
+	}()
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteAccountLeasesOfCNFormat, l.cnUUID)); err != nil {
+		return err
+	}
+	if len(leases) == 0 {
+		return nil
+	}
+	expired := time.Now().UTC().Add(accountLeaseTTL).Format(accountLeaseTimeFormat)
+	values := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		values = append(values, l.leaseValues(lease, expired))
+	}
+	return bh.Exec(ctx, fmt.Sprintf(insertAccountLeasesFormat, strings.Join(values, ",")))
+}
+
+func (l *sqlAccountLeases) leaseValues(lease accountLease, expired string) string {
+	return fmt.Sprintf("(%d, '%s', '%s', %d, '%s')", lease.accountId, lease.kind, l.cnUUID, lease.slots, expired)
+}
+
+// sysContext returns the context to access the leases in the sys account
+func (l *sqlAccountLeases) sysContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, uint32(rootID))
+	return context.WithValue(ctx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+}
+
+func (l *sqlAccountLeases) query(ctx context.Context, bh BackgroundExec, sql string) ([]ExecResult, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	return getResultSet(ctx, bh)
+}
+
+// StartAccountLeases counts the connections and the running statements of the
+// accounts on the cn against their quotas in the cluster, and keeps the leases
+// of the cn until the ctx is done.
+func (rm *RoutineManager) StartAccountLeases(ctx context.Context, cnUUID string, pu *config.ParameterUnit, aicm defines.AutoIncrCaches) error {
+	mp, err := mpool.NewMPool("account_lease", 0, mpool.NoFixed)
+	if err != nil {
+		return err
+	}
+	au := rm.accountUsages
+	au.leaseMu.Lock()
+	au.leases = &sqlAccountLeases{
+		cnUUID: cnUUID,
+		newBh: func(ctx context.Context) BackgroundExec {
+			return NewBackgroundHandler(ctx, mp, pu, aicm)
+		},
+	}
+	au.leaseMu.Unlock()
+	go func() {
+		defer mpool.DeleteMPool(mp)
+		au.keepLeases(ctx)
+	}()
+	return nil
+}
+
+// keepLeases renews the leases of the cn every accountLeaseRenewInterval, and
+// shrinks them to the slots counted once some slots are released.
+func (au *accountUsages) keepLeases(ctx context.Context) {
+	ticker := time.NewTicker(accountLeaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		// the leases left by the cn before it restarts are dropped at first.
+		if err := au.renewLeases(ctx); err != nil {
+			logutil.Errorf("renew the account leases failed. error:%v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-au.shrink:
+		}
+	}
+}
+
+// renewLeases leases the slots counted by the cn, both the slots of the accounts
+// with quotas and without quotas are leased, so the slots are counted once the
+// quotas are set.
+func (au *accountUsages) renewLeases(ctx context.Context) error {
+	au.leaseMu.Lock()
+	defer au.leaseMu.Unlock()
+	var leases []accountLease
+	au.Lock()
+	for accountId, u := range au.accounts {
+		for _, kind := range []string{accountLeaseConnections, accountLeaseQueries} {
+			used, leased, _ := u.slots(kind)
+			*leased = *used
+			if *used != 0 {
+				leases = append(leases, accountLease{accountId: accountId, kind: kind, slots: *used})
+			}
+		}
+	}
+	au.Unlock()
+	return au.leases.renew(ctx, leases)
+}
@@ -0,0 +1,521 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	updateQuotaOfAccountFormat = `update mo_catalog.mo_account set %s where account_name = "%s";`
+
+	getQuotaOfAccountFormat = `select max_storage_size,max_connections,max_concurrent_queries from mo_catalog.mo_account where account_id = %d;`
+
+	// the same accounting as the storage size in the statement show accounts
+	getStorageSizeOfAccountFormat = "select " +
+		"cast(coalesce(sum(mo_table_size(mt.reldatabase,mt.relname)),0) as bigint unsigned) as `size` " +
+		"from " +
+		"mo_catalog.mo_tables as mt " +
+		"where mt.account_id = %d;"
+)
+
+// storageSizeRefreshInterval is how long the storage size of an account
+// is reused before it is measured again at commit.
+var storageSizeRefreshInterval = 10 * time.Second
+
+// storageSizeMeasureTimeout is the timeout of measuring the storage size of an account
+var storageSizeMeasureTimeout = time.Minute
+
+// the columns of the quotas in the mo_account
+var quotaColumnOfAccount = map[tree.AccountQuotaType]string{
+	tree.AccountQuotaMaxStorageSize:       "max_storage_size",
+	tree.AccountQuotaMaxConnections:       "max_connections",
+	tree.AccountQuotaMaxConcurrentQueries: "max_concurrent_queries",
+}
+
+// checkAccountQuota checks every quota is set at most once
+func checkAccountQuota(ctx context.Context, quota *tree.AccountQuota) error {
+	seen := make(map[tree.AccountQuotaType]bool, len(quota.Options))
+	for _, opt := range quota.Options {
+		if seen[opt.Typ] {
+			return moerr.NewInternalError(ctx, "the quota %s is set more than once", opt.Typ)
+		}
+		seen[opt.Typ] = true
+	}
+	return nil
+}
+
+func getSqlForUpdateQuotaOfAccount(ctx context.Context, quota *tree.AccountQuota, account string) (string, error) {
+	err := inputNameIsInvalid(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	sets := make([]string, 0, len(quota.Options))
+	for _, opt := range quota.Options {
+		sets = append(sets, fmt.Sprintf("%s = %d", quotaColumnOfAccount[opt.Typ], opt.Value))
+	}
+	return fmt.Sprintf(updateQuotaOfAccountFormat, strings.Join(sets, ","), account), nil
+}
+
+func getSqlForQuotaOfAccount(accountId uint32) string {
+	return fmt.Sprintf(getQuotaOfAccountFormat, accountId)
+}
+
+func getSqlForStorageSizeOfAccount(accountId uint32) string {
+	return fmt.Sprintf(getStorageSizeOfAccountFormat, accountId)
+}
+
+// accountQuota denotes the limits of an account in the cluster. 0 means unlimited.
+type accountQuota struct {
+	maxStorageSize       uint64
+	maxConnections       uint64
+	maxConcurrentQueries uint64
+}
+
+func (q *accountQuota) apply(opts []tree.AccountQuotaOption) {
+	for _, opt := range opts {
+		switch opt.Typ {
+		case tree.AccountQuotaMaxStorageSize:
+			q.maxStorageSize = opt.Value
+		case tree.AccountQuotaMaxConnections:
+			q.maxConnections = opt.Value
+		case tree.AccountQuotaMaxConcurrentQueries:
+			q.maxConcurrentQueries = opt.Value
+		}
+	}
+}
+
+// accountUsage denotes what an account consumes
+type accountUsage struct {
+	quota       accountQuota
+	connections uint64
+	queries     uint64
+	// the slots of the connections and the queries leased by this cn
+	leasedConnections uint64
+	leasedQueries     uint64
+	storageSize       uint64
+	// the time when the storage size is measured
+	storageTime time.Time
+	// measuring is closed when the storage size being measured is known
+	measuring chan struct{}
+}
+
+// slots returns the count, the leased slots and the quota of the connections
+// or the running statements.
+func (u *accountUsage) slots(kind string) (*uint64, *uint64, *uint64) {
+	if kind == accountLeaseConnections {
+		return &u.connections, &u.leasedConnections, &u.quota.maxConnections
+	}
+	return &u.queries, &u.leasedQueries, &u.quota.maxConcurrentQueries
+}
+
+// accountUsages tracks the connections, the running statements and the
+// storage size of the accounts to enforce their quotas.
+// The connections and the running statements are counted on this cn, and the
+// cn leases the slots of them from the leases shared by all the cns before it
+// counts more than it has leased, so all the cns never count more than the quotas.
+type accountUsages struct {
+	sync.Mutex
+	accounts map[uint32]*accountUsage
+
+	// leaseMu serializes the leases of this cn
+	leaseMu sync.Mutex
+	// leases is nil if the cn is not in a cluster, the slots are counted on this cn only.
+	leases accountLeases
+	// shrink notifies the leases to shrink to the slots counted
+	shrink chan struct{}
+}
+
+func newAccountUsages() *accountUsages {
+	return &accountUsages{
+		accounts: make(map[uint32]*accountUsage),
+		shrink:   make(chan struct{}, 1),
+	}
+}
+
+// getLocked returns the usage of the account, the lock must be held
+func (au *accountUsages) getLocked(accountId uint32) *accountUsage {
+	u, ok := au.accounts[accountId]
+	if !ok {
+		u = &accountUsage{}
+		au.accounts[accountId] = u
+	}
+	return u
+}
+
+func (au *accountUsages) usage(accountId uint32) accountUsage {
+	au.Lock()
+	defer au.Unlock()
+	if u, ok := au.accounts[accountId]; ok {
+		return *u
+	}
+	return accountUsage{}
+}
+
+func (au *accountUsages) setQuota(accountId uint32, quota accountQuota) {
+	au.Lock()
+	defer au.Unlock()
+	au.getLocked(accountId).quota = quota
+}
+
+// updateQuota changes the quotas of the account which is tracked
+func (au *accountUsages) updateQuota(accountId uint32, opts []tree.AccountQuotaOption) {
+	au.Lock()
+	defer au.Unlock()
+	if u, ok := au.accounts[accountId]; ok {
+		u.quota.apply(opts)
+	}
+}
+
+func (au *accountUsages) acquireConnection(ctx context.Context, accountId uint32, account string) error {
+	return au.acquire(ctx, accountId, account, accountLeaseConnections)
+}
+
+func (au *accountUsages) releaseConnection(accountId uint32) {
+	au.release(accountId, accountLeaseConnections)
+}
+
+func (au *accountUsages) acquireQuery(ctx context.Context, accountId uint32, account string) error {
+	return au.acquire(ctx, accountId, account, accountLeaseQueries)
+}
+
+func (au *accountUsages) releaseQuery(accountId uint32) {
+	au.release(accountId, accountLeaseQueries)
+}
+
+// acquire counts a connection or a running statement of the account. If the
+// account has a quota of them and this cn counts all the slots it leased, one
+// more slot is leased, which fails if all the cns lease as many as the quota.
+func (au *accountUsages) acquire(ctx context.Context, accountId uint32, account string, kind string) error {
+	au.leaseMu.Lock()
+	defer au.leaseMu.Unlock()
+
+	au.Lock()
+	u := au.getLocked(accountId)
+	used, leased, quota := u.slots(kind)
+	if *quota != 0 && *used >= *quota {
+		defer au.Unlock()
+		return moerr.NewAccountQuotaExceeded(ctx, account, accountLeaseQuotaTypes[kind].String(), *used, *quota)
+	}
+	if au.leases == nil || *quota == 0 || *used < *leased {
+		*used++
+		au.Unlock()
+		return nil
+	}
+	want := *used + 1
+	au.Unlock()
+
+	ok, newQuota, others, err := au.leases.lease(ctx, accountId, kind, want)
+	if err != nil {
+		return err
+	}
+	au.Lock()
+	defer au.Unlock()
+	// the quota in the leases is the latest one.
+	*quota = newQuota
+	if !ok {
+		return moerr.NewAccountQuotaExceeded(ctx, account, accountLeaseQuotaTypes[kind].String(), others+*used, newQuota)
+	}
+	*leased = want
+	*used++
+	return nil
+}
+
+func (au *accountUsages) release(accountId uint32, kind string) {
+	au.Lock()
+	defer au.Unlock()
+	u, ok := au.accounts[accountId]
+	if !ok {
+		return
+	}
+	used, leased, _ := u.slots(kind)
+	if *used > 0 {
+		*used--
+	}
+	// give the slots back to the other cns soon.
+	if *leased > *used {
+		select {
+		case au.shrink <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// refreshStorageSize measures the storage size of the account by the measure
+// if it is measured storageSizeRefreshInterval before now or never. The
+// concurrent commits of the account wait for the same measurement.
+func (au *accountUsages) refreshStorageSize(ctx context.Context, accountId uint32, now time.Time,
+	measure func(context.Context) (accountQuota, uint64, error)) error {
+	au.Lock()
+	u := au.getLocked(accountId)
+	for {
+		if !u.storageTime.IsZero() && now.Sub(u.storageTime) < storageSizeRefreshInterval {
+			au.Unlock()
+			return nil
+		}
+		if u.measuring == nil {
+			break
+		}
+		measuring := u.measuring
+		au.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-measuring:
+		}
+		au.Lock()
+	}
+	measuring := make(chan struct{})
+	u.measuring = measuring
+	measured := !u.storageTime.IsZero()
+	au.Unlock()
+
+	quota, size, err := measure(ctx)
+
+	au.Lock()
+	defer au.Unlock()
+	u.measuring = nil
+	close(measuring)
+	if err != nil {
+		// the last size is checked until it is measured again.
+		if measured {
+			logutil.Errorf("measure the storage size of the account %d failed. error:%v", accountId, err)
+			return nil
+		}
+		return err
+	}
+	u.quota = quota
+	u.storageSize = size
+	u.storageTime = now
+	return nil
+}
+
+func (au *accountUsages) checkStorageSize(ctx context.Context, accountId uint32, account string) error {
+	au.Lock()
+	defer au.Unlock()
+	u := au.getLocked(accountId)
+	if u.quota.maxStorageSize != 0 && u.storageSize >= u.quota.maxStorageSize {
+		return moerr.NewAccountQuotaExceeded(ctx, account, "max_storage_size", u.storageSize, u.quota.maxStorageSize)
+	}
+	return nil
+}
+
+// readAccountQuota reads the quotas from the row of the mo_account
+// starting at the column idx
+func readAccountQuota(ctx context.Context, er ExecResult, idx uint64) (accountQuota, error) {
+	var err error
+	var quota accountQuota
+	if quota.maxStorageSize, err = er.GetUint64(ctx, 0, idx); err != nil {
+		return quota, err
+	}
+	if quota.maxConnections, err = er.GetUint64(ctx, 0, idx+1); err != nil {
+		return quota, err
+	}
+	if quota.maxConcurrentQueries, err = er.GetUint64(ctx, 0, idx+2); err != nil {
+		return quota, err
+	}
+	return quota, nil
+}
+
+// measureAccountUsage reads the quotas and measures the storage size of the account
+func measureAccountUsage(ctx context.Context, mp *mpool.MPool, pu *config.ParameterUnit, aicm defines.AutoIncrCaches,
+	accountId uint32) (accountQuota, uint64, error) {
+	var quota accountQuota
+	var size uint64
+
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	sysCtx = context.WithValue(sysCtx, defines.UserIDKey{}, uint32(rootID))
+	sysCtx = context.WithValue(sysCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+	rsset, err := executeSQLInBackgroundSession(sysCtx, mp, pu, getSqlForQuotaOfAccount(accountId), aicm)
+	if err != nil {
+		return quota, 0, err
+	}
+	if !execResultArrayHasData(rsset) {
+		return quota, 0, moerr.NewInternalError(ctx, "there is no account %d", accountId)
+	}
+	if quota, err = readAccountQuota(ctx, rsset[0], 0); err != nil {
+		return quota, 0, err
+	}
+
+	accountCtx := context.WithValue(ctx, defines.TenantIDKey{}, accountId)
+	rsset, err = executeSQLInBackgroundSession(accountCtx, mp, pu, getSqlForStorageSizeOfAccount(accountId), aicm)
+	if err != nil {
+		return quota, 0, err
+	}
+	if execResultArrayHasData(rsset) {
+		if size, err = rsset[0].GetUint64(ctx, 0, 0); err != nil {
+			return quota, 0, err
+		}
+	}
+	return quota, size, nil
+}
+
+// statementGrowsStorage reports whether the statement adds data to the storage
+func statementGrowsStorage(ses *Session, stmt tree.Statement) bool {
+	switch st := stmt.(type) {
+	case *tree.Insert, *tree.Replace, *tree.Load, *tree.Update:
+		return true
+	case *tree.Execute:
+		prepareStmt, err := ses.GetPrepareStmt(string(st.Name))
+		if err != nil {
+			return false
+		}
+		return statementGrowsStorage(ses, prepareStmt.PrepareStmt)
+	}
+	return false
+}
+
+// statementIsQuery reports whether the statement counts as a running statement
+// of the account. The transaction control statements, kill, set and use are
+// always allowed so that a busy account can still finish its transactions
+// and kill its statements.
+func statementIsQuery(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.Kill, *tree.SetVar, *tree.Use:
+		return false
+	}
+	return stmt.GetQueryType() != tree.QueryTypeTCL
+}
+
+// getAccountUsage returns the usage of the account on this cn
+func getAccountUsage(ses *Session, accountId uint32) accountUsage {
+	if au := ses.getAccountUsages(); au != nil {
+		return au.usage(accountId)
+	}
+	return accountUsage{}
+}
+
+func (ses *Session) setAccountUsages(au *accountUsages) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.accountUsages = au
+}
+
+func (ses *Session) getAccountUsages() *accountUsages {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.accountUsages
+}
+
+// acquireConnection counts the connection on the account of the session.
+// The connection counted on another account before is released.
+func (ses *Session) acquireConnection(ctx context.Context) error {
+	au := ses.getAccountUsages()
+	tenant := ses.GetTenantInfo()
+	if au == nil || tenant == nil {
+		return nil
+	}
+	accountId := tenant.GetTenantID()
+	ses.mu.Lock()
+	counted, countedId := ses.connectionCounted, ses.connectionAccountId
+	ses.mu.Unlock()
+	if counted && countedId == accountId {
+		return nil
+	}
+	if err := au.acquireConnection(ctx, accountId, tenant.GetTenant()); err != nil {
+		return err
+	}
+	ses.releaseConnection()
+	ses.mu.Lock()
+	ses.connectionCounted = true
+	ses.connectionAccountId = accountId
+	ses.mu.Unlock()
+	return nil
+}
+
+func (ses *Session) releaseConnection() {
+	au := ses.getAccountUsages()
+	ses.mu.Lock()
+	counted, accountId := ses.connectionCounted, ses.connectionAccountId
+	ses.connectionCounted = false
+	ses.mu.Unlock()
+	if au != nil && counted {
+		au.releaseConnection(accountId)
+	}
+}
+
+// acquireQuery counts the running statements of the account.
+// It returns the function to release the count.
+func (ses *Session) acquireQuery(ctx context.Context, cws []ComputationWrapper) (func(), error) {
+	au := ses.getAccountUsages()
+	tenant := ses.GetTenantInfo()
+	if au == nil || tenant == nil {
+		return func() {}, nil
+	}
+	isQuery := false
+	for _, cw := range cws {
+		if statementIsQuery(cw.GetAst()) {
+			isQuery = true
+			break
+		}
+	}
+	if !isQuery {
+		return func() {}, nil
+	}
+	accountId := tenant.GetTenantID()
+	if err := au.acquireQuery(ctx, accountId, tenant.GetTenant()); err != nil {
+		return nil, err
+	}
+	return func() {
+		au.releaseQuery(accountId)
+	}, nil
+}
+
+// measureStorageSize reads the quotas and measures the storage size of the account
+func measureStorageSize(ctx context.Context, pu *config.ParameterUnit, aicm defines.AutoIncrCaches,
+	accountId uint32) (accountQuota, uint64, error) {
+	mp, err := mpool.NewMPool("account_usage", 0, mpool.NoFixed)
+	if err != nil {
+		return accountQuota{}, 0, err
+	}
+	defer mpool.DeleteMPool(mp)
+	ctx, cancel := context.WithTimeout(ctx, storageSizeMeasureTimeout)
+	defer cancel()
+	return measureAccountUsage(ctx, mp, pu, aicm, accountId)
+}
+
+// checkStorageQuota checks the account of the session does not exceed its
+// storage quota before committing a transaction adding data. The storage size
+// is measured before the commit if it is measured storageSizeRefreshInterval
+// ago or never, so the transactions crossing the limit in the interval are
+// committed and the following ones are rejected. The user for initialization
+// is not checked, it commits before the mo_account is created.
+func (ses *Session) checkStorageQuota(ctx context.Context) error {
+	au := ses.getAccountUsages()
+	tenant := ses.GetTenantInfo()
+	if au == nil || tenant == nil || ses.skipAuthForSpecialUser() {
+		return nil
+	}
+	accountId := tenant.GetTenantID()
+	pu, aicm := ses.GetParameterUnit(), ses.GetAutoIncrCaches()
+	err := au.refreshStorageSize(ctx, accountId, time.Now(), func(ctx context.Context) (accountQuota, uint64, error) {
+		return measureStorageSize(ctx, pu, aicm, accountId)
+	})
+	if err != nil {
+		return err
+	}
+	return au.checkStorageSize(ctx, accountId, tenant.GetTenant())
+}
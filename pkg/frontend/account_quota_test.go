@@ -0,0 +1,372 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSqlForUpdateQuotaOfAccount(t *testing.T) {
+	ctx := context.TODO()
+	quota := &tree.AccountQuota{
+		Exist: true,
+		Options: []tree.AccountQuotaOption{
+			{Typ: tree.AccountQuotaMaxStorageSize, Value: 1024},
+			{Typ: tree.AccountQuotaMaxConcurrentQueries, Value: 8},
+		},
+	}
+	sql, err := getSqlForUpdateQuotaOfAccount(ctx, quota, "acc")
+	require.NoError(t, err)
+	require.Equal(t, `update mo_catalog.mo_account set max_storage_size = 1024,max_concurrent_queries = 8 where account_name = "acc";`, sql)
+	require.NoError(t, checkAccountQuota(ctx, quota))
+
+	quota.Options = append(quota.Options, tree.AccountQuotaOption{Typ: tree.AccountQuotaMaxStorageSize, Value: 1})
+	require.Error(t, checkAccountQuota(ctx, quota))
+}
+
+func TestAccountUsages(t *testing.T) {
+	ctx := context.TODO()
+	au := newAccountUsages()
+	au.setQuota(1, accountQuota{maxConnections: 2, maxConcurrentQueries: 1})
+
+	require.NoError(t, au.acquireConnection(ctx, 1, "acc"))
+	require.NoError(t, au.acquireConnection(ctx, 1, "acc"))
+	err := au.acquireConnection(ctx, 1, "acc")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAccountQuotaExceeded))
+	// the other accounts are not affected
+	require.NoError(t, au.acquireConnection(ctx, 2, "acc2"))
+	au.releaseConnection(1)
+	require.NoError(t, au.acquireConnection(ctx, 1, "acc"))
+
+	require.NoError(t, au.acquireQuery(ctx, 1, "acc"))
+	err = au.acquireQuery(ctx, 1, "acc")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAccountQuotaExceeded))
+	au.releaseQuery(1)
+	require.NoError(t, au.acquireQuery(ctx, 1, "acc"))
+
+	// raise the limit of the queries only
+	au.updateQuota(1, []tree.AccountQuotaOption{{Typ: tree.AccountQuotaMaxConcurrentQueries, Value: 0}})
+	require.NoError(t, au.acquireQuery(ctx, 1, "acc"))
+	usage := au.usage(1)
+	require.Equal(t, uint64(2), usage.connections)
+	require.Equal(t, uint64(2), usage.queries)
+	require.Equal(t, accountQuota{maxConnections: 2}, usage.quota)
+
+	// the storage size
+	now := time.Now()
+	measured := 0
+	size := uint64(99)
+	measure := func(context.Context) (accountQuota, uint64, error) {
+		measured++
+		return accountQuota{maxStorageSize: 100}, size, nil
+	}
+	require.NoError(t, au.refreshStorageSize(ctx, 1, now, measure))
+	require.NoError(t, au.checkStorageSize(ctx, 1, "acc"))
+	size = 100
+	require.NoError(t, au.refreshStorageSize(ctx, 1, now.Add(time.Second), measure))
+	require.Equal(t, 1, measured)
+	require.NoError(t, au.refreshStorageSize(ctx, 1, now.Add(storageSizeRefreshInterval), measure))
+	require.Equal(t, 2, measured)
+	err = au.checkStorageSize(ctx, 1, "acc")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAccountQuotaExceeded))
+
+	// the writes are blocked until the storage size is known
+	err = au.refreshStorageSize(ctx, 3, now, func(context.Context) (accountQuota, uint64, error) {
+		return accountQuota{}, 0, moerr.NewInternalError(ctx, "measure failed")
+	})
+	require.Error(t, err)
+	// and the last size is checked if it is not measured again
+	err = au.refreshStorageSize(ctx, 1, now.Add(2*storageSizeRefreshInterval), func(context.Context) (accountQuota, uint64, error) {
+		return accountQuota{}, 0, moerr.NewInternalError(ctx, "measure failed")
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), au.usage(1).storageSize)
+}
+
+// testAccountLeases keeps the leases of all the cns in memory
+type testAccountLeases struct {
+	sync.Mutex
+	quota  uint64
+	leases map[string]map[string]uint64
+}
+
+// testCNAccountLeases is the leases seen by a cn
+type testCNAccountLeases struct {
+	cn     string
+	leases *testAccountLeases
+}
+
+func (l *testCNAccountLeases) lease(_ context.Context, accountId uint32, kind string, slots uint64) (bool, uint64, uint64, error) {
+	l.leases.Lock()
+	defer l.leases.Unlock()
+	key := fmt.Sprintf("%d-%s", accountId, kind)
+	var others uint64
+	for cn, n := range l.leases.leases[key] {
+		if cn != l.cn {
+			others += n
+		}
+	}
+	if l.leases.quota != 0 && others+slots > l.leases.quota {
+		return false, l.leases.quota, others, nil
+	}
+	if l.leases.leases[key] == nil {
+		l.leases.leases[key] = make(map[string]uint64)
+	}
+	l.leases.leases[key][l.cn] = slots
+	return true, l.leases.quota, others, nil
+}
+
+func (l *testCNAccountLeases) renew(_ context.Context, leases []accountLease) error {
+	l.leases.Lock()
+	defer l.leases.Unlock()
+	for _, m := range l.leases.leases {
+		delete(m, l.cn)
+	}
+	for _, lease := range leases {
+		key := fmt.Sprintf("%d-%s", lease.accountId, lease.kind)
+		if l.leases.leases[key] == nil {
+			l.leases.leases[key] = make(map[string]uint64)
+		}
+		l.leases.leases[key][l.cn] = lease.slots
+	}
+	return nil
+}
+
+func TestAccountLeasesOfTwoCNs(t *testing.T) {
+	ctx := context.TODO()
+	leases := &testAccountLeases{quota: 2, leases: make(map[string]map[string]uint64)}
+	cn1, cn2 := newAccountUsages(), newAccountUsages()
+	cn1.leases = &testCNAccountLeases{cn: "cn1", leases: leases}
+	cn2.leases = &testCNAccountLeases{cn: "cn2", leases: leases}
+	cn1.setQuota(1, accountQuota{maxConnections: 2})
+	cn2.setQuota(1, accountQuota{maxConnections: 2})
+
+	require.NoError(t, cn1.acquireConnection(ctx, 1, "acc"))
+	require.NoError(t, cn2.acquireConnection(ctx, 1, "acc"))
+	// the account has 2 connections in the cluster
+	err := cn1.acquireConnection(ctx, 1, "acc")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAccountQuotaExceeded))
+	err = cn2.acquireConnection(ctx, 1, "acc")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAccountQuotaExceeded))
+
+	// the slot released by cn1 is given back to cn2
+	cn1.releaseConnection(1)
+	select {
+	case <-cn1.shrink:
+	default:
+		t.Fatal("the leases of cn1 are not shrunk")
+	}
+	require.NoError(t, cn1.renewLeases(ctx))
+	require.NoError(t, cn2.acquireConnection(ctx, 1, "acc"))
+	err = cn1.acquireConnection(ctx, 1, "acc")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAccountQuotaExceeded))
+
+	// the new quota in the leases is taken by the cn leasing
+	leases.quota = 3
+	require.NoError(t, cn1.acquireConnection(ctx, 1, "acc"))
+	require.Equal(t, uint64(3), cn1.usage(1).quota.maxConnections)
+	// the queries are not limited, and counted without leases
+	require.NoError(t, cn1.acquireQuery(ctx, 1, "acc"))
+	require.Empty(t, leases.leases["1-"+accountLeaseQueries])
+}
+
+// prefixBackgroundExec returns the result of the sql starting with the key
+type prefixBackgroundExec struct {
+	backgroundExecTest
+}
+
+func (bt *prefixBackgroundExec) GetExecResultSet() []interface{} {
+	for sql, result := range bt.sql2result {
+		if strings.HasPrefix(bt.currentSql, sql) {
+			return []interface{}{result}
+		}
+	}
+	return []interface{}{nil}
+}
+
+func TestSqlAccountLeases(t *testing.T) {
+	ctx := context.TODO()
+	bh := &prefixBackgroundExec{}
+	bh.init()
+	l := &sqlAccountLeases{
+		cnUUID: "cn1",
+		newBh: func(context.Context) BackgroundExec {
+			return bh
+		},
+	}
+	newMrs := func(name string, rows ...interface{}) *MysqlResultSet {
+		mrs := &MysqlResultSet{}
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		mrs.AddColumn(col)
+		for _, row := range rows {
+			mrs.AddRow([]interface{}{row})
+		}
+		return mrs
+	}
+	bh.sql2result[fmt.Sprintf(getAccountLeaseHeaderFormat, 1, accountLeaseQueries)] = newMrs("slots", uint64(3))
+	bh.sql2result[fmt.Sprintf(getQuotaOfAccountLeaseFormat, "max_concurrent_queries", 1)] = newMrs("max_concurrent_queries", uint64(4))
+	bh.sql2result["select cast(coalesce(sum(slots),0) as bigint unsigned) from mo_catalog.mo_account_leases"] = newMrs("sum", uint64(3))
+
+	ok, quota, others, err := l.lease(ctx, 1, accountLeaseQueries, 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(4), quota)
+	require.Equal(t, uint64(3), others)
+
+	ok, _, _, err = l.lease(ctx, 1, accountLeaseQueries, 2)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestStatementIsQueryAndGrowsStorage(t *testing.T) {
+	require.True(t, statementIsQuery(&tree.Select{}))
+	require.False(t, statementIsQuery(&tree.CommitTransaction{}))
+	require.False(t, statementIsQuery(&tree.Kill{}))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newSes(nil, ctrl)
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	require.True(t, statementGrowsStorage(ses, &tree.Insert{}))
+	require.False(t, statementGrowsStorage(ses, &tree.Delete{}))
+	require.False(t, statementGrowsStorage(ses, &tree.Execute{Name: "s1"}))
+	require.NoError(t, ses.SetPrepareStmt("s1", &PrepareStmt{PrepareStmt: &tree.Update{}}))
+	require.True(t, statementGrowsStorage(ses, &tree.Execute{Name: "s1"}))
+}
+
+func TestSessionAcquireConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+	au := newAccountUsages()
+	au.setQuota(sysAccountID, accountQuota{maxConnections: 1})
+
+	ses1 := newSes(nil, ctrl)
+	ses1.setAccountUsages(au)
+	require.NoError(t, ses1.acquireConnection(ctx))
+	// counted once
+	require.NoError(t, ses1.acquireConnection(ctx))
+
+	ses2 := newSes(nil, ctrl)
+	ses2.setAccountUsages(au)
+	require.Error(t, ses2.acquireConnection(ctx))
+
+	// the connection moves to another account
+	ses1.SetTenantInfo(&TenantInfo{Tenant: "acc", TenantID: 1})
+	require.NoError(t, ses1.acquireConnection(ctx))
+	require.NoError(t, ses2.acquireConnection(ctx))
+	require.Equal(t, uint64(1), au.usage(1).connections)
+
+	ses1.releaseConnection()
+	ses1.releaseConnection()
+	require.Equal(t, uint64(0), au.usage(1).connections)
+	require.Equal(t, uint64(1), au.usage(sysAccountID).connections)
+}
+
+func TestSessionCheckStorageQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	newMrs := func(names []string, row []interface{}) *MysqlResultSet {
+		mrs := &MysqlResultSet{}
+		for _, name := range names {
+			col := &MysqlColumn{}
+			col.SetName(name)
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			mrs.AddColumn(col)
+		}
+		mrs.AddRow(row)
+		return mrs
+	}
+	bh.sql2result[getSqlForQuotaOfAccount(sysAccountID)] = newMrs(
+		[]string{"max_storage_size", "max_connections", "max_concurrent_queries"},
+		[]interface{}{uint64(100), uint64(0), uint64(0)})
+	bh.sql2result[getSqlForStorageSizeOfAccount(sysAccountID)] = newMrs(
+		[]string{"size"}, []interface{}{uint64(200)})
+
+	ses := newSes(nil, ctrl)
+	au := newAccountUsages()
+	ses.setAccountUsages(au)
+	// the storage size is measured before the first commit
+	err := ses.checkStorageQuota(ses.GetRequestContext())
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrAccountQuotaExceeded))
+	require.Equal(t, uint64(200), au.usage(sysAccountID).storageSize)
+
+	// the user for initialization commits before the mo_account is created
+	SetSpecialUser("special_user", nil)
+	ses2 := newSes(nil, ctrl)
+	ses2.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: "special_user", TenantID: sysAccountID})
+	ses2.setAccountUsages(newAccountUsages())
+	require.NoError(t, ses2.checkStorageQuota(ses2.GetRequestContext()))
+}
+
+func TestDoAlterAccountQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	stmt := &tree.AlterAccount{
+		Name: "acc",
+		Quota: tree.AccountQuota{
+			Exist:   true,
+			Options: []tree.AccountQuotaOption{{Typ: tree.AccountQuotaMaxConnections, Value: 10}},
+		},
+	}
+	priv := determinePrivilegeSetOfStatement(stmt)
+	ses := newSes(priv, ctrl)
+	au := newAccountUsages()
+	au.setQuota(1, accountQuota{maxConcurrentQueries: 5})
+	ses.setAccountUsages(au)
+
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+	sql, _ := getSqlForCheckTenant(context.TODO(), stmt.Name)
+	bh.sql2result[sql] = newMrsForCheckTenant([][]interface{}{
+		{1},
+	})
+
+	err := doAlterAccount(ses.GetRequestContext(), ses, stmt)
+	require.NoError(t, err)
+	require.Equal(t, accountQuota{maxConnections: 10, maxConcurrentQueries: 5}, au.usage(1).quota)
+
+	// the quota can not be altered with other options
+	stmt.Comment = tree.AccountComment{Exist: true, Comment: "a"}
+	err = doAlterAccount(ses.GetRequestContext(), ses, stmt)
+	require.Error(t, err)
+}
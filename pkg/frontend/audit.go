@@ -0,0 +1,818 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+	"go.uber.org/zap"
+)
+
+const (
+	// the audit policies of the cn are reloaded from mo_audit_policies in the interval,
+	// the policies created or dropped on the other cn take effect after it.
+	auditPolicyReloadInterval = 10 * time.Second
+
+	auditObjectDatabase    = "database"
+	auditObjectTable       = "table"
+	auditObjectUser        = "user"
+	auditObjectRole        = "role"
+	auditObjectAccount     = "account"
+	auditObjectAuditPolicy = "audit policy"
+)
+
+var (
+	getAuditPolicyFormat = `select policy_name from mo_catalog.mo_audit_policies where account_id = %d and policy_name = %s;`
+
+	insertAuditPolicyFormat = `insert into mo_catalog.mo_audit_policies(
+			account_id,
+			account_name,
+			policy_name,
+			event_types,
+			object_database,
+			object_table,
+			target_accounts,
+			target_users,
+			creator,
+			created_time) values (%d, %s, %s, %s, %s, %s, %s, %s, %d, %s);`
+
+	getLastAuditRecordFormat = `select seq, hash from system.audit_log where node_uuid = %s order by seq desc limit 1;`
+
+	deleteAuditPolicyFormat = `delete from mo_catalog.mo_audit_policies where account_id = %d and policy_name = %s;`
+
+	deleteAuditPoliciesOfAccountFormat = `delete from mo_catalog.mo_audit_policies where account_id = %d;`
+
+	showAuditPoliciesFormat = `select policy_name, account_name, event_types, object_database, object_table, target_accounts, target_users, created_time
+			from mo_catalog.mo_audit_policies %s order by account_name, policy_name;`
+
+	getAuditPoliciesFormat = `select account_id, account_name, policy_name, event_types, object_database, object_table, target_accounts, target_users
+			from mo_catalog.mo_audit_policies;`
+
+	getAccountsOfAuditPolicyFormat = `select account_name from mo_catalog.mo_account where account_name in (%s);`
+)
+
+// auditPolicy records the events of the users into the audit log. The policy of
+// the sys account audits all the accounts or the accounts listed, and the policy
+// of the other account audits the account itself only. The empty lists and object
+// mean no restriction.
+type auditPolicy struct {
+	accountId   uint32
+	accountName string
+	name        string
+	events      []tree.AuditEvent
+	database    string
+	table       string
+	accounts    []string
+	users       []string
+}
+
+func (p *auditPolicy) hasEvent(event tree.AuditEvent) bool {
+	for _, e := range p.events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether the event of the user touching the objects should be audited.
+// The logins touch no objects, so they are not audited by the policies on the objects.
+func (p *auditPolicy) match(account, user string, event tree.AuditEvent, objects []auditObject) bool {
+	if p.accountId != sysAccountID && p.accountName != account {
+		return false
+	}
+	if len(p.accounts) != 0 && !stringInList(account, p.accounts) {
+		return false
+	}
+	if len(p.users) != 0 && !stringInList(user, p.users) {
+		return false
+	}
+	if !p.hasEvent(event) {
+		return false
+	}
+	if p.database == "" {
+		return true
+	}
+	for _, o := range objects {
+		if o.touches(p.database, p.table) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *auditPolicy) objectString() string {
+	switch {
+	case p.database == "":
+		return "*.*"
+	case p.table == "":
+		return p.database + ".*"
+	}
+	return p.database + "." + p.table
+}
+
+func stringInList(s string, list []string) bool {
+	for _, t := range list {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
+
+// auditObject is the object touched by the statement
+type auditObject struct {
+	typ  string
+	db   string
+	name string
+}
+
+func (o auditObject) String() string {
+	switch o.typ {
+	case auditObjectDatabase:
+		return o.db
+	case auditObjectTable:
+		return o.db + "." + o.name
+	}
+	return o.typ + " " + o.name
+}
+
+// touches reports whether the object is the table or in the database, the
+// database '*' is all the databases.
+func (o auditObject) touches(db, table string) bool {
+	switch o.typ {
+	case auditObjectDatabase:
+		return o.db == "*" || o.db == db
+	case auditObjectTable:
+		return o.db == db && (table == "" || o.name == table)
+	}
+	return false
+}
+
+var gAuditPolicies struct {
+	sync.RWMutex
+	policies []*auditPolicy
+}
+
+func getAuditPolicies() []*auditPolicy {
+	gAuditPolicies.RLock()
+	defer gAuditPolicies.RUnlock()
+	return gAuditPolicies.policies
+}
+
+func setAuditPolicies(policies []*auditPolicy) {
+	gAuditPolicies.Lock()
+	defer gAuditPolicies.Unlock()
+	gAuditPolicies.policies = policies
+}
+
+// StartAuditPolicies loads the audit policies and keeps them synchronized with
+// mo_audit_policies until the ctx is done. The hash chain of the audit records of
+// the cn is resumed before the policies are loaded, or a new segment of the chain
+// is started if it can not be resumed, so no record is audited before.
+func StartAuditPolicies(ctx context.Context, pu *config.ParameterUnit, aicm defines.AutoIncrCaches) error {
+	mp, err := mpool.NewMPool("audit", 0, mpool.NoFixed)
+	if err != nil {
+		return err
+	}
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	bh := NewBackgroundHandler(sysCtx, mp, pu, aicm)
+	if err := resumeAuditChain(sysCtx, bh); err != nil {
+		logutil.Error("resume the hash chain of the audit log failed, start a new segment", zap.Error(err))
+		if err := motrace.StartAuditSegment(sysCtx, err.Error()); err != nil {
+			bh.Close()
+			mpool.DeleteMPool(mp)
+			return err
+		}
+	}
+	bh.Close()
+	go func() {
+		defer mpool.DeleteMPool(mp)
+		ticker := time.NewTicker(auditPolicyReloadInterval)
+		defer ticker.Stop()
+		for {
+			bh := NewBackgroundHandler(sysCtx, mp, pu, aicm)
+			if err := reloadAuditPolicies(sysCtx, bh); err != nil {
+				logutil.Warn("reload the audit policies failed", zap.Error(err))
+			}
+			bh.Close()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// resumeAuditChain continues the hash chain of the cn after the last audit record
+// written before the cn restarts.
+func resumeAuditChain(ctx context.Context, bh BackgroundExec) error {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getLastAuditRecordFormat, quoteString(motrace.GetNodeResource().NodeUuid))); err != nil {
+		// no record is written before the audit log is created
+		if moerr.IsMoErrCode(err, moerr.ErrBadDB) || moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			return nil
+		}
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil
+	}
+	seq, err := erArray[0].GetUint64(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+	hash, err := erArray[0].GetString(ctx, 0, 1)
+	if err != nil {
+		return err
+	}
+	motrace.ResumeAuditChain(seq, hash)
+	return nil
+}
+
+// reloadAuditPolicies reads all the audit policies in mo_audit_policies of the sys account.
+func reloadAuditPolicies(ctx context.Context, bh BackgroundExec) error {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, getAuditPoliciesFormat); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	var policies []*auditPolicy
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			p, err := readAuditPolicy(ctx, erArray[0], i)
+			if err != nil {
+				return err
+			}
+			policies = append(policies, p)
+		}
+	}
+	setAuditPolicies(policies)
+	return nil
+}
+
+// readAuditPolicy reads the policy in the row of the result of getAuditPoliciesFormat
+func readAuditPolicy(ctx context.Context, er ExecResult, row uint64) (*auditPolicy, error) {
+	var vals [8]string
+	accountId, err := er.GetInt64(ctx, row, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(vals); i++ {
+		if vals[i], err = er.GetString(ctx, row, uint64(i)); err != nil {
+			return nil, err
+		}
+	}
+	p := &auditPolicy{
+		accountId:   uint32(accountId),
+		accountName: vals[1],
+		name:        vals[2],
+		database:    vals[4],
+		table:       vals[5],
+	}
+	for _, s := range strings.Split(vals[3], ",") {
+		if e, ok := tree.AuditEventOf(s); ok {
+			p.events = append(p.events, e)
+		}
+	}
+	if err = json.Unmarshal([]byte(vals[6]), &p.accounts); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal([]byte(vals[7]), &p.users); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// newAuditPolicy makes the policy of the account of the session, the object
+// relative to the current database is resolved.
+func newAuditPolicy(ctx context.Context, ses *Session, st *tree.CreateAuditPolicy) (*auditPolicy, error) {
+	tenant := ses.GetTenantInfo()
+	p := &auditPolicy{
+		accountId:   tenant.GetTenantID(),
+		accountName: tenant.GetTenant(),
+		name:        string(st.Name),
+		accounts:    make([]string, 0, len(st.Accounts)),
+		users:       make([]string, 0, len(st.Users)),
+	}
+	for e := tree.AuditEventLogin; e < tree.AuditEventAll; e++ {
+		for _, want := range st.Events {
+			if want == e || want == tree.AuditEventAll {
+				p.events = append(p.events, e)
+				break
+			}
+		}
+	}
+	if len(st.Accounts) != 0 && !tenant.IsSysTenant() {
+		return nil, moerr.NewInternalError(ctx, "only the sys account can audit the other accounts")
+	}
+	for _, a := range st.Accounts {
+		if !stringInList(string(a), p.accounts) {
+			p.accounts = append(p.accounts, string(a))
+		}
+	}
+	for _, u := range st.Users {
+		if !stringInList(u.Username, p.users) {
+			p.users = append(p.users, u.Username)
+		}
+	}
+	if st.Object != nil {
+		switch st.Object.Level {
+		case tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR:
+		case tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR:
+			p.database = st.Object.DbName
+		case tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE:
+			p.database, p.table = st.Object.DbName, st.Object.TabName
+		case tree.PRIVILEGE_LEVEL_TYPE_STAR, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+			p.database = ses.GetDatabaseName()
+			if p.database == "" {
+				return nil, moerr.NewNoDB(ctx)
+			}
+			p.table = st.Object.TabName
+		}
+	}
+	return p, nil
+}
+
+func auditPolicyExists(ctx context.Context, bh BackgroundExec, accountId uint32, name string) (bool, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getAuditPolicyFormat, accountId, quoteString(name))); err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+// checkAccountsOfAuditPolicy checks the accounts audited by the policy exist
+func checkAccountsOfAuditPolicy(ctx context.Context, bh BackgroundExec, accounts []string) error {
+	quoted := make([]string, len(accounts))
+	for i, a := range accounts {
+		quoted[i] = quoteString(a)
+	}
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getAccountsOfAuditPolicyFormat, strings.Join(quoted, ", "))); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	existed := make(map[string]bool)
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			name, err := erArray[0].GetString(ctx, i, 0)
+			if err != nil {
+				return err
+			}
+			existed[name] = true
+		}
+	}
+	for _, a := range accounts {
+		if !existed[a] {
+			return moerr.NewInternalError(ctx, "there is no account %s", a)
+		}
+	}
+	return nil
+}
+
+// doCreateAuditPolicy records the policy in mo_audit_policies of the sys account,
+// the policy takes effect on the cn at once.
+func doCreateAuditPolicy(ctx context.Context, ses *Session, st *tree.CreateAuditPolicy) error {
+	p, err := newAuditPolicy(ctx, ses, st)
+	if err != nil {
+		return err
+	}
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	bh := ses.GetBackgroundExec(sysCtx)
+	defer bh.Close()
+	exists, err := auditPolicyExists(sysCtx, bh, p.accountId, p.name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if st.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "audit policy %s already exists", p.name)
+	}
+	if len(p.accounts) != 0 {
+		if err = checkAccountsOfAuditPolicy(sysCtx, bh, p.accounts); err != nil {
+			return err
+		}
+	}
+
+	events := make([]string, len(p.events))
+	for i, e := range p.events {
+		events[i] = e.String()
+	}
+	accounts, _ := json.Marshal(p.accounts)
+	users, _ := json.Marshal(p.users)
+	bh.ClearExecResultSet()
+	err = bh.Exec(sysCtx, fmt.Sprintf(insertAuditPolicyFormat,
+		p.accountId,
+		quoteString(p.accountName),
+		quoteString(p.name),
+		quoteString(strings.Join(events, ",")),
+		quoteString(p.database),
+		quoteString(p.table),
+		quoteString(string(accounts)),
+		quoteString(string(users)),
+		ses.GetTenantInfo().GetUserID(),
+		quoteString(time.Now().UTC().Format("2006-01-02 15:04:05")),
+	))
+	if err != nil {
+		return err
+	}
+	if err = reloadAuditPolicies(sysCtx, bh); err != nil {
+		logutil.Warn("reload the audit policies failed", zap.Error(err))
+	}
+	return nil
+}
+
+// doDropAuditPolicy removes the policy of the account from mo_audit_policies
+func doDropAuditPolicy(ctx context.Context, ses *Session, st *tree.DropAuditPolicy) error {
+	name := string(st.Name)
+	accountId := ses.GetTenantInfo().GetTenantID()
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	bh := ses.GetBackgroundExec(sysCtx)
+	defer bh.Close()
+	exists, err := auditPolicyExists(sysCtx, bh, accountId, name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if st.IfExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "audit policy %s does not exist", name)
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(sysCtx, fmt.Sprintf(deleteAuditPolicyFormat, accountId, quoteString(name))); err != nil {
+		return err
+	}
+	if err = reloadAuditPolicies(sysCtx, bh); err != nil {
+		logutil.Warn("reload the audit policies failed", zap.Error(err))
+	}
+	return nil
+}
+
+// doShowAuditPolicies lists the audit policies of the account, the sys account
+// lists the policies of all the accounts.
+func doShowAuditPolicies(ctx context.Context, ses *Session, st *tree.ShowAuditPolicies) error {
+	tenant := ses.GetTenantInfo()
+	var conds []string
+	if !tenant.IsSysTenant() {
+		conds = append(conds, fmt.Sprintf("account_id = %d", tenant.GetTenantID()))
+	}
+	if st.Like != nil {
+		conds = append(conds, fmt.Sprintf("policy_name like %s", quoteString(strings.TrimSpace(st.Like.Right.String()))))
+	}
+	whereClause := ""
+	if len(conds) != 0 {
+		whereClause = "where " + strings.Join(conds, " and ")
+	}
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	bh := ses.GetBackgroundExec(sysCtx)
+	defer bh.Close()
+	bh.ClearExecResultSet()
+	if err := bh.Exec(sysCtx, fmt.Sprintf(showAuditPoliciesFormat, whereClause)); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return err
+	}
+
+	mrs := ses.GetMysqlResultSet()
+	for _, name := range []string{"Name", "Account", "Events", "Object", "Accounts", "Users", "Created"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		var vals [8]string
+		for j := range vals {
+			if vals[j], err = erArray[0].GetString(sysCtx, i, uint64(j)); err != nil {
+				return err
+			}
+		}
+		p := &auditPolicy{database: vals[3], table: vals[4]}
+		if err = json.Unmarshal([]byte(vals[5]), &p.accounts); err != nil {
+			return err
+		}
+		if err = json.Unmarshal([]byte(vals[6]), &p.users); err != nil {
+			return err
+		}
+		mrs.AddRow([]interface{}{
+			vals[0],
+			vals[1],
+			vals[2],
+			p.objectString(),
+			strings.Join(p.accounts, ","),
+			strings.Join(p.users, ","),
+			vals[7],
+		})
+	}
+	return nil
+}
+
+// auditEventOfStatement returns the audit event of the statement, the other
+// statements like SHOW, SET and the transaction control are not audited.
+func auditEventOfStatement(stmt tree.Statement) (tree.AuditEvent, bool) {
+	switch stmt.GetQueryType() {
+	case tree.QueryTypeDDL:
+		return tree.AuditEventDDL, true
+	case tree.QueryTypeDCL:
+		return tree.AuditEventDCL, true
+	case tree.QueryTypeDML:
+		return tree.AuditEventDML, true
+	case tree.QueryTypeDQL:
+		return tree.AuditEventDQL, true
+	}
+	return 0, false
+}
+
+// getAuditObjectsOfStatement returns the objects named in the DDL or DCL statement
+func getAuditObjectsOfStatement(ses *Session, stmt tree.Statement) []auditObject {
+	var objects []auditObject
+	addTable := func(tn *tree.TableName) {
+		db := string(tn.Schema())
+		if db == "" {
+			db = ses.GetDatabaseName()
+		}
+		objects = append(objects, auditObject{typ: auditObjectTable, db: db, name: string(tn.Name())})
+	}
+	addUsers := func(users []*tree.User) {
+		for _, u := range users {
+			objects = append(objects, auditObject{typ: auditObjectUser, name: u.Username})
+		}
+	}
+	addRoles := func(roles []*tree.Role) {
+		for _, r := range roles {
+			objects = append(objects, auditObject{typ: auditObjectRole, name: r.UserName})
+		}
+	}
+	addLevel := func(objType tree.ObjectType, level *tree.PrivilegeLevel) {
+		if level == nil || (objType != tree.OBJECT_TYPE_TABLE && objType != tree.OBJECT_TYPE_DATABASE) {
+			return
+		}
+		switch level.Level {
+		case tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR:
+			objects = append(objects, auditObject{typ: auditObjectDatabase, db: "*"})
+		case tree.PRIVILEGE_LEVEL_TYPE_STAR:
+			objects = append(objects, auditObject{typ: auditObjectDatabase, db: ses.GetDatabaseName()})
+		case tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR:
+			objects = append(objects, auditObject{typ: auditObjectDatabase, db: level.DbName})
+		case tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE:
+			objects = append(objects, auditObject{typ: auditObjectTable, db: level.DbName, name: level.TabName})
+		case tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+			objects = append(objects, auditObject{typ: auditObjectTable, db: ses.GetDatabaseName(), name: level.TabName})
+		}
+	}
+
+	switch st := stmt.(type) {
+	case *tree.CreateDatabase:
+		objects = append(objects, auditObject{typ: auditObjectDatabase, db: string(st.Name)})
+	case *tree.DropDatabase:
+		objects = append(objects, auditObject{typ: auditObjectDatabase, db: string(st.Name)})
+	case *tree.CreateTable:
+		addTable(&st.Table)
+	case *tree.AlterTable:
+		addTable(&st.Table)
+	case *tree.DropTable:
+		for _, tn := range st.Names {
+			addTable(tn)
+		}
+	case *tree.TruncateTable:
+		addTable(st.Name)
+	case *tree.CreateView:
+		addTable(st.Name)
+	case *tree.AlterView:
+		addTable(st.Name)
+	case *tree.DropView:
+		for _, tn := range st.Names {
+			addTable(tn)
+		}
+	case *tree.CreateIndex:
+		addTable(&st.Table)
+	case *tree.DropIndex:
+		addTable(&st.TableName)
+	case *tree.CreateUser:
+		addUsers(st.Users)
+	case *tree.DropUser:
+		addUsers(st.Users)
+	case *tree.AlterUser:
+		addUsers(st.Users)
+	case *tree.CreateRole:
+		addRoles(st.Roles)
+	case *tree.DropRole:
+		addRoles(st.Roles)
+	case *tree.Grant:
+		if st.Typ == tree.GrantTypePrivilege {
+			addLevel(st.GrantPrivilege.ObjType, st.GrantPrivilege.Level)
+			addRoles(st.GrantPrivilege.Roles)
+		} else if st.Typ == tree.GrantTypeRole {
+			addRoles(st.GrantRole.Roles)
+			addUsers(st.GrantRole.Users)
+		}
+	case *tree.Revoke:
+		if st.Typ == tree.RevokeTypePrivilege {
+			addLevel(st.RevokePrivilege.ObjType, st.RevokePrivilege.Level)
+			addRoles(st.RevokePrivilege.Roles)
+		} else if st.Typ == tree.RevokeTypeRole {
+			addRoles(st.RevokeRole.Roles)
+			addUsers(st.RevokeRole.Users)
+		}
+	case *tree.CreateAccount:
+		objects = append(objects, auditObject{typ: auditObjectAccount, name: st.Name})
+	case *tree.DropAccount:
+		objects = append(objects, auditObject{typ: auditObjectAccount, name: st.Name})
+	case *tree.AlterAccount:
+		objects = append(objects, auditObject{typ: auditObjectAccount, name: st.Name})
+	case *tree.CreateAuditPolicy:
+		objects = append(objects, auditObject{typ: auditObjectAuditPolicy, name: string(st.Name)})
+	case *tree.DropAuditPolicy:
+		objects = append(objects, auditObject{typ: auditObjectAuditPolicy, name: string(st.Name)})
+	}
+	return objects
+}
+
+// getAuditObjectsOfPlan returns the tables read or written by the DML or DQL statement,
+// including the ones in the subqueries and the views.
+func getAuditObjectsOfPlan(p *plan.Plan) []auditObject {
+	var objects []auditObject
+	for _, pt := range extractPrivilegeTipsFromPlan(p) {
+		o := auditObject{typ: auditObjectTable, db: pt.databaseName, name: pt.tableName}
+		found := false
+		for _, existed := range objects {
+			if existed == o {
+				found = true
+				break
+			}
+		}
+		if !found {
+			objects = append(objects, o)
+		}
+	}
+	return objects
+}
+
+// matchAuditPolicies returns the names of the policies auditing the event
+func matchAuditPolicies(policies []*auditPolicy, account, user string, event tree.AuditEvent, objects []auditObject) []string {
+	var names []string
+	for _, p := range policies {
+		if p.match(account, user, event, objects) {
+			names = append(names, p.accountName+"."+p.name)
+		}
+	}
+	return names
+}
+
+func auditResultCode(err error) uint16 {
+	if err == nil {
+		return 0
+	}
+	var moErr *moerr.Error
+	if errors.As(err, &moErr) {
+		return moErr.ErrorCode()
+	}
+	return moerr.ErrInfo
+}
+
+// statementDigest returns the sha256 of the statement, the secrets like the
+// passwords in the statement are not kept in the audit log.
+func statementDigest(stmt tree.Statement) string {
+	sum := sha256.Sum256([]byte(tree.String(stmt, dialect.MYSQL)))
+	return hex.EncodeToString(sum[:])
+}
+
+// newAuditRecord makes the record of the event of the session
+func newAuditRecord(ses *Session, event tree.AuditEvent, policies []string, err error) *motrace.AuditRecord {
+	tenant := ses.GetTenantInfo()
+	r := &motrace.AuditRecord{
+		Account:    tenant.GetTenant(),
+		User:       tenant.GetUser(),
+		Role:       tenant.GetDefaultRole(),
+		Event:      event.String(),
+		Policies:   policies,
+		ResultCode: auditResultCode(err),
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	copy(r.SessionID[:], ses.GetUUID())
+	r.Host, _ = ses.GetPeer()
+	return r
+}
+
+func reportAuditRecord(ctx context.Context, ses *Session, r *motrace.AuditRecord) {
+	if err := motrace.ReportAudit(ctx, r); err != nil {
+		logError(ses.GetConciseProfile(), "report the audit record failed", zap.Error(err))
+	}
+}
+
+// auditLogin records the login of the user if the audit policies match it, the
+// login failed is recorded with the error.
+func auditLogin(ctx context.Context, ses *Session, err error) {
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return
+	}
+	policies := matchAuditPolicies(getAuditPolicies(), tenant.GetTenant(), tenant.GetUser(), tree.AuditEventLogin, nil)
+	if len(policies) == 0 {
+		return
+	}
+	reportAuditRecord(ctx, ses, newAuditRecord(ses, tree.AuditEventLogin, policies, err))
+}
+
+// auditStatement records the statement finished if the audit policies match it.
+// The statements of the background and internal sessions are not audited.
+func auditStatement(ctx context.Context, ses *Session, stmt tree.Statement, err error) {
+	p := ses.auditPlan
+	ses.auditPlan = nil
+	if stmt == nil || ses.IsBackgroundSession() || ses.GetIsInternal() {
+		return
+	}
+	tenant := ses.GetTenantInfo()
+	policies := getAuditPolicies()
+	if tenant == nil || len(policies) == 0 {
+		return
+	}
+	if st, ok := stmt.(*tree.Execute); ok {
+		if prepareStmt, e := ses.GetPrepareStmt(string(st.Name)); e == nil {
+			stmt = prepareStmt.PrepareStmt
+		}
+	}
+	event, ok := auditEventOfStatement(stmt)
+	if !ok {
+		return
+	}
+	var objects []auditObject
+	if event == tree.AuditEventDML || event == tree.AuditEventDQL {
+		if p != nil {
+			objects = getAuditObjectsOfPlan(p)
+		}
+	} else {
+		objects = getAuditObjectsOfStatement(ses, stmt)
+	}
+	names := matchAuditPolicies(policies, tenant.GetTenant(), tenant.GetUser(), event, objects)
+	if len(names) == 0 {
+		return
+	}
+
+	r := newAuditRecord(ses, event, names, err)
+	r.StatementType = stmt.GetStatementType()
+	r.Digest = statementDigest(stmt)
+	for _, o := range objects {
+		r.Objects = append(r.Objects, o.String())
+	}
+	if stm := motrace.StatementFromContext(ctx); stm != nil {
+		r.StatementID = stm.StatementID
+	}
+	reportAuditRecord(ctx, ses, r)
+}
@@ -0,0 +1,345 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuditSes(tenant *TenantInfo) *Session {
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+	pu.SV.SetDefaultValues()
+	ses := NewSession(&FakeProtocol{database: "db1"}, nil, pu, GSysVariables, false)
+	ses.SetTenantInfo(tenant)
+	ses.SetRequestContext(context.WithValue(context.TODO(), config.ParameterUnitKey, pu))
+	return ses
+}
+
+func newAuditTenant(accountId uint32, account, user string) *TenantInfo {
+	return &TenantInfo{
+		Tenant:      account,
+		TenantID:    accountId,
+		User:        user,
+		DefaultRole: accountAdminRoleName,
+	}
+}
+
+func TestAuditPolicyMatch(t *testing.T) {
+	tables := []auditObject{{typ: auditObjectTable, db: "db1", name: "t1"}}
+	tests := []struct {
+		policy  auditPolicy
+		account string
+		user    string
+		event   tree.AuditEvent
+		objects []auditObject
+		want    bool
+	}{
+		// the policy of the sys account audits all the accounts
+		{auditPolicy{accountId: sysAccountID, accountName: sysAccountName, events: []tree.AuditEvent{tree.AuditEventDDL}},
+			"acc1", "u1", tree.AuditEventDDL, nil, true},
+		{auditPolicy{accountId: sysAccountID, accountName: sysAccountName, events: []tree.AuditEvent{tree.AuditEventDDL}, accounts: []string{"acc2"}},
+			"acc1", "u1", tree.AuditEventDDL, nil, false},
+		// the policy of the other account audits itself only
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventLogin}},
+			"acc1", "u1", tree.AuditEventLogin, nil, true},
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventLogin}},
+			"acc2", "u1", tree.AuditEventLogin, nil, false},
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventDML}},
+			"acc1", "u1", tree.AuditEventDQL, tables, false},
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventDML}, users: []string{"u2"}},
+			"acc1", "u1", tree.AuditEventDML, tables, false},
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventDML}, database: "db1"},
+			"acc1", "u1", tree.AuditEventDML, tables, true},
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventDML}, database: "db1", table: "t2"},
+			"acc1", "u1", tree.AuditEventDML, tables, false},
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventLogin}, database: "db1"},
+			"acc1", "u1", tree.AuditEventLogin, nil, false},
+		// the database dropped touches all the tables in it
+		{auditPolicy{accountId: 1, accountName: "acc1", events: []tree.AuditEvent{tree.AuditEventDDL}, database: "db1", table: "t2"},
+			"acc1", "u1", tree.AuditEventDDL, []auditObject{{typ: auditObjectDatabase, db: "db1"}}, true},
+	}
+	for i, tt := range tests {
+		require.Equal(t, tt.want, tt.policy.match(tt.account, tt.user, tt.event, tt.objects), "case %d", i)
+	}
+}
+
+func TestNewAuditPolicy(t *testing.T) {
+	ctx := context.TODO()
+	ses := newAuditSes(newAuditTenant(1, "acc1", "admin"))
+
+	p, err := newAuditPolicy(ctx, ses, &tree.CreateAuditPolicy{
+		Name:   "p1",
+		Events: []tree.AuditEvent{tree.AuditEventAll, tree.AuditEventDDL},
+		Object: &tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_TABLE, TabName: "t1"},
+		Users:  []*tree.User{{Username: "u1"}, {Username: "u1"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []tree.AuditEvent{tree.AuditEventLogin, tree.AuditEventDDL, tree.AuditEventDCL,
+		tree.AuditEventDML, tree.AuditEventDQL}, p.events)
+	require.Equal(t, "db1.t1", p.objectString())
+	require.Equal(t, []string{"u1"}, p.users)
+
+	// only the sys account can audit the other accounts
+	_, err = newAuditPolicy(ctx, ses, &tree.CreateAuditPolicy{
+		Name:     "p1",
+		Events:   []tree.AuditEvent{tree.AuditEventDDL},
+		Accounts: tree.IdentifierList{"acc2"},
+	})
+	require.Error(t, err)
+}
+
+func TestGetAuditObjectsOfStatement(t *testing.T) {
+	ses := newAuditSes(newAuditTenant(1, "acc1", "admin"))
+	tests := []struct {
+		sql  string
+		want []string
+	}{
+		{"create table t1 (a int)", []string{"db1.t1"}},
+		{"drop table db2.t1, t2", []string{"db2.t1", "db1.t2"}},
+		{"drop database db2", []string{"db2"}},
+		{"create user u1 identified by '123'", []string{"user u1"}},
+		{"grant select on table db2.* to r1", []string{"db2", "role r1"}},
+		{"grant r1 to u1", []string{"role r1", "user u1"}},
+		{"drop audit policy p1", []string{"audit policy p1"}},
+	}
+	for _, tt := range tests {
+		stmt, err := mysql.ParseOne(context.TODO(), tt.sql, 1)
+		require.NoError(t, err)
+		var got []string
+		for _, o := range getAuditObjectsOfStatement(ses, stmt) {
+			got = append(got, o.String())
+		}
+		require.Equal(t, tt.want, got, tt.sql)
+	}
+}
+
+func TestAuditStatement(t *testing.T) {
+	var records []*motrace.AuditRecord
+	stubs := gostub.Stub(&motrace.ReportAudit, func(_ context.Context, r *motrace.AuditRecord) error {
+		records = append(records, r)
+		return nil
+	})
+	defer stubs.Reset()
+	setAuditPolicies([]*auditPolicy{
+		{accountId: 1, accountName: "acc1", name: "p1", events: []tree.AuditEvent{tree.AuditEventLogin, tree.AuditEventDCL}},
+		{accountId: 1, accountName: "acc1", name: "p2", events: []tree.AuditEvent{tree.AuditEventDML}, database: "db1", table: "t1"},
+	})
+	defer setAuditPolicies(nil)
+
+	ctx := context.TODO()
+	ses := newAuditSes(newAuditTenant(1, "acc1", "u1"))
+
+	auditLogin(ctx, ses, moerr.NewInternalError(ctx, "check password failed"))
+	require.Equal(t, 1, len(records))
+	require.Equal(t, "login", records[0].Event)
+	require.Equal(t, []string{"acc1.p1"}, records[0].Policies)
+	require.Equal(t, uint16(moerr.ErrInternal), records[0].ResultCode)
+
+	stmt, err := mysql.ParseOne(ctx, "create user u2 identified by '123'", 1)
+	require.NoError(t, err)
+	auditStatement(ctx, ses, stmt, nil)
+	require.Equal(t, 2, len(records))
+	require.Equal(t, "dcl", records[1].Event)
+	require.Equal(t, "Create User", records[1].StatementType)
+	require.Equal(t, []string{"user u2"}, records[1].Objects)
+	require.Equal(t, uint16(0), records[1].ResultCode)
+	require.Equal(t, 64, len(records[1].Digest))
+	require.False(t, strings.Contains(records[1].Digest, "123"))
+
+	// the tables of the DML are in the plan
+	stmt, err = mysql.ParseOne(ctx, "insert into t1 values (1)", 1)
+	require.NoError(t, err)
+	ses.auditPlan = &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{
+		Nodes: []*plan.Node{{NodeType: plan.Node_INSERT, ObjRef: &plan.ObjectRef{SchemaName: "db1", ObjName: "t1"}}},
+	}}}
+	auditStatement(ctx, ses, stmt, nil)
+	require.Equal(t, 3, len(records))
+	require.Equal(t, []string{"db1.t1"}, records[2].Objects)
+	require.Equal(t, []string{"acc1.p2"}, records[2].Policies)
+	require.Nil(t, ses.auditPlan)
+
+	// the plan is cleared after the statement, the tables are unknown
+	auditStatement(ctx, ses, stmt, nil)
+	require.Equal(t, 3, len(records))
+
+	// the queries are not audited by the policies
+	stmt, err = mysql.ParseOne(ctx, "select * from t1", 1)
+	require.NoError(t, err)
+	auditStatement(ctx, ses, stmt, nil)
+	require.Equal(t, 3, len(records))
+}
+
+type auditBackgroundExecTest struct {
+	backgroundExecTest
+	sqls []string
+}
+
+func (bt *auditBackgroundExecTest) Exec(ctx context.Context, s string) error {
+	bt.sqls = append(bt.sqls, s)
+	return bt.backgroundExecTest.Exec(ctx, s)
+}
+
+func TestResumeAuditChain(t *testing.T) {
+	bh := &backgroundExecTest{}
+	bh.init()
+	mrs := &MysqlResultSet{}
+	for i, name := range []string{"seq", "hash"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		if i == 0 {
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		}
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{uint64(10), "hash10"})
+	bh.sql2result[fmt.Sprintf(getLastAuditRecordFormat, quoteString(motrace.GetNodeResource().NodeUuid))] = mrs
+	defer motrace.ResumeAuditChain(0, "")
+
+	ctx := context.TODO()
+	require.NoError(t, resumeAuditChain(ctx, bh))
+	r := &motrace.AuditRecord{}
+	require.NoError(t, motrace.ReportAudit(ctx, r))
+	require.Equal(t, uint64(11), r.Seq)
+	require.Equal(t, "hash10", r.PrevHash)
+}
+
+type failedBackgroundExecTest struct {
+	backgroundExecTest
+	failed map[string]error
+}
+
+func (bt *failedBackgroundExecTest) Exec(ctx context.Context, s string) error {
+	if err, ok := bt.failed[s]; ok {
+		return err
+	}
+	return bt.backgroundExecTest.Exec(ctx, s)
+}
+
+func TestStartAuditPoliciesWithoutResume(t *testing.T) {
+	bh := &failedBackgroundExecTest{}
+	bh.init()
+	bh.failed = map[string]error{
+		fmt.Sprintf(getLastAuditRecordFormat, quoteString(motrace.GetNodeResource().NodeUuid)): moerr.NewInternalErrorNoCtx("txn closed"),
+	}
+	mrs := &MysqlResultSet{}
+	for i, name := range []string{"account_id", "account_name", "policy_name", "event_types",
+		"object_database", "object_table", "target_accounts", "target_users"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		if i == 0 {
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		}
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{int64(1), "acc1", "p1", "ddl", "db1", "", `[]`, `[]`})
+	bh.sql2result[getAuditPoliciesFormat] = mrs
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+	defer setAuditPolicies(nil)
+
+	var records []*motrace.AuditRecord
+	report := motrace.ReportAudit
+	reportStub := gostub.Stub(&motrace.ReportAudit, func(ctx context.Context, r *motrace.AuditRecord) error {
+		records = append(records, r)
+		return report(ctx, r)
+	})
+	defer reportStub.Reset()
+	defer motrace.ResumeAuditChain(0, "")
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+	require.NoError(t, StartAuditPolicies(ctx, pu, defines.AutoIncrCaches{}))
+
+	// a new segment of the chain is started, and the policies are loaded
+	require.Equal(t, 1, len(records))
+	require.Equal(t, motrace.AuditEventNewSegment, records[0].Event)
+	require.Equal(t, "", records[0].PrevHash)
+	require.Contains(t, records[0].Error, "txn closed")
+	require.Eventually(t, func() bool {
+		return len(getAuditPolicies()) == 1
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestDoCreateAuditPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &auditBackgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+	defer setAuditPolicies(nil)
+
+	mrs := &MysqlResultSet{}
+	for i, name := range []string{"account_id", "account_name", "policy_name", "event_types",
+		"object_database", "object_table", "target_accounts", "target_users"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		if i == 0 {
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		}
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{int64(1), "acc1", "p1", "ddl,dcl", "db1", "", `[]`, `["u1"]`})
+	bh.sql2result[getAuditPoliciesFormat] = mrs
+	getSql := fmt.Sprintf(getAuditPolicyFormat, 1, quoteString("p1"))
+	bh.sql2result[getSql] = newMrsForSqlForShowDatabases(nil)
+
+	ctx := context.TODO()
+	ses := newAuditSes(newAuditTenant(1, "acc1", "admin"))
+	stmt, err := mysql.ParseOne(ctx, "create audit policy p1 for ddl, dcl on db1.* user u1", 1)
+	require.NoError(t, err)
+	err = doCreateAuditPolicy(ctx, ses, stmt.(*tree.CreateAuditPolicy))
+	require.NoError(t, err)
+
+	var inserted string
+	for _, sql := range bh.sqls {
+		if strings.HasPrefix(sql, "insert into mo_catalog.mo_audit_policies") {
+			inserted = sql
+		}
+	}
+	require.Contains(t, inserted, "values (1, 'acc1', 'p1', 'ddl,dcl', 'db1', '', '[]', '[\"u1\"]'")
+
+	// the policy takes effect at once
+	policies := getAuditPolicies()
+	require.Equal(t, 1, len(policies))
+	require.Equal(t, "db1.*", policies[0].objectString())
+	require.Equal(t, []tree.AuditEvent{tree.AuditEventDDL, tree.AuditEventDCL}, policies[0].events)
+	require.Equal(t, []string{"u1"}, policies[0].users)
+
+	// the policy exists
+	bh.sql2result[getSql] = newMrsForSqlForShowDatabases([][]interface{}{{"p1"}})
+	err = doCreateAuditPolicy(ctx, ses, stmt.(*tree.CreateAuditPolicy))
+	require.Error(t, err)
+}
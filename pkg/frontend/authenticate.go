@@ -17,11 +17,14 @@ package frontend
 import (
 	"bytes"
 	"context"
+	crand "crypto/rand"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"math"
 	"math/bits"
 	"os"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -301,6 +304,161 @@ func getSpecialUser() *initUser {
 	return value.(*initUser)
 }
 
+const (
+	// passwordHashPrefix is the prefix of the SHA-256 based password hash stored in the
+	// authentication_string of the mo_user. The format is the same as the one used by the
+	// caching_sha2_password of the mysql 8.0:
+	// $A$<rounds/1000 in 3 hex digits>$<salt 20 bytes><hash 43 bytes>
+	passwordHashPrefix = "$A$"
+	// passwordHashRounds is the number of the rounds of the sha256crypt
+	passwordHashRounds = 5000
+	// passwordHashSaltLength is the length of the salt of the password hash
+	passwordHashSaltLength = 20
+	// passwordHashDigestLength is the length of the encoded sha256crypt digest
+	passwordHashDigestLength = 43
+	// passwordHashLength is the length of the whole password hash
+	passwordHashLength = len(passwordHashPrefix) + 4 + passwordHashSaltLength + passwordHashDigestLength
+)
+
+// sha256CryptAlphabet is the alphabet of the base64 encoding used by the sha256crypt.
+// The salt is generated from it also, so that the hash can be put into the sql safely.
+const sha256CryptAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// generatePasswordHash makes the SHA-256 based hash of the password with a random salt.
+// The empty password is stored as it is.
+func generatePasswordHash(password string) (string, error) {
+	if len(password) == 0 {
+		return "", nil
+	}
+	salt := make([]byte, passwordHashSaltLength)
+	if _, err := crand.Read(salt); err != nil {
+		return "", err
+	}
+	for i := range salt {
+		salt[i] = sha256CryptAlphabet[int(salt[i])%len(sha256CryptAlphabet)]
+	}
+	return makePasswordHash([]byte(password), salt, passwordHashRounds), nil
+}
+
+// makePasswordHash makes the password hash with the designated salt and rounds
+func makePasswordHash(password, salt []byte, rounds int) string {
+	return fmt.Sprintf("%s%03X$%s%s", passwordHashPrefix, rounds/1000, salt, sha256Crypt(password, salt, rounds))
+}
+
+// isPasswordHash checks the authentication_string is the SHA-256 based password hash.
+// Otherwise, it is the password in plain text which is saved by the old version.
+func isPasswordHash(authString []byte) bool {
+	return len(authString) == passwordHashLength &&
+		bytes.HasPrefix(authString, []byte(passwordHashPrefix)) &&
+		authString[len(passwordHashPrefix)+3] == '$'
+}
+
+// checkPasswordHash checks the password in plain text with the authentication_string
+func checkPasswordHash(password, authString []byte) bool {
+	if !isPasswordHash(authString) {
+		return bytes.Equal(password, authString)
+	}
+	pos := len(passwordHashPrefix)
+	rounds, err := strconv.ParseUint(string(authString[pos:pos+3]), 16, 32)
+	if err != nil {
+		return false
+	}
+	pos += 4
+	salt := authString[pos : pos+passwordHashSaltLength]
+	return makePasswordHash(password, salt, int(rounds)*1000) == string(authString)
+}
+
+// sha256Crypt implements the SHA-256 based crypt designed by Ulrich Drepper.
+// Reference to https://www.akkadia.org/drepper/SHA-crypt.txt
+func sha256Crypt(password, salt []byte, rounds int) []byte {
+	//digest B = SHA256(password + salt + password)
+	h := sha256.New()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(password)
+	digestB := h.Sum(nil)
+
+	//digest A
+	h.Reset()
+	h.Write(password)
+	h.Write(salt)
+	h.Write(repeatBytes(digestB, len(password)))
+	for n := len(password); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			h.Write(digestB)
+		} else {
+			h.Write(password)
+		}
+	}
+	digestA := h.Sum(nil)
+
+	//byte sequence P from digest DP = SHA256(password repeated len(password) times)
+	h.Reset()
+	for i := 0; i < len(password); i++ {
+		h.Write(password)
+	}
+	seqP := repeatBytes(h.Sum(nil), len(password))
+
+	//byte sequence S from digest DS = SHA256(salt repeated 16 + A[0] times)
+	h.Reset()
+	for i := 0; i < 16+int(digestA[0]); i++ {
+		h.Write(salt)
+	}
+	seqS := repeatBytes(h.Sum(nil), len(salt))
+
+	digestC := digestA
+	for i := 0; i < rounds; i++ {
+		h.Reset()
+		if i&1 != 0 {
+			h.Write(seqP)
+		} else {
+			h.Write(digestC)
+		}
+		if i%3 != 0 {
+			h.Write(seqS)
+		}
+		if i%7 != 0 {
+			h.Write(seqP)
+		}
+		if i&1 != 0 {
+			h.Write(digestC)
+		} else {
+			h.Write(seqP)
+		}
+		digestC = h.Sum(digestC[:0])
+	}
+
+	//the bytes are encoded in groups of three with the order of the sha256crypt
+	result := make([]byte, 0, passwordHashDigestLength)
+	encode := func(b2, b1, b0 byte, n int) {
+		w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+		for ; n > 0; n-- {
+			result = append(result, sha256CryptAlphabet[w&0x3f])
+			w >>= 6
+		}
+	}
+	for i := 0; i < 10; i++ {
+		a, b, c := i, i+10, i+20
+		if i%3 == 1 {
+			a, b, c = c, a, b
+		} else if i%3 == 2 {
+			a, b, c = b, c, a
+		}
+		encode(digestC[a], digestC[b], digestC[c], 4)
+	}
+	encode(0, digestC[31], digestC[30], 3)
+	return result
+}
+
+// repeatBytes makes a byte sequence of the length n with the data repeated
+func repeatBytes(data []byte, n int) []byte {
+	result := make([]byte, 0, n)
+	for len(result) < n {
+		result = append(result, data[:Min(len(data), n-len(result))]...)
+	}
+	return result
+}
+
 const (
 // createMoUserIndex      = 0
 // createMoAccountIndex = 1
@@ -709,6 +867,12 @@ var (
 		"mo_role_privs":              0,
 		"mo_user_defined_function":   0,
 		"mo_mysql_compatbility_mode": 0,
+		"mo_column_stats":            0,
+		"mo_changefeeds":             0,
+		"mo_audit_policies":          0,
+		"mo_column_privs":            0,
+		"mo_row_policies":            0,
+		"mo_account_leases":          0,
 		catalog.AutoIncrTableName:    0,
 	}
 	//predefined tables of the database mo_catalog in every account
@@ -724,6 +888,12 @@ var (
 		"mo_role_privs":              0,
 		"mo_user_defined_function":   0,
 		"mo_mysql_compatbility_mode": 0,
+		"mo_column_stats":            0,
+		"mo_changefeeds":             0,
+		"mo_audit_policies":          0,
+		"mo_column_privs":            0,
+		"mo_row_policies":            0,
+		"mo_account_leases":          0,
 		catalog.AutoIncrTableName:    0,
 	}
 	createAutoTableSql = fmt.Sprintf("create table `%s`(name varchar(770) primary key, offset bigint unsigned, step bigint unsigned);", catalog.AutoIncrTableName)
@@ -749,7 +919,10 @@ var (
 				status varchar(300),
 				created_time timestamp,
 				comments varchar(256),
-				suspended_time timestamp default NULL
+				suspended_time timestamp default NULL,
+				max_storage_size bigint unsigned default 0,
+				max_connections bigint unsigned default 0,
+				max_concurrent_queries bigint unsigned default 0
 			);`,
 		`create table mo_role(
 				role_id int signed auto_increment,
@@ -811,6 +984,81 @@ var (
 				configuration  json,
 				primary key(configuration_id)
 			);`,
+		`create table mo_column_stats(
+				table_id bigint unsigned,
+				database_name varchar(5000),
+				table_name varchar(5000),
+				column_name varchar(256),
+				table_rows bigint unsigned,
+				ndv bigint unsigned,
+				null_count bigint unsigned,
+				histogram text,
+				top_n text,
+				update_time timestamp,
+				primary key(table_id, column_name)
+			);`,
+		`create table mo_changefeeds(
+				feed_name varchar(300),
+				database_name varchar(5000),
+				table_name varchar(5000),
+				database_id bigint unsigned,
+				table_id bigint unsigned,
+				columns text,
+				primary_keys text,
+				sink_uri varchar(5000),
+				status varchar(16),
+				cn_id varchar(64),
+				checkpoint varchar(64),
+				created_time timestamp,
+				primary key(feed_name)
+			);`,
+		`create table mo_audit_policies(
+				account_id int unsigned,
+				account_name varchar(300),
+				policy_name varchar(300),
+				event_types varchar(100),
+				object_database varchar(5000),
+				object_table varchar(5000),
+				target_accounts text,
+				target_users text,
+				creator int unsigned,
+				created_time timestamp,
+				primary key(account_id, policy_name)
+			);`,
+		`create table mo_column_privs(
+				role_id int signed,
+				role_name varchar(100),
+				obj_id bigint unsigned,
+				dat_name varchar(5000),
+				table_name varchar(5000),
+				column_name varchar(256),
+				privilege_id int,
+				privilege_name varchar(100),
+				operation_user_id int unsigned,
+				granted_time timestamp,
+				with_grant_option bool,
+				primary key(role_id, obj_id, column_name, privilege_id)
+			);`,
+		`create table mo_row_policies(
+				policy_name varchar(300),
+				obj_id bigint unsigned,
+				dat_name varchar(5000),
+				table_name varchar(5000),
+				command varchar(16),
+				roles text,
+				using_expr text,
+				creator int unsigned,
+				created_time timestamp,
+				primary key(obj_id, policy_name)
+			);`,
+		`create table mo_account_leases(
+				account_id int unsigned,
+				kind varchar(16),
+				cn_id varchar(64),
+				slots bigint unsigned,
+				expired_time timestamp,
+				primary key(account_id, kind, cn_id)
+			);`,
 	}
 
 	//drop tables for the tenant
@@ -822,6 +1070,11 @@ var (
 		`drop table if exists mo_catalog.mo_role_privs;`,
 		`drop table if exists mo_catalog.mo_user_defined_function;`,
 		`drop table if exists mo_catalog.mo_mysql_compatbility_mode;`,
+		`drop table if exists mo_catalog.mo_column_stats;`,
+		`drop table if exists mo_catalog.mo_changefeeds;`,
+		`drop table if exists mo_catalog.mo_column_privs;`,
+		`drop table if exists mo_catalog.mo_row_policies;`,
+		`drop table if exists mo_catalog.mo_account_leases;`,
 		fmt.Sprintf("drop table if exists mo_catalog.`%s`;", catalog.AutoIncrTableName),
 	}
 
@@ -920,7 +1173,7 @@ var (
 
 const (
 	//privilege verification
-	checkTenantFormat = `select account_id,account_name,status,suspended_time from mo_catalog.mo_account where account_name = "%s";`
+	checkTenantFormat = `select account_id,account_name,status,suspended_time,max_storage_size,max_connections,max_concurrent_queries from mo_catalog.mo_account where account_name = "%s";`
 
 	updateCommentsOfAccountFormat = `update mo_catalog.mo_account set comments = "%s" where account_name = "%s";`
 
@@ -1151,6 +1404,8 @@ const (
 
 	deleteRoleFromMoRolePrivsFormat = `delete from mo_catalog.mo_role_privs where role_id = %d;`
 
+	deleteRoleFromMoColumnPrivsFormat = `delete from mo_catalog.mo_column_privs where role_id = %d;`
+
 	//delete user from mo_user,mo_user_grant
 	deleteUserFromMoUserFormat = `delete from mo_catalog.mo_user where user_id = %d;`
 
@@ -1497,6 +1752,7 @@ func getSqlForDeleteRole(roleId int64) []string {
 		fmt.Sprintf(deleteRoleFromMoUserGrantFormat, roleId),
 		fmt.Sprintf(deleteRoleFromMoRoleGrantFormat, roleId, roleId),
 		fmt.Sprintf(deleteRoleFromMoRolePrivsFormat, roleId),
+		fmt.Sprintf(deleteRoleFromMoColumnPrivsFormat, roleId),
 	}
 }
 
@@ -2142,6 +2398,7 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 	var erArray []ExecResult
 	var targetAccountId uint64
 	var accountExist bool
+	var passwordHash string
 	account := ses.GetTenantInfo()
 	if !(account.IsSysTenant() && account.IsMoAdminRole()) {
 		return moerr.NewInternalError(ctx, "tenant %s user %s role %s do not have the privilege to alter the account",
@@ -2158,6 +2415,9 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 	if aa.Comment.Exist {
 		optionBits |= 1 << 2
 	}
+	if aa.Quota.Exist {
+		optionBits |= 1 << 3
+	}
 	optionCount := bits.OnesCount8(optionBits)
 	if optionCount == 0 {
 		return moerr.NewInternalError(ctx, "at least one option at a time")
@@ -2194,6 +2454,13 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 		}
 	}
 
+	if aa.Quota.Exist {
+		err = checkAccountQuota(ctx, &aa.Quota)
+		if err != nil {
+			return err
+		}
+	}
+
 	bh := ses.GetBackgroundExec(ctx)
 	defer bh.Close()
 
@@ -2265,7 +2532,11 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 			}
 
 			//2, update the password
-			sql, err = getSqlForUpdatePasswordOfUser(ctx, aa.AuthOption.IdentifiedType.Str, aa.AuthOption.AdminName)
+			passwordHash, err = generatePasswordHash(aa.AuthOption.IdentifiedType.Str)
+			if err != nil {
+				goto handleFailed
+			}
+			sql, err = getSqlForUpdatePasswordOfUser(ctx, passwordHash, aa.AuthOption.AdminName)
 			if err != nil {
 				goto handleFailed
 			}
@@ -2301,12 +2572,34 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 				goto handleFailed
 			}
 		}
+
+		//Option 4: change the quotas of the account
+		if aa.Quota.Exist {
+			sql, err = getSqlForUpdateQuotaOfAccount(ctx, &aa.Quota, aa.Name)
+			if err != nil {
+				goto handleFailed
+			}
+			bh.ClearExecResultSet()
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				goto handleFailed
+			}
+		}
 	}
 
 	err = bh.Exec(ctx, "commit;")
 	if err != nil {
 		goto handleFailed
 	}
+
+	//the new quotas take effect on this cn at once, and on the other cns
+	//when they lease the slots of the account, at the next login of the
+	//account or when its storage size is measured.
+	if accountExist && aa.Quota.Exist {
+		if au := ses.getAccountUsages(); au != nil {
+			au.updateQuota(uint32(targetAccountId), aa.Quota.Options)
+		}
+	}
 	return err
 handleFailed:
 	//ROLLBACK the transaction
@@ -2486,7 +2779,9 @@ func doDropAccount(ctx context.Context, ses *Session, da *tree.DropAccount) erro
 		//step 6 : drop table mo_role_privs
 		//step 7 : drop table mo_user_defined_function
 		//step 8 : drop table mo_mysql_compatbility_mode
-		//step 9 : drop table %!%mo_increment_columns
+		//step 9 : drop table mo_column_stats
+		//step 10 : drop table mo_changefeeds
+		//step 11 : drop table %!%mo_increment_columns
 		for _, sql = range getSqlForDropAccount() {
 			err = bh.Exec(deleteCtx, sql)
 			if err != nil {
@@ -2599,6 +2894,12 @@ func doDropAccount(ctx context.Context, ses *Session, da *tree.DropAccount) erro
 		goto handleFailed
 	}
 
+	//step5: delete the audit policies of the account
+	err = bh.Exec(ctx, fmt.Sprintf(deleteAuditPoliciesOfAccountFormat, accountId))
+	if err != nil {
+		goto handleFailed
+	}
+
 	err = bh.Exec(ctx, "commit;")
 	if err != nil {
 		goto handleFailed
@@ -2963,6 +3264,12 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 		if err != nil {
 			goto handleFailed
 		}
+		if len(priv.ColumnList) != 0 {
+			err = checkColumnPrivilegeType(ctx, privType, rp.ObjType, *rp.Level)
+			if err != nil {
+				goto handleFailed
+			}
+		}
 		checkedPrivilegeTypes[i] = privType
 	}
 
@@ -2973,7 +3280,16 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 	}
 
 	//step 3: delete the granted privilege
-	for _, privType = range checkedPrivilegeTypes {
+	for i := range checkedPrivilegeTypes {
+		privType = checkedPrivilegeTypes[i]
+		//the privilege on the columns
+		if len(rp.Privileges[i].ColumnList) != 0 {
+			err = revokeColumnPrivilege(ctx, bh, rp.Privileges[i], privType, verifiedRoles, objId)
+			if err != nil {
+				goto handleFailed
+			}
+			continue
+		}
 		for _, role := range verifiedRoles {
 			if role == nil {
 				continue
@@ -2995,6 +3311,7 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 	if err != nil {
 		goto handleFailed
 	}
+	ses.tableSecurity.invalidate()
 
 	return err
 
@@ -3265,6 +3582,12 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 		if err != nil {
 			goto handleFailed
 		}
+		if len(priv.ColumnList) != 0 {
+			err = checkColumnPrivilegeType(ctx, privType, gp.ObjType, *gp.Level)
+			if err != nil {
+				goto handleFailed
+			}
+		}
 		checkedPrivilegeTypes[i] = privType
 	}
 
@@ -3279,7 +3602,16 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 	//step 5: check exists
 	//step 6: update or insert
 
-	for _, privType = range checkedPrivilegeTypes {
+	for i := range checkedPrivilegeTypes {
+		privType = checkedPrivilegeTypes[i]
+		//the privilege on the columns
+		if len(gp.Privileges[i].ColumnList) != 0 {
+			err = grantColumnPrivilege(ctx, bh, ses, gp, gp.Privileges[i], privType, verifiedRoles, objId)
+			if err != nil {
+				goto handleFailed
+			}
+			continue
+		}
 		for _, role := range verifiedRoles {
 			sql := getSqlForCheckRoleHasPrivilege(role.id, objType, objId, int64(privType))
 			//check exists
@@ -3331,6 +3663,7 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 	if err != nil {
 		goto handleFailed
 	}
+	ses.tableSecurity.invalidate()
 
 	return err
 handleFailed:
@@ -3890,6 +4223,11 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if st.Name != nil {
 			dbName = string(st.Name.SchemaName)
 		}
+	case *tree.AlterTable:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Table.SchemaName)
 	case *tree.AlterDataBaseConfig:
 		objType = objectTypeNone
 		kind = privilegeKindNone
@@ -3957,10 +4295,31 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		objType = objectTypeNone
 		kind = privilegeKindSpecial
 		special = specialTagAdmin
+	case *tree.CreateChangefeed, *tree.DropChangefeed:
+		// the privilege of reading the table is checked when the changefeed is created
+		objType = objectTypeNone
+		kind = privilegeKindSpecial
+		special = specialTagAdmin
+	case *tree.ShowChangefeeds:
+		objType = objectTypeNone
+		kind = privilegeKindNone
+	case *tree.CreateAuditPolicy, *tree.DropAuditPolicy, *tree.ShowAuditPolicies:
+		objType = objectTypeNone
+		kind = privilegeKindSpecial
+		special = specialTagAdmin
+	case *tree.CreatePolicy, *tree.DropPolicy:
+		objType = objectTypeNone
+		kind = privilegeKindSpecial
+		special = specialTagAdmin
 	case *tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
 		objType = objectTypeNone
 		kind = privilegeKindNone
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction, *tree.SetVar:
+	case *tree.AnalyzeStmt:
+		// the privilege of reading the table is checked when the statistics are collected
+		objType = objectTypeNone
+		kind = privilegeKindNone
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction, *tree.SetVar,
+		*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 		objType = objectTypeNone
 		kind = privilegeKindNone
 	case *tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
@@ -4921,7 +5280,24 @@ func authenticateUserCanExecuteStatementWithObjectTypeDatabaseAndTable(ctx conte
 		if err != nil {
 			return false, err
 		}
-		return ok, nil
+		if ok || !needTableSecurity(ses) {
+			return ok, nil
+		}
+		//the columns the statement selects or updates have been checked
+		//with the privileges on the columns when the plan is built.
+		left, err := removePrivilegeTipsOfColumnPrivileges(ctx, ses, arr)
+		if err != nil {
+			return false, err
+		}
+		if len(left) == 0 {
+			return true, nil
+		}
+		if len(left) == len(arr) {
+			return false, nil
+		}
+		priv = determinePrivilegeSetOfStatement(stmt)
+		convertPrivilegeTipsToPrivilege(priv, left)
+		return determineUserHasPrivilegeSet(ctx, ses, priv, stmt)
 	}
 	return true, nil
 }
@@ -5278,6 +5654,15 @@ func authenticateUserCanExecuteStatementWithObjectTypeNone(ctx context.Context,
 			return checkRevokePrivilege()
 		case *tree.ShowAccounts:
 			return checkShowAccountsPrivilege()
+		case *tree.CreateChangefeed, *tree.DropChangefeed:
+			//only the moAdmin and accountAdmin can write the changes of the tables into the sinks.
+			return tenant.IsAdminRole(), nil
+		case *tree.CreateAuditPolicy, *tree.DropAuditPolicy, *tree.ShowAuditPolicies:
+			//only the moAdmin and accountAdmin can audit the users of the account.
+			return tenant.IsAdminRole(), nil
+		case *tree.CreatePolicy, *tree.DropPolicy:
+			//only the moAdmin and accountAdmin can restrict the rows the roles access.
+			return tenant.IsAdminRole(), nil
 		}
 	}
 
@@ -5457,6 +5842,10 @@ func createTablesInMoCatalog(ctx context.Context, bh BackgroundExec, tenant *Ten
 	if d := os.Getenv(defaultPasswordEnv); d != "" {
 		defaultPassword = d
 	}
+	defaultPassword, err = generatePasswordHash(defaultPassword)
+	if err != nil {
+		return err
+	}
 
 	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, defaultPassword, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID)
 	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, defaultPassword, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID)
@@ -5574,6 +5963,13 @@ func InitGeneralTenant(ctx context.Context, ses *Session, ca *tree.CreateAccount
 		return err
 	}
 
+	if ca.Quota.Exist {
+		err = checkAccountQuota(ctx, &ca.Quota)
+		if err != nil {
+			return err
+		}
+	}
+
 	ca.AuthOption.AdminName, err = normalizeName(ctx, ca.AuthOption.AdminName)
 	if err != nil {
 		return err
@@ -5731,6 +6127,19 @@ func createTablesInMoCatalogOfGeneralTenant(ctx context.Context, bh BackgroundEx
 		goto handleFailed
 	}
 
+	//step 1.1: set the quotas of the new tenant
+	if ca.Quota.Exist {
+		sql, err = getSqlForUpdateQuotaOfAccount(ctx, &ca.Quota, ca.Name)
+		if err != nil {
+			goto handleFailed
+		}
+		bh.ClearExecResultSet()
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+	}
+
 	//step2.Add new entries to the mo_mysql_compatbility_mode when create a new account
 	// configuration = fmt.Sprintf("'"+"{"+"%q"+":"+"%q"+"}"+"'", "version_compatibility", "0.7")
 	// sql = fmt.Sprintf(initMoMysqlCompatbilityModeFormat, ca.Name, moMysqlCompatbilityModeDefaultDb, configuration)
@@ -5763,8 +6172,9 @@ func createTablesInMoCatalogOfGeneralTenant2(tenant *TenantInfo, bh BackgroundEx
 	defer span.End()
 	//create tables for the tenant
 	for _, sql := range createSqls {
-		//only the SYS tenant has the table mo_account
-		if strings.HasPrefix(sql, "create table mo_account") {
+		//only the SYS tenant has the table mo_account and mo_audit_policies
+		if strings.HasPrefix(sql, "create table mo_account") ||
+			strings.HasPrefix(sql, "create table mo_audit_policies") {
 			continue
 		}
 		err = bh.Exec(newTenantCtx, sql)
@@ -5794,6 +6204,10 @@ func createTablesInMoCatalogOfGeneralTenant2(tenant *TenantInfo, bh BackgroundEx
 		err = moerr.NewInternalError(newTenantCtx, "password is empty string")
 		return err
 	}
+	password, err = generatePasswordHash(password)
+	if err != nil {
+		return err
+	}
 	status := rootStatus
 	//TODO: fix the status of user or account
 	if ca.StatusOption.Exist {
@@ -6097,6 +6511,10 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 			err = moerr.NewInternalError(ctx, "password is empty string")
 			goto handleFailed
 		}
+		password, err = generatePasswordHash(password)
+		if err != nil {
+			goto handleFailed
+		}
 
 		//TODO: get comment or attribute. there is no field in mo_user to store it.
 		host = user.Hostname
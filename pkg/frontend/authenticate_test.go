@@ -7235,3 +7235,34 @@ func Test_DropDatabaseOfAccount(t *testing.T) {
 		convey.So(has("mo_catalog"), convey.ShouldBeFalse)
 	})
 }
+
+func TestPasswordHash(t *testing.T) {
+	convey.Convey("sha256crypt", t, func() {
+		//the test vectors of the sha256crypt
+		convey.So(string(sha256Crypt([]byte("Hello world!"), []byte("saltstring"), 5000)), convey.ShouldEqual, "5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5")
+		convey.So(string(sha256Crypt([]byte("Hello world!"), []byte("saltstringsaltst"), 10000)), convey.ShouldEqual, "3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA")
+	})
+
+	convey.Convey("password hash", t, func() {
+		hash, err := generatePasswordHash("111")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(isPasswordHash([]byte(hash)), convey.ShouldBeTrue)
+		convey.So(strings.HasPrefix(hash, "$A$005$"), convey.ShouldBeTrue)
+		convey.So(checkPasswordHash([]byte("111"), []byte(hash)), convey.ShouldBeTrue)
+		convey.So(checkPasswordHash([]byte("112"), []byte(hash)), convey.ShouldBeFalse)
+
+		hash2, err := generatePasswordHash("111")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hash2, convey.ShouldNotEqual, hash)
+
+		hash, err = generatePasswordHash("")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(hash, convey.ShouldBeEmpty)
+	})
+
+	convey.Convey("password in plain text", t, func() {
+		convey.So(isPasswordHash([]byte("111")), convey.ShouldBeFalse)
+		convey.So(checkPasswordHash([]byte("111"), []byte("111")), convey.ShouldBeTrue)
+		convey.So(checkPasswordHash([]byte("112"), []byte("111")), convey.ShouldBeFalse)
+	})
+}
@@ -0,0 +1,520 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"go.uber.org/zap"
+)
+
+const (
+	changefeedStatusRunning = "running"
+
+	// the changefeeds of the cn are synchronized with mo_changefeeds in the interval,
+	// the changefeeds dropped on the other cn are stopped and the checkpoints are saved.
+	changefeedSyncInterval = 10 * time.Second
+)
+
+var (
+	getChangefeedFormat = `select feed_name from mo_catalog.mo_changefeeds where feed_name = %s;`
+
+	insertChangefeedFormat = `insert into mo_catalog.mo_changefeeds(
+			feed_name,
+			database_name,
+			table_name,
+			database_id,
+			table_id,
+			columns,
+			primary_keys,
+			sink_uri,
+			status,
+			cn_id,
+			checkpoint,
+			created_time) values (%s, %s, %s, %d, %d, %s, %s, %s, %s, %s, %s, %s);`
+
+	deleteChangefeedFormat = `delete from mo_catalog.mo_changefeeds where feed_name = %s;`
+
+	showChangefeedsFormat = `select feed_name, database_name, table_name, sink_uri, status, cn_id, checkpoint, created_time
+			from mo_catalog.mo_changefeeds %s order by feed_name;`
+
+	getChangefeedsOfCNFormat = `select feed_name, database_name, table_name, database_id, table_id, columns, primary_keys, sink_uri, checkpoint
+			from mo_catalog.mo_changefeeds where cn_id = %s and status = '` + changefeedStatusRunning + `';`
+
+	updateChangefeedCheckpointFormat = `update mo_catalog.mo_changefeeds set checkpoint = %s where feed_name = %s;`
+
+	getAccountsOfChangefeeds = `select account_id from mo_catalog.mo_account where status != 'suspend';`
+)
+
+// changefeeds are the changefeeds running on the cn.
+type changefeeds struct {
+	cnUUID  string
+	pu      *config.ParameterUnit
+	manager *cdc.Manager
+	newBh   func(context.Context) BackgroundExec
+}
+
+var gChangefeeds struct {
+	sync.RWMutex
+	feeds *changefeeds
+}
+
+// StartChangefeeds resumes the changefeeds running on the cn before it restarts, and
+// keeps the changefeeds synchronized with mo_changefeeds until the ctx is done.
+func StartChangefeeds(ctx context.Context, cnUUID string, pu *config.ParameterUnit, aicm defines.AutoIncrCaches) error {
+	mp, err := mpool.NewMPool("changefeed", 0, mpool.NoFixed)
+	if err != nil {
+		return err
+	}
+	feeds := &changefeeds{
+		cnUUID:  cnUUID,
+		pu:      pu,
+		manager: cdc.NewManager(ctx),
+		newBh: func(ctx context.Context) BackgroundExec {
+			return NewBackgroundHandler(ctx, mp, pu, aicm)
+		},
+	}
+	gChangefeeds.Lock()
+	gChangefeeds.feeds = feeds
+	gChangefeeds.Unlock()
+	go func() {
+		defer mpool.DeleteMPool(mp)
+		feeds.keep(ctx)
+	}()
+	return nil
+}
+
+func getChangefeeds(ctx context.Context) (*changefeeds, engine.ChangeSubscriber, error) {
+	gChangefeeds.RLock()
+	feeds := gChangefeeds.feeds
+	gChangefeeds.RUnlock()
+	if feeds == nil {
+		return nil, nil, moerr.NewNotSupported(ctx, "changefeed is not started on the cn")
+	}
+	subscriber, ok := feeds.pu.StorageEngine.(engine.ChangeSubscriber)
+	if !ok {
+		return nil, nil, moerr.NewNotSupported(ctx, "changefeed on the storage engine")
+	}
+	return feeds, subscriber, nil
+}
+
+// doCreateChangefeed starts the changefeed on the cn and records it in mo_changefeeds.
+func doCreateChangefeed(ctx context.Context, ses *Session, st *tree.CreateChangefeed) error {
+	feeds, subscriber, err := getChangefeeds(ctx)
+	if err != nil {
+		return err
+	}
+	name := string(st.Name)
+	if err = inputNameIsInvalid(ctx, name); err != nil {
+		return err
+	}
+
+	tcc := ses.GetTxnCompileCtx()
+	dbName, err := tcc.ensureDatabaseIsNotEmpty(string(st.Table.SchemaName))
+	if err != nil {
+		return err
+	}
+	tblName := string(st.Table.ObjectName)
+	if isBannedDatabase(dbName) {
+		return moerr.NewNotSupported(ctx, "changefeed for the table in the system database %s", dbName)
+	}
+	tblCtx, table, err := tcc.getRelation(dbName, tblName)
+	if err != nil {
+		return moerr.NewNoSuchTable(ctx, dbName, tblName)
+	}
+	_, tableDef := tcc.getTableDef(tblCtx, table, dbName, tblName)
+	if tableDef == nil {
+		return moerr.NewNoSuchTable(ctx, dbName, tblName)
+	}
+	if tableDef.TableType != catalog.SystemOrdinaryRel {
+		return moerr.NewNotSupported(ctx, "changefeed for table %s.%s which is not a base table", dbName, tblName)
+	}
+	txn, err := tcc.GetTxnHandler().GetTxn()
+	if err != nil {
+		return err
+	}
+	db, err := tcc.GetTxnHandler().GetStorage().Database(tblCtx, dbName, txn)
+	if err != nil {
+		return err
+	}
+	dbId, err := strconv.ParseUint(db.GetDatabaseId(tblCtx), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	spec := cdc.FeedSpec{
+		Name:         name,
+		AccountId:    ses.GetTenantInfo().GetTenantID(),
+		DatabaseName: dbName,
+		TableName:    tblName,
+		DatabaseId:   dbId,
+		TableId:      table.GetTableID(tblCtx),
+		SinkURI:      st.SinkURI,
+		StartTs:      txn.Txn().SnapshotTS,
+	}
+	for _, col := range tableDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		spec.Columns = append(spec.Columns, col.Name)
+		if col.Primary {
+			spec.PrimaryKeys = append(spec.PrimaryKeys, col.Name)
+		}
+	}
+	// the component columns of the composite primary key
+	if tableDef.Pkey != nil && len(tableDef.Pkey.Names) > 1 {
+		spec.PrimaryKeys = append(spec.PrimaryKeys, tableDef.Pkey.Names...)
+	}
+
+	// check the privilege of reading the columns by building the query
+	v, err := ses.GetGlobalVar("lower_case_table_names")
+	if err != nil {
+		return err
+	}
+	selectStmt, err := mysql.ParseOne(ctx, makeChangefeedSelectSql(dbName, tblName, spec.Columns), v.(int64))
+	if err != nil {
+		return err
+	}
+	if _, err = buildPlan(ctx, ses, tcc, selectStmt); err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	exists, err := changefeedExists(ctx, bh, name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if st.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "changefeed %s already exists", name)
+	}
+
+	if err = feeds.manager.Start(ctx, spec, subscriber); err != nil {
+		return err
+	}
+	columns, _ := json.Marshal(spec.Columns)
+	pks, _ := json.Marshal(spec.PrimaryKeys)
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(insertChangefeedFormat,
+		quoteString(name),
+		quoteString(dbName),
+		quoteString(tblName),
+		spec.DatabaseId,
+		spec.TableId,
+		quoteString(string(columns)),
+		quoteString(string(pks)),
+		quoteString(spec.SinkURI),
+		quoteString(changefeedStatusRunning),
+		quoteString(feeds.cnUUID),
+		quoteString(cdc.FormatCheckpoint(spec.StartTs)),
+		quoteString(time.Now().UTC().Format("2006-01-02 15:04:05")),
+	))
+	if err != nil {
+		if e := feeds.manager.Stop(spec.AccountId, name); e != nil {
+			logutil.Error("stop the changefeed failed", zap.String("name", name), zap.Error(e))
+		}
+		return err
+	}
+	return nil
+}
+
+func makeChangefeedSelectSql(dbName, tblName string, cols []string) string {
+	quoted := make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = quoteIdentifier(col)
+	}
+	return fmt.Sprintf("select %s from %s.%s", strings.Join(quoted, ", "),
+		quoteIdentifier(dbName), quoteIdentifier(tblName))
+}
+
+func changefeedExists(ctx context.Context, bh BackgroundExec, name string) (bool, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getChangefeedFormat, quoteString(name))); err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+// doDropChangefeed removes the changefeed from mo_changefeeds, the changefeed running
+// on the other cn is stopped when the cn synchronizes the changefeeds.
+func doDropChangefeed(ctx context.Context, ses *Session, st *tree.DropChangefeed) error {
+	name := string(st.Name)
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	exists, err := changefeedExists(ctx, bh, name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if st.IfExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "changefeed %s does not exist", name)
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(deleteChangefeedFormat, quoteString(name))); err != nil {
+		return err
+	}
+
+	gChangefeeds.RLock()
+	feeds := gChangefeeds.feeds
+	gChangefeeds.RUnlock()
+	if feeds != nil {
+		return feeds.manager.Stop(ses.GetTenantInfo().GetTenantID(), name)
+	}
+	return nil
+}
+
+// doShowChangefeeds lists the changefeeds of the account, the checkpoints of the
+// changefeeds running on the cn are the latest ones.
+func doShowChangefeeds(ctx context.Context, ses *Session, st *tree.ShowChangefeeds) error {
+	likeClause := ""
+	if st.Like != nil {
+		likeClause = fmt.Sprintf("where feed_name like %s", quoteString(strings.TrimSpace(st.Like.Right.String())))
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(showChangefeedsFormat, likeClause)); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+
+	gChangefeeds.RLock()
+	feeds := gChangefeeds.feeds
+	gChangefeeds.RUnlock()
+
+	mrs := ses.GetMysqlResultSet()
+	for _, name := range []string{"Name", "Database", "Table", "Sink", "Status", "CN", "Checkpoint", "Error", "Created"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		row := make([]interface{}, 9)
+		for j := 0; j < 8; j++ {
+			if row[j], err = erArray[0].GetString(ctx, i, uint64(j)); err != nil {
+				return err
+			}
+		}
+		row[8] = row[7]
+		row[7] = ""
+		if feeds != nil {
+			if f := feeds.manager.Get(ses.GetTenantInfo().GetTenantID(), row[0].(string)); f != nil {
+				checkpoint, err := f.Status()
+				row[6] = cdc.FormatCheckpoint(checkpoint)
+				if err != nil {
+					row[7] = err.Error()
+				}
+			}
+		}
+		mrs.AddRow(row)
+	}
+	return nil
+}
+
+// keep synchronizes the changefeeds of the cn with mo_changefeeds periodically, the
+// first synchronization is after the interval, when the accounts are initialized.
+func (feeds *changefeeds) keep(ctx context.Context) {
+	ticker := time.NewTicker(changefeedSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := feeds.sync(ctx); err != nil {
+			logutil.Warn("synchronize the changefeeds failed", zap.Error(err))
+		}
+	}
+}
+
+// sync resumes the changefeeds of the cn not running, stops the changefeeds dropped,
+// and saves the checkpoints of the changefeeds running.
+func (feeds *changefeeds) sync(ctx context.Context) error {
+	subscriber, ok := feeds.pu.StorageEngine.(engine.ChangeSubscriber)
+	if !ok {
+		return nil
+	}
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	bh := feeds.newBh(sysCtx)
+	defer bh.Close()
+	bh.ClearExecResultSet()
+	if err := bh.Exec(sysCtx, getAccountsOfChangefeeds); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return err
+	}
+	accounts := make(map[uint32]bool)
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			id, err := erArray[0].GetInt64(sysCtx, i, 0)
+			if err != nil {
+				return err
+			}
+			accounts[uint32(id)] = false
+		}
+	}
+
+	running := make(map[uint32]map[string]bool)
+	for accountId := range accounts {
+		accountCtx := context.WithValue(ctx, defines.TenantIDKey{}, accountId)
+		names, err := feeds.syncAccount(accountCtx, bh, accountId, subscriber)
+		if err != nil {
+			// go on with the other accounts, and keep the changefeeds of the account running
+			logutil.Warn("synchronize the changefeeds of the account failed",
+				zap.Uint32("accountId", accountId), zap.Error(err))
+			continue
+		}
+		accounts[accountId] = true
+		running[accountId] = names
+	}
+
+	// stop the changefeeds dropped, including the ones of the accounts dropped or suspended
+	for _, f := range feeds.manager.List() {
+		spec := f.Spec()
+		synced, ok := accounts[spec.AccountId]
+		if ok && (!synced || running[spec.AccountId][spec.Name]) {
+			continue
+		}
+		if err = feeds.manager.Stop(spec.AccountId, spec.Name); err != nil {
+			logutil.Error("stop the changefeed failed", zap.String("name", spec.Name), zap.Error(err))
+		}
+	}
+	return ctx.Err()
+}
+
+// syncAccount synchronizes the changefeeds of the account on the cn, and returns the
+// names of them.
+func (feeds *changefeeds) syncAccount(ctx context.Context, bh BackgroundExec, accountId uint32,
+	subscriber engine.ChangeSubscriber) (map[string]bool, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getChangefeedsOfCNFormat, quoteString(feeds.cnUUID))); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	if !execResultArrayHasData(erArray) {
+		return names, nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		spec, checkpoint, err := readChangefeedSpec(ctx, erArray[0], i)
+		if err != nil {
+			return nil, err
+		}
+		spec.AccountId = accountId
+		names[spec.Name] = true
+
+		f := feeds.manager.Get(accountId, spec.Name)
+		if f == nil {
+			if err = feeds.manager.Start(ctx, spec, subscriber); err != nil {
+				logutil.Error("resume the changefeed failed", zap.Uint32("accountId", accountId),
+					zap.String("name", spec.Name), zap.Error(err))
+			}
+			continue
+		}
+		current, _ := f.Status()
+		if current.Greater(checkpoint) {
+			bh.ClearExecResultSet()
+			if err = bh.Exec(ctx, fmt.Sprintf(updateChangefeedCheckpointFormat,
+				quoteString(cdc.FormatCheckpoint(current)), quoteString(spec.Name))); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return names, nil
+}
+
+// readChangefeedSpec reads the changefeed in the row of the result of getChangefeedsOfCNFormat,
+// the checkpoint saved is the start timestamp of the resumed changefeed if the sink has
+// no checkpoint.
+func readChangefeedSpec(ctx context.Context, er ExecResult, row uint64) (spec cdc.FeedSpec, checkpoint timestamp.Timestamp, err error) {
+	if spec.Name, err = er.GetString(ctx, row, 0); err != nil {
+		return
+	}
+	if spec.DatabaseName, err = er.GetString(ctx, row, 1); err != nil {
+		return
+	}
+	if spec.TableName, err = er.GetString(ctx, row, 2); err != nil {
+		return
+	}
+	if spec.DatabaseId, err = er.GetUint64(ctx, row, 3); err != nil {
+		return
+	}
+	if spec.TableId, err = er.GetUint64(ctx, row, 4); err != nil {
+		return
+	}
+	var columns, pks, value string
+	if columns, err = er.GetString(ctx, row, 5); err != nil {
+		return
+	}
+	if err = json.Unmarshal([]byte(columns), &spec.Columns); err != nil {
+		return
+	}
+	if pks, err = er.GetString(ctx, row, 6); err != nil {
+		return
+	}
+	if err = json.Unmarshal([]byte(pks), &spec.PrimaryKeys); err != nil {
+		return
+	}
+	if spec.SinkURI, err = er.GetString(ctx, row, 7); err != nil {
+		return
+	}
+	if value, err = er.GetString(ctx, row, 8); err != nil {
+		return
+	}
+	if checkpoint, err = cdc.ParseCheckpoint(ctx, value); err != nil {
+		return
+	}
+	spec.StartTs = checkpoint
+	return
+}
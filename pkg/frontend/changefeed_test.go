@@ -0,0 +1,130 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeChangefeedSelectSql(t *testing.T) {
+	require.Equal(t, "select `a`, `b``c` from `db`.`t1`", makeChangefeedSelectSql("db", "t1", []string{"a", "b`c"}))
+}
+
+func TestReadChangefeedSpec(t *testing.T) {
+	ctx := context.TODO()
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"feed_name", "database_name", "table_name", "database_id", "table_id",
+		"columns", "primary_keys", "sink_uri", "checkpoint"} {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		if name == "database_id" || name == "table_id" {
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			col.SetSigned(false)
+		}
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{"feed", "db", "t1", uint64(1), uint64(2), `["a","b"]`, `["a"]`,
+		"file:///tmp/t1.json", "100-1"})
+
+	spec, checkpoint, err := readChangefeedSpec(ctx, mrs, 0)
+	require.NoError(t, err)
+	require.Equal(t, timestamp.Timestamp{PhysicalTime: 100, LogicalTime: 1}, checkpoint)
+	require.Equal(t, "feed", spec.Name)
+	require.Equal(t, "db", spec.DatabaseName)
+	require.Equal(t, "t1", spec.TableName)
+	require.Equal(t, uint64(1), spec.DatabaseId)
+	require.Equal(t, uint64(2), spec.TableId)
+	require.Equal(t, []string{"a", "b"}, spec.Columns)
+	require.Equal(t, []string{"a"}, spec.PrimaryKeys)
+	require.Equal(t, "file:///tmp/t1.json", spec.SinkURI)
+	require.Equal(t, checkpoint, spec.StartTs)
+}
+
+func TestDoComQueryChangefeedSendsOk(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, rsStubs := mockRecordStatement(ctx)
+	defer rsStubs.Reset()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+	bh.sql2result[fmt.Sprintf(getChangefeedFormat, quoteString("feed"))] = &MysqlResultSet{}
+
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	eng.EXPECT().Commit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	eng.EXPECT().Rollback(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	eng.EXPECT().Hints().Return(engine.Hints{
+		CommitOrRollbackTimeout: time.Second,
+	}).AnyTimes()
+
+	txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+	txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+	txnOperator.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+	txnClient := mock_frontend.NewMockTxnClient(ctrl)
+	txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
+
+	// the client waits for the response of the statement
+	var received [][]byte
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+		received = append(received, append([]byte{}, msg.([]byte)[HeaderLengthOfTheProtocol:]...))
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng, txnClient)
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	var gSys GlobalSystemVariables
+	InitGlobalSystemVariables(&gSys)
+	ses := NewSession(proto, nil, pu, &gSys, false)
+	ses.SetRequestContext(ctx)
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		UserID:        rootID,
+		DefaultRoleID: moAdminRoleID,
+	})
+	mce := &MysqlCmdExecutor{}
+	mce.SetSession(ses)
+
+	err = mce.doComQuery(ctx, "drop changefeed if exists feed")
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	require.Equal(t, defines.OKHeader, received[0][0])
+}
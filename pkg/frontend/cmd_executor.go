@@ -114,6 +114,10 @@ func Execute(ctx context.Context, ses *Session, proc *process.Process, stmtExec
 		goto handleRet
 	}
 
+	if statementGrowsStorage(ses, stmtExec.GetAst()) {
+		ses.GetTxnHandler().SetGrowsStorage()
+	}
+
 	ses.GetTxnCompileCtx().SetQueryType(TXN_DEFAULT)
 
 	if err = stmtExec.SetDatabaseName(ses.GetDatabaseName()); err != nil {
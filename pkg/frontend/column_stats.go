@@ -0,0 +1,670 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"go.uber.org/zap"
+)
+
+const (
+	columnStatsRefreshTaskName = "ColumnStatsRefresh"
+	// refresh the column statistics at the beginning of every hour
+	columnStatsRefreshCronExpr = "0 0 */1 * * *"
+
+	// only the ndv and the null count are collected for the column
+	// having more distinct values, the histogram and the top-n values
+	// need to read all the distinct values.
+	columnStatsMaxDistinctValues = 100000
+
+	// the column statistics of a table are reloaded after the ttl,
+	// so that the refreshing on the other cn can be seen.
+	columnStatsCacheTTL = time.Minute
+	// the expired entries are evicted when the cache grows to the size
+	columnStatsCacheSize = 4096
+	// the time the column statistics of a table are loaded in
+	columnStatsLoadTimeout = time.Minute
+)
+
+var (
+	getColumnStatsFormat = `select column_name, table_rows, ndv, null_count, histogram, top_n from mo_catalog.mo_column_stats where table_id = %d;`
+
+	deleteColumnStatsFormat = `delete from mo_catalog.mo_column_stats where table_id = %d and column_name in (%s);`
+
+	deleteTableColumnStatsFormat = `delete from mo_catalog.mo_column_stats where table_id = %d;`
+
+	insertColumnStatsFormat = `insert into mo_catalog.mo_column_stats(
+			table_id,
+			database_name,
+			table_name,
+			column_name,
+			table_rows,
+			ndv,
+			null_count,
+			histogram,
+			top_n,
+			update_time) values %s;`
+
+	getAnalyzedColumnsFormat = `select table_id, column_name from mo_catalog.mo_column_stats order by table_id;`
+
+	getTableOfAnalyzedColumnsFormat = `select reldatabase, relname from mo_catalog.mo_tables where rel_id = %d and account_id = %d;`
+
+	getColumnsOfAnalyzedTableFormat = `select attname from mo_catalog.mo_columns where att_relname_id = %d and account_id = %d and attisdropped = 0;`
+
+	getAccountsOfAnalyzedTables = `select account_id from mo_catalog.mo_account where status != 'suspend';`
+)
+
+// analyzeColumn is a column analyzed by analyze table
+type analyzeColumn struct {
+	name string
+	typ  types.T
+}
+
+// isAnalyzableType checks the statistics can be collected on the column of the type
+func isAnalyzableType(typ types.T) bool {
+	switch typ {
+	case types.T_json, types.T_blob, types.T_text, types.T_Rowid, types.T_any:
+		return false
+	}
+	return true
+}
+
+// isHistogramType checks the column of the type has the histogram. The value of
+// the type can be mapped to a float64, which keeps the order of the values.
+func isHistogramType(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_datetime, types.T_time:
+		return true
+	}
+	return false
+}
+
+// columnStatsValueToFloat maps the value of the column to the value in the histogram,
+// which is the same as the constant of the filter on the column.
+func columnStatsValueToFloat(typ types.T, s string) (float64, error) {
+	switch typ {
+	case types.T_date:
+		d, err := types.ParseDateCast(s)
+		return float64(d), err
+	case types.T_datetime:
+		dt, err := types.ParseDatetime(s, 6)
+		return float64(dt), err
+	case types.T_time:
+		t, err := types.ParseTime(s, 6)
+		return float64(t), err
+	default:
+		return strconv.ParseFloat(s, 64)
+	}
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+var columnStatsStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+func quoteString(s string) string {
+	return "'" + columnStatsStringReplacer.Replace(s) + "'"
+}
+
+// getAnalyzeColumns returns the columns to be analyzed, all the visible columns
+// are analyzed if no column is specified.
+func getAnalyzeColumns(ctx context.Context, tableDef *plan2.TableDef, names []string) ([]analyzeColumn, error) {
+	var cols []analyzeColumn
+	if len(names) == 0 {
+		for _, col := range tableDef.Cols {
+			if col.Hidden || !isAnalyzableType(types.T(col.Typ.Id)) {
+				continue
+			}
+			cols = append(cols, analyzeColumn{name: col.Name, typ: types.T(col.Typ.Id)})
+		}
+		return cols, nil
+	}
+	for _, name := range names {
+		found := false
+		for _, col := range tableDef.Cols {
+			if col.Hidden || !strings.EqualFold(col.Name, name) {
+				continue
+			}
+			found = true
+			if isAnalyzableType(types.T(col.Typ.Id)) {
+				cols = append(cols, analyzeColumn{name: col.Name, typ: types.T(col.Typ.Id)})
+			}
+			break
+		}
+		if !found {
+			return nil, moerr.NewBadFieldError(ctx, name, tableDef.Name)
+		}
+	}
+	return cols, nil
+}
+
+// makeAnalyzeCountSql makes the sql counting the rows, the non-null values
+// and the approximate ndv of the columns.
+func makeAnalyzeCountSql(dbName, tblName string, cols []analyzeColumn) string {
+	var sb strings.Builder
+	sb.WriteString("select count(*)")
+	for _, col := range cols {
+		name := quoteIdentifier(col.name)
+		fmt.Fprintf(&sb, ", count(%s), approx_count_distinct(%s)", name, name)
+	}
+	fmt.Fprintf(&sb, " from %s.%s;", quoteIdentifier(dbName), quoteIdentifier(tblName))
+	return sb.String()
+}
+
+// makeAnalyzeDistinctSql makes the sql returning the distinct values of the column
+// and their count, the values are ordered if the column has the histogram.
+func makeAnalyzeDistinctSql(dbName, tblName string, col analyzeColumn) string {
+	name := quoteIdentifier(col.name)
+	sql := fmt.Sprintf("select %s, count(*) from %s.%s where %s is not null group by %s",
+		name, quoteIdentifier(dbName), quoteIdentifier(tblName), name, name)
+	if isHistogramType(col.typ) {
+		sql += " order by " + name
+	}
+	return sql + ";"
+}
+
+// collectColumnStats collects the statistics of the columns
+func collectColumnStats(ctx context.Context, bh BackgroundExec, dbName, tblName string, cols []analyzeColumn) (float64, []*plan2.ColumnStats, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, makeAnalyzeCountSql(dbName, tblName, cols)); err != nil {
+		return 0, nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return 0, nil, moerr.NewInternalError(ctx, "analyze table %s.%s returns no rows", dbName, tblName)
+	}
+	rowCount, err := erArray[0].GetInt64(ctx, 0, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	stats := make([]*plan2.ColumnStats, len(cols))
+	for i, col := range cols {
+		nonNull, err := erArray[0].GetInt64(ctx, 0, uint64(2*i+1))
+		if err != nil {
+			return 0, nil, err
+		}
+		ndv, err := erArray[0].GetInt64(ctx, 0, uint64(2*i+2))
+		if err != nil {
+			return 0, nil, err
+		}
+		if ndv > columnStatsMaxDistinctValues {
+			stats[i] = &plan2.ColumnStats{
+				NDV:       float64(ndv),
+				NullCount: float64(rowCount - nonNull),
+			}
+			continue
+		}
+		stats[i], err = collectColumnDistinctValues(ctx, bh, dbName, tblName, col)
+		if err != nil {
+			return 0, nil, err
+		}
+		stats[i].NullCount = float64(rowCount - nonNull)
+	}
+	return float64(rowCount), stats, nil
+}
+
+// collectColumnDistinctValues builds the statistics of the column from all its distinct values
+func collectColumnDistinctValues(ctx context.Context, bh BackgroundExec, dbName, tblName string, col analyzeColumn) (*plan2.ColumnStats, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, makeAnalyzeDistinctSql(dbName, tblName, col)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	numeric := isHistogramType(col.typ)
+	builder := plan2.NewColumnStatsBuilder(numeric)
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			val, err := erArray[0].GetString(ctx, i, 0)
+			if err != nil {
+				return nil, err
+			}
+			cnt, err := erArray[0].GetInt64(ctx, i, 1)
+			if err != nil {
+				return nil, err
+			}
+			if !numeric {
+				builder.AddStr(val, float64(cnt))
+				continue
+			}
+			num, err := columnStatsValueToFloat(col.typ, val)
+			if err != nil {
+				return nil, err
+			}
+			builder.AddNum(num, float64(cnt))
+		}
+	}
+	return builder.Build(plan2.DefaultHistogramBuckets, plan2.DefaultTopNValues), nil
+}
+
+// saveColumnStats replaces the persisted statistics of the columns
+func saveColumnStats(ctx context.Context, bh BackgroundExec, tableId uint64, dbName, tblName string, rowCount float64, cols []analyzeColumn, stats []*plan2.ColumnStats) error {
+	var err error
+	var sql string
+	var histogram, topN []byte
+	names := make([]string, len(cols))
+	values := make([]string, len(cols))
+	updateTime := types.CurrentTimestamp().String2(time.UTC, 0)
+	for i, col := range cols {
+		names[i] = quoteString(col.name)
+		histogram, err = json.Marshal(stats[i].Histogram)
+		if err != nil {
+			return err
+		}
+		topN, err = json.Marshal(stats[i].TopN)
+		if err != nil {
+			return err
+		}
+		values[i] = fmt.Sprintf("(%d, %s, %s, %s, %d, %d, %d, %s, %s, '%s')",
+			tableId,
+			quoteString(dbName),
+			quoteString(tblName),
+			quoteString(col.name),
+			uint64(rowCount),
+			uint64(stats[i].NDV),
+			uint64(stats[i].NullCount),
+			quoteString(string(histogram)),
+			quoteString(string(topN)),
+			updateTime)
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	sql = fmt.Sprintf(deleteColumnStatsFormat, tableId, strings.Join(names, ", "))
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		goto handleFailed
+	}
+
+	sql = fmt.Sprintf(insertColumnStatsFormat, strings.Join(values, ", "))
+	err = bh.Exec(ctx, sql)
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return nil
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// analyzeTable collects and persists the statistics of the columns of the table
+func analyzeTable(ctx context.Context, bh BackgroundExec, tableId uint64, dbName, tblName string, cols []analyzeColumn) error {
+	if len(cols) == 0 {
+		return nil
+	}
+	rowCount, stats, err := collectColumnStats(ctx, bh, dbName, tblName, cols)
+	if err != nil {
+		return err
+	}
+	if err = saveColumnStats(ctx, bh, tableId, dbName, tblName, rowCount, cols, stats); err != nil {
+		return err
+	}
+	gColumnStatsCache.invalidate(getAccountId(ctx), tableId)
+	return nil
+}
+
+// loadColumnStats loads the persisted statistics of the table, nil is returned
+// if the table is not analyzed.
+func loadColumnStats(ctx context.Context, bh BackgroundExec, tableId uint64) (*plan2.TableColumnStats, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getColumnStatsFormat, tableId)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	stats := &plan2.TableColumnStats{
+		Columns: make(map[string]*plan2.ColumnStats),
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		rowCount, err := erArray[0].GetUint64(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		ndv, err := erArray[0].GetUint64(ctx, i, 2)
+		if err != nil {
+			return nil, err
+		}
+		nullCount, err := erArray[0].GetUint64(ctx, i, 3)
+		if err != nil {
+			return nil, err
+		}
+		histogram, err := erArray[0].GetString(ctx, i, 4)
+		if err != nil {
+			return nil, err
+		}
+		topN, err := erArray[0].GetString(ctx, i, 5)
+		if err != nil {
+			return nil, err
+		}
+		col := &plan2.ColumnStats{
+			NDV:       float64(ndv),
+			NullCount: float64(nullCount),
+		}
+		if err = json.Unmarshal([]byte(histogram), &col.Histogram); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(topN), &col.TopN); err != nil {
+			return nil, err
+		}
+		// the columns may be analyzed at the different time, take the latest row count
+		if float64(rowCount) > stats.RowCount {
+			stats.RowCount = float64(rowCount)
+		}
+		stats.Columns[name] = col
+	}
+	return stats, nil
+}
+
+type columnStatsCacheKey struct {
+	accountId uint32
+	tableId   uint64
+}
+
+type columnStatsCacheEntry struct {
+	stats    *plan2.TableColumnStats
+	loadTime time.Time
+	// loading is true while the statistics are being loaded
+	loading bool
+}
+
+// columnStatsCache caches the persisted column statistics for the planner,
+// the tables without statistics are cached too. The statistics are loaded in
+// the background, so the planner never waits for them.
+type columnStatsCache struct {
+	sync.Mutex
+	entries map[columnStatsCacheKey]columnStatsCacheEntry
+}
+
+var gColumnStatsCache = &columnStatsCache{
+	entries: make(map[columnStatsCacheKey]columnStatsCacheEntry),
+}
+
+// get returns the cached statistics of the table, which are nil if they have
+// not been loaded yet. The statistics missing or expired are loaded by load
+// in the background, and the expired ones are returned until then.
+func (c *columnStatsCache) get(accountId uint32, tableId uint64,
+	load func() (*plan2.TableColumnStats, error)) *plan2.TableColumnStats {
+	key := columnStatsCacheKey{accountId: accountId, tableId: tableId}
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.entries[key]
+	if (ok && time.Since(entry.loadTime) <= columnStatsCacheTTL) || entry.loading {
+		return entry.stats
+	}
+	if !ok && len(c.entries) >= columnStatsCacheSize {
+		for key, entry := range c.entries {
+			if !entry.loading && time.Since(entry.loadTime) > columnStatsCacheTTL {
+				delete(c.entries, key)
+			}
+		}
+	}
+	entry.loading = true
+	c.entries[key] = entry
+	go func() {
+		stats, err := load()
+		if err != nil {
+			// the planner works without the statistics
+			logutil.Warn("load column stats failed", zap.Uint64("tableId", tableId), zap.Error(err))
+		}
+		c.Lock()
+		defer c.Unlock()
+		// the statistics invalidated while loading are loaded again
+		entry, ok := c.entries[key]
+		if !ok || !entry.loading {
+			return
+		}
+		// the failed load is retried after the ttl as well
+		entry.loading = false
+		entry.loadTime = time.Now()
+		if err == nil {
+			entry.stats = stats
+		}
+		c.entries[key] = entry
+	}()
+	return entry.stats
+}
+
+func (c *columnStatsCache) invalidate(accountId uint32, tableId uint64) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.entries, columnStatsCacheKey{accountId: accountId, tableId: tableId})
+}
+
+// getColumnStats returns the cached column statistics of the table for the
+// planner, the statistics not cached are loaded in the background.
+func getColumnStats(ctx context.Context, ses *Session, tableId uint64) *plan2.TableColumnStats {
+	accountId := getAccountId(ctx)
+	pu, aicm := ses.GetParameterUnit(), ses.GetAutoIncrCaches()
+	return gColumnStatsCache.get(accountId, tableId, func() (*plan2.TableColumnStats, error) {
+		mp, err := mpool.NewMPool("column_stats_load", 0, mpool.NoFixed)
+		if err != nil {
+			return nil, err
+		}
+		defer mpool.DeleteMPool(mp)
+		accountCtx, cancel := context.WithTimeout(
+			context.WithValue(context.Background(), defines.TenantIDKey{}, accountId), columnStatsLoadTimeout)
+		defer cancel()
+		bh := NewBackgroundHandler(accountCtx, mp, pu, aicm)
+		defer bh.Close()
+		return loadColumnStats(accountCtx, bh, tableId)
+	})
+}
+
+// ColumnStatsTaskMetadata returns the metadata of the cron task refreshing the column statistics
+func ColumnStatsTaskMetadata(id task.TaskCode) task.TaskMetadata {
+	return task.TaskMetadata{
+		ID:       columnStatsRefreshTaskName,
+		Executor: id,
+		Options:  task.TaskOptions{Concurrency: 1},
+	}
+}
+
+// CreateColumnStatsCronTask creates the cron task refreshing the column statistics,
+// it should be created once with the system init.
+func CreateColumnStatsCronTask(ctx context.Context, executorID task.TaskCode, taskService taskservice.TaskService) error {
+	logutil.Info("init column stats refresh task", zap.String("cronExpr", columnStatsRefreshCronExpr))
+	return taskService.CreateCronTask(ctx, ColumnStatsTaskMetadata(executorID), columnStatsRefreshCronExpr)
+}
+
+// ColumnStatsRefreshExecutor returns the executor of the cron task, which analyzes
+// the analyzed columns of all the accounts again.
+func ColumnStatsRefreshExecutor(pu *config.ParameterUnit, aicm defines.AutoIncrCaches) func(ctx context.Context, t task.Task) error {
+	return func(ctx context.Context, t task.Task) error {
+		mp, err := mpool.NewMPool("column_stats_refresh", 0, mpool.NoFixed)
+		if err != nil {
+			return err
+		}
+		defer mpool.DeleteMPool(mp)
+		return refreshColumnStats(ctx, func(ctx context.Context) BackgroundExec {
+			return NewBackgroundHandler(ctx, mp, pu, aicm)
+		})
+	}
+}
+
+func refreshColumnStats(ctx context.Context, newBh func(context.Context) BackgroundExec) error {
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	bh := newBh(sysCtx)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	if err := bh.Exec(sysCtx, getAccountsOfAnalyzedTables); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return err
+	}
+	var accounts []uint32
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			id, err := erArray[0].GetInt64(sysCtx, i, 0)
+			if err != nil {
+				return err
+			}
+			accounts = append(accounts, uint32(id))
+		}
+	}
+
+	for _, accountId := range accounts {
+		accountCtx := context.WithValue(ctx, defines.TenantIDKey{}, accountId)
+		if err = refreshAccountColumnStats(accountCtx, newBh(accountCtx), accountId); err != nil {
+			// go on with the other accounts
+			logutil.Error("refresh column stats failed", zap.Uint32("accountId", accountId), zap.Error(err))
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// refreshAccountColumnStats analyzes the analyzed columns of the account again
+func refreshAccountColumnStats(ctx context.Context, bh BackgroundExec, accountId uint32) error {
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, getAnalyzedColumnsFormat); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil
+	}
+	var tableIds []uint64
+	analyzed := make(map[uint64]map[string]bool)
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		tableId, err := erArray[0].GetUint64(ctx, i, 0)
+		if err != nil {
+			return err
+		}
+		name, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return err
+		}
+		if analyzed[tableId] == nil {
+			analyzed[tableId] = make(map[string]bool)
+			tableIds = append(tableIds, tableId)
+		}
+		analyzed[tableId][name] = true
+	}
+
+	for _, tableId := range tableIds {
+		if err = refreshTableColumnStats(ctx, bh, accountId, tableId, analyzed[tableId]); err != nil {
+			logutil.Error("refresh column stats of table failed", zap.Uint64("tableId", tableId), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func refreshTableColumnStats(ctx context.Context, bh BackgroundExec, accountId uint32, tableId uint64, analyzed map[string]bool) error {
+	// the table may be renamed or dropped after it's analyzed
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getTableOfAnalyzedColumnsFormat, tableId, accountId)); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return bh.Exec(ctx, fmt.Sprintf(deleteTableColumnStatsFormat, tableId))
+	}
+	dbName, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+	tblName, err := erArray[0].GetString(ctx, 0, 1)
+	if err != nil {
+		return err
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getColumnsOfAnalyzedTableFormat, tableId, accountId)); err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	var names []string
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			name, err := erArray[0].GetString(ctx, i, 0)
+			if err != nil {
+				return err
+			}
+			if analyzed[name] {
+				names = append(names, quoteIdentifier(name))
+			}
+		}
+	}
+	if len(names) == 0 {
+		return bh.Exec(ctx, fmt.Sprintf(deleteTableColumnStatsFormat, tableId))
+	}
+	// analyze table checks the type of the columns again
+	sql := fmt.Sprintf("analyze table %s.%s(%s);", quoteIdentifier(dbName), quoteIdentifier(tblName), strings.Join(names, ", "))
+	return bh.Exec(ctx, sql)
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAnalyzeColumns(t *testing.T) {
+	ctx := context.TODO()
+	tableDef := &plan2.TableDef{
+		Name: "t1",
+		Cols: []*plan2.ColDef{
+			{Name: "a", Typ: &plan2.Type{Id: int32(types.T_int64)}},
+			{Name: "b", Typ: &plan2.Type{Id: int32(types.T_varchar)}},
+			{Name: "c", Typ: &plan2.Type{Id: int32(types.T_json)}},
+			{Name: "__mo_rowid", Typ: &plan2.Type{Id: int32(types.T_Rowid)}, Hidden: true},
+		},
+	}
+	cols, err := getAnalyzeColumns(ctx, tableDef, nil)
+	require.NoError(t, err)
+	require.Equal(t, []analyzeColumn{{name: "a", typ: types.T_int64}, {name: "b", typ: types.T_varchar}}, cols)
+
+	cols, err = getAnalyzeColumns(ctx, tableDef, []string{"B", "c"})
+	require.NoError(t, err)
+	require.Equal(t, []analyzeColumn{{name: "b", typ: types.T_varchar}}, cols)
+
+	_, err = getAnalyzeColumns(ctx, tableDef, []string{"d"})
+	require.Error(t, err)
+	_, err = getAnalyzeColumns(ctx, tableDef, []string{"__mo_rowid"})
+	require.Error(t, err)
+}
+
+func TestMakeAnalyzeSql(t *testing.T) {
+	cols := []analyzeColumn{{name: "a", typ: types.T_int64}, {name: "b`c", typ: types.T_varchar}}
+	require.Equal(t,
+		"select count(*), count(`a`), approx_count_distinct(`a`), count(`b``c`), approx_count_distinct(`b``c`) from `db`.`t1`;",
+		makeAnalyzeCountSql("db", "t1", cols))
+	require.Equal(t,
+		"select `a`, count(*) from `db`.`t1` where `a` is not null group by `a` order by `a`;",
+		makeAnalyzeDistinctSql("db", "t1", cols[0]))
+	require.Equal(t,
+		"select `b``c`, count(*) from `db`.`t1` where `b``c` is not null group by `b``c`;",
+		makeAnalyzeDistinctSql("db", "t1", cols[1]))
+	require.Equal(t, `'it\'s \\'`, quoteString(`it's \`))
+}
+
+func TestColumnStatsValueToFloat(t *testing.T) {
+	v, err := columnStatsValueToFloat(types.T_int32, "-12")
+	require.NoError(t, err)
+	require.Equal(t, float64(-12), v)
+
+	v, err = columnStatsValueToFloat(types.T_decimal64, "12.50")
+	require.NoError(t, err)
+	require.Equal(t, 12.5, v)
+
+	d, err := types.ParseDateCast("2023-01-02")
+	require.NoError(t, err)
+	v, err = columnStatsValueToFloat(types.T_date, "2023-01-02")
+	require.NoError(t, err)
+	require.Equal(t, float64(d), v)
+
+	dt, err := types.ParseDatetime("2023-01-02 03:04:05", 6)
+	require.NoError(t, err)
+	v, err = columnStatsValueToFloat(types.T_datetime, "2023-01-02 03:04:05")
+	require.NoError(t, err)
+	require.Equal(t, float64(dt), v)
+
+	_, err = columnStatsValueToFloat(types.T_int64, "abc")
+	require.Error(t, err)
+}
+
+func TestColumnStatsCache(t *testing.T) {
+	cache := &columnStatsCache{
+		entries: make(map[columnStatsCacheKey]columnStatsCacheEntry),
+	}
+	loaded := make(chan *plan2.TableColumnStats)
+	load := func() (*plan2.TableColumnStats, error) {
+		return <-loaded, nil
+	}
+	noLoad := func() (*plan2.TableColumnStats, error) {
+		panic("the statistics are loaded again")
+	}
+	waitLoaded := func(accountId uint32) {
+		require.Eventually(t, func() bool {
+			cache.Lock()
+			defer cache.Unlock()
+			return !cache.entries[columnStatsCacheKey{accountId: accountId, tableId: 100}].loading
+		}, time.Second*5, time.Millisecond)
+	}
+
+	// the planner never waits for the statistics being loaded
+	require.Nil(t, cache.get(1, 100, load))
+	require.Nil(t, cache.get(1, 100, noLoad))
+	loaded <- &plan2.TableColumnStats{RowCount: 10}
+	waitLoaded(1)
+	stats := cache.get(1, 100, noLoad)
+	require.Equal(t, float64(10), stats.RowCount)
+
+	// the table without statistics is cached too
+	require.Nil(t, cache.get(2, 100, load))
+	loaded <- nil
+	waitLoaded(2)
+	require.Nil(t, cache.get(2, 100, noLoad))
+
+	// the expired statistics are returned while being reloaded
+	cache.entries[columnStatsCacheKey{accountId: 1, tableId: 100}] = columnStatsCacheEntry{
+		stats:    stats,
+		loadTime: time.Now().Add(-2 * columnStatsCacheTTL),
+	}
+	require.Equal(t, stats, cache.get(1, 100, load))
+	loaded <- &plan2.TableColumnStats{RowCount: 20}
+	waitLoaded(1)
+	require.Equal(t, float64(20), cache.get(1, 100, noLoad).RowCount)
+
+	cache.invalidate(1, 100)
+	require.Nil(t, cache.get(1, 100, load))
+	loaded <- nil
+	waitLoaded(1)
+}
@@ -0,0 +1,266 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// compressedHeaderLength is the length of the header of the compressed packet
+	compressedHeaderLength = 7
+	// minCompressLength payloads shorter than it are sent without compression
+	minCompressLength = 50
+	// defaultZstdCompressionLevel the level of zstd if the client does not specify it
+	defaultZstdCompressionLevel = 3
+)
+
+const (
+	compressionZlib         = "zlib"
+	compressionZstd         = "zstd"
+	compressionUncompressed = "uncompressed"
+)
+
+// compressionCapability returns the capabilities of the compression permitted by the
+// algorithms. The algorithms are separated by comma, like "zlib,zstd,uncompressed".
+func compressionCapability(algorithms string) uint32 {
+	var capability uint32
+	for _, algorithm := range strings.Split(algorithms, ",") {
+		switch strings.ToLower(strings.TrimSpace(algorithm)) {
+		case compressionZlib:
+			capability |= CLIENT_COMPRESS
+		case compressionZstd:
+			capability |= CLIENT_ZSTD_COMPRESSION_ALGORITHM
+		}
+	}
+	return capability
+}
+
+// compressor compresses and decompresses the payload of the compressed packets.
+type compressor interface {
+	// compress appends the compressed data of src to dst.
+	compress(dst *bytes.Buffer, src []byte) error
+	// decompress decompresses src into dst, dst has the length of the data before compression.
+	decompress(dst []byte, src []byte) error
+}
+
+type zlibCompressor struct {
+	writer *zlib.Writer
+}
+
+func newZlibCompressor() *zlibCompressor {
+	return &zlibCompressor{
+		writer: zlib.NewWriter(nil),
+	}
+}
+
+func (c *zlibCompressor) compress(dst *bytes.Buffer, src []byte) error {
+	c.writer.Reset(dst)
+	if _, err := c.writer.Write(src); err != nil {
+		return err
+	}
+	return c.writer.Close()
+}
+
+func (c *zlibCompressor) decompress(dst []byte, src []byte) error {
+	reader, err := zlib.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	_, err = io.ReadFull(reader, dst)
+	return err
+}
+
+type zstdCompressor struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdCompressor(level int) (*zstdCompressor, error) {
+	encoder, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		encoder.Close()
+		return nil, err
+	}
+	return &zstdCompressor{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+func (c *zstdCompressor) compress(dst *bytes.Buffer, src []byte) error {
+	dst.Write(c.encoder.EncodeAll(src, nil))
+	return nil
+}
+
+func (c *zstdCompressor) decompress(dst []byte, src []byte) error {
+	data, err := c.decoder.DecodeAll(src, dst[:0])
+	if err != nil {
+		return err
+	}
+	if len(data) != len(dst) {
+		return moerr.NewInvalidInputNoCtx("length of the decompressed payload %d != %d", len(data), len(dst))
+	}
+	return nil
+}
+
+func (c *zstdCompressor) close() {
+	c.encoder.Close()
+	c.decoder.Close()
+}
+
+// compressedConn implements the compressed protocol on a connection. The stream of the
+// ordinary packets is split and carried by the compressed packets, each of which has a
+// header of 7 bytes:
+//
+//	int<3> length of the compressed payload
+//	int<1> sequence id of the compressed packet
+//	int<3> length of the payload before compression, 0 means the payload is not compressed
+type compressedConn struct {
+	net.Conn
+	compressor compressor
+	// sequenceID the sequence id of the next compressed packet sent. The client resets
+	// it at the beginning of every command, the response continues from the request.
+	sequenceID uint8
+
+	// readBuf holds the decompressed data not read yet
+	readBuf     bytes.Buffer
+	readHeader  [compressedHeaderLength]byte
+	readPayload []byte
+	readData    []byte
+
+	writeBuf bytes.Buffer
+}
+
+func newCompressedConn(conn net.Conn, compressor compressor) *compressedConn {
+	return &compressedConn{
+		Conn:       conn,
+		compressor: compressor,
+	}
+}
+
+func (c *compressedConn) Read(p []byte) (int, error) {
+	for c.readBuf.Len() == 0 {
+		if err := c.readPacket(); err != nil {
+			return 0, err
+		}
+	}
+	return c.readBuf.Read(p)
+}
+
+// readPacket reads a compressed packet and puts its decompressed payload into readBuf.
+func (c *compressedConn) readPacket() error {
+	if _, err := io.ReadFull(c.Conn, c.readHeader[:]); err != nil {
+		return err
+	}
+	header := c.readHeader[:]
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	c.sequenceID = header[3] + 1
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+
+	if cap(c.readPayload) < length {
+		c.readPayload = make([]byte, length)
+	}
+	payload := c.readPayload[:length]
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+	if uncompressedLength == 0 {
+		c.readBuf.Write(payload)
+		return nil
+	}
+	if cap(c.readData) < uncompressedLength {
+		c.readData = make([]byte, uncompressedLength)
+	}
+	data := c.readData[:uncompressedLength]
+	if err := c.compressor.decompress(data, payload); err != nil {
+		return err
+	}
+	c.readBuf.Write(data)
+	return nil
+}
+
+func (c *compressedConn) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := Min(len(p), int(MaxPayloadSize))
+		if err := c.writePacket(p[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// writePacket sends the data in a compressed packet. The short data and the data which can
+// not be compressed are sent without compression.
+func (c *compressedConn) writePacket(data []byte) error {
+	c.writeBuf.Reset()
+	c.writeBuf.Write(make([]byte, compressedHeaderLength))
+	uncompressedLength := len(data)
+	if uncompressedLength < minCompressLength {
+		uncompressedLength = 0
+	} else {
+		if err := c.compressor.compress(&c.writeBuf, data); err != nil {
+			return err
+		}
+		if c.writeBuf.Len()-compressedHeaderLength >= len(data) {
+			uncompressedLength = 0
+		}
+	}
+	if uncompressedLength == 0 {
+		c.writeBuf.Truncate(compressedHeaderLength)
+		c.writeBuf.Write(data)
+	}
+
+	packet := c.writeBuf.Bytes()
+	length := len(packet) - compressedHeaderLength
+	if length > int(MaxPayloadSize) {
+		return moerr.NewInternalErrorNoCtx("length of the compressed payload %d is too large", length)
+	}
+	packet[0] = byte(length)
+	packet[1] = byte(length >> 8)
+	packet[2] = byte(length >> 16)
+	packet[3] = c.sequenceID
+	packet[4] = byte(uncompressedLength)
+	packet[5] = byte(uncompressedLength >> 8)
+	packet[6] = byte(uncompressedLength >> 16)
+	c.sequenceID++
+
+	_, err := c.Conn.Write(packet)
+	return err
+}
+
+func (c *compressedConn) Close() error {
+	if zc, ok := c.compressor.(*zstdCompressor); ok {
+		zc.close()
+	}
+	return c.Conn.Close()
+}
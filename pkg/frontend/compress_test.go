@@ -0,0 +1,146 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionCapability(t *testing.T) {
+	require.Equal(t, uint32(0), compressionCapability(""))
+	require.Equal(t, uint32(0), compressionCapability("uncompressed"))
+	require.Equal(t, CLIENT_COMPRESS, compressionCapability("zlib"))
+	require.Equal(t, CLIENT_COMPRESS|CLIENT_ZSTD_COMPRESSION_ALGORITHM, compressionCapability("zlib, ZSTD,uncompressed"))
+}
+
+func TestCompressedConn(t *testing.T) {
+	newCompressors := map[string]func() compressor{
+		compressionZlib: func() compressor {
+			return newZlibCompressor()
+		},
+		compressionZstd: func() compressor {
+			c, err := newZstdCompressor(defaultZstdCompressionLevel)
+			require.NoError(t, err)
+			return c
+		},
+	}
+	for name, newCompressor := range newCompressors {
+		t.Run(name, func(t *testing.T) {
+			server, client := net.Pipe()
+			serverConn := newCompressedConn(server, newCompressor())
+			clientConn := newCompressedConn(client, newCompressor())
+			defer serverConn.Close()
+			defer clientConn.Close()
+
+			request := []byte("select 1")
+			response := bytes.Repeat([]byte("matrixone"), 10000)
+
+			// the short request is not compressed
+			go func() {
+				_, err := clientConn.Write(request)
+				require.NoError(t, err)
+			}()
+			data := make([]byte, len(request))
+			_, err := io.ReadFull(serverConn, data)
+			require.NoError(t, err)
+			require.Equal(t, request, data)
+			require.Equal(t, uint8(1), serverConn.sequenceID)
+
+			// the response continues the sequence id of the request
+			go func() {
+				_, err := serverConn.Write(response)
+				require.NoError(t, err)
+			}()
+			var header [compressedHeaderLength]byte
+			_, err = io.ReadFull(client, header[:])
+			require.NoError(t, err)
+			length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+			require.Equal(t, uint8(1), header[3])
+			require.Less(t, length, len(response))
+			require.Equal(t, len(response), int(uint32(header[4])|uint32(header[5])<<8|uint32(header[6])<<16))
+
+			payload := make([]byte, length)
+			_, err = io.ReadFull(client, payload)
+			require.NoError(t, err)
+			data = make([]byte, len(response))
+			require.NoError(t, clientConn.compressor.decompress(data, payload))
+			require.Equal(t, response, data)
+		})
+	}
+}
+
+func TestAnalyseHandshakeResponse41WithZstd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	sv.ProtocolCompressionAlgorithms = "zlib,zstd"
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+	require.NotZero(t, proto.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+
+	var data []byte
+	capability := CLIENT_PROTOCOL_41 | CLIENT_SECURE_CONNECTION | CLIENT_CONNECT_ATTRS | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	data = proto.io.AppendUint32(data, capability)
+	//max-packet size, character set and reserved
+	data = append(data, 0xff, 0xff, 0xff, 0xff, 0x1)
+	data = append(data, make([]byte, 23)...)
+	//username
+	data = append(data, []byte("abc")...)
+	data = append(data, 0x0)
+	//auth response
+	data = append(data, 0x2, 0x1, 0x2)
+	//connection attributes
+	data = append(data, 0x4, 0x1, 'a', 0x1, 'b')
+	//zstd compression level
+	data = append(data, 0x7)
+
+	ok, resp41, err := proto.analyseHandshakeResponse41(context.TODO(), data)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "abc", resp41.username)
+	require.Equal(t, uint8(7), resp41.zstdCompressionLevel)
+}
+
+func TestIsSecureTransportWithCompression(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	tlsConn := tls.Server(server, &tls.Config{})
+	ioses.EXPECT().RawConn().Return(newCompressedConn(tlsConn, newZlibCompressor())).Times(1)
+	require.True(t, proto.isSecureTransport())
+	ioses.EXPECT().RawConn().Return(newCompressedConn(server, newZlibCompressor())).Times(1)
+	require.False(t, proto.isSecureTransport())
+}
@@ -60,6 +60,11 @@ type ExportParam struct {
 	AsyncReader    *io.PipeReader
 	AsyncWriter    *io.PipeWriter
 	AsyncGroup     *errgroup.Group
+	// the path of the output file in the FileService, FilePath is used if it is empty
+	FileServicePath string
+
+	// the writer of the format other than csv
+	formatWriter exportFormatWriter
 }
 
 var OpenFile = os.OpenFile
@@ -87,6 +92,8 @@ func (cld *CloseExportData) Close() {
 
 func initExportFileParam(ep *ExportParam, mrs *MysqlResultSet) {
 	ep.DefaultBufSize *= 1024 * 1024
+	ep.FileCnt = 0
+	ep.formatWriter = nil
 	n := (int)(mrs.GetColumnCount())
 	if n <= 0 {
 		return
@@ -108,6 +115,9 @@ func initExportFileParam(ep *ExportParam, mrs *MysqlResultSet) {
 var openNewFile = func(ctx context.Context, ep *ExportParam, mrs *MysqlResultSet) error {
 	lineSize := ep.LineSize
 	var err error
+	if err = initExportFormatWriter(ctx, ep, mrs); err != nil {
+		return err
+	}
 	ep.CurFileSize = 0
 	if !ep.UseFileService {
 		filePath := getExportFilePath(ep.FilePath, ep.FileCnt)
@@ -124,7 +134,11 @@ var openNewFile = func(ctx context.Context, ep *ExportParam, mrs *MysqlResultSet
 			ep.LineBuffer.Reset()
 		}
 		ep.AsyncReader, ep.AsyncWriter = io.Pipe()
-		filePath := getExportFilePath(ep.FilePath, ep.FileCnt)
+		filePath := ep.FilePath
+		if ep.FileServicePath != "" {
+			filePath = ep.FileServicePath
+		}
+		filePath = getExportFilePath(filePath, ep.FileCnt)
 
 		asyncWriteFunc := func() error {
 			vec := fileservice.IOVector{
@@ -149,6 +163,10 @@ var openNewFile = func(ctx context.Context, ep *ExportParam, mrs *MysqlResultSet
 		ep.AsyncGroup, _ = errgroup.WithContext(ctx)
 		ep.AsyncGroup.Go(asyncWriteFunc)
 	}
+	if ep.formatWriter != nil {
+		ep.Rows = 0
+		return ep.formatWriter.open(ctx, exportFileWriter(ep))
+	}
 	if ep.Header {
 		var header string
 		n := len(mrs.Columns)
@@ -0,0 +1,751 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/pierrec/lz4"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/marshal"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	// parquetExportRowGroupSize is the size of the row group of the exported parquet file
+	parquetExportRowGroupSize = 64 * 1024 * 1024
+	// parquetExportDecimalPrecision is the precision of the exported decimal column
+	parquetExportDecimalPrecision = 38
+	// binaryCharset is the charset of the binary column in the mysql protocol
+	binaryCharset = 0x3f
+	// utf8Charset is the charset of the text column in the mysql protocol
+	utf8Charset = 0x21
+)
+
+// exportFormatWriter writes the rows of the result set into the output file
+// in a format other than csv.
+type exportFormatWriter interface {
+	// open starts a new output file which is written into w.
+	open(ctx context.Context, w io.Writer) error
+	// encodeRow encodes the first row of the result set and returns the
+	// size of it. The size is counted before compression.
+	encodeRow(oq *outputQueue) (uint64, error)
+	// writeRow writes the row encoded by the last encodeRow.
+	writeRow() error
+	// close finishes the current output file.
+	close() error
+}
+
+// initExportFileService makes the output file written by the file service
+// if the output path has a file service part, such as 'etl:/dir/file' or
+// the s3 path with the arguments.
+func initExportFileService(ep *ExportParam, fs fileservice.FileService) error {
+	ep.UseFileService = false
+	ep.FileService = nil
+	ep.FileServicePath = ""
+	fsPath, err := fileservice.ParsePath(ep.FilePath)
+	if err != nil || fsPath.Service == "" {
+		// local file
+		return nil
+	}
+	etlFS, readPath, err := fileservice.GetForETL(fs, ep.FilePath)
+	if err != nil {
+		return err
+	}
+	ep.UseFileService = true
+	ep.FileService = etlFS
+	ep.FileServicePath = readPath
+	return nil
+}
+
+// initExportFormatWriter creates the writer of the output format if it is not csv.
+func initExportFormatWriter(ctx context.Context, ep *ExportParam, mrs *MysqlResultSet) error {
+	if ep.formatWriter != nil {
+		return nil
+	}
+	switch ep.FileFormat {
+	case "", tree.CSV:
+		if ep.Compression != "" && ep.Compression != tree.NOCOMPRESS {
+			return moerr.NewNotSupported(ctx, "export the csv file with the compression '%s'", ep.Compression)
+		}
+		return nil
+	case tree.JSONLINE:
+		w, err := newJsonlineExportWriter(ctx, ep, mrs)
+		if err != nil {
+			return err
+		}
+		ep.formatWriter = w
+	case tree.PARQUET:
+		w, err := newParquetExportWriter(ctx, ep, mrs)
+		if err != nil {
+			return err
+		}
+		ep.formatWriter = w
+	default:
+		return moerr.NewNotSupported(ctx, "export the file with the format '%s'", ep.FileFormat)
+	}
+	return nil
+}
+
+// exportFileWriter returns the writer of the current output file.
+func exportFileWriter(ep *ExportParam) io.Writer {
+	if ep.UseFileService {
+		return ep.AsyncWriter
+	}
+	return ep.Writer
+}
+
+// exportDataToFile writes the first row of the result set into the output file.
+func exportDataToFile(oq *outputQueue) error {
+	if oq.ep.formatWriter == nil {
+		return exportDataToCSVFile(oq)
+	}
+	return exportDataToFormatFile(oq)
+}
+
+// exportDataToFormatFile writes the row with the format writer, a new output
+// file is opened if the current one would be larger than the max file size.
+func exportDataToFormatFile(oq *outputQueue) error {
+	ep := oq.ep
+	size, err := ep.formatWriter.encodeRow(oq)
+	if err != nil {
+		return err
+	}
+	if ep.MaxFileSize != 0 && ep.CurFileSize+size > ep.MaxFileSize {
+		if ep.Rows == 0 {
+			return moerr.NewInternalError(oq.ctx, "the OneLine size is over the maxFileSize")
+		}
+		if err = closeExportFile(ep); err != nil {
+			return err
+		}
+		if err = openNewFile(oq.ctx, ep, oq.mrs); err != nil {
+			return err
+		}
+	}
+	if err = ep.formatWriter.writeRow(); err != nil {
+		return err
+	}
+	ep.CurFileSize += size
+	ep.Rows++
+	return nil
+}
+
+// closeExportFile finishes the current output file.
+func closeExportFile(ep *ExportParam) error {
+	if ep.formatWriter != nil {
+		if err := ep.formatWriter.close(); err != nil {
+			return err
+		}
+	}
+	if err := Flush(ep); err != nil {
+		return err
+	}
+	return Close(ep)
+}
+
+// abortExportFile stops the writing of the output file written by the file
+// service after a failure, it does nothing if the file has been closed.
+func abortExportFile(ep *ExportParam) {
+	if !ep.UseFileService || ep.AsyncWriter == nil {
+		return
+	}
+	_ = ep.AsyncWriter.CloseWithError(moerr.NewInternalErrorNoCtx("export data aborted"))
+	_ = ep.AsyncGroup.Wait()
+	_ = ep.AsyncReader.Close()
+	ep.AsyncReader = nil
+	ep.AsyncWriter = nil
+	ep.AsyncGroup = nil
+}
+
+// exportColumns returns the mysql columns of the result set.
+func exportColumns(ctx context.Context, mrs *MysqlResultSet) ([]*MysqlColumn, error) {
+	cols := make([]*MysqlColumn, mrs.GetColumnCount())
+	for i := range cols {
+		column, err := mrs.GetColumn(ctx, uint64(i))
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "sendColumn need MysqlColumn")
+		}
+		cols[i] = mysqlColumn
+	}
+	return cols, nil
+}
+
+// isBinaryColumn reports whether the string column holds binary data.
+func isBinaryColumn(col *MysqlColumn) bool {
+	if col.ColumnType() == defines.MYSQL_TYPE_BLOB {
+		// the text column is sent as blob with the utf8 charset
+		return col.Charset() != utf8Charset
+	}
+	return col.Charset() == binaryCharset
+}
+
+// newExportCompressor wraps w with the compression of the jsonline file.
+func newExportCompressor(ctx context.Context, compression string, w io.Writer) (io.Writer, io.Closer, error) {
+	switch compression {
+	case "", tree.NOCOMPRESS:
+		return w, nil, nil
+	case tree.GZIP, tree.GZ:
+		cw := gzip.NewWriter(w)
+		return cw, cw, nil
+	case tree.FLATE:
+		cw, err := flate.NewWriter(w, flate.DefaultCompression)
+		if err != nil {
+			return nil, nil, err
+		}
+		return cw, cw, nil
+	case tree.ZLIB:
+		cw := zlib.NewWriter(w)
+		return cw, cw, nil
+	case tree.LZ4:
+		cw := lz4.NewWriter(w)
+		return cw, cw, nil
+	default:
+		return nil, nil, moerr.NewNotSupported(ctx, "export the jsonline file with the compression '%s'", compression)
+	}
+}
+
+// jsonlineExportWriter writes each row as a json object in a line.
+type jsonlineExportWriter struct {
+	compression string
+	cols        []*MysqlColumn
+	// keys are the encoded column names
+	keys [][]byte
+	w    io.Writer
+	// closer closes the compressor
+	closer io.Closer
+	line   []byte
+	buf    *bytes.Buffer
+	enc    *json.Encoder
+}
+
+func newJsonlineExportWriter(ctx context.Context, ep *ExportParam, mrs *MysqlResultSet) (*jsonlineExportWriter, error) {
+	// check the compression before any file is created
+	if _, _, err := newExportCompressor(ctx, ep.Compression, io.Discard); err != nil {
+		return nil, err
+	}
+	cols, err := exportColumns(ctx, mrs)
+	if err != nil {
+		return nil, err
+	}
+	jw := &jsonlineExportWriter{
+		compression: ep.Compression,
+		cols:        cols,
+		keys:        make([][]byte, len(cols)),
+		buf:         new(bytes.Buffer),
+	}
+	jw.enc = json.NewEncoder(jw.buf)
+	jw.enc.SetEscapeHTML(false)
+	for i, col := range cols {
+		key, err := jw.encodeValue(col.Name())
+		if err != nil {
+			return nil, err
+		}
+		jw.keys[i] = append([]byte(nil), key...)
+	}
+	return jw, nil
+}
+
+func (jw *jsonlineExportWriter) open(ctx context.Context, w io.Writer) (err error) {
+	jw.w, jw.closer, err = newExportCompressor(ctx, jw.compression, w)
+	return err
+}
+
+// encodeValue encodes v into a json value, the result is valid until the next call.
+func (jw *jsonlineExportWriter) encodeValue(v any) ([]byte, error) {
+	jw.buf.Reset()
+	if err := jw.enc.Encode(v); err != nil {
+		return nil, err
+	}
+	// trim the newline added by the encoder
+	return bytes.TrimSuffix(jw.buf.Bytes(), []byte{'\n'}), nil
+}
+
+func (jw *jsonlineExportWriter) encodeRow(oq *outputQueue) (uint64, error) {
+	ctx := oq.ctx
+	mrs := oq.mrs
+	jw.line = append(jw.line[:0], '{')
+	for i, col := range jw.cols {
+		if i > 0 {
+			jw.line = append(jw.line, ',')
+		}
+		jw.line = append(jw.line, jw.keys[i]...)
+		jw.line = append(jw.line, ':')
+		idx := uint64(i)
+		if isNil, err := mrs.ColumnIsNull(ctx, 0, idx); err != nil {
+			return 0, err
+		} else if isNil {
+			jw.line = append(jw.line, "null"...)
+			continue
+		}
+		value, err := mrs.GetValue(ctx, 0, idx)
+		if err != nil {
+			return 0, err
+		}
+		switch col.ColumnType() {
+		case defines.MYSQL_TYPE_DECIMAL:
+			// the decimal string is a valid json number
+			s, err := mrs.GetString(ctx, 0, idx)
+			if err != nil {
+				return 0, err
+			}
+			jw.line = append(jw.line, s...)
+		case defines.MYSQL_TYPE_BOOL:
+			v, err := mrs.GetInt64(ctx, 0, idx)
+			if err != nil {
+				return 0, err
+			}
+			jw.line = strconv.AppendBool(jw.line, v != 0)
+		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG,
+			defines.MYSQL_TYPE_LONGLONG, defines.MYSQL_TYPE_YEAR:
+			if !col.IsSigned() {
+				v, err := mrs.GetUint64(ctx, 0, idx)
+				if err != nil {
+					return 0, err
+				}
+				jw.line = strconv.AppendUint(jw.line, v, 10)
+			} else {
+				v, err := mrs.GetInt64(ctx, 0, idx)
+				if err != nil {
+					return 0, err
+				}
+				jw.line = strconv.AppendInt(jw.line, v, 10)
+			}
+		case defines.MYSQL_TYPE_FLOAT, defines.MYSQL_TYPE_DOUBLE:
+			switch v := value.(type) {
+			case string:
+				// the float with scale has been formatted
+				jw.line = append(jw.line, v...)
+			default:
+				data, err := jw.encodeValue(v)
+				if err != nil {
+					return 0, err
+				}
+				jw.line = append(jw.line, data...)
+			}
+		case defines.MYSQL_TYPE_JSON:
+			jw.line = append(jw.line, value.(bytejson.ByteJson).String()...)
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_TIME,
+			defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_UUID:
+			var s string
+			if date, ok := value.(types.Date); ok {
+				s = date.String()
+			} else if s, err = mrs.GetString(ctx, 0, idx); err != nil {
+				return 0, err
+			}
+			data, err := jw.encodeValue(s)
+			if err != nil {
+				return 0, err
+			}
+			jw.line = append(jw.line, data...)
+		default:
+			return 0, moerr.NewInternalError(ctx, "unsupported column type %d ", col.ColumnType())
+		}
+	}
+	jw.line = append(jw.line, '}', '\n')
+	return uint64(len(jw.line)), nil
+}
+
+func (jw *jsonlineExportWriter) writeRow() error {
+	_, err := jw.w.Write(jw.line)
+	return err
+}
+
+func (jw *jsonlineExportWriter) close() error {
+	jw.w = nil
+	if jw.closer != nil {
+		closer := jw.closer
+		jw.closer = nil
+		return closer.Close()
+	}
+	return nil
+}
+
+// parquetExportColumn converts the value of a column into the parquet value.
+type parquetExportColumn struct {
+	col *MysqlColumn
+	// conv converts the value of the result set, it returns the parquet
+	// value and the size of it.
+	conv func(oq *outputQueue, idx uint64) (any, uint64, error)
+}
+
+// parquetExportWriter writes the rows into a parquet file.
+type parquetExportWriter struct {
+	codec  parquet.CompressionCodec
+	schema []*parquet.SchemaElement
+	cols   []*parquetExportColumn
+	pw     *writer.ParquetWriter
+	row    []any
+}
+
+func newParquetExportWriter(ctx context.Context, ep *ExportParam, mrs *MysqlResultSet) (*parquetExportWriter, error) {
+	xw := &parquetExportWriter{}
+	switch ep.Compression {
+	case "", "snappy":
+		xw.codec = parquet.CompressionCodec_SNAPPY
+	case tree.NOCOMPRESS, "uncompressed":
+		xw.codec = parquet.CompressionCodec_UNCOMPRESSED
+	case tree.GZIP, tree.GZ:
+		xw.codec = parquet.CompressionCodec_GZIP
+	case tree.LZ4:
+		xw.codec = parquet.CompressionCodec_LZ4
+	case "zstd":
+		xw.codec = parquet.CompressionCodec_ZSTD
+	default:
+		return nil, moerr.NewNotSupported(ctx, "export the parquet file with the compression '%s'", ep.Compression)
+	}
+	cols, err := exportColumns(ctx, mrs)
+	if err != nil {
+		return nil, err
+	}
+	rootRepetition := parquet.FieldRepetitionType_REQUIRED
+	numChildren := int32(len(cols))
+	xw.schema = append(xw.schema, &parquet.SchemaElement{
+		Name:           "schema",
+		RepetitionType: &rootRepetition,
+		NumChildren:    &numChildren,
+	})
+	// the names of the columns must be unique in the parquet file
+	names := make(map[string]struct{}, len(cols))
+	for _, col := range cols {
+		name := col.Name()
+		for i := 1; ; i++ {
+			if _, ok := names[common.StringToVariableName(name)]; !ok {
+				break
+			}
+			name = col.Name() + "_" + strconv.Itoa(i)
+		}
+		names[common.StringToVariableName(name)] = struct{}{}
+		elem, xcol, err := parquetExportType(ctx, col)
+		if err != nil {
+			return nil, err
+		}
+		elem.Name = name
+		repetition := parquet.FieldRepetitionType_OPTIONAL
+		elem.RepetitionType = &repetition
+		xw.schema = append(xw.schema, elem)
+		xw.cols = append(xw.cols, xcol)
+	}
+	xw.row = make([]any, len(cols))
+	return xw, nil
+}
+
+func (xw *parquetExportWriter) open(_ context.Context, w io.Writer) error {
+	pw, err := writer.NewParquetWriterFromWriter(w, xw.schema, 1)
+	if err != nil {
+		return err
+	}
+	pw.MarshalFunc = marshal.MarshalCSV
+	pw.CompressionType = xw.codec
+	pw.RowGroupSize = parquetExportRowGroupSize
+	xw.pw = pw
+	return nil
+}
+
+func (xw *parquetExportWriter) encodeRow(oq *outputQueue) (uint64, error) {
+	var size uint64
+	for i, xcol := range xw.cols {
+		idx := uint64(i)
+		if isNil, err := oq.mrs.ColumnIsNull(oq.ctx, 0, idx); err != nil {
+			return 0, err
+		} else if isNil {
+			xw.row[i] = nil
+			continue
+		}
+		v, n, err := xcol.conv(oq, idx)
+		if err != nil {
+			return 0, err
+		}
+		xw.row[i] = v
+		size += n
+	}
+	return size, nil
+}
+
+func (xw *parquetExportWriter) writeRow() error {
+	// the writer keeps the row until the row group is flushed
+	row := make([]any, len(xw.row))
+	copy(row, xw.row)
+	return xw.pw.Write(row)
+}
+
+func (xw *parquetExportWriter) close() error {
+	if xw.pw == nil {
+		return nil
+	}
+	pw := xw.pw
+	xw.pw = nil
+	return pw.WriteStop()
+}
+
+// parquetExportType maps the mysql column to the parquet column.
+func parquetExportType(ctx context.Context, col *MysqlColumn) (*parquet.SchemaElement, *parquetExportColumn, error) {
+	elem := &parquet.SchemaElement{}
+	xcol := &parquetExportColumn{col: col}
+	setType := func(typ parquet.Type) {
+		elem.Type = &typ
+	}
+	setConvertedType := func(typ parquet.ConvertedType) {
+		elem.ConvertedType = &typ
+	}
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		setType(parquet.Type_BOOLEAN)
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			v, err := oq.mrs.GetInt64(oq.ctx, 0, idx)
+			return v != 0, 1, err
+		}
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG,
+		defines.MYSQL_TYPE_YEAR:
+		var bitWidth int8
+		switch col.ColumnType() {
+		case defines.MYSQL_TYPE_TINY:
+			bitWidth = 8
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			bitWidth = 16
+		default:
+			bitWidth = 32
+		}
+		signed := col.IsSigned()
+		setType(parquet.Type_INT32)
+		setConvertedType(parquetIntConvertedType(bitWidth, signed))
+		elem.LogicalType = &parquet.LogicalType{
+			INTEGER: &parquet.IntType{BitWidth: bitWidth, IsSigned: signed},
+		}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			if !signed {
+				v, err := oq.mrs.GetUint64(oq.ctx, 0, idx)
+				return int32(uint32(v)), 4, err
+			}
+			v, err := oq.mrs.GetInt64(oq.ctx, 0, idx)
+			return int32(v), 4, err
+		}
+	case defines.MYSQL_TYPE_LONGLONG:
+		signed := col.IsSigned()
+		setType(parquet.Type_INT64)
+		setConvertedType(parquetIntConvertedType(64, signed))
+		elem.LogicalType = &parquet.LogicalType{
+			INTEGER: &parquet.IntType{BitWidth: 64, IsSigned: signed},
+		}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			if !signed {
+				v, err := oq.mrs.GetUint64(oq.ctx, 0, idx)
+				return int64(v), 8, err
+			}
+			v, err := oq.mrs.GetInt64(oq.ctx, 0, idx)
+			return v, 8, err
+		}
+	case defines.MYSQL_TYPE_FLOAT:
+		setType(parquet.Type_FLOAT)
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			v, err := oq.mrs.GetFloat64(oq.ctx, 0, idx)
+			return float32(v), 4, err
+		}
+	case defines.MYSQL_TYPE_DOUBLE:
+		setType(parquet.Type_DOUBLE)
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			v, err := oq.mrs.GetFloat64(oq.ctx, 0, idx)
+			return v, 8, err
+		}
+	case defines.MYSQL_TYPE_DECIMAL:
+		precision, scale := int32(parquetExportDecimalPrecision), int32(col.Decimal())
+		typeLength := int32(16)
+		setType(parquet.Type_FIXED_LEN_BYTE_ARRAY)
+		setConvertedType(parquet.ConvertedType_DECIMAL)
+		elem.TypeLength = &typeLength
+		elem.Precision = &precision
+		elem.Scale = &scale
+		elem.LogicalType = &parquet.LogicalType{
+			DECIMAL: &parquet.DecimalType{Precision: precision, Scale: scale},
+		}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			s, err := oq.mrs.GetString(oq.ctx, 0, idx)
+			if err != nil {
+				return nil, 0, err
+			}
+			v, err := parquetDecimalBytes(oq.ctx, s, scale, int(typeLength))
+			return v, uint64(typeLength), err
+		}
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+		defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT:
+		setType(parquet.Type_BYTE_ARRAY)
+		if !isBinaryColumn(col) {
+			setConvertedType(parquet.ConvertedType_UTF8)
+			elem.LogicalType = &parquet.LogicalType{STRING: parquet.NewStringType()}
+		}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			s, err := oq.mrs.GetString(oq.ctx, 0, idx)
+			return s, uint64(len(s)), err
+		}
+	case defines.MYSQL_TYPE_JSON:
+		setType(parquet.Type_BYTE_ARRAY)
+		setConvertedType(parquet.ConvertedType_JSON)
+		elem.LogicalType = &parquet.LogicalType{JSON: parquet.NewJsonType()}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			s, err := oq.mrs.GetString(oq.ctx, 0, idx)
+			return s, uint64(len(s)), err
+		}
+	case defines.MYSQL_TYPE_UUID:
+		typeLength := int32(16)
+		setType(parquet.Type_FIXED_LEN_BYTE_ARRAY)
+		elem.TypeLength = &typeLength
+		elem.LogicalType = &parquet.LogicalType{UUID: parquet.NewUUIDType()}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			s, err := oq.mrs.GetString(oq.ctx, 0, idx)
+			if err != nil {
+				return nil, 0, err
+			}
+			u, err := types.ParseUuid(s)
+			return string(u[:]), uint64(typeLength), err
+		}
+	case defines.MYSQL_TYPE_DATE:
+		setType(parquet.Type_INT32)
+		setConvertedType(parquet.ConvertedType_DATE)
+		elem.LogicalType = &parquet.LogicalType{DATE: parquet.NewDateType()}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			value, err := oq.mrs.GetValue(oq.ctx, 0, idx)
+			if err != nil {
+				return nil, 0, err
+			}
+			return value.(types.Date).DaysSinceUnixEpoch(), 4, nil
+		}
+	case defines.MYSQL_TYPE_TIME:
+		setType(parquet.Type_INT64)
+		setConvertedType(parquet.ConvertedType_TIME_MICROS)
+		elem.LogicalType = &parquet.LogicalType{
+			TIME: &parquet.TimeType{IsAdjustedToUTC: false, Unit: parquetMicrosUnit()},
+		}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			s, err := oq.mrs.GetString(oq.ctx, 0, idx)
+			if err != nil {
+				return nil, 0, err
+			}
+			t, err := types.ParseTime(s, 6)
+			return int64(t), 8, err
+		}
+	case defines.MYSQL_TYPE_DATETIME:
+		// the converted type TIMESTAMP_MICROS means the time is adjusted to utc
+		setType(parquet.Type_INT64)
+		elem.LogicalType = &parquet.LogicalType{
+			TIMESTAMP: &parquet.TimestampType{IsAdjustedToUTC: false, Unit: parquetMicrosUnit()},
+		}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			s, err := oq.mrs.GetString(oq.ctx, 0, idx)
+			if err != nil {
+				return nil, 0, err
+			}
+			dt, err := types.ParseDatetime(s, 6)
+			return int64(dt) - types.GetUnixEpochSecs(), 8, err
+		}
+	case defines.MYSQL_TYPE_TIMESTAMP:
+		setType(parquet.Type_INT64)
+		setConvertedType(parquet.ConvertedType_TIMESTAMP_MICROS)
+		elem.LogicalType = &parquet.LogicalType{
+			TIMESTAMP: &parquet.TimestampType{IsAdjustedToUTC: true, Unit: parquetMicrosUnit()},
+		}
+		xcol.conv = func(oq *outputQueue, idx uint64) (any, uint64, error) {
+			s, err := oq.mrs.GetString(oq.ctx, 0, idx)
+			if err != nil {
+				return nil, 0, err
+			}
+			loc := time.Local
+			if oq.ses != nil {
+				loc = oq.ses.GetTimeZone()
+			}
+			ts, err := types.ParseTimestamp(loc, s, 6)
+			return int64(ts) - types.GetUnixEpochSecs(), 8, err
+		}
+	default:
+		return nil, nil, moerr.NewNotSupported(ctx, "export the column '%s' of type %d in parquet", col.Name(), col.ColumnType())
+	}
+	return elem, xcol, nil
+}
+
+func parquetIntConvertedType(bitWidth int8, signed bool) parquet.ConvertedType {
+	switch bitWidth {
+	case 8:
+		if signed {
+			return parquet.ConvertedType_INT_8
+		}
+		return parquet.ConvertedType_UINT_8
+	case 16:
+		if signed {
+			return parquet.ConvertedType_INT_16
+		}
+		return parquet.ConvertedType_UINT_16
+	case 32:
+		if signed {
+			return parquet.ConvertedType_INT_32
+		}
+		return parquet.ConvertedType_UINT_32
+	default:
+		if signed {
+			return parquet.ConvertedType_INT_64
+		}
+		return parquet.ConvertedType_UINT_64
+	}
+}
+
+func parquetMicrosUnit() *parquet.TimeUnit {
+	return &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()}
+}
+
+// parquetDecimalBytes encodes the decimal string into the unscaled value in
+// big-endian two's complement of n bytes.
+func parquetDecimalBytes(ctx context.Context, s string, scale int32, n int) (string, error) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if len(fracPart) > int(scale) {
+		fracPart = fracPart[:scale]
+	} else {
+		fracPart += strings.Repeat("0", int(scale)-len(fracPart))
+	}
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return "", moerr.NewInvalidInput(ctx, "invalid decimal value '%s'", s)
+	}
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	if unscaled.BitLen() >= n*8 {
+		return "", moerr.NewOutOfRange(ctx, "decimal", "value '%s'", s)
+	}
+	if unscaled.Sign() < 0 {
+		unscaled.Add(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(n*8)))
+	}
+	return string(unscaled.FillBytes(make([]byte, n))), nil
+}
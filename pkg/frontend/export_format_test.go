@@ -0,0 +1,268 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const exportFormatTestRows = 100
+
+func newExportFormatTestQueue(path, format, compression string, maxFileSize uint64) *outputQueue {
+	mrs := &MysqlResultSet{}
+	addColumn := func(name string, typ defines.MysqlType, decimal int32) {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		col.SetColumnType(typ)
+		col.SetDecimal(decimal)
+		setCharacter(col)
+		mrs.AddColumn(col)
+	}
+	addColumn("id", defines.MYSQL_TYPE_LONGLONG, 0)
+	addColumn("name", defines.MYSQL_TYPE_VARCHAR, 0)
+	addColumn("price", defines.MYSQL_TYPE_DECIMAL, 2)
+	addColumn("day", defines.MYSQL_TYPE_DATE, 0)
+	addColumn("dt", defines.MYSQL_TYPE_DATETIME, 0)
+	addColumn("flag", defines.MYSQL_TYPE_BOOL, 0)
+	mrs.Data = make([][]interface{}, 1)
+	mrs.Data[0] = make([]interface{}, mrs.GetColumnCount())
+	return &outputQueue{
+		ctx: context.TODO(),
+		mrs: mrs,
+		ep: &ExportParam{
+			ExportParam: &tree.ExportParam{
+				Outfile:     true,
+				FilePath:    path,
+				Fields:      &tree.Fields{Terminated: ","},
+				Lines:       &tree.Lines{TerminatedBy: "\n"},
+				MaxFileSize: maxFileSize,
+				FileFormat:  format,
+				Compression: compression,
+			},
+			DefaultBufSize: 1,
+		},
+	}
+}
+
+func exportFormatTestData(t *testing.T, oq *outputQueue) {
+	initExportFileParam(oq.ep, oq.mrs)
+	require.NoError(t, openNewFile(oq.ctx, oq.ep, oq.mrs))
+	for i := 0; i < exportFormatTestRows; i++ {
+		row := oq.mrs.Data[0]
+		row[0] = int64(i)
+		row[1] = []byte(fmt.Sprintf("name \"%d\"", i))
+		row[2] = fmt.Sprintf("%d.%02d", i, i)
+		row[3] = types.Date(738000 + i)
+		row[4] = fmt.Sprintf("2023-01-01 00:00:%02d", i%60)
+		row[5] = i%2 == 0
+		if i%10 == 0 {
+			row[1] = nil
+		}
+		require.NoError(t, exportDataToFile(oq))
+	}
+	require.NoError(t, closeExportFile(oq.ep))
+}
+
+func readJsonlineExportFile(t *testing.T, path string, compression string) []map[string]any {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var r io.Reader = f
+	if compression == tree.GZIP {
+		gr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		defer gr.Close()
+		r = gr
+	}
+	var rows []map[string]any
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		row := make(map[string]any)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+		rows = append(rows, row)
+	}
+	require.NoError(t, scanner.Err())
+	return rows
+}
+
+func TestExportJsonline(t *testing.T) {
+	for _, compression := range []string{"", tree.GZIP} {
+		path := filepath.Join(t.TempDir(), "export.jsonl")
+		oq := newExportFormatTestQueue(path, tree.JSONLINE, compression, 0)
+		exportFormatTestData(t, oq)
+
+		rows := readJsonlineExportFile(t, path, compression)
+		require.Equal(t, exportFormatTestRows, len(rows))
+		for i, row := range rows {
+			require.Equal(t, float64(i), row["id"])
+			if i%10 == 0 {
+				require.Nil(t, row["name"])
+			} else {
+				require.Equal(t, fmt.Sprintf("name \"%d\"", i), row["name"])
+			}
+			require.Equal(t, float64(i)+float64(i)/100, row["price"])
+			require.Equal(t, types.Date(738000+i).String(), row["day"])
+			require.Equal(t, fmt.Sprintf("2023-01-01 00:00:%02d", i%60), row["dt"])
+			require.Equal(t, i%2 == 0, row["flag"])
+		}
+	}
+}
+
+func TestExportJsonlineSplit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.jsonl")
+	oq := newExportFormatTestQueue(path, tree.JSONLINE, "", 1024)
+	exportFormatTestData(t, oq)
+	require.Greater(t, oq.ep.FileCnt, uint(1))
+
+	rows := 0
+	for i := uint(0); i < oq.ep.FileCnt; i++ {
+		filePath := getExportFilePath(path, i)
+		info, err := os.Stat(filePath)
+		require.NoError(t, err)
+		require.LessOrEqual(t, uint64(info.Size()), uint64(1024))
+		for _, row := range readJsonlineExportFile(t, filePath, "") {
+			require.Equal(t, float64(rows), row["id"])
+			rows++
+		}
+	}
+	require.Equal(t, exportFormatTestRows, rows)
+}
+
+func TestExportParquet(t *testing.T) {
+	ctx := context.TODO()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "export.parquet")
+	oq := newExportFormatTestQueue(path, tree.PARQUET, "zstd", 0)
+	exportFormatTestData(t, oq)
+
+	// read the file back as an external table
+	attrs := []string{"id", "name", "price", "day", "dt", "flag"}
+	typs := []types.Type{
+		types.T_int64.ToType(),
+		types.T_varchar.ToType(),
+		types.New(types.T_decimal128, 38, 2),
+		types.T_date.ToType(),
+		types.T_datetime.ToType(),
+		types.T_bool.ToType(),
+	}
+	cols := make([]*plan.ColDef, len(attrs))
+	for i := range attrs {
+		cols[i] = &plan.ColDef{
+			Name: attrs[i],
+			Typ: &plan.Type{
+				Id:    int32(typs[i].Oid),
+				Width: typs[i].Width,
+				Scale: typs[i].Scale,
+			},
+		}
+	}
+	param := &external.ExternalParam{
+		ExParamConst: external.ExParamConst{
+			Attrs: attrs,
+			Cols:  cols,
+			Extern: &tree.ExternParam{
+				ExParamConst: tree.ExParamConst{
+					Filepath: path,
+					Format:   tree.PARQUET,
+					Tail:     &tree.TailParameter{},
+				},
+				ExParam: tree.ExParam{
+					Ctx: ctx,
+				},
+			},
+		},
+		ExParam: external.ExParam{
+			Fileparam: &external.ExFileparam{Filepath: path, FileCnt: 1},
+			Filter:    &external.FilterParam{},
+		},
+	}
+	proc := testutil.NewProcess()
+	bat, err := external.ScanParquetFile(ctx, param, proc)
+	require.NoError(t, err)
+	defer bat.Clean(proc.Mp())
+	require.True(t, param.Fileparam.End)
+	require.Equal(t, exportFormatTestRows, bat.Length())
+	for i := 0; i < exportFormatTestRows; i++ {
+		require.Equal(t, int64(i), vector.GetFixedAt[int64](bat.Vecs[0], i))
+		if i%10 == 0 {
+			require.True(t, bat.Vecs[1].GetNulls().Contains(uint64(i)))
+		} else {
+			require.Equal(t, fmt.Sprintf("name \"%d\"", i), bat.Vecs[1].GetStringAt(i))
+		}
+		require.Equal(t, fmt.Sprintf("%d.%02d", i, i), vector.GetFixedAt[types.Decimal128](bat.Vecs[2], i).ToStringWithScale(2))
+		require.Equal(t, types.Date(738000+i), vector.GetFixedAt[types.Date](bat.Vecs[3], i))
+		require.Equal(t, fmt.Sprintf("2023-01-01 00:00:%02d", i%60), vector.GetFixedAt[types.Datetime](bat.Vecs[4], i).String())
+		require.Equal(t, i%2 == 0, vector.GetFixedAt[bool](bat.Vecs[5], i))
+	}
+}
+
+func TestExportFileService(t *testing.T) {
+	fs, err := fileservice.NewMemoryFS("etl")
+	require.NoError(t, err)
+	oq := newExportFormatTestQueue("etl:/dir/export.jsonl", tree.JSONLINE, "", 0)
+	require.NoError(t, initExportFileService(oq.ep, fs))
+	require.True(t, oq.ep.UseFileService)
+	require.Equal(t, "etl:/dir/export.jsonl", oq.ep.FileServicePath)
+	exportFormatTestData(t, oq)
+
+	vec := &fileservice.IOVector{
+		FilePath: "dir/export.jsonl",
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+	}
+	require.NoError(t, fs.Read(context.TODO(), vec))
+	lines := 0
+	scanner := bufio.NewScanner(bytes.NewReader(vec.Entries[0].Data))
+	for scanner.Scan() {
+		lines++
+	}
+	require.Equal(t, exportFormatTestRows, lines)
+
+	// the local path is not written by the file service
+	oq.ep.FilePath = "/tmp/export.jsonl"
+	require.NoError(t, initExportFileService(oq.ep, fs))
+	require.False(t, oq.ep.UseFileService)
+}
+
+func TestExportFormatInvalidCompression(t *testing.T) {
+	for _, format := range []string{tree.CSV, tree.JSONLINE, tree.PARQUET} {
+		oq := newExportFormatTestQueue(filepath.Join(t.TempDir(), "export"), format, "bzip3", 0)
+		initExportFileParam(oq.ep, oq.mrs)
+		require.Error(t, openNewFile(oq.ctx, oq.ep, oq.mrs))
+	}
+}
@@ -250,6 +250,10 @@ func (ip *internalProtocol) ParseExecuteData(ctx context.Context, stmt *PrepareS
 	return nil, nil, nil
 }
 
+func (ip *internalProtocol) HandleChangeUser(ctx context.Context, payload []byte) error {
+	return nil
+}
+
 func (ip *internalProtocol) SendPrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
 	return nil
 }
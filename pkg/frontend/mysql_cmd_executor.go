@@ -31,6 +31,7 @@ import (
 
 	"github.com/fagongzi/goetty/v2"
 	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
@@ -398,8 +399,8 @@ func (oq *outputQueue) flush() error {
 		return nil
 	}
 	if oq.ep.Outfile {
-		if err := exportDataToCSVFile(oq); err != nil {
-			logErrorf(oq.ses.GetConciseProfile(), "export to csv file error %v", err)
+		if err := exportDataToFile(oq); err != nil {
+			logErrorf(oq.ses.GetConciseProfile(), "export to file error %v", err)
 			return err
 		}
 	} else {
@@ -682,6 +683,30 @@ func (mce *MysqlCmdExecutor) handleChangeDB(requestCtx context.Context, db strin
 	return doUse(requestCtx, mce.GetSession(), db)
 }
 
+// doChangeUserDatabase uses the database of COM_CHANGE_USER, which is checked as
+// the statement USE of the new user: the privilege to use it and its existence.
+func doChangeUserDatabase(ctx context.Context, ses *Session, db string) (err error) {
+	tenant := ses.GetTenantInfo()
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, tenant.GetTenantID())
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, tenant.GetUserID())
+	ctx = context.WithValue(ctx, defines.RoleIDKey{}, tenant.GetDefaultRoleID())
+	v, err := ses.GetGlobalVar("lower_case_table_names")
+	if err != nil {
+		return err
+	}
+	stmt := &tree.Use{Name: tree.NewCStr(db, v.(int64))}
+	if err = authenticateUserCanExecuteStatement(ctx, ses, stmt); err != nil {
+		return err
+	}
+	defer func() {
+		// the txn checking the database is not kept
+		if rbErr := ses.TxnRollback(); err == nil {
+			err = rbErr
+		}
+	}()
+	return doUse(ctx, ses, stmt.Name.Compare())
+}
+
 func (mce *MysqlCmdExecutor) handleDump(requestCtx context.Context, dump *tree.MoDump) error {
 	var err error
 	if !dump.DumpDatabase {
@@ -1337,25 +1362,77 @@ func constructVarBatch(ses *Session, rows [][]interface{}) (*batch.Batch, error)
 	return bat, nil
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
+// handleAnalyzeStmt collects the statistics of the columns and persists them into
+// mo_catalog.mo_column_stats, which are used by the optimizer to estimate the
+// selectivity of the filters and the cardinality of the joins.
+func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt, cwIndex, cwsLen int) error {
+	ses := mce.GetSession()
+	tcc := ses.GetTxnCompileCtx()
+	dbName, err := tcc.ensureDatabaseIsNotEmpty(string(stmt.Table.SchemaName))
+	if err != nil {
+		return err
+	}
+	tblName := string(stmt.Table.ObjectName)
+	if isBannedDatabase(dbName) {
+		return moerr.NewNotSupported(requestCtx, "analyze table in the system database %s", dbName)
+	}
+	ctx, table, err := tcc.getRelation(dbName, tblName)
+	if err != nil {
+		return moerr.NewNoSuchTable(requestCtx, dbName, tblName)
+	}
+	_, tableDef := tcc.getTableDef(ctx, table, dbName, tblName)
+	if tableDef == nil {
+		return moerr.NewNoSuchTable(requestCtx, dbName, tblName)
+	}
+	if tableDef.TableType == catalog.SystemViewRel || tableDef.TableType == catalog.SystemExternalRel {
+		return moerr.NewNotSupported(requestCtx, "analyze table %s.%s which is not a base table", dbName, tblName)
+	}
+	names := make([]string, len(stmt.Cols))
+	for i, col := range stmt.Cols {
+		names[i] = string(col)
+	}
+	cols, err := getAnalyzeColumns(requestCtx, tableDef, names)
+	if err != nil {
+		return err
+	}
+
+	if len(cols) > 0 {
+		// check the privilege of reading the columns by building the query collecting them
+		v, err := ses.GetGlobalVar("lower_case_table_names")
+		if err != nil {
+			return err
+		}
+		countStmt, err := mysql.ParseOne(requestCtx, makeAnalyzeCountSql(dbName, tblName, cols), v.(int64))
+		if err != nil {
+			return err
+		}
+		if _, err = buildPlan(requestCtx, ses, tcc, countStmt); err != nil {
+			return err
+		}
+
+		bh := ses.GetBackgroundExec(requestCtx)
+		defer bh.Close()
+		if err = analyzeTable(requestCtx, bh, table.GetTableID(ctx), dbName, tblName, cols); err != nil {
+			return err
 		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
 	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.GetDoQueryFunc()(requestCtx, sql)
+
+	columns := []string{"Table", "Op", "Msg_type", "Msg_text"}
+	mrs := ses.GetMysqlResultSet()
+	for _, name := range columns {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{dbName + "." + tblName, "analyze", "status", "OK"})
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, mrs)
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+	if err = ses.GetMysqlProtocol().SendResponse(requestCtx, resp); err != nil {
+		return moerr.NewInternalError(requestCtx, "routine send response failed. error:%v ", err)
+	}
+	return nil
 }
 
 // Note: for pass the compile quickly. We will remove the comments in the future.
@@ -1515,6 +1592,11 @@ func doDeallocate(ctx context.Context, ses *Session, st *tree.Deallocate) error
 }
 
 func doReset(ctx context.Context, ses *Session, st *tree.Reset) error {
+	prepareStmt, err := ses.GetPrepareStmt(string(st.Name))
+	if err != nil {
+		return err
+	}
+	prepareStmt.resetLongData()
 	return nil
 }
 
@@ -1666,6 +1748,72 @@ func (mce *MysqlCmdExecutor) handleShowAccounts(ctx context.Context, sa *tree.Sh
 	return err
 }
 
+// handleCreateChangefeed starts capturing the changes of the table
+func (mce *MysqlCmdExecutor) handleCreateChangefeed(ctx context.Context, st *tree.CreateChangefeed) error {
+	return doCreateChangefeed(ctx, mce.GetSession(), st)
+}
+
+// handleDropChangefeed stops capturing the changes of the table
+func (mce *MysqlCmdExecutor) handleDropChangefeed(ctx context.Context, st *tree.DropChangefeed) error {
+	return doDropChangefeed(ctx, mce.GetSession(), st)
+}
+
+// handleShowChangefeeds lists the changefeeds of the account
+func (mce *MysqlCmdExecutor) handleShowChangefeeds(ctx context.Context, st *tree.ShowChangefeeds, cwIndex, cwsLen int) error {
+	var err error
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	err = doShowChangefeeds(ctx, ses, st)
+	if err != nil {
+		return err
+	}
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.GetMysqlResultSet())
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+
+	if err = proto.SendResponse(ctx, resp); err != nil {
+		return moerr.NewInternalError(ctx, "routine send response failed. error:%v ", err)
+	}
+	return err
+}
+
+// handleCreateAuditPolicy starts auditing the events matched by the policy
+func (mce *MysqlCmdExecutor) handleCreateAuditPolicy(ctx context.Context, st *tree.CreateAuditPolicy) error {
+	return doCreateAuditPolicy(ctx, mce.GetSession(), st)
+}
+
+// handleDropAuditPolicy stops auditing the events matched by the policy
+func (mce *MysqlCmdExecutor) handleDropAuditPolicy(ctx context.Context, st *tree.DropAuditPolicy) error {
+	return doDropAuditPolicy(ctx, mce.GetSession(), st)
+}
+
+// handleShowAuditPolicies lists the audit policies of the account
+func (mce *MysqlCmdExecutor) handleShowAuditPolicies(ctx context.Context, st *tree.ShowAuditPolicies, cwIndex, cwsLen int) error {
+	var err error
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	err = doShowAuditPolicies(ctx, ses, st)
+	if err != nil {
+		return err
+	}
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.GetMysqlResultSet())
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+
+	if err = proto.SendResponse(ctx, resp); err != nil {
+		return moerr.NewInternalError(ctx, "routine send response failed. error:%v ", err)
+	}
+	return err
+}
+
+// handleCreatePolicy creates the row level security policy of the table
+func (mce *MysqlCmdExecutor) handleCreatePolicy(ctx context.Context, st *tree.CreatePolicy) error {
+	return doCreatePolicy(ctx, mce.GetSession(), st)
+}
+
+// handleDropPolicy drops the row level security policy of the table
+func (mce *MysqlCmdExecutor) handleDropPolicy(ctx context.Context, st *tree.DropPolicy) error {
+	return doDropPolicy(ctx, mce.GetSession(), st)
+}
+
 func GetExplainColumns(ctx context.Context, explainColName string) ([]interface{}, error) {
 	cols := []*plan2.ColDef{
 		{Typ: &plan2.Type{Id: int32(types.T_varchar)}, Name: explainColName},
@@ -1710,9 +1858,9 @@ func getExplainOption(requestCtx context.Context, options []tree.OptionElem) (*e
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_DOT
 				} else {
 					return nil, moerr.NewInvalidInput(requestCtx, "invalid explain option '%s', valud '%s'", v.Name, v.Value)
 				}
@@ -1933,6 +2081,7 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 			return nil, err
 		}
 	}
+	cwft.ses.auditPlan = cwft.plan
 
 	txnHandler := cwft.ses.GetTxnHandler()
 	if cacheHit && cwft.plan.NeedImplicitTxn() {
@@ -1953,13 +2102,20 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		addr = cwft.ses.GetParameterUnit().ClusterNodes[0].Addr
 	}
 	cwft.proc.FileService = cwft.ses.GetParameterUnit().FileService
-	cwft.compile = compile.New(addr, cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), requestCtx, cwft.ses.GetStorage(), cwft.proc, cwft.stmt)
+	// all the pipelines of the statement, including the remote ones, are canceled
+	// once it runs too long or allocates too much memory.
+	cwft.proc.Lim.MaxExecutionTime = int64(cwft.ses.getMaxExecutionTime(cwft.stmt))
+	cwft.proc.Lim.QueryDeadline = 0
+	cwft.proc.Lim.QueryMemLimit = cwft.ses.getQueryMemLimit()
+	limitCtx := cwft.proc.StartQueryLimiter(requestCtx)
+	cwft.compile = compile.New(addr, cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), limitCtx, cwft.ses.GetStorage(), cwft.proc, cwft.stmt)
 
 	if _, ok := cwft.stmt.(*tree.ExplainAnalyze); ok {
 		fill = func(obj interface{}, bat *batch.Batch) error { return nil }
 	}
-	err = cwft.compile.Compile(requestCtx, cwft.plan, cwft.ses, fill)
+	err = cwft.compile.Compile(limitCtx, cwft.plan, cwft.ses, fill)
 	if err != nil {
+		cwft.proc.StopQueryLimiter()
 		return nil, err
 	}
 	// check if it is necessary to initialize the temporary engine
@@ -1967,6 +2123,7 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		// 0. init memory-non-dist storage
 		dnStore, err := cwft.ses.SetTempTableStorage(cwft.GetClock())
 		if err != nil {
+			cwft.proc.StopQueryLimiter()
 			return nil, err
 		}
 
@@ -1998,6 +2155,7 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		// 3. init temp-db to store temporary relations
 		err = tempEngine.Create(requestCtx, defines.TEMPORARY_DBNAME, cwft.ses.txnHandler.txn)
 		if err != nil {
+			cwft.proc.StopQueryLimiter()
 			return nil, err
 		}
 
@@ -2104,7 +2262,7 @@ func buildPlan(requestCtx context.Context, ses *Session, ctx plan2.CompilerConte
 	}
 	switch stmt := stmt.(type) {
 	case *tree.Select, *tree.ParenSelect, *tree.ValuesStatement,
-		*tree.Update, *tree.Delete, *tree.Insert,
+		*tree.Update, *tree.Delete, *tree.Insert, *tree.Replace,
 		*tree.ShowDatabases, *tree.ShowTables, *tree.ShowColumns, *tree.ShowColumnNumber, *tree.ShowTableNumber,
 		*tree.ShowCreateDatabase, *tree.ShowCreateTable, *tree.ShowIndex,
 		*tree.ExplainStmt, *tree.ExplainAnalyze:
@@ -2363,6 +2521,30 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			rt: st,
 		})
+	case *tree.Savepoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&SavepointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			sp: st,
+		})
+	case *tree.RollbackToSavepoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&RollbackToSavepointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			rts: st,
+		})
+	case *tree.ReleaseSavepoint:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&ReleaseSavepointExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			rs: st,
+		})
 	case *tree.SetRole:
 		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
 		ret = (&SetRoleExecutor{
@@ -2451,6 +2633,54 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			da: st,
 		})
+	case *tree.CreateChangefeed:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&CreateChangefeedExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			cc: st,
+		})
+	case *tree.DropChangefeed:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&DropChangefeedExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			dc: st,
+		})
+	case *tree.CreateAuditPolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&CreateAuditPolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			cp: st,
+		})
+	case *tree.DropAuditPolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&DropAuditPolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			dp: st,
+		})
+	case *tree.CreatePolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&CreatePolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			cp: st,
+		})
+	case *tree.DropPolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&DropPolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			dp: st,
+		})
 	case *tree.AlterAccount:
 		ret = (&AlterAccountExecutor{
 			statusStmtExecutor: &statusStmtExecutor{
@@ -2562,6 +2792,13 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			av: st,
 		})
+	case *tree.AlterTable:
+		ret = (&AlterTableExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			at: st,
+		})
 	case *tree.DropView:
 		ret = (&DropViewExecutor{
 			statusStmtExecutor: &statusStmtExecutor{
@@ -2940,6 +3177,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.MaxMsgSize = pu.SV.MaxMessageSize
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.SpillSize = pu.SV.ProcessLimitationSpillSize
 	proc.SessionInfo = process.SessionInfo{
 		User:              ses.GetUserName(),
 		Host:              pu.SV.Host,
@@ -2989,6 +3227,12 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		ses.SetMysqlResultSet(nil)
 	}()
 
+	releaseQuery, err := ses.acquireQuery(requestCtx, cws)
+	if err != nil {
+		return err
+	}
+	defer releaseQuery()
+
 	var cmpBegin time.Time
 	var ret interface{}
 	var runner ComputationRunner
@@ -3033,6 +3277,10 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		}
 
+		if statementGrowsStorage(ses, stmt) {
+			ses.GetTxnHandler().SetGrowsStorage()
+		}
+
 		/*
 				if it is in an active or multi-statement transaction, we check the type of the statement.
 				Then we decide that if we can execute the statement.
@@ -3055,7 +3303,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		}
 
 		//check transaction states
-		switch stmt.(type) {
+		switch st := stmt.(type) {
 		case *tree.BeginTransaction:
 			err = ses.TxnBegin()
 			if err != nil {
@@ -3072,6 +3320,21 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.Savepoint:
+			err = ses.TxnSavepoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.RollbackToSavepoint:
+			err = ses.TxnRollbackToSavepoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
+		case *tree.ReleaseSavepoint:
+			err = ses.TxnReleaseSavepoint(string(st.Name))
+			if err != nil {
+				goto handleFailed
+			}
 		}
 
 		switch st := stmt.(type) {
@@ -3085,7 +3348,8 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		ses.GetTxnCompileCtx().SetQueryType(TXN_DEFAULT)
 
 		switch st := stmt.(type) {
-		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 			selfHandle = true
 		case *tree.SetRole:
 			selfHandle = true
@@ -3177,7 +3441,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
-			if err = mce.handleAnalyzeStmt(requestCtx, st); err != nil {
+			if err = mce.handleAnalyzeStmt(requestCtx, st, i, len(cws)); err != nil {
 				goto handleFailed
 			}
 		case *tree.ExplainStmt:
@@ -3312,6 +3576,46 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleShowAccounts(requestCtx, st, i, len(cws)); err != nil {
 				goto handleFailed
 			}
+		case *tree.CreateChangefeed:
+			selfHandle = true
+			if err = mce.handleCreateChangefeed(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropChangefeed:
+			selfHandle = true
+			if err = mce.handleDropChangefeed(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.ShowChangefeeds:
+			selfHandle = true
+			if err = mce.handleShowChangefeeds(requestCtx, st, i, len(cws)); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateAuditPolicy:
+			selfHandle = true
+			if err = mce.handleCreateAuditPolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropAuditPolicy:
+			selfHandle = true
+			if err = mce.handleDropAuditPolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreatePolicy:
+			selfHandle = true
+			if err = mce.handleCreatePolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropPolicy:
+			selfHandle = true
+			if err = mce.handleDropPolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.ShowAuditPolicies:
+			selfHandle = true
+			if err = mce.handleShowAuditPolicies(requestCtx, st, i, len(cws)); err != nil {
+				goto handleFailed
+			}
 		case *tree.Load:
 			if st.Local {
 				proc.LoadLocalReader, loadLocalWriter = io.Pipe()
@@ -3419,6 +3723,9 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if ep.Outfile {
 				ep.DefaultBufSize = pu.SV.ExportDataDefaultFlushSize
 				initExportFileParam(ep, mrs)
+				if err = initExportFileService(ep, pu.FileService); err != nil {
+					goto handleFailed
+				}
 				if err = openNewFile(requestCtx, ep, mrs); err != nil {
 					goto handleFailed
 				}
@@ -3435,10 +3742,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 
 			if ep.Outfile {
-				if err = ep.Writer.Flush(); err != nil {
-					goto handleFailed
-				}
-				if err = ep.File.Close(); err != nil {
+				if err = closeExportFile(ep); err != nil {
 					goto handleFailed
 				}
 			}
@@ -3465,9 +3769,10 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
 			*tree.CreateIndex, *tree.DropIndex,
 			*tree.CreateView, *tree.DropView,
-			*tree.AlterView,
-			*tree.Insert, *tree.Update,
+			*tree.AlterView, *tree.AlterTable,
+			*tree.Insert, *tree.Replace, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint,
 			*tree.SetVar,
 			*tree.Load,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
@@ -3624,16 +3929,21 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		}
 		switch stmt.(type) {
 		case *tree.CreateTable, *tree.DropTable,
-			*tree.CreateIndex, *tree.DropIndex, *tree.Insert, *tree.Update,
-			*tree.CreateView, *tree.DropView, *tree.AlterView, *tree.Load, *tree.MoDump,
+			*tree.CreateIndex, *tree.DropIndex, *tree.Insert, *tree.Replace, *tree.Update,
+			*tree.CreateView, *tree.DropView, *tree.AlterView, *tree.AlterTable, *tree.Load, *tree.MoDump,
 			*tree.CreateAccount, *tree.DropAccount, *tree.AlterAccount, *tree.AlterDataBaseConfig,
 			*tree.CreateFunction, *tree.DropFunction,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 			*tree.CreateRole, *tree.DropRole, *tree.Revoke, *tree.Grant,
+			*tree.CreateChangefeed, *tree.DropChangefeed,
+			*tree.CreateAuditPolicy, *tree.DropAuditPolicy,
+			*tree.CreatePolicy, *tree.DropPolicy,
 			*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword, *tree.Delete, *tree.TruncateTable, *tree.Use,
-			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 			resp := mce.setResponse(i, len(cws), rspLen)
-			if _, ok := stmt.(*tree.Insert); ok {
+			switch stmt.(type) {
+			case *tree.Insert, *tree.Replace:
 				resp.lastInsertId = proc.GetLastInsertID()
 				if proc.GetLastInsertID() != 0 {
 					ses.SetLastInsertID(proc.GetLastInsertID())
@@ -3709,6 +4019,10 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	handleFailed:
 		incStatementCounter(tenant, stmt)
 		incStatementErrorsCounter(tenant, stmt)
+		// stop writing the output file of the failed statement
+		if ep := ses.GetExportParam(); ep != nil && ep.Outfile {
+			abortExportFile(ep)
+		}
 		/*
 			Cases    | set Autocommit = 1/0 | BEGIN statement |
 			---------------------------------------------------
@@ -3786,6 +4100,7 @@ func (mce *MysqlCmdExecutor) doComQueryInProgress(requestCtx context.Context, sq
 	proc.Lim.Size = pu.SV.ProcessLimitationSize
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.SpillSize = pu.SV.ProcessLimitationSpillSize
 	proc.SessionInfo = process.SessionInfo{
 		User:              ses.GetUserName(),
 		Host:              pu.SV.Host,
@@ -3822,6 +4137,16 @@ func (mce *MysqlCmdExecutor) doComQueryInProgress(requestCtx context.Context, sq
 		return retErr
 	}
 
+	cws := make([]ComputationWrapper, len(stmtExecs))
+	for i, exec := range stmtExecs {
+		cws[i] = exec
+	}
+	releaseQuery, err := ses.acquireQuery(requestCtx, cws)
+	if err != nil {
+		return err
+	}
+	defer releaseQuery()
+
 	singleStatement := len(stmtExecs) == 1
 	for _, exec := range stmtExecs {
 		err = Execute(requestCtx, ses, proc, exec, beginInstant, sql, "", singleStatement)
@@ -3975,12 +4300,72 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		}
 		return resp, nil
 
+	case COM_STMT_SEND_LONG_DATA:
+		data := req.GetData().([]byte)
+		// there is no response for COM_STMT_SEND_LONG_DATA
+		if err = mce.parseStmtSendLongData(requestCtx, data); err != nil {
+			logErrorf(ses.GetConciseProfile(), "send long data failed. error:%v", err)
+		}
+		return nil, nil
+
+	case COM_RESET_CONNECTION:
+		logInfo(ses.GetConciseProfile(), "reset connection")
+		err = ses.ResetConnection()
+		if err != nil {
+			return NewGeneralErrorResponse(COM_RESET_CONNECTION, err), nil
+		}
+		return NewGeneralOkResponse(COM_RESET_CONNECTION), nil
+
+	case COM_CHANGE_USER:
+		data := req.GetData().([]byte)
+		// the response has been sent in the authentication
+		if err = ses.GetMysqlProtocol().HandleChangeUser(requestCtx, data); err != nil {
+			logErrorf(ses.GetConciseProfile(), "change user failed. error:%v", err)
+			return nil, err
+		}
+		return nil, nil
+
 	default:
 		resp = NewGeneralErrorResponse(req.GetCmd(), moerr.NewInternalError(requestCtx, "unsupported command. 0x%x", req.GetCmd()))
 	}
 	return resp, nil
 }
 
+// parseStmtSendLongData saves the data of the parameter for the next COM_STMT_EXECUTE,
+// which reports the error of the data of the statement, like a parameter longer than
+// max_allowed_packet.
+// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_send_long_data.html
+func (mce *MysqlCmdExecutor) parseStmtSendLongData(requestCtx context.Context, data []byte) (err error) {
+	if len(data) < 6 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	paramID := binary.LittleEndian.Uint16(data[4:6])
+
+	ses := mce.GetSession()
+	preStmt, err := ses.GetPrepareStmt(getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			preStmt.setLongDataError(err)
+		}
+	}()
+	dcPrepare, ok := preStmt.PreparePlan.GetDcl().Control.(*plan.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(requestCtx, "can not get Prepare plan in prepareStmt")
+	}
+	if int(paramID) >= len(dcPrepare.Prepare.ParamTypes) {
+		return moerr.NewInvalidInput(requestCtx, "invalid parameter %d of prepared statement %d", paramID, stmtID)
+	}
+	v, err := ses.GetSessionVar("max_allowed_packet")
+	if err != nil {
+		return err
+	}
+	return preStmt.appendLongData(requestCtx, paramID, data[6:], v.(int64))
+}
+
 func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data []byte) (string, error) {
 	// see https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
 	pos := 0
@@ -3996,7 +4381,12 @@ func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data [
 	if err != nil {
 		return "", err
 	}
+	if err = preStmt.longDataErr; err != nil {
+		preStmt.resetLongData()
+		return "", err
+	}
 	names, vars, err := ses.GetMysqlProtocol().ParseExecuteData(requestCtx, preStmt, data, pos)
+	preStmt.resetLongData()
 	if err != nil {
 		return "", err
 	}
@@ -4033,10 +4423,11 @@ func StatementCanBeExecutedInUncommittedTransaction(ses *Session, stmt tree.Stat
 	case *tree.CreateTable, *tree.CreateDatabase, *tree.CreateIndex, *tree.CreateView, *tree.AlterView:
 		return true, nil
 		//dml statement
-	case *tree.Insert, *tree.Update, *tree.Delete, *tree.Select, *tree.Load, *tree.MoDump, *tree.ValuesStatement:
+	case *tree.Insert, *tree.Replace, *tree.Update, *tree.Delete, *tree.Select, *tree.Load, *tree.MoDump, *tree.ValuesStatement:
 		return true, nil
 		//transaction
-	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+		*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 		return true, nil
 		//show
 	case *tree.ShowCreateTable,
@@ -4061,7 +4452,9 @@ func StatementCanBeExecutedInUncommittedTransaction(ses *Session, stmt tree.Stat
 		*tree.ShowTableNumber,
 		*tree.ShowColumnNumber,
 		*tree.ShowTableValues,
-		*tree.ShowAccounts:
+		*tree.ShowAccounts,
+		*tree.ShowChangefeeds,
+		*tree.ShowAuditPolicies:
 		return true, nil
 		//others
 	case *tree.ExplainStmt, *tree.ExplainAnalyze, *tree.ExplainFor, *InternalCmdFieldList:
@@ -4106,7 +4499,7 @@ func StatementCanBeExecutedInUncommittedTransaction(ses *Session, stmt tree.Stat
 func IsDDL(stmt tree.Statement) bool {
 	switch stmt.(type) {
 	case *tree.CreateTable, *tree.DropTable,
-		*tree.CreateView, *tree.DropView, *tree.AlterView,
+		*tree.CreateView, *tree.DropView, *tree.AlterView, *tree.AlterTable,
 		*tree.CreateDatabase, *tree.DropDatabase,
 		*tree.CreateIndex, *tree.DropIndex, *tree.TruncateTable:
 		return true
@@ -284,6 +284,67 @@ func Test_mce(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 		convey.So(resp, convey.ShouldBeNil)
 
+		st := tree.NewPrepareString(tree.Identifier(getPrepareStmtName(1)), "select ?")
+		preparePlan, err := buildPlan(ctx, nil, plan.NewEmptyCompilerContext(), st)
+		convey.So(err, convey.ShouldBeNil)
+		prepareStmt := &PrepareStmt{
+			Name:        getPrepareStmtName(1),
+			PreparePlan: preparePlan,
+		}
+		convey.So(ses.SetPrepareStmt(getPrepareStmtName(1), prepareStmt), convey.ShouldBeNil)
+
+		req = &Request{
+			cmd:  COM_STMT_SEND_LONG_DATA,
+			data: []byte{1, 0, 0, 0, 0, 0, 'a', 'b'},
+		}
+
+		resp, err = mce.ExecRequest(ctx, ses, req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(prepareStmt.longData[0], convey.ShouldResemble, []byte("ab"))
+
+		// the invalid parameter is reported by the next COM_STMT_EXECUTE
+		req.data = []byte{1, 0, 0, 0, 1, 0, 'a', 'b'}
+		resp, err = mce.ExecRequest(ctx, ses, req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(prepareStmt.longData, convey.ShouldHaveLength, 1)
+
+		execReq := &Request{
+			cmd:  COM_STMT_EXECUTE,
+			data: []byte{1, 0, 0, 0},
+		}
+		resp, err = mce.ExecRequest(ctx, ses, execReq)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+		convey.So(prepareStmt.longData, convey.ShouldBeNil)
+		convey.So(prepareStmt.longDataErr, convey.ShouldBeNil)
+
+		// the parameter is longer than max_allowed_packet
+		ses.SetSysVar("max_allowed_packet", int64(1024))
+		req.data = append([]byte{1, 0, 0, 0, 0, 0}, make([]byte, 1000)...)
+		resp, err = mce.ExecRequest(ctx, ses, req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(prepareStmt.longDataErr, convey.ShouldBeNil)
+		resp, err = mce.ExecRequest(ctx, ses, req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(prepareStmt.longDataErr, convey.ShouldNotBeNil)
+		convey.So(prepareStmt.longData[0], convey.ShouldBeNil)
+		resp, err = mce.ExecRequest(ctx, ses, execReq)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		req = &Request{
+			cmd: COM_RESET_CONNECTION,
+		}
+
+		resp, err = mce.ExecRequest(ctx, ses, req)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+		_, err = ses.GetPrepareStmt(getPrepareStmtName(1))
+		convey.So(err, convey.ShouldNotBeNil)
 	})
 }
 
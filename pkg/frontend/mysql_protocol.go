@@ -17,11 +17,18 @@ package frontend
 import (
 	"bytes"
 	"context"
+	crand "crypto/rand"
+	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/binary"
+	"encoding/pem"
 	"fmt"
 	"math"
 	"math/rand"
+	"net"
 	"strconv"
 	"strings"
 	"sync"
@@ -137,6 +144,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -180,6 +189,9 @@ type MysqlProtocol interface {
 	GetStats() string
 
 	ParseExecuteData(ctx context.Context, stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error)
+
+	//HandleChangeUser authenticates the user of COM_CHANGE_USER and sends the response
+	HandleChangeUser(ctx context.Context, payload []byte) error
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -263,6 +275,9 @@ type MysqlProtocolImpl struct {
 	//joint capability shared by the server and the client
 	capability uint32
 
+	//the compression level of zstd required by the client
+	zstdCompressionLevel uint8
+
 	//collation id
 	collationID int
 
@@ -399,6 +414,8 @@ type response41 struct {
 	database          string
 	clientPluginName  string
 	isAskForTlsHeader bool
+	// zstdCompressionLevel the compression level of zstd required by the client
+	zstdCompressionLevel uint8
 }
 
 // handshake response 320
@@ -486,6 +503,15 @@ func (mp *MysqlProtocolImpl) SendPrepareResponse(ctx context.Context, stmt *Prep
 	return nil
 }
 
+// isBlobType checks the parameter of the mysql type is passed as []byte
+func isBlobType(tp defines.MysqlType) bool {
+	switch tp {
+	case defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB, defines.MYSQL_TYPE_TEXT:
+		return true
+	}
+	return false
+}
+
 func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error) {
 	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
 	if !ok {
@@ -540,8 +566,16 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 			varName := getPrepareStmtSessionVarName(i)
 			names[i] = varName
 
-			// TODO :if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
+			// if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
 			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+			if longData, has := stmt.longData[uint16(i)]; has {
+				if (i<<1)+1 < len(stmt.ParamTypes) && isBlobType(defines.MysqlType(stmt.ParamTypes[i<<1])) {
+					vars[i] = longData
+				} else {
+					vars[i] = string(longData)
+				}
+				continue
+			}
 
 			if nullBitmaps[i>>3]&(1<<(uint(i)%8)) > 0 {
 				vars[i] = nil
@@ -1069,14 +1103,208 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 	return bytes.Equal(hash1, auth)
 }
 
+// checkSha2Scramble checks the scramble of the caching_sha2_password with the digest
+// SHA256(SHA256(password)).
+// Algorithm: scramble = SHA256( password ) XOR SHA256( SHA256( SHA256( password ) ) + salt )
+func checkSha2Scramble(digest, salt, scramble []byte) bool {
+	if len(scramble) != sha256.Size {
+		return false
+	}
+	sha := sha256.New()
+	sha.Write(digest)
+	sha.Write(salt)
+	hash := sha.Sum(nil)
+	for i := range hash {
+		hash[i] ^= scramble[i]
+	}
+	sum := sha256.Sum256(hash)
+	return bytes.Equal(sum[:], digest)
+}
+
+// sha2PasswordDigest returns SHA256(SHA256(password))
+func sha2PasswordDigest(password []byte) []byte {
+	hash := sha256.Sum256(password)
+	hash = sha256.Sum256(hash[:])
+	return hash[:]
+}
+
+type sha2PasswordCacheEntry struct {
+	authString string
+	digest     []byte
+}
+
+// sha2PasswordCache keeps SHA256(SHA256(password)) of the users that have passed the full
+// authentication of the caching_sha2_password. The entry is valid only when the
+// authentication_string of the user is not changed.
+type sha2PasswordCache struct {
+	mu      sync.RWMutex
+	entries map[string]sha2PasswordCacheEntry
+}
+
+var globalSha2PasswordCache = &sha2PasswordCache{
+	entries: make(map[string]sha2PasswordCacheEntry),
+}
+
+func (c *sha2PasswordCache) get(user string, authString []byte) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[user]
+	if !ok || entry.authString != string(authString) {
+		return nil, false
+	}
+	return entry.digest, true
+}
+
+func (c *sha2PasswordCache) set(user string, authString []byte, digest []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[user] = sha2PasswordCacheEntry{
+		authString: string(authString),
+		digest:     digest,
+	}
+}
+
+// sha2RSAKey is the RSA key pair for the client to encrypt the password of the
+// caching_sha2_password on the connection without TLS. It is generated at the first use.
+var sha2RSAKey struct {
+	once         sync.Once
+	privateKey   *rsa.PrivateKey
+	publicKeyPEM []byte
+	err          error
+}
+
+func getSha2RSAKey() (*rsa.PrivateKey, []byte, error) {
+	sha2RSAKey.once.Do(func() {
+		sha2RSAKey.privateKey, sha2RSAKey.err = rsa.GenerateKey(crand.Reader, 2048)
+		if sha2RSAKey.err != nil {
+			return
+		}
+		var der []byte
+		der, sha2RSAKey.err = x509.MarshalPKIXPublicKey(&sha2RSAKey.privateKey.PublicKey)
+		if sha2RSAKey.err != nil {
+			return
+		}
+		sha2RSAKey.publicKeyPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	})
+	return sha2RSAKey.privateKey, sha2RSAKey.publicKeyPEM, sha2RSAKey.err
+}
+
+// decryptSha2Password decrypts the password encrypted by the client with the RSA public key.
+// Algorithm: RSA_OAEP_SHA1( (password + '\0') XOR salt )
+func decryptSha2Password(key *rsa.PrivateKey, salt, data []byte) ([]byte, error) {
+	plain, err := rsa.DecryptOAEP(sha1.New(), crand.Reader, key, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	return plain, nil
+}
+
+// isSecureTransport checks the password in plain text can be sent on the connection
+func (mp *MysqlProtocolImpl) isSecureTransport() bool {
+	conn := mp.tcpConn.RawConn()
+	// the compressed protocol may be used on the tls connection
+	if c, ok := conn.(*compressedConn); ok {
+		conn = c.Conn
+	}
+	switch conn.(type) {
+	case *tls.Conn, *net.UnixConn:
+		return true
+	}
+	return false
+}
+
+// checkAuthentication checks the authentication data from the client with the
+// authentication_string of the user, by the auth plugin that the client uses.
+func (mp *MysqlProtocolImpl) checkAuthentication(ctx context.Context, authPlugin string, authString, authResponse []byte) error {
+	var err error
+	//the SHA-256 based password hash does not work with the mysql_native_password,
+	//the client is asked to switch to the caching_sha2_password.
+	if authPlugin != AuthCachingSha2Password && isPasswordHash(authString) {
+		if mp.capability&CLIENT_PLUGIN_AUTH == 0 {
+			return moerr.NewInternalError(ctx, "the client does not support the authentication plugin %s", AuthCachingSha2Password)
+		}
+		if authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthCachingSha2Password); err != nil {
+			return err
+		}
+		authPlugin = AuthCachingSha2Password
+	}
+
+	if authPlugin == AuthCachingSha2Password {
+		return mp.checkCachingSha2Password(ctx, authString, authResponse)
+	}
+	if !mp.checkPassword(authString, mp.GetSalt(), authResponse) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	return nil
+}
+
+// checkCachingSha2Password authenticates the user with the caching_sha2_password.
+// The fast authentication checks the scramble with SHA256(SHA256(password)) in the cache.
+// Otherwise, the full authentication asks the client for the password which is sent
+// in plain text over TLS or encrypted by the RSA public key of the server.
+func (mp *MysqlProtocolImpl) checkCachingSha2Password(ctx context.Context, authString, scramble []byte) error {
+	if len(scramble) == 0 {
+		if len(authString) == 0 {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+
+	tenant := mp.GetSession().GetTenantInfo()
+	user := tenant.GetTenant() + ":" + tenant.GetUser()
+
+	//fast authentication
+	digest, ok := globalSha2PasswordCache.get(user, authString)
+	if !ok && !isPasswordHash(authString) {
+		digest, ok = sha2PasswordDigest(authString), true
+	}
+	if ok && checkSha2Scramble(digest, mp.GetSalt(), scramble) {
+		logDebugf(mp.getProfile(profileTypeConcise), "caching_sha2_password fast authentication succeeded")
+		return mp.writePackets([]byte{defines.AuthMoreDataHeader, cachingSha2FastAuthSuccess})
+	}
+
+	//full authentication
+	data, err := mp.exchangeAuthData(ctx, []byte{defines.AuthMoreDataHeader, cachingSha2PerformFullAuthentication})
+	if err != nil {
+		return err
+	}
+	if !mp.isSecureTransport() {
+		key, publicKey, err := getSha2RSAKey()
+		if err != nil {
+			return err
+		}
+		if len(data) == 1 && data[0] == cachingSha2RequestPublicKey {
+			payload := append([]byte{defines.AuthMoreDataHeader}, publicKey...)
+			if data, err = mp.exchangeAuthData(ctx, payload); err != nil {
+				return err
+			}
+		}
+		if data, err = decryptSha2Password(key, mp.GetSalt(), data); err != nil {
+			return moerr.NewInternalError(ctx, "decrypt password failed. error:%v", err)
+		}
+	}
+	password := bytes.TrimSuffix(data, []byte{0})
+	if !checkPasswordHash(password, authString) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	globalSha2PasswordCache.set(user, authString, sha2PasswordDigest(password))
+	return nil
+}
+
 // the server authenticate that the client can connect and use the database
-func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse []byte) error {
+func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authPlugin string, authResponse []byte) error {
 	var psw []byte
 	var err error
 	var tenant *TenantInfo
 
 	ses := mp.GetSession()
 	if !mp.GetSkipCheckUser() {
+		defer func() {
+			auditLogin(ctx, ses, err)
+		}()
 		logDebugf(mp.getProfile(profileTypeConcise), "authenticate user 1")
 		psw, err = ses.AuthenticateUser(mp.GetUserName())
 		if err != nil {
@@ -1085,10 +1313,13 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 		logDebugf(mp.getProfile(profileTypeConcise), "authenticate user 2")
 
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
-			logInfof(mp.getProfile(profileTypeConcise), "check password succeeded")
-		} else {
-			return moerr.NewInternalError(ctx, "check password failed")
+		if err = mp.checkAuthentication(ctx, authPlugin, psw, authResponse); err != nil {
+			return err
+		}
+		logInfof(mp.getProfile(profileTypeConcise), "check password succeeded")
+
+		if err = ses.acquireConnection(ctx); err != nil {
+			return err
 		}
 	} else {
 		logDebugf(mp.getProfile(profileTypeConcise), "skip authenticate user")
@@ -1120,6 +1351,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 	}
 
 	var authResponse []byte
+	var authPlugin = AuthNativePassword
 	if capabilities, _, ok := mp.io.ReadUint16(payload, 0); !ok {
 		return false, moerr.NewInternalError(ctx, "read capabilities from response packet failed")
 	} else if uint32(capabilities)&CLIENT_PROTOCOL_41 != 0 {
@@ -1136,7 +1368,11 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		authResponse = resp41.authResponse
+		if resp41.clientPluginName != "" {
+			authPlugin = resp41.clientPluginName
+		}
 		mp.capability = mp.capability & resp41.capabilities
+		mp.zstdCompressionLevel = resp41.zstdCompressionLevel
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
 			return false, moerr.NewInternalError(ctx, "get collationName and charset failed")
@@ -1173,12 +1409,9 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 	}
 
 	logDebugf(mp.getProfile(profileTypeConcise), "authenticate user")
-	if err = mp.authenticateUser(ctx, authResponse); err != nil {
+	if err = mp.authenticateUser(ctx, authPlugin, authResponse); err != nil {
 		logutil.Errorf("authenticate user failed.error:%v", err)
-		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
-		tipsFormat := "Access denied for user %s. %s"
-		msg := fmt.Sprintf(tipsFormat, mp.username, err.Error())
-		err2 = mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg)
+		err2 = mp.sendAccessDeniedPacket(err)
 		if err2 != nil {
 			logutil.Errorf("send err packet failed.error:%v", err2)
 			return false, err2
@@ -1191,9 +1424,148 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 	if err != nil {
 		return false, err
 	}
+	if err = mp.enableCompression(); err != nil {
+		return false, err
+	}
 	return false, nil
 }
 
+// the server tells the client that the authentication failed
+func (mp *MysqlProtocolImpl) sendAccessDeniedPacket(err error) error {
+	fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
+	tipsFormat := "Access denied for user %s. %s"
+	msg := fmt.Sprintf(tipsFormat, mp.GetUserName(), err.Error())
+	return mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg)
+}
+
+// HandleChangeUser authenticates the new user of COM_CHANGE_USER with the salt of the handshake.
+// If it succeeds, the session is reset as a new connection of the user.
+// Otherwise, the error is returned and the connection is closed like mysql does.
+// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_change_user.html
+func (mp *MysqlProtocolImpl) HandleChangeUser(ctx context.Context, payload []byte) error {
+	var err error
+	var ok bool
+	var pos int
+	var username, database string
+	var authResponse []byte
+	var collationID uint16
+	var authPlugin = AuthNativePassword
+
+	//string[NUL]    user
+	username, pos, ok = mp.readStringNUL(payload, pos)
+	if !ok {
+		return mp.sendChangeUserError(ctx, moerr.NewInternalError(ctx, "get username failed"))
+	}
+
+	if mp.capability&CLIENT_SECURE_CONNECTION != 0 {
+		//int<1>         auth-response length
+		//string[$len]   auth-response
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(payload, pos)
+		if ok {
+			authResponse, pos, ok = mp.readCountOfBytes(payload, pos, int(l))
+		}
+	} else {
+		//string[NUL]    auth-response
+		var auth string
+		auth, pos, ok = mp.readStringNUL(payload, pos)
+		authResponse = []byte(auth)
+	}
+	if !ok {
+		return mp.sendChangeUserError(ctx, moerr.NewInternalError(ctx, "get auth-response failed"))
+	}
+
+	//string[NUL]    schema-name
+	database, pos, ok = mp.readStringNUL(payload, pos)
+	if !ok {
+		return mp.sendChangeUserError(ctx, moerr.NewInternalError(ctx, "get database failed"))
+	}
+
+	if pos < len(payload) {
+		if mp.capability&CLIENT_PROTOCOL_41 != 0 {
+			//int<2>         character set
+			collationID, pos, ok = mp.io.ReadUint16(payload, pos)
+			if !ok {
+				return mp.sendChangeUserError(ctx, moerr.NewInternalError(ctx, "get character set failed"))
+			}
+		}
+		if mp.capability&CLIENT_PLUGIN_AUTH != 0 {
+			//string[NUL]    auth plugin name
+			authPlugin, _, ok = mp.readStringNUL(payload, pos)
+			if !ok {
+				return mp.sendChangeUserError(ctx, moerr.NewInternalError(ctx, "get auth plugin name failed"))
+			}
+		}
+		//drop client connection attributes
+	}
+
+	//to switch authenticate method
+	if authPlugin != AuthNativePassword && authPlugin != AuthCachingSha2Password {
+		if authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthCachingSha2Password); err != nil {
+			return err
+		}
+		authPlugin = AuthCachingSha2Password
+	}
+
+	ses := mp.GetSession()
+	mp.SetUserName(username)
+	logDebugf(mp.getProfile(profileTypeConcise), "authenticate user for change user")
+	if err = mp.authenticateUser(ctx, authPlugin, authResponse); err != nil {
+		err2 := mp.sendAccessDeniedPacket(err)
+		if err2 != nil {
+			return err2
+		}
+		return err
+	}
+	ses.MakeProfile()
+
+	if err = ses.ResetConnection(); err != nil {
+		return mp.sendChangeUserError(ctx, err)
+	}
+	ses.InvalidatePrivilegeCache()
+	ses.SetDatabaseName("")
+	if database != "" {
+		if err = doChangeUserDatabase(ctx, ses, database); err != nil {
+			return mp.sendChangeUserError(ctx, err)
+		}
+	}
+	if nameAndCharset, ok2 := collationID2CharsetAndName[int(collationID)]; ok2 {
+		mp.collationID = int(collationID)
+		mp.collationName = nameAndCharset.collationName
+		mp.charset = nameAndCharset.charset
+	}
+	return mp.sendOKPacket(0, 0, 0, 0, "")
+}
+
+// sendChangeUserError sends the error of COM_CHANGE_USER to the client
+func (mp *MysqlProtocolImpl) sendChangeUserError(ctx context.Context, err error) error {
+	if err2 := mp.SendResponse(ctx, NewGeneralErrorResponse(COM_CHANGE_USER, err)); err2 != nil {
+		return err2
+	}
+	return err
+}
+
+// enableCompression switches the connection to the compressed protocol if the compression
+// is negotiated at handshake. The packets after the OK packet of the handshake are compressed.
+func (mp *MysqlProtocolImpl) enableCompression() error {
+	var c compressor
+	switch {
+	case mp.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0:
+		zc, err := newZstdCompressor(int(mp.zstdCompressionLevel))
+		if err != nil {
+			return err
+		}
+		c = zc
+	case mp.capability&CLIENT_COMPRESS != 0:
+		c = newZlibCompressor()
+	default:
+		return nil
+	}
+	logDebugf(mp.getProfile(profileTypeConcise), "enable compression")
+	mp.tcpConn.UseConn(newCompressedConn(mp.tcpConn.RawConn(), c))
+	return nil
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1223,7 +1595,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((mp.capability>>16)&0xFFFF))
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
@@ -1246,7 +1618,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
-		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
+		pos = mp.writeStringNUL(data, pos, AuthCachingSha2Password)
 	}
 
 	return data[:pos]
@@ -1350,22 +1722,36 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 	}
 
 	if (info.capabilities & CLIENT_PLUGIN_AUTH) != 0 {
-		info.clientPluginName, _, ok = mp.readStringNUL(data, pos)
+		info.clientPluginName, pos, ok = mp.readStringNUL(data, pos)
 		if !ok {
 			return false, info, moerr.NewInternalError(ctx, "get auth plugin name failed")
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		if info.clientPluginName != AuthNativePassword && info.clientPluginName != AuthCachingSha2Password {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthCachingSha2Password); err != nil {
 				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
 			}
-			info.clientPluginName = AuthNativePassword
+			info.clientPluginName = AuthCachingSha2Password
 		}
 	}
 
 	//drop client connection attributes
+	if (info.capabilities & CLIENT_CONNECT_ATTRS) != 0 {
+		//lenenc-int         length of all key-values
+		if l, next, ok2 := mp.readIntLenEnc(data, pos); ok2 {
+			pos = next + int(l)
+		}
+	}
+
+	if (info.capabilities & CLIENT_ZSTD_COMPRESSION_ALGORITHM) != 0 {
+		//int<1>             zstd compression level
+		info.zstdCompressionLevel, _, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			info.zstdCompressionLevel = defaultZstdCompressionLevel
+		}
+	}
 	return true, info, nil
 }
 
@@ -1506,10 +1892,15 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
-	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
-	err = mp.writePackets(aswPkt)
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
+	return mp.exchangeAuthData(ctx, aswPkt)
+}
+
+// the server sends the payload during the authentication and reads the response of the client.
+// return the payload of the response
+func (mp *MysqlProtocolImpl) exchangeAuthData(ctx context.Context, payload []byte) ([]byte, error) {
+	err := mp.writePackets(payload)
 	if err != nil {
 		return nil, err
 	}
@@ -2629,6 +3020,7 @@ func NewMysqlClientProtocol(connectionID uint32, tcp goetty.IOSession, maxBytesT
 	if SV.EnableTls {
 		mysql.capability = mysql.capability | CLIENT_SSL
 	}
+	mysql.capability = mysql.capability | compressionCapability(SV.ProtocolCompressionAlgorithms)
 
 	mysql.resetPacket()
 
@@ -324,6 +324,17 @@ const (
 	CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS   uint32 = 0x00400000
 	CLIENT_SESSION_TRACK                  uint32 = 0x00800000
 	CLIENT_DEPRECATE_EOF                  uint32 = 0x01000000
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM     uint32 = 0x04000000
+)
+
+// the second byte of the AuthMoreData packet of the caching_sha2_password
+const (
+	// the client asks for the RSA public key of the server
+	cachingSha2RequestPublicKey byte = 0x02
+	// the fast authentication succeeded
+	cachingSha2FastAuthSuccess byte = 0x03
+	// the server asks the client for the password
+	cachingSha2PerformFullAuthentication byte = 0x04
 )
 
 // server status
@@ -17,8 +17,15 @@ package frontend
 import (
 	"bytes"
 	"context"
+	crand "crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"encoding/binary"
+	"encoding/pem"
 	"fmt"
 	"math"
 	"reflect"
@@ -40,6 +47,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -2136,6 +2144,140 @@ func TestParseExecuteData(t *testing.T) {
 
 }
 
+func TestParseExecuteDataWithLongData(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("parseExecuteData with long data", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		st := tree.NewPrepareString(tree.Identifier(getPrepareStmtName(1)), "select ?, ?")
+		stmts, err := mysql.Parse(ctx, st.Sql, 1)
+		convey.So(err, convey.ShouldBeNil)
+		preparePlan, err := buildPlan(context.TODO(), nil, plan.NewEmptyCompilerContext(), st)
+		convey.So(err, convey.ShouldBeNil)
+		prepareStmt := &PrepareStmt{
+			Name:        preparePlan.GetDcl().GetPrepare().GetName(),
+			PreparePlan: preparePlan,
+			PrepareStmt: stmts[0],
+		}
+		convey.So(prepareStmt.appendLongData(ctx, 0, []byte("ab"), 1024), convey.ShouldBeNil)
+		convey.So(prepareStmt.appendLongData(ctx, 0, []byte("cd"), 1024), convey.ShouldBeNil)
+		convey.So(prepareStmt.appendLongData(ctx, 1, []byte("ef"), 1024), convey.ShouldBeNil)
+
+		var testData []byte
+		testData = append(testData, 0)          //flag
+		testData = append(testData, 0, 0, 0, 0) // skip iteration-count
+		testData = append(testData, 0)          //nullBitmap
+		testData = append(testData, 1)          // new param bound flag
+		testData = append(testData, uint8(defines.MYSQL_TYPE_VAR_STRING), 0)
+		testData = append(testData, uint8(defines.MYSQL_TYPE_BLOB), 0)
+		// the values of the long data are not in the packet
+
+		names, vars, err := proto.ParseExecuteData(ctx, prepareStmt, testData, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(names, convey.ShouldHaveLength, 2)
+		convey.So(vars[0], convey.ShouldEqual, "abcd")
+		convey.So(vars[1], convey.ShouldResemble, []byte("ef"))
+
+		prepareStmt.resetLongData()
+		convey.So(prepareStmt.longData, convey.ShouldBeNil)
+	})
+}
+
+func TestMysqlProtocolImpl_HandleChangeUser(t *testing.T) {
+	ctx := context.TODO()
+	convey.Convey("handle change user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		var received [][]byte
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+			received = append(received, append([]byte{}, msg.([]byte)[HeaderLengthOfTheProtocol:]...))
+			return nil
+		}).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().RawConn().Return(nil).AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		proto.SetSkipCheckUser(true)
+		proto.capability = CLIENT_PROTOCOL_41 | CLIENT_SECURE_CONNECTION | CLIENT_PLUGIN_AUTH
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		txnOperator.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Rollback(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Hints().Return(engine.Hints{
+			CommitOrRollbackTimeout: time.Second,
+		}).AnyTimes()
+		eng.EXPECT().Database(gomock.Any(), "db2", gomock.Any()).Return(nil, nil).AnyTimes()
+		eng.EXPECT().Database(gomock.Any(), "db3", gomock.Any()).Return(nil, moerr.NewBadDBNoCtx("db3")).AnyTimes()
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+		ses := NewSession(proto, nil, config.NewParameterUnit(sv, eng, txnClient, nil), gSysVars, true)
+		ses.setSkipCheckPrivilege(true)
+		ses.SetRequestContext(ctx)
+		proto.SetSession(ses)
+		ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: "u1"})
+		proto.SetUserName("u1")
+		convey.So(ses.SetUserDefinedVar("a", 1), convey.ShouldBeNil)
+
+		var payload []byte
+		payload = append(payload, []byte("acc1:u2")...)
+		payload = append(payload, 0)
+		payload = append(payload, 0) //empty auth-response
+		payload = append(payload, []byte("db2")...)
+		payload = append(payload, 0)
+		payload = append(payload, 45, 0) //utf8mb4_general_ci
+		payload = append(payload, []byte(AuthNativePassword)...)
+		payload = append(payload, 0)
+
+		err = proto.HandleChangeUser(ctx, payload)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(received, convey.ShouldHaveLength, 1)
+		convey.So(received[0][0], convey.ShouldEqual, defines.OKHeader)
+		convey.So(proto.GetUserName(), convey.ShouldEqual, "acc1:u2")
+		convey.So(ses.GetTenantInfo().GetTenant(), convey.ShouldEqual, "acc1")
+		convey.So(ses.GetTenantInfo().GetUser(), convey.ShouldEqual, "u2")
+		convey.So(ses.GetDatabaseName(), convey.ShouldEqual, "db2")
+		convey.So(proto.collationName, convey.ShouldEqual, "utf8mb4_general_ci")
+		_, value, err := ses.GetUserDefinedVar("a")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(value, convey.ShouldBeNil)
+
+		// the database does not exist
+		received = nil
+		payload = append([]byte{}, []byte("acc1:u2")...)
+		payload = append(payload, 0, 0)
+		payload = append(payload, []byte("db3")...)
+		payload = append(payload, 0)
+		err = proto.HandleChangeUser(ctx, payload)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(received, convey.ShouldHaveLength, 1)
+		convey.So(received[0][0], convey.ShouldEqual, defines.ErrHeader)
+		convey.So(ses.GetDatabaseName(), convey.ShouldEqual, "")
+
+		// the malformed packet
+		received = nil
+		err = proto.HandleChangeUser(ctx, []byte("u3"))
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(received, convey.ShouldHaveLength, 1)
+		convey.So(received[0][0], convey.ShouldEqual, defines.ErrHeader)
+		convey.So(proto.GetUserName(), convey.ShouldEqual, "acc1:u2")
+	})
+}
+
 func Test_resultset(t *testing.T) {
 	ctx := context.TODO()
 	convey.Convey("send result set batch row succ", t, func() {
@@ -2640,3 +2782,135 @@ func TestMysqlProtocolImpl_Close(t *testing.T) {
 	assert.Nil(t, proto.lenEncBuffer)
 	assert.Nil(t, proto.binaryNullBuffer)
 }
+
+func TestMysqlProtocolImpl_checkCachingSha2Password(t *testing.T) {
+	ctx := context.TODO()
+	salt := generate_salt(20)
+	password := []byte("abc")
+	authString, err := generatePasswordHash(string(password))
+	require.NoError(t, err)
+
+	// makeProtocol makes a protocol whose client answers the packets from the server in order
+	makeProtocol := func(ctrl *gomock.Controller, secure bool, answers ...func(received []byte) []byte) (*MysqlProtocolImpl, *[][]byte) {
+		var received [][]byte
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+			received = append(received, append([]byte{}, msg.([]byte)[HeaderLengthOfTheProtocol:]...))
+			return nil
+		}).AnyTimes()
+		ioses.EXPECT().Read(gomock.Any()).DoAndReturn(func(goetty.ReadOptions) (interface{}, error) {
+			require.NotEmpty(t, answers)
+			answer := answers[0]
+			answers = answers[1:]
+			return &Packet{Payload: answer(received[len(received)-1])}, nil
+		}).AnyTimes()
+		if secure {
+			ioses.EXPECT().RawConn().Return(&tls.Conn{}).AnyTimes()
+		} else {
+			ioses.EXPECT().RawConn().Return(nil).AnyTimes()
+		}
+
+		mp := &MysqlProtocolImpl{SV: &config.FrontendParameters{}}
+		mp.io = &IOPackageImpl{}
+		mp.tcpConn = ioses
+		mp.salt = salt
+		ses := &Session{}
+		ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: "u1"})
+		mp.SetSession(ses)
+		return mp, &received
+	}
+
+	scramble := func(password []byte) []byte {
+		hash1 := sha256.Sum256(password)
+		hash2 := sha256.Sum256(hash1[:])
+		hash3 := sha256.Sum256(append(hash2[:], salt...))
+		for i := range hash1 {
+			hash1[i] ^= hash3[i]
+		}
+		return hash1[:]
+	}
+	requestPublicKey := func([]byte) []byte {
+		return []byte{cachingSha2RequestPublicKey}
+	}
+	encryptPassword := func(password []byte) func([]byte) []byte {
+		return func(received []byte) []byte {
+			require.Equal(t, defines.AuthMoreDataHeader, received[0])
+			block, _ := pem.Decode(received[1:])
+			require.NotNil(t, block)
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			require.NoError(t, err)
+			plain := append(append([]byte{}, password...), 0)
+			for i := range plain {
+				plain[i] ^= salt[i%len(salt)]
+			}
+			data, err := rsa.EncryptOAEP(sha1.New(), crand.Reader, pub.(*rsa.PublicKey), plain, nil)
+			require.NoError(t, err)
+			return data
+		}
+	}
+	plainPassword := func(password []byte) func([]byte) []byte {
+		return func([]byte) []byte {
+			return append(append([]byte{}, password...), 0)
+		}
+	}
+	fullAuth := []byte{defines.AuthMoreDataHeader, cachingSha2PerformFullAuthentication}
+	fastAuth := []byte{defines.AuthMoreDataHeader, cachingSha2FastAuthSuccess}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// wrong password with the full authentication over the RSA
+	mp, received := makeProtocol(ctrl, false, requestPublicKey, encryptPassword([]byte("abd")))
+	err = mp.checkCachingSha2Password(ctx, []byte(authString), scramble([]byte("abd")))
+	require.Error(t, err)
+	require.Equal(t, fullAuth, (*received)[0])
+
+	// the fast authentication fails before the full authentication
+	mp, received = makeProtocol(ctrl, false, requestPublicKey, encryptPassword(password))
+	err = mp.checkCachingSha2Password(ctx, []byte(authString), scramble(password))
+	require.NoError(t, err)
+	require.Len(t, *received, 2)
+	require.Equal(t, fullAuth, (*received)[0])
+
+	// the fast authentication succeeds after the full authentication
+	mp, received = makeProtocol(ctrl, false)
+	err = mp.checkCachingSha2Password(ctx, []byte(authString), scramble(password))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{fastAuth}, *received)
+
+	// the cache is invalid after the password is changed
+	authString2, err := generatePasswordHash(string(password))
+	require.NoError(t, err)
+	mp, received = makeProtocol(ctrl, true, plainPassword(password))
+	err = mp.checkCachingSha2Password(ctx, []byte(authString2), scramble(password))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{fullAuth}, *received)
+
+	// the password in plain text saved by the old version
+	mp, received = makeProtocol(ctrl, false)
+	err = mp.checkCachingSha2Password(ctx, password, scramble(password))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{fastAuth}, *received)
+
+	// the empty password
+	mp, _ = makeProtocol(ctrl, false)
+	require.NoError(t, mp.checkCachingSha2Password(ctx, nil, nil))
+	require.Error(t, mp.checkCachingSha2Password(ctx, []byte(authString), nil))
+
+	// the client using the mysql_native_password is asked to switch to the caching_sha2_password
+	mp, received = makeProtocol(ctrl, false, func([]byte) []byte {
+		return scramble(password)
+	})
+	mp.capability = CLIENT_PLUGIN_AUTH
+	err = mp.checkAuthentication(ctx, AuthNativePassword, []byte(authString2), []byte("native"))
+	require.NoError(t, err)
+	require.Len(t, *received, 2)
+	require.Equal(t, mp.makeAuthSwitchRequestPayload(AuthCachingSha2Password), (*received)[0])
+	require.Equal(t, fastAuth, (*received)[1])
+
+	// the client does not support the auth switch
+	mp, _ = makeProtocol(ctrl, false)
+	err = mp.checkAuthentication(ctx, AuthNativePassword, []byte(authString2), []byte("native"))
+	require.Error(t, err)
+}
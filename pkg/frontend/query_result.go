@@ -514,6 +514,7 @@ func doDumpQueryResult(ctx context.Context, ses *Session, eParam *tree.ExportPar
 		if err != nil {
 			return err
 		}
+		mcol.SetDecimal(c.Typ.Scale)
 		mrs.AddColumn(mcol)
 	}
 	mrs.Data = make([][]interface{}, 1)
@@ -605,7 +606,7 @@ func doDumpQueryResult(ctx context.Context, ses *Session, eParam *tree.ExportPar
 		return err
 	}
 
-	err = Close(exportParam)
+	err = closeExportFile(exportParam)
 	if err != nil {
 		return err
 	}
@@ -502,6 +502,8 @@ func (mrs *MysqlResultSet) GetString(ctx context.Context, rindex, cindex uint64)
 		return strconv.FormatInt(int64(v), 10), nil
 	case uint:
 		return strconv.FormatUint(uint64(v), 10), nil
+	case types.Date:
+		return v.String(), nil
 	case types.Time:
 		return v.String(), nil
 	case types.Datetime:
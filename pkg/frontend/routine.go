@@ -174,6 +174,14 @@ func (rt *Routine) handleRequest(req *Request) error {
 
 	if resp, err = executor.ExecRequest(tenantCtx, ses, req); err != nil {
 		logErrorf(ses.GetConciseProfile(), "rt execute request failed. error:%v \n", err)
+		//the connection is closed if the user can not be changed, like mysql
+		quit = req.GetCmd() == COM_CHANGE_USER
+	}
+
+	//the connection is moved to another tenant by COM_CHANGE_USER
+	if newTenant := ses.GetTenantInfo(); newTenant.GetTenant() != tenant.GetTenant() && rt.connectionBeCounted.Load() {
+		metric.ConnectionCounter(tenant.GetTenant()).Dec()
+		metric.ConnectionCounter(newTenant.GetTenant()).Inc()
 	}
 
 	if resp != nil {
@@ -41,6 +41,7 @@ type RoutineManager struct {
 	skipCheckUser  bool
 	tlsConfig      *tls.Config
 	autoIncrCaches defines.AutoIncrCaches
+	accountUsages  *accountUsages
 }
 
 func (rm *RoutineManager) GetAutoIncrCache() defines.AutoIncrCaches {
@@ -112,6 +113,8 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 
 	// Add  autoIncrCaches in session structure.
 	ses.SetAutoIncrCaches(rm.autoIncrCaches)
+	// the connections and the statements of the session are counted on its account
+	ses.setAccountUsages(rm.accountUsages)
 
 	routine.setSession(ses)
 	pro.SetSession(ses)
@@ -159,6 +162,7 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 				}
 				metric.ConnectionCounter(accountName).Dec()
 			})
+			ses.releaseConnection()
 			logDebugf(ses.GetConciseProfile(), "the io session was closed.")
 		}
 		rt.cleanup()
@@ -337,9 +341,10 @@ func (rm *RoutineManager) clientCount() int {
 
 func NewRoutineManager(ctx context.Context, pu *config.ParameterUnit) (*RoutineManager, error) {
 	rm := &RoutineManager{
-		ctx:     ctx,
-		clients: make(map[goetty.IOSession]*Routine),
-		pu:      pu,
+		ctx:           ctx,
+		clients:       make(map[goetty.IOSession]*Routine),
+		pu:            pu,
+		accountUsages: newAccountUsages(),
 	}
 
 	// Initialize auto incre cache.
@@ -19,7 +19,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -36,6 +38,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -73,6 +77,8 @@ type TxnHandler struct {
 	txn       TxnOperator
 	mu        sync.Mutex
 	entryMu   sync.Mutex
+	// the txn adds data, the storage quota is checked at commit
+	growsStorage bool
 }
 
 func InitTxnHandler(storage engine.Engine, txnClient TxnClient) *TxnHandler {
@@ -85,8 +91,9 @@ func InitTxnHandler(storage engine.Engine, txnClient TxnClient) *TxnHandler {
 
 // we don't need to lock. TxnHandler is holded by one session.
 func (th *TxnHandler) SetTempEngine(te engine.Engine) {
-	ee := th.storage.(*engine.EntireEngine)
-	ee.TempEngine = te
+	if ee, ok := th.storage.(*engine.EntireEngine); ok {
+		ee.TempEngine = te
+	}
 }
 
 type profileType uint8
@@ -199,6 +206,10 @@ type Session struct {
 
 	p *plan.Plan
 
+	// auditPlan is the plan of the statement to be audited, it is cleared
+	// when the statement is finished.
+	auditPlan *plan.Plan
+
 	limitResultSize float64 // MB
 
 	curResultSize float64 // MB
@@ -210,6 +221,16 @@ type Session struct {
 	planCache *planCache
 
 	autoIncrCaches defines.AutoIncrCaches
+
+	// accountUsages enforces the quotas of the accounts.
+	// It is nil in the background session.
+	accountUsages *accountUsages
+	// the connection is counted on the account connectionAccountId
+	connectionCounted   bool
+	connectionAccountId uint32
+
+	// the security of the tables loaded in the txn, see getSecurityOfTable
+	tableSecurity tableSecurityOfTxn
 }
 
 // The update version. Four function.
@@ -244,6 +265,69 @@ func (ses *Session) Dispose() {
 	ses.cleanCache()
 }
 
+// ResetConnection restores the session to the state of a new connection, but keeps the
+// user and the database. It is used by COM_RESET_CONNECTION and COM_CHANGE_USER.
+// The active transaction is rolled back, the prepared statements, the user variables and the
+// temporary tables are dropped, and the session variables are reset to the global values.
+func (ses *Session) ResetConnection() error {
+	err := ses.TxnRollback()
+	if err != nil {
+		return err
+	}
+
+	if err = ses.dropTempDatabase(); err != nil {
+		return err
+	}
+
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.sysVars = ses.gSysVars.CopySysVarsToSession()
+	ses.userDefinedVars = make(map[string]interface{})
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.serverStatus = 0
+	ses.optionBits = OPTION_AUTOCOMMIT
+	ses.lastInsertID = 0
+	ses.timeZone = time.Local
+	ses.errInfo.codes = ses.errInfo.codes[:0]
+	ses.errInfo.msgs = ses.errInfo.msgs[:0]
+	ses.planCache.clean()
+	return nil
+}
+
+// dropTempDatabase drops the database holding the temporary tables of the session,
+// and releases the storage of them.
+func (ses *Session) dropTempDatabase() error {
+	ee, ok := ses.storage.(*engine.EntireEngine)
+	if !ok || ee.TempEngine == nil || ses.tempTablestorage == nil {
+		return nil
+	}
+	txnOp, err := ses.GetTxnHandler().GetTxn()
+	if err != nil {
+		return err
+	}
+	ctx := context.WithValue(ses.GetRequestContext(), defines.TemporaryDN{}, ses.tempTablestorage)
+	if err = ee.TempEngine.Delete(ctx, defines.TEMPORARY_DBNAME, txnOp); err != nil {
+		if err2 := ses.TxnRollback(); err2 != nil {
+			logErrorf(ses.GetConciseProfile(), "rollback txn failed.error:%v", err2)
+		}
+		return err
+	}
+	if err = ses.TxnCommit(); err != nil {
+		return err
+	}
+	if err = ses.tempTablestorage.Destroy(ctx); err != nil {
+		return err
+	}
+
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.InitTempEngine = false
+	ses.tempTablestorage = nil
+	ee.TempEngine = nil
+	ses.txnHandler.SetTempEngine(nil)
+	return nil
+}
+
 type errInfo struct {
 	codes  []uint16
 	msgs   []string
@@ -885,6 +969,94 @@ func (ses *Session) GetSessionVar(name string) (interface{}, error) {
 	}
 }
 
+// isPessimistic returns true if the txns of the session are in pessimistic mode, the
+// rows to be modified are locked before they are written.
+func (ses *Session) isPessimistic() bool {
+	if ses.GetGlobalSysVars() == nil {
+		return false
+	}
+	v, err := ses.GetSessionVar("txn_mode")
+	if err != nil {
+		return false
+	}
+	mode, ok := v.(string)
+	return ok && strings.EqualFold(mode, "pessimistic")
+}
+
+// getSnapshotTS returns the timestamp set by the session variable snapshot_timestamp,
+// the txns of the session read the data at it. Nil means reading the latest data.
+func (ses *Session) getSnapshotTS() (*timestamp.Timestamp, error) {
+	if ses.GetGlobalSysVars() == nil {
+		return nil, nil
+	}
+	v, err := ses.GetSessionVar("snapshot_timestamp")
+	if err != nil {
+		return nil, nil
+	}
+	value, ok := v.(string)
+	if !ok || value == "" {
+		return nil, nil
+	}
+	t, err := types.ParseTimestamp(ses.GetTimeZone(), value, 6)
+	if err != nil {
+		return nil, err
+	}
+	ts := plan2.TimestampToTxnTS(t)
+	if err = plan2.CheckSnapshotTS(ses.GetRequestContext(), ts); err != nil {
+		return nil, err
+	}
+	return &ts, nil
+}
+
+// maxExecutionTimeHint matches the optimizer hint MAX_EXECUTION_TIME(N)
+var maxExecutionTimeHint = regexp.MustCompile(`(?i)\bMAX_EXECUTION_TIME\s*\(\s*(\d+)\s*\)`)
+
+// getMaxExecutionTime returns how long the statement can run. Like mysql, it only
+// bounds the SELECT statements. The hint MAX_EXECUTION_TIME(N) of the top-level
+// SELECT overrides the variable max_execution_time. Zero means no limit.
+func (ses *Session) getMaxExecutionTime(stmt tree.Statement) time.Duration {
+	sel, ok := stmt.(*tree.Select)
+	if !ok {
+		return 0
+	}
+	if clause, ok := sel.Select.(*tree.SelectClause); ok {
+		if m := maxExecutionTimeHint.FindStringSubmatch(clause.Hint); m != nil {
+			if ms, err := strconv.ParseUint(m[1], 10, 32); err == nil {
+				return time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	if ses.GetGlobalSysVars() == nil {
+		return 0
+	}
+	v, err := ses.GetSessionVar("max_execution_time")
+	if err != nil {
+		return 0
+	}
+	ms, ok := v.(uint64)
+	if !ok {
+		return 0
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// getQueryMemLimit returns how many bytes of memory a statement can allocate
+// on all the cns it runs on, set by the variable query_mem_limit. Zero means no limit.
+func (ses *Session) getQueryMemLimit() int64 {
+	if ses.GetGlobalSysVars() == nil {
+		return 0
+	}
+	v, err := ses.GetSessionVar("query_mem_limit")
+	if err != nil {
+		return 0
+	}
+	limit, ok := v.(int64)
+	if !ok {
+		return 0
+	}
+	return limit
+}
+
 func (ses *Session) CopyAllSessionVars() map[string]interface{} {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -1237,6 +1409,57 @@ func (ses *Session) TxnRollback() error {
 	return err
 }
 
+// TxnSavepoint sets a savepoint in the current transaction.
+// Like MySQL, it does nothing if the session is not in the multi-statement transaction mode.
+func (ses *Session) TxnSavepoint(name string) error {
+	if !ses.InMultiStmtTransactionMode() {
+		return nil
+	}
+	err := ses.TxnStart()
+	if err != nil {
+		return err
+	}
+	storage, txnOp, err := ses.getSavepointEngine()
+	if err != nil {
+		return err
+	}
+	return storage.Savepoint(ses.GetRequestContext(), txnOp, name)
+}
+
+// TxnRollbackToSavepoint discards the changes after the savepoint without
+// ending the current transaction.
+func (ses *Session) TxnRollbackToSavepoint(name string) error {
+	if !ses.GetTxnHandler().IsValidTxn() {
+		return moerr.NewSavepointNotExist(ses.GetRequestContext(), name)
+	}
+	storage, txnOp, err := ses.getSavepointEngine()
+	if err != nil {
+		return err
+	}
+	return storage.RollbackToSavepoint(ses.GetRequestContext(), txnOp, name)
+}
+
+// TxnReleaseSavepoint removes the savepoint from the current transaction.
+func (ses *Session) TxnReleaseSavepoint(name string) error {
+	if !ses.GetTxnHandler().IsValidTxn() {
+		return moerr.NewSavepointNotExist(ses.GetRequestContext(), name)
+	}
+	storage, txnOp, err := ses.getSavepointEngine()
+	if err != nil {
+		return err
+	}
+	return storage.ReleaseSavepoint(ses.GetRequestContext(), txnOp, name)
+}
+
+func (ses *Session) getSavepointEngine() (engine.SavepointEngine, TxnOperator, error) {
+	th := ses.GetTxnHandler()
+	storage, ok := th.GetStorage().(engine.SavepointEngine)
+	if !ok {
+		return nil, nil, moerr.NewNotSupported(ses.GetRequestContext(), "savepoint")
+	}
+	return storage, th.GetTxnOperator(), nil
+}
+
 /*
 InActiveTransaction checks if it is in an active transaction.
 */
@@ -1351,6 +1574,15 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		return nil, moerr.NewInternalError(sysTenantCtx, "Account %s is suspended", tenant.GetTenant())
 	}
 
+	//account quota
+	quota, err := readAccountQuota(sysTenantCtx, rsset[0], 4)
+	if err != nil {
+		return nil, err
+	}
+	if au := ses.getAccountUsages(); au != nil {
+		au.setQuota(uint32(tenantID), quota)
+	}
+
 	tenant.SetTenantID(uint32(tenantID))
 	//step2 : check user exists or not in general tenant.
 	//step3 : get the password of the user
@@ -1514,6 +1746,20 @@ func (th *TxnHandler) TxnClientNew() error {
 			opts = v.([]client.TxnOption)
 		}
 	}
+	if th.ses != nil && th.ses.isPessimistic() {
+		// the options are shared by all sessions, append to a copy of them
+		opts = append(opts[:len(opts):len(opts)], client.WithTxnMode(txn.TxnMode_Pessimistic))
+	}
+	if th.ses != nil {
+		ts, err := th.ses.getSnapshotTS()
+		if err != nil {
+			return err
+		}
+		if ts != nil {
+			// the history can only be read
+			opts = append(opts[:len(opts):len(opts)], client.WithTxnReadyOnly(), client.WithSnapshotTS(*ts))
+		}
+	}
 
 	th.txn, err = th.txnClient.New(opts...)
 	if err != nil {
@@ -1574,6 +1820,20 @@ func (th *TxnHandler) SetInvalid() {
 	th.mu.Lock()
 	defer th.mu.Unlock()
 	th.txn = nil
+	th.growsStorage = false
+}
+
+// SetGrowsStorage marks the txn adds data
+func (th *TxnHandler) SetGrowsStorage() {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.growsStorage = true
+}
+
+func (th *TxnHandler) GrowsStorage() bool {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	return th.growsStorage
 }
 
 func (th *TxnHandler) GetTxnOperator() TxnOperator {
@@ -1628,6 +1888,21 @@ func (th *TxnHandler) CommitTxn() error {
 	defer func() {
 		logDebugf(sessionProfile, "CommitTxn exit txnId:%s", txnId)
 	}()
+	if th.GrowsStorage() {
+		if err = ses.checkStorageQuota(ctx); err != nil {
+			th.SetInvalid()
+			logErrorf(sessionProfile, "CommitTxn: storage quota exceeded. txnId:%s error:%v", txnId, err)
+			if err2 = storage.Rollback(ctx, txnOp); err2 != nil {
+				logErrorf(sessionProfile, "CommitTxn: storage rollback failed. txnId:%s error:%v", txnId, err2)
+			}
+			if txnOp != nil {
+				if err2 = txnOp.Rollback(ctx); err2 != nil {
+					logErrorf(sessionProfile, "CommitTxn: txn operator rollback failed. txnId:%s error:%v", txnId, err2)
+				}
+			}
+			return err
+		}
+	}
 	if err = storage.Commit(ctx, txnOp); err != nil {
 		th.SetInvalid()
 		logErrorf(sessionProfile, "CommitTxn: storage commit failed. txnId:%s error:%v", txnId, err)
@@ -2384,10 +2659,93 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef, e *plan2.Expr) (stats
 		cols, _ := table.TableColumns(ctx)
 		fixColumnName(cols, e)
 	}
+	if partition := getTablePartition(ctx, table); partition != nil {
+		return tcc.partitionStats(ctx, dbName, partition, e)
+	}
 	stats, _ = table.Stats(ctx, e)
 	return stats
 }
 
+// getTablePartition returns the partition info of the table, or nil if it's not partitioned
+func getTablePartition(ctx context.Context, table engine.Relation) *plan2.PartitionByDef {
+	defs, err := table.TableDefs(ctx)
+	if err != nil {
+		return nil
+	}
+	for _, def := range defs {
+		if partitionDef, ok := def.(*engine.PartitionDef); ok {
+			partition := &plan2.PartitionByDef{}
+			if err = partition.UnMarshalPartitionInfo([]byte(partitionDef.Partition)); err != nil {
+				return nil
+			}
+			return partition
+		}
+	}
+	return nil
+}
+
+// partitionStats sums the stats of the tables of the partitions
+func (tcc *TxnCompilerContext) partitionStats(ctx context.Context, dbName string, partition *plan2.PartitionByDef, e *plan2.Expr) *plan2.Stats {
+	stats := &plan2.Stats{}
+	for _, item := range partition.Partitions {
+		_, table, err := tcc.getRelation(dbName, item.PartitionTableName)
+		if err != nil {
+			return nil
+		}
+		s, err := table.Stats(ctx, e)
+		if err != nil || s == nil {
+			return s
+		}
+		stats.BlockNum += s.BlockNum
+		stats.Cost += s.Cost
+		stats.Outcnt += s.Outcnt
+		stats.TableCnt += s.TableCnt
+	}
+	stats.Selectivity = 1
+	if stats.TableCnt > 0 {
+		stats.Selectivity = stats.Outcnt / stats.TableCnt
+	}
+	return stats
+}
+
+func (tcc *TxnCompilerContext) ColumnStats(obj *plan2.ObjectRef) *plan2.TableColumnStats {
+	dbName, err := tcc.ensureDatabaseIsNotEmpty(obj.GetSchemaName())
+	if err != nil {
+		return nil
+	}
+	// the system tables are never analyzed, and loading the statistics
+	// of them would plan the queries on them again.
+	if isBannedDatabase(dbName) {
+		return nil
+	}
+	ctx, table, err := tcc.getRelation(dbName, obj.GetObjName())
+	if err != nil {
+		return nil
+	}
+	return getColumnStats(ctx, tcc.GetSession(), table.GetTableID(ctx))
+}
+
+// TableSecurity returns the column privileges and the row level security
+// policies of the table applied to the current user.
+func (tcc *TxnCompilerContext) TableSecurity(obj *plan2.ObjectRef) (*plan2.TableSecurity, error) {
+	ses := tcc.GetSession()
+	if ses == nil || !needTableSecurity(ses) {
+		return nil, nil
+	}
+	dbName, err := tcc.ensureDatabaseIsNotEmpty(obj.GetSchemaName())
+	if err != nil {
+		return nil, err
+	}
+	if isBannedDatabase(dbName) {
+		return nil, nil
+	}
+	ctx, table, err := tcc.getRelation(dbName, obj.GetObjName())
+	if err != nil {
+		return nil, err
+	}
+	return getTableSecurity(ctx, ses, dbName, obj.GetObjName(), table.GetTableID(ctx))
+}
+
 func (tcc *TxnCompilerContext) GetProcess() *process.Process {
 	tcc.mu.Lock()
 	defer tcc.mu.Unlock()
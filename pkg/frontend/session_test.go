@@ -27,6 +27,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
@@ -228,6 +231,194 @@ func TestSession_TxnBegin(t *testing.T) {
 	})
 }
 
+func TestSession_TxnSavepoint(t *testing.T) {
+	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnOperator := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOperator.EXPECT().Txn().Return(txn.TxnMeta{}).AnyTimes()
+		txnOperator.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		txnClient.EXPECT().New().Return(txnOperator, nil).AnyTimes()
+		eng := mock_frontend.NewMockEngine(ctrl)
+		hints := engine.Hints{CommitOrRollbackTimeout: time.Second * 10}
+		eng.EXPECT().Hints().Return(hints).AnyTimes()
+		eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		eng.EXPECT().Commit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		session := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, eng, txnClient, nil), gSysVars, false)
+		session.SetRequestContext(context.Background())
+		return session
+	}
+	convey.Convey("savepoint", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+
+		ses := genSession(ctrl, gSysVars)
+		// savepoint is ignored in the autocommit mode
+		err := ses.TxnSavepoint("sp1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.GetTxnHandler().IsValidTxn(), convey.ShouldBeFalse)
+		err = ses.TxnRollbackToSavepoint("sp1")
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist), convey.ShouldBeTrue)
+		err = ses.TxnReleaseSavepoint("sp1")
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist), convey.ShouldBeTrue)
+
+		// the mock engine does not support savepoint
+		err = ses.TxnBegin()
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.TxnSavepoint("sp1")
+		convey.So(moerr.IsMoErrCode(err, moerr.ErrNotSupported), convey.ShouldBeTrue)
+		err = ses.TxnCommit()
+		convey.So(err, convey.ShouldBeNil)
+	})
+}
+
+func TestSession_getSnapshotTS(t *testing.T) {
+	convey.Convey("snapshot timestamp", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+		ses := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, nil, txnClient, nil), gSysVars, true)
+		ses.SetRequestContext(context.Background())
+
+		// read the latest data by default
+		ts, err := ses.getSnapshotTS()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ts, convey.ShouldBeNil)
+
+		value := time.Now().Add(-time.Minute).In(ses.GetTimeZone()).Format("2006-01-02 15:04:05")
+		err = ses.SetSessionVar("snapshot_timestamp", value)
+		convey.So(err, convey.ShouldBeNil)
+		ts, err = ses.getSnapshotTS()
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ts, convey.ShouldNotBeNil)
+		convey.So(time.Unix(0, ts.PhysicalTime).Format("2006-01-02 15:04:05"), convey.ShouldEqual,
+			time.Now().Add(-time.Minute).Format("2006-01-02 15:04:05"))
+
+		err = ses.SetSessionVar("snapshot_timestamp", "not a timestamp")
+		convey.So(err, convey.ShouldBeNil)
+		_, err = ses.getSnapshotTS()
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func TestSession_getQueryLimits(t *testing.T) {
+	convey.Convey("query limits", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+		ses := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, nil, txnClient, nil), gSysVars, true)
+		ses.SetRequestContext(context.Background())
+
+		parse := func(sql string) tree.Statement {
+			stmt, err := parsers.ParseOne(context.Background(), dialect.MYSQL, sql, 1)
+			convey.So(err, convey.ShouldBeNil)
+			return stmt
+		}
+
+		// no limits by default
+		convey.So(ses.getMaxExecutionTime(parse("select a from t")), convey.ShouldEqual, 0)
+		convey.So(ses.getQueryMemLimit(), convey.ShouldEqual, 0)
+
+		err = ses.SetSessionVar("max_execution_time", uint64(1000))
+		convey.So(err, convey.ShouldBeNil)
+		err = ses.SetSessionVar("query_mem_limit", int64(1<<30))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ses.getMaxExecutionTime(parse("select a from t")), convey.ShouldEqual, time.Second)
+		convey.So(ses.getQueryMemLimit(), convey.ShouldEqual, 1<<30)
+
+		// the hint overrides the variable, and only the selects are bounded
+		convey.So(ses.getMaxExecutionTime(parse("select /*+ max_execution_time(10) */ a from t")), convey.ShouldEqual, 10*time.Millisecond)
+		convey.So(ses.getMaxExecutionTime(parse("insert into t select a from t")), convey.ShouldEqual, 0)
+	})
+}
+
+func TestSession_ResetConnection(t *testing.T) {
+	convey.Convey("reset connection", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+		ioses.EXPECT().Ref().AnyTimes()
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+		txnClient := mock_frontend.NewMockTxnClient(ctrl)
+		gSysVars := &GlobalSystemVariables{}
+		InitGlobalSystemVariables(gSysVars)
+		ses := NewSession(proto, nil, config.NewParameterUnit(&config.FrontendParameters{}, nil, txnClient, nil), gSysVars, true)
+		ses.SetRequestContext(context.Background())
+		ses.SetDatabaseName("db1")
+
+		convey.So(ses.SetUserDefinedVar("a", 1), convey.ShouldBeNil)
+		convey.So(ses.SetSessionVar("autocommit", "off"), convey.ShouldBeNil)
+		convey.So(ses.SetPrepareStmt("stmt1", &PrepareStmt{Name: "stmt1"}), convey.ShouldBeNil)
+		ses.SetLastInsertID(10)
+		ses.SetOptionBits(OPTION_BEGIN)
+		ses.SetServerStatus(SERVER_STATUS_IN_TRANS)
+
+		err = ses.ResetConnection()
+		convey.So(err, convey.ShouldBeNil)
+
+		_, value, err := ses.GetUserDefinedVar("a")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(value, convey.ShouldBeNil)
+		value, err = ses.GetSessionVar("autocommit")
+		convey.So(err, convey.ShouldBeNil)
+		globalValue, err := ses.GetGlobalVar("autocommit")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(value, convey.ShouldEqual, globalValue)
+		_, err = ses.GetPrepareStmt("stmt1")
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(ses.GetLastInsertID(), convey.ShouldEqual, 0)
+		convey.So(ses.OptionBitsIsSet(OPTION_BEGIN), convey.ShouldBeFalse)
+		convey.So(ses.OptionBitsIsSet(OPTION_AUTOCOMMIT), convey.ShouldBeTrue)
+		convey.So(ses.ServerStatusIsSet(SERVER_STATUS_IN_TRANS), convey.ShouldBeFalse)
+		// the database is kept
+		convey.So(ses.GetDatabaseName(), convey.ShouldEqual, "db1")
+
+		// the storage without the temporary engine
+		ses.storage = mock_frontend.NewMockEngine(ctrl)
+		convey.So(ses.ResetConnection(), convey.ShouldBeNil)
+	})
+}
+
 func TestVariables(t *testing.T) {
 	genSession := func(ctrl *gomock.Controller, gSysVars *GlobalSystemVariables) *Session {
 		ioses := mock_frontend.NewMockIOSession(ctrl)
@@ -30,7 +30,10 @@ const (
 		"created_time as `created`, " +
 		"status as `status`, " +
 		"suspended_time as `suspended_time`, " +
-		"comments as `comment` " +
+		"comments as `comment`, " +
+		"cast(max_storage_size/1048576 as decimal(29,3)) as `size_limit`, " +
+		"max_connections as `connections_limit`, " +
+		"max_concurrent_queries as `queries_limit` " +
 		"from " +
 		"mo_catalog.mo_account " +
 		"%s" +
@@ -42,7 +45,10 @@ const (
 		"created_time as `created`, " +
 		"status as `status`, " +
 		"suspended_time as `suspended_time`, " +
-		"comments as `comment` " +
+		"comments as `comment`, " +
+		"cast(max_storage_size/1048576 as decimal(29,3)) as `size_limit`, " +
+		"max_connections as `connections_limit`, " +
+		"max_concurrent_queries as `queries_limit` " +
 		"from " +
 		"mo_catalog.mo_account " +
 		"where account_id = %d;"
@@ -55,6 +61,9 @@ const (
 	idxOfStatus        = 3
 	idxOfSuspendedTime = 4
 	idxOfComment       = 5
+	idxOfSizeLimit     = 6
+	idxOfConnLimit     = 7
+	idxOfQueriesLimit  = 8
 
 	getTableStatsFormat = "select " +
 		"( select " +
@@ -92,7 +101,12 @@ const (
 	finalIdxOfRowCount      = 7
 	finalIdxOfSize          = 8
 	finalIdxOfComment       = 9
-	finalColumnCount        = 10
+	finalIdxOfSizeLimit     = 10
+	finalIdxOfConnections   = 11
+	finalIdxOfConnLimit     = 12
+	finalIdxOfQueries       = 13
+	finalIdxOfQueriesLimit  = 14
+	finalColumnCount        = 15
 )
 
 func getSqlForAllAccountInfo(like *tree.ComparisonExpr) string {
@@ -122,6 +136,7 @@ func doShowAccounts(ctx context.Context, ses *Session, sa *tree.ShowAccounts) er
 	var rsOfMoAccount *MysqlResultSet
 	var rsOfEachAccount []*MysqlResultSet
 	var tempRS, outputRS *MysqlResultSet
+	var usages []accountUsage
 	outputRS = &MysqlResultSet{}
 
 	bh := ses.GetBackgroundExec(ctx)
@@ -158,10 +173,11 @@ func doShowAccounts(ctx context.Context, ses *Session, sa *tree.ShowAccounts) er
 				goto handleFailed
 			}
 			rsOfEachAccount = append(rsOfEachAccount, tempRS)
+			usages = append(usages, getAccountUsage(ses, uint32(id)))
 		}
 
 		//step3: merge result set from mo_account and table stats from each account
-		err = mergeOutputResult(ctx, outputRS, rsOfMoAccount, rsOfEachAccount)
+		err = mergeOutputResult(ctx, outputRS, rsOfMoAccount, rsOfEachAccount, usages)
 		if err != nil {
 			goto handleFailed
 		}
@@ -186,7 +202,8 @@ func doShowAccounts(ctx context.Context, ses *Session, sa *tree.ShowAccounts) er
 			goto handleFailed
 		}
 
-		err = mergeOutputResult(ctx, outputRS, rsOfMoAccount, []*MysqlResultSet{tempRS})
+		usages = append(usages, getAccountUsage(ses, account.GetTenantID()))
+		err = mergeOutputResult(ctx, outputRS, rsOfMoAccount, []*MysqlResultSet{tempRS}, usages)
 		if err != nil {
 			goto handleFailed
 		}
@@ -288,9 +305,9 @@ func getTableStats(ctx context.Context, bh BackgroundExec, accountId uint64) (*M
 	return rs, err
 }
 
-// mergeOutputResult merges the result set from mo_account and the table status
-// into the final output format
-func mergeOutputResult(ctx context.Context, outputRS *MysqlResultSet, rsOfMoAccount *MysqlResultSet, rsOfEachAccount []*MysqlResultSet) error {
+// mergeOutputResult merges the result set from mo_account, the table status
+// and the usages of the accounts on this cn into the final output format
+func mergeOutputResult(ctx context.Context, outputRS *MysqlResultSet, rsOfMoAccount *MysqlResultSet, rsOfEachAccount []*MysqlResultSet, usages []accountUsage) error {
 	var err error
 	outputColumns := make([]Column, finalColumnCount)
 
@@ -334,6 +351,20 @@ func mergeOutputResult(ctx context.Context, outputRS *MysqlResultSet, rsOfMoAcco
 	if err != nil {
 		return err
 	}
+	outputColumns[finalIdxOfSizeLimit], err = rsOfMoAccount.GetColumn(ctx, idxOfSizeLimit)
+	if err != nil {
+		return err
+	}
+	outputColumns[finalIdxOfConnections] = newUsageColumn("connections")
+	outputColumns[finalIdxOfConnLimit], err = rsOfMoAccount.GetColumn(ctx, idxOfConnLimit)
+	if err != nil {
+		return err
+	}
+	outputColumns[finalIdxOfQueries] = newUsageColumn("queries")
+	outputColumns[finalIdxOfQueriesLimit], err = rsOfMoAccount.GetColumn(ctx, idxOfQueriesLimit)
+	if err != nil {
+		return err
+	}
 	for _, o := range outputColumns {
 		outputRS.AddColumn(o)
 	}
@@ -379,7 +410,29 @@ func mergeOutputResult(ctx context.Context, outputRS *MysqlResultSet, rsOfMoAcco
 		if err != nil {
 			return err
 		}
+		outputRow[finalIdxOfSizeLimit], err = rsOfMoAccount.GetValue(ctx, uint64(i), idxOfSizeLimit)
+		if err != nil {
+			return err
+		}
+		outputRow[finalIdxOfConnections] = usages[i].connections
+		outputRow[finalIdxOfConnLimit], err = rsOfMoAccount.GetValue(ctx, uint64(i), idxOfConnLimit)
+		if err != nil {
+			return err
+		}
+		outputRow[finalIdxOfQueries] = usages[i].queries
+		outputRow[finalIdxOfQueriesLimit], err = rsOfMoAccount.GetValue(ctx, uint64(i), idxOfQueriesLimit)
+		if err != nil {
+			return err
+		}
 		outputRS.AddRow(outputRow)
 	}
 	return err
 }
+
+// newUsageColumn makes the column of the usage counted on this cn
+func newUsageColumn(name string) *MysqlColumn {
+	col := &MysqlColumn{}
+	col.SetName(name)
+	col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	return col
+}
@@ -34,11 +34,11 @@ func Test_getSqlForAccountInfo(t *testing.T) {
 	args := []arg{
 		{
 			s:    "show accounts;",
-			want: "select account_id as `account_id`, account_name as `account_name`, created_time as `created`, status as `status`, suspended_time as `suspended_time`, comments as `comment` from mo_catalog.mo_account ;",
+			want: "select account_id as `account_id`, account_name as `account_name`, created_time as `created`, status as `status`, suspended_time as `suspended_time`, comments as `comment`, cast(max_storage_size/1048576 as decimal(29,3)) as `size_limit`, max_connections as `connections_limit`, max_concurrent_queries as `queries_limit` from mo_catalog.mo_account ;",
 		},
 		{
 			s:    "show accounts like '%abc';",
-			want: "select account_id as `account_id`, account_name as `account_name`, created_time as `created`, status as `status`, suspended_time as `suspended_time`, comments as `comment` from mo_catalog.mo_account where account_name like '%abc';",
+			want: "select account_id as `account_id`, account_name as `account_name`, created_time as `created`, status as `status`, suspended_time as `suspended_time`, comments as `comment`, cast(max_storage_size/1048576 as decimal(29,3)) as `size_limit`, max_connections as `connections_limit`, max_concurrent_queries as `queries_limit` from mo_catalog.mo_account where account_name like '%abc';",
 		},
 	}
 
@@ -68,6 +68,9 @@ func newAccountInfo() *MysqlResultSet {
 	rsFromMoAccout.AddColumn(getColumnDef("status", defines.MYSQL_TYPE_VARCHAR))
 	rsFromMoAccout.AddColumn(getColumnDef("suspended_time", defines.MYSQL_TYPE_TIMESTAMP))
 	rsFromMoAccout.AddColumn(getColumnDef("comment", defines.MYSQL_TYPE_VARCHAR))
+	rsFromMoAccout.AddColumn(getColumnDef("size_limit", defines.MYSQL_TYPE_DECIMAL))
+	rsFromMoAccout.AddColumn(getColumnDef("connections_limit", defines.MYSQL_TYPE_LONGLONG))
+	rsFromMoAccout.AddColumn(getColumnDef("queries_limit", defines.MYSQL_TYPE_LONGLONG))
 	rsFromMoAccout.AddRow(make([]interface{}, rsFromMoAccout.GetColumnCount()))
 	return rsFromMoAccout
 }
@@ -87,7 +90,7 @@ func Test_mergeResult(t *testing.T) {
 	rsFromMoAccount := newAccountInfo()
 	rs1 := newTableStatsResult()
 	ans1 := &MysqlResultSet{}
-	err := mergeOutputResult(context.Background(), ans1, rsFromMoAccount, []*MysqlResultSet{rs1})
+	err := mergeOutputResult(context.Background(), ans1, rsFromMoAccount, []*MysqlResultSet{rs1}, []accountUsage{{}})
 	assert.NoError(t, err)
 	assert.Equal(t, ans1.GetColumnCount(), uint64(finalColumnCount))
 }
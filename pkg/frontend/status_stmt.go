@@ -61,6 +61,33 @@ func (rte *RollbackTxnExecutor) ExecuteImpl(ctx context.Context, ses *Session) e
 	return ses.TxnRollback()
 }
 
+type SavepointExecutor struct {
+	*statusStmtExecutor
+	sp *tree.Savepoint
+}
+
+func (spe *SavepointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnSavepoint(string(spe.sp.Name))
+}
+
+type RollbackToSavepointExecutor struct {
+	*statusStmtExecutor
+	rts *tree.RollbackToSavepoint
+}
+
+func (rtse *RollbackToSavepointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnRollbackToSavepoint(string(rtse.rts.Name))
+}
+
+type ReleaseSavepointExecutor struct {
+	*statusStmtExecutor
+	rs *tree.ReleaseSavepoint
+}
+
+func (rse *ReleaseSavepointExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return ses.TxnReleaseSavepoint(string(rse.rs.Name))
+}
+
 type SetRoleExecutor struct {
 	*statusStmtExecutor
 	sr *tree.SetRole
@@ -287,6 +314,60 @@ func (dae *DropAccountExecutor) ExecuteImpl(ctx context.Context, ses *Session) e
 	return doDropAccount(ctx, ses, dae.da)
 }
 
+type CreateChangefeedExecutor struct {
+	*statusStmtExecutor
+	cc *tree.CreateChangefeed
+}
+
+func (cce *CreateChangefeedExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doCreateChangefeed(ctx, ses, cce.cc)
+}
+
+type DropChangefeedExecutor struct {
+	*statusStmtExecutor
+	dc *tree.DropChangefeed
+}
+
+func (dce *DropChangefeedExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doDropChangefeed(ctx, ses, dce.dc)
+}
+
+type CreateAuditPolicyExecutor struct {
+	*statusStmtExecutor
+	cp *tree.CreateAuditPolicy
+}
+
+func (cpe *CreateAuditPolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doCreateAuditPolicy(ctx, ses, cpe.cp)
+}
+
+type DropAuditPolicyExecutor struct {
+	*statusStmtExecutor
+	dp *tree.DropAuditPolicy
+}
+
+func (dpe *DropAuditPolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doDropAuditPolicy(ctx, ses, dpe.dp)
+}
+
+type CreatePolicyExecutor struct {
+	*statusStmtExecutor
+	cp *tree.CreatePolicy
+}
+
+func (cpe *CreatePolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doCreatePolicy(ctx, ses, cpe.cp)
+}
+
+type DropPolicyExecutor struct {
+	*statusStmtExecutor
+	dp *tree.DropPolicy
+}
+
+func (dpe *DropPolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doDropPolicy(ctx, ses, dpe.dp)
+}
+
 type AlterAccountExecutor struct {
 	*statusStmtExecutor
 	aa *tree.AlterAccount
@@ -402,6 +483,11 @@ type AlterViewExecutor struct {
 	av *tree.AlterView
 }
 
+type AlterTableExecutor struct {
+	*statusStmtExecutor
+	at *tree.AlterTable
+}
+
 type DropViewExecutor struct {
 	*statusStmtExecutor
 	dv *tree.DropView
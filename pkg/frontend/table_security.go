@@ -0,0 +1,684 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/tidwall/btree"
+)
+
+var (
+	getColumnPrivsOfTableFormat = `select role_id, column_name, privilege_id from mo_catalog.mo_column_privs where obj_id = %d;`
+
+	insertColumnPrivFormat = `insert into mo_catalog.mo_column_privs(
+			role_id,
+			role_name,
+			obj_id,
+			dat_name,
+			table_name,
+			column_name,
+			privilege_id,
+			privilege_name,
+			operation_user_id,
+			granted_time,
+			with_grant_option) values (%d, %s, %d, %s, %s, %s, %d, %s, %d, %s, %v);`
+
+	deleteColumnPrivFormat = `delete from mo_catalog.mo_column_privs where role_id = %d and obj_id = %d and column_name = %s and privilege_id = %d;`
+
+	getColumnsOfTableFormat = `select attname from mo_catalog.mo_columns where att_relname_id = %d and att_is_hidden = 0;`
+
+	getRowPoliciesOfTableFormat = `select policy_name, command, roles, using_expr from mo_catalog.mo_row_policies where obj_id = %d;`
+
+	getRowPolicyFormat = `select policy_name from mo_catalog.mo_row_policies where obj_id = %d and policy_name = %s;`
+
+	insertRowPolicyFormat = `insert into mo_catalog.mo_row_policies(
+			policy_name,
+			obj_id,
+			dat_name,
+			table_name,
+			command,
+			roles,
+			using_expr,
+			creator,
+			created_time) values (%s, %d, %s, %s, %s, %s, %s, %d, %s);`
+
+	deleteRowPolicyFormat = `delete from mo_catalog.mo_row_policies where obj_id = %d and policy_name = %s;`
+
+	// the filter of the policy is checked by the query on the table
+	checkRowPolicyFilterFormat = "select 1 from `%s`.`%s` where %s limit 0;"
+
+	getRoleNamesFormat = `select role_name from mo_catalog.mo_role where role_id in (%s);`
+)
+
+// columnPriv is a privilege on a column of the table granted to the role
+type columnPriv struct {
+	roleId   int64
+	column   string
+	privType PrivilegeType
+}
+
+// rowPolicy is a row level security policy of the table
+type rowPolicy struct {
+	name    string
+	command tree.PolicyCommand
+	// the roles the policy applies to, empty for all the roles
+	roles []string
+	using string
+}
+
+// tableSecurityOfTable is the column privileges and the row level security
+// policies of a table loaded from mo_column_privs and mo_row_policies
+type tableSecurityOfTable struct {
+	privs    []columnPriv
+	policies []*rowPolicy
+}
+
+// tableSecurityOfTxn caches the security of the tables loaded in a txn of the
+// session, so the statements do not query the system tables for every table.
+// The cache is not used across the txns, the privileges granted or revoked and
+// the policies created or dropped on any cn are seen by the next txn.
+type tableSecurityOfTxn struct {
+	sync.Mutex
+	txn    TxnOperator
+	tables map[uint64]*tableSecurityOfTable
+}
+
+func (c *tableSecurityOfTxn) get(txn TxnOperator, tableId uint64) *tableSecurityOfTable {
+	c.Lock()
+	defer c.Unlock()
+	if c.txn != txn {
+		return nil
+	}
+	return c.tables[tableId]
+}
+
+func (c *tableSecurityOfTxn) set(txn TxnOperator, tableId uint64, ts *tableSecurityOfTable) {
+	c.Lock()
+	defer c.Unlock()
+	if c.txn != txn {
+		c.txn = txn
+		c.tables = make(map[uint64]*tableSecurityOfTable)
+	}
+	c.tables[tableId] = ts
+}
+
+// invalidate drops the cached security of the tables
+func (c *tableSecurityOfTxn) invalidate() {
+	c.Lock()
+	defer c.Unlock()
+	c.txn = nil
+	c.tables = nil
+}
+
+// getSecurityOfTable returns the column privileges and the row level security
+// policies of the table, from the cache if they were loaded in the current txn
+// of the session.
+func getSecurityOfTable(ctx context.Context, ses *Session, bh BackgroundExec, tableId uint64) (*tableSecurityOfTable, error) {
+	txn := ses.GetTxnHandler().GetTxnOperator()
+	if txn != nil {
+		if ts := ses.tableSecurity.get(txn, tableId); ts != nil {
+			return ts, nil
+		}
+	}
+	ts := &tableSecurityOfTable{}
+	var err error
+	if ts.privs, err = getColumnPrivsOfTable(ctx, bh, tableId); err != nil {
+		return nil, err
+	}
+	if ts.policies, err = getRowPoliciesOfTable(ctx, bh, tableId); err != nil {
+		return nil, err
+	}
+	if txn != nil {
+		ses.tableSecurity.set(txn, tableId, ts)
+	}
+	return ts, nil
+}
+
+func policyCommandOf(s string) (tree.PolicyCommand, bool) {
+	for c := tree.PolicyCommandAll; c <= tree.PolicyCommandDelete; c++ {
+		if c.String() == s {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+// checkColumnPrivilegeType checks the privilege can be granted on the columns,
+// only the select and update privileges on a table can be.
+func checkColumnPrivilegeType(ctx context.Context, privType PrivilegeType, ot tree.ObjectType, pl tree.PrivilegeLevel) error {
+	if privType != PrivilegeTypeSelect && privType != PrivilegeTypeUpdate {
+		return moerr.NewInternalError(ctx, `the privilege "%s" can not be granted on the columns`, privType)
+	}
+	if ot != tree.OBJECT_TYPE_TABLE ||
+		(pl.Level != tree.PRIVILEGE_LEVEL_TYPE_TABLE && pl.Level != tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE) {
+		return moerr.NewInternalError(ctx, `the privilege "%s" on the columns can only be granted on a table`, privType)
+	}
+	return nil
+}
+
+// getColumnsOfPrivilege returns the columns of the privilege, which should be
+// the visible columns of the table.
+func getColumnsOfPrivilege(ctx context.Context, bh BackgroundExec, tableId int64, priv *tree.Privilege) ([]string, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getColumnsOfTableFormat, tableId)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	existed := make(map[string]bool)
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			name, err := erArray[0].GetString(ctx, i, 0)
+			if err != nil {
+				return nil, err
+			}
+			existed[name] = true
+		}
+	}
+	cols := make([]string, 0, len(priv.ColumnList))
+	for _, c := range priv.ColumnList {
+		col := c.Parts[0]
+		if !existed[col] {
+			return nil, moerr.NewInternalError(ctx, "there is no column %s in the table", col)
+		}
+		if !stringInList(col, cols) {
+			cols = append(cols, col)
+		}
+	}
+	return cols, nil
+}
+
+// grantColumnPrivilege records the privilege on the columns of the table granted to the roles
+func grantColumnPrivilege(ctx context.Context, bh BackgroundExec, ses *Session, gp *tree.GrantPrivilege,
+	priv *tree.Privilege, privType PrivilegeType, roles []*verifiedRole, tableId int64) error {
+	cols, err := getColumnsOfPrivilege(ctx, bh, tableId, priv)
+	if err != nil {
+		return err
+	}
+	dbName := gp.Level.DbName
+	if dbName == "" {
+		dbName = ses.GetDatabaseName()
+	}
+	now := types.CurrentTimestamp().String2(time.UTC, 0)
+	for _, role := range roles {
+		for _, col := range cols {
+			sqls := []string{
+				fmt.Sprintf(deleteColumnPrivFormat, role.id, tableId, quoteString(col), privType),
+				fmt.Sprintf(insertColumnPrivFormat,
+					role.id,
+					quoteString(role.name),
+					tableId,
+					quoteString(dbName),
+					quoteString(gp.Level.TabName),
+					quoteString(col),
+					privType,
+					quoteString(privType.String()),
+					ses.GetTenantInfo().GetUserID(),
+					quoteString(now),
+					gp.GrantOption),
+			}
+			for _, sql := range sqls {
+				bh.ClearExecResultSet()
+				if err = bh.Exec(ctx, sql); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// revokeColumnPrivilege removes the privilege on the columns of the table from the roles
+func revokeColumnPrivilege(ctx context.Context, bh BackgroundExec, priv *tree.Privilege,
+	privType PrivilegeType, roles []*verifiedRole, tableId int64) error {
+	for _, role := range roles {
+		if role == nil {
+			continue
+		}
+		for _, c := range priv.ColumnList {
+			bh.ClearExecResultSet()
+			err := bh.Exec(ctx, fmt.Sprintf(deleteColumnPrivFormat, role.id, tableId, quoteString(c.Parts[0]), privType))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func getColumnPrivsOfTable(ctx context.Context, bh BackgroundExec, tableId uint64) ([]columnPriv, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getColumnPrivsOfTableFormat, tableId)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return nil, err
+	}
+	privs := make([]columnPriv, erArray[0].GetRowCount())
+	for i := range privs {
+		row := uint64(i)
+		if privs[i].roleId, err = erArray[0].GetInt64(ctx, row, 0); err != nil {
+			return nil, err
+		}
+		if privs[i].column, err = erArray[0].GetString(ctx, row, 1); err != nil {
+			return nil, err
+		}
+		privType, err := erArray[0].GetInt64(ctx, row, 2)
+		if err != nil {
+			return nil, err
+		}
+		privs[i].privType = PrivilegeType(privType)
+	}
+	return privs, nil
+}
+
+func getRowPoliciesOfTable(ctx context.Context, bh BackgroundExec, tableId uint64) ([]*rowPolicy, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getRowPoliciesOfTableFormat, tableId)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return nil, err
+	}
+	policies := make([]*rowPolicy, erArray[0].GetRowCount())
+	for i := range policies {
+		row := uint64(i)
+		p := &rowPolicy{}
+		if p.name, err = erArray[0].GetString(ctx, row, 0); err != nil {
+			return nil, err
+		}
+		command, err := erArray[0].GetString(ctx, row, 1)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if p.command, ok = policyCommandOf(command); !ok {
+			return nil, moerr.NewInternalError(ctx, "invalid command %s of the policy %s", command, p.name)
+		}
+		roles, err := erArray[0].GetString(ctx, row, 2)
+		if err != nil {
+			return nil, err
+		}
+		if roles != "" {
+			p.roles = strings.Split(roles, ",")
+		}
+		if p.using, err = erArray[0].GetString(ctx, row, 3); err != nil {
+			return nil, err
+		}
+		policies[i] = p
+	}
+	return policies, nil
+}
+
+// getRoleSetOfCurrentUser returns the roles the current user uses, and the
+// roles granted to them recursively.
+func getRoleSetOfCurrentUser(ctx context.Context, bh BackgroundExec, tenant *TenantInfo) (*btree.Set[int64], error) {
+	roles := &btree.Set[int64]{}
+	roles.Insert(int64(tenant.GetDefaultRoleID()))
+	if err := loadAllSecondaryRoles(ctx, bh, tenant, roles); err != nil {
+		return nil, err
+	}
+	queue := roles.Keys()
+	for len(queue) != 0 {
+		roleId := queue[0]
+		queue = queue[1:]
+		bh.ClearExecResultSet()
+		if err := bh.Exec(ctx, getSqlForInheritedRoleIdOfRoleId(roleId)); err != nil {
+			return nil, err
+		}
+		erArray, err := getResultSet(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			continue
+		}
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			grantedId, err := erArray[0].GetInt64(ctx, i, 0)
+			if err != nil {
+				return nil, err
+			}
+			if !roles.Contains(grantedId) {
+				roles.Insert(grantedId)
+				queue = append(queue, grantedId)
+			}
+		}
+	}
+	return roles, nil
+}
+
+func getRoleNames(ctx context.Context, bh BackgroundExec, roles *btree.Set[int64]) ([]string, error) {
+	ids := make([]string, 0, roles.Len())
+	for _, id := range roles.Keys() {
+		ids = append(ids, fmt.Sprintf("%d", id))
+	}
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getRoleNamesFormat, strings.Join(ids, ", "))); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil || !execResultArrayHasData(erArray) {
+		return nil, err
+	}
+	names := make([]string, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// userHasTablePrivilege decides the user has the privilege on the whole table
+func userHasTablePrivilege(ctx context.Context, ses *Session, privType PrivilegeType, dbName, tableName string) (bool, error) {
+	priv := &privilege{
+		kind:    privilegeKindGeneral,
+		objType: objectTypeTable,
+	}
+	convertPrivilegeTipsToPrivilege(priv, privilegeTipsArray{{
+		typ:          privType,
+		databaseName: dbName,
+		tableName:    tableName,
+	}})
+	return determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+}
+
+// needTableSecurity decides the statements of the session are restricted by the
+// column privileges and the row level security policies. The moAdmin and the
+// accountAdmin are not restricted.
+func needTableSecurity(ses *Session) bool {
+	if ses.skipCheckPrivilege() || ses.skipAuthForSpecialUser() ||
+		ses.IsBackgroundSession() || ses.GetIsInternal() {
+		return false
+	}
+	tenant := ses.GetTenantInfo()
+	return tenant != nil && !tenant.IsAdminRole()
+}
+
+// getTableSecurity returns the column privileges and the row level security
+// policies of the table applied to the current user, nil if the user is not
+// restricted on the table. The columns the user can select or update are
+// restricted only if the user does not have the privilege on the whole table.
+func getTableSecurity(ctx context.Context, ses *Session, dbName, tableName string, tableId uint64) (*plan2.TableSecurity, error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	ts, err := getSecurityOfTable(ctx, ses, bh, tableId)
+	if err != nil {
+		return nil, err
+	}
+	privs, policies := ts.privs, ts.policies
+	if len(privs) == 0 && len(policies) == 0 {
+		return nil, nil
+	}
+	roles, err := getRoleSetOfCurrentUser(ctx, bh, ses.GetTenantInfo())
+	if err != nil {
+		return nil, err
+	}
+
+	security := &plan2.TableSecurity{
+		HasPolicy: len(policies) != 0,
+	}
+	if len(privs) != 0 {
+		for _, privType := range []PrivilegeType{PrivilegeTypeSelect, PrivilegeTypeUpdate} {
+			yes, err := userHasTablePrivilege(ctx, ses, privType, dbName, tableName)
+			if err != nil {
+				return nil, err
+			}
+			if yes {
+				continue
+			}
+			cols := make(map[string]struct{})
+			for _, p := range privs {
+				if p.privType == privType && roles.Contains(p.roleId) {
+					cols[p.column] = struct{}{}
+				}
+			}
+			if privType == PrivilegeTypeSelect {
+				security.SelectColumns = cols
+			} else {
+				security.UpdateColumns = cols
+			}
+		}
+	}
+	if len(policies) != 0 {
+		names, err := getRoleNames(ctx, bh, roles)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range policies {
+			if len(p.roles) != 0 {
+				applied := false
+				for _, name := range names {
+					if stringInList(name, p.roles) {
+						applied = true
+						break
+					}
+				}
+				if !applied {
+					continue
+				}
+			}
+			security.Policies = append(security.Policies, &plan2.RowPolicy{
+				Name:    p.name,
+				Command: p.command,
+				Using:   p.using,
+			})
+		}
+	}
+	return security, nil
+}
+
+// removePrivilegeTipsOfColumnPrivileges removes the tips of selecting or updating
+// the tables the user has the privileges on the columns of, the columns the
+// statement touches have been checked when the plan is built.
+func removePrivilegeTipsOfColumnPrivileges(ctx context.Context, ses *Session, arr privilegeTipsArray) (privilegeTipsArray, error) {
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	var roles *btree.Set[int64]
+	left := make(privilegeTipsArray, 0, len(arr))
+	for _, tips := range arr {
+		if (tips.typ == PrivilegeTypeSelect || tips.typ == PrivilegeTypeUpdate) && !isBannedDatabase(tips.databaseName) {
+			tableId, err := getDatabaseOrTableId(ctx, bh, false, tips.databaseName, tips.tableName)
+			if err == nil {
+				ts, err := getSecurityOfTable(ctx, ses, bh, uint64(tableId))
+				if err != nil {
+					return nil, err
+				}
+				privs := ts.privs
+				if len(privs) != 0 && roles == nil {
+					if roles, err = getRoleSetOfCurrentUser(ctx, bh, ses.GetTenantInfo()); err != nil {
+						return nil, err
+					}
+				}
+				covered := false
+				for _, p := range privs {
+					if p.privType == tips.typ && roles.Contains(p.roleId) {
+						covered = true
+						break
+					}
+				}
+				if covered {
+					continue
+				}
+			}
+		}
+		left = append(left, tips)
+	}
+	return left, nil
+}
+
+// finishPolicyTxn commits the transaction of the policy, or rolls it back on the error
+func finishPolicyTxn(ctx context.Context, bh BackgroundExec, err error) error {
+	if err == nil {
+		if err = bh.Exec(ctx, "commit;"); err == nil {
+			return nil
+		}
+	}
+	if rbErr := bh.Exec(ctx, "rollback;"); rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+func getPolicyTable(ctx context.Context, ses *Session, tbl *tree.TableName) (string, string, error) {
+	dbName := string(tbl.SchemaName)
+	if dbName == "" {
+		dbName = ses.GetDatabaseName()
+	}
+	if dbName == "" {
+		return "", "", moerr.NewNoDB(ctx)
+	}
+	return dbName, string(tbl.ObjectName), nil
+}
+
+func rowPolicyExists(ctx context.Context, bh BackgroundExec, tableId int64, name string) (bool, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getRowPolicyFormat, tableId, quoteString(name))); err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+// doCreatePolicy records the row level security policy of the table in mo_row_policies.
+// Once a table has a policy, the rows of it are invisible to the users unless the
+// policies of their roles allow them.
+func doCreatePolicy(ctx context.Context, ses *Session, st *tree.CreatePolicy) error {
+	dbName, tableName, err := getPolicyTable(ctx, ses, st.Table)
+	if err != nil {
+		return err
+	}
+	if err = normalizeNamesOfRoles(ctx, st.Roles); err != nil {
+		return err
+	}
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	st.Using.Format(fmtCtx)
+	using := fmtCtx.String()
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return err
+	}
+	err = func() error {
+		tableId, err := getDatabaseOrTableId(ctx, bh, false, dbName, tableName)
+		if err != nil {
+			return err
+		}
+		exists, err := rowPolicyExists(ctx, bh, tableId, string(st.Name))
+		if err != nil {
+			return err
+		}
+		if exists {
+			if st.IfNotExists {
+				return nil
+			}
+			return moerr.NewInternalError(ctx, "policy %s on the table %s already exists", st.Name, tableName)
+		}
+		roles := make([]string, 0, len(st.Roles))
+		for _, r := range st.Roles {
+			sql, err := getSqlForRoleIdOfRole(ctx, r.UserName)
+			if err != nil {
+				return err
+			}
+			vr, err := verifyRoleFunc(ctx, bh, sql, r.UserName, roleType)
+			if err != nil {
+				return err
+			}
+			if vr == nil {
+				return moerr.NewInternalError(ctx, "there is no role %s", r.UserName)
+			}
+			if !stringInList(r.UserName, roles) {
+				roles = append(roles, r.UserName)
+			}
+		}
+		bh.ClearExecResultSet()
+		if err = bh.Exec(ctx, fmt.Sprintf(checkRowPolicyFilterFormat, dbName, tableName, using)); err != nil {
+			return moerr.NewInternalError(ctx, "invalid filter of the policy %s: %v", st.Name, err)
+		}
+		bh.ClearExecResultSet()
+		return bh.Exec(ctx, fmt.Sprintf(insertRowPolicyFormat,
+			quoteString(string(st.Name)),
+			tableId,
+			quoteString(dbName),
+			quoteString(tableName),
+			quoteString(st.Command.String()),
+			quoteString(strings.Join(roles, ",")),
+			quoteString(using),
+			ses.GetTenantInfo().GetUserID(),
+			quoteString(types.CurrentTimestamp().String2(time.UTC, 0)),
+		))
+	}()
+	if err = finishPolicyTxn(ctx, bh, err); err != nil {
+		return err
+	}
+	ses.tableSecurity.invalidate()
+	return nil
+}
+
+// doDropPolicy removes the row level security policy of the table
+func doDropPolicy(ctx context.Context, ses *Session, st *tree.DropPolicy) error {
+	dbName, tableName, err := getPolicyTable(ctx, ses, st.Table)
+	if err != nil {
+		return err
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	if err = bh.Exec(ctx, "begin;"); err != nil {
+		return err
+	}
+	err = func() error {
+		tableId, err := getDatabaseOrTableId(ctx, bh, false, dbName, tableName)
+		if err != nil {
+			return err
+		}
+		exists, err := rowPolicyExists(ctx, bh, tableId, string(st.Name))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if st.IfExists {
+				return nil
+			}
+			return moerr.NewInternalError(ctx, "policy %s on the table %s does not exist", st.Name, tableName)
+		}
+		bh.ClearExecResultSet()
+		return bh.Exec(ctx, fmt.Sprintf(deleteRowPolicyFormat, tableId, quoteString(string(st.Name))))
+	}()
+	if err = finishPolicyTxn(ctx, bh, err); err != nil {
+		return err
+	}
+	ses.tableSecurity.invalidate()
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func newMrsForTableSecurity(names []string, rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for i, name := range names {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		if len(rows) != 0 {
+			if _, ok := rows[0][i].(int64); ok {
+				col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			}
+		}
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func newTableSecurityTenant() *TenantInfo {
+	return &TenantInfo{
+		Tenant:        "acc1",
+		TenantID:      1,
+		User:          "u1",
+		UserID:        10,
+		DefaultRole:   "r1",
+		DefaultRoleID: 5,
+	}
+}
+
+func TestGetTableSecurity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ctx := context.TODO()
+	ses := newAuditSes(newTableSecurityTenant())
+	require.True(t, needTableSecurity(ses))
+
+	privCols := []string{"role_id", "column_name", "privilege_id"}
+	policyCols := []string{"policy_name", "command", "roles", "using_expr"}
+	bh.sql2result[fmt.Sprintf(getColumnPrivsOfTableFormat, 100)] = newMrsForTableSecurity(privCols, nil)
+	bh.sql2result[fmt.Sprintf(getRowPoliciesOfTableFormat, 100)] = newMrsForTableSecurity(policyCols, nil)
+
+	ses.GetTxnHandler().txn = mock_frontend.NewMockTxnOperator(ctrl)
+
+	// no privilege on the columns and no policy
+	security, err := getTableSecurity(ctx, ses, "db1", "t1", 100)
+	require.NoError(t, err)
+	require.Nil(t, security)
+
+	// the role r1 inherits the role r2
+	bh.sql2result[fmt.Sprintf(getRowPoliciesOfTableFormat, 100)] = newMrsForTableSecurity(policyCols, [][]interface{}{
+		{"p1", "select", "", "a > 1"},
+		{"p2", "all", "r2", "b = 1"},
+		{"p3", "update", "r3", "c = 1"},
+	})
+	bh.sql2result[getSqlForInheritedRoleIdOfRoleId(5)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{{int64(6), false}})
+	bh.sql2result[getSqlForInheritedRoleIdOfRoleId(6)] = newMrsForInheritedRoleIdOfRoleId(nil)
+	bh.sql2result[fmt.Sprintf(getRoleNamesFormat, "5, 6")] = newMrsForTableSecurity([]string{"role_name"}, [][]interface{}{{"r1"}, {"r2"}})
+
+	// the security of the table is cached in the txn
+	security, err = getTableSecurity(ctx, ses, "db1", "t1", 100)
+	require.NoError(t, err)
+	require.Nil(t, security)
+	ses.GetTxnHandler().txn = mock_frontend.NewMockTxnOperator(ctrl)
+
+	security, err = getTableSecurity(ctx, ses, "db1", "t1", 100)
+	require.NoError(t, err)
+	require.NotNil(t, security)
+	require.True(t, security.HasPolicy)
+	require.Nil(t, security.SelectColumns)
+	require.Nil(t, security.UpdateColumns)
+	require.Equal(t, 2, len(security.Policies))
+	require.Equal(t, "p1", security.Policies[0].Name)
+	require.Equal(t, tree.PolicyCommandSelect, security.Policies[0].Command)
+	require.Equal(t, "p2", security.Policies[1].Name)
+	require.Equal(t, tree.PolicyCommandAll, security.Policies[1].Command)
+	require.Equal(t, "b = 1", security.Policies[1].Using)
+}
+
+func TestRemovePrivilegeTipsOfColumnPrivileges(t *testing.T) {
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ctx := context.TODO()
+	ses := newAuditSes(newTableSecurityTenant())
+
+	sql, err := getSqlForCheckDatabaseTable(ctx, "db1", "t1")
+	require.NoError(t, err)
+	bh.sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{{int64(100)}})
+	sql, err = getSqlForCheckDatabaseTable(ctx, "db1", "t2")
+	require.NoError(t, err)
+	bh.sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{{int64(101)}})
+	privCols := []string{"role_id", "column_name", "privilege_id"}
+	bh.sql2result[fmt.Sprintf(getColumnPrivsOfTableFormat, 100)] = newMrsForTableSecurity(privCols, [][]interface{}{
+		{int64(5), "a", int64(PrivilegeTypeSelect)},
+		{int64(7), "b", int64(PrivilegeTypeUpdate)},
+	})
+	bh.sql2result[fmt.Sprintf(getColumnPrivsOfTableFormat, 101)] = newMrsForTableSecurity(privCols, nil)
+	policyCols := []string{"policy_name", "command", "roles", "using_expr"}
+	bh.sql2result[fmt.Sprintf(getRowPoliciesOfTableFormat, 100)] = newMrsForTableSecurity(policyCols, nil)
+	bh.sql2result[fmt.Sprintf(getRowPoliciesOfTableFormat, 101)] = newMrsForTableSecurity(policyCols, nil)
+	bh.sql2result[getSqlForInheritedRoleIdOfRoleId(5)] = newMrsForInheritedRoleIdOfRoleId(nil)
+
+	left, err := removePrivilegeTipsOfColumnPrivileges(ctx, ses, privilegeTipsArray{
+		{typ: PrivilegeTypeSelect, databaseName: "db1", tableName: "t1"},
+		{typ: PrivilegeTypeUpdate, databaseName: "db1", tableName: "t1"},
+		{typ: PrivilegeTypeSelect, databaseName: "db1", tableName: "t2"},
+		{typ: PrivilegeTypeInsert, databaseName: "db1", tableName: "t1"},
+	})
+	require.NoError(t, err)
+	// the update privilege on the column b is granted to the other role
+	require.Equal(t, privilegeTipsArray{
+		{typ: PrivilegeTypeUpdate, databaseName: "db1", tableName: "t1"},
+		{typ: PrivilegeTypeSelect, databaseName: "db1", tableName: "t2"},
+		{typ: PrivilegeTypeInsert, databaseName: "db1", tableName: "t1"},
+	}, left)
+}
+
+func TestGrantColumnPrivilege(t *testing.T) {
+	ctx := context.TODO()
+	ses := newAuditSes(newTableSecurityTenant())
+	bh := &auditBackgroundExecTest{}
+	bh.init()
+	bh.sql2result[fmt.Sprintf(getColumnsOfTableFormat, 100)] = newMrsForTableSecurity([]string{"attname"}, [][]interface{}{{"a"}, {"b"}})
+
+	stmt, err := mysql.ParseOne(ctx, "grant select(a, a, b) on table t1 to r1", 1)
+	require.NoError(t, err)
+	gp := &stmt.(*tree.Grant).GrantPrivilege
+	roles := []*verifiedRole{{typ: roleType, name: "r1", id: 5}}
+	err = checkColumnPrivilegeType(ctx, PrivilegeTypeSelect, gp.ObjType, *gp.Level)
+	require.NoError(t, err)
+	err = grantColumnPrivilege(ctx, bh, ses, gp, gp.Privileges[0], PrivilegeTypeSelect, roles, 100)
+	require.NoError(t, err)
+
+	var inserted []string
+	for _, sql := range bh.sqls {
+		if strings.HasPrefix(sql, "insert into mo_catalog.mo_column_privs") {
+			inserted = append(inserted, sql)
+		}
+	}
+	require.Equal(t, 2, len(inserted))
+	require.Contains(t, inserted[0], fmt.Sprintf("values (5, 'r1', 100, 'db1', 't1', 'a', %d, 'select', 10", PrivilegeTypeSelect))
+	require.Contains(t, inserted[1], fmt.Sprintf("values (5, 'r1', 100, 'db1', 't1', 'b', %d, 'select', 10", PrivilegeTypeSelect))
+
+	// the column does not exist
+	stmt, err = mysql.ParseOne(ctx, "grant update(c) on table t1 to r1", 1)
+	require.NoError(t, err)
+	gp = &stmt.(*tree.Grant).GrantPrivilege
+	err = grantColumnPrivilege(ctx, bh, ses, gp, gp.Privileges[0], PrivilegeTypeUpdate, roles, 100)
+	require.Error(t, err)
+
+	// only the select and update privileges can be granted on the columns
+	err = checkColumnPrivilegeType(ctx, PrivilegeTypeInsert, gp.ObjType, *gp.Level)
+	require.Error(t, err)
+	err = checkColumnPrivilegeType(ctx, PrivilegeTypeSelect, gp.ObjType,
+		tree.PrivilegeLevel{Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR, DbName: "db1"})
+	require.Error(t, err)
+}
+
+func TestDoCreateAndDropPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &auditBackgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ctx := context.TODO()
+	ses := newAuditSes(newTableSecurityTenant())
+
+	sql, err := getSqlForCheckDatabaseTable(ctx, "db1", "t1")
+	require.NoError(t, err)
+	bh.sql2result[sql] = newMrsForCheckDatabaseTable([][]interface{}{{int64(100)}})
+	getSql := fmt.Sprintf(getRowPolicyFormat, 100, quoteString("p1"))
+	bh.sql2result[getSql] = newMrsForTableSecurity([]string{"policy_name"}, nil)
+	sql, err = getSqlForRoleIdOfRole(ctx, "r1")
+	require.NoError(t, err)
+	bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{int64(5)}})
+
+	// the cached security of the tables is dropped
+	txn := mock_frontend.NewMockTxnOperator(ctrl)
+	ses.tableSecurity.set(txn, 100, &tableSecurityOfTable{})
+	require.NotNil(t, ses.tableSecurity.get(txn, 100))
+
+	stmt, err := mysql.ParseOne(ctx, "create policy p1 on t1 for select to r1 using (a = 'x' and b > 1)", 1)
+	require.NoError(t, err)
+	err = doCreatePolicy(ctx, ses, stmt.(*tree.CreatePolicy))
+	require.NoError(t, err)
+	require.Nil(t, ses.tableSecurity.get(txn, 100))
+
+	var inserted string
+	for _, sql := range bh.sqls {
+		if strings.HasPrefix(sql, "insert into mo_catalog.mo_row_policies") {
+			inserted = sql
+		}
+	}
+	require.Contains(t, bh.sqls, "select 1 from `db1`.`t1` where a = \"x\" and b > 1 limit 0;")
+	require.Contains(t, inserted, `values ('p1', 100, 'db1', 't1', 'select', 'r1', 'a = "x" and b > 1', 10`)
+	require.Equal(t, "commit;", bh.sqls[len(bh.sqls)-1])
+
+	// the policy exists
+	bh.sql2result[getSql] = newMrsForTableSecurity([]string{"policy_name"}, [][]interface{}{{"p1"}})
+	err = doCreatePolicy(ctx, ses, stmt.(*tree.CreatePolicy))
+	require.Error(t, err)
+	require.Equal(t, "rollback;", bh.sqls[len(bh.sqls)-1])
+	stmt, err = mysql.ParseOne(ctx, "create policy if not exists p1 on t1 using (a = 'x')", 1)
+	require.NoError(t, err)
+	err = doCreatePolicy(ctx, ses, stmt.(*tree.CreatePolicy))
+	require.NoError(t, err)
+
+	stmt, err = mysql.ParseOne(ctx, "drop policy p1 on t1", 1)
+	require.NoError(t, err)
+	err = doDropPolicy(ctx, ses, stmt.(*tree.DropPolicy))
+	require.NoError(t, err)
+	require.Contains(t, bh.sqls, fmt.Sprintf(deleteRowPolicyFormat, 100, quoteString("p1")))
+
+	// the policy does not exist
+	bh.sql2result[getSql] = newMrsForTableSecurity([]string{"policy_name"}, nil)
+	err = doDropPolicy(ctx, ses, stmt.(*tree.DropPolicy))
+	require.Error(t, err)
+	stmt, err = mysql.ParseOne(ctx, "drop policy if exists p1 on t1", 1)
+	require.NoError(t, err)
+	err = doDropPolicy(ctx, ses, stmt.(*tree.DropPolicy))
+	require.NoError(t, err)
+}
@@ -91,6 +91,40 @@ type PrepareStmt struct {
 	PreparePlan *plan.Plan
 	PrepareStmt tree.Statement
 	ParamTypes  []byte
+
+	// longData keeps the parameters sent by COM_STMT_SEND_LONG_DATA.
+	// It is reset after the statement is executed or reset.
+	longData map[uint16][]byte
+	// longDataErr is the first error of COM_STMT_SEND_LONG_DATA, which has no
+	// response, so the error is reported by the next COM_STMT_EXECUTE.
+	longDataErr error
+}
+
+// appendLongData appends the data to the parameter, the parameter can not be
+// longer than maxSize bytes.
+func (prepareStmt *PrepareStmt) appendLongData(ctx context.Context, paramID uint16, data []byte, maxSize int64) error {
+	if prepareStmt.longData == nil {
+		prepareStmt.longData = make(map[uint16][]byte)
+	}
+	if int64(len(prepareStmt.longData[paramID])+len(data)) > maxSize {
+		delete(prepareStmt.longData, paramID)
+		return moerr.NewInvalidInput(ctx, "parameter %d of the prepared statement set by the long data is longer than 'max_allowed_packet' bytes", paramID)
+	}
+	prepareStmt.longData[paramID] = append(prepareStmt.longData[paramID], data...)
+	return nil
+}
+
+// setLongDataError keeps the first error of COM_STMT_SEND_LONG_DATA
+func (prepareStmt *PrepareStmt) setLongDataError(err error) {
+	if prepareStmt.longDataErr == nil {
+		prepareStmt.longDataErr = err
+	}
+}
+
+// resetLongData discards the parameters sent by COM_STMT_SEND_LONG_DATA
+func (prepareStmt *PrepareStmt) resetLongData() {
+	prepareStmt.longData = nil
+	prepareStmt.longDataErr = nil
 }
 
 /*
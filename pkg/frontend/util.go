@@ -381,6 +381,7 @@ func logStatementStatus(ctx context.Context, ses *Session, stmt tree.Statement,
 		stmtStr = stm.Statement
 	}
 	logStatementStringStatus(ctx, ses, stmtStr, status, err)
+	auditStatement(ctx, ses, stmt, err)
 }
 
 func logStatementStringStatus(ctx context.Context, ses *Session, stmtStr string, status statementStatus, err error) {
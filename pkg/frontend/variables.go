@@ -961,6 +961,38 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemSystemEnumType("tx_isolation", "READ-UNCOMMITTED", "READ-COMMITTED", "REPEATABLE-READ", "SERIALIZABLE"),
 		Default:           "REPEATABLE-READ",
 	},
+	"txn_mode": {
+		Name:              "txn_mode",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("txn_mode", "optimistic", "pessimistic"),
+		Default:           "optimistic",
+	},
+	"snapshot_timestamp": {
+		Name:              "snapshot_timestamp",
+		Scope:             ScopeSession,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("snapshot_timestamp"),
+		Default:           "",
+	},
+	"max_execution_time": {
+		Name:              "max_execution_time",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableUintType("max_execution_time", 0, 4294967295),
+		Default:           uint64(0),
+	},
+	"query_mem_limit": {
+		Name:              "query_mem_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("query_mem_limit", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
 	"testglobalvar_dyn": {
 		Name:              "testglobalvar_dyn",
 		Scope:             ScopeGlobal,
@@ -1226,6 +1258,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("sql_select_limit", 0, 18446744073709551615),
 		Default:           uint64(18446744073709551615),
 	},
+	"cte_max_recursion_depth": {
+		Name:              "cte_max_recursion_depth",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("cte_max_recursion_depth", 0, 4294967295, false),
+		Default:           int64(1000),
+	},
 	"save_query_result": {
 		Name:              "save_query_result",
 		Scope:             ScopeBoth,
@@ -14,6 +14,8 @@
 
 package lockservice
 
+import "bytes"
+
 const (
 	flagLockRow byte = 1 << iota
 	flagLockRangeStart
@@ -29,6 +31,9 @@ func newRangeLock(txnID []byte, mode LockMode) (Lock, Lock) {
 
 func newRowLock(txnID []byte, mode LockMode) Lock {
 	l := newLock(txnID, mode)
+	if mode == Shared {
+		l.holders = &lockHolders{txns: [][]byte{txnID}}
+	}
 	return l.toRowLock()
 }
 
@@ -79,3 +84,42 @@ func (l Lock) getLockMode() LockMode {
 	}
 	return Shared
 }
+
+// isSharedRow returns true if the lock is a row lock which can be held by
+// multiple txns in the Shared mode. The range locks are always exclusive.
+func (l Lock) isSharedRow() bool {
+	return l.isLockRow() && l.holders != nil
+}
+
+// heldBy returns true if the lock is held by the txn
+func (l Lock) heldBy(txnID []byte) bool {
+	if l.holders != nil {
+		return l.holders.contains(txnID)
+	}
+	return bytes.Equal(l.txnID, txnID)
+}
+
+func (h *lockHolders) contains(txnID []byte) bool {
+	for _, v := range h.txns {
+		if bytes.Equal(v, txnID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *lockHolders) add(txnID []byte) {
+	h.txns = append(h.txns, txnID)
+}
+
+// remove removes the txn from the holders, and returns the number of the
+// remaining holders.
+func (h *lockHolders) remove(txnID []byte) int {
+	for i, v := range h.txns {
+		if bytes.Equal(v, txnID) {
+			h.txns = append(h.txns[:i], h.txns[i+1:]...)
+			break
+		}
+	}
+	return len(h.txns)
+}
@@ -0,0 +1,301 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockservice
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/log"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/lock"
+	"go.uber.org/zap"
+)
+
+var (
+	defaultKeepaliveTimeout = time.Second * 10
+)
+
+// remoteTxn is a txn of a remote lockservice which locks rows in the lock server.
+type remoteTxn struct {
+	serviceID string
+	txnID     []byte
+	// pending the number of the lock requests in progress, the locks of the txn
+	// can only be released after all the lock requests completed.
+	pending int
+	closed  bool
+}
+
+type lockServer struct {
+	logger  *log.MOLogger
+	service LockService
+	rpc     morpc.RPCServer
+	stopper *stopper.Stopper
+	timeout time.Duration
+
+	mu struct {
+		sync.Mutex
+		// services the last active time of the remote lockservices
+		services map[string]time.Time
+		txns     map[string]*remoteTxn
+	}
+}
+
+// NewLockServer create a lock server listening at the address. The timeout is the
+// time after which the locks of a remote lockservice are released if no request is
+// received from it, the default timeout is used if it is 0.
+func NewLockServer(
+	address string,
+	timeout time.Duration) (LockServer, error) {
+	if timeout == 0 {
+		timeout = defaultKeepaliveTimeout
+	}
+
+	logger := runtime.ProcessLevelRuntime().Logger()
+	tag := "lock-server"
+	s := &lockServer{
+		logger:  logger.Named(tag),
+		service: NewLockService(),
+		stopper: stopper.NewStopper(tag,
+			stopper.WithLogger(logger.RawLogger().Named(tag))),
+		timeout: timeout,
+	}
+	s.mu.services = make(map[string]time.Time)
+	s.mu.txns = make(map[string]*remoteTxn)
+
+	rpc, err := morpc.NewRPCServer(tag, address,
+		morpc.NewMessageCodec(func() morpc.Message { return &pb.Request{} }),
+		morpc.WithServerLogger(s.logger.RawLogger()),
+		// the lock requests are handled asynchronously
+		morpc.WithServerDisableAutoCancelContext())
+	if err != nil {
+		return nil, err
+	}
+	rpc.RegisterRequestHandler(s.onMessage)
+	s.rpc = rpc
+	return s, nil
+}
+
+func (s *lockServer) Start() error {
+	if err := s.stopper.RunTask(s.checkInactiveServices); err != nil {
+		return err
+	}
+	return s.rpc.Start()
+}
+
+func (s *lockServer) Close() error {
+	s.stopper.Stop()
+	if err := s.rpc.Close(); err != nil {
+		return err
+	}
+	return s.service.Close()
+}
+
+func (s *lockServer) onMessage(
+	ctx context.Context,
+	request morpc.Message,
+	sequence uint64,
+	cs morpc.ClientSession) error {
+	req, ok := request.(*pb.Request)
+	if !ok {
+		s.logger.Fatal("received invalid message", zap.Any("message", request))
+	}
+
+	resp := &pb.Response{RequestID: req.RequestID, Method: req.Method}
+	switch req.Method {
+	case pb.Method_Lock:
+		if err := s.beginLock(req.ServiceID, req.Lock.TxnID); err != nil {
+			resp.WrapError(err)
+			break
+		}
+		// the lock may wait for the conflicting locks to be released, which can
+		// not block the requests of other txns sent in the same session.
+		go func() {
+			s.handleLock(ctx, req, resp)
+			if err := cs.Write(ctx, resp); err != nil {
+				s.logger.Error("failed to write lock response",
+					zap.String("request", req.DebugString()),
+					zap.Error(err))
+			}
+		}()
+		return nil
+	case pb.Method_Unlock:
+		s.active(req.ServiceID)
+		if s.closeTxn(req.Unlock.TxnID) {
+			if err := s.service.Unlock(req.Unlock.TxnID); err != nil {
+				resp.WrapError(err)
+			}
+		}
+	case pb.Method_Keepalive:
+		s.active(req.ServiceID)
+	default:
+		s.logger.Fatal("invalid lock request method",
+			zap.String("method", req.Method.String()))
+	}
+	return cs.Write(ctx, resp)
+}
+
+func (s *lockServer) handleLock(
+	ctx context.Context,
+	req *pb.Request,
+	resp *pb.Response) {
+	err := s.service.Lock(
+		ctx,
+		req.Lock.TableID,
+		req.Lock.Rows,
+		req.Lock.TxnID,
+		fromPBLockOptions(req.Lock.Options))
+	if s.endLock(req.Lock.TxnID) {
+		// the txn is closed while locking, the locks are released after all
+		// the lock requests completed.
+		if err := s.service.Unlock(req.Lock.TxnID); err != nil {
+			s.logger.Error("failed to unlock closed txn",
+				zap.String("request", req.DebugString()),
+				zap.Error(err))
+		}
+	}
+	if err == ErrDeadlockDetectorClosed {
+		err = moerr.NewDeadLockDetectedNoCtx()
+	}
+	if err != nil {
+		resp.WrapError(err)
+	}
+}
+
+func (s *lockServer) active(serviceID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.services[serviceID] = time.Now()
+}
+
+func (s *lockServer) beginLock(serviceID string, txnID []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.services[serviceID] = time.Now()
+	txn, ok := s.mu.txns[string(txnID)]
+	if !ok {
+		txn = &remoteTxn{serviceID: serviceID, txnID: txnID}
+		s.mu.txns[string(txnID)] = txn
+	}
+	if txn.closed {
+		return moerr.NewTxnClosedNoCtx(txnID)
+	}
+	txn.pending++
+	return nil
+}
+
+// endLock returns true if the txn is closed while locking, and the locks of the
+// txn need to be released.
+func (s *lockServer) endLock(txnID []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	txn, ok := s.mu.txns[string(txnID)]
+	if !ok {
+		panic("BUG: missing remote txn")
+	}
+	txn.pending--
+	if txn.closed && txn.pending == 0 {
+		delete(s.mu.txns, string(txnID))
+		return true
+	}
+	return false
+}
+
+// closeTxn returns true if the locks of the txn can be released, otherwise the
+// locks are released by the last lock request of the txn.
+func (s *lockServer) closeTxn(txnID []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closeTxnLocked(txnID)
+}
+
+func (s *lockServer) closeTxnLocked(txnID []byte) bool {
+	txn, ok := s.mu.txns[string(txnID)]
+	if !ok {
+		return true
+	}
+	if txn.pending > 0 {
+		txn.closed = true
+		return false
+	}
+	delete(s.mu.txns, string(txnID))
+	return true
+}
+
+// checkInactiveServices releases the locks of the txns of the lockservices which
+// have not sent any request for a long time, the CNs of them may be crashed.
+func (s *lockServer) checkInactiveServices(ctx context.Context) {
+	timer := time.NewTimer(s.timeout / 2)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			for _, txnID := range s.closeInactiveTxns() {
+				if err := s.service.Unlock(txnID); err != nil {
+					s.logger.Error("failed to unlock inactive txn",
+						zap.String("txn", hex.EncodeToString(txnID)),
+						zap.Error(err))
+				}
+			}
+			timer.Reset(s.timeout / 2)
+		}
+	}
+}
+
+func (s *lockServer) closeInactiveTxns() [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	var txns [][]byte
+	for _, txn := range s.mu.txns {
+		if now.Sub(s.mu.services[txn.serviceID]) < s.timeout {
+			continue
+		}
+		if s.closeTxnLocked(txn.txnID) {
+			txns = append(txns, txn.txnID)
+		}
+	}
+	for id, last := range s.mu.services {
+		if now.Sub(last) >= s.timeout {
+			s.logger.Warn("release the locks of inactive lockservice",
+				zap.String("service", id))
+			delete(s.mu.services, id)
+		}
+	}
+	return txns
+}
+
+func fromPBLockOptions(opts pb.LockOptions) LockOptions {
+	return LockOptions{
+		granularity: Granularity(opts.Granularity),
+		mode:        LockMode(opts.Mode),
+		policy:      WaitPolicy(opts.Policy),
+	}
+}
+
+func toPBLockOptions(opts LockOptions) pb.LockOptions {
+	return pb.LockOptions{
+		Granularity: pb.Granularity(opts.granularity),
+		Mode:        pb.LockMode(opts.mode),
+		Policy:      pb.WaitPolicy(opts.policy),
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockservice
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testLockServerAddress = "unix:///tmp/lock-server.sock"
+)
+
+func TestRemoteLockServicesContend(t *testing.T) {
+	runLockServerTest(
+		t,
+		time.Hour,
+		func(s1, s2 LockService) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+			option := LockOptions{Row, Exclusive, Wait}
+
+			require.NoError(t, s1.Lock(ctx, 1, [][]byte{{1}}, []byte("txn1"), option))
+			require.Equal(t, ErrLockConflict, s2.Lock(ctx, 1, [][]byte{{1}}, []byte("txn2"),
+				option.WithWaitPolicy(FastFail)))
+
+			// txn2 on the other service is blocked until txn1 unlocked
+			acquired := make(chan struct{})
+			go func() {
+				defer close(acquired)
+				assert.NoError(t, s2.Lock(ctx, 1, [][]byte{{1}}, []byte("txn2"), option))
+			}()
+			select {
+			case <-acquired:
+				require.Fail(t, "lock acquired by txn2 before txn1 unlocked")
+			case <-time.After(time.Second / 2):
+			}
+			require.NoError(t, s1.Unlock([]byte("txn1")))
+			<-acquired
+
+			require.Equal(t, ErrLockConflict, s1.Lock(ctx, 1, [][]byte{{1}}, []byte("txn3"),
+				option.WithWaitPolicy(FastFail)))
+			require.NoError(t, s2.Unlock([]byte("txn2")))
+			require.NoError(t, s1.Lock(ctx, 1, [][]byte{{1}}, []byte("txn3"), option))
+			require.NoError(t, s1.Unlock([]byte("txn3")))
+		})
+}
+
+func TestRemoteLockServicesDeadlock(t *testing.T) {
+	runLockServerTest(
+		t,
+		time.Hour,
+		func(s1, s2 LockService) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+			option := LockOptions{Row, Exclusive, Wait}
+
+			require.NoError(t, s1.Lock(ctx, 1, [][]byte{{1}}, []byte("txn1"), option))
+			require.NoError(t, s2.Lock(ctx, 1, [][]byte{{2}}, []byte("txn2"), option))
+
+			c := make(chan error, 2)
+			go func() {
+				c <- s1.Lock(ctx, 1, [][]byte{{2}}, []byte("txn1"), option)
+			}()
+			go func() {
+				c <- s2.Lock(ctx, 1, [][]byte{{1}}, []byte("txn2"), option)
+			}()
+
+			// one of the txns is aborted by the deadlock detection of the lock server
+			err := <-c
+			require.True(t, moerr.IsMoErrCode(err, moerr.ErrDeadLockDetected))
+			require.NoError(t, s1.Unlock([]byte("txn1")))
+			require.NoError(t, s2.Unlock([]byte("txn2")))
+			require.NoError(t, <-c)
+		})
+}
+
+func TestLockServerReleasesInactiveService(t *testing.T) {
+	runLockServerTest(
+		t,
+		time.Second,
+		func(s1, s2 LockService) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+			option := LockOptions{Row, Exclusive, Wait}
+
+			require.NoError(t, s1.Lock(ctx, 1, [][]byte{{1}}, []byte("txn1"), option))
+			// the keepalive of s1 is stopped, and the locks of it are released
+			// after the timeout.
+			require.NoError(t, s1.Close())
+			require.NoError(t, s2.Lock(ctx, 1, [][]byte{{1}}, []byte("txn2"), option))
+			require.NoError(t, s2.Unlock([]byte("txn2")))
+		})
+}
+
+func runLockServerTest(
+	t *testing.T,
+	timeout time.Duration,
+	fn func(s1, s2 LockService)) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	require.NoError(t, os.RemoveAll(testLockServerAddress[7:]))
+	server, err := NewLockServer(testLockServerAddress, timeout)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		assert.NoError(t, server.Close())
+	}()
+
+	getAddress := func() (string, bool) {
+		return testLockServerAddress, true
+	}
+	s1, err := NewRemoteLockService("s1", getAddress)
+	require.NoError(t, err)
+	s2, err := NewRemoteLockService("s2", getAddress)
+	require.NoError(t, err)
+	defer func() {
+		// closing a closed service is no-op
+		_ = s1.Close()
+		assert.NoError(t, s2.Close())
+	}()
+	fn(s1, s2)
+}
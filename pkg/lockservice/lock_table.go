@@ -19,6 +19,14 @@ import (
 	"context"
 	"fmt"
 	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+var (
+	// ErrLockConflict the lock conflicts with others and the FastFail wait
+	// policy is used
+	ErrLockConflict = moerr.NewLockConflictNoCtx()
 )
 
 // a localLockTable instance manages the locks on a table
@@ -41,13 +49,35 @@ func newLocalLockTable(
 }
 
 func (l *localLockTable) lock(
+	ctx context.Context,
+	txn *activeTxn,
+	rows [][]byte,
+	options LockOptions) error {
+	if options.granularity != Row {
+		return l.doLock(ctx, txn, rows, options)
+	}
+
+	// each row lock holds its own waiter
+	for _, row := range rows {
+		if err := l.doLock(ctx, txn, [][]byte{row}, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *localLockTable) doLock(
 	ctx context.Context,
 	txn *activeTxn,
 	rows [][]byte,
 	options LockOptions) error {
 	waiter := acquireWaiter(txn.txnID)
 	for {
-		if added := l.doAcquireLock(txn, waiter, rows, options); added {
+		added, err := l.doAcquireLock(txn, waiter, rows, options)
+		if err != nil {
+			return err
+		}
+		if added {
 			return nil
 		}
 
@@ -58,7 +88,10 @@ func (l *localLockTable) lock(
 	}
 }
 
-func (l *localLockTable) unlock(ctx context.Context, ls *cowSlice) error {
+func (l *localLockTable) unlock(
+	ctx context.Context,
+	txnID []byte,
+	ls *cowSlice) error {
 	locks := ls.slice()
 	defer locks.unref()
 
@@ -66,6 +99,18 @@ func (l *localLockTable) unlock(ctx context.Context, ls *cowSlice) error {
 	defer l.mu.Unlock()
 	locks.iter(func(key []byte) bool {
 		if lock, ok := l.mu.store.Get(key); ok {
+			if !lock.heldBy(txnID) {
+				return true
+			}
+			if lock.isSharedRow() &&
+				lock.holders.remove(txnID) > 0 {
+				// the lock is still held by other txns, wake up the waiters
+				// to retry, a waiter may be able to upgrade the lock.
+				lock.txnID = lock.holders.txns[0]
+				l.mu.store.Add(key, lock)
+				lock.waiter.wakeAll()
+				return true
+			}
 			if lock.isLockRow() || lock.isLockRangeEnd() {
 				lock.waiter.close()
 			}
@@ -86,18 +131,18 @@ func (l *localLockTable) doAcquireLock(
 	txn *activeTxn,
 	waiter *waiter,
 	rows [][]byte,
-	opts LockOptions) bool {
+	opts LockOptions) (bool, error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
 	switch opts.granularity {
 	case Row:
-		return l.acquireRowLockLocked(txn, waiter, rows[0], opts.mode)
+		return l.acquireRowLockLocked(txn, waiter, rows[0], opts)
 	case Range:
 		if len(rows) != 2 {
 			panic("invalid range lock")
 		}
-		return l.acquireRangeLockLocked(txn, waiter, rows[0], rows[1], opts.mode)
+		return l.acquireRangeLockLocked(txn, waiter, rows[0], rows[1], opts)
 	default:
 		panic(fmt.Sprintf("not support lock granularity %d", opts))
 	}
@@ -107,23 +152,74 @@ func (l *localLockTable) acquireRowLockLocked(
 	txn *activeTxn,
 	w *waiter,
 	row []byte,
-	mode LockMode) bool {
+	opts LockOptions) (bool, error) {
 	key, lock, ok := l.mu.store.Seek(row)
 	if ok &&
 		(bytes.Equal(key, row) ||
 			lock.isLockRangeEnd()) {
-		l.handleLockConflict(txn, w, lock)
-		return false
+		if lock.isSharedRow() {
+			return l.acquireSharedRowLockLocked(txn, w, row, lock, opts)
+		}
+		// the row is already locked by the txn itself, the waiter is
+		// not used any more.
+		if bytes.Equal(lock.txnID, txn.txnID) {
+			l.releaseWaiter(w)
+			return true, nil
+		}
+		return false, l.handleLockConflict(txn, w, lock, opts)
+	}
+	l.addRowLockLocked(txn, row, w, opts.mode)
+	return true, nil
+}
+
+// acquireSharedRowLockLocked locks a row which is already locked in the
+// Shared mode. A Shared lock is added into the holders if no txn is waiting
+// for the row, and an Exclusive lock can only be acquired by upgrading the
+// lock of the only holder.
+func (l *localLockTable) acquireSharedRowLockLocked(
+	txn *activeTxn,
+	w *waiter,
+	row []byte,
+	lock Lock,
+	opts LockOptions) (bool, error) {
+	held := lock.holders.contains(txn.txnID)
+	switch {
+	case held && opts.mode == Shared:
+		l.releaseWaiter(w)
+		return true, nil
+	case held && len(lock.holders.txns) == 1:
+		// upgrade to the Exclusive lock, the waiters of the Shared
+		// lock are kept.
+		upgraded := newRowLock(txn.txnID, Exclusive)
+		upgraded.waiter = lock.waiter
+		l.mu.store.Add(row, upgraded)
+		l.releaseWaiter(w)
+		return true, nil
+	case !held && opts.mode == Shared &&
+		lock.waiter.waiters.len() == 0:
+		// we must first add the lock to txn to ensure that the
+		// lock can be read when the deadlock is detected.
+		txn.lockAdded(l.tableID, [][]byte{row})
+		lock.holders.add(txn.txnID)
+		l.releaseWaiter(w)
+		return true, nil
 	}
-	l.addRowLockLocked(txn, row, w, mode)
-	return true
+	return false, l.handleLockConflict(txn, w, lock, opts)
+}
+
+// releaseWaiter gives up the waiter which is not used by a lock. The waiter
+// may hold the txns waiting for the previous lock of the row, they need to
+// retry.
+func (l *localLockTable) releaseWaiter(w *waiter) {
+	w.wakeAll()
+	w.unref()
 }
 
 func (l *localLockTable) acquireRangeLockLocked(
 	txn *activeTxn,
 	w *waiter,
 	start, end []byte,
-	mode LockMode) bool {
+	opts LockOptions) (bool, error) {
 	if bytes.Compare(start, end) >= 0 {
 		panic(fmt.Sprintf("lock error: start[%v] is greater than end[%v]",
 			start, end))
@@ -131,12 +227,11 @@ func (l *localLockTable) acquireRangeLockLocked(
 	key, lock, ok := l.mu.store.Seek(start)
 	if ok &&
 		bytes.Compare(key, end) <= 0 {
-		l.handleLockConflict(txn, w, lock)
-		return false
+		return false, l.handleLockConflict(txn, w, lock, opts)
 	}
 
-	l.addRangeLockLocked(txn, start, end, w, mode)
-	return true
+	l.addRangeLockLocked(txn, start, end, w, opts.mode)
+	return true, nil
 }
 
 func (l *localLockTable) addRowLockLocked(
@@ -151,6 +246,11 @@ func (l *localLockTable) addRowLockLocked(
 	// lock can be read when the deadlock is detected.
 	txn.lockAdded(l.tableID, [][]byte{row})
 	l.mu.store.Add(row, lock)
+
+	// the waiters moved into the waiter may share the lock
+	if mode == Shared {
+		waiter.wakeAll()
+	}
 }
 
 func (l *localLockTable) addRangeLockLocked(
@@ -169,7 +269,18 @@ func (l *localLockTable) addRangeLockLocked(
 	l.mu.store.Add(end, endLock)
 }
 
-func (l *localLockTable) handleLockConflict(txn *activeTxn, w *waiter, conflictWith Lock) {
+func (l *localLockTable) handleLockConflict(
+	txn *activeTxn,
+	w *waiter,
+	conflictWith Lock,
+	opts LockOptions) error {
+	// the txn does not want to wait, give up the waiter and return
+	// the conflict to the caller.
+	if opts.policy == FastFail {
+		w.unref()
+		return ErrLockConflict
+	}
+
 	// find conflict, and wait prev txn completed, and a new
 	// waiter added, we need to active deadlock check.
 	txn.setBlocked(w)
@@ -177,4 +288,5 @@ func (l *localLockTable) handleLockConflict(txn *activeTxn, w *waiter, conflictW
 	if err := l.detector.check(txn.txnID); err != nil {
 		panic("BUG: active dead lock check can not fail")
 	}
+	return nil
 }
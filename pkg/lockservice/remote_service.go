@@ -0,0 +1,175 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/log"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/lock"
+	"go.uber.org/zap"
+)
+
+var (
+	defaultKeepaliveInterval = time.Second
+	defaultRPCTimeout        = time.Second * 10
+	// defaultLockWaitTimeout is used if the context of the lock has no deadline
+	defaultLockWaitTimeout = time.Hour
+)
+
+type remoteLockService struct {
+	logger     *log.MOLogger
+	serviceID  string
+	getAddress func() (string, bool)
+	client     morpc.RPCClient
+	stopper    *stopper.Stopper
+}
+
+// NewRemoteLockService create a lockservice which locks the rows in the LockServer,
+// getAddress returns the address of the LockServer. The serviceID is the id of the
+// CN, a unique id is appended to it so that the locks of the txns of a restarted CN
+// are released by the LockServer.
+func NewRemoteLockService(
+	serviceID string,
+	getAddress func() (string, bool)) (LockService, error) {
+	logger := runtime.ProcessLevelRuntime().Logger()
+	tag := "remote-lock-service"
+	s := &remoteLockService{
+		logger:     logger.Named(tag),
+		serviceID:  serviceID + "-" + uuid.NewString(),
+		getAddress: getAddress,
+		stopper: stopper.NewStopper(tag,
+			stopper.WithLogger(logger.RawLogger().Named(tag))),
+	}
+
+	codec := morpc.NewMessageCodec(func() morpc.Message { return &pb.Response{} })
+	bf := morpc.NewGoettyBasedBackendFactory(codec,
+		morpc.WithBackendLogger(s.logger.RawLogger()))
+	client, err := morpc.NewClient(bf,
+		morpc.WithClientLogger(s.logger.RawLogger()),
+		morpc.WithClientTag(tag))
+	if err != nil {
+		return nil, err
+	}
+	s.client = client
+	if err := s.stopper.RunTask(s.keepalive); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *remoteLockService) Lock(
+	ctx context.Context,
+	tableID uint64,
+	rows [][]byte,
+	txnID []byte,
+	options LockOptions) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultLockWaitTimeout)
+		defer cancel()
+	}
+	err := s.send(ctx, &pb.Request{
+		Method:    pb.Method_Lock,
+		ServiceID: s.serviceID,
+		Lock: pb.LockRequest{
+			TableID: tableID,
+			Rows:    rows,
+			TxnID:   txnID,
+			Options: toPBLockOptions(options),
+		},
+	})
+	if moerr.IsMoErrCode(err, moerr.ErrLockConflict) {
+		return ErrLockConflict
+	}
+	return err
+}
+
+// Unlock keeps retrying until the rpc timeout if failed to send the request, the
+// locks are released by the LockServer if the lockservice is inactive anyway.
+func (s *remoteLockService) Unlock(txnID []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRPCTimeout)
+	defer cancel()
+	req := &pb.Request{
+		Method:    pb.Method_Unlock,
+		ServiceID: s.serviceID,
+		Unlock:    pb.UnlockRequest{TxnID: txnID},
+	}
+	for {
+		err := s.send(ctx, req)
+		if err == nil {
+			return nil
+		}
+		s.logger.Error("failed to unlock",
+			zap.String("request", req.DebugString()),
+			zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(defaultKeepaliveInterval):
+		}
+	}
+}
+
+func (s *remoteLockService) Close() error {
+	s.stopper.Stop()
+	return s.client.Close()
+}
+
+func (s *remoteLockService) keepalive(ctx context.Context) {
+	timer := time.NewTimer(defaultKeepaliveInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			ctx, cancel := context.WithTimeout(ctx, defaultKeepaliveInterval)
+			err := s.send(ctx, &pb.Request{
+				Method:    pb.Method_Keepalive,
+				ServiceID: s.serviceID,
+			})
+			cancel()
+			if err != nil {
+				s.logger.Error("failed to send keepalive",
+					zap.Error(err))
+			}
+			timer.Reset(defaultKeepaliveInterval)
+		}
+	}
+}
+
+func (s *remoteLockService) send(ctx context.Context, req *pb.Request) error {
+	address, ok := s.getAddress()
+	if !ok {
+		return moerr.NewNoAvailableBackend(ctx)
+	}
+	f, err := s.client.Send(ctx, address, req)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	v, err := f.Get()
+	if err != nil {
+		return err
+	}
+	return v.(*pb.Response).UnwrapError()
+}
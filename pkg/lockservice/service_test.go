@@ -86,6 +86,160 @@ func TestMultipleRowLocks(t *testing.T) {
 	assert.Equal(t, sum, iter)
 }
 
+func TestLockMultipleRowsInOneCall(t *testing.T) {
+	l := NewLockService()
+	ctx := context.Background()
+	option := LockOptions{Row, Exclusive, FastFail}
+
+	err := l.Lock(ctx, 0, [][]byte{{1}, {2}, {3}}, []byte("txn1"), option)
+	require.NoError(t, err)
+	for _, row := range [][]byte{{1}, {2}, {3}} {
+		err = l.Lock(ctx, 0, [][]byte{row}, []byte("txn2"), option)
+		require.Equal(t, ErrLockConflict, err)
+	}
+	require.NoError(t, l.Unlock([]byte("txn1")))
+
+	err = l.Lock(ctx, 0, [][]byte{{2}}, []byte("txn2"), option)
+	require.NoError(t, err)
+	require.NoError(t, l.Unlock([]byte("txn2")))
+}
+
+func TestRowLockReentrant(t *testing.T) {
+	l := NewLockService()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	option := LockOptions{Row, Exclusive, Wait}
+
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option.WithMode(Shared)))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}, {2}}, []byte("txn1"), option))
+
+	// row in a range locked by the same txn
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{3}, {6}}, []byte("txn1"), option.WithGranularity(Range)))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{4}}, []byte("txn1"), option))
+	require.NoError(t, l.Unlock([]byte("txn1")))
+
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}, {4}}, []byte("txn2"), option.WithWaitPolicy(FastFail)))
+	require.NoError(t, l.Unlock([]byte("txn2")))
+}
+
+func TestLockWithFastFail(t *testing.T) {
+	l := NewLockService()
+	ctx := context.Background()
+	option := LockOptions{Row, Exclusive, Wait}
+
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{3}, {6}}, []byte("txn1"), option.WithGranularity(Range)))
+
+	option = option.WithWaitPolicy(FastFail)
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn2"), option))
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{4}}, []byte("txn2"), option))
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{2}, {3}}, []byte("txn2"), option.WithGranularity(Range)))
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn2"), option.WithMode(Shared)))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{2}}, []byte("txn2"), option))
+
+	require.NoError(t, l.Unlock([]byte("txn1")))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn2"), option))
+	require.NoError(t, l.Unlock([]byte("txn2")))
+}
+
+func TestSharedRowLock(t *testing.T) {
+	l := NewLockService()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	option := LockOptions{Row, Shared, Wait}
+
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn2"), option))
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn3"),
+		option.WithMode(Exclusive).WithWaitPolicy(FastFail)))
+
+	acquired := make(chan struct{})
+	go func() {
+		defer close(acquired)
+		assert.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn3"), option.WithMode(Exclusive)))
+	}()
+
+	// the exclusive lock waits until all the shared locks are released
+	require.NoError(t, l.Unlock([]byte("txn1")))
+	select {
+	case <-acquired:
+		require.Fail(t, "exclusive lock acquired with a shared lock held")
+	case <-time.After(time.Second / 2):
+	}
+	require.NoError(t, l.Unlock([]byte("txn2")))
+	<-acquired
+
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn4"),
+		option.WithWaitPolicy(FastFail)))
+	require.NoError(t, l.Unlock([]byte("txn3")))
+}
+
+func TestSharedRowLockWaitersWakeUp(t *testing.T) {
+	l := NewLockService()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	option := LockOptions{Row, Exclusive, Wait}
+
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option))
+
+	var wg sync.WaitGroup
+	var acquired atomic.Int32
+	for _, txn := range []string{"txn2", "txn3", "txn4"} {
+		wg.Add(1)
+		go func(txn []byte) {
+			defer wg.Done()
+			assert.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, txn, option.WithMode(Shared)))
+			acquired.Add(1)
+		}([]byte(txn))
+	}
+	time.Sleep(time.Second / 2)
+	require.Equal(t, int32(0), acquired.Load())
+
+	// all the shared waiters hold the lock together
+	require.NoError(t, l.Unlock([]byte("txn1")))
+	wg.Wait()
+	for _, txn := range []string{"txn2", "txn3", "txn4"} {
+		require.NoError(t, l.Unlock([]byte(txn)))
+	}
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn5"), option.WithWaitPolicy(FastFail)))
+	require.NoError(t, l.Unlock([]byte("txn5")))
+}
+
+func TestUpgradeSharedRowLock(t *testing.T) {
+	l := NewLockService()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	option := LockOptions{Row, Shared, Wait}
+
+	// the only holder upgrades the lock immediately
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option.WithMode(Exclusive)))
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn2"),
+		option.WithWaitPolicy(FastFail)))
+	require.NoError(t, l.Unlock([]byte("txn1")))
+
+	// the upgrade waits for the other holders
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option))
+	require.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn2"), option))
+	upgraded := make(chan struct{})
+	go func() {
+		defer close(upgraded)
+		assert.NoError(t, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn1"), option.WithMode(Exclusive)))
+	}()
+	select {
+	case <-upgraded:
+		require.Fail(t, "lock upgraded with other holders")
+	case <-time.After(time.Second / 2):
+	}
+	require.NoError(t, l.Unlock([]byte("txn2")))
+	<-upgraded
+	require.Equal(t, ErrLockConflict, l.Lock(ctx, 0, [][]byte{{1}}, []byte("txn2"),
+		option.WithWaitPolicy(FastFail)))
+	require.NoError(t, l.Unlock([]byte("txn1")))
+}
+
 func TestCtxCancelWhileWaiting(t *testing.T) {
 	l := NewLockService()
 	ctx, cancel := context.WithCancel(context.Background())
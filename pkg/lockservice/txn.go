@@ -70,7 +70,7 @@ func (txn *activeTxn) close(lockTableFunc func(uint64) lockTable) {
 	for table, cs := range txn.holdLocks {
 		l := lockTableFunc(table)
 		// TODO(fagongzi): use a deadline context, and retry if has a error
-		l.unlock(context.TODO(), cs)
+		l.unlock(context.TODO(), txn.txnID, cs)
 		cs.close()
 		delete(txn.holdLocks, table)
 	}
@@ -119,6 +119,10 @@ func (txn *activeTxn) fetchWhoWaitingMe(
 			// TODO(fagongzi): use a deadline context, and retry if has a error
 			if lock, ok := l.getLock(context.TODO(), lockKey); ok {
 				lock.waiter.waiters.iter(func(id []byte) bool {
+					// the txn is waiting to upgrade its own Shared lock
+					if bytes.Equal(id, txnID) {
+						return true
+					}
 					if !waiters.add(id) {
 						hasDeadLock = true
 						return false
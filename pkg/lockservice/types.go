@@ -91,8 +91,13 @@ type LockService interface {
 	// If a conflict is encountered, the method will block until the conflicting lock is
 	// released and held by the current operation, or until it times out.
 	//
-	// Returns false if conflicts are encountered in FastFail wait policy and ErrDeadLockDetected
-	// returns if current operation was aborted by deadlock detection.
+	// Locking a row which is already locked by the same txn will success immediately. A row
+	// locked in the Shared mode can be locked by other txns in the Shared mode, and conflicts
+	// with the Exclusive locks only. A txn holding the only Shared lock of a row can upgrade
+	// it to an Exclusive lock. The row ranges are always locked exclusively.
+	//
+	// Returns ErrLockConflict if conflicts are encountered in FastFail wait policy and
+	// ErrDeadLockDetected returns if current operation was aborted by deadlock detection.
 	Lock(ctx context.Context, tableID uint64, rows [][]byte, txnID []byte, options LockOptions) error
 	// Unlock release all locks associated with the transaction.
 	Unlock(txnID []byte) error
@@ -100,6 +105,18 @@ type LockService interface {
 	Close() error
 }
 
+// LockServer runs at the DN node, and holds the lock tables of all the tables for the
+// lockservices of all CNs, so that the txns running on different CNs lock the same rows
+// in the same lock tables and are serialized by the locks. Each lockservice sends the
+// keepalive requests to the LockServer, the locks of the txns of a lockservice are
+// released if the lockservice is inactive for a long time.
+type LockServer interface {
+	// Start start the lock server
+	Start() error
+	// Close close the lock server
+	Close() error
+}
+
 // lockTable is used to manage all locks of a Table. LockTable can be local or remote, as determined
 // by LockTableAllocator.
 //
@@ -118,9 +135,9 @@ type lockTable interface {
 	// 2. ErrLockTableNotMatch, indicates that the LockTable binding relationship has changed.
 	// 3. Other known errors.
 	lock(ctx context.Context, txn *activeTxn, rows [][]byte, options LockOptions) error
-	// Unlock release a set of locks, it will keep retrying until the context times out when it encounters an
-	// error.
-	unlock(ctx context.Context, ls *cowSlice) error
+	// Unlock release a set of locks held by the txn, it will keep retrying until the context times out when
+	// it encounters an error.
+	unlock(ctx context.Context, txnID []byte, ls *cowSlice) error
 	// getLock get a lock, it will keep retrying until the context times out when it encounters an error.
 	getLock(ctx context.Context, key []byte) (Lock, bool)
 }
@@ -188,4 +205,12 @@ type Lock struct {
 	// all lock info will encode into this field to save memory overhead
 	value  byte
 	waiter *waiter
+	// holders is only used by the Shared row locks, all the txns holding the
+	// lock. The txnID is the first holder.
+	holders *lockHolders
+}
+
+// lockHolders the txns that hold a Shared row lock together
+type lockHolders struct {
+	txns [][]byte
 }
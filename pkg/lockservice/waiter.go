@@ -237,6 +237,18 @@ func (w *waiter) fetchNextWaiter() *waiter {
 	}
 }
 
+// wakeAll notifies all the waiters to retry the lock, it is used when the lock
+// can be held by more than one txn.
+func (w *waiter) wakeAll() {
+	for w.waiters.len() > 0 {
+		next, _ := w.waiters.pop()
+		if next.notify(nil) {
+			next.unref()
+		}
+	}
+	w.waiters.reset()
+}
+
 func (w *waiter) awakeNextWaiter() *waiter {
 	next, remains := w.waiters.pop()
 	next.add(remains...)
@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/binary"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 )
@@ -58,7 +59,7 @@ func (cb *ColumnBlock) GetData(ctx context.Context, m *mpool.MPool) (*fileservic
 		Offset: int64(cb.meta.location.Offset()),
 		Size:   int64(cb.meta.location.Length()),
 	}
-	data.Entries[0].ToObject = newDecompressToObject(int64(cb.meta.location.OriginSize()))
+	data.Entries[0].ToObject = newDecompressToObject(int64(cb.meta.location.OriginSize()), cb.meta.alg)
 	err = cb.object.fs.Read(ctx, data)
 	if err != nil {
 		return nil, err
@@ -79,7 +80,8 @@ func (cb *ColumnBlock) GetIndex(ctx context.Context, dataType IndexDataType, rea
 			Size:   int64(cb.meta.bloomFilter.Length()),
 		}
 		var err error
-		data.Entries[0].ToObject = readFunc(int64(cb.meta.bloomFilter.OriginSize()))
+		// bloom filters are always compressed with lz4
+		data.Entries[0].ToObject = readFunc(int64(cb.meta.bloomFilter.OriginSize()), compress.Lz4)
 		err = cb.object.fs.Read(ctx, data)
 		if err != nil {
 			return nil, err
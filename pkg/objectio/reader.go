@@ -138,7 +138,7 @@ func (r *ObjectReader) Read(ctx context.Context,
 				Offset: int64(col.GetMeta().location.Offset()),
 				Size:   int64(col.GetMeta().location.Length()),
 
-				ToObject: readFunc(int64(col.GetMeta().location.OriginSize())),
+				ToObject: readFunc(int64(col.GetMeta().location.OriginSize()), col.GetMeta().alg),
 			})
 		}
 	}
@@ -219,10 +219,10 @@ func (r *ObjectReader) readFooterAndUnMarshal(ctx context.Context, fileSize, siz
 }
 
 type ToObjectFunc = func(r io.Reader, buf []byte) (any, int64, error)
-type ReadObjectFunc = func(size int64) ToObjectFunc
+type ReadObjectFunc = func(size int64, alg uint8) ToObjectFunc
 
 // newDecompressToObject the decompression function passed to fileservice
-func newDecompressToObject(size int64) ToObjectFunc {
+func newDecompressToObject(size int64, alg uint8) ToObjectFunc {
 	return func(reader io.Reader, data []byte) (any, int64, error) {
 		// decompress
 		var err error
@@ -233,7 +233,7 @@ func newDecompressToObject(size int64) ToObjectFunc {
 			}
 		}
 		decompressed := make([]byte, size)
-		decompressed, err = compress.Decompress(data, decompressed, compress.Lz4)
+		decompressed, err = compress.Decompress(data, decompressed, int(alg))
 		if err != nil {
 			return nil, 0, err
 		}
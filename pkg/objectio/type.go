@@ -41,6 +41,10 @@ type Writer interface {
 	// and returns the handle of the block.
 	Write(batch *batch.Batch) (BlockObject, error)
 
+	// SetCompression sets the compression algorithm of the column idx
+	// for the batches written afterwards, lz4 is used by default
+	SetCompression(idx uint16, alg uint8)
+
 	// WriteIndex is the index of the column in the block written to the block's handle.
 	// block is the handle of the block
 	// idx is the column to which the index is written
@@ -21,7 +21,6 @@ import (
 	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
-	"github.com/pierrec/lz4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -35,6 +34,8 @@ type ObjectWriter struct {
 	buffer *ObjectBuffer
 	name   string
 	lastId uint32
+	// compression algorithm of columns, lz4 if not set
+	algs map[uint16]uint8
 }
 
 func NewObjectWriter(name string, fs fileservice.FileService) (Writer, error) {
@@ -69,6 +70,13 @@ func (w *ObjectWriter) WriteHeader() error {
 	return err
 }
 
+func (w *ObjectWriter) SetCompression(idx uint16, alg uint8) {
+	if w.algs == nil {
+		w.algs = make(map[uint16]uint8)
+	}
+	w.algs[idx] = alg
+}
+
 func (w *ObjectWriter) Write(batch *batch.Batch) (BlockObject, error) {
 	block := NewBlock(uint16(len(batch.Vecs)), w.object, w.name)
 	w.AddBlock(block.(*Block))
@@ -78,10 +86,12 @@ func (w *ObjectWriter) Write(batch *batch.Batch) (BlockObject, error) {
 			return nil, err
 		}
 		originSize := len(buf)
-		// TODO:Now by default, lz4 compression must be used for Write,
-		// and parameters need to be passed in later to determine the compression type
-		data := make([]byte, lz4.CompressBlockBound(originSize))
-		if buf, err = compress.Compress(buf, data, compress.Lz4); err != nil {
+		alg, ok := w.algs[uint16(i)]
+		if !ok {
+			alg = compress.Lz4
+		}
+		data := make([]byte, compress.CompressBound(originSize, int(alg)))
+		if buf, err = compress.Compress(buf, data, int(alg)); err != nil {
 			return nil, err
 		}
 		offset, length, err := w.buffer.Write(buf)
@@ -94,7 +104,7 @@ func (w *ObjectWriter) Write(batch *batch.Batch) (BlockObject, error) {
 			length:     uint32(length),
 			originSize: uint32(originSize),
 		}
-		block.(*Block).columns[i].(*ColumnBlock).meta.alg = compress.Lz4
+		block.(*Block).columns[i].(*ColumnBlock).meta.alg = alg
 		block.(*Block).columns[i].(*ColumnBlock).meta.typ = uint8(vec.GetType().Oid)
 	}
 	return block, nil
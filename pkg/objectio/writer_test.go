@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -176,6 +177,50 @@ func TestNewObjectWriter(t *testing.T) {
 
 }
 
+func TestObjectWriterCompression(t *testing.T) {
+	ctx := context.Background()
+	name := "compression.blk"
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	service, err := fileservice.NewMemoryFS(defines.LocalFileServiceName)
+	assert.Nil(t, err)
+
+	objectWriter, err := NewObjectWriter(name, service)
+	assert.Nil(t, err)
+	algs := []uint8{compress.None, compress.Lz4, compress.Zstd, compress.Snappy}
+	for i, alg := range algs {
+		objectWriter.SetCompression(uint16(i), alg)
+	}
+	_, err = objectWriter.Write(bat)
+	assert.Nil(t, err)
+	blocks, err := objectWriter.WriteEnd(ctx)
+	assert.Nil(t, err)
+
+	objectReader, err := NewObjectReader(name, service)
+	assert.Nil(t, err)
+	bs, err := objectReader.ReadMeta(ctx, []Extent{blocks[0].GetExtent()}, mp, nil)
+	assert.Nil(t, err)
+	idxs := make([]uint16, len(bat.Vecs))
+	for i := range bat.Vecs {
+		idxs[i] = uint16(i)
+		col, err := bs[0].GetColumn(uint16(i))
+		assert.Nil(t, err)
+		if i < len(algs) {
+			assert.Equal(t, algs[i], col.GetMeta().GetAlg())
+		} else {
+			assert.Equal(t, uint8(compress.Lz4), col.GetMeta().GetAlg())
+		}
+	}
+	vec, err := objectReader.Read(ctx, blocks[0].GetExtent(), idxs, nil, mp, nil, newDecompressToObject)
+	assert.Nil(t, err)
+	for i, v := range bat.Vecs {
+		expected, err := v.MarshalBinary()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, vec.Entries[i].Object.([]byte))
+	}
+}
+
 func newBatch(mp *mpool.MPool) *batch.Batch {
 	types := []types.Type{
 		{Oid: types.T_int8},
@@ -63,18 +63,21 @@ const (
 	Entry_Insert Entry_EntryType = 0
 	Entry_Delete Entry_EntryType = 1
 	Entry_Update Entry_EntryType = 2
+	Entry_Alter  Entry_EntryType = 3
 )
 
 var Entry_EntryType_name = map[int32]string{
 	0: "Insert",
 	1: "Delete",
 	2: "Update",
+	3: "Alter",
 }
 
 var Entry_EntryType_value = map[string]int32{
 	"Insert": 0,
 	"Delete": 1,
 	"Update": 2,
+	"Alter":  3,
 }
 
 func (x Entry_EntryType) String() string {
@@ -589,6 +592,164 @@ func (m *Entry) GetBat() *Batch {
 	return nil
 }
 
+// AlterTableReq is sent to DN by an Alter entry of mo_tables, the schema of
+// the table is changed by the operation and its version is increased.
+type AlterTableReq struct {
+	DbId    uint64 `protobuf:"varint,1,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	TableId uint64 `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// Types that are valid to be assigned to Operation:
+	//	*AlterTableReq_AddColumn
+	//	*AlterTableReq_DropColumn
+	//	*AlterTableReq_ModifyColumn
+	//	*AlterTableReq_RenameColumn
+	//	*AlterTableReq_RenameTable
+	//	*AlterTableReq_ReplacePartition
+	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
+func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
+func (*AlterTableReq) ProtoMessage()    {}
+func (*AlterTableReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+}
+func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableReq.Merge(m, src)
+}
+func (m *AlterTableReq) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableReq proto.InternalMessageInfo
+
+type isAlterTableReq_Operation interface {
+	isAlterTableReq_Operation()
+	MarshalTo([]byte) (int, error)
+	ProtoSize() int
+}
+
+type AlterTableReq_AddColumn struct {
+	AddColumn *plan.AlterTableAddColumn `protobuf:"bytes,3,opt,name=add_column,json=addColumn,proto3,oneof" json:"add_column,omitempty"`
+}
+type AlterTableReq_DropColumn struct {
+	DropColumn *plan.AlterTableDropColumn `protobuf:"bytes,4,opt,name=drop_column,json=dropColumn,proto3,oneof" json:"drop_column,omitempty"`
+}
+type AlterTableReq_ModifyColumn struct {
+	ModifyColumn *plan.AlterTableModifyColumn `protobuf:"bytes,5,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTableReq_RenameColumn struct {
+	RenameColumn *plan.AlterTableRenameColumn `protobuf:"bytes,6,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTableReq_RenameTable struct {
+	RenameTable *plan.AlterTableRenameTable `protobuf:"bytes,7,opt,name=rename_table,json=renameTable,proto3,oneof" json:"rename_table,omitempty"`
+}
+type AlterTableReq_ReplacePartition struct {
+	ReplacePartition *plan.PartitionByDef `protobuf:"bytes,8,opt,name=replace_partition,json=replacePartition,proto3,oneof" json:"replace_partition,omitempty"`
+}
+
+func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()        {}
+func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()       {}
+func (*AlterTableReq_ModifyColumn) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_RenameColumn) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_ReplacePartition) isAlterTableReq_Operation() {}
+
+func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetDbId() uint64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *AlterTableReq) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *AlterTableReq) GetAddColumn() *plan.AlterTableAddColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_AddColumn); ok {
+		return x.AddColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetDropColumn() *plan.AlterTableDropColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_DropColumn); ok {
+		return x.DropColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetModifyColumn() *plan.AlterTableModifyColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetRenameColumn() *plan.AlterTableRenameColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetRenameTable() *plan.AlterTableRenameTable {
+	if x, ok := m.GetOperation().(*AlterTableReq_RenameTable); ok {
+		return x.RenameTable
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetReplacePartition() *plan.PartitionByDef {
+	if x, ok := m.GetOperation().(*AlterTableReq_ReplacePartition); ok {
+		return x.ReplacePartition
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AlterTableReq_AddColumn)(nil),
+		(*AlterTableReq_DropColumn)(nil),
+		(*AlterTableReq_ModifyColumn)(nil),
+		(*AlterTableReq_RenameColumn)(nil),
+		(*AlterTableReq_RenameTable)(nil),
+		(*AlterTableReq_ReplacePartition)(nil),
+	}
+}
+
 // CatalogCkp contains information about database and tables in the system,and
 // MetadataCkp contains information about blocks.
 type Checkpoint struct {
@@ -608,7 +769,7 @@ func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
 func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
 func (*Checkpoint) ProtoMessage()    {}
 func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +835,7 @@ func (m *CatalogCkp) Reset()         { *m = CatalogCkp{} }
 func (m *CatalogCkp) String() string { return proto.CompactTextString(m) }
 func (*CatalogCkp) ProtoMessage()    {}
 func (*CatalogCkp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
 func (m *CatalogCkp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,7 +904,7 @@ func (m *MetadataCkp) Reset()         { *m = MetadataCkp{} }
 func (m *MetadataCkp) String() string { return proto.CompactTextString(m) }
 func (*MetadataCkp) ProtoMessage()    {}
 func (*MetadataCkp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 func (m *MetadataCkp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,6 +964,7 @@ func init() {
 	proto.RegisterType((*SyncLogTailResp)(nil), "api.SyncLogTailResp")
 	proto.RegisterType((*PrecommitWriteCmd)(nil), "api.PrecommitWriteCmd")
 	proto.RegisterType((*Entry)(nil), "api.Entry")
+	proto.RegisterType((*AlterTableReq)(nil), "api.AlterTableReq")
 	proto.RegisterType((*Checkpoint)(nil), "api.Checkpoint")
 	proto.RegisterType((*CatalogCkp)(nil), "api.CatalogCkp")
 	proto.RegisterType((*MetadataCkp)(nil), "api.MetadataCkp")
@@ -811,59 +973,70 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 822 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x8e, 0xe3, 0xc4,
-	0x13, 0x1e, 0xc7, 0x89, 0x1d, 0x97, 0x93, 0x5d, 0x6f, 0xff, 0x46, 0xfa, 0x99, 0x01, 0xb2, 0xc1,
-	0x48, 0x28, 0xfc, 0xd9, 0x44, 0xca, 0xde, 0xf7, 0xb0, 0x19, 0x04, 0x91, 0x86, 0x9d, 0x55, 0x13,
-	0x76, 0x25, 0x84, 0x14, 0xb5, 0xed, 0xde, 0x4c, 0x2b, 0x76, 0x77, 0x63, 0x77, 0x86, 0x99, 0x3b,
-	0x9c, 0x91, 0x78, 0x02, 0x8e, 0x48, 0xbc, 0x08, 0x47, 0x1e, 0x01, 0x0d, 0x17, 0xe0, 0x29, 0x50,
-	0x97, 0xe3, 0x0c, 0x08, 0xd8, 0xeb, 0x5e, 0xac, 0xaa, 0xaf, 0xbe, 0x6a, 0x7f, 0x55, 0x5d, 0xa5,
-	0x86, 0x80, 0x69, 0x31, 0xd5, 0x95, 0x32, 0x8a, 0xb8, 0x4c, 0x8b, 0x93, 0x07, 0x1b, 0x61, 0x2e,
-	0x76, 0xe9, 0x34, 0x53, 0xe5, 0x6c, 0xa3, 0x36, 0x6a, 0x86, 0xb1, 0x74, 0xf7, 0x02, 0x3d, 0x74,
-	0xd0, 0x6a, 0x72, 0x4e, 0xee, 0x1a, 0x51, 0xf2, 0xda, 0xb0, 0x52, 0xef, 0x01, 0xd0, 0x05, 0x93,
-	0x8d, 0x9d, 0xfc, 0xe8, 0x80, 0xf7, 0x8c, 0x67, 0x46, 0x55, 0x84, 0x40, 0x37, 0x67, 0x86, 0xc5,
-	0xce, 0xd8, 0x99, 0x0c, 0x28, 0xda, 0x64, 0x04, 0x5d, 0x73, 0xad, 0x79, 0xdc, 0x19, 0x3b, 0x93,
-	0x70, 0x0e, 0x53, 0xcc, 0x5c, 0x5d, 0x6b, 0x4e, 0x11, 0x27, 0x27, 0xd0, 0x97, 0xbb, 0xa2, 0x60,
-	0x69, 0xc1, 0x63, 0x77, 0xec, 0x4c, 0xfa, 0xf4, 0xe0, 0x93, 0x08, 0x5c, 0x59, 0xeb, 0xb8, 0x8b,
-	0xc7, 0x59, 0x93, 0xbc, 0x06, 0x7d, 0x51, 0xaf, 0x33, 0x25, 0x6b, 0x13, 0xf7, 0x90, 0xed, 0x8b,
-	0x7a, 0x61, 0x5d, 0x4b, 0x2e, 0xb8, 0x8c, 0xbd, 0xb1, 0x33, 0x19, 0x52, 0x6b, 0x5a, 0x39, 0xac,
-	0xe2, 0x2c, 0xf6, 0x1b, 0x39, 0xd6, 0x4e, 0x1e, 0x41, 0xef, 0x31, 0x33, 0xd9, 0x05, 0x39, 0x86,
-	0x1e, 0x33, 0xa6, 0xaa, 0x63, 0x67, 0xec, 0x4e, 0x02, 0xda, 0x38, 0xe4, 0x3e, 0x74, 0x2f, 0x79,
-	0x56, 0xc7, 0x9d, 0xb1, 0x3b, 0x09, 0xe7, 0xe1, 0xd4, 0xf6, 0xad, 0x29, 0x8e, 0x62, 0x20, 0x79,
-	0x06, 0xfe, 0xca, 0x6a, 0x5b, 0x9e, 0x92, 0xff, 0x41, 0x2f, 0x4f, 0xd7, 0x22, 0xc7, 0x72, 0xbb,
-	0xb4, 0x9b, 0xa7, 0xcb, 0xdc, 0x82, 0x06, 0xc1, 0x4e, 0x03, 0x1a, 0x0b, 0xbe, 0x05, 0x03, 0xcd,
-	0x2a, 0x23, 0x8c, 0x50, 0xd2, 0xc6, 0x5c, 0x8c, 0x85, 0x07, 0x6c, 0x99, 0x27, 0xdf, 0x39, 0x70,
-	0xe7, 0xd3, 0x6b, 0x99, 0x9d, 0xa9, 0xcd, 0x8a, 0x89, 0x82, 0xf2, 0x2f, 0xc9, 0x03, 0xf0, 0x33,
-	0xb9, 0xbe, 0x60, 0x97, 0x1c, 0xff, 0x10, 0xce, 0x8f, 0xa7, 0xb7, 0xf7, 0xb0, 0x6a, 0x2d, 0xea,
-	0x65, 0xf2, 0x63, 0x76, 0xc9, 0xf7, 0xf4, 0xaf, 0x98, 0x34, 0xfb, 0x5e, 0xff, 0x27, 0xfd, 0x39,
-	0x93, 0x86, 0x24, 0xd0, 0x33, 0x87, 0xa6, 0x87, 0xf3, 0x01, 0x96, 0xba, 0x2f, 0x8d, 0x36, 0xa1,
-	0xe4, 0x0b, 0xb8, 0xfb, 0x37, 0x4d, 0xb5, 0xb6, 0xa5, 0x64, 0x5b, 0xbd, 0x2e, 0x54, 0xc6, 0xac,
-	0x72, 0x54, 0x16, 0xd0, 0x30, 0xdb, 0xea, 0xb3, 0x3d, 0x44, 0xde, 0x81, 0x7e, 0xa6, 0xca, 0x92,
-	0xc9, 0xbc, 0xed, 0x23, 0xe0, 0xe1, 0x1f, 0x4a, 0x53, 0x5d, 0xd3, 0x43, 0x2c, 0xf9, 0xd6, 0x81,
-	0x7b, 0x4f, 0x2b, 0x6e, 0x7d, 0x61, 0x9e, 0x57, 0xc2, 0xf0, 0x45, 0x99, 0x93, 0xff, 0x83, 0xbf,
-	0xab, 0x79, 0xd5, 0xf6, 0x75, 0x48, 0x3d, 0xeb, 0x2e, 0x31, 0x50, 0xa9, 0x82, 0xb7, 0xbd, 0x1d,
-	0x52, 0xcf, 0xba, 0xcb, 0x9c, 0xbc, 0x09, 0xc0, 0xb2, 0x4c, 0xed, 0xa4, 0x69, 0x7b, 0x3b, 0xa4,
-	0xc1, 0x1e, 0x59, 0xe6, 0xe4, 0x5d, 0x00, 0x6e, 0xff, 0xbc, 0x2e, 0x44, 0x6d, 0xe2, 0xee, 0x3f,
-	0x04, 0x05, 0x18, 0x3d, 0x13, 0xb5, 0x49, 0x7e, 0xe8, 0x40, 0x0f, 0x41, 0xf2, 0xb0, 0x4d, 0xc2,
-	0xd9, 0xb5, 0x42, 0xee, 0xcc, 0x8f, 0x6f, 0x93, 0x9a, 0x2f, 0x4e, 0x71, 0x93, 0x6e, 0x4d, 0x3b,
-	0x9c, 0xd8, 0xb7, 0xdb, 0xeb, 0xf7, 0xd1, 0x5f, 0xe6, 0xe4, 0x3e, 0x84, 0x76, 0x1b, 0x52, 0x56,
-	0xf3, 0xdb, 0x01, 0x80, 0x16, 0x6a, 0x8a, 0x68, 0x72, 0x25, 0x2b, 0x39, 0x4e, 0x7c, 0x40, 0x03,
-	0x44, 0x9e, 0xb0, 0x92, 0x93, 0xb7, 0x61, 0x78, 0xc8, 0x47, 0x46, 0x0f, 0x19, 0x83, 0x16, 0x44,
-	0xd2, 0xeb, 0x10, 0xbc, 0x10, 0xed, 0x11, 0x1e, 0x12, 0xfa, 0x16, 0xc0, 0xe0, 0x1b, 0xe0, 0xa6,
-	0xcc, 0xc4, 0xfd, 0xfd, 0x1a, 0xda, 0x52, 0x70, 0x11, 0xa8, 0x85, 0x93, 0x19, 0x04, 0x87, 0x92,
-	0x08, 0x80, 0xb7, 0x94, 0x35, 0xaf, 0x4c, 0x74, 0x64, 0xed, 0x53, 0x5e, 0x70, 0xc3, 0x23, 0xc7,
-	0xda, 0x9f, 0xe9, 0x9c, 0x19, 0x1e, 0x75, 0x92, 0xaf, 0x1d, 0x80, 0xc5, 0x05, 0xcf, 0xb6, 0x5a,
-	0x09, 0x69, 0xc8, 0xfb, 0xe0, 0x95, 0x42, 0xae, 0x4d, 0xfd, 0xd2, 0x51, 0xed, 0x95, 0x42, 0xae,
-	0x6a, 0x24, 0xb3, 0x2b, 0x4b, 0xee, 0xbc, 0x94, 0xcc, 0xae, 0x56, 0x75, 0xab, 0xdb, 0xfd, 0x77,
-	0xdd, 0x28, 0x83, 0x19, 0x56, 0xa8, 0xcd, 0x62, 0xab, 0x5f, 0x99, 0x8c, 0x6f, 0x1c, 0x08, 0x3f,
-	0xe1, 0x86, 0xd9, 0xeb, 0x78, 0x85, 0x3a, 0xde, 0x3b, 0x05, 0xef, 0x5c, 0x2f, 0x54, 0xce, 0x89,
-	0x0f, 0xee, 0x13, 0xa5, 0xa3, 0x23, 0x72, 0x0f, 0x06, 0xe7, 0xfa, 0x23, 0x6e, 0xf6, 0x4b, 0x1c,
-	0xfd, 0xe6, 0x93, 0x01, 0xf8, 0xe7, 0x1a, 0x17, 0x2e, 0xfa, 0xdd, 0x27, 0x11, 0x84, 0xe7, 0xfa,
-	0x69, 0xc5, 0x17, 0xb8, 0x87, 0xd1, 0x1f, 0xfe, 0xe3, 0x47, 0x3f, 0xdd, 0x8c, 0x9c, 0x9f, 0x6f,
-	0x46, 0xce, 0x2f, 0x37, 0xa3, 0xa3, 0xef, 0x7f, 0x1d, 0x39, 0x9f, 0x7f, 0xf0, 0x97, 0xf7, 0xa2,
-	0x64, 0xa6, 0x12, 0x57, 0xaa, 0x12, 0x1b, 0x21, 0x5b, 0x47, 0xf2, 0x99, 0xde, 0x6e, 0x66, 0x3a,
-	0x9d, 0x31, 0x2d, 0x52, 0x0f, 0x1f, 0x86, 0x87, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xd9,
-	0x8e, 0x5a, 0x76, 0x06, 0x00, 0x00,
+	// 994 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xf6, 0xf8, 0x6f, 0x3c, 0x35, 0xf6, 0xae, 0xd3, 0x44, 0x62, 0x36, 0xbb, 0x64, 0xcd, 0x20,
+	0x21, 0xf3, 0xb3, 0x89, 0x94, 0xbd, 0xad, 0xc4, 0x8a, 0x8d, 0x83, 0x88, 0xa5, 0xec, 0x26, 0x1a,
+	0xcc, 0xae, 0x84, 0x90, 0xac, 0xf6, 0x4c, 0xc7, 0x69, 0x65, 0xa6, 0xbb, 0xe9, 0x69, 0x87, 0xf8,
+	0x0e, 0x67, 0x24, 0x9e, 0x80, 0x3b, 0x47, 0x5e, 0x82, 0x23, 0x47, 0x8e, 0x28, 0x5c, 0x80, 0xa7,
+	0x40, 0x5d, 0x33, 0x63, 0x87, 0x25, 0xca, 0x75, 0x2f, 0x51, 0x7d, 0x5f, 0x55, 0x7d, 0x53, 0x55,
+	0x5d, 0xdd, 0x31, 0x78, 0x54, 0xf1, 0x1d, 0xa5, 0xa5, 0x91, 0xa4, 0x41, 0x15, 0xdf, 0x7a, 0x34,
+	0xe7, 0xe6, 0x6c, 0x31, 0xdb, 0x89, 0x65, 0xb6, 0x3b, 0x97, 0x73, 0xb9, 0x8b, 0xbe, 0xd9, 0xe2,
+	0x14, 0x11, 0x02, 0xb4, 0x8a, 0x9c, 0xad, 0xbb, 0x86, 0x67, 0x2c, 0x37, 0x34, 0x53, 0x25, 0x01,
+	0x2a, 0xa5, 0xa2, 0xb0, 0xc3, 0x9f, 0x1d, 0x68, 0xbf, 0x64, 0xb1, 0x91, 0x9a, 0x10, 0x68, 0x26,
+	0xd4, 0xd0, 0xc0, 0x19, 0x38, 0xc3, 0x6e, 0x84, 0x36, 0xd9, 0x86, 0xa6, 0x59, 0x2a, 0x16, 0xd4,
+	0x07, 0xce, 0xd0, 0xdf, 0x83, 0x1d, 0xcc, 0x9c, 0x2c, 0x15, 0x8b, 0x90, 0x27, 0x5b, 0xd0, 0x11,
+	0x8b, 0x34, 0xa5, 0xb3, 0x94, 0x05, 0x8d, 0x81, 0x33, 0xec, 0x44, 0x2b, 0x4c, 0xfa, 0xd0, 0x10,
+	0xb9, 0x0a, 0x9a, 0x28, 0x67, 0x4d, 0x72, 0x0f, 0x3a, 0x3c, 0x9f, 0xc6, 0x52, 0xe4, 0x26, 0x68,
+	0x61, 0xb4, 0xcb, 0xf3, 0x91, 0x85, 0x36, 0x38, 0x65, 0x22, 0x68, 0x0f, 0x9c, 0x61, 0x2f, 0xb2,
+	0xa6, 0x2d, 0x87, 0x6a, 0x46, 0x03, 0xb7, 0x28, 0xc7, 0xda, 0xe1, 0x53, 0x68, 0xed, 0x53, 0x13,
+	0x9f, 0x91, 0x4d, 0x68, 0x51, 0x63, 0x74, 0x1e, 0x38, 0x83, 0xc6, 0xd0, 0x8b, 0x0a, 0x40, 0x1e,
+	0x42, 0xf3, 0x82, 0xc5, 0x79, 0x50, 0x1f, 0x34, 0x86, 0xfe, 0x9e, 0xbf, 0x63, 0xe7, 0x56, 0x34,
+	0x17, 0xa1, 0x23, 0x7c, 0x09, 0xee, 0xc4, 0xd6, 0x36, 0x3e, 0x20, 0x6f, 0x41, 0x2b, 0x99, 0x4d,
+	0x79, 0x82, 0xed, 0x36, 0xa3, 0x66, 0x32, 0x1b, 0x27, 0x96, 0x34, 0x48, 0xd6, 0x0b, 0xd2, 0x58,
+	0xf2, 0x5d, 0xe8, 0x2a, 0xaa, 0x0d, 0x37, 0x5c, 0x0a, 0xeb, 0x6b, 0xa0, 0xcf, 0x5f, 0x71, 0xe3,
+	0x24, 0xfc, 0xd1, 0x81, 0x3b, 0x5f, 0x2c, 0x45, 0x7c, 0x24, 0xe7, 0x13, 0xca, 0xd3, 0x88, 0x7d,
+	0x43, 0x1e, 0x81, 0x1b, 0x8b, 0xe9, 0x19, 0xbd, 0x60, 0xf8, 0x05, 0x7f, 0x6f, 0x73, 0x67, 0x7d,
+	0x0e, 0x93, 0xca, 0x8a, 0xda, 0xb1, 0x38, 0xa4, 0x17, 0xac, 0x0c, 0xff, 0x96, 0x0a, 0x13, 0xd4,
+	0x6f, 0x0f, 0x7f, 0x45, 0x85, 0x21, 0x21, 0xb4, 0xcc, 0x6a, 0xe8, 0xfe, 0x5e, 0x17, 0x5b, 0x2d,
+	0x5b, 0x8b, 0x0a, 0x57, 0xf8, 0x35, 0xdc, 0xfd, 0x4f, 0x4d, 0xb9, 0xb2, 0xad, 0xc4, 0xe7, 0x6a,
+	0x9a, 0xca, 0x98, 0xda, 0xca, 0xb1, 0x32, 0x2f, 0xf2, 0xe3, 0x73, 0x75, 0x54, 0x52, 0xe4, 0x7d,
+	0xe8, 0xc4, 0x32, 0xcb, 0xa8, 0x48, 0xaa, 0x39, 0x02, 0x8a, 0x7f, 0x26, 0x8c, 0x5e, 0x46, 0x2b,
+	0x5f, 0xf8, 0x83, 0x03, 0x1b, 0x27, 0x9a, 0x59, 0xcc, 0xcd, 0x2b, 0xcd, 0x0d, 0x1b, 0x65, 0x09,
+	0x79, 0x1b, 0xdc, 0x45, 0xce, 0x74, 0x35, 0xd7, 0x5e, 0xd4, 0xb6, 0x70, 0x8c, 0x0e, 0x2d, 0x53,
+	0x56, 0xcd, 0xb6, 0x17, 0xb5, 0x2d, 0x1c, 0x27, 0xe4, 0x1d, 0x00, 0x1a, 0xc7, 0x72, 0x21, 0x4c,
+	0x35, 0xdb, 0x5e, 0xe4, 0x95, 0xcc, 0x38, 0x21, 0x1f, 0x00, 0x30, 0xfb, 0xe5, 0x69, 0xca, 0x73,
+	0x13, 0x34, 0xff, 0x57, 0x90, 0x87, 0xde, 0x23, 0x9e, 0x9b, 0xf0, 0x97, 0x3a, 0xb4, 0x90, 0x24,
+	0x8f, 0xab, 0x24, 0xdc, 0x5d, 0x5b, 0xc8, 0x9d, 0xbd, 0xcd, 0x75, 0x52, 0xf1, 0x17, 0xb7, 0xd8,
+	0x63, 0x95, 0x69, 0x97, 0x13, 0xe7, 0xb6, 0x3e, 0x7e, 0x17, 0xf1, 0x38, 0x21, 0x0f, 0xc1, 0xb7,
+	0xb7, 0x61, 0x46, 0x73, 0xb6, 0x5e, 0x00, 0xa8, 0xa8, 0xa2, 0x89, 0x22, 0x57, 0xd0, 0x8c, 0xe1,
+	0xc6, 0x7b, 0x91, 0x87, 0xcc, 0x0b, 0x9a, 0x31, 0xf2, 0x1e, 0xf4, 0x56, 0xf9, 0x18, 0xd1, 0xc2,
+	0x88, 0x6e, 0x45, 0x62, 0xd0, 0x7d, 0xf0, 0x4e, 0x79, 0x25, 0xd1, 0xc6, 0x80, 0x8e, 0x25, 0xd0,
+	0xf9, 0x00, 0x1a, 0x33, 0x6a, 0x82, 0x4e, 0x79, 0x0d, 0x6d, 0x2b, 0x78, 0x11, 0x22, 0x4b, 0x87,
+	0x4f, 0xc0, 0x5b, 0xb5, 0x44, 0x00, 0xda, 0x63, 0x91, 0x33, 0x6d, 0xfa, 0x35, 0x6b, 0x1f, 0xb0,
+	0x94, 0x19, 0xd6, 0x77, 0xac, 0xfd, 0xa5, 0x4a, 0xa8, 0x61, 0xfd, 0x3a, 0xf1, 0xa0, 0xf5, 0x2c,
+	0x35, 0x4c, 0xf7, 0x1b, 0xe1, 0xef, 0x0d, 0xe8, 0xa1, 0x8d, 0xdb, 0x63, 0x37, 0xf7, 0xc6, 0x9b,
+	0x71, 0xcb, 0x74, 0x9e, 0x00, 0xd0, 0x24, 0x99, 0xc6, 0x32, 0x5d, 0x64, 0xa2, 0x5c, 0xc8, 0x7b,
+	0xc5, 0x4b, 0xb1, 0x16, 0x7e, 0x96, 0x24, 0x23, 0x0c, 0x38, 0xac, 0x45, 0x1e, 0xad, 0x00, 0xf9,
+	0x04, 0xfc, 0x44, 0x4b, 0x55, 0x25, 0x37, 0x31, 0x79, 0xeb, 0xf5, 0xe4, 0x03, 0x2d, 0xd5, 0x2a,
+	0x1b, 0x92, 0x15, 0x22, 0x23, 0xe8, 0x65, 0x32, 0xe1, 0xa7, 0xcb, 0x4a, 0xa0, 0x85, 0x02, 0x0f,
+	0x5e, 0x17, 0x78, 0x8e, 0x41, 0x2b, 0x89, 0x6e, 0x76, 0x0d, 0x5b, 0x11, 0xcd, 0xec, 0xd4, 0x2b,
+	0x91, 0xf6, 0xcd, 0x22, 0x11, 0x06, 0xad, 0x45, 0xf4, 0x35, 0x4c, 0x3e, 0x85, 0x12, 0x4f, 0x8b,
+	0x7b, 0xe9, 0xa2, 0xc6, 0xfd, 0x9b, 0x35, 0xd0, 0x3c, 0xac, 0x45, 0xbe, 0x5e, 0x43, 0x32, 0x82,
+	0x0d, 0xcd, 0x54, 0x4a, 0x63, 0x36, 0x5d, 0x3d, 0x2d, 0xe5, 0x81, 0x6f, 0x16, 0x32, 0x27, 0x15,
+	0xbd, 0xbf, 0x3c, 0x60, 0xa7, 0x87, 0xb5, 0xa8, 0x5f, 0x26, 0xac, 0x1d, 0x3e, 0x78, 0x52, 0x31,
+	0x8d, 0x57, 0x39, 0xfc, 0xce, 0x01, 0x18, 0x9d, 0xb1, 0xf8, 0x5c, 0x49, 0x2e, 0x0c, 0xf9, 0x08,
+	0xda, 0x19, 0x17, 0x53, 0x93, 0xdf, 0xfa, 0x20, 0xb5, 0x32, 0x2e, 0x26, 0x39, 0x06, 0xd3, 0x4b,
+	0x1b, 0x5c, 0xbf, 0x35, 0x98, 0x5e, 0x4e, 0xf2, 0x6a, 0x3b, 0x1b, 0x37, 0x6f, 0x27, 0x96, 0x41,
+	0x0d, 0x4d, 0xe5, 0x7c, 0x74, 0xae, 0xde, 0x58, 0x19, 0xdf, 0x3b, 0xe0, 0x3f, 0x67, 0x86, 0xda,
+	0x4b, 0xf7, 0x06, 0xeb, 0xf8, 0xf0, 0x00, 0xda, 0xc7, 0x6a, 0x24, 0x13, 0x46, 0x5c, 0x68, 0xbc,
+	0x90, 0xaa, 0x5f, 0x23, 0x1b, 0xd0, 0x3d, 0x56, 0x9f, 0x33, 0x53, 0x3e, 0xd5, 0xfd, 0xbf, 0x5c,
+	0xd2, 0x05, 0xf7, 0x58, 0xe1, 0xb3, 0xda, 0xff, 0xdb, 0x25, 0x7d, 0xf0, 0x8f, 0xd5, 0x89, 0x66,
+	0x23, 0x7c, 0x6d, 0xfb, 0xff, 0xb8, 0xfb, 0x4f, 0x7f, 0xbd, 0xda, 0x76, 0x7e, 0xbb, 0xda, 0x76,
+	0xfe, 0xb8, 0xda, 0xae, 0xfd, 0xf4, 0xe7, 0xb6, 0xf3, 0xd5, 0xc7, 0xd7, 0x7e, 0x15, 0x64, 0xd4,
+	0x68, 0x7e, 0x29, 0x35, 0x9f, 0x73, 0x51, 0x01, 0xc1, 0x76, 0xd5, 0xf9, 0x7c, 0x57, 0xcd, 0x76,
+	0xa9, 0xe2, 0xb3, 0x36, 0xfe, 0xfb, 0x7f, 0xfc, 0xef, 0x00, 0x24, 0xfe, 0xc5, 0xaa, 0x5c, 0x08,
+	0x00, 0x00,
 }
 
 func (m *Vector) Marshal() (dAtA []byte, err error) {
@@ -1285,6 +1458,178 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableReq) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Operation != nil {
+		{
+			size := m.Operation.ProtoSize()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.TableId != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.TableId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DbId != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.DbId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableReq_AddColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_AddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddColumn != nil {
+		{
+			size, err := m.AddColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_DropColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_DropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropColumn != nil {
+		{
+			size, err := m.DropColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_RenameTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_RenameTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameTable != nil {
+		{
+			size, err := m.RenameTable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_ReplacePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_ReplacePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReplacePartition != nil {
+		{
+			size, err := m.ReplacePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1677,23 +2022,20 @@ func (m *Entry) ProtoSize() (n int) {
 	return n
 }
 
-func (m *Checkpoint) ProtoSize() (n int) {
+func (m *AlterTableReq) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MinTs != nil {
-		l = m.MinTs.ProtoSize()
-		n += 1 + l + sovApi(uint64(l))
+	if m.DbId != 0 {
+		n += 1 + sovApi(uint64(m.DbId))
 	}
-	if m.MaxTs != nil {
-		l = m.MaxTs.ProtoSize()
-		n += 1 + l + sovApi(uint64(l))
+	if m.TableId != 0 {
+		n += 1 + sovApi(uint64(m.TableId))
 	}
-	if m.Bat != nil {
-		l = m.Bat.ProtoSize()
-		n += 1 + l + sovApi(uint64(l))
+	if m.Operation != nil {
+		n += m.Operation.ProtoSize()
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1701,38 +2043,134 @@ func (m *Checkpoint) ProtoSize() (n int) {
 	return n
 }
 
-func (m *CatalogCkp) ProtoSize() (n int) {
+func (m *AlterTableReq_AddColumn) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MinTs != nil {
-		l = m.MinTs.ProtoSize()
+	if m.AddColumn != nil {
+		l = m.AddColumn.ProtoSize()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.MaxTs != nil {
-		l = m.MaxTs.ProtoSize()
-		n += 1 + l + sovApi(uint64(l))
+	return n
+}
+func (m *AlterTableReq_DropColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Bat != nil {
-		l = m.Bat.ProtoSize()
+	var l int
+	_ = l
+	if m.DropColumn != nil {
+		l = m.DropColumn.ProtoSize()
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
 	return n
 }
-
-func (m *MetadataCkp) ProtoSize() (n int) {
+func (m *AlterTableReq_ModifyColumn) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MinTs != nil {
-		l = m.MinTs.ProtoSize()
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_RenameTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameTable != nil {
+		l = m.RenameTable.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_ReplacePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplacePartition != nil {
+		l = m.ReplacePartition.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *Checkpoint) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinTs != nil {
+		l = m.MinTs.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MaxTs != nil {
+		l = m.MaxTs.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Bat != nil {
+		l = m.Bat.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CatalogCkp) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinTs != nil {
+		l = m.MinTs.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.MaxTs != nil {
+		l = m.MaxTs.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Bat != nil {
+		l = m.Bat.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetadataCkp) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinTs != nil {
+		l = m.MinTs.ProtoSize()
 		n += 1 + l + sovApi(uint64(l))
 	}
 	if m.MaxTs != nil {
@@ -2886,6 +3324,305 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			}
+			m.DbId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &plan.AlterTableAddColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_AddColumn{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &plan.AlterTableDropColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_DropColumn{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &plan.AlterTableModifyColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_ModifyColumn{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &plan.AlterTableRenameColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_RenameColumn{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &plan.AlterTableRenameTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_RenameTable{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &plan.PartitionByDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_ReplacePartition{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
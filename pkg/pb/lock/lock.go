@@ -15,7 +15,10 @@
 package lock
 
 import (
+	"context"
 	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 // Changed returns true if LockTable bind changed
@@ -28,3 +31,82 @@ func (m LockTable) Changed(v LockTable) bool {
 func (m LockTable) DebugString() string {
 	return fmt.Sprintf("%d/%s/%d", m.Table, m.ServiceID, m.Version)
 }
+
+// SetID implement morpc Messgae
+func (m *Request) SetID(id uint64) {
+	m.RequestID = id
+}
+
+// GetID implement morpc Messgae
+func (m *Request) GetID() uint64 {
+	return m.RequestID
+}
+
+// DebugString returns the debug string
+func (m *Request) DebugString() string {
+	switch m.Method {
+	case Method_Lock:
+		return fmt.Sprintf("%d/%s/%s/%x/%d/%d rows",
+			m.RequestID,
+			m.Method,
+			m.ServiceID,
+			m.Lock.TxnID,
+			m.Lock.TableID,
+			len(m.Lock.Rows))
+	case Method_Unlock:
+		return fmt.Sprintf("%d/%s/%s/%x",
+			m.RequestID,
+			m.Method,
+			m.ServiceID,
+			m.Unlock.TxnID)
+	default:
+		return fmt.Sprintf("%d/%s/%s",
+			m.RequestID,
+			m.Method,
+			m.ServiceID)
+	}
+}
+
+// SetID implement morpc Messgae
+func (m *Response) SetID(id uint64) {
+	m.RequestID = id
+}
+
+// GetID implement morpc Messgae
+func (m *Response) GetID() uint64 {
+	return m.RequestID
+}
+
+// DebugString returns the debug string
+func (m *Response) DebugString() string {
+	return fmt.Sprintf("%d/%s/%d bytes error",
+		m.RequestID,
+		m.Method,
+		len(m.Error))
+}
+
+// WrapError wraps the error of the request into the response
+func (m *Response) WrapError(err error) {
+	me, ok := err.(*moerr.Error)
+	if !ok {
+		me = moerr.ConvertGoError(context.Background(), err).(*moerr.Error)
+	}
+	data, e := me.MarshalBinary()
+	if e != nil {
+		panic(e)
+	}
+	m.Error = data
+}
+
+// UnwrapError returns the error of the request, nil returned if the request
+// succeeded.
+func (m *Response) UnwrapError() error {
+	if len(m.Error) == 0 {
+		return nil
+	}
+	err := &moerr.Error{}
+	if e := err.UnmarshalBinary(m.Error); e != nil {
+		return e
+	}
+	return err
+}
@@ -474,7 +474,9 @@ type DNStore struct {
 	State          NodeState     `protobuf:"varint,4,opt,name=State,proto3,enum=logservice.NodeState" json:"State,omitempty"`
 	Shards         []DNShardInfo `protobuf:"bytes,5,rep,name=Shards,proto3" json:"Shards"`
 	// Server address for logtail push model
-	LogtailServerAddress string   `protobuf:"bytes,6,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
+	LogtailServerAddress string `protobuf:"bytes,6,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
+	// LockServiceAddress is used to provide the lock server of all CNs
+	LockServiceAddress   string   `protobuf:"bytes,7,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -555,6 +557,13 @@ func (m *DNStore) GetLogtailServerAddress() string {
 	return ""
 }
 
+func (m *DNStore) GetLockServiceAddress() string {
+	if m != nil {
+		return m.LockServiceAddress
+	}
+	return ""
+}
+
 type LogStore struct {
 	UUID                 string           `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
 	ServiceAddress       string           `protobuf:"bytes,2,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
@@ -1065,7 +1074,9 @@ type DNStoreHeartbeat struct {
 	Shards             []DNShardInfo `protobuf:"bytes,3,rep,name=Shards,proto3" json:"Shards"`
 	TaskServiceCreated bool          `protobuf:"varint,4,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
 	// Server address for logtail push model
-	LogtailServerAddress string   `protobuf:"bytes,5,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
+	LogtailServerAddress string `protobuf:"bytes,5,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
+	// LockServiceAddress is used to provide the lock server of all CNs
+	LockServiceAddress   string   `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1139,6 +1150,13 @@ func (m *DNStoreHeartbeat) GetLogtailServerAddress() string {
 	return ""
 }
 
+func (m *DNStoreHeartbeat) GetLockServiceAddress() string {
+	if m != nil {
+		return m.LockServiceAddress
+	}
+	return ""
+}
+
 type RSMState struct {
 	Tso                  uint64            `protobuf:"varint,1,opt,name=Tso,proto3" json:"Tso,omitempty"`
 	Index                uint64            `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
@@ -2504,7 +2522,9 @@ type DNStoreInfo struct {
 	Shards             []DNShardInfo `protobuf:"bytes,3,rep,name=Shards,proto3" json:"Shards"`
 	TaskServiceCreated bool          `protobuf:"varint,4,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
 	// Server address for logtail push model
-	LogtailServerAddress string   `protobuf:"bytes,5,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
+	LogtailServerAddress string `protobuf:"bytes,5,opt,name=LogtailServerAddress,proto3" json:"LogtailServerAddress,omitempty"`
+	// LockServiceAddress is used to provide the lock server of all CNs
+	LockServiceAddress   string   `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2578,6 +2598,13 @@ func (m *DNStoreInfo) GetLogtailServerAddress() string {
 	return ""
 }
 
+func (m *DNStoreInfo) GetLockServiceAddress() string {
+	if m != nil {
+		return m.LockServiceAddress
+	}
+	return ""
+}
+
 // DNState contains all DN details known to the HAKeeper.
 type DNState struct {
 	// Stores is keyed by DN store UUID.
@@ -3404,179 +3431,179 @@ func init() {
 func init() { proto.RegisterFile("logservice.proto", fileDescriptor_fd1040c5381ab5a7) }
 
 var fileDescriptor_fd1040c5381ab5a7 = []byte{
-	// 2745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0xcb, 0x6f, 0x1b, 0xc7,
-	0xf9, 0x5a, 0x92, 0xe2, 0xe3, 0xa3, 0xa4, 0xac, 0x46, 0x92, 0xcd, 0x28, 0xf9, 0xc9, 0xfa, 0x6d,
-	0x5d, 0xc3, 0x55, 0x1a, 0x0a, 0x90, 0xe0, 0x20, 0x69, 0x14, 0x1b, 0x14, 0x97, 0xb6, 0x18, 0xd3,
-	0x94, 0x32, 0xa4, 0x7a, 0x08, 0x10, 0xa8, 0x2b, 0x72, 0x4c, 0xb1, 0x22, 0xb9, 0xec, 0xee, 0xd2,
-	0xb1, 0x7b, 0x6a, 0x0f, 0x2d, 0x50, 0xf4, 0xd4, 0xf6, 0x12, 0x14, 0x41, 0xff, 0x8a, 0x1e, 0x7a,
-	0xeb, 0xa5, 0x05, 0x02, 0xf4, 0x01, 0xff, 0x05, 0x41, 0x6b, 0xf4, 0xd2, 0x53, 0xd1, 0x4b, 0xcf,
-	0xc5, 0xbc, 0x76, 0x67, 0xb8, 0x2b, 0xaa, 0x76, 0x5c, 0xc0, 0xe8, 0x49, 0x9c, 0xef, 0x35, 0xdf,
-	0x7c, 0xef, 0x99, 0x15, 0x98, 0x03, 0xb7, 0xe7, 0x13, 0xef, 0x51, 0xbf, 0x43, 0xca, 0x63, 0xcf,
-	0x0d, 0x5c, 0x04, 0x11, 0x64, 0xfd, 0xed, 0x5e, 0x3f, 0x38, 0x9b, 0x9c, 0x96, 0x3b, 0xee, 0x70,
-	0xbb, 0xe7, 0xf6, 0xdc, 0x6d, 0x46, 0x72, 0x3a, 0x79, 0xc8, 0x56, 0x6c, 0xc1, 0x7e, 0x71, 0xd6,
-	0xf5, 0xa5, 0x21, 0x09, 0x9c, 0xae, 0x13, 0x38, 0x7c, 0x6d, 0xfd, 0xd1, 0x80, 0x5c, 0xb5, 0xd9,
-	0x0a, 0x5c, 0x8f, 0x20, 0x04, 0x99, 0xe3, 0xe3, 0xba, 0x5d, 0x32, 0x36, 0x8d, 0x9b, 0x05, 0xcc,
-	0x7e, 0xa3, 0x1b, 0xb0, 0xd4, 0xe2, 0x3b, 0x55, 0xba, 0x5d, 0x8f, 0xf8, 0x7e, 0x29, 0xc5, 0xb0,
-	0x53, 0x50, 0xb4, 0x01, 0xd0, 0xfa, 0xa8, 0x21, 0x69, 0xd2, 0x8c, 0x46, 0x81, 0xa0, 0xeb, 0x90,
-	0xc1, 0xee, 0x80, 0x94, 0x32, 0x9b, 0xc6, 0xcd, 0xa5, 0x1d, 0xb3, 0x1c, 0xaa, 0x51, 0x6d, 0x52,
-	0x38, 0x66, 0x58, 0xaa, 0x41, 0xbb, 0xdf, 0x39, 0x2f, 0xcd, 0x6f, 0x1a, 0x37, 0x33, 0x98, 0xfd,
-	0x46, 0x6f, 0xc1, 0x7c, 0x2b, 0x70, 0x02, 0x52, 0xca, 0x32, 0xd6, 0xb5, 0xb2, 0x62, 0x8e, 0xa6,
-	0xdb, 0x25, 0x0c, 0x89, 0x39, 0x8d, 0xf5, 0x4f, 0x03, 0x72, 0xf6, 0x4b, 0x38, 0x8e, 0x54, 0x24,
-	0x9d, 0xa4, 0x48, 0xe6, 0x72, 0x45, 0xd0, 0x2d, 0xc8, 0xb6, 0xce, 0x1c, 0xaf, 0xeb, 0x97, 0xe6,
-	0x37, 0xd3, 0x37, 0x8b, 0x3b, 0x57, 0x55, 0x6a, 0xbb, 0xc9, 0x70, 0xf5, 0xd1, 0x43, 0x77, 0x3f,
-	0xf3, 0xc5, 0x97, 0xd7, 0xe6, 0xb0, 0x20, 0x46, 0x3b, 0xb0, 0xda, 0x70, 0x7b, 0x81, 0xd3, 0x1f,
-	0x50, 0x85, 0x88, 0x27, 0xb5, 0xcc, 0x32, 0x2d, 0x13, 0x71, 0xd6, 0xef, 0x0d, 0xc8, 0x37, 0xdc,
-	0xde, 0x2b, 0x70, 0xe8, 0x3d, 0xc8, 0x63, 0x32, 0x1e, 0xf4, 0x3b, 0x8e, 0x3c, 0xf6, 0xba, 0x4a,
-	0xdf, 0x70, 0x7b, 0x02, 0xad, 0x9c, 0x3c, 0xe4, 0xb0, 0xfe, 0x61, 0xc0, 0x02, 0x3d, 0x87, 0x34,
-	0x0d, 0x2a, 0x41, 0x8e, 0x2f, 0xf8, 0x71, 0x32, 0x58, 0x2e, 0xd1, 0xbe, 0xb2, 0x51, 0x8a, 0x6d,
-	0x74, 0x63, 0x6a, 0xa3, 0x50, 0x4a, 0x59, 0x12, 0xd6, 0x46, 0x81, 0xf7, 0x24, 0xda, 0x0e, 0xad,
-	0xc2, 0x7c, 0x6d, 0xec, 0x76, 0xce, 0xc4, 0x71, 0xf9, 0x02, 0xad, 0x43, 0xbe, 0x41, 0x9c, 0x2e,
-	0xf1, 0xea, 0x36, 0x3b, 0x72, 0x06, 0x87, 0x6b, 0x66, 0x1f, 0xe2, 0x0d, 0xc3, 0xe8, 0x24, 0xde,
-	0x70, 0xfd, 0x7d, 0x58, 0xd4, 0x36, 0x40, 0x26, 0xa4, 0xcf, 0xc9, 0x13, 0xa1, 0x30, 0xfd, 0x49,
-	0x37, 0x7a, 0xe4, 0x0c, 0x26, 0x44, 0x58, 0x9d, 0x2f, 0xbe, 0x95, 0x7a, 0xd7, 0xb0, 0x1e, 0xc1,
-	0x92, 0x6e, 0x13, 0x74, 0x57, 0x37, 0x01, 0x13, 0x53, 0xdc, 0x29, 0x5d, 0x74, 0xb8, 0xfd, 0x3c,
-	0xb5, 0xe1, 0xd3, 0x2f, 0xaf, 0x19, 0x58, 0x37, 0xdd, 0x9b, 0x50, 0x90, 0x62, 0x6d, 0xb6, 0x6f,
-	0x06, 0x47, 0x00, 0xeb, 0x77, 0x06, 0x98, 0x22, 0xe9, 0x0f, 0x88, 0xe3, 0x05, 0xa7, 0xc4, 0x09,
-	0x5e, 0x81, 0xec, 0x2f, 0x03, 0x6a, 0x3b, 0xfe, 0xb9, 0x90, 0x5d, 0xf5, 0x88, 0x13, 0x90, 0x2e,
-	0xb3, 0x76, 0x1e, 0x27, 0x60, 0xac, 0xeb, 0xb0, 0x50, 0x6d, 0x56, 0x06, 0x03, 0xb7, 0xe3, 0x04,
-	0xa4, 0x6e, 0x53, 0x43, 0xef, 0x3b, 0x41, 0xe7, 0x4c, 0x18, 0x9f, 0x2f, 0xac, 0x1f, 0xa5, 0x60,
-	0x59, 0xa6, 0xc7, 0xec, 0xd3, 0x6e, 0x42, 0x11, 0x3b, 0x0f, 0x03, 0xfd, 0xa8, 0x2a, 0x28, 0xc1,
-	0x1e, 0xe9, 0x44, 0x7b, 0x5c, 0x87, 0xc5, 0x7b, 0xae, 0xef, 0xf7, 0xc7, 0x92, 0x2c, 0xc3, 0xc8,
-	0x74, 0xe0, 0x57, 0x4b, 0x97, 0x0b, 0xac, 0x95, 0xbd, 0xd0, 0x5a, 0x35, 0x28, 0x2a, 0x75, 0x67,
-	0x46, 0x72, 0xcd, 0x8e, 0x9d, 0xbf, 0x1b, 0x60, 0xda, 0x2f, 0x33, 0x76, 0xa2, 0x4a, 0x99, 0x7e,
-	0x9e, 0x4a, 0x99, 0x7c, 0xfc, 0xcc, 0x45, 0xc7, 0xbf, 0xb0, 0xb2, 0xce, 0xcf, 0xa8, 0xac, 0x3f,
-	0x49, 0x41, 0x1e, 0xb7, 0x1e, 0xf0, 0xe2, 0x66, 0x42, 0xba, 0xed, 0xbb, 0x32, 0xb1, 0xdb, 0xbe,
-	0x4b, 0xe3, 0xad, 0x3e, 0xea, 0x92, 0xc7, 0xc2, 0x48, 0x7c, 0x41, 0x7d, 0xdf, 0x20, 0x8e, 0x4f,
-	0x0e, 0xdc, 0x01, 0x2f, 0x23, 0xbc, 0xbe, 0xe8, 0x40, 0x64, 0xc1, 0x42, 0xdb, 0x9b, 0x8c, 0x68,
-	0xe4, 0x76, 0x1b, 0xfe, 0x48, 0xd4, 0x1a, 0x0d, 0x86, 0x3e, 0x84, 0x05, 0xce, 0xd4, 0xf7, 0x03,
-	0xd7, 0x7b, 0x22, 0x62, 0x44, 0xab, 0x74, 0x52, 0xbb, 0xb2, 0x4a, 0xc8, 0x2b, 0x9d, 0xc6, 0xbb,
-	0x7e, 0x07, 0x96, 0x63, 0x24, 0x97, 0xd5, 0xaa, 0x8c, 0x5a, 0xab, 0x3e, 0x81, 0x02, 0x0b, 0xc8,
-	0x8e, 0xeb, 0x75, 0x29, 0x23, 0x55, 0x5a, 0x30, 0x52, 0x5d, 0xb7, 0x20, 0xd3, 0x7e, 0x32, 0xe6,
-	0x7c, 0x4b, 0x3b, 0x57, 0x34, 0x1d, 0x19, 0x0f, 0xc5, 0x62, 0x46, 0x43, 0xa3, 0xc5, 0x76, 0x02,
-	0x87, 0x19, 0x66, 0x01, 0xb3, 0xdf, 0xd6, 0x67, 0x06, 0x00, 0x93, 0xff, 0xbd, 0x09, 0xf1, 0x59,
-	0x40, 0x35, 0x9d, 0x21, 0x91, 0x01, 0x45, 0x7f, 0xab, 0x11, 0x9b, 0xd2, 0x23, 0x56, 0xa8, 0x93,
-	0x8e, 0xd4, 0x29, 0x41, 0xee, 0x81, 0xf3, 0xb8, 0xd5, 0xff, 0x3e, 0x11, 0x96, 0x95, 0x4b, 0x1a,
-	0xdd, 0x32, 0xa8, 0x6c, 0x51, 0xc9, 0x23, 0x00, 0x53, 0xad, 0x59, 0xb7, 0x59, 0x1a, 0x65, 0x30,
-	0xfb, 0x6d, 0x59, 0x00, 0x6d, 0xdf, 0x95, 0x9a, 0xad, 0xc2, 0x7c, 0xd5, 0x9d, 0x8c, 0x02, 0x59,
-	0x64, 0xd8, 0xc2, 0xfa, 0x73, 0x1a, 0x72, 0x92, 0x82, 0xe5, 0x0f, 0xfb, 0x19, 0xe6, 0x56, 0x04,
-	0x40, 0x65, 0xc8, 0x3e, 0x20, 0xc1, 0x99, 0xdb, 0x4d, 0x32, 0x15, 0xc7, 0x30, 0x53, 0x09, 0x2a,
-	0xb4, 0xa7, 0xda, 0x85, 0x1d, 0xb1, 0xa8, 0xf3, 0x44, 0x58, 0x91, 0x21, 0xaa, 0x1d, 0x2b, 0xac,
-	0x9f, 0x84, 0x89, 0xca, 0x8c, 0x51, 0xdc, 0xf9, 0xbf, 0xe9, 0x7e, 0xa2, 0x65, 0x33, 0xd6, 0x58,
-	0xd0, 0x6d, 0x28, 0x56, 0x9b, 0x91, 0x84, 0x79, 0x26, 0xe1, 0x4d, 0x55, 0xc2, 0x74, 0x2b, 0xc1,
-	0x2a, 0x03, 0xe5, 0xb7, 0x15, 0xfe, 0x6c, 0x9c, 0xdf, 0x8e, 0xf1, 0x2b, 0x0c, 0xe8, 0x1d, 0xd5,
-	0xfc, 0xa5, 0x5c, 0xdc, 0x00, 0x11, 0x16, 0xab, 0x8e, 0xda, 0xd3, 0xbb, 0x43, 0x29, 0x1f, 0x6f,
-	0xa5, 0x2a, 0x1e, 0x6b, 0xd4, 0x56, 0x0b, 0x8a, 0xcc, 0x8c, 0xfe, 0xd8, 0x1d, 0xf9, 0x64, 0x46,
-	0xb5, 0x14, 0xb1, 0x97, 0xd2, 0x62, 0xaf, 0xe1, 0xf8, 0x41, 0x14, 0x91, 0x72, 0x69, 0x95, 0x01,
-	0x29, 0x1b, 0x2a, 0xb2, 0xef, 0xf6, 0x3d, 0x25, 0x5a, 0xe4, 0xd2, 0xfa, 0x57, 0x86, 0x76, 0x08,
-	0x41, 0xf6, 0x72, 0xc3, 0xea, 0x4d, 0x28, 0xd4, 0x3c, 0xcf, 0xf5, 0xaa, 0x6e, 0x97, 0x30, 0x35,
-	0x17, 0x71, 0x04, 0xa0, 0xd5, 0x89, 0x2d, 0x1e, 0x10, 0xdf, 0x77, 0x7a, 0x44, 0xb4, 0x2f, 0x0d,
-	0x46, 0x7b, 0x7e, 0xdd, 0x3f, 0xa8, 0xdc, 0x27, 0x64, 0x4c, 0x3c, 0xd1, 0xa5, 0x15, 0x08, 0xba,
-	0xa3, 0x59, 0x50, 0xf8, 0xfd, 0x6a, 0x2c, 0x72, 0x39, 0x5a, 0x84, 0xae, 0x66, 0x73, 0xea, 0x40,
-	0x77, 0x38, 0x74, 0x46, 0x5d, 0xde, 0xd5, 0x73, 0x09, 0x0e, 0x54, 0xf0, 0x58, 0xa3, 0x46, 0xef,
-	0x41, 0x91, 0x05, 0x83, 0xd8, 0x3e, 0x1f, 0xdf, 0x5e, 0x41, 0x63, 0x95, 0x16, 0xed, 0xc3, 0x52,
-	0x75, 0x30, 0xf1, 0x03, 0xe2, 0xd9, 0x84, 0x36, 0x05, 0xbf, 0x54, 0x60, 0xdc, 0x5a, 0x77, 0xd6,
-	0x29, 0xf0, 0x14, 0x07, 0xba, 0x0d, 0x85, 0x68, 0x8a, 0x03, 0xc6, 0xbe, 0xa9, 0xb2, 0x87, 0xc8,
-	0x8f, 0x26, 0xc4, 0x7b, 0x82, 0x89, 0x3f, 0x19, 0x04, 0x38, 0x62, 0x41, 0xb7, 0x01, 0x94, 0xd8,
-	0x2d, 0x32, 0x01, 0x1b, 0xaa, 0x80, 0x78, 0x20, 0x61, 0x85, 0x83, 0x19, 0xef, 0x8c, 0x74, 0xce,
-	0x89, 0xc7, 0xc7, 0xf7, 0x85, 0x04, 0xe3, 0x29, 0x78, 0xac, 0x51, 0x5b, 0x1f, 0xb2, 0x91, 0x89,
-	0x17, 0xee, 0xd0, 0x2c, 0xb7, 0x68, 0x89, 0xa3, 0x10, 0xbf, 0x64, 0xb0, 0x4e, 0xb4, 0x16, 0x73,
-	0x26, 0xc5, 0x0a, 0x57, 0x4a, 0x5a, 0xeb, 0x6b, 0x9a, 0x23, 0x68, 0xfd, 0xfc, 0x36, 0xeb, 0x30,
-	0xa2, 0x7e, 0xb2, 0x85, 0x75, 0x0f, 0x16, 0x69, 0xcf, 0x6e, 0x3b, 0xa7, 0x03, 0x72, 0xec, 0x13,
-	0x8f, 0xce, 0xe1, 0xf4, 0xef, 0x28, 0x6a, 0x02, 0xe1, 0x9a, 0xe2, 0x8e, 0x1c, 0xdf, 0xff, 0xd4,
-	0xf5, 0xba, 0x62, 0xa6, 0x08, 0xd7, 0xd6, 0x4f, 0x0d, 0xaa, 0x25, 0x1b, 0x56, 0x12, 0xa7, 0x92,
-	0x8b, 0x9b, 0x88, 0x36, 0xf6, 0xa4, 0xa7, 0xc6, 0x9e, 0xe8, 0xb6, 0x90, 0x51, 0x6f, 0x0b, 0x1b,
-	0xac, 0x38, 0xeb, 0xdd, 0x44, 0x81, 0x58, 0xbf, 0x4c, 0xd1, 0x18, 0x1e, 0x3d, 0xec, 0xf7, 0xaa,
-	0x67, 0xce, 0xa8, 0x47, 0xd0, 0x6e, 0xa8, 0x9d, 0x18, 0xed, 0x57, 0xf4, 0x4e, 0xc9, 0x50, 0x91,
-	0x05, 0xf9, 0x39, 0xf6, 0x00, 0x38, 0xbb, 0xd2, 0x61, 0xf5, 0x02, 0xac, 0x6c, 0xc1, 0xb2, 0x5c,
-	0xa1, 0x47, 0x6d, 0x58, 0xaa, 0x8f, 0xfa, 0x41, 0xdf, 0x19, 0x3c, 0x20, 0xc3, 0x53, 0xe2, 0xc9,
-	0x39, 0xeb, 0x9b, 0x17, 0x49, 0x28, 0xeb, 0xe4, 0x7c, 0x9a, 0x98, 0x92, 0xb1, 0x5e, 0x81, 0x95,
-	0x04, 0xb2, 0xe7, 0xba, 0xfd, 0x7c, 0x03, 0x16, 0x5b, 0x67, 0x93, 0xa0, 0xeb, 0x7e, 0x3a, 0xe2,
-	0x77, 0x57, 0xea, 0x1b, 0xfa, 0x23, 0x74, 0x99, 0x5c, 0x5a, 0xbf, 0x48, 0xc3, 0x6b, 0xad, 0xce,
-	0x19, 0xe9, 0x4e, 0x06, 0x44, 0x64, 0x79, 0xa2, 0x77, 0xaf, 0xc3, 0xe2, 0xbe, 0xeb, 0x06, 0x7e,
-	0xe0, 0x39, 0xe3, 0x71, 0x7f, 0xd4, 0x63, 0x9b, 0xe6, 0xb1, 0x0e, 0xa4, 0xa5, 0x41, 0x0c, 0x87,
-	0xcc, 0xa0, 0x69, 0x66, 0x50, 0xad, 0x34, 0x28, 0x68, 0xac, 0xd2, 0xf2, 0x9a, 0x14, 0x99, 0x4a,
-	0xf4, 0xd3, 0xd2, 0x45, 0xa6, 0xc4, 0xba, 0xf7, 0xef, 0x4c, 0x9d, 0x58, 0x34, 0xd3, 0xd7, 0xf5,
-	0xc2, 0xa0, 0x10, 0xe0, 0x29, 0x0b, 0xdd, 0x87, 0x65, 0x3e, 0xcf, 0x2a, 0x03, 0xae, 0xa8, 0xac,
-	0x5a, 0x4f, 0x8f, 0x11, 0xe1, 0x38, 0x1f, 0xd5, 0xc6, 0x26, 0x03, 0x12, 0x10, 0xd1, 0xbf, 0x45,
-	0x81, 0xd5, 0xb4, 0xd1, 0x08, 0xb0, 0x4e, 0x6f, 0x0d, 0x12, 0xb4, 0x41, 0xbb, 0x90, 0xa1, 0x89,
-	0x2a, 0xc2, 0x5b, 0x13, 0xa6, 0x65, 0xb8, 0x08, 0x72, 0x46, 0xcc, 0xa6, 0x61, 0xc7, 0x3f, 0xa7,
-	0x93, 0xe0, 0xa9, 0xe3, 0xcb, 0x58, 0xd1, 0x60, 0x34, 0x5c, 0xb4, 0xed, 0x67, 0x84, 0x8b, 0xa3,
-	0x77, 0x8e, 0xf0, 0xe2, 0x6e, 0x44, 0x17, 0x77, 0xf4, 0x01, 0xe4, 0x05, 0x8d, 0x7c, 0x42, 0x78,
-	0x43, 0x73, 0x83, 0x1e, 0x6d, 0xf2, 0xf6, 0x25, 0x59, 0xac, 0xdf, 0x1a, 0x74, 0x2c, 0xe2, 0x1b,
-	0xd2, 0x7a, 0x2d, 0xdf, 0x4e, 0x0c, 0xe5, 0xed, 0xe4, 0xd5, 0xbe, 0x3d, 0x7f, 0x2e, 0x5e, 0xfe,
-	0xe8, 0xdd, 0xe6, 0x03, 0xc8, 0xb2, 0xa3, 0xc8, 0xca, 0x7e, 0x6d, 0x7a, 0xbc, 0xa3, 0x57, 0x0c,
-	0x4e, 0xc1, 0xf2, 0x3c, 0xbc, 0x8b, 0x31, 0xd0, 0x3a, 0x86, 0xa2, 0x82, 0x54, 0x8b, 0x40, 0x81,
-	0x17, 0x81, 0xb7, 0xd5, 0x22, 0x30, 0xd5, 0x86, 0x15, 0x2b, 0xaa, 0xd5, 0xe1, 0x6f, 0x06, 0xbb,
-	0xaf, 0xbe, 0x14, 0x03, 0xbf, 0xc2, 0x57, 0xcc, 0xcf, 0xc5, 0x83, 0xe5, 0xa5, 0x5e, 0xb0, 0xff,
-	0xbb, 0x5e, 0xb0, 0x93, 0xbd, 0xf0, 0x1b, 0x63, 0x7a, 0x16, 0x42, 0xb7, 0x20, 0x2f, 0x88, 0xa5,
-	0x9e, 0x2b, 0x09, 0x82, 0x64, 0xc2, 0x48, 0x52, 0xca, 0x56, 0x95, 0x6c, 0xa9, 0x38, 0x5b, 0x55,
-	0x67, 0x93, 0xa4, 0xe8, 0x5d, 0x76, 0xed, 0x14, 0x7c, 0xdc, 0x7b, 0xab, 0x49, 0xb7, 0x17, 0xc1,
-	0x18, 0x11, 0x5b, 0x3f, 0xa6, 0x19, 0xca, 0x55, 0x67, 0x01, 0xf4, 0x1e, 0xd3, 0x9b, 0x87, 0x81,
-	0x21, 0xc2, 0x20, 0xcc, 0x24, 0x81, 0xd1, 0x26, 0x98, 0x90, 0x1c, 0xed, 0x71, 0x25, 0x38, 0x2f,
-	0x57, 0xbe, 0x14, 0xf1, 0x4a, 0x94, 0xc6, 0x1c, 0x31, 0x58, 0x3f, 0x33, 0x60, 0x4d, 0xf4, 0x4a,
-	0xa1, 0x8f, 0xbc, 0xa2, 0xdc, 0x80, 0xa5, 0xe6, 0x64, 0x78, 0xf8, 0x30, 0x12, 0xce, 0xa3, 0x7b,
-	0x0a, 0x4a, 0xdb, 0x1a, 0x83, 0x84, 0xfa, 0xf3, 0xd1, 0x45, 0x07, 0xa2, 0x2d, 0x30, 0x25, 0x5f,
-	0xf8, 0xac, 0xc4, 0xe7, 0x98, 0x18, 0xdc, 0xfa, 0x41, 0x8a, 0x3f, 0x34, 0xce, 0x4c, 0xaf, 0xff,
-	0xed, 0xf7, 0xb0, 0x5f, 0xa7, 0xc4, 0xb3, 0x39, 0x4d, 0xbd, 0xdb, 0x61, 0x85, 0xe0, 0xa1, 0xb1,
-	0x19, 0x8b, 0x31, 0x96, 0x7b, 0x8c, 0x44, 0xcf, 0x3d, 0x6e, 0xfb, 0xdb, 0x61, 0xea, 0xa6, 0x66,
-	0xf1, 0x5f, 0x98, 0xbb, 0x2d, 0x28, 0x2a, 0xc2, 0x13, 0xc6, 0xa8, 0xb2, 0x9e, 0xbb, 0x17, 0xbe,
-	0x08, 0x2b, 0xc9, 0xcb, 0x84, 0xce, 0x2c, 0x08, 0x97, 0x09, 0x4d, 0xaa, 0x08, 0x7f, 0x4a, 0xeb,
-	0x37, 0x8b, 0xc4, 0xc8, 0xb9, 0xa3, 0xa5, 0x5e, 0x62, 0xd5, 0x8f, 0xd0, 0xf2, 0xee, 0xa7, 0x26,
-	0xeb, 0x6e, 0x58, 0x15, 0xc5, 0x93, 0xc7, 0x4a, 0x42, 0x2d, 0x94, 0x73, 0xb2, 0xac, 0x9f, 0xef,
-	0x44, 0x0e, 0x15, 0x83, 0xd9, 0x6a, 0x92, 0x1b, 0x64, 0xe4, 0x84, 0xce, 0xdf, 0x0d, 0x1b, 0xa1,
-	0x18, 0xc8, 0x56, 0x12, 0xda, 0x9f, 0xdc, 0x4c, 0xb6, 0xcc, 0x6d, 0xfd, 0xb3, 0x94, 0x36, 0xe8,
-	0xc8, 0x3b, 0xb0, 0xf6, 0x71, 0xa4, 0x29, 0xe2, 0x53, 0x0c, 0x16, 0xe2, 0x5e, 0x96, 0x63, 0xdc,
-	0x1b, 0xd3, 0x63, 0x92, 0x4e, 0x85, 0x13, 0x38, 0x51, 0x6d, 0xea, 0xca, 0x24, 0xae, 0xb8, 0x97,
-	0x4e, 0x5c, 0x3a, 0x97, 0xf5, 0x87, 0x2c, 0x98, 0x52, 0xdf, 0xf0, 0xad, 0x33, 0xc9, 0xa7, 0x57,
-	0x20, 0xdb, 0x24, 0x8f, 0x83, 0xf0, 0xe2, 0x24, 0x56, 0xe1, 0x70, 0x95, 0x56, 0x86, 0xab, 0x6d,
-	0xfd, 0xab, 0xd1, 0x8b, 0x1a, 0x67, 0xfe, 0x85, 0x8d, 0xd3, 0x05, 0x73, 0x6a, 0x82, 0xf3, 0x4b,
-	0x59, 0x96, 0x99, 0x3b, 0x49, 0xba, 0x84, 0xcf, 0xa8, 0xd3, 0x4c, 0x6a, 0xae, 0xc6, 0x24, 0xa2,
-	0xba, 0xda, 0x17, 0x72, 0x4c, 0xfc, 0x5b, 0x33, 0xc5, 0x87, 0xd4, 0xfc, 0x52, 0x15, 0x71, 0xab,
-	0x31, 0x98, 0xff, 0x8f, 0x63, 0x50, 0xc9, 0x92, 0xc2, 0x0b, 0x65, 0x09, 0x3c, 0x47, 0x96, 0x4c,
-	0xe5, 0x74, 0xf1, 0xb9, 0x73, 0x3a, 0x16, 0xb0, 0x0b, 0x2f, 0x12, 0xb0, 0xeb, 0x9f, 0xc0, 0x5a,
-	0xa2, 0x97, 0x9e, 0xb3, 0xbe, 0x69, 0x4f, 0x47, 0x4a, 0xd1, 0xdc, 0x63, 0xdf, 0xe4, 0x2e, 0x28,
-	0xc6, 0x85, 0xcb, 0x5e, 0xc9, 0xeb, 0x50, 0x54, 0x3f, 0xe7, 0x7d, 0x85, 0xef, 0x22, 0xd6, 0xaf,
-	0x52, 0xb0, 0x9a, 0xf4, 0x4a, 0x34, 0xe3, 0x2d, 0xf2, 0x28, 0xf6, 0x59, 0xb4, 0x7c, 0xd9, 0x9b,
-	0x93, 0xfe, 0x79, 0x34, 0xd6, 0x54, 0x5f, 0xce, 0x47, 0xd2, 0xf6, 0xe5, 0x1f, 0x49, 0x67, 0xcd,
-	0xa6, 0x8a, 0x45, 0x15, 0x5b, 0x6f, 0x7d, 0x07, 0xe0, 0x78, 0xdc, 0xa5, 0xd7, 0x4f, 0x7a, 0x33,
-	0xbf, 0x0a, 0x2b, 0xda, 0x17, 0x16, 0x8e, 0x32, 0xe7, 0xd0, 0x1a, 0x2c, 0xcb, 0xaf, 0x2a, 0x8d,
-	0x56, 0x53, 0x80, 0x0d, 0xb4, 0x02, 0xaf, 0xd1, 0x70, 0x62, 0xfa, 0x08, 0x60, 0x0a, 0x2d, 0x42,
-	0xa1, 0xdd, 0x3a, 0x14, 0xcb, 0xf4, 0x56, 0x19, 0x0a, 0xe1, 0x37, 0x6e, 0xf4, 0x1a, 0x14, 0x9b,
-	0xae, 0x37, 0x74, 0x06, 0x6c, 0x69, 0xce, 0x21, 0x13, 0x16, 0xda, 0xfd, 0x21, 0x71, 0x27, 0x01,
-	0x87, 0x18, 0x5b, 0x3f, 0x4f, 0x01, 0x44, 0x6f, 0xad, 0x68, 0x09, 0xa0, 0xdd, 0x3a, 0x3c, 0x39,
-	0x3e, 0xb2, 0x2b, 0xed, 0x9a, 0x39, 0x87, 0x00, 0xb2, 0x95, 0xa3, 0xa3, 0x5a, 0xd3, 0x36, 0x0d,
-	0x94, 0x87, 0x0c, 0xae, 0x55, 0x6c, 0x33, 0x85, 0x16, 0x20, 0xdf, 0xc6, 0xc7, 0xcd, 0x2a, 0xa5,
-	0x49, 0x53, 0xa1, 0xf7, 0x6a, 0xed, 0x93, 0x10, 0x92, 0x41, 0x45, 0xc8, 0x55, 0x0f, 0x9b, 0xcd,
-	0x5a, 0xb5, 0x6d, 0xce, 0x53, 0x91, 0x62, 0x71, 0x82, 0x0f, 0xcd, 0x2c, 0x5a, 0x86, 0xc5, 0xc6,
-	0xe1, 0xbd, 0x93, 0x83, 0x5a, 0x05, 0xb7, 0xf7, 0x6b, 0x95, 0xb6, 0x99, 0xa3, 0x12, 0xaa, 0x4d,
-	0x05, 0x92, 0xa7, 0x10, 0x5b, 0x85, 0x14, 0x10, 0x82, 0xa5, 0xea, 0x41, 0xad, 0x7a, 0xff, 0xe4,
-	0xa0, 0x72, 0xbf, 0x56, 0x3b, 0xaa, 0x61, 0x13, 0xa8, 0x01, 0xe9, 0xce, 0xd5, 0xc6, 0x71, 0xab,
-	0x5d, 0xc3, 0x27, 0x76, 0xad, 0x5d, 0xa9, 0x37, 0x5a, 0x66, 0x91, 0x12, 0x53, 0x44, 0xeb, 0xa0,
-	0x82, 0xed, 0x93, 0x7a, 0xf3, 0xee, 0xa1, 0xb9, 0xc0, 0x04, 0x34, 0x4f, 0x2a, 0x8d, 0xc6, 0x21,
-	0xd5, 0xf2, 0xa4, 0x6e, 0x9b, 0x8b, 0xd4, 0xd0, 0xaa, 0x80, 0x56, 0x9b, 0xea, 0xbf, 0xb4, 0xd5,
-	0x04, 0x88, 0xbe, 0x00, 0xd1, 0x03, 0x50, 0xb3, 0x73, 0x88, 0x39, 0x47, 0x4f, 0x5f, 0x1f, 0x05,
-	0xc4, 0x1b, 0x39, 0x03, 0xd3, 0xa0, 0x36, 0x66, 0x4e, 0x0c, 0x1d, 0xb2, 0x2c, 0x3e, 0xa6, 0x61,
-	0xf2, 0x5d, 0xd2, 0x09, 0x48, 0xd7, 0x4c, 0x6f, 0xfd, 0x30, 0x05, 0x48, 0x16, 0x56, 0xc5, 0xff,
-	0xd4, 0xd8, 0xfd, 0xce, 0xb9, 0xea, 0x76, 0xe5, 0x2b, 0x44, 0xe8, 0xf6, 0x35, 0x58, 0xb6, 0x63,
-	0xe0, 0x14, 0xba, 0x02, 0x48, 0xfd, 0xe8, 0x21, 0x23, 0x80, 0x2a, 0x74, 0x8f, 0x04, 0x61, 0x34,
-	0x65, 0xd0, 0xeb, 0xb1, 0xea, 0x23, 0x50, 0xf3, 0xd4, 0x26, 0x2d, 0xc2, 0x63, 0x41, 0xc0, 0xb2,
-	0xa8, 0x04, 0xab, 0xfa, 0xe8, 0x2f, 0x30, 0x39, 0x74, 0x0d, 0xde, 0x68, 0x91, 0x20, 0xde, 0xba,
-	0x04, 0x41, 0x1e, 0xad, 0xc3, 0x15, 0x41, 0x10, 0xd6, 0x3e, 0x81, 0x2b, 0x6c, 0x7d, 0x66, 0xc0,
-	0xa2, 0xd6, 0x47, 0x69, 0x38, 0x4b, 0x80, 0x18, 0x70, 0xcd, 0x39, 0xaa, 0xac, 0x04, 0x6a, 0x2f,
-	0x60, 0xa6, 0x81, 0xbe, 0x0e, 0xff, 0x1f, 0x43, 0xc9, 0x72, 0x8a, 0x49, 0x87, 0xf4, 0x1f, 0x91,
-	0xae, 0x99, 0x42, 0x6f, 0xc0, 0xd5, 0x18, 0xd9, 0x5d, 0xa7, 0x3f, 0xa0, 0x9e, 0x50, 0xf7, 0xc4,
-	0x93, 0xd1, 0x88, 0x0a, 0xce, 0x6c, 0x9d, 0x26, 0x75, 0x72, 0x6a, 0x07, 0x0d, 0x1a, 0xe9, 0x38,
-	0x8d, 0x91, 0x92, 0x8c, 0x18, 0xa6, 0x15, 0xb8, 0xe3, 0x31, 0xd5, 0x6a, 0xeb, 0x0c, 0xcc, 0xe9,
-	0x27, 0x4f, 0xea, 0xff, 0x4a, 0xb7, 0x2b, 0x4a, 0x85, 0x39, 0x47, 0x23, 0x07, 0x93, 0xa1, 0xfb,
-	0x88, 0x48, 0x90, 0x41, 0xf3, 0xa0, 0x15, 0x38, 0x5e, 0x20, 0x21, 0x29, 0xea, 0x5e, 0x2a, 0x55,
-	0x02, 0xd2, 0x54, 0xca, 0xfd, 0xfe, 0x60, 0xf0, 0xb1, 0x3b, 0x3c, 0xed, 0x13, 0x33, 0xb3, 0xf5,
-	0xbe, 0xf6, 0x54, 0x48, 0xd1, 0xb4, 0x39, 0x70, 0x88, 0x39, 0x47, 0xeb, 0x85, 0xdd, 0x94, 0x4b,
-	0x83, 0x2e, 0xab, 0xe1, 0x32, 0xb5, 0x5f, 0x7b, 0xfa, 0xd7, 0x8d, 0xb9, 0x2f, 0x9e, 0x6d, 0x18,
-	0x4f, 0x9f, 0x6d, 0x18, 0x7f, 0x79, 0xb6, 0x61, 0x7c, 0xbc, 0xab, 0xfc, 0xb3, 0xd6, 0xd0, 0x09,
-	0xbc, 0xfe, 0x63, 0xd7, 0xeb, 0xf7, 0xfa, 0x23, 0xb9, 0x18, 0x91, 0xed, 0xf1, 0x79, 0x6f, 0x7b,
-	0x7c, 0xba, 0x1d, 0x95, 0xbf, 0xd3, 0x2c, 0xfb, 0x4f, 0xad, 0xdd, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x9a, 0x14, 0xdf, 0xf7, 0x08, 0x26, 0x00, 0x00,
+	// 2750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4b, 0x6f, 0x1b, 0xc7,
+	0x59, 0xbb, 0xa4, 0x48, 0xea, 0xa3, 0xc4, 0xac, 0xc6, 0xb2, 0xcd, 0x28, 0xa9, 0xac, 0x6e, 0xdd,
+	0xc0, 0x55, 0x1a, 0x0a, 0x90, 0x91, 0x20, 0x69, 0x1c, 0x1b, 0x14, 0x97, 0xb6, 0x18, 0xd3, 0x2b,
+	0x67, 0x48, 0xf5, 0x10, 0x20, 0x50, 0x57, 0xe4, 0x98, 0x62, 0x45, 0x72, 0xd9, 0xdd, 0xa5, 0x63,
+	0xf7, 0xd4, 0x1e, 0x5a, 0xa0, 0xe8, 0xa9, 0x8f, 0x43, 0x5a, 0x14, 0x05, 0xfa, 0x1f, 0x7a, 0xe8,
+	0xad, 0x97, 0x16, 0x08, 0xd0, 0x07, 0xf2, 0x0b, 0x82, 0x36, 0x7f, 0xa0, 0xb7, 0x9e, 0x8b, 0x79,
+	0xed, 0xce, 0x70, 0x97, 0x72, 0xec, 0xb8, 0x40, 0x90, 0x93, 0x38, 0xdf, 0x6b, 0xbe, 0xf9, 0xde,
+	0x33, 0x2b, 0xb0, 0x46, 0xfe, 0x20, 0x24, 0xc1, 0xc3, 0x61, 0x8f, 0xd4, 0xa6, 0x81, 0x1f, 0xf9,
+	0x08, 0x12, 0xc8, 0xe6, 0x6b, 0x83, 0x61, 0x74, 0x3a, 0x3b, 0xa9, 0xf5, 0xfc, 0xf1, 0xee, 0xc0,
+	0x1f, 0xf8, 0xbb, 0x8c, 0xe4, 0x64, 0xf6, 0x80, 0xad, 0xd8, 0x82, 0xfd, 0xe2, 0xac, 0x9b, 0x95,
+	0x31, 0x89, 0xbc, 0xbe, 0x17, 0x79, 0x7c, 0x6d, 0xff, 0xdd, 0x80, 0x62, 0xc3, 0xed, 0x44, 0x7e,
+	0x40, 0x10, 0x82, 0xfc, 0xd1, 0x51, 0xcb, 0xa9, 0x1a, 0xdb, 0xc6, 0xb5, 0x15, 0xcc, 0x7e, 0xa3,
+	0x57, 0xa0, 0xd2, 0xe1, 0x3b, 0xd5, 0xfb, 0xfd, 0x80, 0x84, 0x61, 0xd5, 0x64, 0xd8, 0x39, 0x28,
+	0xda, 0x02, 0xe8, 0xbc, 0xd7, 0x96, 0x34, 0x39, 0x46, 0xa3, 0x40, 0xd0, 0x55, 0xc8, 0x63, 0x7f,
+	0x44, 0xaa, 0xf9, 0x6d, 0xe3, 0x5a, 0x65, 0xcf, 0xaa, 0xc5, 0x6a, 0x34, 0x5c, 0x0a, 0xc7, 0x0c,
+	0x4b, 0x35, 0xe8, 0x0e, 0x7b, 0x67, 0xd5, 0xe5, 0x6d, 0xe3, 0x5a, 0x1e, 0xb3, 0xdf, 0xe8, 0x55,
+	0x58, 0xee, 0x44, 0x5e, 0x44, 0xaa, 0x05, 0xc6, 0x7a, 0xb1, 0xa6, 0x98, 0xc3, 0xf5, 0xfb, 0x84,
+	0x21, 0x31, 0xa7, 0xb1, 0xff, 0x60, 0x42, 0xd1, 0x79, 0x0e, 0xc7, 0x91, 0x8a, 0xe4, 0xb2, 0x14,
+	0xc9, 0x3f, 0x59, 0x11, 0xf4, 0x3a, 0x14, 0x3a, 0xa7, 0x5e, 0xd0, 0x0f, 0xab, 0xcb, 0xdb, 0xb9,
+	0x6b, 0xe5, 0xbd, 0xcb, 0x2a, 0xb5, 0xe3, 0x32, 0x5c, 0x6b, 0xf2, 0xc0, 0xdf, 0xcf, 0x7f, 0xfc,
+	0xe9, 0x95, 0x25, 0x2c, 0x88, 0xd1, 0x1e, 0x6c, 0xb4, 0xfd, 0x41, 0xe4, 0x0d, 0x47, 0x54, 0x21,
+	0x12, 0x48, 0x2d, 0x0b, 0x4c, 0xcb, 0x4c, 0x1c, 0xaa, 0x01, 0x6a, 0xfb, 0xbd, 0xb3, 0xb9, 0x73,
+	0x15, 0x19, 0x47, 0x06, 0xc6, 0xfe, 0xab, 0x01, 0xa5, 0xb6, 0x3f, 0xf8, 0x12, 0x18, 0xe9, 0x06,
+	0x94, 0x30, 0x99, 0x8e, 0x86, 0x3d, 0x4f, 0x9a, 0x69, 0x53, 0xa5, 0x6f, 0xfb, 0x03, 0x81, 0x56,
+	0x2c, 0x15, 0x73, 0xd8, 0xff, 0x31, 0x60, 0x95, 0x9e, 0x43, 0x9a, 0x12, 0x55, 0xa1, 0xc8, 0x17,
+	0xfc, 0x38, 0x79, 0x2c, 0x97, 0x68, 0x5f, 0xd9, 0xc8, 0x64, 0x1b, 0xbd, 0x32, 0xb7, 0x51, 0x2c,
+	0xa5, 0x26, 0x09, 0x9b, 0x93, 0x28, 0x78, 0x9c, 0x6c, 0x87, 0x36, 0x60, 0xb9, 0x39, 0xf5, 0x7b,
+	0xa7, 0xe2, 0xb8, 0x7c, 0x81, 0x36, 0xa1, 0xd4, 0x26, 0x5e, 0x9f, 0x04, 0x2d, 0x87, 0x1d, 0x39,
+	0x8f, 0xe3, 0x35, 0xb3, 0x0f, 0x09, 0xc6, 0x71, 0x34, 0x93, 0x60, 0xbc, 0xf9, 0x36, 0xac, 0x69,
+	0x1b, 0x20, 0x0b, 0x72, 0x67, 0xe4, 0xb1, 0x50, 0x98, 0xfe, 0xa4, 0x1b, 0x3d, 0xf4, 0x46, 0x33,
+	0x22, 0xac, 0xce, 0x17, 0xdf, 0x31, 0xdf, 0x34, 0xec, 0x87, 0x50, 0xd1, 0x6d, 0x82, 0x6e, 0xeb,
+	0x26, 0x60, 0x62, 0xca, 0x7b, 0xd5, 0x45, 0x87, 0xdb, 0x2f, 0x51, 0x1b, 0x7e, 0xf2, 0xe9, 0x15,
+	0x03, 0xeb, 0xa6, 0x7b, 0x19, 0x56, 0xa4, 0x58, 0x87, 0xed, 0x9b, 0xc7, 0x09, 0xc0, 0xfe, 0x8b,
+	0x01, 0x96, 0x28, 0x12, 0x07, 0xc4, 0x0b, 0xa2, 0x13, 0xe2, 0x45, 0x5f, 0x82, 0x6a, 0x51, 0x03,
+	0xd4, 0xf5, 0x42, 0x19, 0xde, 0x8d, 0x80, 0x78, 0x11, 0xe9, 0x33, 0x6b, 0x97, 0x70, 0x06, 0xc6,
+	0xbe, 0x0a, 0xab, 0x0d, 0xb7, 0x3e, 0x1a, 0xf9, 0x3d, 0x2f, 0x22, 0x2d, 0x87, 0x1a, 0x7a, 0xdf,
+	0x8b, 0x7a, 0xa7, 0xc2, 0xf8, 0x7c, 0x61, 0xff, 0xc4, 0x84, 0x75, 0x99, 0x1e, 0xe7, 0x9f, 0x76,
+	0x1b, 0xca, 0xd8, 0x7b, 0x10, 0xe9, 0x47, 0x55, 0x41, 0x19, 0xf6, 0xc8, 0x65, 0xda, 0xe3, 0x2a,
+	0xac, 0xdd, 0xf1, 0xc3, 0x70, 0x38, 0x95, 0x64, 0x79, 0x46, 0xa6, 0x03, 0xbf, 0x58, 0xba, 0x2c,
+	0xb0, 0x56, 0x61, 0xa1, 0xb5, 0x9a, 0x50, 0x76, 0xdc, 0xcf, 0x93, 0x5c, 0xe7, 0xc7, 0xce, 0x6f,
+	0x4c, 0xb0, 0x9c, 0xe7, 0x19, 0x3b, 0x49, 0x65, 0xcd, 0x3d, 0x4d, 0x65, 0xcd, 0x3e, 0x7e, 0x7e,
+	0xd1, 0xf1, 0x17, 0x56, 0xe2, 0xe5, 0xa7, 0xae, 0xc4, 0x85, 0x85, 0x95, 0xf8, 0x67, 0x26, 0x94,
+	0x70, 0xe7, 0x1e, 0x2f, 0x86, 0x16, 0xe4, 0xba, 0xa1, 0x2f, 0x0b, 0x41, 0x37, 0xf4, 0x69, 0x7c,
+	0xb6, 0x26, 0x7d, 0xf2, 0x48, 0x18, 0x95, 0x2f, 0x68, 0xac, 0xb4, 0x89, 0x17, 0x92, 0x03, 0x7f,
+	0xc4, 0xcb, 0x0e, 0xaf, 0x47, 0x3a, 0x10, 0xd9, 0xb0, 0xda, 0x0d, 0x66, 0x13, 0x1a, 0xe9, 0xfd,
+	0x76, 0x38, 0x11, 0xb5, 0x49, 0x83, 0xa1, 0x77, 0x61, 0x95, 0x33, 0x0d, 0xc3, 0xc8, 0x0f, 0x1e,
+	0x57, 0x97, 0xd3, 0x95, 0x51, 0x6a, 0x57, 0x53, 0x09, 0x79, 0x65, 0xd4, 0x78, 0x37, 0x6f, 0xc1,
+	0x7a, 0x8a, 0xe4, 0x49, 0xb5, 0x2d, 0xaf, 0xd6, 0xb6, 0x0f, 0x60, 0x85, 0x05, 0x70, 0xcf, 0x0f,
+	0xfa, 0x94, 0x91, 0x2a, 0x2d, 0x18, 0xa9, 0xae, 0x3b, 0x90, 0xef, 0x3e, 0x9e, 0x72, 0xbe, 0xca,
+	0xde, 0x25, 0x4d, 0x47, 0xc6, 0x43, 0xb1, 0x98, 0xd1, 0xd0, 0xe8, 0x72, 0xbc, 0xc8, 0x63, 0x86,
+	0x59, 0xc5, 0xec, 0xb7, 0xfd, 0x91, 0x01, 0xc0, 0xe4, 0xff, 0x60, 0x46, 0x42, 0x16, 0x80, 0xae,
+	0x37, 0x26, 0x32, 0x00, 0xe9, 0x6f, 0x35, 0xc2, 0x4d, 0x3d, 0xc2, 0x85, 0x3a, 0xb9, 0x44, 0x9d,
+	0x2a, 0x14, 0xef, 0x79, 0x8f, 0x3a, 0xc3, 0x1f, 0x12, 0x61, 0x59, 0xb9, 0xa4, 0xd9, 0x20, 0x83,
+	0xd0, 0x11, 0x95, 0x3f, 0x01, 0x30, 0xd5, 0xdc, 0x96, 0xc3, 0x62, 0x22, 0x8f, 0xd9, 0x6f, 0xdb,
+	0x06, 0xe8, 0x86, 0xbe, 0xd4, 0x6c, 0x03, 0x96, 0x1b, 0xfe, 0x6c, 0x12, 0xc9, 0xa2, 0xc4, 0x16,
+	0xf6, 0x3f, 0x73, 0x50, 0x94, 0x14, 0x2c, 0xdf, 0xd8, 0xcf, 0x38, 0x17, 0x13, 0x00, 0xaa, 0x41,
+	0xe1, 0x1e, 0x89, 0x4e, 0xfd, 0x7e, 0x96, 0xa9, 0x38, 0x86, 0x99, 0x4a, 0x50, 0xa1, 0x1b, 0xaa,
+	0x5d, 0xd8, 0x11, 0xcb, 0x3a, 0x4f, 0x82, 0x15, 0x19, 0xa5, 0xda, 0xb1, 0xce, 0xfa, 0x4f, 0x9c,
+	0xd8, 0xcc, 0x18, 0xe5, 0xbd, 0xaf, 0xcd, 0xf7, 0x1f, 0x2d, 0xfb, 0xb1, 0xc6, 0x82, 0x6e, 0x42,
+	0xb9, 0xe1, 0x26, 0x12, 0x96, 0x99, 0x84, 0x97, 0x55, 0x09, 0xf3, 0xad, 0x07, 0xab, 0x0c, 0x94,
+	0xdf, 0x51, 0xf8, 0x0b, 0x69, 0x7e, 0x27, 0xc5, 0xaf, 0x30, 0xa0, 0x37, 0x54, 0xf3, 0x57, 0x8b,
+	0x69, 0x03, 0x24, 0x58, 0xac, 0x3a, 0xea, 0x86, 0xde, 0x4d, 0xaa, 0xa5, 0x74, 0xeb, 0x55, 0xf1,
+	0x58, 0xa3, 0xb6, 0x3b, 0x50, 0x66, 0x66, 0x0c, 0xa7, 0xfe, 0x24, 0x24, 0xe7, 0x54, 0x57, 0x11,
+	0x7b, 0xa6, 0x16, 0x7b, 0x6d, 0x2f, 0x8c, 0x92, 0x88, 0x94, 0x4b, 0xbb, 0x06, 0x48, 0xd9, 0x50,
+	0x91, 0x7d, 0x7b, 0x18, 0x28, 0xd1, 0x22, 0x97, 0xf6, 0x7f, 0xf3, 0x50, 0x8a, 0xc9, 0x9e, 0x6f,
+	0x58, 0xbd, 0x0c, 0x2b, 0xcd, 0x20, 0xf0, 0x83, 0x86, 0xdf, 0x27, 0x4c, 0xcd, 0x35, 0x9c, 0x00,
+	0x68, 0x75, 0x62, 0x8b, 0x7b, 0x24, 0x0c, 0xbd, 0x01, 0x11, 0xed, 0x4e, 0x83, 0xd1, 0x19, 0xa1,
+	0x15, 0x1e, 0xd4, 0xef, 0x12, 0x32, 0x25, 0x81, 0xe8, 0xea, 0x0a, 0x04, 0xdd, 0xd2, 0x2c, 0x28,
+	0xfc, 0x7e, 0x39, 0x15, 0xb9, 0x1c, 0x2d, 0x42, 0x57, 0xb3, 0x39, 0x75, 0xa0, 0x3f, 0x1e, 0x7b,
+	0x93, 0x3e, 0x9f, 0x02, 0x8a, 0x19, 0x0e, 0x54, 0xf0, 0x58, 0xa3, 0x46, 0x6f, 0x41, 0x99, 0x05,
+	0x83, 0xd8, 0xbe, 0x94, 0xde, 0x5e, 0x41, 0x63, 0x95, 0x16, 0xed, 0x43, 0xa5, 0x31, 0x9a, 0x85,
+	0x11, 0x09, 0x1c, 0x42, 0x9b, 0x48, 0x58, 0x5d, 0xd9, 0x36, 0xe6, 0xbb, 0xb9, 0x4e, 0x81, 0xe7,
+	0x38, 0xd0, 0x4d, 0x58, 0x49, 0xa6, 0x3e, 0x60, 0xec, 0xdb, 0x2a, 0x7b, 0x8c, 0x7c, 0x6f, 0x46,
+	0x82, 0xc7, 0x98, 0x84, 0xb3, 0x51, 0x84, 0x13, 0x16, 0x74, 0x13, 0x40, 0x89, 0xdd, 0x32, 0x13,
+	0xb0, 0xa5, 0x0a, 0x48, 0x07, 0x12, 0x56, 0x38, 0x98, 0xf1, 0x4e, 0x49, 0xef, 0x8c, 0x04, 0x7c,
+	0xdc, 0x5f, 0xcd, 0x30, 0x9e, 0x82, 0xc7, 0x1a, 0xb5, 0xfd, 0x2e, 0x1b, 0xb1, 0x78, 0xe1, 0x8e,
+	0xcd, 0xf2, 0x3a, 0x14, 0x39, 0x24, 0xac, 0x1a, 0xac, 0x13, 0x5d, 0x4c, 0x39, 0x93, 0x62, 0x85,
+	0x2b, 0x25, 0xad, 0xfd, 0x0d, 0xcd, 0x11, 0xb4, 0x7e, 0x7e, 0x97, 0x75, 0x18, 0x51, 0x3f, 0xd9,
+	0xc2, 0xbe, 0x03, 0x6b, 0xb4, 0xc7, 0x77, 0xbd, 0x93, 0x11, 0x39, 0x0a, 0x49, 0x40, 0xe7, 0x76,
+	0xfa, 0x77, 0x92, 0x34, 0x81, 0x78, 0x4d, 0x71, 0xf7, 0xbd, 0x30, 0xfc, 0xd0, 0x0f, 0xfa, 0x62,
+	0x06, 0x89, 0xd7, 0xf6, 0xcf, 0x0d, 0x28, 0x8a, 0xe1, 0x26, 0x73, 0x8a, 0x59, 0xdc, 0x44, 0xb4,
+	0x31, 0x29, 0x37, 0x37, 0x26, 0x25, 0xb7, 0x8b, 0xbc, 0x7a, 0xbb, 0xd8, 0x62, 0xc5, 0x59, 0xef,
+	0x26, 0x0a, 0xc4, 0xfe, 0xad, 0x49, 0x63, 0x78, 0xf2, 0x60, 0x38, 0x68, 0x9c, 0x7a, 0x93, 0x01,
+	0x41, 0xd7, 0x63, 0xed, 0xc4, 0x55, 0xe0, 0x82, 0xde, 0x29, 0x19, 0x2a, 0xb1, 0x20, 0x3f, 0xc7,
+	0x0d, 0x00, 0xce, 0xae, 0x74, 0x58, 0xbd, 0x00, 0x2b, 0x5b, 0xb0, 0x2c, 0x57, 0xe8, 0x51, 0x17,
+	0x2a, 0xad, 0xc9, 0x30, 0x1a, 0x7a, 0xa3, 0x7b, 0x64, 0x7c, 0x42, 0x02, 0x39, 0x97, 0x7d, 0x7b,
+	0x91, 0x84, 0x9a, 0x4e, 0xce, 0xa7, 0x89, 0x39, 0x19, 0x9b, 0x75, 0xb8, 0x90, 0x41, 0xf6, 0x54,
+	0xb7, 0xa5, 0x6f, 0xc1, 0x5a, 0xe7, 0x74, 0x16, 0xf5, 0xfd, 0x0f, 0x27, 0xfc, 0xae, 0x4b, 0x7d,
+	0x43, 0x7f, 0xc4, 0x2e, 0x93, 0x4b, 0xfb, 0x57, 0x39, 0x78, 0xa1, 0xd3, 0x3b, 0x25, 0xfd, 0xd9,
+	0x88, 0x88, 0x2c, 0xcf, 0xf4, 0xee, 0x55, 0x58, 0xdb, 0xf7, 0xfd, 0x28, 0x8c, 0x02, 0x6f, 0x3a,
+	0x1d, 0x4e, 0x06, 0x6c, 0xd3, 0x12, 0xd6, 0x81, 0xb4, 0x34, 0x88, 0x41, 0x8f, 0x19, 0x34, 0xc7,
+	0x0c, 0xaa, 0x95, 0x06, 0x05, 0x8d, 0x55, 0x5a, 0x5e, 0x93, 0x12, 0x53, 0x55, 0xf3, 0x19, 0x69,
+	0xa5, 0xe0, 0xb1, 0xee, 0xfd, 0x5b, 0x73, 0x27, 0x16, 0xcd, 0xf4, 0x45, 0xbd, 0x30, 0x28, 0x04,
+	0x78, 0xce, 0x42, 0x77, 0x61, 0x9d, 0xcf, 0xbf, 0xca, 0x40, 0x5c, 0x2d, 0xa4, 0x7b, 0x7a, 0x8a,
+	0x08, 0xa7, 0xf9, 0xa8, 0x36, 0x0e, 0x19, 0x91, 0x88, 0x88, 0xfe, 0x5d, 0x2d, 0xa6, 0xb5, 0xd1,
+	0x08, 0xb0, 0x4e, 0x6f, 0x8f, 0x32, 0xb4, 0x41, 0xd7, 0x21, 0x4f, 0x13, 0xb5, 0x6a, 0xa4, 0x85,
+	0x69, 0x19, 0x2e, 0x82, 0x9c, 0x11, 0xb3, 0x69, 0xd8, 0x0b, 0xcf, 0xe8, 0x24, 0x78, 0xe2, 0x85,
+	0x32, 0x56, 0x34, 0x18, 0x0d, 0x17, 0x6d, 0xfb, 0x73, 0xc2, 0xc5, 0xd3, 0x3b, 0x47, 0x7c, 0xd1,
+	0x37, 0x92, 0x8b, 0x3e, 0x7a, 0x07, 0x4a, 0x82, 0x46, 0x3e, 0x39, 0xbc, 0xa4, 0xb9, 0x41, 0x8f,
+	0x36, 0x79, 0x5b, 0x93, 0x2c, 0xf6, 0x9f, 0x0d, 0x3a, 0x16, 0xf1, 0x0d, 0x69, 0xbd, 0x96, 0x6f,
+	0x2d, 0x86, 0xf2, 0xd6, 0xf2, 0xe5, 0xbe, 0x6d, 0xff, 0x4e, 0xbc, 0x2c, 0xd2, 0xbb, 0xcd, 0x3b,
+	0x50, 0x60, 0x47, 0x91, 0x95, 0xfd, 0xca, 0xfc, 0x78, 0x47, 0xaf, 0x18, 0x9c, 0x82, 0xe5, 0x79,
+	0x7c, 0x77, 0x63, 0xa0, 0x4d, 0x0c, 0x65, 0x05, 0xa9, 0x16, 0x81, 0x15, 0x5e, 0x04, 0x5e, 0x53,
+	0x8b, 0xc0, 0x5c, 0x1b, 0x56, 0xac, 0xa8, 0x56, 0x87, 0x5f, 0x9b, 0xec, 0x7e, 0xfb, 0x5c, 0x0c,
+	0xfc, 0x15, 0xba, 0x92, 0x52, 0xaf, 0x39, 0x9f, 0xc7, 0x6b, 0xce, 0xff, 0xd7, 0x6b, 0x4e, 0xb6,
+	0xd7, 0xfe, 0x64, 0xcc, 0xcf, 0x4e, 0xe8, 0x75, 0x28, 0x39, 0xae, 0xa6, 0xe7, 0x85, 0x0c, 0x41,
+	0x32, 0xc1, 0x24, 0x29, 0x65, 0x6b, 0x48, 0x36, 0x33, 0xcd, 0xd6, 0xd0, 0xd9, 0x24, 0x29, 0x7a,
+	0x93, 0x5d, 0x53, 0x05, 0x1f, 0xf7, 0xf6, 0x46, 0xd6, 0x6d, 0x47, 0x30, 0x26, 0xc4, 0xf6, 0x4f,
+	0x69, 0x46, 0x73, 0xd5, 0x59, 0xc0, 0xbd, 0xc5, 0xf4, 0xe6, 0x61, 0x63, 0x88, 0xb0, 0x89, 0x33,
+	0x4f, 0x60, 0xb4, 0x89, 0x27, 0x26, 0x47, 0x37, 0xb8, 0x12, 0x9c, 0x97, 0x2b, 0x5f, 0x4d, 0x78,
+	0x25, 0x4a, 0x63, 0x4e, 0x18, 0xec, 0x5f, 0x18, 0x70, 0x51, 0xf4, 0x56, 0xa1, 0x8f, 0xbc, 0xd2,
+	0xbc, 0x02, 0x15, 0x77, 0x36, 0x3e, 0x7c, 0x90, 0x08, 0xe7, 0xd9, 0x30, 0x07, 0xa5, 0x6d, 0x90,
+	0x41, 0x62, 0xfd, 0xf9, 0xa8, 0xa3, 0x03, 0xd1, 0x0e, 0x58, 0x92, 0x2f, 0x7e, 0xb6, 0xe2, 0x73,
+	0x4f, 0x0a, 0x6e, 0xff, 0xc8, 0x84, 0x55, 0x69, 0xaa, 0x85, 0xe9, 0xf8, 0xd5, 0x7e, 0x6f, 0xfb,
+	0xa3, 0x29, 0x9e, 0xe5, 0x69, 0xea, 0xdd, 0x84, 0x82, 0x16, 0x1a, 0xdb, 0xa9, 0x18, 0x63, 0xb9,
+	0xc7, 0x48, 0xf4, 0xdc, 0xe3, 0xb6, 0xbf, 0x19, 0xa7, 0xae, 0x79, 0x1e, 0xff, 0xc2, 0xdc, 0xed,
+	0x40, 0x59, 0x11, 0x9e, 0x31, 0x76, 0xd5, 0xf4, 0xdc, 0x5d, 0xf8, 0xe2, 0xac, 0x24, 0x2f, 0x13,
+	0x7a, 0x6e, 0x41, 0x78, 0x92, 0xd0, 0xac, 0x8a, 0xf0, 0x8f, 0x9c, 0x7e, 0x13, 0xc9, 0x8c, 0x9c,
+	0x5b, 0x5a, 0xea, 0x65, 0x76, 0x89, 0x04, 0x2d, 0xef, 0x8a, 0x0a, 0x88, 0xce, 0xd5, 0xa2, 0xe0,
+	0x89, 0x27, 0x92, 0x0b, 0x19, 0xb5, 0x50, 0xce, 0xd5, 0x62, 0x89, 0xde, 0x48, 0x1c, 0x2a, 0x06,
+	0xb9, 0x8d, 0x2c, 0x37, 0xc8, 0xc8, 0x89, 0x9d, 0x7f, 0x3d, 0x6e, 0x9c, 0xd5, 0xe5, 0xf4, 0x66,
+	0x0d, 0x7d, 0x33, 0xb1, 0x44, 0xbb, 0xfa, 0x67, 0x32, 0x6d, 0x30, 0x92, 0x77, 0x66, 0xed, 0xe3,
+	0x8b, 0x2b, 0xe2, 0x53, 0x0c, 0x22, 0x1c, 0xc9, 0x66, 0xb4, 0x8a, 0x7e, 0x13, 0x4c, 0x53, 0xe1,
+	0x0c, 0x4e, 0xd4, 0x9c, 0xbb, 0x62, 0x89, 0x2b, 0xf1, 0x13, 0x27, 0x34, 0x9d, 0xcb, 0xfe, 0x5b,
+	0x01, 0x2c, 0xa9, 0x6f, 0xfc, 0x36, 0x9a, 0xe5, 0xd3, 0x4b, 0x50, 0x70, 0xc9, 0xa3, 0x28, 0xbe,
+	0x68, 0x89, 0x55, 0x3c, 0x8c, 0xe5, 0x94, 0x61, 0x6c, 0x57, 0xff, 0x2a, 0xf5, 0xac, 0xc6, 0x59,
+	0x7e, 0x66, 0xe3, 0xf4, 0xc1, 0x9a, 0x9b, 0xf8, 0x68, 0x13, 0xa6, 0x99, 0xb9, 0x97, 0xa5, 0x4b,
+	0xfc, 0xec, 0x3a, 0xcf, 0xa4, 0xe6, 0x6a, 0x4a, 0x22, 0x6a, 0xa9, 0x7d, 0xa1, 0xc8, 0xc4, 0xbf,
+	0x7a, 0xae, 0xf8, 0x98, 0x9a, 0xc9, 0x55, 0x9a, 0x84, 0x1a, 0x83, 0xa5, 0xcf, 0x1d, 0x83, 0x4a,
+	0x96, 0xac, 0x3c, 0x53, 0x96, 0xc0, 0x53, 0x64, 0xc9, 0x5c, 0x4e, 0x97, 0x9f, 0x3a, 0xa7, 0x53,
+	0x01, 0xbb, 0xfa, 0x2c, 0x01, 0xbb, 0xf9, 0x01, 0x5c, 0xcc, 0xf4, 0xd2, 0x53, 0xd6, 0x37, 0xed,
+	0xa9, 0x49, 0x29, 0x9a, 0x37, 0xa0, 0x12, 0x7b, 0x65, 0x91, 0xdc, 0xc5, 0xaf, 0xea, 0x2d, 0x28,
+	0xab, 0x9f, 0x0b, 0xbf, 0xc0, 0x77, 0x17, 0xfb, 0xf7, 0x26, 0x6c, 0x64, 0xbd, 0x2a, 0x9d, 0xf3,
+	0x76, 0x79, 0x3f, 0xf5, 0xd9, 0xb5, 0xf6, 0xa4, 0x37, 0x2a, 0xfd, 0xf3, 0x6b, 0xaa, 0xa9, 0x3e,
+	0x9f, 0x8f, 0xb0, 0xdd, 0x27, 0x7f, 0x84, 0x3d, 0x6f, 0x36, 0x55, 0x2c, 0xaa, 0xd8, 0x7a, 0xe7,
+	0x7b, 0x00, 0x47, 0xd3, 0xbe, 0x17, 0xf1, 0x9b, 0xfc, 0x65, 0xb8, 0xa0, 0x7d, 0x91, 0xe1, 0x28,
+	0x6b, 0x09, 0x5d, 0x84, 0x75, 0xf9, 0x15, 0xa6, 0xdd, 0x71, 0x05, 0xd8, 0x40, 0x17, 0xe0, 0x05,
+	0x1a, 0x4e, 0x4c, 0x1f, 0x01, 0x34, 0xd1, 0x1a, 0xac, 0x74, 0x3b, 0x87, 0x62, 0x99, 0xdb, 0xa9,
+	0xc1, 0x4a, 0xfc, 0x0d, 0x1d, 0xbd, 0x00, 0x65, 0xd7, 0x0f, 0xc6, 0xde, 0x88, 0x2d, 0xad, 0x25,
+	0x64, 0xc1, 0x6a, 0x77, 0x38, 0x26, 0xfe, 0x2c, 0xe2, 0x10, 0x63, 0xe7, 0x97, 0x26, 0x40, 0xf2,
+	0x36, 0x8b, 0x2a, 0x00, 0xdd, 0xce, 0xe1, 0xf1, 0xd1, 0x7d, 0xa7, 0xde, 0x6d, 0x5a, 0x4b, 0x08,
+	0xa0, 0x50, 0xbf, 0x7f, 0xbf, 0xe9, 0x3a, 0x96, 0x81, 0x4a, 0x90, 0xc7, 0xcd, 0xba, 0x63, 0x99,
+	0x68, 0x15, 0x4a, 0x5d, 0x7c, 0xe4, 0x36, 0x28, 0x4d, 0x8e, 0x0a, 0xbd, 0xd3, 0xec, 0x1e, 0xc7,
+	0x90, 0x3c, 0x2a, 0x43, 0xb1, 0x71, 0xe8, 0xba, 0xcd, 0x46, 0xd7, 0x5a, 0xa6, 0x22, 0xc5, 0xe2,
+	0x18, 0x1f, 0x5a, 0x05, 0xb4, 0x0e, 0x6b, 0xed, 0xc3, 0x3b, 0xc7, 0x07, 0xcd, 0x3a, 0xee, 0xee,
+	0x37, 0xeb, 0x5d, 0xab, 0x48, 0x25, 0x34, 0x5c, 0x05, 0x52, 0xa2, 0x10, 0x47, 0x85, 0xac, 0x20,
+	0x04, 0x95, 0xc6, 0x41, 0xb3, 0x71, 0xf7, 0xf8, 0xa0, 0x7e, 0xb7, 0xd9, 0xbc, 0xdf, 0xc4, 0x16,
+	0x50, 0x03, 0xd2, 0x9d, 0x1b, 0xed, 0xa3, 0x4e, 0xb7, 0x89, 0x8f, 0x9d, 0x66, 0xb7, 0xde, 0x6a,
+	0x77, 0xac, 0x32, 0x25, 0xa6, 0x88, 0xce, 0x41, 0x1d, 0x3b, 0xc7, 0x2d, 0xf7, 0xf6, 0xa1, 0xb5,
+	0xca, 0x04, 0xb8, 0xc7, 0xf5, 0x76, 0xfb, 0x90, 0x6a, 0x79, 0xdc, 0x72, 0xac, 0x35, 0x6a, 0x68,
+	0x55, 0x40, 0xa7, 0x4b, 0xf5, 0xaf, 0xec, 0xb8, 0x00, 0xc9, 0x17, 0x23, 0x7a, 0x00, 0x6a, 0x76,
+	0x0e, 0xb1, 0x96, 0xe8, 0xe9, 0x5b, 0x93, 0x88, 0x04, 0x13, 0x6f, 0x64, 0x19, 0xd4, 0xc6, 0xcc,
+	0x89, 0xb1, 0x43, 0xd6, 0xc5, 0xc7, 0x37, 0x4c, 0xbe, 0x4f, 0x7a, 0x11, 0xe9, 0x5b, 0xb9, 0x9d,
+	0x1f, 0x9b, 0x80, 0x64, 0x61, 0x55, 0xfc, 0x4f, 0x8d, 0x3d, 0xec, 0x9d, 0xa9, 0x6e, 0x57, 0xbe,
+	0x5a, 0xc4, 0x6e, 0xbf, 0x08, 0xeb, 0x4e, 0x0a, 0x6c, 0xa2, 0x4b, 0x80, 0xd4, 0x8f, 0x24, 0x32,
+	0x02, 0xa8, 0x42, 0x77, 0x48, 0x14, 0x47, 0x53, 0x1e, 0xbd, 0x98, 0xaa, 0x3e, 0x02, 0xb5, 0x4c,
+	0x6d, 0xd2, 0x21, 0x3c, 0x16, 0x04, 0xac, 0x80, 0xaa, 0xb0, 0xa1, 0x8f, 0xfe, 0x02, 0x53, 0x44,
+	0x57, 0xe0, 0xa5, 0x0e, 0x89, 0xd2, 0xad, 0x4b, 0x10, 0x94, 0xd0, 0x26, 0x5c, 0x12, 0x04, 0x71,
+	0xed, 0x13, 0xb8, 0x95, 0x9d, 0x8f, 0x0c, 0x58, 0xd3, 0xfa, 0x28, 0x0d, 0x67, 0x09, 0x10, 0x03,
+	0xae, 0xb5, 0x44, 0x95, 0x95, 0x40, 0xed, 0xc5, 0xcc, 0x32, 0xd0, 0x37, 0xe1, 0xeb, 0x29, 0x94,
+	0x2c, 0xa7, 0x98, 0xf4, 0xc8, 0xf0, 0x21, 0xe9, 0x5b, 0x26, 0x7a, 0x09, 0x2e, 0xa7, 0xc8, 0x6e,
+	0x7b, 0xc3, 0x11, 0xf5, 0x84, 0xba, 0x27, 0x9e, 0x4d, 0x26, 0x54, 0x70, 0x7e, 0xe7, 0x24, 0xab,
+	0x93, 0x53, 0x3b, 0x68, 0xd0, 0x44, 0xc7, 0x79, 0x8c, 0x94, 0x64, 0xa4, 0x30, 0x9d, 0xc8, 0x9f,
+	0x4e, 0xa9, 0x56, 0x3b, 0xa7, 0x60, 0xcd, 0x3f, 0x91, 0x52, 0xff, 0xd7, 0xfb, 0x7d, 0x51, 0x2a,
+	0xac, 0x25, 0x1a, 0x39, 0x98, 0x8c, 0xfd, 0x87, 0x44, 0x82, 0x0c, 0x9a, 0x07, 0x9d, 0xc8, 0x0b,
+	0x22, 0x09, 0x31, 0xa9, 0x7b, 0xa9, 0x54, 0x09, 0xc8, 0x51, 0x29, 0x77, 0x87, 0xa3, 0xd1, 0xfb,
+	0xfe, 0xf8, 0x64, 0x48, 0xac, 0xfc, 0xce, 0xdb, 0xda, 0xd3, 0x22, 0x45, 0xd3, 0xe6, 0xc0, 0x21,
+	0xd6, 0x12, 0xad, 0x17, 0x8e, 0x2b, 0x97, 0x06, 0x5d, 0x36, 0xe2, 0xa5, 0xb9, 0xdf, 0xfc, 0xe4,
+	0xdf, 0x5b, 0x4b, 0x1f, 0x7f, 0xb6, 0x65, 0x7c, 0xf2, 0xd9, 0x96, 0xf1, 0xaf, 0xcf, 0xb6, 0x8c,
+	0xf7, 0xaf, 0x2b, 0xff, 0x3c, 0x36, 0xf6, 0xa2, 0x60, 0xf8, 0xc8, 0x0f, 0x86, 0x83, 0xe1, 0x44,
+	0x2e, 0x26, 0x64, 0x77, 0x7a, 0x36, 0xd8, 0x9d, 0x9e, 0xec, 0x26, 0xe5, 0xef, 0xa4, 0xc0, 0xfe,
+	0x73, 0xec, 0xfa, 0xff, 0x06, 0x00, 0xc8, 0xd6, 0x4c, 0x30, 0x98, 0x26, 0x00, 0x00,
 }
 
 func (m *CNStore) Marshal() (dAtA []byte, err error) {
@@ -3666,6 +3693,13 @@ func (m *DNStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LockServiceAddress) > 0 {
+		i -= len(m.LockServiceAddress)
+		copy(dAtA[i:], m.LockServiceAddress)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.LockServiceAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.LogtailServerAddress) > 0 {
 		i -= len(m.LogtailServerAddress)
 		copy(dAtA[i:], m.LogtailServerAddress)
@@ -4120,6 +4154,13 @@ func (m *DNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LockServiceAddress) > 0 {
+		i -= len(m.LockServiceAddress)
+		copy(dAtA[i:], m.LockServiceAddress)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.LockServiceAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.LogtailServerAddress) > 0 {
 		i -= len(m.LogtailServerAddress)
 		copy(dAtA[i:], m.LogtailServerAddress)
@@ -5305,6 +5346,13 @@ func (m *DNStoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LockServiceAddress) > 0 {
+		i -= len(m.LockServiceAddress)
+		copy(dAtA[i:], m.LockServiceAddress)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.LockServiceAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.LogtailServerAddress) > 0 {
 		i -= len(m.LogtailServerAddress)
 		copy(dAtA[i:], m.LogtailServerAddress)
@@ -6137,6 +6185,10 @@ func (m *DNStore) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	l = len(m.LockServiceAddress)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6351,6 +6403,10 @@ func (m *DNStoreHeartbeat) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	l = len(m.LockServiceAddress)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6878,6 +6934,10 @@ func (m *DNStoreInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	l = len(m.LockServiceAddress)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7591,6 +7651,38 @@ func (m *DNStore) Unmarshal(dAtA []byte) error {
 			}
 			m.LogtailServerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockServiceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockServiceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -8900,6 +8992,38 @@ func (m *DNStoreHeartbeat) Unmarshal(dAtA []byte) error {
 			}
 			m.LogtailServerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockServiceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockServiceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -12224,6 +12348,38 @@ func (m *DNStoreInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.LogtailServerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockServiceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockServiceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
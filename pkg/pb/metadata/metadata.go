@@ -91,6 +91,8 @@ func (m DNService) DebugString() string {
 	buf.WriteString(m.ServiceID)
 	buf.WriteString("/txn(")
 	buf.WriteString(m.TxnServiceAddress)
+	buf.WriteString(")/lock(")
+	buf.WriteString(m.LockServiceAddress)
 	buf.WriteString(")/[")
 	n := len(m.Shards)
 	for idx, shard := range m.Shards {
@@ -587,10 +587,12 @@ type DNService struct {
 	// Shards DN shards on service
 	Shards []DNShard `protobuf:"bytes,4,rep,name=Shards,proto3" json:"Shards"`
 	// Labels lables on service
-	Labels               map[string]string `protobuf:"bytes,5,rep,name=Labels,proto3" json:"Labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Labels map[string]string `protobuf:"bytes,5,rep,name=Labels,proto3" json:"Labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// LockServiceAddress is used to provide the lock server of all CNs
+	LockServiceAddress   string   `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DNService) Reset()         { *m = DNService{} }
@@ -661,6 +663,13 @@ func (m *DNService) GetLabels() map[string]string {
 	return nil
 }
 
+func (m *DNService) GetLockServiceAddress() string {
+	if m != nil {
+		return m.LockServiceAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("metadata.ServiceType", ServiceType_name, ServiceType_value)
 	proto.RegisterEnum("metadata.CNRole", CNRole_name, CNRole_value)
@@ -680,44 +689,45 @@ func init() {
 func init() { proto.RegisterFile("metadata.proto", fileDescriptor_56d9f74966f40d04) }
 
 var fileDescriptor_56d9f74966f40d04 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6e, 0xda, 0x40,
-	0x14, 0xce, 0x18, 0x07, 0xc2, 0x43, 0x45, 0xce, 0xa8, 0x3f, 0x56, 0x54, 0x41, 0x64, 0x55, 0x15,
-	0x42, 0x2d, 0x6e, 0x69, 0x55, 0xb5, 0x95, 0xba, 0x08, 0xa6, 0x8a, 0x52, 0x59, 0x0e, 0x35, 0xa4,
-	0x8b, 0xee, 0x6c, 0x98, 0x38, 0x16, 0x86, 0xb1, 0x8c, 0x89, 0xc2, 0x15, 0x7a, 0x95, 0x5e, 0xa0,
-	0x47, 0xc8, 0x92, 0x13, 0x44, 0x2d, 0x27, 0xa9, 0x3c, 0x1e, 0x83, 0x63, 0x08, 0xcd, 0xa6, 0x2b,
-	0xcf, 0xfb, 0xbe, 0xe7, 0x6f, 0xde, 0xf7, 0xe9, 0x69, 0xa0, 0x3c, 0x22, 0xa1, 0x35, 0xb0, 0x42,
-	0xab, 0xe1, 0x07, 0x34, 0xa4, 0x78, 0x2f, 0xa9, 0x0f, 0x5e, 0x3a, 0x6e, 0x78, 0x31, 0xb5, 0x1b,
-	0x7d, 0x3a, 0x52, 0x1d, 0xea, 0x50, 0x95, 0x35, 0xd8, 0xd3, 0x73, 0x56, 0xb1, 0x82, 0x9d, 0xe2,
-	0x1f, 0x95, 0x13, 0x78, 0xd0, 0x36, 0xba, 0x17, 0x56, 0x30, 0x30, 0x49, 0x9f, 0x06, 0x03, 0x2c,
-	0x43, 0x81, 0x95, 0x27, 0x6d, 0x19, 0x1d, 0xa2, 0x9a, 0x68, 0x26, 0x25, 0xae, 0x00, 0xe8, 0xd4,
-	0x49, 0x48, 0x81, 0x91, 0x29, 0x44, 0xf9, 0x81, 0xa0, 0xc0, 0xb5, 0xf0, 0x71, 0x46, 0x96, 0x69,
-	0x95, 0x9a, 0x4f, 0x1a, 0xcb, 0xb9, 0x6f, 0xd1, 0xad, 0xbd, 0xeb, 0x9b, 0xea, 0xce, 0xfc, 0xa6,
-	0x8a, 0xcc, 0xcc, 0x38, 0x4f, 0xa1, 0x68, 0x12, 0xdf, 0x73, 0xfb, 0xd6, 0xf2, 0xce, 0x15, 0x10,
-	0x0d, 0x7b, 0x34, 0x18, 0x04, 0x64, 0x32, 0x91, 0x73, 0x87, 0xa8, 0x56, 0x34, 0x93, 0x52, 0xf9,
-	0x06, 0xe5, 0x64, 0xb4, 0x7f, 0x1a, 0xab, 0x83, 0x64, 0x4c, 0x47, 0x36, 0x09, 0x4e, 0xcf, 0xb9,
-	0xf4, 0x84, 0x5f, 0xb5, 0x86, 0x2b, 0x21, 0xec, 0x25, 0xba, 0xf8, 0x4b, 0xf6, 0x0e, 0xee, 0x52,
-	0x5e, 0xb9, 0xbc, 0xcd, 0xa7, 0x6c, 0x66, 0xa7, 0xdb, 0xea, 0x53, 0x31, 0x58, 0xb2, 0x21, 0x0d,
-	0x08, 0xc6, 0x20, 0x9e, 0x9d, 0x71, 0x0f, 0x45, 0x93, 0x9d, 0xb1, 0x0a, 0x79, 0xa6, 0x15, 0x8d,
-	0x9d, 0xab, 0x95, 0x9a, 0xfb, 0x6b, 0x31, 0xb7, 0xc4, 0xe8, 0x66, 0x93, 0xb7, 0x29, 0x9d, 0xd8,
-	0xc5, 0x9d, 0x82, 0xaf, 0x32, 0x82, 0x78, 0xdd, 0x51, 0x46, 0x51, 0x83, 0x82, 0xb6, 0x65, 0xc2,
-	0x67, 0x20, 0x9a, 0xd4, 0x23, 0xcc, 0x59, 0xb9, 0x29, 0xad, 0xe4, 0x34, 0x23, 0xc2, 0x4d, 0xc6,
-	0x2a, 0x3f, 0x05, 0x28, 0x6a, 0x46, 0x97, 0x04, 0x97, 0x6e, 0x9f, 0x44, 0x91, 0xf0, 0xe3, 0x52,
-	0x6c, 0x05, 0xe0, 0x06, 0x60, 0x9d, 0xf6, 0x87, 0x1c, 0x48, 0xb6, 0x40, 0x60, 0x6d, 0x1b, 0x18,
-	0xfc, 0x0e, 0x1e, 0x77, 0x5c, 0x9f, 0x78, 0xee, 0x98, 0x64, 0xfe, 0x89, 0x37, 0xe7, 0x0e, 0x36,
-	0xda, 0xfa, 0xee, 0x57, 0x3d, 0xe9, 0x15, 0x59, 0x6f, 0x0a, 0xc1, 0x9f, 0x20, 0xaf, 0x5b, 0x36,
-	0xf1, 0x26, 0xf2, 0x2e, 0x8b, 0xaa, 0x9a, 0xf6, 0xc6, 0xb5, 0x1a, 0x71, 0xc7, 0xe7, 0x71, 0x18,
-	0xcc, 0x92, 0xdc, 0x62, 0xe8, 0xe0, 0x03, 0x94, 0x52, 0x24, 0x96, 0x20, 0x37, 0x24, 0x33, 0xee,
-	0x36, 0x3a, 0xe2, 0x87, 0xb0, 0x7b, 0x69, 0x79, 0x53, 0xc2, 0xad, 0xc5, 0xc5, 0x47, 0xe1, 0x3d,
-	0x52, 0x7e, 0x09, 0x50, 0x6c, 0xdf, 0x33, 0xad, 0x17, 0xb0, 0xdf, 0xbb, 0x1a, 0x6f, 0x0c, 0x6b,
-	0x9d, 0xc0, 0x6f, 0xe1, 0x91, 0x4e, 0x9d, 0x9e, 0xe5, 0x7a, 0x1b, 0xa3, 0xda, 0x4c, 0xa6, 0xb6,
-	0x50, 0xbc, 0xd7, 0x16, 0x6e, 0x8b, 0xae, 0xfd, 0x5f, 0xa3, 0xab, 0x3f, 0x87, 0x12, 0xd7, 0xef,
-	0xcd, 0x7c, 0x82, 0xf3, 0x20, 0x68, 0x86, 0xb4, 0x13, 0x7d, 0xdb, 0x86, 0x84, 0x70, 0x01, 0x72,
-	0xfa, 0xe9, 0xb1, 0x24, 0xd4, 0x65, 0xc8, 0xc7, 0x0b, 0x1a, 0x51, 0xbd, 0x4e, 0xdc, 0x72, 0xd4,
-	0x91, 0x50, 0x4b, 0x9b, 0xff, 0xa9, 0xa0, 0xeb, 0x45, 0x05, 0xcd, 0x17, 0x15, 0xf4, 0x7b, 0x51,
-	0x41, 0xdf, 0x5f, 0xa7, 0x1e, 0xde, 0x91, 0x15, 0x06, 0xee, 0x15, 0x0d, 0x5c, 0xc7, 0x1d, 0x27,
-	0xc5, 0x98, 0xa8, 0xfe, 0xd0, 0x51, 0x7d, 0x5b, 0x4d, 0x5c, 0xda, 0x79, 0xf6, 0x06, 0xbf, 0xf9,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x30, 0x41, 0x08, 0x8b, 0xce, 0x05, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xed, 0x38, 0xae, 0xd3, 0xdc, 0xe8, 0xab, 0xdc, 0xd1, 0x07, 0x58, 0x15, 0x4a, 0x2b, 0x0b,
+	0xa1, 0x2a, 0x82, 0x18, 0x02, 0x42, 0x80, 0xc4, 0xa2, 0x71, 0x50, 0x55, 0x64, 0xb9, 0xc1, 0x49,
+	0x59, 0xb0, 0xb3, 0x93, 0xa9, 0x6b, 0xc5, 0xc9, 0x58, 0x8e, 0x53, 0x35, 0xaf, 0xc0, 0xab, 0xf0,
+	0x22, 0x5d, 0xe6, 0x09, 0x2a, 0xc8, 0x8a, 0xc7, 0x40, 0x1e, 0x8f, 0x13, 0xd7, 0xf9, 0x69, 0x25,
+	0x56, 0x9e, 0x7b, 0xcf, 0xf5, 0x99, 0x7b, 0x8e, 0x8e, 0x06, 0x76, 0x07, 0x24, 0xb2, 0x7b, 0x76,
+	0x64, 0xd7, 0x82, 0x90, 0x46, 0x14, 0xef, 0xa4, 0xf5, 0xfe, 0x4b, 0xd7, 0x8b, 0x2e, 0xc7, 0x4e,
+	0xad, 0x4b, 0x07, 0x9a, 0x4b, 0x5d, 0xaa, 0xb1, 0x01, 0x67, 0x7c, 0xc1, 0x2a, 0x56, 0xb0, 0x53,
+	0xf2, 0xa3, 0x7a, 0x0a, 0xff, 0x35, 0xcd, 0xf6, 0xa5, 0x1d, 0xf6, 0x2c, 0xd2, 0xa5, 0x61, 0x0f,
+	0x2b, 0x50, 0x64, 0xe5, 0x69, 0x53, 0x41, 0x87, 0xe8, 0x48, 0xb4, 0xd2, 0x12, 0x57, 0x00, 0x0c,
+	0xea, 0xa6, 0xa0, 0xc0, 0xc0, 0x4c, 0x47, 0xfd, 0x81, 0xa0, 0xc8, 0xb9, 0xf0, 0x49, 0x8e, 0x96,
+	0x71, 0x95, 0xeb, 0x4f, 0x6a, 0xf3, 0xbd, 0xef, 0xc0, 0x8d, 0x9d, 0x9b, 0xdb, 0x83, 0xad, 0xe9,
+	0xed, 0x01, 0xb2, 0x72, 0xeb, 0x3c, 0x85, 0x92, 0x45, 0x02, 0xdf, 0xeb, 0xda, 0xf3, 0x3b, 0x17,
+	0x8d, 0x78, 0xd9, 0xe3, 0x5e, 0x2f, 0x24, 0xa3, 0x91, 0x52, 0x38, 0x44, 0x47, 0x25, 0x2b, 0x2d,
+	0xd5, 0x6f, 0xb0, 0x9b, 0xae, 0x76, 0xaf, 0xb0, 0x2a, 0xc8, 0xe6, 0x78, 0xe0, 0x90, 0xf0, 0xec,
+	0x82, 0x53, 0x8f, 0xf8, 0x55, 0x4b, 0x7d, 0x35, 0x82, 0x9d, 0x94, 0x17, 0x7f, 0xc9, 0xdf, 0xc1,
+	0x55, 0x2a, 0x0b, 0x95, 0x77, 0xf1, 0x8c, 0xcc, 0xfc, 0x76, 0x1b, 0x75, 0xaa, 0x26, 0x73, 0x36,
+	0xa2, 0x21, 0xc1, 0x18, 0xc4, 0xf3, 0x73, 0xae, 0xa1, 0x64, 0xb1, 0x33, 0xd6, 0x40, 0x62, 0x5c,
+	0xf1, 0xda, 0x85, 0xa3, 0x72, 0x7d, 0x6f, 0xc9, 0xe6, 0x86, 0x18, 0xdf, 0x6c, 0xf1, 0x31, 0xb5,
+	0x95, 0xa8, 0x58, 0x4b, 0xf8, 0x2a, 0x47, 0x88, 0x97, 0x15, 0xe5, 0x18, 0x75, 0x28, 0xea, 0x1b,
+	0x36, 0x7c, 0x06, 0xa2, 0x45, 0x7d, 0xc2, 0x94, 0xed, 0xd6, 0xe5, 0x05, 0x9d, 0x6e, 0xc6, 0x7d,
+	0x8b, 0xa1, 0xea, 0x4f, 0x01, 0x4a, 0xba, 0xd9, 0x26, 0xe1, 0x95, 0xd7, 0x25, 0xb1, 0x25, 0xfc,
+	0x38, 0x27, 0x5b, 0x34, 0x70, 0x0d, 0xb0, 0x41, 0xbb, 0x7d, 0xde, 0x48, 0x53, 0x20, 0xb0, 0xb1,
+	0x15, 0x08, 0x7e, 0x07, 0x8f, 0x5b, 0x5e, 0x40, 0x7c, 0x6f, 0x48, 0x72, 0xff, 0x24, 0xc9, 0x59,
+	0x83, 0xc6, 0xa9, 0x6f, 0x7f, 0x35, 0xd2, 0x59, 0x91, 0xcd, 0x66, 0x3a, 0xf8, 0x13, 0x48, 0x86,
+	0xed, 0x10, 0x7f, 0xa4, 0x6c, 0x33, 0xab, 0x0e, 0xb2, 0xda, 0x38, 0x57, 0x2d, 0x99, 0xf8, 0x3c,
+	0x8c, 0xc2, 0x49, 0xea, 0x5b, 0xd2, 0xda, 0xff, 0x00, 0xe5, 0x0c, 0x88, 0x65, 0x28, 0xf4, 0xc9,
+	0x84, 0xab, 0x8d, 0x8f, 0xf8, 0x7f, 0xd8, 0xbe, 0xb2, 0xfd, 0x31, 0xe1, 0xd2, 0x92, 0xe2, 0xa3,
+	0xf0, 0x1e, 0xa9, 0x7f, 0x04, 0x28, 0x35, 0x1f, 0xe8, 0xd6, 0x0b, 0xd8, 0xeb, 0x5c, 0x0f, 0x57,
+	0x9a, 0xb5, 0x0c, 0xe0, 0xb7, 0xf0, 0xc8, 0xa0, 0x6e, 0xc7, 0xf6, 0xfc, 0x95, 0x56, 0xad, 0x06,
+	0x33, 0x29, 0x14, 0x1f, 0x94, 0xc2, 0x4d, 0xd6, 0x35, 0xef, 0xb7, 0x6e, 0x4d, 0x02, 0xa4, 0x75,
+	0x09, 0xf8, 0x07, 0xab, 0xab, 0xcf, 0xa1, 0xcc, 0xc9, 0x3a, 0x93, 0x80, 0x60, 0x09, 0x04, 0xdd,
+	0x94, 0xb7, 0xe2, 0x6f, 0xd3, 0x94, 0x11, 0x2e, 0x42, 0xc1, 0x38, 0x3b, 0x91, 0x85, 0xaa, 0x02,
+	0x52, 0x12, 0xe8, 0x18, 0xea, 0xb4, 0x92, 0x91, 0xe3, 0x96, 0x8c, 0x1a, 0xfa, 0xf4, 0x77, 0x05,
+	0xdd, 0xcc, 0x2a, 0x68, 0x3a, 0xab, 0xa0, 0x5f, 0xb3, 0x0a, 0xfa, 0xfe, 0x3a, 0xf3, 0x50, 0x0f,
+	0xec, 0x28, 0xf4, 0xae, 0x69, 0xe8, 0xb9, 0xde, 0x30, 0x2d, 0x86, 0x44, 0x0b, 0xfa, 0xae, 0x16,
+	0x38, 0x5a, 0xea, 0x8a, 0x23, 0xb1, 0x37, 0xfb, 0xcd, 0xdf, 0x01, 0x00, 0xa4, 0x7a, 0x59, 0xf7,
+	0xfe, 0x05, 0x00, 0x00,
 }
 
 func (m *DNShardRecord) Marshal() (dAtA []byte, err error) {
@@ -1118,6 +1128,13 @@ func (m *DNService) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.LockServiceAddress) > 0 {
+		i -= len(m.LockServiceAddress)
+		copy(dAtA[i:], m.LockServiceAddress)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.LockServiceAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Labels) > 0 {
 		for k := range m.Labels {
 			v := m.Labels[k]
@@ -1391,6 +1408,10 @@ func (m *DNService) Size() (n int) {
 			n += mapEntrySize + 1 + sovMetadata(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.LockServiceAddress)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2747,6 +2768,38 @@ func (m *DNService) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockServiceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockServiceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -27,6 +27,8 @@ const (
 	PipelineMessage
 	BatchMessage
 	PrepareDoneNotifyMessage // for dispatch
+	RuntimeFilterMessage     // for the runtime filters of the scans on other CNs
+	QueryMemMessage          // for the memory allocated by the query on other CNs
 
 	// For Sid. Status type
 	WaitingNext
@@ -54,7 +54,7 @@ func (x Pipeline_PipelineType) String() string {
 }
 
 func (Pipeline_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{28, 0}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{30, 0}
 }
 
 type Message struct {
@@ -633,6 +633,8 @@ type OnDuplicateKey struct {
 	OnDuplicateExpr      map[string]*plan.Expr `protobuf:"bytes,3,rep,name=on_duplicate_expr,json=onDuplicateExpr,proto3" json:"on_duplicate_expr,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Ref                  *plan.ObjectRef       `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
 	TableDef             *plan.TableDef        `protobuf:"bytes,5,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	IsReplace            bool                  `protobuf:"varint,6,opt,name=is_replace,json=isReplace,proto3" json:"is_replace,omitempty"`
+	RowIdx               int32                 `protobuf:"varint,7,opt,name=row_idx,json=rowIdx,proto3" json:"row_idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -706,18 +708,33 @@ func (m *OnDuplicateKey) GetTableDef() *plan.TableDef {
 	return nil
 }
 
+func (m *OnDuplicateKey) GetIsReplace() bool {
+	if m != nil {
+		return m.IsReplace
+	}
+	return false
+}
+
+func (m *OnDuplicateKey) GetRowIdx() int32 {
+	if m != nil {
+		return m.RowIdx
+	}
+	return 0
+}
+
 type Join struct {
-	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	RelList              []int32      `protobuf:"varint,3,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
-	ColList              []int32      `protobuf:"varint,4,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
-	Expr                 *plan.Expr   `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
-	LeftCond             []*plan.Expr `protobuf:"bytes,7,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
-	RightCond            []*plan.Expr `protobuf:"bytes,8,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ibucket                uint64                    `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	RelList                []int32                   `protobuf:"varint,3,rep,packed,name=rel_list,json=relList,proto3" json:"rel_list,omitempty"`
+	ColList                []int32                   `protobuf:"varint,4,rep,packed,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	LeftCond               []*plan.Expr              `protobuf:"bytes,7,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond              []*plan.Expr              `protobuf:"bytes,8,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,9,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *Join) Reset()         { *m = Join{} }
@@ -809,6 +826,13 @@ func (m *Join) GetRightCond() []*plan.Expr {
 	return nil
 }
 
+func (m *Join) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
 type AntiJoin struct {
 	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
 	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
@@ -1222,16 +1246,17 @@ func (m *RightJoin) GetRightCond() []*plan.Expr {
 }
 
 type SemiJoin struct {
-	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
-	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
-	Result               []int32      `protobuf:"varint,3,rep,packed,name=result,proto3" json:"result,omitempty"`
-	Expr                 *plan.Expr   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	Types                []*plan.Type `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
-	LeftCond             []*plan.Expr `protobuf:"bytes,6,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
-	RightCond            []*plan.Expr `protobuf:"bytes,7,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ibucket                uint64                    `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
+	Nbucket                uint64                    `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
+	Result                 []int32                   `protobuf:"varint,3,rep,packed,name=result,proto3" json:"result,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	Types                  []*plan.Type              `protobuf:"bytes,5,rep,name=types,proto3" json:"types,omitempty"`
+	LeftCond               []*plan.Expr              `protobuf:"bytes,6,rep,name=left_cond,json=leftCond,proto3" json:"left_cond,omitempty"`
+	RightCond              []*plan.Expr              `protobuf:"bytes,7,rep,name=right_cond,json=rightCond,proto3" json:"right_cond,omitempty"`
+	RuntimeFilterBuildList []*plan.RuntimeFilterSpec `protobuf:"bytes,8,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *SemiJoin) Reset()         { *m = SemiJoin{} }
@@ -1316,6 +1341,13 @@ func (m *SemiJoin) GetRightCond() []*plan.Expr {
 	return nil
 }
 
+func (m *SemiJoin) GetRuntimeFilterBuildList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
 type SingleJoin struct {
 	Ibucket              uint64       `protobuf:"varint,1,opt,name=ibucket,proto3" json:"ibucket,omitempty"`
 	Nbucket              uint64       `protobuf:"varint,2,opt,name=nbucket,proto3" json:"nbucket,omitempty"`
@@ -1923,11 +1955,12 @@ type Instruction struct {
 	// isFirst identifies whether it is the first instruction of analyzeInfo corresponding to idx
 	IsFirst bool `protobuf:"varint,25,opt,name=isFirst,proto3" json:"isFirst,omitempty"`
 	// isLast identifies whether it is the last instruction of analyzeInfo corresponding to idx
-	IsLast               bool       `protobuf:"varint,26,opt,name=isLast,proto3" json:"isLast,omitempty"`
-	RightJoin            *RightJoin `protobuf:"bytes,27,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	IsLast               bool             `protobuf:"varint,26,opt,name=isLast,proto3" json:"isLast,omitempty"`
+	RightJoin            *RightJoin       `protobuf:"bytes,27,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
+	WinSpec              *plan.WindowSpec `protobuf:"bytes,28,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Instruction) Reset()         { *m = Instruction{} }
@@ -2152,6 +2185,13 @@ func (m *Instruction) GetRightJoin() *RightJoin {
 	return nil
 }
 
+func (m *Instruction) GetWinSpec() *plan.WindowSpec {
+	if m != nil {
+		return m.WinSpec
+	}
+	return nil
+}
+
 type AnalysisList struct {
 	List                 []*plan.AnalyzeInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -2200,18 +2240,20 @@ func (m *AnalysisList) GetList() []*plan.AnalyzeInfo {
 }
 
 type Source struct {
-	SchemaName           string               `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	TableName            string               `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColList              []string             `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
-	Block                string               `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
-	PushdownId           uint64               `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
-	PushdownAddr         string               `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
-	Expr                 *plan.Expr           `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
-	TableDef             *plan.TableDef       `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	SchemaName             string                    `protobuf:"bytes,1,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TableName              string                    `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColList                []string                  `protobuf:"bytes,3,rep,name=col_list,json=colList,proto3" json:"col_list,omitempty"`
+	Block                  string                    `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	PushdownId             uint64                    `protobuf:"varint,5,opt,name=pushdown_id,json=pushdownId,proto3" json:"pushdown_id,omitempty"`
+	PushdownAddr           string                    `protobuf:"bytes,6,opt,name=pushdown_addr,json=pushdownAddr,proto3" json:"pushdown_addr,omitempty"`
+	Expr                   *plan.Expr                `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
+	TableDef               *plan.TableDef            `protobuf:"bytes,8,opt,name=tableDef,proto3" json:"tableDef,omitempty"`
+	Timestamp              *timestamp.Timestamp      `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RuntimeFilterProbeList []*plan.RuntimeFilterSpec `protobuf:"bytes,10,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
+	RuntimeFilterReceivers []*RuntimeFilterReceiver  `protobuf:"bytes,11,rep,name=runtime_filter_receivers,json=runtimeFilterReceivers,proto3" json:"runtime_filter_receivers,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                  `json:"-"`
+	XXX_unrecognized       []byte                    `json:"-"`
+	XXX_sizecache          int32                     `json:"-"`
 }
 
 func (m *Source) Reset()         { *m = Source{} }
@@ -2310,6 +2352,149 @@ func (m *Source) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Source) GetRuntimeFilterProbeList() []*plan.RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterProbeList
+	}
+	return nil
+}
+
+func (m *Source) GetRuntimeFilterReceivers() []*RuntimeFilterReceiver {
+	if m != nil {
+		return m.RuntimeFilterReceivers
+	}
+	return nil
+}
+
+// RuntimeFilterReceiver is a runtime filter of a scan sent to another CN, the
+// filter is fetched by the uuid from the CN running the hash build of the join.
+type RuntimeFilterReceiver struct {
+	Uuid                 []byte   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Col                  string   `protobuf:"bytes,2,opt,name=col,proto3" json:"col,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFilterReceiver) Reset()         { *m = RuntimeFilterReceiver{} }
+func (m *RuntimeFilterReceiver) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFilterReceiver) ProtoMessage()    {}
+func (*RuntimeFilterReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{24}
+}
+func (m *RuntimeFilterReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeFilterReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeFilterReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeFilterReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFilterReceiver.Merge(m, src)
+}
+func (m *RuntimeFilterReceiver) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RuntimeFilterReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFilterReceiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFilterReceiver proto.InternalMessageInfo
+
+func (m *RuntimeFilterReceiver) GetUuid() []byte {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
+}
+
+func (m *RuntimeFilterReceiver) GetCol() string {
+	if m != nil {
+		return m.Col
+	}
+	return ""
+}
+
+func (m *RuntimeFilterReceiver) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+// RuntimeFilter is the runtime filter sent back to the CN fetching it.
+type RuntimeFilter struct {
+	Typ                  int32    `protobuf:"varint,1,opt,name=typ,proto3" json:"typ,omitempty"`
+	Keys                 []byte   `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Bloom                []byte   `protobuf:"bytes,3,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFilter) Reset()         { *m = RuntimeFilter{} }
+func (m *RuntimeFilter) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFilter) ProtoMessage()    {}
+func (*RuntimeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{25}
+}
+func (m *RuntimeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFilter.Merge(m, src)
+}
+func (m *RuntimeFilter) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RuntimeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFilter proto.InternalMessageInfo
+
+func (m *RuntimeFilter) GetTyp() int32 {
+	if m != nil {
+		return m.Typ
+	}
+	return 0
+}
+
+func (m *RuntimeFilter) GetKeys() []byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *RuntimeFilter) GetBloom() []byte {
+	if m != nil {
+		return m.Bloom
+	}
+	return nil
+}
+
 type NodeInfo struct {
 	Mcpu                 int32    `protobuf:"varint,1,opt,name=mcpu,proto3" json:"mcpu,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -2324,7 +2509,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{24}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{26}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2387,6 +2572,10 @@ type ProcessLimitation struct {
 	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
 	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	SpillSize            int64    `protobuf:"varint,6,opt,name=spill_size,json=spillSize,proto3" json:"spill_size,omitempty"`
+	MaxExecutionTime     int64    `protobuf:"varint,7,opt,name=max_execution_time,json=maxExecutionTime,proto3" json:"max_execution_time,omitempty"`
+	QueryMemLimit        int64    `protobuf:"varint,8,opt,name=query_mem_limit,json=queryMemLimit,proto3" json:"query_mem_limit,omitempty"`
+	QueryDeadline        int64    `protobuf:"varint,9,opt,name=query_deadline,json=queryDeadline,proto3" json:"query_deadline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2396,7 +2585,7 @@ func (m *ProcessLimitation) Reset()         { *m = ProcessLimitation{} }
 func (m *ProcessLimitation) String() string { return proto.CompactTextString(m) }
 func (*ProcessLimitation) ProtoMessage()    {}
 func (*ProcessLimitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{25}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{27}
 }
 func (m *ProcessLimitation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2460,6 +2649,34 @@ func (m *ProcessLimitation) GetReaderSize() int64 {
 	return 0
 }
 
+func (m *ProcessLimitation) GetSpillSize() int64 {
+	if m != nil {
+		return m.SpillSize
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetMaxExecutionTime() int64 {
+	if m != nil {
+		return m.MaxExecutionTime
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetQueryMemLimit() int64 {
+	if m != nil {
+		return m.QueryMemLimit
+	}
+	return 0
+}
+
+func (m *ProcessLimitation) GetQueryDeadline() int64 {
+	if m != nil {
+		return m.QueryDeadline
+	}
+	return 0
+}
+
 type ProcessInfo struct {
 	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Lim                  *ProcessLimitation `protobuf:"bytes,2,opt,name=lim,proto3" json:"lim,omitempty"`
@@ -2476,7 +2693,7 @@ func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
 func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
 func (*ProcessInfo) ProtoMessage()    {}
 func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{26}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{28}
 }
 func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2565,7 +2782,7 @@ func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
 func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
 func (*SessionInfo) ProtoMessage()    {}
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{27}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{29}
 }
 func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2672,7 +2889,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{28}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{30}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2797,7 +3014,7 @@ func (m *WrapNode) Reset()         { *m = WrapNode{} }
 func (m *WrapNode) String() string { return proto.CompactTextString(m) }
 func (*WrapNode) ProtoMessage()    {}
 func (*WrapNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{29}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{31}
 }
 func (m *WrapNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2853,7 +3070,7 @@ func (m *UuidToRegIdx) Reset()         { *m = UuidToRegIdx{} }
 func (m *UuidToRegIdx) String() string { return proto.CompactTextString(m) }
 func (*UuidToRegIdx) ProtoMessage()    {}
 func (*UuidToRegIdx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{30}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{32}
 }
 func (m *UuidToRegIdx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2933,6 +3150,8 @@ func init() {
 	proto.RegisterType((*Instruction)(nil), "pipeline.Instruction")
 	proto.RegisterType((*AnalysisList)(nil), "pipeline.AnalysisList")
 	proto.RegisterType((*Source)(nil), "pipeline.Source")
+	proto.RegisterType((*RuntimeFilterReceiver)(nil), "pipeline.RuntimeFilterReceiver")
+	proto.RegisterType((*RuntimeFilter)(nil), "pipeline.RuntimeFilter")
 	proto.RegisterType((*NodeInfo)(nil), "pipeline.NodeInfo")
 	proto.RegisterType((*ProcessLimitation)(nil), "pipeline.ProcessLimitation")
 	proto.RegisterType((*ProcessInfo)(nil), "pipeline.ProcessInfo")
@@ -2945,174 +3164,189 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 2662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x8f, 0x1c, 0x47,
-	0xf9, 0xcf, 0xbc, 0x77, 0x3f, 0x33, 0x3b, 0xbb, 0xee, 0xd8, 0x71, 0xc7, 0xf9, 0xc7, 0xde, 0x7f,
-	0xff, 0xff, 0x4e, 0x1c, 0x1c, 0xef, 0x2a, 0x8b, 0x82, 0x22, 0x12, 0x12, 0xec, 0xb5, 0x13, 0x06,
-	0x1c, 0x67, 0x53, 0xeb, 0x08, 0x11, 0x21, 0x5a, 0xbd, 0xdd, 0x35, 0x33, 0x9d, 0xed, 0xa9, 0x6a,
-	0x57, 0xf5, 0x38, 0xbb, 0x39, 0x71, 0xe2, 0x00, 0x39, 0x80, 0xf8, 0x02, 0xf9, 0x00, 0xf0, 0x11,
-	0x10, 0xe2, 0x80, 0xc4, 0x11, 0xce, 0xb9, 0xa0, 0x70, 0x85, 0x2f, 0xc0, 0x09, 0x3d, 0x4f, 0x55,
-	0xf7, 0xf4, 0xcc, 0xee, 0xda, 0x0e, 0xe2, 0x80, 0x94, 0xdc, 0xea, 0x79, 0xa9, 0x97, 0xe7, 0xa5,
-	0x7e, 0xf5, 0x54, 0x15, 0x0c, 0xf3, 0x34, 0xe7, 0x59, 0x2a, 0xf8, 0x56, 0xae, 0x64, 0x21, 0x3d,
-	0xa7, 0xa4, 0x2f, 0xdd, 0x98, 0xa4, 0xc5, 0x74, 0x7e, 0xb0, 0x15, 0xcb, 0xd9, 0xf6, 0x44, 0x4e,
-	0xe4, 0x36, 0x29, 0x1c, 0xcc, 0xc7, 0x44, 0x11, 0x41, 0x2d, 0xd3, 0xf1, 0x12, 0xe4, 0x59, 0x24,
-	0x6c, 0x7b, 0xbd, 0x48, 0x67, 0x5c, 0x17, 0xd1, 0x2c, 0x37, 0x8c, 0xe0, 0xd3, 0x26, 0xf4, 0xde,
-	0xe5, 0x5a, 0x47, 0x13, 0xee, 0x6d, 0x40, 0x4b, 0xa7, 0x89, 0xdf, 0xd8, 0x6c, 0x5c, 0x6b, 0x33,
-	0x6c, 0x22, 0x27, 0x9e, 0x25, 0x7e, 0xd3, 0x70, 0xe2, 0x19, 0x71, 0xb8, 0x52, 0x7e, 0x6b, 0xb3,
-	0x71, 0x6d, 0xc0, 0xb0, 0xe9, 0x79, 0xd0, 0x4e, 0xa2, 0x22, 0xf2, 0xdb, 0xc4, 0xa2, 0xb6, 0xf7,
-	0xff, 0x30, 0xcc, 0x95, 0x8c, 0xc3, 0x54, 0x8c, 0x65, 0x48, 0xd2, 0x0e, 0x49, 0x07, 0xc8, 0x1d,
-	0x89, 0xb1, 0xbc, 0x8d, 0x5a, 0x3e, 0xf4, 0x22, 0x11, 0x65, 0xc7, 0x9a, 0xfb, 0x5d, 0x12, 0x97,
-	0xa4, 0x37, 0x84, 0x66, 0x9a, 0xf8, 0x3d, 0x9a, 0xb6, 0x99, 0x26, 0x38, 0xc7, 0x7c, 0x9e, 0x26,
-	0xbe, 0x63, 0xe6, 0xc0, 0xb6, 0xf7, 0x1c, 0xb8, 0x07, 0x51, 0x11, 0x4f, 0xc3, 0x58, 0x14, 0xbe,
-	0x4b, 0xaa, 0x0e, 0x31, 0x76, 0x45, 0xe1, 0x5d, 0x02, 0x27, 0x9e, 0xf2, 0xf8, 0x50, 0xcf, 0x67,
-	0x3e, 0x6c, 0x36, 0xae, 0xad, 0xb1, 0x8a, 0x46, 0x99, 0xe6, 0x0f, 0xe6, 0x5c, 0xc4, 0xdc, 0xef,
-	0x9b, 0x7e, 0x25, 0x1d, 0x7c, 0x00, 0xee, 0xae, 0x14, 0x82, 0xc7, 0x85, 0x54, 0xde, 0x15, 0xe8,
-	0x97, 0x3e, 0x0f, 0xad, 0x5f, 0x3a, 0x0c, 0x4a, 0xd6, 0x28, 0xf1, 0x5e, 0x84, 0xf5, 0xb8, 0xd4,
-	0x0e, 0x53, 0x91, 0xf0, 0x23, 0x72, 0x55, 0x87, 0x0d, 0x2b, 0xf6, 0x08, 0xb9, 0xc1, 0x67, 0x0d,
-	0x70, 0x6e, 0xa7, 0x3a, 0xc7, 0xe5, 0x79, 0x17, 0xa1, 0x37, 0x9e, 0x8b, 0x78, 0x31, 0x64, 0x17,
-	0xc9, 0x51, 0xe2, 0xbd, 0x01, 0xeb, 0x99, 0x8c, 0xa3, 0x2c, 0xac, 0x7a, 0xfb, 0xcd, 0xcd, 0xd6,
-	0xb5, 0xfe, 0xce, 0xd3, 0x5b, 0x55, 0x2e, 0x54, 0xab, 0x63, 0x43, 0xd2, 0x5d, 0xac, 0xf6, 0x3b,
-	0xb0, 0xa1, 0xf8, 0x4c, 0x16, 0xbc, 0xd6, 0xbd, 0x45, 0xdd, 0xbd, 0x45, 0xf7, 0x1f, 0xaa, 0x28,
-	0xbf, 0x27, 0x13, 0xce, 0xd6, 0x8d, 0x6e, 0xd5, 0x3d, 0x78, 0x0f, 0xdc, 0x9b, 0x93, 0x89, 0xe2,
-	0x93, 0xa8, 0x20, 0xff, 0xcb, 0xdc, 0xae, 0xae, 0x29, 0x73, 0x8a, 0x71, 0xaa, 0x0b, 0xb2, 0xce,
-	0x61, 0xd4, 0xf6, 0x2e, 0x43, 0x9b, 0x1f, 0xe5, 0x26, 0x15, 0xfa, 0x3b, 0xb0, 0x45, 0x59, 0x76,
-	0xe7, 0x28, 0x57, 0x8c, 0xf8, 0xc1, 0x1f, 0x1b, 0xd0, 0x79, 0x47, 0xc9, 0x79, 0x8e, 0x91, 0x12,
-	0x9c, 0x27, 0x21, 0x7f, 0x18, 0x65, 0x34, 0xa8, 0xc3, 0x1c, 0x64, 0xdc, 0x79, 0x18, 0x65, 0x98,
-	0x04, 0xe9, 0xc1, 0x3c, 0x3e, 0xe4, 0x85, 0x4d, 0xb3, 0x92, 0x44, 0x89, 0xb0, 0x92, 0x96, 0x91,
-	0x58, 0xd2, 0xdb, 0x84, 0x0e, 0x4e, 0xa1, 0xfd, 0x36, 0xd9, 0x57, 0x9f, 0xdb, 0x08, 0x50, 0xa3,
-	0x38, 0xce, 0xb9, 0xf6, 0x3b, 0x75, 0x8d, 0xfb, 0xc7, 0x39, 0x67, 0x46, 0xe0, 0xbd, 0x08, 0xed,
-	0x68, 0x32, 0xd1, 0x7e, 0x77, 0xd5, 0xc3, 0x95, 0x17, 0x18, 0x29, 0x04, 0xbf, 0x69, 0x43, 0x77,
-	0x24, 0x34, 0x57, 0x94, 0x55, 0xd1, 0x78, 0xcc, 0xe3, 0x82, 0x97, 0xbb, 0xa4, 0xa2, 0x51, 0x36,
-	0xd2, 0x8c, 0x9c, 0x6a, 0xdd, 0x54, 0xd1, 0xde, 0x35, 0xd8, 0x90, 0x22, 0x4c, 0xe6, 0x79, 0x96,
-	0xc6, 0x51, 0x81, 0xc9, 0x74, 0x44, 0xa1, 0xe9, 0xb0, 0xa1, 0x14, 0xb7, 0x4b, 0xf6, 0x28, 0x39,
-	0xf2, 0xde, 0x87, 0x73, 0x4b, 0x9a, 0xe4, 0x61, 0x63, 0xe5, 0xd5, 0xc5, 0x12, 0xcd, 0x72, 0xb6,
-	0xde, 0x5b, 0xf4, 0x45, 0xdb, 0xef, 0x88, 0x42, 0x1d, 0xb3, 0x75, 0xb9, 0xcc, 0xf5, 0xfe, 0x17,
-	0x5a, 0x8a, 0x8f, 0x69, 0x03, 0xf6, 0x77, 0xd6, 0x8d, 0x23, 0xde, 0x3b, 0xf8, 0x88, 0xc7, 0x05,
-	0xe3, 0x63, 0x86, 0x32, 0xef, 0x3a, 0xb8, 0x45, 0x74, 0x90, 0xf1, 0x30, 0xe1, 0x63, 0xda, 0x8a,
-	0xfd, 0x9d, 0xa1, 0xf5, 0x18, 0xb2, 0x6f, 0xf3, 0x31, 0x73, 0x0a, 0xdb, 0xf2, 0xde, 0x04, 0xc8,
-	0x23, 0xc5, 0x45, 0x41, 0x66, 0xf4, 0x68, 0x6d, 0x57, 0x4e, 0xac, 0x6d, 0x8f, 0x54, 0x46, 0xc9,
-	0x91, 0x59, 0x95, 0x9b, 0x97, 0xb4, 0xf7, 0x2d, 0x18, 0xec, 0x66, 0x73, 0x5d, 0x70, 0x45, 0x83,
-	0xd3, 0x9e, 0xa6, 0x1c, 0xc5, 0xf9, 0xea, 0x12, 0xb6, 0xa4, 0x87, 0xdb, 0x26, 0x4d, 0x8e, 0x68,
-	0x52, 0x97, 0x7c, 0xd7, 0x4d, 0x93, 0xa3, 0x51, 0x72, 0x74, 0xe9, 0x1e, 0x9c, 0x3f, 0xcd, 0x13,
-	0x08, 0x55, 0x87, 0xfc, 0x98, 0x02, 0xe5, 0x32, 0x6c, 0x62, 0x56, 0x3c, 0x8c, 0xb2, 0xb9, 0x09,
-	0xd0, 0x4a, 0xde, 0x90, 0xe0, 0xdb, 0xcd, 0xd7, 0x1a, 0x97, 0xde, 0x80, 0xe1, 0xf2, 0xea, 0x4f,
-	0x19, 0xe9, 0x7c, 0x7d, 0xa4, 0x4e, 0xad, 0x77, 0xf0, 0xd3, 0x26, 0xb8, 0x7b, 0x8a, 0xdb, 0x8c,
-	0xb9, 0x02, 0x7d, 0x1d, 0x4f, 0xf9, 0x2c, 0x0a, 0x45, 0x34, 0xe3, 0x76, 0x04, 0x30, 0xac, 0x7b,
-	0xd1, 0x8c, 0x2f, 0xbb, 0xbe, 0xf9, 0x18, 0xd7, 0xff, 0x04, 0x2e, 0x2c, 0x5c, 0x1f, 0xe6, 0x8a,
-	0x87, 0x29, 0x4d, 0x63, 0xf7, 0xf9, 0xf5, 0x45, 0x14, 0xaa, 0x15, 0x2c, 0x02, 0x51, 0xb1, 0x4c,
-	0x44, 0xbc, 0xfc, 0x84, 0xe0, 0xd2, 0x1d, 0xb8, 0x78, 0x86, 0xfa, 0x97, 0x72, 0xc1, 0x5f, 0x9a,
-	0x30, 0xac, 0x45, 0xe4, 0x07, 0xfc, 0xf8, 0x91, 0x3b, 0xe7, 0xb4, 0xdd, 0xd1, 0x3c, 0x75, 0x77,
-	0xfc, 0xe8, 0xb4, 0xdd, 0x61, 0x6c, 0xbf, 0xb1, 0xb0, 0x7d, 0x79, 0xea, 0x2f, 0xb7, 0x4b, 0xda,
-	0x4f, 0xba, 0x4b, 0x3a, 0x8f, 0x0e, 0xd5, 0x7f, 0x3a, 0x29, 0x83, 0x9f, 0x35, 0xa1, 0xfd, 0x7d,
-	0x99, 0x8a, 0x3a, 0x5e, 0x36, 0xce, 0xc4, 0xcb, 0xe6, 0x32, 0x5e, 0x3e, 0x0b, 0x8e, 0xe2, 0x59,
-	0x98, 0x21, 0x84, 0x1b, 0xdc, 0xe9, 0x29, 0x9e, 0xdd, 0x45, 0x14, 0x7f, 0x16, 0x9c, 0x58, 0x5a,
-	0x51, 0xdb, 0x88, 0x62, 0x69, 0x44, 0x25, 0xc0, 0x77, 0x4e, 0x07, 0xf8, 0x05, 0xc6, 0x76, 0xcf,
-	0xc6, 0x58, 0x37, 0xe3, 0xe3, 0x02, 0x0f, 0xa4, 0xc4, 0x22, 0x45, 0x7d, 0x18, 0x07, 0x85, 0xbb,
-	0x52, 0x24, 0xde, 0x4b, 0x00, 0x2a, 0x9d, 0x4c, 0xad, 0xa6, 0x73, 0x42, 0xd3, 0x25, 0x29, 0xaa,
-	0x06, 0x7f, 0x6f, 0x80, 0x73, 0x53, 0x14, 0xe9, 0xbf, 0xed, 0x8c, 0x67, 0xa0, 0xab, 0xb8, 0x9e,
-	0x67, 0xa5, 0x2b, 0x2c, 0x55, 0x99, 0xdb, 0x7e, 0x9c, 0xb9, 0x9d, 0x27, 0x32, 0xb7, 0xfb, 0xc4,
-	0xe6, 0xf6, 0x1e, 0x65, 0xee, 0x2f, 0x9a, 0xe0, 0x8e, 0x84, 0xe0, 0xea, 0xeb, 0xe0, 0x8b, 0x24,
-	0xf8, 0x79, 0x13, 0x9c, 0xbb, 0x7c, 0x5c, 0x7c, 0xed, 0x0c, 0x91, 0x04, 0x7f, 0x68, 0x82, 0xcb,
-	0x90, 0xfa, 0x2f, 0xf3, 0xc6, 0x4b, 0x00, 0x64, 0xeb, 0x59, 0x2e, 0x21, 0x4f, 0xdc, 0x27, 0xb7,
-	0x5c, 0x87, 0xbe, 0xb1, 0xd6, 0xe8, 0xf6, 0x4e, 0xe8, 0x1a, 0x67, 0xdc, 0x3f, 0xe9, 0x43, 0xe7,
-	0x89, 0x7d, 0xe8, 0x3e, 0x0e, 0x4d, 0xf6, 0xf9, 0xec, 0xab, 0x82, 0x26, 0x9f, 0x36, 0x01, 0xf6,
-	0x53, 0x31, 0xc9, 0xf8, 0xd7, 0x3b, 0x48, 0x24, 0xc1, 0xaf, 0x9a, 0xe0, 0xbc, 0x1b, 0xa9, 0xc3,
-	0xaf, 0x46, 0xf4, 0xbd, 0xff, 0x83, 0x9e, 0x14, 0x26, 0x3c, 0x27, 0xdd, 0xd2, 0x95, 0x02, 0x23,
-	0x15, 0x44, 0xd0, 0xdb, 0x53, 0x32, 0x99, 0xc7, 0xcb, 0xa1, 0x6e, 0x9c, 0x1d, 0xea, 0xe6, 0x72,
-	0xa8, 0x2b, 0xdb, 0x5a, 0x67, 0xd8, 0x16, 0xfc, 0xba, 0x01, 0x6b, 0x54, 0x32, 0xbd, 0x3d, 0x17,
-	0x71, 0x91, 0x4a, 0x81, 0xb5, 0x64, 0x54, 0x14, 0x4a, 0xd3, 0x34, 0x2e, 0x33, 0x84, 0xb7, 0x09,
-	0x6d, 0xc5, 0x0b, 0x6d, 0x2f, 0xc1, 0x03, 0x7b, 0x43, 0x90, 0x19, 0x56, 0x5a, 0x24, 0x41, 0x3f,
-	0x47, 0x6a, 0xb2, 0x32, 0x95, 0xf1, 0x33, 0xf2, 0x31, 0x3e, 0x79, 0xa4, 0xa2, 0x99, 0xb6, 0xaf,
-	0x13, 0x96, 0xc2, 0xfb, 0x2c, 0xd5, 0xe3, 0x1d, 0x2a, 0xc3, 0xa8, 0x1d, 0xfc, 0xae, 0x01, 0xee,
-	0xf7, 0x22, 0x3d, 0xbd, 0x35, 0x4f, 0xb3, 0x64, 0x71, 0x67, 0xc5, 0x30, 0xd6, 0xef, 0xac, 0x18,
-	0xbe, 0x52, 0x38, 0x8d, 0xf4, 0xb4, 0xbc, 0xec, 0x21, 0x03, 0xbb, 0xd7, 0xf3, 0xa8, 0x75, 0x66,
-	0x1e, 0xb5, 0x4f, 0x5c, 0x68, 0x1f, 0x93, 0x0f, 0x9b, 0xd0, 0xc1, 0x00, 0xeb, 0x53, 0x72, 0xc1,
-	0x08, 0x82, 0x9b, 0x70, 0xe1, 0xce, 0x51, 0xc1, 0x95, 0x88, 0x32, 0xbc, 0x59, 0xec, 0xec, 0xca,
-	0x8c, 0x1e, 0x1f, 0x2a, 0x63, 0x1b, 0x0b, 0x63, 0xd1, 0xe1, 0xf5, 0xf7, 0x0a, 0x43, 0x04, 0xff,
-	0x6c, 0xc0, 0xa0, 0x1c, 0x63, 0x3f, 0x8e, 0x1e, 0x11, 0x97, 0x58, 0x66, 0x67, 0xc4, 0x05, 0x25,
-	0xde, 0x3b, 0xb0, 0x8e, 0xd3, 0xec, 0x84, 0x98, 0x24, 0x66, 0xa2, 0xd6, 0xea, 0x45, 0xf1, 0xd4,
-	0xc5, 0xb2, 0x35, 0xb1, 0xb4, 0xf6, 0xe7, 0x01, 0x62, 0xc5, 0xb1, 0xd6, 0xd7, 0x0f, 0x32, 0xf2,
-	0x9a, 0xcb, 0x5c, 0xc3, 0xd9, 0x7f, 0x90, 0x61, 0x20, 0xc6, 0x69, 0xc6, 0x4d, 0x1e, 0x76, 0x68,
-	0x8d, 0x0e, 0x32, 0x28, 0x11, 0x6f, 0x40, 0x5f, 0xaa, 0x74, 0x92, 0x8a, 0x90, 0x56, 0xdb, 0x3d,
-	0x65, 0xb5, 0x60, 0x14, 0x76, 0x65, 0xa6, 0x83, 0x5f, 0xba, 0xd0, 0x1f, 0x09, 0x5d, 0xa8, 0xb9,
-	0xc9, 0xc9, 0xd5, 0x37, 0x90, 0x0d, 0x68, 0x99, 0x9b, 0x09, 0x32, 0xb0, 0xe9, 0xbd, 0x00, 0xed,
-	0x48, 0x14, 0xa9, 0x7d, 0x01, 0xa9, 0xbd, 0xb2, 0x94, 0xf5, 0x29, 0x23, 0xb9, 0x77, 0x03, 0x7a,
-	0xf6, 0x49, 0xc6, 0x02, 0xc2, 0xa9, 0xef, 0x39, 0xa5, 0x8e, 0xb7, 0x05, 0x4e, 0x62, 0xdf, 0x8a,
-	0x2c, 0x5e, 0xd6, 0x86, 0x2e, 0x5f, 0x91, 0x58, 0xa5, 0x83, 0x57, 0x97, 0x68, 0x32, 0xb1, 0xf7,
-	0xf6, 0xf5, 0x85, 0x2a, 0x3d, 0xbe, 0x30, 0x94, 0x79, 0x3b, 0x00, 0x29, 0x16, 0x91, 0xe1, 0x47,
-	0x32, 0x15, 0xf4, 0xae, 0xb6, 0xb4, 0x88, 0xaa, 0xc0, 0x64, 0x6e, 0x5a, 0xd5, 0x9a, 0xdb, 0x16,
-	0x81, 0xa8, 0x8b, 0xb3, 0xba, 0x8e, 0xb2, 0x0a, 0x33, 0x48, 0x54, 0x76, 0xd0, 0x7c, 0x96, 0x9a,
-	0x0e, 0xee, 0x6a, 0x87, 0xf2, 0x94, 0x65, 0x8e, 0x2e, 0xcf, 0xdb, 0x57, 0xa1, 0xaf, 0xe9, 0x30,
-	0x32, 0x5d, 0x80, 0xba, 0x9c, 0xaf, 0x75, 0xa9, 0x4e, 0x2a, 0x06, 0x7a, 0x71, 0x6a, 0x6d, 0x83,
-	0x3b, 0x8b, 0xd4, 0xa1, 0xe9, 0xd4, 0x5f, 0x9d, 0xa7, 0xc4, 0x73, 0xe6, 0xcc, 0x4a, 0x64, 0x0f,
-	0xa0, 0x4d, 0xba, 0x83, 0xf2, 0xce, 0x56, 0xea, 0x9a, 0x18, 0xa1, 0xcc, 0xbb, 0x0e, 0xbd, 0xdc,
-	0xc0, 0x9e, 0xbf, 0x46, 0x6a, 0xe7, 0xea, 0x97, 0x69, 0x12, 0xb0, 0x52, 0xc3, 0x7b, 0x13, 0x86,
-	0xe6, 0x26, 0x38, 0xb6, 0x00, 0xe6, 0x0f, 0xa9, 0xcf, 0xc5, 0x45, 0x9f, 0x25, 0x7c, 0x63, 0x6b,
-	0xc5, 0x12, 0xdc, 0xed, 0x00, 0x20, 0x74, 0x84, 0x07, 0x08, 0x35, 0xfe, 0xfa, 0x6a, 0x38, 0x2a,
-	0x14, 0x62, 0xee, 0xb4, 0x02, 0xa4, 0xd7, 0x61, 0x8d, 0xdb, 0x1d, 0x13, 0xea, 0x38, 0x12, 0xfe,
-	0x06, 0x75, 0x7b, 0xe6, 0xe4, 0x86, 0xc2, 0x9d, 0xcb, 0x06, 0xbc, 0xbe, 0x8f, 0xaf, 0x41, 0xd7,
-	0xbe, 0x14, 0x9c, 0xa3, 0x5e, 0x1b, 0xab, 0xef, 0x35, 0xcc, 0xca, 0xbd, 0x5b, 0x2b, 0x97, 0x71,
-	0xbc, 0xac, 0x7a, 0xd4, 0xc7, 0x3f, 0xeb, 0x86, 0xbd, 0x74, 0x4d, 0xc7, 0xcb, 0xfe, 0x0e, 0x40,
-	0xed, 0x6d, 0xe2, 0xe9, 0x55, 0xf3, 0xaa, 0x97, 0x05, 0xe6, 0xe6, 0xd5, 0x43, 0xc9, 0xcb, 0xe0,
-	0x48, 0x95, 0x70, 0x15, 0x1e, 0x1c, 0xfb, 0xe7, 0x69, 0xa7, 0x9e, 0xb3, 0x97, 0x70, 0xe4, 0xde,
-	0x3a, 0xde, 0xcf, 0x79, 0xcc, 0x7a, 0xd2, 0x10, 0xde, 0x0d, 0x18, 0xe4, 0x4a, 0xe2, 0xed, 0xdc,
-	0x6c, 0xfd, 0x0b, 0x27, 0x40, 0xb1, 0x6f, 0xe5, 0x84, 0x04, 0x01, 0x74, 0xc7, 0x69, 0x56, 0x70,
-	0xe5, 0x3f, 0x73, 0xe2, 0x40, 0xb6, 0x12, 0x84, 0xba, 0x2c, 0x9d, 0xa5, 0x85, 0x7f, 0x91, 0xa0,
-	0xd9, 0x10, 0x78, 0x80, 0xc8, 0xf1, 0x58, 0xf3, 0xc2, 0xf7, 0x89, 0x6d, 0x29, 0x02, 0x79, 0xfd,
-	0x76, 0xaa, 0x74, 0xe1, 0x3f, 0x4b, 0xf8, 0x5f, 0x92, 0xd8, 0x23, 0xd5, 0x77, 0x23, 0x5d, 0xf8,
-	0x97, 0x48, 0x60, 0x29, 0x74, 0x8a, 0x39, 0xa7, 0x29, 0x15, 0x9f, 0x5b, 0x75, 0x4a, 0x55, 0xc8,
-	0xdb, 0x03, 0x1b, 0x9b, 0xc1, 0xab, 0x30, 0xb8, 0x49, 0x2f, 0xe2, 0xa9, 0x26, 0x3b, 0xae, 0x42,
-	0xbb, 0x3a, 0x8c, 0x2b, 0x07, 0x91, 0xc6, 0x27, 0x7c, 0x24, 0xc6, 0x92, 0x91, 0x38, 0xf8, 0x7d,
-	0x13, 0xba, 0xfb, 0x72, 0xae, 0x62, 0xfe, 0xf8, 0xf7, 0xa7, 0xe7, 0x01, 0x4c, 0x2a, 0x93, 0xbc,
-	0x69, 0x00, 0x96, 0x38, 0x24, 0xae, 0x9f, 0xf3, 0x2d, 0xc2, 0xd7, 0xea, 0x9c, 0x3f, 0x0f, 0x9d,
-	0x83, 0x4c, 0xc6, 0x87, 0x16, 0x95, 0x0d, 0x41, 0x6f, 0xe6, 0x73, 0x3d, 0x4d, 0xe4, 0xc7, 0x22,
-	0x4c, 0x13, 0xc2, 0xaf, 0x36, 0x83, 0x92, 0x35, 0xc2, 0x22, 0x64, 0xad, 0x52, 0x88, 0x92, 0x44,
-	0x11, 0x6e, 0xb9, 0x6c, 0x50, 0x32, 0x6f, 0x26, 0x89, 0xaa, 0xea, 0xa7, 0xde, 0x19, 0xf5, 0xd3,
-	0x37, 0xa0, 0x7a, 0x69, 0xb1, 0xd0, 0x74, 0xf6, 0xa3, 0xd9, 0x0e, 0xb8, 0xd5, 0xa7, 0x87, 0x85,
-	0xa5, 0xf3, 0x5b, 0x8b, 0x6f, 0x90, 0xfb, 0x65, 0x8b, 0x2d, 0xd4, 0x82, 0x1f, 0x83, 0x73, 0x4f,
-	0x26, 0xe4, 0x53, 0x3c, 0x3e, 0x67, 0x71, 0x3e, 0xb7, 0x27, 0x01, 0xb5, 0xed, 0xff, 0x84, 0xf1,
-	0x96, 0xfd, 0x9f, 0x20, 0x5b, 0x5a, 0xe6, 0x88, 0xc5, 0x36, 0xa6, 0x48, 0x1e, 0x1d, 0x67, 0x32,
-	0x4a, 0xa8, 0x18, 0x76, 0x59, 0x49, 0x06, 0xbf, 0x6d, 0xc0, 0xb9, 0x3d, 0x25, 0x63, 0xae, 0xf5,
-	0x5d, 0xcc, 0xb2, 0x88, 0x40, 0xc1, 0x83, 0xb6, 0x4e, 0x3f, 0x31, 0x31, 0x6a, 0x31, 0x6a, 0x63,
-	0x74, 0xcc, 0x1f, 0x87, 0x92, 0x1f, 0x6b, 0x9a, 0xaf, 0xc5, 0xcc, 0xaf, 0x07, 0x93, 0x1f, 0xeb,
-	0x85, 0x98, 0x3a, 0xb6, 0x6a, 0xe2, 0x7d, 0xec, 0x7d, 0x15, 0x86, 0x79, 0xa4, 0x8a, 0x14, 0x87,
-	0x37, 0x23, 0xb4, 0x49, 0x65, 0xad, 0xe2, 0xd2, 0x28, 0x57, 0xa0, 0xaf, 0x78, 0x84, 0x7b, 0x8f,
-	0x86, 0xe9, 0x90, 0x0e, 0x18, 0x16, 0x8e, 0x13, 0xfc, 0xa3, 0x01, 0x7d, 0xbb, 0x5e, 0xf2, 0x88,
-	0xb1, 0xbe, 0x51, 0x59, 0x7f, 0x03, 0x5a, 0x59, 0x3a, 0xb3, 0xef, 0x57, 0xcf, 0x2d, 0xe1, 0xe6,
-	0xb2, 0x8d, 0x0c, 0xf5, 0xf0, 0xd0, 0x9e, 0x8b, 0xf4, 0x28, 0x44, 0x77, 0xdb, 0x45, 0x3b, 0xc8,
-	0xc0, 0x48, 0xd0, 0xe7, 0x8c, 0x88, 0x72, 0x3d, 0x95, 0x85, 0x4d, 0xac, 0x8a, 0xf6, 0x5e, 0x83,
-	0x81, 0xe6, 0x5a, 0xa3, 0x35, 0xa9, 0x18, 0x4b, 0x7b, 0x38, 0x5e, 0xa8, 0x9f, 0x31, 0x24, 0xa5,
-	0xad, 0xd0, 0xd7, 0x0b, 0xc2, 0x7b, 0x19, 0xbc, 0xc8, 0x6e, 0xa4, 0x50, 0xc8, 0xc4, 0x16, 0x0c,
-	0x5d, 0x2a, 0x5c, 0x37, 0x4a, 0x09, 0x46, 0x9c, 0x4a, 0xe0, 0xcf, 0x1b, 0xd0, 0xaf, 0x0d, 0x45,
-	0xbf, 0x4f, 0x9a, 0xab, 0xb2, 0x80, 0xc2, 0x36, 0xf2, 0xa6, 0xd2, 0xfe, 0x88, 0xb8, 0x8c, 0xda,
-	0xc8, 0x53, 0x32, 0xe3, 0x65, 0x16, 0x60, 0x1b, 0xd3, 0xdd, 0x9e, 0xeb, 0xb4, 0xec, 0xc4, 0x56,
-	0x7e, 0x83, 0x05, 0x73, 0x44, 0x7f, 0x07, 0x49, 0x54, 0x44, 0x07, 0x91, 0x2e, 0x4b, 0xd2, 0x8a,
-	0xc6, 0x34, 0x7a, 0xc8, 0x15, 0xae, 0xc5, 0xee, 0x94, 0x92, 0x44, 0x3f, 0xa2, 0x0b, 0xc3, 0x4f,
-	0xa4, 0xe0, 0xb4, 0x53, 0x06, 0xcc, 0x41, 0xc6, 0x87, 0x52, 0x50, 0xb7, 0x28, 0x8e, 0xe5, 0x5c,
-	0x14, 0xb4, 0x41, 0x5c, 0x56, 0x92, 0xc1, 0xe7, 0x6d, 0x70, 0xf6, 0xac, 0xc7, 0xbc, 0xdb, 0xb0,
-	0x56, 0x7d, 0x71, 0x61, 0xa1, 0x49, 0x36, 0x0e, 0xeb, 0x65, 0xda, 0xde, 0x6a, 0x83, 0xaa, 0xd2,
-	0x41, 0x5e, 0xa3, 0x56, 0x3f, 0xca, 0x9a, 0x27, 0x3e, 0xca, 0xfe, 0x07, 0x5a, 0x0f, 0xd4, 0xf1,
-	0xf2, 0x57, 0xd1, 0x5e, 0x16, 0x09, 0x86, 0x6c, 0xef, 0x15, 0xe8, 0xa3, 0xb9, 0xa1, 0x26, 0xcc,
-	0xb2, 0x35, 0x52, 0xed, 0x88, 0x32, 0x58, 0xc6, 0x00, 0x95, 0x2c, 0xae, 0x6d, 0x81, 0x13, 0x4f,
-	0xd3, 0x2c, 0x51, 0x5c, 0xd8, 0x9a, 0xd9, 0x3b, 0xb9, 0x64, 0x56, 0xe9, 0x78, 0xdf, 0x85, 0x8d,
-	0x74, 0x51, 0xdb, 0x2d, 0xc2, 0xbf, 0x94, 0x3e, 0xb5, 0xea, 0x8f, 0xad, 0xd7, 0xd4, 0x09, 0xee,
-	0x2e, 0x20, 0xae, 0x87, 0x5c, 0x98, 0x6f, 0x49, 0x87, 0x75, 0x52, 0x7d, 0x47, 0x24, 0xf4, 0x2b,
-	0xa1, 0x17, 0x35, 0x12, 0xe1, 0x3d, 0x15, 0x1d, 0x2f, 0x40, 0x1b, 0x33, 0xed, 0x64, 0x21, 0x54,
-	0x02, 0x0b, 0x23, 0x39, 0x7d, 0x95, 0xce, 0xf5, 0x34, 0x34, 0x88, 0x89, 0x69, 0x0d, 0xe4, 0x3e,
-	0x02, 0xc4, 0xdb, 0x88, 0x99, 0x98, 0x82, 0x57, 0x61, 0x58, 0xda, 0x12, 0x9a, 0xa8, 0xf6, 0x49,
-	0x6b, 0xad, 0xe4, 0xee, 0x22, 0xd3, 0x7b, 0x0b, 0x36, 0xe6, 0xf3, 0x34, 0xd1, 0x61, 0x21, 0x43,
-	0xc5, 0x27, 0xf4, 0x94, 0x3e, 0x20, 0x33, 0x6b, 0x75, 0xc2, 0x07, 0xf3, 0x34, 0xb9, 0x2f, 0x19,
-	0x9f, 0x8c, 0x92, 0x23, 0xb6, 0x46, 0xfa, 0x25, 0x19, 0xbc, 0x05, 0x83, 0x7a, 0x9c, 0x3d, 0x17,
-	0x3a, 0xef, 0x72, 0x35, 0xe1, 0x1b, 0x4f, 0x79, 0x00, 0xdd, 0x7b, 0x52, 0xcd, 0xa2, 0x6c, 0xa3,
-	0x81, 0x6d, 0xf3, 0xb5, 0xb5, 0xd1, 0xf4, 0x06, 0xe0, 0xec, 0x45, 0x2a, 0xca, 0x32, 0x9e, 0x6d,
-	0xb4, 0x82, 0xd7, 0xc1, 0x29, 0xff, 0x18, 0xe9, 0x9a, 0x84, 0x9b, 0x8d, 0xa0, 0xd1, 0x6c, 0x1e,
-	0x07, 0x19, 0x04, 0xf1, 0xe5, 0x97, 0x6e, 0x73, 0xf1, 0xa5, 0x1b, 0xbc, 0x0f, 0x83, 0xfa, 0xe2,
-	0xca, 0x92, 0xbb, 0xb1, 0x28, 0xb9, 0x4f, 0xe9, 0x45, 0x97, 0x00, 0x25, 0x67, 0x61, 0x0d, 0x81,
-	0x1d, 0x64, 0xe0, 0x34, 0xb7, 0x76, 0xff, 0xf4, 0xc5, 0xe5, 0xc6, 0x9f, 0xbf, 0xb8, 0xdc, 0xf8,
-	0xeb, 0x17, 0x97, 0x9f, 0xfa, 0xec, 0x6f, 0x97, 0x1b, 0x1f, 0xbe, 0x52, 0xfb, 0x3d, 0x9f, 0x45,
-	0x85, 0x4a, 0x8f, 0xcc, 0x25, 0xa0, 0x24, 0x04, 0xdf, 0xce, 0x0f, 0x27, 0xdb, 0xf9, 0xc1, 0x76,
-	0xe9, 0xb1, 0x83, 0x2e, 0xfd, 0x95, 0x7f, 0xf3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xf3,
-	0x51, 0x2c, 0x93, 0x1f, 0x00, 0x00,
+	// 2912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0xcd, 0x8e, 0xdc, 0xc6,
+	0xd1, 0x9e, 0x7f, 0xb2, 0x66, 0xf6, 0x47, 0xb4, 0x7e, 0x28, 0xd9, 0x96, 0xf6, 0xe3, 0x17, 0xd9,
+	0x72, 0x64, 0xad, 0xe0, 0x0d, 0x1c, 0x18, 0xb1, 0x63, 0x47, 0x5a, 0xc9, 0xce, 0xda, 0x92, 0xbc,
+	0xee, 0x95, 0x61, 0xd8, 0x08, 0x42, 0x70, 0xc9, 0x9e, 0x59, 0x7a, 0x39, 0xdd, 0x54, 0x37, 0x47,
+	0x3b, 0xeb, 0x53, 0xae, 0x49, 0x7c, 0x09, 0xf2, 0x02, 0x7e, 0x80, 0xbc, 0x82, 0x81, 0xe4, 0x10,
+	0x20, 0xb9, 0xe5, 0x1c, 0x5f, 0x02, 0xe7, 0x9a, 0xe4, 0x01, 0x72, 0x0a, 0xaa, 0xba, 0xc9, 0xe1,
+	0xcc, 0xee, 0x4a, 0xb2, 0x81, 0x00, 0x01, 0xec, 0x5b, 0xfd, 0x36, 0xbb, 0xab, 0xaa, 0xab, 0xab,
+	0xba, 0x09, 0xcb, 0x79, 0x9a, 0xf3, 0x2c, 0x15, 0x7c, 0x3d, 0x57, 0xb2, 0x90, 0x9e, 0x53, 0xe2,
+	0x17, 0xae, 0x8d, 0xd2, 0x62, 0x6f, 0xb2, 0xbb, 0x1e, 0xcb, 0xf1, 0xf5, 0x91, 0x1c, 0xc9, 0xeb,
+	0x24, 0xb0, 0x3b, 0x19, 0x12, 0x46, 0x08, 0x41, 0x46, 0xf1, 0x02, 0xe4, 0x59, 0x24, 0x2c, 0xbc,
+	0x52, 0xa4, 0x63, 0xae, 0x8b, 0x68, 0x9c, 0x1b, 0x42, 0xf0, 0x59, 0x13, 0x7a, 0x77, 0xb9, 0xd6,
+	0xd1, 0x88, 0x7b, 0xab, 0xd0, 0xd2, 0x69, 0xe2, 0x37, 0xd6, 0x1a, 0x57, 0xda, 0x0c, 0x41, 0xa4,
+	0xc4, 0xe3, 0xc4, 0x6f, 0x1a, 0x4a, 0x3c, 0x26, 0x0a, 0x57, 0xca, 0x6f, 0xad, 0x35, 0xae, 0x0c,
+	0x18, 0x82, 0x9e, 0x07, 0xed, 0x24, 0x2a, 0x22, 0xbf, 0x4d, 0x24, 0x82, 0xbd, 0xef, 0xc1, 0x72,
+	0xae, 0x64, 0x1c, 0xa6, 0x62, 0x28, 0x43, 0xe2, 0x76, 0x88, 0x3b, 0x40, 0xea, 0x96, 0x18, 0xca,
+	0x5b, 0x28, 0xe5, 0x43, 0x2f, 0x12, 0x51, 0x76, 0xa8, 0xb9, 0xdf, 0x25, 0x76, 0x89, 0x7a, 0xcb,
+	0xd0, 0x4c, 0x13, 0xbf, 0x47, 0x9f, 0x6d, 0xa6, 0x09, 0x7e, 0x63, 0x32, 0x49, 0x13, 0xdf, 0x31,
+	0xdf, 0x40, 0xd8, 0x7b, 0x06, 0xdc, 0xdd, 0xa8, 0x88, 0xf7, 0xc2, 0x58, 0x14, 0xbe, 0x4b, 0xa2,
+	0x0e, 0x11, 0x36, 0x45, 0xe1, 0x5d, 0x00, 0x27, 0xde, 0xe3, 0xf1, 0xbe, 0x9e, 0x8c, 0x7d, 0x58,
+	0x6b, 0x5c, 0x59, 0x62, 0x15, 0x8e, 0x3c, 0xcd, 0x1f, 0x4c, 0xb8, 0x88, 0xb9, 0xdf, 0x37, 0x7a,
+	0x25, 0x1e, 0x7c, 0x00, 0xee, 0xa6, 0x14, 0x82, 0xc7, 0x85, 0x54, 0xde, 0x25, 0xe8, 0x97, 0x36,
+	0x0f, 0xad, 0x5d, 0x3a, 0x0c, 0x4a, 0xd2, 0x56, 0xe2, 0xbd, 0x00, 0x2b, 0x71, 0x29, 0x1d, 0xa6,
+	0x22, 0xe1, 0x53, 0x32, 0x55, 0x87, 0x2d, 0x57, 0xe4, 0x2d, 0xa4, 0x06, 0x9f, 0x37, 0xc0, 0xb9,
+	0x95, 0xea, 0x1c, 0xa7, 0xe7, 0x9d, 0x83, 0xde, 0x70, 0x22, 0xe2, 0xd9, 0x90, 0x5d, 0x44, 0xb7,
+	0x12, 0xef, 0x75, 0x58, 0xc9, 0x64, 0x1c, 0x65, 0x61, 0xa5, 0xed, 0x37, 0xd7, 0x5a, 0x57, 0xfa,
+	0x1b, 0x4f, 0xaf, 0x57, 0xb1, 0x50, 0xcd, 0x8e, 0x2d, 0x93, 0xec, 0x6c, 0xb6, 0x3f, 0x86, 0x55,
+	0xc5, 0xc7, 0xb2, 0xe0, 0x35, 0xf5, 0x16, 0xa9, 0x7b, 0x33, 0xf5, 0x0f, 0x55, 0x94, 0xdf, 0x93,
+	0x09, 0x67, 0x2b, 0x46, 0xb6, 0x52, 0x0f, 0xde, 0x03, 0xf7, 0xc6, 0x68, 0xa4, 0xf8, 0x28, 0x2a,
+	0xc8, 0xfe, 0x32, 0xb7, 0xb3, 0x6b, 0xca, 0x9c, 0x7c, 0x9c, 0xea, 0x82, 0x56, 0xe7, 0x30, 0x82,
+	0xbd, 0x8b, 0xd0, 0xe6, 0xd3, 0xdc, 0x84, 0x42, 0x7f, 0x03, 0xd6, 0x29, 0xca, 0x6e, 0x4f, 0x73,
+	0xc5, 0x88, 0x1e, 0xfc, 0xb1, 0x01, 0x9d, 0xb7, 0x95, 0x9c, 0xe4, 0xe8, 0x29, 0xc1, 0x79, 0x12,
+	0xf2, 0x87, 0x51, 0x46, 0x83, 0x3a, 0xcc, 0x41, 0xc2, 0xed, 0x87, 0x51, 0x86, 0x41, 0x90, 0xee,
+	0x4e, 0xe2, 0x7d, 0x5e, 0xd8, 0x30, 0x2b, 0x51, 0xe4, 0x08, 0xcb, 0x69, 0x19, 0x8e, 0x45, 0xbd,
+	0x35, 0xe8, 0xe0, 0x27, 0xb4, 0xdf, 0x5e, 0x6b, 0x2d, 0x7c, 0xdb, 0x30, 0x50, 0xa2, 0x38, 0xcc,
+	0xb9, 0xf6, 0x3b, 0x75, 0x89, 0xfb, 0x87, 0x39, 0x67, 0x86, 0xe1, 0xbd, 0x00, 0xed, 0x68, 0x34,
+	0xd2, 0x7e, 0x77, 0xd1, 0xc2, 0x95, 0x15, 0x18, 0x09, 0x04, 0xbf, 0x6b, 0x43, 0x77, 0x4b, 0x68,
+	0xae, 0x28, 0xaa, 0xa2, 0xe1, 0x90, 0xc7, 0x05, 0x2f, 0x77, 0x49, 0x85, 0x23, 0x6f, 0x4b, 0x33,
+	0x32, 0xaa, 0x35, 0x53, 0x85, 0x7b, 0x57, 0x60, 0x55, 0x8a, 0x30, 0x99, 0xe4, 0x59, 0x1a, 0x47,
+	0x05, 0x06, 0xd3, 0x94, 0x5c, 0xd3, 0x61, 0xcb, 0x52, 0xdc, 0x2a, 0xc9, 0x5b, 0xc9, 0xd4, 0x7b,
+	0x1f, 0x4e, 0xcd, 0x49, 0x92, 0x85, 0xcd, 0x2a, 0x2f, 0xcf, 0xa6, 0x68, 0xa6, 0xb3, 0xfe, 0xde,
+	0x4c, 0x17, 0xd7, 0x7e, 0x5b, 0x14, 0xea, 0x90, 0xad, 0xc8, 0x79, 0xaa, 0xf7, 0x7f, 0xd0, 0x52,
+	0x7c, 0x48, 0x1b, 0xb0, 0xbf, 0xb1, 0x62, 0x0c, 0xf1, 0xde, 0xee, 0x27, 0x3c, 0x2e, 0x18, 0x1f,
+	0x32, 0xe4, 0x79, 0x57, 0xc1, 0x2d, 0xa2, 0xdd, 0x8c, 0x87, 0x09, 0x1f, 0xd2, 0x56, 0xec, 0x6f,
+	0x2c, 0x5b, 0x8b, 0x21, 0xf9, 0x16, 0x1f, 0x32, 0xa7, 0xb0, 0x90, 0xf7, 0x06, 0x40, 0x1e, 0x29,
+	0x2e, 0x0a, 0x5a, 0x46, 0x8f, 0xe6, 0x76, 0xe9, 0xc8, 0xdc, 0xb6, 0x49, 0x64, 0x2b, 0x99, 0x9a,
+	0x59, 0xb9, 0x79, 0x89, 0x7b, 0x3f, 0x84, 0xc1, 0x66, 0x36, 0xd1, 0x05, 0x57, 0x34, 0x38, 0xed,
+	0x69, 0x8a, 0x51, 0xfc, 0x5e, 0x9d, 0xc3, 0xe6, 0xe4, 0x70, 0xdb, 0xa4, 0xc9, 0x94, 0x3e, 0xea,
+	0x92, 0xed, 0xba, 0x69, 0x32, 0xdd, 0x4a, 0xa6, 0x17, 0xee, 0xc1, 0xe9, 0xe3, 0x2c, 0x81, 0xa9,
+	0x6a, 0x9f, 0x1f, 0x92, 0xa3, 0x5c, 0x86, 0x20, 0x46, 0xc5, 0xc3, 0x28, 0x9b, 0x18, 0x07, 0x2d,
+	0xc4, 0x0d, 0x31, 0x7e, 0xd4, 0x7c, 0xb5, 0x71, 0xe1, 0x75, 0x58, 0x9e, 0x9f, 0xfd, 0x31, 0x23,
+	0x9d, 0xae, 0x8f, 0xd4, 0xa9, 0x69, 0x07, 0xbf, 0x68, 0x82, 0xbb, 0xad, 0xb8, 0x8d, 0x98, 0x4b,
+	0xd0, 0xd7, 0xf1, 0x1e, 0x1f, 0x47, 0xa1, 0x88, 0xc6, 0xdc, 0x8e, 0x00, 0x86, 0x74, 0x2f, 0x1a,
+	0xf3, 0x79, 0xd3, 0x37, 0x1f, 0x63, 0xfa, 0x9f, 0xc3, 0x99, 0x99, 0xe9, 0xc3, 0x5c, 0xf1, 0x30,
+	0xa5, 0xcf, 0xd8, 0x7d, 0x7e, 0x75, 0xe6, 0x85, 0x6a, 0x06, 0x33, 0x47, 0x54, 0x24, 0xe3, 0x11,
+	0x2f, 0x3f, 0xc2, 0xb8, 0x70, 0x1b, 0xce, 0x9d, 0x20, 0xfe, 0xb5, 0x4c, 0xf0, 0xcb, 0x16, 0x2c,
+	0xd7, 0x3c, 0xf2, 0x2e, 0x3f, 0x7c, 0xe4, 0xce, 0x39, 0x6e, 0x77, 0x34, 0x8f, 0xdd, 0x1d, 0x1f,
+	0x1d, 0xb7, 0x3b, 0xcc, 0xda, 0xaf, 0xcd, 0xd6, 0x3e, 0xff, 0xe9, 0xaf, 0xb7, 0x4b, 0xda, 0x4f,
+	0xba, 0x4b, 0x3a, 0x8f, 0x71, 0xd5, 0x73, 0x00, 0xa9, 0x0e, 0x15, 0xcf, 0xb3, 0x28, 0x36, 0xc7,
+	0x9b, 0xc3, 0xdc, 0x54, 0x33, 0x43, 0xc0, 0x60, 0x56, 0xf2, 0xc0, 0xee, 0x20, 0x3a, 0x03, 0x94,
+	0x3c, 0xf8, 0x2f, 0x04, 0x73, 0xf0, 0xd7, 0x26, 0xb4, 0xdf, 0x91, 0xa9, 0xa8, 0xe7, 0xd9, 0xc6,
+	0x89, 0x79, 0xb6, 0x39, 0x9f, 0x67, 0xcf, 0x83, 0xa3, 0x78, 0x16, 0x66, 0x98, 0xfa, 0x4d, 0xbe,
+	0xea, 0x29, 0x9e, 0xdd, 0xc1, 0xec, 0x7f, 0x1e, 0x9c, 0x58, 0x5a, 0x56, 0xdb, 0xb0, 0x62, 0x99,
+	0xdd, 0xa9, 0x1f, 0x0c, 0x9d, 0xe3, 0x0f, 0x86, 0x59, 0x6e, 0xee, 0x9e, 0x9c, 0x9b, 0xdd, 0x8c,
+	0x0f, 0x0b, 0x3c, 0xc8, 0x12, 0xbf, 0x57, 0x97, 0xa2, 0x61, 0x1c, 0x64, 0x6e, 0x4a, 0x91, 0x78,
+	0x2f, 0x02, 0xa8, 0x74, 0xb4, 0x67, 0x25, 0x9d, 0x23, 0x92, 0x2e, 0x71, 0x49, 0x94, 0xc1, 0x79,
+	0x35, 0x11, 0x58, 0xfe, 0x84, 0xc3, 0x34, 0x2b, 0xb8, 0x0a, 0x77, 0x27, 0x69, 0x96, 0x98, 0x15,
+	0xb8, 0xa4, 0x79, 0xce, 0x68, 0x32, 0x23, 0xf6, 0x16, 0x49, 0xed, 0xe4, 0x3c, 0x66, 0x67, 0x55,
+	0x9d, 0x74, 0x13, 0xf5, 0x70, 0xa5, 0xc1, 0x3f, 0x1a, 0xe0, 0xdc, 0x10, 0x45, 0xfa, 0x8d, 0x0d,
+	0x7c, 0x16, 0xba, 0x8a, 0xeb, 0x49, 0x56, 0x9a, 0xd7, 0x62, 0x95, 0x09, 0xdb, 0x8f, 0x33, 0x61,
+	0xe7, 0x89, 0x4c, 0xd8, 0x7d, 0x62, 0x13, 0xf6, 0x1e, 0x61, 0xc2, 0xe0, 0xd7, 0x4d, 0x70, 0xb7,
+	0x84, 0xe0, 0xea, 0xbb, 0x80, 0x12, 0x49, 0xf0, 0xab, 0x26, 0x38, 0x77, 0xf8, 0xb0, 0xf8, 0xce,
+	0x18, 0x22, 0x09, 0xfe, 0xd0, 0x04, 0x97, 0x21, 0xf6, 0x3f, 0x66, 0x8d, 0x17, 0x01, 0x68, 0xad,
+	0x27, 0x99, 0x84, 0x2c, 0x71, 0x9f, 0xcc, 0x72, 0x15, 0xfa, 0x66, 0xb5, 0x46, 0xb6, 0x77, 0x44,
+	0xd6, 0x18, 0xe3, 0xfe, 0x51, 0x1b, 0x3a, 0x4f, 0x6c, 0x43, 0xf7, 0x51, 0x36, 0xfc, 0x7d, 0x13,
+	0x9c, 0x1d, 0x3e, 0xfe, 0x96, 0x64, 0x93, 0x47, 0x27, 0x64, 0xe7, 0x9b, 0x25, 0xe4, 0xcf, 0x9a,
+	0x00, 0x3b, 0xa9, 0x18, 0x65, 0xfc, 0xbb, 0x5d, 0x29, 0x92, 0xe0, 0x37, 0x4d, 0x70, 0xee, 0x46,
+	0x6a, 0xff, 0x5b, 0x12, 0x51, 0xff, 0x0f, 0x3d, 0x29, 0xea, 0xf1, 0x53, 0x97, 0xeb, 0x4a, 0x41,
+	0x21, 0x12, 0x41, 0x6f, 0x5b, 0xc9, 0x64, 0x12, 0xcf, 0xbb, 0xba, 0x71, 0xb2, 0xab, 0x9b, 0xf3,
+	0xae, 0xae, 0xd6, 0xd6, 0x3a, 0x61, 0x6d, 0xc1, 0x6f, 0x1b, 0xb0, 0x44, 0x25, 0xe1, 0x5b, 0x13,
+	0x11, 0x17, 0xa9, 0x14, 0x58, 0x2b, 0x47, 0x45, 0xa1, 0x34, 0x7d, 0xc6, 0x65, 0x06, 0xf1, 0xd6,
+	0xa0, 0xad, 0x78, 0xa1, 0x6d, 0x93, 0x3f, 0xb0, 0x1d, 0x90, 0xcc, 0xb0, 0x92, 0x24, 0x0e, 0xda,
+	0x39, 0x52, 0xa3, 0x85, 0x4f, 0x19, 0x3b, 0x23, 0x1d, 0xfd, 0x93, 0x47, 0x2a, 0x1a, 0x6b, 0x7b,
+	0xfb, 0x62, 0x31, 0xec, 0xd7, 0xa9, 0xdf, 0xe8, 0x50, 0xb9, 0x48, 0x70, 0xf0, 0x45, 0x03, 0xdc,
+	0x9f, 0x46, 0x7a, 0x8f, 0x76, 0xcb, 0xac, 0x27, 0x47, 0x37, 0xd6, 0x7b, 0x72, 0x74, 0x5f, 0xc9,
+	0xdc, 0x8b, 0xf4, 0x5e, 0xd9, 0xcc, 0x22, 0x01, 0xd5, 0xeb, 0x71, 0xd4, 0x3a, 0x31, 0x8e, 0xda,
+	0x47, 0x1a, 0xf6, 0xc7, 0xc4, 0xc3, 0x1a, 0x74, 0xd0, 0xc1, 0xfa, 0x98, 0x58, 0x30, 0x8c, 0xe0,
+	0x06, 0x9c, 0xb9, 0x3d, 0x2d, 0xb8, 0x12, 0x51, 0x86, 0x9d, 0xd3, 0xc6, 0xa6, 0xcc, 0xe8, 0x72,
+	0xa5, 0x5a, 0x6c, 0x63, 0xb6, 0x58, 0x34, 0x78, 0xfd, 0x3e, 0xc6, 0x20, 0xc1, 0xbf, 0x1b, 0x30,
+	0x28, 0xc7, 0xd8, 0x89, 0xa3, 0x47, 0xf8, 0x25, 0x96, 0xd9, 0x09, 0x7e, 0x41, 0x8e, 0xf7, 0x36,
+	0xac, 0xe0, 0x67, 0x36, 0x42, 0x0c, 0x12, 0xf3, 0xa1, 0xd6, 0x62, 0x23, 0x7c, 0xec, 0x64, 0xd9,
+	0x92, 0x98, 0x9b, 0xfb, 0x73, 0x00, 0xb1, 0xe2, 0xd8, 0xcb, 0xe8, 0x07, 0x19, 0x59, 0xcd, 0x65,
+	0xae, 0xa1, 0xec, 0x3c, 0xc8, 0xd0, 0x11, 0xc3, 0x34, 0xe3, 0x26, 0x0e, 0x3b, 0x34, 0x47, 0x07,
+	0x09, 0x14, 0x88, 0xd7, 0xa0, 0x2f, 0x55, 0x3a, 0x4a, 0x45, 0x48, 0xb3, 0xed, 0x1e, 0x33, 0x5b,
+	0x30, 0x02, 0x9b, 0x32, 0xd3, 0xc1, 0x17, 0x2e, 0xf4, 0xb7, 0x84, 0x2e, 0xd4, 0xc4, 0xc4, 0xe4,
+	0xe2, 0x1d, 0xcf, 0x2a, 0xb4, 0x4c, 0xe7, 0x85, 0x04, 0x04, 0xbd, 0xe7, 0xa1, 0x1d, 0x89, 0x22,
+	0xb5, 0x37, 0x3c, 0xb5, 0x5b, 0xa4, 0xb2, 0xe6, 0x65, 0xc4, 0xf7, 0xae, 0x41, 0xcf, 0x5e, 0x39,
+	0xd9, 0x84, 0x70, 0xec, 0x7d, 0x55, 0x29, 0xe3, 0xad, 0x83, 0x93, 0xd8, 0xbb, 0x30, 0xbf, 0xb3,
+	0x38, 0x74, 0x79, 0x4b, 0xc6, 0x2a, 0x19, 0x6c, 0xcd, 0xa2, 0xd1, 0xc8, 0xde, 0x4b, 0xac, 0xcc,
+	0x44, 0xe9, 0x72, 0x89, 0x21, 0xcf, 0xdb, 0x00, 0x48, 0x85, 0xe0, 0x2a, 0xfc, 0x44, 0xa6, 0xc2,
+	0xef, 0x2d, 0x4e, 0xa2, 0x2a, 0x5a, 0x99, 0x9b, 0x96, 0xa0, 0x77, 0xdd, 0x66, 0x20, 0x52, 0x71,
+	0x16, 0xe7, 0x51, 0x56, 0x76, 0x26, 0x13, 0x95, 0x0a, 0x9a, 0x8f, 0x53, 0xa3, 0xe0, 0x2e, 0x2a,
+	0x94, 0x27, 0x37, 0x5e, 0x26, 0x1a, 0xc8, 0x7b, 0x05, 0xfa, 0x9a, 0x0e, 0x23, 0xa3, 0x02, 0xa4,
+	0x72, 0xba, 0xa6, 0x52, 0x9d, 0x54, 0x0c, 0x74, 0x05, 0xe3, 0x77, 0xc6, 0x91, 0xda, 0x37, 0x4a,
+	0xfd, 0xc5, 0xef, 0x94, 0xf9, 0x9c, 0x39, 0x63, 0x0b, 0x79, 0x01, 0xb4, 0x49, 0x76, 0x50, 0xf6,
+	0xa4, 0xa5, 0xac, 0xf1, 0x11, 0xf2, 0xbc, 0xab, 0xd0, 0xcb, 0x4d, 0xda, 0xf3, 0x97, 0x48, 0xec,
+	0x54, 0xfd, 0xb2, 0x80, 0x18, 0xac, 0x94, 0xf0, 0xde, 0x80, 0x65, 0xd3, 0xe9, 0x0e, 0x6d, 0x02,
+	0xf3, 0x97, 0x49, 0xe7, 0xdc, 0x4c, 0x67, 0x2e, 0xbf, 0xb1, 0xa5, 0xa2, 0x8e, 0xa2, 0x3b, 0x30,
+	0x75, 0x98, 0x03, 0xdd, 0x5f, 0x59, 0x74, 0x47, 0x95, 0x85, 0x98, 0xbb, 0x57, 0x82, 0xde, 0x6b,
+	0xb0, 0xc4, 0xed, 0x8e, 0x09, 0x75, 0x1c, 0x09, 0x7f, 0x95, 0xd4, 0xce, 0x1e, 0xdd, 0x50, 0xb8,
+	0x73, 0xd9, 0x80, 0xd7, 0x30, 0xef, 0x0a, 0x74, 0xed, 0x4d, 0xc8, 0x29, 0xd2, 0x5a, 0x5d, 0xbc,
+	0x8f, 0x62, 0x96, 0xef, 0xdd, 0x5c, 0xb8, 0x6c, 0xc0, 0xa6, 0xda, 0x23, 0x1d, 0xff, 0xa4, 0x1b,
+	0x84, 0xb9, 0x6b, 0x08, 0xbc, 0xcc, 0xd8, 0x00, 0xa8, 0xdd, 0xbd, 0x3c, 0xbd, 0xb8, 0xbc, 0xea,
+	0xe6, 0x84, 0xb9, 0x79, 0x09, 0x7a, 0x2f, 0x81, 0x23, 0x55, 0x82, 0x45, 0xce, 0xa1, 0x7f, 0x9a,
+	0x76, 0xea, 0x29, 0x7b, 0xc9, 0x80, 0xd4, 0x9b, 0x87, 0x54, 0xd6, 0xf4, 0xa4, 0x41, 0xbc, 0x6b,
+	0x80, 0x37, 0xe5, 0x78, 0xfb, 0x60, 0xb6, 0xfe, 0x99, 0x23, 0x49, 0xb1, 0x6f, 0xf9, 0x94, 0x09,
+	0x02, 0xe8, 0x9a, 0x12, 0xca, 0x3f, 0x7b, 0xe4, 0x40, 0xb6, 0x1c, 0x4c, 0x75, 0x59, 0x3a, 0x4e,
+	0x0b, 0xff, 0x1c, 0xa5, 0x66, 0x83, 0xe0, 0x01, 0x22, 0x87, 0x43, 0xcd, 0x0b, 0xdf, 0x27, 0xb2,
+	0xc5, 0x28, 0xc9, 0xeb, 0xb7, 0x52, 0xa5, 0x0b, 0xff, 0x3c, 0xe5, 0xff, 0x12, 0x45, 0x8d, 0x54,
+	0xdf, 0x89, 0x74, 0xe1, 0x5f, 0x20, 0x86, 0xc5, 0xd0, 0x28, 0xe6, 0x9c, 0xa6, 0x50, 0x7c, 0x66,
+	0xd1, 0x28, 0x55, 0x73, 0x60, 0x0f, 0xec, 0x77, 0x4c, 0x50, 0x3a, 0x07, 0xa9, 0x08, 0x75, 0xce,
+	0x63, 0xff, 0xd9, 0xd2, 0x71, 0x38, 0xf3, 0x0f, 0x53, 0x91, 0xc8, 0x03, 0x63, 0x93, 0x83, 0x54,
+	0x20, 0x10, 0xbc, 0x02, 0x83, 0x1b, 0xf4, 0x3c, 0x90, 0x6a, 0x5a, 0xf4, 0x65, 0x68, 0x57, 0x27,
+	0x77, 0x65, 0x4d, 0x92, 0xf8, 0x94, 0xe3, 0x13, 0x03, 0x23, 0x76, 0xf0, 0xaf, 0x16, 0x74, 0x77,
+	0xe4, 0x44, 0xc5, 0xfc, 0xf1, 0x97, 0x71, 0xcf, 0x01, 0x98, 0xb8, 0x27, 0x7e, 0xd3, 0x64, 0x63,
+	0xa2, 0x10, 0xbb, 0x5e, 0x14, 0xb4, 0x28, 0x19, 0x57, 0x45, 0xc1, 0x69, 0xe8, 0xec, 0x66, 0x32,
+	0xde, 0xb7, 0x29, 0xdc, 0x20, 0xf8, 0xc1, 0x7c, 0xa2, 0xf7, 0x12, 0x79, 0x20, 0xf0, 0xb6, 0xbf,
+	0x43, 0x26, 0x86, 0x92, 0xb4, 0x85, 0x15, 0xcb, 0x52, 0x25, 0x10, 0x25, 0x89, 0xa2, 0x24, 0xe7,
+	0xb2, 0x41, 0x49, 0xbc, 0x91, 0x24, 0xaa, 0x2a, 0xb6, 0x7a, 0x27, 0x14, 0x5b, 0xdf, 0x87, 0xea,
+	0xda, 0xc9, 0x77, 0x1e, 0x73, 0x2d, 0xb5, 0x01, 0x6e, 0xf5, 0x02, 0x64, 0x73, 0xd8, 0xe9, 0xf5,
+	0x8a, 0xb2, 0x7e, 0xbf, 0x84, 0xd8, 0x4c, 0xec, 0x98, 0x42, 0x3d, 0x57, 0x72, 0xd7, 0x1e, 0x4a,
+	0xf0, 0x75, 0x0a, 0xf5, 0x6d, 0xd4, 0x23, 0x7b, 0x7d, 0x04, 0xfe, 0xc2, 0x98, 0x8a, 0xc7, 0x3c,
+	0x7d, 0xc8, 0x95, 0xf6, 0xfb, 0x8b, 0x27, 0xe9, 0xdc, 0xb0, 0xcc, 0xca, 0x2d, 0x0c, 0x5d, 0x92,
+	0x75, 0xf0, 0x3e, 0x9c, 0x39, 0x56, 0xa1, 0x7a, 0x44, 0x6a, 0xd4, 0x1e, 0x91, 0xf0, 0x81, 0x4b,
+	0x66, 0xd6, 0xd5, 0x08, 0xa2, 0x14, 0x79, 0xa2, 0x45, 0x24, 0x82, 0x83, 0x77, 0x61, 0x69, 0x6e,
+	0x48, 0x54, 0x2b, 0x0e, 0xcb, 0xc3, 0x13, 0x41, 0x54, 0xdb, 0xe7, 0x87, 0x9a, 0x46, 0x1a, 0x30,
+	0x82, 0x6d, 0x50, 0xc8, 0xb1, 0x7d, 0x2d, 0x33, 0x48, 0xf0, 0x33, 0x70, 0xf0, 0x05, 0x06, 0x43,
+	0x14, 0xb5, 0xc6, 0x71, 0x3e, 0xb1, 0x03, 0x11, 0x6c, 0xdf, 0xbe, 0xcc, 0x8c, 0xec, 0xdb, 0xd7,
+	0xe2, 0x84, 0x70, 0x7b, 0xe6, 0xd1, 0x61, 0x26, 0xa3, 0x84, 0x1a, 0x11, 0x97, 0x95, 0x68, 0xf0,
+	0xe7, 0x26, 0x9c, 0xda, 0x56, 0x32, 0xe6, 0x5a, 0xdf, 0xc1, 0x1d, 0x1e, 0x51, 0x42, 0xf6, 0xa0,
+	0xad, 0xd3, 0x4f, 0x4d, 0xc8, 0xb7, 0x18, 0xc1, 0x18, 0xec, 0xe6, 0xfd, 0x4c, 0xc9, 0x03, 0x33,
+	0xef, 0x16, 0x33, 0x2f, 0x6a, 0x4c, 0x1e, 0xe8, 0x19, 0x9b, 0x14, 0x5b, 0x35, 0xf6, 0x0e, 0x6a,
+	0x5f, 0x86, 0xe5, 0x3c, 0x52, 0x45, 0x8a, 0xc3, 0x9b, 0x11, 0xda, 0x24, 0xb2, 0x54, 0x51, 0x69,
+	0x94, 0x4b, 0xd0, 0x57, 0x3c, 0xc2, 0xbc, 0x47, 0xc3, 0x74, 0x48, 0x06, 0x0c, 0x69, 0xc7, 0xce,
+	0x42, 0xe7, 0x69, 0x96, 0x19, 0x7e, 0xd7, 0x7c, 0x86, 0x28, 0xc4, 0x7e, 0x09, 0xbc, 0x71, 0x34,
+	0x0d, 0xf9, 0x94, 0xc7, 0x13, 0xfa, 0x14, 0x3a, 0x81, 0x76, 0x42, 0x8b, 0xad, 0x8e, 0xa3, 0xe9,
+	0xed, 0x92, 0x81, 0x91, 0xeb, 0x3d, 0x0f, 0x2b, 0x0f, 0x26, 0x5c, 0x1d, 0x86, 0x63, 0x3e, 0x0e,
+	0x4d, 0xb6, 0x73, 0xcc, 0xac, 0x88, 0x7c, 0x97, 0x8f, 0xc9, 0x26, 0x38, 0x79, 0x23, 0x97, 0xf0,
+	0x28, 0xc1, 0x10, 0xf3, 0xdd, 0x9a, 0xd8, 0x2d, 0x4b, 0x0c, 0xfe, 0xd9, 0x80, 0xbe, 0xb5, 0x25,
+	0x79, 0xcb, 0x78, 0xa6, 0x51, 0x79, 0xe6, 0x1a, 0xb4, 0xb2, 0x74, 0x6c, 0xef, 0x5f, 0x9f, 0x99,
+	0x3b, 0x4f, 0xe7, 0xed, 0xcf, 0x50, 0x0e, 0x8b, 0xb9, 0x89, 0x48, 0xa7, 0x66, 0x09, 0xc6, 0xa0,
+	0x0e, 0x12, 0x68, 0xea, 0xf8, 0x28, 0x29, 0xa2, 0x5c, 0xef, 0xc9, 0xc2, 0xe6, 0x90, 0x0a, 0xf7,
+	0x5e, 0x85, 0x81, 0xe6, 0x5a, 0xe3, 0xf2, 0xf1, 0x41, 0xd5, 0x16, 0x4d, 0x67, 0xea, 0xb5, 0x07,
+	0x71, 0x29, 0xeb, 0xf5, 0xf5, 0x0c, 0x41, 0xf3, 0x45, 0x36, 0x67, 0x86, 0x42, 0x26, 0x76, 0xcf,
+	0x76, 0xa9, 0xa1, 0x59, 0x2d, 0x39, 0x18, 0x8d, 0xd4, 0x1a, 0x7d, 0xd9, 0x80, 0x7e, 0x6d, 0x28,
+	0xda, 0x30, 0x9a, 0xab, 0xb2, 0xb0, 0x46, 0x18, 0x69, 0x7b, 0xd2, 0xbe, 0x04, 0xba, 0x8c, 0x60,
+	0xa4, 0x29, 0x99, 0xf1, 0x32, 0x42, 0x11, 0xc6, 0xcc, 0x66, 0xeb, 0x3d, 0x9a, 0x76, 0x62, 0x3b,
+	0x82, 0xc1, 0x8c, 0xb8, 0x45, 0x6f, 0x66, 0xf8, 0x38, 0xbc, 0x1b, 0xe9, 0xb2, 0x55, 0xa9, 0x70,
+	0x0c, 0x71, 0xdc, 0xce, 0x58, 0x7c, 0x98, 0xa4, 0x58, 0xa2, 0x68, 0x47, 0x4a, 0x1c, 0x9f, 0x4a,
+	0x61, 0x42, 0x61, 0xc0, 0x1c, 0x24, 0x7c, 0x2c, 0x05, 0xa9, 0x45, 0x71, 0x2c, 0x27, 0xc2, 0xb8,
+	0xde, 0x65, 0x25, 0x1a, 0x7c, 0xd9, 0x06, 0x67, 0xdb, 0x5a, 0xcc, 0xbb, 0x05, 0x4b, 0xd5, 0xd3,
+	0x2e, 0x36, 0x20, 0xb4, 0xc6, 0xe5, 0x7a, 0xd2, 0xd9, 0x5e, 0x04, 0xa8, 0x5b, 0x19, 0xe4, 0x35,
+	0x6c, 0xf1, 0x81, 0xb8, 0x79, 0xe4, 0x81, 0xf8, 0x59, 0x68, 0x3d, 0x50, 0x87, 0xf3, 0x4f, 0xa4,
+	0xdb, 0x59, 0x24, 0x18, 0x92, 0xbd, 0x97, 0xa1, 0x8f, 0xcb, 0x0d, 0x35, 0x1d, 0x4f, 0x7e, 0x7b,
+	0xb1, 0x74, 0x31, 0xc7, 0x16, 0x03, 0x14, 0x32, 0x30, 0xd6, 0xce, 0xf1, 0x5e, 0x9a, 0x25, 0x8a,
+	0x0b, 0xdb, 0x4b, 0x79, 0x47, 0xa7, 0xcc, 0x2a, 0x19, 0xef, 0x27, 0xb0, 0x9a, 0xce, 0x6a, 0xfe,
+	0x99, 0xfb, 0xe7, 0xc2, 0xa7, 0xd6, 0x15, 0xb0, 0x95, 0x9a, 0x38, 0x65, 0xea, 0x33, 0x78, 0xde,
+	0x87, 0x5c, 0x98, 0xe7, 0x78, 0x87, 0x75, 0x52, 0x7d, 0x5b, 0x24, 0xf4, 0x1a, 0xa7, 0x67, 0xb5,
+	0x33, 0xd5, 0x01, 0x74, 0xa6, 0x3f, 0x0f, 0x6d, 0x8c, 0xb4, 0xa3, 0x05, 0x72, 0x99, 0xf4, 0x18,
+	0xf1, 0xe9, 0x17, 0x81, 0x89, 0xde, 0x0b, 0xcd, 0xe1, 0x88, 0x61, 0x0d, 0x64, 0x3e, 0x3a, 0xfb,
+	0x6e, 0xc9, 0x03, 0x13, 0x82, 0x97, 0x61, 0xb9, 0x5c, 0x4b, 0x68, 0xbc, 0xda, 0x27, 0xa9, 0xa5,
+	0x92, 0xba, 0x89, 0x44, 0xef, 0x4d, 0x58, 0xc5, 0x74, 0xae, 0xc3, 0x42, 0x86, 0x8a, 0x8f, 0xe8,
+	0x5d, 0x65, 0xb0, 0xd6, 0x9a, 0xaf, 0x1f, 0x3f, 0x98, 0xa4, 0xc9, 0x7d, 0xc9, 0xf8, 0x68, 0x2b,
+	0x99, 0xb2, 0x25, 0x92, 0x2f, 0xd1, 0xe0, 0x4d, 0x18, 0xd4, 0xfd, 0xec, 0xb9, 0xd0, 0xb9, 0xcb,
+	0xd5, 0x88, 0xaf, 0x3e, 0xe5, 0x01, 0x74, 0xef, 0x49, 0x35, 0x8e, 0xb2, 0xd5, 0x06, 0xc2, 0xe6,
+	0x49, 0x77, 0xb5, 0xe9, 0x0d, 0xc0, 0xd9, 0x8e, 0x54, 0x94, 0x65, 0x3c, 0x5b, 0x6d, 0x05, 0xaf,
+	0x81, 0x53, 0xbe, 0xad, 0x53, 0xfb, 0x8c, 0x9b, 0x8d, 0xd2, 0xb6, 0xd9, 0x3c, 0x0e, 0x12, 0xe8,
+	0x34, 0x2f, 0x4f, 0xa1, 0xe6, 0xec, 0x14, 0x0a, 0xde, 0x87, 0x41, 0x7d, 0x72, 0x65, 0x2b, 0xd6,
+	0x98, 0xb5, 0x62, 0xc7, 0x68, 0x51, 0x73, 0xa8, 0xe4, 0x38, 0xac, 0x9d, 0x0e, 0x0e, 0x12, 0xf0,
+	0x33, 0x37, 0x37, 0xff, 0xf4, 0xd5, 0xc5, 0xc6, 0x5f, 0xbe, 0xba, 0xd8, 0xf8, 0xdb, 0x57, 0x17,
+	0x9f, 0xfa, 0xfc, 0xef, 0x17, 0x1b, 0x1f, 0xbf, 0x5c, 0xfb, 0x6b, 0x64, 0x1c, 0x15, 0x2a, 0x9d,
+	0x9a, 0xe6, 0xb0, 0x44, 0x04, 0xbf, 0x9e, 0xef, 0x8f, 0xae, 0xe7, 0xbb, 0xd7, 0x4b, 0x8b, 0xed,
+	0x76, 0xe9, 0x1f, 0x91, 0x1f, 0xfc, 0x67, 0x00, 0x68, 0x1e, 0x2f, 0x94, 0x8b, 0x22, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -3693,6 +3927,21 @@ func (m *OnDuplicateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RowIdx != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.RowIdx))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsReplace {
+		i--
+		if m.IsReplace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.TableDef != nil {
 		{
 			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
@@ -3794,6 +4043,20 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.RightCond) > 0 {
 		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4434,6 +4697,20 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.RightCond) > 0 {
 		for iNdEx := len(m.RightCond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5172,6 +5449,20 @@ func (m *Instruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.WinSpec != nil {
+		{
+			size, err := m.WinSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
 	if m.RightJoin != nil {
 		{
 			size, err := m.RightJoin.MarshalToSizedBuffer(dAtA[:i])
@@ -5560,6 +5851,34 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterReceivers) > 0 {
+		for iNdEx := len(m.RuntimeFilterReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterReceivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for iNdEx := len(m.RuntimeFilterProbeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterProbeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.Timestamp != nil {
 		{
 			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -5641,7 +5960,7 @@ func (m *Source) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
+func (m *RuntimeFilterReceiver) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5651,12 +5970,12 @@ func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RuntimeFilterReceiver) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RuntimeFilterReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5665,15 +5984,6 @@ func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Payload) > 0 {
-		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Payload[iNdEx])
-			copy(dAtA[i:], m.Payload[iNdEx])
-			i = encodeVarintPipeline(dAtA, i, uint64(len(m.Payload[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
 	if len(m.Addr) > 0 {
 		i -= len(m.Addr)
 		copy(dAtA[i:], m.Addr)
@@ -5681,10 +5991,113 @@ func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Id)))
+	if len(m.Col) > 0 {
+		i -= len(m.Col)
+		copy(dAtA[i:], m.Col)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Col)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uuid) > 0 {
+		i -= len(m.Uuid)
+		copy(dAtA[i:], m.Uuid)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Uuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuntimeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bloom) > 0 {
+		i -= len(m.Bloom)
+		copy(dAtA[i:], m.Bloom)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Bloom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Keys) > 0 {
+		i -= len(m.Keys)
+		copy(dAtA[i:], m.Keys)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Keys)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Payload[iNdEx])
+			copy(dAtA[i:], m.Payload[iNdEx])
+			i = encodeVarintPipeline(dAtA, i, uint64(len(m.Payload[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintPipeline(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5720,6 +6133,26 @@ func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.QueryDeadline != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.QueryDeadline))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.QueryMemLimit != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.QueryMemLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxExecutionTime != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.MaxExecutionTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SpillSize != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.SpillSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ReaderSize != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
 		i--
@@ -5773,21 +6206,21 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.AnalysisNodeList) > 0 {
-		dAtA77 := make([]byte, len(m.AnalysisNodeList)*10)
-		var j76 int
+		dAtA78 := make([]byte, len(m.AnalysisNodeList)*10)
+		var j77 int
 		for _, num1 := range m.AnalysisNodeList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j76++
+				j77++
 			}
-			dAtA77[j76] = uint8(num)
-			j76++
+			dAtA78[j77] = uint8(num)
+			j77++
 		}
-		i -= j76
-		copy(dAtA[i:], dAtA77[:j76])
-		i = encodeVarintPipeline(dAtA, i, uint64(j76))
+		i -= j77
+		copy(dAtA[i:], dAtA78[:j77])
+		i = encodeVarintPipeline(dAtA, i, uint64(j77))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -6447,6 +6880,12 @@ func (m *OnDuplicateKey) ProtoSize() (n int) {
 		l = m.TableDef.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if m.IsReplace {
+		n += 2
+	}
+	if m.RowIdx != 0 {
+		n += 1 + sovPipeline(uint64(m.RowIdx))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6501,6 +6940,12 @@ func (m *Join) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6763,6 +7208,12 @@ func (m *SemiJoin) ProtoSize() (n int) {
 			n += 1 + l + sovPipeline(uint64(l))
 		}
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7159,6 +7610,10 @@ func (m *Instruction) ProtoSize() (n int) {
 		l = m.RightJoin.ProtoSize()
 		n += 2 + l + sovPipeline(uint64(l))
 	}
+	if m.WinSpec != nil {
+		l = m.WinSpec.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7226,6 +7681,65 @@ func (m *Source) ProtoSize() (n int) {
 		l = m.Timestamp.ProtoSize()
 		n += 1 + l + sovPipeline(uint64(l))
 	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for _, e := range m.RuntimeFilterProbeList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if len(m.RuntimeFilterReceivers) > 0 {
+		for _, e := range m.RuntimeFilterReceivers {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RuntimeFilterReceiver) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.Col)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RuntimeFilter) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Typ != 0 {
+		n += 1 + sovPipeline(uint64(m.Typ))
+	}
+	l = len(m.Keys)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	l = len(m.Bloom)
+	if l > 0 {
+		n += 1 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7282,6 +7796,18 @@ func (m *ProcessLimitation) ProtoSize() (n int) {
 	if m.ReaderSize != 0 {
 		n += 1 + sovPipeline(uint64(m.ReaderSize))
 	}
+	if m.SpillSize != 0 {
+		n += 1 + sovPipeline(uint64(m.SpillSize))
+	}
+	if m.MaxExecutionTime != 0 {
+		n += 1 + sovPipeline(uint64(m.MaxExecutionTime))
+	}
+	if m.QueryMemLimit != 0 {
+		n += 1 + sovPipeline(uint64(m.QueryMemLimit))
+	}
+	if m.QueryDeadline != 0 {
+		n += 1 + sovPipeline(uint64(m.QueryDeadline))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9526,6 +10052,45 @@ func (m *OnDuplicateKey) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReplace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReplace = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowIdx", wireType)
+			}
+			m.RowIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -9905,6 +10470,40 @@ func (m *Join) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -11682,16 +12281,50 @@ func (m *SemiJoin) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPipeline(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPipeline
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
@@ -14319,6 +14952,42 @@ func (m *Instruction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinSpec == nil {
+				m.WinSpec = &plan.WindowSpec{}
+			}
+			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -14742,6 +15411,361 @@ func (m *Source) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &plan.RuntimeFilterSpec{})
+			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterReceivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterReceivers = append(m.RuntimeFilterReceivers, &RuntimeFilterReceiver{})
+			if err := m.RuntimeFilterReceivers[len(m.RuntimeFilterReceivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeFilterReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeFilterReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeFilterReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uuid == nil {
+				m.Uuid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Col = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys[:0], dAtA[iNdEx:postIndex]...)
+			if m.Keys == nil {
+				m.Keys = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bloom = append(m.Bloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bloom == nil {
+				m.Bloom = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -15054,6 +16078,82 @@ func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillSize", wireType)
+			}
+			m.SpillSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpillSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionTime", wireType)
+			}
+			m.MaxExecutionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMemLimit", wireType)
+			}
+			m.QueryMemLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryMemLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryDeadline", wireType)
+			}
+			m.QueryDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
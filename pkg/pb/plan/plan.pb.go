@@ -12,6 +12,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -28,18 +29,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CompressType int32
 
 const (
-	CompressType_None CompressType = 0
-	CompressType_Lz4  CompressType = 1
+	CompressType_None   CompressType = 0
+	CompressType_Lz4    CompressType = 1
+	CompressType_Zstd   CompressType = 2
+	CompressType_Snappy CompressType = 3
 )
 
 var CompressType_name = map[int32]string{
 	0: "None",
 	1: "Lz4",
+	2: "Zstd",
+	3: "Snappy",
 }
 
 var CompressType_value = map[string]int32{
-	"None": 0,
-	"Lz4":  1,
+	"None":   0,
+	"Lz4":    1,
+	"Zstd":   2,
+	"Snappy": 3,
 }
 
 func (x CompressType) String() string {
@@ -326,6 +333,62 @@ func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{39, 0}
 }
 
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_PRECEDING   FrameBound_BoundType = 0
+	FrameBound_CURRENT_ROW FrameBound_BoundType = 1
+	FrameBound_FOLLOWING   FrameBound_BoundType = 2
+)
+
+var FrameBound_BoundType_name = map[int32]string{
+	0: "PRECEDING",
+	1: "CURRENT_ROW",
+	2: "FOLLOWING",
+}
+
+var FrameBound_BoundType_value = map[string]int32{
+	"PRECEDING":   0,
+	"CURRENT_ROW": 1,
+	"FOLLOWING":   2,
+}
+
+func (x FrameBound_BoundType) String() string {
+	return proto.EnumName(FrameBound_BoundType_name, int32(x))
+}
+
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS   FrameClause_FrameType = 0
+	FrameClause_RANGE  FrameClause_FrameType = 1
+	FrameClause_GROUPS FrameClause_FrameType = 2
+)
+
+var FrameClause_FrameType_name = map[int32]string{
+	0: "ROWS",
+	1: "RANGE",
+	2: "GROUPS",
+}
+
+var FrameClause_FrameType_value = map[string]int32{
+	"ROWS":   0,
+	"RANGE":  1,
+	"GROUPS": 2,
+}
+
+func (x FrameClause_FrameType) String() string {
+	return proto.EnumName(FrameClause_FrameType_name, int32(x))
+}
+
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{41, 0}
+}
+
 type Node_NodeType int32
 
 const (
@@ -450,7 +513,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{46, 0}
 }
 
 type Node_JoinFlag int32
@@ -496,7 +559,7 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{46, 1}
 }
 
 type Node_AggMode int32
@@ -524,7 +587,60 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{46, 2}
+}
+
+type LockTarget_LockMode int32
+
+const (
+	LockTarget_EXCLUSIVE LockTarget_LockMode = 0
+	LockTarget_SHARED    LockTarget_LockMode = 1
+)
+
+var LockTarget_LockMode_name = map[int32]string{
+	0: "EXCLUSIVE",
+	1: "SHARED",
+}
+
+var LockTarget_LockMode_value = map[string]int32{
+	"EXCLUSIVE": 0,
+	"SHARED":    1,
+}
+
+func (x LockTarget_LockMode) String() string {
+	return proto.EnumName(LockTarget_LockMode_name, int32(x))
+}
+
+func (LockTarget_LockMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+}
+
+type LockTarget_WaitPolicy int32
+
+const (
+	LockTarget_WAIT        LockTarget_WaitPolicy = 0
+	LockTarget_NO_WAIT     LockTarget_WaitPolicy = 1
+	LockTarget_SKIP_LOCKED LockTarget_WaitPolicy = 2
+)
+
+var LockTarget_WaitPolicy_name = map[int32]string{
+	0: "WAIT",
+	1: "NO_WAIT",
+	2: "SKIP_LOCKED",
+}
+
+var LockTarget_WaitPolicy_value = map[string]int32{
+	"WAIT":        0,
+	"NO_WAIT":     1,
+	"SKIP_LOCKED": 2,
+}
+
+func (x LockTarget_WaitPolicy) String() string {
+	return proto.EnumName(LockTarget_WaitPolicy_name, int32(x))
+}
+
+func (LockTarget_WaitPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 1}
 }
 
 type Query_StatementType int32
@@ -561,7 +677,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{54, 0}
 }
 
 type TransationControl_TclType int32
@@ -589,7 +705,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -617,7 +733,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
 }
 
 type DataControl_DclType int32
@@ -657,7 +773,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{61, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -763,7 +879,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{62, 0}
 }
 
 type Type struct {
@@ -3342,12 +3458,14 @@ func (m *PartitionColumns) GetPartitionColumns() []string {
 }
 
 type PartitionItem struct {
-	PartitionName        string   `protobuf:"bytes,1,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	OrdinalPosition      uint32   `protobuf:"varint,2,opt,name=ordinal_position,json=ordinalPosition,proto3" json:"ordinal_position,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Comment              string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	LessThan             []*Expr  `protobuf:"bytes,5,rep,name=less_than,json=lessThan,proto3" json:"less_than,omitempty"`
-	InValues             []*Expr  `protobuf:"bytes,6,rep,name=in_values,json=inValues,proto3" json:"in_values,omitempty"`
+	PartitionName   string  `protobuf:"bytes,1,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	OrdinalPosition uint32  `protobuf:"varint,2,opt,name=ordinal_position,json=ordinalPosition,proto3" json:"ordinal_position,omitempty"`
+	Description     string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Comment         string  `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	LessThan        []*Expr `protobuf:"bytes,5,rep,name=less_than,json=lessThan,proto3" json:"less_than,omitempty"`
+	InValues        []*Expr `protobuf:"bytes,6,rep,name=in_values,json=inValues,proto3" json:"in_values,omitempty"`
+	// partition_table_name is the hidden table storing the rows of the partition
+	PartitionTableName   string   `protobuf:"bytes,7,opt,name=partition_table_name,json=partitionTableName,proto3" json:"partition_table_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3428,6 +3546,13 @@ func (m *PartitionItem) GetInValues() []*Expr {
 	return nil
 }
 
+func (m *PartitionItem) GetPartitionTableName() string {
+	if m != nil {
+		return m.PartitionTableName
+	}
+	return ""
+}
+
 type ViewDef struct {
 	View                 string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4087,11 +4212,139 @@ func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 	return OrderBySpec_INTERNAL
 }
 
+type FrameBound struct {
+	Type FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameBound_BoundType" json:"type,omitempty"`
+	// unbounded is only meaningful for PRECEDING and FOLLOWING
+	Unbounded bool `protobuf:"varint,2,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	// val is the constant offset of a bounded PRECEDING/FOLLOWING bound
+	Val                  *Expr    `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrameBound) Reset()         { *m = FrameBound{} }
+func (m *FrameBound) String() string { return proto.CompactTextString(m) }
+func (*FrameBound) ProtoMessage()    {}
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
+}
+func (m *FrameBound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameBound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameBound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameBound.Merge(m, src)
+}
+func (m *FrameBound) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameBound) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameBound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameBound proto.InternalMessageInfo
+
+func (m *FrameBound) GetType() FrameBound_BoundType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameBound_PRECEDING
+}
+
+func (m *FrameBound) GetUnbounded() bool {
+	if m != nil {
+		return m.Unbounded
+	}
+	return false
+}
+
+func (m *FrameBound) GetVal() *Expr {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+type FrameClause struct {
+	Type                 FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameClause_FrameType" json:"type,omitempty"`
+	Start                *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FrameClause) Reset()         { *m = FrameClause{} }
+func (m *FrameClause) String() string { return proto.CompactTextString(m) }
+func (*FrameClause) ProtoMessage()    {}
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
+}
+func (m *FrameClause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameClause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameClause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameClause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameClause.Merge(m, src)
+}
+func (m *FrameClause) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameClause) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameClause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameClause proto.InternalMessageInfo
+
+func (m *FrameClause) GetType() FrameClause_FrameType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameClause_ROWS
+}
+
+func (m *FrameClause) GetStart() *FrameBound {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *FrameClause) GetEnd() *FrameBound {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
 type WindowSpec struct {
-	PartitionBy          []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
-	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	WindowFunc           *Expr          `protobuf:"bytes,1,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
+	PartitionBy          []*Expr        `protobuf:"bytes,2,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OrderBy              []*OrderBySpec `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Frame                *FrameClause   `protobuf:"bytes,4,opt,name=frame,proto3" json:"frame,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -4101,7 +4354,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4130,53 +4383,59 @@ func (m *WindowSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WindowSpec proto.InternalMessageInfo
 
-func (m *WindowSpec) GetPartitionBy() []*Expr {
+func (m *WindowSpec) GetWindowFunc() *Expr {
 	if m != nil {
-		return m.PartitionBy
+		return m.WindowFunc
 	}
 	return nil
 }
 
-func (m *WindowSpec) GetOrderBy() []*OrderBySpec {
+func (m *WindowSpec) GetPartitionBy() []*Expr {
 	if m != nil {
-		return m.OrderBy
+		return m.PartitionBy
 	}
 	return nil
 }
 
-func (m *WindowSpec) GetLead() int32 {
+func (m *WindowSpec) GetOrderBy() []*OrderBySpec {
 	if m != nil {
-		return m.Lead
+		return m.OrderBy
 	}
-	return 0
+	return nil
 }
 
-func (m *WindowSpec) GetLag() int32 {
+func (m *WindowSpec) GetFrame() *FrameClause {
 	if m != nil {
-		return m.Lag
+		return m.Frame
 	}
-	return 0
+	return nil
 }
 
 type InsertCtx struct {
-	Ref                  *ObjectRef       `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
-	TableDef             *TableDef        `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	OnDuplicateIdx       []int32          `protobuf:"varint,3,rep,packed,name=on_duplicate_idx,json=onDuplicateIdx,proto3" json:"on_duplicate_idx,omitempty"`
-	OnDuplicateExpr      map[string]*Expr `protobuf:"bytes,4,rep,name=on_duplicate_expr,json=onDuplicateExpr,proto3" json:"on_duplicate_expr,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	IdxRef               []*ObjectRef     `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
-	IdxIdx               []int32          `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
-	ParentIdx            map[string]int32 `protobuf:"bytes,7,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	ClusterTable         *ClusterTable    `protobuf:"bytes,8,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Ref             *ObjectRef       `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	TableDef        *TableDef        `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	OnDuplicateIdx  []int32          `protobuf:"varint,3,rep,packed,name=on_duplicate_idx,json=onDuplicateIdx,proto3" json:"on_duplicate_idx,omitempty"`
+	OnDuplicateExpr map[string]*Expr `protobuf:"bytes,4,rep,name=on_duplicate_expr,json=onDuplicateExpr,proto3" json:"on_duplicate_expr,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IdxRef          []*ObjectRef     `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
+	IdxIdx          []int32          `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
+	ParentIdx       map[string]int32 `protobuf:"bytes,7,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ClusterTable    *ClusterTable    `protobuf:"bytes,8,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
+	// is_replace is set for REPLACE, the rows conflicting on any unique key
+	// are deleted before the new rows are inserted.
+	IsReplace bool `protobuf:"varint,9,opt,name=is_replace,json=isReplace,proto3" json:"is_replace,omitempty"`
+	// row_idx is the position of the column numbering the new rows of REPLACE,
+	// the rows of the join for the same new row have the same number.
+	RowIdx               int32    `protobuf:"varint,10,opt,name=row_idx,json=rowIdx,proto3" json:"row_idx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4261,24 +4520,41 @@ func (m *InsertCtx) GetClusterTable() *ClusterTable {
 	return nil
 }
 
+func (m *InsertCtx) GetIsReplace() bool {
+	if m != nil {
+		return m.IsReplace
+	}
+	return false
+}
+
+func (m *InsertCtx) GetRowIdx() int32 {
+	if m != nil {
+		return m.RowIdx
+	}
+	return 0
+}
+
 type UpdateCtx struct {
-	Ref                  []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
-	Idx                  []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
-	TableDefs            []*TableDef  `protobuf:"bytes,3,rep,name=tableDefs,proto3" json:"tableDefs,omitempty"`
-	UpdateCol            []*ColPosMap `protobuf:"bytes,4,rep,name=update_col,json=updateCol,proto3" json:"update_col,omitempty"`
-	IdxRef               []*ObjectRef `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
-	IdxIdx               []int32      `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
-	OnRestrictRef        []*ObjectRef `protobuf:"bytes,7,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
-	OnRestrictIdx        []int32      `protobuf:"varint,8,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
-	OnCascadeRef         []*ObjectRef `protobuf:"bytes,9,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
-	OnCascadeIdx         []*IdList    `protobuf:"bytes,10,rep,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
-	OnCascadeDef         []*TableDef  `protobuf:"bytes,11,rep,name=on_cascade_def,json=onCascadeDef,proto3" json:"on_cascade_def,omitempty"`
-	OnCascadeUpdateCol   []*ColPosMap `protobuf:"bytes,12,rep,name=on_cascade_update_col,json=onCascadeUpdateCol,proto3" json:"on_cascade_update_col,omitempty"`
-	OnSetRef             []*ObjectRef `protobuf:"bytes,13,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
-	OnSetIdx             []*IdList    `protobuf:"bytes,14,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
-	OnSetDef             []*TableDef  `protobuf:"bytes,15,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
-	OnSetUpdateCol       []*ColPosMap `protobuf:"bytes,16,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
-	ParentIdx            []*ColPosMap `protobuf:"bytes,17,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty"`
+	Ref                []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
+	Idx                []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
+	TableDefs          []*TableDef  `protobuf:"bytes,3,rep,name=tableDefs,proto3" json:"tableDefs,omitempty"`
+	UpdateCol          []*ColPosMap `protobuf:"bytes,4,rep,name=update_col,json=updateCol,proto3" json:"update_col,omitempty"`
+	IdxRef             []*ObjectRef `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
+	IdxIdx             []int32      `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
+	OnRestrictRef      []*ObjectRef `protobuf:"bytes,7,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
+	OnRestrictIdx      []int32      `protobuf:"varint,8,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
+	OnCascadeRef       []*ObjectRef `protobuf:"bytes,9,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
+	OnCascadeIdx       []*IdList    `protobuf:"bytes,10,rep,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
+	OnCascadeDef       []*TableDef  `protobuf:"bytes,11,rep,name=on_cascade_def,json=onCascadeDef,proto3" json:"on_cascade_def,omitempty"`
+	OnCascadeUpdateCol []*ColPosMap `protobuf:"bytes,12,rep,name=on_cascade_update_col,json=onCascadeUpdateCol,proto3" json:"on_cascade_update_col,omitempty"`
+	OnSetRef           []*ObjectRef `protobuf:"bytes,13,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
+	OnSetIdx           []*IdList    `protobuf:"bytes,14,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
+	OnSetDef           []*TableDef  `protobuf:"bytes,15,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
+	OnSetUpdateCol     []*ColPosMap `protobuf:"bytes,16,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
+	ParentIdx          []*ColPosMap `protobuf:"bytes,17,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty"`
+	// partition_idx maps the partition key columns of each updated table to
+	// the positions of their old values.
+	PartitionIdx         []*ColPosMap `protobuf:"bytes,18,rep,name=partition_idx,json=partitionIdx,proto3" json:"partition_idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -4288,7 +4564,7 @@ func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4436,6 +4712,13 @@ func (m *UpdateCtx) GetParentIdx() []*ColPosMap {
 	return nil
 }
 
+func (m *UpdateCtx) GetPartitionIdx() []*ColPosMap {
+	if m != nil {
+		return m.PartitionIdx
+	}
+	return nil
+}
+
 type AnalyzeInfo struct {
 	InputRows            int64    `protobuf:"varint,1,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"`
 	OutputRows           int64    `protobuf:"varint,2,opt,name=output_rows,json=outputRows,proto3" json:"output_rows,omitempty"`
@@ -4459,7 +4742,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4607,20 +4890,44 @@ type Node struct {
 	TblFuncExprList []*Expr        `protobuf:"bytes,25,rep,name=tbl_func_expr_list,json=tblFuncExprList,proto3" json:"tbl_func_expr_list,omitempty"`
 	// The pipeline will determine the parallelism by traversing the plan
 	// when it is received. Then the build is built based on this information.
-	Parallelism          int32         `protobuf:"varint,26,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
-	ClusterTable         *ClusterTable `protobuf:"bytes,27,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
-	NotCacheable         bool          `protobuf:"varint,28,opt,name=not_cacheable,json=notCacheable,proto3" json:"not_cacheable,omitempty"`
-	InsertCtx            *InsertCtx    `protobuf:"bytes,29,opt,name=insert_ctx,json=insertCtx,proto3" json:"insert_ctx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Parallelism  int32         `protobuf:"varint,26,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	ClusterTable *ClusterTable `protobuf:"bytes,27,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
+	NotCacheable bool          `protobuf:"varint,28,opt,name=not_cacheable,json=notCacheable,proto3" json:"not_cacheable,omitempty"`
+	InsertCtx    *InsertCtx    `protobuf:"bytes,29,opt,name=insert_ctx,json=insertCtx,proto3" json:"insert_ctx,omitempty"`
+	// window_idx is the index of the window function computed by a WINDOW node
+	WindowIdx int32 `protobuf:"varint,30,opt,name=window_idx,json=windowIdx,proto3" json:"window_idx,omitempty"`
+	// union_all and max_recursion_depth are used by a RECURSIVE_CTE node,
+	// duplicate rows are removed across all the iterations unless union_all is set.
+	UnionAll          bool  `protobuf:"varint,31,opt,name=union_all,json=unionAll,proto3" json:"union_all,omitempty"`
+	MaxRecursionDepth int64 `protobuf:"varint,32,opt,name=max_recursion_depth,json=maxRecursionDepth,proto3" json:"max_recursion_depth,omitempty"`
+	// partition_prune is set for the scan of a partitioned table, only the
+	// partitions listed are read, the others are pruned by the filters.
+	PartitionPrune *PartitionPrune `protobuf:"bytes,33,opt,name=partition_prune,json=partitionPrune,proto3" json:"partition_prune,omitempty"`
+	// lock_target is set for the scan of a table read by SELECT ... FOR UPDATE
+	// or FOR SHARE, the rows left by the filters are locked.
+	LockTarget *LockTarget `protobuf:"bytes,34,opt,name=lock_target,json=lockTarget,proto3" json:"lock_target,omitempty"`
+	// scan_ts is set for the scan of a table read with AS OF TIMESTAMP, the
+	// table is read at this timestamp instead of the snapshot of the txn.
+	ScanTs *timestamp.Timestamp `protobuf:"bytes,35,opt,name=scan_ts,json=scanTs,proto3" json:"scan_ts,omitempty"`
+	// index_scan is set for the scan of an index table chosen by the planner
+	// to look up the rows of the table by the filters on the index columns.
+	IndexScan *IndexScan `protobuf:"bytes,36,opt,name=index_scan,json=indexScan,proto3" json:"index_scan,omitempty"`
+	// runtime_filter_probe_list is set for the table scan whose rows are filtered
+	// by the keys of the joins above it, the filters are generated at runtime.
+	RuntimeFilterProbeList []*RuntimeFilterSpec `protobuf:"bytes,37,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
+	// runtime_filter_build_list is set for the join which generates the runtime
+	// filters from its build side after the hash map is built.
+	RuntimeFilterBuildList []*RuntimeFilterSpec `protobuf:"bytes,38,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4852,6 +5159,315 @@ func (m *Node) GetInsertCtx() *InsertCtx {
 	return nil
 }
 
+func (m *Node) GetWindowIdx() int32 {
+	if m != nil {
+		return m.WindowIdx
+	}
+	return 0
+}
+
+func (m *Node) GetUnionAll() bool {
+	if m != nil {
+		return m.UnionAll
+	}
+	return false
+}
+
+func (m *Node) GetMaxRecursionDepth() int64 {
+	if m != nil {
+		return m.MaxRecursionDepth
+	}
+	return 0
+}
+
+func (m *Node) GetPartitionPrune() *PartitionPrune {
+	if m != nil {
+		return m.PartitionPrune
+	}
+	return nil
+}
+
+func (m *Node) GetLockTarget() *LockTarget {
+	if m != nil {
+		return m.LockTarget
+	}
+	return nil
+}
+
+func (m *Node) GetScanTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.ScanTs
+	}
+	return nil
+}
+
+func (m *Node) GetIndexScan() *IndexScan {
+	if m != nil {
+		return m.IndexScan
+	}
+	return nil
+}
+
+func (m *Node) GetRuntimeFilterProbeList() []*RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterProbeList
+	}
+	return nil
+}
+
+func (m *Node) GetRuntimeFilterBuildList() []*RuntimeFilterSpec {
+	if m != nil {
+		return m.RuntimeFilterBuildList
+	}
+	return nil
+}
+
+type RuntimeFilterSpec struct {
+	// tag pairs the filter generated by the join with the table scan
+	Tag int32 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// expr is the join key on the side of the node, a column of the table
+	// for the table scan.
+	Expr                 *Expr    `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
+func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFilterSpec) ProtoMessage()    {}
+func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
+}
+func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeFilterSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeFilterSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeFilterSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFilterSpec.Merge(m, src)
+}
+func (m *RuntimeFilterSpec) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RuntimeFilterSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFilterSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFilterSpec proto.InternalMessageInfo
+
+func (m *RuntimeFilterSpec) GetTag() int32 {
+	if m != nil {
+		return m.Tag
+	}
+	return 0
+}
+
+func (m *RuntimeFilterSpec) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+type PartitionPrune struct {
+	Partitions           []int32  `protobuf:"varint,1,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
+func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
+func (*PartitionPrune) ProtoMessage()    {}
+func (*PartitionPrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
+}
+func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionPrune.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionPrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionPrune.Merge(m, src)
+}
+func (m *PartitionPrune) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PartitionPrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionPrune.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionPrune proto.InternalMessageInfo
+
+func (m *PartitionPrune) GetPartitions() []int32 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+type IndexScan struct {
+	// index_name is the name of the index and table_name is the name of
+	// the table whose rows are looked up by the index.
+	IndexName            string   `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	TableName            string   `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexScan) Reset()         { *m = IndexScan{} }
+func (m *IndexScan) String() string { return proto.CompactTextString(m) }
+func (*IndexScan) ProtoMessage()    {}
+func (*IndexScan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
+}
+func (m *IndexScan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexScan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexScan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexScan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexScan.Merge(m, src)
+}
+func (m *IndexScan) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexScan) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexScan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexScan proto.InternalMessageInfo
+
+func (m *IndexScan) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *IndexScan) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+type LockTarget struct {
+	TableId    uint64                `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Mode       LockTarget_LockMode   `protobuf:"varint,2,opt,name=mode,proto3,enum=plan.LockTarget_LockMode" json:"mode,omitempty"`
+	WaitPolicy LockTarget_WaitPolicy `protobuf:"varint,3,opt,name=wait_policy,json=waitPolicy,proto3,enum=plan.LockTarget_WaitPolicy" json:"wait_policy,omitempty"`
+	// primary_key_pos is the position of the primary key column in the
+	// output of the scan, the primary key values are used as the lock keys.
+	PrimaryKeyPos int32 `protobuf:"varint,4,opt,name=primary_key_pos,json=primaryKeyPos,proto3" json:"primary_key_pos,omitempty"`
+	// composite_key_pos are the positions of the columns of the composite
+	// primary key in the output of the scan, the serialized values of them
+	// are used as the lock keys.
+	CompositeKeyPos      []int32  `protobuf:"varint,5,rep,packed,name=composite_key_pos,json=compositeKeyPos,proto3" json:"composite_key_pos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockTarget) Reset()         { *m = LockTarget{} }
+func (m *LockTarget) String() string { return proto.CompactTextString(m) }
+func (*LockTarget) ProtoMessage()    {}
+func (*LockTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
+}
+func (m *LockTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockTarget.Merge(m, src)
+}
+func (m *LockTarget) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LockTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockTarget proto.InternalMessageInfo
+
+func (m *LockTarget) GetTableId() uint64 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *LockTarget) GetMode() LockTarget_LockMode {
+	if m != nil {
+		return m.Mode
+	}
+	return LockTarget_EXCLUSIVE
+}
+
+func (m *LockTarget) GetWaitPolicy() LockTarget_WaitPolicy {
+	if m != nil {
+		return m.WaitPolicy
+	}
+	return LockTarget_WAIT
+}
+
+func (m *LockTarget) GetPrimaryKeyPos() int32 {
+	if m != nil {
+		return m.PrimaryKeyPos
+	}
+	return 0
+}
+
+func (m *LockTarget) GetCompositeKeyPos() []int32 {
+	if m != nil {
+		return m.CompositeKeyPos
+	}
+	return nil
+}
+
 type IdList struct {
 	List                 []int64  `protobuf:"varint,1,rep,packed,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4863,7 +5479,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4910,7 +5526,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4947,18 +5563,22 @@ func (m *ColPosMap) GetMap() map[string]int32 {
 }
 
 type DeleteCtx struct {
-	Ref                  []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
-	IdxRef               []*ObjectRef `protobuf:"bytes,2,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
-	IdxIdx               []int32      `protobuf:"varint,3,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
-	OnRestrictRef        []*ObjectRef `protobuf:"bytes,4,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
-	OnRestrictIdx        []int32      `protobuf:"varint,5,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
-	OnCascadeRef         []*ObjectRef `protobuf:"bytes,6,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
-	OnCascadeIdx         []int32      `protobuf:"varint,7,rep,packed,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
-	OnSetRef             []*ObjectRef `protobuf:"bytes,8,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
-	OnSetDef             []*TableDef  `protobuf:"bytes,9,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
-	OnSetIdx             []*IdList    `protobuf:"bytes,10,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
-	OnSetUpdateCol       []*ColPosMap `protobuf:"bytes,11,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
-	CanTruncate          bool         `protobuf:"varint,12,opt,name=can_truncate,json=canTruncate,proto3" json:"can_truncate,omitempty"`
+	Ref            []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
+	IdxRef         []*ObjectRef `protobuf:"bytes,2,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
+	IdxIdx         []int32      `protobuf:"varint,3,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
+	OnRestrictRef  []*ObjectRef `protobuf:"bytes,4,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
+	OnRestrictIdx  []int32      `protobuf:"varint,5,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
+	OnCascadeRef   []*ObjectRef `protobuf:"bytes,6,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
+	OnCascadeIdx   []int32      `protobuf:"varint,7,rep,packed,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
+	OnSetRef       []*ObjectRef `protobuf:"bytes,8,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
+	OnSetDef       []*TableDef  `protobuf:"bytes,9,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
+	OnSetIdx       []*IdList    `protobuf:"bytes,10,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
+	OnSetUpdateCol []*ColPosMap `protobuf:"bytes,11,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
+	CanTruncate    bool         `protobuf:"varint,12,opt,name=can_truncate,json=canTruncate,proto3" json:"can_truncate,omitempty"`
+	// partition_idx maps the partition key columns of each deleted table to
+	// their positions, which locate the partitions of the deleted rows. It's
+	// empty for the table not partitioned.
+	PartitionIdx         []*ColPosMap `protobuf:"bytes,13,rep,name=partition_idx,json=partitionIdx,proto3" json:"partition_idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -4968,7 +5588,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5081,6 +5701,13 @@ func (m *DeleteCtx) GetCanTruncate() bool {
 	return false
 }
 
+func (m *DeleteCtx) GetPartitionIdx() []*ColPosMap {
+	if m != nil {
+		return m.PartitionIdx
+	}
+	return nil
+}
+
 type Query struct {
 	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
 	// Each step is simply a root node.  Root node refers to other
@@ -5104,7 +5731,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5193,7 +5820,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5297,7 +5924,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5344,7 +5971,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5391,7 +6018,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5444,7 +6071,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5553,7 +6180,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5608,7 +6235,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5746,7 +6373,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5990,7 +6617,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6045,7 +6672,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6100,7 +6727,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6163,7 +6790,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6259,7 +6886,7 @@ func (m *CreateTable_FkColName) Reset()         { *m = CreateTable_FkColName{} }
 func (m *CreateTable_FkColName) String() string { return proto.CompactTextString(m) }
 func (*CreateTable_FkColName) ProtoMessage()    {}
 func (*CreateTable_FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{66, 0}
 }
 func (m *CreateTable_FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6296,18 +6923,22 @@ func (m *CreateTable_FkColName) GetCols() []string {
 }
 
 type AlterTable struct {
-	Table                string    `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
-	TableDef             *TableDef `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// table_def is the definition of the table after it is altered
+	TableDef *TableDef `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	Database string    `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	// actions are applied in order
+	Actions              []*AlterTableAction `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6350,6 +6981,624 @@ func (m *AlterTable) GetTableDef() *TableDef {
 	return nil
 }
 
+func (m *AlterTable) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *AlterTable) GetActions() []*AlterTableAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type AlterTableAction struct {
+	// Types that are valid to be assigned to Action:
+	//	*AlterTableAction_AddColumn
+	//	*AlterTableAction_DropColumn
+	//	*AlterTableAction_ModifyColumn
+	//	*AlterTableAction_RenameColumn
+	//	*AlterTableAction_RenameTable
+	//	*AlterTableAction_AddIndex
+	//	*AlterTableAction_DropIndex
+	//	*AlterTableAction_AddPartition
+	//	*AlterTableAction_DropPartition
+	//	*AlterTableAction_TruncatePartition
+	Action               isAlterTableAction_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *AlterTableAction) Reset()         { *m = AlterTableAction{} }
+func (m *AlterTableAction) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAction) ProtoMessage()    {}
+func (*AlterTableAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
+}
+func (m *AlterTableAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAction.Merge(m, src)
+}
+func (m *AlterTableAction) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableAction proto.InternalMessageInfo
+
+type isAlterTableAction_Action interface {
+	isAlterTableAction_Action()
+	MarshalTo([]byte) (int, error)
+	ProtoSize() int
+}
+
+type AlterTableAction_AddColumn struct {
+	AddColumn *AlterTableAddColumn `protobuf:"bytes,1,opt,name=add_column,json=addColumn,proto3,oneof" json:"add_column,omitempty"`
+}
+type AlterTableAction_DropColumn struct {
+	DropColumn *AlterTableDropColumn `protobuf:"bytes,2,opt,name=drop_column,json=dropColumn,proto3,oneof" json:"drop_column,omitempty"`
+}
+type AlterTableAction_ModifyColumn struct {
+	ModifyColumn *AlterTableModifyColumn `protobuf:"bytes,3,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTableAction_RenameColumn struct {
+	RenameColumn *AlterTableRenameColumn `protobuf:"bytes,4,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTableAction_RenameTable struct {
+	RenameTable *AlterTableRenameTable `protobuf:"bytes,5,opt,name=rename_table,json=renameTable,proto3,oneof" json:"rename_table,omitempty"`
+}
+type AlterTableAction_AddIndex struct {
+	AddIndex *CreateIndex `protobuf:"bytes,6,opt,name=add_index,json=addIndex,proto3,oneof" json:"add_index,omitempty"`
+}
+type AlterTableAction_DropIndex struct {
+	DropIndex *DropIndex `protobuf:"bytes,7,opt,name=drop_index,json=dropIndex,proto3,oneof" json:"drop_index,omitempty"`
+}
+type AlterTableAction_AddPartition struct {
+	AddPartition *AlterTableAddPartition `protobuf:"bytes,8,opt,name=add_partition,json=addPartition,proto3,oneof" json:"add_partition,omitempty"`
+}
+type AlterTableAction_DropPartition struct {
+	DropPartition *AlterTableDropPartition `protobuf:"bytes,9,opt,name=drop_partition,json=dropPartition,proto3,oneof" json:"drop_partition,omitempty"`
+}
+type AlterTableAction_TruncatePartition struct {
+	TruncatePartition *AlterTableTruncatePartition `protobuf:"bytes,10,opt,name=truncate_partition,json=truncatePartition,proto3,oneof" json:"truncate_partition,omitempty"`
+}
+
+func (*AlterTableAction_AddColumn) isAlterTableAction_Action()         {}
+func (*AlterTableAction_DropColumn) isAlterTableAction_Action()        {}
+func (*AlterTableAction_ModifyColumn) isAlterTableAction_Action()      {}
+func (*AlterTableAction_RenameColumn) isAlterTableAction_Action()      {}
+func (*AlterTableAction_RenameTable) isAlterTableAction_Action()       {}
+func (*AlterTableAction_AddIndex) isAlterTableAction_Action()          {}
+func (*AlterTableAction_DropIndex) isAlterTableAction_Action()         {}
+func (*AlterTableAction_AddPartition) isAlterTableAction_Action()      {}
+func (*AlterTableAction_DropPartition) isAlterTableAction_Action()     {}
+func (*AlterTableAction_TruncatePartition) isAlterTableAction_Action() {}
+
+func (m *AlterTableAction) GetAction() isAlterTableAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetAddColumn() *AlterTableAddColumn {
+	if x, ok := m.GetAction().(*AlterTableAction_AddColumn); ok {
+		return x.AddColumn
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetDropColumn() *AlterTableDropColumn {
+	if x, ok := m.GetAction().(*AlterTableAction_DropColumn); ok {
+		return x.DropColumn
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetModifyColumn() *AlterTableModifyColumn {
+	if x, ok := m.GetAction().(*AlterTableAction_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetRenameColumn() *AlterTableRenameColumn {
+	if x, ok := m.GetAction().(*AlterTableAction_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetRenameTable() *AlterTableRenameTable {
+	if x, ok := m.GetAction().(*AlterTableAction_RenameTable); ok {
+		return x.RenameTable
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetAddIndex() *CreateIndex {
+	if x, ok := m.GetAction().(*AlterTableAction_AddIndex); ok {
+		return x.AddIndex
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetDropIndex() *DropIndex {
+	if x, ok := m.GetAction().(*AlterTableAction_DropIndex); ok {
+		return x.DropIndex
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetAddPartition() *AlterTableAddPartition {
+	if x, ok := m.GetAction().(*AlterTableAction_AddPartition); ok {
+		return x.AddPartition
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetDropPartition() *AlterTableDropPartition {
+	if x, ok := m.GetAction().(*AlterTableAction_DropPartition); ok {
+		return x.DropPartition
+	}
+	return nil
+}
+
+func (m *AlterTableAction) GetTruncatePartition() *AlterTableTruncatePartition {
+	if x, ok := m.GetAction().(*AlterTableAction_TruncatePartition); ok {
+		return x.TruncatePartition
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AlterTableAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AlterTableAction_AddColumn)(nil),
+		(*AlterTableAction_DropColumn)(nil),
+		(*AlterTableAction_ModifyColumn)(nil),
+		(*AlterTableAction_RenameColumn)(nil),
+		(*AlterTableAction_RenameTable)(nil),
+		(*AlterTableAction_AddIndex)(nil),
+		(*AlterTableAction_DropIndex)(nil),
+		(*AlterTableAction_AddPartition)(nil),
+		(*AlterTableAction_DropPartition)(nil),
+		(*AlterTableAction_TruncatePartition)(nil),
+	}
+}
+
+type AlterTableAddColumn struct {
+	Column               *ColDef  `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableAddColumn) Reset()         { *m = AlterTableAddColumn{} }
+func (m *AlterTableAddColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAddColumn) ProtoMessage()    {}
+func (*AlterTableAddColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
+}
+func (m *AlterTableAddColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableAddColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableAddColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableAddColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAddColumn.Merge(m, src)
+}
+func (m *AlterTableAddColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableAddColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAddColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableAddColumn proto.InternalMessageInfo
+
+func (m *AlterTableAddColumn) GetColumn() *ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+type AlterTableDropColumn struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableDropColumn) Reset()         { *m = AlterTableDropColumn{} }
+func (m *AlterTableDropColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableDropColumn) ProtoMessage()    {}
+func (*AlterTableDropColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
+}
+func (m *AlterTableDropColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableDropColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableDropColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableDropColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableDropColumn.Merge(m, src)
+}
+func (m *AlterTableDropColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableDropColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableDropColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableDropColumn proto.InternalMessageInfo
+
+func (m *AlterTableDropColumn) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AlterTableModifyColumn struct {
+	// column is the new definition of the column with the same name
+	Column               *ColDef  `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableModifyColumn) Reset()         { *m = AlterTableModifyColumn{} }
+func (m *AlterTableModifyColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableModifyColumn) ProtoMessage()    {}
+func (*AlterTableModifyColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
+}
+func (m *AlterTableModifyColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableModifyColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableModifyColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableModifyColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableModifyColumn.Merge(m, src)
+}
+func (m *AlterTableModifyColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableModifyColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableModifyColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableModifyColumn proto.InternalMessageInfo
+
+func (m *AlterTableModifyColumn) GetColumn() *ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+type AlterTableRenameColumn struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableRenameColumn) Reset()         { *m = AlterTableRenameColumn{} }
+func (m *AlterTableRenameColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameColumn) ProtoMessage()    {}
+func (*AlterTableRenameColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
+}
+func (m *AlterTableRenameColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableRenameColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableRenameColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableRenameColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameColumn.Merge(m, src)
+}
+func (m *AlterTableRenameColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableRenameColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableRenameColumn proto.InternalMessageInfo
+
+func (m *AlterTableRenameColumn) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameColumn) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type AlterTableRenameTable struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableRenameTable) Reset()         { *m = AlterTableRenameTable{} }
+func (m *AlterTableRenameTable) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameTable) ProtoMessage()    {}
+func (*AlterTableRenameTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
+}
+func (m *AlterTableRenameTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableRenameTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableRenameTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableRenameTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameTable.Merge(m, src)
+}
+func (m *AlterTableRenameTable) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableRenameTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameTable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableRenameTable proto.InternalMessageInfo
+
+func (m *AlterTableRenameTable) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameTable) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type AlterTableAddPartition struct {
+	// partition is the partition info of the table after it is altered
+	Partition *PartitionByDef `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	// partition_table_names are the tables of the added partitions
+	PartitionTableNames  []string `protobuf:"bytes,2,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{} }
+func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAddPartition) ProtoMessage()    {}
+func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
+}
+func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableAddPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableAddPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableAddPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAddPartition.Merge(m, src)
+}
+func (m *AlterTableAddPartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableAddPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAddPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableAddPartition proto.InternalMessageInfo
+
+func (m *AlterTableAddPartition) GetPartition() *PartitionByDef {
+	if m != nil {
+		return m.Partition
+	}
+	return nil
+}
+
+func (m *AlterTableAddPartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+type AlterTableDropPartition struct {
+	Partition *PartitionByDef `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	// partition_table_names are the tables of the dropped partitions
+	PartitionTableNames  []string `protobuf:"bytes,2,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableDropPartition) Reset()         { *m = AlterTableDropPartition{} }
+func (m *AlterTableDropPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableDropPartition) ProtoMessage()    {}
+func (*AlterTableDropPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
+}
+func (m *AlterTableDropPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableDropPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableDropPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableDropPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableDropPartition.Merge(m, src)
+}
+func (m *AlterTableDropPartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableDropPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableDropPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableDropPartition proto.InternalMessageInfo
+
+func (m *AlterTableDropPartition) GetPartition() *PartitionByDef {
+	if m != nil {
+		return m.Partition
+	}
+	return nil
+}
+
+func (m *AlterTableDropPartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+type AlterTableTruncatePartition struct {
+	PartitionTableNames  []string `protobuf:"bytes,1,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableTruncatePartition) Reset()         { *m = AlterTableTruncatePartition{} }
+func (m *AlterTableTruncatePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableTruncatePartition) ProtoMessage()    {}
+func (*AlterTableTruncatePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
+}
+func (m *AlterTableTruncatePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableTruncatePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableTruncatePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableTruncatePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableTruncatePartition.Merge(m, src)
+}
+func (m *AlterTableTruncatePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableTruncatePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableTruncatePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableTruncatePartition proto.InternalMessageInfo
+
+func (m *AlterTableTruncatePartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
 type AlterView struct {
 	IfExists             bool        `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
 	Database             string      `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
@@ -6366,7 +7615,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6445,6 +7694,7 @@ type DropTable struct {
 	ClusterTable         *ClusterTable `protobuf:"bytes,5,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
 	TableId              uint64        `protobuf:"varint,6,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	ForeignTbl           []uint64      `protobuf:"varint,7,rep,packed,name=foreign_tbl,json=foreignTbl,proto3" json:"foreign_tbl,omitempty"`
+	PartitionTableNames  []string      `protobuf:"bytes,8,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -6454,7 +7704,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6532,6 +7782,13 @@ func (m *DropTable) GetForeignTbl() []uint64 {
 	return nil
 }
 
+func (m *DropTable) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
 type CreateIndex struct {
 	Database              string       `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Table                 string       `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
@@ -6547,7 +7804,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6622,7 +7879,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6672,7 +7929,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6736,6 +7993,7 @@ type TruncateTable struct {
 	ClusterTable         *ClusterTable `protobuf:"bytes,4,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
 	TableId              uint64        `protobuf:"varint,5,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	ForeignTbl           []uint64      `protobuf:"varint,6,rep,packed,name=foreign_tbl,json=foreignTbl,proto3" json:"foreign_tbl,omitempty"`
+	PartitionTableNames  []string      `protobuf:"bytes,7,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -6745,7 +8003,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6816,6 +8074,13 @@ func (m *TruncateTable) GetForeignTbl() []uint64 {
 	return nil
 }
 
+func (m *TruncateTable) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
 type ClusterTable struct {
 	IsClusterTable         bool     `protobuf:"varint,1,opt,name=is_cluster_table,json=isClusterTable,proto3" json:"is_cluster_table,omitempty"`
 	AccountIDs             []uint32 `protobuf:"varint,2,rep,packed,name=accountIDs,proto3" json:"accountIDs,omitempty"`
@@ -6829,7 +8094,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6891,7 +8156,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6945,7 +8210,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6996,7 +8261,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7074,7 +8339,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7143,7 +8408,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7197,7 +8462,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7247,7 +8512,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7315,7 +8580,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7361,7 +8626,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7399,9 +8664,13 @@ func init() {
 	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
 	proto.RegisterEnum("plan.ForeignKeyDef_RefAction", ForeignKeyDef_RefAction_name, ForeignKeyDef_RefAction_value)
 	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
+	proto.RegisterEnum("plan.FrameBound_BoundType", FrameBound_BoundType_name, FrameBound_BoundType_value)
+	proto.RegisterEnum("plan.FrameClause_FrameType", FrameClause_FrameType_name, FrameClause_FrameType_value)
 	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
 	proto.RegisterEnum("plan.Node_JoinFlag", Node_JoinFlag_name, Node_JoinFlag_value)
 	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
+	proto.RegisterEnum("plan.LockTarget_LockMode", LockTarget_LockMode_name, LockTarget_LockMode_value)
+	proto.RegisterEnum("plan.LockTarget_WaitPolicy", LockTarget_WaitPolicy_name, LockTarget_WaitPolicy_value)
 	proto.RegisterEnum("plan.Query_StatementType", Query_StatementType_name, Query_StatementType_value)
 	proto.RegisterEnum("plan.TransationControl_TclType", TransationControl_TclType_name, TransationControl_TclType_value)
 	proto.RegisterEnum("plan.TransationBegin_TransationMode", TransationBegin_TransationMode_name, TransationBegin_TransationMode_value)
@@ -7449,6 +8718,8 @@ func init() {
 	proto.RegisterType((*ColData)(nil), "plan.ColData")
 	proto.RegisterType((*RowsetData)(nil), "plan.RowsetData")
 	proto.RegisterType((*OrderBySpec)(nil), "plan.OrderBySpec")
+	proto.RegisterType((*FrameBound)(nil), "plan.FrameBound")
+	proto.RegisterType((*FrameClause)(nil), "plan.FrameClause")
 	proto.RegisterType((*WindowSpec)(nil), "plan.WindowSpec")
 	proto.RegisterType((*InsertCtx)(nil), "plan.InsertCtx")
 	proto.RegisterMapType((map[string]*Expr)(nil), "plan.InsertCtx.OnDuplicateExprEntry")
@@ -7456,6 +8727,10 @@ func init() {
 	proto.RegisterType((*UpdateCtx)(nil), "plan.UpdateCtx")
 	proto.RegisterType((*AnalyzeInfo)(nil), "plan.AnalyzeInfo")
 	proto.RegisterType((*Node)(nil), "plan.Node")
+	proto.RegisterType((*RuntimeFilterSpec)(nil), "plan.RuntimeFilterSpec")
+	proto.RegisterType((*PartitionPrune)(nil), "plan.PartitionPrune")
+	proto.RegisterType((*IndexScan)(nil), "plan.IndexScan")
+	proto.RegisterType((*LockTarget)(nil), "plan.LockTarget")
 	proto.RegisterType((*IdList)(nil), "plan.IdList")
 	proto.RegisterType((*ColPosMap)(nil), "plan.ColPosMap")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.ColPosMap.MapEntry")
@@ -7475,6 +8750,15 @@ func init() {
 	proto.RegisterType((*CreateTable)(nil), "plan.CreateTable")
 	proto.RegisterType((*CreateTable_FkColName)(nil), "plan.CreateTable.FkColName")
 	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
+	proto.RegisterType((*AlterTableAction)(nil), "plan.AlterTableAction")
+	proto.RegisterType((*AlterTableAddColumn)(nil), "plan.AlterTableAddColumn")
+	proto.RegisterType((*AlterTableDropColumn)(nil), "plan.AlterTableDropColumn")
+	proto.RegisterType((*AlterTableModifyColumn)(nil), "plan.AlterTableModifyColumn")
+	proto.RegisterType((*AlterTableRenameColumn)(nil), "plan.AlterTableRenameColumn")
+	proto.RegisterType((*AlterTableRenameTable)(nil), "plan.AlterTableRenameTable")
+	proto.RegisterType((*AlterTableAddPartition)(nil), "plan.AlterTableAddPartition")
+	proto.RegisterType((*AlterTableDropPartition)(nil), "plan.AlterTableDropPartition")
+	proto.RegisterType((*AlterTableTruncatePartition)(nil), "plan.AlterTableTruncatePartition")
 	proto.RegisterType((*AlterView)(nil), "plan.AlterView")
 	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
 	proto.RegisterType((*CreateIndex)(nil), "plan.CreateIndex")
@@ -7496,431 +8780,490 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 6770 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7c, 0x4b, 0x8f, 0x1b, 0xc7,
-	0x7a, 0xe8, 0x34, 0x9f, 0xcd, 0x8f, 0x8f, 0x69, 0x95, 0x5e, 0xd4, 0xc3, 0xf2, 0xa8, 0x25, 0xdb,
-	0xb2, 0x6c, 0xcb, 0xc7, 0x23, 0xbf, 0xaf, 0x0f, 0x8e, 0x39, 0x24, 0x35, 0x43, 0x8b, 0x22, 0xe7,
-	0x14, 0x39, 0x92, 0x7d, 0x0f, 0x2e, 0x88, 0x26, 0xbb, 0x39, 0xd3, 0x9a, 0x66, 0x37, 0xdd, 0xdd,
-	0xd4, 0xcc, 0x18, 0xb8, 0x80, 0x81, 0x0b, 0x5c, 0xe0, 0x02, 0x17, 0xb8, 0x8b, 0xbb, 0xc8, 0x2e,
-	0x39, 0x08, 0xb2, 0x48, 0x4e, 0x16, 0x41, 0x56, 0x59, 0x06, 0xc8, 0x2a, 0x01, 0xb2, 0x48, 0x90,
-	0x07, 0x02, 0x64, 0x13, 0x38, 0x3f, 0x20, 0x08, 0xb2, 0x4c, 0x10, 0x04, 0xdf, 0x57, 0xd5, 0xcd,
-	0xee, 0x19, 0xea, 0x48, 0x16, 0xce, 0x46, 0xea, 0xef, 0x55, 0xf5, 0x55, 0xd5, 0x57, 0xdf, 0xa3,
-	0xaa, 0x38, 0x00, 0x73, 0xc7, 0x70, 0xef, 0xcd, 0x7d, 0x2f, 0xf4, 0x58, 0x0e, 0xbf, 0xaf, 0xbe,
-	0xb7, 0x6f, 0x87, 0x07, 0x8b, 0xf1, 0xbd, 0x89, 0x37, 0x7b, 0x7f, 0xdf, 0xdb, 0xf7, 0xde, 0x27,
-	0xe2, 0x78, 0x31, 0x25, 0x88, 0x00, 0xfa, 0x12, 0x42, 0xfa, 0x1f, 0x2a, 0x90, 0x1b, 0x9e, 0xcc,
-	0x2d, 0x56, 0x83, 0x8c, 0x6d, 0xd6, 0x95, 0x0d, 0xe5, 0x4e, 0x9e, 0x67, 0x6c, 0x93, 0x6d, 0x40,
-	0xd9, 0xf5, 0xc2, 0xde, 0xc2, 0x71, 0x8c, 0xb1, 0x63, 0xd5, 0x33, 0x1b, 0xca, 0x1d, 0x95, 0x27,
-	0x51, 0xec, 0x1a, 0x94, 0x8c, 0x45, 0xe8, 0x8d, 0x6c, 0x77, 0xe2, 0xd7, 0xb3, 0x44, 0x57, 0x11,
-	0xd1, 0x71, 0x27, 0x3e, 0xbb, 0x00, 0xf9, 0x23, 0xdb, 0x0c, 0x0f, 0xea, 0x39, 0x6a, 0x51, 0x00,
-	0x8c, 0x41, 0x2e, 0xb0, 0xbf, 0xb3, 0xea, 0x79, 0x42, 0xd2, 0x37, 0x72, 0x06, 0x13, 0xc3, 0xb1,
-	0xea, 0x05, 0xc1, 0x49, 0x00, 0x62, 0x43, 0xea, 0xb8, 0xb8, 0xa1, 0xdc, 0x29, 0x71, 0x01, 0xe8,
-	0x7f, 0x9d, 0x87, 0x7c, 0xd3, 0x73, 0x83, 0x90, 0x5d, 0x82, 0x82, 0x1d, 0xb8, 0x0b, 0xc7, 0x21,
-	0x95, 0x55, 0x2e, 0x21, 0x76, 0x09, 0xf2, 0xf6, 0xa7, 0xcf, 0x0c, 0x87, 0x14, 0xce, 0xef, 0xac,
-	0x71, 0x01, 0xb2, 0x3a, 0x14, 0xec, 0x0f, 0x3e, 0x46, 0x42, 0x56, 0x12, 0x24, 0x4c, 0x94, 0xfb,
-	0x9b, 0x48, 0xc9, 0xc5, 0x14, 0x82, 0x89, 0xf2, 0xf1, 0x87, 0x48, 0x41, 0x7d, 0xb3, 0x44, 0x21,
-	0x18, 0x7b, 0x59, 0x50, 0x2f, 0xa8, 0x73, 0x15, 0x7b, 0x59, 0x44, 0xbd, 0x2c, 0x44, 0x2f, 0x45,
-	0x49, 0x90, 0x30, 0x51, 0x44, 0x2f, 0x6a, 0x4c, 0x89, 0x7b, 0x59, 0x88, 0x5e, 0x4a, 0x1b, 0xca,
-	0x9d, 0x1c, 0x51, 0x44, 0x2f, 0x17, 0x20, 0x67, 0x22, 0x1e, 0x36, 0x94, 0x3b, 0xca, 0xce, 0x1a,
-	0x27, 0x08, 0xb1, 0x01, 0x62, 0xcb, 0x38, 0x31, 0x88, 0x0d, 0x24, 0x76, 0x8c, 0xd8, 0x0a, 0xce,
-	0x06, 0x62, 0xc7, 0x12, 0x3b, 0x45, 0x6c, 0x75, 0x43, 0xb9, 0x93, 0x41, 0x2c, 0x42, 0xec, 0x2a,
-	0x14, 0x4d, 0x23, 0xb4, 0x90, 0x50, 0x93, 0x43, 0x8e, 0x10, 0x48, 0x0b, 0xed, 0x19, 0xd1, 0xd6,
-	0xe5, 0xa0, 0x23, 0x04, 0xd3, 0xa1, 0x8c, 0x6c, 0x11, 0x5d, 0x93, 0xf4, 0x24, 0x92, 0x7d, 0x04,
-	0x15, 0xd3, 0x9a, 0xd8, 0x33, 0xc3, 0x11, 0x63, 0x3a, 0xb7, 0xa1, 0xdc, 0x29, 0x6f, 0xae, 0xdf,
-	0x23, 0x3b, 0x8d, 0x29, 0x3b, 0x6b, 0x3c, 0xc5, 0xc6, 0x3e, 0x85, 0xaa, 0x84, 0x3f, 0xd8, 0xa4,
-	0x89, 0x65, 0x24, 0xa7, 0xa5, 0xe4, 0x3e, 0xd8, 0xfc, 0x74, 0x67, 0x8d, 0xa7, 0x19, 0xd9, 0x6d,
-	0xa8, 0x60, 0xdf, 0x41, 0x68, 0xcc, 0xe6, 0x28, 0x78, 0x5e, 0x6a, 0x95, 0xc2, 0xe2, 0xb0, 0x9e,
-	0x06, 0x9e, 0x8b, 0x0c, 0x17, 0xe4, 0xbc, 0x45, 0x08, 0xb6, 0x01, 0x60, 0x5a, 0x53, 0x63, 0xe1,
-	0x84, 0x48, 0xbe, 0x28, 0x27, 0x30, 0x81, 0x63, 0x37, 0xa0, 0xb4, 0x98, 0xe3, 0x28, 0x1f, 0x1b,
-	0x4e, 0xfd, 0x92, 0x64, 0x58, 0xa2, 0xd0, 0x58, 0xed, 0x60, 0xcb, 0x76, 0xeb, 0x97, 0xc9, 0x16,
-	0x05, 0xc0, 0xae, 0x43, 0x36, 0xf0, 0x27, 0xf5, 0x3a, 0x8d, 0x04, 0xc4, 0x48, 0xda, 0xc7, 0x73,
-	0x9f, 0x23, 0x7a, 0xab, 0x08, 0xf9, 0x67, 0x86, 0xb3, 0xb0, 0xf4, 0xeb, 0xa0, 0xee, 0x1a, 0xbe,
-	0x31, 0xe3, 0xd6, 0x94, 0x69, 0x90, 0x9d, 0x7b, 0x81, 0xdc, 0x85, 0xf8, 0xa9, 0x77, 0xa1, 0xf0,
-	0xd8, 0xf0, 0x91, 0xc6, 0x20, 0xe7, 0x1a, 0x33, 0x8b, 0x88, 0x25, 0x4e, 0xdf, 0xb8, 0x0b, 0x82,
-	0x93, 0x20, 0xb4, 0x66, 0x72, 0x7f, 0x4a, 0x08, 0xf1, 0xfb, 0x8e, 0x37, 0x96, 0xd6, 0xae, 0x72,
-	0x09, 0xe9, 0x3d, 0x28, 0x34, 0x3d, 0x07, 0x5b, 0xbb, 0x0c, 0x45, 0xdf, 0x72, 0x46, 0xcb, 0xde,
-	0x0a, 0xbe, 0xe5, 0xec, 0x7a, 0x01, 0x12, 0x26, 0x9e, 0x20, 0x64, 0x04, 0x61, 0xe2, 0x11, 0x21,
-	0xea, 0x3f, 0xbb, 0xec, 0x5f, 0xff, 0x0c, 0x4a, 0xdc, 0x38, 0x92, 0x4d, 0x5e, 0x84, 0x42, 0x38,
-	0x76, 0x46, 0xd2, 0x8b, 0xe4, 0x78, 0x3e, 0x1c, 0x3b, 0x1d, 0x13, 0xd1, 0xd8, 0xa0, 0x6d, 0x52,
-	0x7b, 0x39, 0x9e, 0x9f, 0x78, 0x4e, 0xc7, 0xd4, 0x87, 0x00, 0x4d, 0xcf, 0xf7, 0x5f, 0x59, 0x9d,
-	0x0b, 0x90, 0x37, 0xad, 0x79, 0x78, 0x20, 0xf6, 0x33, 0x17, 0x80, 0x7e, 0x17, 0x54, 0x9c, 0xe2,
-	0xae, 0x1d, 0x84, 0xec, 0x06, 0xe4, 0x1c, 0x3b, 0x08, 0xeb, 0xca, 0x46, 0xf6, 0xd4, 0x02, 0x10,
-	0x5e, 0xdf, 0x00, 0xf5, 0x91, 0x71, 0xfc, 0x18, 0x17, 0x01, 0x5b, 0xa3, 0xd5, 0x90, 0xb3, 0x2b,
-	0x97, 0xe6, 0x2e, 0xc0, 0xd0, 0xf0, 0xf7, 0xad, 0x90, 0x3c, 0xe4, 0x75, 0xc8, 0x86, 0x27, 0x73,
-	0xe2, 0x88, 0x9b, 0x43, 0x02, 0x47, 0xb4, 0xfe, 0x6f, 0x0a, 0x94, 0x07, 0x8b, 0xf1, 0xb7, 0x0b,
-	0xcb, 0x3f, 0xc1, 0x11, 0xdd, 0x59, 0x72, 0xd7, 0x36, 0x2f, 0x09, 0xee, 0x04, 0x7d, 0x29, 0x89,
-	0x43, 0x74, 0x3d, 0xd3, 0x8a, 0x66, 0x28, 0xcf, 0x0b, 0x08, 0x76, 0x4c, 0x74, 0xc9, 0xde, 0x5c,
-	0xce, 0x77, 0xc6, 0x9b, 0xb3, 0x0d, 0xc8, 0x4f, 0x0e, 0x6c, 0xc7, 0x24, 0x47, 0x95, 0x1e, 0x91,
-	0x20, 0xb0, 0x2b, 0xa0, 0xfa, 0xde, 0xd1, 0x28, 0xe1, 0x63, 0x8b, 0xbe, 0x77, 0x34, 0xb0, 0xbf,
-	0xb3, 0xf4, 0xa1, 0xf4, 0xf3, 0x00, 0x85, 0x41, 0xb3, 0xd1, 0x6d, 0x70, 0x6d, 0x0d, 0xbf, 0xdb,
-	0x5f, 0x77, 0x06, 0xc3, 0x81, 0xa6, 0xb0, 0x1a, 0x40, 0xaf, 0x3f, 0x1c, 0x49, 0x38, 0xc3, 0x0a,
-	0x90, 0xe9, 0xf4, 0xb4, 0x2c, 0xf2, 0x20, 0xbe, 0xd3, 0xd3, 0x72, 0xac, 0x08, 0xd9, 0x46, 0xef,
-	0x1b, 0x2d, 0x4f, 0x1f, 0xdd, 0xae, 0x56, 0xd0, 0xff, 0x46, 0x81, 0x52, 0x7f, 0xfc, 0xd4, 0x9a,
-	0x84, 0x38, 0x66, 0x34, 0x47, 0xcb, 0x7f, 0x66, 0xf9, 0x34, 0xec, 0x2c, 0x97, 0x10, 0x0e, 0xc4,
-	0x1c, 0xd3, 0xe0, 0xb2, 0x3c, 0x63, 0x8e, 0x89, 0x6f, 0x72, 0x60, 0xcd, 0x0c, 0x1a, 0x1c, 0xf2,
-	0x11, 0x84, 0xe6, 0xef, 0x8d, 0x9f, 0xd2, 0xf0, 0xb2, 0x1c, 0x3f, 0xd9, 0xeb, 0x50, 0x16, 0x6d,
-	0x8c, 0xc8, 0xf6, 0xf2, 0x34, 0x17, 0x20, 0x50, 0x3d, 0xdc, 0x01, 0x97, 0xa1, 0x68, 0x8e, 0x05,
-	0xb1, 0x40, 0xc4, 0x82, 0x39, 0x26, 0x02, 0x4a, 0x52, 0xab, 0x82, 0x58, 0x94, 0x92, 0x84, 0x22,
-	0x86, 0x2b, 0xa0, 0x7a, 0xe3, 0xa7, 0x82, 0xaa, 0x12, 0xb5, 0xe8, 0x8d, 0x9f, 0x22, 0x49, 0xff,
-	0x57, 0x05, 0xd4, 0x07, 0x0b, 0x77, 0x12, 0xda, 0x9e, 0xcb, 0x6e, 0x41, 0x6e, 0xba, 0x70, 0x27,
-	0x72, 0xdd, 0xa5, 0x27, 0x8b, 0xc7, 0xcc, 0x89, 0x88, 0xb6, 0x66, 0xf8, 0xfb, 0x68, 0xa3, 0x67,
-	0x6c, 0x0d, 0xf1, 0xfa, 0xef, 0xc8, 0x16, 0x1f, 0x38, 0xc6, 0x3e, 0x53, 0x21, 0xd7, 0xeb, 0xf7,
-	0xda, 0xda, 0x1a, 0xab, 0x80, 0xda, 0xe9, 0x0d, 0xdb, 0xbc, 0xd7, 0xe8, 0x6a, 0x0a, 0x2d, 0xcd,
-	0xb0, 0xb1, 0xd5, 0x6d, 0x6b, 0x19, 0xa4, 0x3c, 0xee, 0x77, 0x1b, 0xc3, 0x4e, 0xb7, 0xad, 0xe5,
-	0x04, 0x85, 0x77, 0x9a, 0x43, 0x4d, 0x65, 0x1a, 0x54, 0x76, 0x79, 0xbf, 0xb5, 0xd7, 0x6c, 0x8f,
-	0x7a, 0x7b, 0xdd, 0xae, 0xa6, 0xb1, 0xf3, 0xb0, 0x1e, 0x63, 0xfa, 0x02, 0xb9, 0x81, 0x22, 0x8f,
-	0x1b, 0xbc, 0xc1, 0xb7, 0xb5, 0x2f, 0x99, 0x0a, 0xd9, 0xc6, 0xf6, 0xb6, 0xf6, 0xbd, 0x82, 0x5f,
-	0x4f, 0x3a, 0x3d, 0xed, 0xfb, 0x0c, 0xab, 0x41, 0xe9, 0x51, 0xbf, 0xd7, 0x1f, 0xf6, 0x7b, 0x9d,
-	0xa6, 0xf6, 0x7d, 0x4e, 0xff, 0x83, 0x2c, 0xe4, 0x50, 0xe1, 0x5f, 0x6f, 0xe6, 0xec, 0x1a, 0x28,
-	0x13, 0x5a, 0xc9, 0xf2, 0x66, 0x59, 0xd0, 0x28, 0x1e, 0xef, 0xac, 0x71, 0x05, 0x67, 0x41, 0x11,
-	0xf6, 0x5a, 0xde, 0xac, 0x09, 0x62, 0xe4, 0xd9, 0x90, 0x3e, 0x67, 0xd7, 0x41, 0x79, 0x26, 0x8d,
-	0xb7, 0x22, 0xe8, 0xc2, 0xb7, 0x21, 0xf5, 0x19, 0xdb, 0x80, 0xec, 0xc4, 0x13, 0xb1, 0x36, 0xa6,
-	0x0b, 0xf7, 0xb0, 0xb3, 0xc6, 0x91, 0xc4, 0x6e, 0x41, 0xd6, 0x37, 0x8e, 0x68, 0xa1, 0xe3, 0x95,
-	0x88, 0xfd, 0x0f, 0x32, 0xf9, 0xc6, 0x11, 0x2a, 0x31, 0xa5, 0xe5, 0x8e, 0x95, 0x88, 0x96, 0x12,
-	0xbb, 0x99, 0xb2, 0x37, 0x20, 0x1b, 0x2c, 0xc6, 0xb4, 0xe4, 0xe5, 0xcd, 0x73, 0x67, 0x36, 0x26,
-	0x36, 0x13, 0x2c, 0xc6, 0xec, 0x4d, 0xc8, 0x4d, 0x3c, 0xdf, 0xa7, 0xa0, 0x1c, 0x07, 0xa2, 0xa5,
-	0xc7, 0xc2, 0x60, 0x8a, 0x74, 0xb6, 0x01, 0x4a, 0x48, 0x11, 0x3a, 0x66, 0x5a, 0xba, 0x0c, 0xec,
-	0x30, 0x64, 0xb7, 0xa5, 0x1f, 0x2a, 0x27, 0x75, 0x8a, 0xbc, 0x14, 0xb6, 0x83, 0x54, 0xa6, 0x43,
-	0x76, 0x66, 0x1c, 0x53, 0xfc, 0x8e, 0x99, 0x22, 0xf7, 0x84, 0x3a, 0xcd, 0x8c, 0xe3, 0xad, 0x02,
-	0xe4, 0xac, 0xe3, 0xb9, 0xaf, 0x5f, 0x81, 0x52, 0x1c, 0x3d, 0x59, 0x05, 0x14, 0x43, 0xee, 0x37,
-	0xc5, 0xd0, 0xef, 0x60, 0x30, 0x8b, 0xe2, 0x63, 0x9a, 0x86, 0x50, 0xb4, 0x0b, 0x95, 0xb1, 0xfe,
-	0x05, 0x54, 0xb8, 0x15, 0x2c, 0x9c, 0xb0, 0xe9, 0x39, 0x2d, 0x6b, 0xca, 0xde, 0x05, 0x88, 0xe1,
-	0x40, 0x3a, 0xcd, 0xe5, 0x2a, 0xb4, 0xac, 0x29, 0x4f, 0xd0, 0xf5, 0xff, 0x95, 0xa5, 0x50, 0xd2,
-	0x12, 0x7e, 0x5f, 0x3a, 0x78, 0x25, 0xe1, 0xe0, 0xe3, 0x78, 0x91, 0x49, 0xc7, 0xab, 0x03, 0xdb,
-	0x34, 0x2d, 0x37, 0x8a, 0x4b, 0x02, 0x62, 0xb7, 0x21, 0x6b, 0x38, 0xfb, 0x64, 0x1a, 0xb5, 0x4d,
-	0x16, 0x75, 0x3a, 0x9b, 0xfb, 0x56, 0x10, 0x08, 0xdb, 0x33, 0x9c, 0xfd, 0xc8, 0x32, 0xf3, 0xab,
-	0x2d, 0xf3, 0x0a, 0xa8, 0xae, 0x17, 0x8e, 0x28, 0x27, 0x2c, 0x50, 0xeb, 0x45, 0x99, 0xad, 0xb2,
-	0xb7, 0xa0, 0x28, 0xa3, 0xb9, 0x34, 0x8c, 0xaa, 0x10, 0x6e, 0x09, 0x24, 0x8f, 0xa8, 0xac, 0x8e,
-	0xd1, 0x66, 0x36, 0xb3, 0xdc, 0x30, 0x72, 0x09, 0x12, 0x64, 0xef, 0x40, 0xc9, 0x73, 0x47, 0x22,
-	0xe4, 0x4b, 0x9b, 0x90, 0x8b, 0xd4, 0x77, 0xf7, 0x08, 0xcb, 0x55, 0x4f, 0x7e, 0xa1, 0x2a, 0x8e,
-	0x77, 0x34, 0x9a, 0x18, 0xbe, 0x49, 0xa6, 0xa1, 0xf2, 0xa2, 0xe3, 0x1d, 0x35, 0x0d, 0xdf, 0x64,
-	0xd7, 0xa1, 0x34, 0x71, 0x16, 0x41, 0x68, 0xf9, 0x5b, 0x27, 0x64, 0x11, 0x2a, 0x5f, 0x22, 0xb0,
-	0xff, 0xb9, 0x6f, 0xcf, 0x0c, 0xff, 0x44, 0x24, 0x72, 0x3c, 0x02, 0x31, 0x40, 0xcd, 0x0f, 0x6d,
-	0xf3, 0x98, 0x52, 0xb9, 0x3c, 0x17, 0x80, 0xfe, 0x2d, 0x14, 0xe5, 0x18, 0xd0, 0x03, 0xa1, 0x6d,
-	0xa4, 0xf7, 0xad, 0xf0, 0x40, 0x88, 0x67, 0xb7, 0xa0, 0xea, 0xf9, 0xf6, 0xbe, 0xed, 0x8e, 0x82,
-	0xd0, 0xb7, 0xdd, 0x7d, 0xb9, 0x2e, 0x15, 0x81, 0x1c, 0x10, 0x8e, 0xdd, 0x84, 0x0a, 0xce, 0xdf,
-	0xc8, 0x18, 0xdb, 0x8e, 0x1d, 0x9e, 0xc8, 0x55, 0x2a, 0x23, 0xae, 0x21, 0x50, 0x7a, 0x1f, 0xd4,
-	0x68, 0xc4, 0xbf, 0x91, 0x3e, 0xf5, 0xff, 0x06, 0xe5, 0x8e, 0x6b, 0x5a, 0xc7, 0xfd, 0x39, 0xb9,
-	0xdb, 0x77, 0x81, 0x4d, 0x7c, 0xcb, 0x08, 0xad, 0x91, 0x75, 0x1c, 0xfa, 0xc6, 0x48, 0x54, 0x01,
-	0x22, 0xc9, 0xd7, 0x04, 0xa5, 0x8d, 0x84, 0x21, 0x15, 0x04, 0xbf, 0xaf, 0x40, 0x75, 0x57, 0x4c,
-	0xd1, 0x43, 0xeb, 0xa4, 0x25, 0xd2, 0xa4, 0x49, 0x64, 0xc0, 0x39, 0x4e, 0xdf, 0xec, 0x06, 0x94,
-	0xe7, 0x87, 0xd6, 0xc9, 0x28, 0x95, 0x87, 0x94, 0x10, 0xd5, 0x24, 0x53, 0x7d, 0x1b, 0x0a, 0x1e,
-	0xf5, 0x2e, 0x9d, 0x97, 0xf4, 0x0a, 0x09, 0xb5, 0xb8, 0x64, 0x60, 0x3a, 0x54, 0xe3, 0xa6, 0xc8,
-	0xbc, 0x73, 0x34, 0xa4, 0xb2, 0x6c, 0x8c, 0x22, 0xcb, 0x05, 0xc8, 0x23, 0x29, 0xa8, 0xe7, 0x37,
-	0xb2, 0x98, 0x4c, 0x10, 0xa0, 0xff, 0xa7, 0x02, 0x2a, 0xb5, 0x28, 0xf7, 0x8c, 0x6d, 0x1e, 0x47,
-	0x7b, 0xa6, 0xc4, 0xf3, 0xb6, 0x79, 0xdc, 0x31, 0xd9, 0x6b, 0x00, 0x36, 0xb2, 0x8c, 0x12, 0x3b,
-	0xa7, 0x44, 0x98, 0xa8, 0xe1, 0xb9, 0xe1, 0x87, 0x41, 0x3d, 0x2b, 0x1a, 0x26, 0x00, 0x37, 0xd5,
-	0xc2, 0xb5, 0xbf, 0x5d, 0x08, 0x5d, 0x54, 0x2e, 0x21, 0x76, 0x07, 0x34, 0xd1, 0x18, 0x4d, 0x61,
-	0x32, 0x80, 0xd6, 0x08, 0x4f, 0x33, 0x18, 0xc5, 0x4a, 0xc1, 0x63, 0x1d, 0xa3, 0xa3, 0x12, 0xbb,
-	0x07, 0x08, 0xd5, 0x46, 0x4c, 0x72, 0x5f, 0x14, 0xd3, 0xfb, 0x62, 0x39, 0x75, 0xea, 0x0b, 0xa6,
-	0x4e, 0xff, 0x8b, 0x0c, 0x54, 0x1f, 0x78, 0xbe, 0x65, 0xef, 0xbb, 0xcb, 0xb5, 0x3a, 0x93, 0xd2,
-	0x46, 0xeb, 0x97, 0x49, 0xac, 0xdf, 0xeb, 0x50, 0x9e, 0x0a, 0xc1, 0x51, 0x38, 0x16, 0x39, 0x6d,
-	0x8e, 0x83, 0x44, 0x0d, 0xc7, 0x0e, 0xda, 0x6d, 0xc4, 0x40, 0xc2, 0x39, 0x12, 0x8e, 0x84, 0xd0,
-	0x61, 0xb1, 0xcf, 0x69, 0x03, 0x9b, 0x96, 0x63, 0x85, 0x62, 0x1a, 0x6a, 0x9b, 0xaf, 0xc9, 0xf0,
-	0x90, 0xd4, 0xe9, 0x1e, 0xb7, 0xa6, 0x0d, 0x8a, 0x16, 0xb8, 0x9f, 0x5b, 0xc4, 0x2e, 0x65, 0xe5,
-	0xe6, 0x2f, 0xbc, 0xa4, 0xac, 0xd8, 0x23, 0xfa, 0x10, 0x4a, 0x31, 0x1a, 0xa3, 0x3a, 0x6f, 0xcb,
-	0x48, 0xbe, 0xc6, 0xca, 0x50, 0x6c, 0x36, 0x06, 0xcd, 0x46, 0xab, 0xad, 0x29, 0x48, 0x1a, 0xb4,
-	0x87, 0x22, 0x7a, 0x67, 0xd8, 0x3a, 0x94, 0x11, 0x6a, 0xb5, 0x1f, 0x34, 0xf6, 0xba, 0x43, 0x2d,
-	0xcb, 0xaa, 0x50, 0xea, 0xf5, 0x47, 0x8d, 0xe6, 0xb0, 0xd3, 0xef, 0x69, 0x39, 0xfd, 0x4b, 0x50,
-	0x9b, 0x07, 0xd6, 0xe4, 0xf0, 0x79, 0xb3, 0x48, 0xa9, 0xa2, 0x35, 0x39, 0x94, 0xa1, 0xfa, 0x54,
-	0xaa, 0x68, 0x4d, 0x0e, 0xf5, 0x16, 0x54, 0x9a, 0x91, 0xdf, 0xc1, 0x56, 0x36, 0x22, 0xdb, 0x3a,
-	0x9b, 0x2e, 0x4b, 0x3b, 0x5b, 0xe1, 0xd0, 0xf5, 0x8f, 0xa0, 0xbc, 0xeb, 0x7b, 0x73, 0xcb, 0x0f,
-	0xa9, 0x11, 0x0d, 0xb2, 0x87, 0xd6, 0x89, 0xd4, 0x04, 0x3f, 0x97, 0x89, 0x75, 0x26, 0x99, 0x58,
-	0x6f, 0x82, 0x1a, 0x89, 0xbd, 0xb4, 0xcc, 0xcf, 0x70, 0xa7, 0x93, 0x8c, 0x6d, 0x05, 0xd8, 0xd9,
-	0x3d, 0x80, 0x79, 0x8c, 0x90, 0x6a, 0x47, 0x69, 0x87, 0x6c, 0x9c, 0x27, 0x38, 0xf4, 0x3f, 0xcd,
-	0x42, 0x6d, 0xd7, 0xf0, 0x43, 0x1b, 0x97, 0x42, 0x0c, 0xfa, 0x2d, 0xc8, 0x85, 0x27, 0x73, 0x4b,
-	0x66, 0xe9, 0xe7, 0xe3, 0x9c, 0x45, 0xf0, 0x50, 0x6c, 0x21, 0x06, 0xf6, 0x39, 0xd4, 0xe6, 0x11,
-	0x7a, 0x44, 0x3e, 0x4f, 0x4c, 0xec, 0x69, 0x11, 0x9a, 0xaf, 0xea, 0x3c, 0x09, 0xb2, 0x9f, 0xc2,
-	0x85, 0xb4, 0xac, 0x15, 0x04, 0x4b, 0x5f, 0x93, 0x9c, 0xe8, 0xf3, 0x29, 0x41, 0xc1, 0xc6, 0x9a,
-	0x70, 0x6e, 0x29, 0x3e, 0xf1, 0x9c, 0xc5, 0xcc, 0x0d, 0x64, 0x12, 0x75, 0xe9, 0x54, 0xef, 0x4d,
-	0x41, 0xe5, 0xda, 0xfc, 0x14, 0x86, 0xe9, 0x50, 0x89, 0x71, 0xbd, 0xc5, 0x8c, 0x36, 0x40, 0x8e,
-	0xa7, 0x70, 0xec, 0x3e, 0x40, 0x0c, 0x07, 0xf5, 0x02, 0xcd, 0xe7, 0xe9, 0xf1, 0x75, 0x42, 0x6b,
-	0xc6, 0x13, 0x6c, 0x18, 0xcf, 0x0c, 0x67, 0xdf, 0xf3, 0xed, 0xf0, 0x60, 0x46, 0xbe, 0x21, 0xcb,
-	0x97, 0x08, 0x72, 0x41, 0xc1, 0x28, 0x58, 0x8c, 0x47, 0xb1, 0x08, 0xf9, 0x09, 0x95, 0xd7, 0xec,
-	0x60, 0xb0, 0x18, 0xc7, 0xed, 0x62, 0xa8, 0x58, 0x8e, 0x72, 0x16, 0xec, 0x53, 0x8c, 0x2d, 0x25,
-	0x34, 0x7c, 0x14, 0xec, 0xeb, 0x5f, 0x41, 0x35, 0x35, 0xd3, 0x2f, 0x0c, 0x40, 0x57, 0x40, 0xc5,
-	0xff, 0x31, 0xfc, 0x48, 0x63, 0x2a, 0x22, 0x3c, 0x08, 0x7d, 0xdd, 0x02, 0xed, 0xf4, 0xbc, 0xb1,
-	0xdb, 0x54, 0x6c, 0xd2, 0x04, 0x9f, 0xdd, 0x05, 0x11, 0x89, 0xbd, 0xb3, 0x6a, 0x41, 0x32, 0xe4,
-	0x91, 0xcf, 0x4c, 0xbc, 0xfe, 0x2f, 0x4a, 0x42, 0x67, 0x9c, 0x3d, 0xf6, 0x46, 0xd2, 0x94, 0x12,
-	0x1b, 0x77, 0x39, 0x7e, 0xf2, 0xc9, 0x6f, 0x83, 0xe6, 0xf9, 0xa6, 0xed, 0x1a, 0x54, 0xfc, 0x8a,
-	0xa9, 0xc3, 0x21, 0x54, 0xf9, 0xba, 0xc4, 0xef, 0x4a, 0x34, 0xdb, 0x80, 0xb2, 0x69, 0x05, 0x13,
-	0xdf, 0x5e, 0xc6, 0xb0, 0x12, 0x4f, 0xa2, 0x92, 0xfe, 0x3b, 0x97, 0xf6, 0xdf, 0x6f, 0x41, 0xc9,
-	0xb1, 0x82, 0x60, 0x14, 0x1e, 0x18, 0x2e, 0xc5, 0xab, 0xf4, 0xa0, 0x55, 0x24, 0x0e, 0x0f, 0x0c,
-	0x17, 0x19, 0x6d, 0x77, 0x44, 0x5b, 0x31, 0x32, 0x8e, 0x14, 0xa3, 0xed, 0x52, 0xaa, 0x1a, 0xe8,
-	0xaf, 0x41, 0xf1, 0xb1, 0x6d, 0x1d, 0x49, 0xcf, 0xf4, 0xcc, 0xb6, 0x8e, 0x22, 0xcf, 0x84, 0xdf,
-	0xfa, 0x6f, 0xab, 0xa0, 0x52, 0xe4, 0x69, 0x3d, 0xff, 0xc8, 0xe0, 0xc7, 0xa4, 0x8e, 0x1b, 0x32,
-	0x5e, 0xe4, 0x56, 0x24, 0xac, 0x22, 0x7a, 0xbc, 0x06, 0x22, 0x94, 0x8d, 0x68, 0xab, 0x8b, 0x08,
-	0x58, 0x22, 0x8c, 0x2c, 0xeb, 0x4b, 0x22, 0xad, 0x08, 0xbe, 0x75, 0x64, 0x0d, 0xb9, 0x44, 0xb0,
-	0x7b, 0xa0, 0xa2, 0x86, 0x54, 0x01, 0x16, 0x93, 0x5b, 0x9e, 0xc6, 0x10, 0x55, 0x16, 0xbc, 0x18,
-	0x8e, 0x1d, 0x04, 0xd0, 0xa3, 0x60, 0x2a, 0x20, 0x93, 0xfd, 0x68, 0xfb, 0x24, 0x33, 0x14, 0x4e,
-	0x0c, 0xec, 0x0e, 0x14, 0x29, 0x0a, 0x5b, 0x41, 0xbd, 0x92, 0x74, 0x5d, 0x51, 0x8a, 0xc0, 0x23,
-	0x32, 0x7b, 0x1b, 0xf2, 0xd3, 0x43, 0xeb, 0x24, 0xa8, 0x57, 0x93, 0x5b, 0x32, 0x15, 0x79, 0xb8,
-	0xe0, 0x60, 0xb7, 0xa1, 0xe6, 0x5b, 0xd3, 0x11, 0x1d, 0x06, 0x60, 0xa8, 0x0c, 0xea, 0x35, 0x8a,
-	0x84, 0x15, 0xdf, 0x9a, 0x36, 0x11, 0x39, 0x1c, 0x3b, 0x01, 0x7b, 0x13, 0x0a, 0x14, 0x03, 0x82,
-	0xfa, 0x7a, 0xb2, 0xe7, 0x28, 0xa0, 0x70, 0x49, 0x65, 0x9b, 0x50, 0x5a, 0x6e, 0xdb, 0x8b, 0x34,
-	0xa0, 0x0b, 0xa7, 0xfc, 0x01, 0xb9, 0x51, 0xbe, 0x64, 0x63, 0x1f, 0x00, 0xc8, 0x74, 0x76, 0x34,
-	0x3e, 0xa1, 0xb3, 0xb2, 0x72, 0x9c, 0xd0, 0x27, 0xc2, 0x4d, 0x32, 0xe9, 0x7d, 0x0b, 0xf2, 0xe8,
-	0xa5, 0x83, 0xfa, 0x65, 0xd2, 0xe6, 0x5c, 0xda, 0x85, 0xd3, 0xe8, 0x88, 0xce, 0xee, 0x80, 0x8a,
-	0x26, 0x34, 0xc2, 0x85, 0xaa, 0x27, 0xf3, 0x78, 0x69, 0x6f, 0xbc, 0x88, 0xe4, 0xc1, 0xb7, 0x0e,
-	0x7b, 0x0f, 0xca, 0x32, 0xf1, 0x24, 0xdb, 0xb8, 0xb2, 0xaa, 0x98, 0x11, 0x0c, 0x94, 0x1b, 0xdc,
-	0x85, 0x9c, 0x69, 0x4d, 0x83, 0xfa, 0xeb, 0xc4, 0x77, 0x29, 0xb1, 0xc0, 0x18, 0xd5, 0x5b, 0xd6,
-	0x54, 0x44, 0x02, 0xe4, 0x61, 0x3b, 0x50, 0x43, 0x7b, 0xdc, 0xa4, 0x5c, 0x12, 0x57, 0xa8, 0xbe,
-	0x41, 0x52, 0x37, 0x4f, 0x49, 0xf5, 0x24, 0x13, 0xad, 0x67, 0xdb, 0x0d, 0xfd, 0x13, 0x5e, 0x75,
-	0x93, 0x38, 0x76, 0x1f, 0x6a, 0x13, 0x6f, 0x46, 0x9b, 0xdb, 0x1a, 0x91, 0xd1, 0xdc, 0x3c, 0x55,
-	0xfa, 0xa2, 0x9e, 0xd5, 0x98, 0x67, 0x17, 0xcd, 0xe6, 0x2a, 0xa8, 0x76, 0xd0, 0xf5, 0x26, 0x87,
-	0x96, 0x59, 0xd7, 0xc5, 0x99, 0x7b, 0x04, 0xb3, 0xcf, 0xa0, 0x4a, 0x66, 0x8d, 0x20, 0x6a, 0x5c,
-	0xbf, 0x95, 0x0c, 0x6b, 0xc3, 0x24, 0x89, 0xa7, 0x39, 0xaf, 0x6e, 0x53, 0x21, 0x41, 0xfb, 0xe1,
-	0xa3, 0x53, 0x61, 0x35, 0x65, 0xc7, 0x89, 0xf8, 0xbb, 0xb3, 0x96, 0x8c, 0xae, 0x5b, 0x79, 0xc8,
-	0x9a, 0xd6, 0xf4, 0xea, 0x97, 0xc0, 0xce, 0x8e, 0xfc, 0x45, 0x31, 0x3e, 0x2f, 0x63, 0xfc, 0xe7,
-	0x99, 0x4f, 0x15, 0xfd, 0x33, 0xa8, 0xa6, 0xf6, 0xd6, 0xca, 0xfc, 0x46, 0x64, 0xc2, 0x86, 0x38,
-	0xf7, 0xac, 0x70, 0x01, 0xe8, 0x7f, 0xa9, 0x40, 0x7e, 0x10, 0x1a, 0x61, 0xc0, 0xae, 0x41, 0x69,
-	0xec, 0x78, 0x93, 0xc3, 0x91, 0xbb, 0x98, 0xc9, 0x13, 0x45, 0x95, 0x10, 0x18, 0xe8, 0x28, 0xc5,
-	0x0c, 0x42, 0x92, 0x55, 0x38, 0x7d, 0xa3, 0x7b, 0xf1, 0x16, 0xe1, 0xc4, 0x0d, 0xc9, 0xbd, 0x28,
-	0x5c, 0x42, 0xe8, 0x39, 0x7d, 0xef, 0x88, 0x0e, 0xd4, 0x72, 0x44, 0x88, 0x40, 0xcc, 0x39, 0x0f,
-	0x8c, 0xe0, 0x60, 0x66, 0xcc, 0x97, 0xe7, 0x6d, 0x0a, 0x2f, 0x4b, 0xdc, 0x00, 0x59, 0xae, 0x81,
-	0xf0, 0x33, 0x23, 0x6c, 0xb7, 0x40, 0x74, 0x95, 0x10, 0x4d, 0x37, 0x44, 0xaf, 0x1d, 0x58, 0x8e,
-	0x35, 0x09, 0xed, 0x67, 0x58, 0x6a, 0x15, 0x85, 0x78, 0x02, 0xa5, 0xbf, 0x0d, 0x45, 0x34, 0x02,
-	0x23, 0x34, 0x30, 0xd0, 0x99, 0x46, 0x68, 0xac, 0x3a, 0xcb, 0x44, 0xbc, 0xfe, 0x3e, 0x00, 0xf7,
-	0x8e, 0x02, 0x2b, 0x24, 0xee, 0x9b, 0x89, 0x1a, 0x28, 0xde, 0x24, 0xb2, 0x29, 0xe1, 0x14, 0xf5,
-	0x7f, 0x54, 0xa0, 0xdc, 0xf7, 0x4d, 0xdc, 0x80, 0x83, 0xb9, 0x35, 0x79, 0x61, 0x24, 0x45, 0x2f,
-	0xe9, 0x39, 0x8e, 0x11, 0xc7, 0x21, 0xf4, 0x92, 0x11, 0x82, 0x7d, 0x00, 0xb9, 0xa9, 0x63, 0xec,
-	0xd3, 0xdc, 0xc5, 0xb9, 0x71, 0xa2, 0xf9, 0xe8, 0xfb, 0x81, 0x63, 0xec, 0x73, 0x62, 0xd5, 0x7f,
-	0x11, 0xf7, 0x4f, 0x67, 0x62, 0xc9, 0x93, 0xb0, 0x35, 0x3a, 0x5f, 0x1c, 0x34, 0x35, 0x85, 0xa9,
-	0x90, 0x6b, 0xb5, 0x07, 0x4d, 0x91, 0x11, 0x63, 0x6e, 0x3c, 0x18, 0x3d, 0xe8, 0xf0, 0xc1, 0x50,
-	0xcb, 0xd1, 0x81, 0x25, 0x21, 0xba, 0x8d, 0xc1, 0x50, 0x53, 0x19, 0x40, 0x61, 0xaf, 0xd7, 0xf9,
-	0xf9, 0x5e, 0x5b, 0xd3, 0xf4, 0xff, 0xa7, 0x00, 0x3c, 0xb1, 0x5d, 0xd3, 0x3b, 0xa2, 0xc1, 0xbd,
-	0x97, 0xc8, 0x7e, 0xd0, 0x2d, 0x9d, 0x9d, 0xc5, 0xf2, 0x7c, 0xe9, 0xd1, 0xd8, 0xbb, 0xa0, 0x7a,
-	0xa8, 0x1a, 0xb2, 0x66, 0x92, 0x3e, 0x29, 0x31, 0x22, 0x5e, 0xf4, 0x04, 0x80, 0xd6, 0xe4, 0x58,
-	0x86, 0x29, 0xcf, 0xa1, 0xe9, 0x1b, 0xed, 0x1d, 0xa7, 0x43, 0xdc, 0x7d, 0xe1, 0xa7, 0xfe, 0xcb,
-	0x1c, 0x94, 0x3a, 0x6e, 0x60, 0xf9, 0x61, 0x33, 0x3c, 0x66, 0x37, 0x21, 0xeb, 0x5b, 0xd3, 0xe7,
-	0x1d, 0x29, 0x22, 0x8d, 0xbd, 0x13, 0xd9, 0x8e, 0x69, 0x4d, 0x65, 0xb2, 0x59, 0x4b, 0xbb, 0x18,
-	0x69, 0x4b, 0x2d, 0x3a, 0x6c, 0xd6, 0xb0, 0xb8, 0x59, 0xcc, 0x1d, 0x7b, 0x82, 0xa5, 0xb3, 0x6d,
-	0x1e, 0x53, 0x8d, 0x98, 0xe7, 0x35, 0xcf, 0x6d, 0x45, 0xe8, 0x8e, 0x79, 0xcc, 0x76, 0xe1, 0x5c,
-	0x8a, 0x93, 0x16, 0x5d, 0xc4, 0xce, 0xdb, 0x51, 0x00, 0x92, 0x5a, 0xde, 0xeb, 0x2f, 0x45, 0x71,
-	0x92, 0x84, 0x13, 0x5b, 0xf7, 0xd2, 0x58, 0x0a, 0x64, 0xe6, 0xf1, 0x08, 0xc7, 0x23, 0xf2, 0x87,
-	0x33, 0xe3, 0xc1, 0x52, 0x57, 0x1e, 0xf2, 0x8b, 0xa2, 0xf7, 0x98, 0x12, 0x88, 0x3c, 0x11, 0x50,
-	0xa9, 0x9f, 0x52, 0xe6, 0x69, 0xb9, 0x21, 0xd1, 0x8a, 0xd4, 0xca, 0x8d, 0xd3, 0xda, 0xec, 0x12,
-	0x47, 0xc7, 0x94, 0xce, 0xb4, 0x34, 0x8f, 0x60, 0xf6, 0x09, 0x54, 0xa3, 0x98, 0x23, 0x4e, 0x0b,
-	0xd4, 0x15, 0x61, 0x87, 0x66, 0x8d, 0x57, 0x26, 0x09, 0xe8, 0x6a, 0x0f, 0x2e, 0xac, 0x1a, 0xe3,
-	0x0a, 0x77, 0xb5, 0x91, 0x74, 0x57, 0xa7, 0xaa, 0xa3, 0xd8, 0x75, 0x5d, 0xfd, 0x82, 0x0a, 0x8c,
-	0x84, 0x96, 0x3f, 0xca, 0xf1, 0xfd, 0xaa, 0x00, 0x25, 0x51, 0x34, 0xa6, 0x4c, 0x24, 0xfb, 0x5c,
-	0x13, 0xb9, 0x01, 0x59, 0x9c, 0xaf, 0x4c, 0x32, 0xba, 0x75, 0xcc, 0xae, 0x1d, 0x84, 0x1c, 0x09,
-	0xec, 0x5d, 0x69, 0x42, 0x2d, 0x8c, 0x6d, 0xd9, 0x64, 0xa8, 0x8f, 0x4d, 0x68, 0xc9, 0x80, 0xe5,
-	0x94, 0xa8, 0x70, 0x31, 0x66, 0x4a, 0x93, 0x58, 0x8f, 0x5d, 0xc7, 0xae, 0x17, 0x3c, 0x32, 0xe6,
-	0xd1, 0xa5, 0x57, 0xd3, 0x73, 0x7e, 0x13, 0xeb, 0xfe, 0x09, 0xac, 0x7b, 0xee, 0xc8, 0xb7, 0x82,
-	0xd0, 0xb7, 0x27, 0x21, 0x35, 0x55, 0x5c, 0xdd, 0x54, 0xd5, 0x73, 0xb9, 0x64, 0xc3, 0x16, 0xdf,
-	0x4c, 0x0b, 0x62, 0xcb, 0x2a, 0xb5, 0x9c, 0xe0, 0xc3, 0x0e, 0x3e, 0x82, 0x1a, 0xe6, 0xe8, 0x46,
-	0x30, 0x31, 0x4c, 0x8b, 0xda, 0x2f, 0xad, 0x6e, 0xbf, 0xe2, 0xb9, 0x4d, 0xc1, 0x85, 0xcd, 0x6f,
-	0xa6, 0xc4, 0xb0, 0x75, 0x58, 0x31, 0xc7, 0x4b, 0x19, 0xec, 0xea, 0xc3, 0x94, 0x0c, 0x6e, 0xda,
-	0xf2, 0xca, 0x19, 0x5f, 0x4a, 0xe1, 0xc6, 0xdd, 0x82, 0x8b, 0x09, 0xa9, 0xc4, 0xfc, 0x57, 0x56,
-	0xcf, 0x3f, 0x8b, 0xa5, 0xf7, 0xe2, 0x85, 0x78, 0x0f, 0xc0, 0x73, 0x47, 0x81, 0x25, 0x26, 0xb0,
-	0xba, 0x7a, 0x80, 0xaa, 0xe7, 0x0e, 0x2c, 0x9a, 0xbb, 0xbb, 0x31, 0x3b, 0x0e, 0xac, 0xb6, 0x62,
-	0x60, 0x82, 0xb7, 0x43, 0x16, 0x14, 0xf1, 0xe2, 0x80, 0xd6, 0x57, 0x0e, 0x48, 0x70, 0xe3, 0x60,
-	0x3e, 0x27, 0xdf, 0x82, 0xdc, 0x89, 0x81, 0x68, 0xab, 0x07, 0x52, 0x23, 0xa9, 0xe5, 0x20, 0xee,
-	0xa5, 0x5c, 0xc0, 0xb9, 0xe7, 0x58, 0x5f, 0xbc, 0xe7, 0xf5, 0x3f, 0xca, 0x42, 0xb9, 0xe1, 0x1a,
-	0xce, 0xc9, 0x77, 0x56, 0xc7, 0x9d, 0x7a, 0xe2, 0xe4, 0x6c, 0xbe, 0x08, 0x47, 0x18, 0x9e, 0xe5,
-	0x91, 0x77, 0x89, 0x30, 0x18, 0x17, 0xd9, 0xeb, 0x50, 0xf6, 0x16, 0x61, 0x4c, 0x17, 0x87, 0xe0,
-	0x20, 0x50, 0xc4, 0x10, 0xcb, 0x53, 0x2c, 0xcf, 0x26, 0xe4, 0x29, 0x92, 0x2f, 0xe5, 0xe3, 0x54,
-	0x20, 0x96, 0x27, 0x86, 0x5b, 0x50, 0x0d, 0xed, 0x19, 0x0e, 0xd9, 0x0d, 0x16, 0x33, 0xcb, 0x14,
-	0x4f, 0x06, 0xc4, 0x2d, 0x74, 0x53, 0xe2, 0xb0, 0x95, 0x99, 0x35, 0xf3, 0xfc, 0x13, 0xd1, 0x4a,
-	0x41, 0xb4, 0x22, 0x50, 0xd4, 0xca, 0xbb, 0xc0, 0x8e, 0x0c, 0x3b, 0x1c, 0xa5, 0x9b, 0x12, 0x65,
-	0xb5, 0x86, 0x94, 0x61, 0xb2, 0xb9, 0x4b, 0x50, 0x30, 0xed, 0xe0, 0xb0, 0xd3, 0x27, 0x87, 0x97,
-	0xe5, 0x12, 0xc2, 0xb4, 0x23, 0xb8, 0xdf, 0xe9, 0x8f, 0xc6, 0x27, 0xf2, 0xac, 0x3a, 0xcb, 0x55,
-	0x44, 0x6c, 0x9d, 0x84, 0x16, 0x0e, 0x94, 0x88, 0x13, 0x6f, 0xe1, 0x8a, 0x8b, 0x8b, 0x2c, 0x27,
-	0xf6, 0x26, 0x22, 0x30, 0xce, 0xbb, 0x56, 0x78, 0xe4, 0xf9, 0xd8, 0x6c, 0x59, 0x50, 0x63, 0x04,
-	0x66, 0x9f, 0xc1, 0xc4, 0x70, 0x51, 0x0b, 0x3a, 0xa0, 0xc6, 0x86, 0x25, 0xcc, 0x6e, 0xe0, 0x0c,
-	0xa2, 0xb3, 0x26, 0x6a, 0x55, 0x8c, 0x6d, 0x89, 0xd1, 0xff, 0xb6, 0x06, 0xb9, 0x9e, 0x67, 0x5a,
-	0xec, 0x27, 0x50, 0xa2, 0xfb, 0xce, 0xb3, 0x27, 0x2f, 0x48, 0xa6, 0x7f, 0x28, 0x45, 0x55, 0x5d,
-	0xf9, 0xf5, 0xfc, 0x1b, 0xd2, 0x9b, 0x90, 0x0f, 0x30, 0xdf, 0x93, 0x67, 0x29, 0xf2, 0x46, 0x8a,
-	0x52, 0x40, 0x2e, 0x28, 0x14, 0xfb, 0x7d, 0x0f, 0xb7, 0xc1, 0x88, 0x6e, 0x61, 0x72, 0x2b, 0x62,
-	0xbf, 0xa0, 0xd3, 0xa5, 0xf1, 0x55, 0x50, 0xa9, 0x7a, 0xf2, 0x2d, 0x51, 0x0e, 0xe7, 0x79, 0x0c,
-	0xa3, 0xe2, 0x4f, 0x3d, 0xdb, 0x15, 0x8a, 0x17, 0xce, 0x28, 0xfe, 0x95, 0x67, 0xbb, 0x94, 0xe0,
-	0xa8, 0xc8, 0x45, 0x8a, 0xdf, 0x82, 0xa2, 0xe7, 0x8a, 0x7e, 0x8b, 0x67, 0xfa, 0x2d, 0x78, 0x2e,
-	0x75, 0xf9, 0x0e, 0x94, 0xa7, 0xb6, 0x83, 0xd1, 0x8b, 0x18, 0xd5, 0x33, 0x8c, 0x20, 0xc8, 0xc4,
-	0xfc, 0x06, 0xa8, 0xfb, 0xbe, 0xb7, 0x98, 0x63, 0x6e, 0x52, 0x3a, 0x7b, 0x46, 0x41, 0xb4, 0xad,
-	0x13, 0x1c, 0x35, 0x7d, 0xda, 0xee, 0x3e, 0x6e, 0x48, 0xe9, 0xbf, 0x52, 0xa3, 0x8e, 0xe8, 0x03,
-	0x8b, 0x5a, 0x35, 0xf6, 0xf7, 0x47, 0xf2, 0x9a, 0xea, 0x4c, 0xab, 0xc6, 0xfe, 0x3e, 0x75, 0x9e,
-	0x4c, 0x8c, 0x2a, 0x2f, 0x4c, 0x8c, 0x12, 0x01, 0x25, 0x14, 0xf7, 0x16, 0xf1, 0x96, 0x8e, 0xc3,
-	0x5c, 0x1c, 0x50, 0xc2, 0x63, 0xf6, 0x0e, 0xa8, 0x47, 0xb6, 0x3b, 0x0a, 0xe6, 0xd6, 0x84, 0xde,
-	0xa5, 0xc4, 0x17, 0x6a, 0xcb, 0x4c, 0x8e, 0x17, 0x8f, 0x6c, 0x97, 0x52, 0xba, 0x0d, 0xc8, 0x3b,
-	0xf6, 0xcc, 0x0e, 0xe9, 0x95, 0xca, 0xa9, 0x80, 0x4c, 0x04, 0xa6, 0x43, 0xc1, 0x9b, 0x4e, 0x71,
-	0xf0, 0xda, 0x19, 0x16, 0x49, 0x49, 0x27, 0x59, 0xe7, 0x5e, 0x90, 0x64, 0x6d, 0xca, 0xf2, 0x0a,
-	0x99, 0x47, 0xcf, 0xac, 0x49, 0x9d, 0xad, 0xf4, 0x87, 0xe5, 0x48, 0xe0, 0xb1, 0x35, 0xc1, 0x20,
-	0xe9, 0x8d, 0x9f, 0x92, 0x63, 0x3e, 0xbf, 0x3a, 0xd9, 0x2b, 0x78, 0xe3, 0xa7, 0xe8, 0x96, 0x3f,
-	0x80, 0xb2, 0x4f, 0x19, 0xfc, 0x88, 0x12, 0xfd, 0x0b, 0xc9, 0x09, 0x58, 0xa6, 0xf6, 0x1c, 0xfc,
-	0x65, 0x9a, 0x7f, 0x0b, 0xaa, 0xe2, 0x8e, 0x44, 0x1c, 0xb0, 0x07, 0x54, 0xa3, 0x97, 0x78, 0x85,
-	0x90, 0xe2, 0xf0, 0x9d, 0xc2, 0xba, 0x38, 0xf4, 0xa6, 0x55, 0xb8, 0x94, 0x54, 0x42, 0x9c, 0x6e,
-	0xd3, 0x2a, 0x98, 0xd1, 0x27, 0x96, 0x35, 0x63, 0xdb, 0x35, 0xd1, 0x70, 0x42, 0x63, 0x5f, 0x14,
-	0xe5, 0x79, 0x5e, 0x96, 0xb8, 0xa1, 0xb1, 0x1f, 0xb0, 0x0f, 0xa1, 0x62, 0x08, 0xd7, 0x3b, 0xb2,
-	0xdd, 0xa9, 0x27, 0x6b, 0x71, 0x69, 0x0a, 0x09, 0xa7, 0xcc, 0xcb, 0x46, 0xc2, 0x43, 0x7f, 0x02,
-	0x2c, 0x3a, 0x49, 0xa1, 0xac, 0x53, 0x58, 0xdb, 0x95, 0x33, 0xd6, 0xb6, 0x2e, 0x8f, 0x52, 0xe2,
-	0x77, 0x1c, 0x1b, 0x80, 0xd9, 0xb9, 0xe1, 0x38, 0x96, 0x63, 0x07, 0xb3, 0xfa, 0x55, 0xf2, 0x00,
-	0x49, 0xd4, 0xd9, 0x04, 0xf0, 0xda, 0xcb, 0x25, 0x80, 0x38, 0x83, 0xae, 0x17, 0x8e, 0x26, 0xc6,
-	0xe4, 0xc0, 0x22, 0xc1, 0xeb, 0x54, 0x52, 0x57, 0x5c, 0x2f, 0x6c, 0x46, 0x38, 0x9c, 0x41, 0xe1,
-	0xc6, 0x68, 0x06, 0x5f, 0x4b, 0xce, 0x60, 0x9c, 0x9d, 0x62, 0xac, 0x90, 0x9f, 0xfa, 0xdf, 0x65,
-	0x41, 0x8d, 0x9c, 0x18, 0x2b, 0x43, 0x71, 0xaf, 0xf7, 0xb0, 0xd7, 0x7f, 0xd2, 0xd3, 0xd6, 0xb0,
-	0x64, 0x79, 0xdc, 0xe8, 0xee, 0xb5, 0x47, 0x83, 0x66, 0xa3, 0x27, 0xde, 0x5c, 0xd0, 0x7d, 0xbf,
-	0x80, 0x33, 0xec, 0x1c, 0x54, 0x1f, 0xec, 0xf5, 0xe8, 0x8c, 0x5f, 0xa0, 0xb2, 0x88, 0x6a, 0x7f,
-	0x2d, 0xea, 0x22, 0x81, 0xca, 0x21, 0xea, 0x51, 0x63, 0xd8, 0xe6, 0x9d, 0x08, 0x95, 0xc7, 0x5e,
-	0x76, 0x79, 0xff, 0xab, 0x76, 0x73, 0xa8, 0x01, 0xbb, 0x08, 0xe7, 0x62, 0x91, 0xa8, 0x39, 0xad,
-	0x8c, 0x15, 0x56, 0x24, 0xa6, 0x5d, 0xc0, 0x46, 0x78, 0xbb, 0xb9, 0xc7, 0x07, 0x9d, 0xc7, 0xed,
-	0x51, 0x73, 0xd8, 0xd6, 0x2e, 0x62, 0xad, 0x35, 0xe8, 0xf4, 0x1e, 0x6a, 0x97, 0x58, 0x15, 0x4a,
-	0xf8, 0x25, 0x5a, 0xbf, 0x4c, 0xd5, 0xd8, 0xf6, 0xb6, 0x76, 0x03, 0x9b, 0x68, 0x75, 0x06, 0xc3,
-	0x4e, 0xaf, 0x39, 0xd4, 0x5e, 0xc7, 0x82, 0xeb, 0x41, 0xa7, 0x3b, 0x6c, 0x73, 0x6d, 0x03, 0x65,
-	0xbf, 0xea, 0x77, 0x7a, 0xda, 0x4d, 0x7a, 0xaa, 0xd0, 0x78, 0xb4, 0xdb, 0x6d, 0x6b, 0x3a, 0xb5,
-	0xd8, 0xe7, 0x43, 0xed, 0x16, 0x2b, 0x41, 0x7e, 0xaf, 0x87, 0x7a, 0xdc, 0xc6, 0xc6, 0xe9, 0x73,
-	0xd4, 0xe8, 0x76, 0xb5, 0x37, 0x12, 0x65, 0xdb, 0x9b, 0xf8, 0xfd, 0xa4, 0xd3, 0x6b, 0xf5, 0x9f,
-	0x68, 0x6f, 0x21, 0xdb, 0x16, 0xef, 0x37, 0x5a, 0x4d, 0xac, 0xee, 0xee, 0x60, 0x03, 0x83, 0xdd,
-	0x6e, 0x67, 0xa8, 0xbd, 0x8d, 0x5c, 0xdb, 0x8d, 0xe1, 0x4e, 0x9b, 0x6b, 0x77, 0xf1, 0xbb, 0x31,
-	0x18, 0xb4, 0xf9, 0x50, 0xdb, 0xc4, 0xef, 0x4e, 0x8f, 0xbe, 0xef, 0x53, 0xab, 0xbb, 0xad, 0xc6,
-	0xb0, 0xad, 0x7d, 0x88, 0xdf, 0xad, 0x76, 0xb7, 0x3d, 0x6c, 0x6b, 0x1f, 0x61, 0xab, 0x54, 0x66,
-	0x0e, 0x70, 0xaa, 0x3e, 0xc6, 0x59, 0x88, 0x41, 0xd2, 0xe7, 0x13, 0xec, 0xe8, 0x51, 0xa7, 0xb7,
-	0x37, 0xd0, 0x3e, 0x45, 0x66, 0xfa, 0x24, 0xca, 0x67, 0xfa, 0x53, 0x50, 0x23, 0x17, 0x8f, 0x5c,
-	0x9d, 0x5e, 0xaf, 0xcd, 0xb5, 0x35, 0x1c, 0x64, 0xb7, 0xfd, 0x60, 0xa8, 0x29, 0x88, 0xe4, 0x9d,
-	0xed, 0x9d, 0xa1, 0x96, 0xc1, 0xcf, 0xfe, 0x1e, 0x4e, 0x4d, 0x96, 0x26, 0xa1, 0xfd, 0xa8, 0xa3,
-	0xe5, 0xf0, 0xab, 0xd1, 0x1b, 0x76, 0xb4, 0x3c, 0x4d, 0x52, 0xa7, 0xb7, 0xdd, 0x6d, 0x6b, 0x05,
-	0xc4, 0x3e, 0x6a, 0xf0, 0x87, 0x5a, 0x11, 0x85, 0x1a, 0xbb, 0xbb, 0xdd, 0x6f, 0x34, 0x55, 0xbf,
-	0x03, 0xc5, 0xc6, 0xfe, 0xfe, 0x23, 0x0c, 0x97, 0x2a, 0xe4, 0x1e, 0xec, 0x75, 0xbb, 0xe2, 0xb9,
-	0xce, 0x56, 0x7f, 0x38, 0xec, 0x3f, 0xd2, 0x14, 0x5c, 0x93, 0x61, 0x7f, 0x57, 0xcb, 0xe8, 0xd7,
-	0xa1, 0x20, 0xd2, 0x36, 0x2a, 0x44, 0xa3, 0xf7, 0x4e, 0x59, 0xf9, 0xc6, 0xc9, 0x83, 0x52, 0x9c,
-	0x3e, 0xb1, 0xbb, 0x90, 0x9d, 0x19, 0x73, 0x59, 0x52, 0xd4, 0x4f, 0x25, 0x57, 0xf7, 0x1e, 0x19,
-	0x73, 0x51, 0x59, 0x21, 0xd3, 0xd5, 0x8f, 0x41, 0x8d, 0x10, 0x3f, 0xaa, 0x88, 0xf9, 0xad, 0x1c,
-	0x94, 0x5a, 0x09, 0x67, 0xf2, 0xc2, 0x22, 0x26, 0x51, 0x46, 0x64, 0x5e, 0xba, 0x8c, 0xc8, 0xbe,
-	0xa8, 0x8c, 0xc8, 0xbd, 0x6a, 0x19, 0x91, 0x7f, 0xb9, 0x32, 0xa2, 0xf0, 0x32, 0x65, 0xc4, 0xed,
-	0x33, 0x65, 0x44, 0x91, 0x5a, 0x4f, 0x17, 0x0e, 0xe9, 0xf4, 0x5d, 0x7d, 0x51, 0xfa, 0x9e, 0x4e,
-	0xc9, 0x4b, 0x2f, 0x48, 0xc9, 0xd3, 0xc9, 0x3e, 0xfc, 0xda, 0x64, 0x7f, 0x65, 0xfa, 0x5e, 0x7e,
-	0xb9, 0xf4, 0xfd, 0x26, 0x54, 0x26, 0x86, 0x3b, 0x0a, 0xfd, 0x85, 0x8b, 0xa5, 0xb4, 0x7c, 0xbd,
-	0x50, 0xc6, 0xdc, 0x50, 0xa2, 0xf4, 0x5f, 0x65, 0x20, 0xff, 0xf3, 0x85, 0xe5, 0x9f, 0xb0, 0x8f,
-	0xa1, 0x14, 0x84, 0xb3, 0x30, 0x99, 0x00, 0x5e, 0x11, 0x1d, 0x10, 0x9d, 0xf2, 0x37, 0x6b, 0x66,
-	0xb9, 0xa1, 0x48, 0x03, 0x91, 0x97, 0x7c, 0xe9, 0x05, 0xcc, 0xf6, 0xac, 0xb9, 0xb8, 0x6c, 0xc9,
-	0x73, 0x01, 0x60, 0x26, 0x80, 0xd9, 0x60, 0x54, 0xe1, 0xc2, 0x32, 0x23, 0xe3, 0x82, 0x80, 0x99,
-	0x00, 0x9d, 0x0f, 0x06, 0x2b, 0x92, 0x3f, 0x49, 0xc1, 0xbc, 0xef, 0xc0, 0x32, 0x30, 0xc4, 0x45,
-	0xd7, 0xf6, 0x31, 0xcc, 0xea, 0x50, 0x74, 0x3c, 0xc3, 0x1c, 0x1a, 0xfb, 0xd1, 0xc3, 0x12, 0x09,
-	0xea, 0x4f, 0xa0, 0x9a, 0x52, 0x36, 0xed, 0xee, 0x71, 0x97, 0xb7, 0xbb, 0xe8, 0x69, 0x94, 0x84,
-	0x73, 0xca, 0x24, 0x1c, 0x52, 0x36, 0xe1, 0xa8, 0x72, 0xe4, 0x7a, 0xda, 0x7c, 0xbb, 0xad, 0xe5,
-	0xf5, 0xdf, 0xcd, 0xc0, 0xb9, 0xa1, 0x6f, 0xb8, 0x81, 0x21, 0x2e, 0x93, 0xdc, 0xd0, 0xf7, 0x1c,
-	0xf6, 0x39, 0xa8, 0xe1, 0xc4, 0x49, 0xce, 0xdb, 0xeb, 0x72, 0xe5, 0x4f, 0xb3, 0xde, 0x1b, 0x4e,
-	0x1c, 0x9a, 0xbd, 0x62, 0x28, 0x3e, 0xd8, 0x7b, 0x90, 0x1f, 0x5b, 0xfb, 0xb6, 0x2b, 0x4f, 0x30,
-	0x2e, 0x9e, 0x16, 0xdc, 0x42, 0xe2, 0xce, 0x1a, 0x17, 0x5c, 0xec, 0x27, 0x50, 0x98, 0x78, 0x33,
-	0x4c, 0xb0, 0xb2, 0xc9, 0xab, 0xc6, 0x64, 0x47, 0x48, 0xdd, 0x59, 0xe3, 0x92, 0x8f, 0x7d, 0x0c,
-	0xaa, 0xef, 0x39, 0xce, 0xd8, 0x98, 0x1c, 0xca, 0xeb, 0xc9, 0xfa, 0x69, 0x19, 0x2e, 0xe9, 0x3b,
-	0x6b, 0x3c, 0xe6, 0xd5, 0xef, 0x41, 0x51, 0x2a, 0x8b, 0x13, 0xb0, 0xd5, 0xde, 0xee, 0xc8, 0xb9,
-	0x6b, 0xf6, 0x1f, 0x3d, 0xea, 0x0c, 0xc5, 0xd5, 0x38, 0xef, 0x77, 0xbb, 0x5b, 0x8d, 0xe6, 0x43,
-	0x2d, 0xb3, 0xa5, 0x42, 0xc1, 0xa0, 0x83, 0x61, 0xfd, 0x7f, 0x2b, 0xb0, 0x7e, 0x6a, 0x00, 0xec,
-	0x53, 0xc8, 0xcd, 0x3c, 0x33, 0x9a, 0x9e, 0xdb, 0x2b, 0x47, 0x99, 0x80, 0xd1, 0xc3, 0x72, 0x92,
-	0xd0, 0x3f, 0x83, 0x5a, 0x1a, 0x9f, 0x78, 0xa7, 0x57, 0x85, 0x12, 0x6f, 0x37, 0x5a, 0xa3, 0x7e,
-	0xaf, 0xfb, 0x8d, 0x88, 0xdb, 0x04, 0x3e, 0xe1, 0x9d, 0x61, 0x5b, 0xcb, 0xe8, 0xbf, 0x00, 0xed,
-	0xf4, 0xc4, 0xb0, 0x6d, 0x58, 0x9f, 0x78, 0xb3, 0xb9, 0x63, 0xd1, 0xa1, 0x63, 0x62, 0xc9, 0x6e,
-	0xac, 0x98, 0x49, 0xc9, 0x46, 0x2b, 0x56, 0x9b, 0xa4, 0x60, 0xfd, 0x7f, 0x00, 0x3b, 0x3b, 0x83,
-	0xbf, 0xb9, 0xe6, 0xff, 0x58, 0x81, 0xdc, 0xae, 0x63, 0xb8, 0xec, 0x16, 0xe4, 0xe9, 0x0d, 0x9c,
-	0x3c, 0x95, 0x2c, 0x27, 0x76, 0x24, 0x9a, 0x05, 0xd1, 0xd8, 0x3b, 0x90, 0x0d, 0x27, 0x8e, 0xb4,
-	0xa1, 0xcb, 0xcf, 0x31, 0xbe, 0x9d, 0x35, 0x8e, 0x5c, 0xec, 0x0e, 0x64, 0x4d, 0xd3, 0x91, 0x06,
-	0x24, 0x6f, 0x8e, 0x30, 0x71, 0x6d, 0x59, 0x53, 0xdb, 0xb5, 0xe5, 0x8b, 0x3c, 0x64, 0x61, 0x6f,
-	0x40, 0xd6, 0x9c, 0x38, 0xd2, 0x6c, 0xce, 0x2d, 0x39, 0x13, 0x0d, 0x9a, 0x13, 0x67, 0xab, 0x00,
-	0xf4, 0x1b, 0x07, 0xfd, 0x5d, 0x7a, 0x71, 0xb6, 0x98, 0xb9, 0xb8, 0xb5, 0xc5, 0xd7, 0x8a, 0x33,
-	0x5d, 0x49, 0xd1, 0xff, 0x23, 0x03, 0xe5, 0x44, 0x63, 0xec, 0x43, 0x50, 0xcd, 0xf4, 0x2e, 0xba,
-	0x72, 0xa6, 0xc7, 0x7b, 0xad, 0x68, 0xff, 0x98, 0xd2, 0x36, 0x3f, 0x83, 0x2a, 0xba, 0xc6, 0x67,
-	0x86, 0x6f, 0xa3, 0x9b, 0x0d, 0xe4, 0x1c, 0xc8, 0x1c, 0x73, 0x60, 0x85, 0x8f, 0x23, 0xca, 0xce,
-	0x1a, 0xaf, 0x04, 0x09, 0x98, 0xbd, 0x0d, 0xc5, 0xb9, 0x6f, 0xcd, 0x0d, 0xdf, 0x92, 0x73, 0x51,
-	0x8d, 0xae, 0x53, 0x08, 0xb9, 0xb3, 0xc6, 0x23, 0x3a, 0xb2, 0x5a, 0xc7, 0xd6, 0x64, 0x11, 0x5a,
-	0x72, 0x32, 0xaa, 0xd1, 0x80, 0x08, 0x89, 0xac, 0x92, 0xce, 0x36, 0x31, 0xb1, 0x37, 0x1c, 0xc7,
-	0x23, 0x87, 0x9b, 0x4f, 0xd6, 0x0b, 0xad, 0x18, 0x2f, 0x1e, 0xb2, 0x47, 0x90, 0xbe, 0x0f, 0x45,
-	0x39, 0x30, 0x4c, 0x7d, 0x06, 0xed, 0xe1, 0xe8, 0x71, 0x83, 0x77, 0x30, 0x05, 0x1d, 0x68, 0x6b,
-	0xb8, 0xfd, 0xb6, 0x79, 0xa3, 0x27, 0xdd, 0x15, 0x6f, 0x3f, 0xee, 0x3f, 0x6c, 0x6b, 0x19, 0x71,
-	0x06, 0xdf, 0xfb, 0x46, 0xcb, 0x8a, 0x34, 0xb3, 0xbd, 0xdb, 0xe0, 0xe8, 0xad, 0xca, 0x50, 0x6c,
-	0x7f, 0xdd, 0x6e, 0xee, 0x0d, 0xdb, 0x5a, 0x1e, 0x77, 0x44, 0xab, 0xdd, 0xe8, 0x76, 0xfb, 0x4d,
-	0x74, 0x65, 0x85, 0xad, 0x12, 0x14, 0x27, 0x62, 0x26, 0xf5, 0x3f, 0x29, 0x43, 0x2d, 0xbd, 0xea,
-	0xec, 0x13, 0x50, 0x4d, 0x33, 0xb5, 0x02, 0xd7, 0x57, 0x59, 0xc7, 0xbd, 0x96, 0x19, 0x2d, 0x82,
-	0xf8, 0xc0, 0x7a, 0x5f, 0xd8, 0x68, 0xe6, 0x8c, 0x8d, 0x46, 0x16, 0xfa, 0x33, 0x58, 0x97, 0xef,
-	0xc7, 0xb0, 0x8e, 0x1a, 0x1b, 0x81, 0x95, 0x36, 0xc0, 0x26, 0x11, 0x5b, 0x92, 0xb6, 0xb3, 0xc6,
-	0x6b, 0x93, 0x14, 0x86, 0x7d, 0x01, 0x35, 0x83, 0xaa, 0xf1, 0x58, 0x3e, 0x97, 0xbc, 0x03, 0x6b,
-	0x20, 0x2d, 0x21, 0x5e, 0x35, 0x92, 0x08, 0x34, 0x13, 0xd3, 0xf7, 0xe6, 0x4b, 0xe1, 0x7c, 0xd2,
-	0x4c, 0x5a, 0xbe, 0x37, 0x4f, 0xc8, 0x56, 0xcc, 0x04, 0xcc, 0x3e, 0x86, 0x8a, 0xd4, 0x5c, 0x14,
-	0x31, 0x85, 0xe4, 0x6e, 0x10, 0x6a, 0x53, 0x84, 0xdf, 0x59, 0xe3, 0xe5, 0xc9, 0x12, 0x64, 0xf7,
-	0xa1, 0x2c, 0x14, 0x5e, 0xfe, 0x60, 0x26, 0xb6, 0x04, 0xd2, 0x36, 0x92, 0x02, 0x23, 0x86, 0xd8,
-	0x4f, 0x00, 0x48, 0xcf, 0xe4, 0x81, 0xf9, 0xfa, 0x52, 0xc9, 0x48, 0xa4, 0x64, 0x46, 0x40, 0x42,
-	0x3d, 0x71, 0xed, 0x59, 0x3a, 0xab, 0x1e, 0xdd, 0xf8, 0x2d, 0xd5, 0x8b, 0xae, 0x39, 0xa5, 0x7a,
-	0x42, 0x0c, 0xce, 0xa8, 0x17, 0x49, 0x09, 0xf5, 0x84, 0x50, 0xa4, 0x9e, 0x90, 0x29, 0x9f, 0x56,
-	0x2f, 0x12, 0x21, 0xf5, 0x84, 0xc4, 0x17, 0x50, 0x8b, 0xb2, 0x0f, 0x39, 0xa8, 0x4a, 0xea, 0xba,
-	0x5e, 0xd2, 0xa2, 0x81, 0x55, 0xc3, 0x24, 0x02, 0xa5, 0x83, 0x03, 0xef, 0x28, 0xb1, 0xbd, 0xab,
-	0x49, 0xe9, 0xc1, 0x81, 0x77, 0x94, 0xdc, 0xdf, 0xd5, 0x20, 0x89, 0x40, 0x6d, 0xc5, 0x10, 0xe9,
-	0xb5, 0x43, 0x2d, 0xa9, 0x2d, 0x8d, 0xf0, 0xb1, 0x6d, 0x1d, 0xa1, 0xb6, 0x46, 0x04, 0xe0, 0xa4,
-	0xd0, 0xf5, 0x64, 0x28, 0x3a, 0x5b, 0x4f, 0x4e, 0x0a, 0x5d, 0xca, 0x46, 0x3d, 0x81, 0x13, 0x43,
-	0x68, 0x5b, 0x0b, 0x37, 0x29, 0xa6, 0x25, 0x6d, 0x6b, 0xcf, 0x4d, 0x09, 0x56, 0x04, 0xab, 0x80,
-	0xf5, 0xdf, 0xcb, 0x41, 0x51, 0xee, 0x26, 0x76, 0x1e, 0xd6, 0x9b, 0xbc, 0xdd, 0x18, 0xb6, 0x47,
-	0xad, 0xc6, 0xb0, 0xb1, 0xd5, 0x18, 0x60, 0x84, 0x63, 0x50, 0x6b, 0x60, 0x2d, 0xb7, 0xc4, 0x29,
-	0xe8, 0x22, 0x5a, 0xbc, 0xbf, 0xbb, 0x44, 0x65, 0x98, 0x06, 0x15, 0x29, 0x2b, 0x1e, 0xaa, 0x67,
-	0xd9, 0x3a, 0x94, 0x85, 0xa0, 0x40, 0xd0, 0xbd, 0x1c, 0x49, 0x09, 0x38, 0x9f, 0x10, 0xe9, 0xf4,
-	0x5a, 0xed, 0xaf, 0xb5, 0xc2, 0x52, 0x44, 0x20, 0x8a, 0xb1, 0x88, 0x80, 0x55, 0x54, 0x66, 0xc8,
-	0xf7, 0x7a, 0xcd, 0x65, 0x3f, 0x25, 0x14, 0x92, 0xcd, 0x3c, 0xee, 0xb4, 0x9f, 0x68, 0x80, 0x42,
-	0xa2, 0x15, 0x82, 0xcb, 0x18, 0xa3, 0xa9, 0x11, 0x02, 0x2b, 0xec, 0x32, 0x9c, 0x1f, 0xec, 0xf4,
-	0x9f, 0x8c, 0x84, 0x50, 0x3c, 0x84, 0x2a, 0xbb, 0x00, 0x5a, 0x82, 0x20, 0x9a, 0xaf, 0x61, 0x97,
-	0x84, 0x8d, 0x18, 0x07, 0xda, 0x3a, 0x3d, 0xc2, 0x43, 0xdc, 0x50, 0x38, 0x48, 0x0d, 0x87, 0x22,
-	0x44, 0xfb, 0xdd, 0xbd, 0x47, 0xbd, 0x81, 0x76, 0x0e, 0x95, 0x20, 0x8c, 0xd0, 0x9c, 0xc5, 0xcd,
-	0x2c, 0xdd, 0xea, 0x79, 0xf2, 0xb4, 0x88, 0x7b, 0xd2, 0xe0, 0xbd, 0x4e, 0x6f, 0x7b, 0xa0, 0x5d,
-	0x88, 0x5b, 0x6e, 0x73, 0xde, 0xe7, 0x03, 0xed, 0x62, 0x8c, 0x18, 0x0c, 0x1b, 0xc3, 0xbd, 0x81,
-	0x76, 0x29, 0xd6, 0x72, 0x97, 0xf7, 0x9b, 0xed, 0xc1, 0xa0, 0xdb, 0x19, 0x0c, 0xb5, 0xcb, 0x58,
-	0xda, 0x2f, 0x35, 0x8a, 0x98, 0xeb, 0x09, 0x45, 0xf9, 0x76, 0x7b, 0xa8, 0x5d, 0x89, 0xd5, 0x68,
-	0xf6, 0xbb, 0xdd, 0x06, 0xd5, 0xff, 0x57, 0x91, 0xa9, 0xdb, 0x6f, 0x3e, 0x8c, 0x46, 0x73, 0x0d,
-	0xf5, 0xda, 0xeb, 0x25, 0x51, 0xd7, 0xb7, 0x2a, 0xf4, 0x53, 0x28, 0xe9, 0x7e, 0xf5, 0x5d, 0xa8,
-	0xa5, 0xbd, 0x25, 0xd3, 0xa1, 0x6a, 0x4f, 0x47, 0xae, 0x17, 0x8a, 0x97, 0xa2, 0x81, 0x7c, 0x97,
-	0x5b, 0xb6, 0xa7, 0x3d, 0x2f, 0xa4, 0xa7, 0xa2, 0x94, 0x49, 0xc7, 0xce, 0x4f, 0x5c, 0x14, 0xc7,
-	0xb0, 0xbe, 0x03, 0xd5, 0x94, 0xff, 0x64, 0xd7, 0xa0, 0x64, 0x4f, 0xd3, 0x8d, 0xa9, 0xf6, 0xf4,
-	0x25, 0x5a, 0xda, 0x86, 0x4a, 0xd2, 0x99, 0xbe, 0x7a, 0x43, 0x7f, 0x9f, 0x81, 0x72, 0xc2, 0xb9,
-	0xbe, 0xd4, 0x10, 0xaf, 0x43, 0x29, 0xb4, 0x66, 0x73, 0xcf, 0x37, 0x64, 0x28, 0x52, 0xf9, 0x12,
-	0x91, 0xea, 0x2d, 0x9b, 0xee, 0x2d, 0x7d, 0xe0, 0x98, 0x7b, 0xc1, 0x81, 0xe3, 0x07, 0x50, 0x49,
-	0x3c, 0xe0, 0x0d, 0xe4, 0x35, 0xdb, 0x99, 0xf3, 0xc6, 0xe5, 0x63, 0xde, 0x80, 0x5d, 0x84, 0xc2,
-	0xf4, 0x70, 0x64, 0x8e, 0xc5, 0x13, 0xad, 0x12, 0xcf, 0x4f, 0x0f, 0x5b, 0x63, 0x7a, 0x0e, 0x31,
-	0x8d, 0xbd, 0x46, 0x51, 0x94, 0x37, 0xd3, 0xc8, 0xad, 0x7c, 0x08, 0xc5, 0xe9, 0xa1, 0x78, 0x28,
-	0x23, 0xaa, 0xcf, 0x6b, 0x67, 0x42, 0xce, 0xbd, 0x07, 0x87, 0xf2, 0x71, 0x33, 0x2f, 0x4c, 0xf1,
-	0x33, 0xb8, 0xfa, 0x3a, 0x94, 0x62, 0x64, 0xea, 0xd1, 0x75, 0x49, 0xbe, 0x30, 0xe8, 0x03, 0x2c,
-	0xa3, 0xcf, 0xf2, 0xf7, 0x9c, 0x4a, 0xe2, 0xf7, 0x9c, 0x3f, 0xea, 0x92, 0x5b, 0xff, 0x07, 0x05,
-	0x4a, 0xb1, 0x3b, 0x7d, 0xe5, 0x05, 0x4f, 0x2f, 0x5e, 0xf6, 0xf4, 0xe2, 0xc5, 0x7a, 0xe6, 0x9e,
-	0xab, 0x67, 0xfe, 0x47, 0x2e, 0x5b, 0xe1, 0x85, 0xcb, 0xa6, 0xff, 0xbb, 0x02, 0xa5, 0x38, 0xec,
-	0xbe, 0xfa, 0xd0, 0x62, 0xe5, 0xb3, 0x49, 0xe5, 0xef, 0xc2, 0xb9, 0xd3, 0xef, 0xc0, 0x45, 0x25,
-	0x5c, 0xe2, 0xeb, 0xe9, 0x87, 0xe0, 0xc1, 0xd9, 0x93, 0xd4, 0xfc, 0x4b, 0x9e, 0xa4, 0x5e, 0x01,
-	0x31, 0x01, 0x23, 0xdb, 0xa4, 0xc4, 0x25, 0xc7, 0x8b, 0x04, 0x77, 0xcc, 0xd3, 0xaf, 0xb7, 0x8b,
-	0xf4, 0x22, 0x2d, 0xf1, 0x7a, 0x5b, 0xff, 0x33, 0x25, 0xda, 0x82, 0x22, 0x94, 0x27, 0x87, 0xa8,
-	0x3c, 0x6f, 0x88, 0x99, 0xe4, 0x10, 0x3f, 0x81, 0xba, 0x7c, 0xef, 0x25, 0x94, 0x90, 0xbf, 0x9a,
-	0x18, 0x1d, 0x5a, 0x27, 0x72, 0x2e, 0x2e, 0x0a, 0x3a, 0x29, 0xbb, 0x7c, 0x8e, 0xc7, 0xde, 0x82,
-	0xbc, 0x48, 0x31, 0x72, 0xcf, 0x49, 0xb6, 0xb8, 0xa0, 0x9f, 0x7e, 0x22, 0x9f, 0x3f, 0xfd, 0x44,
-	0x5e, 0xd7, 0xa5, 0xb9, 0x8b, 0x21, 0x5c, 0x88, 0xda, 0x8d, 0x9e, 0xf7, 0x23, 0x80, 0x05, 0x6b,
-	0x29, 0x4e, 0x5f, 0x5e, 0x61, 0x98, 0xe9, 0x9f, 0x07, 0x64, 0x4f, 0xff, 0x3c, 0x60, 0xd5, 0x83,
-	0xff, 0xdc, 0xaa, 0x07, 0xff, 0xfa, 0x0f, 0x0a, 0x54, 0x53, 0x19, 0xd1, 0x2b, 0x28, 0xb3, 0xd2,
-	0xac, 0xb2, 0x2f, 0x69, 0x56, 0xb9, 0x57, 0x30, 0xab, 0xfc, 0xaf, 0x35, 0xab, 0xc2, 0x19, 0xb3,
-	0xfa, 0xff, 0x4a, 0xfc, 0xc4, 0x5d, 0x34, 0x26, 0x5e, 0x23, 0xa7, 0x15, 0x51, 0xa2, 0xd7, 0xc8,
-	0x29, 0xce, 0x1b, 0x00, 0xc6, 0x84, 0x6e, 0x48, 0x3b, 0x2d, 0x71, 0xdc, 0x54, 0xe5, 0x09, 0x0c,
-	0xfb, 0x0c, 0xae, 0x88, 0xe2, 0x52, 0x24, 0xa8, 0x23, 0x6f, 0x3a, 0x8a, 0xa8, 0xd1, 0x43, 0xa0,
-	0x4b, 0x82, 0x41, 0xfc, 0x10, 0x62, 0xda, 0x88, 0xa8, 0x7a, 0x07, 0xaa, 0xa9, 0x6c, 0x32, 0xf1,
-	0x5b, 0x5d, 0x25, 0xf9, 0x5b, 0x5d, 0xb6, 0x01, 0xf9, 0xa3, 0x03, 0xcb, 0xb7, 0x56, 0xfc, 0xa6,
-	0x50, 0x10, 0xf4, 0x2f, 0xa0, 0x92, 0xac, 0x3b, 0xd9, 0xbb, 0x90, 0xb7, 0x43, 0x6b, 0x16, 0xbd,
-	0xfb, 0xba, 0x74, 0xb6, 0x34, 0xa5, 0xe7, 0xdb, 0x82, 0x49, 0xff, 0xa5, 0x02, 0xda, 0x69, 0x5a,
-	0xe2, 0x07, 0xc5, 0xca, 0x73, 0x7e, 0x50, 0x9c, 0x49, 0x29, 0xb9, 0xe2, 0x47, 0xc1, 0xcb, 0xb7,
-	0x32, 0xb9, 0xe7, 0xbc, 0x95, 0x61, 0x6f, 0x82, 0xea, 0x5b, 0xf4, 0x23, 0x4e, 0x33, 0xfd, 0x6b,
-	0x2e, 0xf1, 0x94, 0x38, 0xa2, 0xe9, 0xff, 0x47, 0x81, 0xa2, 0x2c, 0x92, 0x57, 0xbe, 0x02, 0x7c,
-	0x1b, 0x8a, 0xe2, 0x07, 0x9d, 0xc1, 0xf3, 0xce, 0x8e, 0x23, 0x3a, 0xbb, 0x21, 0x8e, 0x0e, 0xd2,
-	0x8f, 0xee, 0x77, 0x1d, 0xc3, 0xe5, 0x84, 0x47, 0x6b, 0xa2, 0x93, 0x40, 0x2a, 0x4a, 0x85, 0x7b,
-	0xcc, 0xd3, 0x43, 0x77, 0x63, 0x86, 0x49, 0x73, 0xa0, 0xff, 0x14, 0x8a, 0xb2, 0x08, 0x5f, 0xa9,
-	0xca, 0x8b, 0x7e, 0x00, 0xba, 0x01, 0xb0, 0xac, 0xca, 0x57, 0xb5, 0xa0, 0xff, 0x5f, 0x45, 0x3e,
-	0x7c, 0xc4, 0x34, 0x9e, 0x6e, 0xcc, 0xde, 0x07, 0xd5, 0x89, 0x9e, 0x72, 0x2a, 0xcf, 0x7f, 0xca,
-	0x19, 0x33, 0xb1, 0x3a, 0xc8, 0xdd, 0xd1, 0x92, 0xbf, 0x71, 0x8a, 0x40, 0x0c, 0x7a, 0x03, 0xf1,
-	0x7b, 0x82, 0x4e, 0x8b, 0xe6, 0xa0, 0xc2, 0x97, 0x08, 0x54, 0x87, 0x9e, 0x45, 0xe0, 0xa8, 0x2b,
-	0x9c, 0xbe, 0xf5, 0x06, 0xc0, 0xb2, 0x9e, 0x60, 0xf7, 0xe5, 0x1b, 0x6a, 0x44, 0x45, 0xf6, 0x75,
-	0x5a, 0x19, 0xba, 0xf2, 0x4b, 0xb0, 0xe9, 0x35, 0xa8, 0x24, 0x8b, 0x92, 0xbb, 0x37, 0xa1, 0x92,
-	0xfc, 0x51, 0x1f, 0x9d, 0xaf, 0x79, 0xae, 0x25, 0xde, 0xfb, 0x75, 0xbf, 0xfb, 0x50, 0x53, 0xee,
-	0xfe, 0xcf, 0xc4, 0x6b, 0x79, 0xe2, 0x29, 0x42, 0xf6, 0x61, 0xfb, 0x1b, 0x71, 0x77, 0xd6, 0xed,
-	0xf4, 0xda, 0x0d, 0x3e, 0x42, 0x98, 0x5e, 0x06, 0xee, 0x34, 0x06, 0x3b, 0xe2, 0x65, 0xa0, 0xa4,
-	0x10, 0x22, 0x4b, 0xf7, 0x30, 0x8d, 0xde, 0x76, 0x5b, 0xdc, 0x95, 0xd1, 0x67, 0x9c, 0xb2, 0xe7,
-	0xe9, 0xbe, 0x06, 0xb3, 0xe9, 0x02, 0xa6, 0xf3, 0xf8, 0x15, 0xd3, 0x8a, 0x77, 0xbf, 0x84, 0xfa,
-	0xf3, 0x0e, 0xce, 0xb0, 0xd5, 0xe6, 0x4e, 0x83, 0x0e, 0x27, 0x2b, 0xa0, 0xf6, 0xfa, 0x23, 0x01,
-	0x29, 0xac, 0x0c, 0x45, 0xde, 0xee, 0xb6, 0xa9, 0x40, 0xba, 0xfb, 0x7d, 0x72, 0x15, 0xa3, 0x83,
-	0x96, 0x18, 0x21, 0x87, 0x9b, 0x44, 0x71, 0xcb, 0x30, 0x35, 0x85, 0x5d, 0x02, 0x96, 0x42, 0x75,
-	0xbd, 0x89, 0xe1, 0x68, 0x19, 0x2a, 0x85, 0x22, 0xfc, 0x13, 0xdf, 0x0e, 0x2d, 0x2d, 0xcb, 0x5e,
-	0x83, 0x2b, 0x31, 0xae, 0xeb, 0x1d, 0xed, 0xfa, 0xb6, 0xe7, 0xdb, 0xe1, 0x89, 0x20, 0xe7, 0xb6,
-	0x7e, 0xf6, 0xe7, 0x3f, 0xdc, 0x50, 0xfe, 0xea, 0x87, 0x1b, 0xca, 0x3f, 0xfd, 0x70, 0x63, 0xed,
-	0x97, 0xff, 0x7c, 0x43, 0xf9, 0xef, 0xc9, 0xbf, 0x09, 0x32, 0x33, 0x42, 0xdf, 0x3e, 0x16, 0xd1,
-	0x30, 0x02, 0x5c, 0xeb, 0xfd, 0xf9, 0xe1, 0xfe, 0xfb, 0xf3, 0xf1, 0xfb, 0xb8, 0xa2, 0xe3, 0x02,
-	0xfd, 0x69, 0x90, 0xfb, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x74, 0x48, 0x8b, 0x3f, 0x5d, 0x44,
-	0x00, 0x00,
+	// 7723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbc, 0xdd, 0x8f, 0x1b, 0xc7,
+	0xb2, 0x18, 0xae, 0xe1, 0x37, 0x8b, 0xe4, 0x6a, 0xd4, 0xfa, 0xa2, 0x64, 0x59, 0x5e, 0x8d, 0x65,
+	0x5b, 0x96, 0x6d, 0xd9, 0x5e, 0x7f, 0xfb, 0x1e, 0xff, 0x8e, 0xb9, 0x24, 0x25, 0xf1, 0x98, 0x22,
+	0xf7, 0x34, 0xb9, 0x92, 0x7d, 0x2f, 0x7e, 0x20, 0x86, 0x9c, 0xe1, 0x6a, 0xac, 0xe1, 0x0c, 0x3d,
+	0x33, 0xd4, 0xee, 0x1e, 0x20, 0x80, 0x83, 0x00, 0x01, 0x02, 0xe4, 0x2d, 0x40, 0xde, 0x92, 0xdc,
+	0x04, 0x79, 0xb8, 0x49, 0x1e, 0x2e, 0x02, 0x04, 0x48, 0xf2, 0x74, 0x81, 0x00, 0x01, 0x12, 0x20,
+	0x01, 0x12, 0x04, 0x09, 0x2e, 0x90, 0x97, 0x8b, 0x93, 0xbf, 0x20, 0xc8, 0x6b, 0x10, 0x04, 0x55,
+	0xdd, 0x33, 0xd3, 0x43, 0x72, 0x2d, 0xd9, 0x38, 0xc8, 0xcb, 0x6e, 0xd7, 0x47, 0x57, 0x7f, 0x4c,
+	0x75, 0x55, 0x75, 0x75, 0x37, 0x01, 0x96, 0xae, 0xe9, 0xdd, 0x5b, 0x06, 0x7e, 0xe4, 0xb3, 0x02,
+	0x96, 0xaf, 0xbf, 0x77, 0xe4, 0x44, 0x4f, 0x57, 0xd3, 0x7b, 0x33, 0x7f, 0xf1, 0xfe, 0x91, 0x7f,
+	0xe4, 0xbf, 0x4f, 0xc4, 0xe9, 0x6a, 0x4e, 0x10, 0x01, 0x54, 0x12, 0x95, 0xae, 0x9f, 0x8f, 0x9c,
+	0x85, 0x1d, 0x46, 0xe6, 0x62, 0x29, 0x10, 0xc6, 0x3f, 0xd3, 0xa0, 0x30, 0x3e, 0x5d, 0xda, 0x6c,
+	0x07, 0x72, 0x8e, 0xd5, 0xd4, 0x76, 0xb5, 0x3b, 0x45, 0x9e, 0x73, 0x2c, 0xb6, 0x0b, 0x35, 0xcf,
+	0x8f, 0x06, 0x2b, 0xd7, 0x35, 0xa7, 0xae, 0xdd, 0xcc, 0xed, 0x6a, 0x77, 0x2a, 0x5c, 0x45, 0xb1,
+	0x57, 0xa0, 0x6a, 0xae, 0x22, 0x7f, 0xe2, 0x78, 0xb3, 0xa0, 0x99, 0x27, 0x7a, 0x05, 0x11, 0x3d,
+	0x6f, 0x16, 0xb0, 0x4b, 0x50, 0x3c, 0x76, 0xac, 0xe8, 0x69, 0xb3, 0x40, 0x12, 0x05, 0xc0, 0x18,
+	0x14, 0x42, 0xe7, 0x77, 0x76, 0xb3, 0x48, 0x48, 0x2a, 0x23, 0x67, 0x38, 0x33, 0x5d, 0xbb, 0x59,
+	0x12, 0x9c, 0x04, 0x20, 0x36, 0xa2, 0x86, 0xcb, 0xbb, 0xda, 0x9d, 0x2a, 0x17, 0x80, 0xf1, 0x9f,
+	0x8b, 0x50, 0x6c, 0xfb, 0x5e, 0x18, 0xb1, 0x2b, 0x50, 0x72, 0x42, 0x6f, 0xe5, 0xba, 0xd4, 0xe5,
+	0x0a, 0x97, 0x10, 0xbb, 0x02, 0x45, 0xe7, 0xf3, 0xe7, 0xa6, 0x4b, 0x1d, 0x2e, 0x3e, 0x3c, 0xc7,
+	0x05, 0xc8, 0x9a, 0x50, 0x72, 0x3e, 0xfc, 0x14, 0x09, 0x79, 0x49, 0x90, 0x30, 0x51, 0x3e, 0xda,
+	0x43, 0x4a, 0x21, 0xa1, 0x7c, 0xb4, 0x17, 0x53, 0x3e, 0xfd, 0x18, 0x29, 0xd8, 0xdf, 0x3c, 0x51,
+	0x08, 0xc6, 0x56, 0x56, 0xd4, 0x0a, 0xf6, 0xb9, 0x81, 0xad, 0xac, 0xe2, 0x56, 0x56, 0xa2, 0x95,
+	0xb2, 0x24, 0x48, 0x98, 0x28, 0xa2, 0x95, 0x4a, 0x42, 0x49, 0x5a, 0x59, 0x89, 0x56, 0xaa, 0xbb,
+	0xda, 0x9d, 0x02, 0x51, 0x44, 0x2b, 0x97, 0xa0, 0x60, 0x21, 0x1e, 0x76, 0xb5, 0x3b, 0xda, 0xc3,
+	0x73, 0xbc, 0x60, 0x49, 0x6c, 0x88, 0xd8, 0x1a, 0x4e, 0x0c, 0x62, 0x43, 0x89, 0x9d, 0x22, 0xb6,
+	0x8e, 0xb3, 0x81, 0xd8, 0xa9, 0xc4, 0xce, 0x11, 0xdb, 0xd8, 0xd5, 0xee, 0xe4, 0x10, 0x8b, 0x10,
+	0xbb, 0x0e, 0x65, 0xcb, 0x8c, 0x6c, 0x24, 0xec, 0xc8, 0x21, 0xc7, 0x08, 0xa4, 0xa1, 0x8a, 0x20,
+	0xed, 0xbc, 0x1c, 0x74, 0x8c, 0x60, 0x06, 0xd4, 0x90, 0x2d, 0xa6, 0xeb, 0x92, 0xae, 0x22, 0xd9,
+	0x27, 0x50, 0xb7, 0xec, 0x99, 0xb3, 0x30, 0x5d, 0x31, 0xa6, 0x0b, 0xbb, 0xda, 0x9d, 0xda, 0xde,
+	0xf9, 0x7b, 0xa4, 0xb8, 0x09, 0xe5, 0xe1, 0x39, 0x9e, 0x61, 0x63, 0x9f, 0x43, 0x43, 0xc2, 0x1f,
+	0xee, 0xd1, 0xc4, 0x32, 0xaa, 0xa7, 0x67, 0xea, 0x7d, 0xb8, 0xf7, 0xf9, 0xc3, 0x73, 0x3c, 0xcb,
+	0xc8, 0x6e, 0x43, 0x3d, 0xd1, 0x69, 0xac, 0x78, 0x51, 0xf6, 0x2a, 0x83, 0xc5, 0x61, 0x7d, 0x1f,
+	0xfa, 0x1e, 0x32, 0x5c, 0x92, 0xf3, 0x16, 0x23, 0xd8, 0x2e, 0x80, 0x65, 0xcf, 0xcd, 0x95, 0x1b,
+	0x21, 0xf9, 0xb2, 0x9c, 0x40, 0x05, 0xc7, 0x6e, 0x42, 0x75, 0xb5, 0xc4, 0x51, 0x3e, 0x36, 0xdd,
+	0xe6, 0x15, 0xc9, 0x90, 0xa2, 0x50, 0x59, 0x9d, 0x70, 0xdf, 0xf1, 0x9a, 0x57, 0x91, 0xc6, 0x05,
+	0xc0, 0x6e, 0x40, 0x3e, 0x0c, 0x66, 0xcd, 0x26, 0x8d, 0x04, 0xc4, 0x48, 0xba, 0x27, 0xcb, 0x80,
+	0x23, 0x7a, 0xbf, 0x0c, 0xc5, 0xe7, 0xa6, 0xbb, 0xb2, 0x8d, 0x1b, 0x50, 0x39, 0x30, 0x03, 0x73,
+	0xc1, 0xed, 0x39, 0xd3, 0x21, 0xbf, 0xf4, 0x43, 0xb9, 0x0a, 0xb1, 0x68, 0xf4, 0xa1, 0xf4, 0xd8,
+	0x0c, 0x90, 0xc6, 0xa0, 0xe0, 0x99, 0x0b, 0x9b, 0x88, 0x55, 0x4e, 0x65, 0x5c, 0x05, 0xe1, 0x69,
+	0x18, 0xd9, 0x0b, 0xb9, 0x3e, 0x25, 0x84, 0xf8, 0x23, 0xd7, 0x9f, 0x4a, 0x6d, 0xaf, 0x70, 0x09,
+	0x19, 0x03, 0x28, 0xb5, 0x7d, 0x17, 0xa5, 0x5d, 0x85, 0x72, 0x60, 0xbb, 0x93, 0xb4, 0xb5, 0x52,
+	0x60, 0xbb, 0x07, 0x7e, 0x88, 0x84, 0x99, 0x2f, 0x08, 0x39, 0x41, 0x98, 0xf9, 0x44, 0x88, 0xdb,
+	0xcf, 0xa7, 0xed, 0x1b, 0x5f, 0x40, 0x95, 0x9b, 0xc7, 0x52, 0xe4, 0x65, 0x28, 0x45, 0x53, 0x77,
+	0x22, 0xad, 0x48, 0x81, 0x17, 0xa3, 0xa9, 0xdb, 0xb3, 0x10, 0x8d, 0x02, 0x1d, 0x8b, 0xe4, 0x15,
+	0x78, 0x71, 0xe6, 0xbb, 0x3d, 0xcb, 0x18, 0x03, 0xb4, 0xfd, 0x20, 0xf8, 0xc5, 0xdd, 0xb9, 0x04,
+	0x45, 0xcb, 0x5e, 0x46, 0x4f, 0xc5, 0x7a, 0xe6, 0x02, 0x30, 0xee, 0x42, 0x05, 0xa7, 0xb8, 0xef,
+	0x84, 0x11, 0xbb, 0x09, 0x05, 0xd7, 0x09, 0xa3, 0xa6, 0xb6, 0x9b, 0x5f, 0xfb, 0x00, 0x84, 0x37,
+	0x76, 0xa1, 0xf2, 0xc8, 0x3c, 0x79, 0x8c, 0x1f, 0x81, 0x5d, 0x92, 0x5f, 0x43, 0xce, 0xae, 0xfc,
+	0x34, 0x77, 0x01, 0xc6, 0x66, 0x70, 0x64, 0x47, 0x64, 0x21, 0x6f, 0x40, 0x3e, 0x3a, 0x5d, 0x12,
+	0x47, 0x22, 0x0e, 0x09, 0x1c, 0xd1, 0xc6, 0xff, 0xd2, 0xa0, 0x36, 0x5a, 0x4d, 0x7f, 0x58, 0xd9,
+	0xc1, 0x29, 0x8e, 0xe8, 0x4e, 0xca, 0xbd, 0xb3, 0x77, 0x45, 0x70, 0x2b, 0xf4, 0xb4, 0x26, 0x0e,
+	0xd1, 0xf3, 0x2d, 0x3b, 0x9e, 0xa1, 0x22, 0x2f, 0x21, 0xd8, 0xb3, 0xd0, 0x24, 0xfb, 0x4b, 0x39,
+	0xdf, 0x39, 0x7f, 0xc9, 0x76, 0xa1, 0x38, 0x7b, 0xea, 0xb8, 0x56, 0xb3, 0xa0, 0x76, 0x81, 0x46,
+	0x24, 0x08, 0xec, 0x1a, 0x54, 0x02, 0xff, 0x78, 0xa2, 0xd8, 0xd8, 0x72, 0xe0, 0x1f, 0x8f, 0x9c,
+	0xdf, 0xd9, 0xc6, 0x58, 0xda, 0x79, 0x80, 0xd2, 0xa8, 0xdd, 0xea, 0xb7, 0xb8, 0x7e, 0x0e, 0xcb,
+	0xdd, 0x6f, 0x7b, 0xa3, 0xf1, 0x48, 0xd7, 0xd8, 0x0e, 0xc0, 0x60, 0x38, 0x9e, 0x48, 0x38, 0xc7,
+	0x4a, 0x90, 0xeb, 0x0d, 0xf4, 0x3c, 0xf2, 0x20, 0xbe, 0x37, 0xd0, 0x0b, 0xac, 0x0c, 0xf9, 0xd6,
+	0xe0, 0x3b, 0xbd, 0x48, 0x85, 0x7e, 0x5f, 0x2f, 0x19, 0xff, 0x45, 0x83, 0xea, 0x70, 0xfa, 0xbd,
+	0x3d, 0x8b, 0x70, 0xcc, 0xa8, 0x8e, 0x76, 0xf0, 0xdc, 0x0e, 0x68, 0xd8, 0x79, 0x2e, 0x21, 0x1c,
+	0x88, 0x35, 0xa5, 0xc1, 0xe5, 0x79, 0xce, 0x9a, 0x12, 0xdf, 0xec, 0xa9, 0xbd, 0x30, 0x9b, 0x79,
+	0xc9, 0x47, 0x10, 0xaa, 0xbf, 0x3f, 0xfd, 0x9e, 0x86, 0x97, 0xe7, 0x58, 0x64, 0xaf, 0x41, 0x4d,
+	0xc8, 0x98, 0x90, 0xee, 0x15, 0x69, 0x2e, 0x40, 0xa0, 0x06, 0xb8, 0x02, 0xae, 0x42, 0xd9, 0x9a,
+	0x0a, 0x62, 0x89, 0x88, 0x25, 0x6b, 0x4a, 0x04, 0xac, 0x49, 0x52, 0x05, 0xb1, 0x2c, 0x6b, 0x12,
+	0x8a, 0x18, 0xae, 0x41, 0xc5, 0x9f, 0x7e, 0x2f, 0xa8, 0x15, 0xa2, 0x96, 0xfd, 0xe9, 0xf7, 0x48,
+	0x32, 0xfe, 0xa7, 0x06, 0x95, 0xfb, 0x2b, 0x6f, 0x16, 0x39, 0xbe, 0xc7, 0x5e, 0x87, 0xc2, 0x7c,
+	0xe5, 0xcd, 0x9a, 0x9a, 0x6a, 0xc9, 0x92, 0x31, 0x73, 0x22, 0xa2, 0xae, 0x99, 0xc1, 0x11, 0xea,
+	0xe8, 0x86, 0xae, 0x21, 0xde, 0xf8, 0x07, 0x52, 0xe2, 0x7d, 0xd7, 0x3c, 0x62, 0x15, 0x28, 0x0c,
+	0x86, 0x83, 0xae, 0x7e, 0x8e, 0xd5, 0xa1, 0xd2, 0x1b, 0x8c, 0xbb, 0x7c, 0xd0, 0xea, 0xeb, 0x1a,
+	0x7d, 0x9a, 0x71, 0x6b, 0xbf, 0xdf, 0xd5, 0x73, 0x48, 0x79, 0x3c, 0xec, 0xb7, 0xc6, 0xbd, 0x7e,
+	0x57, 0x2f, 0x08, 0x0a, 0xef, 0xb5, 0xc7, 0x7a, 0x85, 0xe9, 0x50, 0x3f, 0xe0, 0xc3, 0xce, 0x61,
+	0xbb, 0x3b, 0x19, 0x1c, 0xf6, 0xfb, 0xba, 0xce, 0x2e, 0xc2, 0xf9, 0x04, 0x33, 0x14, 0xc8, 0x5d,
+	0xac, 0xf2, 0xb8, 0xc5, 0x5b, 0xfc, 0x81, 0xfe, 0x35, 0xab, 0x40, 0xbe, 0xf5, 0xe0, 0x81, 0xfe,
+	0xa3, 0x86, 0xa5, 0x27, 0xbd, 0x81, 0xfe, 0x63, 0x8e, 0xed, 0x40, 0xf5, 0xd1, 0x70, 0x30, 0x1c,
+	0x0f, 0x07, 0xbd, 0xb6, 0xfe, 0x63, 0xc1, 0xf8, 0x27, 0x79, 0x28, 0x60, 0x87, 0x7f, 0x5a, 0xcd,
+	0xd9, 0x2b, 0xa0, 0xcd, 0xe8, 0x4b, 0xd6, 0xf6, 0x6a, 0x82, 0x46, 0xfe, 0xf8, 0xe1, 0x39, 0xae,
+	0xe1, 0x2c, 0x68, 0x42, 0x5f, 0x6b, 0x7b, 0x3b, 0x82, 0x18, 0x5b, 0x36, 0xa4, 0x2f, 0xd9, 0x0d,
+	0xd0, 0x9e, 0x4b, 0xe5, 0xad, 0x0b, 0xba, 0xb0, 0x6d, 0x48, 0x7d, 0xce, 0x76, 0x21, 0x3f, 0xf3,
+	0x85, 0xaf, 0x4d, 0xe8, 0xc2, 0x3c, 0x3c, 0x3c, 0xc7, 0x91, 0xc4, 0x5e, 0x87, 0x7c, 0x60, 0x1e,
+	0x37, 0x4b, 0xea, 0x97, 0x48, 0xec, 0x0f, 0x32, 0x05, 0xe6, 0x31, 0x76, 0x62, 0xde, 0x2c, 0xab,
+	0x9d, 0x88, 0x3f, 0x25, 0x36, 0x33, 0x67, 0x6f, 0x40, 0x3e, 0x5c, 0x4d, 0xe9, 0x93, 0xd7, 0xf6,
+	0x2e, 0x6c, 0x2c, 0x4c, 0x14, 0x13, 0xae, 0xa6, 0xec, 0x4d, 0x28, 0xcc, 0xfc, 0x20, 0x68, 0x56,
+	0x55, 0x47, 0x94, 0x5a, 0x2c, 0x74, 0xa6, 0x48, 0x67, 0xbb, 0xa0, 0x45, 0x4d, 0x50, 0x99, 0x52,
+	0x93, 0x81, 0x0d, 0x46, 0xec, 0xb6, 0xb4, 0x43, 0x35, 0xb5, 0x4f, 0xb1, 0x95, 0x42, 0x39, 0x48,
+	0x65, 0x06, 0xe4, 0x17, 0xe6, 0x49, 0xb3, 0xae, 0x32, 0xc5, 0xe6, 0x09, 0xfb, 0xb4, 0x30, 0x4f,
+	0xf6, 0x4b, 0x50, 0xb0, 0x4f, 0x96, 0x81, 0x71, 0x0d, 0xaa, 0x89, 0xf7, 0x64, 0x75, 0xd0, 0x4c,
+	0xb9, 0xde, 0x34, 0xd3, 0xb8, 0x03, 0x20, 0x49, 0x1f, 0xee, 0x7d, 0x9e, 0xa5, 0x21, 0x14, 0xaf,
+	0x42, 0x6d, 0x6a, 0xfc, 0x0a, 0xea, 0xdc, 0x0e, 0x57, 0x6e, 0xd4, 0xf6, 0xdd, 0x8e, 0x3d, 0x67,
+	0xef, 0x02, 0x24, 0x70, 0x28, 0x8d, 0x66, 0xfa, 0x15, 0x3a, 0xf6, 0x9c, 0x2b, 0x74, 0xe3, 0x6f,
+	0xe4, 0xa1, 0x24, 0x2b, 0xa6, 0x06, 0x5e, 0x53, 0x0c, 0x7c, 0xe2, 0x2f, 0x72, 0x59, 0x7f, 0xf5,
+	0xd4, 0xb1, 0x2c, 0xdb, 0x8b, 0xfd, 0x92, 0x80, 0xd8, 0x6d, 0xc8, 0x9b, 0xee, 0x11, 0xa9, 0xc6,
+	0xce, 0x1e, 0x8b, 0x1b, 0x5d, 0x2c, 0x03, 0x3b, 0x0c, 0x85, 0xee, 0x99, 0xee, 0x51, 0xac, 0x99,
+	0xc5, 0xed, 0x9a, 0x79, 0x0d, 0x2a, 0x9e, 0x1f, 0x4d, 0x28, 0x26, 0x2c, 0x91, 0xf4, 0xb2, 0x8c,
+	0x56, 0xd9, 0x5b, 0x50, 0x96, 0xde, 0x5c, 0x2a, 0x46, 0x43, 0x54, 0xee, 0x08, 0x24, 0x8f, 0xa9,
+	0xac, 0x89, 0xde, 0x66, 0xb1, 0xb0, 0xbd, 0x28, 0x36, 0x09, 0x12, 0x64, 0xef, 0x40, 0xd5, 0xf7,
+	0x26, 0xc2, 0xe5, 0x37, 0xab, 0xea, 0x47, 0x1a, 0x7a, 0x87, 0x84, 0xe5, 0x15, 0x5f, 0x96, 0xb0,
+	0x2b, 0xae, 0x7f, 0x3c, 0x99, 0x99, 0x81, 0x45, 0xaa, 0x51, 0xe1, 0x65, 0xd7, 0x3f, 0x6e, 0x9b,
+	0x81, 0xc5, 0x6e, 0x40, 0x75, 0xe6, 0xae, 0xc2, 0xc8, 0x0e, 0xf6, 0x4f, 0x49, 0x23, 0x2a, 0x3c,
+	0x45, 0x60, 0xfb, 0xcb, 0xc0, 0x59, 0x98, 0xc1, 0xa9, 0x08, 0xe4, 0x78, 0x0c, 0xa2, 0x83, 0x5a,
+	0x3e, 0x73, 0xac, 0x13, 0x0a, 0xe5, 0x8a, 0x5c, 0x00, 0xc6, 0x0f, 0x50, 0x96, 0x63, 0x60, 0x37,
+	0x85, 0x6e, 0x64, 0xd7, 0xad, 0xb0, 0x40, 0x88, 0x67, 0xaf, 0x43, 0xc3, 0x0f, 0x9c, 0x23, 0xc7,
+	0x9b, 0x84, 0x51, 0xe0, 0x78, 0x47, 0xf2, 0xbb, 0xd4, 0x05, 0x72, 0x44, 0x38, 0x76, 0x0b, 0xea,
+	0x38, 0x7f, 0x13, 0x73, 0xea, 0xb8, 0x4e, 0x74, 0x2a, 0xbf, 0x52, 0x0d, 0x71, 0x2d, 0x81, 0x32,
+	0x86, 0x50, 0x89, 0x47, 0xfc, 0x07, 0x69, 0xd3, 0xf8, 0x23, 0xa8, 0xf5, 0x3c, 0xcb, 0x3e, 0x19,
+	0x2e, 0xc9, 0xdc, 0xbe, 0x0b, 0x6c, 0x16, 0xd8, 0x66, 0x64, 0x4f, 0xec, 0x93, 0x28, 0x30, 0x27,
+	0x62, 0x17, 0x20, 0x82, 0x7c, 0x5d, 0x50, 0xba, 0x48, 0x18, 0x23, 0xde, 0xf8, 0x33, 0x0d, 0x1a,
+	0x07, 0x62, 0x8a, 0xbe, 0xb1, 0x4f, 0x3b, 0x22, 0x4c, 0x9a, 0xc5, 0x0a, 0x5c, 0xe0, 0x54, 0x66,
+	0x37, 0xa1, 0xb6, 0x7c, 0x66, 0x9f, 0x4e, 0x32, 0x71, 0x48, 0x15, 0x51, 0x6d, 0x52, 0xd5, 0xb7,
+	0xa1, 0xe4, 0x53, 0xeb, 0xcd, 0xbc, 0x6a, 0x15, 0x94, 0x6e, 0x71, 0xc9, 0xc0, 0x0c, 0x68, 0x24,
+	0xa2, 0x48, 0xbd, 0x0b, 0x34, 0xa4, 0x9a, 0x14, 0x46, 0x9e, 0xe5, 0x12, 0x14, 0x91, 0x14, 0x36,
+	0x8b, 0xbb, 0x79, 0x0c, 0x26, 0x08, 0x30, 0xfe, 0x8f, 0x06, 0x15, 0x92, 0x28, 0xd7, 0x8c, 0x63,
+	0x9d, 0xc4, 0x6b, 0xa6, 0xca, 0x8b, 0x8e, 0x75, 0xd2, 0xb3, 0xd8, 0xab, 0x00, 0x0e, 0xb2, 0x4c,
+	0x94, 0x95, 0x53, 0x25, 0x4c, 0x2c, 0x78, 0x69, 0x06, 0x51, 0xd8, 0xcc, 0x0b, 0xc1, 0x04, 0xe0,
+	0xa2, 0x5a, 0x79, 0xce, 0x0f, 0x2b, 0xd1, 0x97, 0x0a, 0x97, 0x10, 0xbb, 0x03, 0xba, 0x10, 0x46,
+	0x53, 0xa8, 0x3a, 0xd0, 0x1d, 0xc2, 0xd3, 0x0c, 0xc6, 0xbe, 0x52, 0xf0, 0xd8, 0x27, 0x68, 0xa8,
+	0xc4, 0xea, 0x01, 0x42, 0x75, 0x11, 0xa3, 0xae, 0x8b, 0x72, 0x76, 0x5d, 0xa4, 0x53, 0x57, 0x79,
+	0xc1, 0xd4, 0x19, 0xff, 0x3e, 0x07, 0x8d, 0xfb, 0x7e, 0x60, 0x3b, 0x47, 0x5e, 0xfa, 0xad, 0x36,
+	0x42, 0xda, 0xf8, 0xfb, 0xe5, 0x94, 0xef, 0xf7, 0x1a, 0xd4, 0xe6, 0xa2, 0xe2, 0x24, 0x9a, 0x8a,
+	0x98, 0xb6, 0xc0, 0x41, 0xa2, 0xc6, 0x53, 0x17, 0xf5, 0x36, 0x66, 0xa0, 0xca, 0x05, 0xaa, 0x1c,
+	0x57, 0x42, 0x83, 0xc5, 0xbe, 0xa4, 0x05, 0x6c, 0xd9, 0xae, 0x1d, 0x89, 0x69, 0xd8, 0xd9, 0x7b,
+	0x55, 0xba, 0x07, 0xb5, 0x4f, 0xf7, 0xb8, 0x3d, 0x6f, 0x91, 0xb7, 0xc0, 0xf5, 0xdc, 0x21, 0x76,
+	0xf6, 0xa5, 0xba, 0xf8, 0x4b, 0x2f, 0x59, 0x57, 0xac, 0x11, 0x63, 0x0c, 0xd5, 0x04, 0x8d, 0x5e,
+	0x9d, 0x77, 0xa5, 0x27, 0x3f, 0xc7, 0x6a, 0x50, 0x6e, 0xb7, 0x46, 0xed, 0x56, 0xa7, 0xab, 0x6b,
+	0x48, 0x1a, 0x75, 0xc7, 0xc2, 0x7b, 0xe7, 0xd8, 0x79, 0xa8, 0x21, 0xd4, 0xe9, 0xde, 0x6f, 0x1d,
+	0xf6, 0xc7, 0x7a, 0x9e, 0x35, 0xa0, 0x3a, 0x18, 0x4e, 0x5a, 0xed, 0x71, 0x6f, 0x38, 0xd0, 0x0b,
+	0xc6, 0xd7, 0x50, 0x69, 0x3f, 0xb5, 0x67, 0xcf, 0xce, 0x9a, 0x45, 0x0a, 0x15, 0xed, 0xd9, 0xb3,
+	0x66, 0x6e, 0x63, 0x69, 0x0a, 0x82, 0xd1, 0x81, 0x7a, 0x3b, 0xb6, 0x3b, 0x28, 0x65, 0x37, 0xd6,
+	0xad, 0xcd, 0x70, 0x59, 0x10, 0xb6, 0x19, 0x74, 0xe3, 0x13, 0xa8, 0x1d, 0x04, 0xfe, 0xd2, 0x0e,
+	0x22, 0x12, 0xa2, 0x43, 0xfe, 0x99, 0x7d, 0x2a, 0x7b, 0x82, 0xc5, 0x34, 0xb0, 0xce, 0xa9, 0x81,
+	0xf5, 0x1e, 0x54, 0xe2, 0x6a, 0x2f, 0x5d, 0xe7, 0xd7, 0xd0, 0x90, 0x75, 0x1c, 0x3b, 0xc4, 0xc6,
+	0xee, 0x01, 0x2c, 0x13, 0x84, 0xec, 0x76, 0x1c, 0x76, 0x48, 0xe1, 0x5c, 0xe1, 0x30, 0xfe, 0x22,
+	0x0f, 0x3b, 0x07, 0x66, 0x10, 0x39, 0xf8, 0x29, 0xc4, 0xa0, 0xdf, 0x82, 0x42, 0x74, 0xba, 0xb4,
+	0x65, 0x94, 0x7e, 0x31, 0x89, 0x59, 0x04, 0x0f, 0xf9, 0x16, 0x62, 0x60, 0x5f, 0xc2, 0xce, 0x32,
+	0x46, 0x4f, 0xc8, 0xe6, 0x89, 0x89, 0x5d, 0xaf, 0x42, 0xf3, 0xd5, 0x58, 0xaa, 0x20, 0xfb, 0x0a,
+	0x2e, 0x65, 0xeb, 0xda, 0x61, 0x98, 0xda, 0x1a, 0x75, 0xa2, 0x2f, 0x66, 0x2a, 0x0a, 0x36, 0xd6,
+	0x86, 0x0b, 0x69, 0xf5, 0x99, 0xef, 0xae, 0x16, 0x5e, 0x28, 0x83, 0xa8, 0x2b, 0x6b, 0xad, 0xb7,
+	0x05, 0x95, 0xeb, 0xcb, 0x35, 0x0c, 0x33, 0xa0, 0x9e, 0xe0, 0x06, 0xab, 0x05, 0x2d, 0x80, 0x02,
+	0xcf, 0xe0, 0xd8, 0x47, 0x00, 0x09, 0x1c, 0x36, 0x4b, 0xbb, 0xf9, 0x2d, 0xe3, 0xeb, 0x45, 0xf6,
+	0x82, 0x2b, 0x6c, 0xe8, 0xcf, 0x4c, 0xf7, 0xc8, 0x0f, 0x9c, 0xe8, 0xe9, 0x82, 0x6c, 0x43, 0x9e,
+	0xa7, 0x08, 0x32, 0x41, 0xe1, 0x24, 0x5c, 0x4d, 0x27, 0x49, 0x15, 0xb2, 0x13, 0x15, 0xbe, 0xe3,
+	0x84, 0xa3, 0xd5, 0x34, 0x91, 0x8b, 0xae, 0x22, 0x1d, 0xe5, 0x22, 0x3c, 0x22, 0x1f, 0x5b, 0x55,
+	0x7a, 0xf8, 0x28, 0x3c, 0x32, 0x7e, 0x03, 0x8d, 0xcc, 0x4c, 0xbf, 0xd0, 0x01, 0x5d, 0x83, 0x0a,
+	0xfe, 0x47, 0xf7, 0x23, 0x95, 0xa9, 0x8c, 0xf0, 0x28, 0x0a, 0x0c, 0x1b, 0xf4, 0xf5, 0x79, 0x63,
+	0xb7, 0x69, 0xb3, 0x89, 0xc5, 0x2d, 0xab, 0x20, 0x26, 0xb1, 0x77, 0xb6, 0x7d, 0x90, 0x1c, 0x59,
+	0xe4, 0x8d, 0x89, 0x37, 0xfe, 0x61, 0x0e, 0x1a, 0x99, 0xd9, 0x63, 0x6f, 0xa8, 0xaa, 0xa4, 0x2c,
+	0xdc, 0x74, 0xfc, 0x64, 0x93, 0xdf, 0x06, 0xdd, 0x0f, 0x2c, 0xc7, 0x33, 0x69, 0xf3, 0x2b, 0xa6,
+	0x0e, 0x87, 0xd0, 0xe0, 0xe7, 0x25, 0xfe, 0x40, 0xa2, 0x31, 0x55, 0x67, 0xd9, 0xe1, 0x2c, 0x70,
+	0x52, 0x1f, 0x56, 0xe5, 0x2a, 0x4a, 0xb5, 0xdf, 0x85, 0xac, 0xfd, 0x7e, 0x0b, 0xaa, 0xae, 0x1d,
+	0x86, 0x93, 0xe8, 0xa9, 0xe9, 0x35, 0x8b, 0x1b, 0x83, 0xae, 0x20, 0x71, 0xfc, 0xd4, 0xf4, 0x90,
+	0xd1, 0xf1, 0x26, 0xb4, 0x14, 0x63, 0xe5, 0xc8, 0x30, 0x3a, 0x1e, 0x85, 0xaa, 0x21, 0xfb, 0x40,
+	0x55, 0x77, 0xc5, 0xf5, 0x08, 0xc7, 0xc1, 0x12, 0x5a, 0xe2, 0x7e, 0x8c, 0x57, 0xa1, 0xfc, 0xd8,
+	0xb1, 0x8f, 0xa5, 0x2d, 0x7b, 0xee, 0xd8, 0xc7, 0xb1, 0x2d, 0xc3, 0xb2, 0xf1, 0xf7, 0x2b, 0x50,
+	0x21, 0xe6, 0xce, 0xd9, 0x49, 0x86, 0x9f, 0x13, 0x6c, 0xee, 0x42, 0x21, 0x71, 0x12, 0xeb, 0x21,
+	0x2e, 0x51, 0xd0, 0x0d, 0x8b, 0x8e, 0x93, 0x71, 0x10, 0x3e, 0xb3, 0x4a, 0x18, 0x99, 0x08, 0xa8,
+	0x8a, 0x40, 0x24, 0xfc, 0xc1, 0x95, 0xbb, 0xce, 0x14, 0xc1, 0xee, 0x41, 0x05, 0x7b, 0x48, 0x7b,
+	0xc6, 0xb2, 0x6a, 0x24, 0x68, 0x0c, 0xf1, 0x5e, 0x84, 0x97, 0xa3, 0xa9, 0x8b, 0x00, 0xda, 0x20,
+	0x0c, 0x1e, 0x9a, 0x35, 0x95, 0x37, 0x13, 0xd3, 0x70, 0x62, 0x60, 0x77, 0xa0, 0x4c, 0x7e, 0xdb,
+	0x0e, 0x9b, 0x75, 0xd5, 0xd8, 0xc5, 0x41, 0x05, 0x8f, 0xc9, 0xec, 0x6d, 0x28, 0xce, 0x9f, 0xd9,
+	0xa7, 0x61, 0xb3, 0xa1, 0x2e, 0xe2, 0x8c, 0xaf, 0xe2, 0x82, 0x83, 0xdd, 0x86, 0x9d, 0xc0, 0x9e,
+	0x4f, 0x28, 0x7d, 0x80, 0xce, 0x35, 0x6c, 0xee, 0x90, 0xef, 0xac, 0x07, 0xf6, 0xbc, 0x8d, 0xc8,
+	0xf1, 0xd4, 0x0d, 0xd9, 0x9b, 0x50, 0x22, 0xaf, 0x11, 0x36, 0xcf, 0xab, 0x2d, 0xc7, 0x2e, 0x88,
+	0x4b, 0x2a, 0xdb, 0x83, 0x6a, 0xba, 0xd0, 0x2f, 0xd3, 0x80, 0x2e, 0xad, 0x59, 0x10, 0x32, 0xbc,
+	0x3c, 0x65, 0x63, 0x1f, 0x02, 0xc8, 0x00, 0x78, 0x32, 0x3d, 0xa5, 0xec, 0x5a, 0x2d, 0xd9, 0x02,
+	0x28, 0x0e, 0x4a, 0x0d, 0x93, 0xdf, 0x82, 0x22, 0xda, 0xf5, 0xb0, 0x79, 0x75, 0x37, 0x9f, 0xc6,
+	0x1c, 0x8a, 0x23, 0xe2, 0x82, 0xce, 0xee, 0x40, 0x05, 0x55, 0x68, 0x82, 0x1f, 0xaa, 0xa9, 0x46,
+	0xfe, 0x52, 0xdf, 0x78, 0x19, 0xc9, 0xa3, 0x1f, 0x5c, 0xf6, 0x1e, 0xd4, 0x64, 0xa8, 0x4a, 0xba,
+	0x71, 0x6d, 0xdb, 0xf6, 0x47, 0x30, 0x50, 0x34, 0x71, 0x17, 0x0a, 0x96, 0x3d, 0x0f, 0x9b, 0xaf,
+	0xed, 0xe6, 0x53, 0x3b, 0x1c, 0x2b, 0x29, 0xee, 0x2b, 0x84, 0xef, 0x40, 0x1e, 0xf6, 0x10, 0x76,
+	0x50, 0x1f, 0xf7, 0x28, 0xfa, 0xc4, 0x2f, 0xd4, 0xdc, 0xa5, 0x5a, 0xb7, 0xd6, 0x6a, 0x0d, 0x24,
+	0x13, 0x7d, 0xcf, 0xae, 0x17, 0x05, 0xa7, 0xbc, 0xe1, 0xa9, 0x38, 0xf6, 0x11, 0xec, 0xcc, 0xfc,
+	0x05, 0x99, 0x03, 0x7b, 0x42, 0x4a, 0x73, 0x6b, 0x57, 0xdb, 0xe8, 0x67, 0x23, 0xe1, 0x39, 0x40,
+	0xb5, 0xb9, 0x0e, 0x15, 0x27, 0xec, 0xfb, 0xb3, 0x67, 0xb6, 0xd5, 0x34, 0x44, 0x96, 0x3e, 0x86,
+	0xd9, 0x17, 0xd0, 0x20, 0xb5, 0x46, 0x10, 0x7b, 0xdc, 0x7c, 0x5d, 0x75, 0x84, 0x63, 0x95, 0xc4,
+	0xb3, 0x9c, 0xd7, 0x1f, 0xd0, 0xd6, 0x03, 0x8b, 0xec, 0x93, 0x35, 0x47, 0x9c, 0xd1, 0x63, 0xc5,
+	0x63, 0x63, 0x56, 0x35, 0x65, 0xdc, 0x2f, 0x42, 0xde, 0xb2, 0xe7, 0xd7, 0xbf, 0x06, 0xb6, 0x39,
+	0xf2, 0x17, 0x45, 0x05, 0x45, 0x19, 0x15, 0x7c, 0x99, 0xfb, 0x5c, 0x33, 0xbe, 0x80, 0x46, 0x66,
+	0x6d, 0x6d, 0x8d, 0x88, 0x44, 0xec, 0x6c, 0x8a, 0x4c, 0x69, 0x9d, 0x0b, 0xc0, 0xf8, 0x0f, 0x1a,
+	0x14, 0x47, 0x91, 0x19, 0x85, 0x78, 0x9a, 0x31, 0x75, 0xfd, 0xd9, 0xb3, 0x89, 0xb7, 0x5a, 0xc8,
+	0x1c, 0x64, 0x85, 0x10, 0xe8, 0x1a, 0x29, 0x28, 0x0d, 0x23, 0xaa, 0xab, 0x71, 0x2a, 0xa3, 0x79,
+	0xf1, 0x57, 0xd1, 0xcc, 0x8b, 0xc8, 0xbc, 0x68, 0x5c, 0x42, 0x68, 0x6b, 0x03, 0xff, 0x98, 0x52,
+	0x70, 0x05, 0x22, 0xc4, 0x20, 0x46, 0xa9, 0x4f, 0xcd, 0xf0, 0xe9, 0xc2, 0x5c, 0xa6, 0x19, 0x3a,
+	0x8d, 0xd7, 0x24, 0x0e, 0xb3, 0x74, 0xd8, 0x0b, 0x61, 0x79, 0x50, 0x6e, 0x89, 0xe8, 0x15, 0x42,
+	0xb4, 0xbd, 0x08, 0xed, 0x7c, 0x68, 0xbb, 0xf6, 0x2c, 0x72, 0x9e, 0xe3, 0xe6, 0xac, 0x2c, 0xaa,
+	0x2b, 0x28, 0xe3, 0x6d, 0x28, 0xa3, 0x12, 0x98, 0x91, 0x89, 0xae, 0xd1, 0x32, 0x23, 0x73, 0x5b,
+	0xf6, 0x13, 0xf1, 0xc6, 0xfb, 0x00, 0xdc, 0x3f, 0x0e, 0xed, 0x88, 0xb8, 0x6f, 0x29, 0xbb, 0xa6,
+	0x64, 0x91, 0x48, 0x51, 0xc2, 0x28, 0x1a, 0xff, 0x5d, 0x83, 0xda, 0x30, 0xb0, 0x70, 0x01, 0x8e,
+	0x96, 0xf6, 0xec, 0x85, 0xbe, 0x17, 0xad, 0xa4, 0xef, 0xba, 0x66, 0xe2, 0xb9, 0xaa, 0x3c, 0x45,
+	0xb0, 0x0f, 0xa1, 0x30, 0x77, 0xcd, 0xa3, 0x66, 0x5e, 0x8d, 0xa6, 0x15, 0xf1, 0x71, 0x19, 0x13,
+	0x66, 0x9c, 0x58, 0x8d, 0x3f, 0x81, 0x9a, 0x82, 0xcc, 0xe4, 0xce, 0xce, 0x51, 0x46, 0x72, 0xd4,
+	0xd6, 0x31, 0xc3, 0x55, 0xe8, 0x74, 0x47, 0x6d, 0x11, 0x43, 0x63, 0x34, 0x3d, 0x9a, 0xdc, 0xef,
+	0xf1, 0xd1, 0x58, 0x2f, 0x50, 0x8a, 0x93, 0x10, 0xfd, 0xd6, 0x08, 0x33, 0x69, 0x00, 0xa5, 0xc3,
+	0x41, 0xef, 0xb7, 0x87, 0x5d, 0x5d, 0x37, 0xfe, 0x85, 0x06, 0x70, 0x3f, 0x30, 0x17, 0xf6, 0xbe,
+	0xbf, 0xf2, 0x2c, 0x76, 0x2f, 0x13, 0x18, 0x5e, 0x97, 0x06, 0x34, 0xa1, 0xdf, 0xa3, 0xbf, 0x4a,
+	0x7c, 0x78, 0x03, 0xaa, 0x2b, 0x6f, 0x8a, 0x48, 0xdb, 0x92, 0xb9, 0xf8, 0x14, 0x81, 0x89, 0x8b,
+	0xf8, 0xe4, 0x69, 0xed, 0x24, 0xe0, 0xb9, 0xe9, 0x1a, 0x5f, 0x42, 0x35, 0x11, 0x87, 0x71, 0xfe,
+	0x01, 0xef, 0xb6, 0xbb, 0x9d, 0xde, 0xe0, 0x81, 0x7e, 0x0e, 0xc7, 0xd0, 0x3e, 0xe4, 0xbc, 0x3b,
+	0x18, 0x4f, 0xf8, 0xf0, 0x89, 0xae, 0x21, 0xfd, 0xfe, 0xb0, 0xdf, 0x1f, 0x3e, 0x41, 0x7a, 0xce,
+	0xf8, 0x57, 0x1a, 0xd4, 0xa8, 0x5b, 0x6d, 0xd7, 0x5c, 0x85, 0x36, 0x7b, 0x3f, 0xd3, 0xef, 0x57,
+	0x94, 0x7e, 0x0b, 0x06, 0x51, 0x56, 0x3a, 0xfe, 0x26, 0x14, 0xc3, 0xc8, 0x0c, 0xa2, 0x66, 0x4e,
+	0x4d, 0x61, 0xa5, 0x23, 0xe5, 0x82, 0x8c, 0xe9, 0x29, 0xdb, 0xb3, 0x9a, 0xf9, 0x33, 0xb8, 0x90,
+	0x68, 0xbc, 0x0b, 0xd5, 0x44, 0x3c, 0x7e, 0x07, 0x3e, 0x7c, 0x32, 0xd2, 0xcf, 0xb1, 0x2a, 0x14,
+	0x79, 0x6b, 0xf0, 0xa0, 0x2b, 0x32, 0x9c, 0x0f, 0xf8, 0xf0, 0xf0, 0x60, 0xa4, 0xe7, 0x8c, 0xbf,
+	0xd0, 0x00, 0x9e, 0x38, 0x9e, 0xe5, 0x1f, 0x93, 0x3a, 0xbd, 0x03, 0xb5, 0x63, 0x82, 0x26, 0x4a,
+	0xb6, 0x55, 0x9d, 0x2b, 0x10, 0x64, 0xf2, 0x99, 0xef, 0x29, 0xe1, 0x2c, 0x7a, 0x8d, 0xcd, 0xb4,
+	0x6b, 0x6d, 0x99, 0x3a, 0x1c, 0xf6, 0x2e, 0x54, 0x7c, 0xd4, 0x1c, 0x64, 0xcd, 0xab, 0x2e, 0x43,
+	0x51, 0x38, 0x5e, 0xf6, 0x03, 0x2b, 0xf6, 0x2e, 0xf3, 0x20, 0xde, 0xda, 0x27, 0xac, 0xca, 0x24,
+	0x72, 0x41, 0x37, 0xfe, 0xb2, 0x00, 0xd5, 0x9e, 0x17, 0xda, 0x41, 0xd4, 0x8e, 0x4e, 0xd8, 0x2d,
+	0xc8, 0x07, 0xf6, 0xfc, 0xac, 0x34, 0x31, 0xd2, 0x30, 0x89, 0x24, 0x56, 0xb7, 0x65, 0xcf, 0xe5,
+	0x84, 0xef, 0x64, 0x9d, 0x80, 0x5c, 0xed, 0x1d, 0x3a, 0x40, 0xd0, 0x71, 0xc3, 0xba, 0x5a, 0xba,
+	0xce, 0x0c, 0xd3, 0x21, 0x98, 0xfc, 0xc1, 0xce, 0x17, 0xf9, 0x8e, 0xef, 0x75, 0x62, 0x74, 0xcf,
+	0x3a, 0x61, 0x07, 0x70, 0x21, 0xc3, 0x49, 0xcb, 0x52, 0x44, 0x37, 0xb7, 0xe3, 0x10, 0x41, 0xf6,
+	0xf2, 0xde, 0x30, 0xad, 0x8a, 0xf3, 0x24, 0xdc, 0xcc, 0x79, 0x3f, 0x8b, 0xa5, 0x50, 0xc3, 0x3a,
+	0x99, 0xe0, 0x78, 0x44, 0x4c, 0xb8, 0x31, 0x1e, 0x4c, 0x5f, 0xc8, 0x83, 0x1b, 0x91, 0xc8, 0x38,
+	0xa1, 0xa0, 0xb0, 0x48, 0x04, 0xec, 0xd4, 0x57, 0xb4, 0x9b, 0xb0, 0xbd, 0x88, 0x68, 0x65, 0x92,
+	0x72, 0x73, 0xbd, 0x37, 0x07, 0xc4, 0xd1, 0xb3, 0xa4, 0xbb, 0xab, 0x2e, 0x63, 0x98, 0x7d, 0x06,
+	0x8d, 0x38, 0x2a, 0x10, 0x19, 0xa0, 0xca, 0x96, 0xc0, 0x80, 0x66, 0x8d, 0xd7, 0x67, 0x0a, 0x44,
+	0x29, 0x94, 0x70, 0x12, 0xd8, 0x4b, 0xd7, 0x9c, 0x89, 0x4c, 0x5d, 0x85, 0x57, 0x9d, 0x90, 0x0b,
+	0x04, 0xf6, 0x17, 0x4f, 0x48, 0xb0, 0x4f, 0x20, 0x0f, 0x9a, 0xfc, 0xe3, 0x9e, 0x75, 0x72, 0x7d,
+	0x00, 0x97, 0xb6, 0xcd, 0xcd, 0x16, 0x47, 0xb4, 0xab, 0x3a, 0xa2, 0xb5, 0x9d, 0x72, 0xe2, 0x94,
+	0xae, 0xff, 0x8a, 0x36, 0x9b, 0xca, 0xe8, 0x7e, 0x96, 0x4b, 0xfb, 0xab, 0x12, 0x54, 0x45, 0x02,
+	0x21, 0xa3, 0x5a, 0xf9, 0x33, 0x55, 0xeb, 0x26, 0xe4, 0x71, 0x4c, 0x39, 0x35, 0x6e, 0xe9, 0x59,
+	0x98, 0x61, 0xe6, 0x48, 0x60, 0xef, 0x4a, 0xd5, 0xeb, 0x60, 0xd4, 0x92, 0x57, 0x83, 0xb8, 0x44,
+	0xf5, 0x52, 0x06, 0xdc, 0x5a, 0x8b, 0x6c, 0x07, 0x46, 0x43, 0xcd, 0x82, 0xda, 0x6e, 0x9b, 0x8e,
+	0xdf, 0x1e, 0x99, 0xcb, 0xf8, 0x00, 0xb4, 0xed, 0xbb, 0x7f, 0x08, 0x7d, 0xf9, 0x0c, 0xce, 0xfb,
+	0xde, 0x24, 0xb0, 0x31, 0x53, 0x38, 0x8b, 0x48, 0x54, 0x79, 0xbb, 0xa8, 0x86, 0xef, 0x71, 0xc9,
+	0x86, 0x12, 0xdf, 0xcc, 0x56, 0x44, 0xc9, 0x15, 0x92, 0xac, 0xf0, 0x61, 0x03, 0x9f, 0xc0, 0x0e,
+	0xee, 0xd7, 0xcc, 0x70, 0x66, 0x5a, 0x36, 0xc9, 0xaf, 0x6e, 0x97, 0x5f, 0xf7, 0xbd, 0xb6, 0xe0,
+	0x42, 0xf1, 0x7b, 0x99, 0x6a, 0x42, 0x6f, 0x36, 0xe7, 0x38, 0xad, 0x83, 0x4d, 0x7d, 0x9c, 0xa9,
+	0x83, 0x8b, 0xbd, 0xb6, 0x75, 0xc6, 0xd3, 0x5a, 0xb8, 0xe0, 0xf7, 0xe1, 0xb2, 0x52, 0x4b, 0x99,
+	0xff, 0xfa, 0xf6, 0xf9, 0x67, 0x49, 0xed, 0xc3, 0xe4, 0x43, 0xbc, 0x07, 0xe0, 0x7b, 0x93, 0xd0,
+	0x16, 0x13, 0xd8, 0xd8, 0x3e, 0xc0, 0x8a, 0xef, 0x8d, 0x6c, 0x2c, 0xb1, 0xbb, 0x09, 0x3b, 0x0e,
+	0x6c, 0x67, 0xcb, 0xc0, 0x04, 0x6f, 0x8f, 0x34, 0x28, 0xe6, 0xc5, 0x01, 0x9d, 0xdf, 0x3a, 0x20,
+	0xc1, 0x8d, 0x83, 0xf9, 0x12, 0x2e, 0x48, 0x6e, 0x65, 0x20, 0xfa, 0xf6, 0x81, 0xec, 0x50, 0xad,
+	0x74, 0x10, 0xf7, 0x32, 0xa6, 0xe3, 0xc2, 0x19, 0xda, 0x97, 0xda, 0x8a, 0x8f, 0xd5, 0xdc, 0x01,
+	0x56, 0x61, 0xdb, 0xab, 0xa4, 0x3e, 0xa3, 0x67, 0x9d, 0x18, 0x7f, 0x9e, 0x87, 0x5a, 0xcb, 0x33,
+	0xdd, 0xd3, 0xdf, 0xd9, 0x3d, 0x6f, 0xee, 0x8b, 0xdc, 0xeb, 0x72, 0x15, 0x4d, 0x30, 0x5c, 0x93,
+	0x87, 0x26, 0x55, 0xc2, 0x60, 0x9c, 0x84, 0x39, 0x48, 0x7f, 0x15, 0x25, 0x74, 0x71, 0x8c, 0x02,
+	0x02, 0x45, 0x0c, 0x49, 0x7d, 0x8a, 0xed, 0xf2, 0x4a, 0x7d, 0x8a, 0xec, 0xd2, 0xfa, 0x49, 0x68,
+	0x98, 0xd4, 0x27, 0x86, 0xd7, 0xa1, 0x81, 0x57, 0x16, 0x26, 0x33, 0xdf, 0x0b, 0x57, 0x0b, 0xdb,
+	0x12, 0x97, 0x4e, 0xc4, 0x3d, 0x86, 0xb6, 0xc4, 0xa1, 0x94, 0x85, 0xbd, 0xf0, 0x83, 0x53, 0x21,
+	0xa5, 0x24, 0xa4, 0x08, 0x14, 0x49, 0x79, 0x17, 0xd8, 0xb1, 0xe9, 0x44, 0x93, 0xac, 0x28, 0x91,
+	0x98, 0xd1, 0x91, 0x32, 0x56, 0xc5, 0x5d, 0x81, 0x92, 0xe5, 0x84, 0xcf, 0x7a, 0x43, 0x32, 0xaf,
+	0x79, 0x2e, 0x21, 0x0c, 0x43, 0xc3, 0x8f, 0x7a, 0xc3, 0xc9, 0xf4, 0x54, 0x9e, 0x76, 0xe4, 0x79,
+	0x05, 0x11, 0xfb, 0xa7, 0x11, 0x59, 0x58, 0x22, 0xce, 0xfc, 0x95, 0x27, 0x8e, 0xbe, 0xf2, 0x9c,
+	0xd8, 0xdb, 0x88, 0xc0, 0x50, 0xc8, 0xb3, 0xa3, 0x63, 0x3f, 0x40, 0xb1, 0x35, 0x41, 0x4d, 0x10,
+	0xb8, 0x1b, 0x09, 0x67, 0xa6, 0x87, 0xbd, 0x68, 0xd6, 0xa5, 0x60, 0x09, 0xb3, 0x9b, 0x38, 0x83,
+	0xe8, 0x1a, 0x88, 0xda, 0x10, 0x63, 0x4b, 0x31, 0xc6, 0xbf, 0x66, 0x50, 0x18, 0xf8, 0x96, 0xcd,
+	0x3e, 0x80, 0x2a, 0x9d, 0x98, 0x6f, 0xe6, 0xee, 0x90, 0x4c, 0x7f, 0x28, 0xc4, 0xa9, 0x78, 0xb2,
+	0x74, 0xf6, 0x19, 0xfb, 0x2d, 0x8a, 0x7f, 0x28, 0xa5, 0xae, 0x9c, 0x69, 0xd2, 0x96, 0x80, 0x0b,
+	0x0a, 0x05, 0x1b, 0x81, 0x8f, 0x8b, 0x67, 0x42, 0xe7, 0x78, 0x85, 0x2d, 0xc1, 0x86, 0xa0, 0xd3,
+	0xb5, 0x83, 0xeb, 0x50, 0xa1, 0xdd, 0x74, 0x60, 0x8b, 0x84, 0x4a, 0x91, 0x27, 0x30, 0x76, 0xfc,
+	0x7b, 0xdf, 0xf1, 0x44, 0xc7, 0x4b, 0x1b, 0x1d, 0xff, 0x8d, 0xef, 0x78, 0x14, 0xf0, 0x56, 0x90,
+	0x8b, 0x3a, 0xfe, 0x3a, 0x94, 0x7d, 0x4f, 0xb4, 0x5b, 0xde, 0x68, 0xb7, 0xe4, 0x7b, 0xd4, 0xe4,
+	0x3b, 0x50, 0x9b, 0x3b, 0x2e, 0xfa, 0x4a, 0x62, 0xac, 0x6c, 0x30, 0x82, 0x20, 0x13, 0xf3, 0x1b,
+	0x50, 0x39, 0x0a, 0xfc, 0xd5, 0x12, 0x83, 0xa1, 0xea, 0x06, 0x67, 0x99, 0x68, 0xfb, 0xa7, 0x38,
+	0x6a, 0x2a, 0x3a, 0xde, 0x11, 0x2e, 0xe3, 0x26, 0x6c, 0xb0, 0xd6, 0x62, 0xfa, 0xc8, 0x26, 0xa9,
+	0xe6, 0xd1, 0xd1, 0x44, 0x1e, 0x74, 0x6e, 0x48, 0x35, 0x8f, 0x8e, 0xa8, 0x71, 0x35, 0x12, 0xab,
+	0xbf, 0x30, 0x12, 0x53, 0xdc, 0x50, 0x24, 0x4e, 0xbe, 0x92, 0x55, 0x9d, 0x38, 0xc7, 0xc4, 0x0d,
+	0x45, 0x27, 0xec, 0x1d, 0xa8, 0x1c, 0xe3, 0x61, 0xd3, 0xd2, 0x9e, 0x35, 0x77, 0xd4, 0x48, 0x35,
+	0x8d, 0x33, 0x79, 0xf9, 0xd8, 0xf1, 0xb0, 0x80, 0x6e, 0xdc, 0x75, 0x16, 0x4e, 0x44, 0xf7, 0x9c,
+	0xd6, 0xdc, 0x38, 0x11, 0x98, 0x01, 0x25, 0x7f, 0x3e, 0xc7, 0xc1, 0xeb, 0x1b, 0x2c, 0x92, 0x92,
+	0x0d, 0xe9, 0x2e, 0xbc, 0x20, 0xa4, 0xdb, 0x83, 0x46, 0xc2, 0x3c, 0x79, 0x6e, 0xcf, 0xa4, 0xa1,
+	0x5a, 0xaf, 0x50, 0x8b, 0x2b, 0x3c, 0xb6, 0x67, 0xe8, 0x5a, 0xf1, 0x9a, 0x02, 0x9a, 0xf3, 0x8b,
+	0xdb, 0x43, 0xcb, 0x92, 0x3f, 0xfd, 0x1e, 0x8d, 0xf9, 0x87, 0x50, 0x0b, 0x68, 0x47, 0x37, 0xa1,
+	0x8d, 0xdf, 0x25, 0x75, 0x02, 0xd2, 0xad, 0x1e, 0x87, 0x20, 0x29, 0xa3, 0xcd, 0x11, 0xa7, 0x6c,
+	0xe2, 0x88, 0x26, 0xa4, 0x9c, 0x4d, 0x95, 0xd7, 0x09, 0x29, 0x8e, 0x6f, 0x28, 0x18, 0x10, 0xc7,
+	0x26, 0xf4, 0x15, 0xae, 0xa8, 0x9d, 0x10, 0xe7, 0x23, 0xf4, 0x15, 0xac, 0xb8, 0x88, 0xdb, 0xdc,
+	0xa9, 0xe3, 0x59, 0xa8, 0x38, 0x91, 0x79, 0x24, 0x92, 0x34, 0x45, 0x5e, 0x93, 0xb8, 0xb1, 0x79,
+	0x14, 0xb2, 0x8f, 0xa1, 0x6e, 0x0a, 0xd3, 0x3b, 0x71, 0xbc, 0xb9, 0x2f, 0x73, 0x33, 0x52, 0x15,
+	0x14, 0xa3, 0xcc, 0x6b, 0x66, 0x0a, 0xb0, 0xcf, 0x80, 0xc5, 0x99, 0x35, 0x8a, 0x71, 0x85, 0xb6,
+	0x5d, 0xdb, 0xd0, 0xb6, 0xf3, 0x32, 0xb5, 0x96, 0xdc, 0x04, 0xda, 0x05, 0xdc, 0x0e, 0x98, 0xae,
+	0x6b, 0xbb, 0x4e, 0xb8, 0x68, 0x5e, 0x27, 0x0b, 0xa0, 0xa2, 0x36, 0xc3, 0xcd, 0x57, 0x5e, 0x32,
+	0xdc, 0x7c, 0x1d, 0x1a, 0x78, 0xea, 0x3c, 0x33, 0x67, 0x4f, 0x6d, 0xaa, 0x78, 0x83, 0x22, 0xce,
+	0xba, 0xe7, 0x47, 0xed, 0x18, 0x87, 0x33, 0x28, 0xcc, 0x18, 0xcd, 0xe0, 0xab, 0xea, 0x0c, 0x26,
+	0xb1, 0x30, 0xfa, 0x0a, 0x59, 0x44, 0x0b, 0x2b, 0xf7, 0x42, 0xe8, 0xcd, 0x6e, 0x52, 0x77, 0xab,
+	0x02, 0x83, 0xfe, 0xee, 0x15, 0xdc, 0x6c, 0xa2, 0xaf, 0x33, 0x5d, 0xb7, 0xf9, 0x9a, 0x48, 0xe9,
+	0x10, 0xa2, 0xe5, 0xa2, 0xf3, 0xbc, 0xb8, 0x30, 0x31, 0x14, 0x9b, 0xad, 0x02, 0x3c, 0x3f, 0x98,
+	0x88, 0x5b, 0x52, 0xbb, 0x64, 0x4d, 0x2f, 0x2c, 0xcc, 0x13, 0x1e, 0x53, 0x3a, 0x48, 0x60, 0x5f,
+	0xc1, 0xf9, 0xd4, 0x79, 0x2e, 0x83, 0x95, 0x67, 0x37, 0x6f, 0x6d, 0x4d, 0xdc, 0x1d, 0x20, 0x8d,
+	0xef, 0x2c, 0x33, 0x30, 0x2a, 0x1d, 0x65, 0x4d, 0x22, 0xba, 0xf4, 0xd0, 0x34, 0x54, 0xa5, 0xa3,
+	0x5c, 0x11, 0xe1, 0x39, 0xb8, 0x49, 0x99, 0xbd, 0x07, 0x65, 0x34, 0xf9, 0x93, 0x28, 0x6c, 0xbe,
+	0x2e, 0x5b, 0x4a, 0x6f, 0xa5, 0x8e, 0xe3, 0x12, 0x5e, 0x0a, 0x32, 0xbd, 0x71, 0x28, 0x26, 0x0f,
+	0x8f, 0x31, 0x11, 0x6e, 0xde, 0xce, 0x4e, 0x9e, 0x65, 0x9f, 0x8c, 0x66, 0xa6, 0x27, 0x0f, 0x49,
+	0xb1, 0xc8, 0x38, 0x5c, 0x0b, 0x56, 0x1e, 0xf9, 0x3f, 0x69, 0x14, 0x97, 0x81, 0x3f, 0xb5, 0x85,
+	0xb2, 0xbc, 0x41, 0xca, 0x72, 0x55, 0x2e, 0x0a, 0xc1, 0x76, 0x9f, 0xb8, 0xc8, 0x38, 0x5c, 0x09,
+	0x54, 0xd4, 0x01, 0xd6, 0x23, 0x05, 0xda, 0x94, 0x39, 0x5d, 0x61, 0xc2, 0x94, 0x64, 0xbe, 0xf9,
+	0x73, 0x64, 0xee, 0x63, 0x3d, 0x94, 0x69, 0xfc, 0xd7, 0x3c, 0x54, 0x62, 0x4f, 0x85, 0x47, 0x81,
+	0x87, 0x83, 0x6f, 0x06, 0xc3, 0x27, 0x03, 0xfd, 0x1c, 0xe6, 0x29, 0x1e, 0xb7, 0xfa, 0x87, 0xdd,
+	0xc9, 0xa8, 0xdd, 0x1a, 0x88, 0xab, 0x59, 0x74, 0x2d, 0x48, 0xc0, 0x39, 0x76, 0x01, 0x1a, 0xf7,
+	0x0f, 0x07, 0x74, 0x14, 0x28, 0x50, 0x79, 0x44, 0x75, 0xbf, 0x15, 0xc9, 0x10, 0x81, 0x2a, 0x20,
+	0xea, 0x51, 0x6b, 0xdc, 0xe5, 0xbd, 0x18, 0x55, 0xc4, 0x56, 0x0e, 0xf8, 0xf0, 0x37, 0xdd, 0xf6,
+	0x58, 0x07, 0x76, 0x19, 0x2e, 0x24, 0x55, 0x62, 0x71, 0x7a, 0x0d, 0xd3, 0x2a, 0x71, 0x35, 0xfd,
+	0x12, 0x0a, 0xe1, 0xdd, 0xf6, 0x21, 0x1f, 0xf5, 0x1e, 0x77, 0x27, 0xed, 0x71, 0x57, 0xbf, 0x8c,
+	0x1b, 0xfb, 0x51, 0x6f, 0xf0, 0x8d, 0x7e, 0x05, 0x73, 0x11, 0x58, 0x12, 0xd2, 0xaf, 0x52, 0x0a,
+	0xe6, 0xc1, 0x03, 0xfd, 0x26, 0x8a, 0xe8, 0xf4, 0x46, 0xe3, 0xde, 0xa0, 0x3d, 0xd6, 0x5f, 0xc3,
+	0x3d, 0xff, 0xfd, 0x5e, 0x7f, 0xdc, 0xe5, 0xfa, 0x2e, 0xd6, 0xfd, 0xcd, 0xb0, 0x37, 0xd0, 0x6f,
+	0x21, 0x76, 0xd4, 0x7a, 0x74, 0xd0, 0xef, 0xea, 0x06, 0x49, 0x1c, 0xf2, 0xb1, 0xfe, 0x3a, 0xa6,
+	0x0a, 0x0e, 0x07, 0xd8, 0x8f, 0xdb, 0x28, 0x9c, 0x8a, 0x13, 0xbc, 0x68, 0xf6, 0x86, 0x92, 0xab,
+	0x79, 0x13, 0xcb, 0x4f, 0x7a, 0x83, 0xce, 0xf0, 0x89, 0xfe, 0x16, 0xb2, 0xed, 0xf3, 0x61, 0xab,
+	0xd3, 0xc6, 0x94, 0xce, 0x1d, 0x14, 0x30, 0x3a, 0xe8, 0xf7, 0xc6, 0xfa, 0xdb, 0x94, 0x6b, 0x68,
+	0x8d, 0x1f, 0x76, 0xb9, 0x7e, 0x17, 0xcb, 0xad, 0xd1, 0xa8, 0xcb, 0xc7, 0xfa, 0x1e, 0x96, 0x7b,
+	0x03, 0x2a, 0x7f, 0x44, 0x52, 0x0f, 0x3a, 0xad, 0x71, 0x57, 0xff, 0x18, 0xcb, 0x9d, 0x6e, 0xbf,
+	0x3b, 0xee, 0xea, 0x9f, 0xa0, 0x54, 0xca, 0x2d, 0x8d, 0x70, 0xaa, 0x3e, 0xc5, 0x59, 0x48, 0x40,
+	0xea, 0xcf, 0x67, 0xd8, 0xd0, 0xa3, 0xde, 0xe0, 0x70, 0xa4, 0x7f, 0x8e, 0xcc, 0x54, 0x24, 0xca,
+	0x17, 0xc6, 0xf7, 0x50, 0x89, 0xfd, 0x38, 0x72, 0xf5, 0x06, 0x83, 0x2e, 0xde, 0xb5, 0xab, 0x40,
+	0xa1, 0xdf, 0xbd, 0x3f, 0xd6, 0x35, 0x44, 0xf2, 0xde, 0x83, 0x87, 0x63, 0x3d, 0x87, 0xc5, 0xe1,
+	0x21, 0x4e, 0x4d, 0x9e, 0x26, 0xa1, 0xfb, 0xa8, 0xa7, 0x17, 0xb0, 0xd4, 0x1a, 0x8c, 0x7b, 0x7a,
+	0x91, 0x26, 0xa9, 0x37, 0x78, 0xd0, 0xef, 0xea, 0x25, 0xc4, 0x3e, 0x6a, 0xf1, 0x6f, 0xf4, 0x32,
+	0x56, 0x6a, 0x1d, 0x1c, 0xf4, 0xbf, 0xd3, 0x2b, 0xc6, 0x1d, 0x28, 0xb7, 0x8e, 0x8e, 0x1e, 0x61,
+	0x4c, 0x54, 0x81, 0xc2, 0x7d, 0x3c, 0x3b, 0xa6, 0x5b, 0x7d, 0xfb, 0xc3, 0xf1, 0x78, 0xf8, 0x48,
+	0xd7, 0xf0, 0x9b, 0x8c, 0x87, 0x07, 0x7a, 0xce, 0xe8, 0xc2, 0x85, 0x0d, 0xd5, 0xc4, 0x1d, 0x69,
+	0x64, 0x1e, 0xc5, 0xd7, 0x4d, 0x23, 0xf3, 0x28, 0x49, 0xea, 0xe5, 0xb6, 0x27, 0xf5, 0x8c, 0x0f,
+	0x94, 0x23, 0x54, 0x61, 0x00, 0x6e, 0x66, 0x4e, 0x0d, 0x35, 0xb2, 0xf5, 0x0a, 0xc6, 0xe8, 0x61,
+	0x8e, 0x24, 0x5e, 0x9b, 0xd9, 0xfb, 0x0d, 0xda, 0xfa, 0xfd, 0x86, 0xe4, 0xdc, 0x45, 0xbd, 0xfe,
+	0x10, 0x25, 0xe7, 0x44, 0xff, 0x36, 0x07, 0x90, 0xda, 0x14, 0x3c, 0xdc, 0x13, 0xdc, 0xc9, 0x61,
+	0x50, 0x99, 0xe0, 0x9e, 0xc5, 0xde, 0x83, 0xc2, 0xc2, 0xb7, 0x84, 0x88, 0x9d, 0xbd, 0x6b, 0xeb,
+	0xe6, 0x88, 0x8a, 0x38, 0x6b, 0x9c, 0xd8, 0xd8, 0xaf, 0xa0, 0x46, 0x41, 0xf3, 0xd2, 0x77, 0x9d,
+	0xd9, 0x69, 0x33, 0xaf, 0x26, 0xcf, 0x94, 0x5a, 0x4f, 0x4c, 0x27, 0x3a, 0x20, 0x16, 0x0e, 0xc7,
+	0x49, 0x19, 0x37, 0xa0, 0xf2, 0x96, 0xce, 0x04, 0x6f, 0x86, 0xe0, 0x55, 0x55, 0x71, 0xe9, 0xbd,
+	0xb1, 0x4c, 0x4e, 0x71, 0xf0, 0xc6, 0xea, 0x5d, 0xb8, 0x90, 0x66, 0xef, 0x63, 0x4e, 0x11, 0x21,
+	0x9e, 0x4f, 0x08, 0x82, 0xd7, 0x78, 0x03, 0x2a, 0x71, 0x1f, 0x51, 0xbf, 0xba, 0xdf, 0xb6, 0xfb,
+	0x87, 0xb8, 0x06, 0xc5, 0xe7, 0x1d, 0x3d, 0x6c, 0xf1, 0x6e, 0x47, 0xd7, 0x8c, 0x8f, 0x01, 0xd2,
+	0x4e, 0xa1, 0x0a, 0x3c, 0x69, 0xf5, 0xe4, 0xcd, 0x82, 0xc1, 0x70, 0x42, 0x80, 0x46, 0x77, 0x09,
+	0xbe, 0xe9, 0x1d, 0x4c, 0xfa, 0xc3, 0xf6, 0x37, 0xdd, 0x8e, 0x9e, 0x33, 0x6e, 0x40, 0x49, 0xec,
+	0xee, 0x30, 0xaf, 0x9d, 0x5c, 0x91, 0xcd, 0xcb, 0x6b, 0xb1, 0x3e, 0x54, 0x93, 0x2d, 0x13, 0xbb,
+	0x8b, 0xb7, 0xd2, 0x96, 0x32, 0xf3, 0xd0, 0x5c, 0xdb, 0x50, 0xdd, 0x7b, 0x64, 0x2e, 0x45, 0xe2,
+	0x06, 0x99, 0xae, 0x7f, 0x0a, 0x95, 0x18, 0xf1, 0xb3, 0x72, 0x1d, 0xff, 0xb1, 0x00, 0xd5, 0x8e,
+	0x12, 0x3d, 0xbc, 0x30, 0xd7, 0xa1, 0x64, 0x1b, 0x72, 0x2f, 0x9d, 0x6d, 0xc8, 0xbf, 0x28, 0xdb,
+	0x50, 0xf8, 0xa5, 0xd9, 0x86, 0xe2, 0xcb, 0x65, 0x1b, 0x4a, 0x2f, 0x93, 0x6d, 0xb8, 0xbd, 0x91,
+	0x6d, 0x28, 0x93, 0xf4, 0x6c, 0x7e, 0x21, 0xbb, 0xcb, 0xaf, 0xbc, 0x68, 0x97, 0x9f, 0xdd, 0xb9,
+	0x57, 0x5f, 0xb0, 0x73, 0xcf, 0xe6, 0x04, 0xe0, 0x27, 0x73, 0x02, 0x5b, 0x77, 0xf9, 0xb5, 0x97,
+	0xdb, 0xe5, 0xdf, 0x82, 0x3a, 0x45, 0x01, 0xc1, 0xca, 0xc3, 0x8c, 0x9b, 0xbc, 0xf0, 0x56, 0x43,
+	0xa7, 0x2f, 0x51, 0x9b, 0x1b, 0xfb, 0xc6, 0xcb, 0x6c, 0xec, 0xff, 0x69, 0x0e, 0x8a, 0xbf, 0xc5,
+	0xdb, 0x9c, 0xec, 0x53, 0xa8, 0x86, 0xd1, 0x22, 0x52, 0xf7, 0x89, 0xd2, 0x16, 0x10, 0x9d, 0xb6,
+	0x79, 0x36, 0x1e, 0x83, 0x8b, 0xdd, 0x22, 0xf2, 0x62, 0x89, 0x9e, 0xa4, 0x44, 0xf6, 0x52, 0x9c,
+	0xea, 0x17, 0xb9, 0x00, 0x70, 0xc3, 0x80, 0x9b, 0xc6, 0x38, 0x7d, 0x06, 0xe9, 0xc6, 0x8d, 0x0b,
+	0x02, 0x6e, 0x18, 0xe8, 0x58, 0x29, 0xdc, 0xb2, 0x47, 0x94, 0x14, 0xdc, 0x1e, 0x3e, 0xb5, 0x4d,
+	0x8c, 0x84, 0xe3, 0xfb, 0x61, 0x09, 0x8c, 0x47, 0x47, 0xae, 0x6f, 0x5a, 0x63, 0xf3, 0x28, 0xbe,
+	0xc1, 0x28, 0x41, 0xe3, 0x09, 0x34, 0x32, 0x9d, 0xcd, 0x06, 0x0c, 0x68, 0x12, 0xba, 0x7d, 0xf4,
+	0x55, 0x9a, 0xe2, 0xde, 0x72, 0x8a, 0x4b, 0xcb, 0x2b, 0xae, 0xae, 0x40, 0xce, 0xab, 0xcb, 0x1f,
+	0x74, 0xf5, 0xa2, 0xf1, 0x8f, 0x72, 0x70, 0x61, 0x1c, 0x98, 0x5e, 0x68, 0x8a, 0x5b, 0x0b, 0x5e,
+	0x14, 0xf8, 0x2e, 0xfb, 0x12, 0x2a, 0xd1, 0xcc, 0x55, 0xe7, 0xed, 0x35, 0xa9, 0x2f, 0xeb, 0xac,
+	0xf7, 0xc6, 0x33, 0x97, 0x66, 0xaf, 0x1c, 0x89, 0x02, 0x7b, 0x0f, 0x8a, 0x53, 0xfb, 0xc8, 0xf1,
+	0xa4, 0x0f, 0xb9, 0xbc, 0x5e, 0x71, 0x1f, 0x89, 0xf8, 0x64, 0x86, 0xb8, 0xd8, 0x07, 0x78, 0x7b,
+	0x74, 0x81, 0xfb, 0xb0, 0xbc, 0x7a, 0xa7, 0x45, 0x6d, 0x08, 0xa9, 0xf8, 0x2c, 0x46, 0xf0, 0xb1,
+	0x4f, 0xf1, 0x92, 0xbb, 0xeb, 0x4e, 0xcd, 0xd9, 0x33, 0x99, 0xa2, 0x6f, 0xae, 0xd7, 0xe1, 0x92,
+	0xfe, 0xf0, 0x1c, 0x4f, 0x78, 0x8d, 0x7b, 0x50, 0x96, 0x9d, 0xc5, 0x09, 0xd8, 0xef, 0x3e, 0xe8,
+	0xc9, 0xb9, 0x6b, 0x0f, 0x1f, 0x3d, 0x22, 0x4b, 0x89, 0xd7, 0xb3, 0x86, 0xfd, 0xfe, 0x7e, 0xab,
+	0xfd, 0x8d, 0x9e, 0xdb, 0xaf, 0x40, 0xc9, 0xa4, 0xf3, 0x44, 0xe3, 0x6f, 0x6a, 0x70, 0x7e, 0x6d,
+	0x00, 0xec, 0x73, 0xe9, 0x62, 0xc4, 0xf4, 0xdc, 0xde, 0x3a, 0x4a, 0x05, 0x4e, 0xbd, 0x8d, 0xf1,
+	0x05, 0xec, 0x64, 0xf1, 0xca, 0x85, 0xf0, 0x06, 0x54, 0x79, 0xb7, 0xd5, 0x99, 0x0c, 0x07, 0xfd,
+	0xef, 0x44, 0xe4, 0x47, 0xe0, 0x13, 0xde, 0x1b, 0x77, 0xf5, 0x9c, 0xf1, 0x27, 0xa0, 0xaf, 0x4f,
+	0x0c, 0x7b, 0x00, 0xe4, 0x3d, 0x5c, 0x5b, 0x5c, 0xb8, 0x48, 0x3f, 0xd9, 0xcd, 0x2d, 0x33, 0x29,
+	0xd9, 0xe8, 0x8b, 0xed, 0xcc, 0x32, 0xb0, 0xf1, 0xff, 0x03, 0xdb, 0x9c, 0xc1, 0x3f, 0x9c, 0xf8,
+	0x7f, 0xae, 0x41, 0xe1, 0xc0, 0x35, 0xf1, 0xaa, 0x4f, 0x91, 0x2e, 0x5b, 0x37, 0x35, 0x35, 0xe5,
+	0x42, 0x2b, 0x12, 0xd5, 0x82, 0x68, 0xec, 0x1d, 0xc8, 0x47, 0x33, 0x57, 0xea, 0xd0, 0xd5, 0x33,
+	0x94, 0x0f, 0xef, 0x45, 0x47, 0x33, 0x4c, 0x3f, 0xe7, 0x2d, 0x2b, 0x3e, 0x5f, 0x93, 0xfb, 0x16,
+	0xdc, 0xdf, 0x76, 0xec, 0xb9, 0xe3, 0x39, 0xf2, 0xea, 0x37, 0xb2, 0xe0, 0xe5, 0x6f, 0x6b, 0xe6,
+	0x66, 0x4f, 0x76, 0x90, 0x53, 0x11, 0x68, 0xcd, 0x5c, 0xbc, 0x68, 0x8d, 0x24, 0xe3, 0x5d, 0xba,
+	0xda, 0xbc, 0x5a, 0xe0, 0xbd, 0x4f, 0x59, 0xda, 0x72, 0xa0, 0x2a, 0x29, 0xc6, 0xff, 0xce, 0x41,
+	0x4d, 0x11, 0xc6, 0x3e, 0x86, 0x8a, 0x35, 0x73, 0xb7, 0x58, 0x1f, 0x85, 0xe9, 0x5e, 0x27, 0x5e,
+	0x3f, 0x96, 0x28, 0xe0, 0x99, 0x3c, 0x1a, 0xd4, 0xe7, 0x66, 0xe0, 0xa0, 0x71, 0x0e, 0x9b, 0x39,
+	0x75, 0x2b, 0x3a, 0xb2, 0xa3, 0xc7, 0x31, 0x05, 0x5f, 0x39, 0x85, 0x0a, 0xcc, 0xde, 0xc6, 0xeb,
+	0xc3, 0xf6, 0xd2, 0x0c, 0x6c, 0x39, 0x17, 0x8d, 0xf8, 0x14, 0x9e, 0x90, 0xf8, 0xe8, 0x49, 0xd2,
+	0x91, 0xd5, 0x3e, 0xb1, 0x67, 0xab, 0x28, 0x3e, 0xe6, 0x6a, 0xc4, 0x03, 0x22, 0x24, 0xb2, 0x4a,
+	0x3a, 0xdb, 0xc3, 0xfd, 0xbf, 0xe9, 0xba, 0x3e, 0x99, 0xe9, 0xa2, 0xba, 0xc3, 0xeb, 0x24, 0x78,
+	0xf1, 0x62, 0x2a, 0x86, 0x8c, 0x23, 0x28, 0xcb, 0x81, 0x61, 0xf0, 0x8c, 0x57, 0x19, 0x1f, 0xb7,
+	0x78, 0x0f, 0x37, 0x31, 0xf2, 0x44, 0xf0, 0x01, 0x6f, 0x0d, 0xa4, 0xb9, 0xe2, 0xdd, 0xc7, 0xc3,
+	0x6f, 0xf0, 0xcd, 0x03, 0x1d, 0xdd, 0x0e, 0xbe, 0xd3, 0xf3, 0x62, 0xa3, 0xd2, 0x3d, 0x68, 0x71,
+	0xb4, 0x56, 0x35, 0x28, 0x77, 0xbf, 0xed, 0xb6, 0x0f, 0xc7, 0x5d, 0xbd, 0x88, 0x2b, 0xa2, 0xd3,
+	0x6d, 0xf5, 0xfb, 0xc3, 0x36, 0x9a, 0xb2, 0xd2, 0x7e, 0x15, 0x6f, 0x36, 0xd1, 0x4c, 0x1a, 0xff,
+	0xb2, 0x06, 0x3b, 0xd9, 0xaf, 0xce, 0x3e, 0x83, 0x8a, 0x65, 0x65, 0xbe, 0xc0, 0x8d, 0x6d, 0xda,
+	0x71, 0xaf, 0x63, 0xc5, 0x1f, 0x41, 0x14, 0x30, 0x2d, 0x28, 0x74, 0x34, 0xb7, 0xa1, 0xa3, 0xb1,
+	0x86, 0xfe, 0x1a, 0xce, 0xcb, 0x8b, 0xca, 0x98, 0x6e, 0x99, 0x9a, 0xa1, 0x9d, 0x55, 0xc0, 0x36,
+	0x11, 0x3b, 0x92, 0xf6, 0xf0, 0x1c, 0xdf, 0x99, 0x65, 0x30, 0xec, 0x57, 0xb0, 0x63, 0xd2, 0x5e,
+	0x32, 0xa9, 0x5f, 0x50, 0xaf, 0x4e, 0xb4, 0x90, 0xa6, 0x54, 0x6f, 0x98, 0x2a, 0x02, 0xd5, 0xc4,
+	0x0a, 0xfc, 0x65, 0x5a, 0xb9, 0xa8, 0xaa, 0x49, 0x27, 0xf0, 0x97, 0x4a, 0xdd, 0xba, 0xa5, 0xc0,
+	0xec, 0x53, 0xa8, 0xcb, 0x9e, 0x8b, 0x5c, 0x47, 0x49, 0x5d, 0x0d, 0xa2, 0xdb, 0x14, 0x17, 0xe0,
+	0xdb, 0xbe, 0x59, 0x0a, 0xb2, 0x8f, 0xa0, 0x26, 0x3a, 0x9c, 0xbe, 0xcc, 0x4c, 0x34, 0x81, 0x7a,
+	0x1b, 0xd7, 0x02, 0x33, 0x81, 0xd8, 0x07, 0x00, 0xd4, 0x4f, 0xf5, 0x14, 0xef, 0x7c, 0xda, 0xc9,
+	0xb8, 0x4a, 0xd5, 0x8a, 0x01, 0xa5, 0x7b, 0xe2, 0xb6, 0x4c, 0x75, 0xb3, 0x7b, 0xb4, 0xa3, 0x48,
+	0xbb, 0x17, 0xdf, 0x8e, 0x91, 0xdd, 0x13, 0xd5, 0x60, 0xa3, 0x7b, 0x71, 0x2d, 0x30, 0x13, 0x28,
+	0xe9, 0x9e, 0xa8, 0x53, 0x5b, 0xef, 0x5e, 0x5c, 0xa5, 0x6a, 0xc5, 0x00, 0x7e, 0xb6, 0x38, 0x66,
+	0x91, 0x83, 0xaa, 0x67, 0x6e, 0x79, 0x49, 0x5a, 0x3c, 0xb0, 0x46, 0xa4, 0x22, 0xb0, 0x76, 0xf8,
+	0xd4, 0x3f, 0x56, 0x96, 0x77, 0x43, 0xad, 0x3d, 0x7a, 0xea, 0x1f, 0xab, 0xeb, 0xbb, 0x11, 0xaa,
+	0x08, 0xec, 0xad, 0x18, 0x22, 0x5d, 0x92, 0xdb, 0x51, 0x7b, 0x4b, 0x23, 0xc4, 0x6b, 0x4d, 0xd8,
+	0x5b, 0x33, 0x06, 0x70, 0x52, 0x64, 0x7e, 0x86, 0x1a, 0x3b, 0xbf, 0x99, 0x9f, 0x91, 0x2d, 0x81,
+	0x9b, 0x40, 0xa8, 0x5b, 0x2b, 0x4f, 0xad, 0xa6, 0xab, 0xba, 0x75, 0xe8, 0x65, 0x2a, 0xd6, 0x05,
+	0xab, 0x80, 0x8d, 0x7f, 0x5c, 0x80, 0xb2, 0x5c, 0x4d, 0xf8, 0x2e, 0xa9, 0xcd, 0xbb, 0xad, 0x71,
+	0x77, 0xd2, 0x69, 0x8d, 0x5b, 0xfb, 0xad, 0x11, 0x7a, 0x38, 0x06, 0x3b, 0x2d, 0xcc, 0x06, 0xa4,
+	0x38, 0x0d, 0x4d, 0x44, 0x87, 0x0f, 0x0f, 0x52, 0x54, 0x0e, 0x5f, 0x39, 0xc9, 0xba, 0xe2, 0x45,
+	0x54, 0x1e, 0xb7, 0x31, 0xa2, 0xa2, 0x40, 0xd0, 0x75, 0x0e, 0xaa, 0x25, 0xe0, 0xa2, 0x52, 0xa5,
+	0x37, 0xe8, 0x74, 0xbf, 0xd5, 0x4b, 0x69, 0x15, 0x81, 0x28, 0x27, 0x55, 0x04, 0x5c, 0xc1, 0xce,
+	0x8c, 0xf9, 0xe1, 0xa0, 0x9d, 0xb6, 0x53, 0xc5, 0x4a, 0x52, 0xcc, 0xe3, 0x5e, 0xf7, 0x89, 0x0e,
+	0x58, 0x49, 0x48, 0x21, 0xb8, 0x86, 0x3e, 0x9a, 0x84, 0x10, 0x58, 0x67, 0x57, 0xe1, 0xe2, 0xe8,
+	0xe1, 0xf0, 0xc9, 0x44, 0x54, 0x4a, 0x86, 0xd0, 0x60, 0x97, 0x40, 0x57, 0x08, 0x42, 0xfc, 0x0e,
+	0x36, 0x49, 0xd8, 0x98, 0x71, 0xa4, 0x9f, 0xa7, 0x1d, 0x1a, 0xe2, 0xc6, 0xc2, 0x40, 0xea, 0x38,
+	0x14, 0x51, 0x75, 0xd8, 0x3f, 0x7c, 0x34, 0x18, 0xe9, 0x17, 0xb0, 0x13, 0x84, 0x11, 0x3d, 0x67,
+	0x89, 0x98, 0xd4, 0xac, 0x5e, 0x24, 0x4b, 0x8b, 0xb8, 0x27, 0x2d, 0x3e, 0xe8, 0x0d, 0x1e, 0x8c,
+	0xf4, 0x4b, 0x89, 0xe4, 0x2e, 0xe7, 0x43, 0x3e, 0xd2, 0x2f, 0x27, 0x88, 0xd1, 0xb8, 0x35, 0x3e,
+	0x1c, 0xe9, 0x57, 0x92, 0x5e, 0x1e, 0xf0, 0x61, 0xbb, 0x3b, 0x1a, 0xf5, 0x7b, 0xa3, 0xb1, 0x7e,
+	0x15, 0x93, 0x43, 0x69, 0x8f, 0x62, 0xe6, 0xa6, 0xd2, 0x51, 0xfe, 0xa0, 0x3b, 0xd6, 0xaf, 0x25,
+	0xdd, 0x68, 0x0f, 0xfb, 0xf8, 0x58, 0x6d, 0x38, 0xd0, 0xaf, 0x23, 0x13, 0x6e, 0x35, 0xe3, 0xd1,
+	0xbc, 0x82, 0xfd, 0x3a, 0x1c, 0xa8, 0xa8, 0x1b, 0xfb, 0x75, 0x7a, 0x73, 0x2b, 0xcd, 0xaf, 0x71,
+	0x00, 0x3b, 0x59, 0x6b, 0x89, 0xcf, 0x2c, 0x9c, 0xf9, 0x04, 0x33, 0xab, 0xf4, 0x24, 0x21, 0x94,
+	0x0f, 0x40, 0x6a, 0xce, 0x7c, 0xe0, 0x47, 0xf4, 0x26, 0x81, 0x22, 0xe9, 0xc4, 0xf8, 0x89, 0x5c,
+	0x41, 0x02, 0x1b, 0x0f, 0xa1, 0x91, 0xb1, 0x9f, 0x98, 0x33, 0x75, 0xe6, 0x59, 0x61, 0x15, 0x67,
+	0xfe, 0x12, 0x92, 0x1e, 0x40, 0x5d, 0x35, 0xa6, 0xbf, 0x5c, 0xd0, 0x7f, 0xcb, 0x41, 0x4d, 0x31,
+	0xae, 0x2f, 0x35, 0xc4, 0x1b, 0x50, 0x8d, 0xec, 0xc5, 0xd2, 0x0f, 0x4c, 0xe9, 0x8a, 0x2a, 0x3c,
+	0x45, 0x64, 0x5a, 0xcb, 0x67, 0x5b, 0xcb, 0x9e, 0x4b, 0x14, 0x5e, 0x70, 0x2e, 0xf1, 0x21, 0xd4,
+	0x95, 0x97, 0x22, 0xa1, 0x3c, 0xc3, 0x5f, 0xe7, 0xaf, 0xa5, 0xaf, 0x46, 0x42, 0xbc, 0x87, 0x3b,
+	0x7f, 0x36, 0xb1, 0xa6, 0xe2, 0x2e, 0x70, 0x15, 0xaf, 0x93, 0x76, 0xa6, 0x74, 0x8b, 0x6e, 0x9e,
+	0x58, 0x8d, 0x32, 0x51, 0x2a, 0xf3, 0xd8, 0xac, 0x7c, 0x0c, 0xe5, 0xf9, 0x33, 0x71, 0xbf, 0x52,
+	0xec, 0x59, 0x5f, 0xd9, 0x70, 0x39, 0xf7, 0xee, 0x3f, 0x93, 0xaf, 0x68, 0x78, 0x69, 0x8e, 0xc5,
+	0xf0, 0xfa, 0x6b, 0x50, 0x4d, 0x90, 0x99, 0xd7, 0x3d, 0x55, 0x79, 0x31, 0xed, 0xef, 0x69, 0x00,
+	0xa9, 0xfb, 0x49, 0x7f, 0x39, 0x40, 0x53, 0x7e, 0x39, 0xe0, 0xe7, 0x5d, 0xbd, 0xf9, 0xa9, 0x89,
+	0xfd, 0x00, 0xca, 0x62, 0x57, 0x10, 0x6f, 0xf2, 0xae, 0xac, 0x3b, 0x40, 0xf9, 0x04, 0x24, 0x66,
+	0x33, 0xfe, 0xac, 0x08, 0xfa, 0x3a, 0x95, 0x7d, 0x09, 0x60, 0x5a, 0xd6, 0x24, 0x89, 0x29, 0xb1,
+	0x43, 0xd7, 0x36, 0x24, 0x59, 0x96, 0xb8, 0x47, 0x4e, 0x36, 0x3d, 0x06, 0xd8, 0x57, 0x50, 0x23,
+	0x9f, 0x25, 0x2b, 0x8b, 0xd1, 0x5c, 0x5f, 0xaf, 0x8c, 0x5a, 0x9b, 0xd4, 0x06, 0x2b, 0x81, 0x58,
+	0x1b, 0x1a, 0x0b, 0xdf, 0x72, 0xe6, 0xa7, 0xb1, 0x00, 0x11, 0xb6, 0xdc, 0x58, 0x17, 0xf0, 0x88,
+	0x98, 0x12, 0x11, 0xf5, 0x85, 0x02, 0xa3, 0x90, 0xc0, 0xc6, 0x34, 0x5d, 0x2c, 0xa4, 0xb0, 0x5d,
+	0x08, 0x27, 0xa6, 0x54, 0x48, 0xa0, 0xc0, 0xec, 0x6b, 0x90, 0xb0, 0x74, 0xa4, 0x22, 0x84, 0x79,
+	0x65, 0xbb, 0x8c, 0x24, 0x24, 0x09, 0x52, 0x10, 0x0f, 0x54, 0x71, 0x1a, 0x85, 0xf7, 0x2e, 0x9d,
+	0x1d, 0x28, 0x54, 0x4c, 0xcb, 0xda, 0xe6, 0xf0, 0xcb, 0x2f, 0xe1, 0xf0, 0xdb, 0xd0, 0xc0, 0x36,
+	0xb2, 0x2f, 0x18, 0xb6, 0x0c, 0xb5, 0x65, 0x59, 0x49, 0x6e, 0x14, 0x87, 0x6a, 0x2a, 0x30, 0xbb,
+	0x0f, 0x3b, 0xd4, 0x6c, 0x2a, 0x45, 0x84, 0x35, 0xaf, 0x6e, 0xfb, 0x6c, 0xaa, 0x98, 0x86, 0xa5,
+	0x22, 0x18, 0x07, 0x96, 0x44, 0x1f, 0xa9, 0x2c, 0x11, 0xeb, 0xdc, 0x5a, 0x97, 0x15, 0xc7, 0x22,
+	0xaa, 0xbc, 0x0b, 0xd1, 0x3a, 0x52, 0xd9, 0xe8, 0xfe, 0x11, 0x5c, 0xdc, 0xa2, 0x7d, 0xec, 0xb6,
+	0xb2, 0xf9, 0xd9, 0xbc, 0x6f, 0x2c, 0x69, 0xc6, 0x5d, 0xb8, 0xb4, 0x4d, 0xfb, 0xb6, 0xdd, 0xc6,
+	0x35, 0xfe, 0x3f, 0xb8, 0xb2, 0x5d, 0xd1, 0x5e, 0xb2, 0xad, 0x01, 0x5c, 0x59, 0xd7, 0x0f, 0x59,
+	0x1f, 0x9f, 0x75, 0xbb, 0x96, 0x9a, 0x60, 0x2e, 0xfb, 0xae, 0x15, 0xbf, 0xf8, 0xf6, 0xec, 0x63,
+	0x35, 0xb9, 0x5c, 0xf6, 0xec, 0x63, 0x24, 0x19, 0x8f, 0xe0, 0xf2, 0x56, 0x7d, 0xfb, 0x85, 0xe2,
+	0x7e, 0xd4, 0xe0, 0xca, 0x76, 0xc5, 0xc8, 0x5e, 0x91, 0xd7, 0x5e, 0xee, 0x8a, 0xfc, 0x1e, 0x5c,
+	0xde, 0xf6, 0xa4, 0x22, 0x7e, 0x75, 0x72, 0x71, 0xf3, 0x4d, 0x45, 0x68, 0xfc, 0x75, 0x0d, 0xae,
+	0x9e, 0xa1, 0x55, 0xff, 0xcf, 0xfa, 0xf0, 0x5b, 0x78, 0xe5, 0x27, 0x94, 0xf1, 0x6c, 0x91, 0xda,
+	0xd9, 0x22, 0xff, 0x52, 0x83, 0x6a, 0x12, 0xea, 0xfe, 0x62, 0x67, 0x9c, 0x75, 0xac, 0xf9, 0x75,
+	0xc7, 0x9a, 0xb8, 0x90, 0xc2, 0x99, 0x2e, 0xa4, 0xf8, 0x33, 0x5d, 0x6a, 0xe9, 0x85, 0x2e, 0xd5,
+	0xf8, 0xf3, 0x1c, 0x54, 0x93, 0x2d, 0xd1, 0x2f, 0x1f, 0x5a, 0xd2, 0xf9, 0xbc, 0xda, 0xf9, 0xbb,
+	0x70, 0x61, 0xfd, 0x31, 0xa8, 0x70, 0x60, 0x55, 0x7e, 0x3e, 0xfb, 0x1a, 0x34, 0xdc, 0x3c, 0x0c,
+	0x2f, 0xbe, 0xe4, 0x61, 0xb8, 0x7a, 0x22, 0x53, 0xca, 0x9e, 0xc8, 0xac, 0x3d, 0xe1, 0x2c, 0xef,
+	0xe6, 0xd7, 0x9e, 0x70, 0x9e, 0xa9, 0x0c, 0x95, 0xb3, 0x95, 0xe1, 0xdf, 0x68, 0x71, 0x48, 0x25,
+	0x2c, 0xb5, 0x3a, 0x2d, 0xda, 0x59, 0xd3, 0x92, 0x53, 0xa7, 0xe5, 0x33, 0x68, 0xca, 0x67, 0x1f,
+	0xa2, 0x49, 0xe5, 0x20, 0x47, 0xce, 0xdf, 0x65, 0x41, 0xa7, 0x56, 0xd3, 0x57, 0x39, 0x78, 0x49,
+	0x58, 0x78, 0x90, 0xc2, 0x19, 0x9b, 0x67, 0x2e, 0xe8, 0xeb, 0x6f, 0x6b, 0x8b, 0xeb, 0x6f, 0x6b,
+	0x0d, 0x43, 0x46, 0x2f, 0x62, 0x08, 0x97, 0x62, 0xb9, 0xf1, 0xbb, 0x60, 0x04, 0x30, 0x01, 0x59,
+	0x4d, 0xbc, 0xd3, 0x2f, 0x18, 0x66, 0xf6, 0xdc, 0x2d, 0xbf, 0x7e, 0xee, 0xb6, 0xed, 0xa5, 0x70,
+	0x61, 0xdb, 0x4b, 0x61, 0xe3, 0xef, 0xe6, 0xa0, 0x91, 0xd9, 0xe1, 0xfe, 0x82, 0xce, 0x6c, 0x55,
+	0xc5, 0xfc, 0x4b, 0xaa, 0x62, 0xe1, 0x17, 0xa8, 0x62, 0xf1, 0x27, 0x55, 0xb1, 0xf4, 0xf2, 0xaa,
+	0x58, 0x3e, 0x5b, 0x15, 0xff, 0x8e, 0x96, 0xbc, 0xa7, 0x15, 0x1d, 0x10, 0x4f, 0x1f, 0xb3, 0x9d,
+	0xd7, 0xe2, 0xa7, 0x8f, 0x19, 0xce, 0x9b, 0x00, 0xe6, 0x8c, 0x2e, 0xd3, 0xf5, 0x3a, 0xc2, 0x9c,
+	0x36, 0xb8, 0x82, 0x61, 0x5f, 0xc0, 0x35, 0xe1, 0xf5, 0x44, 0xcc, 0x32, 0xf1, 0xe7, 0x93, 0x98,
+	0x6a, 0xc9, 0x5f, 0xbf, 0xb9, 0x22, 0x18, 0xc4, 0xab, 0xeb, 0x79, 0x2b, 0xa6, 0x1a, 0x3d, 0x68,
+	0x64, 0x32, 0x0a, 0xca, 0x0f, 0x03, 0x69, 0xea, 0x0f, 0x03, 0xe1, 0xd9, 0xc6, 0xf1, 0x53, 0x3b,
+	0xb0, 0xb7, 0xdc, 0xa4, 0x17, 0x04, 0xfc, 0xb9, 0x08, 0x35, 0xf7, 0xc8, 0xde, 0x85, 0xa2, 0x13,
+	0xd9, 0x8b, 0xf8, 0xc9, 0xc8, 0x95, 0xcd, 0xf4, 0x24, 0xbd, 0x15, 0x15, 0x4c, 0xc6, 0x9f, 0x6a,
+	0xa0, 0xaf, 0xd3, 0x94, 0x5f, 0x2f, 0xd2, 0xce, 0xf8, 0xf5, 0xa2, 0x5c, 0xa6, 0x93, 0x5b, 0x7e,
+	0x81, 0x28, 0xbd, 0x8c, 0x5d, 0x38, 0xe3, 0x32, 0x36, 0x7b, 0x13, 0x2a, 0x81, 0x4d, 0xbf, 0x18,
+	0x63, 0x35, 0x8b, 0x1b, 0x4c, 0x09, 0xcd, 0xf8, 0x5b, 0x1a, 0x94, 0x65, 0xa2, 0x74, 0xeb, 0x03,
+	0xa2, 0xb7, 0xa1, 0x2c, 0x7e, 0x3d, 0x26, 0x3c, 0xeb, 0xd4, 0x31, 0xa6, 0xe3, 0x29, 0x3a, 0x92,
+	0xb2, 0x0f, 0x3e, 0x30, 0xf7, 0xcd, 0x09, 0x8f, 0x1a, 0x48, 0xa7, 0x41, 0x94, 0x98, 0x14, 0x66,
+	0x58, 0x1c, 0x9a, 0x9b, 0x0b, 0x4c, 0x9c, 0x84, 0xc6, 0x57, 0x50, 0x96, 0x89, 0xd8, 0xad, 0x5d,
+	0x79, 0xd1, 0xaf, 0xcd, 0xec, 0x02, 0xa4, 0x99, 0xd9, 0xad, 0xf1, 0xd7, 0xdf, 0xd6, 0xe4, 0x9b,
+	0x29, 0x4c, 0xe5, 0xd0, 0xe5, 0xaa, 0xf7, 0xf1, 0x37, 0x2b, 0xe4, 0x2b, 0x30, 0xed, 0xec, 0x57,
+	0x60, 0x09, 0x13, 0x1e, 0x56, 0x89, 0x15, 0xd5, 0x91, 0x3f, 0xa8, 0x10, 0x83, 0xe8, 0x5c, 0x47,
+	0xe2, 0xf1, 0x72, 0xaf, 0x43, 0x73, 0x50, 0xe7, 0x29, 0x02, 0xbb, 0x43, 0x37, 0x68, 0x71, 0xd4,
+	0x75, 0x4e, 0x65, 0xa3, 0x15, 0x1f, 0xec, 0x93, 0x6a, 0x7d, 0x24, 0xaf, 0x01, 0x20, 0x2a, 0xd6,
+	0xaf, 0xf5, 0xce, 0x60, 0x9f, 0xb9, 0xc2, 0x66, 0xec, 0x40, 0x5d, 0x4d, 0x4c, 0xdd, 0xfd, 0x0c,
+	0xea, 0xea, 0x2f, 0x88, 0xd0, 0x19, 0x8b, 0xef, 0xd9, 0xe2, 0xa9, 0x50, 0xff, 0x77, 0x1f, 0x8b,
+	0xa7, 0x42, 0x7f, 0x1c, 0x46, 0x96, 0x38, 0x28, 0x1b, 0x79, 0xe6, 0x72, 0x79, 0xaa, 0xe7, 0xef,
+	0xfe, 0x35, 0xe5, 0xc1, 0x2e, 0xd5, 0x2c, 0x43, 0xfe, 0x9b, 0xee, 0x77, 0xe2, 0x5e, 0x4e, 0xbf,
+	0x37, 0xe8, 0xb6, 0xf8, 0x04, 0x61, 0xaa, 0xff, 0xb0, 0x35, 0x7a, 0x28, 0x9e, 0x1a, 0x49, 0x0a,
+	0x21, 0xf2, 0xe9, 0x9b, 0x17, 0xba, 0x87, 0x43, 0xc5, 0x24, 0x99, 0x53, 0xc4, 0x8a, 0x94, 0x67,
+	0x29, 0x61, 0xa2, 0x07, 0x4b, 0x09, 0xad, 0x7c, 0xf7, 0x6b, 0x68, 0x9e, 0x75, 0xa4, 0x82, 0x52,
+	0xdb, 0x0f, 0x5b, 0x74, 0x6c, 0x55, 0x87, 0xca, 0x60, 0x38, 0x11, 0x90, 0x86, 0x29, 0x72, 0xde,
+	0xed, 0x77, 0x29, 0x75, 0x76, 0xf7, 0x47, 0xf5, 0xdb, 0xc6, 0x29, 0xf8, 0x04, 0x21, 0x27, 0x41,
+	0x45, 0x71, 0xdb, 0xb4, 0x74, 0x8d, 0x5d, 0x01, 0x96, 0x41, 0xf5, 0xfd, 0x99, 0xe9, 0xea, 0x39,
+	0x4a, 0x92, 0xc5, 0xf8, 0x27, 0x81, 0x13, 0xd9, 0x7a, 0x9e, 0xbd, 0x0a, 0xd7, 0x12, 0x5c, 0xdf,
+	0x3f, 0x3e, 0x08, 0x1c, 0x7c, 0xf1, 0x7d, 0x2a, 0xc8, 0x85, 0xfd, 0x5f, 0xff, 0xbb, 0xdf, 0xdf,
+	0xd4, 0xfe, 0xd3, 0xef, 0x6f, 0x6a, 0x7f, 0xf5, 0xfb, 0x9b, 0xe7, 0xfe, 0xf4, 0x7f, 0xdc, 0xd4,
+	0xfe, 0x58, 0xfd, 0x9d, 0xc2, 0x85, 0x19, 0x05, 0xce, 0x89, 0xf0, 0xab, 0x31, 0xe0, 0xd9, 0xef,
+	0x2f, 0x9f, 0x1d, 0xbd, 0xbf, 0x9c, 0xbe, 0x8f, 0xdf, 0x79, 0x5a, 0xa2, 0x5f, 0x27, 0xfc, 0xe8,
+	0xff, 0x0e, 0x00, 0x83, 0x2c, 0xa8, 0x47, 0xf1, 0x50, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -10261,6 +11604,13 @@ func (m *PartitionItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PartitionTableName) > 0 {
+		i -= len(m.PartitionTableName)
+		copy(dAtA[i:], m.PartitionTableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableName)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.InValues) > 0 {
 		for iNdEx := len(m.InValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -10954,6 +12304,116 @@ func (m *OrderBySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrameBound) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Val != nil {
+		{
+			size, err := m.Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Unbounded {
+		i--
+		if m.Unbounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameClause) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameClause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameClause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -10978,15 +12438,17 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Lag != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Lead != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Lead))
+	if m.Frame != nil {
+		{
+			size, err := m.Frame.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
 	if len(m.OrderBy) > 0 {
 		for iNdEx := len(m.OrderBy) - 1; iNdEx >= 0; iNdEx-- {
@@ -10999,7 +12461,7 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPlan(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
 	if len(m.PartitionBy) > 0 {
@@ -11013,9 +12475,21 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPlan(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if m.WindowFunc != nil {
+		{
+			size, err := m.WindowFunc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -11043,6 +12517,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RowIdx != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.RowIdx))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IsReplace {
+		i--
+		if m.IsReplace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.ClusterTable != nil {
 		{
 			size, err := m.ClusterTable.MarshalToSizedBuffer(dAtA[:i])
@@ -11073,21 +12562,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA49 := make([]byte, len(m.IdxIdx)*10)
-		var j48 int
+		dAtA54 := make([]byte, len(m.IdxIdx)*10)
+		var j53 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j53++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA54[j53] = uint8(num)
+			j53++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPlan(dAtA, i, uint64(j48))
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintPlan(dAtA, i, uint64(j53))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -11132,21 +12621,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA52 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j51 int
+		dAtA57 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j56 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j56++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA57[j56] = uint8(num)
+			j56++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPlan(dAtA, i, uint64(j51))
+		i -= j56
+		copy(dAtA[i:], dAtA57[:j56])
+		i = encodeVarintPlan(dAtA, i, uint64(j56))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -11201,6 +12690,22 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PartitionIdx) > 0 {
+		for iNdEx := len(m.PartitionIdx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartitionIdx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.ParentIdx) > 0 {
 		for iNdEx := len(m.ParentIdx) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -11332,21 +12837,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA56 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j55 int
+		dAtA61 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j60 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j55++
+				j60++
 			}
-			dAtA56[j55] = uint8(num)
-			j55++
+			dAtA61[j60] = uint8(num)
+			j60++
 		}
-		i -= j55
-		copy(dAtA[i:], dAtA56[:j55])
-		i = encodeVarintPlan(dAtA, i, uint64(j55))
+		i -= j60
+		copy(dAtA[i:], dAtA61[:j60])
+		i = encodeVarintPlan(dAtA, i, uint64(j60))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -11365,21 +12870,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA58 := make([]byte, len(m.IdxIdx)*10)
-		var j57 int
+		dAtA63 := make([]byte, len(m.IdxIdx)*10)
+		var j62 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j57++
+				j62++
 			}
-			dAtA58[j57] = uint8(num)
-			j57++
+			dAtA63[j62] = uint8(num)
+			j62++
 		}
-		i -= j57
-		copy(dAtA[i:], dAtA58[:j57])
-		i = encodeVarintPlan(dAtA, i, uint64(j57))
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintPlan(dAtA, i, uint64(j62))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -11572,6 +13077,120 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for iNdEx := len(m.RuntimeFilterBuildList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterBuildList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for iNdEx := len(m.RuntimeFilterProbeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeFilterProbeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.IndexScan != nil {
+		{
+			size, err := m.IndexScan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.ScanTs != nil {
+		{
+			size, err := m.ScanTs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.LockTarget != nil {
+		{
+			size, err := m.LockTarget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PartitionPrune != nil {
+		{
+			size, err := m.PartitionPrune.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxRecursionDepth != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MaxRecursionDepth))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.UnionAll {
+		i--
+		if m.UnionAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.WindowIdx != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WindowIdx))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
 	if m.InsertCtx != nil {
 		{
 			size, err := m.InsertCtx.MarshalToSizedBuffer(dAtA[:i])
@@ -11650,21 +13269,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc2
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA63 := make([]byte, len(m.BindingTags)*10)
-		var j62 int
+		dAtA72 := make([]byte, len(m.BindingTags)*10)
+		var j71 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j71++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA72[j71] = uint8(num)
+			j71++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j71
+		copy(dAtA[i:], dAtA72[:j71])
+		i = encodeVarintPlan(dAtA, i, uint64(j71))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -11891,21 +13510,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.Children) > 0 {
-		dAtA73 := make([]byte, len(m.Children)*10)
-		var j72 int
+		dAtA82 := make([]byte, len(m.Children)*10)
+		var j81 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j81++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA82[j81] = uint8(num)
+			j81++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintPlan(dAtA, i, uint64(j72))
+		i -= j81
+		copy(dAtA[i:], dAtA82[:j81])
+		i = encodeVarintPlan(dAtA, i, uint64(j81))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -11948,6 +13567,203 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RuntimeFilterSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeFilterSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeFilterSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tag != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Tag))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionPrune) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionPrune) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionPrune) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		dAtA86 := make([]byte, len(m.Partitions)*10)
+		var j85 int
+		for _, num1 := range m.Partitions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j85++
+			}
+			dAtA86[j85] = uint8(num)
+			j85++
+		}
+		i -= j85
+		copy(dAtA[i:], dAtA86[:j85])
+		i = encodeVarintPlan(dAtA, i, uint64(j85))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexScan) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexScan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IndexName) > 0 {
+		i -= len(m.IndexName)
+		copy(dAtA[i:], m.IndexName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockTarget) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CompositeKeyPos) > 0 {
+		dAtA88 := make([]byte, len(m.CompositeKeyPos)*10)
+		var j87 int
+		for _, num1 := range m.CompositeKeyPos {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA88[j87] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j87++
+			}
+			dAtA88[j87] = uint8(num)
+			j87++
+		}
+		i -= j87
+		copy(dAtA[i:], dAtA88[:j87])
+		i = encodeVarintPlan(dAtA, i, uint64(j87))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PrimaryKeyPos != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.PrimaryKeyPos))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WaitPolicy != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.WaitPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mode != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TableId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TableId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IdList) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -11973,21 +13789,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA76 := make([]byte, len(m.List)*10)
-		var j75 int
+		dAtA90 := make([]byte, len(m.List)*10)
+		var j89 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA90[j89] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j75++
+				j89++
 			}
-			dAtA76[j75] = uint8(num)
-			j75++
+			dAtA90[j89] = uint8(num)
+			j89++
 		}
-		i -= j75
-		copy(dAtA[i:], dAtA76[:j75])
-		i = encodeVarintPlan(dAtA, i, uint64(j75))
+		i -= j89
+		copy(dAtA[i:], dAtA90[:j89])
+		i = encodeVarintPlan(dAtA, i, uint64(j89))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -12062,6 +13878,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PartitionIdx) > 0 {
+		for iNdEx := len(m.PartitionIdx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartitionIdx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.CanTruncate {
 		i--
 		if m.CanTruncate {
@@ -12129,21 +13959,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnCascadeIdx) > 0 {
-		dAtA78 := make([]byte, len(m.OnCascadeIdx)*10)
-		var j77 int
+		dAtA92 := make([]byte, len(m.OnCascadeIdx)*10)
+		var j91 int
 		for _, num1 := range m.OnCascadeIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j91++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA92[j91] = uint8(num)
+			j91++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintPlan(dAtA, i, uint64(j77))
+		i -= j91
+		copy(dAtA[i:], dAtA92[:j91])
+		i = encodeVarintPlan(dAtA, i, uint64(j91))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -12162,21 +13992,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA80 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j79 int
+		dAtA94 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j93 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j79++
+				j93++
 			}
-			dAtA80[j79] = uint8(num)
-			j79++
+			dAtA94[j93] = uint8(num)
+			j93++
 		}
-		i -= j79
-		copy(dAtA[i:], dAtA80[:j79])
-		i = encodeVarintPlan(dAtA, i, uint64(j79))
+		i -= j93
+		copy(dAtA[i:], dAtA94[:j93])
+		i = encodeVarintPlan(dAtA, i, uint64(j93))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -12195,21 +14025,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA82 := make([]byte, len(m.IdxIdx)*10)
-		var j81 int
+		dAtA96 := make([]byte, len(m.IdxIdx)*10)
+		var j95 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j95++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA96[j95] = uint8(num)
+			j95++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintPlan(dAtA, i, uint64(j81))
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintPlan(dAtA, i, uint64(j95))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12316,21 +14146,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA84 := make([]byte, len(m.Steps)*10)
-		var j83 int
+		dAtA98 := make([]byte, len(m.Steps)*10)
+		var j97 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j83++
+				j97++
 			}
-			dAtA84[j83] = uint8(num)
-			j83++
+			dAtA98[j97] = uint8(num)
+			j97++
 		}
-		i -= j83
-		copy(dAtA[i:], dAtA84[:j83])
-		i = encodeVarintPlan(dAtA, i, uint64(j83))
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintPlan(dAtA, i, uint64(j97))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -13481,6 +15311,27 @@ func (m *AlterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TableDef != nil {
 		{
 			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
@@ -13503,7 +15354,7 @@ func (m *AlterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AlterView) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAction) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13513,12 +15364,12 @@ func (m *AlterView) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AlterView) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AlterView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -13527,23 +15378,28 @@ func (m *AlterView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.IndexTables) > 0 {
-		for iNdEx := len(m.IndexTables) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IndexTables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
+	if m.Action != nil {
+		{
+			size := m.Action.ProtoSize()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x32
 		}
 	}
-	if m.TableDef != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableAction_AddColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_AddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddColumn != nil {
 		{
-			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AddColumn.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -13551,95 +15407,125 @@ func (m *AlterView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if m.Temporary {
-		i--
-		if m.Temporary {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_DropColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_DropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropColumn != nil {
+		{
+			size, err := m.DropColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.IfExists {
-		i--
-		if m.IfExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *DropTable) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAction_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropTable) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAction_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_RenameTable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAction_RenameTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ForeignTbl) > 0 {
-		dAtA115 := make([]byte, len(m.ForeignTbl)*10)
-		var j114 int
-		for _, num := range m.ForeignTbl {
-			for num >= 1<<7 {
-				dAtA115[j114] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j114++
+	if m.RenameTable != nil {
+		{
+			size, err := m.RenameTable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA115[j114] = uint8(num)
-			j114++
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
-		i -= j114
-		copy(dAtA[i:], dAtA115[:j114])
-		i = encodeVarintPlan(dAtA, i, uint64(j114))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x2a
 	}
-	if m.TableId != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.TableId))
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_AddIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_AddIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddIndex != nil {
+		{
+			size, err := m.AddIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x32
 	}
-	if m.ClusterTable != nil {
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_DropIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropIndex != nil {
 		{
-			size, err := m.ClusterTable.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DropIndex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -13647,45 +15533,74 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
 	}
-	if len(m.IndexTableNames) > 0 {
-		for iNdEx := len(m.IndexTableNames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.IndexTableNames[iNdEx])
-			copy(dAtA[i:], m.IndexTableNames[iNdEx])
-			i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexTableNames[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_AddPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_AddPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddPartition != nil {
+		{
+			size, err := m.AddPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x42
 	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_DropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_DropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropPartition != nil {
+		{
+			size, err := m.DropPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if m.IfExists {
-		i--
-		if m.IfExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAction_TruncatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAction_TruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TruncatePartition != nil {
+		{
+			size, err := m.TruncatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x52
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAddColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13695,12 +15610,12 @@ func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAddColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -13709,19 +15624,9 @@ func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TableExist {
-		i--
-		if m.TableExist {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Index != nil {
+	if m.Column != nil {
 		{
-			size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -13729,33 +15634,46 @@ func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if len(m.OriginTablePrimaryKey) > 0 {
-		i -= len(m.OriginTablePrimaryKey)
-		copy(dAtA[i:], m.OriginTablePrimaryKey)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginTablePrimaryKey)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableDropColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
-		i--
-		dAtA[i] = 0x12
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableDropColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableDropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableModifyColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13765,12 +15683,12 @@ func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AlterIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableModifyColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -13779,17 +15697,22 @@ func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DropIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableRenameColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -13799,12 +15722,12 @@ func (m *DropIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableRenameColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableRenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -13813,7 +15736,516 @@ func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.IndexTableName) > 0 {
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableRenameTable) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableRenameTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableRenameTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableAddPartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableAddPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAddPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Partition != nil {
+		{
+			size, err := m.Partition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableDropPartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableDropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableDropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Partition != nil {
+		{
+			size, err := m.Partition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableTruncatePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableTruncatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableTruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterView) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterView) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IndexTables) > 0 {
+		for iNdEx := len(m.IndexTables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexTables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TableDef != nil {
+		{
+			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Temporary {
+		i--
+		if m.Temporary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DropTable) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ForeignTbl) > 0 {
+		dAtA143 := make([]byte, len(m.ForeignTbl)*10)
+		var j142 int
+		for _, num := range m.ForeignTbl {
+			for num >= 1<<7 {
+				dAtA143[j142] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j142++
+			}
+			dAtA143[j142] = uint8(num)
+			j142++
+		}
+		i -= j142
+		copy(dAtA[i:], dAtA143[:j142])
+		i = encodeVarintPlan(dAtA, i, uint64(j142))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TableId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.TableId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClusterTable != nil {
+		{
+			size, err := m.ClusterTable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IndexTableNames) > 0 {
+		for iNdEx := len(m.IndexTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexTableNames[iNdEx])
+			copy(dAtA[i:], m.IndexTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TableExist {
+		i--
+		if m.TableExist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Index != nil {
+		{
+			size, err := m.Index.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OriginTablePrimaryKey) > 0 {
+		i -= len(m.OriginTablePrimaryKey)
+		copy(dAtA[i:], m.OriginTablePrimaryKey)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginTablePrimaryKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DropIndex) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IndexTableName) > 0 {
 		i -= len(m.IndexTableName)
 		copy(dAtA[i:], m.IndexTableName)
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexTableName)))
@@ -13868,21 +16300,30 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA119 := make([]byte, len(m.ForeignTbl)*10)
-		var j118 int
+		dAtA147 := make([]byte, len(m.ForeignTbl)*10)
+		var j146 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA119[j118] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA147[j146] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j118++
+				j146++
 			}
-			dAtA119[j118] = uint8(num)
-			j118++
+			dAtA147[j146] = uint8(num)
+			j146++
 		}
-		i -= j118
-		copy(dAtA[i:], dAtA119[:j118])
-		i = encodeVarintPlan(dAtA, i, uint64(j118))
+		i -= j146
+		copy(dAtA[i:], dAtA147[:j146])
+		i = encodeVarintPlan(dAtA, i, uint64(j146))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13959,20 +16400,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA122 := make([]byte, len(m.AccountIDs)*10)
-		var j121 int
+		dAtA150 := make([]byte, len(m.AccountIDs)*10)
+		var j149 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA122[j121] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA150[j149] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j121++
+				j149++
 			}
-			dAtA122[j121] = uint8(num)
-			j121++
+			dAtA150[j149] = uint8(num)
+			j149++
 		}
-		i -= j121
-		copy(dAtA[i:], dAtA122[:j121])
-		i = encodeVarintPlan(dAtA, i, uint64(j121))
+		i -= j149
+		copy(dAtA[i:], dAtA150[:j149])
+		i = encodeVarintPlan(dAtA, i, uint64(j149))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14184,21 +16625,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA126 := make([]byte, len(m.ParamTypes)*10)
-		var j125 int
+		dAtA154 := make([]byte, len(m.ParamTypes)*10)
+		var j153 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA126[j125] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA154[j153] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j125++
+				j153++
 			}
-			dAtA126[j125] = uint8(num)
-			j125++
+			dAtA154[j153] = uint8(num)
+			j153++
 		}
-		i -= j125
-		copy(dAtA[i:], dAtA126[:j125])
-		i = encodeVarintPlan(dAtA, i, uint64(j125))
+		i -= j153
+		copy(dAtA[i:], dAtA154[:j153])
+		i = encodeVarintPlan(dAtA, i, uint64(j153))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -15605,6 +18046,10 @@ func (m *PartitionItem) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	l = len(m.PartitionTableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -15883,12 +18328,61 @@ func (m *OrderBySpec) ProtoSize() (n int) {
 	return n
 }
 
+func (m *FrameBound) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Unbounded {
+		n += 2
+	}
+	if m.Val != nil {
+		l = m.Val.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrameClause) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Start != nil {
+		l = m.Start.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *WindowSpec) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.WindowFunc != nil {
+		l = m.WindowFunc.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if len(m.PartitionBy) > 0 {
 		for _, e := range m.PartitionBy {
 			l = e.ProtoSize()
@@ -15901,11 +18395,9 @@ func (m *WindowSpec) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
-	if m.Lead != 0 {
-		n += 1 + sovPlan(uint64(m.Lead))
-	}
-	if m.Lag != 0 {
-		n += 1 + sovPlan(uint64(m.Lag))
+	if m.Frame != nil {
+		l = m.Frame.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -15972,6 +18464,12 @@ func (m *InsertCtx) ProtoSize() (n int) {
 		l = m.ClusterTable.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.IsReplace {
+		n += 2
+	}
+	if m.RowIdx != 0 {
+		n += 1 + sovPlan(uint64(m.RowIdx))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16088,6 +18586,12 @@ func (m *UpdateCtx) ProtoSize() (n int) {
 			n += 2 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.PartitionIdx) > 0 {
+		for _, e := range m.PartitionIdx {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16286,6 +18790,132 @@ func (m *Node) ProtoSize() (n int) {
 		l = m.InsertCtx.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if m.WindowIdx != 0 {
+		n += 2 + sovPlan(uint64(m.WindowIdx))
+	}
+	if m.UnionAll {
+		n += 3
+	}
+	if m.MaxRecursionDepth != 0 {
+		n += 2 + sovPlan(uint64(m.MaxRecursionDepth))
+	}
+	if m.PartitionPrune != nil {
+		l = m.PartitionPrune.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.LockTarget != nil {
+		l = m.LockTarget.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.ScanTs != nil {
+		l = m.ScanTs.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.IndexScan != nil {
+		l = m.IndexScan.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if len(m.RuntimeFilterProbeList) > 0 {
+		for _, e := range m.RuntimeFilterProbeList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.RuntimeFilterBuildList) > 0 {
+		for _, e := range m.RuntimeFilterBuildList {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RuntimeFilterSpec) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tag != 0 {
+		n += 1 + sovPlan(uint64(m.Tag))
+	}
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionPrune) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexScan) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IndexName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LockTarget) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TableId != 0 {
+		n += 1 + sovPlan(uint64(m.TableId))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPlan(uint64(m.Mode))
+	}
+	if m.WaitPolicy != 0 {
+		n += 1 + sovPlan(uint64(m.WaitPolicy))
+	}
+	if m.PrimaryKeyPos != 0 {
+		n += 1 + sovPlan(uint64(m.PrimaryKeyPos))
+	}
+	if len(m.CompositeKeyPos) > 0 {
+		l = 0
+		for _, e := range m.CompositeKeyPos {
+			l += sovPlan(uint64(e))
+		}
+		n += 1 + sovPlan(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16409,6 +19039,12 @@ func (m *DeleteCtx) ProtoSize() (n int) {
 	if m.CanTruncate {
 		n += 2
 	}
+	if len(m.PartitionIdx) > 0 {
+		for _, e := range m.PartitionIdx {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17033,6 +19669,301 @@ func (m *AlterTable) ProtoSize() (n int) {
 		l = m.TableDef.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableAction) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != nil {
+		n += m.Action.ProtoSize()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableAction_AddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddColumn != nil {
+		l = m.AddColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_DropColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropColumn != nil {
+		l = m.DropColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_ModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_RenameTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameTable != nil {
+		l = m.RenameTable.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_AddIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddIndex != nil {
+		l = m.AddIndex.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_DropIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropIndex != nil {
+		l = m.DropIndex.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_AddPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddPartition != nil {
+		l = m.AddPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_DropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropPartition != nil {
+		l = m.DropPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAction_TruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TruncatePartition != nil {
+		l = m.TruncatePartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableDropColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableRenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableRenameTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableAddPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partition != nil {
+		l = m.Partition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableDropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partition != nil {
+		l = m.Partition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableTruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17112,6 +20043,12 @@ func (m *DropTable) ProtoSize() (n int) {
 		}
 		n += 1 + sovPlan(uint64(l)) + l
 	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17227,6 +20164,12 @@ func (m *TruncateTable) ProtoSize() (n int) {
 		}
 		n += 1 + sovPlan(uint64(l)) + l
 	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -23116,6 +26059,38 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -24736,7 +27711,7 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WindowSpec) Unmarshal(dAtA []byte) error {
+func (m *FrameBound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24759,15 +27734,54 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameBound_BoundType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbounded = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24794,14 +27808,86 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionBy = append(m.PartitionBy, &Expr{})
-			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Val == nil {
+				m.Val = &Expr{}
+			}
+			if err := m.Val.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameClause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameClause_FrameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24828,16 +27914,18 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
-			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Start == nil {
+				m.Start = &FrameBound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.Lead = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -24847,30 +27935,28 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Lead |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.Lag = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lag |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &FrameBound{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -24893,7 +27979,7 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertCtx) Unmarshal(dAtA []byte) error {
+func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24916,15 +28002,15 @@ func (m *InsertCtx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertCtx: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowFunc", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24951,16 +28037,207 @@ func (m *InsertCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ref == nil {
-				m.Ref = &ObjectRef{}
+			if m.WindowFunc == nil {
+				m.WindowFunc = &Expr{}
 			}
-			if err := m.Ref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WindowFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionBy = append(m.PartitionBy, &Expr{})
+			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Frame == nil {
+				m.Frame = &FrameClause{}
+			}
+			if err := m.Frame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ref == nil {
+				m.Ref = &ObjectRef{}
+			}
+			if err := m.Ref.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25458,6 +28735,45 @@ func (m *InsertCtx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReplace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReplace = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowIdx", wireType)
+			}
+			m.RowIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -26171,6 +29487,40 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionIdx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionIdx = append(m.PartitionIdx, &ColPosMap{})
+			if err := m.PartitionIdx[len(m.PartitionIdx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -27538,187 +30888,67 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IdList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowIdx", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IdList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.WindowIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				m.List = append(m.List, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnionAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.List) == 0 {
-					m.List = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.List = append(m.List, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ColPosMap) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.UnionAll = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecursionDepth", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxRecursionDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecursionDepth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ColPosMap: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColPosMap: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionPrune", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27745,89 +30975,188 @@ func (m *ColPosMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Map == nil {
-				m.Map = make(map[string]int32)
+			if m.PartitionPrune == nil {
+				m.PartitionPrune = &PartitionPrune{}
 			}
-			var mapkey string
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.PartitionPrune.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTarget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPlan
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPlan
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPlan(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPlan
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Map[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockTarget == nil {
+				m.LockTarget = &LockTarget{}
+			}
+			if err := m.LockTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScanTs == nil {
+				m.ScanTs = &timestamp.Timestamp{}
+			}
+			if err := m.ScanTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexScan == nil {
+				m.IndexScan = &IndexScan{}
+			}
+			if err := m.IndexScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27851,7 +31180,7 @@ func (m *ColPosMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
+func (m *RuntimeFilterSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27874,17 +31203,17 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCtx: wiretype end group for non-group")
+			return fmt.Errorf("proto: RuntimeFilterSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RuntimeFilterSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			m.Tag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -27894,29 +31223,14 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Tag |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ref = append(m.Ref, &ObjectRef{})
-			if err := m.Ref[len(m.Ref)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdxRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27943,12 +31257,65 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdxRef = append(m.IdxRef, &ObjectRef{})
-			if err := m.IdxRef[len(m.IdxRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionPrune) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionPrune: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionPrune: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType == 0 {
 				var v int32
 				for shift := uint(0); ; shift += 7 {
@@ -27965,7 +31332,7 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.IdxIdx = append(m.IdxIdx, v)
+				m.Partitions = append(m.Partitions, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -28000,8 +31367,8 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.IdxIdx) == 0 {
-					m.IdxIdx = make([]int32, 0, elementCount)
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]int32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v int32
@@ -28019,16 +31386,67 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.IdxIdx = append(m.IdxIdx, v)
+					m.Partitions = append(m.Partitions, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdxIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexScan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexScan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexScan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnRestrictRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28038,107 +31456,29 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnRestrictRef = append(m.OnRestrictRef, &ObjectRef{})
-			if err := m.OnRestrictRef[len(m.OnRestrictRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.OnRestrictIdx = append(m.OnRestrictIdx, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.OnRestrictIdx) == 0 {
-					m.OnRestrictIdx = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.OnRestrictIdx = append(m.OnRestrictIdx, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnRestrictIdx", wireType)
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnCascadeRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28148,56 +31488,181 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnCascadeRef = append(m.OnCascadeRef, &ObjectRef{})
-			if err := m.OnCascadeRef[len(m.OnCascadeRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.OnCascadeIdx = append(m.OnCascadeIdx, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= LockTarget_LockMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitPolicy", wireType)
+			}
+			m.WaitPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitPolicy |= LockTarget_WaitPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeyPos", wireType)
+			}
+			m.PrimaryKeyPos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryKeyPos |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CompositeKeyPos = append(m.CompositeKeyPos, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -28220,8 +31685,8 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.OnCascadeIdx) == 0 {
-					m.OnCascadeIdx = make([]int32, 0, elementCount)
+				if elementCount != 0 && len(m.CompositeKeyPos) == 0 {
+					m.CompositeKeyPos = make([]int32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v int32
@@ -28239,14 +31704,2153 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.OnCascadeIdx = append(m.OnCascadeIdx, v)
+					m.CompositeKeyPos = append(m.CompositeKeyPos, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnCascadeIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeKeyPos", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.List = append(m.List, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.List) == 0 {
+					m.List = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.List = append(m.List, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColPosMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColPosMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColPosMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Map == nil {
+				m.Map = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPlan
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPlan
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPlan(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPlan
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Map[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = append(m.Ref, &ObjectRef{})
+			if err := m.Ref[len(m.Ref)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdxRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdxRef = append(m.IdxRef, &ObjectRef{})
+			if err := m.IdxRef[len(m.IdxRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.IdxIdx = append(m.IdxIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IdxIdx) == 0 {
+					m.IdxIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.IdxIdx = append(m.IdxIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdxIdx", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnRestrictRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnRestrictRef = append(m.OnRestrictRef, &ObjectRef{})
+			if err := m.OnRestrictRef[len(m.OnRestrictRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OnRestrictIdx = append(m.OnRestrictIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OnRestrictIdx) == 0 {
+					m.OnRestrictIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OnRestrictIdx = append(m.OnRestrictIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnRestrictIdx", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnCascadeRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnCascadeRef = append(m.OnCascadeRef, &ObjectRef{})
+			if err := m.OnCascadeRef[len(m.OnCascadeRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OnCascadeIdx = append(m.OnCascadeIdx, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OnCascadeIdx) == 0 {
+					m.OnCascadeIdx = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OnCascadeIdx = append(m.OnCascadeIdx, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnCascadeIdx", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnSetRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnSetRef = append(m.OnSetRef, &ObjectRef{})
+			if err := m.OnSetRef[len(m.OnSetRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnSetDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnSetDef = append(m.OnSetDef, &TableDef{})
+			if err := m.OnSetDef[len(m.OnSetDef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnSetIdx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnSetIdx = append(m.OnSetIdx, &IdList{})
+			if err := m.OnSetIdx[len(m.OnSetIdx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnSetUpdateCol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnSetUpdateCol = append(m.OnSetUpdateCol, &ColPosMap{})
+			if err := m.OnSetUpdateCol[len(m.OnSetUpdateCol)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanTruncate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanTruncate = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionIdx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionIdx = append(m.PartitionIdx, &ColPosMap{})
+			if err := m.PartitionIdx[len(m.PartitionIdx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
+			}
+			m.StmtType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StmtType |= Query_StatementType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Steps = append(m.Steps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Steps) == 0 {
+					m.Steps = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Steps = append(m.Steps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = append(m.Params, &Expr{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadTag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LoadTag = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
+			}
+			m.TclType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TclType |= TransationControl_TclType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationBegin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TransationControl_Begin{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationCommit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TransationControl_Commit{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationRollback{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TransationControl_Rollback{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= TransationBegin_TransationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			}
+			m.CompletionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			}
+			m.CompletionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Query{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Query{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tcl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TransationControl{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Tcl{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ddl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DataDefinition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Ddl{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dcl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DataControl{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Dcl{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Column) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: column: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: column: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Column = append(m.Column, &Expr{})
+			if err := m.Column[len(m.Column)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DclType", wireType)
+			}
+			m.DclType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DclType |= DataControl_DclType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetVariables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SetVariables{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_SetVariables{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Prepare{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Prepare{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Execute{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Execute{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Deallocate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Deallocate{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
+			}
+			m.DdlType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &Query{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnSetRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28273,14 +33877,15 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnSetRef = append(m.OnSetRef, &ObjectRef{})
-			if err := m.OnSetRef[len(m.OnSetRef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CreateDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_CreateDatabase{v}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnSetDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28307,14 +33912,15 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnSetDef = append(m.OnSetDef, &TableDef{})
-			if err := m.OnSetDef[len(m.OnSetDef)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AlterDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_AlterDatabase{v}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnSetIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28341,14 +33947,15 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnSetIdx = append(m.OnSetIdx, &IdList{})
-			if err := m.OnSetIdx[len(m.OnSetIdx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DropDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_DropDatabase{v}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnSetUpdateCol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28375,16 +33982,17 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnSetUpdateCol = append(m.OnSetUpdateCol, &ColPosMap{})
-			if err := m.OnSetUpdateCol[len(m.OnSetUpdateCol)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CreateTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_CreateTable{v}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanTruncate", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28394,68 +34002,32 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CanTruncate = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Query) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &AlterTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
+			m.Definition = &DataDefinition_AlterTable{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
 			}
-			m.StmtType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28465,90 +34037,30 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StmtType |= Query_StatementType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Steps = append(m.Steps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Steps) == 0 {
-					m.Steps = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Steps = append(m.Steps, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-		case 3:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DropTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_DropTable{v}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28575,14 +34087,15 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &Node{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &CreateIndex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_CreateIndex{v}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28609,16 +34122,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, &Expr{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AlterIndex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_AlterIndex{v}
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28628,29 +34142,32 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
+			v := &DropIndex{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_DropIndex{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoadTag", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28660,68 +34177,32 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LoadTag = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransationControl) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &TruncateTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
+			m.Definition = &DataDefinition_TruncateTable{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
 			}
-			m.TclType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28731,14 +34212,30 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TclType |= TransationControl_TclType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShowVariables{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_ShowVariables{v}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterView", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28765,15 +34262,15 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationBegin{}
+			v := &AlterView{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Begin{v}
+			m.Definition = &DataDefinition_AlterView{v}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28800,15 +34297,15 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationCommit{}
+			v := &LockTables{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Commit{v}
+			m.Definition = &DataDefinition_LockTables{v}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28835,11 +34332,11 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationRollback{}
+			v := &UnLockTables{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Rollback{v}
+			m.Definition = &DataDefinition_UnlockTables{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28863,7 +34360,7 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransationBegin) Unmarshal(dAtA []byte) error {
+func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28886,17 +34383,17 @@ func (m *TransationBegin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransationBegin: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateDatabase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
 			}
-			m.Mode = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28906,67 +34403,17 @@ func (m *TransationBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= TransationBegin_TransationMode(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransationCommit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransationCommit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationCommit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			m.IfNotExists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			m.CompletionType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28976,81 +34423,24 @@ func (m *TransationCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransationRollback) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransationRollback: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationRollback: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.CompletionType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -29073,7 +34463,7 @@ func (m *TransationRollback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plan) Unmarshal(dAtA []byte) error {
+func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29096,17 +34486,17 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterDatabase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29116,102 +34506,17 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &Query{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Query{v}
-			iNdEx = postIndex
+			m.IfExists = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tcl", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &TransationControl{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Tcl{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ddl", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DataDefinition{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Ddl{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dcl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29221,26 +34526,23 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DataControl{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Dcl{v}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29264,7 +34566,7 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Column) Unmarshal(dAtA []byte) error {
+func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29287,17 +34589,37 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: column: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropDatabase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: column: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfExists = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29307,25 +34629,23 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Column = append(m.Column, &Expr{})
-			if err := m.Column[len(m.Column)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29349,7 +34669,7 @@ func (m *Column) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataControl) Unmarshal(dAtA []byte) error {
+func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29372,17 +34692,17 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DclType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
 			}
-			m.DclType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29392,14 +34712,67 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DclType |= DataControl_DclType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IfNotExists = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Temporary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Temporary = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetVariables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29426,15 +34799,16 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SetVariables{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_SetVariables{v}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTables", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29461,17 +34835,16 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Prepare{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IndexTables = append(m.IndexTables, &TableDef{})
+			if err := m.IndexTables[len(m.IndexTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Prepare{v}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FkDbs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29481,30 +34854,59 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Execute{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FkDbs = append(m.FkDbs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FkTables", wireType)
 			}
-			m.Control = &DataControl_Execute{v}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29531,11 +34933,10 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Deallocate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FkCols = append(m.FkCols, &CreateTable_FkColName{})
+			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Deallocate{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29559,7 +34960,7 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataDefinition) Unmarshal(dAtA []byte) error {
+func (m *CreateTable_FkColName) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29582,36 +34983,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: FkColName: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FkColName: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
-			}
-			m.DdlType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29621,33 +35003,80 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &Query{}
-			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29657,30 +35086,27 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_CreateDatabase{v}
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29707,17 +35133,18 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterDatabase{v}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29727,30 +35154,27 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_DropDatabase{v}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29777,15 +35201,65 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateTable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Actions = append(m.Actions, &AlterTableAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateTable{v}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29812,15 +35286,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTable{}
+			v := &AlterTableAddColumn{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterTable{v}
+			m.Action = &AlterTableAction_AddColumn{v}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29847,15 +35321,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropTable{}
+			v := &AlterTableDropColumn{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropTable{v}
+			m.Action = &AlterTableAction_DropColumn{v}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29882,15 +35356,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateIndex{}
+			v := &AlterTableModifyColumn{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateIndex{v}
+			m.Action = &AlterTableAction_ModifyColumn{v}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29917,15 +35391,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterIndex{}
+			v := &AlterTableRenameColumn{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterIndex{v}
+			m.Action = &AlterTableAction_RenameColumn{v}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29952,15 +35426,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropIndex{}
+			v := &AlterTableRenameTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropIndex{v}
+			m.Action = &AlterTableAction_RenameTable{v}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29987,15 +35461,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TruncateTable{}
+			v := &CreateIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_TruncateTable{v}
+			m.Action = &AlterTableAction_AddIndex{v}
 			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30022,15 +35496,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ShowVariables{}
+			v := &DropIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_ShowVariables{v}
+			m.Action = &AlterTableAction_DropIndex{v}
 			iNdEx = postIndex
-		case 14:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterView", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddPartition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30057,15 +35531,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterView{}
+			v := &AlterTableAddPartition{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterView{v}
+			m.Action = &AlterTableAction_AddPartition{v}
 			iNdEx = postIndex
-		case 15:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPartition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30092,15 +35566,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &LockTables{}
+			v := &AlterTableDropPartition{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_LockTables{v}
+			m.Action = &AlterTableAction_DropPartition{v}
 			iNdEx = postIndex
-		case 16:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatePartition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30127,11 +35601,11 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UnLockTables{}
+			v := &AlterTableTruncatePartition{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_UnlockTables{v}
+			m.Action = &AlterTableAction_TruncatePartition{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30155,7 +35629,7 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAddColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30178,37 +35652,17 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAddColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAddColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IfNotExists = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -30218,23 +35672,27 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			if m.Column == nil {
+				m.Column = &ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30258,7 +35716,7 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30281,35 +35739,15 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IfExists = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30337,7 +35775,7 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30361,7 +35799,7 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTableModifyColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30384,37 +35822,17 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableModifyColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableModifyColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IfExists = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -30424,23 +35842,27 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			if m.Column == nil {
+				m.Column = &ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30464,7 +35886,7 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTable) Unmarshal(dAtA []byte) error {
+func (m *AlterTableRenameColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30487,157 +35909,15 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTable: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableRenameColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableRenameColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IfNotExists = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Temporary", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Temporary = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Database = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTables", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IndexTables = append(m.IndexTables, &TableDef{})
-			if err := m.IndexTables[len(m.IndexTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkDbs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30665,11 +35945,11 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkDbs = append(m.FkDbs, string(dAtA[iNdEx:postIndex]))
+			m.OldName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30697,41 +35977,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FkCols = append(m.FkCols, &CreateTable_FkColName{})
-			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30755,7 +36001,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTable_FkColName) Unmarshal(dAtA []byte) error {
+func (m *AlterTableRenameTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30778,15 +36024,15 @@ func (m *CreateTable_FkColName) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FkColName: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableRenameTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FkColName: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableRenameTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30814,7 +36060,39 @@ func (m *CreateTable_FkColName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			m.OldName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30838,7 +36116,7 @@ func (m *CreateTable_FkColName) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTable) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30861,15 +36139,51 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTable: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAddPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAddPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Partition == nil {
+				m.Partition = &PartitionByDef{}
+			}
+			if err := m.Partition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30897,11 +36211,62 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableDropPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDropPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDropPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30928,12 +36293,127 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
+			if m.Partition == nil {
+				m.Partition = &PartitionByDef{}
 			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Partition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableTruncatePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableTruncatePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableTruncatePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31458,6 +36938,38 @@ func (m *DropTable) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ForeignTbl", wireType)
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -32201,6 +37713,38 @@ func (m *TruncateTable) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ForeignTbl", wireType)
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
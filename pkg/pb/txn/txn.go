@@ -152,6 +152,11 @@ func (m TxnMeta) DebugString() string {
 	return buffer.String()
 }
 
+// IsPessimistic returns true if the txn is in pessimistic mode
+func (m TxnMeta) IsPessimistic() bool {
+	return m.Mode == TxnMode_Pessimistic
+}
+
 // GetTargetDN return dn shard ID that message need send to.
 func (m TxnRequest) GetTargetDN() metadata.DNShard {
 	switch m.Method {
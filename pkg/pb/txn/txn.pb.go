@@ -98,6 +98,35 @@ func (TxnStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_4f782e76b37adb9a, []int{0}
 }
 
+// TxnMode transaction mode
+type TxnMode int32
+
+const (
+	// Optimistic check conflicts when the transaction is committed
+	TxnMode_Optimistic TxnMode = 0
+	// Pessimistic use the lockservice to lock the rows to be modified or read with
+	// FOR UPDATE, conflicts are resolved by waiting for the locks to be released.
+	TxnMode_Pessimistic TxnMode = 1
+)
+
+var TxnMode_name = map[int32]string{
+	0: "Optimistic",
+	1: "Pessimistic",
+}
+
+var TxnMode_value = map[string]int32{
+	"Optimistic":  0,
+	"Pessimistic": 1,
+}
+
+func (x TxnMode) String() string {
+	return proto.EnumName(TxnMode_name, int32(x))
+}
+
+func (TxnMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4f782e76b37adb9a, []int{1}
+}
+
 // TxnMethod transaction operations
 type TxnMethod int32
 
@@ -170,7 +199,7 @@ func (x TxnMethod) String() string {
 }
 
 func (TxnMethod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4f782e76b37adb9a, []int{1}
+	return fileDescriptor_4f782e76b37adb9a, []int{2}
 }
 
 // TxnMeta transaction metadata
@@ -190,10 +219,12 @@ type TxnMeta struct {
 	CommitTS timestamp.Timestamp `protobuf:"bytes,5,opt,name=CommitTS,proto3" json:"CommitTS"`
 	// DNShards all DNShards that have written data. The first DN is the coordinator of the
 	// transaction
-	DNShards             []metadata.DNShard `protobuf:"bytes,6,rep,name=DNShards,proto3" json:"DNShards"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	DNShards []metadata.DNShard `protobuf:"bytes,6,rep,name=DNShards,proto3" json:"DNShards"`
+	// Mode transaction mode
+	Mode                 TxnMode  `protobuf:"varint,7,opt,name=Mode,proto3,enum=txn.TxnMode" json:"Mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TxnMeta) Reset()         { *m = TxnMeta{} }
@@ -271,6 +302,13 @@ func (m *TxnMeta) GetDNShards() []metadata.DNShard {
 	return nil
 }
 
+func (m *TxnMeta) GetMode() TxnMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TxnMode_Optimistic
+}
+
 // CNTxnSnapshot snapshot of the cn txn operation.
 type CNTxnSnapshot struct {
 	// ID txn id
@@ -1540,6 +1578,7 @@ func (m *TxnError) GetTxnErrCode() uint32 {
 
 func init() {
 	proto.RegisterEnum("txn.TxnStatus", TxnStatus_name, TxnStatus_value)
+	proto.RegisterEnum("txn.TxnMode", TxnMode_name, TxnMode_value)
 	proto.RegisterEnum("txn.TxnMethod", TxnMethod_name, TxnMethod_value)
 	proto.RegisterType((*TxnMeta)(nil), "txn.TxnMeta")
 	proto.RegisterType((*CNTxnSnapshot)(nil), "txn.CNTxnSnapshot")
@@ -1568,79 +1607,82 @@ func init() {
 func init() { proto.RegisterFile("txn.proto", fileDescriptor_4f782e76b37adb9a) }
 
 var fileDescriptor_4f782e76b37adb9a = []byte{
-	// 1151 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xeb, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0x73, 0x75, 0x4e, 0x2e, 0xeb, 0x9e, 0xde, 0xbc, 0xa5, 0x64, 0x2b, 0x6b, 0xb5, 0x0a,
-	0x15, 0x24, 0x6c, 0x57, 0x20, 0x04, 0x52, 0xa5, 0x36, 0x69, 0x4b, 0x25, 0x7a, 0xd1, 0x24, 0x80,
-	0x96, 0x3f, 0xc8, 0x69, 0x86, 0xd4, 0xda, 0xc4, 0x36, 0xce, 0xb4, 0x4a, 0x9f, 0x85, 0xdf, 0xf0,
-	0x2c, 0xfb, 0x73, 0x9f, 0x00, 0x41, 0x25, 0x9e, 0x80, 0x17, 0x40, 0x33, 0x9e, 0x49, 0x32, 0x4e,
-	0xb2, 0x2b, 0x95, 0x7f, 0x3e, 0xb7, 0xef, 0x8c, 0xbf, 0x9c, 0xef, 0x78, 0x02, 0x05, 0x36, 0xf6,
-	0xeb, 0x61, 0x14, 0xb0, 0x00, 0xd3, 0x6c, 0xec, 0x6f, 0x7f, 0xd6, 0xf7, 0xd8, 0xcd, 0x6d, 0xb7,
-	0x7e, 0x1d, 0x0c, 0x1b, 0xfd, 0xa0, 0x1f, 0x34, 0x44, 0xac, 0x7b, 0xfb, 0x8b, 0xb0, 0x84, 0x21,
-	0x9e, 0xe2, 0x9a, 0xed, 0x27, 0xcc, 0x1b, 0xd2, 0x11, 0x73, 0x87, 0xa1, 0x74, 0x54, 0x86, 0x94,
-	0xb9, 0x3d, 0x97, 0xb9, 0xb1, 0xed, 0xfc, 0x91, 0x82, 0x7c, 0x67, 0xec, 0x9f, 0x53, 0xe6, 0x62,
-	0x05, 0x52, 0x67, 0x2d, 0xdb, 0xd8, 0x35, 0x6a, 0x25, 0x92, 0x3a, 0x6b, 0xe1, 0x0b, 0xc8, 0xb5,
-	0x99, 0xcb, 0x6e, 0x47, 0x76, 0x6a, 0xd7, 0xa8, 0x55, 0xf6, 0x2b, 0x75, 0x7e, 0x98, 0xce, 0xd8,
-	0x8f, 0xbd, 0x44, 0x46, 0xf1, 0x6b, 0x80, 0xb6, 0xef, 0x86, 0xa3, 0x9b, 0x80, 0x75, 0xda, 0x76,
-	0x7a, 0xd7, 0xa8, 0x15, 0xf7, 0xd7, 0xeb, 0xd3, 0xce, 0x1d, 0xf5, 0x74, 0x94, 0x79, 0xfb, 0xe7,
-	0xb3, 0x15, 0x32, 0x93, 0xcd, 0x6b, 0xaf, 0x22, 0x1a, 0xba, 0x11, 0xed, 0x75, 0xda, 0x76, 0xe6,
-	0xc3, 0xb5, 0xd3, 0x6c, 0xfc, 0x12, 0xcc, 0x66, 0x30, 0x1c, 0x7a, 0xbc, 0x6b, 0xf6, 0x83, 0x95,
-	0x93, 0x5c, 0x7c, 0x05, 0x66, 0xeb, 0xa2, 0x7d, 0xe3, 0x46, 0xbd, 0x91, 0x9d, 0xdb, 0x4d, 0xd7,
-	0x8a, 0xfb, 0xab, 0xf5, 0x09, 0x2d, 0x32, 0xa2, 0x8a, 0x54, 0xa2, 0xf3, 0xbb, 0x01, 0xe5, 0xe6,
-	0x05, 0x7f, 0x79, 0x79, 0x78, 0x7c, 0x0e, 0xe9, 0xce, 0xd8, 0x17, 0x7c, 0x15, 0xf7, 0x4b, 0x8a,
-	0x1b, 0xce, 0xa4, 0x2c, 0xe6, 0x61, 0xdc, 0x81, 0x02, 0xa1, 0x6e, 0xef, 0xfe, 0xd2, 0x1f, 0xdc,
-	0x0b, 0x1e, 0x4d, 0x32, 0x75, 0xe0, 0x1e, 0x58, 0xc7, 0xbe, 0xdb, 0x1d, 0xd0, 0xa6, 0x7b, 0x7d,
-	0x43, 0x7f, 0x8c, 0x3c, 0x46, 0x05, 0x81, 0x26, 0x99, 0xf3, 0xe3, 0x73, 0x28, 0xb7, 0xbc, 0x11,
-	0x77, 0xbe, 0xbc, 0x6a, 0x5e, 0x86, 0x4c, 0xb0, 0x65, 0x12, 0xdd, 0xe9, 0x84, 0x50, 0x6c, 0x5e,
-	0x5c, 0x86, 0x84, 0xfe, 0x7a, 0x4b, 0x47, 0x0c, 0x37, 0x21, 0x77, 0x19, 0x36, 0x83, 0x1e, 0x15,
-	0xe7, 0x2c, 0x13, 0x69, 0xa1, 0x0d, 0xf9, 0x2b, 0xf7, 0x7e, 0x10, 0xb8, 0x3d, 0x71, 0xa8, 0x12,
-	0x51, 0x26, 0x36, 0x20, 0xd7, 0x71, 0xa3, 0x3e, 0x65, 0xf2, 0x97, 0x5c, 0xca, 0x8d, 0x4c, 0x73,
-	0x6a, 0x50, 0x8a, 0x3b, 0x8e, 0xc2, 0xc0, 0x1f, 0x69, 0xd0, 0x86, 0x06, 0xed, 0xfc, 0x93, 0x05,
-	0xe8, 0x8c, 0x7d, 0x75, 0x36, 0x41, 0x8d, 0x78, 0x94, 0x63, 0x97, 0x21, 0x53, 0x87, 0xa2, 0x37,
-	0xf5, 0x7e, 0x7a, 0x5f, 0x40, 0xee, 0x9c, 0xb2, 0x9b, 0xa0, 0x27, 0x4e, 0x3b, 0x33, 0xa3, 0xb1,
-	0x97, 0xc8, 0x28, 0x22, 0x64, 0x4e, 0x06, 0x6e, 0x5f, 0x70, 0x56, 0x26, 0xe2, 0x19, 0xeb, 0x50,
-	0x68, 0x5e, 0xc8, 0x86, 0x72, 0x80, 0x2c, 0x51, 0x3e, 0x43, 0x20, 0x99, 0xa6, 0xe0, 0x37, 0x50,
-	0x8e, 0x67, 0x48, 0xd5, 0xe4, 0x44, 0xcd, 0x86, 0x6a, 0xa9, 0x05, 0x89, 0x9e, 0x8b, 0x87, 0xf0,
-	0x84, 0x04, 0x83, 0x41, 0xd7, 0xbd, 0x7e, 0xa3, 0xca, 0xf3, 0xa2, 0x7c, 0x4b, 0x95, 0x27, 0xc2,
-	0x24, 0x99, 0x8f, 0x07, 0x50, 0x91, 0xd3, 0xaf, 0x10, 0x4c, 0x81, 0xb0, 0xa9, 0x10, 0xf4, 0x28,
-	0x49, 0x64, 0x63, 0x0b, 0xac, 0x53, 0xca, 0xa4, 0x78, 0x25, 0x42, 0x41, 0x20, 0xd8, 0x0a, 0x21,
-	0x19, 0x27, 0x73, 0x15, 0x78, 0x05, 0xeb, 0xf1, 0x9b, 0xc9, 0x69, 0x50, 0x48, 0x20, 0x90, 0x76,
-	0x74, 0x32, 0xf4, 0x1c, 0xb2, 0xb0, 0x12, 0x7f, 0x80, 0x4d, 0xf5, 0xaa, 0x09, 0xcc, 0xa2, 0xc0,
-	0xac, 0x26, 0x19, 0x4a, 0xa0, 0x2e, 0xa9, 0xc6, 0x63, 0xa8, 0x10, 0x3a, 0x0c, 0xee, 0xe8, 0xb9,
-	0x1c, 0x60, 0xbb, 0x24, 0xf0, 0x3e, 0x9e, 0xe0, 0x69, 0xd1, 0x09, 0x6d, 0xba, 0x1b, 0x3f, 0x87,
-	0xfc, 0x65, 0xc8, 0xbc, 0xc0, 0x1f, 0xd9, 0x65, 0x9d, 0x6f, 0x59, 0x21, 0xa3, 0x44, 0xa5, 0x39,
-	0xaf, 0x61, 0x75, 0x2e, 0x8a, 0x55, 0x00, 0x42, 0x59, 0x74, 0xcf, 0xe5, 0x37, 0xb2, 0x8d, 0xdd,
-	0x74, 0x2d, 0x4b, 0x66, 0x3c, 0x5c, 0xde, 0xc2, 0x3a, 0xf3, 0x19, 0x8d, 0xee, 0xdc, 0x81, 0x98,
-	0xfc, 0x34, 0xd1, 0x9d, 0xce, 0xbf, 0x59, 0x28, 0x0a, 0x6c, 0x29, 0xb6, 0xf7, 0x6b, 0xa8, 0xba,
-	0x54, 0x43, 0xff, 0x5f, 0x3d, 0x9f, 0x80, 0xd9, 0x19, 0xfb, 0xc7, 0x51, 0x14, 0x44, 0x52, 0x3c,
-	0x65, 0x55, 0x2d, 0x9c, 0x64, 0x12, 0xc6, 0x2f, 0xf4, 0x0d, 0x21, 0x75, 0xb3, 0x3a, 0xa3, 0xb5,
-	0x38, 0x40, 0xf4, 0x45, 0x72, 0x00, 0x15, 0xa5, 0x21, 0x59, 0x98, 0xd7, 0xf9, 0xd7, 0xa3, 0x24,
-	0x91, 0xcd, 0xe7, 0x7d, 0x2a, 0x21, 0x89, 0x60, 0xea, 0xf3, 0x9e, 0x8c, 0x93, 0xb9, 0x0a, 0x2e,
-	0xdc, 0x89, 0x8e, 0x24, 0x48, 0x41, 0x17, 0x6e, 0x22, 0x4c, 0x92, 0xf9, 0x78, 0x0a, 0xab, 0x33,
-	0x32, 0x92, 0x20, 0xb1, 0x5e, 0x9e, 0x2e, 0x50, 0x9e, 0x84, 0x99, 0xaf, 0xc1, 0x36, 0x6c, 0x24,
-	0x14, 0x24, 0xc1, 0x8a, 0xfa, 0x60, 0x2f, 0x4c, 0x22, 0x8b, 0x6b, 0xf1, 0x35, 0x6c, 0xcd, 0x09,
-	0x48, 0xc2, 0xc6, 0x7a, 0x79, 0xb6, 0x54, 0x7f, 0x12, 0x78, 0x59, 0x3d, 0x9e, 0xcc, 0x29, 0xb0,
-	0x9c, 0x50, 0x74, 0x42, 0x81, 0xea, 0x97, 0xd4, 0xfd, 0xce, 0x57, 0x60, 0x25, 0xf7, 0xeb, 0xfc,
-	0xe7, 0x30, 0xb5, 0xe8, 0x73, 0xb8, 0x26, 0xa4, 0xa8, 0x0f, 0x86, 0xb3, 0x0e, 0x38, 0xbf, 0x6f,
-	0x9d, 0x0d, 0x58, 0x5b, 0x30, 0x11, 0xce, 0x89, 0x40, 0x48, 0xac, 0xd2, 0x97, 0x90, 0x97, 0xef,
-	0x2a, 0xbf, 0xff, 0x4b, 0xbf, 0x92, 0x2a, 0x4f, 0x36, 0x4d, 0x8c, 0x86, 0xf3, 0xad, 0x68, 0x3a,
-	0xb7, 0x64, 0x1f, 0x81, 0xbf, 0x09, 0xeb, 0x8b, 0xc6, 0xc8, 0xf9, 0x0e, 0xb6, 0x96, 0xac, 0xe3,
-	0xc7, 0x74, 0xd9, 0x06, 0x7b, 0xd9, 0x7c, 0x39, 0x17, 0xf0, 0x74, 0xe9, 0x92, 0x7e, 0x4c, 0xaf,
-	0x1d, 0xd8, 0x5e, 0x3e, 0x74, 0xce, 0xb9, 0x38, 0xc9, 0xc2, 0x15, 0xfe, 0x98, 0x66, 0x1f, 0xc5,
-	0x87, 0x5f, 0x38, 0x8f, 0x4e, 0x67, 0xba, 0xeb, 0xf8, 0x2e, 0x9c, 0xb9, 0x4f, 0x89, 0x67, 0x5c,
-	0x87, 0x6c, 0xbc, 0x08, 0xe3, 0xbb, 0x54, 0x6c, 0xf0, 0x8d, 0x1f, 0x57, 0x89, 0xfc, 0xb4, 0xc8,
-	0x9f, 0xf1, 0xec, 0xfd, 0x0c, 0x85, 0xc9, 0x65, 0x1a, 0x01, 0x72, 0x87, 0xd7, 0xcc, 0xbb, 0xa3,
-	0xd6, 0x0a, 0x96, 0xc0, 0x54, 0xd7, 0x5c, 0xcb, 0xc0, 0x0a, 0x40, 0xcc, 0x37, 0xf3, 0xfc, 0xbe,
-	0x95, 0xc2, 0x32, 0x14, 0xa4, 0x4d, 0x7b, 0x56, 0x9a, 0x27, 0x1f, 0x76, 0x83, 0x48, 0x04, 0x33,
-	0x58, 0x84, 0xbc, 0xb0, 0x68, 0xcf, 0xca, 0xee, 0xfd, 0x66, 0x88, 0x0e, 0x72, 0x89, 0x9b, 0x90,
-	0xe1, 0x17, 0x4f, 0x6b, 0x05, 0x0b, 0x90, 0x15, 0x57, 0x4a, 0xcb, 0xe0, 0x6d, 0x63, 0x30, 0x2b,
-	0xc5, 0x91, 0x14, 0xd9, 0x56, 0x9a, 0x23, 0xc9, 0x43, 0x58, 0x19, 0xde, 0x73, 0x32, 0x59, 0x56,
-	0x16, 0x57, 0xd5, 0x4d, 0x48, 0xb2, 0x67, 0xe5, 0x70, 0x6d, 0x7a, 0xbf, 0x51, 0xce, 0x3c, 0x5a,
-	0x50, 0x52, 0x8c, 0x72, 0x3e, 0x2d, 0x93, 0xb7, 0x6e, 0x1d, 0x1f, 0x7d, 0x7f, 0x6a, 0x15, 0x8e,
-	0x0e, 0xde, 0xfd, 0x5d, 0x35, 0xde, 0x3e, 0x54, 0x8d, 0x77, 0x0f, 0x55, 0xe3, 0xaf, 0x87, 0xaa,
-	0xf1, 0xd3, 0xa7, 0x33, 0x7f, 0x6e, 0x86, 0x2e, 0x8b, 0xbc, 0x71, 0x10, 0x79, 0x7d, 0xcf, 0x57,
-	0x86, 0x4f, 0x1b, 0xe1, 0x9b, 0x7e, 0x23, 0xec, 0x36, 0xd8, 0xd8, 0xef, 0xe6, 0xc4, 0x3f, 0x98,
-	0x57, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x6e, 0x5e, 0x03, 0x23, 0x0d, 0x00, 0x00,
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xae, 0xf3, 0x9f, 0x93, 0x9f, 0x3a, 0xa7, 0x7f, 0x6e, 0x29, 0xd9, 0xc8, 0x5a, 0xad, 0x42,
+	0x05, 0x09, 0xdb, 0x15, 0x08, 0x81, 0x54, 0xa9, 0x4d, 0xda, 0x52, 0x89, 0xfe, 0x68, 0x12, 0x40,
+	0xcb, 0x0d, 0x72, 0x9a, 0x21, 0xb5, 0x36, 0xb1, 0x8d, 0x3d, 0xad, 0xd2, 0x67, 0xe1, 0x9a, 0x77,
+	0xe9, 0xe5, 0x3e, 0x01, 0x82, 0x4a, 0x3c, 0x01, 0x2f, 0x80, 0x66, 0x3c, 0x93, 0xc4, 0x4e, 0xd2,
+	0x95, 0xba, 0x77, 0x9e, 0x73, 0xce, 0xf7, 0x9d, 0xf1, 0x97, 0xf3, 0x8d, 0x27, 0x90, 0x67, 0x63,
+	0xa7, 0xe1, 0xf9, 0x2e, 0x73, 0x31, 0xc9, 0xc6, 0xce, 0xce, 0x17, 0x03, 0x9b, 0xdd, 0xdc, 0xf6,
+	0x1a, 0xd7, 0xee, 0xa8, 0x39, 0x70, 0x07, 0x6e, 0x53, 0xe4, 0x7a, 0xb7, 0xbf, 0x89, 0x95, 0x58,
+	0x88, 0xa7, 0x10, 0xb3, 0xb3, 0xca, 0xec, 0x11, 0x0d, 0x98, 0x35, 0xf2, 0x64, 0xa0, 0x3c, 0xa2,
+	0xcc, 0xea, 0x5b, 0xcc, 0x0a, 0xd7, 0xe6, 0x43, 0x02, 0xb2, 0xdd, 0xb1, 0x73, 0x4e, 0x99, 0x85,
+	0x65, 0x48, 0x9c, 0xb5, 0x0d, 0xad, 0xa6, 0xd5, 0x8b, 0x24, 0x71, 0xd6, 0xc6, 0x57, 0x90, 0xe9,
+	0x30, 0x8b, 0xdd, 0x06, 0x46, 0xa2, 0xa6, 0xd5, 0xcb, 0xfb, 0xe5, 0x06, 0xdf, 0x4c, 0x77, 0xec,
+	0x84, 0x51, 0x22, 0xb3, 0xf8, 0x2d, 0x40, 0xc7, 0xb1, 0xbc, 0xe0, 0xc6, 0x65, 0xdd, 0x8e, 0x91,
+	0xac, 0x69, 0xf5, 0xc2, 0xfe, 0x7a, 0x63, 0xda, 0xb9, 0xab, 0x9e, 0x8e, 0x52, 0x0f, 0x7f, 0xbd,
+	0x58, 0x21, 0x33, 0xd5, 0x1c, 0x7b, 0xe5, 0x53, 0xcf, 0xf2, 0x69, 0xbf, 0xdb, 0x31, 0x52, 0x1f,
+	0xc6, 0x4e, 0xab, 0xf1, 0x6b, 0xc8, 0xb5, 0xdc, 0xd1, 0xc8, 0xe6, 0x5d, 0xd3, 0x1f, 0x44, 0x4e,
+	0x6a, 0xf1, 0x0d, 0xe4, 0xda, 0x17, 0x9d, 0x1b, 0xcb, 0xef, 0x07, 0x46, 0xa6, 0x96, 0xac, 0x17,
+	0xf6, 0x2b, 0x8d, 0x89, 0x2c, 0x32, 0xa3, 0x40, 0xaa, 0x10, 0x6b, 0x90, 0x3a, 0x77, 0xfb, 0xd4,
+	0xc8, 0x0a, 0x29, 0x8a, 0x4a, 0x0a, 0x1e, 0x23, 0x22, 0x63, 0xfe, 0xa9, 0x41, 0xa9, 0x75, 0xc1,
+	0xe5, 0x91, 0xaf, 0x87, 0x2f, 0x21, 0xd9, 0x1d, 0x3b, 0x42, 0xd1, 0xc2, 0x0c, 0x84, 0x32, 0x4b,
+	0xd2, 0xf3, 0x34, 0xee, 0x42, 0x9e, 0x50, 0xab, 0x7f, 0x7f, 0xe9, 0x0c, 0xef, 0x85, 0xd2, 0x39,
+	0x32, 0x0d, 0xe0, 0x1e, 0xe8, 0xc7, 0x8e, 0xd5, 0x1b, 0xd2, 0x96, 0x75, 0x7d, 0x43, 0x7f, 0xf6,
+	0x6d, 0x46, 0x85, 0xc4, 0x39, 0x32, 0x17, 0xc7, 0x97, 0x50, 0x6a, 0xdb, 0x01, 0x0f, 0xbe, 0xbe,
+	0x6a, 0x5d, 0x7a, 0x4c, 0xe8, 0x99, 0x23, 0xd1, 0xa0, 0xe9, 0x41, 0xa1, 0x75, 0x71, 0xe9, 0x11,
+	0xfa, 0xfb, 0x2d, 0x0d, 0x18, 0x6e, 0x42, 0xe6, 0xd2, 0x6b, 0xf1, 0x57, 0xe3, 0xfb, 0x2c, 0x11,
+	0xb9, 0x42, 0x03, 0xb2, 0x57, 0xd6, 0xfd, 0xd0, 0xb5, 0xfa, 0x62, 0x53, 0x45, 0xa2, 0x96, 0xd8,
+	0x84, 0x4c, 0xd7, 0xf2, 0x07, 0x94, 0xc9, 0xdf, 0x7a, 0xa9, 0x7a, 0xb2, 0xcc, 0xac, 0x43, 0x31,
+	0xec, 0x18, 0x78, 0xae, 0x13, 0x44, 0xa8, 0xb5, 0x08, 0xb5, 0xf9, 0x6f, 0x1a, 0xa0, 0x3b, 0x76,
+	0xd4, 0xde, 0x84, 0x34, 0xe2, 0x51, 0x0e, 0x66, 0x8a, 0x4c, 0x03, 0x4a, 0xde, 0xc4, 0xd3, 0xf2,
+	0xbe, 0x82, 0xcc, 0x39, 0x65, 0x37, 0x6e, 0xdf, 0x48, 0x46, 0xa7, 0x38, 0x8c, 0x12, 0x99, 0x45,
+	0x84, 0xd4, 0xc9, 0xd0, 0x1a, 0x08, 0xcd, 0x4a, 0x44, 0x3c, 0x63, 0x03, 0xf2, 0xad, 0x0b, 0xd9,
+	0x50, 0x8e, 0x98, 0x2e, 0xe0, 0x33, 0x02, 0x92, 0x69, 0x09, 0x7e, 0x07, 0xa5, 0x70, 0xca, 0x14,
+	0x26, 0x23, 0x30, 0x1b, 0xaa, 0x65, 0x24, 0x49, 0xa2, 0xb5, 0x78, 0x08, 0xab, 0xc4, 0x1d, 0x0e,
+	0x7b, 0xd6, 0xf5, 0x3b, 0x05, 0xcf, 0x0a, 0xf8, 0x96, 0x82, 0xc7, 0xd2, 0x24, 0x5e, 0x8f, 0x07,
+	0x50, 0x96, 0xfe, 0x50, 0x0c, 0x39, 0xc1, 0xb0, 0xa9, 0x18, 0xa2, 0x59, 0x12, 0xab, 0xc6, 0x36,
+	0xe8, 0xa7, 0x94, 0x49, 0x7b, 0x4b, 0x86, 0xbc, 0x60, 0x30, 0x14, 0x43, 0x3c, 0x4f, 0xe6, 0x10,
+	0x78, 0x05, 0xeb, 0xe1, 0x9b, 0xc9, 0x69, 0x50, 0x4c, 0x20, 0x98, 0x76, 0xa3, 0x62, 0x44, 0x6b,
+	0xc8, 0x42, 0x24, 0xfe, 0x04, 0x9b, 0xea, 0x55, 0x63, 0x9c, 0x05, 0xc1, 0x59, 0x8d, 0x2b, 0x14,
+	0x63, 0x5d, 0x82, 0xc6, 0x63, 0x28, 0x13, 0x3a, 0x72, 0xef, 0xe8, 0xb9, 0x1c, 0x60, 0xa3, 0x28,
+	0xf8, 0x3e, 0x9d, 0xf0, 0x45, 0xb2, 0x13, 0xd9, 0xa2, 0x61, 0xfc, 0x12, 0xb2, 0x97, 0x1e, 0xb3,
+	0x5d, 0x27, 0x30, 0x4a, 0x51, 0xbd, 0x25, 0x42, 0x66, 0x89, 0x2a, 0x33, 0xdf, 0x42, 0x65, 0x2e,
+	0x8b, 0x55, 0x00, 0x42, 0x99, 0x7f, 0xcf, 0xed, 0x17, 0x18, 0x5a, 0x2d, 0x59, 0x4f, 0x93, 0x99,
+	0x08, 0xb7, 0xb7, 0x58, 0x9d, 0x39, 0x8c, 0xfa, 0x77, 0xd6, 0x50, 0x4c, 0x7e, 0x92, 0x44, 0x83,
+	0xe6, 0x7f, 0x69, 0x28, 0x08, 0x6e, 0x69, 0xb6, 0xa7, 0x3d, 0x54, 0x5d, 0xea, 0xa1, 0x8f, 0x77,
+	0xcf, 0x67, 0x90, 0xeb, 0x8e, 0x9d, 0x63, 0xdf, 0x77, 0x7d, 0x69, 0x9e, 0x92, 0x42, 0x8b, 0x20,
+	0x99, 0xa4, 0xf1, 0xab, 0xe8, 0x09, 0x21, 0x7d, 0x53, 0x99, 0xf1, 0x5a, 0x98, 0x20, 0xd1, 0x83,
+	0xe4, 0x00, 0xca, 0xca, 0x43, 0x12, 0x98, 0x8d, 0xea, 0x1f, 0xcd, 0x92, 0x58, 0x35, 0x9f, 0xf7,
+	0xa9, 0x85, 0x24, 0x43, 0x2e, 0x3a, 0xef, 0xf1, 0x3c, 0x99, 0x43, 0x70, 0xe3, 0x4e, 0x7c, 0x24,
+	0x49, 0xf2, 0x51, 0xe3, 0xc6, 0xd2, 0x24, 0x5e, 0x8f, 0xa7, 0x50, 0x99, 0xb1, 0x91, 0x24, 0x09,
+	0xfd, 0xb2, 0xbd, 0xc0, 0x79, 0x92, 0x66, 0x1e, 0x83, 0x1d, 0xd8, 0x88, 0x39, 0x48, 0x92, 0x15,
+	0xa2, 0x83, 0xbd, 0xb0, 0x88, 0x2c, 0xc6, 0xe2, 0x5b, 0xd8, 0x9a, 0x33, 0x90, 0xa4, 0x0d, 0xfd,
+	0xf2, 0x62, 0xa9, 0xff, 0x24, 0xf1, 0x32, 0x3c, 0x9e, 0xcc, 0x39, 0xb0, 0x14, 0x73, 0x74, 0xcc,
+	0x81, 0xea, 0x97, 0x8c, 0xc6, 0xcd, 0x6f, 0x40, 0x8f, 0x9f, 0xaf, 0xf3, 0x9f, 0xc3, 0xc4, 0xa2,
+	0xcf, 0xe1, 0x1a, 0x54, 0x66, 0x90, 0x21, 0xbd, 0xb9, 0x0e, 0x38, 0x7f, 0xde, 0x9a, 0x1b, 0xb0,
+	0xb6, 0x60, 0x22, 0xcc, 0x13, 0xc1, 0x10, 0x3b, 0x4a, 0x5f, 0x43, 0x56, 0xbe, 0xab, 0xa1, 0x3d,
+	0xfd, 0x95, 0x54, 0x75, 0xb2, 0x69, 0x6c, 0x34, 0xcc, 0xef, 0x45, 0xd3, 0xb9, 0x43, 0xf6, 0x19,
+	0xfc, 0x9b, 0xb0, 0xbe, 0x68, 0x8c, 0xcc, 0x1f, 0x60, 0x6b, 0xc9, 0x71, 0xfc, 0x9c, 0x2e, 0x3b,
+	0x60, 0x2c, 0x9b, 0x2f, 0xf3, 0x02, 0xb6, 0x97, 0x1e, 0xd2, 0xcf, 0xe9, 0xb5, 0x0b, 0x3b, 0xcb,
+	0x87, 0xce, 0x3c, 0x17, 0x3b, 0x59, 0x78, 0x84, 0x3f, 0xa7, 0xd9, 0x27, 0xb0, 0xbd, 0x80, 0x4e,
+	0xf6, 0xea, 0x4e, 0xcf, 0x3a, 0x7e, 0x16, 0xce, 0xdc, 0xa7, 0xc4, 0x33, 0xae, 0x43, 0x5a, 0x24,
+	0xe5, 0x5d, 0x2a, 0x5c, 0xf0, 0x13, 0x3f, 0x44, 0x89, 0xfa, 0xa4, 0xa8, 0x9f, 0x89, 0xec, 0xfd,
+	0x0a, 0xf9, 0xc9, 0x75, 0x1b, 0x01, 0x32, 0x87, 0xd7, 0xcc, 0xbe, 0xa3, 0xfa, 0x0a, 0x16, 0x21,
+	0xa7, 0x2e, 0xc2, 0xba, 0x86, 0x65, 0x80, 0x50, 0x6f, 0x66, 0x3b, 0x03, 0x3d, 0x81, 0x25, 0xc8,
+	0xcb, 0x35, 0xed, 0xeb, 0x49, 0x5e, 0x7c, 0xd8, 0x73, 0x7d, 0x91, 0x4c, 0x61, 0x01, 0xb2, 0x62,
+	0x45, 0xfb, 0x7a, 0x7a, 0x6f, 0x2f, 0xbc, 0xfd, 0xf3, 0x1d, 0x96, 0x01, 0xf8, 0x87, 0x68, 0x64,
+	0x07, 0xcc, 0xbe, 0xd6, 0x57, 0x70, 0x15, 0x0a, 0x57, 0x34, 0x08, 0x54, 0x40, 0xdb, 0xfb, 0x43,
+	0x13, 0xbb, 0x91, 0x07, 0x7e, 0x0e, 0x52, 0x84, 0x5a, 0x7d, 0x7d, 0x05, 0xf3, 0x90, 0x16, 0xd7,
+	0x4f, 0x5d, 0xe3, 0x5b, 0x0c, 0x1b, 0xeb, 0x09, 0xde, 0x55, 0xfd, 0x30, 0x7a, 0x92, 0x77, 0x95,
+	0x1b, 0xd6, 0x53, 0x7c, 0x7f, 0x93, 0x29, 0xd4, 0xd3, 0x58, 0x51, 0xb7, 0x26, 0xa9, 0xb4, 0x9e,
+	0xc1, 0xb5, 0xe9, 0x5d, 0x48, 0x05, 0xb3, 0xa8, 0x43, 0x51, 0xa9, 0xcf, 0xb5, 0xd7, 0x73, 0xbc,
+	0x75, 0xfb, 0xf8, 0xe8, 0xc7, 0x53, 0x3d, 0x7f, 0x74, 0xf0, 0xfe, 0x9f, 0xaa, 0xf6, 0xf0, 0x58,
+	0xd5, 0xde, 0x3f, 0x56, 0xb5, 0xbf, 0x1f, 0xab, 0xda, 0x2f, 0x9f, 0xcf, 0xfc, 0x55, 0x1a, 0x59,
+	0xcc, 0xb7, 0xc7, 0xae, 0x6f, 0x0f, 0x6c, 0x47, 0x2d, 0x1c, 0xda, 0xf4, 0xde, 0x0d, 0x9a, 0x5e,
+	0xaf, 0xc9, 0xc6, 0x4e, 0x2f, 0x23, 0xfe, 0x0f, 0xbd, 0xf9, 0x7f, 0x00, 0x5c, 0x60, 0xa0, 0x94,
+	0x71, 0x0d, 0x00, 0x00,
 }
 
 func (m *TxnMeta) Marshal() (dAtA []byte, err error) {
@@ -1667,6 +1709,11 @@ func (m *TxnMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Mode != 0 {
+		i = encodeVarintTxn(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.DNShards) > 0 {
 		for iNdEx := len(m.DNShards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2767,6 +2814,9 @@ func (m *TxnMeta) Size() (n int) {
 			n += 1 + l + sovTxn(uint64(l))
 		}
 	}
+	if m.Mode != 0 {
+		n += 1 + sovTxn(uint64(m.Mode))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3399,6 +3449,25 @@ func (m *TxnMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= TxnMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxn(dAtA[iNdEx:])
@@ -41,10 +41,19 @@ type TableInfo struct {
 	IdxList            []int32
 }
 
-func FilterAndDelByRowId(proc *process.Process, bat *batch.Batch, idxList []int32, rels []engine.Relation) (uint64, error) {
+// FilterAndDelByRowId deletes the rows by the rowid columns of the batch, partitionIdxs
+// is nil or holds the positions of the partition key columns of each partitioned table.
+func FilterAndDelByRowId(proc *process.Process, bat *batch.Batch, idxList []int32, rels []engine.Relation, partitionIdxs []map[string]int32) (uint64, error) {
 	var affectedRows uint64
 	for i, idx := range idxList {
-		delBatch := filterRowIdForDel(proc, bat, int(idx))
+		var partitionIdx map[string]int32
+		if len(partitionIdxs) > 0 {
+			partitionIdx = partitionIdxs[i]
+		}
+		delBatch, err := filterRowIdForDel(proc, bat, int(idx), partitionIdx)
+		if err != nil {
+			return 0, err
+		}
 		affectedRows = affectedRows + uint64(delBatch.Length())
 		if delBatch.Length() > 0 {
 			err := rels[i].Delete(proc.Ctx, delBatch, catalog.Row_ID)
@@ -69,6 +78,7 @@ func FilterAndUpdateByRowId(
 	updateCols []map[string]int32,
 	parentIdxs []map[string]int32,
 	uniqueRels [][]engine.Relation,
+	partitionIdxs []map[string]int32,
 ) (uint64, error) {
 	var affectedRows uint64
 	var delBatch *batch.Batch
@@ -92,9 +102,13 @@ func FilterAndUpdateByRowId(
 		if len(parentIdxs) > 0 {
 			parentIdx = parentIdxs[i]
 		}
+		var partitionIdx map[string]int32
+		if len(partitionIdxs) > 0 {
+			partitionIdx = partitionIdxs[i]
+		}
 		info := GetInfoForInsertAndUpdate(tableDef, updateCol)
 
-		delBatch, updateBatch, err = filterRowIdForUpdate(proc, bat, setIdxList, info.Attrs, parentIdx)
+		delBatch, updateBatch, err = filterRowIdForUpdate(proc, bat, setIdxList, info.Attrs, parentIdx, partitionIdx)
 		if err != nil {
 			return 0, err
 		}
@@ -168,7 +182,8 @@ func writeUniqueTable(s3Container *WriteS3Container, proc *process.Process, upda
 	uIdx := 0
 	if tableDef.Indexes != nil {
 		for _, indexdef := range tableDef.Indexes {
-			if indexdef.Unique {
+			// the index tables of the unique and the secondary indexes are written in the same way
+			if indexdef.TableExist {
 				partsLength := len(indexdef.Parts)
 				uniqueColumnPos := make([]int, partsLength)
 				for p, column := range indexdef.Parts {
@@ -232,29 +247,51 @@ func writeUniqueTable(s3Container *WriteS3Container, proc *process.Process, upda
 	return nil
 }
 
-func filterRowIdForDel(proc *process.Process, bat *batch.Batch, idx int) *batch.Batch {
+func filterRowIdForDel(proc *process.Process, bat *batch.Batch, idx int, partitionIdx map[string]int32) (*batch.Batch, error) {
 	retVec := vector.NewVec(types.T_Rowid.ToType())
 	rowIdMap := make(map[types.Rowid]struct{})
+	var rowIdList []types.Rowid
+	var sels []int64
 	for i, r := range vector.MustFixedCol[types.Rowid](bat.Vecs[idx]) {
-		if !bat.Vecs[idx].GetNulls().Contains(uint64(i)) {
+		if bat.Vecs[idx].GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		if _, exist := rowIdMap[r]; !exist {
 			rowIdMap[r] = struct{}{}
+			rowIdList = append(rowIdList, r)
+			sels = append(sels, int64(i))
 		}
 	}
-	rowIdList := make([]types.Rowid, len(rowIdMap))
-	i := 0
-	for rowId := range rowIdMap {
-		rowIdList[i] = rowId
-		i++
-	}
 	vector.AppendFixedList(retVec, rowIdList, nil, proc.Mp())
 	retBatch := batch.New(true, []string{catalog.Row_ID})
 	retBatch.SetZs(retVec.Length(), proc.Mp())
 	retBatch.SetVector(0, retVec)
-	return retBatch
+	if err := appendPartitionKeyVecs(proc, retBatch, bat, sels, partitionIdx); err != nil {
+		retBatch.Clean(proc.Mp())
+		return nil, err
+	}
+	return retBatch, nil
 }
 
-func filterRowIdForUpdate(proc *process.Process, bat *batch.Batch, idxList []int32, attrs []string, parentIdx map[string]int32) (*batch.Batch, *batch.Batch, error) {
+// appendPartitionKeyVecs appends the partition key columns of the rows deleted to the
+// delete batch, by which the rows are routed to their partitions.
+func appendPartitionKeyVecs(proc *process.Process, delBatch *batch.Batch, bat *batch.Batch, sels []int64, partitionIdx map[string]int32) error {
+	for name, pos := range partitionIdx {
+		vec := vector.NewVec(*bat.Vecs[pos].GetType())
+		if err := vec.Union(bat.Vecs[pos], sels, proc.Mp()); err != nil {
+			vec.Free(proc.Mp())
+			return err
+		}
+		delBatch.Attrs = append(delBatch.Attrs, name)
+		delBatch.Vecs = append(delBatch.Vecs, vec)
+	}
+	return nil
+}
+
+func filterRowIdForUpdate(proc *process.Process, bat *batch.Batch, idxList []int32, attrs []string, parentIdx map[string]int32, partitionIdx map[string]int32) (*batch.Batch, *batch.Batch, error) {
 	rowIdMap := make(map[types.Rowid]struct{})
+	var rowIdList []types.Rowid
+	var sels []int64
 	var rowSkip []bool
 	foundRowId := false
 	for i, idx := range idxList {
@@ -266,6 +303,8 @@ func filterRowIdForUpdate(proc *process.Process, bat *batch.Batch, idxList []int
 					rowSkip = append(rowSkip, true)
 				} else {
 					rowIdMap[r] = struct{}{}
+					rowIdList = append(rowIdList, r)
+					sels = append(sels, int64(j))
 					rowSkip = append(rowSkip, false)
 				}
 			}
@@ -284,17 +323,15 @@ func filterRowIdForUpdate(proc *process.Process, bat *batch.Batch, idxList []int
 
 	// get delete batch
 	delVec := vector.NewVec(types.T_Rowid.ToType())
-	rowIdList := make([]types.Rowid, len(rowIdMap))
-	i := 0
-	for rowId := range rowIdMap {
-		rowIdList[i] = rowId
-		i++
-	}
 	mp := proc.Mp()
 	vector.AppendFixedList(delVec, rowIdList, nil, mp)
 	delBatch := batch.New(true, []string{catalog.Row_ID})
 	delBatch.SetVector(0, delVec)
 	delBatch.SetZs(batLen, mp)
+	if err := appendPartitionKeyVecs(proc, delBatch, bat, sels, partitionIdx); err != nil {
+		delBatch.Clean(mp)
+		return nil, nil, err
+	}
 
 	// get update batch
 	updateBatch, err := GetUpdateBatch(proc, bat, idxList, batLen, attrs, rowSkip, parentIdx)
@@ -59,20 +59,20 @@ func Call(_ int, proc *process.Process, arg any, isFirst bool, isLast bool) (boo
 	}
 
 	// delete unique index
-	_, err = colexec.FilterAndDelByRowId(proc, bat, delCtx.IdxIdx, delCtx.IdxSource)
+	_, err = colexec.FilterAndDelByRowId(proc, bat, delCtx.IdxIdx, delCtx.IdxSource, nil)
 	if err != nil {
 		return false, err
 	}
 
 	// delete child table(which ref on delete cascade)
-	_, err = colexec.FilterAndDelByRowId(proc, bat, delCtx.OnCascadeIdx, delCtx.OnCascadeSource)
+	_, err = colexec.FilterAndDelByRowId(proc, bat, delCtx.OnCascadeIdx, delCtx.OnCascadeSource, nil)
 	if err != nil {
 		return false, err
 	}
 
 	// update child table(which ref on delete set null)
 	_, err = colexec.FilterAndUpdateByRowId(p.Engine, proc, bat, delCtx.OnSetIdx, delCtx.OnSetSource,
-		delCtx.OnSetRef, delCtx.OnSetTableDef, delCtx.OnSetUpdateCol, nil, delCtx.OnSetUniqueSource)
+		delCtx.OnSetRef, delCtx.OnSetTableDef, delCtx.OnSetUpdateCol, nil, delCtx.OnSetUniqueSource, nil)
 	if err != nil {
 		return false, err
 	}
@@ -82,7 +82,7 @@ func Call(_ int, proc *process.Process, arg any, isFirst bool, isLast bool) (boo
 	for i := 0; i < len(delCtx.DelSource); i++ {
 		idxList[i] = int32(i)
 	}
-	affectedRows, err = colexec.FilterAndDelByRowId(proc, bat, idxList, delCtx.DelSource)
+	affectedRows, err = colexec.FilterAndDelByRowId(proc, bat, idxList, delCtx.DelSource, delCtx.PartitionIdx)
 	if err != nil {
 		return false, err
 	}
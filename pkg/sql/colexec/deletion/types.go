@@ -56,6 +56,9 @@ type DeleteCtx struct {
 	OnSetRef          []*plan.ObjectRef
 	OnSetTableDef     []*plan.TableDef
 	OnSetUpdateCol    []map[string]int32
+
+	// PartitionIdx is the positions of the partition key columns of each deleted table
+	PartitionIdx []map[string]int32
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
@@ -141,7 +141,7 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		proc.SetInputBatch(nil)
 		return true, nil
 	}
-	if param.plh == nil {
+	if param.plh == nil && param.parqh == nil {
 		if param.Fileparam.FileIndex >= len(param.FileList) {
 			proc.SetInputBatch(nil)
 			return true, nil
@@ -873,6 +873,8 @@ func ScanZonemapFile(ctx context.Context, param *ExternalParam, proc *process.Pr
 func ScanFileData(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
 	if strings.HasSuffix(param.Fileparam.Filepath, ".tae") || param.Extern.QueryResult {
 		return ScanZonemapFile(ctx, param, proc)
+	} else if param.Extern.Format == tree.PARQUET {
+		return ScanParquetFile(ctx, param, proc)
 	} else {
 		return ScanCsvFile(ctx, param, proc)
 	}
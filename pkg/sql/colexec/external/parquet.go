@@ -0,0 +1,798 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/operator"
+	"github.com/matrixorigin/matrixone/pkg/util/errutil"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	parquettypes "github.com/xitongsys/parquet-go/types"
+)
+
+// ParquetHandler reads the selected row groups of a parquet file column by column.
+type ParquetHandler struct {
+	file   *parquetFile
+	reader *reader.ParquetReader
+	// cols[i] is the parquet column of param.Attrs[i], it is nil for the
+	// hidden file path column and the account id of the cluster table.
+	cols []*parquetColumn
+	// rows is the number of rows not read yet in the selected row groups.
+	rows int64
+}
+
+type parquetColumn struct {
+	name string
+	path string
+	elem *parquet.SchemaElement
+	// typ is the type the parquet column is mapped to
+	typ types.Type
+	// conv converts a value returned by the parquet reader to typ
+	conv func(v any) (any, error)
+}
+
+// ScanParquetFile reads a batch from the parquet file param.Fileparam.Filepath.
+func ScanParquetFile(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	_, span := trace.Start(ctx, "ScanParquetFile")
+	defer span.End()
+	if param.parqh == nil {
+		if param.Extern.Local {
+			return nil, moerr.NewNotSupported(proc.Ctx, "load local file with the parquet format")
+		}
+		fs, readPath, err := plan2.GetForETLWithType(param.Extern, param.Fileparam.Filepath)
+		if err != nil {
+			return nil, err
+		}
+		param.parqh, err = NewParquetHandler(proc.Ctx, fs, readPath, param.Attrs, param.Cols, param.ClusterTable.GetIsClusterTable(), int(param.ClusterTable.GetColumnIndexOfAccountId()))
+		if err != nil {
+			return nil, err
+		}
+		param.parqh.pruneRowGroups(param, proc)
+	}
+	h := param.parqh
+	bat, err := h.getBatch(param, proc)
+	if err != nil {
+		return nil, err
+	}
+	if h.rows == 0 {
+		if err := h.file.Close(); err != nil {
+			logutil.Errorf("close file failed. err:%v", err)
+		}
+		param.parqh = nil
+		param.Fileparam.FileFin++
+		if param.Fileparam.FileFin >= param.Fileparam.FileCnt {
+			param.Fileparam.End = true
+		}
+	}
+	bat.Cnt = 1
+	return bat, nil
+}
+
+// NewParquetHandler opens the parquet file at path of fs and maps each of the
+// attributes to the parquet column with the same name.
+func NewParquetHandler(ctx context.Context, fs fileservice.FileService, path string, attrs []string, cols []*plan.ColDef,
+	isClusterTable bool, accountIdIdx int) (*ParquetHandler, error) {
+	entry, err := fs.StatFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	file := &parquetFile{
+		ctx:  ctx,
+		fs:   fs,
+		path: path,
+		size: entry.Size,
+	}
+	pr, err := reader.NewParquetColumnReader(file, 1)
+	if err != nil {
+		return nil, moerr.NewInvalidInput(ctx, "the file '%s' is not a valid parquet file: %v", path, err)
+	}
+	schemaHandler := pr.SchemaHandler
+	name2Index := make(map[string]int, len(schemaHandler.SchemaElements))
+	for i := 1; i < len(schemaHandler.SchemaElements); i++ {
+		name2Index[strings.ToLower(schemaHandler.Infos[i].ExName)] = i
+	}
+	h := &ParquetHandler{
+		file:   file,
+		reader: pr,
+		cols:   make([]*parquetColumn, len(attrs)),
+		rows:   pr.GetNumRows(),
+	}
+	for i, attr := range attrs {
+		if catalog.ContainExternalHidenCol(attr) || (isClusterTable && accountIdIdx == i) {
+			continue
+		}
+		idx, ok := name2Index[strings.ToLower(attr)]
+		if !ok {
+			return nil, moerr.NewInvalidInput(ctx, "the column '%s' is not found in the parquet file '%s'", attr, path)
+		}
+		elem := schemaHandler.SchemaElements[idx]
+		colPath := schemaHandler.IndexMap[int32(idx)]
+		if elem.GetNumChildren() > 0 || strings.Count(colPath, common.PAR_GO_PATH_DELIMITER) != 1 ||
+			elem.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+			return nil, moerr.NewNotSupported(ctx, "the nested parquet column '%s'", attr)
+		}
+		col := &parquetColumn{
+			name: attr,
+			path: colPath,
+			elem: elem,
+		}
+		if col.typ, col.conv, err = parquetColumnType(ctx, elem); err != nil {
+			return nil, err
+		}
+		if !operator.IfTypeCastSupported(col.typ.Oid, types.T(cols[i].Typ.Id)) && col.typ.Oid != types.T(cols[i].Typ.Id) {
+			return nil, moerr.NewNotSupported(ctx, "load the parquet column '%s' of type %s into %s", attr, col.typ, types.T(cols[i].Typ.Id))
+		}
+		h.cols[i] = col
+	}
+	return h, nil
+}
+
+// pruneRowGroups drops the row groups whose statistics show that none of
+// their rows can pass the filter. The filter is evaluated on the min/max
+// values of the row group, as what is done for the zonemap of the tae file.
+func (h *ParquetHandler) pruneRowGroups(param *ExternalParam, proc *process.Process) {
+	if param.Filter.FilterExpr == nil || !param.Filter.exprMono || len(param.Filter.defColumns) == 0 {
+		return
+	}
+	footer := h.reader.Footer
+	rowGroups := make([]*parquet.RowGroup, 0, len(footer.RowGroups))
+	h.rows = 0
+	for _, rg := range footer.RowGroups {
+		if h.needReadRowGroup(param, proc, rg) {
+			rowGroups = append(rowGroups, rg)
+			h.rows += rg.NumRows
+		}
+	}
+	footer.RowGroups = rowGroups
+}
+
+func (h *ParquetHandler) needReadRowGroup(param *ExternalParam, proc *process.Process, rg *parquet.RowGroup) bool {
+	dataLength := len(param.Filter.defColumns)
+	datas := make([][2]any, dataLength)
+	dataTypes := make([]uint8, dataLength)
+	for i := 0; i < dataLength; i++ {
+		idx := param.Filter.defColumns[i]
+		col := h.cols[idx]
+		if col == nil || !parquetStatsComparable(col.typ.Oid, types.T(param.Cols[idx].Typ.Id)) {
+			return true
+		}
+		min, max, ok := col.rowGroupMinMax(rg)
+		if !ok {
+			return true
+		}
+		dataTypes[i] = uint8(param.Cols[idx].Typ.Id)
+		datas[i] = [2]any{min, max}
+	}
+	buildVectors := plan2.BuildVectorsByData(datas, dataTypes, proc.Mp())
+	bat := batch.NewWithSize(param.Filter.maxCol + 1)
+	defer bat.Clean(proc.Mp())
+	for k, v := range param.Filter.columnMap {
+		for i, realIdx := range param.Filter.defColumns {
+			if int(realIdx) == v {
+				bat.SetVector(int32(k), buildVectors[i])
+				break
+			}
+		}
+	}
+	bat.SetZs(buildVectors[0].Length(), proc.Mp())
+
+	notReportErrCtx := errutil.ContextWithNoReport(proc.Ctx, true)
+	ifNeed, err := plan2.EvalFilterExpr(notReportErrCtx, param.Filter.FilterExpr, bat, proc)
+	if err != nil {
+		return true
+	}
+	return ifNeed
+}
+
+// parquetStatsComparable reports whether the statistics of a parquet column
+// mapped to typ can be used to prune the rows of a column of colTyp.
+func parquetStatsComparable(typ, colTyp types.T) bool {
+	switch typ {
+	case types.T_decimal64, types.T_decimal128, types.T_json, types.T_uuid:
+		return false
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
+		switch colTyp {
+		case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
+			return true
+		}
+		return false
+	}
+	return typ == colTyp
+}
+
+// rowGroupMinMax returns the min and max values of the column in the row group.
+func (col *parquetColumn) rowGroupMinMax(rg *parquet.RowGroup) (any, any, bool) {
+	for _, chunk := range rg.Columns {
+		meta := chunk.MetaData
+		if meta == nil || !strings.HasSuffix(col.path, common.PAR_GO_PATH_DELIMITER+common.PathToStr(meta.PathInSchema)) {
+			continue
+		}
+		st := meta.Statistics
+		if st == nil {
+			return nil, nil, false
+		}
+		minData, maxData := st.MinValue, st.MaxValue
+		if minData == nil || maxData == nil {
+			// the deprecated min and max are compared as signed values
+			switch col.elem.GetType() {
+			case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY, parquet.Type_INT96:
+				return nil, nil, false
+			}
+			switch col.typ.Oid {
+			case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+				return nil, nil, false
+			}
+			minData, maxData = st.Min, st.Max
+		}
+		if minData == nil || maxData == nil {
+			return nil, nil, false
+		}
+		min, ok := col.decodeStat(minData)
+		if !ok {
+			return nil, nil, false
+		}
+		max, ok := col.decodeStat(maxData)
+		if !ok {
+			return nil, nil, false
+		}
+		return min, max, true
+	}
+	return nil, nil, false
+}
+
+// decodeStat decodes a plain encoded statistic value into the type of the column.
+func (col *parquetColumn) decodeStat(data []byte) (any, bool) {
+	var v any
+	switch col.elem.GetType() {
+	case parquet.Type_BOOLEAN:
+		if len(data) < 1 {
+			return nil, false
+		}
+		v = data[0] != 0
+	case parquet.Type_INT32:
+		if len(data) < 4 {
+			return nil, false
+		}
+		v = int32(binary.LittleEndian.Uint32(data))
+	case parquet.Type_INT64:
+		if len(data) < 8 {
+			return nil, false
+		}
+		v = int64(binary.LittleEndian.Uint64(data))
+	case parquet.Type_FLOAT:
+		if len(data) < 4 {
+			return nil, false
+		}
+		v = math.Float32frombits(binary.LittleEndian.Uint32(data))
+	case parquet.Type_DOUBLE:
+		if len(data) < 8 {
+			return nil, false
+		}
+		v = math.Float64frombits(binary.LittleEndian.Uint64(data))
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		v = string(data)
+	default:
+		return nil, false
+	}
+	res, err := col.conv(v)
+	if err != nil {
+		return nil, false
+	}
+	return res, true
+}
+
+// getBatch reads at most ONE_BATCH_MAX_ROW rows from the parquet file.
+func (h *ParquetHandler) getBatch(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	n := int64(ONE_BATCH_MAX_ROW)
+	if h.rows < n {
+		n = h.rows
+	}
+	bat := batch.New(true, param.Attrs)
+	for i := range param.Attrs {
+		vec, err := h.getVector(param, proc, i, int(n))
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+	}
+	h.rows -= n
+
+	sels := proc.Mp().GetSels()
+	if int(n) > cap(sels) {
+		proc.Mp().PutSels(sels)
+		sels = make([]int64, n)
+	}
+	bat.Zs = sels[:n]
+	for k := 0; k < int(n); k++ {
+		bat.Zs[k] = 1
+	}
+	return bat, nil
+}
+
+func (h *ParquetHandler) getVector(param *ExternalParam, proc *process.Process, colIdx int, n int) (*vector.Vector, error) {
+	typ := makeType(param.Cols, colIdx)
+	col := h.cols[colIdx]
+	if col == nil {
+		vec, err := proc.AllocVectorOfRows(typ, n, nil)
+		if err != nil {
+			return nil, err
+		}
+		if catalog.ContainExternalHidenCol(param.Attrs[colIdx]) {
+			for j := 0; j < n; j++ {
+				if err := vector.SetStringAt(vec, j, param.Fileparam.Filepath, proc.Mp()); err != nil {
+					vec.Free(proc.Mp())
+					return nil, err
+				}
+			}
+		}
+		return vec, nil
+	}
+
+	// only the decimal needs to be cast if the parquet column has the same
+	// type as the table column, the scale of other types is only for display
+	vtyp := col.typ
+	if vtyp.Oid == typ.Oid && (!types.IsDecimal(vtyp.Oid) || vtyp.Scale == typ.Scale) {
+		vtyp = typ
+	}
+	vec := vector.NewVec(vtyp)
+	if n == 0 {
+		return castParquetVector(proc, vec, typ, n)
+	}
+	values, _, _, err := h.reader.ReadColumnByPath(col.path, int64(n))
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != n {
+		return nil, moerr.NewInternalError(proc.Ctx, "read %d values from the parquet column '%s', expected %d", len(values), col.name, n)
+	}
+	for _, v := range values {
+		if v == nil {
+			err = appendParquetNull(vec, proc)
+		} else {
+			var val any
+			if val, err = col.conv(v); err == nil {
+				err = vector.AppendAny(vec, val, false, proc.Mp())
+			}
+		}
+		if err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return castParquetVector(proc, vec, typ, n)
+}
+
+func appendParquetNull(vec *vector.Vector, proc *process.Process) error {
+	if vec.GetType().IsVarlen() {
+		return vector.AppendBytes(vec, nil, true, proc.Mp())
+	}
+	return vector.AppendAny(vec, nil, true, proc.Mp())
+}
+
+// castParquetVector casts vec into typ if the parquet column is mapped to a
+// type different from the table column, vec is freed if it is casted.
+func castParquetVector(proc *process.Process, vec *vector.Vector, typ types.Type, n int) (*vector.Vector, error) {
+	if vec.GetType().Eq(typ) {
+		return vec, nil
+	}
+	defer vec.Free(proc.Mp())
+	result := vector.NewFunctionResultWrapper(typ, proc.Mp(), false, n)
+	if err := operator.NewCast([]*vector.Vector{vec, vector.NewConstNull(typ, n, proc.Mp())}, result, proc, n); err != nil {
+		result.Free()
+		return nil, err
+	}
+	rvec := result.GetResultVector()
+	rvec.SetLength(n)
+	return rvec, nil
+}
+
+var (
+	parquetUnixEpochDays   = int32(types.DateFromCalendar(1970, 1, 1))
+	parquetNanosPerMicro   = int64(1000)
+	parquetMicrosPerMillis = int64(1000)
+)
+
+// parquetColumnType maps the physical and logical type of a parquet column
+// to a types.Type, and returns the function converting the values read from
+// the column into the values of that type.
+func parquetColumnType(ctx context.Context, elem *parquet.SchemaElement) (types.Type, func(v any) (any, error), error) {
+	physical := elem.GetType()
+	if lt := elem.LogicalType; lt != nil {
+		switch {
+		case lt.STRING != nil, lt.ENUM != nil:
+			return types.New(types.T_varchar, types.MaxVarcharLen, 0), parquetToBytes, nil
+		case lt.JSON != nil:
+			return types.New(types.T_json, 0, 0), parquetToJson, nil
+		case lt.BSON != nil:
+			return types.New(types.T_blob, 0, 0), parquetToBytes, nil
+		case lt.UUID != nil:
+			return types.New(types.T_uuid, 0, 0), parquetToUuid, nil
+		case lt.DATE != nil:
+			return types.New(types.T_date, 0, 0), parquetToDate, nil
+		case lt.TIME != nil:
+			return types.New(types.T_time, 0, 6), parquetToTime(parquetTimeUnit(lt.TIME.Unit)), nil
+		case lt.TIMESTAMP != nil:
+			unit := parquetTimeUnit(lt.TIMESTAMP.Unit)
+			if lt.TIMESTAMP.IsAdjustedToUTC {
+				return types.New(types.T_timestamp, 0, 6), parquetToTimestamp(unit), nil
+			}
+			return types.New(types.T_datetime, 0, 6), parquetToDatetime(unit), nil
+		case lt.INTEGER != nil:
+			return parquetIntType(ctx, elem, lt.INTEGER.BitWidth, lt.INTEGER.IsSigned)
+		case lt.DECIMAL != nil:
+			return parquetDecimalType(ctx, elem, lt.DECIMAL.Precision, lt.DECIMAL.Scale)
+		}
+	}
+	if elem.ConvertedType != nil {
+		switch elem.GetConvertedType() {
+		case parquet.ConvertedType_UTF8, parquet.ConvertedType_ENUM:
+			return types.New(types.T_varchar, types.MaxVarcharLen, 0), parquetToBytes, nil
+		case parquet.ConvertedType_JSON:
+			return types.New(types.T_json, 0, 0), parquetToJson, nil
+		case parquet.ConvertedType_BSON:
+			return types.New(types.T_blob, 0, 0), parquetToBytes, nil
+		case parquet.ConvertedType_DATE:
+			return types.New(types.T_date, 0, 0), parquetToDate, nil
+		case parquet.ConvertedType_TIME_MILLIS:
+			return types.New(types.T_time, 0, 6), parquetToTime(parquetMicrosPerMillis), nil
+		case parquet.ConvertedType_TIME_MICROS:
+			return types.New(types.T_time, 0, 6), parquetToTime(1), nil
+		case parquet.ConvertedType_TIMESTAMP_MILLIS:
+			return types.New(types.T_timestamp, 0, 6), parquetToTimestamp(parquetMicrosPerMillis), nil
+		case parquet.ConvertedType_TIMESTAMP_MICROS:
+			return types.New(types.T_timestamp, 0, 6), parquetToTimestamp(1), nil
+		case parquet.ConvertedType_INT_8:
+			return parquetIntType(ctx, elem, 8, true)
+		case parquet.ConvertedType_INT_16:
+			return parquetIntType(ctx, elem, 16, true)
+		case parquet.ConvertedType_INT_32:
+			return parquetIntType(ctx, elem, 32, true)
+		case parquet.ConvertedType_INT_64:
+			return parquetIntType(ctx, elem, 64, true)
+		case parquet.ConvertedType_UINT_8:
+			return parquetIntType(ctx, elem, 8, false)
+		case parquet.ConvertedType_UINT_16:
+			return parquetIntType(ctx, elem, 16, false)
+		case parquet.ConvertedType_UINT_32:
+			return parquetIntType(ctx, elem, 32, false)
+		case parquet.ConvertedType_UINT_64:
+			return parquetIntType(ctx, elem, 64, false)
+		case parquet.ConvertedType_DECIMAL:
+			return parquetDecimalType(ctx, elem, elem.GetPrecision(), elem.GetScale())
+		}
+	}
+	switch physical {
+	case parquet.Type_BOOLEAN:
+		return types.New(types.T_bool, 0, 0), parquetIdentity, nil
+	case parquet.Type_INT32:
+		return types.New(types.T_int32, 0, 0), parquetIdentity, nil
+	case parquet.Type_INT64:
+		return types.New(types.T_int64, 0, 0), parquetIdentity, nil
+	case parquet.Type_INT96:
+		return types.New(types.T_timestamp, 0, 6), parquetInt96ToTimestamp, nil
+	case parquet.Type_FLOAT:
+		return types.New(types.T_float32, 0, 0), parquetIdentity, nil
+	case parquet.Type_DOUBLE:
+		return types.New(types.T_float64, 0, 0), parquetIdentity, nil
+	case parquet.Type_BYTE_ARRAY:
+		return types.New(types.T_varbinary, types.MaxVarBinaryLen, 0), parquetToBytes, nil
+	case parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		return types.New(types.T_binary, elem.GetTypeLength(), 0), parquetToBytes, nil
+	}
+	return types.Type{}, nil, moerr.NewNotSupported(ctx, "the parquet column '%s' of type %s", elem.Name, physical)
+}
+
+func parquetTimeUnit(unit *parquet.TimeUnit) int64 {
+	switch {
+	case unit == nil, unit.MICROS != nil:
+		return 1
+	case unit.MILLIS != nil:
+		return parquetMicrosPerMillis
+	default:
+		// nanoseconds are represented as a negative factor
+		return -parquetNanosPerMicro
+	}
+}
+
+// toMicros converts v, which is in the unit of factor, to microseconds.
+func toMicros(v int64, factor int64) int64 {
+	if factor < 0 {
+		return v / -factor
+	}
+	return v * factor
+}
+
+func parquetToInt64(v any) (int64, error) {
+	switch x := v.(type) {
+	case int32:
+		return int64(x), nil
+	case int64:
+		return x, nil
+	}
+	return 0, moerr.NewInternalErrorNoCtx("unexpected parquet value %v", v)
+}
+
+func parquetIdentity(v any) (any, error) {
+	return v, nil
+}
+
+func parquetToBytes(v any) (any, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("unexpected parquet value %v", v)
+	}
+	return []byte(s), nil
+}
+
+func parquetToJson(v any) (any, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("unexpected parquet value %v", v)
+	}
+	bj, err := types.ParseStringToByteJson(s)
+	if err != nil {
+		return nil, err
+	}
+	return types.EncodeJson(bj)
+}
+
+func parquetToUuid(v any) (any, error) {
+	s, ok := v.(string)
+	if !ok || len(s) != 16 {
+		return nil, moerr.NewInternalErrorNoCtx("unexpected parquet value %v", v)
+	}
+	var u types.Uuid
+	copy(u[:], s)
+	return u, nil
+}
+
+func parquetToDate(v any) (any, error) {
+	d, ok := v.(int32)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("unexpected parquet value %v", v)
+	}
+	return types.Date(d + parquetUnixEpochDays), nil
+}
+
+func parquetToTime(factor int64) func(v any) (any, error) {
+	return func(v any) (any, error) {
+		t, err := parquetToInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		return types.Time(toMicros(t, factor)), nil
+	}
+}
+
+func parquetToTimestamp(factor int64) func(v any) (any, error) {
+	return func(v any) (any, error) {
+		t, err := parquetToInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		return types.UnixMicroToTimestamp(toMicros(t, factor)), nil
+	}
+}
+
+func parquetToDatetime(factor int64) func(v any) (any, error) {
+	return func(v any) (any, error) {
+		t, err := parquetToInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		return types.Datetime(types.UnixMicroToTimestamp(toMicros(t, factor))), nil
+	}
+}
+
+func parquetInt96ToTimestamp(v any) (any, error) {
+	s, ok := v.(string)
+	if !ok || len(s) != 12 {
+		return nil, moerr.NewInternalErrorNoCtx("unexpected parquet value %v", v)
+	}
+	return types.UnixMicroToTimestamp(parquettypes.INT96ToTime(s).UnixMicro()), nil
+}
+
+func parquetIntType(ctx context.Context, elem *parquet.SchemaElement, bitWidth int8, signed bool) (types.Type, func(v any) (any, error), error) {
+	if physical := elem.GetType(); physical != parquet.Type_INT32 && physical != parquet.Type_INT64 {
+		return types.Type{}, nil, moerr.NewNotSupported(ctx, "the parquet integer column '%s' of type %s", elem.Name, physical)
+	}
+	var oid types.T
+	var conv func(v int64) any
+	switch {
+	case bitWidth == 8 && signed:
+		oid, conv = types.T_int8, func(v int64) any { return int8(v) }
+	case bitWidth == 16 && signed:
+		oid, conv = types.T_int16, func(v int64) any { return int16(v) }
+	case bitWidth == 32 && signed:
+		oid, conv = types.T_int32, func(v int64) any { return int32(v) }
+	case bitWidth == 64 && signed:
+		oid, conv = types.T_int64, func(v int64) any { return v }
+	case bitWidth == 8:
+		oid, conv = types.T_uint8, func(v int64) any { return uint8(v) }
+	case bitWidth == 16:
+		oid, conv = types.T_uint16, func(v int64) any { return uint16(v) }
+	case bitWidth == 32:
+		oid, conv = types.T_uint32, func(v int64) any { return uint32(v) }
+	case bitWidth == 64:
+		oid, conv = types.T_uint64, func(v int64) any { return uint64(v) }
+	default:
+		return types.Type{}, nil, moerr.NewNotSupported(ctx, "the parquet integer column '%s' of bit width %d", elem.Name, bitWidth)
+	}
+	return types.New(oid, 0, 0), func(v any) (any, error) {
+		x, err := parquetToInt64(v)
+		if err != nil {
+			return nil, err
+		}
+		return conv(x), nil
+	}, nil
+}
+
+func parquetDecimalType(ctx context.Context, elem *parquet.SchemaElement, precision, scale int32) (types.Type, func(v any) (any, error), error) {
+	var typ types.Type
+	switch {
+	case precision <= 18:
+		typ = types.New(types.T_decimal64, precision, scale)
+	case precision <= 38:
+		typ = types.New(types.T_decimal128, precision, scale)
+	default:
+		return types.Type{}, nil, moerr.NewNotSupported(ctx, "the parquet decimal column '%s' of precision %d", elem.Name, precision)
+	}
+	return typ, func(v any) (any, error) {
+		unscaled := new(big.Int)
+		switch x := v.(type) {
+		case int32:
+			unscaled.SetInt64(int64(x))
+		case int64:
+			unscaled.SetInt64(x)
+		case string:
+			// big-endian two's complement
+			unscaled.SetBytes([]byte(x))
+			if len(x) > 0 && x[0]&0x80 != 0 {
+				unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(x)*8)))
+			}
+		default:
+			return nil, moerr.NewInternalErrorNoCtx("unexpected parquet value %v", v)
+		}
+		s := decimalString(unscaled, scale)
+		if typ.Oid == types.T_decimal64 {
+			return types.Decimal64_FromStringWithScale(s, precision, scale)
+		}
+		return types.Decimal128_FromStringWithScale(s, precision, scale)
+	}, nil
+}
+
+// decimalString formats the unscaled value of a decimal with scale.
+func decimalString(unscaled *big.Int, scale int32) string {
+	s := unscaled.String()
+	if scale <= 0 {
+		return s
+	}
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	if len(s) <= int(scale) {
+		s = strings.Repeat("0", int(scale)-len(s)+1) + s
+	}
+	s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+var _ source.ParquetFile = new(parquetFile)
+
+// parquetFile serves a parquet file of a file service to the parquet reader.
+type parquetFile struct {
+	ctx    context.Context
+	fs     fileservice.FileService
+	path   string
+	size   int64
+	offset int64
+}
+
+func (f *parquetFile) Read(p []byte) (int, error) {
+	if f.offset >= f.size {
+		return 0, io.EOF
+	}
+	n := int64(len(p))
+	if n == 0 {
+		return 0, nil
+	}
+	if f.offset+n > f.size {
+		n = f.size - f.offset
+	}
+	vec := fileservice.IOVector{
+		FilePath: f.path,
+		Entries: []fileservice.IOEntry{
+			0: {
+				Offset: f.offset,
+				Size:   n,
+				Data:   p[:n],
+			},
+		},
+	}
+	if err := f.fs.Read(f.ctx, &vec); err != nil {
+		return 0, err
+	}
+	copy(p, vec.Entries[0].Data)
+	f.offset += n
+	return int(n), nil
+}
+
+func (f *parquetFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.size
+	default:
+		return 0, moerr.NewInvalidInputNoCtx("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, moerr.NewInvalidInputNoCtx("invalid offset %d", offset)
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+// Open opens the file again with an independent offset, the column chunks
+// in other files are not supported.
+func (f *parquetFile) Open(name string) (source.ParquetFile, error) {
+	if name != "" && name != f.path {
+		return nil, moerr.NewNotSupportedNoCtx("the parquet column chunk in file '%s'", name)
+	}
+	return &parquetFile{
+		ctx:  f.ctx,
+		fs:   f.fs,
+		path: f.path,
+		size: f.size,
+	}, nil
+}
+
+func (f *parquetFile) Write(p []byte) (int, error) {
+	return 0, moerr.NewNotSupportedNoCtx("write the parquet file")
+}
+
+func (f *parquetFile) Create(name string) (source.ParquetFile, error) {
+	return nil, moerr.NewNotSupportedNoCtx("create the parquet file")
+}
+
+func (f *parquetFile) Close() error {
+	return nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+type parquetTestRow struct {
+	Id    int64    `parquet:"name=id, type=INT64"`
+	Name  string   `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Score *float64 `parquet:"name=score, type=DOUBLE, repetitiontype=OPTIONAL"`
+	Day   int32    `parquet:"name=day, type=INT32, convertedtype=DATE"`
+	Price int64    `parquet:"name=price, type=INT64, convertedtype=DECIMAL, scale=2, precision=10"`
+}
+
+const (
+	parquetTestRows         = 300
+	parquetTestRowGroupRows = 100
+)
+
+// makeParquetTestData writes parquetTestRows rows into a parquet file,
+// each row group holds parquetTestRowGroupRows rows.
+func makeParquetTestData(t *testing.T) []byte {
+	buf := new(bytes.Buffer)
+	pw, err := writer.NewParquetWriterFromWriter(buf, new(parquetTestRow), 1)
+	require.NoError(t, err)
+	for i := 0; i < parquetTestRows; i++ {
+		row := &parquetTestRow{
+			Id:    int64(i),
+			Name:  fmt.Sprintf("name%d", i),
+			Day:   int32(i),
+			Price: int64(i*100 + 1),
+		}
+		if i%10 != 0 {
+			score := float64(i) / 2
+			row.Score = &score
+		}
+		require.NoError(t, pw.Write(row))
+		if (i+1)%parquetTestRowGroupRows == 0 {
+			require.NoError(t, pw.Flush(true))
+		}
+	}
+	require.NoError(t, pw.WriteStop())
+	return buf.Bytes()
+}
+
+func newParquetTestParam(path string) *ExternalParam {
+	attrs := []string{"name", "ID", "score", "day", "price", catalog.ExternalFilePath}
+	typs := []types.Type{
+		types.T_varchar.ToType(),
+		types.T_int32.ToType(),
+		types.T_float64.ToType(),
+		types.T_date.ToType(),
+		types.New(types.T_decimal64, 10, 2),
+		types.T_varchar.ToType(),
+	}
+	cols := make([]*plan.ColDef, len(attrs))
+	for i := range attrs {
+		cols[i] = &plan.ColDef{
+			Name: attrs[i],
+			Typ: &plan.Type{
+				Id:    int32(typs[i].Oid),
+				Width: typs[i].Width,
+				Scale: typs[i].Scale,
+			},
+		}
+	}
+	return &ExternalParam{
+		ExParamConst: ExParamConst{
+			Attrs: attrs,
+			Cols:  cols,
+		},
+		ExParam: ExParam{
+			Fileparam: &ExFileparam{Filepath: path},
+			Filter:    &FilterParam{},
+		},
+	}
+}
+
+func writeParquetTestFile(t *testing.T, fs fileservice.FileService, path string) {
+	data := makeParquetTestData(t)
+	err := fs.Write(context.Background(), fileservice.IOVector{
+		FilePath: path,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(len(data)),
+				Data:   data,
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestParquetHandler(t *testing.T) {
+	ctx := context.Background()
+	proc := testutil.NewProcess()
+	fs, err := fileservice.NewMemoryFS("memory")
+	require.NoError(t, err)
+	writeParquetTestFile(t, fs, "t.parquet")
+
+	param := newParquetTestParam("t.parquet")
+	h, err := NewParquetHandler(ctx, fs, "t.parquet", param.Attrs, param.Cols, false, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(parquetTestRows), h.rows)
+	require.Equal(t, types.T_varchar, h.cols[0].typ.Oid)
+	require.Equal(t, types.T_int64, h.cols[1].typ.Oid)
+	require.Equal(t, types.T_float64, h.cols[2].typ.Oid)
+	require.Equal(t, types.T_date, h.cols[3].typ.Oid)
+	require.Equal(t, types.T_decimal64, h.cols[4].typ.Oid)
+	require.Nil(t, h.cols[5])
+
+	bat, err := h.getBatch(param, proc)
+	require.NoError(t, err)
+	defer bat.Clean(proc.Mp())
+	require.Equal(t, parquetTestRows, bat.Length())
+	require.Equal(t, int64(0), h.rows)
+	for i := 0; i < parquetTestRows; i++ {
+		require.Equal(t, fmt.Sprintf("name%d", i), bat.Vecs[0].GetStringAt(i))
+		require.Equal(t, int32(i), vector.GetFixedAt[int32](bat.Vecs[1], i))
+		if i%10 == 0 {
+			require.True(t, bat.Vecs[2].GetNulls().Contains(uint64(i)))
+		} else {
+			require.Equal(t, float64(i)/2, vector.GetFixedAt[float64](bat.Vecs[2], i))
+		}
+		require.Equal(t, types.Date(int32(i)+parquetUnixEpochDays), vector.GetFixedAt[types.Date](bat.Vecs[3], i))
+		require.Equal(t, fmt.Sprintf("%d.01", i), vector.GetFixedAt[types.Decimal64](bat.Vecs[4], i).ToStringWithScale(2))
+		require.Equal(t, "t.parquet", bat.Vecs[5].GetStringAt(i))
+	}
+
+	// the column doesn't exist in the file
+	param.Attrs[0] = "nosuchcolumn"
+	param.Cols[0].Name = "nosuchcolumn"
+	_, err = NewParquetHandler(ctx, fs, "t.parquet", param.Attrs, param.Cols, false, 0)
+	require.Error(t, err)
+
+	// not a parquet file
+	require.NoError(t, fs.Write(ctx, fileservice.IOVector{
+		FilePath: "t.csv",
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   4,
+				Data:   []byte("1,2\n"),
+			},
+		},
+	}))
+	_, err = NewParquetHandler(ctx, fs, "t.csv", param.Attrs, param.Cols, false, 0)
+	require.Error(t, err)
+}
+
+func TestParquetPruneRowGroups(t *testing.T) {
+	ctx := context.Background()
+	proc := testutil.NewProcess()
+	fs, err := fileservice.NewMemoryFS("memory")
+	require.NoError(t, err)
+	writeParquetTestFile(t, fs, "t.parquet")
+
+	param := newParquetTestParam("t.parquet")
+	// the statistics are used only if the column has the same type in the file
+	param.Cols[1].Typ.Id = int32(types.T_int64)
+	argTypes := []types.Type{types.T_int64.ToType(), types.T_int64.ToType()}
+	fid, retType, _, err := function.GetFunctionByName(ctx, ">", argTypes)
+	require.NoError(t, err)
+	param.Filter.FilterExpr = &plan.Expr{
+		Typ: &plan.Type{Id: int32(retType.Oid)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fid, ObjName: ">"},
+				Args: []*plan.Expr{
+					{
+						Typ: &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_Col{
+							Col: &plan.ColRef{ColPos: 0, Name: "ID"},
+						},
+					},
+					{
+						Typ: &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_C{
+							C: &plan.Const{
+								Value: &plan.Const_I64Val{I64Val: 150},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	param.Filter.exprMono = true
+	param.Filter.columnMap = map[int]int{0: 1}
+	param.Filter.defColumns = []uint16{1}
+
+	h, err := NewParquetHandler(ctx, fs, "t.parquet", param.Attrs, param.Cols, false, 0)
+	require.NoError(t, err)
+	h.pruneRowGroups(param, proc)
+	require.Equal(t, 2, len(h.reader.Footer.RowGroups))
+	require.Equal(t, int64(2*parquetTestRowGroupRows), h.rows)
+
+	bat, err := h.getBatch(param, proc)
+	require.NoError(t, err)
+	defer bat.Clean(proc.Mp())
+	require.Equal(t, 2*parquetTestRowGroupRows, bat.Length())
+	require.Equal(t, int64(parquetTestRowGroupRows), vector.GetFixedAt[int64](bat.Vecs[1], 0))
+	require.Equal(t, fmt.Sprintf("name%d", parquetTestRows-1), bat.Vecs[0].GetStringAt(bat.Length()-1))
+}
+
+func TestScanParquetFile(t *testing.T) {
+	ctx := context.Background()
+	proc := testutil.NewProcess()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "t.parquet")
+	require.NoError(t, os.WriteFile(path, makeParquetTestData(t), 0644))
+
+	param := newParquetTestParam(path)
+	param.Extern = &tree.ExternParam{
+		ExParamConst: tree.ExParamConst{
+			Filepath: path,
+			Format:   tree.PARQUET,
+			Tail:     &tree.TailParameter{},
+		},
+		ExParam: tree.ExParam{
+			Ctx: ctx,
+		},
+	}
+	param.Fileparam.FileCnt = 1
+
+	old := ONE_BATCH_MAX_ROW
+	ONE_BATCH_MAX_ROW = 128
+	defer func() {
+		ONE_BATCH_MAX_ROW = old
+	}()
+
+	rows := 0
+	for !param.Fileparam.End {
+		bat, err := ScanFileData(ctx, param, proc)
+		require.NoError(t, err)
+		require.LessOrEqual(t, bat.Length(), ONE_BATCH_MAX_ROW)
+		for i := 0; i < bat.Length(); i++ {
+			require.Equal(t, int32(rows+i), vector.GetFixedAt[int32](bat.Vecs[1], i))
+		}
+		rows += bat.Length()
+		bat.Clean(proc.Mp())
+	}
+	require.Equal(t, parquetTestRows, rows)
+	require.Nil(t, param.parqh)
+	require.Equal(t, 1, param.Fileparam.FileFin)
+}
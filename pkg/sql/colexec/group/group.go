@@ -180,20 +180,15 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 
 	bat := proc.InputBatch()
 	if bat == nil {
+		if ctr.spiller != nil {
+			if err = ctr.mergeSpilled(ap, proc, anal); err != nil {
+				return false, err
+			}
+		}
 		if ctr.bat != nil {
 			if ap.NeedEval {
-				for i, ag := range ctr.bat.Aggs {
-					vec, err := ag.Eval(proc.Mp())
-					if err != nil {
-						return false, err
-					}
-					ctr.bat.Aggs[i] = nil
-					ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
-					anal.Alloc(int64(vec.Size()))
-				}
-				ctr.bat.Aggs = nil
-				for i := range ctr.bat.Zs { // reset zs
-					ctr.bat.Zs[i] = 1
+				if err = evalAggs(ctr.bat, proc, anal); err != nil {
+					return false, err
 				}
 			}
 			ctr.bat.ExpandNulls()
@@ -313,9 +308,234 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	if err != nil {
 		return false, err
 	}
+	// group_concat can't be serialized, so it never spills.
+	if len(ap.MultiAggs) == 0 && proc.ExceedSpillSize(ctr.size()) {
+		err = ctr.spill(proc)
+	}
 	return false, err
 }
 
+// size returns the memory held by the groups in memory.
+func (ctr *container) size() int64 {
+	var size int64
+	if ctr.bat != nil {
+		size = int64(ctr.bat.Size())
+	}
+	if ctr.intHashMap != nil {
+		size += ctr.intHashMap.Size()
+	}
+	if ctr.strHashMap != nil {
+		size += ctr.strHashMap.Size()
+	}
+	return size
+}
+
+// spill splits the groups in memory into partitions and writes them into
+// temporary files, then the hash table is reset to aggregate the rest of
+// the input.
+func (ctr *container) spill(proc *process.Process) error {
+	var err error
+
+	if ctr.bat.Length() > 0 {
+		if ctr.spiller == nil {
+			if ctr.spiller, err = colexec.NewSpiller(proc.FileService, colexec.SpillPartitions); err != nil {
+				return err
+			}
+		}
+		n := ctr.spiller.Partitions()
+		ctr.ps = colexec.SpillPartition(ctr.bat.Vecs, n, ctr.ps)
+		bats, err := colexec.SpillSplit(ctr.bat, ctr.ps, n, proc.Mp())
+		if err != nil {
+			return err
+		}
+		defer colexec.CleanSpillBatches(bats, proc.Mp())
+		for i, b := range bats {
+			if b == nil {
+				continue
+			}
+			if err = ctr.spiller.Write(proc.Ctx, i, b); err != nil {
+				return err
+			}
+		}
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.cleanHashMap()
+	return nil
+}
+
+// mergeSpilled aggregates the spilled partitions one by one. The groups of
+// different partitions never overlap, so the results of the partitions are
+// simply concatenated into ctr.bat.
+func (ctr *container) mergeSpilled(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	if ctr.bat != nil {
+		if err := ctr.spill(proc); err != nil {
+			return err
+		}
+	}
+	defer ctr.cleanSpiller()
+
+	var rbat *batch.Batch
+	for i := 0; i < ctr.spiller.Partitions(); i++ {
+		bat, err := ctr.mergePartition(i, proc)
+		if err == nil && bat != nil && ap.NeedEval {
+			err = evalAggs(bat, proc, anal)
+		}
+		if err != nil {
+			if bat != nil {
+				bat.Clean(proc.Mp())
+			}
+			if rbat != nil {
+				rbat.Clean(proc.Mp())
+			}
+			return err
+		}
+		if bat == nil {
+			continue
+		}
+		if rbat == nil {
+			rbat = bat
+			continue
+		}
+		err = appendGroups(rbat, bat, proc)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			rbat.Clean(proc.Mp())
+			return err
+		}
+	}
+	ctr.bat = rbat
+	return nil
+}
+
+// mergePartition merges the partial aggregation results of a spilled partition.
+func (ctr *container) mergePartition(i int, proc *process.Process) (*batch.Batch, error) {
+	var rbat *batch.Batch
+
+	if ctr.spiller.Count(i) == 0 {
+		return nil, nil
+	}
+	mp, err := hashmap.NewStrMap(true, 0, 0, proc.Mp())
+	if err != nil {
+		return nil, err
+	}
+	defer mp.Free()
+	itr := mp.NewIterator()
+	for j := 0; j < ctr.spiller.Count(i); j++ {
+		bat, err := ctr.spiller.Read(proc.Ctx, i, j, proc.Mp())
+		if err != nil {
+			if rbat != nil {
+				rbat.Clean(proc.Mp())
+			}
+			return nil, err
+		}
+		if rbat == nil {
+			rbat = batch.NewWithSize(len(bat.Vecs))
+			rbat.Zs = proc.Mp().GetSels()
+			for k, vec := range bat.Vecs {
+				rbat.Vecs[k] = vector.NewVec(*vec.GetType())
+			}
+			rbat.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+			for k, ag := range bat.Aggs {
+				if rbat.Aggs[k], err = agg.New(ag.GetOperatorId(), ag.IsDistinct(), ag.GetInputTypes()[0]); err != nil {
+					bat.Clean(proc.Mp())
+					rbat.Clean(proc.Mp())
+					return nil, err
+				}
+			}
+		}
+		count := bat.Length()
+		for k := 0; k < count; k += hashmap.UnitLimit {
+			n := count - k
+			if n > hashmap.UnitLimit {
+				n = hashmap.UnitLimit
+			}
+			rows := mp.GroupCount()
+			vals, _, err := itr.Insert(k, n, bat.Vecs)
+			if err == nil {
+				err = ctr.batchMerge(rbat, k, n, bat, vals, rows, proc)
+			}
+			if err != nil {
+				bat.Clean(proc.Mp())
+				rbat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+		bat.Clean(proc.Mp())
+	}
+	return rbat, nil
+}
+
+func (ctr *container) batchMerge(rbat *batch.Batch, i int, n int, bat *batch.Batch, vals []uint64, hashRows uint64, proc *process.Process) error {
+	cnt := 0
+	copy(ctr.inserted[:n], ctr.zInserted[:n])
+	for k, v := range vals[:n] {
+		if v > hashRows {
+			ctr.inserted[k] = 1
+			hashRows++
+			cnt++
+			rbat.Zs = append(rbat.Zs, 0)
+		}
+		ai := int64(v) - 1
+		rbat.Zs[ai] += bat.Zs[i+k]
+	}
+	if cnt > 0 {
+		for j, vec := range rbat.Vecs {
+			if err := vector.UnionBatch(vec, bat.Vecs[j], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+				return err
+			}
+		}
+		for _, ag := range rbat.Aggs {
+			if err := ag.Grows(cnt, proc.Mp()); err != nil {
+				return err
+			}
+		}
+	}
+	for j, ag := range rbat.Aggs {
+		if err := ag.BatchMerge(bat.Aggs[j], int64(i), ctr.inserted[:n], vals); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendGroups appends the groups of bat to rbat, both batches hold
+// disjoint groups.
+func appendGroups(rbat, bat *batch.Batch, proc *process.Process) error {
+	start := int64(rbat.Length())
+	if _, err := rbat.Append(proc.Ctx, proc.Mp(), bat); err != nil {
+		return err
+	}
+	for i, ag := range rbat.Aggs {
+		if err := ag.Grows(bat.Length(), proc.Mp()); err != nil {
+			return err
+		}
+		for j := 0; j < bat.Length(); j++ {
+			if err := ag.Merge(bat.Aggs[i], start+int64(j), int64(j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// evalAggs evaluates the aggregations of bat and appends the results to its vectors.
+func evalAggs(bat *batch.Batch, proc *process.Process, anal process.Analyze) error {
+	for i, ag := range bat.Aggs {
+		vec, err := ag.Eval(proc.Mp())
+		if err != nil {
+			return err
+		}
+		bat.Aggs[i] = nil
+		bat.Vecs = append(bat.Vecs, vec)
+		anal.Alloc(int64(vec.Size()))
+	}
+	bat.Aggs = nil
+	for i := range bat.Zs { // reset zs
+		bat.Zs[i] = 1
+	}
+	return nil
+}
+
 func (ctr *container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	for _, z := range bat.Zs {
 		ctr.bat.Zs[0] += z
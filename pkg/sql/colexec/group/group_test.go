@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -121,6 +122,99 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	for _, needEval := range []bool{true, false} {
+		// SELECT SUM(t.b), AVG(t.b) FROM t GROUP BY t.a
+		tc := newTestCase([]bool{false, false}, []types.Type{types.T_int64.ToType(), types.T_int64.ToType()},
+			[]*plan.Expr{newExpression(0)}, []agg.Aggregate{
+				{Op: agg.AggregateSum, E: newExpression(1)},
+				{Op: agg.AggregateAvg, E: newExpression(1)},
+			})
+		tc.arg.NeedEval = needEval
+		tc.proc.Lim.SpillSize = 1
+
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		sums := make(map[int64]int64)
+		cnts := make(map[int64]int64)
+		for i := 0; i < 3; i++ {
+			as := make([]int64, 100)
+			bs := make([]int64, 100)
+			for j := range as {
+				as[j] = int64((i*100 + j) % 37)
+				bs[j] = int64(i*100 + j)
+				sums[as[j]] += bs[j]
+				cnts[as[j]]++
+			}
+			tc.proc.Reg.InputBatch = testutil.NewBatchWithVectors([]*vector.Vector{
+				testutil.NewVector(len(as), types.T_int64.ToType(), tc.proc.Mp(), false, as),
+				testutil.NewVector(len(bs), types.T_int64.ToType(), tc.proc.Mp(), false, bs),
+			}, nil)
+			_, err = Call(0, tc.proc, tc.arg, false, false)
+			require.NoError(t, err)
+			require.NotNil(t, tc.arg.ctr.spiller)
+		}
+		tc.proc.Reg.InputBatch = nil
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		require.True(t, end)
+		require.Nil(t, tc.arg.ctr.spiller)
+
+		rbat := tc.proc.Reg.InputBatch
+		require.Equal(t, len(sums), rbat.Length())
+		keys := vector.MustFixedCol[int64](rbat.Vecs[0])
+		if needEval {
+			vs := vector.MustFixedCol[int64](rbat.Vecs[1])
+			avgs := vector.MustFixedCol[float64](rbat.Vecs[2])
+			for i, k := range keys {
+				require.Equal(t, sums[k], vs[i])
+				require.Equal(t, float64(sums[k])/float64(cnts[k]), avgs[i])
+			}
+		} else {
+			require.Equal(t, 2, len(rbat.Aggs))
+			for i, k := range keys {
+				require.Equal(t, cnts[k], rbat.Zs[i])
+			}
+		}
+		rbat.Clean(tc.proc.Mp())
+		tc.arg.Free(tc.proc, false)
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+func TestGroupSpillOwnMemory(t *testing.T) {
+	// SELECT SUM(t.b) FROM t GROUP BY t.a
+	tc := newTestCase([]bool{false, false}, []types.Type{types.T_int64.ToType(), types.T_int64.ToType()},
+		[]*plan.Expr{newExpression(0)}, []agg.Aggregate{{Op: agg.AggregateSum, E: newExpression(1)}})
+	tc.arg.NeedEval = true
+	tc.proc.Lim.SpillSize = 1 << 20
+	// the memory held by other operators of the query never makes the
+	// group spill
+	other, err := tc.proc.Mp().Alloc(2 << 20)
+	require.NoError(t, err)
+
+	err = Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	as := []int64{1, 2, 3, 1}
+	tc.proc.Reg.InputBatch = testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewVector(len(as), types.T_int64.ToType(), tc.proc.Mp(), false, as),
+		testutil.NewVector(len(as), types.T_int64.ToType(), tc.proc.Mp(), false, as),
+	}, nil)
+	_, err = Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.Nil(t, tc.arg.ctr.spiller)
+
+	tc.proc.Reg.InputBatch = nil
+	end, err := Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.True(t, end)
+	require.Equal(t, 3, tc.proc.Reg.InputBatch.Length())
+	tc.proc.Reg.InputBatch.Clean(tc.proc.Mp())
+	tc.arg.Free(tc.proc, false)
+	tc.proc.Mp().Free(other)
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 /*
 	func TestLowCardinalityGroup(t *testing.T) {
 		{
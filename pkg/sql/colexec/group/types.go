@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_col/group_concat"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -57,6 +58,11 @@ type container struct {
 	vecs []*vector.Vector
 
 	bat *batch.Batch
+
+	// spiller holds the groups spilled to disk when the memory
+	// of the query exceeds its spill size.
+	spiller *colexec.Spiller
+	ps      []int
 }
 
 type Argument struct {
@@ -79,6 +85,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.cleanAggVectors(mp)
 		ctr.cleanGroupVectors(mp)
 		ctr.cleanMultiAggVecs(mp)
+		ctr.cleanSpiller()
 	}
 }
 
@@ -132,6 +139,13 @@ func (ctr *container) cleanGroupVectors(mp *mpool.MPool) {
 	}
 }
 
+func (ctr *container) cleanSpiller() {
+	if ctr.spiller != nil {
+		ctr.spiller.Free()
+		ctr.spiller = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.intHashMap != nil {
 		ctr.intHashMap.Free()
@@ -43,13 +43,17 @@ func Prepare(proc *process.Process, arg any) error {
 		ap.ctr.evecs = make([]evalVector, len(ap.Conditions))
 		ap.ctr.nullSels = make([]int32, 0)
 	}
-	ap.ctr.bat = batch.NewWithSize(len(ap.Typs))
-	ap.ctr.bat.Zs = proc.Mp().GetSels()
+	ap.ctr.bat = newBuildBatch(ap, proc)
+	return nil
+}
+
+func newBuildBatch(ap *Argument, proc *process.Process) *batch.Batch {
+	bat := batch.NewWithSize(len(ap.Typs))
+	bat.Zs = proc.Mp().GetSels()
 	for i, typ := range ap.Typs {
-		ap.ctr.bat.Vecs[i] = vector.NewVec(typ)
+		bat.Vecs[i] = vector.NewVec(typ)
 	}
-
-	return nil
+	return bat
 }
 
 func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool, error) {
@@ -68,11 +72,19 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool,
 			if ap.ctr.mp != nil {
 				anal.Alloc(ap.ctr.mp.Size())
 			}
+			if err := ctr.sendRuntimeFilters(ap, proc, ctr.spiller != nil); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
 			ctr.state = End
 		default:
 			if ctr.bat != nil {
 				if ap.NeedHashMap {
 					ctr.bat.Ht = hashmap.NewJoinMap(ctr.sels, ctr.nullSels, nil, ctr.mp, ctr.hasNull)
+					if ctr.spiller != nil {
+						ctr.bat.Ht.(*hashmap.JoinMap).SetSpilled(ctr.spiller)
+						ctr.spiller = nil
+					}
 				}
 				proc.SetInputBatch(ctr.bat)
 				ctr.mp = nil
@@ -108,6 +120,15 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 			return err
 		}
 		bat.Clean(proc.Mp())
+		if ap.CanSpill && ap.NeedHashMap && proc.ExceedSpillSize(int64(ctr.bat.Size())) {
+			if err = ctr.spill(ap, proc, anal); err != nil {
+				return err
+			}
+		}
+	}
+	if ctr.spiller != nil {
+		// the hash map is built for each partition by the join.
+		return ctr.spill(ap, proc, anal)
 	}
 	if ctr.bat == nil || ctr.bat.Length() == 0 || !ap.NeedHashMap {
 		return nil
@@ -167,6 +188,43 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	return nil
 }
 
+// spill splits the rows in memory into partitions by the join keys and writes
+// them into temporary files, the join is then done partition by partition.
+func (ctr *container) spill(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	var err error
+
+	if ctr.bat.Length() == 0 {
+		return nil
+	}
+	if ctr.spiller == nil {
+		if ctr.spiller, err = colexec.NewSpiller(proc.FileService, colexec.SpillPartitions); err != nil {
+			return err
+		}
+	}
+	if err = ctr.evalJoinCondition(ctr.bat, ap.Conditions, proc, anal); err != nil {
+		return err
+	}
+	n := ctr.spiller.Partitions()
+	ctr.ps = colexec.SpillPartition(ctr.vecs, n, ctr.ps)
+	ctr.cleanEvalVectors(proc.Mp())
+	bats, err := colexec.SpillSplit(ctr.bat, ctr.ps, n, proc.Mp())
+	if err != nil {
+		return err
+	}
+	defer colexec.CleanSpillBatches(bats, proc.Mp())
+	for i, b := range bats {
+		if b == nil {
+			continue
+		}
+		if err = ctr.spiller.Write(proc.Ctx, i, b); err != nil {
+			return err
+		}
+	}
+	ctr.bat.Clean(proc.Mp())
+	ctr.bat = newBuildBatch(ap, proc)
+	return nil
+}
+
 /*
 func (ctr *container) indexBuild() error {
 	// e.g. original data = ["a", "b", "a", "c", "b", "c", "a", "a"]
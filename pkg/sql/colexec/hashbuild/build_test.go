@@ -23,8 +23,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
@@ -88,6 +90,49 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestRuntimeFilter(t *testing.T) {
+	typs := []types.Type{types.T_int64.ToType()}
+	for _, c := range []struct {
+		rows int64
+		typ  engine.RuntimeFilterType
+	}{
+		{rows: 0, typ: engine.RuntimeFilterDrop},
+		{rows: Rows, typ: engine.RuntimeFilterIn},
+		{rows: RuntimeFilterInLimit * 2, typ: engine.RuntimeFilterBloom},
+	} {
+		tc := newTestCase([]bool{false}, typs, []*plan.Expr{newExpr(0, typs[0])})
+		ch := make(chan *engine.RuntimeFilter, 1)
+		tc.arg.RuntimeFilterSpecs = []*plan.RuntimeFilterSpec{{Tag: 1, Expr: newExpr(0, typs[0])}}
+		tc.arg.RuntimeFilterSenders = map[int32][]chan *engine.RuntimeFilter{1: {ch}}
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		if c.rows > 0 {
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, c.rows)
+		}
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		filter := <-ch
+		require.Equal(t, c.typ, filter.Typ)
+		if c.typ == engine.RuntimeFilterIn {
+			keys := vector.NewVec(typs[0])
+			require.NoError(t, keys.UnmarshalBinary(filter.Keys))
+			require.Equal(t, int(c.rows), keys.Length())
+		}
+		if c.typ == engine.RuntimeFilterBloom {
+			require.NotEmpty(t, filter.Bloom)
+		}
+		if bat := tc.proc.Reg.InputBatch; bat != nil {
+			if bat.Ht != nil {
+				bat.Ht.(*hashmap.JoinMap).Free()
+			}
+			bat.Clean(tc.proc.Mp())
+		}
+		tc.arg.Free(tc.proc, false)
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
 func BenchmarkBuild(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []buildTestCase{
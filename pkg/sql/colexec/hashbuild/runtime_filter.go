@@ -0,0 +1,192 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashbuild
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// RuntimeFilterInLimit is the max number of the distinct keys sent by the IN filter.
+	RuntimeFilterInLimit = 1024
+	// RuntimeFilterBloomLimit is the max number of the distinct keys of the bloom filter,
+	// only the range of the keys is sent if there are more.
+	RuntimeFilterBloomLimit = 1 << 22
+)
+
+// sendRuntimeFilters generates the runtime filters from the keys of the build side and
+// sends them to the table scans of the probe side.
+func (ctr *container) sendRuntimeFilters(ap *Argument, proc *process.Process, spilled bool) error {
+	for _, spec := range ap.RuntimeFilterSpecs {
+		chs := ap.RuntimeFilterSenders[spec.Tag]
+		if len(chs) == 0 {
+			continue
+		}
+		filter := &engine.RuntimeFilter{Typ: engine.RuntimeFilterPass}
+		// the keys of the spilled rows are not in memory
+		if !spilled {
+			var err error
+			if filter, err = ctr.newRuntimeFilter(spec, proc); err != nil {
+				return err
+			}
+		}
+		for _, ch := range chs {
+			select {
+			case ch <- filter:
+			default:
+			}
+		}
+	}
+	ap.RuntimeFilterSenders = nil
+	return nil
+}
+
+// passRuntimeFilters tells the table scans to read all the rows if the filters
+// are not sent.
+func (ap *Argument) passRuntimeFilters() {
+	for _, chs := range ap.RuntimeFilterSenders {
+		for _, ch := range chs {
+			select {
+			case ch <- &engine.RuntimeFilter{Typ: engine.RuntimeFilterPass}:
+			default:
+			}
+		}
+	}
+	ap.RuntimeFilterSenders = nil
+}
+
+func (ctr *container) newRuntimeFilter(spec *plan.RuntimeFilterSpec, proc *process.Process) (*engine.RuntimeFilter, error) {
+	if ctr.bat == nil || ctr.bat.Length() == 0 {
+		return &engine.RuntimeFilter{Typ: engine.RuntimeFilterDrop}, nil
+	}
+	vec, err := colexec.EvalExpr(ctr.bat, proc, spec.Expr)
+	if err != nil {
+		return nil, err
+	}
+	needFree := true
+	for i := range ctr.bat.Vecs {
+		if ctr.bat.Vecs[i] == vec {
+			needFree = false
+			break
+		}
+	}
+	if needFree {
+		defer vec.Free(proc.Mp())
+	}
+	if vec.IsConst() || !supportRuntimeFilter(vec.GetType().Oid) {
+		return &engine.RuntimeFilter{Typ: engine.RuntimeFilterPass}, nil
+	}
+
+	// collect the first rows of the distinct keys and the rows of the smallest
+	// and the largest keys, the null keys never match the join.
+	typ := *vec.GetType()
+	rows := make(map[any]int64)
+	var min, max any
+	var minRow, maxRow int64
+	op := func(v any, row int) error {
+		if nulls.Contains(vec.GetNulls(), uint64(row)) {
+			return nil
+		}
+		key := v
+		if bs, ok := v.([]byte); ok {
+			key = string(bs)
+		}
+		if _, ok := rows[key]; !ok && len(rows) <= RuntimeFilterBloomLimit {
+			rows[key] = int64(row)
+		}
+		if min == nil || compute.CompareGeneric(v, min, typ) < 0 {
+			min, minRow = v, int64(row)
+		}
+		if max == nil || compute.CompareGeneric(v, max, typ) > 0 {
+			max, maxRow = v, int64(row)
+		}
+		return nil
+	}
+	if err = containers.NewVectorWithSharedMemory(vec, true).ForeachShallow(op, nil); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return &engine.RuntimeFilter{Typ: engine.RuntimeFilterDrop}, nil
+	}
+
+	sels := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		sels = append(sels, row)
+	}
+	sort.Slice(sels, func(i, j int) bool { return sels[i] < sels[j] })
+	if len(rows) <= RuntimeFilterInLimit {
+		keys, err := marshalRuntimeFilterKeys(vec, sels, proc)
+		if err != nil {
+			return nil, err
+		}
+		return &engine.RuntimeFilter{Typ: engine.RuntimeFilterIn, Keys: keys}, nil
+	}
+
+	filter := &engine.RuntimeFilter{Typ: engine.RuntimeFilterMinMax}
+	if filter.Keys, err = marshalRuntimeFilterKeys(vec, []int64{minRow, maxRow}, proc); err != nil {
+		return nil, err
+	}
+	if len(rows) > RuntimeFilterBloomLimit {
+		return filter, nil
+	}
+	keys := vector.NewVec(typ)
+	defer keys.Free(proc.Mp())
+	if err = keys.Union(vec, sels, proc.Mp()); err != nil {
+		return nil, err
+	}
+	bloom, err := index.NewBinaryFuseFilter(containers.NewVectorWithSharedMemory(keys, false))
+	if err != nil {
+		return nil, err
+	}
+	if filter.Bloom, err = bloom.Marshal(); err != nil {
+		return nil, err
+	}
+	filter.Typ = engine.RuntimeFilterBloom
+	return filter, nil
+}
+
+func marshalRuntimeFilterKeys(vec *vector.Vector, sels []int64, proc *process.Process) ([]byte, error) {
+	keys := vector.NewVec(*vec.GetType())
+	defer keys.Free(proc.Mp())
+	if err := keys.Union(vec, sels, proc.Mp()); err != nil {
+		return nil, err
+	}
+	return keys.MarshalBinary()
+}
+
+// supportRuntimeFilter returns true if the keys of the type are able to be
+// compared with the zonemaps and hashed by the bloom filters.
+func supportRuntimeFilter(oid types.T) bool {
+	switch oid {
+	case types.T_bool, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_time, types.T_datetime,
+		types.T_timestamp, types.T_decimal64, types.T_decimal128, types.T_uuid,
+		types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_text:
+		return true
+	}
+	return false
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -49,6 +51,11 @@ type container struct {
 	mp *hashmap.StrHashMap
 
 	nullSels []int32
+
+	// spiller holds the rows spilled to disk when the memory
+	// of the query exceeds its spill size.
+	spiller *colexec.Spiller
+	ps      []int
 }
 
 type Argument struct {
@@ -63,9 +70,19 @@ type Argument struct {
 	Conditions     []*plan.Expr
 
 	IsRight bool
+	// CanSpill is true if the join is able to probe a build side
+	// spilled to disk.
+	CanSpill bool
+
+	// RuntimeFilterSpecs are the runtime filters generated from the keys of the build side.
+	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
+	// RuntimeFilterSenders are the channels to the table scans of the probe side
+	// for each runtime filter tag.
+	RuntimeFilterSenders map[int32][]chan *engine.RuntimeFilter
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	arg.passRuntimeFilters()
 	ctr := arg.ctr
 	if ctr != nil {
 		mp := proc.Mp()
@@ -74,6 +91,10 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		if !arg.NeedHashMap {
 			ctr.cleanHashMap()
 		}
+		if ctr.spiller != nil {
+			ctr.spiller.Free()
+			ctr.spiller = nil
+		}
 	}
 }
 
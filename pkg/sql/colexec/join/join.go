@@ -59,12 +59,23 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 			anal.WaitStop(start)
 
 			if bat == nil {
-				ctr.state = End
+				if ctr.spilled != nil {
+					ctr.state = SpillProbe
+				} else {
+					ctr.state = End
+				}
 				continue
 			}
 			if bat.Length() == 0 {
 				continue
 			}
+			if ctr.spilled != nil {
+				if err := ctr.spillProbe(bat, ap, proc, anal); err != nil {
+					ap.Free(proc, true)
+					return false, err
+				}
+				continue
+			}
 			if ctr.bat == nil || ctr.bat.Length() == 0 {
 				bat.Clean(proc.Mp())
 				continue
@@ -75,6 +86,22 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 			}
 			return false, nil
 
+		case SpillProbe:
+			bat, err := ctr.nextSpilledProbe(ap, proc, anal)
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc, anal, isFirst, isLast); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			return false, nil
+
 		default:
 			ap.Free(proc, false)
 			proc.SetInputBatch(nil)
@@ -89,13 +116,148 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	anal.WaitStop(start)
 
 	if bat != nil {
+		jm := bat.Ht.(*hashmap.JoinMap).Dup()
+		if spilled := jm.Spilled(); spilled != nil {
+			// the build side is spilled, the join is done partition by partition.
+			bat.Clean(proc.Mp())
+			ctr.spillMap = jm
+			ctr.spilled = spilled.(*colexec.Spiller)
+			return nil
+		}
 		ctr.bat = bat
-		ctr.mp = bat.Ht.(*hashmap.JoinMap).Dup()
+		ctr.mp = jm
 		anal.Alloc(ctr.mp.Map().Size())
 	}
 	return nil
 }
 
+// spillProbe splits the probe batch into partitions by the join keys and
+// writes them into temporary files.
+func (ctr *container) spillProbe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze) error {
+	var err error
+
+	defer bat.Clean(proc.Mp())
+	if ctr.probeSpiller == nil {
+		if ctr.probeSpiller, err = colexec.NewSpiller(proc.FileService, ctr.spilled.Partitions()); err != nil {
+			return err
+		}
+	}
+	idxFlg := false
+	ctr.cleanEvalVectors(proc.Mp())
+	if err = ctr.evalJoinCondition(bat, ap.Conditions[0], proc, &idxFlg, anal); err != nil {
+		return err
+	}
+	n := ctr.probeSpiller.Partitions()
+	ctr.ps = colexec.SpillPartition(ctr.vecs, n, ctr.ps)
+	ctr.cleanEvalVectors(proc.Mp())
+	bats, err := colexec.SpillSplit(bat, ctr.ps, n, proc.Mp())
+	if err != nil {
+		return err
+	}
+	defer colexec.CleanSpillBatches(bats, proc.Mp())
+	for i, b := range bats {
+		if b == nil {
+			continue
+		}
+		if err = ctr.probeSpiller.Write(proc.Ctx, i, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextSpilledProbe returns the next spilled probe batch, the hash map of the
+// build side is built when a partition is visited for the first time. It
+// returns nil if all the partitions have been joined.
+func (ctr *container) nextSpilledProbe(ap *Argument, proc *process.Process, anal process.Analyze) (*batch.Batch, error) {
+	if ctr.probeSpiller == nil {
+		return nil, nil
+	}
+	for ctr.part < ctr.spilled.Partitions() {
+		if ctr.mp == nil {
+			if ctr.spilled.Count(ctr.part) == 0 || ctr.probeSpiller.Count(ctr.part) == 0 {
+				ctr.part++
+				continue
+			}
+			if err := ctr.buildPartition(ap, proc, anal); err != nil {
+				return nil, err
+			}
+			ctr.probeIdx = 0
+		}
+		if ctr.probeIdx < ctr.probeSpiller.Count(ctr.part) {
+			bat, err := ctr.probeSpiller.Read(proc.Ctx, ctr.part, ctr.probeIdx, proc.Mp())
+			if err != nil {
+				return nil, err
+			}
+			ctr.probeIdx++
+			return bat, nil
+		}
+		ctr.cleanBatch(proc.Mp())
+		ctr.cleanHashMap()
+		ctr.part++
+	}
+	return nil, nil
+}
+
+// buildPartition loads the spilled build side of the current partition
+// and builds its hash map.
+func (ctr *container) buildPartition(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	for i := 0; i < ctr.spilled.Count(ctr.part); i++ {
+		bat, err := ctr.spilled.Read(proc.Ctx, ctr.part, i, proc.Mp())
+		if err != nil {
+			return err
+		}
+		if ctr.bat == nil {
+			ctr.bat = bat
+			continue
+		}
+		_, err = ctr.bat.Append(proc.Ctx, proc.Mp(), bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	mp, err := hashmap.NewStrMap(false, 0, 0, proc.Mp())
+	if err != nil {
+		return err
+	}
+	idxFlg := false
+	ctr.cleanEvalVectors(proc.Mp())
+	if err = ctr.evalJoinCondition(ctr.bat, ap.Conditions[1], proc, &idxFlg, anal); err != nil {
+		mp.Free()
+		return err
+	}
+	defer ctr.cleanEvalVectors(proc.Mp())
+	var sels [][]int32
+	itr := mp.NewIterator()
+	count := ctr.bat.Length()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := mp.GroupCount()
+		vals, zvals, err := itr.Insert(i, n, ctr.vecs)
+		if err != nil {
+			mp.Free()
+			return err
+		}
+		for k, v := range vals[:n] {
+			if zvals[k] == 0 || v == 0 {
+				continue
+			}
+			if v > rows {
+				sels = append(sels, make([]int32, 0))
+			}
+			ai := int64(v) - 1
+			sels[ai] = append(sels[ai], int32(i+k))
+		}
+	}
+	ctr.mp = hashmap.NewJoinMap(sels, nil, nil, mp, false)
+	anal.Alloc(mp.Size())
+	return nil
+}
+
 func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool, isLast bool) error {
 	defer bat.Clean(proc.Mp())
 	anal.Input(bat, isFirst)
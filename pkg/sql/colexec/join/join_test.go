@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
@@ -109,6 +110,81 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinSpill(t *testing.T) {
+	typ := types.T_int64.ToType()
+	newKeyBatch := func(tc joinTestCase, vs []int64) *batch.Batch {
+		return testutil.NewBatchWithVectors([]*vector.Vector{
+			testutil.NewVector(len(vs), typ, tc.proc.Mp(), false, vs),
+		}, nil)
+	}
+	join := func(spill bool) int {
+		tc := newTestCase([]bool{false}, []types.Type{typ}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+			[][]*plan.Expr{
+				{
+					newExpr(0, typ),
+				},
+				{
+					newExpr(0, typ),
+				},
+			})
+		tc.arg.Cond = nil
+		if spill {
+			tc.barg.CanSpill = true
+			tc.proc.Lim.SpillSize = 1
+		}
+
+		// build side: keys 0..49, each appears 4 times
+		err := hashbuild.Prepare(tc.proc, tc.barg)
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			vs := make([]int64, 100)
+			for j := range vs {
+				vs[j] = int64(j % 50)
+			}
+			tc.proc.Reg.MergeReceivers[0].Ch <- newKeyBatch(tc, vs)
+		}
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		ok, err := hashbuild.Call(0, tc.proc, tc.barg, false, false)
+		require.NoError(t, err)
+		require.True(t, ok)
+		bat := tc.proc.Reg.InputBatch
+		jm := bat.Ht.(*hashmap.JoinMap)
+		jm.SetDupCount(int64(1))
+		require.Equal(t, spill, jm.Spilled() != nil)
+
+		// probe side: keys 0..99
+		err = Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			vs := make([]int64, 50)
+			for j := range vs {
+				vs[j] = int64(i*50 + j)
+			}
+			tc.proc.Reg.MergeReceivers[0].Ch <- newKeyBatch(tc, vs)
+		}
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- bat
+		rows := 0
+		for {
+			ok, err := Call(0, tc.proc, tc.arg, false, false)
+			require.NoError(t, err)
+			if ok {
+				break
+			}
+			rbat := tc.proc.Reg.InputBatch
+			l, r := vector.MustFixedCol[int64](rbat.Vecs[0]), vector.MustFixedCol[int64](rbat.Vecs[1])
+			require.Equal(t, l, r)
+			rows += rbat.Length()
+			rbat.Clean(tc.proc.Mp())
+		}
+		tc.arg.Free(tc.proc, false)
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+		return rows
+	}
+	require.Equal(t, 200, join(false))
+	require.Equal(t, 200, join(true))
+}
+
 /*
 func TestLowCardinalityJoin(t *testing.T) {
 	tc := newTestCase([]bool{false}, []types.Type{types.T_varchar.ToType()}, []colexec.ResultPos{colexec.NewResultPos(1, 0)},
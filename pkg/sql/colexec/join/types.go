@@ -28,6 +28,7 @@ import (
 const (
 	Build = iota
 	Probe
+	SpillProbe
 	End
 )
 
@@ -47,6 +48,16 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.JoinMap
+
+	// spilled is the build side spilled to disk, the probe side is then
+	// spilled into probeSpiller with the same partitions, and the join is
+	// done partition by partition.
+	spilled      *colexec.Spiller
+	spillMap     *hashmap.JoinMap
+	probeSpiller *colexec.Spiller
+	part         int
+	probeIdx     int
+	ps           []int
 }
 
 type Argument struct {
@@ -57,6 +68,9 @@ type Argument struct {
 	Typs       []types.Type
 	Cond       *plan.Expr
 	Conditions [][]*plan.Expr
+
+	// RuntimeFilterSpecs are the runtime filters pushed into the scans of the probe side.
+	RuntimeFilterSpecs []*plan.RuntimeFilterSpec
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
@@ -66,6 +80,19 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.cleanBatch(mp)
 		ctr.cleanEvalVectors(mp)
 		ctr.cleanHashMap()
+		ctr.cleanSpill()
+	}
+}
+
+func (ctr *container) cleanSpill() {
+	if ctr.probeSpiller != nil {
+		ctr.probeSpiller.Free()
+		ctr.probeSpiller = nil
+	}
+	if ctr.spillMap != nil {
+		ctr.spillMap.Free()
+		ctr.spillMap = nil
+		ctr.spilled = nil
 	}
 }
 
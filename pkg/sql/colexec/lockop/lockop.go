@@ -0,0 +1,218 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockop
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/multi"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("lock(%d, %v)", ap.TableID, ap.WaitPolicy))
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ls, ok := GetLockService()
+	if !ok {
+		return moerr.NewNotSupported(proc.Ctx, "lock rows without lockservice")
+	}
+	if proc.TxnOperator == nil {
+		return moerr.NewInternalError(proc.Ctx, "lock rows without txn")
+	}
+	ap.ctr = &container{ls: ls}
+	return nil
+}
+
+// Call locks the rows of the input batches. If the WaitPolicy is WAIT, the rows are held
+// until the end of the input, and ErrTxnNeedRetry is returned instead of the rows if the
+// txn has waited for the locks, since the rows were read before the conflicting txns
+// were completed.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	bat := proc.InputBatch()
+	if bat == nil {
+		if ctr.waited {
+			ap.Free(proc, true)
+			return true, moerr.NewTxnNeedRetry(proc.Ctx)
+		}
+		if ctr.bat != nil {
+			anal.Output(ctr.bat, isLast)
+		}
+		proc.SetInputBatch(ctr.bat)
+		ctr.bat = nil
+		return true, nil
+	}
+	if bat.Length() == 0 {
+		return false, nil
+	}
+	anal.Input(bat, isFirst)
+
+	if err := ap.lockRows(proc, bat); err != nil {
+		bat.Clean(proc.Mp())
+		return false, err
+	}
+	if ap.WaitPolicy != plan.LockTarget_WAIT {
+		anal.Output(bat, isLast)
+		proc.SetInputBatch(bat)
+		return false, nil
+	}
+
+	if ctr.bat == nil {
+		ctr.bat = bat
+	} else {
+		_, err := ctr.bat.Append(proc.Ctx, proc.Mp(), bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return false, err
+		}
+	}
+	proc.SetInputBatch(&batch.Batch{})
+	return false, nil
+}
+
+func (ap *Argument) lockRows(proc *process.Process, bat *batch.Batch) error {
+	ctr := ap.ctr
+	txnID := proc.TxnOperator.Txn().ID
+	keys, err := ap.getLockKeys(proc, bat)
+	if err != nil {
+		return err
+	}
+	opts := lockservice.LockOptions{}.
+		WithMode(ap.Mode).
+		WithWaitPolicy(lockservice.FastFail)
+
+	switch ap.WaitPolicy {
+	case plan.LockTarget_NO_WAIT:
+		return ap.lock(proc, keys, txnID, opts)
+	case plan.LockTarget_SKIP_LOCKED:
+		sels := proc.Mp().GetSels()
+		defer proc.Mp().PutSels(sels)
+		for i, key := range keys {
+			err := ap.lock(proc, [][]byte{key}, txnID, opts)
+			if moerr.IsMoErrCode(err, moerr.ErrLockConflict) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			sels = append(sels, int64(i))
+		}
+		if len(sels) < len(keys) {
+			bat.Shrink(sels)
+		}
+		return nil
+	default:
+		// try to lock the rows without waiting first, so that we know whether
+		// the rows read are changed by others.
+		err := ap.lock(proc, keys, txnID, opts)
+		if !moerr.IsMoErrCode(err, moerr.ErrLockConflict) {
+			return err
+		}
+		ctr.waited = true
+		return ap.lock(proc, keys, txnID, opts.WithWaitPolicy(lockservice.Wait))
+	}
+}
+
+// getLockKeys returns the lock keys of the rows, the keys of a composite primary key are
+// serialized in the same way as the hidden composite key column written.
+func (ap *Argument) getLockKeys(proc *process.Process, bat *batch.Batch) ([][]byte, error) {
+	if len(ap.CompositeKeyIdx) == 0 {
+		return GetLockKeys(bat.GetVector(ap.PrimaryKeyIdx)), nil
+	}
+	vs := make([]*vector.Vector, len(ap.CompositeKeyIdx))
+	for i, idx := range ap.CompositeKeyIdx {
+		vs[i] = bat.GetVector(idx)
+	}
+	vec, err := multi.Serial(vs, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Free(proc.Mp())
+	return GetLockKeys(vec), nil
+}
+
+func (ap *Argument) lock(proc *process.Process, keys [][]byte, txnID []byte, opts lockservice.LockOptions) error {
+	return LockRows(proc.Ctx, ap.ctr.ls, ap.TableID, keys, txnID, opts)
+}
+
+// LockRows locks the rows of the table by the lock keys, the error of the txn aborted
+// by the deadlock detection is converted to ErrDeadLockDetected.
+func LockRows(
+	ctx context.Context,
+	ls lockservice.LockService,
+	tableID uint64,
+	keys [][]byte,
+	txnID []byte,
+	opts lockservice.LockOptions) error {
+	err := ls.Lock(ctx, tableID, keys, txnID, opts)
+	if err == lockservice.ErrDeadlockDetectorClosed {
+		return moerr.NewDeadLockDetected(ctx)
+	}
+	return err
+}
+
+// GetLockService returns the lockservice of the process
+func GetLockService() (lockservice.LockService, bool) {
+	rt := runtime.ProcessLevelRuntime()
+	if rt == nil {
+		return nil, false
+	}
+	v, ok := rt.GetGlobalVariables(runtime.LockService)
+	if !ok {
+		return nil, false
+	}
+	return v.(lockservice.LockService), true
+}
+
+// GetLockKeys returns the values of the primary key vector as the lock keys, the
+// values are copied since the lockservice holds the keys until the txn completed.
+func GetLockKeys(vec *vector.Vector) [][]byte {
+	n := vec.Length()
+	keys := make([][]byte, 0, n)
+	if vec.GetType().IsVarlen() {
+		for i := 0; i < n; i++ {
+			keys = append(keys, append([]byte(nil), vec.GetBytesAt(i)...))
+		}
+		return keys
+	}
+
+	size := vec.GetType().TypeSize()
+	data := vec.UnsafeGetRawData()
+	for i := 0; i < n; i++ {
+		j := i
+		if vec.IsConst() {
+			j = 0
+		}
+		keys = append(keys, append([]byte(nil), data[j*size:(j+1)*size]...))
+	}
+	return keys
+}
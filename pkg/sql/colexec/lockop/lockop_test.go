@@ -0,0 +1,194 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockop
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/builtin/multi"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{TableID: 1}, buf)
+	require.Equal(t, "lock(1, WAIT)", buf.String())
+}
+
+func TestGetLockKeys(t *testing.T) {
+	mp := testutil.TestUtilMp
+	vec := testutil.NewVector(3, types.T_int64.ToType(), mp, false, []int64{1, 2, 1})
+	defer vec.Free(mp)
+	keys := GetLockKeys(vec)
+	require.Equal(t, 3, len(keys))
+	require.Equal(t, keys[0], keys[2])
+	require.NotEqual(t, keys[0], keys[1])
+
+	svec := testutil.NewVector(2, types.T_varchar.ToType(), mp, false, []string{"a", "b"})
+	defer svec.Free(mp)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, GetLockKeys(svec))
+}
+
+func TestGetCompositeLockKeys(t *testing.T) {
+	proc := testutil.NewProc()
+	bat := batch.NewWithSize(3)
+	bat.Vecs[0] = testutil.NewVector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2})
+	bat.Vecs[1] = testutil.NewVector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{3, 3})
+	bat.Vecs[2] = testutil.NewVector(2, types.T_varchar.ToType(), proc.Mp(), false, []string{"a", "a"})
+	defer bat.Clean(proc.Mp())
+
+	// the keys are the serialized values of the key columns in the order of the key
+	arg := &Argument{CompositeKeyIdx: []int32{2, 0}}
+	keys, err := arg.getLockKeys(proc, bat)
+	require.NoError(t, err)
+	vec, err := multi.Serial([]*vector.Vector{bat.Vecs[2], bat.Vecs[0]}, proc)
+	require.NoError(t, err)
+	defer vec.Free(proc.Mp())
+	require.Equal(t, GetLockKeys(vec), keys)
+	require.NotEqual(t, keys[0], keys[1])
+}
+
+func TestLockNoWaitAndSkipLocked(t *testing.T) {
+	runLockOpTest(t, func(ls lockservice.LockService, proc1, proc2 *process.Process) {
+		// txn1 locks row 1 and 2
+		arg1 := newArgument(plan.LockTarget_NO_WAIT)
+		require.NoError(t, Prepare(proc1, arg1))
+		proc1.Reg.InputBatch = newBatch(proc1, 1, 2)
+		_, err := Call(0, proc1, arg1, false, false)
+		require.NoError(t, err)
+		require.Equal(t, 2, proc1.Reg.InputBatch.Length())
+		proc1.Reg.InputBatch.Clean(proc1.Mp())
+
+		// txn2 fails to lock row 2 without waiting
+		arg2 := newArgument(plan.LockTarget_NO_WAIT)
+		require.NoError(t, Prepare(proc2, arg2))
+		proc2.Reg.InputBatch = newBatch(proc2, 2, 3)
+		_, err = Call(0, proc2, arg2, false, false)
+		require.True(t, moerr.IsMoErrCode(err, moerr.ErrLockConflict))
+
+		// txn2 skips row 1 and 2 which are locked by txn1
+		arg2 = newArgument(plan.LockTarget_SKIP_LOCKED)
+		require.NoError(t, Prepare(proc2, arg2))
+		proc2.Reg.InputBatch = newBatch(proc2, 1, 2, 3, 4)
+		_, err = Call(0, proc2, arg2, false, false)
+		require.NoError(t, err)
+		require.Equal(t, 2, proc2.Reg.InputBatch.Length())
+		proc2.Reg.InputBatch.Clean(proc2.Mp())
+	})
+}
+
+func TestLockWait(t *testing.T) {
+	runLockOpTest(t, func(ls lockservice.LockService, proc1, proc2 *process.Process) {
+		arg1 := newArgument(plan.LockTarget_WAIT)
+		require.NoError(t, Prepare(proc1, arg1))
+		proc1.Reg.InputBatch = newBatch(proc1, 1, 2)
+		_, err := Call(0, proc1, arg1, false, false)
+		require.NoError(t, err)
+		// the rows are held until all rows are locked
+		require.Equal(t, 0, proc1.Reg.InputBatch.Length())
+		proc1.Reg.InputBatch = newBatch(proc1, 3)
+		_, err = Call(0, proc1, arg1, false, false)
+		require.NoError(t, err)
+		proc1.Reg.InputBatch = nil
+		end, err := Call(0, proc1, arg1, false, false)
+		require.NoError(t, err)
+		require.True(t, end)
+		require.Equal(t, 3, proc1.Reg.InputBatch.Length())
+		proc1.Reg.InputBatch.Clean(proc1.Mp())
+
+		c := make(chan error)
+		go func() {
+			arg2 := newArgument(plan.LockTarget_WAIT)
+			if err := Prepare(proc2, arg2); err != nil {
+				c <- err
+				return
+			}
+			proc2.Reg.InputBatch = newBatch(proc2, 3)
+			if _, err := Call(0, proc2, arg2, false, false); err != nil {
+				c <- err
+				return
+			}
+			proc2.Reg.InputBatch = nil
+			_, err := Call(0, proc2, arg2, false, false)
+			c <- err
+		}()
+
+		select {
+		case err := <-c:
+			require.Failf(t, "lock must wait", "err: %v", err)
+		case <-time.After(time.Millisecond * 100):
+		}
+		require.NoError(t, ls.Unlock(proc1.TxnOperator.Txn().ID))
+		// txn2 waited for txn1, the statement must be retried
+		require.True(t, moerr.IsMoErrCode(<-c, moerr.ErrTxnNeedRetry))
+	})
+}
+
+func runLockOpTest(t *testing.T, fn func(lockservice.LockService, *process.Process, *process.Process)) {
+	rt := runtime.ProcessLevelRuntime()
+	if rt == nil {
+		rt = runtime.DefaultRuntime()
+		runtime.SetupProcessLevelRuntime(rt)
+	}
+	ls := lockservice.NewLockService()
+	defer func() {
+		require.NoError(t, ls.Close())
+	}()
+	rt.SetGlobalVariables(runtime.LockService, ls)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	newProc := func(id string) *process.Process {
+		txnOp := mock_frontend.NewMockTxnOperator(ctrl)
+		txnOp.EXPECT().Txn().Return(txn.TxnMeta{ID: []byte(id)}).AnyTimes()
+		proc := testutil.NewProcess()
+		proc.Ctx = ctx
+		proc.TxnOperator = txnOp
+		return proc
+	}
+	fn(ls, newProc("txn1"), newProc("txn2"))
+}
+
+func newArgument(policy plan.LockTarget_WaitPolicy) *Argument {
+	return &Argument{
+		TableID:    1,
+		Mode:       lockservice.Exclusive,
+		WaitPolicy: policy,
+	}
+}
+
+func newBatch(proc *process.Process, values ...int64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = testutil.NewVector(len(values), types.T_int64.ToType(), proc.Mp(), false, values)
+	bat.InitZsOne(len(values))
+	return bat
+}
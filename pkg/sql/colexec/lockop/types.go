@@ -0,0 +1,54 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lockop
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type container struct {
+	ls lockservice.LockService
+	// waited is set if the txn has waited for the locks held by other txns,
+	// the rows read before are stale and the statement needs to be retried.
+	waited bool
+	// bat holds the locked rows until all the rows are locked if the
+	// WaitPolicy is WAIT.
+	bat *batch.Batch
+}
+
+type Argument struct {
+	// TableID is the id of the table whose rows are locked
+	TableID uint64
+	// PrimaryKeyIdx is the position of the primary key column in the input batch,
+	// the primary key values are used as the lock keys.
+	PrimaryKeyIdx int32
+	// CompositeKeyIdx is the positions of the columns of the composite primary key in
+	// the input batch, the serialized values of them are used as the lock keys.
+	CompositeKeyIdx []int32
+	Mode            lockservice.LockMode
+	WaitPolicy      plan.LockTarget_WaitPolicy
+	ctr             *container
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil && ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp())
+		ctr.bat = nil
+	}
+}
@@ -92,11 +92,28 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		if err = mergeSort(proc, bat, ap, ctr, anal); err != nil {
 			break
 		}
+		if proc.ExceedSpillSize(int64(ctr.bat.Size())) {
+			if err = ctr.spill(proc); err != nil {
+				break
+			}
+		}
 	}
 	if err != nil {
 		ap.Free(proc, true)
 		return false, err
 	}
+	if ctr.spiller != nil {
+		end, err = ctr.mergeRuns(proc)
+		if err != nil {
+			ap.Free(proc, true)
+			return false, err
+		}
+		anal.Output(proc.InputBatch(), isLast)
+		if end {
+			ap.Free(proc, false)
+		}
+		return end, nil
+	}
 
 	// remove and clean unnecessary vector
 	// shuffle the ctr.bat
@@ -263,6 +280,140 @@ func (ctr *container) mergeSort2(bat2 *batch.Batch, proc *process.Process) error
 	return nil
 }
 
+// spill writes the ordered batch in memory into a new run of the spiller,
+// each run is split into several files so that merging the runs only
+// needs to keep a part of each run in memory.
+func (ctr *container) spill(proc *process.Process) error {
+	var err error
+
+	if ctr.spiller == nil {
+		if ctr.spiller, err = colexec.NewSpiller(proc.FileService, 0); err != nil {
+			return err
+		}
+		ctr.spillIndex = make([]int32, len(ctr.compare0Index))
+		copy(ctr.spillIndex, ctr.compare0Index)
+	}
+	if err = ctr.bat.Shuffle(ctr.finalSelectList, proc.Mp()); err != nil {
+		return err
+	}
+	run := ctr.spiller.Partitions()
+	count := ctr.bat.Length()
+	for i := 0; i < count; i += spillRows {
+		n := count - i
+		if n > spillRows {
+			n = spillRows
+		}
+		bat := batch.NewWithSize(len(ctr.bat.Vecs))
+		for j, vec := range ctr.bat.Vecs {
+			bat.Vecs[j] = vector.NewVec(*vec.GetType())
+			if err = vector.UnionBatch(bat.Vecs[j], vec, int64(i), n, makeFlagsOne(n), proc.Mp()); err != nil {
+				bat.Clean(proc.Mp())
+				return err
+			}
+		}
+		bat.Zs = append(proc.Mp().GetSels(), ctr.bat.Zs[i:i+n]...)
+		err = ctr.spiller.Write(proc.Ctx, run, bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.finalSelectList = nil
+	return nil
+}
+
+// mergeRuns merges the spilled runs, and outputs at most spillRows rows
+// each time. It returns true when all the runs have been merged.
+func (ctr *container) mergeRuns(proc *process.Process) (bool, error) {
+	if ctr.bat != nil {
+		if err := ctr.spill(proc); err != nil {
+			return false, err
+		}
+	}
+	if ctr.runs == nil {
+		ctr.runs = make([]*spillRun, ctr.spiller.Partitions())
+		for i := range ctr.runs {
+			ctr.runs[i] = &spillRun{}
+			if err := ctr.runs[i].next(ctr.spiller, i, proc); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	var rbat *batch.Batch
+	for rows := 0; rows < spillRows; rows++ {
+		k := -1
+		for i, r := range ctr.runs {
+			if r.bat == nil {
+				continue
+			}
+			if k == -1 || ctr.compareRuns(r, ctr.runs[k]) < 0 {
+				k = i
+			}
+		}
+		if k == -1 {
+			break
+		}
+		r := ctr.runs[k]
+		if rbat == nil {
+			rbat = batch.NewWithSize(ctr.n)
+			rbat.Zs = proc.Mp().GetSels()
+			for i := range rbat.Vecs {
+				rbat.Vecs[i] = vector.NewVec(*r.bat.Vecs[i].GetType())
+			}
+		}
+		for i := range rbat.Vecs {
+			if err := rbat.Vecs[i].UnionOne(r.bat.Vecs[i], r.row, proc.Mp()); err != nil {
+				rbat.Clean(proc.Mp())
+				return false, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, r.bat.Zs[r.row])
+		if r.row++; r.row == int64(r.bat.Length()) {
+			if err := r.next(ctr.spiller, k, proc); err != nil {
+				rbat.Clean(proc.Mp())
+				return false, err
+			}
+		}
+	}
+	if rbat == nil {
+		proc.SetInputBatch(nil)
+		return true, nil
+	}
+	rbat.ExpandNulls()
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+// compareRuns compares the current rows of two runs.
+func (ctr *container) compareRuns(r0, r1 *spillRun) int {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, r0.bat.GetVector(ctr.spillIndex[i]))
+		cmp.Set(1, r1.bat.GetVector(ctr.spillIndex[i]))
+		if result := cmp.Compare(0, 1, r0.row, r1.row); result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+// next loads the next part of the i-th run, bat is nil if the run is exhausted.
+func (r *spillRun) next(s *colexec.Spiller, i int, proc *process.Process) error {
+	var err error
+
+	if r.bat != nil {
+		r.bat.Clean(proc.Mp())
+		r.bat = nil
+	}
+	r.row = 0
+	if r.part < s.Count(i) {
+		r.bat, err = s.Read(proc.Ctx, i, r.part, proc.Mp())
+		r.part++
+	}
+	return err
+}
+
 func generateSelectList(j int64) []int64 {
 	list := make([]int64, j)
 	var i int64
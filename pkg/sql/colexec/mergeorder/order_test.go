@@ -149,6 +149,46 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	for _, flag := range []plan.OrderBySpec_OrderByFlag{0, plan.OrderBySpec_DESC} {
+		tc := newTestCase([]types.Type{{Oid: types.T_int64}, {Oid: types.T_int8}}, []*plan.OrderBySpec{{Expr: newExpression(0), Flag: flag}})
+		tc.proc.Lim.SpillSize = 1
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		// the input batches of merge order are ordered
+		tc.proc.Reg.MergeReceivers[0].Ch <- newIntBatch(tc.types, tc.proc, spillRows, tc.arg.Fs)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newIntBatch(tc.types, tc.proc, spillRows, tc.arg.Fs)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newIntBatch(tc.types, tc.proc, spillRows/2, tc.arg.Fs)
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+		var vs []int64
+		for {
+			end, err := Call(0, tc.proc, tc.arg, false, false)
+			require.NoError(t, err)
+			if end {
+				require.Nil(t, tc.proc.Reg.InputBatch)
+				break
+			}
+			bat := tc.proc.Reg.InputBatch
+			require.Equal(t, 2, len(bat.Vecs))
+			require.LessOrEqual(t, bat.Length(), spillRows)
+			vs = append(vs, vector.MustFixedCol[int64](bat.Vecs[0])...)
+			bat.Clean(tc.proc.Mp())
+		}
+		require.Equal(t, spillRows*5/2, len(vs))
+		for i := 1; i < len(vs); i++ {
+			if flag == plan.OrderBySpec_DESC {
+				require.True(t, vs[i] <= vs[i-1])
+			} else {
+				require.True(t, vs[i] >= vs[i-1])
+			}
+		}
+		require.Nil(t, tc.arg.ctr.spiller)
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs := []orderTestCase{
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -40,8 +41,25 @@ type container struct {
 	unionFlag                    []uint8
 	compare0Index, compare1Index []int32
 	finalSelectList              []int64
+
+	// spiller holds the ordered runs spilled to disk when the memory
+	// of the query exceeds its spill size.
+	spiller    *colexec.Spiller
+	spillIndex []int32 // positions of the order columns in the spilled runs
+	runs       []*spillRun
+}
+
+// spillRun is the cursor of a spilled run during merging.
+type spillRun struct {
+	part int // index of the next part to load
+	row  int64
+	bat  *batch.Batch
 }
 
+// spillRows is the row count of each part of a spilled run and of each
+// batch output by merging the runs.
+const spillRows = 8192
+
 type Argument struct {
 	ctr *container          // ctr stores the attributes needn't do Serialization work
 	Fs  []*plan.OrderBySpec // Fields store the order information
@@ -52,6 +70,21 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	if ctr != nil {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
+		ctr.cleanSpiller(mp)
+	}
+}
+
+func (ctr *container) cleanSpiller(mp *mpool.MPool) {
+	for _, r := range ctr.runs {
+		if r.bat != nil {
+			r.bat.Clean(mp)
+			r.bat = nil
+		}
+	}
+	ctr.runs = nil
+	if ctr.spiller != nil {
+		ctr.spiller.Free()
+		ctr.spiller = nil
 	}
 }
 
@@ -34,7 +34,12 @@ func String(_ any, buf *bytes.Buffer) {
 	buf.WriteString("processing on duplicate key before insert")
 }
 
-func Prepare(_ *proc, _ any) error {
+func Prepare(_ *proc, x any) error {
+	arg := x.(*Argument)
+	arg.ctr = &container{
+		newRows: make(map[types.Uuid]struct{}),
+		deleted: make(map[types.Rowid]struct{}),
+	}
 	return nil
 }
 
@@ -54,7 +59,13 @@ func Call(idx int, proc *proc, x any, isFirst, isLast bool) (bool, error) {
 		return false, nil
 	}
 
-	rbat, err := resetInsertBatchForOnduplicateKey(proc, bat, arg)
+	var rbat *batch.Batch
+	var err error
+	if arg.IsReplace {
+		rbat, err = resetInsertBatchForReplace(proc, bat, arg)
+	} else {
+		rbat, err = resetInsertBatchForOnduplicateKey(proc, bat, arg)
+	}
 	if err != nil {
 		return false, err
 	}
@@ -106,6 +117,21 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 	oldRowIdVec := vector.MustFixedCol[types.Rowid](originBatch.Vecs[rowIdIdx])
 	delRowIdVec := vector.NewVec(types.T_Rowid.ToType())
 
+	// the old values of the partition key columns route the deleted rows to their partitions
+	var partitionKeys []string
+	var partitionKeyPos []int
+	var delPartitionKeyVecs []*vector.Vector
+	for _, key := range plan2.GetPartitionKeyColumns(tableDef.Partition) {
+		for j := 0; j < columnCount; j++ {
+			if tableDef.Cols[j].Name == key {
+				partitionKeys = append(partitionKeys, key)
+				partitionKeyPos = append(partitionKeyPos, columnCount+j)
+				delPartitionKeyVecs = append(delPartitionKeyVecs, vector.NewVec(*originBatch.Vecs[columnCount+j].GetType()))
+				break
+			}
+		}
+	}
+
 	var oldUniqueRowIdVec []types.Rowid
 	var delUniqueRowIdVec *vector.Vector
 	if len(insertArg.IdxIdx) > 0 {
@@ -166,6 +192,11 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 				if err != nil {
 					return nil, err
 				}
+				for j, pos := range partitionKeyPos {
+					if err := delPartitionKeyVecs[j].UnionOne(originBatch.Vecs[pos], int64(i), proc.Mp()); err != nil {
+						return nil, err
+					}
+				}
 
 				if len(insertArg.IdxIdx) > 0 {
 					err := vector.AppendFixed(delUniqueRowIdVec, oldUniqueRowIdVec[i], false, proc.GetMPool())
@@ -197,6 +228,8 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 		deleteBatch := batch.New(true, []string{catalog.Row_ID})
 		deleteBatch.SetZs(delRowIdVec.Length(), proc.Mp())
 		deleteBatch.SetVector(0, delRowIdVec)
+		deleteBatch.Attrs = append(deleteBatch.Attrs, partitionKeys...)
+		deleteBatch.Vecs = append(deleteBatch.Vecs, delPartitionKeyVecs...)
 
 		// delete origin rows
 		err := insertArg.Source.Delete(proc.Ctx, deleteBatch, catalog.Row_ID)
@@ -221,6 +254,199 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 
 }
 
+// resetInsertBatchForReplace deletes the rows conflicting with the new rows on
+// any unique key and returns the new rows to insert. A new row conflicting with
+// several rows is output by the join once for each of them, the rows of the join
+// for the same new row have the same number in the column RowIdx.
+// Like mysql, Affected counts each deleted row, and a new row replaced by a later
+// one in the same statement counts as inserted and deleted.
+func resetInsertBatchForReplace(proc *process.Process, originBatch *batch.Batch, insertArg *Argument) (*batch.Batch, error) {
+	rowIdIdx := int32(-1)
+	for _, idx := range insertArg.OnDuplicateIdx {
+		if originBatch.Vecs[idx].GetType().Oid == types.T_Rowid {
+			rowIdIdx = idx
+			break
+		}
+	}
+	if rowIdIdx == -1 {
+		return nil, moerr.NewConstraintViolation(proc.Ctx, "can not find rowid when replace")
+	}
+
+	tableDef := insertArg.TableDef
+	columnCount := len(tableDef.Cols)
+	uniqueCols := plan2.GetUniqueColAndIdxFromTableDef(tableDef)
+	checkExpr, err := plan2.GenUniqueColCheckExpr(proc.Ctx, tableDef, uniqueCols)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := make([]string, 0, len(originBatch.Vecs))
+	for i := 0; i < 2; i++ {
+		for j := 0; j < columnCount; j++ {
+			attrs = append(attrs, tableDef.Cols[j].Name)
+		}
+	}
+	for i := len(attrs); i < len(originBatch.Vecs); i++ {
+		attrs = append(attrs, "")
+	}
+	insertBatch := batch.New(true, attrs)
+	for i, v := range originBatch.Vecs {
+		insertBatch.SetVector(int32(i), vector.NewVec(*v.GetType()))
+	}
+
+	oldRowIdNulls := originBatch.Vecs[rowIdIdx].GetNulls()
+	oldRowIdVec := vector.MustFixedCol[types.Rowid](originBatch.Vecs[rowIdIdx])
+	delRowIdVec := vector.NewVec(types.T_Rowid.ToType())
+
+	// the old values of the partition key columns route the deleted rows to their partitions
+	var partitionKeys []string
+	var partitionKeyPos []int
+	var delPartitionKeyVecs []*vector.Vector
+	for _, key := range plan2.GetPartitionKeyColumns(tableDef.Partition) {
+		for j := 0; j < columnCount; j++ {
+			if tableDef.Cols[j].Name == key {
+				partitionKeys = append(partitionKeys, key)
+				partitionKeyPos = append(partitionKeyPos, columnCount+j)
+				delPartitionKeyVecs = append(delPartitionKeyVecs, vector.NewVec(*originBatch.Vecs[columnCount+j].GetType()))
+				break
+			}
+		}
+	}
+
+	// the rows of the index tables are those of the deleted rows
+	delIdxRowIdVecs := make([]*vector.Vector, len(insertArg.IdxIdx))
+	for i := range delIdxRowIdVecs {
+		delIdxRowIdVecs[i] = vector.NewVec(types.T_Rowid.ToType())
+	}
+
+	// the first row of the join for each new row, in the order of the new rows
+	rowNums := vector.MustFixedCol[types.Uuid](originBatch.Vecs[insertArg.RowIdx])
+	var newRows []int
+	for i := 0; i < originBatch.Length(); i++ {
+		if _, ok := insertArg.ctr.newRows[rowNums[i]]; !ok {
+			insertArg.ctr.newRows[rowNums[i]] = struct{}{}
+			newRows = append(newRows, i)
+		}
+		if oldRowIdNulls.Contains(uint64(i)) {
+			continue
+		}
+		rowId := oldRowIdVec[i]
+		if _, ok := insertArg.ctr.deleted[rowId]; ok {
+			continue
+		}
+		insertArg.ctr.deleted[rowId] = struct{}{}
+		if err := vector.AppendFixed(delRowIdVec, rowId, false, proc.Mp()); err != nil {
+			return nil, err
+		}
+		for j, pos := range partitionKeyPos {
+			if err := delPartitionKeyVecs[j].UnionOne(originBatch.Vecs[pos], int64(i), proc.Mp()); err != nil {
+				return nil, err
+			}
+		}
+		for j, idx := range insertArg.IdxIdx {
+			if originBatch.Vecs[idx].GetNulls().Contains(uint64(i)) {
+				continue
+			}
+			if err := delIdxRowIdVecs[j].UnionOne(originBatch.Vecs[idx], int64(i), proc.Mp()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, i := range newRows {
+		newBatch, err := fetchOneRowAsBatch(i, originBatch, proc, attrs)
+		if err != nil {
+			return nil, err
+		}
+		// the new rows before conflicting with the new row are replaced too
+		sels, err := getNotConflictRows(proc, newBatch, insertBatch, checkExpr, columnCount)
+		if err != nil {
+			newBatch.Clean(proc.Mp())
+			return nil, err
+		}
+		if replaced := insertBatch.Length() - len(sels); replaced > 0 {
+			insertBatch.Shrink(sels)
+			insertArg.Affected += uint64(2 * replaced)
+		}
+		_, err = insertBatch.Append(proc.Ctx, proc.Mp(), newBatch)
+		newBatch.Clean(proc.Mp())
+		if err != nil {
+			return nil, err
+		}
+	}
+	insertArg.Affected += uint64(delRowIdVec.Length())
+
+	if delRowIdVec.Length() > 0 {
+		deleteBatch := batch.New(true, []string{catalog.Row_ID})
+		deleteBatch.SetZs(delRowIdVec.Length(), proc.Mp())
+		deleteBatch.SetVector(0, delRowIdVec)
+		deleteBatch.Attrs = append(deleteBatch.Attrs, partitionKeys...)
+		deleteBatch.Vecs = append(deleteBatch.Vecs, delPartitionKeyVecs...)
+		if err := insertArg.Source.Delete(proc.Ctx, deleteBatch, catalog.Row_ID); err != nil {
+			deleteBatch.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	for i, vec := range delIdxRowIdVecs {
+		if vec.Length() == 0 {
+			continue
+		}
+		deleteBatch := batch.New(true, []string{catalog.Row_ID})
+		deleteBatch.SetZs(vec.Length(), proc.Mp())
+		deleteBatch.SetVector(0, vec)
+		if err := insertArg.UniqueSource[i].Delete(proc.Ctx, deleteBatch, catalog.Row_ID); err != nil {
+			deleteBatch.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	return insertBatch, nil
+}
+
+// getNotConflictRows returns the rows of insertBatch not conflicting with the
+// new row on any unique key
+func getNotConflictRows(proc *process.Process, newBatch *batch.Batch, insertBatch *batch.Batch,
+	checkExpr []*plan2.Expr, colCount int) ([]int64, error) {
+	if insertBatch.Length() == 0 {
+		return nil, nil
+	}
+	// fill newBatch to insertBatch's old columns, which are not used
+	for j := 0; j < colCount; j++ {
+		fromVec := newBatch.Vecs[j]
+		toVec := insertBatch.Vecs[j+colCount]
+		for i := 0; i < insertBatch.Length(); i++ {
+			if err := toVec.Copy(fromVec, int64(i), 0, proc.Mp()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	conflict := make([]bool, insertBatch.Length())
+	for _, e := range checkExpr {
+		result, err := colexec.EvalExpr(insertBatch, proc, e)
+		if err != nil {
+			return nil, err
+		}
+		flags := vector.MustFixedCol[bool](result)
+		nsp := result.GetNulls()
+		for i := range conflict {
+			if result.IsConst() {
+				conflict[i] = conflict[i] || (!result.IsConstNull() && flags[0])
+			} else {
+				conflict[i] = conflict[i] || (!nsp.Contains(uint64(i)) && flags[i])
+			}
+		}
+		result.Free(proc.Mp())
+	}
+
+	sels := make([]int64, 0, len(conflict))
+	for i, c := range conflict {
+		if !c {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels, nil
+}
+
 func resetColPos(e *plan.Expr, columnCount int) {
 	switch tmpExpr := e.Expr.(type) {
 	case *plan.Expr_Col:
@@ -0,0 +1,132 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onduplicatekey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRelation struct {
+	engine.Relation
+	deleted []types.Rowid
+}
+
+func (e *mockRelation) Delete(_ context.Context, b *batch.Batch, _ string) error {
+	e.deleted = append(e.deleted, vector.MustFixedCol[types.Rowid](b.Vecs[0])...)
+	return nil
+}
+
+func makeRowIdVector(t *testing.T, proc *proc, ids []types.Rowid, nsp []bool) *vector.Vector {
+	vec := vector.NewVec(types.T_Rowid.ToType())
+	for i, id := range ids {
+		require.NoError(t, vector.AppendFixed(vec, id, nsp[i], proc.Mp()))
+	}
+	return vec
+}
+
+func makeRowNumVector(t *testing.T, proc *proc, nums []types.Uuid) *vector.Vector {
+	vec := vector.NewVec(types.T_uuid.ToType())
+	for _, num := range nums {
+		require.NoError(t, vector.AppendFixed(vec, num, false, proc.Mp()))
+	}
+	return vec
+}
+
+func TestReplace(t *testing.T) {
+	proc := testutil.NewProc()
+	i64typ := &plan.Type{Id: int32(types.T_int64)}
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{Name: "a", Typ: i64typ},
+			{Name: "b", Typ: i64typ},
+		},
+		Name2ColIndex: map[string]int32{"a": 0, "b": 1},
+		Pkey:          &plan.PrimaryKeyDef{Names: []string{"a"}, PkeyColName: "a"},
+		Indexes: []*plan.IndexDef{
+			{Unique: true, Parts: []string{"b"}, TableExist: true},
+		},
+	}
+
+	// replace into t values (1, 20), (3, 30), (3, 31) with the rows (1, 10)
+	// and (2, 20) in t, the first new row conflicts with both of them, and
+	// the rows of the join for it are not adjacent
+	rowId1, rowId2 := types.Rowid{1}, types.Rowid{2}
+	idxRowId1, idxRowId2 := types.Rowid{11}, types.Rowid{12}
+	rowNum1, rowNum2, rowNum3 := types.Uuid{1}, types.Uuid{2}, types.Uuid{3}
+	nsp := []bool{false, true, false, true}
+	bat := &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeInt64Vector([]int64{1, 3, 1, 3}, nil),
+			testutil.MakeInt64Vector([]int64{20, 30, 20, 31}, nil),
+			testutil.MakeInt64Vector([]int64{1, 0, 2, 0}, []uint64{1, 3}),
+			testutil.MakeInt64Vector([]int64{10, 0, 20, 0}, []uint64{1, 3}),
+			makeRowIdVector(t, proc, []types.Rowid{rowId1, {}, rowId2, {}}, nsp),
+			makeRowIdVector(t, proc, []types.Rowid{idxRowId1, {}, idxRowId2, {}}, nsp),
+			makeRowNumVector(t, proc, []types.Uuid{rowNum1, rowNum2, rowNum1, rowNum3}),
+		},
+		Zs: []int64{1, 1, 1, 1},
+	}
+	source, uniqueSource := &mockRelation{}, &mockRelation{}
+	arg := &Argument{
+		TableDef:       tableDef,
+		Source:         source,
+		UniqueSource:   []engine.Relation{uniqueSource},
+		OnDuplicateIdx: []int32{2, 3, 4},
+		IdxIdx:         []int32{5},
+		IsReplace:      true,
+		RowIdx:         6,
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	rbat, err := resetInsertBatchForReplace(proc, bat, arg)
+	require.NoError(t, err)
+	require.Equal(t, []types.Rowid{rowId1, rowId2}, source.deleted)
+	require.Equal(t, []types.Rowid{idxRowId1, idxRowId2}, uniqueSource.deleted)
+	require.Equal(t, []int64{1, 3}, vector.MustFixedCol[int64](rbat.Vecs[0]))
+	require.Equal(t, []int64{20, 31}, vector.MustFixedCol[int64](rbat.Vecs[1]))
+	// two conflicting rows are deleted, and the new row (3, 30) is inserted
+	// and then deleted
+	require.Equal(t, uint64(4), arg.Affected)
+
+	// the join outputs the first new row again in the next batch for the row
+	// (5, 20) in t, which is deleted and the new row is not inserted again
+	rowId3, idxRowId3 := types.Rowid{3}, types.Rowid{13}
+	bat = &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeInt64Vector([]int64{1}, nil),
+			testutil.MakeInt64Vector([]int64{20}, nil),
+			testutil.MakeInt64Vector([]int64{5}, nil),
+			testutil.MakeInt64Vector([]int64{20}, nil),
+			makeRowIdVector(t, proc, []types.Rowid{rowId3}, []bool{false}),
+			makeRowIdVector(t, proc, []types.Rowid{idxRowId3}, []bool{false}),
+			makeRowNumVector(t, proc, []types.Uuid{rowNum1}),
+		},
+		Zs: []int64{1},
+	}
+	rbat, err = resetInsertBatchForReplace(proc, bat, arg)
+	require.NoError(t, err)
+	require.Equal(t, 0, rbat.Length())
+	require.Equal(t, []types.Rowid{rowId1, rowId2, rowId3}, source.deleted)
+	require.Equal(t, uint64(5), arg.Affected)
+}
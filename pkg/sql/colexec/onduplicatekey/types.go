@@ -15,6 +15,7 @@
 package onduplicatekey
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -22,7 +23,16 @@ import (
 
 type proc = process.Process
 
+// container keeps the rows of REPLACE seen in the former batches, a new row
+// may be output by the join in several batches
+type container struct {
+	newRows map[types.Uuid]struct{}
+	deleted map[types.Rowid]struct{}
+}
+
 type Argument struct {
+	ctr *container
+
 	// Ts is not used
 	Ts       uint64
 	Affected uint64
@@ -35,6 +45,11 @@ type Argument struct {
 
 	OnDuplicateIdx  []int32
 	OnDuplicateExpr map[string]*plan.Expr
+	// IsReplace is set for REPLACE, the rows conflicting with a new row on
+	// any unique key are deleted, and Affected counts the deleted rows
+	IsReplace bool
+	// RowIdx is the position of the column numbering the new rows of REPLACE
+	RowIdx int32
 
 	IdxIdx []int32
 }
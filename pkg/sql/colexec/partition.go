@@ -0,0 +1,171 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// PartitionRelation is the relation of a partitioned table, whose rows are stored
+// in the hidden tables of the partitions. The rows written are routed to the
+// partitions by the partition expression, and the rows deleted are routed by the
+// old values of the partition key columns, which must be in the deleted batch.
+type PartitionRelation struct {
+	engine.Relation
+	proc *process.Process
+	// keys are the columns used by the partition expression
+	keys []string
+	// expr is evaluated on the batch of the key columns in the order of keys
+	expr       *plan.Expr
+	partitions []engine.Relation
+}
+
+func NewPartitionRelation(proc *process.Process, rel engine.Relation, keys []string,
+	expr *plan.Expr, partitions []engine.Relation) *PartitionRelation {
+	return &PartitionRelation{
+		Relation:   rel,
+		proc:       proc,
+		keys:       keys,
+		expr:       expr,
+		partitions: partitions,
+	}
+}
+
+func (r *PartitionRelation) Rows(ctx context.Context) (int64, error) {
+	var rows int64
+	for _, rel := range r.partitions {
+		cnt, err := rel.Rows(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows += cnt
+	}
+	return rows, nil
+}
+
+func (r *PartitionRelation) Write(ctx context.Context, bat *batch.Batch) error {
+	if bat == nil {
+		return nil
+	}
+	if bat.Attrs[0] == catalog.BlockMeta_MetaLoc {
+		return moerr.NewNYI(ctx, "write blocks to partitioned table")
+	}
+	sels, err := r.routeRows(ctx, bat)
+	if err != nil {
+		return err
+	}
+	for i, rel := range r.partitions {
+		if len(sels[i]) == 0 {
+			continue
+		}
+		pbat, err := shuffleBatch(r.proc, bat, bat.Attrs, sels[i])
+		if err != nil {
+			return err
+		}
+		err = rel.Write(ctx, pbat)
+		pbat.Clean(r.proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *PartitionRelation) Delete(ctx context.Context, bat *batch.Batch, name string) error {
+	sels, err := r.routeRows(ctx, bat)
+	if err != nil {
+		return err
+	}
+	for i, rel := range r.partitions {
+		if len(sels[i]) == 0 {
+			continue
+		}
+		pbat, err := shuffleBatch(r.proc, bat, []string{catalog.Row_ID}, sels[i])
+		if err != nil {
+			return err
+		}
+		err = rel.Delete(ctx, pbat, name)
+		pbat.Clean(r.proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// routeRows returns the rows of the batch belonging to each partition
+func (r *PartitionRelation) routeRows(ctx context.Context, bat *batch.Batch) ([][]int64, error) {
+	keyBat := batch.NewWithSize(len(r.keys))
+	for i, key := range r.keys {
+		for j, attr := range bat.Attrs {
+			if attr == key {
+				keyBat.Vecs[i] = bat.Vecs[j]
+				break
+			}
+		}
+		if keyBat.Vecs[i] == nil {
+			return nil, moerr.NewInternalError(ctx, "partition key column '%s' not found", key)
+		}
+	}
+	keyBat.Zs = bat.Zs
+
+	vec, err := EvalExpr(keyBat, r.proc, r.expr)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Free(r.proc.Mp())
+	if vec.GetType().Oid != types.T_int64 {
+		return nil, moerr.NewInternalError(ctx, "partition expression returns %s", vec.GetType().String())
+	}
+
+	sels := make([][]int64, len(r.partitions))
+	cols := vector.MustFixedCol[int64](vec)
+	for i := 0; i < bat.Length(); i++ {
+		idx := i
+		if vec.IsConst() {
+			idx = 0
+		}
+		if vec.GetNulls().Contains(uint64(idx)) || cols[idx] < 0 || cols[idx] >= int64(len(r.partitions)) {
+			return nil, moerr.NewInvalidInput(ctx, "table has no partition for the row")
+		}
+		sels[cols[idx]] = append(sels[cols[idx]], int64(i))
+	}
+	return sels, nil
+}
+
+// shuffleBatch copies the rows of the first len(attrs) columns of the batch
+func shuffleBatch(proc *process.Process, bat *batch.Batch, attrs []string, sels []int64) (*batch.Batch, error) {
+	rbat := batch.New(true, attrs)
+	for i := range attrs {
+		vec := vector.NewVec(*bat.Vecs[i].GetType())
+		if err := vec.Union(bat.Vecs[i], sels, proc.Mp()); err != nil {
+			vec.Free(proc.Mp())
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+		rbat.SetVector(int32(i), vec)
+	}
+	rbat.SetZs(len(sels), proc.Mp())
+	return rbat, nil
+}
@@ -0,0 +1,251 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+	"reflect"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.UnionAll {
+		buf.WriteString("recursive cte union all")
+	} else {
+		buf.WriteString("recursive cte union")
+	}
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	ap.ctr.receiverListener = make([]reflect.SelectCase, len(proc.Reg.MergeReceivers))
+	for i, mr := range proc.Reg.MergeReceivers {
+		ap.ctr.receiverListener[i] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(mr.Ch),
+		}
+	}
+	ap.ctr.aliveMergeReceiver = len(proc.Reg.MergeReceivers)
+	return nil
+}
+
+// Call outputs the rows of the anchor first, and then runs the recursive member
+// repeatedly, each iteration takes the rows produced by the last one as its working
+// table, until an iteration produces no new row.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	for {
+		switch ctr.state {
+		case receiveAnchor:
+			start := time.Now()
+			bat, end, err := receiveBatch(proc, ctr)
+			anal.WaitStop(start)
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			if end {
+				ctr.state = iterate
+				continue
+			}
+			if bat == nil {
+				continue
+			}
+			if bat.Length() == 0 {
+				bat.Clean(proc.Mp())
+				continue
+			}
+			anal.Input(bat, isFirst)
+
+			out, err := ctr.accept(ap, proc, bat)
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			if out == nil {
+				continue
+			}
+			anal.Output(out, isLast)
+			proc.SetInputBatch(out)
+			return false, nil
+
+		case iterate:
+			working := ctr.working
+			ctr.working = nil
+			if working == nil {
+				ctr.state = end
+				continue
+			}
+			ctr.depth++
+
+			bat, err := ap.Iterate(working)
+			working.Clean(proc.Mp())
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			if bat == nil {
+				continue
+			}
+			if bat.Length() == 0 {
+				bat.Clean(proc.Mp())
+				continue
+			}
+
+			out, err := ctr.accept(ap, proc, bat)
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			if out == nil {
+				continue
+			}
+			// the iteration beyond the limit is only an error if it produces new rows.
+			if ctr.depth > ap.MaxDepth {
+				out.Clean(proc.Mp())
+				ap.Free(proc, true)
+				return false, moerr.NewInternalError(proc.Ctx, "recursive query aborted after %d iterations, try increasing @@cte_max_recursion_depth to a larger value", ctr.depth)
+			}
+			anal.Output(out, isLast)
+			proc.SetInputBatch(out)
+			return false, nil
+
+		case end:
+			ap.Free(proc, false)
+			proc.SetInputBatch(nil)
+			return true, nil
+		}
+	}
+}
+
+// receiveBatch get a batch from receiver, return true if all batches have been got.
+func receiveBatch(proc *process.Process, ctr *container) (*batch.Batch, bool, error) {
+	if ctr.aliveMergeReceiver == 0 {
+		return nil, true, nil
+	}
+	chosen, value, ok := reflect.Select(ctr.receiverListener)
+	if !ok {
+		return nil, false, moerr.NewInternalError(proc.Ctx, "pipeline closed unexpectedly")
+	}
+	pointer := value.UnsafePointer()
+	bat := (*batch.Batch)(pointer)
+	if bat == nil {
+		ctr.receiverListener = append(ctr.receiverListener[:chosen], ctr.receiverListener[chosen+1:]...)
+		ctr.aliveMergeReceiver--
+	}
+	return bat, false, nil
+}
+
+// accept removes the rows of bat which have been output before if duplicates are
+// not allowed, and appends the rest of them to the working table of the next iteration.
+// it returns the batch to output, or nil if no row is left. bat is always cleaned.
+func (ctr *container) accept(ap *Argument, proc *process.Process, bat *batch.Batch) (*batch.Batch, error) {
+	// the received batch may contain constant vectors, copy it first.
+	out := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		out.Vecs[i] = vector.NewVec(*vec.GetType())
+	}
+	out, err := out.Append(proc.Ctx, proc.Mp(), bat)
+	bat.Clean(proc.Mp())
+	if err != nil {
+		out.Clean(proc.Mp())
+		return nil, err
+	}
+
+	if !ap.UnionAll {
+		if err = ctr.removeDuplicates(proc, out); err != nil {
+			out.Clean(proc.Mp())
+			return nil, err
+		}
+		if out.Length() == 0 {
+			out.Clean(proc.Mp())
+			return nil, nil
+		}
+	}
+
+	if ctr.working == nil {
+		ctr.working = batch.NewWithSize(len(out.Vecs))
+		for i, vec := range out.Vecs {
+			ctr.working.Vecs[i] = vector.NewVec(*vec.GetType())
+		}
+	}
+	if ctr.working, err = ctr.working.Append(proc.Ctx, proc.Mp(), out); err != nil {
+		out.Clean(proc.Mp())
+		return nil, err
+	}
+	return out, nil
+}
+
+// removeDuplicates removes the rows of bat which exist in the hash table, and
+// inserts the rest of them into the hash table.
+func (ctr *container) removeDuplicates(proc *process.Process, bat *batch.Batch) error {
+	var err error
+
+	if ctr.hashTable == nil {
+		if ctr.hashTable, err = hashmap.NewStrMap(true, 0, 0, proc.Mp()); err != nil {
+			return err
+		}
+	}
+
+	count := bat.Length()
+	sels := make([]int64, 0, count)
+	itr := ctr.hashTable.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := ctr.hashTable.GroupCount()
+		vs, _, err := itr.Insert(i, n, bat.Vecs)
+		if err != nil {
+			return err
+		}
+		for j, v := range vs {
+			if v > rows {
+				// ensure that the same value will only be kept once.
+				rows++
+				sels = append(sels, int64(i+j))
+			}
+		}
+	}
+
+	if len(sels) == 0 {
+		// all the rows are duplicate, the batch will be cleaned by the caller.
+		bat.Zs = bat.Zs[:0]
+		return nil
+	}
+	if len(sels) < count {
+		if err = bat.Shuffle(sels, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type recursiveTestCase struct {
+	arg    *Argument
+	proc   *process.Process
+	cancel context.CancelFunc
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(newTestCase(true, 10, nil).arg, buf)
+	String(newTestCase(false, 10, nil).arg, buf)
+}
+
+func TestPrepare(t *testing.T) {
+	tc := newTestCase(true, 10, nil)
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+}
+
+func TestRecursiveCte(t *testing.T) {
+	// n + 1 while n < 5
+	next := func(n int64) (int64, bool) { return n + 1, n < 5 }
+	tc := newTestCase(true, 10, next)
+	rows, err := run(t, tc, []int64{1, 3}, []int64{1})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 1, 2, 2, 3, 3, 3, 4, 4, 4, 5, 5, 5}, rows)
+
+	tc = newTestCase(false, 10, next)
+	rows, err = run(t, tc, []int64{1, 3}, []int64{1})
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, rows)
+
+	// the iteration never produces new rows after 3, it ends even if it's a cycle
+	cycle := func(n int64) (int64, bool) { return n%3 + 1, true }
+	tc = newTestCase(false, 10, cycle)
+	rows, err = run(t, tc, []int64{1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, rows)
+}
+
+func TestMaxRecursionDepth(t *testing.T) {
+	next := func(n int64) (int64, bool) { return n + 1, n < 5 }
+	tc := newTestCase(true, 3, next)
+	_, err := run(t, tc, []int64{1}, nil)
+	require.Error(t, err)
+
+	// the iteration beyond the limit produces no row, so the recursion ends in time
+	tc = newTestCase(true, 4, next)
+	rows, err := run(t, tc, []int64{1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, rows)
+
+	tc = newTestCase(true, 4, next)
+	rows, err = run(t, tc, []int64{2}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3, 4, 5}, rows)
+
+	// the recursion never ends without a limit
+	cycle := func(n int64) (int64, bool) { return n%3 + 1, true }
+	tc = newTestCase(true, 100, cycle)
+	_, err = run(t, tc, []int64{1}, nil)
+	require.Error(t, err)
+}
+
+// run sends the anchor batches to the operator and returns all the sorted output rows.
+func run(t *testing.T, tc recursiveTestCase, anchor0, anchor1 []int64) ([]int64, error) {
+	defer tc.cancel()
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(tc.proc, anchor0)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	if anchor1 != nil {
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(tc.proc, anchor1)
+	}
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+	var rows []int64
+	for {
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		if err != nil {
+			require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+			return nil, err
+		}
+		if end {
+			break
+		}
+		bat := tc.proc.Reg.InputBatch
+		rows = append(rows, vector.MustFixedCol[int64](bat.Vecs[0])...)
+		bat.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	sort.Slice(rows, func(i, j int) bool { return rows[i] < rows[j] })
+	return rows, nil
+}
+
+// newTestCase returns a test case whose recursive member maps each row n of
+// the working table to next(n), and the row is dropped if next returns false.
+func newTestCase(unionAll bool, maxDepth int64, next func(int64) (int64, bool)) recursiveTestCase {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	return recursiveTestCase{
+		proc: proc,
+		arg: &Argument{
+			UnionAll: unionAll,
+			MaxDepth: maxDepth,
+			Iterate: func(working *batch.Batch) (*batch.Batch, error) {
+				var vals []int64
+				for _, n := range vector.MustFixedCol[int64](working.Vecs[0]) {
+					if v, ok := next(n); ok {
+						vals = append(vals, v)
+					}
+				}
+				return newBatch(proc, vals), nil
+			},
+		},
+		cancel: cancel,
+	}
+}
+
+func newBatch(proc *process.Process, vals []int64) *batch.Batch {
+	vec := testutil.NewVector(len(vals), types.T_int64.ToType(), proc.Mp(), false, vals)
+	zs := make([]int64, len(vals))
+	for i := range zs {
+		zs[i] = 1
+	}
+	return testutil.NewBatchWithVectors([]*vector.Vector{vec}, zs)
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"reflect"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	receiveAnchor = iota
+	iterate
+	end
+)
+
+type container struct {
+	// operator execution stage.
+	state int
+	// depth is the number of iterations of the recursive member that have been run.
+	depth int64
+
+	// working is the input of the next iteration, it contains the
+	// rows produced by the anchor or by the last iteration.
+	working *batch.Batch
+
+	// hashTable stores all the output rows to remove duplicates, only used by UNION.
+	hashTable *hashmap.StrHashMap
+
+	// aliveMergeReceiver is a count for no-close receiver
+	aliveMergeReceiver int
+	// receiverListener is a structure to listen all the merge receiver.
+	receiverListener []reflect.SelectCase
+}
+
+type Argument struct {
+	ctr *container
+
+	// UnionAll is false if duplicate rows are removed from the result, a row
+	// which has been output will not be passed to the next iteration either.
+	UnionAll bool
+	// MaxDepth is the maximum number of iterations of the recursive member.
+	MaxDepth int64
+	// Iterate runs the recursive member once with the working table, and
+	// returns the rows it produces. the working table is owned by the
+	// caller, and the returned batch is owned by the operator.
+	Iterate func(working *batch.Batch) (*batch.Batch, error)
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		ctr.cleanWorking(proc.Mp())
+		ctr.cleanHashMap()
+	}
+}
+
+func (ctr *container) cleanWorking(mp *mpool.MPool) {
+	if ctr.working != nil {
+		ctr.working.Clean(mp)
+		ctr.working = nil
+	}
+}
+
+func (ctr *container) cleanHashMap() {
+	if ctr.hashTable != nil {
+		ctr.hashTable.Free()
+		ctr.hashTable = nil
+	}
+}
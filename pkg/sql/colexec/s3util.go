@@ -15,9 +15,12 @@
 package colexec
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -39,6 +42,13 @@ type WriteS3Container struct {
 	sortIndex        []int
 	nameToNullablity map[string]bool
 	pk               map[string]bool
+	// compression algorithms of the main table's columns
+	compression map[string]uint8
+	// layout[i] is the position in the batches of the main table of the i-th
+	// column of its blocks, or -1 if the column is dropped by ALTER TABLE,
+	// whose type is dropped[i]. It is nil if the batches are in the layout.
+	layout  []int
+	dropped map[int]types.T
 
 	writer  dataio.Writer
 	lengths []uint64
@@ -71,7 +81,7 @@ const (
 func NewWriteS3Container(tableDef *plan.TableDef) *WriteS3Container {
 	unique_nums := 0
 	for _, idx := range tableDef.Indexes {
-		if idx.Unique {
+		if idx.TableExist {
 			unique_nums++
 		}
 	}
@@ -80,6 +90,7 @@ func NewWriteS3Container(tableDef *plan.TableDef) *WriteS3Container {
 		sortIndex:        make([]int, 0, 1),
 		pk:               make(map[string]bool),
 		nameToNullablity: make(map[string]bool),
+		compression:      make(map[string]uint8),
 		// main table and unique tables
 		buffers:         make([]*batch.Batch, unique_nums+1),
 		tableBatches:    make([][]*batch.Batch, unique_nums+1),
@@ -118,6 +129,7 @@ func NewWriteS3Container(tableDef *plan.TableDef) *WriteS3Container {
 		if def.Primary {
 			container.pk[def.Name] = true
 		}
+		container.compression[def.Name] = getCompressAlg(def.Alg)
 	}
 	if tableDef.CompositePkey != nil {
 		def := tableDef.CompositePkey
@@ -141,11 +153,70 @@ func NewWriteS3Container(tableDef *plan.TableDef) *WriteS3Container {
 	if tableDef.ClusterBy != nil {
 		container.nameToNullablity[tableDef.ClusterBy.Name] = true
 	}
+	container.layout, container.dropped = getBlockLayout(tableDef)
 	container.resetMetaLocBat()
 
 	return container
 }
 
+// getBlockLayout returns the layout of the blocks of the table, the columns
+// dropped by ALTER TABLE are still kept in the blocks, and the hidden key
+// column is kept in its position instead of the last of the batches
+func getBlockLayout(tableDef *plan.TableDef) ([]int, map[int]types.T) {
+	var droppedCols []catalog.DroppedColumn
+	for _, def := range tableDef.GetDefs() {
+		if pro, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
+			for _, p := range pro.Properties.Properties {
+				if p.Key == catalog.PropDroppedColumns {
+					var err error
+					if droppedCols, err = catalog.DecodeDroppedColumns(p.Value); err != nil {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+	hiddenPos := len(tableDef.Cols)
+	cols := len(tableDef.Cols) + len(droppedCols)
+	if tableDef.CompositePkey != nil ||
+		(tableDef.ClusterBy != nil && util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name)) {
+		cols++
+	}
+	layout := make([]int, cols)
+	for i := range layout {
+		layout[i] = hiddenPos
+	}
+	dropped := make(map[int]types.T, len(droppedCols))
+	set := func(colId uint64, pos int) bool {
+		// the column ids are the positions in the layout starting from 1
+		if colId == 0 || colId > uint64(cols) || layout[colId-1] != hiddenPos {
+			return false
+		}
+		layout[colId-1] = pos
+		return true
+	}
+	for i, col := range tableDef.Cols {
+		if !set(col.ColId, i) {
+			return nil, nil
+		}
+	}
+	if tableDef.CompositePkey != nil && !set(tableDef.CompositePkey.ColId, hiddenPos) {
+		return nil, nil
+	}
+	for _, col := range droppedCols {
+		if !set(uint64(col.Pos)+1, -1) {
+			return nil, nil
+		}
+		dropped[col.Pos] = col.Typ
+	}
+	for i, pos := range layout {
+		if i != pos {
+			return layout, dropped
+		}
+	}
+	return nil, nil
+}
+
 func (container *WriteS3Container) resetMetaLocBat() {
 	// A simple explanation of the two vectors held by metaLocBat
 	// vecs[0] to mark which table this metaLoc belongs to: [0] means insertTable itself, [1] means the first uniqueIndex table, [2] means the second uniqueIndex table and so on
@@ -232,7 +303,7 @@ func (container *WriteS3Container) MergeBlock(idx int, length int, proc *process
 			return err
 		}
 		for i := range bats {
-			if err := WriteBlock(container, bats[i]); err != nil {
+			if err := container.writeBlock(idx, bats[i], proc); err != nil {
 				return err
 			}
 		}
@@ -301,7 +372,7 @@ func (container *WriteS3Container) MergeBlock(idx int, length int, proc *process
 			lens++
 			if lens == int(options.DefaultBlockMaxRows) {
 				lens = 0
-				if err := WriteBlock(container, container.buffers[idx]); err != nil {
+				if err := container.writeBlock(idx, container.buffers[idx], proc); err != nil {
 					return err
 				}
 				// force clean
@@ -309,7 +380,7 @@ func (container *WriteS3Container) MergeBlock(idx int, length int, proc *process
 			}
 		}
 		if lens > 0 {
-			if err := WriteBlock(container, container.buffers[idx]); err != nil {
+			if err := container.writeBlock(idx, container.buffers[idx], proc); err != nil {
 				return err
 			}
 		}
@@ -435,6 +506,49 @@ func getPrimaryKeyIdx(pk map[string]bool, Attrs []string) (bool, uint16) {
 	return found, idx
 }
 
+func getCompressAlg(typ plan.CompressType) uint8 {
+	switch typ {
+	case plan.CompressType_None:
+		return compress.None
+	case plan.CompressType_Zstd:
+		return compress.Zstd
+	case plan.CompressType_Snappy:
+		return compress.Snappy
+	}
+	return compress.Lz4
+}
+
+// writeBlock writes bat of table idx in the layout of its blocks
+func (container *WriteS3Container) writeBlock(idx int, bat *batch.Batch, proc *process.Process) error {
+	if idx != 0 || container.layout == nil {
+		return WriteBlock(container, bat)
+	}
+	rows := bat.Vecs[0].Length()
+	blk := batch.NewWithSize(len(container.layout))
+	blk.Attrs = make([]string, len(container.layout))
+	var nullVecs []*vector.Vector
+	defer func() {
+		for _, vec := range nullVecs {
+			vec.Free(proc.GetMPool())
+		}
+	}()
+	for i, pos := range container.layout {
+		if pos >= 0 {
+			blk.Attrs[i] = bat.Attrs[pos]
+			blk.Vecs[i] = bat.Vecs[pos]
+			continue
+		}
+		vec := vector.NewVec(container.dropped[i].ToType())
+		nullVecs = append(nullVecs, vec)
+		if err := vector.AppendMultiFixed(vec, 0, true, rows, proc.GetMPool()); err != nil {
+			return err
+		}
+		blk.Attrs[i] = fmt.Sprintf("%s%d", catalog.PrefixDroppedColName, i)
+		blk.Vecs[i] = vec
+	}
+	return WriteBlock(container, blk)
+}
+
 // WriteBlock WriteBlock writes one batch to a buffer and generate related indexes for this batch
 // For more information, please refer to the comment about func Write in Writer interface
 func WriteBlock(container *WriteS3Container, bat *batch.Batch) error {
@@ -442,6 +556,11 @@ func WriteBlock(container *WriteS3Container, bat *batch.Batch) error {
 	if isPK {
 		container.writer.SetPrimaryKey(pkIdx)
 	}
+	for i, attr := range bat.Attrs {
+		if alg, ok := container.compression[attr]; ok {
+			container.writer.SetCompression(uint16(i), alg)
+		}
+	}
 	_, err := container.writer.WriteBatch(bat)
 
 	if err != nil {
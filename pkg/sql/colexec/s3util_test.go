@@ -17,8 +17,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/stretchr/testify/require"
 )
@@ -55,3 +58,39 @@ func TestSortKey(t *testing.T) {
 		require.Equal(t, cols2[i], res[i])
 	}
 }
+
+func TestGetBlockLayout(t *testing.T) {
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{ColId: 1, Name: "a"},
+			{ColId: 2, Name: "b"},
+		},
+	}
+	layout, _ := getBlockLayout(tableDef)
+	require.Nil(t, layout)
+
+	// c is dropped, the composite primary key is created before d is added
+	tableDef = &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{ColId: 1, Name: "a"},
+			{ColId: 2, Name: "b"},
+			{ColId: 5, Name: "d"},
+		},
+		CompositePkey: &plan.ColDef{ColId: 4, Name: catalog.CPrimaryKeyColName},
+		Defs: []*plan.TableDef_DefType{{
+			Def: &plan.TableDef_DefType_Properties{
+				Properties: &plan.PropertiesDef{
+					Properties: []*plan.Property{{
+						Key: catalog.PropDroppedColumns,
+						Value: catalog.EncodeDroppedColumns([]catalog.DroppedColumn{
+							{Pos: 2, Typ: types.T_varchar},
+						}),
+					}},
+				},
+			},
+		}},
+	}
+	layout, dropped := getBlockLayout(tableDef)
+	require.Equal(t, []int{0, 1, -1, 3, 2}, layout)
+	require.Equal(t, map[int]types.T{2: types.T_varchar}, dropped)
+}
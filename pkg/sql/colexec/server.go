@@ -38,7 +38,8 @@ func NewServer(client logservice.CNHAKeeperClient) *Server {
 		mp:       make(map[uint64]*process.WaitRegister),
 		hakeeper: client,
 
-		uuidCsChanMap: UuidCsChanMap{mp: make(map[uuid.UUID]chan process.WrapCs)},
+		uuidCsChanMap:    UuidCsChanMap{mp: make(map[uuid.UUID]chan process.WrapCs)},
+		runtimeFilterMap: RuntimeFilterMap{mp: make(map[uuid.UUID]RuntimeFilterCh)},
 	}
 	return Srv
 }
@@ -75,6 +76,23 @@ func (srv *Server) PutNotifyChIntoUuidMap(u uuid.UUID, ch chan process.WrapCs) e
 	return nil
 }
 
+// PutRuntimeFilterCh registers the channel of a runtime filter fetched by a scan
+// running on another CN.
+func (srv *Server) PutRuntimeFilterCh(u uuid.UUID, ch RuntimeFilterCh) {
+	srv.runtimeFilterMap.Lock()
+	defer srv.runtimeFilterMap.Unlock()
+	srv.runtimeFilterMap.mp[u] = ch
+}
+
+// GetRuntimeFilterCh returns and removes the channel of a runtime filter.
+func (srv *Server) GetRuntimeFilterCh(u uuid.UUID) (RuntimeFilterCh, bool) {
+	srv.runtimeFilterMap.Lock()
+	defer srv.runtimeFilterMap.Unlock()
+	ch, ok := srv.runtimeFilterMap.mp[u]
+	delete(srv.runtimeFilterMap.mp, u)
+	return ch, ok
+}
+
 func (srv *Server) HandleRequest(ctx context.Context, req morpc.Message, _ uint64, cs morpc.ClientSession) error {
 	return nil
 }
@@ -0,0 +1,264 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+)
+
+const (
+	// SpillPartitions is the number of partitions an operator splits its
+	// intermediate state into when it spills.
+	SpillPartitions = 16
+
+	spillDir = "spill"
+)
+
+// Spiller stores the batches spilled by an operator into temporary files.
+// The batches are grouped by partition, each batch is written into its own
+// file of the local file service of the node, which is removed when the
+// spiller is freed.
+type Spiller struct {
+	id    string
+	fs    fileservice.FileService
+	files [][]string
+}
+
+// NewSpiller creates a spiller with n partitions, which writes into the local
+// file service of fs.
+func NewSpiller(fs fileservice.FileService, n int) (*Spiller, error) {
+	local, err := fileservice.Get[fileservice.FileService](fs, defines.LocalFileServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return &Spiller{
+		id:    uuid.NewString(),
+		fs:    local,
+		files: make([][]string, n),
+	}, nil
+}
+
+// Partitions returns the partition count of the spiller.
+func (s *Spiller) Partitions() int {
+	return len(s.files)
+}
+
+// Count returns the number of batches spilled into partition p.
+func (s *Spiller) Count(p int) int {
+	return len(s.files[p])
+}
+
+// Write spills the batch into partition p, the batch is not freed.
+// The partitions grow on demand if p is out of range.
+func (s *Spiller) Write(ctx context.Context, p int, bat *batch.Batch) error {
+	for p >= len(s.files) {
+		s.files = append(s.files, nil)
+	}
+	data, err := bat.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s/%s_%d_%d", spillDir, s.id, p, len(s.files[p]))
+	if err = s.fs.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(len(data)),
+				Data:   data,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	s.files[p] = append(s.files[p], name)
+	return nil
+}
+
+// Read loads the i-th batch of partition p, the memory of the batch
+// is allocated from mp.
+func (s *Spiller) Read(ctx context.Context, p, i int, mp *mpool.MPool) (*batch.Batch, error) {
+	vec := &fileservice.IOVector{
+		FilePath: s.files[p][i],
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+	}
+	if err := s.fs.Read(ctx, vec); err != nil {
+		return nil, err
+	}
+	bat := new(batch.Batch)
+	if err := bat.UnmarshalBinary(vec.Entries[0].Data); err != nil {
+		return nil, err
+	}
+	for j := range bat.Vecs {
+		v, err := bat.Vecs[j].Dup(mp)
+		if err != nil {
+			for k := 0; k < j; k++ {
+				bat.Vecs[k].Free(mp)
+			}
+			return nil, err
+		}
+		bat.Vecs[j] = v
+	}
+	for j, ag := range bat.Aggs {
+		if err := ag.WildAggReAlloc(mp); err != nil {
+			for k := 0; k < j; k++ {
+				bat.Aggs[k].Free(mp)
+			}
+			for k := range bat.Vecs {
+				bat.Vecs[k].Free(mp)
+			}
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+// Free removes all the spilled files. The files are removed even if the
+// query is canceled.
+func (s *Spiller) Free() {
+	var names []string
+	for _, files := range s.files {
+		names = append(names, files...)
+	}
+	if len(names) > 0 {
+		if err := s.fs.Delete(context.Background(), names...); err != nil {
+			logutil.Errorf("remove spilled files failed. err:%v", err)
+		}
+	}
+	s.files = nil
+}
+
+// SpillPartition computes the partition of each row of vecs, rows with
+// the same key are always put into the same one of n partitions.
+func SpillPartition(vecs []*vector.Vector, n int, ps []int) []int {
+	count := 0
+	if len(vecs) > 0 {
+		count = vecs[0].Length()
+	}
+	ps = ps[:0]
+	h := fnv.New64a()
+	for i := 0; i < count; i++ {
+		h.Reset()
+		for _, vec := range vecs {
+			_, _ = h.Write(spillKeyAt(vec, i))
+		}
+		ps = append(ps, int(h.Sum64()%uint64(n)))
+	}
+	return ps
+}
+
+var (
+	spillNullKey  = []byte{1}
+	spillValueKey = []byte{0}
+)
+
+func spillKeyAt(vec *vector.Vector, i int) []byte {
+	if vec.IsConstNull() {
+		return spillNullKey
+	}
+	if vec.IsConst() {
+		i = 0
+	} else if nulls.Contains(vec.GetNulls(), uint64(i)) {
+		return spillNullKey
+	}
+	var key []byte
+	if vec.GetType().IsFixedLen() {
+		sz := vec.GetType().TypeSize()
+		key = vec.UnsafeGetRawData()[i*sz : (i+1)*sz]
+	} else {
+		key = vec.GetBytesAt(i)
+	}
+	return append(spillValueKey[:1:1], key...)
+}
+
+// SpillSplit splits bat into n batches by the row partitions ps, a batch
+// is nil if none of the rows belongs to its partition. The aggregations of
+// bat are split as well, the i-th group of an aggregation belongs to row i.
+func SpillSplit(bat *batch.Batch, ps []int, n int, mp *mpool.MPool) ([]*batch.Batch, error) {
+	sels := make([][]int64, n)
+	for i, p := range ps {
+		sels[p] = append(sels[p], int64(i))
+	}
+	bats := make([]*batch.Batch, n)
+	for p := range sels {
+		if len(sels[p]) == 0 {
+			continue
+		}
+		b := batch.NewWithSize(len(bat.Vecs))
+		b.Attrs = bat.Attrs
+		for j, vec := range bat.Vecs {
+			b.Vecs[j] = vector.NewVec(*vec.GetType())
+			if err := b.Vecs[j].Union(vec, sels[p], mp); err != nil {
+				b.Clean(mp)
+				CleanSpillBatches(bats, mp)
+				return nil, err
+			}
+		}
+		b.Zs = mp.GetSels()
+		for _, sel := range sels[p] {
+			b.Zs = append(b.Zs, bat.Zs[sel])
+		}
+		bats[p] = b
+		if len(bat.Aggs) > 0 {
+			b.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+			for j, ag := range bat.Aggs {
+				nag, err := agg.New(ag.GetOperatorId(), ag.IsDistinct(), ag.GetInputTypes()[0])
+				if err != nil {
+					CleanSpillBatches(bats, mp)
+					return nil, err
+				}
+				b.Aggs[j] = nag
+				if err = nag.Grows(len(sels[p]), mp); err != nil {
+					CleanSpillBatches(bats, mp)
+					return nil, err
+				}
+				for k, sel := range sels[p] {
+					if err = nag.Merge(ag, int64(k), sel); err != nil {
+						CleanSpillBatches(bats, mp)
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+	return bats, nil
+}
+
+// CleanSpillBatches frees the batches returned by SpillSplit.
+func CleanSpillBatches(bats []*batch.Batch, mp *mpool.MPool) {
+	for i := range bats {
+		if bats[i] != nil {
+			bats[i].Clean(mp)
+			bats[i] = nil
+		}
+	}
+}
@@ -15,10 +15,12 @@
 package colexec
 
 import (
+	"context"
 	"sync"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -50,6 +52,22 @@ type Server struct {
 	InitSegmentId bool
 
 	uuidCsChanMap UuidCsChanMap
+
+	runtimeFilterMap RuntimeFilterMap
+}
+
+// RuntimeFilterMap holds the runtime filters waiting to be fetched by the scans
+// running on other CNs.
+type RuntimeFilterMap struct {
+	sync.Mutex
+	mp map[uuid.UUID]RuntimeFilterCh
+}
+
+// RuntimeFilterCh is the channel a hash build sends a runtime filter to, Ctx is
+// the context of the query the hash build belongs to.
+type RuntimeFilterCh struct {
+	Ctx context.Context
+	Ch  chan *engine.RuntimeFilter
 }
 
 type UuidCsChanMap struct {
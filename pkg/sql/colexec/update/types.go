@@ -59,4 +59,7 @@ type UpdateCtx struct {
 	OnSetUpdateCol    []map[string]int32
 
 	ParentIdx []map[string]int32
+
+	// PartitionIdx is the positions of the old partition key columns of each updated table
+	PartitionIdx []map[string]int32
 }
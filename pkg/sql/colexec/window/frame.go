@@ -0,0 +1,210 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// framer computes the frame of each row, a frame is a range [start, end) of the sorted rows.
+type framer struct {
+	ctr    *container
+	clause *plan.FrameClause
+
+	// offsets of the start bound and end bound, only valid if the bound is neither
+	// unbounded nor current row.
+	start, end float64
+
+	// keys and isNull are the values of the only order key, and they are only
+	// used by the RANGE frame with offset. keys of a descending order key
+	// are negated, so keys of a partition are always ascending.
+	keys   []float64
+	isNull []bool
+}
+
+func (ctr *container) newFramer(spec *plan.WindowSpec, proc *process.Process) (*framer, error) {
+	frame := spec.Frame
+	fr := &framer{
+		ctr:    ctr,
+		clause: frame,
+	}
+	fr.start = boundOffset(frame.Start)
+	fr.end = boundOffset(frame.End)
+	if frame.Type != plan.FrameClause_RANGE || !(hasOffset(frame.Start) || hasOffset(frame.End)) {
+		return fr, nil
+	}
+
+	// RANGE frame with offset, there must be exactly one numeric order key.
+	if len(spec.OrderBy) != 1 {
+		return nil, moerr.NewInternalError(proc.Ctx, "window frame of RANGE with offset requires exactly one ORDER BY expression")
+	}
+	vec := ctr.bat.Vecs[ctr.poses[len(ctr.poses)-1]]
+	rows := ctr.bat.Length()
+	fr.keys = make([]float64, rows)
+	fr.isNull = make([]bool, rows)
+	for i := 0; i < rows; i++ {
+		if vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+			fr.isNull[i] = true
+			continue
+		}
+		key, err := getFloat64(proc, vec, i)
+		if err != nil {
+			return nil, err
+		}
+		fr.keys[i] = key
+	}
+	if spec.OrderBy[0].Flag&plan.OrderBySpec_DESC != 0 {
+		for i := range fr.keys {
+			fr.keys[i] = -fr.keys[i]
+		}
+	}
+	return fr, nil
+}
+
+func (fr *framer) frame(i int) (int, int) {
+	ctr := fr.ctr
+	ps, pe := ctr.parts[ctr.rowPart[i]], ctr.parts[ctr.rowPart[i]+1]
+	fs := fr.bound(i, fr.clause.Start, fr.start, true, ps, pe)
+	fe := fr.bound(i, fr.clause.End, fr.end, false, ps, pe)
+	if fs < ps {
+		fs = ps
+	}
+	if fs > pe {
+		fs = pe
+	}
+	if fe > pe {
+		fe = pe
+	}
+	if fe < fs {
+		fe = fs
+	}
+	return fs, fe
+}
+
+// bound returns the row index of a frame bound, it is inclusive for the start bound
+// and exclusive for the end bound.
+func (fr *framer) bound(i int, b *plan.FrameBound, offset float64, isStart bool, ps, pe int) int {
+	ctr := fr.ctr
+	if b.Unbounded {
+		if b.Type == plan.FrameBound_PRECEDING {
+			return ps
+		}
+		return pe
+	}
+
+	if fr.clause.Type == plan.FrameClause_ROWS {
+		n := i
+		switch b.Type {
+		case plan.FrameBound_PRECEDING:
+			n = i - int(offset)
+		case plan.FrameBound_FOLLOWING:
+			n = i + int(offset)
+		}
+		if isStart {
+			return n
+		}
+		return n + 1
+	}
+
+	// RANGE frame
+	if b.Type == plan.FrameBound_CURRENT_ROW || fr.isNull[i] {
+		// rows of the current peer group, null values are peers of each other
+		// and any offset from null is still null.
+		if isStart {
+			return ctr.peers[ctr.rowPeer[i]]
+		}
+		return ctr.peers[ctr.rowPeer[i]+1]
+	}
+
+	// the non-null rows of the partition is [ns, ne)
+	ns, ne := ps, pe
+	for ns < ne && fr.isNull[ns] {
+		ns++
+	}
+	for ne > ns && fr.isNull[ne-1] {
+		ne--
+	}
+	target := fr.keys[i]
+	if b.Type == plan.FrameBound_PRECEDING {
+		target -= offset
+	} else {
+		target += offset
+	}
+	if isStart {
+		return ns + sort.Search(ne-ns, func(k int) bool {
+			return fr.keys[ns+k] >= target
+		})
+	}
+	return ns + sort.Search(ne-ns, func(k int) bool {
+		return fr.keys[ns+k] > target
+	})
+}
+
+func hasOffset(b *plan.FrameBound) bool {
+	return !b.Unbounded && b.Type != plan.FrameBound_CURRENT_ROW
+}
+
+func boundOffset(b *plan.FrameBound) float64 {
+	if !hasOffset(b) || b.Val == nil {
+		return 0
+	}
+	c := b.Val.GetC()
+	if c == nil {
+		return 0
+	}
+	switch v := c.Value.(type) {
+	case *plan.Const_I64Val:
+		return float64(v.I64Val)
+	case *plan.Const_Dval:
+		return v.Dval
+	}
+	return 0
+}
+
+func getFloat64(proc *process.Process, vec *vector.Vector, i int) (float64, error) {
+	switch vec.GetType().Oid {
+	case types.T_int8:
+		return float64(vector.GetFixedAt[int8](vec, i)), nil
+	case types.T_int16:
+		return float64(vector.GetFixedAt[int16](vec, i)), nil
+	case types.T_int32:
+		return float64(vector.GetFixedAt[int32](vec, i)), nil
+	case types.T_int64:
+		return float64(vector.GetFixedAt[int64](vec, i)), nil
+	case types.T_uint8:
+		return float64(vector.GetFixedAt[uint8](vec, i)), nil
+	case types.T_uint16:
+		return float64(vector.GetFixedAt[uint16](vec, i)), nil
+	case types.T_uint32:
+		return float64(vector.GetFixedAt[uint32](vec, i)), nil
+	case types.T_uint64:
+		return float64(vector.GetFixedAt[uint64](vec, i)), nil
+	case types.T_float32:
+		return float64(vector.GetFixedAt[float32](vec, i)), nil
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, i), nil
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, i).ToFloat64(), nil
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, i).ToFloat64(), nil
+	}
+	return 0, moerr.NewNYI(proc.Ctx, "window frame of RANGE with offset on ORDER BY expression of type %s", vec.GetType().String())
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"reflect"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type container struct {
+	n int // number of the input columns
+
+	fid   int32 // function id of the window function
+	isAgg bool  // whether the window function is an aggregate function
+	dist  bool  // distinct aggregate
+	aggOp int   // aggregate operator id, only valid when isAgg is true
+
+	bat *batch.Batch // bat stores all the rows of the input
+
+	// poses are the positions of the partition keys and order keys in bat,
+	// the first len(PartitionBy) of them are the partition keys.
+	poses []int32
+	cmps  []compare.Compare
+
+	// parts and peers are the boundaries of partitions and peer groups of the sorted rows.
+	// for example, parts = [0, 3, 5] means that rows [0, 3) and [3, 5) are two partitions.
+	parts []int
+	peers []int
+	// rowPart and rowPeer store the partition and peer group index of each row.
+	rowPart []int
+	rowPeer []int
+
+	// vecs are the evaluated arguments of the window function, and
+	// freeVecs are the vectors allocated by the window operator itself.
+	vecs     []*vector.Vector
+	freeVecs []*vector.Vector
+
+	// aliveMergeReceiver is a count for no-close receiver
+	aliveMergeReceiver int
+	// receiverListener is a structure to listen all the merge receiver.
+	receiverListener []reflect.SelectCase
+}
+
+type Argument struct {
+	ctr     *container
+	WinSpec *plan.WindowSpec // WinSpec is the window function and its partition, order and frame
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanVecs(mp)
+		ctr.cleanBatch(mp)
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+}
+
+func (ctr *container) cleanVecs(mp *mpool.MPool) {
+	for _, vec := range ctr.freeVecs {
+		vec.Free(mp)
+	}
+	ctr.freeVecs = nil
+	ctr.vecs = nil
+}
@@ -0,0 +1,517 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_col/group_concat"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("window(")
+	buf.WriteString(ap.WinSpec.WindowFunc.String())
+	buf.WriteString(")")
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+
+	f, ok := ap.WinSpec.WindowFunc.Expr.(*plan.Expr_F)
+	if !ok {
+		return moerr.NewInternalError(proc.Ctx, "window function should be a function expression")
+	}
+	obj := int64(uint64(f.F.Func.Obj) & function.DistinctMask)
+	fun, err := function.GetFunctionByID(proc.Ctx, obj)
+	if err != nil {
+		return err
+	}
+	ap.ctr.fid, _ = function.DecodeOverloadID(obj)
+	ap.ctr.dist = uint64(f.F.Func.Obj)&function.Distinct != 0
+	ap.ctr.isAgg = fun.IsAggregate()
+	ap.ctr.aggOp = fun.AggregateInfo
+
+	ap.ctr.receiverListener = make([]reflect.SelectCase, len(proc.Reg.MergeReceivers))
+	for i, mr := range proc.Reg.MergeReceivers {
+		ap.ctr.receiverListener[i] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(mr.Ch),
+		}
+	}
+	ap.ctr.aliveMergeReceiver = len(proc.Reg.MergeReceivers)
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	var bat *batch.Batch
+	var end bool
+	var err error
+
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	// window function needs all rows of a partition, so we
+	// receive all the batches and save them in ctr.bat first.
+	for {
+		start := time.Now()
+		bat, end, err = receiveBatch(proc, ctr)
+		if err != nil {
+			break
+		}
+		anal.WaitStop(start)
+		if end {
+			break
+		}
+
+		if bat == nil {
+			continue
+		}
+		if bat.Length() == 0 {
+			bat.Clean(proc.Mp())
+			continue
+		}
+		anal.Input(bat, isFirst)
+		err = ctr.appendBatch(proc, bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			break
+		}
+	}
+	if err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+
+	if ctr.bat == nil {
+		proc.SetInputBatch(nil)
+		return true, nil
+	}
+
+	if err = ctr.sortAndSplit(ap, proc, anal); err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+	vec, err := ctr.eval(ap, proc, anal)
+	if err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+	// the keys are useless now, remove them.
+	for i := ctr.n; i < len(ctr.bat.Vecs); i++ {
+		ctr.bat.Vecs[i].Free(proc.Mp())
+	}
+	ctr.bat.Vecs = append(ctr.bat.Vecs[:ctr.n], vec)
+
+	// output the input columns with the result of window function.
+	anal.Output(ctr.bat, isLast)
+	proc.SetInputBatch(ctr.bat)
+	ctr.bat = nil
+
+	// free and return
+	ap.Free(proc, false)
+	return true, nil
+}
+
+// receiveBatch get a batch from receiver, return true if all batches have been got.
+func receiveBatch(proc *process.Process, ctr *container) (*batch.Batch, bool, error) {
+	if ctr.aliveMergeReceiver == 0 {
+		return nil, true, nil
+	}
+	chosen, value, ok := reflect.Select(ctr.receiverListener)
+	if !ok {
+		return nil, false, moerr.NewInternalError(proc.Ctx, "pipeline closed unexpectedly")
+	}
+	pointer := value.UnsafePointer()
+	bat := (*batch.Batch)(pointer)
+	if bat == nil {
+		ctr.receiverListener = append(ctr.receiverListener[:chosen], ctr.receiverListener[chosen+1:]...)
+		ctr.aliveMergeReceiver--
+	}
+	return bat, false, nil
+}
+
+// appendBatch copies the rows of bat into ctr.bat, the received batch
+// may contain constant vectors, so it cannot be used as ctr.bat directly.
+func (ctr *container) appendBatch(proc *process.Process, bat *batch.Batch) error {
+	var err error
+
+	if ctr.bat == nil {
+		ctr.n = len(bat.Vecs)
+		ctr.bat = batch.NewWithSize(ctr.n)
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.NewVec(*vec.GetType())
+		}
+	}
+	ctr.bat, err = ctr.bat.Append(proc.Ctx, proc.Mp(), bat)
+	return err
+}
+
+// sortAndSplit sorts the rows by partition keys and order keys,
+// and then find out the boundaries of partitions and peer groups.
+func (ctr *container) sortAndSplit(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	spec := ap.WinSpec
+	bat := ctr.bat
+	rows := bat.Length()
+
+	ctr.poses = make([]int32, 0, len(spec.PartitionBy)+len(spec.OrderBy))
+	ctr.cmps = make([]compare.Compare, 0, len(spec.PartitionBy)+len(spec.OrderBy))
+	for _, expr := range spec.PartitionBy {
+		pos, err := ctr.evalKey(proc, expr, anal)
+		if err != nil {
+			return err
+		}
+		ctr.poses = append(ctr.poses, pos)
+		ctr.cmps = append(ctr.cmps, compare.New(*bat.Vecs[pos].GetType(), false, false))
+	}
+	for _, f := range spec.OrderBy {
+		pos, err := ctr.evalKey(proc, f.Expr, anal)
+		if err != nil {
+			return err
+		}
+		desc := f.Flag&plan.OrderBySpec_DESC != 0
+		nullsLast := desc
+		if f.Flag&plan.OrderBySpec_NULLS_FIRST != 0 {
+			nullsLast = false
+		} else if f.Flag&plan.OrderBySpec_NULLS_LAST != 0 {
+			nullsLast = true
+		}
+		ctr.poses = append(ctr.poses, pos)
+		ctr.cmps = append(ctr.cmps, compare.New(*bat.Vecs[pos].GetType(), desc, nullsLast))
+	}
+
+	if len(ctr.cmps) > 0 {
+		ctr.setCompares()
+		sels := make([]int64, rows)
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+		sort.SliceStable(sels, func(i, j int) bool {
+			for _, cmp := range ctr.cmps {
+				if r := cmp.Compare(0, 0, sels[i], sels[j]); r != 0 {
+					return r < 0
+				}
+			}
+			return false
+		})
+		if err := bat.Shuffle(sels, proc.Mp()); err != nil {
+			return err
+		}
+		// shuffle may re-alloc the vectors, so set the compare structures again.
+		ctr.setCompares()
+	}
+
+	npart := len(spec.PartitionBy)
+	ctr.parts = append(ctr.parts[:0], 0)
+	ctr.peers = append(ctr.peers[:0], 0)
+	ctr.rowPart = make([]int, rows)
+	ctr.rowPeer = make([]int, rows)
+	for i := 1; i < rows; i++ {
+		newPart, newPeer := false, false
+		for k, cmp := range ctr.cmps {
+			if cmp.Compare(0, 0, int64(i-1), int64(i)) != 0 {
+				newPart = k < npart
+				newPeer = true
+				break
+			}
+		}
+		if newPart {
+			ctr.parts = append(ctr.parts, i)
+		}
+		if newPeer {
+			ctr.peers = append(ctr.peers, i)
+		}
+		ctr.rowPart[i] = len(ctr.parts) - 1
+		ctr.rowPeer[i] = len(ctr.peers) - 1
+	}
+	ctr.parts = append(ctr.parts, rows)
+	ctr.peers = append(ctr.peers, rows)
+	return nil
+}
+
+// evalKey evaluates a partition key or an order key, the result will be appended to
+// ctr.bat if it is a new vector, so it can be shuffled together with the input columns.
+// the keys are removed after the window function is evaluated.
+func (ctr *container) evalKey(proc *process.Process, expr *plan.Expr, anal process.Analyze) (int32, error) {
+	vec, err := colexec.EvalExpr(ctr.bat, proc, expr)
+	if err != nil {
+		return -1, err
+	}
+	for i := range ctr.bat.Vecs {
+		if ctr.bat.Vecs[i] == vec {
+			return int32(i), nil
+		}
+	}
+	ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+	anal.Alloc(int64(vec.Size()))
+	return int32(len(ctr.bat.Vecs) - 1), nil
+}
+
+func (ctr *container) setCompares() {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, ctr.bat.Vecs[ctr.poses[i]])
+	}
+}
+
+// eval evaluates the window function for each row, and returns the result vector.
+func (ctr *container) eval(ap *Argument, proc *process.Process, anal process.Analyze) (*vector.Vector, error) {
+	f := ap.WinSpec.WindowFunc.Expr.(*plan.Expr_F)
+	ctr.vecs = make([]*vector.Vector, len(f.F.Args))
+	for i, expr := range f.F.Args {
+		vec, err := colexec.EvalExpr(ctr.bat, proc, expr)
+		if err != nil {
+			return nil, err
+		}
+		ctr.vecs[i] = vec
+		if !ctr.isInput(vec) {
+			ctr.freeVecs = append(ctr.freeVecs, vec)
+			anal.Alloc(int64(vec.Size()))
+		}
+	}
+
+	typ := ap.WinSpec.WindowFunc.Typ
+	rtyp := types.New(types.T(typ.Id), typ.Width, typ.Scale)
+	if ctr.isAgg {
+		return ctr.evalAgg(ap, proc)
+	}
+	switch ctr.fid {
+	case function.ROW_NUMBER, function.RANK, function.DENSE_RANK:
+		return ctr.evalRank(rtyp, proc)
+	case function.LAG, function.LEAD:
+		return ctr.evalLagLead(rtyp, proc)
+	case function.FIRST_VALUE, function.LAST_VALUE:
+		return ctr.evalValue(ap.WinSpec, rtyp, proc)
+	}
+	return nil, moerr.NewNYI(proc.Ctx, "window function %s", ap.WinSpec.WindowFunc.String())
+}
+
+func (ctr *container) isInput(vec *vector.Vector) bool {
+	for i := range ctr.bat.Vecs {
+		if ctr.bat.Vecs[i] == vec {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctr *container) evalRank(rtyp types.Type, proc *process.Process) (*vector.Vector, error) {
+	rows := ctr.bat.Length()
+	vs := make([]int64, rows)
+	for i := 0; i < rows; i++ {
+		ps := ctr.parts[ctr.rowPart[i]]
+		switch ctr.fid {
+		case function.ROW_NUMBER:
+			vs[i] = int64(i - ps + 1)
+		case function.RANK:
+			vs[i] = int64(ctr.peers[ctr.rowPeer[i]] - ps + 1)
+		case function.DENSE_RANK:
+			if i == ps {
+				vs[i] = 1
+			} else if ctr.rowPeer[i] != ctr.rowPeer[i-1] {
+				vs[i] = vs[i-1] + 1
+			} else {
+				vs[i] = vs[i-1]
+			}
+		}
+	}
+	vec := vector.NewVec(rtyp)
+	if err := vector.AppendFixedList(vec, vs, nil, proc.Mp()); err != nil {
+		vec.Free(proc.Mp())
+		return nil, err
+	}
+	return vec, nil
+}
+
+func (ctr *container) evalLagLead(rtyp types.Type, proc *process.Process) (*vector.Vector, error) {
+	var err error
+	var dflt *vector.Vector
+
+	offset := int64(1)
+	if len(ctr.vecs) > 1 {
+		offset = vector.GetFixedAt[int64](ctr.vecs[1], 0)
+	}
+	if len(ctr.vecs) > 2 {
+		dflt = ctr.vecs[2]
+	}
+	if ctr.fid == function.LAG {
+		offset = -offset
+	}
+
+	rows := ctr.bat.Length()
+	vec := vector.NewVec(rtyp)
+	for i := 0; i < rows; i++ {
+		ps, pe := ctr.parts[ctr.rowPart[i]], ctr.parts[ctr.rowPart[i]+1]
+		j := int64(i) + offset
+		switch {
+		case j >= int64(ps) && j < int64(pe):
+			err = vec.UnionOne(ctr.vecs[0], j, proc.Mp())
+		case dflt != nil:
+			err = vec.UnionOne(dflt, int64(i), proc.Mp())
+		default:
+			err = vec.UnionNull(proc.Mp())
+		}
+		if err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+func (ctr *container) evalValue(spec *plan.WindowSpec, rtyp types.Type, proc *process.Process) (*vector.Vector, error) {
+	var err error
+
+	fr, err := ctr.newFramer(spec, proc)
+	if err != nil {
+		return nil, err
+	}
+	rows := ctr.bat.Length()
+	vec := vector.NewVec(rtyp)
+	for i := 0; i < rows; i++ {
+		fs, fe := fr.frame(i)
+		switch {
+		case fs == fe:
+			err = vec.UnionNull(proc.Mp())
+		case ctr.fid == function.FIRST_VALUE:
+			err = vec.UnionOne(ctr.vecs[0], int64(fs), proc.Mp())
+		default:
+			err = vec.UnionOne(ctr.vecs[0], int64(fe-1), proc.Mp())
+		}
+		if err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+// evalAgg evaluates an aggregate function over the frame of each row.
+// rows with the same frame share one group of the aggregate, and if the
+// frame of a row only extends the frame of the previous row at the end,
+// the new group is computed by merging the previous group incrementally.
+func (ctr *container) evalAgg(ap *Argument, proc *process.Process) (*vector.Vector, error) {
+	var err error
+	var ag agg.Agg[any]
+
+	mp := proc.Mp()
+	rows := ctr.bat.Length()
+	vecs := ctr.vecs
+	if len(ctr.vecs) > 1 {
+		// group_concat, the last argument is the separator
+		vecs = ctr.vecs[:len(ctr.vecs)-1]
+	}
+	// aggregate functions cannot deal with constant vectors.
+	for i, vec := range vecs {
+		if vec.IsConst() {
+			nvec := vector.NewVec(*vec.GetType())
+			ctr.freeVecs = append(ctr.freeVecs, nvec)
+			if err = nvec.UnionMulti(vec, 0, rows, mp); err != nil {
+				return nil, err
+			}
+			vecs[i] = nvec
+		}
+	}
+
+	incremental := false
+	if len(ctr.vecs) > 1 {
+		f := ap.WinSpec.WindowFunc.Expr.(*plan.Expr_F)
+		typs := make([]types.Type, len(vecs))
+		for i, vec := range vecs {
+			typs[i] = *vec.GetType()
+		}
+		ag = group_concat.NewGroupConcat(&group_concat.Argument{
+			Dist:      ctr.dist,
+			GroupExpr: f.F.Args[:len(f.F.Args)-1],
+			Separator: ctr.vecs[len(ctr.vecs)-1].GetStringAt(0),
+		}, typs)
+	} else {
+		if ag, err = agg.New(ctr.aggOp, ctr.dist, *vecs[0].GetType()); err != nil {
+			return nil, err
+		}
+		switch ctr.aggOp {
+		case agg.AggregateSum, agg.AggregateAvg, agg.AggregateMax, agg.AggregateMin,
+			agg.AggregateCount, agg.AggregateStarCount, agg.AggregateBitAnd,
+			agg.AggregateBitXor, agg.AggregateBitOr, agg.AggregateAnyValue:
+			incremental = !ctr.dist
+		}
+	}
+
+	fr, err := ctr.newFramer(ap.WinSpec, proc)
+	if err != nil {
+		ag.Free(mp)
+		return nil, err
+	}
+	groups := make([]int64, rows)
+	g, lastFs, lastFe := int64(-1), -1, -1
+	for i := 0; i < rows; i++ {
+		fs, fe := fr.frame(i)
+		if g >= 0 && fs == lastFs && fe == lastFe {
+			groups[i] = g
+			continue
+		}
+		if err = ag.Grows(1, mp); err != nil {
+			ag.Free(mp)
+			return nil, err
+		}
+		g++
+		start := fs
+		if incremental && g > 0 && fs == lastFs && fe >= lastFe {
+			if err = ag.Merge(ag, g, g-1); err != nil {
+				ag.Free(mp)
+				return nil, err
+			}
+			start = lastFe
+		}
+		for j := start; j < fe; j++ {
+			if err = ag.Fill(g, int64(j), ctr.bat.Zs[j], vecs); err != nil {
+				ag.Free(mp)
+				return nil, err
+			}
+		}
+		groups[i] = g
+		lastFs, lastFe = fs, fe
+	}
+
+	rvec, err := ag.Eval(mp)
+	if err != nil {
+		return nil, err
+	}
+	defer rvec.Free(mp)
+	vec := vector.NewVec(*rvec.GetType())
+	for i := 0; i < rows; i++ {
+		if err = vec.UnionOne(rvec, groups[i], mp); err != nil {
+			vec.Free(mp)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
@@ -0,0 +1,288 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type winTestCase struct {
+	arg    *Argument
+	proc   *process.Process
+	cancel context.CancelFunc
+	// expected result of the window function, indexed by the sorted rows.
+	// nil means null.
+	expect []any
+}
+
+var (
+	// the input rows are (a, b), and they will be sorted by (a, b) as follows
+	// (1, 1), (1, 2), (1, 3), (1, 4), (2, 1), (2, 2), (2, 2)
+	batch0 = [][]int64{{1, 1, 2, 1}, {1, 3, 2, 2}}
+	batch1 = [][]int64{{2, 2, 1}, {1, 2, 4}}
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range newTestCases(t) {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range newTestCases(t) {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	for tci, tc := range newTestCases(t) {
+		runTestCase(t, tci, tc)
+	}
+}
+
+// TestComputedKeys tests the window partitioned by a+0 and ordered by b+0,
+// both keys are computed, and must be kept while sorting.
+func TestComputedKeys(t *testing.T) {
+	tc := newTestCase(t, "row_number", nil, []*plan.OrderBySpec{{Expr: newPlusZero(t, newColumn(1))}}, nil, 1, 2, 3, 4, 1, 2, 3)
+	tc.arg.WinSpec.PartitionBy = []*plan.Expr{newPlusZero(t, newColumn(0))}
+	runTestCase(t, 0, tc)
+}
+
+func runTestCase(t *testing.T, tci int, tc winTestCase) {
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(tc.proc, batch0)
+	tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(tc.proc, batch1)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	for {
+		if ok, err := Call(0, tc.proc, tc.arg, false, false); ok || err != nil {
+			require.NoError(t, err)
+			bat := tc.proc.Reg.InputBatch
+			require.Equal(t, 3, len(bat.Vecs))
+			require.Equal(t, len(tc.expect), bat.Length())
+
+			as := vector.MustFixedCol[int64](bat.Vecs[0])
+			require.Equal(t, []int64{1, 1, 1, 1, 2, 2, 2}, as)
+			vec := bat.Vecs[2]
+			for i, v := range tc.expect {
+				if v == nil {
+					require.True(t, vec.GetNulls().Contains(uint64(i)), "case %d, row %d", tci, i)
+					continue
+				}
+				require.False(t, vec.GetNulls().Contains(uint64(i)), "case %d, row %d", tci, i)
+				switch val := v.(type) {
+				case int64:
+					require.Equal(t, val, vector.GetFixedAt[int64](vec, i), "case %d, row %d", tci, i)
+				case float64:
+					require.Equal(t, val, vector.GetFixedAt[float64](vec, i), "case %d, row %d", tci, i)
+				}
+			}
+			bat.Clean(tc.proc.Mp())
+			break
+		}
+	}
+	for i := 0; i < len(tc.proc.Reg.MergeReceivers); i++ { // simulating the end of a pipeline
+		for len(tc.proc.Reg.MergeReceivers[i].Ch) > 0 {
+			bat := <-tc.proc.Reg.MergeReceivers[i].Ch
+			if bat != nil {
+				bat.Clean(tc.proc.Mp())
+			}
+		}
+	}
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
+func TestEmptyInput(t *testing.T) {
+	tc := newTestCase(t, "row_number", nil, nil, nil)
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	ok, err := Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Nil(t, tc.proc.Reg.InputBatch)
+}
+
+func newTestCases(t *testing.T) []winTestCase {
+	a, b := newColumn(0), newColumn(1)
+	rowsFrame := func(n int64) *plan.FrameClause {
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_ROWS,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newI64Const(n)},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}
+	}
+	rangeFrame := func(n float64) *plan.FrameClause {
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newF64Const(n)},
+			End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: newF64Const(n)},
+		}
+	}
+	desc := []*plan.OrderBySpec{{Expr: b, Flag: plan.OrderBySpec_DESC}}
+	return []winTestCase{
+		newTestCase(t, "row_number", nil, nil, nil, 1, 2, 3, 4, 1, 2, 3),
+		newTestCase(t, "rank", nil, nil, nil, 1, 2, 3, 4, 1, 2, 2),
+		newTestCase(t, "dense_rank", nil, nil, nil, 1, 2, 3, 4, 1, 2, 2),
+		newTestCase(t, "lag", []*plan.Expr{b}, nil, nil, nil, 1, 2, 3, nil, 1, 2),
+		newTestCase(t, "lead", []*plan.Expr{b, newI64Const(2), newI64Const(0)}, nil, nil, 3, 4, 0, 0, 2, 0, 0),
+		newTestCase(t, "first_value", []*plan.Expr{b}, desc, nil, 4, 4, 4, 4, 2, 2, 2),
+		newTestCase(t, "last_value", []*plan.Expr{b}, nil, nil, 1, 2, 3, 4, 1, 2, 2),
+		newTestCase(t, "sum", []*plan.Expr{b}, nil, nil, 1, 3, 6, 10, 1, 5, 5),
+		newTestCase(t, "sum", []*plan.Expr{b}, nil, rowsFrame(1), 1, 3, 5, 7, 1, 3, 4),
+		newTestCase(t, "sum", []*plan.Expr{b}, nil, rangeFrame(1), 3, 6, 9, 7, 5, 5, 5),
+		newTestCase(t, "max", []*plan.Expr{b}, desc, rangeFrame(1), 4, 4, 3, 2, 2, 2, 2),
+		newTestCase(t, "count", []*plan.Expr{a}, []*plan.OrderBySpec{}, nil, 4, 4, 4, 4, 3, 3, 3),
+		newTestCase(t, "avg", []*plan.Expr{b}, nil, rowsFrame(3), 1.0, 1.5, 2.0, 2.5, 1.0, 1.5, 5.0/3),
+	}
+}
+
+// newTestCase returns a test case of the window function partitioned by a.
+// the window is ordered by b if orderBy is nil, and has the default frame if frame is nil.
+func newTestCase(t *testing.T, name string, args []*plan.Expr, orderBy []*plan.OrderBySpec, frame *plan.FrameClause, expect ...any) winTestCase {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+
+	typs := make([]types.Type, len(args))
+	for i, arg := range args {
+		typs[i] = types.T(arg.Typ.Id).ToType()
+	}
+	fid, rtyp, _, err := function.GetFunctionByName(proc.Ctx, name, typs)
+	require.NoError(t, err)
+
+	if orderBy == nil {
+		orderBy = []*plan.OrderBySpec{{Expr: newColumn(1)}}
+	}
+	if frame == nil {
+		frame = &plan.FrameClause{
+			Type:  plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}
+		if len(orderBy) == 0 {
+			frame.End = &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Unbounded: true}
+		}
+	}
+	for i, v := range expect {
+		if iv, ok := v.(int); ok {
+			expect[i] = int64(iv)
+		}
+	}
+	return winTestCase{
+		proc: proc,
+		arg: &Argument{
+			WinSpec: &plan.WindowSpec{
+				WindowFunc: &plan.Expr{
+					Typ: &plan.Type{Id: int32(rtyp.Oid)},
+					Expr: &plan.Expr_F{
+						F: &plan.Function{
+							Func: &plan.ObjectRef{Obj: fid, ObjName: name},
+							Args: args,
+						},
+					},
+				},
+				PartitionBy: []*plan.Expr{newColumn(0)},
+				OrderBy:     orderBy,
+				Frame:       frame,
+			},
+		},
+		cancel: cancel,
+		expect: expect,
+	}
+}
+
+func newColumn(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+// newPlusZero returns the expression expr + 0.
+func newPlusZero(t *testing.T, expr *plan.Expr) *plan.Expr {
+	typ := types.T_int64.ToType()
+	fid, _, _, err := function.GetFunctionByName(context.Background(), "+", []types.Type{typ, typ})
+	require.NoError(t, err)
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fid, ObjName: "+"},
+				Args: []*plan.Expr{expr, newI64Const(0)},
+			},
+		},
+	}
+}
+
+func newI64Const(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{Value: &plan.Const_I64Val{I64Val: v}},
+		},
+	}
+}
+
+func newF64Const(v float64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_float64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{Value: &plan.Const_Dval{Dval: v}},
+		},
+	}
+}
+
+func newBatch(proc *process.Process, cols [][]int64) *batch.Batch {
+	vs := make([]*vector.Vector, len(cols))
+	for i, col := range cols {
+		vs[i] = testutil.NewVector(len(col), types.T_int64.ToType(), proc.Mp(), false, col)
+	}
+	zs := make([]int64, len(cols[0]))
+	for i := range zs {
+		zs[i] = 1
+	}
+	return testutil.NewBatchWithVectors(vs, zs)
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -57,6 +58,10 @@ const (
 	SingleLineSizeEstimate uint64 = 300 * mpool.B
 )
 
+// maxLockRetryTimes is the max times a statement is run again after it waited for
+// the locks held by other txns.
+const maxLockRetryTimes = 10
+
 // New is used to new an object of compile
 func New(addr, db string, sql string, uid string, ctx context.Context,
 	e engine.Engine, proc *process.Process, stmt tree.Statement) *Compile {
@@ -110,6 +115,7 @@ func (c *Compile) Compile(ctx context.Context, pn *plan.Plan, u any, fill func(a
 	// build scope for a single sql
 	s, err := c.compileScope(ctx, pn)
 	if err != nil {
+		c.closeSnapshots()
 		return err
 	}
 	c.scope = s
@@ -126,11 +132,39 @@ func (c *Compile) GetAffectedRows() uint64 {
 }
 
 // Run is an important function of the compute-layer, it executes a single sql according to its scope
-func (c *Compile) Run(_ uint64) (err error) {
+func (c *Compile) Run(ts uint64) (err error) {
 	if c.scope == nil {
 		return nil
 	}
+	defer func() {
+		// the pipelines canceled by the limits of the query may quit without
+		// an error or with a misleading one, report why they are canceled.
+		if e := c.proc.QueryLimitError(); e != nil {
+			err = e
+		}
+		c.proc.StopQueryLimiter()
+	}()
+	pn := c.scope.Plan
+	for i := 0; ; i++ {
+		err = c.run(ts)
+		if !moerr.IsMoErrCode(err, moerr.ErrTxnNeedRetry) || i >= maxLockRetryTimes {
+			return err
+		}
+		// the statement waited for the locks held by other txns, and the rows it read are
+		// stale. Run it again at a new snapshot to see the changes made by those txns, the
+		// locks already acquired are held by the txn.
+		if err = c.proc.TxnOperator.UpdateSnapshot(c.ctx, timestamp.Timestamp{}); err != nil {
+			return err
+		}
+		if err = c.Compile(c.ctx, pn, c.u, c.fill); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Compile) run(_ uint64) (err error) {
 	defer func() { c.scope = nil }() // free pipeline
+	defer c.closeSnapshots()
 
 	// XXX PrintScope has a none-trivial amount of logging
 	// PrintScope(nil, []*Scope{c.scope})
@@ -175,6 +209,8 @@ func (c *Compile) Run(_ uint64) (err error) {
 		}
 	case AlterView:
 		return c.scope.AlterView(c)
+	case AlterTable:
+		return c.scope.AlterTable(c)
 	case DropTable:
 		return c.scope.DropTable(c)
 	case CreateIndex:
@@ -237,6 +273,11 @@ func (c *Compile) compileScope(ctx context.Context, pn *plan.Plan) (*Scope, erro
 				Magic: AlterView,
 				Plan:  pn,
 			}, nil
+		case plan.DataDefinition_ALTER_TABLE:
+			return &Scope{
+				Magic: AlterTable,
+				Plan:  pn,
+			}, nil
 		case plan.DataDefinition_DROP_TABLE:
 			return &Scope{
 				Magic: DropTable,
@@ -368,7 +409,11 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 		}
 		nodeStats := qry.Nodes[insertNode.Children[0]].Stats
 
-		if nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag {
+		// the rows of a partitioned table are routed to its partitions
+		// through the dn, and the conflicting rows on duplicate key are
+		// found in a single pipeline
+		if (nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag) &&
+			insertNode.InsertCtx.TableDef.Partition == nil && onDuplicateKeyArg == nil {
 			// use distributed-insert
 			arg.IsRemote = true
 			rs = c.newInsertMergeScope(arg, preArg, ss)
@@ -427,6 +472,21 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 	return rs, nil
 }
 
+// dupBatch returns a copy of bat with its own memory.
+func dupBatch(proc *process.Process, bat *batch.Batch) (*batch.Batch, error) {
+	var err error
+
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		if rbat.Vecs[i], err = vec.Dup(proc.Mp()); err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	rbat.InitZsOne(bat.Length())
+	return rbat, nil
+}
+
 func constructValueScanBatch(ctx context.Context, proc *process.Process, node *plan.Node) (*batch.Batch, error) {
 	if node == nil || node.TableDef == nil { // like : select 1, 2
 		bat := batch.NewWithSize(1)
@@ -475,7 +535,7 @@ func (c *Compile) compilePlanScope(ctx context.Context, n *plan.Node, ns []*plan
 			return nil, err
 		}
 		// RelationName
-		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+		return c.compileSort(n, c.compileLock(n, c.compileProjection(n, c.compileRestrict(n, ss)))), nil
 	case plan.Node_FILTER:
 		curr := c.anal.curr
 		c.SetAnalyzeCurrent(nil, int(n.Children[0]))
@@ -534,6 +594,34 @@ func (c *Compile) compilePlanScope(ctx context.Context, n *plan.Node, ns []*plan
 		c.SetAnalyzeCurrent(ss, curr)
 		ss = c.compileSort(n, ss)
 		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
+	case plan.Node_WINDOW:
+		curr := c.anal.curr
+		c.SetAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.SetAnalyzeCurrent(ss, curr)
+		ss = c.compileWindow(n, ss)
+		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
+	case plan.Node_RECURSIVE_CTE:
+		curr := c.anal.curr
+		c.SetAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.SetAnalyzeCurrent(ss, curr)
+		return c.compileRecursiveCte(n, ss, ns), nil
+	case plan.Node_SINK_SCAN:
+		if _, ok := c.cteWorkings[n.TableDef.Name]; !ok {
+			return nil, moerr.NewInternalError(ctx, "working table of recursive CTE %s not found", n.TableDef.Name)
+		}
+		ds := &Scope{Magic: Normal}
+		ds.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
+		// the working table is bound before each iteration, see bindCteWorkings.
+		ds.DataSource = &Source{CteName: n.TableDef.Name}
+		return c.compileProjection(n, []*Scope{ds}), nil
 	case plan.Node_UNION:
 		curr := c.anal.curr
 		c.SetAnalyzeCurrent(nil, int(n.Children[0]))
@@ -660,6 +748,11 @@ func (c *Compile) compileExternScan(ctx context.Context, n *plan.Node) ([]*Scope
 		}
 	}
 
+	if param.Format == tree.PARQUET {
+		// a parquet file is read by row groups, it can't be split by lines
+		param.Parallel = false
+	}
+
 	if n.ObjRef != nil {
 		param.SysTable = external.IsSysTable(n.ObjRef.SchemaName, n.TableDef.Name)
 	}
@@ -755,18 +848,58 @@ func (c *Compile) compileTableFunction(n *plan.Node, ss []*Scope) ([]*Scope, err
 }
 
 func (c *Compile) compileTableScan(n *plan.Node) ([]*Scope, error) {
+	if partition := n.TableDef.Partition; partition != nil {
+		return c.compilePartitionTableScan(n, partition)
+	}
 	nodes, err := c.generateNodes(n)
 	if err != nil {
 		return nil, err
 	}
 	ss := make([]*Scope, 0, len(nodes))
 	for i := range nodes {
-		ss = append(ss, c.compileTableScanWithNode(n, nodes[i]))
+		s, err := c.compileTableScanWithNode(n, nodes[i])
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, s)
 	}
 	return ss, nil
 }
 
-func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scope {
+// compilePartitionTableScan scans the tables of the partitions not pruned, if all
+// the partitions are pruned, the table itself which holds no rows is scanned.
+func (c *Compile) compilePartitionTableScan(n *plan.Node, partition *plan.PartitionByDef) ([]*Scope, error) {
+	var items []*plan.PartitionItem
+	if n.PartitionPrune == nil {
+		items = partition.Partitions
+	} else {
+		for _, idx := range n.PartitionPrune.Partitions {
+			items = append(items, partition.Partitions[idx])
+		}
+	}
+	if len(items) == 0 {
+		node := *n
+		node.TableDef = plan2.DeepCopyTableDef(n.TableDef)
+		node.TableDef.Partition = nil
+		return c.compileTableScan(&node)
+	}
+
+	var ss []*Scope
+	for _, item := range items {
+		node := *n
+		node.TableDef = plan2.DeepCopyTableDef(n.TableDef)
+		node.TableDef.Name = item.PartitionTableName
+		node.TableDef.Partition = nil
+		pss, err := c.compileTableScan(&node)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, pss...)
+	}
+	return ss, nil
+}
+
+func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) (*Scope, error) {
 	var err error
 	var s *Scope
 	var tblDef *plan.TableDef
@@ -778,8 +911,12 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 	for j, col := range n.TableDef.Cols {
 		attrs[j] = col.Name
 	}
-	if c.proc != nil && c.proc.TxnOperator != nil {
-		ts = c.proc.TxnOperator.Txn().SnapshotTS
+	txnOp, err := c.getTxnOperator(n)
+	if err != nil {
+		return nil, err
+	}
+	if txnOp != nil {
+		ts = txnOp.Txn().SnapshotTS
 	}
 	{
 		var cols []*plan.ColDef
@@ -787,25 +924,25 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 		if util.TableIsClusterTable(n.TableDef.GetTableType()) {
 			ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 		}
-		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 		rel, err = db.Relation(ctx, n.TableDef.Name)
 		if err != nil {
 			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
 			if e != nil {
-				panic(e)
+				return nil, e
 			}
 			rel, e = db.Relation(c.ctx, engine.GetTempTableName(n.ObjRef.SchemaName, n.TableDef.Name))
 			if e != nil {
-				panic(e)
+				return nil, e
 			}
 		}
 		defs, err := rel.TableDefs(ctx)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 		i := int32(0)
 		name2index := make(map[string]int32)
@@ -847,10 +984,12 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 			RelationName: n.TableDef.Name,
 			SchemaName:   n.ObjRef.SchemaName,
 			Expr:         colexec.RewriteFilterExprList(n.FilterList),
+
+			RuntimeFilterSpecs: n.RuntimeFilterProbeList,
 		},
 	}
 	s.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
-	return s
+	return s, nil
 }
 
 func (c *Compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
@@ -884,6 +1023,21 @@ func (c *Compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
+// compileLock locks the rows left by the filters of the scan. The rows are merged into
+// one scope of the current CN first, so the lock operator never runs on the remote CNs.
+func (c *Compile) compileLock(n *plan.Node, ss []*Scope) []*Scope {
+	if n.LockTarget == nil {
+		return ss
+	}
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:  vm.LockOp,
+		Idx: c.anal.curr,
+		Arg: constructLock(n),
+	})
+	return []*Scope{rs}
+}
+
 func (c *Compile) compileUnion(n *plan.Node, ss []*Scope, children []*Scope, ns []*plan.Node) []*Scope {
 	ss = append(ss, children...)
 	rs := c.newScopeList(1, int(n.Stats.BlockNum))
@@ -1213,6 +1367,140 @@ func (c *Compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
+func (c *Compile) compileWindow(n *plan.Node, ss []*Scope) []*Scope {
+	currentFirstFlag := c.anal.isFirst
+	for i := range ss {
+		if containBrokenNode(ss[i]) {
+			c.anal.isFirst = currentFirstFlag
+			ss[i] = c.newMergeScope([]*Scope{ss[i]})
+		}
+	}
+
+	rs := c.newMergeScope(ss)
+	rs.Instructions[0] = vm.Instruction{
+		Op:  vm.Window,
+		Idx: c.anal.curr,
+		Arg: constructWindow(n, c.proc),
+	}
+	return []*Scope{rs}
+}
+
+func (c *Compile) compileRecursiveCte(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Scope {
+	rs := c.newMergeScope(ss)
+	rs.Instructions[0] = vm.Instruction{
+		Op:  vm.RecursiveCte,
+		Idx: c.anal.curr,
+		Arg: constructRecursiveCte(n, c.newRecursiveCteIterator(n, ns)),
+	}
+	return []*Scope{rs}
+}
+
+// newRecursiveCteIterator returns a function which runs the recursive member of a
+// recursive CTE once. The recursive member is compiled at the first iteration, and
+// each iteration runs a copy of it whose SINK_SCAN reads the working table passed in.
+func (c *Compile) newRecursiveCteIterator(n *plan.Node, ns []*plan.Node) func(*batch.Batch) (*batch.Batch, error) {
+	var rc *Compile
+	var member []*Scope
+	// workings are the working tables read by the recursive member, including the
+	// ones of the outer recursive CTEs which are being iterated.
+	workings := make(map[string]*batch.Batch, len(c.cteWorkings)+1)
+	return func(working *batch.Batch) (*batch.Batch, error) {
+		for name, bat := range c.cteWorkings {
+			workings[name] = bat
+		}
+		workings[n.TableDef.Name] = working
+
+		if rc == nil {
+			rc = New(c.addr, c.db, c.sql, c.uid, c.ctx, c.e, c.proc, c.stmt)
+			rc.info = c.info
+			// the working table only exists in the current CN, so is the recursive member run.
+			rc.cnList = engine.Nodes{engine.Node{Mcpu: c.NumCPU()}}
+			rc.anal = &anaylze{
+				curr:      int(n.Children[1]),
+				isFirst:   true,
+				qry:       c.anal.qry,
+				analInfos: c.anal.analInfos,
+			}
+			rc.cteWorkings = workings
+			ss, err := rc.compilePlanScope(c.ctx, ns[n.Children[1]], ns)
+			if err != nil {
+				rc = nil
+				return nil, err
+			}
+			member = ss
+		}
+
+		ss := dupScopeList(member)
+		if ss == nil {
+			return nil, moerr.NewInternalError(c.ctx, "failed to copy the recursive member of CTE %s", n.TableDef.Name)
+		}
+		if err := bindCteWorkings(c.proc, ss, workings); err != nil {
+			return nil, err
+		}
+
+		var res *batch.Batch
+		rs := rc.newMergeScope(ss)
+		updateScopesLastFlag([]*Scope{rs})
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op: vm.Output,
+			Arg: &output.Argument{
+				Func: func(_ any, bat *batch.Batch) error {
+					if bat == nil {
+						return nil
+					}
+					var err error
+					if res == nil {
+						res = batch.NewWithSize(len(bat.Vecs))
+						for i, vec := range bat.Vecs {
+							res.Vecs[i] = vector.NewVec(*vec.GetType())
+						}
+					}
+					res, err = res.Append(c.ctx, c.proc.Mp(), bat)
+					return err
+				},
+			},
+		})
+		if err := rs.MergeRun(rc); err != nil {
+			if res != nil {
+				res.Clean(c.proc.Mp())
+			}
+			return nil, err
+		}
+		return res, nil
+	}
+}
+
+// bindCteWorkings sets the working tables read by the SINK_SCANs of the scopes,
+// each of the scans consumes a copy of its working table.
+func bindCteWorkings(proc *process.Process, ss []*Scope, workings map[string]*batch.Batch) error {
+	for _, s := range ss {
+		if s.DataSource != nil && s.DataSource.CteName != "" {
+			bat, err := dupBatch(proc, workings[s.DataSource.CteName])
+			if err != nil {
+				cleanCteWorkings(proc, ss)
+				return err
+			}
+			s.DataSource.Bat = bat
+		}
+		if err := bindCteWorkings(proc, s.PreScopes, workings); err != nil {
+			cleanCteWorkings(proc, ss)
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanCteWorkings cleans the working tables bound by bindCteWorkings.
+func cleanCteWorkings(proc *process.Process, ss []*Scope) {
+	for _, s := range ss {
+		if s.DataSource != nil && s.DataSource.CteName != "" && s.DataSource.Bat != nil {
+			s.DataSource.Bat.Clean(proc.Mp())
+			s.DataSource.Bat = nil
+		}
+		cleanCteWorkings(proc, s.PreScopes)
+	}
+}
+
 func (c *Compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	currentFirstFlag := c.anal.isFirst
 	for i := range ss {
@@ -1615,14 +1903,18 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	if util.TableIsClusterTable(n.TableDef.GetTableType()) {
 		ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 	}
-	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+	txnOp, err := c.getTxnOperator(n)
+	if err != nil {
+		return nil, err
+	}
+	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 	if err != nil {
 		return nil, err
 	}
 	rel, err = db.Relation(ctx, n.TableDef.Name)
 	if err != nil {
 		var e error // avoid contamination of error messages
-		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, txnOp)
 		if e != nil {
 			return nil, err
 		}
@@ -1719,6 +2011,41 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	return nodes, nil
 }
 
+// getTxnOperator returns the txn used to read the table of the scan node. The table
+// read with AS OF TIMESTAMP is read by a read-only txn at that timestamp, the txns are
+// shared by the scans at the same timestamp and closed after the query.
+func (c *Compile) getTxnOperator(n *plan.Node) (client.TxnOperator, error) {
+	if n.ScanTs == nil {
+		return c.proc.TxnOperator, nil
+	}
+	for _, op := range c.snapshots {
+		if op.Txn().SnapshotTS.Equal(*n.ScanTs) {
+			return op, nil
+		}
+	}
+	if err := plan2.CheckSnapshotTS(c.ctx, *n.ScanTs); err != nil {
+		return nil, err
+	}
+	op, err := c.proc.TxnClient.New(client.WithTxnReadyOnly(), client.WithSnapshotTS(*n.ScanTs))
+	if err != nil {
+		return nil, err
+	}
+	if err = c.e.New(c.ctx, op); err != nil {
+		_ = op.Rollback(c.ctx)
+		return nil, err
+	}
+	c.snapshots = append(c.snapshots, op)
+	return op, nil
+}
+
+func (c *Compile) closeSnapshots() {
+	for _, op := range c.snapshots {
+		_ = c.e.Rollback(c.ctx, op)
+		_ = op.Rollback(c.ctx)
+	}
+	c.snapshots = nil
+}
+
 func (anal *anaylze) Nodes() []*process.AnalyzeInfo {
 	return anal.analInfos
 }
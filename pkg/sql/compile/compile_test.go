@@ -16,10 +16,14 @@ package compile
 
 import (
 	"context"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -64,6 +68,9 @@ func init() {
 		//newTestCase("insert into R select * from R", new(testing.T)),
 		newTestCase("select count(*) from R group by uid", new(testing.T)),
 		newTestCase("select count(distinct uid) from R", new(testing.T)),
+		newTestCase("with recursive t(n) as (select 1 union all select n + 1 from t where n < 10) select * from t", new(testing.T)),
+		newTestCase("with recursive t(n) as (select 1 union select n % 3 + 1 from t) select * from t", new(testing.T)),
+		newTestCase("with recursive t(id) as ((select uid from R limit 1) union all select R.uid from R join t on R.uid > t.id) select count(*) from t", new(testing.T)),
 	}
 }
 
@@ -107,6 +114,102 @@ func TestCompileWithFaults(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCompileRecursiveCte(t *testing.T) {
+	ctx := context.Background()
+	tc := newTestCase("with recursive t(n) as (select 1 union all select n + 1 from t where n < 10) select n from t", t)
+	var rows []int64
+	fill := func(_ any, bat *batch.Batch) error {
+		if bat != nil && len(bat.Vecs) > 0 {
+			rows = append(rows, vector.MustFixedCol[int64](bat.Vecs[0])...)
+		}
+		return nil
+	}
+	c := New("test", "test", tc.sql, "", ctx, tc.e, tc.proc, tc.stmt)
+	err := c.Compile(ctx, tc.pn, nil, fill)
+	require.NoError(t, err)
+	err = c.Run(0)
+	require.NoError(t, err)
+	sort.Slice(rows, func(i, j int) bool { return rows[i] < rows[j] })
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, rows)
+}
+
+func TestCompileWithQueryLimits(t *testing.T) {
+	ctx := context.Background()
+
+	tc := newTestCase("select * from R join S on R.uid = S.uid", t)
+	tc.proc.Lim.MaxExecutionTime = int64(time.Millisecond)
+	limitCtx := tc.proc.StartQueryLimiter(ctx)
+	time.Sleep(10 * time.Millisecond)
+	c := New("test", "test", tc.sql, "", limitCtx, tc.e, tc.proc, tc.stmt)
+	err := c.Compile(limitCtx, tc.pn, nil, testPrint)
+	require.NoError(t, err)
+	err = c.Run(0)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryTimeout))
+	require.Error(t, limitCtx.Err())
+
+	tc = newTestCase("select * from R join S on R.uid = S.uid", t)
+	tc.proc.Lim.QueryMemLimit = 1
+	limitCtx = tc.proc.StartQueryLimiter(ctx)
+	c = New("test", "test", tc.sql, "", limitCtx, tc.e, tc.proc, tc.stmt)
+	err = c.Compile(limitCtx, tc.pn, nil, testPrint)
+	require.NoError(t, err)
+	err = c.Run(0)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemLimitExceeded))
+
+	// the scope sent to the other cn is canceled at the deadline of the query,
+	// not after the whole max execution time from the time it is received.
+	tc = newTestCase("select * from R join S on R.uid = S.uid", t)
+	tc.proc.Lim.MaxExecutionTime = int64(time.Hour)
+	tc.proc.Lim.QueryDeadline = time.Now().Add(-time.Millisecond).UnixNano()
+	tc.proc.Lim = convertToProcessLimitation(convertToPipelineLimitation(tc.proc.Lim))
+	limitCtx = tc.proc.StartQueryLimiter(ctx)
+	time.Sleep(10 * time.Millisecond)
+	c = New("test", "test", tc.sql, "", limitCtx, tc.e, tc.proc, tc.stmt)
+	err = c.Compile(limitCtx, tc.pn, nil, testPrint)
+	require.NoError(t, err)
+	err = c.Run(0)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryTimeout))
+}
+
+func TestQueryMemLimitOfCNs(t *testing.T) {
+	ctx := context.Background()
+
+	// the cn sending the scopes counts the memory reported by the other cns.
+	proc := testutil.NewProcess()
+	proc.Lim.QueryMemLimit = 100
+	limitCtx := proc.StartQueryLimiter(ctx)
+	defer proc.StopQueryLimiter()
+	proc.SetRemoteQueryMem(1, 60)
+	require.NoError(t, proc.CheckQueryLimits())
+	proc.SetRemoteQueryMem(1, 0)
+	proc.SetRemoteQueryMem(2, 50)
+	require.NoError(t, proc.CheckQueryLimits())
+	proc.SetRemoteQueryMem(1, 60)
+	require.True(t, moerr.IsMoErrCode(proc.CheckQueryLimits(), moerr.ErrQueryMemLimitExceeded))
+	require.Error(t, limitCtx.Err())
+
+	// the cn running the scopes reports the memory once it changes much.
+	proc = testutil.NewProcess()
+	proc.Lim.QueryMemLimit = 1 << 20
+	base := proc.Mp().CurrNB()
+	proc.StartQueryLimiter(ctx)
+	defer proc.StopQueryLimiter()
+	var reported []int64
+	proc.SetQueryMemReporter(func(used int64) {
+		reported = append(reported, used)
+	})
+	data, err := proc.Mp().Alloc(1 << 10)
+	require.NoError(t, err)
+	require.NoError(t, proc.CheckQueryLimits())
+	require.Empty(t, reported)
+	proc.SetRemoteQueryMem(1, 1<<18)
+	require.Equal(t, []int64{1<<18 + proc.Mp().CurrNB() - base}, reported)
+	proc.Mp().Free(data)
+	proc.SetRemoteQueryMem(1, 0)
+	require.NoError(t, proc.CheckQueryLimits())
+	require.Equal(t, int64(0), reported[len(reported)-1])
+}
+
 func newTestCase(sql string, t *testing.T) compileTestCase {
 	proc := testutil.NewProcess()
 	e, _, compilerCtx := testengine.New(context.Background())
@@ -17,11 +17,13 @@ package compile
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
@@ -102,6 +104,131 @@ func (s *Scope) AlterView(c *Compile) error {
 	return dbSource.Create(context.WithValue(c.ctx, defines.SqlKey{}, c.sql), tblName, append(exeCols, exeDefs...))
 }
 
+// AlterTable applies the actions in order, the column actions and the rename
+// are sent to the storage and the index actions update the constraint of the
+// table as CREATE/DROP INDEX do.
+func (s *Scope) AlterTable(c *Compile) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+	dbName := c.db
+	if qry.GetDatabase() != "" {
+		dbName = qry.GetDatabase()
+	}
+	d, err := c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	r, err := d.Relation(c.ctx, qry.GetTable())
+	if err != nil {
+		return err
+	}
+	dbId, err := strconv.ParseUint(d.GetDatabaseId(c.ctx), 10, 64)
+	if err != nil {
+		return err
+	}
+	partitionTableNames, err := getPartitionTableNames(c.ctx, r)
+	if err != nil {
+		return err
+	}
+	for _, action := range qry.GetActions() {
+		req := &api.AlterTableReq{
+			DbId:    dbId,
+			TableId: r.GetTableID(c.ctx),
+		}
+		// the column actions are applied to the tables of the partitions too
+		alterPartitions := false
+		switch act := action.Action.(type) {
+		case *plan.AlterTableAction_AddIndex:
+			if err = createIndex(c, d, r, act.AddIndex); err != nil {
+				return err
+			}
+			continue
+		case *plan.AlterTableAction_DropIndex:
+			if err = dropIndex(c, d, r, act.DropIndex); err != nil {
+				return err
+			}
+			continue
+		case *plan.AlterTableAction_AddColumn:
+			req.Operation = &api.AlterTableReq_AddColumn{AddColumn: act.AddColumn}
+			alterPartitions = true
+		case *plan.AlterTableAction_DropColumn:
+			req.Operation = &api.AlterTableReq_DropColumn{DropColumn: act.DropColumn}
+			alterPartitions = true
+		case *plan.AlterTableAction_ModifyColumn:
+			req.Operation = &api.AlterTableReq_ModifyColumn{ModifyColumn: act.ModifyColumn}
+			alterPartitions = true
+		case *plan.AlterTableAction_RenameColumn:
+			req.Operation = &api.AlterTableReq_RenameColumn{RenameColumn: act.RenameColumn}
+			alterPartitions = true
+		case *plan.AlterTableAction_RenameTable:
+			req.Operation = &api.AlterTableReq_RenameTable{RenameTable: act.RenameTable}
+		case *plan.AlterTableAction_AddPartition:
+			if err = createPartitionTables(c, d, r, act.AddPartition.PartitionTableNames); err != nil {
+				return err
+			}
+			req.Operation = &api.AlterTableReq_ReplacePartition{ReplacePartition: act.AddPartition.Partition}
+		case *plan.AlterTableAction_DropPartition:
+			for _, name := range act.DropPartition.PartitionTableNames {
+				if err = d.Delete(c.ctx, name); err != nil {
+					return err
+				}
+			}
+			req.Operation = &api.AlterTableReq_ReplacePartition{ReplacePartition: act.DropPartition.Partition}
+		case *plan.AlterTableAction_TruncatePartition:
+			for _, name := range act.TruncatePartition.PartitionTableNames {
+				if _, err = d.Truncate(c.ctx, name); err != nil {
+					return err
+				}
+			}
+			continue
+		default:
+			return moerr.NewNYI(c.ctx, "alter table action %T", action.Action)
+		}
+		if err = r.AlterTable(c.ctx, []*api.AlterTableReq{req}); err != nil {
+			return err
+		}
+		if !alterPartitions {
+			continue
+		}
+		for _, name := range partitionTableNames {
+			pr, err := d.Relation(c.ctx, name)
+			if err != nil {
+				return err
+			}
+			preq := *req
+			preq.TableId = pr.GetTableID(c.ctx)
+			if err = pr.AlterTable(c.ctx, []*api.AlterTableReq{&preq}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getPartitionTableNames returns the tables of the partitions of the table, they
+// are nil if the table isn't partitioned.
+func getPartitionTableNames(ctx context.Context, rel engine.Relation) ([]string, error) {
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defs {
+		partitionDef, ok := def.(*engine.PartitionDef)
+		if !ok {
+			continue
+		}
+		partition := &plan.PartitionByDef{}
+		if err = partition.UnMarshalPartitionInfo([]byte(partitionDef.Partition)); err != nil {
+			return nil, err
+		}
+		names := make([]string, len(partition.Partitions))
+		for i, item := range partition.Partitions {
+			names[i] = item.PartitionTableName
+		}
+		return names, nil
+	}
+	return nil, nil
+}
+
 func (s *Scope) CreateTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
@@ -258,9 +385,55 @@ func (s *Scope) CreateTable(c *Compile) error {
 			return err
 		}
 	}
+
+	// build partition tables
+	if partition := qry.GetTableDef().GetPartition(); partition != nil {
+		newRelation, err := dbSource.Relation(c.ctx, tblName)
+		if err != nil {
+			return err
+		}
+		names := make([]string, len(partition.Partitions))
+		for i, item := range partition.Partitions {
+			names[i] = item.PartitionTableName
+		}
+		if err = createPartitionTables(c, dbSource, newRelation, names); err != nil {
+			return err
+		}
+	}
 	return colexec.CreateAutoIncrCol(c.e, c.ctx, dbSource, c.proc, tableCols, dbName, tblName)
 }
 
+// createPartitionTables creates the hidden tables storing the partitions of the table,
+// which have the columns and the primary key of the table.
+func createPartitionTables(c *Compile, dbSource engine.Database, rel engine.Relation, names []string) error {
+	tableDefs, err := rel.TableDefs(c.ctx)
+	if err != nil {
+		return err
+	}
+	var defs []engine.TableDef
+	for _, def := range tableDefs {
+		switch d := def.(type) {
+		case *engine.AttributeDef:
+			defs = append(defs, d)
+		case *engine.ConstraintDef:
+			for _, ct := range d.Cts {
+				if pk, ok := ct.(*engine.PrimaryKeyDef); ok {
+					defs = append(defs, &engine.ConstraintDef{Cts: []engine.Constraint{pk}})
+				}
+			}
+		}
+	}
+	for _, name := range names {
+		if _, err := dbSource.Relation(c.ctx, name); err == nil {
+			return moerr.NewTableAlreadyExists(c.ctx, name)
+		}
+		if err := dbSource.Create(c.ctx, name, defs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Scope) CreateTempTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
@@ -341,7 +514,10 @@ func (s *Scope) CreateIndex(c *Compile) error {
 	if err != nil {
 		return err
 	}
+	return createIndex(c, d, r, qry)
+}
 
+func createIndex(c *Compile, d engine.Database, r engine.Relation, qry *plan.CreateIndex) error {
 	// build and create index table
 	if qry.TableExist {
 		def := qry.GetIndex().GetIndexTables()[0]
@@ -389,8 +565,14 @@ func (s *Scope) CreateIndex(c *Compile) error {
 	// TODO: implement by insert ... select ...
 	// insert data into index table
 	indexDef := qry.GetIndex().GetTableDef().Indexes[0]
-	if indexDef.Unique {
-		targetAttrs := getIndexColsFromOriginTable(tblDefs, indexDef.Parts)
+	if indexDef.TableExist {
+		indexColumns := indexDef.Parts
+		if qry.OriginTablePrimaryKey != "" {
+			// the primary key of the rows is written to the index table with the key,
+			// it's the hidden column of the composite primary key if there are several
+			indexColumns = append(append([]string{}, indexDef.Parts...), qry.OriginTablePrimaryKey)
+		}
+		targetAttrs := getIndexColsFromOriginTable(tblDefs, indexColumns)
 		ret, err := r.Ranges(c.ctx, nil)
 		if err != nil {
 			return err
@@ -399,28 +581,33 @@ func (s *Scope) CreateIndex(c *Compile) error {
 		if err != nil {
 			return err
 		}
-		bat, err := rds[0].Read(c.ctx, targetAttrs, nil, c.proc.Mp())
-		if err != nil {
-			return err
-		}
-		err = rds[0].Close()
+		indexR, err := d.Relation(c.ctx, indexDef.IndexTableName)
 		if err != nil {
 			return err
 		}
-
-		if bat != nil {
-			indexBat, cnt := util.BuildUniqueKeyBatch(bat.Vecs, targetAttrs, indexDef.Parts, qry.OriginTablePrimaryKey, c.proc)
-			indexR, err := d.Relation(c.ctx, indexDef.IndexTableName)
+		// the rows may be read in several batches
+		for {
+			bat, err := rds[0].Read(c.ctx, targetAttrs, nil, c.proc.Mp())
 			if err != nil {
+				rds[0].Close()
 				return err
 			}
+			if bat == nil {
+				break
+			}
+			indexBat, cnt := util.BuildUniqueKeyBatch(bat.Vecs, targetAttrs, indexDef.Parts, qry.OriginTablePrimaryKey, c.proc)
 			if cnt != 0 {
 				if err := indexR.Write(c.ctx, indexBat); err != nil {
+					indexBat.Clean(c.proc.Mp())
+					rds[0].Close()
 					return err
 				}
 			}
 			indexBat.Clean(c.proc.Mp())
 		}
+		if err = rds[0].Close(); err != nil {
+			return err
+		}
 		// other situation is not supported now and check in plan
 	}
 
@@ -437,7 +624,10 @@ func (s *Scope) DropIndex(c *Compile) error {
 	if err != nil {
 		return err
 	}
+	return dropIndex(c, d, r, qry)
+}
 
+func dropIndex(c *Compile, d engine.Database, r engine.Relation, qry *plan.DropIndex) error {
 	// build and update constraint def
 	tblDefs, err := r.TableDefs(c.ctx)
 	if err != nil {
@@ -667,6 +857,13 @@ func (s *Scope) TruncateTable(c *Compile) error {
 		return err
 	}
 
+	// Truncate Partition Tables if needed
+	for _, name := range tqry.PartitionTableNames {
+		if _, err := dbSource.Truncate(c.ctx, name); err != nil {
+			return err
+		}
+	}
+
 	// Truncate Index Tables if needed
 	for _, name := range tqry.IndexTableNames {
 		var err error
@@ -827,6 +1024,11 @@ func (s *Scope) DropTable(c *Compile) error {
 				return err
 			}
 		}
+		for _, name := range qry.PartitionTableNames {
+			if err := dbSource.Delete(c.ctx, name); err != nil {
+				return err
+			}
+		}
 		return colexec.DeleteAutoIncrCol(c.e, c.ctx, dbSource, rel, c.proc, dbName, rel.GetTableID(c.ctx))
 	}
 }
@@ -937,6 +1139,10 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 			alg = compress.None
 		case plan.CompressType_Lz4:
 			alg = compress.Lz4
+		case plan.CompressType_Zstd:
+			alg = compress.Zstd
+		case plan.CompressType_Snappy:
+			alg = compress.Snappy
 		}
 		colTyp := col.GetTyp()
 		exeCols[i] = &engine.AttributeDef{
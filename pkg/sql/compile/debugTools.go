@@ -64,6 +64,9 @@ var debugInstructionNames = map[int]string{
 	vm.Intersect:    "intersect",
 	vm.IntersectAll: "intersect all",
 	vm.HashBuild:    "hash build",
+	vm.Window:       "window",
+	vm.RecursiveCte: "recursive cte",
+	vm.LockOp:       "lock",
 }
 
 var debugMagicNames = map[int]string{
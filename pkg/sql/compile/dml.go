@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/insert"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/onduplicatekey"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
 )
 
@@ -77,7 +78,14 @@ func (s *Scope) Insert(c *Compile) (uint64, error) {
 	if err := s.MergeRun(c); err != nil {
 		return 0, err
 	}
-	return arg.Affected, nil
+	affected := arg.Affected
+	// the rows deleted by replace are affected too
+	for _, in := range s.Instructions {
+		if dupArg, ok := in.Arg.(*onduplicatekey.Argument); ok {
+			affected += dupArg.Affected
+		}
+	}
+	return affected, nil
 }
 
 func (s *Scope) Update(c *Compile) (uint64, error) {
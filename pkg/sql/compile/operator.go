@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
@@ -41,6 +42,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
@@ -62,6 +64,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
@@ -69,6 +72,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
@@ -283,6 +287,26 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 		res.Arg = &mergeorder.Argument{
 			Fs: t.Fs,
 		}
+	case vm.Window:
+		t := sourceIns.Arg.(*window.Argument)
+		res.Arg = &window.Argument{
+			WinSpec: t.WinSpec,
+		}
+	case vm.RecursiveCte:
+		t := sourceIns.Arg.(*recursivecte.Argument)
+		res.Arg = &recursivecte.Argument{
+			UnionAll: t.UnionAll,
+			MaxDepth: t.MaxDepth,
+			Iterate:  t.Iterate,
+		}
+	case vm.LockOp:
+		t := sourceIns.Arg.(*lockop.Argument)
+		res.Arg = &lockop.Argument{
+			TableID:       t.TableID,
+			PrimaryKeyIdx: t.PrimaryKeyIdx,
+			Mode:          t.Mode,
+			WaitPolicy:    t.WaitPolicy,
+		}
 	case vm.Mark:
 		t := sourceIns.Arg.(*mark.Argument)
 		res.Arg = &mark.Argument{
@@ -435,6 +459,8 @@ func constructDeletion(n *plan.Node, eg engine.Engine, proc *process.Process) (*
 		OnSetUpdateCol:    make([]map[string]int32, len(oldCtx.OnSetUpdateCol)),
 
 		CanTruncate: oldCtx.CanTruncate,
+
+		PartitionIdx: make([]map[string]int32, len(oldCtx.PartitionIdx)),
 	}
 
 	if delCtx.CanTruncate {
@@ -484,6 +510,9 @@ func constructDeletion(n *plan.Node, eg engine.Engine, proc *process.Process) (*
 		for i, idxMap := range oldCtx.OnSetUpdateCol {
 			delCtx.OnSetUpdateCol[i] = idxMap.Map
 		}
+		for i, idxMap := range oldCtx.PartitionIdx {
+			delCtx.PartitionIdx[i] = idxMap.Map
+		}
 	}
 
 	return &deletion.Argument{
@@ -510,6 +539,8 @@ func constructOnduplicateKey(n *plan.Node, eg engine.Engine, proc *process.Proce
 
 		OnDuplicateIdx:  oldCtx.OnDuplicateIdx,
 		OnDuplicateExpr: oldCtx.OnDuplicateExpr,
+		IsReplace:       oldCtx.IsReplace,
+		RowIdx:          oldCtx.RowIdx,
 		Source:          originRel,
 		UniqueSource:    indexRels,
 
@@ -597,6 +628,8 @@ func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*up
 		OnSetUpdateCol:    make([]map[string]int32, len(oldCtx.OnSetUpdateCol)),
 
 		ParentIdx: make([]map[string]int32, len(oldCtx.ParentIdx)),
+
+		PartitionIdx: make([]map[string]int32, len(oldCtx.PartitionIdx)),
 	}
 
 	for i, idxMap := range oldCtx.UpdateCol {
@@ -660,6 +693,9 @@ func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*up
 	for i, idxMap := range oldCtx.ParentIdx {
 		updateCtx.ParentIdx[i] = idxMap.Map
 	}
+	for i, idxMap := range oldCtx.PartitionIdx {
+		updateCtx.PartitionIdx[i] = idxMap.Map
+	}
 
 	return &update.Argument{
 		UpdateCtx: updateCtx,
@@ -730,10 +766,11 @@ func constructJoin(n *plan.Node, typs []types.Type, proc *process.Process) *join
 	}
 	cond, conds := extraJoinConditions(n.OnList)
 	return &join.Argument{
-		Typs:       typs,
-		Result:     result,
-		Cond:       cond,
-		Conditions: constructJoinConditions(conds, proc),
+		Typs:               typs,
+		Result:             result,
+		Cond:               cond,
+		Conditions:         constructJoinConditions(conds, proc),
+		RuntimeFilterSpecs: n.RuntimeFilterBuildList,
 	}
 }
 
@@ -748,10 +785,11 @@ func constructSemi(n *plan.Node, typs []types.Type, proc *process.Process) *semi
 	}
 	cond, conds := extraJoinConditions(n.OnList)
 	return &semi.Argument{
-		Typs:       typs,
-		Result:     result,
-		Cond:       cond,
-		Conditions: constructJoinConditions(conds, proc),
+		Typs:               typs,
+		Result:             result,
+		Cond:               cond,
+		Conditions:         constructJoinConditions(conds, proc),
+		RuntimeFilterSpecs: n.RuntimeFilterBuildList,
 	}
 }
 
@@ -1058,6 +1096,35 @@ func constructMergeOrder(n *plan.Node, proc *process.Process) *mergeorder.Argume
 	}
 }
 
+func constructWindow(n *plan.Node, proc *process.Process) *window.Argument {
+	return &window.Argument{
+		WinSpec: n.WinSpec,
+	}
+}
+
+func constructRecursiveCte(n *plan.Node, iterate func(*batch.Batch) (*batch.Batch, error)) *recursivecte.Argument {
+	return &recursivecte.Argument{
+		UnionAll: n.UnionAll,
+		MaxDepth: n.MaxRecursionDepth,
+		Iterate:  iterate,
+	}
+}
+
+func constructLock(n *plan.Node) *lockop.Argument {
+	target := n.LockTarget
+	mode := lockservice.Exclusive
+	if target.Mode == plan.LockTarget_SHARED {
+		mode = lockservice.Shared
+	}
+	return &lockop.Argument{
+		TableID:         target.TableId,
+		PrimaryKeyIdx:   target.PrimaryKeyPos,
+		CompositeKeyIdx: target.CompositeKeyPos,
+		Mode:            mode,
+		WaitPolicy:      target.WaitPolicy,
+	}
+}
+
 func constructLoopJoin(n *plan.Node, typs []types.Type, proc *process.Process) *loopjoin.Argument {
 	result := make([]colexec.ResultPos, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -1164,10 +1231,12 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 	case vm.Join:
 		arg := in.Arg.(*join.Argument)
 		return &hashbuild.Argument{
-			NeedHashMap:    true,
-			NeedSelectList: true,
-			Typs:           arg.Typs,
-			Conditions:     arg.Conditions[1],
+			NeedHashMap:        true,
+			NeedSelectList:     true,
+			CanSpill:           true,
+			Typs:               arg.Typs,
+			Conditions:         arg.Conditions[1],
+			RuntimeFilterSpecs: arg.RuntimeFilterSpecs,
 		}
 	case vm.Left:
 		arg := in.Arg.(*left.Argument)
@@ -1190,9 +1259,10 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 	case vm.Semi:
 		arg := in.Arg.(*semi.Argument)
 		return &hashbuild.Argument{
-			NeedHashMap: true,
-			Typs:        arg.Typs,
-			Conditions:  arg.Conditions[1],
+			NeedHashMap:        true,
+			Typs:               arg.Typs,
+			Conditions:         arg.Conditions[1],
+			RuntimeFilterSpecs: arg.RuntimeFilterSpecs,
 		}
 	case vm.Single:
 		arg := in.Arg.(*single.Argument)
@@ -1374,10 +1444,19 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 			isTemp = true
 		}
 	} else {
-		_, _, relation, err = eg.GetRelationById(ctx, proc.TxnOperator, uint64(ref.Obj))
+		var dbName string
+		dbName, _, relation, err = eg.GetRelationById(ctx, proc.TxnOperator, uint64(ref.Obj))
 		if err != nil {
 			return nil, nil, err
 		}
+		dbSource, err = eg.Database(ctx, dbName, proc.TxnOperator)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	relation, err = getPartitionRelation(ctx, proc, dbSource, relation)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var uniqueIndexTables []engine.Relation
@@ -1385,24 +1464,48 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 		uniqueIndexTables = make([]engine.Relation, 0)
 		if tableDef.Indexes != nil {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if indexdef.TableExist {
 					var indexTable engine.Relation
-					if indexdef.TableExist {
-						if isTemp {
-							indexTable, err = dbSource.Relation(ctx, engine.GetTempTableName(oldDbName, indexdef.IndexTableName))
-						} else {
-							indexTable, err = dbSource.Relation(ctx, indexdef.IndexTableName)
-						}
-						if err != nil {
-							return nil, nil, err
-						}
-						uniqueIndexTables = append(uniqueIndexTables, indexTable)
+					if isTemp {
+						indexTable, err = dbSource.Relation(ctx, engine.GetTempTableName(oldDbName, indexdef.IndexTableName))
+					} else {
+						indexTable, err = dbSource.Relation(ctx, indexdef.IndexTableName)
 					}
-				} else {
-					continue
+					if err != nil {
+						return nil, nil, err
+					}
+					uniqueIndexTables = append(uniqueIndexTables, indexTable)
 				}
 			}
 		}
 	}
 	return relation, uniqueIndexTables, err
 }
+
+// getPartitionRelation wraps the relation of a partitioned table to route the rows
+// written and deleted to the tables of its partitions
+func getPartitionRelation(ctx context.Context, proc *process.Process, dbSource engine.Database, rel engine.Relation) (engine.Relation, error) {
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defs {
+		partitionDef, ok := def.(*engine.PartitionDef)
+		if !ok {
+			continue
+		}
+		partition := &plan.PartitionByDef{}
+		if err = partition.UnMarshalPartitionInfo([]byte(partitionDef.Partition)); err != nil {
+			return nil, err
+		}
+		partitions := make([]engine.Relation, len(partition.Partitions))
+		for i, item := range partition.Partitions {
+			if partitions[i], err = dbSource.Relation(ctx, item.PartitionTableName); err != nil {
+				return nil, err
+			}
+		}
+		return colexec.NewPartitionRelation(proc, rel, plan2.GetPartitionKeyColumns(partition),
+			plan2.BuildPartitionRouteExpr(partition), partitions), nil
+	}
+	return rel, nil
+}
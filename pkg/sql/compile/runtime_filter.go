@@ -0,0 +1,227 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// runtimeFilterReceiver receives the runtime filter generated by the hash build
+// of a join for the table scan of its probe side.
+type runtimeFilterReceiver struct {
+	col string
+	ch  chan *engine.RuntimeFilter
+	// uuid and addr are set if the table scan runs on another CN, the filter is
+	// fetched by the uuid from the CN at addr which runs the hash build.
+	uuid uuid.UUID
+	addr string
+}
+
+// connectRuntimeFilters connects the hash build of a join with the table scans in
+// the probe side which the runtime filters are pushed into.
+// The filters are passed by channels. The channels of the table scans in the scopes
+// sent to other CNs are registered in colexec.Srv, and the remote scans fetch the
+// filters from this CN by the RuntimeFilterMessage.
+func connectRuntimeFilters(c *Compile, arg *hashbuild.Argument, probe *Scope) {
+	if len(arg.RuntimeFilterSpecs) == 0 || probe == nil {
+		return
+	}
+	tags := make(map[int32]bool, len(arg.RuntimeFilterSpecs))
+	for _, spec := range arg.RuntimeFilterSpecs {
+		tags[spec.Tag] = true
+	}
+	var walk func(s *Scope, remote bool)
+	walk = func(s *Scope, remote bool) {
+		remote = remote || s.runOnOtherCN(c)
+		if ds := s.DataSource; ds != nil {
+			for _, spec := range ds.RuntimeFilterSpecs {
+				if !tags[spec.Tag] {
+					continue
+				}
+				col, ok := spec.Expr.Expr.(*plan.Expr_Col)
+				if !ok || int(col.Col.ColPos) >= len(ds.Attributes) {
+					continue
+				}
+				ch := make(chan *engine.RuntimeFilter, 1)
+				if arg.RuntimeFilterSenders == nil {
+					arg.RuntimeFilterSenders = make(map[int32][]chan *engine.RuntimeFilter)
+				}
+				arg.RuntimeFilterSenders[spec.Tag] = append(arg.RuntimeFilterSenders[spec.Tag], ch)
+				r := &runtimeFilterReceiver{
+					col: ds.Attributes[col.Col.ColPos],
+					ch:  ch,
+				}
+				if remote {
+					r.uuid, r.addr = uuid.New(), c.addr
+					colexec.Srv.PutRuntimeFilterCh(r.uuid, colexec.RuntimeFilterCh{
+						Ctx: c.proc.Ctx,
+						Ch:  ch,
+					})
+				}
+				ds.runtimeFilterReceivers = append(ds.runtimeFilterReceivers, r)
+			}
+		}
+		for i := range s.PreScopes {
+			walk(s.PreScopes[i], remote)
+		}
+	}
+	walk(probe, false)
+}
+
+// releaseRemoteRuntimeFilters removes the runtime filters registered for the scope
+// sent to another CN, which are not fetched once the scope is over.
+func releaseRemoteRuntimeFilters(s *Scope) {
+	if ds := s.DataSource; ds != nil {
+		for _, r := range ds.runtimeFilterReceivers {
+			if r.addr != "" {
+				colexec.Srv.GetRuntimeFilterCh(r.uuid)
+			}
+		}
+	}
+	for i := range s.PreScopes {
+		releaseRemoteRuntimeFilters(s.PreScopes[i])
+	}
+}
+
+// runOnOtherCN returns true if the scope will be sent to another CN for execution.
+func (s *Scope) runOnOtherCN(c *Compile) bool {
+	if s.Magic != Remote {
+		return false
+	}
+	return len(s.NodeInfo.Addr) != 0 && cnclient.IsCNClientReady() &&
+		len(c.addr) != 0 && !isSameCN(s.NodeInfo.Addr, c.addr)
+}
+
+// applyRuntimeFilters waits for the runtime filters of the scope and pushes them
+// into the readers, the readers which don't support runtime filters read all the rows.
+// The scope connected to be sent to another CN may still run on this CN, then its
+// filters are received from the channels and removed from colexec.Srv.
+func (s *Scope) applyRuntimeFilters(rds []engine.Reader) error {
+	receivers := s.DataSource.runtimeFilterReceivers
+	if len(receivers) == 0 {
+		return nil
+	}
+	s.DataSource.runtimeFilterReceivers = nil
+	filters := make([]*engine.RuntimeFilter, 0, len(receivers))
+	for _, r := range receivers {
+		var filter *engine.RuntimeFilter
+		if r.ch == nil {
+			var err error
+			if filter, err = fetchRuntimeFilter(s.Proc.Ctx, r); err != nil {
+				return err
+			}
+		} else {
+			if r.addr != "" {
+				colexec.Srv.GetRuntimeFilterCh(r.uuid)
+			}
+			select {
+			case <-s.Proc.Ctx.Done():
+				return s.Proc.Ctx.Err()
+			case filter = <-r.ch:
+			}
+		}
+		if filter == nil || filter.Typ == engine.RuntimeFilterPass {
+			continue
+		}
+		f := *filter
+		f.Col = r.col
+		filters = append(filters, &f)
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	for _, rd := range rds {
+		if r, ok := rd.(engine.RuntimeFilterReader); ok {
+			r.SetRuntimeFilters(filters)
+		}
+	}
+	return nil
+}
+
+// fetchRuntimeFilter fetches the runtime filter of a table scan running on this CN
+// from the CN which runs the hash build of the join.
+func fetchRuntimeFilter(ctx context.Context, r *runtimeFilterReceiver) (*engine.RuntimeFilter, error) {
+	streamSender, err := cnclient.GetStreamSender(r.addr)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = streamSender.Close()
+	}()
+
+	c, cancel := context.WithTimeout(ctx, time.Second*10000)
+	defer cancel()
+	message := cnclient.AcquireMessage()
+	{
+		message.Id = streamSender.ID()
+		message.Cmd = pipeline.RuntimeFilterMessage
+		message.Sid = pipeline.Last
+		message.Uuid = r.uuid[:]
+	}
+	if err = streamSender.Send(c, message); err != nil {
+		return nil, err
+	}
+	messagesReceive, err := streamSender.Receive()
+	if err != nil {
+		return nil, err
+	}
+	return receiveRuntimeFilter(c, messagesReceive)
+}
+
+// receiveRuntimeFilter receives the runtime filter sent back by the CN running the
+// hash build, the filter is nil if the CN ends the stream without a filter.
+func receiveRuntimeFilter(ctx context.Context, receiveCh chan morpc.Message) (*engine.RuntimeFilter, error) {
+	var val morpc.Message
+	var ok bool
+	select {
+	case <-ctx.Done():
+		return nil, moerr.NewRPCTimeout(ctx)
+	case val, ok = <-receiveCh:
+	}
+	if val == nil || !ok {
+		return nil, moerr.NewStreamClosedNoCtx()
+	}
+
+	m, ok := val.(*pipeline.Message)
+	if !ok {
+		panic("unexpected message type for cn-server")
+	}
+	if err := pipeline.GetMessageErrorInfo(m); err != nil {
+		return nil, err
+	}
+	if m.IsEndMessage() {
+		return nil, nil
+	}
+	filter := &pipeline.RuntimeFilter{}
+	if err := filter.Unmarshal(m.Data); err != nil {
+		return nil, err
+	}
+	return &engine.RuntimeFilter{
+		Typ:   engine.RuntimeFilterType(filter.Typ),
+		Keys:  filter.Keys,
+		Bloom: filter.Bloom,
+	}, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/cnservice/cnclient"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+type testRuntimeFilterReader struct {
+	engine.Reader
+	filters []*engine.RuntimeFilter
+}
+
+func (r *testRuntimeFilterReader) SetRuntimeFilters(filters []*engine.RuntimeFilter) {
+	r.filters = filters
+}
+
+// TestRuntimeFilterOnOtherCN runs the hash build of a join on the CN listening at
+// 127.0.0.1, and the table scan of the probe side on the CN at 127.0.0.2, which
+// fetches the runtime filter from the first CN.
+func TestRuntimeFilterOnOtherCN(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+
+	acquirer := func() morpc.Message { return &pipeline.Message{} }
+	server, err := morpc.NewRPCServer("cn-server", addr,
+		morpc.NewMessageCodec(acquirer),
+		morpc.WithServerDisableAutoCancelContext())
+	require.NoError(t, err)
+	server.RegisterRequestHandler(func(ctx context.Context, req morpc.Message, _ uint64, cs morpc.ClientSession) error {
+		go func() {
+			_ = CnServerMessageHandler(ctx, req, cs, nil, nil, nil, acquirer)
+		}()
+		return nil
+	})
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Close())
+	}()
+	require.NoError(t, cnclient.NewCNClient(&cnclient.ClientConfig{}))
+	defer func() {
+		require.NoError(t, cnclient.CloseCNClient())
+	}()
+	colexec.NewServer(nil)
+
+	proc := testutil.NewProcess()
+	c := &Compile{addr: addr, proc: proc, ctx: proc.Ctx}
+	spec := &plan.RuntimeFilterSpec{
+		Tag:  1,
+		Expr: &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 1}}},
+	}
+	probe := &Scope{
+		Magic:    Remote,
+		NodeInfo: engine.Node{Addr: fmt.Sprintf("127.0.0.2:%d", port)},
+		Proc:     testutil.NewProcess(),
+		DataSource: &Source{
+			Attributes:         []string{"a", "b"},
+			RuntimeFilterSpecs: []*plan.RuntimeFilterSpec{spec},
+		},
+	}
+	arg := &hashbuild.Argument{RuntimeFilterSpecs: []*plan.RuntimeFilterSpec{spec}}
+	connectRuntimeFilters(c, arg, probe)
+	require.Equal(t, 1, len(arg.RuntimeFilterSenders[1]))
+
+	// the scope is sent to the other cn.
+	data, err := encodeScope(probe)
+	require.NoError(t, err)
+	s, err := decodeScope(data, testutil.NewProcess(), true)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(s.DataSource.runtimeFilterReceivers))
+
+	arg.RuntimeFilterSenders[1][0] <- &engine.RuntimeFilter{
+		Typ:  engine.RuntimeFilterIn,
+		Keys: []byte("keys"),
+	}
+	rd := &testRuntimeFilterReader{}
+	require.NoError(t, s.applyRuntimeFilters([]engine.Reader{rd}))
+	require.Equal(t, []*engine.RuntimeFilter{{
+		Typ:  engine.RuntimeFilterIn,
+		Col:  "b",
+		Keys: []byte("keys"),
+	}}, rd.filters)
+
+	// the filter is removed from the cn running the hash build once fetched.
+	_, ok := colexec.Srv.GetRuntimeFilterCh(probe.DataSource.runtimeFilterReceivers[0].uuid)
+	require.False(t, ok)
+
+	// the scope runs on this cn if it is not sent, e.g. the cn client is closed.
+	arg = &hashbuild.Argument{RuntimeFilterSpecs: []*plan.RuntimeFilterSpec{spec}}
+	probe.DataSource.runtimeFilterReceivers = nil
+	connectRuntimeFilters(c, arg, probe)
+	uuid := probe.DataSource.runtimeFilterReceivers[0].uuid
+	arg.RuntimeFilterSenders[1][0] <- &engine.RuntimeFilter{
+		Typ:  engine.RuntimeFilterIn,
+		Keys: []byte("keys"),
+	}
+	rd = &testRuntimeFilterReader{}
+	require.NoError(t, probe.applyRuntimeFilters([]engine.Reader{rd}))
+	require.Equal(t, 1, len(rd.filters))
+	_, ok = colexec.Srv.GetRuntimeFilterCh(uuid)
+	require.False(t, ok)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergegroup"
@@ -175,6 +176,7 @@ func (s *Scope) RemoteRun(c *Compile) error {
 	}
 
 	err := s.remoteRun(c)
+	releaseRemoteRuntimeFilters(s)
 	// tell connect operator that it's over
 	arg := s.Instructions[len(s.Instructions)-1].Arg.(*connector.Argument)
 	arg.Free(s.Proc, err != nil)
@@ -243,6 +245,9 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		}
 		s.NodeInfo.Data = nil
 	}
+	if err := s.applyRuntimeFilters(rds); err != nil {
+		return err
+	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
 		ss[i] = &Scope{
@@ -306,6 +311,9 @@ func (s *Scope) JoinRun(c *Compile) error {
 		ss[i].Proc.Reg.MergeReceivers[1].Ch = make(chan *batch.Batch, 10)
 	}
 	left_scope, right_scope := c.newLeftScope(s, ss), c.newRightScope(s, ss)
+	if len(chp) > 0 {
+		connectRuntimeFilters(c, right_scope.Instructions[0].Arg.(*hashbuild.Argument), chp[0])
+	}
 	s = newParallelScope(c, s, ss)
 
 	if isRight {
@@ -585,6 +593,9 @@ func copyScope(srcScope *Scope, regMap map[*process.WaitRegister]*process.WaitRe
 			// read only.
 			Expr:     srcScope.DataSource.Expr,
 			TableDef: srcScope.DataSource.TableDef,
+			CteName:  srcScope.DataSource.CteName,
+
+			RuntimeFilterSpecs: srcScope.DataSource.RuntimeFilterSpecs,
 		}
 
 		// IF const run.
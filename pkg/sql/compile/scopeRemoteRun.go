@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -48,6 +49,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
@@ -68,6 +70,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
@@ -144,8 +147,30 @@ func cnMessageHandle(receiver messageReceiverOnServer) error {
 		<-doneCh
 		return nil
 
+	case pipeline.RuntimeFilterMessage: // send the runtime filter to the scan on another cn
+		ch, ok := colexec.Srv.GetRuntimeFilterCh(receiver.messageUuid)
+		if !ok {
+			// the join is over, the scan reads all the rows.
+			return nil
+		}
+		select {
+		case <-receiver.ctx.Done():
+			return receiver.ctx.Err()
+		case <-ch.Ctx.Done():
+			return ch.Ctx.Err()
+		case filter := <-ch.Ch:
+			return receiver.sendRuntimeFilter(filter)
+		}
+
 	case pipeline.PipelineMessage:
 		c := receiver.newCompile()
+		defer c.proc.StopQueryLimiter()
+		// the cn-client cancels the query against the memory allocated on all the cns.
+		c.proc.SetQueryMemReporter(func(used int64) {
+			if err := receiver.sendQueryMem(used); err != nil {
+				logutil.Errorf("report the memory of the query failed. error:%v", err)
+			}
+		})
 
 		// decode and rewrite the scope.
 		// insert operator needs to fill the engine info.
@@ -157,6 +182,11 @@ func cnMessageHandle(receiver messageReceiverOnServer) error {
 		s = refactorScope(c, c.ctx, s)
 
 		err = s.ParallelRun(c, s.IsRemote)
+		// the scope is canceled by the limits of the query, which are
+		// checked on this cn too, tell the cn-client why.
+		if e := c.proc.QueryLimitError(); e != nil {
+			return e
+		}
 		if err != nil {
 			return err
 		}
@@ -174,6 +204,9 @@ func receiveMessageFromCnServer(c *Compile, sender messageSenderOnClient, nextAn
 	var err error
 	var dataBuffer []byte
 	var sequence uint64
+	// the memory allocated on the cn-server is freed once the scope is over.
+	streamId := sender.streamSender.ID()
+	defer c.proc.SetRemoteQueryMem(streamId, 0)
 	for {
 		val, err = sender.receiveMessage()
 		if err != nil {
@@ -185,6 +218,10 @@ func receiveMessageFromCnServer(c *Compile, sender messageSenderOnClient, nextAn
 		if errInfo, get := m.TryToGetMoErr(); get {
 			return errInfo
 		}
+		if m.GetCmd() == pipeline.QueryMemMessage {
+			c.proc.SetRemoteQueryMem(streamId, types.DecodeInt64(m.Data))
+			continue
+		}
 		if m.IsEndMessage() {
 			anaData := m.GetAnalyse()
 			if len(anaData) > 0 {
@@ -410,6 +447,19 @@ func generatePipeline(s *Scope, ctx *scopeContext, ctxId int32) (*pipeline.Pipel
 			Expr:         s.DataSource.Expr,
 			TableDef:     s.DataSource.TableDef,
 			Timestamp:    &s.DataSource.Timestamp,
+
+			RuntimeFilterProbeList: s.DataSource.RuntimeFilterSpecs,
+		}
+		for _, r := range s.DataSource.runtimeFilterReceivers {
+			if r.addr == "" {
+				continue
+			}
+			p.DataSource.RuntimeFilterReceivers = append(p.DataSource.RuntimeFilterReceivers,
+				&pipeline.RuntimeFilterReceiver{
+					Uuid: r.uuid[:],
+					Col:  r.col,
+					Addr: r.addr,
+				})
 		}
 		if s.DataSource.Bat != nil {
 			data, err := types.Encode(s.DataSource.Bat)
@@ -513,6 +563,20 @@ func generateScope(proc *process.Process, p *pipeline.Pipeline, ctx *scopeContex
 			Expr:         dsc.Expr,
 			TableDef:     dsc.TableDef,
 			Timestamp:    *dsc.Timestamp,
+
+			RuntimeFilterSpecs: dsc.RuntimeFilterProbeList,
+		}
+		for _, r := range dsc.RuntimeFilterReceivers {
+			u, err := uuid.FromBytes(r.Uuid)
+			if err != nil {
+				return nil, err
+			}
+			s.DataSource.runtimeFilterReceivers = append(s.DataSource.runtimeFilterReceivers,
+				&runtimeFilterReceiver{
+					col:  r.Col,
+					uuid: u,
+					addr: r.Addr,
+				})
 		}
 		if len(dsc.Block) > 0 {
 			bat := new(batch.Batch)
@@ -594,6 +658,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			TableDef:        t.TableDef,
 			OnDuplicateIdx:  t.OnDuplicateIdx,
 			OnDuplicateExpr: t.OnDuplicateExpr,
+			IsReplace:       t.IsReplace,
+			RowIdx:          t.RowIdx,
 		}
 	case *preinsert.Argument:
 		in.PreInsert = &pipeline.PreInsert{
@@ -658,6 +724,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Types:     convertToPlanTypes(t.Typs),
 			LeftCond:  t.Conditions[0],
 			RightCond: t.Conditions[1],
+
+			RuntimeFilterBuildList: t.RuntimeFilterSpecs,
 		}
 	case *left.Argument:
 		relList, colList := getRelColList(t.Result)
@@ -752,6 +820,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Types:     convertToPlanTypes(t.Typs),
 			LeftCond:  t.Conditions[0],
 			RightCond: t.Conditions[1],
+
+			RuntimeFilterBuildList: t.RuntimeFilterSpecs,
 		}
 	case *single.Argument:
 		relList, colList := getRelColList(t.Result)
@@ -798,6 +868,8 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 		in.OrderBy = t.Fs
 	case *mergeorder.Argument:
 		in.OrderBy = t.Fs
+	case *window.Argument:
+		in.WinSpec = t.WinSpec
 	case *connector.Argument:
 		idx, ctx0 := ctx.root.findRegister(t.Reg)
 		if ctx0.root.isRemote(ctx0, 0) && !ctx0.isDescendant(ctx) {
@@ -852,6 +924,12 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			CreateSql:     t.Es.CreateSql,
 			FileList:      t.Es.FileList,
 		}
+	case *recursivecte.Argument:
+		// the recursive member is compiled and run by the local CN in each iteration.
+		return -1, nil, moerr.NewNYINoCtx("recursive CTE in remote pipeline")
+	case *lockop.Argument:
+		// the lock operator is always compiled into the merge scope of the current CN.
+		return -1, nil, moerr.NewNYINoCtx("lock rows in remote pipeline")
 	default:
 		return -1, nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("unexpected operator: %v", opr.Op))
 	}
@@ -889,6 +967,8 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 			TableDef:        t.TableDef,
 			OnDuplicateIdx:  t.OnDuplicateIdx,
 			OnDuplicateExpr: t.OnDuplicateExpr,
+			IsReplace:       t.IsReplace,
+			RowIdx:          t.RowIdx,
 		}
 	case vm.Anti:
 		t := opr.GetAnti()
@@ -940,12 +1020,13 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 	case vm.Join:
 		t := opr.GetJoin()
 		v.Arg = &join.Argument{
-			Ibucket:    t.Ibucket,
-			Nbucket:    t.Nbucket,
-			Cond:       t.Expr,
-			Typs:       convertToTypes(t.Types),
-			Result:     convertToResultPos(t.RelList, t.ColList),
-			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+			Ibucket:            t.Ibucket,
+			Nbucket:            t.Nbucket,
+			Cond:               t.Expr,
+			Typs:               convertToTypes(t.Types),
+			Result:             convertToResultPos(t.RelList, t.ColList),
+			Conditions:         [][]*plan.Expr{t.LeftCond, t.RightCond},
+			RuntimeFilterSpecs: t.RuntimeFilterBuildList,
 		}
 	case vm.Left:
 		t := opr.GetLeftJoin()
@@ -1029,12 +1110,13 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 	case vm.Semi:
 		t := opr.GetSemiJoin()
 		v.Arg = &semi.Argument{
-			Ibucket:    t.Ibucket,
-			Nbucket:    t.Nbucket,
-			Result:     t.Result,
-			Cond:       t.Expr,
-			Typs:       convertToTypes(t.Types),
-			Conditions: [][]*plan.Expr{t.LeftCond, t.RightCond},
+			Ibucket:            t.Ibucket,
+			Nbucket:            t.Nbucket,
+			Result:             t.Result,
+			Cond:               t.Expr,
+			Typs:               convertToTypes(t.Types),
+			Conditions:         [][]*plan.Expr{t.LeftCond, t.RightCond},
+			RuntimeFilterSpecs: t.RuntimeFilterBuildList,
 		}
 	case vm.Single:
 		t := opr.GetSingleJoin()
@@ -1109,6 +1191,10 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 		v.Arg = &mergeorder.Argument{
 			Fs: opr.OrderBy,
 		}
+	case vm.Window:
+		v.Arg = &window.Argument{
+			WinSpec: opr.WinSpec,
+		}
 	case vm.TableFunction:
 		v.Arg = &table_function.Argument{
 			Attrs:  opr.TableFunction.Attrs,
@@ -1253,22 +1339,30 @@ func convertToResultPos(relList, colList []int32) []colexec.ResultPos {
 // convert process.Limitation to pipeline.ProcessLimitation
 func convertToPipelineLimitation(lim process.Limitation) *pipeline.ProcessLimitation {
 	return &pipeline.ProcessLimitation{
-		Size:          lim.Size,
-		BatchRows:     lim.BatchRows,
-		BatchSize:     lim.BatchSize,
-		PartitionRows: lim.PartitionRows,
-		ReaderSize:    lim.ReaderSize,
+		Size:             lim.Size,
+		BatchRows:        lim.BatchRows,
+		BatchSize:        lim.BatchSize,
+		PartitionRows:    lim.PartitionRows,
+		ReaderSize:       lim.ReaderSize,
+		SpillSize:        lim.SpillSize,
+		MaxExecutionTime: lim.MaxExecutionTime,
+		QueryMemLimit:    lim.QueryMemLimit,
+		QueryDeadline:    lim.QueryDeadline,
 	}
 }
 
 // convert pipeline.ProcessLimitation to process.Limitation
 func convertToProcessLimitation(lim *pipeline.ProcessLimitation) process.Limitation {
 	return process.Limitation{
-		Size:          lim.Size,
-		BatchRows:     lim.BatchRows,
-		BatchSize:     lim.BatchSize,
-		PartitionRows: lim.PartitionRows,
-		ReaderSize:    lim.ReaderSize,
+		Size:             lim.Size,
+		BatchRows:        lim.BatchRows,
+		BatchSize:        lim.BatchSize,
+		PartitionRows:    lim.PartitionRows,
+		ReaderSize:       lim.ReaderSize,
+		SpillSize:        lim.SpillSize,
+		MaxExecutionTime: lim.MaxExecutionTime,
+		QueryMemLimit:    lim.QueryMemLimit,
+		QueryDeadline:    lim.QueryDeadline,
 	}
 }
 
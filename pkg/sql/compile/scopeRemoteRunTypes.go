@@ -17,6 +17,7 @@ package compile
 import (
 	"context"
 	"hash/crc32"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -174,7 +175,10 @@ type messageReceiverOnServer struct {
 	// information to build a process.
 	procBuildHelper processHelper
 
-	clientSession   morpc.ClientSession
+	clientSession morpc.ClientSession
+	// writeMu serializes the messages written to the client session, the memory
+	// allocated by the query is reported while the batches are sent.
+	writeMu         *sync.Mutex
 	messageAcquirer func() morpc.Message
 	maxMessageSize  int
 	scopeData       []byte
@@ -200,13 +204,14 @@ func newMessageReceiverOnServer(
 		messageId:       m.GetId(),
 		messageTyp:      m.GetCmd(),
 		clientSession:   cs,
+		writeMu:         &sync.Mutex{},
 		messageAcquirer: messageAcquirer,
 		maxMessageSize:  maxMessageSizeToMoRpc,
 		sequence:        0,
 	}
 
 	switch m.GetCmd() {
-	case pipeline.PrepareDoneNotifyMessage:
+	case pipeline.PrepareDoneNotifyMessage, pipeline.RuntimeFilterMessage:
 		opUuid, err := uuid.FromBytes(m.GetUuid())
 		if err != nil {
 			logutil.Errorf("decode uuid from pipeline.Message failed, bytes are %v", m.GetUuid())
@@ -267,9 +272,13 @@ func (receiver *messageReceiverOnServer) newCompile() *Compile {
 		proc.AnalInfos[i].NodeId = pHelper.analysisNodeList[i]
 	}
 	proc.DispatchNotifyCh = make(chan process.WrapCs, 1)
+	// the scope is canceled at the deadline of the query on the cn-client, and
+	// once it allocates more memory than the limit of the query on this cn, the
+	// memory allocated is reported to the cn-client checking the whole query.
+	proc.Ctx = proc.StartQueryLimiter(receiver.ctx)
 
 	c := &Compile{
-		ctx:  receiver.ctx,
+		ctx:  proc.Ctx,
 		proc: proc,
 		e:    cnInfo.storeEngine,
 		anal: &anaylze{},
@@ -293,6 +302,12 @@ func (receiver *messageReceiverOnServer) sendError(
 	if errInfo != nil {
 		message.SetMoError(receiver.ctx, errInfo)
 	}
+	return receiver.write(message)
+}
+
+func (receiver *messageReceiverOnServer) write(message morpc.Message) error {
+	receiver.writeMu.Lock()
+	defer receiver.writeMu.Unlock()
 	return receiver.clientSession.Write(receiver.ctx, message)
 }
 
@@ -321,7 +336,7 @@ func (receiver *messageReceiverOnServer) sendBatch(
 		m.SetSequence(receiver.sequence)
 		m.SetSid(pipeline.Last)
 		receiver.sequence++
-		return receiver.clientSession.Write(receiver.ctx, m)
+		return receiver.write(m)
 	}
 	// if data is too large, cut and send
 	for start, end := 0, 0; start < dataLen; start = end {
@@ -342,13 +357,49 @@ func (receiver *messageReceiverOnServer) sendBatch(
 		m.SetSequence(receiver.sequence)
 		receiver.sequence++
 
-		if errW := receiver.clientSession.Write(receiver.ctx, m); errW != nil {
+		if errW := receiver.write(m); errW != nil {
 			return errW
 		}
 	}
 	return nil
 }
 
+func (receiver *messageReceiverOnServer) sendRuntimeFilter(
+	filter *engine.RuntimeFilter) error {
+	// the end message tells the scan there's no filter.
+	if filter == nil {
+		return nil
+	}
+	data, err := (&pipeline.RuntimeFilter{
+		Typ:   int32(filter.Typ),
+		Keys:  filter.Keys,
+		Bloom: filter.Bloom,
+	}).Marshal()
+	if err != nil {
+		return err
+	}
+	m, err := receiver.acquireMessage()
+	if err != nil {
+		return err
+	}
+	m.SetMessageType(pipeline.RuntimeFilterMessage)
+	m.SetData(data)
+	m.SetSid(pipeline.Last)
+	return receiver.write(m)
+}
+
+// sendQueryMem reports the memory allocated by the query on this cn.
+func (receiver *messageReceiverOnServer) sendQueryMem(used int64) error {
+	m, err := receiver.acquireMessage()
+	if err != nil {
+		return err
+	}
+	m.SetMessageType(pipeline.QueryMemMessage)
+	m.SetData(types.EncodeInt64(&used))
+	m.SetSid(pipeline.Last)
+	return receiver.write(m)
+}
+
 func (receiver *messageReceiverOnServer) sendEndMessage() error {
 	message, err := receiver.acquireMessage()
 	if err != nil {
@@ -372,7 +423,7 @@ func (receiver *messageReceiverOnServer) sendEndMessage() error {
 		}
 		message.SetAnalysis(data)
 	}
-	return receiver.clientSession.Write(receiver.ctx, message)
+	return receiver.write(message)
 }
 
 func generateProcessHelper(data []byte, cli client.TxnClient) (processHelper, error) {
@@ -59,6 +59,7 @@ const (
 	TruncateTable
 	AlterView
 	MergeInsert
+	AlterTable
 )
 
 // Source contains information of a relation which will be used in execution,
@@ -73,6 +74,14 @@ type Source struct {
 	Expr         *plan.Expr
 	TableDef     *plan.TableDef
 	Timestamp    timestamp.Timestamp
+
+	// CteName is the name of the recursive CTE whose working table is read by the
+	// scan, the working table is bound to Bat each time the recursive member is run.
+	CteName string
+
+	// RuntimeFilterSpecs are the runtime filters pushed into the scan by joins.
+	RuntimeFilterSpecs     []*plan.RuntimeFilterSpec
+	runtimeFilterReceivers []*runtimeFilterReceiver
 }
 
 // Col is the information of attribute
@@ -173,6 +182,13 @@ type Compile struct {
 	// remote, but now the tempEngine is just standlone. So for now use this to read
 	// table locally. But int the future, this will disappear.
 	isTemporaryScan bool
+
+	// cteWorkings are the working tables of the recursive CTEs being iterated, indexed by the name of CTE.
+	// they are updated by the iterations of the recursive CTEs.
+	cteWorkings map[string]*batch.Batch
+
+	// snapshots are the read-only txns used to read the tables with AS OF TIMESTAMP.
+	snapshots []client.TxnOperator
 }
 type RemoteReceivRegInfo struct {
 	Idx      int
@@ -259,6 +259,7 @@ func init() {
 		"mod":                      MOD,
 		"month":                    MONTH,
 		"mode":                     MODE,
+		"modify":                   MODIFY,
 		"memory":                   MEMORY,
 		"modifies":                 UNUSED,
 		"multilinestring":          MULTILINESTRING,
@@ -268,6 +269,8 @@ func init() {
 		"max_update_per_hour":      MAX_UPDATES_PER_HOUR,
 		"max_connections_per_hour": MAX_CONNECTIONS_PER_HOUR,
 		"max_user_connections":     MAX_USER_CONNECTIONS,
+		"max_storage_size":         MAX_STORAGE_SIZE,
+		"max_concurrent_queries":   MAX_CONCURRENT_QUERIES,
 		"max_rows":                 MAX_ROWS,
 		"min_rows":                 MIN_ROWS,
 		"names":                    NAMES,
@@ -283,6 +286,7 @@ func init() {
 		"nulls":                    NULLS,
 		"numeric":                  NUMERIC,
 		"none":                     NONE,
+		"of":                       OF,
 		"offset":                   OFFSET,
 		"on":                       ON,
 		"only":                     ONLY,
@@ -329,6 +333,7 @@ func init() {
 		"redundant":                REDUNDANT,
 		"read_write":               UNUSED,
 		"real":                     REAL,
+		"recursive":                RECURSIVE,
 		"references":               REFERENCES,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
@@ -355,6 +360,7 @@ func init() {
 		"row_format":               ROW_FORMAT,
 		"row_count":                ROW_COUNT,
 		"rtree":                    RTREE,
+		"savepoint":                SAVEPOINT,
 		"schema":                   SCHEMA,
 		"schemas":                  SCHEMAS,
 		"second":                   SECOND,
@@ -365,6 +371,9 @@ func init() {
 		"session":                  SESSION,
 		"set":                      SET,
 		"share":                    SHARE,
+		"skip":                     SKIP,
+		"locked":                   LOCKED,
+		"nowait":                   NOWAIT,
 		"show":                     SHOW,
 		"shutdown":                 SHUTDOWN,
 		"signal":                   UNUSED,
@@ -538,5 +547,10 @@ func init() {
 		"publication":              PUBLICATION,
 		"subscriptions":            SUBSCRIPTIONS,
 		"publications":             PUBLICATIONS,
+		"changefeed":               CHANGEFEED,
+		"changefeeds":              CHANGEFEEDS,
+		"audit":                    AUDIT,
+		"policy":                   POLICY,
+		"policies":                 POLICIES,
 	}
 }
@@ -21,522 +21,537 @@ import (
 )
 
 const LEX_ERROR = 57346
-const EMPTY = 57347
-const UNION = 57348
-const EXCEPT = 57349
-const INTERSECT = 57350
-const MINUS = 57351
-const SELECT = 57352
-const STREAM = 57353
-const INSERT = 57354
-const UPDATE = 57355
-const DELETE = 57356
-const FROM = 57357
-const WHERE = 57358
-const GROUP = 57359
-const HAVING = 57360
-const ORDER = 57361
-const BY = 57362
-const LIMIT = 57363
-const OFFSET = 57364
-const FOR = 57365
-const CONNECT = 57366
-const MANAGE = 57367
-const GRANTS = 57368
-const OWNERSHIP = 57369
-const REFERENCE = 57370
-const LOWER_THAN_SET = 57371
-const SET = 57372
-const ALL = 57373
-const DISTINCT = 57374
-const DISTINCTROW = 57375
-const AS = 57376
-const EXISTS = 57377
-const ASC = 57378
-const DESC = 57379
-const INTO = 57380
-const DUPLICATE = 57381
-const DEFAULT = 57382
-const LOCK = 57383
-const KEYS = 57384
-const NULLS = 57385
-const FIRST = 57386
-const LAST = 57387
-const VALUES = 57388
-const NEXT = 57389
-const VALUE = 57390
-const SHARE = 57391
-const MODE = 57392
-const SQL_NO_CACHE = 57393
-const SQL_CACHE = 57394
-const JOIN = 57395
-const STRAIGHT_JOIN = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const INNER = 57399
-const OUTER = 57400
-const CROSS = 57401
-const NATURAL = 57402
-const USE = 57403
-const FORCE = 57404
-const LOWER_THAN_ON = 57405
-const ON = 57406
-const USING = 57407
-const SUBQUERY_AS_EXPR = 57408
-const LOWER_THAN_STRING = 57409
-const ID = 57410
-const AT_ID = 57411
-const AT_AT_ID = 57412
-const STRING = 57413
-const VALUE_ARG = 57414
-const LIST_ARG = 57415
-const COMMENT = 57416
-const COMMENT_KEYWORD = 57417
-const QUOTE_ID = 57418
-const INTEGRAL = 57419
-const HEX = 57420
-const BIT_LITERAL = 57421
-const FLOAT = 57422
-const HEXNUM = 57423
-const NULL = 57424
-const TRUE = 57425
-const FALSE = 57426
-const LOWER_THAN_CHARSET = 57427
-const CHARSET = 57428
-const UNIQUE = 57429
-const KEY = 57430
-const OR = 57431
-const PIPE_CONCAT = 57432
-const XOR = 57433
-const AND = 57434
-const NOT = 57435
-const BETWEEN = 57436
-const CASE = 57437
-const WHEN = 57438
-const THEN = 57439
-const ELSE = 57440
-const END = 57441
-const LOWER_THAN_EQ = 57442
-const LE = 57443
-const GE = 57444
-const NE = 57445
-const NULL_SAFE_EQUAL = 57446
-const IS = 57447
-const LIKE = 57448
-const REGEXP = 57449
-const IN = 57450
-const ASSIGNMENT = 57451
-const ILIKE = 57452
-const SHIFT_LEFT = 57453
-const SHIFT_RIGHT = 57454
-const DIV = 57455
-const MOD = 57456
-const UNARY = 57457
-const COLLATE = 57458
-const BINARY = 57459
-const UNDERSCORE_BINARY = 57460
-const INTERVAL = 57461
-const BEGIN = 57462
-const START = 57463
-const TRANSACTION = 57464
-const COMMIT = 57465
-const ROLLBACK = 57466
-const WORK = 57467
-const CONSISTENT = 57468
-const SNAPSHOT = 57469
-const CHAIN = 57470
-const NO = 57471
-const RELEASE = 57472
-const PRIORITY = 57473
-const QUICK = 57474
-const BIT = 57475
-const TINYINT = 57476
-const SMALLINT = 57477
-const MEDIUMINT = 57478
-const INT = 57479
-const INTEGER = 57480
-const BIGINT = 57481
-const INTNUM = 57482
-const REAL = 57483
-const DOUBLE = 57484
-const FLOAT_TYPE = 57485
-const DECIMAL = 57486
-const NUMERIC = 57487
-const DECIMAL_VALUE = 57488
-const TIME = 57489
-const TIMESTAMP = 57490
-const DATETIME = 57491
-const YEAR = 57492
-const CHAR = 57493
-const VARCHAR = 57494
-const BOOL = 57495
-const CHARACTER = 57496
-const VARBINARY = 57497
-const NCHAR = 57498
-const TEXT = 57499
-const TINYTEXT = 57500
-const MEDIUMTEXT = 57501
-const LONGTEXT = 57502
-const BLOB = 57503
-const TINYBLOB = 57504
-const MEDIUMBLOB = 57505
-const LONGBLOB = 57506
-const JSON = 57507
-const ENUM = 57508
-const UUID = 57509
-const GEOMETRY = 57510
-const POINT = 57511
-const LINESTRING = 57512
-const POLYGON = 57513
-const GEOMETRYCOLLECTION = 57514
-const MULTIPOINT = 57515
-const MULTILINESTRING = 57516
-const MULTIPOLYGON = 57517
-const INT1 = 57518
-const INT2 = 57519
-const INT3 = 57520
-const INT4 = 57521
-const INT8 = 57522
-const S3OPTION = 57523
-const SQL_SMALL_RESULT = 57524
-const SQL_BIG_RESULT = 57525
-const SQL_BUFFER_RESULT = 57526
-const LOW_PRIORITY = 57527
-const HIGH_PRIORITY = 57528
-const DELAYED = 57529
-const CREATE = 57530
-const ALTER = 57531
-const DROP = 57532
-const RENAME = 57533
-const ANALYZE = 57534
-const ADD = 57535
-const RETURNS = 57536
-const SCHEMA = 57537
-const TABLE = 57538
-const INDEX = 57539
-const VIEW = 57540
-const TO = 57541
-const IGNORE = 57542
-const IF = 57543
-const PRIMARY = 57544
-const COLUMN = 57545
-const CONSTRAINT = 57546
-const SPATIAL = 57547
-const FULLTEXT = 57548
-const FOREIGN = 57549
-const KEY_BLOCK_SIZE = 57550
-const SHOW = 57551
-const DESCRIBE = 57552
-const EXPLAIN = 57553
-const DATE = 57554
-const ESCAPE = 57555
-const REPAIR = 57556
-const OPTIMIZE = 57557
-const TRUNCATE = 57558
-const MAXVALUE = 57559
-const PARTITION = 57560
-const REORGANIZE = 57561
-const LESS = 57562
-const THAN = 57563
-const PROCEDURE = 57564
-const TRIGGER = 57565
-const STATUS = 57566
-const VARIABLES = 57567
-const ROLE = 57568
-const PROXY = 57569
-const AVG_ROW_LENGTH = 57570
-const STORAGE = 57571
-const DISK = 57572
-const MEMORY = 57573
-const CHECKSUM = 57574
-const COMPRESSION = 57575
-const DATA = 57576
-const DIRECTORY = 57577
-const DELAY_KEY_WRITE = 57578
-const ENCRYPTION = 57579
-const ENGINE = 57580
-const MAX_ROWS = 57581
-const MIN_ROWS = 57582
-const PACK_KEYS = 57583
-const ROW_FORMAT = 57584
-const STATS_AUTO_RECALC = 57585
-const STATS_PERSISTENT = 57586
-const STATS_SAMPLE_PAGES = 57587
-const DYNAMIC = 57588
-const COMPRESSED = 57589
-const REDUNDANT = 57590
-const COMPACT = 57591
-const FIXED = 57592
-const COLUMN_FORMAT = 57593
-const AUTO_RANDOM = 57594
-const RESTRICT = 57595
-const CASCADE = 57596
-const ACTION = 57597
-const PARTIAL = 57598
-const SIMPLE = 57599
-const CHECK = 57600
-const ENFORCED = 57601
-const RANGE = 57602
-const LIST = 57603
-const ALGORITHM = 57604
-const LINEAR = 57605
-const PARTITIONS = 57606
-const SUBPARTITION = 57607
-const SUBPARTITIONS = 57608
-const CLUSTER = 57609
-const TYPE = 57610
-const ANY = 57611
-const SOME = 57612
-const EXTERNAL = 57613
-const LOCALFILE = 57614
-const URL = 57615
-const PREPARE = 57616
-const DEALLOCATE = 57617
-const RESET = 57618
-const EXTENSION = 57619
-const PUBLICATION = 57620
-const SUBSCRIPTIONS = 57621
-const PUBLICATIONS = 57622
-const PROPERTIES = 57623
-const PARSER = 57624
-const VISIBLE = 57625
-const INVISIBLE = 57626
-const BTREE = 57627
-const HASH = 57628
-const RTREE = 57629
-const BSI = 57630
-const ZONEMAP = 57631
-const LEADING = 57632
-const BOTH = 57633
-const TRAILING = 57634
-const UNKNOWN = 57635
-const EXPIRE = 57636
-const ACCOUNT = 57637
-const ACCOUNTS = 57638
-const UNLOCK = 57639
-const DAY = 57640
-const NEVER = 57641
-const PUMP = 57642
-const MYSQL_COMPATBILITY_MODE = 57643
-const SECOND = 57644
-const ASCII = 57645
-const COALESCE = 57646
-const COLLATION = 57647
-const HOUR = 57648
-const MICROSECOND = 57649
-const MINUTE = 57650
-const MONTH = 57651
-const QUARTER = 57652
-const REPEAT = 57653
-const REVERSE = 57654
-const ROW_COUNT = 57655
-const WEEK = 57656
-const REVOKE = 57657
-const FUNCTION = 57658
-const PRIVILEGES = 57659
-const TABLESPACE = 57660
-const EXECUTE = 57661
-const SUPER = 57662
-const GRANT = 57663
-const OPTION = 57664
-const REFERENCES = 57665
-const REPLICATION = 57666
-const SLAVE = 57667
-const CLIENT = 57668
-const USAGE = 57669
-const RELOAD = 57670
-const FILE = 57671
-const TEMPORARY = 57672
-const ROUTINE = 57673
-const EVENT = 57674
-const SHUTDOWN = 57675
-const NULLX = 57676
-const AUTO_INCREMENT = 57677
-const APPROXNUM = 57678
-const SIGNED = 57679
-const UNSIGNED = 57680
-const ZEROFILL = 57681
-const ENGINES = 57682
-const LOW_CARDINALITY = 57683
-const ADMIN_NAME = 57684
-const RANDOM = 57685
-const SUSPEND = 57686
-const ATTRIBUTE = 57687
-const HISTORY = 57688
-const REUSE = 57689
-const CURRENT = 57690
-const OPTIONAL = 57691
-const FAILED_LOGIN_ATTEMPTS = 57692
-const PASSWORD_LOCK_TIME = 57693
-const UNBOUNDED = 57694
-const SECONDARY = 57695
-const USER = 57696
-const IDENTIFIED = 57697
-const CIPHER = 57698
-const ISSUER = 57699
-const X509 = 57700
-const SUBJECT = 57701
-const SAN = 57702
-const REQUIRE = 57703
-const SSL = 57704
-const NONE = 57705
-const PASSWORD = 57706
-const MAX_QUERIES_PER_HOUR = 57707
-const MAX_UPDATES_PER_HOUR = 57708
-const MAX_CONNECTIONS_PER_HOUR = 57709
-const MAX_USER_CONNECTIONS = 57710
-const FORMAT = 57711
-const VERBOSE = 57712
-const CONNECTION = 57713
-const TRIGGERS = 57714
-const PROFILES = 57715
-const LOAD = 57716
-const INFILE = 57717
-const TERMINATED = 57718
-const OPTIONALLY = 57719
-const ENCLOSED = 57720
-const ESCAPED = 57721
-const STARTING = 57722
-const LINES = 57723
-const ROWS = 57724
-const IMPORT = 57725
-const MODUMP = 57726
-const OVER = 57727
-const PRECEDING = 57728
-const FOLLOWING = 57729
-const GROUPS = 57730
-const DATABASES = 57731
-const TABLES = 57732
-const EXTENDED = 57733
-const FULL = 57734
-const PROCESSLIST = 57735
-const FIELDS = 57736
-const COLUMNS = 57737
-const OPEN = 57738
-const ERRORS = 57739
-const WARNINGS = 57740
-const INDEXES = 57741
-const SCHEMAS = 57742
-const NODE = 57743
-const LOCKS = 57744
-const TABLE_NUMBER = 57745
-const COLUMN_NUMBER = 57746
-const TABLE_VALUES = 57747
-const NAMES = 57748
-const GLOBAL = 57749
-const SESSION = 57750
-const ISOLATION = 57751
-const LEVEL = 57752
-const READ = 57753
-const WRITE = 57754
-const ONLY = 57755
-const REPEATABLE = 57756
-const COMMITTED = 57757
-const UNCOMMITTED = 57758
-const SERIALIZABLE = 57759
-const LOCAL = 57760
-const EVENTS = 57761
-const PLUGINS = 57762
-const CURRENT_TIMESTAMP = 57763
-const DATABASE = 57764
-const CURRENT_TIME = 57765
-const LOCALTIME = 57766
-const LOCALTIMESTAMP = 57767
-const UTC_DATE = 57768
-const UTC_TIME = 57769
-const UTC_TIMESTAMP = 57770
-const REPLACE = 57771
-const CONVERT = 57772
-const SEPARATOR = 57773
-const TIMESTAMPDIFF = 57774
-const CURRENT_DATE = 57775
-const CURRENT_USER = 57776
-const CURRENT_ROLE = 57777
-const SECOND_MICROSECOND = 57778
-const MINUTE_MICROSECOND = 57779
-const MINUTE_SECOND = 57780
-const HOUR_MICROSECOND = 57781
-const HOUR_SECOND = 57782
-const HOUR_MINUTE = 57783
-const DAY_MICROSECOND = 57784
-const DAY_SECOND = 57785
-const DAY_MINUTE = 57786
-const DAY_HOUR = 57787
-const YEAR_MONTH = 57788
-const SQL_TSI_HOUR = 57789
-const SQL_TSI_DAY = 57790
-const SQL_TSI_WEEK = 57791
-const SQL_TSI_MONTH = 57792
-const SQL_TSI_QUARTER = 57793
-const SQL_TSI_YEAR = 57794
-const SQL_TSI_SECOND = 57795
-const SQL_TSI_MINUTE = 57796
-const RECURSIVE = 57797
-const CONFIG = 57798
-const DRAINER = 57799
-const MATCH = 57800
-const AGAINST = 57801
-const BOOLEAN = 57802
-const LANGUAGE = 57803
-const WITH = 57804
-const QUERY = 57805
-const EXPANSION = 57806
-const ADDDATE = 57807
-const BIT_AND = 57808
-const BIT_OR = 57809
-const BIT_XOR = 57810
-const CAST = 57811
-const COUNT = 57812
-const APPROX_COUNT_DISTINCT = 57813
-const APPROX_PERCENTILE = 57814
-const CURDATE = 57815
-const CURTIME = 57816
-const DATE_ADD = 57817
-const DATE_SUB = 57818
-const EXTRACT = 57819
-const GROUP_CONCAT = 57820
-const MAX = 57821
-const MID = 57822
-const MIN = 57823
-const NOW = 57824
-const POSITION = 57825
-const SESSION_USER = 57826
-const STD = 57827
-const STDDEV = 57828
-const MEDIAN = 57829
-const STDDEV_POP = 57830
-const STDDEV_SAMP = 57831
-const SUBDATE = 57832
-const SUBSTR = 57833
-const SUBSTRING = 57834
-const SUM = 57835
-const SYSDATE = 57836
-const SYSTEM_USER = 57837
-const TRANSLATE = 57838
-const TRIM = 57839
-const VARIANCE = 57840
-const VAR_POP = 57841
-const VAR_SAMP = 57842
-const AVG = 57843
-const ARROW = 57844
-const ROW = 57845
-const OUTFILE = 57846
-const HEADER = 57847
-const MAX_FILE_SIZE = 57848
-const FORCE_QUOTE = 57849
-const PARALLEL = 57850
-const UNUSED = 57851
-const BINDINGS = 57852
-const DO = 57853
-const DECLARE = 57854
-const KILL = 57855
-const QUERY_RESULT = 57856
+const OPTIMIZER_HINT = 57347
+const EMPTY = 57348
+const UNION = 57349
+const EXCEPT = 57350
+const INTERSECT = 57351
+const MINUS = 57352
+const SELECT = 57353
+const STREAM = 57354
+const INSERT = 57355
+const UPDATE = 57356
+const DELETE = 57357
+const FROM = 57358
+const WHERE = 57359
+const GROUP = 57360
+const HAVING = 57361
+const ORDER = 57362
+const BY = 57363
+const LIMIT = 57364
+const OFFSET = 57365
+const FOR = 57366
+const CONNECT = 57367
+const MANAGE = 57368
+const GRANTS = 57369
+const OWNERSHIP = 57370
+const REFERENCE = 57371
+const LOWER_THAN_SET = 57372
+const SET = 57373
+const ALL = 57374
+const DISTINCT = 57375
+const DISTINCTROW = 57376
+const AS = 57377
+const EXISTS = 57378
+const ASC = 57379
+const DESC = 57380
+const INTO = 57381
+const DUPLICATE = 57382
+const DEFAULT = 57383
+const LOCK = 57384
+const KEYS = 57385
+const NULLS = 57386
+const FIRST = 57387
+const LAST = 57388
+const VALUES = 57389
+const NEXT = 57390
+const VALUE = 57391
+const SHARE = 57392
+const MODE = 57393
+const NOWAIT = 57394
+const SKIP = 57395
+const LOCKED = 57396
+const SQL_NO_CACHE = 57397
+const SQL_CACHE = 57398
+const JOIN = 57399
+const STRAIGHT_JOIN = 57400
+const LEFT = 57401
+const RIGHT = 57402
+const INNER = 57403
+const OUTER = 57404
+const CROSS = 57405
+const NATURAL = 57406
+const USE = 57407
+const FORCE = 57408
+const LOWER_THAN_ON = 57409
+const ON = 57410
+const USING = 57411
+const SUBQUERY_AS_EXPR = 57412
+const LOWER_THAN_STRING = 57413
+const ID = 57414
+const AT_ID = 57415
+const AT_AT_ID = 57416
+const STRING = 57417
+const VALUE_ARG = 57418
+const LIST_ARG = 57419
+const COMMENT = 57420
+const COMMENT_KEYWORD = 57421
+const QUOTE_ID = 57422
+const INTEGRAL = 57423
+const HEX = 57424
+const BIT_LITERAL = 57425
+const FLOAT = 57426
+const HEXNUM = 57427
+const NULL = 57428
+const TRUE = 57429
+const FALSE = 57430
+const LOWER_THAN_CHARSET = 57431
+const CHARSET = 57432
+const UNIQUE = 57433
+const KEY = 57434
+const OR = 57435
+const PIPE_CONCAT = 57436
+const XOR = 57437
+const AND = 57438
+const NOT = 57439
+const BETWEEN = 57440
+const CASE = 57441
+const WHEN = 57442
+const THEN = 57443
+const ELSE = 57444
+const END = 57445
+const LOWER_THAN_EQ = 57446
+const LE = 57447
+const GE = 57448
+const NE = 57449
+const NULL_SAFE_EQUAL = 57450
+const IS = 57451
+const LIKE = 57452
+const REGEXP = 57453
+const IN = 57454
+const ASSIGNMENT = 57455
+const ILIKE = 57456
+const SHIFT_LEFT = 57457
+const SHIFT_RIGHT = 57458
+const DIV = 57459
+const MOD = 57460
+const UNARY = 57461
+const COLLATE = 57462
+const BINARY = 57463
+const UNDERSCORE_BINARY = 57464
+const INTERVAL = 57465
+const BEGIN = 57466
+const START = 57467
+const TRANSACTION = 57468
+const COMMIT = 57469
+const ROLLBACK = 57470
+const WORK = 57471
+const CONSISTENT = 57472
+const SNAPSHOT = 57473
+const CHAIN = 57474
+const NO = 57475
+const RELEASE = 57476
+const PRIORITY = 57477
+const QUICK = 57478
+const SAVEPOINT = 57479
+const OF = 57480
+const BIT = 57481
+const TINYINT = 57482
+const SMALLINT = 57483
+const MEDIUMINT = 57484
+const INT = 57485
+const INTEGER = 57486
+const BIGINT = 57487
+const INTNUM = 57488
+const REAL = 57489
+const DOUBLE = 57490
+const FLOAT_TYPE = 57491
+const DECIMAL = 57492
+const NUMERIC = 57493
+const DECIMAL_VALUE = 57494
+const TIME = 57495
+const TIMESTAMP = 57496
+const DATETIME = 57497
+const YEAR = 57498
+const CHAR = 57499
+const VARCHAR = 57500
+const BOOL = 57501
+const CHARACTER = 57502
+const VARBINARY = 57503
+const NCHAR = 57504
+const TEXT = 57505
+const TINYTEXT = 57506
+const MEDIUMTEXT = 57507
+const LONGTEXT = 57508
+const BLOB = 57509
+const TINYBLOB = 57510
+const MEDIUMBLOB = 57511
+const LONGBLOB = 57512
+const JSON = 57513
+const ENUM = 57514
+const UUID = 57515
+const GEOMETRY = 57516
+const POINT = 57517
+const LINESTRING = 57518
+const POLYGON = 57519
+const GEOMETRYCOLLECTION = 57520
+const MULTIPOINT = 57521
+const MULTILINESTRING = 57522
+const MULTIPOLYGON = 57523
+const INT1 = 57524
+const INT2 = 57525
+const INT3 = 57526
+const INT4 = 57527
+const INT8 = 57528
+const S3OPTION = 57529
+const SQL_SMALL_RESULT = 57530
+const SQL_BIG_RESULT = 57531
+const SQL_BUFFER_RESULT = 57532
+const LOW_PRIORITY = 57533
+const HIGH_PRIORITY = 57534
+const DELAYED = 57535
+const CREATE = 57536
+const ALTER = 57537
+const DROP = 57538
+const RENAME = 57539
+const ANALYZE = 57540
+const ADD = 57541
+const RETURNS = 57542
+const MODIFY = 57543
+const SCHEMA = 57544
+const TABLE = 57545
+const INDEX = 57546
+const VIEW = 57547
+const TO = 57548
+const IGNORE = 57549
+const IF = 57550
+const PRIMARY = 57551
+const COLUMN = 57552
+const CONSTRAINT = 57553
+const SPATIAL = 57554
+const FULLTEXT = 57555
+const FOREIGN = 57556
+const KEY_BLOCK_SIZE = 57557
+const SHOW = 57558
+const DESCRIBE = 57559
+const EXPLAIN = 57560
+const DATE = 57561
+const ESCAPE = 57562
+const REPAIR = 57563
+const OPTIMIZE = 57564
+const TRUNCATE = 57565
+const MAXVALUE = 57566
+const PARTITION = 57567
+const REORGANIZE = 57568
+const LESS = 57569
+const THAN = 57570
+const PROCEDURE = 57571
+const TRIGGER = 57572
+const STATUS = 57573
+const VARIABLES = 57574
+const ROLE = 57575
+const PROXY = 57576
+const AVG_ROW_LENGTH = 57577
+const STORAGE = 57578
+const DISK = 57579
+const MEMORY = 57580
+const CHECKSUM = 57581
+const COMPRESSION = 57582
+const DATA = 57583
+const DIRECTORY = 57584
+const DELAY_KEY_WRITE = 57585
+const ENCRYPTION = 57586
+const ENGINE = 57587
+const MAX_ROWS = 57588
+const MIN_ROWS = 57589
+const PACK_KEYS = 57590
+const ROW_FORMAT = 57591
+const STATS_AUTO_RECALC = 57592
+const STATS_PERSISTENT = 57593
+const STATS_SAMPLE_PAGES = 57594
+const DYNAMIC = 57595
+const COMPRESSED = 57596
+const REDUNDANT = 57597
+const COMPACT = 57598
+const FIXED = 57599
+const COLUMN_FORMAT = 57600
+const AUTO_RANDOM = 57601
+const RESTRICT = 57602
+const CASCADE = 57603
+const ACTION = 57604
+const PARTIAL = 57605
+const SIMPLE = 57606
+const CHECK = 57607
+const ENFORCED = 57608
+const RANGE = 57609
+const LIST = 57610
+const ALGORITHM = 57611
+const LINEAR = 57612
+const PARTITIONS = 57613
+const SUBPARTITION = 57614
+const SUBPARTITIONS = 57615
+const CLUSTER = 57616
+const TYPE = 57617
+const ANY = 57618
+const SOME = 57619
+const EXTERNAL = 57620
+const LOCALFILE = 57621
+const URL = 57622
+const PREPARE = 57623
+const DEALLOCATE = 57624
+const RESET = 57625
+const EXTENSION = 57626
+const PUBLICATION = 57627
+const SUBSCRIPTIONS = 57628
+const PUBLICATIONS = 57629
+const CHANGEFEED = 57630
+const CHANGEFEEDS = 57631
+const AUDIT = 57632
+const POLICY = 57633
+const POLICIES = 57634
+const PROPERTIES = 57635
+const PARSER = 57636
+const VISIBLE = 57637
+const INVISIBLE = 57638
+const BTREE = 57639
+const HASH = 57640
+const RTREE = 57641
+const BSI = 57642
+const ZONEMAP = 57643
+const LEADING = 57644
+const BOTH = 57645
+const TRAILING = 57646
+const UNKNOWN = 57647
+const EXPIRE = 57648
+const ACCOUNT = 57649
+const ACCOUNTS = 57650
+const UNLOCK = 57651
+const DAY = 57652
+const NEVER = 57653
+const PUMP = 57654
+const MYSQL_COMPATBILITY_MODE = 57655
+const SECOND = 57656
+const ASCII = 57657
+const COALESCE = 57658
+const COLLATION = 57659
+const HOUR = 57660
+const MICROSECOND = 57661
+const MINUTE = 57662
+const MONTH = 57663
+const QUARTER = 57664
+const REPEAT = 57665
+const REVERSE = 57666
+const ROW_COUNT = 57667
+const WEEK = 57668
+const REVOKE = 57669
+const FUNCTION = 57670
+const PRIVILEGES = 57671
+const TABLESPACE = 57672
+const EXECUTE = 57673
+const SUPER = 57674
+const GRANT = 57675
+const OPTION = 57676
+const REFERENCES = 57677
+const REPLICATION = 57678
+const SLAVE = 57679
+const CLIENT = 57680
+const USAGE = 57681
+const RELOAD = 57682
+const FILE = 57683
+const TEMPORARY = 57684
+const ROUTINE = 57685
+const EVENT = 57686
+const SHUTDOWN = 57687
+const NULLX = 57688
+const AUTO_INCREMENT = 57689
+const APPROXNUM = 57690
+const SIGNED = 57691
+const UNSIGNED = 57692
+const ZEROFILL = 57693
+const ENGINES = 57694
+const LOW_CARDINALITY = 57695
+const ADMIN_NAME = 57696
+const RANDOM = 57697
+const SUSPEND = 57698
+const ATTRIBUTE = 57699
+const HISTORY = 57700
+const REUSE = 57701
+const CURRENT = 57702
+const OPTIONAL = 57703
+const FAILED_LOGIN_ATTEMPTS = 57704
+const PASSWORD_LOCK_TIME = 57705
+const UNBOUNDED = 57706
+const SECONDARY = 57707
+const USER = 57708
+const IDENTIFIED = 57709
+const CIPHER = 57710
+const ISSUER = 57711
+const X509 = 57712
+const SUBJECT = 57713
+const SAN = 57714
+const REQUIRE = 57715
+const SSL = 57716
+const NONE = 57717
+const PASSWORD = 57718
+const MAX_QUERIES_PER_HOUR = 57719
+const MAX_UPDATES_PER_HOUR = 57720
+const MAX_CONNECTIONS_PER_HOUR = 57721
+const MAX_USER_CONNECTIONS = 57722
+const MAX_STORAGE_SIZE = 57723
+const MAX_CONCURRENT_QUERIES = 57724
+const FORMAT = 57725
+const VERBOSE = 57726
+const CONNECTION = 57727
+const TRIGGERS = 57728
+const PROFILES = 57729
+const LOAD = 57730
+const INFILE = 57731
+const TERMINATED = 57732
+const OPTIONALLY = 57733
+const ENCLOSED = 57734
+const ESCAPED = 57735
+const STARTING = 57736
+const LINES = 57737
+const ROWS = 57738
+const IMPORT = 57739
+const MODUMP = 57740
+const OVER = 57741
+const PRECEDING = 57742
+const FOLLOWING = 57743
+const GROUPS = 57744
+const DATABASES = 57745
+const TABLES = 57746
+const EXTENDED = 57747
+const FULL = 57748
+const PROCESSLIST = 57749
+const FIELDS = 57750
+const COLUMNS = 57751
+const OPEN = 57752
+const ERRORS = 57753
+const WARNINGS = 57754
+const INDEXES = 57755
+const SCHEMAS = 57756
+const NODE = 57757
+const LOCKS = 57758
+const TABLE_NUMBER = 57759
+const COLUMN_NUMBER = 57760
+const TABLE_VALUES = 57761
+const NAMES = 57762
+const GLOBAL = 57763
+const SESSION = 57764
+const ISOLATION = 57765
+const LEVEL = 57766
+const READ = 57767
+const WRITE = 57768
+const ONLY = 57769
+const REPEATABLE = 57770
+const COMMITTED = 57771
+const UNCOMMITTED = 57772
+const SERIALIZABLE = 57773
+const LOCAL = 57774
+const EVENTS = 57775
+const PLUGINS = 57776
+const CURRENT_TIMESTAMP = 57777
+const DATABASE = 57778
+const CURRENT_TIME = 57779
+const LOCALTIME = 57780
+const LOCALTIMESTAMP = 57781
+const UTC_DATE = 57782
+const UTC_TIME = 57783
+const UTC_TIMESTAMP = 57784
+const REPLACE = 57785
+const CONVERT = 57786
+const SEPARATOR = 57787
+const TIMESTAMPDIFF = 57788
+const CURRENT_DATE = 57789
+const CURRENT_USER = 57790
+const CURRENT_ROLE = 57791
+const SECOND_MICROSECOND = 57792
+const MINUTE_MICROSECOND = 57793
+const MINUTE_SECOND = 57794
+const HOUR_MICROSECOND = 57795
+const HOUR_SECOND = 57796
+const HOUR_MINUTE = 57797
+const DAY_MICROSECOND = 57798
+const DAY_SECOND = 57799
+const DAY_MINUTE = 57800
+const DAY_HOUR = 57801
+const YEAR_MONTH = 57802
+const SQL_TSI_HOUR = 57803
+const SQL_TSI_DAY = 57804
+const SQL_TSI_WEEK = 57805
+const SQL_TSI_MONTH = 57806
+const SQL_TSI_QUARTER = 57807
+const SQL_TSI_YEAR = 57808
+const SQL_TSI_SECOND = 57809
+const SQL_TSI_MINUTE = 57810
+const RECURSIVE = 57811
+const CONFIG = 57812
+const DRAINER = 57813
+const MATCH = 57814
+const AGAINST = 57815
+const BOOLEAN = 57816
+const LANGUAGE = 57817
+const WITH = 57818
+const QUERY = 57819
+const EXPANSION = 57820
+const ADDDATE = 57821
+const BIT_AND = 57822
+const BIT_OR = 57823
+const BIT_XOR = 57824
+const CAST = 57825
+const COUNT = 57826
+const APPROX_COUNT_DISTINCT = 57827
+const APPROX_PERCENTILE = 57828
+const CURDATE = 57829
+const CURTIME = 57830
+const DATE_ADD = 57831
+const DATE_SUB = 57832
+const EXTRACT = 57833
+const GROUP_CONCAT = 57834
+const MAX = 57835
+const MID = 57836
+const MIN = 57837
+const NOW = 57838
+const POSITION = 57839
+const SESSION_USER = 57840
+const STD = 57841
+const STDDEV = 57842
+const MEDIAN = 57843
+const STDDEV_POP = 57844
+const STDDEV_SAMP = 57845
+const SUBDATE = 57846
+const SUBSTR = 57847
+const SUBSTRING = 57848
+const SUM = 57849
+const SYSDATE = 57850
+const SYSTEM_USER = 57851
+const TRANSLATE = 57852
+const TRIM = 57853
+const VARIANCE = 57854
+const VAR_POP = 57855
+const VAR_SAMP = 57856
+const AVG = 57857
+const ARROW = 57858
+const ROW = 57859
+const OUTFILE = 57860
+const HEADER = 57861
+const MAX_FILE_SIZE = 57862
+const FORCE_QUOTE = 57863
+const PARALLEL = 57864
+const UNUSED = 57865
+const BINDINGS = 57866
+const DO = 57867
+const DECLARE = 57868
+const KILL = 57869
+const QUERY_RESULT = 57870
 
 var yyToknames = [...]string{
 	"$end",
 	"error",
 	"$unk",
 	"LEX_ERROR",
+	"OPTIMIZER_HINT",
 	"EMPTY",
 	"UNION",
 	"EXCEPT",
@@ -583,6 +598,9 @@ var yyToknames = [...]string{
 	"VALUE",
 	"SHARE",
 	"MODE",
+	"NOWAIT",
+	"SKIP",
+	"LOCKED",
 	"SQL_NO_CACHE",
 	"SQL_CACHE",
 	"JOIN",
@@ -682,6 +700,8 @@ var yyToknames = [...]string{
 	"RELEASE",
 	"PRIORITY",
 	"QUICK",
+	"SAVEPOINT",
+	"OF",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -744,6 +764,7 @@ var yyToknames = [...]string{
 	"ANALYZE",
 	"ADD",
 	"RETURNS",
+	"MODIFY",
 	"SCHEMA",
 	"TABLE",
 	"INDEX",
@@ -830,6 +851,11 @@ var yyToknames = [...]string{
 	"PUBLICATION",
 	"SUBSCRIPTIONS",
 	"PUBLICATIONS",
+	"CHANGEFEED",
+	"CHANGEFEEDS",
+	"AUDIT",
+	"POLICY",
+	"POLICIES",
 	"PROPERTIES",
 	"PARSER",
 	"VISIBLE",
@@ -918,6 +944,8 @@ var yyToknames = [...]string{
 	"MAX_UPDATES_PER_HOUR",
 	"MAX_CONNECTIONS_PER_HOUR",
 	"MAX_USER_CONNECTIONS",
+	"MAX_STORAGE_SIZE",
+	"MAX_CONCURRENT_QUERIES",
 	"FORMAT",
 	"VERBOSE",
 	"CONNECTION",
@@ -1076,3527 +1104,4146 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:8730
+//line mysql_sql.y:9223
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 99,
-	21, 569,
-	-2, 550,
-	-1, 107,
-	214, 771,
-	-2, 820,
-	-1, 127,
-	42, 397,
-	214, 397,
-	241, 404,
-	242, 404,
-	416, 397,
-	-2, 429,
-	-1, 446,
-	290, 93,
-	392, 93,
-	-2, 1383,
-	-1, 504,
-	67, 1189,
-	-2, 1523,
-	-1, 505,
-	67, 1207,
-	-2, 1494,
-	-1, 509,
-	67, 1208,
-	-2, 1522,
-	-1, 531,
-	67, 1121,
-	-2, 1578,
+	-1, 106,
+	22, 614,
+	-2, 588,
+	-1, 114,
+	221, 852,
+	-2, 901,
+	-1, 137,
+	43, 426,
+	221, 426,
+	248, 433,
+	249, 433,
+	430, 426,
+	-2, 460,
+	-1, 143,
+	223, 217,
+	-2, 222,
+	-1, 469,
+	297, 93,
+	406, 93,
+	-2, 1467,
+	-1, 527,
+	71, 1273,
+	-2, 1613,
+	-1, 528,
+	71, 1291,
+	-2, 1584,
 	-1, 532,
-	67, 1122,
-	-2, 1577,
-	-1, 533,
-	67, 1123,
-	-2, 1567,
-	-1, 534,
-	67, 1542,
-	-2, 1562,
-	-1, 535,
-	67, 1543,
-	-2, 1563,
-	-1, 536,
-	67, 1544,
-	-2, 1569,
-	-1, 537,
-	67, 1545,
-	-2, 1552,
-	-1, 538,
-	67, 1546,
-	-2, 1560,
-	-1, 539,
-	67, 1547,
-	-2, 1570,
-	-1, 540,
-	67, 1548,
-	-2, 1571,
-	-1, 541,
-	67, 1549,
-	-2, 1576,
-	-1, 542,
-	67, 1550,
-	-2, 1581,
-	-1, 543,
-	67, 1551,
-	-2, 1582,
-	-1, 545,
-	67, 1186,
-	-2, 1375,
-	-1, 552,
-	67, 1195,
-	-2, 1401,
+	71, 1292,
+	-2, 1612,
+	-1, 554,
+	71, 1205,
+	-2, 1673,
+	-1, 555,
+	71, 1206,
+	-2, 1672,
 	-1, 556,
-	67, 1199,
-	-2, 1440,
+	71, 1207,
+	-2, 1662,
 	-1, 557,
-	67, 1200,
-	-2, 1518,
+	71, 1637,
+	-2, 1657,
+	-1, 558,
+	71, 1638,
+	-2, 1658,
+	-1, 559,
+	71, 1639,
+	-2, 1664,
+	-1, 560,
+	71, 1640,
+	-2, 1647,
+	-1, 561,
+	71, 1641,
+	-2, 1655,
+	-1, 562,
+	71, 1642,
+	-2, 1665,
+	-1, 563,
+	71, 1643,
+	-2, 1666,
+	-1, 564,
+	71, 1644,
+	-2, 1671,
 	-1, 565,
-	67, 1210,
-	-2, 1503,
-	-1, 567,
-	67, 1212,
-	-2, 1513,
+	71, 1645,
+	-2, 1676,
+	-1, 566,
+	71, 1646,
+	-2, 1677,
 	-1, 568,
-	67, 1213,
-	-2, 1536,
+	71, 1270,
+	-2, 1459,
+	-1, 575,
+	71, 1279,
+	-2, 1485,
 	-1, 579,
-	67, 1103,
-	-2, 1572,
+	71, 1283,
+	-2, 1528,
 	-1, 580,
-	67, 1104,
-	-2, 1573,
-	-1, 581,
-	67, 1105,
-	-2, 1574,
+	71, 1284,
+	-2, 1608,
 	-1, 588,
-	21, 570,
-	-2, 533,
-	-1, 644,
-	411, 429,
-	412, 429,
-	-2, 398,
-	-1, 693,
-	104, 1375,
-	115, 1375,
-	135, 1375,
-	-2, 1350,
-	-1, 731,
-	21, 570,
-	-2, 533,
-	-1, 830,
-	21, 569,
-	-2, 1011,
-	-1, 1164,
-	67, 1257,
-	-2, 1520,
-	-1, 1165,
-	67, 1258,
-	-2, 1521,
-	-1, 1371,
-	1, 308,
-	68, 308,
-	532, 308,
-	-2, 806,
-	-1, 1609,
-	68, 1336,
-	136, 1336,
+	71, 1294,
+	-2, 1593,
+	-1, 590,
+	71, 1296,
+	-2, 1603,
+	-1, 591,
+	71, 1297,
+	-2, 1626,
+	-1, 602,
+	71, 1186,
+	-2, 1667,
+	-1, 603,
+	71, 1187,
+	-2, 1668,
+	-1, 604,
+	71, 1188,
+	-2, 1669,
+	-1, 611,
+	22, 615,
+	-2, 567,
+	-1, 670,
+	425, 460,
+	426, 460,
+	-2, 427,
+	-1, 708,
+	223, 218,
+	-2, 223,
+	-1, 725,
+	108, 1459,
+	119, 1459,
+	139, 1459,
+	-2, 1434,
+	-1, 759,
+	22, 615,
+	-2, 567,
+	-1, 859,
+	22, 614,
+	-2, 1093,
+	-1, 1206,
+	71, 1341,
+	-2, 1610,
+	-1, 1207,
+	71, 1342,
+	-2, 1611,
+	-1, 1416,
+	1, 317,
+	72, 317,
+	546, 317,
+	-2, 887,
+	-1, 1664,
+	72, 1420,
+	140, 1420,
+	-2, 1595,
+	-1, 1665,
+	72, 1420,
+	140, 1420,
+	-2, 1594,
+	-1, 1666,
+	72, 1398,
+	140, 1398,
+	-2, 1581,
+	-1, 1667,
+	72, 1399,
+	140, 1399,
+	-2, 1586,
+	-1, 1668,
+	72, 1400,
+	140, 1400,
+	-2, 1513,
+	-1, 1669,
+	72, 1401,
+	140, 1401,
+	-2, 1506,
+	-1, 1670,
+	72, 1402,
+	140, 1402,
+	-2, 1450,
+	-1, 1671,
+	72, 1403,
+	140, 1403,
+	-2, 1583,
+	-1, 1672,
+	72, 1404,
+	140, 1404,
+	-2, 1511,
+	-1, 1673,
+	72, 1405,
+	140, 1405,
 	-2, 1505,
-	-1, 1610,
-	68, 1336,
-	136, 1336,
-	-2, 1504,
-	-1, 1611,
-	68, 1314,
-	136, 1314,
-	-2, 1491,
-	-1, 1612,
-	68, 1315,
-	136, 1315,
-	-2, 1496,
-	-1, 1613,
-	68, 1316,
-	136, 1316,
-	-2, 1428,
-	-1, 1614,
-	68, 1317,
-	136, 1317,
-	-2, 1422,
-	-1, 1615,
-	68, 1318,
-	136, 1318,
-	-2, 1366,
-	-1, 1616,
-	68, 1319,
-	136, 1319,
-	-2, 1493,
-	-1, 1617,
-	68, 1320,
-	136, 1320,
-	-2, 1426,
-	-1, 1618,
-	68, 1321,
-	136, 1321,
-	-2, 1421,
-	-1, 1619,
-	68, 1322,
-	136, 1322,
-	-2, 1414,
-	-1, 1621,
-	68, 1325,
-	136, 1325,
-	-2, 1536,
-	-1, 1622,
-	68, 1305,
-	136, 1305,
-	-2, 1523,
-	-1, 1623,
-	68, 1334,
-	136, 1334,
+	-1, 1674,
+	72, 1406,
+	140, 1406,
+	-2, 1498,
+	-1, 1676,
+	72, 1409,
+	140, 1409,
+	-2, 1626,
+	-1, 1677,
+	72, 1389,
+	140, 1389,
+	-2, 1613,
+	-1, 1678,
+	72, 1418,
+	140, 1418,
+	-2, 1584,
+	-1, 1679,
+	72, 1418,
+	140, 1418,
+	-2, 1612,
+	-1, 1680,
+	72, 1418,
+	140, 1418,
+	-2, 1468,
+	-1, 1681,
+	72, 1416,
+	140, 1416,
+	-2, 1603,
+	-1, 1682,
+	72, 1413,
+	140, 1413,
+	-2, 1490,
+	-1, 1683,
+	71, 1371,
+	72, 1371,
+	140, 1371,
+	366, 1371,
+	367, 1371,
+	368, 1371,
+	-2, 1449,
+	-1, 1684,
+	71, 1372,
+	72, 1372,
+	140, 1372,
+	366, 1372,
+	367, 1372,
+	368, 1372,
+	-2, 1451,
+	-1, 1685,
+	71, 1375,
+	72, 1375,
+	140, 1375,
+	366, 1375,
+	367, 1375,
+	368, 1375,
+	-2, 1585,
+	-1, 1686,
+	71, 1377,
+	72, 1377,
+	140, 1377,
+	366, 1377,
+	367, 1377,
+	368, 1377,
+	-2, 1568,
+	-1, 1687,
+	71, 1379,
+	72, 1379,
+	140, 1379,
+	366, 1379,
+	367, 1379,
+	368, 1379,
+	-2, 1512,
+	-1, 1688,
+	71, 1381,
+	72, 1381,
+	140, 1381,
+	366, 1381,
+	367, 1381,
+	368, 1381,
 	-2, 1494,
-	-1, 1624,
-	68, 1334,
-	136, 1334,
-	-2, 1522,
-	-1, 1625,
-	68, 1334,
-	136, 1334,
-	-2, 1384,
-	-1, 1626,
-	68, 1332,
-	136, 1332,
-	-2, 1513,
-	-1, 1627,
-	68, 1329,
-	136, 1329,
-	-2, 1406,
-	-1, 1628,
-	67, 1287,
-	68, 1287,
-	136, 1287,
-	354, 1287,
-	355, 1287,
-	356, 1287,
-	-2, 1365,
-	-1, 1629,
-	67, 1288,
-	68, 1288,
-	136, 1288,
-	354, 1288,
-	355, 1288,
-	356, 1288,
-	-2, 1367,
-	-1, 1630,
-	67, 1291,
-	68, 1291,
-	136, 1291,
-	354, 1291,
-	355, 1291,
-	356, 1291,
+	-1, 1689,
+	71, 1382,
+	72, 1382,
+	140, 1382,
+	366, 1382,
+	367, 1382,
+	368, 1382,
 	-2, 1495,
-	-1, 1631,
-	67, 1293,
-	68, 1293,
-	136, 1293,
-	354, 1293,
-	355, 1293,
-	356, 1293,
-	-2, 1478,
-	-1, 1632,
-	67, 1295,
-	68, 1295,
-	136, 1295,
-	354, 1295,
-	355, 1295,
-	356, 1295,
-	-2, 1427,
-	-1, 1633,
-	67, 1297,
-	68, 1297,
-	136, 1297,
-	354, 1297,
-	355, 1297,
-	356, 1297,
-	-2, 1410,
-	-1, 1634,
-	67, 1298,
-	68, 1298,
-	136, 1298,
-	354, 1298,
-	355, 1298,
-	356, 1298,
-	-2, 1411,
-	-1, 1635,
-	67, 1300,
-	68, 1300,
-	136, 1300,
-	354, 1300,
-	355, 1300,
-	356, 1300,
-	-2, 1364,
-	-1, 1636,
-	68, 1339,
-	136, 1339,
-	354, 1339,
-	355, 1339,
-	356, 1339,
-	-2, 1389,
-	-1, 1637,
-	68, 1339,
-	136, 1339,
-	354, 1339,
-	355, 1339,
-	356, 1339,
-	-2, 1402,
-	-1, 1638,
-	68, 1342,
-	136, 1342,
-	354, 1342,
-	355, 1342,
-	356, 1342,
-	-2, 1385,
-	-1, 1639,
-	68, 1339,
-	136, 1339,
-	354, 1339,
-	355, 1339,
-	356, 1339,
-	-2, 1463,
-	-1, 1652,
-	1, 799,
-	68, 799,
-	532, 799,
-	-2, 806,
-	-1, 1758,
-	21, 569,
-	-2, 661,
-	-1, 1925,
-	1, 800,
-	68, 800,
-	532, 800,
-	-2, 806,
-	-1, 1934,
-	65, 477,
-	136, 477,
-	-2, 915,
-	-1, 1951,
-	275, 979,
-	-2, 958,
-	-1, 2194,
-	275, 979,
-	-2, 959,
-	-1, 2321,
-	88, 806,
-	131, 806,
-	168, 806,
-	171, 806,
-	-2, 863,
-	-1, 2324,
-	88, 806,
-	131, 806,
-	168, 806,
-	171, 806,
-	-2, 863,
-	-1, 2327,
-	65, 477,
-	136, 477,
-	-2, 916,
-	-1, 2415,
-	88, 806,
-	131, 806,
-	168, 806,
-	171, 806,
-	-2, 864,
-	-1, 2687,
-	68, 835,
-	136, 835,
-	-2, 806,
-	-1, 2691,
-	68, 835,
-	136, 835,
-	-2, 806,
-	-1, 2705,
-	68, 839,
-	136, 839,
-	-2, 806,
-	-1, 2710,
-	68, 840,
-	136, 840,
-	-2, 806,
+	-1, 1690,
+	71, 1384,
+	72, 1384,
+	140, 1384,
+	366, 1384,
+	367, 1384,
+	368, 1384,
+	-2, 1448,
+	-1, 1691,
+	72, 1423,
+	140, 1423,
+	366, 1423,
+	367, 1423,
+	368, 1423,
+	-2, 1473,
+	-1, 1692,
+	72, 1423,
+	140, 1423,
+	366, 1423,
+	367, 1423,
+	368, 1423,
+	-2, 1486,
+	-1, 1693,
+	72, 1426,
+	140, 1426,
+	366, 1426,
+	367, 1426,
+	368, 1426,
+	-2, 1469,
+	-1, 1694,
+	72, 1423,
+	140, 1423,
+	366, 1423,
+	367, 1423,
+	368, 1423,
+	-2, 1552,
+	-1, 1710,
+	1, 880,
+	72, 880,
+	546, 880,
+	-2, 887,
+	-1, 1828,
+	22, 614,
+	-2, 708,
+	-1, 2011,
+	1, 881,
+	72, 881,
+	546, 881,
+	-2, 887,
+	-1, 2020,
+	69, 511,
+	140, 511,
+	-2, 996,
+	-1, 2043,
+	282, 1061,
+	-2, 1039,
+	-1, 2309,
+	282, 1061,
+	-2, 1040,
+	-1, 2455,
+	92, 887,
+	135, 887,
+	174, 887,
+	177, 887,
+	-2, 944,
+	-1, 2458,
+	92, 887,
+	135, 887,
+	174, 887,
+	177, 887,
+	-2, 944,
+	-1, 2461,
+	69, 511,
+	140, 511,
+	-2, 997,
+	-1, 2573,
+	92, 887,
+	135, 887,
+	174, 887,
+	177, 887,
+	-2, 945,
+	-1, 2581,
+	72, 916,
+	140, 916,
+	-2, 887,
+	-1, 2660,
+	72, 916,
+	140, 916,
+	-2, 887,
+	-1, 2782,
+	72, 920,
+	140, 920,
+	-2, 887,
+	-1, 2824,
+	72, 921,
+	140, 921,
+	-2, 887,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 31392
+const yyLast = 37504
 
 var yyAct = [...]int{
-	475, 1372, 2691, 1230, 2699, 2690, 2670, 2581, 1145, 2628,
-	455, 2409, 2620, 457, 477, 2598, 2387, 2517, 2206, 2535,
-	1599, 2536, 2524, 2508, 2440, 2273, 2392, 2528, 2408, 2459,
-	2407, 2274, 589, 857, 2482, 997, 2390, 1292, 2450, 1334,
-	148, 148, 501, 1148, 2428, 1336, 148, 392, 399, 2414,
-	1141, 399, 2176, 1937, 2337, 1434, 2304, 1789, 2016, 2015,
-	2017, 2002, 2216, 2195, 1686, 1050, 1404, 2012, 1752, 2009,
-	459, 2271, 1503, 1825, 1412, 1607, 1472, 2265, 1691, 2038,
-	2248, 2146, 2151, 404, 1451, 2215, 2148, 1659, 1605, 725,
-	1473, 1375, 2058, 410, 959, 448, 2174, 584, 692, 449,
-	1824, 1302, 1866, 1926, 1427, 1480, 454, 2052, 698, 974,
-	625, 2097, 1481, 1407, 147, 147, 1499, 1904, 1741, 1908,
-	383, 1955, 1498, 1753, 1658, 1687, 1346, 1322, 1310, 702,
-	43, 1229, 3, 396, 19, 584, 894, 1347, 701, 30,
-	1288, 393, 8, 1293, 723, 1531, 1139, 148, 1500, 1792,
-	1078, 1603, 1283, 394, 6, 1059, 397, 31, 98, 458,
-	1645, 395, 7, 1431, 987, 1344, 1510, 1345, 1194, 447,
-	696, 1178, 1587, 1130, 466, 388, 43, 742, 939, 1476,
-	1479, 1405, 456, 1457, 1138, 684, 1760, 1321, 2415, 1360,
-	385, 624, 1144, 983, 1200, 1029, 586, 1199, 412, 1042,
-	16, 9, 4, 1077, 998, 685, 398, 413, 588, 957,
-	137, 976, 622, 2091, 1827, 2091, 140, 1517, 640, 1507,
-	143, 727, 142, 2455, 2451, 1790, 650, 2272, 1306, 852,
-	2564, 1475, 587, 858, 762, 141, 141, 39, 129, 108,
-	597, 1812, 2400, 1504, 141, 2399, 39, 129, 108, 141,
-	2572, 39, 129, 108, 381, 134, 408, 402, 141, 141,
-	1820, 2492, 122, 1031, 141, 2120, 135, 141, 1515, 141,
-	1649, 97, 43, 1776, 796, 722, 19, 141, 1097, 1090,
-	777, 30, 778, 1131, 8, 1135, 82, 141, 1445, 39,
-	129, 108, 138, 138, 1094, 1087, 6, 699, 1777, 31,
-	2073, 138, 583, 2066, 7, 97, 138, 1115, 1906, 1134,
-	780, 2616, 144, 97, 1032, 1096, 1089, 707, 706, 708,
-	1793, 138, 660, 665, 138, 664, 138, 770, 1012, 772,
-	1013, 789, 598, 994, 138, 574, 1220, 573, 575, 576,
-	409, 577, 578, 2468, 138, 1415, 1416, 705, 1003, 1004,
-	1001, 1083, 450, 1000, 1003, 1004, 1356, 773, 1147, 794,
-	1905, 695, 130, 131, 2614, 132, 133, 2539, 2540, 694,
-	1867, 799, 800, 801, 798, 2565, 2566, 2602, 2603, 775,
-	2275, 2457, 1136, 2059, 2510, 148, 735, 2460, 2461, 2462,
-	2463, 2060, 2510, 2061, 2513, 710, 2453, 734, 2571, 712,
-	2275, 399, 399, 1133, 148, 669, 1807, 736, 1150, 590,
-	1015, 745, 2523, 1428, 730, 732, 703, 2284, 1420, 1126,
-	2305, 1511, 666, 2312, 2405, 2213, 766, 1235, 1732, 1584,
-	107, 128, 139, 1911, 80, 2162, 1899, 711, 776, 107,
-	1424, 139, 1705, 1644, 2474, 2152, 2160, 2086, 2084, 768,
-	791, 127, 121, 120, 1277, 1276, 765, 1817, 45, 733,
-	127, 771, 774, 2006, 832, 2467, 2477, 729, 792, 793,
-	1734, 2469, 2402, 2574, 2575, 704, 745, 2167, 753, 443,
-	1737, 668, 445, 2618, 1216, 767, 2609, 444, 1213, 2173,
-	401, 731, 1215, 1212, 1214, 1218, 1219, 1516, 2157, 2158,
-	1217, 757, 400, 2538, 2357, 992, 779, 2529, 2613, 1132,
-	1149, 1024, 2684, 2159, 1443, 1444, 123, 124, 125, 43,
-	43, 2156, 787, 788, 2700, 1156, 1159, 1160, 782, 2638,
-	783, 1520, 1522, 1523, 982, 2583, 1157, 2579, 2580, 136,
-	2583, 2645, 2499, 709, 2350, 2442, 2649, 697, 1715, 667,
-	699, 1714, 2230, 769, 1014, 2363, 2364, 92, 785, 1917,
-	1038, 126, 1037, 93, 755, 738, 739, 2429, 2430, 2431,
-	2433, 2432, 1920, 1921, 1922, 1923, 1017, 1505, 2623, 996,
-	995, 2701, 981, 980, 1505, 2341, 1505, 2671, 747, 746,
-	2483, 960, 2296, 2695, 2707, 2345, 726, 1532, 408, 2507,
-	956, 958, 1030, 1519, 2089, 1813, 1767, 750, 751, 969,
-	762, 1508, 2288, 754, 2090, 2154, 94, 965, 936, 699,
-	968, 2040, 2042, 1695, 625, 967, 38, 781, 403, 2142,
-	619, 620, 621, 1223, 1224, 1225, 1226, 1227, 1228, 1221,
-	1222, 971, 838, 2044, 834, 835, 836, 837, 1987, 2099,
-	2098, 1764, 2573, 747, 746, 1003, 1004, 1035, 888, 1766,
-	1765, 1033, 1034, 786, 740, 1418, 148, 1002, 1026, 1419,
-	1763, 40, 1417, 671, 672, 999, 1692, 1695, 1518, 591,
-	2650, 1506, 587, 2567, 2568, 40, 784, 584, 584, 584,
-	761, 1128, 1054, 1054, 2624, 148, 2619, 961, 962, 963,
-	964, 2401, 966, 2180, 109, 109, 1910, 993, 1003, 1004,
-	407, 399, 958, 109, 1081, 1081, 2694, 2713, 109, 1821,
-	1084, 1429, 1935, 40, 1791, 2163, 2441, 109, 109, 1092,
-	2153, 2475, 1061, 109, 2087, 797, 109, 2712, 109, 1750,
-	1022, 2245, 1052, 1052, 868, 869, 109, 1696, 756, 1113,
-	2406, 1158, 1844, 95, 96, 100, 109, 1521, 1056, 1914,
-	1915, 2706, 1054, 760, 1054, 735, 1098, 1421, 1127, 1060,
-	984, 988, 988, 1913, 1936, 1699, 1146, 2041, 934, 931,
-	932, 933, 2703, 2685, 1849, 797, 1848, 1847, 1845, 1423,
-	984, 697, 984, 2680, 941, 1560, 797, 2155, 1559, 989,
-	2674, 1696, 2346, 2347, 802, 797, 1689, 943, 2343, 588,
-	1690, 1693, 2342, 831, 2241, 762, 2673, 2654, 2621, 2622,
-	1337, 840, 1779, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
-	1173, 1174, 1175, 1176, 1177, 1025, 973, 1108, 1109, 1189,
-	1190, 1337, 845, 1936, 1198, 1751, 1016, 990, 1018, 1846,
-	2704, 1513, 661, 1244, 1006, 1007, 2630, 1009, 1010, 1011,
-	1005, 2681, 1694, 1008, 1250, 1251, 797, 675, 1513, 591,
-	43, 1988, 1990, 1991, 1992, 1989, 1253, 1258, 1259, 43,
-	1143, 2592, 1088, 1121, 1513, 1513, 1095, 1036, 584, 1048,
-	1049, 1118, 1045, 1046, 1047, 2171, 1795, 2322, 1593, 1698,
-	2668, 2546, 1460, 1117, 1702, 1700, 1122, 1647, 1812, 1701,
-	1099, 1161, 1124, 381, 1062, 674, 1104, 1074, 1082, 677,
-	676, 1075, 1129, 2541, 2631, 661, 1112, 2501, 1751, 1542,
-	1901, 1278, 663, 2500, 1111, 662, 1299, 1100, 2497, 588,
-	1751, 1800, 715, 720, 721, 799, 800, 801, 798, 2593,
-	1120, 1119, 1116, 1779, 1140, 799, 800, 801, 798, 1137,
-	148, 2245, 1320, 1054, 1324, 1243, 1326, 1327, 1709, 2479,
-	1142, 2496, 1300, 625, 1850, 1851, 1335, 2495, 1504, 1231,
-	1054, 1234, 1598, 985, 1026, 1245, 937, 759, 2494, 392,
-	2118, 2479, 2478, 2365, 1303, 2502, 1252, 1564, 1254, 1180,
-	1541, 1663, 2232, 1646, 1495, 663, 2479, 2035, 662, 1187,
-	1188, 1890, 1888, 1361, 1361, 1441, 1026, 1026, 2172, 1026,
-	1886, 1319, 148, 1458, 1320, 1320, 1884, 1872, 1054, 1402,
-	1414, 1828, 1810, 1804, 1317, 1359, 1350, 1802, 1797, 2479,
-	584, 972, 1054, 762, 1192, 2479, 1233, 1662, 1281, 1594,
-	1284, 1285, 1357, 1358, 1290, 1291, 2479, 1568, 1325, 760,
-	2479, 1779, 1328, 1329, 1330, 1255, 1080, 1080, 1320, 1054,
-	2233, 1450, 148, 148, 1454, 1751, 1295, 1456, 1298, 1891,
-	1889, 1462, 986, 1398, 1399, 148, 1039, 2311, 1885, 2632,
-	1244, 1244, 1483, 1273, 1885, 797, 1367, 1244, 1244, 797,
-	1663, 1798, 1490, 728, 673, 1803, 1798, 1567, 1558, 1363,
-	717, 718, 719, 1512, 1440, 1663, 1349, 1593, 984, 2330,
-	1307, 1105, 2181, 1762, 617, 797, 1335, 1447, 1354, 1351,
-	1054, 1502, 1425, 2054, 1301, 1938, 1815, 1814, 1806, 1679,
-	988, 1555, 1543, 490, 99, 1494, 1452, 1452, 1465, 1316,
-	1151, 1152, 1153, 1154, 1155, 1323, 1101, 1453, 1430, 1452,
-	1338, 1339, 935, 1449, 1332, 1496, 1331, 1355, 843, 1468,
-	748, 1484, 1340, 728, 1342, 797, 797, 814, 2185, 1348,
-	1597, 1513, 1365, 1364, 1366, 1525, 2081, 382, 977, 1106,
-	99, 728, 978, 1343, 1196, 1197, 1529, 1530, 1237, 1236,
-	1232, 2663, 1478, 1362, 1238, 2651, 1043, 1352, 1353, 1478,
-	1706, 1371, 1438, 1439, 2246, 1401, 1403, 1044, 2237, 2234,
-	1323, 2092, 2007, 1801, 1769, 1426, 815, 816, 817, 818,
-	819, 820, 821, 814, 985, 43, 737, 1835, 678, 1195,
-	1446, 1538, 1195, 801, 798, 1435, 1436, 1437, 699, 799,
-	800, 801, 798, 1448, 670, 699, 1318, 1041, 2606, 798,
-	1565, 1140, 799, 800, 801, 798, 1466, 1572, 817, 818,
-	819, 820, 821, 814, 2353, 2352, 1485, 1488, 1487, 1489,
-	2062, 1492, 700, 1965, 1493, 1964, 99, 1959, 1954, 2334,
-	1264, 1304, 2648, 2010, 1596, 1308, 2403, 2689, 1311, 1256,
-	1257, 2677, 1497, 1260, 1261, 1262, 1263, 1265, 1266, 1267,
-	1268, 1269, 1270, 1271, 1272, 448, 735, 1640, 805, 806,
-	807, 808, 809, 810, 811, 803, 2309, 1608, 2639, 148,
-	148, 148, 1660, 986, 1533, 2404, 2647, 1524, 1040, 1998,
-	699, 2634, 1667, 1026, 1186, 2554, 2422, 443, 1670, 1996,
-	445, 1994, 1672, 1984, 1526, 444, 2147, 1180, 1248, 1183,
-	1185, 1182, 1537, 1184, 1026, 2310, 1527, 1528, 2308, 1249,
-	735, 2161, 2137, 1704, 2136, 1021, 2077, 1023, 1997, 1027,
-	1028, 1685, 799, 800, 801, 798, 2056, 1982, 1995, 1669,
-	1993, 1837, 1983, 1551, 1981, 1304, 1980, 1977, 1673, 1674,
-	1971, 1304, 1304, 1654, 1655, 1656, 1968, 1967, 1592, 1859,
-	1755, 1755, 1414, 1755, 1067, 1068, 1069, 1070, 1071, 1072,
-	1073, 1591, 1682, 1076, 1590, 1586, 1671, 1641, 812, 822,
-	823, 815, 816, 817, 818, 819, 820, 821, 814, 478,
-	487, 1054, 148, 1585, 1102, 479, 1550, 486, 480, 484,
-	483, 481, 482, 954, 2608, 2388, 735, 2604, 2569, 1081,
-	1589, 1414, 1600, 1601, 1784, 2505, 1786, 1608, 2111, 799,
-	800, 801, 798, 2476, 1708, 2452, 2413, 1681, 1759, 1757,
-	2386, 1761, 1676, 1677, 1602, 799, 800, 801, 798, 2384,
-	1648, 1774, 2369, 2367, 2003, 1808, 2336, 988, 1502, 488,
-	2307, 2306, 2303, 1781, 2293, 1054, 2287, 1054, 1668, 1054,
-	1826, 2240, 1788, 2110, 735, 2238, 1060, 799, 800, 801,
-	798, 2228, 2227, 2141, 1678, 1822, 2135, 1783, 2533, 485,
-	2088, 1680, 2057, 99, 99, 700, 799, 800, 801, 798,
-	2047, 1985, 1978, 1054, 1853, 1535, 1974, 1973, 1539, 1972,
-	1595, 799, 800, 801, 798, 1588, 1860, 1818, 530, 529,
-	2702, 1054, 1735, 1469, 1467, 1313, 1675, 1103, 867, 863,
-	699, 862, 844, 1862, 724, 813, 812, 822, 823, 815,
-	816, 817, 818, 819, 820, 821, 814, 141, 1549, 2516,
-	129, 108, 1553, 1052, 1562, 2487, 2324, 1775, 1864, 1770,
-	1771, 1772, 2323, 2321, 830, 2298, 2297, 1780, 1852, 1782,
-	1566, 1052, 2527, 1569, 1570, 1571, 1839, 2292, 1574, 1575,
-	1576, 1577, 1578, 1579, 1580, 1581, 1861, 1582, 2279, 2264,
-	2263, 1819, 2186, 2116, 2109, 799, 800, 801, 798, 2101,
-	2096, 1833, 2394, 2051, 138, 141, 1811, 1054, 1900, 1892,
-	1918, 1816, 1887, 1883, 1320, 1809, 1882, 1573, 1934, 1563,
-	1561, 1557, 2393, 1556, 1940, 799, 800, 801, 798, 2362,
-	1554, 1868, 1548, 1829, 1830, 1545, 1873, 1544, 1843, 1247,
-	1949, 1246, 1066, 2290, 1902, 799, 800, 801, 798, 1953,
-	1664, 1064, 799, 800, 801, 798, 2662, 1140, 2656, 1961,
-	1962, 1963, 138, 2646, 2643, 1966, 799, 800, 801, 798,
-	2641, 2553, 2503, 1928, 859, 1832, 1280, 1943, 1540, 1755,
-	2438, 1945, 2688, 2114, 2426, 2423, 2377, 1896, 2375, 1999,
-	2360, 2359, 1932, 2113, 944, 2358, 2355, 2349, 1320, 735,
-	1414, 1414, 1414, 1414, 1927, 1893, 799, 800, 801, 798,
-	2018, 735, 1414, 2316, 2122, 1755, 799, 800, 801, 798,
-	1941, 1951, 2018, 1285, 1289, 1290, 1291, 1282, 1054, 1956,
-	975, 1956, 2000, 1960, 1916, 799, 800, 801, 798, 148,
-	148, 1295, 1933, 1298, 1931, 1930, 1957, 1929, 1304, 1304,
-	1304, 43, 1294, 1297, 1939, 19, 1286, 1796, 860, 1244,
-	30, 1244, 1768, 8, 2072, 1948, 1730, 2076, 1661, 2031,
-	1944, 1080, 1952, 1054, 1958, 6, 2083, 1707, 31, 1710,
-	1711, 1712, 1713, 7, 1181, 1716, 1717, 1718, 1719, 1720,
-	1721, 1722, 1723, 1724, 1725, 1726, 1727, 1728, 1729, 1323,
-	138, 1063, 1979, 1455, 1315, 1287, 382, 1125, 1091, 2004,
-	938, 886, 1303, 2049, 2050, 2008, 885, 2071, 884, 883,
-	882, 881, 2033, 880, 879, 2032, 878, 588, 2030, 99,
-	877, 876, 875, 99, 2048, 874, 2069, 873, 2034, 872,
-	1942, 871, 2075, 870, 99, 2104, 2063, 2106, 1946, 1947,
-	2045, 1836, 2085, 99, 2043, 866, 2055, 2356, 2065, 1854,
-	1855, 865, 735, 864, 1857, 1858, 2070, 2080, 2150, 861,
-	856, 2068, 855, 1608, 853, 852, 851, 1863, 2165, 613,
-	148, 2019, 2020, 2021, 2022, 850, 849, 848, 2093, 2079,
-	735, 735, 735, 847, 846, 842, 1414, 1660, 2094, 2184,
-	841, 1685, 1685, 1685, 764, 2188, 1304, 2249, 2250, 1894,
-	1895, 1311, 1666, 2100, 2067, 2217, 2219, 1651, 2217, 2217,
-	752, 2074, 2107, 2108, 2588, 2224, 2586, 2537, 2121, 2252,
-	1054, 1054, 2123, 2124, 2125, 2126, 1919, 2127, 2128, 2129,
-	2130, 2131, 2132, 2133, 2134, 2112, 2138, 1778, 2187, 2105,
-	2143, 1471, 2189, 2190, 2168, 763, 2255, 2102, 2103, 2027,
-	2025, 148, 2182, 2254, 2028, 2026, 2150, 1881, 799, 800,
-	801, 798, 2024, 2023, 1320, 1320, 2214, 1927, 2218, 1805,
-	1052, 1052, 2179, 1880, 2183, 1799, 2170, 2177, 2178, 2169,
-	799, 800, 801, 798, 1898, 2225, 2226, 2029, 1397, 1747,
-	1748, 2144, 615, 2220, 2221, 601, 799, 800, 801, 798,
-	1546, 81, 612, 611, 2222, 1853, 1274, 2380, 1794, 2379,
-	2145, 1823, 2244, 822, 823, 815, 816, 817, 818, 819,
-	820, 821, 814, 605, 1879, 1452, 940, 2256, 2139, 2140,
-	1878, 2242, 2243, 1600, 1601, 2231, 2235, 1907, 42, 145,
-	148, 2239, 2236, 2378, 41, 378, 1085, 799, 800, 801,
-	798, 1642, 2253, 799, 800, 801, 798, 2192, 758, 2522,
-	1950, 1877, 1903, 1333, 610, 2270, 1314, 2257, 609, 2260,
-	2261, 2262, 1876, 790, 599, 604, 799, 800, 801, 798,
-	377, 942, 379, 2269, 799, 800, 801, 798, 380, 1237,
-	1236, 2595, 602, 2280, 1733, 799, 800, 801, 798, 1304,
-	2281, 1969, 1970, 2283, 1304, 952, 953, 1975, 1976, 1400,
-	2191, 1875, 1020, 600, 2285, 2286, 950, 951, 1320, 1874,
-	948, 949, 2282, 1413, 2320, 2005, 1019, 616, 946, 947,
-	1755, 1414, 2327, 2259, 799, 800, 801, 798, 1491, 979,
-	2095, 591, 799, 800, 801, 798, 1871, 2657, 2577, 2560,
-	2558, 603, 1337, 1054, 2530, 2515, 1870, 2514, 2512, 2335,
-	2299, 2504, 2115, 2449, 148, 2302, 2448, 2397, 2301, 799,
-	800, 801, 798, 2219, 2385, 2277, 2276, 2267, 2328, 799,
-	800, 801, 798, 700, 2331, 2315, 2314, 2332, 2329, 1869,
-	700, 945, 1320, 2266, 2078, 2053, 735, 2326, 2325, 99,
-	1865, 2590, 2589, 2338, 1653, 1547, 749, 2018, 2333, 2214,
-	2589, 2590, 799, 800, 801, 798, 2351, 2278, 991, 614,
-	50, 2382, 1442, 799, 800, 801, 798, 2371, 1058, 735,
-	1, 1312, 596, 2036, 1856, 2361, 2037, 2258, 2354, 2039,
-	2018, 1509, 1731, 2368, 1643, 2366, 2164, 970, 618, 1239,
-	1110, 2370, 2373, 714, 744, 1107, 2372, 799, 800, 801,
-	798, 743, 741, 2223, 1193, 492, 1474, 735, 1054, 1054,
-	2001, 2445, 825, 735, 829, 830, 2594, 2396, 2627, 2383,
-	2552, 2597, 1123, 476, 1685, 2506, 2456, 2389, 2556, 826,
-	828, 824, 2458, 827, 813, 812, 822, 823, 815, 816,
-	817, 818, 819, 820, 821, 814, 735, 2398, 2391, 735,
-	735, 735, 1514, 795, 2064, 636, 524, 2418, 1052, 2338,
-	499, 2420, 2421, 2412, 2419, 2416, 1335, 854, 2446, 1093,
-	1834, 2329, 1086, 2411, 2119, 1191, 2427, 716, 498, 2435,
-	2436, 2437, 2313, 1912, 2424, 2395, 608, 713, 637, 1583,
-	2473, 2470, 2434, 799, 800, 801, 798, 2443, 799, 800,
-	801, 798, 2454, 1275, 1296, 2444, 1279, 1704, 2698, 592,
-	593, 594, 595, 2687, 2669, 2655, 2582, 735, 592, 593,
-	594, 595, 591, 2683, 2612, 2644, 1738, 2471, 2466, 735,
-	2464, 591, 1743, 1746, 1747, 1748, 1744, 2480, 1745, 1749,
-	2198, 2465, 2637, 2486, 2485, 2484, 2578, 2493, 2489, 1743,
-	1746, 1747, 1748, 1744, 2289, 1745, 1749, 414, 1422, 2498,
-	582, 2291, 682, 2439, 2208, 1470, 415, 1665, 2570, 2425,
-	735, 1065, 606, 1650, 607, 1925, 1924, 2201, 2511, 2509,
-	1162, 804, 1179, 2294, 2196, 2295, 839, 453, 1536, 2211,
-	2212, 2526, 465, 2547, 2550, 2197, 1909, 2207, 2525, 2046,
-	49, 48, 47, 2531, 46, 1461, 152, 2542, 2543, 2544,
-	2545, 2551, 2532, 494, 151, 2549, 2599, 474, 473, 2559,
-	472, 2561, 2562, 2557, 2555, 471, 2563, 1742, 1740, 1739,
-	1409, 2202, 1408, 1459, 1697, 1368, 2534, 2490, 2576, 2491,
-	2348, 1986, 2344, 2340, 2601, 1758, 2587, 2585, 2584, 2229,
-	2193, 2194, 2200, 893, 889, 2591, 891, 892, 2600, 890,
-	1842, 1838, 1683, 1684, 2175, 955, 2472, 2605, 735, 2300,
-	1606, 1604, 2251, 2247, 2166, 1482, 1309, 1897, 1410, 2610,
-	1406, 1736, 1652, 909, 2626, 73, 72, 79, 119, 1304,
-	37, 2417, 2374, 2625, 1413, 2376, 2615, 2617, 585, 2629,
-	2635, 32, 735, 2317, 2318, 2319, 27, 5, 29, 2381,
-	2636, 28, 14, 1146, 2633, 2640, 15, 2642, 13, 2210,
-	1114, 1688, 2601, 2653, 12, 18, 26, 25, 24, 91,
-	90, 23, 735, 89, 735, 88, 2600, 2652, 87, 909,
-	2659, 86, 2661, 1146, 22, 1146, 2204, 2664, 11, 85,
-	2665, 84, 83, 735, 2629, 2672, 21, 78, 76, 2679,
-	20, 2676, 2682, 77, 1146, 1220, 74, 75, 2203, 2205,
-	60, 59, 58, 70, 69, 68, 67, 66, 2686, 2693,
-	65, 635, 57, 2697, 56, 897, 55, 2696, 54, 71,
-	64, 2705, 63, 62, 61, 53, 2708, 2693, 2710, 2709,
-	52, 2711, 2697, 917, 921, 923, 925, 927, 928, 930,
-	51, 934, 931, 932, 933, 106, 105, 912, 913, 914,
-	915, 895, 896, 918, 104, 898, 103, 899, 900, 901,
-	902, 903, 904, 905, 906, 907, 908, 910, 916, 102,
-	2213, 897, 101, 33, 34, 887, 920, 922, 924, 926,
-	929, 35, 2199, 2481, 36, 116, 115, 117, 2209, 917,
-	921, 923, 925, 927, 928, 930, 2488, 934, 931, 932,
-	933, 118, 113, 912, 913, 914, 915, 895, 896, 918,
-	111, 898, 911, 899, 900, 901, 902, 903, 904, 905,
-	906, 907, 908, 910, 916, 99, 2678, 114, 112, 110,
-	44, 10, 920, 922, 924, 926, 929, 17, 2, 2521,
-	0, 0, 0, 1216, 0, 0, 0, 1213, 0, 0,
-	0, 1215, 1212, 1214, 1218, 1219, 0, 0, 0, 1217,
-	0, 0, 0, 0, 0, 0, 0, 2660, 911, 0,
-	0, 0, 0, 0, 0, 813, 812, 822, 823, 815,
-	816, 817, 818, 819, 820, 821, 814, 0, 0, 0,
-	2658, 2521, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1413, 1413, 1413, 1413, 0, 0, 0,
-	0, 0, 0, 0, 0, 1413, 813, 812, 822, 823,
-	815, 816, 817, 818, 819, 820, 821, 814, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1840, 1841, 813,
-	812, 822, 823, 815, 816, 817, 818, 819, 820, 821,
-	814, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 99, 0, 0, 0, 0, 0, 0, 99, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2521,
-	0, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209,
-	1210, 1211, 1223, 1224, 1225, 1226, 1227, 1228, 1221, 1222,
-	0, 0, 319, 506, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 281, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2667, 0, 467, 0, 0, 0,
-	226, 0, 0, 251, 2607, 0, 0, 497, 0, 0,
-	311, 265, 0, 0, 0, 0, 553, 561, 0, 0,
-	0, 2117, 0, 0, 0, 0, 0, 0, 460, 0,
-	919, 491, 530, 529, 478, 487, 0, 99, 207, 150,
-	479, 0, 486, 480, 484, 483, 481, 482, 0, 545,
-	0, 0, 0, 0, 0, 0, 451, 464, 2518, 468,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1413,
-	813, 812, 822, 823, 815, 816, 817, 818, 819, 820,
-	821, 814, 461, 462, 99, 0, 919, 0, 507, 0,
-	463, 0, 0, 502, 488, 489, 0, 0, 198, 316,
-	332, 208, 307, 345, 213, 314, 203, 280, 303, 0,
-	0, 200, 330, 313, 262, 245, 246, 199, 0, 298,
-	224, 237, 220, 278, 485, 505, 509, 219, 567, 503,
-	340, 202, 0, 339, 277, 326, 331, 263, 257, 201,
-	328, 261, 256, 249, 228, 568, 241, 289, 255, 290,
-	242, 267, 266, 268, 0, 0, 0, 0, 0, 368,
-	813, 812, 822, 823, 815, 816, 817, 818, 819, 820,
-	821, 814, 0, 500, 0, 0, 342, 0, 0, 551,
-	0, 0, 0, 315, 0, 0, 250, 0, 0, 0,
-	504, 0, 301, 283, 564, 452, 0, 299, 253, 327,
-	291, 333, 317, 341, 295, 292, 193, 318, 222, 264,
-	204, 206, 218, 225, 227, 229, 230, 273, 274, 286,
-	306, 320, 321, 322, 221, 214, 300, 215, 239, 216,
-	194, 308, 217, 196, 287, 325, 0, 235, 296, 260,
-	197, 259, 288, 324, 323, 205, 349, 355, 356, 360,
-	0, 361, 0, 0, 0, 369, 374, 375, 376, 0,
-	0, 0, 0, 0, 363, 0, 0, 0, 0, 0,
-	0, 354, 233, 190, 191, 337, 549, 279, 0, 0,
-	563, 544, 546, 547, 550, 554, 555, 556, 557, 558,
-	560, 562, 566, 304, 0, 0, 0, 0, 0, 244,
-	285, 0, 305, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1413, 312, 335, 347, 364, 367,
-	0, 0, 0, 195, 366, 0, 2519, 0, 0, 1831,
-	2520, 0, 565, 0, 0, 0, 346, 0, 0, 0,
-	0, 0, 508, 269, 270, 271, 272, 552, 0, 212,
-	365, 294, 813, 812, 822, 823, 815, 816, 817, 818,
-	819, 820, 821, 814, 0, 0, 0, 0, 359, 232,
-	238, 373, 240, 211, 284, 234, 344, 247, 0, 370,
-	0, 0, 0, 0, 276, 243, 309, 248, 254, 297,
-	343, 282, 302, 209, 334, 310, 258, 1534, 0, 574,
-	548, 573, 575, 576, 572, 577, 578, 559, 470, 0,
-	512, 570, 569, 571, 0, 0, 0, 0, 0, 0,
-	813, 812, 822, 823, 815, 816, 817, 818, 819, 820,
-	821, 814, 0, 0, 0, 0, 0, 192, 0, 252,
-	0, 293, 231, 537, 517, 518, 519, 469, 520, 515,
-	516, 538, 510, 534, 535, 493, 513, 521, 533, 522,
-	536, 539, 540, 579, 580, 528, 581, 525, 541, 532,
-	531, 523, 511, 542, 543, 496, 495, 526, 527, 514,
-	319, 506, 0, 350, 351, 352, 372, 336, 0, 223,
-	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 467, 0, 0, 0, 226, 0,
-	0, 251, 0, 0, 0, 497, 0, 0, 311, 265,
-	0, 0, 0, 0, 553, 561, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 460, 0, 0, 491,
-	530, 529, 478, 487, 0, 0, 207, 150, 479, 0,
-	486, 480, 484, 483, 481, 482, 0, 545, 0, 0,
-	0, 0, 0, 0, 451, 464, 0, 468, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	461, 462, 0, 0, 0, 0, 507, 0, 463, 0,
-	0, 502, 488, 489, 0, 0, 198, 316, 332, 208,
-	307, 345, 213, 314, 203, 280, 303, 0, 0, 200,
-	330, 313, 262, 245, 246, 199, 0, 298, 224, 237,
-	220, 278, 485, 505, 509, 219, 567, 503, 340, 202,
-	0, 339, 277, 326, 331, 263, 257, 201, 328, 261,
-	256, 249, 228, 568, 241, 289, 255, 290, 242, 267,
-	266, 268, 0, 0, 0, 0, 0, 368, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 500, 0, 0, 342, 0, 0, 551, 0, 0,
-	0, 315, 0, 0, 250, 0, 0, 0, 504, 0,
-	301, 283, 564, 452, 0, 299, 253, 327, 291, 333,
-	317, 341, 295, 292, 193, 318, 222, 264, 204, 206,
-	218, 225, 227, 229, 230, 273, 274, 286, 306, 320,
-	321, 322, 221, 214, 300, 215, 239, 216, 194, 308,
-	217, 196, 287, 325, 0, 235, 296, 260, 197, 259,
-	288, 324, 323, 205, 349, 355, 356, 360, 0, 361,
-	0, 0, 0, 369, 374, 375, 376, 0, 0, 0,
-	0, 0, 363, 0, 0, 0, 1241, 1240, 1242, 354,
-	233, 190, 191, 337, 549, 279, 0, 0, 563, 544,
-	546, 547, 550, 554, 555, 556, 557, 558, 560, 562,
-	566, 304, 0, 0, 0, 0, 0, 244, 285, 0,
-	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 312, 335, 347, 364, 367, 0, 0,
-	0, 195, 366, 0, 0, 0, 0, 0, 0, 0,
-	565, 0, 0, 0, 346, 0, 0, 0, 0, 0,
-	508, 269, 270, 271, 272, 552, 0, 212, 365, 294,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 359, 232, 238, 373,
-	240, 211, 284, 234, 344, 247, 0, 370, 0, 0,
-	0, 0, 276, 243, 309, 248, 254, 297, 343, 282,
-	302, 209, 334, 310, 258, 0, 0, 574, 548, 573,
-	575, 576, 572, 577, 578, 559, 470, 0, 512, 570,
-	569, 571, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 0, 252, 0, 293,
-	231, 537, 517, 518, 519, 469, 520, 515, 516, 538,
-	510, 534, 535, 493, 513, 521, 533, 522, 536, 539,
-	540, 579, 580, 528, 581, 525, 541, 532, 531, 523,
-	511, 542, 543, 496, 495, 526, 527, 514, 319, 506,
-	0, 350, 351, 352, 372, 336, 0, 223, 0, 281,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 467, 0, 0, 0, 226, 0, 0, 251,
-	0, 0, 0, 497, 0, 0, 311, 265, 0, 0,
-	0, 0, 553, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 460, 0, 0, 491, 530, 529,
-	478, 487, 0, 0, 207, 150, 479, 0, 486, 480,
-	484, 483, 481, 482, 0, 545, 0, 0, 0, 0,
-	0, 0, 451, 464, 0, 468, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 461, 462,
-	0, 0, 0, 0, 507, 0, 463, 0, 0, 502,
-	488, 489, 0, 0, 198, 316, 332, 208, 307, 345,
-	213, 314, 203, 280, 303, 0, 0, 200, 330, 313,
-	262, 245, 246, 199, 0, 298, 224, 237, 220, 278,
-	485, 505, 509, 219, 567, 503, 340, 202, 0, 339,
-	277, 326, 331, 263, 257, 201, 328, 261, 256, 249,
-	228, 568, 241, 289, 255, 290, 242, 267, 266, 268,
-	0, 0, 0, 0, 0, 368, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
-	0, 0, 342, 0, 0, 551, 0, 0, 0, 315,
-	0, 0, 250, 0, 0, 0, 504, 0, 301, 283,
-	564, 452, 0, 299, 253, 327, 291, 333, 317, 341,
-	295, 292, 193, 318, 222, 264, 204, 206, 218, 225,
-	227, 229, 230, 273, 274, 286, 306, 320, 321, 322,
-	221, 214, 300, 215, 239, 216, 194, 308, 217, 196,
-	287, 325, 0, 235, 296, 260, 197, 259, 288, 324,
-	323, 205, 349, 355, 356, 360, 0, 361, 0, 0,
-	0, 369, 374, 375, 376, 0, 0, 0, 0, 0,
-	363, 0, 0, 0, 0, 0, 0, 354, 233, 190,
-	191, 337, 549, 279, 0, 0, 563, 544, 546, 547,
-	550, 554, 555, 556, 557, 558, 560, 562, 566, 304,
-	0, 0, 0, 0, 0, 244, 285, 0, 305, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 312, 335, 347, 364, 367, 0, 0, 0, 195,
-	366, 0, 2519, 0, 0, 0, 2520, 0, 565, 0,
-	0, 0, 346, 0, 0, 0, 0, 0, 508, 269,
-	270, 271, 272, 552, 0, 212, 365, 294, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 359, 232, 238, 373, 240, 211,
-	284, 234, 344, 247, 0, 370, 0, 0, 0, 0,
-	276, 243, 309, 248, 254, 297, 343, 282, 302, 209,
-	334, 310, 258, 0, 0, 574, 548, 573, 575, 576,
-	572, 577, 578, 559, 470, 0, 512, 570, 569, 571,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 252, 0, 293, 231, 537,
-	517, 518, 519, 469, 520, 515, 516, 538, 510, 534,
-	535, 493, 513, 521, 533, 522, 536, 539, 540, 579,
-	580, 528, 581, 525, 541, 532, 531, 523, 511, 542,
-	543, 496, 495, 526, 527, 514, 319, 506, 0, 350,
-	351, 352, 372, 336, 0, 223, 0, 281, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	467, 0, 0, 0, 226, 1305, 0, 251, 0, 0,
-	0, 497, 0, 0, 311, 265, 0, 0, 0, 0,
-	553, 561, 0, 0, 0, 0, 0, 0, 0, 1432,
-	0, 0, 460, 0, 0, 491, 530, 529, 478, 487,
-	0, 0, 207, 150, 479, 0, 486, 480, 484, 483,
-	481, 482, 0, 545, 0, 0, 0, 0, 0, 0,
-	451, 464, 0, 468, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 461, 462, 0, 0,
-	0, 0, 507, 0, 463, 0, 0, 1433, 488, 489,
-	0, 0, 198, 316, 332, 208, 307, 345, 213, 314,
-	203, 280, 303, 0, 0, 200, 330, 313, 262, 245,
-	246, 199, 0, 298, 224, 237, 220, 278, 485, 505,
-	509, 219, 567, 503, 340, 202, 0, 339, 277, 326,
-	331, 263, 257, 201, 328, 261, 256, 249, 228, 568,
-	241, 289, 255, 290, 242, 267, 266, 268, 0, 0,
-	0, 0, 0, 368, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 500, 0, 0,
-	342, 0, 0, 551, 0, 0, 0, 315, 0, 0,
-	250, 0, 0, 0, 504, 0, 301, 283, 564, 452,
-	0, 299, 253, 327, 291, 333, 317, 341, 295, 292,
-	193, 318, 222, 264, 204, 206, 218, 225, 227, 229,
-	230, 273, 274, 286, 306, 320, 321, 322, 221, 214,
-	300, 215, 239, 216, 194, 308, 217, 196, 287, 325,
-	0, 235, 296, 260, 197, 259, 288, 324, 323, 205,
-	349, 355, 356, 360, 0, 361, 0, 0, 0, 369,
-	374, 375, 376, 0, 0, 0, 0, 0, 363, 0,
-	0, 0, 0, 0, 0, 354, 233, 190, 191, 337,
-	549, 279, 0, 0, 563, 544, 546, 547, 550, 554,
-	555, 556, 557, 558, 560, 562, 566, 304, 0, 0,
-	0, 0, 0, 244, 285, 0, 305, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
-	335, 347, 364, 367, 0, 0, 0, 195, 366, 0,
-	0, 0, 0, 0, 0, 0, 565, 0, 0, 0,
-	346, 0, 0, 0, 0, 0, 508, 269, 270, 271,
-	272, 552, 0, 212, 365, 294, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 359, 232, 238, 373, 240, 211, 284, 234,
-	344, 247, 0, 370, 0, 0, 0, 0, 276, 243,
-	309, 248, 254, 297, 343, 282, 302, 209, 334, 310,
-	258, 0, 0, 574, 548, 573, 575, 576, 572, 577,
-	578, 559, 470, 0, 512, 570, 569, 571, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 0, 252, 0, 293, 231, 537, 517, 518,
-	519, 469, 520, 515, 516, 538, 510, 534, 535, 493,
-	513, 521, 533, 522, 536, 539, 540, 579, 580, 528,
-	581, 525, 541, 532, 531, 523, 511, 542, 543, 496,
-	495, 526, 527, 514, 141, 319, 506, 350, 351, 352,
-	372, 336, 0, 223, 0, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 467,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	833, 0, 0, 311, 265, 0, 0, 0, 0, 553,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 460, 0, 0, 491, 530, 529, 478, 487, 0,
-	0, 207, 150, 479, 0, 486, 480, 484, 483, 481,
-	482, 0, 545, 0, 0, 0, 0, 0, 0, 451,
-	464, 0, 468, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 461, 462, 0, 0, 0,
-	0, 507, 0, 463, 0, 0, 502, 488, 489, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 485, 505, 509,
-	219, 567, 503, 340, 202, 0, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 568, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 500, 0, 0, 342,
-	0, 0, 551, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 504, 0, 301, 283, 564, 452, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 549,
-	279, 0, 0, 563, 544, 546, 547, 550, 554, 555,
-	556, 557, 558, 560, 562, 566, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 565, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 508, 269, 270, 271, 272,
-	552, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 276, 243, 309,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 258,
-	0, 0, 574, 548, 573, 575, 576, 572, 577, 578,
-	559, 470, 0, 512, 570, 569, 571, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 109, 293, 231, 537, 517, 518, 519,
-	469, 520, 515, 516, 538, 510, 534, 535, 493, 513,
-	521, 533, 522, 536, 539, 540, 579, 580, 528, 581,
-	525, 541, 532, 531, 523, 511, 542, 543, 496, 495,
-	526, 527, 514, 319, 506, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 467, 0, 0,
-	0, 226, 2666, 0, 251, 0, 0, 0, 497, 0,
-	0, 311, 265, 0, 0, 0, 0, 553, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 460,
-	0, 0, 491, 530, 529, 478, 487, 0, 0, 207,
-	150, 479, 0, 486, 480, 484, 483, 481, 482, 0,
-	545, 0, 0, 0, 0, 0, 0, 451, 464, 0,
-	468, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 461, 462, 0, 0, 0, 0, 507,
-	0, 463, 0, 0, 502, 488, 489, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 485, 505, 509, 219, 567,
-	503, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 568, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 500, 0, 0, 342, 0, 0,
-	551, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 504, 0, 301, 283, 564, 452, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 549, 279, 0,
-	0, 563, 544, 546, 547, 550, 554, 555, 556, 557,
-	558, 560, 562, 566, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 565, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 508, 269, 270, 271, 272, 552, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	574, 548, 573, 575, 576, 572, 577, 578, 559, 470,
-	0, 512, 570, 569, 571, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 537, 517, 518, 519, 469, 520,
-	515, 516, 538, 510, 534, 535, 493, 513, 521, 533,
-	522, 536, 539, 540, 579, 580, 528, 581, 525, 541,
-	532, 531, 523, 511, 542, 543, 496, 495, 526, 527,
-	514, 319, 506, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 467, 0, 0, 0, 226,
-	1305, 0, 251, 0, 0, 0, 497, 0, 0, 311,
-	265, 0, 0, 0, 0, 553, 561, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 460, 0, 0,
-	491, 530, 529, 478, 487, 0, 0, 207, 150, 479,
-	0, 486, 480, 484, 483, 481, 482, 0, 545, 0,
-	0, 0, 0, 0, 0, 451, 464, 0, 468, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 461, 462, 0, 0, 0, 0, 507, 0, 463,
-	0, 0, 502, 488, 489, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 485, 505, 509, 219, 567, 503, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 568, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 500, 0, 0, 342, 0, 0, 551, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 504,
-	0, 301, 283, 564, 452, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 549, 279, 0, 0, 563,
-	544, 546, 547, 550, 554, 555, 556, 557, 558, 560,
-	562, 566, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 565, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 508, 269, 270, 271, 272, 552, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 574, 548,
-	573, 575, 576, 572, 577, 578, 559, 470, 0, 512,
-	570, 569, 571, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 537, 517, 518, 519, 469, 520, 515, 516,
-	538, 510, 534, 535, 493, 513, 521, 533, 522, 536,
-	539, 540, 579, 580, 528, 581, 525, 541, 532, 531,
-	523, 511, 542, 543, 496, 495, 526, 527, 514, 319,
-	506, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 467, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 497, 0, 0, 311, 265, 0,
-	0, 0, 0, 553, 561, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 460, 0, 0, 491, 530,
-	529, 478, 487, 0, 0, 207, 150, 479, 0, 486,
-	480, 484, 483, 481, 482, 0, 545, 0, 0, 0,
-	0, 0, 0, 451, 464, 0, 468, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 461,
-	462, 1079, 0, 0, 0, 507, 0, 463, 0, 0,
-	502, 488, 489, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 485, 505, 509, 219, 567, 503, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 568, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	500, 0, 0, 342, 0, 0, 551, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 504, 0, 301,
-	283, 564, 452, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 549, 279, 0, 0, 563, 544, 546,
-	547, 550, 554, 555, 556, 557, 558, 560, 562, 566,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 565,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 508,
-	269, 270, 271, 272, 552, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 574, 548, 573, 575,
-	576, 572, 577, 578, 559, 470, 0, 512, 570, 569,
-	571, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	537, 517, 518, 519, 469, 520, 515, 516, 538, 510,
-	534, 535, 493, 513, 521, 533, 522, 536, 539, 540,
-	579, 580, 528, 581, 525, 541, 532, 531, 523, 511,
-	542, 543, 496, 495, 526, 527, 514, 0, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 319, 506, 0,
-	0, 1552, 0, 0, 0, 0, 0, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 467, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 497, 0, 0, 311, 265, 0, 0, 0,
-	0, 553, 561, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 460, 0, 0, 491, 530, 529, 478,
-	487, 0, 0, 207, 150, 479, 0, 486, 480, 484,
-	483, 481, 482, 0, 545, 0, 0, 0, 0, 0,
-	0, 451, 464, 0, 468, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 461, 462, 0,
-	0, 0, 0, 507, 0, 463, 0, 0, 502, 488,
-	489, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 485,
-	505, 509, 219, 567, 503, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	568, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 500, 0,
-	0, 342, 0, 0, 551, 0, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 504, 0, 301, 283, 564,
-	452, 0, 299, 253, 327, 291, 333, 317, 341, 295,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 549, 279, 0, 0, 563, 544, 546, 547, 550,
-	554, 555, 556, 557, 558, 560, 562, 566, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 0, 565, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 508, 269, 270,
-	271, 272, 552, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 574, 548, 573, 575, 576, 572,
-	577, 578, 559, 470, 0, 512, 570, 569, 571, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 0, 293, 231, 537, 517,
-	518, 519, 469, 520, 515, 516, 538, 510, 534, 535,
-	493, 513, 521, 533, 522, 536, 539, 540, 579, 580,
-	528, 581, 525, 541, 532, 531, 523, 511, 542, 543,
-	496, 495, 526, 527, 514, 319, 506, 0, 350, 351,
-	352, 372, 336, 0, 223, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 467,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	497, 0, 0, 311, 265, 0, 0, 0, 0, 553,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 460, 0, 0, 491, 530, 529, 478, 487, 0,
-	0, 207, 150, 479, 0, 486, 480, 484, 483, 481,
-	482, 0, 545, 0, 0, 0, 0, 0, 0, 451,
-	464, 0, 468, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 461, 462, 0, 0, 0,
-	0, 507, 0, 463, 0, 0, 502, 488, 489, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 485, 505, 509,
-	219, 567, 503, 340, 202, 0, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 568, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 500, 0, 0, 342,
-	0, 0, 551, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 504, 0, 301, 283, 564, 452, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 549,
-	279, 0, 0, 563, 544, 546, 547, 550, 554, 555,
-	556, 557, 558, 560, 562, 566, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 565, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 508, 269, 270, 271, 272,
-	552, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 276, 243, 309,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 258,
-	0, 0, 574, 548, 573, 575, 576, 572, 577, 578,
-	559, 470, 0, 512, 570, 569, 571, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 0, 293, 231, 537, 517, 518, 519,
-	469, 520, 515, 516, 538, 510, 534, 535, 493, 513,
-	521, 533, 522, 536, 539, 540, 579, 580, 528, 581,
-	525, 541, 532, 531, 523, 511, 542, 543, 496, 495,
-	526, 527, 514, 319, 506, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 1163, 0, 0, 0, 467, 0, 0,
-	0, 226, 0, 0, 251, 0, 0, 0, 497, 0,
-	0, 311, 265, 0, 0, 0, 0, 553, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 460,
-	0, 0, 491, 530, 529, 478, 487, 0, 0, 207,
-	150, 479, 0, 486, 480, 484, 483, 481, 482, 0,
-	545, 0, 0, 0, 0, 0, 0, 0, 464, 0,
-	468, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 461, 462, 0, 0, 0, 0, 507,
-	0, 463, 0, 0, 502, 488, 489, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 485, 505, 509, 219, 567,
-	503, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 568, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 500, 0, 0, 342, 0, 0,
-	551, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 504, 0, 301, 283, 564, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 1164, 1165,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 549, 279, 0,
-	0, 563, 544, 546, 547, 550, 554, 555, 556, 557,
-	558, 560, 562, 566, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 565, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 508, 269, 270, 271, 272, 552, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	574, 548, 573, 575, 576, 572, 577, 578, 559, 470,
-	0, 512, 570, 569, 571, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 537, 517, 518, 519, 469, 520,
-	515, 516, 538, 510, 534, 535, 493, 513, 521, 533,
-	522, 536, 539, 540, 579, 580, 528, 581, 525, 541,
-	532, 531, 523, 511, 542, 543, 496, 495, 526, 527,
-	514, 319, 506, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 467, 0, 0, 0, 226,
-	0, 0, 251, 0, 0, 0, 497, 0, 0, 311,
-	265, 0, 0, 0, 0, 553, 561, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	491, 530, 529, 478, 487, 0, 0, 207, 150, 479,
-	0, 486, 480, 484, 483, 481, 482, 0, 545, 0,
-	0, 0, 0, 0, 0, 451, 464, 0, 468, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 461, 462, 0, 0, 0, 0, 507, 0, 463,
-	0, 0, 502, 488, 489, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 485, 505, 509, 219, 567, 503, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 568, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 500, 0, 0, 342, 0, 0, 551, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 504,
-	0, 301, 283, 564, 452, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 549, 279, 0, 0, 563,
-	544, 546, 547, 550, 554, 555, 556, 557, 558, 560,
-	562, 566, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 565, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 508, 269, 270, 271, 272, 552, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 574, 548,
-	573, 575, 576, 572, 577, 578, 559, 470, 0, 512,
-	570, 569, 571, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 537, 517, 518, 519, 469, 520, 515, 516,
-	538, 510, 534, 535, 493, 513, 521, 533, 522, 536,
-	539, 540, 579, 580, 528, 581, 525, 541, 532, 531,
-	523, 511, 542, 543, 496, 495, 526, 527, 514, 319,
-	506, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 467, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 497, 0, 0, 311, 265, 0,
-	0, 0, 0, 553, 561, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 460, 0, 0, 491, 530,
-	529, 478, 487, 0, 0, 207, 150, 479, 0, 486,
-	480, 484, 483, 481, 482, 0, 545, 0, 0, 0,
-	0, 0, 0, 0, 464, 0, 468, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 461,
-	462, 0, 0, 0, 0, 507, 0, 463, 0, 0,
-	502, 488, 489, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 485, 505, 509, 219, 567, 503, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 568, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	500, 0, 0, 342, 0, 0, 551, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 504, 0, 301,
-	283, 564, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 549, 279, 0, 0, 563, 544, 546,
-	547, 550, 554, 555, 556, 557, 558, 560, 562, 566,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 565,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 508,
-	269, 270, 271, 272, 552, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 574, 548, 573, 575,
-	576, 572, 577, 578, 559, 470, 0, 512, 570, 569,
-	571, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	537, 517, 518, 519, 469, 520, 515, 516, 538, 510,
-	534, 535, 493, 513, 521, 533, 522, 536, 539, 540,
-	579, 580, 528, 581, 525, 541, 532, 531, 523, 511,
-	542, 543, 496, 495, 526, 527, 514, 0, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 141, 319, 39,
-	129, 108, 0, 0, 0, 0, 0, 0, 0, 281,
+	498, 2777, 480, 478, 1187, 2305, 500, 2835, 2801, 1419,
+	2698, 2827, 473, 2660, 1748, 2726, 2720, 2533, 2322, 2727,
+	2601, 1654, 2740, 2708, 2689, 2659, 2538, 2395, 2566, 1272,
+	2712, 1029, 2682, 2623, 2132, 2396, 1180, 2565, 886, 2654,
+	1334, 2536, 612, 160, 160, 2547, 2108, 1379, 2614, 160,
+	415, 422, 1822, 1186, 422, 2589, 2306, 524, 2023, 1190,
+	2572, 2275, 1086, 433, 2288, 1868, 2524, 2113, 2428, 2112,
+	2474, 1555, 1488, 2425, 2098, 2332, 1183, 1749, 2111, 2310,
+	2105, 2393, 1456, 1662, 482, 1906, 1558, 1525, 427, 2388,
+	2383, 2134, 2365, 2256, 2253, 1505, 2331, 1717, 2251, 753,
+	2012, 724, 471, 1754, 607, 1427, 1660, 2286, 2163, 2202,
+	1905, 420, 31, 477, 1554, 1949, 1533, 651, 1344, 1534,
+	1515, 1481, 1553, 1526, 1823, 730, 1811, 1325, 1006, 1457,
+	1271, 1987, 472, 1746, 1459, 1535, 2047, 1991, 1352, 1750,
+	1418, 733, 30, 419, 19, 709, 1392, 607, 3, 1775,
+	1716, 734, 46, 1871, 988, 1181, 751, 1335, 1330, 160,
+	1950, 923, 416, 8, 417, 6, 418, 7, 1381, 481,
+	1858, 1391, 1364, 1586, 479, 1850, 1095, 1658, 1485, 105,
+	1700, 1565, 1389, 411, 470, 771, 1642, 1236, 1220, 489,
+	1390, 1172, 728, 1529, 1532, 1514, 716, 1830, 1363, 2573,
+	1406, 46, 1019, 1063, 968, 408, 650, 609, 1241, 16,
+	435, 1242, 152, 1078, 1015, 421, 9, 1030, 717, 436,
+	149, 648, 986, 4, 2196, 2196, 1908, 1572, 666, 1464,
+	1562, 430, 611, 155, 154, 2619, 2615, 1869, 2394, 678,
+	1008, 887, 1348, 881, 2758, 1528, 610, 791, 1262, 153,
+	620, 2551, 153, 153, 42, 139, 115, 1893, 153, 1559,
+	42, 139, 115, 153, 153, 1901, 31, 404, 153, 425,
+	2768, 153, 159, 159, 2426, 1065, 2545, 2670, 406, 153,
+	2226, 1570, 1704, 153, 1139, 42, 139, 115, 1046, 2178,
+	1047, 1132, 1848, 825, 1467, 1468, 30, 1849, 19, 2171,
+	1136, 431, 432, 750, 104, 1499, 46, 1129, 1026, 150,
+	938, 1989, 150, 150, 1872, 104, 688, 8, 150, 6,
+	1401, 7, 606, 150, 1138, 156, 1066, 1125, 150, 2819,
+	731, 1131, 2817, 1189, 597, 823, 596, 598, 599, 150,
+	600, 601, 621, 150, 1035, 1036, 727, 726, 2621, 1157,
+	2730, 2731, 1173, 1033, 1177, 1936, 1032, 1035, 1036, 2759,
+	2760, 2805, 2806, 818, 1262, 1988, 2164, 2691, 2397, 806,
+	1049, 807, 2691, 2165, 2632, 2166, 2694, 2617, 1176, 2397,
+	1887, 764, 828, 829, 830, 827, 1192, 613, 755, 2624,
+	2625, 2626, 2627, 774, 2549, 2548, 2550, 1482, 2683, 809,
+	2705, 2406, 1258, 1474, 1994, 2429, 1255, 1168, 160, 763,
+	1257, 1254, 1256, 1260, 1261, 926, 1566, 2647, 1259, 2267,
+	2436, 2767, 2556, 762, 422, 422, 1802, 160, 1639, 1699,
+	1319, 1318, 693, 2329, 692, 946, 950, 952, 954, 956,
+	957, 959, 2189, 963, 960, 961, 962, 758, 760, 941,
+	942, 943, 944, 924, 925, 947, 1178, 927, 1982, 928,
+	929, 930, 931, 932, 933, 934, 935, 936, 937, 939,
+	945, 2257, 774, 804, 2642, 820, 2191, 1175, 949, 951,
+	953, 955, 958, 114, 1898, 151, 1024, 831, 794, 739,
+	738, 740, 2261, 2729, 786, 1191, 860, 821, 822, 2102,
+	757, 2770, 2771, 2631, 869, 137, 1804, 2645, 2553, 2633,
+	2560, 2272, 1807, 861, 697, 940, 2812, 1571, 1258, 737,
+	2265, 1048, 1255, 1277, 1045, 874, 1257, 1254, 1256, 1260,
+	1261, 759, 805, 2821, 1259, 1114, 694, 729, 2285, 1497,
+	1498, 1478, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
+	1251, 1252, 1253, 1265, 1266, 1267, 1268, 1269, 1270, 1263,
+	1264, 816, 817, 1058, 46, 46, 2495, 742, 1575, 1577,
+	1578, 744, 2262, 2263, 745, 994, 746, 747, 776, 775,
+	424, 423, 2713, 466, 2888, 1174, 468, 2264, 811, 2259,
+	812, 467, 2845, 735, 731, 696, 766, 767, 2721, 799,
+	1560, 801, 808, 2816, 1198, 1201, 1202, 2852, 2775, 2776,
+	1560, 2779, 1560, 2779, 743, 1199, 1014, 2677, 814, 2006,
+	2007, 2008, 2009, 985, 987, 2603, 2488, 2857, 2830, 802,
+	2347, 2478, 1785, 2410, 2195, 1784, 2722, 761, 2000, 779,
+	780, 768, 769, 2741, 1074, 1921, 1922, 2083, 783, 1051,
+	651, 965, 736, 2482, 2502, 2503, 782, 776, 775, 863,
+	864, 865, 866, 731, 1073, 695, 784, 689, 1013, 1265,
+	1266, 1267, 1268, 1269, 1270, 1263, 1264, 1028, 1027, 1012,
+	867, 1587, 2769, 1035, 1036, 2664, 1035, 1036, 1993, 917,
+	2307, 2655, 810, 1034, 160, 1025, 1060, 1031, 1573, 2413,
+	2784, 43, 1561, 795, 2761, 2762, 610, 43, 754, 1126,
+	2283, 1071, 1768, 1483, 1770, 989, 431, 2268, 2688, 607,
+	607, 607, 741, 2552, 1090, 1090, 797, 160, 815, 2136,
+	2138, 116, 1064, 785, 116, 116, 791, 1902, 800, 803,
+	116, 1997, 1998, 422, 987, 116, 116, 2831, 2445, 691,
+	116, 813, 690, 116, 2822, 1996, 1774, 1134, 2341, 2557,
+	1097, 116, 796, 1475, 2258, 116, 1146, 1169, 1894, 2643,
+	948, 2192, 1839, 1154, 1563, 897, 898, 1070, 1155, 2260,
+	990, 991, 992, 993, 1758, 995, 996, 1092, 998, 997,
+	1140, 1090, 729, 1090, 763, 2590, 2591, 2592, 2594, 2593,
+	1088, 1088, 1576, 2194, 2002, 1016, 1020, 1020, 1188, 2602,
+	2247, 2663, 2039, 1424, 1001, 2038, 2380, 1423, 1000, 790,
+	999, 426, 645, 646, 647, 1574, 972, 1016, 1473, 1016,
+	970, 710, 798, 2204, 2203, 1003, 2284, 2141, 1200, 1193,
+	1194, 1195, 1196, 1197, 1182, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1835, 611, 1069,
+	2480, 1231, 1232, 1021, 2479, 1130, 2483, 2484, 1470, 1137,
+	2783, 1005, 1471, 1240, 2828, 2829, 2084, 2086, 2087, 2088,
+	2085, 1834, 1286, 1238, 1239, 1469, 1059, 700, 2137, 1274,
+	1164, 1837, 1836, 1280, 699, 1050, 1037, 1052, 1295, 1040,
+	701, 1477, 1022, 1615, 1755, 1758, 1614, 1185, 2858, 1038,
+	1039, 1759, 1041, 1042, 1043, 1044, 614, 607, 2443, 1292,
+	1293, 46, 1163, 1056, 1055, 1559, 1057, 2358, 1061, 1062,
+	46, 1072, 1300, 1301, 1702, 1084, 1085, 1081, 1082, 1083,
+	689, 1160, 1098, 1159, 1773, 1067, 1068, 1203, 1771, 2889,
+	2886, 1166, 2880, 1148, 826, 404, 1096, 791, 1110, 1820,
+	1870, 2021, 703, 1320, 1141, 1341, 1111, 1103, 1104, 1105,
+	1106, 1107, 1108, 1109, 826, 2292, 1112, 1113, 1142, 1115,
+	1346, 2879, 1861, 1779, 1350, 611, 703, 1353, 1162, 160,
+	2442, 1362, 1090, 1366, 1367, 1161, 1369, 1342, 1371, 1372,
+	1179, 1285, 1158, 2362, 2876, 651, 2862, 1273, 1380, 1276,
+	708, 1184, 1090, 1287, 705, 704, 1060, 1568, 2468, 2854,
+	1568, 415, 691, 1171, 1294, 690, 1296, 1118, 1123, 1124,
+	1229, 1230, 1759, 1701, 702, 2837, 826, 1752, 705, 704,
+	1345, 1753, 1756, 1222, 706, 1365, 2022, 1407, 1407, 1568,
+	1060, 1060, 1323, 1060, 1326, 1327, 160, 2826, 1362, 1362,
+	1370, 1854, 1090, 1454, 1466, 1385, 1382, 1404, 1821, 1361,
+	1874, 501, 510, 2456, 1568, 2795, 607, 502, 1090, 509,
+	503, 507, 506, 504, 505, 2022, 1275, 826, 1648, 2780,
+	1821, 1346, 1652, 1757, 2737, 1893, 1382, 1346, 1346, 1882,
+	1984, 1332, 1333, 2838, 1362, 1090, 1859, 1504, 160, 160,
+	1508, 1297, 2732, 1510, 1511, 1365, 1513, 1518, 1518, 1337,
+	2679, 1340, 2678, 1017, 1597, 826, 2675, 160, 2674, 1517,
+	1517, 511, 1286, 1286, 1536, 1315, 1450, 1451, 1479, 1286,
+	1286, 1502, 2673, 2796, 1543, 2672, 2650, 791, 1546, 1368,
+	828, 829, 830, 827, 1373, 1374, 1375, 2781, 1182, 1016,
+	2504, 2467, 2651, 508, 828, 829, 830, 827, 1380, 1503,
+	1396, 2349, 1090, 1557, 1343, 1349, 828, 829, 830, 827,
+	2651, 1020, 1501, 1879, 1383, 1384, 1854, 2131, 2680, 1821,
+	1721, 1395, 1484, 1394, 2651, 1596, 2651, 1973, 1971, 1969,
+	1120, 1121, 1122, 1409, 1507, 1399, 1376, 1402, 1403, 1651,
+	2651, 1377, 1740, 2651, 2651, 1551, 1653, 1170, 1359, 2362,
+	1967, 2224, 1521, 1955, 1537, 1018, 1393, 1387, 1854, 2468,
+	614, 1584, 1585, 1411, 1619, 1412, 1909, 1400, 1891, 2350,
+	1410, 1883, 1881, 1550, 1495, 1004, 756, 1590, 1408, 1234,
+	1594, 1531, 1075, 1580, 1388, 1821, 1416, 2839, 1531, 788,
+	1453, 1455, 643, 1492, 1493, 1974, 1972, 1968, 1397, 1398,
+	2464, 1876, 1480, 842, 841, 851, 852, 844, 845, 846,
+	847, 848, 849, 850, 843, 1413, 1405, 1720, 1968, 789,
+	1604, 826, 1617, 1649, 1608, 1489, 1490, 1491, 46, 1623,
+	1622, 46, 1500, 1613, 826, 1567, 1721, 2390, 1494, 1877,
+	1882, 731, 1621, 1519, 1149, 1624, 1625, 1626, 731, 2293,
+	1629, 1630, 1631, 1632, 1633, 1634, 1635, 1636, 1620, 1637,
+	1545, 789, 1538, 1547, 1540, 1627, 1548, 1506, 1506, 1877,
+	1833, 2024, 1896, 1895, 1886, 1541, 1512, 1542, 1866, 2435,
+	966, 1737, 1610, 1598, 843, 1721, 1506, 471, 763, 1695,
+	2871, 1648, 1552, 1549, 1358, 1143, 1017, 826, 826, 1706,
+	2297, 826, 1663, 1568, 160, 160, 160, 1718, 964, 872,
+	777, 2186, 1150, 756, 2148, 1079, 1077, 1725, 1060, 2859,
+	1228, 1279, 1278, 1728, 1579, 1722, 1080, 1730, 1776, 2363,
+	1582, 1583, 698, 731, 1588, 1225, 1227, 1224, 756, 1226,
+	1060, 1009, 2354, 1581, 1222, 1010, 2351, 2197, 2103, 1925,
+	1880, 763, 1765, 1592, 1298, 1299, 1742, 1841, 1302, 1303,
+	1304, 1305, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314,
+	846, 847, 848, 849, 850, 843, 1147, 963, 960, 961,
+	962, 765, 1916, 1930, 1237, 1929, 1928, 1926, 828, 829,
+	830, 827, 1825, 1825, 1466, 1825, 2765, 1918, 1018, 1237,
+	1076, 1593, 1360, 1838, 841, 851, 852, 844, 845, 846,
+	847, 848, 849, 850, 843, 1744, 1306, 2809, 1696, 828,
+	829, 830, 827, 830, 827, 1090, 160, 2724, 828, 829,
+	830, 827, 1346, 1346, 1346, 2491, 827, 2490, 2167, 2058,
+	763, 2057, 2051, 1856, 2046, 1827, 1644, 1831, 1863, 1927,
+	828, 829, 830, 827, 1663, 2471, 2554, 2890, 2856, 1778,
+	851, 852, 844, 845, 846, 847, 848, 849, 850, 843,
+	466, 1657, 1739, 468, 1290, 2883, 1829, 1703, 467, 2106,
+	1889, 1020, 2846, 1557, 2841, 1291, 2748, 1727, 2641, 2433,
+	1090, 2640, 1090, 2639, 1090, 2583, 1731, 1732, 2555, 763,
+	1726, 1846, 1734, 1735, 2217, 2855, 1777, 2432, 1780, 1781,
+	1782, 1783, 2266, 1903, 1786, 1787, 1788, 1789, 1790, 1791,
+	1792, 1793, 1794, 1795, 1796, 1797, 1798, 1799, 1090, 1934,
+	1738, 2434, 2094, 1712, 1713, 1714, 1917, 1736, 2242, 2252,
+	2241, 1943, 2182, 1182, 1937, 1938, 1090, 2161, 2078, 1940,
+	1941, 1945, 2216, 2077, 1892, 2092, 1729, 2076, 2073, 2090,
+	513, 106, 1946, 2080, 1805, 1655, 1656, 2067, 1899, 2064,
+	1733, 2063, 1647, 731, 2093, 828, 829, 830, 827, 1931,
+	1932, 844, 845, 846, 847, 848, 849, 850, 843, 2711,
+	1933, 1346, 1646, 1947, 1977, 1978, 1353, 2091, 1842, 1843,
+	1844, 2089, 1847, 1645, 1088, 2079, 1641, 405, 1944, 1920,
+	106, 2811, 828, 829, 830, 827, 1900, 1855, 1640, 1144,
+	1867, 983, 1088, 828, 829, 830, 827, 1606, 46, 2534,
+	2807, 2794, 1090, 1914, 2764, 2001, 2742, 2686, 160, 2542,
+	2644, 2616, 1362, 2571, 2870, 1897, 2020, 1885, 2558, 2532,
+	1890, 1975, 2026, 2530, 2509, 1096, 2506, 2099, 2485, 2473,
+	1888, 2431, 828, 829, 830, 827, 2430, 2427, 2035, 1951,
+	2418, 2409, 1907, 2357, 1956, 2355, 763, 1985, 2345, 2344,
+	2246, 2040, 2045, 1605, 2240, 1365, 1910, 1911, 1924, 2193,
+	1935, 1536, 1913, 2054, 2055, 2056, 2162, 2144, 2081, 1536,
+	763, 2541, 2061, 160, 2074, 2070, 828, 829, 830, 827,
+	2069, 732, 2068, 553, 552, 106, 1650, 1643, 2027, 1825,
+	2014, 1522, 1979, 1327, 828, 829, 830, 827, 1520, 2095,
+	1355, 896, 892, 2500, 1976, 891, 873, 752, 1362, 763,
+	1466, 1466, 1466, 1466, 2697, 153, 2013, 2665, 139, 115,
+	2458, 763, 1466, 2114, 2457, 1825, 828, 829, 830, 827,
+	2455, 31, 2043, 1825, 2420, 2114, 2419, 2417, 1332, 1333,
+	1090, 834, 835, 836, 837, 838, 839, 840, 832, 1990,
+	1337, 2401, 1340, 2382, 160, 160, 2381, 2048, 160, 2048,
+	1518, 30, 1466, 19, 2298, 2156, 2019, 2158, 2025, 2222,
+	2214, 46, 1517, 2206, 1999, 150, 2864, 2030, 1286, 2042,
+	1286, 2037, 8, 2177, 6, 2201, 7, 2181, 1346, 2044,
+	2127, 1912, 2050, 1346, 1090, 2151, 2053, 2188, 2029, 1983,
+	1970, 1966, 2031, 2060, 2059, 1965, 1628, 1618, 2034, 2049,
+	1616, 1612, 1611, 2075, 842, 841, 851, 852, 844, 845,
+	846, 847, 848, 849, 850, 843, 1609, 1603, 2065, 2066,
+	1600, 2200, 1599, 2100, 2071, 2072, 1289, 2004, 1288, 2115,
+	2116, 2117, 2118, 2104, 2155, 2018, 2126, 153, 1345, 2130,
+	1102, 2129, 2101, 2176, 611, 2221, 2128, 2130, 2185, 1100,
+	2853, 2850, 2142, 2145, 2140, 2848, 2174, 2028, 2747, 2209,
+	2723, 2211, 2180, 2684, 888, 2032, 2033, 1322, 2649, 2599,
+	2154, 2587, 2584, 2517, 2515, 763, 2415, 2190, 2172, 2498,
+	2497, 2255, 2496, 2493, 2170, 2179, 2175, 2173, 2168, 1663,
+	2487, 2270, 2062, 160, 2277, 2450, 160, 150, 2184, 828,
+	829, 830, 827, 1942, 2215, 1331, 763, 763, 763, 2198,
+	1324, 1007, 1466, 1718, 2096, 2296, 2199, 2153, 2052, 2041,
+	2017, 2300, 2205, 2016, 2015, 1336, 2160, 2220, 1339, 763,
+	1765, 2212, 2213, 106, 106, 732, 1328, 2333, 2335, 1875,
+	2333, 2333, 889, 2207, 2208, 1840, 1800, 1719, 2340, 1223,
+	828, 829, 830, 827, 2227, 2339, 1090, 1090, 2228, 2229,
+	2230, 2231, 150, 2232, 2233, 2234, 2235, 2236, 2237, 2238,
+	2239, 2884, 2219, 2149, 2150, 2243, 1509, 2152, 2210, 1357,
+	1329, 2248, 828, 829, 830, 827, 1167, 160, 2250, 1133,
+	967, 915, 2255, 1744, 2294, 828, 829, 830, 827, 914,
+	1362, 1362, 2281, 913, 859, 2282, 912, 2013, 2291, 911,
+	2295, 2330, 910, 2334, 2289, 2290, 909, 908, 2342, 2343,
+	842, 841, 851, 852, 844, 845, 846, 847, 848, 849,
+	850, 843, 1088, 1088, 907, 906, 2336, 2337, 2304, 854,
+	905, 858, 904, 903, 902, 901, 2359, 2360, 900, 2790,
+	2218, 2788, 2868, 2728, 1964, 899, 855, 857, 853, 895,
+	856, 842, 841, 851, 852, 844, 845, 846, 847, 848,
+	849, 850, 843, 828, 829, 830, 827, 828, 829, 830,
+	827, 894, 2348, 893, 890, 160, 2353, 2299, 2356, 2352,
+	885, 2301, 2302, 884, 882, 881, 2338, 880, 879, 1601,
+	2370, 842, 841, 851, 852, 844, 845, 846, 847, 848,
+	849, 850, 843, 878, 877, 2374, 876, 875, 2392, 1963,
+	2414, 871, 2273, 870, 793, 2280, 2369, 2416, 2386, 1962,
+	2377, 2378, 2379, 2563, 973, 1961, 2494, 2404, 2366, 2367,
+	2391, 1724, 828, 829, 830, 827, 1709, 781, 2005, 2402,
+	1857, 2372, 828, 829, 830, 827, 2403, 1853, 828, 829,
+	830, 827, 2405, 1362, 1960, 2303, 1707, 1524, 1959, 792,
+	2123, 2361, 2408, 2371, 2120, 2124, 2454, 1958, 828, 829,
+	830, 827, 1825, 1466, 2461, 2119, 2373, 828, 829, 830,
+	827, 828, 829, 830, 827, 2522, 2384, 2385, 2469, 2121,
+	828, 829, 830, 827, 2122, 2146, 2523, 2472, 1090, 2439,
+	2387, 2125, 1957, 1817, 1818, 2421, 1506, 2582, 2244, 2245,
+	160, 2423, 1884, 1878, 2424, 2520, 86, 2519, 45, 2335,
+	1981, 2440, 2501, 1954, 2459, 828, 829, 830, 827, 1449,
+	1595, 2147, 2438, 1851, 2249, 2463, 44, 1316, 157, 1362,
+	1099, 1873, 1904, 763, 969, 405, 828, 829, 830, 827,
+	1127, 1852, 1953, 1697, 2518, 2460, 1346, 2114, 787, 2514,
+	1655, 1656, 2516, 401, 106, 402, 819, 2330, 106, 2470,
+	2704, 1934, 2036, 2521, 2475, 828, 829, 830, 827, 1986,
+	106, 763, 400, 403, 2511, 1378, 1356, 1279, 1278, 106,
+	828, 829, 830, 827, 2499, 2114, 1952, 2447, 981, 982,
+	2448, 2449, 2508, 1948, 2407, 2505, 2507, 979, 980, 2798,
+	2512, 1803, 2451, 2452, 2453, 1452, 2513, 2510, 2446, 828,
+	829, 830, 827, 2277, 1557, 1054, 828, 829, 830, 827,
+	1589, 763, 1090, 1090, 2539, 977, 978, 763, 2528, 2529,
+	975, 976, 1053, 2462, 2376, 2567, 1939, 2279, 2003, 2465,
+	2535, 1705, 2466, 842, 841, 851, 852, 844, 845, 846,
+	847, 848, 849, 850, 843, 1544, 1011, 2544, 971, 828,
+	829, 830, 827, 2865, 763, 2773, 2754, 763, 763, 763,
+	2752, 2389, 2714, 2696, 2561, 2695, 2693, 2685, 2567, 2564,
+	2610, 2567, 2567, 2567, 2569, 2562, 1380, 2576, 2607, 2577,
+	2463, 2574, 1825, 2570, 2609, 2580, 2540, 2531, 1088, 2475,
+	2526, 2588, 2399, 2543, 2596, 2597, 2598, 2398, 2612, 974,
+	614, 1915, 2525, 2604, 1382, 2183, 2637, 2585, 2792, 2791,
+	2791, 2595, 1233, 1711, 1602, 778, 2613, 2792, 2489, 2492,
+	2400, 1362, 749, 2605, 828, 829, 830, 827, 1023, 1772,
+	2634, 1769, 53, 1472, 2611, 828, 829, 830, 827, 707,
+	1496, 2653, 1094, 1742, 1, 1354, 619, 2133, 763, 2375,
+	2135, 2662, 2638, 2635, 1564, 2444, 2278, 2666, 2646, 2559,
+	763, 2441, 2567, 2274, 2546, 1801, 1808, 2648, 2652, 1698,
+	615, 616, 617, 618, 2567, 2269, 1002, 2657, 2656, 644,
+	1281, 2658, 748, 614, 2667, 2671, 1117, 773, 2578, 2579,
+	1151, 772, 1813, 1816, 1817, 1818, 1814, 2676, 1815, 1819,
+	770, 1235, 515, 2702, 1527, 2097, 2681, 2606, 1090, 2797,
+	2834, 2746, 763, 2800, 1165, 499, 2692, 2690, 615, 616,
+	617, 618, 2707, 2687, 2620, 2750, 2567, 2622, 2703, 2537,
+	2718, 614, 1569, 824, 2710, 1101, 2169, 662, 547, 522,
+	2709, 2738, 883, 1135, 2706, 2744, 2717, 1128, 2715, 2225,
+	2716, 1119, 521, 2437, 1465, 1995, 2733, 2734, 2735, 2736,
+	634, 1182, 1116, 2745, 663, 1638, 2618, 1317, 1338, 1321,
+	2757, 2753, 2702, 2755, 2756, 2751, 2719, 2749, 2581, 2863,
+	2778, 2887, 2782, 2815, 2851, 2630, 2763, 2628, 1813, 1816,
+	1817, 1818, 1814, 2772, 1815, 1819, 2629, 2844, 2774, 437,
+	1476, 605, 714, 2789, 2600, 2786, 2787, 1523, 2804, 438,
+	1723, 2766, 2586, 2785, 2803, 632, 2793, 1708, 633, 2011,
+	2010, 1204, 732, 833, 1221, 2411, 2412, 868, 476, 732,
+	1591, 488, 2808, 1992, 763, 2323, 2143, 106, 52, 51,
+	106, 50, 49, 1862, 164, 517, 163, 2743, 2813, 2802,
+	497, 496, 2662, 495, 494, 1812, 2824, 2833, 2823, 1810,
+	1809, 2836, 2818, 2820, 2832, 1461, 1460, 1860, 1417, 1761,
+	1415, 1414, 2725, 2842, 2825, 763, 2668, 2669, 2486, 2843,
+	2840, 2847, 2702, 2849, 2082, 2481, 2477, 2346, 2308, 1188,
+	2309, 2315, 922, 918, 2718, 920, 921, 919, 1923, 2804,
+	2861, 1919, 1747, 2287, 984, 2803, 2636, 2860, 2422, 763,
+	1661, 763, 1659, 2867, 859, 2869, 2368, 2872, 2364, 2271,
+	1351, 2836, 1980, 1188, 1462, 1188, 2873, 2878, 1458, 1806,
+	2877, 763, 2875, 337, 529, 2882, 1710, 2885, 129, 40,
+	78, 128, 39, 77, 298, 1188, 127, 38, 76, 75,
+	84, 126, 37, 2575, 608, 32, 27, 490, 5, 29,
+	28, 238, 14, 15, 263, 13, 1156, 12, 520, 18,
+	26, 328, 278, 297, 329, 271, 2866, 2810, 25, 24,
+	576, 584, 98, 97, 23, 96, 95, 94, 93, 22,
+	11, 92, 483, 91, 90, 514, 553, 552, 501, 510,
+	89, 88, 219, 162, 502, 21, 509, 503, 507, 506,
+	504, 505, 83, 568, 81, 20, 82, 79, 80, 63,
+	474, 487, 2699, 491, 62, 842, 841, 851, 852, 844,
+	845, 846, 847, 848, 849, 850, 843, 61, 73, 72,
+	71, 70, 69, 68, 661, 60, 484, 485, 59, 58,
+	57, 74, 530, 67, 486, 66, 65, 525, 511, 512,
+	64, 56, 210, 334, 350, 220, 324, 363, 225, 332,
+	215, 296, 320, 55, 54, 113, 112, 212, 348, 331,
+	275, 257, 258, 211, 111, 315, 236, 249, 232, 294,
+	508, 528, 532, 231, 590, 526, 358, 214, 110, 357,
+	293, 344, 349, 276, 269, 213, 346, 274, 268, 261,
+	240, 591, 253, 306, 267, 307, 254, 281, 280, 282,
+	109, 108, 33, 34, 35, 386, 842, 841, 851, 852,
+	844, 845, 846, 847, 848, 849, 850, 843, 36, 279,
+	523, 123, 122, 360, 124, 125, 574, 120, 118, 121,
+	333, 119, 117, 262, 1828, 47, 10, 527, 17, 318,
+	300, 587, 475, 2, 316, 265, 345, 308, 351, 335,
+	359, 312, 309, 205, 336, 234, 277, 216, 218, 230,
+	237, 239, 241, 242, 289, 290, 303, 323, 338, 339,
+	340, 233, 226, 317, 227, 251, 228, 206, 325, 229,
+	208, 304, 343, 0, 247, 313, 273, 209, 272, 305,
+	342, 341, 217, 367, 373, 374, 378, 0, 379, 0,
+	0, 0, 387, 392, 393, 394, 395, 396, 397, 398,
+	399, 0, 0, 0, 0, 0, 381, 106, 0, 0,
+	0, 0, 0, 372, 245, 202, 203, 355, 572, 295,
+	0, 0, 586, 567, 569, 570, 573, 577, 578, 579,
+	580, 581, 583, 585, 589, 321, 0, 0, 0, 0,
+	0, 256, 302, 0, 322, 0, 0, 2223, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 353, 365,
+	382, 385, 0, 0, 0, 207, 384, 0, 2700, 0,
+	0, 0, 2701, 0, 588, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 531, 283, 284, 285, 286, 287,
+	288, 575, 0, 224, 383, 311, 842, 841, 851, 852,
+	844, 845, 846, 847, 848, 849, 850, 843, 0, 639,
+	0, 0, 377, 244, 250, 391, 252, 223, 301, 246,
+	362, 259, 0, 388, 0, 0, 0, 0, 292, 255,
+	326, 260, 266, 314, 361, 299, 319, 221, 352, 327,
+	270, 0, 0, 597, 571, 596, 598, 599, 595, 600,
+	601, 582, 493, 0, 535, 593, 592, 594, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	106, 204, 0, 264, 0, 310, 243, 560, 540, 541,
+	542, 492, 543, 538, 539, 561, 533, 557, 558, 516,
+	536, 544, 556, 545, 559, 562, 563, 602, 603, 551,
+	604, 548, 564, 555, 554, 546, 534, 565, 566, 519,
+	518, 549, 550, 537, 0, 0, 0, 368, 369, 370,
+	390, 354, 0, 235, 0, 641, 0, 0, 624, 0,
+	0, 0, 0, 0, 0, 638, 637, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 631, 0, 0, 0,
+	1465, 1465, 1465, 1465, 0, 0, 0, 0, 0, 0,
+	0, 0, 1465, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 636, 0, 0,
+	0, 635, 0, 0, 0, 0, 0, 622, 627, 0,
+	0, 628, 1465, 629, 630, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 106, 0, 0,
+	625, 0, 0, 0, 106, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 337, 529, 0, 0, 0, 0,
+	0, 623, 0, 0, 0, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 642, 0, 0, 490, 0,
+	0, 0, 238, 0, 0, 263, 0, 0, 0, 520,
+	0, 0, 328, 278, 297, 329, 271, 0, 0, 626,
+	0, 576, 584, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 483, 0, 0, 514, 553, 552, 501,
+	510, 0, 0, 219, 162, 502, 0, 509, 503, 507,
+	506, 504, 505, 0, 568, 0, 0, 0, 0, 0,
+	0, 474, 487, 0, 491, 0, 0, 106, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 484, 485, 640,
+	0, 0, 0, 530, 0, 486, 0, 0, 525, 511,
+	512, 0, 1465, 210, 334, 350, 220, 324, 363, 225,
+	332, 215, 296, 320, 0, 0, 0, 106, 212, 348,
+	331, 275, 257, 258, 211, 0, 315, 236, 249, 232,
+	294, 508, 528, 532, 231, 590, 526, 358, 214, 0,
+	357, 293, 344, 349, 276, 269, 213, 346, 274, 268,
+	261, 240, 591, 253, 306, 267, 307, 254, 281, 280,
+	282, 0, 0, 0, 0, 0, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 523, 0, 0, 360, 0, 0, 574, 0, 0,
+	0, 333, 0, 0, 262, 0, 0, 0, 527, 0,
+	318, 300, 587, 475, 0, 316, 265, 345, 308, 351,
+	335, 359, 312, 309, 205, 336, 234, 277, 216, 218,
+	230, 237, 239, 241, 242, 289, 290, 303, 323, 338,
+	339, 340, 233, 226, 317, 227, 251, 228, 206, 325,
+	229, 208, 304, 343, 0, 247, 313, 273, 209, 272,
+	305, 342, 341, 217, 367, 373, 374, 378, 0, 379,
+	0, 0, 0, 387, 392, 393, 394, 395, 396, 397,
+	398, 399, 0, 0, 0, 0, 0, 381, 0, 0,
+	0, 1283, 1282, 1284, 372, 245, 202, 203, 355, 572,
+	295, 0, 0, 586, 567, 569, 570, 573, 577, 578,
+	579, 580, 581, 583, 585, 589, 321, 0, 0, 0,
+	0, 0, 256, 302, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 353,
+	365, 382, 385, 0, 0, 0, 207, 384, 0, 0,
+	0, 0, 0, 0, 0, 588, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 531, 283, 284, 285, 286,
+	287, 288, 575, 0, 224, 383, 311, 0, 0, 0,
+	0, 0, 0, 1465, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 377, 244, 250, 391, 252, 223, 301,
+	246, 362, 259, 0, 388, 0, 0, 0, 0, 292,
+	255, 326, 260, 266, 314, 361, 299, 319, 221, 352,
+	327, 270, 0, 0, 597, 571, 596, 598, 599, 595,
+	600, 601, 582, 493, 0, 535, 593, 592, 594, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 204, 0, 264, 0, 310, 243, 560, 540,
+	541, 542, 492, 543, 538, 539, 561, 533, 557, 558,
+	516, 536, 544, 556, 545, 559, 562, 563, 602, 603,
+	551, 604, 548, 564, 555, 554, 546, 534, 565, 566,
+	519, 518, 549, 550, 537, 337, 529, 0, 368, 369,
+	370, 390, 354, 0, 235, 0, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 0, 238, 0, 0, 263, 0, 0, 0,
+	520, 0, 0, 328, 278, 297, 329, 271, 0, 0,
+	0, 0, 576, 584, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 483, 0, 0, 514, 553, 552,
+	501, 510, 0, 0, 219, 162, 502, 0, 509, 503,
+	507, 506, 504, 505, 0, 568, 0, 0, 0, 0,
+	0, 0, 474, 487, 0, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 484, 485,
+	0, 0, 0, 0, 530, 0, 486, 0, 0, 525,
+	511, 512, 0, 0, 210, 334, 350, 220, 324, 363,
+	225, 332, 215, 296, 320, 0, 0, 0, 0, 212,
+	348, 331, 275, 257, 258, 211, 0, 315, 236, 249,
+	232, 294, 508, 528, 532, 231, 590, 526, 358, 214,
+	0, 357, 293, 344, 349, 276, 269, 213, 346, 274,
+	268, 261, 240, 591, 253, 306, 267, 307, 254, 281,
+	280, 282, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 523, 0, 0, 360, 0, 0, 574, 0,
+	0, 0, 333, 0, 0, 262, 0, 0, 0, 527,
+	0, 318, 300, 587, 475, 0, 316, 265, 345, 308,
+	351, 335, 359, 312, 309, 205, 336, 234, 277, 216,
+	218, 230, 237, 239, 241, 242, 289, 290, 303, 323,
+	338, 339, 340, 233, 226, 317, 227, 251, 228, 206,
+	325, 229, 208, 304, 343, 0, 247, 313, 273, 209,
+	272, 305, 342, 341, 217, 367, 373, 374, 378, 0,
+	379, 0, 0, 0, 387, 392, 393, 394, 395, 396,
+	397, 398, 399, 0, 0, 0, 0, 0, 381, 0,
+	0, 0, 0, 0, 0, 372, 245, 202, 203, 355,
+	572, 295, 0, 0, 586, 567, 569, 570, 573, 577,
+	578, 579, 580, 581, 583, 585, 589, 321, 0, 0,
+	0, 0, 0, 256, 302, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 330,
+	353, 365, 382, 385, 0, 0, 0, 207, 384, 0,
+	2700, 0, 0, 0, 2701, 0, 588, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 531, 283, 284, 285,
+	286, 287, 288, 575, 0, 224, 383, 311, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 244, 250, 391, 252, 223,
+	301, 246, 362, 259, 0, 388, 0, 0, 0, 0,
+	292, 255, 326, 260, 266, 314, 361, 299, 319, 221,
+	352, 327, 270, 0, 0, 597, 571, 596, 598, 599,
+	595, 600, 601, 582, 493, 0, 535, 593, 592, 594,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 204, 0, 264, 0, 310, 243, 560,
+	540, 541, 542, 492, 543, 538, 539, 561, 533, 557,
+	558, 516, 536, 544, 556, 545, 559, 562, 563, 602,
+	603, 551, 604, 548, 564, 555, 554, 546, 534, 565,
+	566, 519, 518, 549, 550, 537, 337, 529, 0, 368,
+	369, 370, 390, 354, 0, 235, 0, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 0, 0, 0, 238, 1347, 0, 263, 0, 0,
+	0, 520, 0, 0, 328, 278, 297, 329, 271, 0,
+	0, 0, 0, 576, 584, 0, 0, 0, 0, 0,
+	0, 0, 1486, 0, 0, 483, 0, 0, 514, 553,
+	552, 501, 510, 0, 0, 219, 162, 502, 0, 509,
+	503, 507, 506, 504, 505, 0, 568, 0, 0, 0,
+	0, 0, 0, 474, 487, 0, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 484,
+	485, 0, 0, 0, 0, 530, 0, 486, 0, 0,
+	1487, 511, 512, 0, 0, 210, 334, 350, 220, 324,
+	363, 225, 332, 215, 296, 320, 0, 0, 0, 0,
+	212, 348, 331, 275, 257, 258, 211, 0, 315, 236,
+	249, 232, 294, 508, 528, 532, 231, 590, 526, 358,
+	214, 0, 357, 293, 344, 349, 276, 269, 213, 346,
+	274, 268, 261, 240, 591, 253, 306, 267, 307, 254,
+	281, 280, 282, 0, 0, 0, 0, 0, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 523, 0, 0, 360, 0, 0, 574,
+	0, 0, 0, 333, 0, 0, 262, 0, 0, 0,
+	527, 0, 318, 300, 587, 475, 0, 316, 265, 345,
+	308, 351, 335, 359, 312, 309, 205, 336, 234, 277,
+	216, 218, 230, 237, 239, 241, 242, 289, 290, 303,
+	323, 338, 339, 340, 233, 226, 317, 227, 251, 228,
+	206, 325, 229, 208, 304, 343, 0, 247, 313, 273,
+	209, 272, 305, 342, 341, 217, 367, 373, 374, 378,
+	0, 379, 0, 0, 0, 387, 392, 393, 394, 395,
+	396, 397, 398, 399, 0, 0, 0, 0, 0, 381,
+	0, 0, 0, 0, 0, 0, 372, 245, 202, 203,
+	355, 572, 295, 0, 0, 586, 567, 569, 570, 573,
+	577, 578, 579, 580, 581, 583, 585, 589, 321, 0,
+	0, 0, 0, 0, 256, 302, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	330, 353, 365, 382, 385, 0, 0, 0, 207, 384,
+	0, 0, 0, 0, 0, 0, 0, 588, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 531, 283, 284,
+	285, 286, 287, 288, 575, 0, 224, 383, 311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 377, 244, 250, 391, 252,
+	223, 301, 246, 362, 259, 0, 388, 0, 0, 0,
+	0, 292, 255, 326, 260, 266, 314, 361, 299, 319,
+	221, 352, 327, 270, 0, 0, 597, 571, 596, 598,
+	599, 595, 600, 601, 582, 493, 0, 535, 593, 592,
+	594, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 204, 0, 264, 0, 310, 243,
+	560, 540, 541, 542, 492, 543, 538, 539, 561, 533,
+	557, 558, 516, 536, 544, 556, 545, 559, 562, 563,
+	602, 603, 551, 604, 548, 564, 555, 554, 546, 534,
+	565, 566, 519, 518, 549, 550, 537, 153, 337, 529,
+	368, 369, 370, 390, 354, 0, 235, 0, 0, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 0, 0, 0, 238, 0, 0, 263,
+	0, 0, 0, 862, 0, 0, 328, 278, 297, 329,
+	271, 0, 0, 0, 0, 576, 584, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 483, 0, 0,
+	514, 553, 552, 501, 510, 0, 0, 219, 162, 502,
+	0, 509, 503, 507, 506, 504, 505, 0, 568, 0,
+	0, 0, 0, 0, 0, 474, 487, 0, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 484, 485, 0, 0, 0, 0, 530, 0, 486,
+	0, 0, 525, 511, 512, 0, 0, 210, 334, 350,
+	220, 324, 363, 225, 332, 215, 296, 320, 0, 0,
+	0, 0, 212, 348, 331, 275, 257, 258, 211, 0,
+	315, 236, 249, 232, 294, 508, 528, 532, 231, 590,
+	526, 358, 214, 0, 357, 293, 344, 349, 276, 269,
+	213, 346, 274, 268, 261, 240, 591, 253, 306, 267,
+	307, 254, 281, 280, 282, 0, 0, 0, 0, 0,
 	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 226, 0, 0, 251,
-	0, 0, 0, 0, 0, 0, 311, 265, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 0, 0, 149, 0, 0,
-	0, 0, 0, 0, 207, 150, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 316, 332, 208, 307, 345,
-	213, 314, 203, 280, 303, 0, 0, 200, 330, 313,
-	262, 245, 246, 199, 0, 298, 224, 237, 220, 278,
-	0, 329, 357, 219, 348, 0, 340, 202, 0, 339,
-	277, 326, 331, 263, 257, 201, 328, 261, 256, 249,
-	228, 371, 241, 289, 255, 290, 242, 267, 266, 268,
-	0, 0, 0, 0, 0, 368, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 390, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 250, 0, 0, 0, 358, 0, 301, 283,
-	0, 0, 0, 299, 253, 327, 291, 333, 317, 341,
-	295, 292, 193, 318, 222, 264, 204, 206, 218, 225,
-	227, 229, 230, 273, 274, 286, 306, 320, 321, 322,
-	221, 214, 300, 215, 239, 216, 194, 308, 217, 196,
-	287, 325, 0, 235, 296, 260, 197, 259, 288, 324,
-	323, 205, 349, 355, 356, 360, 0, 361, 0, 0,
-	0, 369, 374, 375, 376, 0, 0, 0, 0, 0,
-	363, 0, 0, 0, 0, 0, 0, 354, 233, 190,
-	191, 337, 0, 279, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 353, 0, 0, 0, 0, 304,
-	0, 0, 0, 0, 0, 244, 285, 0, 305, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 312, 335, 347, 364, 367, 0, 0, 0, 195,
-	366, 0, 0, 0, 0, 0, 0, 0, 338, 0,
-	0, 0, 346, 0, 0, 0, 0, 0, 362, 269,
-	270, 271, 272, 387, 389, 212, 365, 294, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 359, 232, 238, 373, 240, 211,
-	284, 234, 344, 247, 0, 370, 0, 0, 0, 0,
-	276, 243, 309, 248, 254, 297, 343, 282, 302, 209,
-	334, 310, 258, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 40, 0, 0, 189, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 252, 109, 293, 231, 153,
-	154, 155, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
-	174, 0, 175, 176, 177, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 188, 319, 0, 0, 350,
-	351, 352, 372, 336, 0, 223, 0, 281, 0, 0,
-	0, 0, 0, 0, 0, 909, 0, 0, 0, 0,
-	0, 0, 0, 0, 226, 0, 0, 251, 0, 0,
-	0, 0, 0, 0, 311, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 149, 0, 0, 0, 0,
-	0, 0, 207, 150, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 897, 0, 0,
-	0, 0, 198, 316, 332, 208, 307, 345, 213, 314,
-	203, 280, 303, 0, 0, 1628, 1630, 1631, 1632, 1633,
-	1634, 1635, 0, 1639, 1636, 1637, 1638, 278, 0, 1623,
-	1624, 1625, 1626, 895, 1609, 1629, 0, 1610, 277, 1611,
-	1612, 1613, 1614, 1615, 1616, 1617, 1618, 1619, 1620, 1621,
-	1627, 289, 255, 290, 242, 267, 266, 268, 920, 922,
-	924, 926, 929, 368, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 0, 0, 0, 0, 315, 0, 0,
-	250, 0, 0, 0, 1622, 0, 301, 283, 0, 0,
-	0, 299, 253, 327, 291, 333, 317, 341, 295, 292,
-	193, 318, 222, 264, 204, 206, 218, 225, 227, 229,
-	230, 273, 274, 286, 306, 320, 321, 322, 221, 214,
-	300, 215, 239, 216, 194, 308, 217, 196, 287, 325,
-	0, 235, 296, 260, 197, 259, 288, 324, 323, 205,
-	349, 355, 356, 360, 0, 361, 0, 0, 0, 369,
-	374, 375, 376, 0, 0, 0, 0, 0, 363, 0,
-	0, 0, 0, 0, 0, 354, 233, 190, 191, 337,
-	0, 279, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 353, 0, 0, 0, 0, 304, 0, 0,
-	0, 0, 0, 244, 285, 0, 305, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
-	335, 347, 364, 367, 0, 0, 0, 195, 366, 0,
-	0, 0, 0, 0, 0, 0, 338, 0, 0, 0,
-	346, 0, 0, 0, 0, 0, 362, 269, 270, 271,
-	272, 236, 0, 212, 365, 294, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 359, 232, 238, 373, 240, 211, 284, 234,
-	344, 247, 0, 370, 0, 0, 0, 0, 276, 243,
-	309, 248, 254, 297, 343, 282, 302, 209, 334, 310,
-	258, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 919, 252, 0, 293, 231, 153, 154, 155,
-	156, 157, 158, 159, 160, 161, 162, 163, 164, 165,
-	166, 167, 168, 169, 170, 171, 172, 173, 174, 0,
-	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 188, 319, 0, 0, 350, 351, 352,
-	372, 336, 0, 223, 0, 281, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 226, 0, 0, 251, 0, 0, 0, 0,
-	0, 0, 311, 265, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 149, 0, 0, 0, 0, 0, 0,
-	207, 150, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 210, 1692, 1695, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 316, 332, 208, 307, 345, 213, 314, 203, 280,
-	303, 0, 0, 200, 330, 313, 262, 245, 246, 199,
-	0, 298, 224, 237, 220, 278, 0, 329, 357, 219,
-	348, 0, 340, 202, 0, 339, 277, 326, 331, 263,
-	257, 201, 328, 261, 256, 249, 228, 371, 241, 289,
-	255, 290, 242, 267, 266, 268, 0, 0, 0, 0,
-	0, 368, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1696, 342, 0,
-	0, 0, 1689, 0, 1688, 315, 1690, 1693, 250, 0,
-	0, 0, 358, 0, 301, 283, 0, 0, 0, 299,
-	253, 327, 291, 333, 317, 341, 295, 292, 193, 318,
-	222, 264, 204, 206, 218, 225, 227, 229, 230, 273,
-	274, 286, 306, 320, 321, 322, 221, 214, 300, 215,
-	239, 216, 194, 308, 217, 196, 287, 325, 1694, 235,
-	296, 260, 197, 259, 288, 324, 323, 205, 349, 355,
-	356, 360, 0, 361, 0, 0, 0, 369, 374, 375,
-	376, 0, 0, 0, 0, 0, 363, 0, 0, 0,
-	0, 0, 0, 354, 233, 190, 191, 337, 0, 279,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 275,
-	353, 0, 0, 0, 0, 304, 0, 0, 0, 0,
-	0, 244, 285, 0, 305, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 312, 335, 347,
-	364, 367, 0, 0, 0, 195, 366, 0, 0, 0,
-	0, 0, 0, 0, 338, 0, 0, 0, 346, 0,
-	0, 0, 0, 0, 362, 269, 270, 271, 272, 236,
-	0, 212, 365, 294, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	359, 232, 238, 373, 240, 211, 284, 234, 344, 247,
-	0, 370, 0, 0, 0, 0, 276, 243, 309, 248,
-	254, 297, 343, 282, 302, 209, 334, 310, 258, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	0, 252, 0, 293, 231, 153, 154, 155, 156, 157,
-	158, 159, 160, 161, 162, 163, 164, 165, 166, 167,
-	168, 169, 170, 171, 172, 173, 174, 0, 175, 176,
-	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 188, 319, 0, 0, 350, 351, 352, 372, 336,
-	0, 223, 0, 281, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1463, 0, 0, 0, 0,
-	226, 0, 0, 251, 0, 0, 0, 0, 0, 0,
-	311, 265, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 523, 0, 0, 360, 0,
+	0, 574, 0, 0, 0, 333, 0, 0, 262, 0,
+	0, 0, 527, 0, 318, 300, 587, 475, 0, 316,
+	265, 345, 308, 351, 335, 359, 312, 309, 205, 336,
+	234, 277, 216, 218, 230, 237, 239, 241, 242, 289,
+	290, 303, 323, 338, 339, 340, 233, 226, 317, 227,
+	251, 228, 206, 325, 229, 208, 304, 343, 0, 247,
+	313, 273, 209, 272, 305, 342, 341, 217, 367, 373,
+	374, 378, 0, 379, 0, 0, 0, 387, 392, 393,
+	394, 395, 396, 397, 398, 399, 0, 0, 0, 0,
+	0, 381, 0, 0, 0, 0, 0, 0, 372, 245,
+	202, 203, 355, 572, 295, 0, 0, 586, 567, 569,
+	570, 573, 577, 578, 579, 580, 581, 583, 585, 589,
+	321, 0, 0, 0, 0, 0, 256, 302, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 330, 353, 365, 382, 385, 0, 0, 0,
+	207, 384, 0, 0, 0, 0, 0, 0, 0, 588,
+	0, 0, 0, 364, 0, 0, 0, 0, 0, 531,
+	283, 284, 285, 286, 287, 288, 575, 0, 224, 383,
+	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 377, 244, 250,
+	391, 252, 223, 301, 246, 362, 259, 0, 388, 0,
+	0, 0, 0, 292, 255, 326, 260, 266, 314, 361,
+	299, 319, 221, 352, 327, 270, 0, 0, 597, 571,
+	596, 598, 599, 595, 600, 601, 582, 493, 0, 535,
+	593, 592, 594, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 204, 0, 264, 116,
+	310, 243, 560, 540, 541, 542, 492, 543, 538, 539,
+	561, 533, 557, 558, 516, 536, 544, 556, 545, 559,
+	562, 563, 602, 603, 551, 604, 548, 564, 555, 554,
+	546, 534, 565, 566, 519, 518, 549, 550, 537, 337,
+	529, 0, 368, 369, 370, 390, 354, 0, 235, 0,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 0, 0, 238, 2874, 0,
+	263, 0, 0, 0, 520, 0, 0, 328, 278, 297,
+	329, 271, 0, 0, 0, 0, 576, 584, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 483, 0,
+	0, 514, 553, 552, 501, 510, 0, 0, 219, 162,
+	502, 0, 509, 503, 507, 506, 504, 505, 0, 568,
+	0, 0, 0, 0, 0, 0, 474, 487, 0, 491,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 484, 485, 0, 0, 0, 0, 530, 0,
+	486, 0, 0, 525, 511, 512, 0, 0, 210, 334,
+	350, 220, 324, 363, 225, 332, 215, 296, 320, 0,
+	0, 0, 0, 212, 348, 331, 275, 257, 258, 211,
+	0, 315, 236, 249, 232, 294, 508, 528, 532, 231,
+	590, 526, 358, 214, 0, 357, 293, 344, 349, 276,
+	269, 213, 346, 274, 268, 261, 240, 591, 253, 306,
+	267, 307, 254, 281, 280, 282, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 523, 0, 0, 360,
+	0, 0, 574, 0, 0, 0, 333, 0, 0, 262,
+	0, 0, 0, 527, 0, 318, 300, 587, 475, 0,
+	316, 265, 345, 308, 351, 335, 359, 312, 309, 205,
+	336, 234, 277, 216, 218, 230, 237, 239, 241, 242,
+	289, 290, 303, 323, 338, 339, 340, 233, 226, 317,
+	227, 251, 228, 206, 325, 229, 208, 304, 343, 0,
+	247, 313, 273, 209, 272, 305, 342, 341, 217, 367,
+	373, 374, 378, 0, 379, 0, 0, 0, 387, 392,
+	393, 394, 395, 396, 397, 398, 399, 0, 0, 0,
+	0, 0, 381, 0, 0, 0, 0, 0, 0, 372,
+	245, 202, 203, 355, 572, 295, 0, 0, 586, 567,
+	569, 570, 573, 577, 578, 579, 580, 581, 583, 585,
+	589, 321, 0, 0, 0, 0, 0, 256, 302, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 330, 353, 365, 382, 385, 0, 0,
+	0, 207, 384, 0, 0, 0, 0, 0, 0, 0,
+	588, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	531, 283, 284, 285, 286, 287, 288, 575, 0, 224,
+	383, 311, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 377, 244,
+	250, 391, 252, 223, 301, 246, 362, 259, 0, 388,
+	0, 0, 0, 0, 292, 255, 326, 260, 266, 314,
+	361, 299, 319, 221, 352, 327, 270, 0, 0, 597,
+	571, 596, 598, 599, 595, 600, 601, 582, 493, 0,
+	535, 593, 592, 594, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 204, 0, 264,
+	0, 310, 243, 560, 540, 541, 542, 492, 543, 538,
+	539, 561, 533, 557, 558, 516, 536, 544, 556, 545,
+	559, 562, 563, 602, 603, 551, 604, 548, 564, 555,
+	554, 546, 534, 565, 566, 519, 518, 549, 550, 537,
+	337, 529, 0, 368, 369, 370, 390, 354, 0, 235,
+	0, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 490, 0, 0, 0, 238, 1347,
+	0, 263, 0, 0, 0, 520, 0, 0, 328, 278,
+	297, 329, 271, 0, 0, 0, 0, 576, 584, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
+	0, 0, 514, 553, 552, 501, 510, 0, 0, 219,
+	162, 502, 0, 509, 503, 507, 506, 504, 505, 0,
+	568, 0, 0, 0, 0, 0, 0, 474, 487, 0,
+	491, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 484, 485, 0, 0, 0, 0, 530,
+	0, 486, 0, 0, 525, 511, 512, 0, 0, 210,
+	334, 350, 220, 324, 363, 225, 332, 215, 296, 320,
+	0, 0, 0, 0, 212, 348, 331, 275, 257, 258,
+	211, 0, 315, 236, 249, 232, 294, 508, 528, 532,
+	231, 590, 526, 358, 214, 0, 357, 293, 344, 349,
+	276, 269, 213, 346, 274, 268, 261, 240, 591, 253,
+	306, 267, 307, 254, 281, 280, 282, 0, 0, 0,
+	0, 0, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 523, 0, 0,
+	360, 0, 0, 574, 0, 0, 0, 333, 0, 0,
+	262, 0, 0, 0, 527, 0, 318, 300, 587, 475,
+	0, 316, 265, 345, 308, 351, 335, 359, 312, 309,
+	205, 336, 234, 277, 216, 218, 230, 237, 239, 241,
+	242, 289, 290, 303, 323, 338, 339, 340, 233, 226,
+	317, 227, 251, 228, 206, 325, 229, 208, 304, 343,
+	0, 247, 313, 273, 209, 272, 305, 342, 341, 217,
+	367, 373, 374, 378, 0, 379, 0, 0, 0, 387,
+	392, 393, 394, 395, 396, 397, 398, 399, 0, 0,
+	0, 0, 0, 381, 0, 0, 0, 0, 0, 0,
+	372, 245, 202, 203, 355, 572, 295, 0, 0, 586,
+	567, 569, 570, 573, 577, 578, 579, 580, 581, 583,
+	585, 589, 321, 0, 0, 0, 0, 0, 256, 302,
+	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 330, 353, 365, 382, 385, 0,
+	0, 0, 207, 384, 0, 0, 0, 0, 0, 0,
+	0, 588, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 531, 283, 284, 285, 286, 287, 288, 575, 0,
+	224, 383, 311, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 377,
+	244, 250, 391, 252, 223, 301, 246, 362, 259, 0,
+	388, 0, 0, 0, 0, 292, 255, 326, 260, 266,
+	314, 361, 299, 319, 221, 352, 327, 270, 0, 0,
+	597, 571, 596, 598, 599, 595, 600, 601, 582, 493,
+	0, 535, 593, 592, 594, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 204, 0,
+	264, 0, 310, 243, 560, 540, 541, 542, 492, 543,
+	538, 539, 561, 533, 557, 558, 516, 536, 544, 556,
+	545, 559, 562, 563, 602, 603, 551, 604, 548, 564,
+	555, 554, 546, 534, 565, 566, 519, 518, 549, 550,
+	537, 337, 529, 0, 368, 369, 370, 390, 354, 0,
+	235, 0, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 490, 0, 0, 0, 238,
+	0, 0, 263, 0, 0, 0, 520, 0, 0, 328,
+	278, 297, 329, 271, 0, 0, 0, 0, 576, 584,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	483, 0, 0, 514, 553, 552, 501, 510, 0, 0,
+	219, 162, 502, 0, 509, 503, 507, 506, 504, 505,
+	0, 568, 0, 0, 0, 0, 0, 0, 474, 487,
+	0, 491, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 484, 485, 1516, 0, 0, 0,
+	530, 0, 486, 0, 0, 525, 511, 512, 0, 0,
+	210, 334, 350, 220, 324, 363, 225, 332, 215, 296,
+	320, 0, 0, 0, 0, 212, 348, 331, 275, 257,
+	258, 211, 0, 315, 236, 249, 232, 294, 508, 528,
+	532, 231, 590, 526, 358, 214, 0, 357, 293, 344,
+	349, 276, 269, 213, 346, 274, 268, 261, 240, 591,
+	253, 306, 267, 307, 254, 281, 280, 282, 0, 0,
+	0, 0, 0, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 523, 0,
+	0, 360, 0, 0, 574, 0, 0, 0, 333, 0,
+	0, 262, 0, 0, 0, 527, 0, 318, 300, 587,
+	475, 0, 316, 265, 345, 308, 351, 335, 359, 312,
+	309, 205, 336, 234, 277, 216, 218, 230, 237, 239,
+	241, 242, 289, 290, 303, 323, 338, 339, 340, 233,
+	226, 317, 227, 251, 228, 206, 325, 229, 208, 304,
+	343, 0, 247, 313, 273, 209, 272, 305, 342, 341,
+	217, 367, 373, 374, 378, 0, 379, 0, 0, 0,
+	387, 392, 393, 394, 395, 396, 397, 398, 399, 0,
+	0, 0, 0, 0, 381, 0, 0, 0, 0, 0,
+	0, 372, 245, 202, 203, 355, 572, 295, 0, 0,
+	586, 567, 569, 570, 573, 577, 578, 579, 580, 581,
+	583, 585, 589, 321, 0, 0, 0, 0, 0, 256,
+	302, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 330, 353, 365, 382, 385,
+	0, 0, 0, 207, 384, 0, 0, 0, 0, 0,
+	0, 0, 588, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 531, 283, 284, 285, 286, 287, 288, 575,
+	0, 224, 383, 311, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 244, 250, 391, 252, 223, 301, 246, 362, 259,
+	0, 388, 0, 0, 0, 0, 292, 255, 326, 260,
+	266, 314, 361, 299, 319, 221, 352, 327, 270, 0,
+	0, 597, 571, 596, 598, 599, 595, 600, 601, 582,
+	493, 0, 535, 593, 592, 594, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	0, 264, 0, 310, 243, 560, 540, 541, 542, 492,
+	543, 538, 539, 561, 533, 557, 558, 516, 536, 544,
+	556, 545, 559, 562, 563, 602, 603, 551, 604, 548,
+	564, 555, 554, 546, 534, 565, 566, 519, 518, 549,
+	550, 537, 0, 0, 0, 368, 369, 370, 390, 354,
+	0, 235, 337, 529, 0, 0, 1607, 0, 0, 0,
+	0, 0, 0, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 0, 0, 0,
+	238, 0, 0, 263, 0, 0, 0, 520, 0, 0,
+	328, 278, 297, 329, 271, 0, 0, 0, 0, 576,
+	584, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 483, 0, 0, 514, 553, 552, 501, 510, 0,
+	0, 219, 162, 502, 0, 509, 503, 507, 506, 504,
+	505, 0, 568, 0, 0, 0, 0, 0, 0, 474,
+	487, 0, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 484, 485, 0, 0, 0,
+	0, 530, 0, 486, 0, 0, 525, 511, 512, 0,
+	0, 210, 334, 350, 220, 324, 363, 225, 332, 215,
+	296, 320, 0, 0, 0, 0, 212, 348, 331, 275,
+	257, 258, 211, 0, 315, 236, 249, 232, 294, 508,
+	528, 532, 231, 590, 526, 358, 214, 0, 357, 293,
+	344, 349, 276, 269, 213, 346, 274, 268, 261, 240,
+	591, 253, 306, 267, 307, 254, 281, 280, 282, 0,
+	0, 0, 0, 0, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 523,
+	0, 0, 360, 0, 0, 574, 0, 0, 0, 333,
+	0, 0, 262, 0, 0, 0, 527, 0, 318, 300,
+	587, 475, 0, 316, 265, 345, 308, 351, 335, 359,
+	312, 309, 205, 336, 234, 277, 216, 218, 230, 237,
+	239, 241, 242, 289, 290, 303, 323, 338, 339, 340,
+	233, 226, 317, 227, 251, 228, 206, 325, 229, 208,
+	304, 343, 0, 247, 313, 273, 209, 272, 305, 342,
+	341, 217, 367, 373, 374, 378, 0, 379, 0, 0,
+	0, 387, 392, 393, 394, 395, 396, 397, 398, 399,
+	0, 0, 0, 0, 0, 381, 0, 0, 0, 0,
+	0, 0, 372, 245, 202, 203, 355, 572, 295, 0,
+	0, 586, 567, 569, 570, 573, 577, 578, 579, 580,
+	581, 583, 585, 589, 321, 0, 0, 0, 0, 0,
+	256, 302, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 330, 353, 365, 382,
+	385, 0, 0, 0, 207, 384, 0, 0, 0, 0,
+	0, 0, 0, 588, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 531, 283, 284, 285, 286, 287, 288,
+	575, 0, 224, 383, 311, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 377, 244, 250, 391, 252, 223, 301, 246, 362,
+	259, 0, 388, 0, 0, 0, 0, 292, 255, 326,
+	260, 266, 314, 361, 299, 319, 221, 352, 327, 270,
+	0, 0, 597, 571, 596, 598, 599, 595, 600, 601,
+	582, 493, 0, 535, 593, 592, 594, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	204, 0, 264, 0, 310, 243, 560, 540, 541, 542,
+	492, 543, 538, 539, 561, 533, 557, 558, 516, 536,
+	544, 556, 545, 559, 562, 563, 602, 603, 551, 604,
+	548, 564, 555, 554, 546, 534, 565, 566, 519, 518,
+	549, 550, 537, 337, 529, 0, 368, 369, 370, 390,
+	354, 0, 235, 0, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 490, 0, 0,
+	0, 238, 0, 0, 263, 0, 0, 0, 520, 0,
+	0, 328, 278, 297, 329, 271, 0, 0, 0, 0,
+	576, 584, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 483, 0, 0, 514, 553, 552, 501, 510,
+	0, 0, 219, 162, 502, 0, 509, 503, 507, 506,
+	504, 505, 0, 568, 0, 0, 0, 0, 0, 0,
+	474, 487, 0, 491, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 484, 485, 0, 0,
+	0, 0, 530, 0, 486, 0, 0, 525, 511, 512,
+	0, 0, 210, 334, 350, 220, 324, 363, 225, 332,
+	215, 296, 320, 0, 0, 0, 0, 212, 348, 331,
+	275, 257, 258, 211, 0, 315, 236, 249, 232, 294,
+	508, 528, 532, 231, 590, 526, 358, 214, 0, 357,
+	293, 344, 349, 276, 269, 213, 346, 274, 268, 261,
+	240, 591, 253, 306, 267, 307, 254, 281, 280, 282,
+	0, 0, 0, 0, 0, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	523, 0, 0, 360, 0, 0, 574, 0, 0, 0,
+	333, 0, 0, 262, 0, 0, 0, 527, 0, 318,
+	300, 587, 475, 0, 316, 265, 345, 308, 351, 335,
+	359, 312, 309, 205, 336, 234, 277, 216, 218, 230,
+	237, 239, 241, 242, 289, 290, 303, 323, 338, 339,
+	340, 233, 226, 317, 227, 251, 228, 206, 325, 229,
+	208, 304, 343, 0, 247, 313, 273, 209, 272, 305,
+	342, 341, 217, 367, 373, 374, 378, 0, 379, 0,
+	0, 0, 387, 392, 393, 394, 395, 396, 397, 398,
+	399, 0, 0, 0, 0, 0, 381, 0, 0, 0,
+	0, 0, 0, 372, 245, 202, 203, 355, 572, 295,
+	0, 0, 586, 567, 569, 570, 573, 577, 578, 579,
+	580, 581, 583, 585, 589, 321, 0, 0, 0, 0,
+	0, 256, 302, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 353, 365,
+	382, 385, 0, 0, 0, 207, 384, 0, 0, 0,
+	0, 0, 0, 0, 588, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 531, 283, 284, 285, 286, 287,
+	288, 575, 0, 224, 383, 311, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 377, 244, 250, 391, 252, 223, 301, 246,
+	362, 259, 0, 388, 0, 0, 0, 0, 292, 255,
+	326, 260, 266, 314, 361, 299, 319, 221, 352, 327,
+	270, 0, 0, 597, 571, 596, 598, 599, 595, 600,
+	601, 582, 493, 0, 535, 593, 592, 594, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 204, 0, 264, 0, 310, 243, 560, 540, 541,
+	542, 492, 543, 538, 539, 561, 533, 557, 558, 516,
+	536, 544, 556, 545, 559, 562, 563, 602, 603, 551,
+	604, 548, 564, 555, 554, 546, 534, 565, 566, 519,
+	518, 549, 550, 537, 337, 529, 0, 368, 369, 370,
+	390, 354, 0, 235, 0, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 1205, 0, 0, 0, 490, 0,
+	0, 0, 238, 0, 0, 263, 0, 0, 0, 520,
+	0, 0, 328, 278, 297, 329, 271, 0, 0, 0,
+	0, 576, 584, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 483, 0, 0, 514, 553, 552, 501,
+	510, 0, 0, 219, 162, 502, 0, 509, 503, 507,
+	506, 504, 505, 0, 568, 0, 0, 0, 0, 0,
+	0, 0, 487, 0, 491, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 484, 485, 0,
+	0, 0, 0, 530, 0, 486, 0, 0, 525, 511,
+	512, 0, 0, 210, 334, 350, 220, 324, 363, 225,
+	332, 215, 296, 320, 0, 0, 0, 0, 212, 348,
+	331, 275, 257, 258, 211, 0, 315, 236, 249, 232,
+	294, 508, 528, 532, 231, 590, 526, 358, 214, 0,
+	357, 293, 344, 349, 276, 269, 213, 346, 274, 268,
+	261, 240, 591, 253, 306, 267, 307, 254, 281, 280,
+	282, 0, 0, 0, 0, 0, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 523, 0, 0, 360, 0, 0, 574, 0, 0,
+	0, 333, 0, 0, 262, 0, 0, 0, 527, 0,
+	318, 300, 587, 0, 0, 316, 265, 345, 308, 351,
+	335, 359, 312, 309, 205, 336, 234, 277, 216, 218,
+	230, 237, 239, 241, 242, 289, 290, 303, 323, 338,
+	339, 340, 233, 226, 317, 227, 251, 228, 206, 325,
+	229, 208, 304, 343, 0, 247, 313, 273, 209, 272,
+	305, 342, 341, 217, 367, 1206, 1207, 378, 0, 379,
+	0, 0, 0, 387, 392, 393, 394, 395, 396, 397,
+	398, 399, 0, 0, 0, 0, 0, 381, 0, 0,
+	0, 0, 0, 0, 372, 245, 202, 203, 355, 572,
+	295, 0, 0, 586, 567, 569, 570, 573, 577, 578,
+	579, 580, 581, 583, 585, 589, 321, 0, 0, 0,
+	0, 0, 256, 302, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 353,
+	365, 382, 385, 0, 0, 0, 207, 384, 0, 0,
+	0, 0, 0, 0, 0, 588, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 531, 283, 284, 285, 286,
+	287, 288, 575, 0, 224, 383, 311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 377, 244, 250, 391, 252, 223, 301,
+	246, 362, 259, 0, 388, 0, 0, 0, 0, 292,
+	255, 326, 260, 266, 314, 361, 299, 319, 221, 352,
+	327, 270, 0, 0, 597, 571, 596, 598, 599, 595,
+	600, 601, 582, 493, 0, 535, 593, 592, 594, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 204, 0, 264, 0, 310, 243, 560, 540,
+	541, 542, 492, 543, 538, 539, 561, 533, 557, 558,
+	516, 536, 544, 556, 545, 559, 562, 563, 602, 603,
+	551, 604, 548, 564, 555, 554, 546, 534, 565, 566,
+	519, 518, 549, 550, 537, 337, 529, 0, 368, 369,
+	370, 390, 354, 0, 235, 0, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 0, 238, 0, 0, 263, 0, 0, 0,
+	520, 0, 0, 328, 278, 297, 329, 271, 0, 0,
+	0, 0, 576, 584, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 514, 553, 552,
+	501, 510, 0, 0, 219, 162, 502, 0, 509, 503,
+	507, 506, 504, 505, 0, 568, 0, 0, 0, 0,
+	0, 0, 474, 487, 0, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 484, 485,
+	0, 0, 0, 0, 530, 0, 486, 0, 0, 525,
+	511, 512, 0, 0, 210, 334, 350, 220, 324, 363,
+	225, 332, 215, 296, 320, 0, 0, 0, 0, 212,
+	348, 331, 275, 257, 258, 211, 0, 315, 236, 249,
+	232, 294, 508, 528, 532, 231, 590, 526, 358, 214,
+	0, 357, 293, 344, 349, 276, 269, 213, 346, 274,
+	268, 261, 240, 591, 253, 306, 267, 307, 254, 281,
+	280, 282, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 523, 0, 0, 360, 0, 0, 574, 0,
+	0, 0, 333, 0, 0, 262, 0, 0, 0, 527,
+	0, 318, 300, 587, 475, 0, 316, 265, 345, 308,
+	351, 335, 359, 312, 309, 205, 336, 234, 277, 216,
+	218, 230, 237, 239, 241, 242, 289, 290, 303, 323,
+	338, 339, 340, 233, 226, 317, 227, 251, 228, 206,
+	325, 229, 208, 304, 343, 0, 247, 313, 273, 209,
+	272, 305, 342, 341, 217, 367, 373, 374, 378, 0,
+	379, 0, 0, 0, 387, 392, 393, 394, 395, 396,
+	397, 398, 399, 0, 0, 0, 0, 0, 381, 0,
+	0, 0, 0, 0, 0, 372, 245, 202, 203, 355,
+	572, 295, 0, 0, 586, 567, 569, 570, 573, 577,
+	578, 579, 580, 581, 583, 585, 589, 321, 0, 0,
+	0, 0, 0, 256, 302, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 330,
+	353, 365, 382, 385, 0, 0, 0, 207, 384, 0,
+	0, 0, 0, 0, 0, 0, 588, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 531, 283, 284, 285,
+	286, 287, 288, 575, 0, 224, 383, 311, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 244, 250, 391, 252, 223,
+	301, 246, 362, 259, 0, 388, 0, 0, 0, 0,
+	292, 255, 326, 260, 266, 314, 361, 299, 319, 221,
+	352, 327, 270, 0, 0, 597, 571, 596, 598, 599,
+	595, 600, 601, 582, 493, 0, 535, 593, 592, 594,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 204, 0, 264, 0, 310, 243, 560,
+	540, 541, 542, 492, 543, 538, 539, 561, 533, 557,
+	558, 516, 536, 544, 556, 545, 559, 562, 563, 602,
+	603, 551, 604, 548, 564, 555, 554, 546, 534, 565,
+	566, 519, 518, 549, 550, 537, 337, 529, 0, 368,
+	369, 370, 390, 354, 0, 235, 0, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 0, 0, 0, 238, 0, 0, 263, 0, 0,
+	0, 520, 0, 0, 328, 278, 297, 329, 271, 0,
+	0, 0, 0, 576, 584, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 483, 0, 0, 514, 553,
+	552, 501, 510, 0, 0, 219, 162, 502, 0, 509,
+	503, 507, 506, 504, 505, 0, 568, 0, 0, 0,
+	0, 0, 0, 0, 487, 0, 491, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 484,
+	485, 0, 0, 0, 0, 530, 0, 486, 0, 0,
+	525, 511, 512, 0, 0, 210, 334, 350, 220, 324,
+	363, 225, 332, 215, 296, 320, 0, 0, 0, 0,
+	212, 348, 331, 275, 257, 258, 211, 0, 315, 236,
+	249, 232, 294, 508, 528, 532, 231, 590, 526, 358,
+	214, 0, 357, 293, 344, 349, 276, 269, 213, 346,
+	274, 268, 261, 240, 591, 253, 306, 267, 307, 254,
+	281, 280, 282, 0, 0, 0, 0, 0, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 523, 0, 0, 360, 0, 0, 574,
+	0, 0, 0, 333, 0, 0, 262, 0, 0, 0,
+	527, 0, 318, 300, 587, 0, 0, 316, 265, 345,
+	308, 351, 335, 359, 312, 309, 205, 336, 234, 277,
+	216, 218, 230, 237, 239, 241, 242, 289, 290, 303,
+	323, 338, 339, 340, 233, 226, 317, 227, 251, 228,
+	206, 325, 229, 208, 304, 343, 0, 247, 313, 273,
+	209, 272, 305, 342, 341, 217, 367, 373, 374, 378,
+	0, 379, 0, 0, 0, 387, 392, 393, 394, 395,
+	396, 397, 398, 399, 0, 0, 0, 0, 0, 381,
+	0, 0, 0, 0, 0, 0, 372, 245, 202, 203,
+	355, 572, 295, 0, 0, 586, 567, 569, 570, 573,
+	577, 578, 579, 580, 581, 583, 585, 589, 321, 0,
+	0, 0, 0, 0, 256, 302, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	330, 353, 365, 382, 385, 0, 0, 0, 207, 384,
+	0, 0, 0, 0, 0, 0, 0, 588, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 531, 283, 284,
+	285, 286, 287, 288, 575, 0, 224, 383, 311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 377, 244, 250, 391, 252,
+	223, 301, 246, 362, 259, 0, 388, 0, 0, 0,
+	0, 292, 255, 326, 260, 266, 314, 361, 299, 319,
+	221, 352, 327, 270, 0, 0, 597, 571, 596, 598,
+	599, 595, 600, 601, 582, 493, 0, 535, 593, 592,
+	594, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 204, 0, 264, 0, 310, 243,
+	560, 540, 541, 542, 492, 543, 538, 539, 561, 533,
+	557, 558, 516, 536, 544, 556, 545, 559, 562, 563,
+	602, 603, 551, 604, 548, 564, 555, 554, 546, 534,
+	565, 566, 519, 518, 549, 550, 537, 337, 0, 0,
+	368, 369, 370, 390, 354, 0, 235, 0, 298, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 328, 278, 297, 329, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 0, 0, 0, 0, 219, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 1755, 1758,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 334, 350, 220,
+	324, 363, 225, 332, 215, 296, 320, 0, 0, 0,
+	0, 212, 348, 331, 275, 257, 258, 211, 0, 315,
+	236, 249, 232, 294, 0, 347, 375, 231, 366, 0,
+	358, 214, 0, 357, 293, 344, 349, 276, 269, 213,
+	346, 274, 268, 261, 240, 389, 253, 306, 267, 307,
+	254, 281, 280, 282, 0, 0, 0, 0, 0, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 0, 0, 1759, 360, 0, 0,
+	0, 1752, 1745, 1751, 333, 1753, 1756, 262, 0, 0,
+	0, 376, 0, 318, 300, 0, 0, 1743, 316, 265,
+	345, 308, 351, 335, 359, 312, 309, 205, 336, 234,
+	277, 216, 218, 230, 237, 239, 241, 242, 289, 290,
+	303, 323, 338, 339, 340, 233, 226, 317, 227, 251,
+	228, 206, 325, 229, 208, 304, 343, 1757, 247, 313,
+	273, 209, 272, 305, 342, 341, 217, 367, 373, 374,
+	378, 0, 379, 0, 0, 0, 387, 392, 393, 394,
+	395, 396, 397, 398, 399, 0, 0, 0, 0, 0,
+	381, 0, 0, 0, 0, 0, 0, 372, 245, 202,
+	203, 355, 0, 295, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 371, 0, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 256, 302, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 330, 353, 365, 382, 385, 0, 0, 0, 207,
+	384, 0, 0, 0, 0, 0, 0, 0, 356, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 380, 283,
+	284, 285, 286, 287, 288, 248, 0, 224, 383, 311,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 377, 244, 250, 391,
+	252, 223, 301, 246, 362, 259, 0, 388, 0, 0,
+	0, 0, 292, 255, 326, 260, 266, 314, 361, 299,
+	319, 221, 352, 327, 270, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 204, 0, 264, 0, 310,
+	243, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 0, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 0, 0,
+	0, 368, 369, 370, 390, 354, 0, 235, 153, 337,
+	42, 139, 115, 0, 0, 0, 0, 0, 0, 0,
+	298, 409, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 328, 278, 297,
+	329, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
+	0, 161, 0, 0, 0, 0, 0, 0, 219, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 334,
+	350, 220, 324, 363, 225, 332, 215, 296, 320, 0,
+	0, 0, 0, 212, 348, 331, 275, 257, 258, 211,
+	0, 315, 236, 249, 232, 294, 0, 347, 375, 231,
+	366, 0, 358, 214, 0, 357, 293, 344, 349, 276,
+	269, 213, 346, 274, 268, 261, 240, 389, 253, 306,
+	267, 307, 254, 281, 280, 282, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 413, 0, 0, 279, 0, 0, 0, 360,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 262,
+	0, 0, 0, 376, 0, 318, 300, 0, 0, 0,
+	316, 265, 345, 308, 351, 335, 359, 312, 309, 205,
+	336, 234, 277, 216, 218, 230, 237, 239, 241, 242,
+	289, 290, 303, 323, 338, 339, 340, 233, 226, 317,
+	227, 251, 228, 206, 325, 229, 208, 304, 343, 0,
+	247, 313, 273, 209, 272, 305, 342, 341, 217, 367,
+	373, 374, 378, 0, 379, 0, 0, 0, 387, 392,
+	393, 394, 395, 396, 397, 398, 399, 0, 0, 0,
+	0, 0, 381, 0, 0, 0, 0, 0, 0, 372,
+	245, 202, 203, 355, 0, 295, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 371, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 256, 302, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 330, 353, 365, 382, 385, 0, 0,
+	0, 207, 384, 0, 0, 0, 0, 0, 0, 0,
+	356, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	380, 283, 284, 285, 286, 287, 288, 410, 412, 224,
+	383, 311, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 377, 244,
+	250, 391, 252, 223, 301, 246, 362, 259, 0, 388,
+	0, 0, 0, 0, 292, 255, 326, 260, 266, 314,
+	361, 299, 319, 221, 352, 327, 270, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 43, 0, 0,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 204, 0, 264,
+	116, 310, 243, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 0, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	337, 0, 0, 368, 369, 370, 390, 354, 0, 235,
+	0, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 328, 278,
+	297, 329, 271, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 161, 0, 0, 0, 0, 0, 0, 219,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 1755, 1758, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 149, 0, 0, 1464, 0, 0, 0, 207, 150,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 210,
-	0, 0, 799, 800, 801, 798, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 316,
-	332, 208, 307, 345, 213, 314, 203, 280, 303, 0,
-	0, 200, 330, 313, 262, 245, 246, 199, 0, 298,
-	224, 237, 220, 278, 0, 329, 357, 219, 348, 0,
-	340, 202, 0, 339, 277, 326, 331, 263, 257, 201,
-	328, 261, 256, 249, 228, 371, 241, 289, 255, 290,
-	242, 267, 266, 268, 0, 0, 0, 0, 0, 368,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 342, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 250, 0, 0, 0,
-	358, 0, 301, 283, 0, 0, 0, 299, 253, 327,
-	291, 333, 317, 341, 295, 292, 193, 318, 222, 264,
-	204, 206, 218, 225, 227, 229, 230, 273, 274, 286,
-	306, 320, 321, 322, 221, 214, 300, 215, 239, 216,
-	194, 308, 217, 196, 287, 325, 0, 235, 296, 260,
-	197, 259, 288, 324, 323, 205, 349, 355, 356, 360,
-	0, 361, 0, 0, 0, 369, 374, 375, 376, 0,
-	0, 0, 0, 0, 363, 0, 0, 0, 0, 0,
-	0, 354, 233, 190, 191, 337, 0, 279, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 353, 0,
-	0, 0, 0, 304, 0, 0, 0, 0, 0, 244,
-	285, 0, 305, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 312, 335, 347, 364, 367,
-	0, 0, 0, 195, 366, 0, 0, 0, 0, 0,
-	0, 0, 338, 0, 0, 0, 346, 0, 0, 0,
-	0, 0, 362, 269, 270, 271, 272, 236, 0, 212,
-	365, 294, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 359, 232,
-	238, 373, 240, 211, 284, 234, 344, 247, 0, 370,
-	0, 0, 0, 0, 276, 243, 309, 248, 254, 297,
-	343, 282, 302, 209, 334, 310, 258, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 0, 252,
-	0, 293, 231, 153, 154, 155, 156, 157, 158, 159,
-	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
-	170, 171, 172, 173, 174, 0, 175, 176, 177, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
-	319, 0, 0, 350, 351, 352, 372, 336, 0, 223,
-	0, 281, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 226, 681,
-	0, 251, 0, 0, 0, 0, 0, 0, 311, 265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
-	689, 690, 0, 0, 0, 0, 207, 150, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 693, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 316, 332, 208,
-	307, 345, 213, 314, 203, 280, 303, 0, 0, 200,
-	330, 313, 262, 245, 246, 199, 0, 298, 224, 237,
-	220, 278, 0, 329, 357, 219, 348, 663, 340, 202,
-	662, 339, 277, 326, 331, 263, 257, 201, 328, 261,
-	256, 249, 228, 371, 241, 289, 255, 290, 242, 267,
-	266, 268, 0, 0, 0, 0, 0, 368, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 342, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 250, 0, 0, 0, 358, 0,
-	301, 283, 0, 0, 0, 299, 253, 327, 291, 333,
-	317, 341, 679, 292, 193, 318, 222, 264, 204, 206,
-	218, 225, 227, 229, 230, 273, 274, 286, 306, 320,
-	321, 322, 221, 214, 300, 215, 239, 216, 194, 308,
-	217, 196, 287, 325, 0, 235, 296, 260, 197, 259,
-	288, 324, 323, 205, 349, 355, 356, 360, 0, 361,
-	0, 0, 0, 369, 374, 375, 376, 0, 0, 0,
-	0, 0, 363, 0, 0, 0, 0, 0, 0, 354,
-	233, 190, 191, 337, 0, 279, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 353, 0, 0, 0,
-	0, 304, 0, 0, 0, 0, 0, 244, 285, 0,
-	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 312, 335, 347, 364, 367, 0, 0,
-	0, 195, 366, 0, 0, 0, 0, 0, 0, 680,
-	338, 0, 0, 0, 346, 0, 0, 0, 0, 0,
-	683, 269, 270, 271, 272, 236, 0, 212, 365, 294,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 359, 232, 238, 373,
-	240, 211, 284, 234, 344, 247, 0, 370, 0, 0,
-	0, 0, 691, 686, 687, 248, 254, 297, 343, 282,
-	302, 209, 334, 310, 688, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 0, 252, 0, 293,
-	231, 153, 154, 155, 156, 157, 158, 159, 160, 161,
-	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
-	172, 173, 174, 0, 175, 176, 177, 178, 179, 180,
-	181, 182, 183, 184, 185, 186, 187, 188, 141, 319,
-	0, 350, 351, 352, 372, 336, 0, 223, 0, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 97, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 138, 1486, 0, 149, 0,
-	0, 0, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 109, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 141, 319, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 0, 281,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 226, 0, 0, 251,
-	0, 0, 0, 97, 0, 0, 311, 265, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 1477, 0, 149, 0, 0,
-	0, 0, 0, 0, 207, 150, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 210, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 316, 332, 208, 307, 345,
-	213, 314, 203, 280, 303, 0, 0, 200, 330, 313,
-	262, 245, 246, 199, 0, 298, 224, 237, 220, 278,
-	0, 329, 357, 219, 348, 0, 340, 202, 0, 339,
-	277, 326, 331, 263, 257, 201, 328, 261, 256, 249,
-	228, 371, 241, 289, 255, 290, 242, 267, 266, 268,
-	0, 0, 0, 0, 0, 368, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 342, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 250, 0, 0, 0, 358, 0, 301, 283,
-	0, 0, 0, 299, 253, 327, 291, 333, 317, 341,
-	295, 292, 193, 318, 222, 264, 204, 206, 218, 225,
-	227, 229, 230, 273, 274, 286, 306, 320, 321, 322,
-	221, 214, 300, 215, 239, 216, 194, 308, 217, 196,
-	287, 325, 0, 235, 296, 260, 197, 259, 288, 324,
-	323, 205, 349, 355, 356, 360, 0, 361, 0, 0,
-	0, 369, 374, 375, 376, 0, 0, 0, 0, 0,
-	363, 0, 0, 0, 0, 0, 0, 354, 233, 190,
-	191, 337, 0, 279, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 353, 0, 0, 0, 0, 304,
-	0, 0, 0, 0, 0, 244, 285, 0, 305, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 312, 335, 347, 364, 367, 0, 0, 0, 195,
-	366, 0, 0, 0, 0, 0, 0, 0, 338, 0,
-	0, 0, 346, 0, 0, 0, 0, 0, 362, 269,
-	270, 271, 272, 236, 0, 212, 365, 294, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 359, 232, 238, 373, 240, 211,
-	284, 234, 344, 247, 0, 370, 0, 0, 0, 0,
-	276, 243, 309, 248, 254, 297, 343, 282, 302, 209,
-	334, 310, 258, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 0, 252, 109, 293, 231, 153,
-	154, 155, 156, 157, 158, 159, 160, 161, 162, 163,
-	164, 165, 166, 167, 168, 169, 170, 171, 172, 173,
-	174, 0, 175, 176, 177, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 188, 141, 319, 0, 350,
-	351, 352, 372, 336, 0, 223, 0, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 97, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1411, 0, 0, 149, 0, 0, 0,
-	0, 0, 0, 207, 150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 0,
-	329, 357, 219, 348, 0, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	371, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 358, 0, 301, 283, 0,
-	0, 0, 299, 253, 327, 291, 333, 317, 341, 295,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 0, 279, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 353, 0, 0, 0, 0, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 0, 338, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 362, 269, 270,
-	271, 272, 236, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 109, 293, 231, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	0, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 319, 0, 0, 350, 351,
-	352, 372, 336, 0, 223, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	0, 0, 0, 311, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 149, 689, 690, 0, 0, 0,
-	0, 207, 150, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 693, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 0, 329, 357,
-	219, 348, 663, 340, 202, 662, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 371, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 358, 0, 301, 283, 0, 0, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 0,
-	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 353, 0, 0, 0, 0, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 338, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 362, 269, 270, 271, 272,
-	236, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 691, 686, 687,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 688,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 0, 293, 231, 153, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 0, 175,
+	334, 350, 220, 324, 363, 225, 332, 215, 296, 320,
+	0, 0, 0, 0, 212, 348, 331, 275, 257, 258,
+	211, 0, 315, 236, 249, 232, 294, 0, 347, 375,
+	231, 366, 0, 358, 214, 0, 357, 293, 344, 349,
+	276, 269, 213, 346, 274, 268, 261, 240, 389, 253,
+	306, 267, 307, 254, 281, 280, 282, 0, 0, 0,
+	0, 0, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 0, 1759,
+	360, 0, 0, 0, 1752, 1745, 1751, 333, 1753, 1756,
+	262, 0, 0, 0, 376, 0, 318, 300, 0, 0,
+	0, 316, 265, 345, 308, 351, 335, 359, 312, 309,
+	205, 336, 234, 277, 216, 218, 230, 237, 239, 241,
+	242, 289, 290, 303, 323, 338, 339, 340, 233, 226,
+	317, 227, 251, 228, 206, 325, 229, 208, 304, 343,
+	1757, 247, 313, 273, 209, 272, 305, 342, 341, 217,
+	367, 373, 374, 378, 0, 379, 0, 0, 0, 387,
+	392, 393, 394, 395, 396, 397, 398, 399, 0, 0,
+	0, 0, 0, 381, 0, 0, 0, 0, 0, 0,
+	372, 245, 202, 203, 355, 0, 295, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 371, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 256, 302,
+	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 330, 353, 365, 382, 385, 0,
+	0, 0, 207, 384, 0, 0, 0, 0, 0, 0,
+	0, 356, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 380, 283, 284, 285, 286, 287, 288, 248, 0,
+	224, 383, 311, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 377,
+	244, 250, 391, 252, 223, 301, 246, 362, 259, 0,
+	388, 0, 0, 0, 0, 292, 255, 326, 260, 266,
+	314, 361, 299, 319, 221, 352, 327, 270, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 204, 0,
+	264, 0, 310, 243, 165, 166, 167, 168, 169, 170,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 186, 0, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 337, 0, 0, 368, 369, 370, 390, 354, 0,
+	235, 0, 298, 0, 0, 0, 0, 0, 0, 0,
+	938, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 328,
+	278, 297, 329, 271, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 0, 0, 0, 0, 0, 0,
+	219, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 926, 0, 0, 0, 0,
+	210, 334, 350, 220, 324, 363, 225, 332, 215, 296,
+	320, 0, 0, 0, 0, 1683, 1685, 1686, 1687, 1688,
+	1689, 1690, 0, 1694, 1691, 1692, 1693, 294, 0, 1678,
+	1679, 1680, 1681, 924, 1664, 1684, 0, 1665, 293, 1666,
+	1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675, 1676,
+	1682, 306, 267, 307, 254, 281, 280, 282, 949, 951,
+	953, 955, 958, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 0, 0,
+	0, 360, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 262, 0, 0, 0, 1677, 0, 318, 300, 0,
+	0, 0, 316, 265, 345, 308, 351, 335, 359, 312,
+	309, 205, 336, 234, 277, 216, 218, 230, 237, 239,
+	241, 242, 289, 290, 303, 323, 338, 339, 340, 233,
+	226, 317, 227, 251, 228, 206, 325, 229, 208, 304,
+	343, 0, 247, 313, 273, 209, 272, 305, 342, 341,
+	217, 367, 373, 374, 378, 0, 379, 0, 0, 0,
+	387, 392, 393, 394, 395, 396, 397, 398, 399, 0,
+	0, 0, 0, 0, 381, 0, 0, 0, 0, 0,
+	0, 372, 245, 202, 203, 355, 0, 295, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 371, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 256,
+	302, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 330, 353, 365, 382, 385,
+	0, 0, 0, 207, 384, 0, 0, 0, 0, 0,
+	0, 0, 356, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 380, 283, 284, 285, 286, 287, 288, 248,
+	0, 224, 383, 311, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 244, 250, 391, 252, 223, 301, 246, 362, 259,
+	0, 388, 0, 0, 0, 0, 292, 255, 326, 260,
+	266, 314, 361, 299, 319, 221, 352, 327, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	948, 264, 0, 310, 243, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 0, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 337, 0, 0, 368, 369, 370, 390, 354,
+	0, 235, 0, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	328, 278, 297, 329, 271, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 0, 0, 0, 0, 0,
+	0, 219, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 222, 1755, 1758, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 210, 334, 350, 220, 324, 363, 225, 332, 215,
+	296, 320, 0, 0, 0, 0, 212, 348, 331, 275,
+	257, 258, 211, 0, 315, 236, 249, 232, 294, 0,
+	347, 375, 231, 366, 0, 358, 214, 0, 357, 293,
+	344, 349, 276, 269, 213, 346, 274, 268, 261, 240,
+	389, 253, 306, 267, 307, 254, 281, 280, 282, 0,
+	0, 0, 0, 0, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	0, 1759, 360, 0, 0, 0, 1752, 0, 1751, 333,
+	1753, 1756, 262, 0, 0, 0, 376, 0, 318, 300,
+	0, 0, 0, 316, 265, 345, 308, 351, 335, 359,
+	312, 309, 205, 336, 234, 277, 216, 218, 230, 237,
+	239, 241, 242, 289, 290, 303, 323, 338, 339, 340,
+	233, 226, 317, 227, 251, 228, 206, 325, 229, 208,
+	304, 343, 1757, 247, 313, 273, 209, 272, 305, 342,
+	341, 217, 367, 373, 374, 378, 0, 379, 0, 0,
+	0, 387, 392, 393, 394, 395, 396, 397, 398, 399,
+	0, 0, 0, 0, 0, 381, 0, 0, 0, 0,
+	0, 0, 372, 245, 202, 203, 355, 0, 295, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 371,
+	0, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	256, 302, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 330, 353, 365, 382,
+	385, 0, 0, 0, 207, 384, 0, 0, 0, 0,
+	0, 0, 0, 356, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 380, 283, 284, 285, 286, 287, 288,
+	248, 0, 224, 383, 311, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 377, 244, 250, 391, 252, 223, 301, 246, 362,
+	259, 0, 388, 0, 0, 0, 0, 292, 255, 326,
+	260, 266, 314, 361, 299, 319, 221, 352, 327, 270,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	204, 0, 264, 0, 310, 243, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 0, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 337, 0, 0, 368, 369, 370, 390,
+	354, 0, 235, 0, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1824, 0, 0, 0,
+	0, 238, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 328, 278, 297, 329, 271, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 0, 0, 1826, 0,
+	0, 0, 219, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 828, 829, 830, 827,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 334, 350, 220, 324, 363, 225, 332,
+	215, 296, 320, 0, 0, 0, 0, 212, 348, 331,
+	275, 257, 258, 211, 0, 315, 236, 249, 232, 294,
+	0, 347, 375, 231, 366, 0, 358, 214, 0, 357,
+	293, 344, 349, 276, 269, 213, 346, 274, 268, 261,
+	240, 389, 253, 306, 267, 307, 254, 281, 280, 282,
+	0, 0, 0, 0, 0, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	0, 0, 0, 360, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 262, 0, 0, 0, 376, 0, 318,
+	300, 0, 0, 0, 316, 265, 345, 308, 351, 335,
+	359, 312, 309, 205, 336, 234, 277, 216, 218, 230,
+	237, 239, 241, 242, 289, 290, 303, 323, 338, 339,
+	340, 233, 226, 317, 227, 251, 228, 206, 325, 229,
+	208, 304, 343, 0, 247, 313, 273, 209, 272, 305,
+	342, 341, 217, 367, 373, 374, 378, 0, 379, 0,
+	0, 0, 387, 392, 393, 394, 395, 396, 397, 398,
+	399, 0, 0, 0, 0, 0, 381, 0, 0, 0,
+	0, 0, 0, 372, 245, 202, 203, 355, 0, 295,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	371, 0, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 256, 302, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 353, 365,
+	382, 385, 0, 0, 0, 207, 384, 0, 0, 0,
+	0, 0, 0, 0, 356, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 380, 283, 284, 285, 286, 287,
+	288, 248, 0, 224, 383, 311, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 377, 244, 250, 391, 252, 223, 301, 246,
+	362, 259, 0, 388, 0, 0, 0, 0, 292, 255,
+	326, 260, 266, 314, 361, 299, 319, 221, 352, 327,
+	270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 204, 0, 264, 0, 310, 243, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 0,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 337, 0, 0, 368, 369, 370,
+	390, 354, 0, 235, 0, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1864, 0, 0,
+	0, 0, 238, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 328, 278, 297, 329, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 0, 0, 1865,
+	0, 0, 0, 219, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 222, 0, 0, 828, 829, 830,
+	827, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 210, 334, 350, 220, 324, 363, 225,
+	332, 215, 296, 320, 0, 0, 0, 0, 212, 348,
+	331, 275, 257, 258, 211, 0, 315, 236, 249, 232,
+	294, 0, 347, 375, 231, 366, 0, 358, 214, 0,
+	357, 293, 344, 349, 276, 269, 213, 346, 274, 268,
+	261, 240, 389, 253, 306, 267, 307, 254, 281, 280,
+	282, 0, 0, 0, 0, 0, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 0, 0, 360, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 262, 0, 0, 0, 376, 0,
+	318, 300, 0, 0, 0, 316, 265, 345, 308, 351,
+	335, 359, 312, 309, 205, 336, 234, 277, 216, 218,
+	230, 237, 239, 241, 242, 289, 290, 303, 323, 338,
+	339, 340, 233, 226, 317, 227, 251, 228, 206, 325,
+	229, 208, 304, 343, 0, 247, 313, 273, 209, 272,
+	305, 342, 341, 217, 367, 373, 374, 378, 0, 379,
+	0, 0, 0, 387, 392, 393, 394, 395, 396, 397,
+	398, 399, 0, 0, 0, 0, 0, 381, 0, 0,
+	0, 0, 0, 0, 372, 245, 202, 203, 355, 0,
+	295, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 371, 0, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 256, 302, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 353,
+	365, 382, 385, 0, 0, 0, 207, 384, 0, 0,
+	0, 0, 0, 0, 0, 356, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 380, 283, 284, 285, 286,
+	287, 288, 248, 0, 224, 383, 311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 377, 244, 250, 391, 252, 223, 301,
+	246, 362, 259, 0, 388, 0, 0, 0, 0, 292,
+	255, 326, 260, 266, 314, 361, 299, 319, 221, 352,
+	327, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 204, 0, 264, 0, 310, 243, 165, 166,
+	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
+	0, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 337, 0, 0, 368, 369,
+	370, 390, 354, 0, 235, 0, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 328, 278, 297, 329, 271, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 0, 0,
+	0, 0, 0, 0, 219, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 222, 0, 1763, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 334, 350, 220, 324, 363,
+	225, 332, 215, 296, 320, 0, 0, 0, 0, 212,
+	348, 331, 275, 257, 258, 211, 0, 315, 236, 249,
+	232, 294, 0, 347, 375, 231, 366, 0, 358, 214,
+	0, 357, 293, 344, 349, 276, 269, 213, 346, 274,
+	268, 261, 240, 389, 253, 306, 267, 307, 254, 281,
+	280, 282, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 0, 0, 1762, 360, 0, 0, 0, 1767,
+	1764, 0, 333, 0, 1766, 262, 0, 0, 0, 376,
+	0, 318, 300, 0, 0, 1760, 316, 265, 345, 308,
+	351, 335, 359, 312, 309, 205, 336, 234, 277, 216,
+	218, 230, 237, 239, 241, 242, 289, 290, 303, 323,
+	338, 339, 340, 233, 226, 317, 227, 251, 228, 206,
+	325, 229, 208, 304, 343, 0, 247, 313, 273, 209,
+	272, 305, 342, 341, 217, 367, 373, 374, 378, 0,
+	379, 0, 0, 0, 387, 392, 393, 394, 395, 396,
+	397, 398, 399, 0, 0, 0, 0, 0, 381, 0,
+	0, 0, 0, 0, 0, 372, 245, 202, 203, 355,
+	0, 295, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 371, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 256, 302, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 330,
+	353, 365, 382, 385, 0, 0, 0, 207, 384, 0,
+	0, 0, 0, 0, 0, 0, 356, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 380, 283, 284, 285,
+	286, 287, 288, 248, 0, 224, 383, 311, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 244, 250, 391, 252, 223,
+	301, 246, 362, 259, 0, 388, 0, 0, 0, 0,
+	292, 255, 326, 260, 266, 314, 361, 299, 319, 221,
+	352, 327, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 201, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 204, 0, 264, 0, 310, 243, 165,
+	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
 	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 319, 0, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 2011, 0, 0, 0, 0, 0, 0,
-	0, 226, 0, 0, 251, 0, 0, 0, 0, 0,
-	0, 311, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 149, 0, 0, 0, 0, 0, 0, 207,
-	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 0, 329, 357, 219, 348,
-	0, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 371, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 2014,
-	0, 0, 2013, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 358, 0, 301, 283, 0, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 0, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 353,
-	0, 0, 0, 0, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 338, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 362, 269, 270, 271, 272, 236, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 0, 175, 176, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 319, 0, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
-	1057, 0, 251, 0, 0, 0, 0, 0, 0, 311,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 1055, 0, 0, 0, 207, 150, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1053, 0, 0, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 0, 329, 357, 219, 348, 0, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 371, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 358,
-	0, 301, 283, 0, 0, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 0, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 353, 0, 0,
-	0, 0, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 338, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 362, 269, 270, 271, 272, 236, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 0, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 319,
-	0, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 1051, 0,
-	251, 0, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 0,
-	0, 1055, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1053, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 319, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 0, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2596, 0, 149, 530, 0, 0,
-	0, 0, 0, 207, 150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 0,
-	329, 357, 219, 348, 0, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	371, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 358, 0, 301, 283, 0,
-	0, 0, 299, 253, 327, 291, 333, 317, 341, 295,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 0, 279, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 353, 0, 0, 0, 0, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 0, 338, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 362, 269, 270,
-	271, 272, 236, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 0, 293, 231, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 162, 163, 164,
+	186, 0, 187, 188, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 337, 0, 0, 368,
+	369, 370, 390, 354, 0, 235, 0, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 713, 0, 263, 0, 0,
+	0, 0, 0, 0, 328, 278, 297, 329, 271, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 721,
+	722, 0, 0, 0, 0, 219, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 725, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 210, 334, 350, 220, 324,
+	363, 225, 332, 215, 296, 320, 0, 0, 0, 0,
+	212, 348, 331, 275, 257, 258, 211, 0, 315, 236,
+	249, 232, 294, 0, 347, 375, 231, 366, 691, 358,
+	214, 690, 357, 293, 344, 349, 276, 269, 213, 346,
+	274, 268, 261, 240, 389, 253, 306, 267, 307, 254,
+	281, 280, 282, 0, 0, 0, 0, 0, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 0, 0, 360, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 262, 0, 0, 0,
+	376, 0, 318, 300, 0, 0, 0, 316, 265, 345,
+	308, 351, 335, 359, 711, 309, 205, 336, 234, 277,
+	216, 218, 230, 237, 239, 241, 242, 289, 290, 303,
+	323, 338, 339, 340, 233, 226, 317, 227, 251, 228,
+	206, 325, 229, 208, 304, 343, 0, 247, 313, 273,
+	209, 272, 305, 342, 341, 217, 367, 373, 374, 378,
+	0, 379, 0, 0, 0, 387, 392, 393, 394, 395,
+	396, 397, 398, 399, 0, 0, 0, 0, 0, 381,
+	0, 0, 0, 0, 0, 0, 372, 245, 202, 203,
+	355, 0, 295, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 371, 0, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 256, 302, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	330, 353, 365, 382, 385, 0, 0, 0, 207, 384,
+	0, 0, 0, 0, 0, 0, 712, 356, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 715, 283, 284,
+	285, 286, 287, 288, 248, 0, 224, 383, 311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 377, 244, 250, 391, 252,
+	223, 301, 246, 362, 259, 0, 388, 0, 0, 0,
+	0, 723, 718, 719, 260, 266, 314, 361, 299, 319,
+	221, 352, 327, 720, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 204, 0, 264, 0, 310, 243,
 	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	0, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 319, 0, 0, 350, 351,
-	352, 372, 336, 0, 223, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	0, 0, 0, 311, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 149, 0, 0, 1055, 0, 0,
-	0, 207, 150, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2339, 0, 0, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 0, 329, 357,
-	219, 348, 0, 340, 202, 0, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 371, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 358, 0, 301, 283, 0, 0, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 0,
-	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 353, 0, 0, 0, 0, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 338, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 362, 269, 270, 271, 272,
-	236, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 276, 243, 309,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 0, 293, 231, 153, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 0, 175,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 0, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 337, 0, 0,
+	368, 369, 370, 390, 354, 0, 235, 0, 298, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 328, 278, 297, 329, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 0, 0, 0, 0, 219, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 0, 1763,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 334, 350, 220,
+	324, 363, 225, 332, 215, 296, 320, 0, 0, 0,
+	0, 212, 348, 331, 275, 257, 258, 211, 0, 315,
+	236, 249, 232, 294, 0, 347, 375, 231, 366, 0,
+	358, 214, 0, 357, 293, 344, 349, 276, 269, 213,
+	346, 274, 268, 261, 240, 389, 253, 306, 267, 307,
+	254, 281, 280, 282, 0, 0, 0, 0, 0, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 0, 0, 1762, 360, 0, 0,
+	0, 1767, 1764, 0, 333, 0, 1766, 262, 0, 0,
+	0, 376, 0, 318, 300, 0, 0, 0, 316, 265,
+	345, 308, 351, 335, 359, 312, 309, 205, 336, 234,
+	277, 216, 218, 230, 237, 239, 241, 242, 289, 290,
+	303, 323, 338, 339, 340, 233, 226, 317, 227, 251,
+	228, 206, 325, 229, 208, 304, 343, 0, 247, 313,
+	273, 209, 272, 305, 342, 341, 217, 367, 373, 374,
+	378, 0, 379, 0, 0, 0, 387, 392, 393, 394,
+	395, 396, 397, 398, 399, 0, 0, 0, 0, 0,
+	381, 0, 0, 0, 0, 0, 0, 372, 245, 202,
+	203, 355, 0, 295, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 371, 0, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 256, 302, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 330, 353, 365, 382, 385, 0, 0, 0, 207,
+	384, 0, 0, 0, 0, 0, 0, 0, 356, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 380, 283,
+	284, 285, 286, 287, 288, 248, 0, 224, 383, 311,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 377, 244, 250, 391,
+	252, 223, 301, 246, 362, 259, 0, 388, 0, 0,
+	0, 0, 292, 255, 326, 260, 266, 314, 361, 299,
+	319, 221, 352, 327, 270, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 204, 0, 264, 0, 310,
+	243, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 0, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 153, 337,
+	0, 368, 369, 370, 390, 354, 0, 235, 0, 0,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	263, 0, 0, 0, 104, 0, 0, 328, 278, 297,
+	329, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 1539,
+	0, 161, 0, 0, 0, 0, 0, 0, 219, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 334,
+	350, 220, 324, 363, 225, 332, 215, 296, 320, 0,
+	0, 0, 0, 212, 348, 331, 275, 257, 258, 211,
+	0, 315, 236, 249, 232, 294, 0, 347, 375, 231,
+	366, 0, 358, 214, 0, 357, 293, 344, 349, 276,
+	269, 213, 346, 274, 268, 261, 240, 389, 253, 306,
+	267, 307, 254, 281, 280, 282, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 0, 0, 0, 360,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 262,
+	0, 0, 0, 376, 0, 318, 300, 0, 0, 0,
+	316, 265, 345, 308, 351, 335, 359, 312, 309, 205,
+	336, 234, 277, 216, 218, 230, 237, 239, 241, 242,
+	289, 290, 303, 323, 338, 339, 340, 233, 226, 317,
+	227, 251, 228, 206, 325, 229, 208, 304, 343, 0,
+	247, 313, 273, 209, 272, 305, 342, 341, 217, 367,
+	373, 374, 378, 0, 379, 0, 0, 0, 387, 392,
+	393, 394, 395, 396, 397, 398, 399, 0, 0, 0,
+	0, 0, 381, 0, 0, 0, 0, 0, 0, 372,
+	245, 202, 203, 355, 0, 295, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 371, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 256, 302, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 330, 353, 365, 382, 385, 0, 0,
+	0, 207, 384, 0, 0, 0, 0, 0, 0, 0,
+	356, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	380, 283, 284, 285, 286, 287, 288, 248, 0, 224,
+	383, 311, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 377, 244,
+	250, 391, 252, 223, 301, 246, 362, 259, 0, 388,
+	0, 0, 0, 0, 292, 255, 326, 260, 266, 314,
+	361, 299, 319, 221, 352, 327, 270, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 204, 0, 264,
+	116, 310, 243, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 0, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	153, 337, 0, 368, 369, 370, 390, 354, 0, 235,
+	0, 0, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 263, 0, 0, 0, 104, 0, 0, 328,
+	278, 297, 329, 271, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 1530, 0, 161, 0, 0, 0, 0, 0, 0,
+	219, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	210, 334, 350, 220, 324, 363, 225, 332, 215, 296,
+	320, 0, 0, 0, 0, 212, 348, 331, 275, 257,
+	258, 211, 0, 315, 236, 249, 232, 294, 0, 347,
+	375, 231, 366, 0, 358, 214, 0, 357, 293, 344,
+	349, 276, 269, 213, 346, 274, 268, 261, 240, 389,
+	253, 306, 267, 307, 254, 281, 280, 282, 0, 0,
+	0, 0, 0, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 0, 0,
+	0, 360, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 262, 0, 0, 0, 376, 0, 318, 300, 0,
+	0, 0, 316, 265, 345, 308, 351, 335, 359, 312,
+	309, 205, 336, 234, 277, 216, 218, 230, 237, 239,
+	241, 242, 289, 290, 303, 323, 338, 339, 340, 233,
+	226, 317, 227, 251, 228, 206, 325, 229, 208, 304,
+	343, 0, 247, 313, 273, 209, 272, 305, 342, 341,
+	217, 367, 373, 374, 378, 0, 379, 0, 0, 0,
+	387, 392, 393, 394, 395, 396, 397, 398, 399, 0,
+	0, 0, 0, 0, 381, 0, 0, 0, 0, 0,
+	0, 372, 245, 202, 203, 355, 0, 295, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 371, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 256,
+	302, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 330, 353, 365, 382, 385,
+	0, 0, 0, 207, 384, 0, 0, 0, 0, 0,
+	0, 0, 356, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 380, 283, 284, 285, 286, 287, 288, 248,
+	0, 224, 383, 311, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 244, 250, 391, 252, 223, 301, 246, 362, 259,
+	0, 388, 0, 0, 0, 0, 292, 255, 326, 260,
+	266, 314, 361, 299, 319, 221, 352, 327, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	0, 264, 116, 310, 243, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 0, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 153, 337, 0, 368, 369, 370, 390, 354,
+	0, 235, 0, 0, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 238, 0, 0, 263, 0, 0, 0, 104, 0,
+	0, 328, 278, 297, 329, 271, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1463, 0, 0, 161, 0, 0, 0, 0,
+	0, 0, 219, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 334, 350, 220, 324, 363, 225, 332,
+	215, 296, 320, 0, 0, 0, 0, 212, 348, 331,
+	275, 257, 258, 211, 0, 315, 236, 249, 232, 294,
+	0, 347, 375, 231, 366, 0, 358, 214, 0, 357,
+	293, 344, 349, 276, 269, 213, 346, 274, 268, 261,
+	240, 389, 253, 306, 267, 307, 254, 281, 280, 282,
+	0, 0, 0, 0, 0, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	0, 0, 0, 360, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 262, 0, 0, 0, 376, 0, 318,
+	300, 0, 0, 0, 316, 265, 345, 308, 351, 335,
+	359, 312, 309, 205, 336, 234, 277, 216, 218, 230,
+	237, 239, 241, 242, 289, 290, 303, 323, 338, 339,
+	340, 233, 226, 317, 227, 251, 228, 206, 325, 229,
+	208, 304, 343, 0, 247, 313, 273, 209, 272, 305,
+	342, 341, 217, 367, 373, 374, 378, 0, 379, 0,
+	0, 0, 387, 392, 393, 394, 395, 396, 397, 398,
+	399, 0, 0, 0, 0, 0, 381, 0, 0, 0,
+	0, 0, 0, 372, 245, 202, 203, 355, 0, 295,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	371, 0, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 256, 302, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 353, 365,
+	382, 385, 0, 0, 0, 207, 384, 0, 0, 0,
+	0, 0, 0, 0, 356, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 380, 283, 284, 285, 286, 287,
+	288, 248, 0, 224, 383, 311, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 377, 244, 250, 391, 252, 223, 301, 246,
+	362, 259, 0, 388, 0, 0, 0, 0, 292, 255,
+	326, 260, 266, 314, 361, 299, 319, 221, 352, 327,
+	270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 204, 0, 264, 116, 310, 243, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 0,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 337, 0, 0, 368, 369, 370,
+	390, 354, 0, 235, 0, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 328, 278, 297, 329, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 721, 722, 0,
+	0, 0, 0, 219, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 725, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 210, 334, 350, 220, 324, 363, 225,
+	332, 215, 296, 320, 0, 0, 0, 0, 212, 348,
+	331, 275, 257, 258, 211, 0, 315, 236, 249, 232,
+	294, 0, 347, 375, 231, 366, 691, 358, 214, 690,
+	357, 293, 344, 349, 276, 269, 213, 346, 274, 268,
+	261, 240, 389, 253, 306, 267, 307, 254, 281, 280,
+	282, 0, 0, 0, 0, 0, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 0, 0, 360, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 262, 0, 0, 0, 376, 0,
+	318, 300, 0, 0, 0, 316, 265, 345, 308, 351,
+	335, 359, 312, 309, 205, 336, 234, 277, 216, 218,
+	230, 237, 239, 241, 242, 289, 290, 303, 323, 338,
+	339, 340, 233, 226, 317, 227, 251, 228, 206, 325,
+	229, 208, 304, 343, 0, 247, 313, 273, 209, 272,
+	305, 342, 341, 217, 367, 373, 374, 378, 0, 379,
+	0, 0, 0, 387, 392, 393, 394, 395, 396, 397,
+	398, 399, 0, 0, 0, 0, 0, 381, 0, 0,
+	0, 0, 0, 0, 372, 245, 202, 203, 355, 0,
+	295, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 371, 0, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 256, 302, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 353,
+	365, 382, 385, 0, 0, 0, 207, 384, 0, 0,
+	0, 0, 0, 0, 0, 356, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 380, 283, 284, 285, 286,
+	287, 288, 248, 0, 224, 383, 311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 377, 244, 250, 391, 252, 223, 301,
+	246, 362, 259, 0, 388, 0, 0, 0, 0, 723,
+	718, 719, 260, 266, 314, 361, 299, 319, 221, 352,
+	327, 720, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 204, 0, 264, 0, 310, 243, 165, 166,
+	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
+	0, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 337, 0, 0, 368, 369,
+	370, 390, 354, 0, 235, 0, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 2107, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 328, 278, 297, 329, 271, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 0, 0,
+	0, 0, 0, 0, 219, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 334, 350, 220, 324, 363,
+	225, 332, 215, 296, 320, 0, 0, 0, 0, 212,
+	348, 331, 275, 257, 258, 211, 0, 315, 236, 249,
+	232, 294, 0, 347, 375, 231, 366, 0, 358, 214,
+	0, 357, 293, 344, 349, 276, 269, 213, 346, 274,
+	268, 261, 240, 389, 253, 306, 267, 307, 254, 281,
+	280, 282, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 2110, 0, 0, 2109,
+	0, 279, 0, 0, 0, 360, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 262, 0, 0, 0, 376,
+	0, 318, 300, 0, 0, 0, 316, 265, 345, 308,
+	351, 335, 359, 312, 309, 205, 336, 234, 277, 216,
+	218, 230, 237, 239, 241, 242, 289, 290, 303, 323,
+	338, 339, 340, 233, 226, 317, 227, 251, 228, 206,
+	325, 229, 208, 304, 343, 0, 247, 313, 273, 209,
+	272, 305, 342, 341, 217, 367, 373, 374, 378, 0,
+	379, 0, 0, 0, 387, 392, 393, 394, 395, 396,
+	397, 398, 399, 0, 0, 0, 0, 0, 381, 0,
+	0, 0, 0, 0, 0, 372, 245, 202, 203, 355,
+	0, 295, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 371, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 256, 302, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 330,
+	353, 365, 382, 385, 0, 0, 0, 207, 384, 0,
+	0, 0, 0, 0, 0, 0, 356, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 380, 283, 284, 285,
+	286, 287, 288, 248, 0, 224, 383, 311, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 244, 250, 391, 252, 223,
+	301, 246, 362, 259, 0, 388, 0, 0, 0, 0,
+	292, 255, 326, 260, 266, 314, 361, 299, 319, 221,
+	352, 327, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 201, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 204, 0, 264, 0, 310, 243, 165,
+	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
 	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 319, 0, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 226, 0, 0, 251, 0, 0, 0, 0, 0,
-	0, 311, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 149, 0, 0, 1055, 0, 0, 0, 207,
-	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1053, 0, 0, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 0, 329, 357, 219, 348,
-	0, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 371, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 358, 0, 301, 283, 0, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 0, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 353,
-	0, 0, 0, 0, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 338, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 362, 269, 270, 271, 272, 236, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 0, 175, 176, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 319, 0, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1754, 0, 0, 0, 0, 226,
-	0, 0, 251, 0, 0, 0, 0, 0, 0, 311,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 1756, 0, 0, 0, 207, 150, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 0, 329, 357, 219, 348, 0, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 371, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 358,
-	0, 301, 283, 0, 0, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 0, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 353, 0, 0,
-	0, 0, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 338, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 362, 269, 270, 271, 272, 236, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 0, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 319,
-	0, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 1773, 0,
-	251, 0, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 0,
-	0, 1055, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 319, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 0, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2675, 0, 149, 0, 0, 0,
-	0, 0, 0, 207, 150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 0,
-	329, 357, 219, 348, 0, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	371, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 358, 0, 301, 283, 0,
-	0, 0, 299, 253, 327, 291, 333, 317, 341, 295,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 0, 279, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 353, 0, 0, 0, 0, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 0, 338, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 362, 269, 270,
-	271, 272, 236, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 0, 293, 231, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 162, 163, 164,
+	186, 0, 187, 188, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 337, 0, 0, 368,
+	369, 370, 390, 354, 0, 235, 0, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 1093, 0, 263, 0, 0,
+	0, 0, 0, 0, 328, 278, 297, 329, 271, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
+	0, 1091, 0, 0, 0, 219, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1089, 0, 0, 0, 0, 210, 334, 350, 220, 324,
+	363, 225, 332, 215, 296, 320, 0, 0, 0, 0,
+	212, 348, 331, 275, 257, 258, 211, 0, 315, 236,
+	249, 232, 294, 0, 347, 375, 231, 366, 0, 358,
+	214, 0, 357, 293, 344, 349, 276, 269, 213, 346,
+	274, 268, 261, 240, 389, 253, 306, 267, 307, 254,
+	281, 280, 282, 0, 0, 0, 0, 0, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 0, 0, 360, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 262, 0, 0, 0,
+	376, 0, 318, 300, 0, 0, 0, 316, 265, 345,
+	308, 351, 335, 359, 312, 309, 205, 336, 234, 277,
+	216, 218, 230, 237, 239, 241, 242, 289, 290, 303,
+	323, 338, 339, 340, 233, 226, 317, 227, 251, 228,
+	206, 325, 229, 208, 304, 343, 0, 247, 313, 273,
+	209, 272, 305, 342, 341, 217, 367, 373, 374, 378,
+	0, 379, 0, 0, 0, 387, 392, 393, 394, 395,
+	396, 397, 398, 399, 0, 0, 0, 0, 0, 381,
+	0, 0, 0, 0, 0, 0, 372, 245, 202, 203,
+	355, 0, 295, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 371, 0, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 256, 302, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	330, 353, 365, 382, 385, 0, 0, 0, 207, 384,
+	0, 0, 0, 0, 0, 0, 0, 356, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 380, 283, 284,
+	285, 286, 287, 288, 248, 0, 224, 383, 311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 377, 244, 250, 391, 252,
+	223, 301, 246, 362, 259, 0, 388, 0, 0, 0,
+	0, 292, 255, 326, 260, 266, 314, 361, 299, 319,
+	221, 352, 327, 270, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 204, 0, 264, 0, 310, 243,
 	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	0, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 319, 0, 0, 350, 351,
-	352, 372, 336, 0, 223, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	0, 0, 0, 311, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 149, 530, 0, 0, 0, 0,
-	0, 207, 150, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 0, 329, 357,
-	219, 348, 0, 340, 202, 0, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 371, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 358, 0, 301, 283, 0, 0, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 0,
-	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 353, 0, 0, 0, 0, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 338, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 362, 269, 270, 271, 272,
-	236, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 276, 243, 309,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 0, 293, 231, 153, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 0, 175,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 0, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 337, 0, 0,
+	368, 369, 370, 390, 354, 0, 235, 0, 298, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 1087, 0, 263, 0,
+	0, 0, 0, 0, 0, 328, 278, 297, 329, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 1091, 0, 0, 0, 219, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1089, 0, 0, 0, 0, 210, 334, 350, 220,
+	324, 363, 225, 332, 215, 296, 320, 0, 0, 0,
+	0, 212, 348, 331, 275, 257, 258, 211, 0, 315,
+	236, 249, 232, 294, 0, 347, 375, 231, 366, 0,
+	358, 214, 0, 357, 293, 344, 349, 276, 269, 213,
+	346, 274, 268, 261, 240, 389, 253, 306, 267, 307,
+	254, 281, 280, 282, 0, 0, 0, 0, 0, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 0, 0, 0, 360, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 262, 0, 0,
+	0, 376, 0, 318, 300, 0, 0, 0, 316, 265,
+	345, 308, 351, 335, 359, 312, 309, 205, 336, 234,
+	277, 216, 218, 230, 237, 239, 241, 242, 289, 290,
+	303, 323, 338, 339, 340, 233, 226, 317, 227, 251,
+	228, 206, 325, 229, 208, 304, 343, 0, 247, 313,
+	273, 209, 272, 305, 342, 341, 217, 367, 373, 374,
+	378, 0, 379, 0, 0, 0, 387, 392, 393, 394,
+	395, 396, 397, 398, 399, 0, 0, 0, 0, 0,
+	381, 0, 0, 0, 0, 0, 0, 372, 245, 202,
+	203, 355, 0, 295, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 371, 0, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 256, 302, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 330, 353, 365, 382, 385, 0, 0, 0, 207,
+	384, 0, 0, 0, 0, 0, 0, 0, 356, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 380, 283,
+	284, 285, 286, 287, 288, 248, 0, 224, 383, 311,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 377, 244, 250, 391,
+	252, 223, 301, 246, 362, 259, 0, 388, 0, 0,
+	0, 0, 292, 255, 326, 260, 266, 314, 361, 299,
+	319, 221, 352, 327, 270, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 204, 0, 264, 0, 310,
+	243, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 0, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 337, 0,
+	0, 368, 369, 370, 390, 354, 0, 235, 0, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 328, 278, 297, 329,
+	271, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2799, 0,
+	161, 553, 0, 0, 0, 0, 0, 219, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 210, 334, 350,
+	220, 324, 363, 225, 332, 215, 296, 320, 0, 0,
+	0, 0, 212, 348, 331, 275, 257, 258, 211, 0,
+	315, 236, 249, 232, 294, 0, 347, 375, 231, 366,
+	0, 358, 214, 0, 357, 293, 344, 349, 276, 269,
+	213, 346, 274, 268, 261, 240, 389, 253, 306, 267,
+	307, 254, 281, 280, 282, 0, 0, 0, 0, 0,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 0, 0, 360, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 262, 0,
+	0, 0, 376, 0, 318, 300, 0, 0, 0, 316,
+	265, 345, 308, 351, 335, 359, 312, 309, 205, 336,
+	234, 277, 216, 218, 230, 237, 239, 241, 242, 289,
+	290, 303, 323, 338, 339, 340, 233, 226, 317, 227,
+	251, 228, 206, 325, 229, 208, 304, 343, 0, 247,
+	313, 273, 209, 272, 305, 342, 341, 217, 367, 373,
+	374, 378, 0, 379, 0, 0, 0, 387, 392, 393,
+	394, 395, 396, 397, 398, 399, 0, 0, 0, 0,
+	0, 381, 0, 0, 0, 0, 0, 0, 372, 245,
+	202, 203, 355, 0, 295, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 371, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 256, 302, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 330, 353, 365, 382, 385, 0, 0, 0,
+	207, 384, 0, 0, 0, 0, 0, 0, 0, 356,
+	0, 0, 0, 364, 0, 0, 0, 0, 0, 380,
+	283, 284, 285, 286, 287, 288, 248, 0, 224, 383,
+	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 377, 244, 250,
+	391, 252, 223, 301, 246, 362, 259, 0, 388, 0,
+	0, 0, 0, 292, 255, 326, 260, 266, 314, 361,
+	299, 319, 221, 352, 327, 270, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 204, 0, 264, 0,
+	310, 243, 165, 166, 167, 168, 169, 170, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 186, 0, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 337,
+	0, 0, 368, 369, 370, 390, 354, 0, 235, 0,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 328, 278, 297,
+	329, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 0, 0, 1091, 0, 0, 0, 219, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2476, 0, 0, 0, 0, 210, 334,
+	350, 220, 324, 363, 225, 332, 215, 296, 320, 0,
+	0, 0, 0, 212, 348, 331, 275, 257, 258, 211,
+	0, 315, 236, 249, 232, 294, 0, 347, 375, 231,
+	366, 0, 358, 214, 0, 357, 293, 344, 349, 276,
+	269, 213, 346, 274, 268, 261, 240, 389, 253, 306,
+	267, 307, 254, 281, 280, 282, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 0, 0, 0, 360,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 262,
+	0, 0, 0, 376, 0, 318, 300, 0, 0, 0,
+	316, 265, 345, 308, 351, 335, 359, 312, 309, 205,
+	336, 234, 277, 216, 218, 230, 237, 239, 241, 242,
+	289, 290, 303, 323, 338, 339, 340, 233, 226, 317,
+	227, 251, 228, 206, 325, 229, 208, 304, 343, 0,
+	247, 313, 273, 209, 272, 305, 342, 341, 217, 367,
+	373, 374, 378, 0, 379, 0, 0, 0, 387, 392,
+	393, 394, 395, 396, 397, 398, 399, 0, 0, 0,
+	0, 0, 381, 0, 0, 0, 0, 0, 0, 372,
+	245, 202, 203, 355, 0, 295, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 371, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 256, 302, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 330, 353, 365, 382, 385, 0, 0,
+	0, 207, 384, 0, 0, 0, 0, 0, 0, 0,
+	356, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	380, 283, 284, 285, 286, 287, 288, 248, 0, 224,
+	383, 311, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 377, 244,
+	250, 391, 252, 223, 301, 246, 362, 259, 0, 388,
+	0, 0, 0, 0, 292, 255, 326, 260, 266, 314,
+	361, 299, 319, 221, 352, 327, 270, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 204, 0, 264,
+	0, 310, 243, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 0, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	337, 0, 0, 368, 369, 370, 390, 354, 0, 235,
+	0, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 328, 278,
+	297, 329, 271, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 161, 0, 0, 1091, 0, 0, 0, 219,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1089, 0, 0, 0, 0, 210,
+	334, 350, 220, 324, 363, 225, 332, 215, 296, 320,
+	0, 0, 0, 0, 212, 348, 331, 275, 257, 258,
+	211, 0, 315, 236, 249, 232, 294, 0, 347, 375,
+	231, 366, 0, 358, 214, 0, 357, 293, 344, 349,
+	276, 269, 213, 346, 274, 268, 261, 240, 389, 253,
+	306, 267, 307, 254, 281, 280, 282, 0, 0, 0,
+	0, 0, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 0, 0,
+	360, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	262, 0, 0, 0, 376, 0, 318, 300, 0, 0,
+	0, 316, 265, 345, 308, 351, 335, 359, 312, 309,
+	205, 336, 234, 277, 216, 218, 230, 237, 239, 241,
+	242, 289, 290, 303, 323, 338, 339, 340, 233, 226,
+	317, 227, 251, 228, 206, 325, 229, 208, 304, 343,
+	0, 247, 313, 273, 209, 272, 305, 342, 341, 217,
+	367, 373, 374, 378, 0, 379, 0, 0, 0, 387,
+	392, 393, 394, 395, 396, 397, 398, 399, 0, 0,
+	0, 0, 0, 381, 0, 0, 0, 0, 0, 0,
+	372, 245, 202, 203, 355, 0, 295, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 371, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 256, 302,
+	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 330, 353, 365, 382, 385, 0,
+	0, 0, 207, 384, 0, 0, 0, 0, 0, 0,
+	0, 356, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 380, 283, 284, 285, 286, 287, 288, 248, 0,
+	224, 383, 311, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 377,
+	244, 250, 391, 252, 223, 301, 246, 362, 259, 0,
+	388, 0, 0, 0, 0, 292, 255, 326, 260, 266,
+	314, 361, 299, 319, 221, 352, 327, 270, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 204, 0,
+	264, 0, 310, 243, 165, 166, 167, 168, 169, 170,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 186, 0, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 337, 0, 0, 368, 369, 370, 390, 354, 0,
+	235, 0, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1824, 0, 0, 0, 0, 238,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 328,
+	278, 297, 329, 271, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 0, 0, 1826, 0, 0, 0,
+	219, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	210, 334, 350, 220, 324, 363, 225, 332, 215, 296,
+	320, 0, 0, 0, 0, 212, 348, 331, 275, 257,
+	258, 211, 0, 315, 236, 249, 232, 294, 0, 347,
+	375, 231, 366, 0, 358, 214, 0, 357, 293, 344,
+	349, 276, 269, 213, 346, 274, 268, 261, 240, 389,
+	253, 306, 267, 307, 254, 281, 280, 282, 0, 0,
+	0, 0, 0, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 0, 0,
+	0, 360, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 262, 0, 0, 0, 376, 0, 318, 300, 0,
+	0, 0, 316, 265, 345, 308, 351, 335, 359, 312,
+	309, 205, 336, 234, 277, 216, 218, 230, 237, 239,
+	241, 242, 289, 290, 303, 323, 338, 339, 340, 233,
+	226, 317, 227, 251, 228, 206, 325, 229, 208, 304,
+	343, 0, 247, 313, 273, 209, 272, 305, 342, 341,
+	217, 367, 373, 374, 378, 0, 379, 0, 0, 0,
+	387, 392, 393, 394, 395, 396, 397, 398, 399, 0,
+	0, 0, 0, 0, 381, 0, 0, 0, 0, 0,
+	0, 372, 245, 202, 203, 355, 0, 295, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 371, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 256,
+	302, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 330, 353, 365, 382, 385,
+	0, 0, 0, 207, 384, 0, 0, 0, 0, 0,
+	0, 0, 356, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 380, 283, 284, 285, 286, 287, 288, 248,
+	0, 224, 383, 311, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 244, 250, 391, 252, 223, 301, 246, 362, 259,
+	0, 388, 0, 0, 0, 0, 292, 255, 326, 260,
+	266, 314, 361, 299, 319, 221, 352, 327, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	0, 264, 0, 310, 243, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 0, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 337, 0, 0, 368, 369, 370, 390, 354,
+	0, 235, 0, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	328, 278, 297, 329, 271, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 0, 0, 1826, 0, 0,
+	0, 219, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 210, 334, 350, 220, 324, 363, 225, 332, 215,
+	296, 320, 0, 0, 0, 2139, 212, 348, 331, 275,
+	257, 258, 211, 0, 315, 236, 249, 232, 294, 0,
+	347, 375, 231, 366, 0, 358, 214, 0, 357, 293,
+	344, 349, 276, 269, 213, 346, 274, 268, 261, 240,
+	389, 253, 306, 267, 307, 254, 281, 280, 282, 0,
+	0, 0, 0, 0, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	0, 0, 360, 0, 0, 0, 0, 0, 0, 333,
+	0, 0, 262, 0, 0, 0, 376, 0, 318, 300,
+	0, 0, 0, 316, 265, 345, 308, 351, 335, 359,
+	312, 309, 205, 336, 234, 277, 216, 218, 230, 237,
+	239, 241, 242, 289, 290, 303, 323, 338, 339, 340,
+	233, 226, 317, 227, 251, 228, 206, 325, 229, 208,
+	304, 343, 0, 247, 313, 273, 209, 272, 305, 342,
+	341, 217, 367, 373, 374, 378, 0, 379, 0, 0,
+	0, 387, 392, 393, 394, 395, 396, 397, 398, 399,
+	0, 0, 0, 0, 0, 381, 0, 0, 0, 0,
+	0, 0, 372, 245, 202, 203, 355, 0, 295, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 371,
+	0, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	256, 302, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 330, 353, 365, 382,
+	385, 0, 0, 0, 207, 384, 0, 0, 0, 0,
+	0, 0, 0, 356, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 380, 283, 284, 285, 286, 287, 288,
+	248, 0, 224, 383, 311, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 377, 244, 250, 391, 252, 223, 301, 246, 362,
+	259, 0, 388, 0, 0, 0, 0, 292, 255, 326,
+	260, 266, 314, 361, 299, 319, 221, 352, 327, 270,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	204, 0, 264, 0, 310, 243, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 0, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 337, 0, 0, 368, 369, 370, 390,
+	354, 0, 235, 0, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 238, 1845, 0, 263, 0, 0, 0, 0, 0,
+	0, 328, 278, 297, 329, 271, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 0, 0, 1091, 0,
+	0, 0, 219, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 334, 350, 220, 324, 363, 225, 332,
+	215, 296, 320, 0, 0, 0, 0, 212, 348, 331,
+	275, 257, 258, 211, 0, 315, 236, 249, 232, 294,
+	0, 347, 375, 231, 366, 0, 358, 214, 0, 357,
+	293, 344, 349, 276, 269, 213, 346, 274, 268, 261,
+	240, 389, 253, 306, 267, 307, 254, 281, 280, 282,
+	0, 0, 0, 0, 0, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	0, 0, 0, 360, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 262, 0, 0, 0, 376, 0, 318,
+	300, 0, 0, 0, 316, 265, 345, 308, 351, 335,
+	359, 312, 309, 205, 336, 234, 277, 216, 218, 230,
+	237, 239, 241, 242, 289, 290, 303, 323, 338, 339,
+	340, 233, 226, 317, 227, 251, 228, 206, 325, 229,
+	208, 304, 343, 0, 247, 313, 273, 209, 272, 305,
+	342, 341, 217, 367, 373, 374, 378, 0, 379, 0,
+	0, 0, 387, 392, 393, 394, 395, 396, 397, 398,
+	399, 0, 0, 0, 0, 0, 381, 0, 0, 0,
+	0, 0, 0, 372, 245, 202, 203, 355, 0, 295,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	371, 0, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 256, 302, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 353, 365,
+	382, 385, 0, 0, 0, 207, 384, 0, 0, 0,
+	0, 0, 0, 0, 356, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 380, 283, 284, 285, 286, 287,
+	288, 248, 0, 224, 383, 311, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 377, 244, 250, 391, 252, 223, 301, 246,
+	362, 259, 0, 388, 0, 0, 0, 0, 292, 255,
+	326, 260, 266, 314, 361, 299, 319, 221, 352, 327,
+	270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 204, 0, 264, 0, 310, 243, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 0,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 337, 0, 0, 368, 369, 370,
+	390, 354, 0, 235, 0, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1832, 0, 0,
+	0, 0, 238, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 328, 278, 297, 329, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 0, 0, 1826,
+	0, 0, 0, 219, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 210, 334, 350, 220, 324, 363, 225,
+	332, 215, 296, 320, 0, 0, 0, 0, 212, 348,
+	331, 275, 257, 258, 211, 0, 315, 236, 249, 232,
+	294, 0, 347, 375, 231, 366, 0, 358, 214, 0,
+	357, 293, 344, 349, 276, 269, 213, 346, 274, 268,
+	261, 240, 389, 253, 306, 267, 307, 254, 281, 280,
+	282, 0, 0, 0, 0, 0, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 0, 0, 360, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 262, 0, 0, 0, 376, 0,
+	318, 300, 0, 0, 0, 316, 265, 345, 308, 351,
+	335, 359, 312, 309, 205, 336, 234, 277, 216, 218,
+	230, 237, 239, 241, 242, 289, 290, 303, 323, 338,
+	339, 340, 233, 226, 317, 227, 251, 228, 206, 325,
+	229, 208, 304, 343, 0, 247, 313, 273, 209, 272,
+	305, 342, 341, 217, 367, 373, 374, 378, 0, 379,
+	0, 0, 0, 387, 392, 393, 394, 395, 396, 397,
+	398, 399, 0, 0, 0, 0, 0, 381, 0, 0,
+	0, 0, 0, 0, 372, 245, 202, 203, 355, 0,
+	295, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 371, 0, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 256, 302, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 353,
+	365, 382, 385, 0, 0, 0, 207, 384, 0, 0,
+	0, 0, 0, 0, 0, 356, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 380, 283, 284, 285, 286,
+	287, 288, 248, 0, 224, 383, 311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 377, 244, 250, 391, 252, 223, 301,
+	246, 362, 259, 0, 388, 0, 0, 0, 0, 292,
+	255, 326, 260, 266, 314, 361, 299, 319, 221, 352,
+	327, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 204, 0, 264, 0, 310, 243, 165, 166,
+	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
+	0, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 337, 0, 0, 368, 369,
+	370, 390, 354, 0, 235, 0, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 328, 278, 297, 329, 271, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 0, 0,
+	0, 0, 0, 0, 219, 162, 0, 0, 0, 0,
+	0, 0, 1152, 1153, 0, 222, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 334, 350, 220, 324, 363,
+	225, 332, 215, 296, 320, 0, 0, 0, 0, 212,
+	348, 331, 275, 257, 258, 211, 0, 315, 236, 249,
+	232, 294, 0, 347, 375, 231, 366, 0, 358, 214,
+	0, 357, 293, 344, 349, 276, 269, 213, 346, 274,
+	268, 261, 240, 389, 253, 306, 267, 307, 254, 281,
+	280, 282, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 0, 0, 0, 360, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 262, 0, 0, 0, 376,
+	0, 318, 300, 0, 0, 0, 316, 265, 345, 308,
+	351, 335, 359, 312, 309, 205, 336, 234, 277, 216,
+	218, 230, 237, 239, 241, 242, 289, 290, 303, 323,
+	338, 339, 340, 233, 226, 317, 227, 251, 228, 206,
+	325, 229, 208, 304, 343, 0, 247, 313, 273, 209,
+	272, 305, 342, 341, 217, 367, 373, 374, 378, 0,
+	379, 0, 0, 0, 387, 392, 393, 394, 395, 396,
+	397, 398, 399, 0, 0, 0, 0, 0, 381, 0,
+	0, 0, 0, 0, 0, 372, 245, 202, 203, 355,
+	0, 295, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 371, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 256, 302, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 330,
+	353, 365, 382, 385, 0, 0, 0, 207, 384, 0,
+	0, 0, 0, 0, 0, 0, 356, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 380, 283, 284, 285,
+	286, 287, 288, 248, 0, 224, 383, 311, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 244, 250, 391, 252, 223,
+	301, 246, 362, 259, 0, 388, 0, 0, 0, 0,
+	292, 255, 326, 260, 266, 314, 361, 299, 319, 221,
+	352, 327, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 201, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 204, 0, 264, 0, 310, 243, 165,
+	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
 	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 319, 0, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 226, 0, 0, 251, 0, 0, 0, 0, 0,
-	0, 311, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2611,
-	0, 0, 149, 0, 0, 0, 0, 0, 0, 207,
-	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 0, 329, 357, 219, 348,
-	0, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 371, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 358, 0, 301, 283, 0, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 0, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 353,
-	0, 0, 0, 0, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 338, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 362, 269, 270, 271, 272, 236, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 0, 175, 176, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 319, 0, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
-	0, 0, 251, 0, 0, 0, 0, 0, 0, 311,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 0, 0, 0, 0, 207, 150, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 0, 329, 357, 219, 348, 0, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 371, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 2548,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 358,
-	0, 301, 283, 0, 0, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 0, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 353, 0, 0,
-	0, 0, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 338, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 362, 269, 270, 271, 272, 236, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 0, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 319,
-	0, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2410, 0, 0, 149, 0,
-	0, 0, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 319, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 0, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 149, 0, 0, 0,
-	0, 0, 0, 207, 150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 0,
-	329, 357, 219, 348, 0, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	371, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 2447, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 358, 0, 301, 283, 0,
-	0, 0, 299, 253, 327, 291, 333, 317, 341, 295,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 0, 279, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 353, 0, 0, 0, 0, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 0, 338, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 362, 269, 270,
-	271, 272, 236, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 0, 293, 231, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 162, 163, 164,
+	186, 0, 187, 188, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 337, 0, 0, 368,
+	369, 370, 390, 354, 0, 235, 0, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 328, 278, 297, 329, 271, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2881, 0, 161, 0,
+	0, 0, 0, 0, 0, 219, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 210, 334, 350, 220, 324,
+	363, 225, 332, 215, 296, 320, 0, 0, 0, 0,
+	212, 348, 331, 275, 257, 258, 211, 0, 315, 236,
+	249, 232, 294, 0, 347, 375, 231, 366, 0, 358,
+	214, 0, 357, 293, 344, 349, 276, 269, 213, 346,
+	274, 268, 261, 240, 389, 253, 306, 267, 307, 254,
+	281, 280, 282, 0, 0, 0, 0, 0, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 0, 0, 360, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 262, 0, 0, 0,
+	376, 0, 318, 300, 0, 0, 0, 316, 265, 345,
+	308, 351, 335, 359, 312, 309, 205, 336, 234, 277,
+	216, 218, 230, 237, 239, 241, 242, 289, 290, 303,
+	323, 338, 339, 340, 233, 226, 317, 227, 251, 228,
+	206, 325, 229, 208, 304, 343, 0, 247, 313, 273,
+	209, 272, 305, 342, 341, 217, 367, 373, 374, 378,
+	0, 379, 0, 0, 0, 387, 392, 393, 394, 395,
+	396, 397, 398, 399, 0, 0, 0, 0, 0, 381,
+	0, 0, 0, 0, 0, 0, 372, 245, 202, 203,
+	355, 0, 295, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 371, 0, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 256, 302, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	330, 353, 365, 382, 385, 0, 0, 0, 207, 384,
+	0, 0, 0, 0, 0, 0, 0, 356, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 380, 283, 284,
+	285, 286, 287, 288, 248, 0, 224, 383, 311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 377, 244, 250, 391, 252,
+	223, 301, 246, 362, 259, 0, 388, 0, 0, 0,
+	0, 292, 255, 326, 260, 266, 314, 361, 299, 319,
+	221, 352, 327, 270, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 204, 0, 264, 0, 310, 243,
 	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	0, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 319, 0, 0, 350, 351,
-	352, 372, 336, 0, 223, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	0, 0, 0, 311, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 149, 0, 0, 0, 0, 0,
-	0, 207, 150, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2185, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 0, 329, 357,
-	219, 348, 0, 340, 202, 0, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 371, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 358, 0, 301, 283, 0, 0, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 0,
-	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 353, 0, 0, 0, 0, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 338, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 362, 269, 270, 271, 272,
-	236, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 276, 243, 309,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 0, 293, 231, 153, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 0, 175,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 0, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 337, 0, 0,
+	368, 369, 370, 390, 354, 0, 235, 0, 298, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 328, 278, 297, 329, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	553, 0, 0, 0, 0, 0, 219, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 334, 350, 220,
+	324, 363, 225, 332, 215, 296, 320, 0, 0, 0,
+	0, 212, 348, 331, 275, 257, 258, 211, 0, 315,
+	236, 249, 232, 294, 0, 347, 375, 231, 366, 0,
+	358, 214, 0, 357, 293, 344, 349, 276, 269, 213,
+	346, 274, 268, 261, 240, 389, 253, 306, 267, 307,
+	254, 281, 280, 282, 0, 0, 0, 0, 0, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 0, 0, 0, 360, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 262, 0, 0,
+	0, 376, 0, 318, 300, 0, 0, 0, 316, 265,
+	345, 308, 351, 335, 359, 312, 309, 205, 336, 234,
+	277, 216, 218, 230, 237, 239, 241, 242, 289, 290,
+	303, 323, 338, 339, 340, 233, 226, 317, 227, 251,
+	228, 206, 325, 229, 208, 304, 343, 0, 247, 313,
+	273, 209, 272, 305, 342, 341, 217, 367, 373, 374,
+	378, 0, 379, 0, 0, 0, 387, 392, 393, 394,
+	395, 396, 397, 398, 399, 0, 0, 0, 0, 0,
+	381, 0, 0, 0, 0, 0, 0, 372, 245, 202,
+	203, 355, 0, 295, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 371, 0, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 256, 302, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 330, 353, 365, 382, 385, 0, 0, 0, 207,
+	384, 0, 0, 0, 0, 0, 0, 0, 356, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 380, 283,
+	284, 285, 286, 287, 288, 248, 0, 224, 383, 311,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 377, 244, 250, 391,
+	252, 223, 301, 246, 362, 259, 0, 388, 0, 0,
+	0, 0, 292, 255, 326, 260, 266, 314, 361, 299,
+	319, 221, 352, 327, 270, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 204, 0, 264, 0, 310,
+	243, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 0, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 337, 0,
+	0, 368, 369, 370, 390, 354, 0, 235, 0, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 328, 278, 297, 329,
+	271, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2814, 0, 0,
+	161, 0, 0, 0, 0, 0, 0, 219, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 210, 334, 350,
+	220, 324, 363, 225, 332, 215, 296, 320, 0, 0,
+	0, 0, 212, 348, 331, 275, 257, 258, 211, 0,
+	315, 236, 249, 232, 294, 0, 347, 375, 231, 366,
+	0, 358, 214, 0, 357, 293, 344, 349, 276, 269,
+	213, 346, 274, 268, 261, 240, 389, 253, 306, 267,
+	307, 254, 281, 280, 282, 0, 0, 0, 0, 0,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 0, 0, 360, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 262, 0,
+	0, 0, 376, 0, 318, 300, 0, 0, 0, 316,
+	265, 345, 308, 351, 335, 359, 312, 309, 205, 336,
+	234, 277, 216, 218, 230, 237, 239, 241, 242, 289,
+	290, 303, 323, 338, 339, 340, 233, 226, 317, 227,
+	251, 228, 206, 325, 229, 208, 304, 343, 0, 247,
+	313, 273, 209, 272, 305, 342, 341, 217, 367, 373,
+	374, 378, 0, 379, 0, 0, 0, 387, 392, 393,
+	394, 395, 396, 397, 398, 399, 0, 0, 0, 0,
+	0, 381, 0, 0, 0, 0, 0, 0, 372, 245,
+	202, 203, 355, 0, 295, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 371, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 256, 302, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 330, 353, 365, 382, 385, 0, 0, 0,
+	207, 384, 0, 0, 0, 0, 0, 0, 0, 356,
+	0, 0, 0, 364, 0, 0, 0, 0, 0, 380,
+	283, 284, 285, 286, 287, 288, 248, 0, 224, 383,
+	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 377, 244, 250,
+	391, 252, 223, 301, 246, 362, 259, 0, 388, 0,
+	0, 0, 0, 292, 255, 326, 260, 266, 314, 361,
+	299, 319, 221, 352, 327, 270, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 204, 0, 264, 0,
+	310, 243, 165, 166, 167, 168, 169, 170, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 186, 0, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 337,
+	0, 0, 368, 369, 370, 390, 354, 0, 235, 0,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 328, 278, 297,
+	329, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 0, 0, 0, 0, 0, 0, 219, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 334,
+	350, 220, 324, 363, 225, 332, 215, 296, 320, 0,
+	0, 0, 0, 212, 348, 331, 275, 257, 258, 211,
+	0, 315, 236, 249, 232, 294, 0, 347, 375, 231,
+	366, 0, 358, 214, 0, 357, 293, 344, 349, 276,
+	269, 213, 346, 274, 268, 261, 240, 389, 253, 306,
+	267, 307, 254, 281, 280, 282, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 0, 0, 0, 360,
+	0, 0, 0, 2739, 0, 0, 333, 0, 0, 262,
+	0, 0, 0, 376, 0, 318, 300, 0, 0, 0,
+	316, 265, 345, 308, 351, 335, 359, 312, 309, 205,
+	336, 234, 277, 216, 218, 230, 237, 239, 241, 242,
+	289, 290, 303, 323, 338, 339, 340, 233, 226, 317,
+	227, 251, 228, 206, 325, 229, 208, 304, 343, 0,
+	247, 313, 273, 209, 272, 305, 342, 341, 217, 367,
+	373, 374, 378, 0, 379, 0, 0, 0, 387, 392,
+	393, 394, 395, 396, 397, 398, 399, 0, 0, 0,
+	0, 0, 381, 0, 0, 0, 0, 0, 0, 372,
+	245, 202, 203, 355, 0, 295, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 371, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 256, 302, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 330, 353, 365, 382, 385, 0, 0,
+	0, 207, 384, 0, 0, 0, 0, 0, 0, 0,
+	356, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	380, 283, 284, 285, 286, 287, 288, 248, 0, 224,
+	383, 311, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 377, 244,
+	250, 391, 252, 223, 301, 246, 362, 259, 0, 388,
+	0, 0, 0, 0, 292, 255, 326, 260, 266, 314,
+	361, 299, 319, 221, 352, 327, 270, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 204, 0, 264,
+	0, 310, 243, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 0, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	337, 0, 0, 368, 369, 370, 390, 354, 0, 235,
+	0, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 328, 278,
+	297, 329, 271, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2568,
+	0, 0, 161, 0, 0, 0, 0, 0, 0, 219,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 210,
+	334, 350, 220, 324, 363, 225, 332, 215, 296, 320,
+	0, 0, 0, 0, 212, 348, 331, 275, 257, 258,
+	211, 0, 315, 236, 249, 232, 294, 0, 347, 375,
+	231, 366, 0, 358, 214, 0, 357, 293, 344, 349,
+	276, 269, 213, 346, 274, 268, 261, 240, 389, 253,
+	306, 267, 307, 254, 281, 280, 282, 0, 0, 0,
+	0, 0, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 0, 0,
+	360, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	262, 0, 0, 0, 376, 0, 318, 300, 0, 0,
+	0, 316, 265, 345, 308, 351, 335, 359, 312, 309,
+	205, 336, 234, 277, 216, 218, 230, 237, 239, 241,
+	242, 289, 290, 303, 323, 338, 339, 340, 233, 226,
+	317, 227, 251, 228, 206, 325, 229, 208, 304, 343,
+	0, 247, 313, 273, 209, 272, 305, 342, 341, 217,
+	367, 373, 374, 378, 0, 379, 0, 0, 0, 387,
+	392, 393, 394, 395, 396, 397, 398, 399, 0, 0,
+	0, 0, 0, 381, 0, 0, 0, 0, 0, 0,
+	372, 245, 202, 203, 355, 0, 295, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 371, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 256, 302,
+	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 330, 353, 365, 382, 385, 0,
+	0, 0, 207, 384, 0, 0, 0, 0, 0, 0,
+	0, 356, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 380, 283, 284, 285, 286, 287, 288, 248, 0,
+	224, 383, 311, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 377,
+	244, 250, 391, 252, 223, 301, 246, 362, 259, 0,
+	388, 0, 0, 0, 0, 292, 255, 326, 260, 266,
+	314, 361, 299, 319, 221, 352, 327, 270, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 204, 0,
+	264, 0, 310, 243, 165, 166, 167, 168, 169, 170,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 186, 0, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 337, 0, 0, 368, 369, 370, 390, 354, 0,
+	235, 0, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 328,
+	278, 297, 329, 271, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 0, 0, 1091, 0, 0, 0,
+	219, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	210, 334, 350, 220, 324, 363, 225, 332, 215, 296,
+	320, 0, 0, 0, 0, 212, 348, 331, 275, 257,
+	258, 211, 0, 315, 236, 249, 232, 294, 0, 347,
+	375, 231, 366, 0, 358, 214, 0, 357, 293, 344,
+	349, 276, 269, 213, 346, 274, 268, 261, 240, 389,
+	253, 306, 267, 307, 254, 281, 280, 282, 0, 0,
+	0, 0, 0, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 0, 0,
+	0, 360, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 262, 0, 0, 0, 376, 0, 318, 300, 0,
+	0, 0, 316, 265, 345, 308, 351, 335, 359, 312,
+	309, 205, 336, 234, 277, 216, 218, 230, 237, 239,
+	241, 242, 289, 290, 303, 323, 338, 339, 340, 233,
+	226, 317, 227, 251, 228, 206, 325, 229, 208, 304,
+	343, 0, 247, 313, 273, 209, 272, 305, 342, 341,
+	217, 367, 373, 374, 378, 0, 379, 0, 0, 0,
+	387, 392, 393, 394, 395, 396, 397, 398, 399, 0,
+	0, 0, 0, 0, 381, 0, 0, 0, 0, 0,
+	0, 372, 245, 202, 203, 355, 0, 295, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 371, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 256,
+	302, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 330, 353, 365, 382, 385,
+	0, 0, 0, 207, 384, 0, 0, 0, 0, 0,
+	0, 0, 356, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 380, 283, 284, 285, 286, 287, 288, 248,
+	0, 224, 383, 311, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 244, 250, 391, 252, 223, 301, 246, 362, 259,
+	0, 388, 0, 0, 0, 0, 292, 255, 326, 260,
+	266, 314, 361, 299, 319, 221, 352, 327, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	0, 264, 0, 310, 243, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 0, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 337, 0, 0, 368, 369, 370, 390, 354,
+	0, 235, 0, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	328, 278, 297, 329, 271, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 0, 0, 0, 0, 0,
+	0, 219, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 210, 334, 350, 220, 324, 363, 225, 332, 215,
+	296, 320, 0, 0, 0, 0, 212, 348, 331, 275,
+	257, 258, 211, 0, 315, 236, 249, 232, 294, 0,
+	347, 375, 231, 366, 0, 358, 214, 0, 357, 293,
+	344, 349, 276, 269, 213, 346, 274, 268, 261, 240,
+	389, 253, 306, 267, 307, 254, 281, 280, 282, 0,
+	0, 0, 0, 0, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	0, 0, 360, 0, 0, 0, 2608, 0, 0, 333,
+	0, 0, 262, 0, 0, 0, 376, 0, 318, 300,
+	0, 0, 0, 316, 265, 345, 308, 351, 335, 359,
+	312, 309, 205, 336, 234, 277, 216, 218, 230, 237,
+	239, 241, 242, 289, 290, 303, 323, 338, 339, 340,
+	233, 226, 317, 227, 251, 228, 206, 325, 229, 208,
+	304, 343, 0, 247, 313, 273, 209, 272, 305, 342,
+	341, 217, 367, 373, 374, 378, 0, 379, 0, 0,
+	0, 387, 392, 393, 394, 395, 396, 397, 398, 399,
+	0, 0, 0, 0, 0, 381, 0, 0, 0, 0,
+	0, 0, 372, 245, 202, 203, 355, 0, 295, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 371,
+	0, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	256, 302, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 330, 353, 365, 382,
+	385, 0, 0, 0, 207, 384, 0, 0, 0, 0,
+	0, 0, 0, 356, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 380, 283, 284, 285, 286, 287, 288,
+	248, 0, 224, 383, 311, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 377, 244, 250, 391, 252, 223, 301, 246, 362,
+	259, 0, 388, 0, 0, 0, 0, 292, 255, 326,
+	260, 266, 314, 361, 299, 319, 221, 352, 327, 270,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	204, 0, 264, 0, 310, 243, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 0, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 337, 0, 0, 368, 369, 370, 390,
+	354, 0, 235, 0, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 238, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 328, 278, 297, 329, 271, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 0, 0, 0, 0,
+	0, 0, 219, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1556, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 334, 350, 220, 324, 363, 225, 332,
+	215, 296, 320, 0, 0, 0, 0, 212, 348, 331,
+	275, 257, 258, 211, 0, 315, 236, 249, 232, 294,
+	0, 347, 375, 231, 366, 0, 358, 214, 0, 357,
+	293, 344, 349, 276, 269, 213, 346, 274, 268, 261,
+	240, 389, 253, 306, 267, 307, 254, 281, 280, 282,
+	0, 0, 0, 0, 0, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	0, 0, 0, 360, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 262, 0, 0, 0, 376, 0, 318,
+	300, 0, 0, 0, 316, 265, 345, 308, 351, 335,
+	359, 312, 309, 205, 336, 234, 277, 216, 218, 230,
+	237, 239, 241, 242, 289, 290, 303, 323, 338, 339,
+	340, 233, 226, 317, 227, 251, 228, 206, 325, 229,
+	208, 304, 343, 0, 247, 313, 273, 209, 272, 305,
+	342, 341, 217, 367, 373, 374, 378, 0, 379, 0,
+	0, 0, 387, 392, 393, 394, 395, 396, 397, 398,
+	399, 0, 0, 0, 0, 0, 381, 0, 0, 0,
+	0, 0, 0, 372, 245, 202, 203, 355, 0, 295,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	371, 0, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 256, 302, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 353, 365,
+	382, 385, 0, 0, 0, 207, 384, 0, 0, 0,
+	0, 0, 0, 0, 356, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 380, 283, 284, 285, 286, 287,
+	288, 248, 0, 224, 383, 311, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 377, 244, 250, 391, 252, 223, 301, 246,
+	362, 259, 0, 388, 0, 0, 0, 0, 292, 255,
+	326, 260, 266, 314, 361, 299, 319, 221, 352, 327,
+	270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 204, 0, 264, 0, 310, 243, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 0,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 337, 0, 0, 368, 369, 370,
+	390, 354, 0, 235, 0, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 2276, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 328, 278, 297, 329, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 0, 0, 0,
+	0, 0, 0, 219, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 210, 334, 350, 220, 324, 363, 225,
+	332, 215, 296, 320, 0, 0, 0, 0, 212, 348,
+	331, 275, 257, 258, 211, 0, 315, 236, 249, 232,
+	294, 0, 347, 375, 231, 366, 0, 358, 214, 0,
+	357, 293, 344, 349, 276, 269, 213, 346, 274, 268,
+	261, 240, 389, 253, 306, 267, 307, 254, 281, 280,
+	282, 0, 0, 0, 0, 0, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 0, 0, 360, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 262, 0, 0, 0, 376, 0,
+	318, 300, 0, 0, 0, 316, 265, 345, 308, 351,
+	335, 359, 312, 309, 205, 336, 234, 277, 216, 218,
+	230, 237, 239, 241, 242, 289, 290, 303, 323, 338,
+	339, 340, 233, 226, 317, 227, 251, 228, 206, 325,
+	229, 208, 304, 343, 0, 247, 313, 273, 209, 272,
+	305, 342, 341, 217, 367, 373, 374, 378, 0, 379,
+	0, 0, 0, 387, 392, 393, 394, 395, 396, 397,
+	398, 399, 0, 0, 0, 0, 0, 381, 0, 0,
+	0, 0, 0, 0, 372, 245, 202, 203, 355, 0,
+	295, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 371, 0, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 256, 302, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 353,
+	365, 382, 385, 0, 0, 0, 207, 384, 0, 0,
+	0, 0, 0, 0, 0, 356, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 380, 283, 284, 285, 286,
+	287, 288, 248, 0, 224, 383, 311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 377, 244, 250, 391, 252, 223, 301,
+	246, 362, 259, 0, 388, 0, 0, 0, 0, 292,
+	255, 326, 260, 266, 314, 361, 299, 319, 221, 352,
+	327, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 204, 0, 264, 0, 310, 243, 165, 166,
+	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
+	0, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 337, 0, 0, 368, 369,
+	370, 390, 354, 0, 235, 0, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 328, 278, 297, 329, 271, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 0, 0,
+	0, 0, 0, 0, 219, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 334, 350, 220, 324, 363,
+	225, 332, 215, 296, 320, 0, 0, 0, 0, 212,
+	348, 331, 275, 257, 258, 211, 0, 315, 236, 249,
+	232, 294, 0, 347, 375, 231, 366, 0, 358, 214,
+	0, 357, 293, 344, 349, 276, 269, 213, 346, 274,
+	268, 261, 240, 389, 253, 306, 267, 307, 254, 281,
+	280, 282, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 0, 0, 0, 360, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 262, 0, 0, 0, 376,
+	0, 318, 300, 0, 0, 0, 316, 265, 345, 308,
+	351, 335, 359, 312, 309, 205, 336, 234, 277, 216,
+	218, 230, 237, 239, 241, 242, 289, 290, 303, 323,
+	338, 339, 340, 233, 226, 317, 227, 251, 228, 206,
+	325, 229, 208, 304, 343, 0, 247, 313, 273, 209,
+	272, 305, 342, 341, 217, 367, 373, 374, 378, 0,
+	379, 0, 0, 0, 387, 392, 393, 394, 395, 396,
+	397, 398, 399, 0, 0, 0, 0, 0, 381, 0,
+	0, 0, 0, 0, 0, 372, 245, 202, 203, 355,
+	0, 295, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 371, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 256, 302, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 330,
+	353, 365, 382, 385, 0, 0, 0, 207, 384, 0,
+	0, 0, 0, 0, 0, 0, 356, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 380, 283, 284, 285,
+	286, 287, 288, 248, 0, 224, 383, 311, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 244, 250, 391, 252, 223,
+	301, 246, 362, 259, 0, 388, 0, 0, 0, 0,
+	292, 255, 326, 260, 266, 314, 361, 299, 319, 221,
+	352, 327, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 201, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 204, 0, 264, 0, 310, 243, 165,
+	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
 	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 319, 0, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 226, 0, 0, 251, 0, 0, 0, 0, 0,
-	0, 311, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1411,
-	0, 0, 149, 0, 0, 0, 0, 0, 0, 207,
-	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 0, 329, 357, 219, 348,
-	0, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 371, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 358, 0, 301, 283, 0, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 0, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 353,
-	0, 0, 0, 0, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 338, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 362, 269, 270, 271, 272, 236, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 0, 175, 176, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 319, 0, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
-	0, 0, 251, 0, 0, 0, 0, 0, 0, 311,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 0, 0, 0, 0, 207, 150, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2268, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 0, 329, 357, 219, 348, 0, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 371, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 358,
-	0, 301, 283, 0, 0, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 0, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 353, 0, 0,
-	0, 0, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 338, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 362, 269, 270, 271, 272, 236, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 0, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 319,
-	0, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 0,
-	0, 2149, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 319, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 0, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 149, 0, 0, 0,
-	0, 0, 0, 207, 150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2082,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 0,
-	329, 357, 219, 348, 0, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	371, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 358, 0, 301, 283, 0,
-	0, 0, 299, 253, 327, 291, 333, 317, 341, 295,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 0, 279, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 353, 0, 0, 0, 0, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 0, 338, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 362, 269, 270,
-	271, 272, 236, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 0, 293, 231, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 162, 163, 164,
+	186, 0, 187, 188, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 337, 0, 0, 368,
+	369, 370, 390, 354, 0, 235, 0, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 328, 278, 297, 329, 271, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
+	0, 0, 0, 0, 0, 219, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2297, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 210, 334, 350, 220, 324,
+	363, 225, 332, 215, 296, 320, 0, 0, 0, 0,
+	212, 348, 331, 275, 257, 258, 211, 0, 315, 236,
+	249, 232, 294, 0, 347, 375, 231, 366, 0, 358,
+	214, 0, 357, 293, 344, 349, 276, 269, 213, 346,
+	274, 268, 261, 240, 389, 253, 306, 267, 307, 254,
+	281, 280, 282, 0, 0, 0, 0, 0, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 0, 0, 360, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 262, 0, 0, 0,
+	376, 0, 318, 300, 0, 0, 0, 316, 265, 345,
+	308, 351, 335, 359, 312, 309, 205, 336, 234, 277,
+	216, 218, 230, 237, 239, 241, 242, 289, 290, 303,
+	323, 338, 339, 340, 233, 226, 317, 227, 251, 228,
+	206, 325, 229, 208, 304, 343, 0, 247, 313, 273,
+	209, 272, 305, 342, 341, 217, 367, 373, 374, 378,
+	0, 379, 0, 0, 0, 387, 392, 393, 394, 395,
+	396, 397, 398, 399, 0, 0, 0, 0, 0, 381,
+	0, 0, 0, 0, 0, 0, 372, 245, 202, 203,
+	355, 0, 295, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 371, 0, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 256, 302, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	330, 353, 365, 382, 385, 0, 0, 0, 207, 384,
+	0, 0, 0, 0, 0, 0, 0, 356, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 380, 283, 284,
+	285, 286, 287, 288, 248, 0, 224, 383, 311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 377, 244, 250, 391, 252,
+	223, 301, 246, 362, 259, 0, 388, 0, 0, 0,
+	0, 292, 255, 326, 260, 266, 314, 361, 299, 319,
+	221, 352, 327, 270, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 204, 0, 264, 0, 310, 243,
 	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	0, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 319, 0, 0, 350, 351,
-	352, 372, 336, 0, 223, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	0, 0, 0, 311, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 149, 0, 0, 1055, 0, 0,
-	0, 207, 150, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 0, 329, 357,
-	219, 348, 0, 340, 202, 0, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 371, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 342,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 358, 0, 301, 283, 0, 0, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 0,
-	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 353, 0, 0, 0, 0, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 338, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 362, 269, 270, 271, 272,
-	236, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 276, 243, 309,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 0, 293, 231, 153, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 0, 175,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 0, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 337, 0, 0,
+	368, 369, 370, 390, 354, 0, 235, 0, 298, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 238, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 328, 278, 297, 329, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1463, 0, 0, 161,
+	0, 0, 0, 0, 0, 0, 219, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 334, 350, 220,
+	324, 363, 225, 332, 215, 296, 320, 0, 0, 0,
+	0, 212, 348, 331, 275, 257, 258, 211, 0, 315,
+	236, 249, 232, 294, 0, 347, 375, 231, 366, 0,
+	358, 214, 0, 357, 293, 344, 349, 276, 269, 213,
+	346, 274, 268, 261, 240, 389, 253, 306, 267, 307,
+	254, 281, 280, 282, 0, 0, 0, 0, 0, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 0, 0, 0, 360, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 262, 0, 0,
+	0, 376, 0, 318, 300, 0, 0, 0, 316, 265,
+	345, 308, 351, 335, 359, 312, 309, 205, 336, 234,
+	277, 216, 218, 230, 237, 239, 241, 242, 289, 290,
+	303, 323, 338, 339, 340, 233, 226, 317, 227, 251,
+	228, 206, 325, 229, 208, 304, 343, 0, 247, 313,
+	273, 209, 272, 305, 342, 341, 217, 367, 373, 374,
+	378, 0, 379, 0, 0, 0, 387, 392, 393, 394,
+	395, 396, 397, 398, 399, 0, 0, 0, 0, 0,
+	381, 0, 0, 0, 0, 0, 0, 372, 245, 202,
+	203, 355, 0, 295, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 371, 0, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 256, 302, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 330, 353, 365, 382, 385, 0, 0, 0, 207,
+	384, 0, 0, 0, 0, 0, 0, 0, 356, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 380, 283,
+	284, 285, 286, 287, 288, 248, 0, 224, 383, 311,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 377, 244, 250, 391,
+	252, 223, 301, 246, 362, 259, 0, 388, 0, 0,
+	0, 0, 292, 255, 326, 260, 266, 314, 361, 299,
+	319, 221, 352, 327, 270, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 204, 0, 264, 0, 310,
+	243, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 0, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 337, 0,
+	0, 368, 369, 370, 390, 354, 0, 235, 0, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 328, 278, 297, 329,
+	271, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 0, 0, 2254, 0, 0, 0, 219, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 210, 334, 350,
+	220, 324, 363, 225, 332, 215, 296, 320, 0, 0,
+	0, 0, 212, 348, 331, 275, 257, 258, 211, 0,
+	315, 236, 249, 232, 294, 0, 347, 375, 231, 366,
+	0, 358, 214, 0, 357, 293, 344, 349, 276, 269,
+	213, 346, 274, 268, 261, 240, 389, 253, 306, 267,
+	307, 254, 281, 280, 282, 0, 0, 0, 0, 0,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 0, 0, 360, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 262, 0,
+	0, 0, 376, 0, 318, 300, 0, 0, 0, 316,
+	265, 345, 308, 351, 335, 359, 312, 309, 205, 336,
+	234, 277, 216, 218, 230, 237, 239, 241, 242, 289,
+	290, 303, 323, 338, 339, 340, 233, 226, 317, 227,
+	251, 228, 206, 325, 229, 208, 304, 343, 0, 247,
+	313, 273, 209, 272, 305, 342, 341, 217, 367, 373,
+	374, 378, 0, 379, 0, 0, 0, 387, 392, 393,
+	394, 395, 396, 397, 398, 399, 0, 0, 0, 0,
+	0, 381, 0, 0, 0, 0, 0, 0, 372, 245,
+	202, 203, 355, 0, 295, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 371, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 256, 302, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 330, 353, 365, 382, 385, 0, 0, 0,
+	207, 384, 0, 0, 0, 0, 0, 0, 0, 356,
+	0, 0, 0, 364, 0, 0, 0, 0, 0, 380,
+	283, 284, 285, 286, 287, 288, 248, 0, 224, 383,
+	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 377, 244, 250,
+	391, 252, 223, 301, 246, 362, 259, 0, 388, 0,
+	0, 0, 0, 292, 255, 326, 260, 266, 314, 361,
+	299, 319, 221, 352, 327, 270, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 204, 0, 264, 0,
+	310, 243, 165, 166, 167, 168, 169, 170, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 186, 0, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 337,
+	0, 0, 368, 369, 370, 390, 354, 0, 235, 0,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 328, 278, 297,
+	329, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 0, 0, 0, 0, 0, 0, 219, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2187, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 334,
+	350, 220, 324, 363, 225, 332, 215, 296, 320, 0,
+	0, 0, 0, 212, 348, 331, 275, 257, 258, 211,
+	0, 315, 236, 249, 232, 294, 0, 347, 375, 231,
+	366, 0, 358, 214, 0, 357, 293, 344, 349, 276,
+	269, 213, 346, 274, 268, 261, 240, 389, 253, 306,
+	267, 307, 254, 281, 280, 282, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 0, 0, 0, 360,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 262,
+	0, 0, 0, 376, 0, 318, 300, 0, 0, 0,
+	316, 265, 345, 308, 351, 335, 359, 312, 309, 205,
+	336, 234, 277, 216, 218, 230, 237, 239, 241, 242,
+	289, 290, 303, 323, 338, 339, 340, 233, 226, 317,
+	227, 251, 228, 206, 325, 229, 208, 304, 343, 0,
+	247, 313, 273, 209, 272, 305, 342, 341, 217, 367,
+	373, 374, 378, 0, 379, 0, 0, 0, 387, 392,
+	393, 394, 395, 396, 397, 398, 399, 0, 0, 0,
+	0, 0, 381, 0, 0, 0, 0, 0, 0, 372,
+	245, 202, 203, 355, 0, 295, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 371, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 256, 302, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 330, 353, 365, 382, 385, 0, 0,
+	0, 207, 384, 0, 0, 0, 0, 0, 0, 0,
+	356, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	380, 283, 284, 285, 286, 287, 288, 248, 0, 224,
+	383, 311, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 377, 244,
+	250, 391, 252, 223, 301, 246, 362, 259, 0, 388,
+	0, 0, 0, 0, 292, 255, 326, 260, 266, 314,
+	361, 299, 319, 221, 352, 327, 270, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 204, 0, 264,
+	0, 310, 243, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 0, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	337, 0, 0, 368, 369, 370, 390, 354, 0, 235,
+	0, 298, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 238, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 328, 278,
+	297, 329, 271, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 161, 0, 0, 0, 0, 0, 0, 219,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	222, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2159, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 210,
+	334, 350, 220, 324, 363, 225, 332, 215, 296, 320,
+	0, 0, 0, 0, 212, 348, 331, 275, 257, 258,
+	211, 0, 315, 236, 249, 232, 294, 0, 347, 375,
+	231, 366, 0, 358, 214, 0, 357, 293, 344, 349,
+	276, 269, 213, 346, 274, 268, 261, 240, 389, 253,
+	306, 267, 307, 254, 281, 280, 282, 0, 0, 0,
+	0, 0, 386, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 279, 0, 0, 0,
+	360, 0, 0, 0, 0, 0, 0, 333, 0, 0,
+	262, 0, 0, 0, 376, 0, 318, 300, 0, 0,
+	0, 316, 265, 345, 308, 351, 335, 359, 312, 309,
+	205, 336, 234, 277, 216, 218, 230, 237, 239, 241,
+	242, 289, 290, 303, 323, 338, 339, 340, 233, 226,
+	317, 227, 251, 228, 206, 325, 229, 208, 304, 343,
+	0, 247, 313, 273, 209, 272, 305, 342, 341, 217,
+	367, 373, 374, 378, 0, 379, 0, 0, 0, 387,
+	392, 393, 394, 395, 396, 397, 398, 399, 0, 0,
+	0, 0, 0, 381, 0, 0, 0, 0, 0, 0,
+	372, 245, 202, 203, 355, 0, 295, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 371, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 256, 302,
+	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 330, 353, 365, 382, 385, 0,
+	0, 0, 207, 384, 0, 0, 0, 0, 0, 0,
+	0, 356, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 380, 283, 284, 285, 286, 287, 288, 248, 0,
+	224, 383, 311, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 377,
+	244, 250, 391, 252, 223, 301, 246, 362, 259, 0,
+	388, 0, 0, 0, 0, 292, 255, 326, 260, 266,
+	314, 361, 299, 319, 221, 352, 327, 270, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 204, 0,
+	264, 0, 310, 243, 165, 166, 167, 168, 169, 170,
+	171, 172, 173, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 186, 0, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 337, 0, 0, 368, 369, 370, 390, 354, 0,
+	235, 0, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 328,
+	278, 297, 329, 271, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 0, 0, 2157, 0, 0, 0,
+	219, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	210, 334, 350, 220, 324, 363, 225, 332, 215, 296,
+	320, 0, 0, 0, 0, 212, 348, 331, 275, 257,
+	258, 211, 0, 315, 236, 249, 232, 294, 0, 347,
+	375, 231, 366, 0, 358, 214, 0, 357, 293, 344,
+	349, 276, 269, 213, 346, 274, 268, 261, 240, 389,
+	253, 306, 267, 307, 254, 281, 280, 282, 0, 0,
+	0, 0, 0, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 0, 0,
+	0, 360, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 262, 0, 0, 0, 376, 0, 318, 300, 0,
+	0, 0, 316, 265, 345, 308, 351, 335, 359, 312,
+	309, 205, 336, 234, 277, 216, 218, 230, 237, 239,
+	241, 242, 289, 290, 303, 323, 338, 339, 340, 233,
+	226, 317, 227, 251, 228, 206, 325, 229, 208, 304,
+	343, 0, 247, 313, 273, 209, 272, 305, 342, 341,
+	217, 367, 373, 374, 378, 0, 379, 0, 0, 0,
+	387, 392, 393, 394, 395, 396, 397, 398, 399, 0,
+	0, 0, 0, 0, 381, 0, 0, 0, 0, 0,
+	0, 372, 245, 202, 203, 355, 0, 295, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 371, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 256,
+	302, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 330, 353, 365, 382, 385,
+	0, 0, 0, 207, 384, 0, 0, 0, 0, 0,
+	0, 0, 356, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 380, 283, 284, 285, 286, 287, 288, 248,
+	0, 224, 383, 311, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 244, 250, 391, 252, 223, 301, 246, 362, 259,
+	0, 388, 0, 0, 0, 0, 292, 255, 326, 260,
+	266, 314, 361, 299, 319, 221, 352, 327, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	0, 264, 0, 310, 243, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 0, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 337, 0, 0, 368, 369, 370, 390, 354,
+	0, 235, 0, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	328, 278, 297, 329, 271, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 0, 0, 1826, 0, 0,
+	0, 219, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 210, 334, 350, 220, 324, 363, 225, 332, 215,
+	296, 320, 0, 0, 0, 0, 212, 348, 331, 275,
+	257, 258, 211, 0, 315, 236, 249, 232, 294, 0,
+	347, 375, 231, 366, 0, 358, 214, 0, 357, 293,
+	344, 349, 276, 269, 213, 346, 274, 268, 261, 240,
+	389, 253, 306, 267, 307, 254, 281, 280, 282, 0,
+	0, 0, 0, 0, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	0, 0, 360, 0, 0, 0, 0, 0, 0, 333,
+	0, 0, 262, 0, 0, 0, 376, 0, 318, 300,
+	0, 0, 0, 316, 265, 345, 308, 351, 335, 359,
+	312, 309, 205, 336, 234, 277, 216, 218, 230, 237,
+	239, 241, 242, 289, 290, 303, 323, 338, 339, 340,
+	233, 226, 317, 227, 251, 228, 206, 325, 229, 208,
+	304, 343, 0, 247, 313, 273, 209, 272, 305, 342,
+	341, 217, 367, 373, 374, 378, 0, 379, 0, 0,
+	0, 387, 392, 393, 394, 395, 396, 397, 398, 399,
+	0, 0, 0, 0, 0, 381, 0, 0, 0, 0,
+	0, 0, 372, 245, 202, 203, 355, 0, 295, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 371,
+	0, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	256, 302, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 330, 353, 365, 382,
+	385, 0, 0, 0, 207, 384, 0, 0, 0, 0,
+	0, 0, 0, 356, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 380, 283, 284, 285, 286, 287, 288,
+	248, 0, 224, 383, 311, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 377, 244, 250, 391, 252, 223, 301, 246, 362,
+	259, 0, 388, 0, 0, 0, 0, 292, 255, 326,
+	260, 266, 314, 361, 299, 319, 221, 352, 327, 270,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	204, 0, 264, 0, 310, 243, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 0, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 0, 0, 0, 368, 369, 370, 390,
+	354, 337, 235, 0, 0, 1715, 0, 0, 0, 0,
+	0, 0, 298, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 238,
+	0, 0, 263, 0, 0, 0, 0, 0, 0, 328,
+	278, 297, 329, 271, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 0, 0, 0, 0, 0, 0,
+	219, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	210, 334, 350, 220, 324, 363, 225, 332, 215, 296,
+	320, 0, 0, 0, 0, 212, 348, 331, 275, 257,
+	258, 211, 0, 315, 236, 249, 232, 294, 0, 347,
+	375, 231, 366, 0, 358, 214, 0, 357, 293, 344,
+	349, 276, 269, 213, 346, 274, 268, 261, 240, 389,
+	253, 306, 267, 307, 254, 281, 280, 282, 0, 0,
+	0, 0, 0, 386, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 279, 0, 0,
+	0, 360, 0, 0, 0, 0, 0, 0, 333, 0,
+	0, 262, 0, 0, 0, 376, 0, 318, 300, 0,
+	0, 0, 316, 265, 345, 308, 351, 335, 359, 312,
+	309, 205, 336, 234, 277, 216, 218, 230, 237, 239,
+	241, 242, 289, 290, 303, 323, 338, 339, 340, 233,
+	226, 317, 227, 251, 228, 206, 325, 229, 208, 304,
+	343, 0, 247, 313, 273, 209, 272, 305, 342, 341,
+	217, 367, 373, 374, 378, 0, 379, 0, 0, 0,
+	387, 392, 393, 394, 395, 396, 397, 398, 399, 0,
+	0, 0, 0, 0, 381, 0, 0, 0, 0, 0,
+	0, 372, 245, 202, 203, 355, 0, 295, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 371, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 256,
+	302, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 330, 353, 365, 382, 385,
+	0, 0, 0, 207, 384, 0, 0, 0, 0, 0,
+	0, 0, 356, 0, 0, 0, 364, 0, 0, 0,
+	0, 0, 380, 283, 284, 285, 286, 287, 288, 248,
+	0, 224, 383, 311, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	377, 244, 250, 391, 252, 223, 301, 246, 362, 259,
+	0, 388, 0, 0, 0, 0, 292, 255, 326, 260,
+	266, 314, 361, 299, 319, 221, 352, 327, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 201, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 204,
+	0, 264, 0, 310, 243, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 0, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 337, 0, 0, 368, 369, 370, 390, 354,
+	0, 235, 0, 298, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	238, 0, 0, 263, 0, 0, 0, 0, 0, 0,
+	328, 278, 297, 329, 271, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 161, 0, 0, 1091, 0, 0,
+	0, 219, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 222, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 210, 334, 350, 220, 324, 363, 225, 332, 215,
+	296, 320, 0, 0, 0, 0, 212, 348, 331, 275,
+	257, 258, 211, 0, 315, 236, 249, 232, 294, 0,
+	347, 375, 231, 366, 0, 358, 214, 0, 357, 293,
+	344, 349, 276, 269, 213, 346, 274, 268, 261, 240,
+	389, 253, 306, 267, 307, 254, 281, 280, 282, 0,
+	0, 0, 0, 0, 386, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
+	0, 0, 360, 0, 0, 0, 0, 0, 0, 333,
+	0, 0, 262, 0, 0, 0, 376, 0, 318, 300,
+	0, 0, 0, 316, 265, 345, 308, 351, 335, 359,
+	1386, 309, 205, 336, 234, 277, 216, 218, 230, 237,
+	239, 241, 242, 289, 290, 303, 323, 338, 339, 340,
+	233, 226, 317, 227, 251, 228, 206, 325, 229, 208,
+	304, 343, 0, 247, 313, 273, 209, 272, 305, 342,
+	341, 217, 367, 373, 374, 378, 0, 379, 0, 0,
+	0, 387, 392, 393, 394, 395, 396, 397, 398, 399,
+	0, 0, 0, 0, 0, 381, 0, 0, 0, 0,
+	0, 0, 372, 245, 202, 203, 355, 0, 295, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 371,
+	0, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	256, 302, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 330, 353, 365, 382,
+	385, 0, 0, 0, 207, 384, 0, 0, 0, 0,
+	0, 0, 0, 356, 0, 0, 0, 364, 0, 0,
+	0, 0, 0, 380, 283, 284, 285, 286, 287, 288,
+	248, 0, 224, 383, 311, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 377, 244, 250, 391, 252, 223, 301, 246, 362,
+	259, 0, 388, 0, 0, 0, 0, 292, 255, 326,
+	260, 266, 314, 361, 299, 319, 221, 352, 327, 270,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	204, 0, 264, 0, 310, 243, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 0, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 337, 0, 0, 368, 369, 370, 390,
+	354, 0, 235, 0, 298, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 238, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 328, 278, 297, 329, 271, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 161, 0, 0, 1145, 0,
+	0, 0, 219, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 222, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 210, 334, 350, 220, 324, 363, 225, 332,
+	215, 296, 320, 0, 0, 0, 0, 212, 348, 331,
+	275, 257, 258, 211, 0, 315, 236, 249, 232, 294,
+	0, 347, 375, 231, 366, 0, 358, 214, 0, 357,
+	293, 344, 349, 276, 269, 213, 346, 274, 268, 261,
+	240, 389, 253, 306, 267, 307, 254, 281, 280, 282,
+	0, 0, 0, 0, 0, 386, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
+	0, 0, 0, 360, 0, 0, 0, 0, 0, 0,
+	333, 0, 0, 262, 0, 0, 0, 376, 0, 318,
+	300, 0, 0, 0, 316, 265, 345, 308, 351, 335,
+	359, 312, 309, 205, 336, 234, 277, 216, 218, 230,
+	237, 239, 241, 242, 289, 290, 303, 323, 338, 339,
+	340, 233, 226, 317, 227, 251, 228, 206, 325, 229,
+	208, 304, 343, 0, 247, 313, 273, 209, 272, 305,
+	342, 341, 217, 367, 373, 374, 378, 0, 379, 0,
+	0, 0, 387, 392, 393, 394, 395, 396, 397, 398,
+	399, 0, 0, 0, 0, 0, 381, 0, 0, 0,
+	0, 0, 0, 372, 245, 202, 203, 355, 0, 295,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	371, 0, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 256, 302, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 330, 353, 365,
+	382, 385, 0, 0, 0, 207, 384, 0, 0, 0,
+	0, 0, 0, 0, 356, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 380, 283, 284, 285, 286, 287,
+	288, 248, 0, 224, 383, 311, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 377, 244, 250, 391, 252, 223, 301, 246,
+	362, 259, 0, 388, 0, 0, 0, 0, 292, 255,
+	326, 260, 266, 314, 361, 299, 319, 221, 352, 327,
+	270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 204, 0, 264, 0, 310, 243, 165, 166, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	178, 179, 180, 181, 182, 183, 184, 185, 186, 0,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 337, 0, 0, 368, 369, 370,
+	390, 354, 0, 235, 0, 298, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 238, 0, 0, 263, 0, 0, 0, 0,
+	0, 0, 328, 278, 297, 329, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 161, 0, 0, 0,
+	0, 0, 0, 219, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 222, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 210, 334, 350, 220, 324, 363, 225,
+	332, 215, 296, 320, 0, 0, 0, 0, 212, 348,
+	331, 275, 257, 258, 211, 0, 315, 236, 249, 232,
+	294, 0, 347, 375, 231, 366, 0, 358, 214, 0,
+	357, 293, 344, 349, 276, 269, 213, 346, 274, 268,
+	261, 240, 389, 253, 306, 267, 307, 254, 281, 280,
+	282, 0, 0, 0, 0, 0, 386, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	279, 0, 0, 0, 360, 0, 0, 0, 0, 0,
+	0, 333, 0, 0, 262, 0, 0, 0, 376, 0,
+	318, 300, 0, 0, 0, 316, 265, 345, 308, 351,
+	335, 359, 312, 309, 205, 336, 234, 277, 216, 218,
+	230, 237, 239, 241, 242, 289, 290, 303, 323, 338,
+	339, 340, 233, 226, 317, 227, 251, 228, 206, 325,
+	229, 208, 304, 343, 0, 247, 313, 273, 209, 272,
+	305, 342, 341, 217, 367, 373, 374, 378, 0, 379,
+	0, 0, 0, 387, 392, 393, 394, 395, 396, 397,
+	398, 399, 0, 0, 0, 0, 0, 381, 0, 0,
+	0, 0, 0, 0, 372, 245, 202, 203, 355, 0,
+	295, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 371, 0, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 256, 302, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 330, 353,
+	365, 382, 385, 0, 0, 0, 207, 384, 0, 0,
+	0, 0, 0, 0, 0, 356, 0, 0, 0, 364,
+	0, 0, 0, 0, 0, 380, 283, 284, 285, 286,
+	287, 288, 248, 0, 224, 383, 311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 377, 244, 250, 391, 252, 223, 301,
+	246, 362, 259, 0, 388, 0, 0, 0, 0, 292,
+	255, 326, 260, 266, 314, 361, 299, 319, 221, 352,
+	327, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 649, 0,
+	0, 0, 204, 0, 264, 0, 310, 243, 165, 166,
+	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
+	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
+	0, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 337, 0, 0, 368, 369,
+	370, 390, 354, 0, 235, 0, 298, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 238, 0, 0, 263, 0, 0, 0,
+	0, 0, 0, 328, 278, 297, 329, 271, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 0, 0,
+	0, 0, 0, 0, 219, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 222, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 210, 334, 350, 220, 324, 363,
+	225, 332, 215, 296, 320, 0, 0, 0, 0, 212,
+	348, 331, 275, 257, 258, 211, 0, 315, 236, 249,
+	232, 294, 0, 347, 375, 231, 366, 0, 358, 214,
+	0, 357, 293, 344, 349, 276, 269, 213, 346, 274,
+	268, 261, 240, 389, 253, 306, 267, 307, 254, 281,
+	280, 282, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 279, 0, 0, 0, 360, 0, 0, 0, 0,
+	0, 0, 333, 0, 0, 262, 0, 0, 0, 376,
+	0, 318, 300, 0, 0, 0, 316, 265, 345, 308,
+	351, 335, 359, 428, 309, 205, 336, 234, 277, 216,
+	218, 230, 237, 239, 241, 242, 289, 290, 303, 323,
+	338, 339, 340, 233, 226, 317, 227, 251, 228, 206,
+	325, 229, 208, 304, 343, 0, 247, 313, 273, 209,
+	272, 305, 342, 341, 217, 367, 373, 374, 378, 0,
+	379, 0, 0, 0, 387, 392, 393, 394, 395, 396,
+	397, 398, 399, 0, 0, 0, 0, 0, 381, 0,
+	0, 0, 0, 0, 0, 372, 245, 202, 203, 355,
+	0, 295, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 371, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 256, 302, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 330,
+	353, 365, 382, 385, 0, 0, 0, 207, 384, 0,
+	0, 0, 0, 0, 0, 429, 356, 0, 0, 0,
+	364, 0, 0, 0, 0, 0, 380, 283, 284, 285,
+	286, 287, 288, 248, 0, 224, 383, 311, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 377, 244, 250, 391, 252, 223,
+	301, 246, 362, 259, 0, 388, 0, 0, 0, 0,
+	292, 255, 326, 260, 266, 314, 361, 299, 319, 221,
+	352, 327, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 201, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 204, 0, 264, 0, 310, 243, 165,
+	166, 167, 168, 169, 170, 171, 172, 173, 174, 175,
 	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 319, 0, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 226, 0, 0, 251, 0, 0, 0, 0, 0,
-	0, 311, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 149, 0, 0, 1756, 0, 0, 0, 207,
-	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 0, 329, 357, 219, 348,
-	0, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 371, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 358, 0, 301, 283, 0, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 0, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 353,
-	0, 0, 0, 0, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 338, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 362, 269, 270, 271, 272, 236, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 0, 175, 176, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 319, 0, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
-	0, 0, 251, 0, 0, 0, 0, 0, 0, 311,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 0, 0, 0, 0, 207, 150, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1501, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 0, 329, 357, 219, 348, 0, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 371, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 358,
-	0, 301, 283, 0, 0, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 0, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 353, 0, 0,
-	0, 0, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 338, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 362, 269, 270, 271, 272, 236, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 0, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 319,
-	0, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 0,
-	0, 0, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1787, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 319, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 0, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 149, 0, 0, 1785,
-	0, 0, 0, 207, 150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 0,
-	329, 357, 219, 348, 0, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	371, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 358, 0, 301, 283, 0,
-	0, 0, 299, 253, 327, 291, 333, 317, 341, 295,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 0, 279, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 353, 0, 0, 0, 0, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 0, 338, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 362, 269, 270,
-	271, 272, 236, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 0, 293, 231, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	0, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 0, 0, 0, 350, 351,
-	352, 372, 336, 319, 223, 0, 0, 1657, 0, 0,
-	0, 0, 0, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 226, 0, 0, 251, 0, 0, 0, 0, 0,
-	0, 311, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 149, 0, 0, 0, 0, 0, 0, 207,
-	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 0, 329, 357, 219, 348,
-	0, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 371, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 358, 0, 301, 283, 0, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 0, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 353,
-	0, 0, 0, 0, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 338, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 362, 269, 270, 271, 272, 236, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 0, 175, 176, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 319, 0, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
-	0, 0, 251, 0, 0, 0, 0, 0, 0, 311,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 1055, 0, 0, 0, 207, 150, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 0, 329, 357, 219, 348, 0, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 371, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 358,
-	0, 301, 283, 0, 0, 0, 299, 253, 327, 291,
-	333, 317, 341, 1341, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 0, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 353, 0, 0,
-	0, 0, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 338, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 362, 269, 270, 271, 272, 236, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 0, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 319,
-	0, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 0,
-	0, 0, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 218,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 0, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	623, 0, 0, 0, 192, 0, 252, 0, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 319, 0, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 281, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 226, 0, 0, 251, 0,
-	0, 0, 0, 0, 0, 311, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 149, 0, 0, 0,
-	0, 0, 0, 207, 150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 316, 332, 208, 307, 345, 213,
-	314, 203, 280, 303, 0, 0, 200, 330, 313, 262,
-	245, 246, 199, 0, 298, 224, 237, 220, 278, 0,
-	329, 357, 219, 348, 0, 340, 202, 0, 339, 277,
-	326, 331, 263, 257, 201, 328, 261, 256, 249, 228,
-	371, 241, 289, 255, 290, 242, 267, 266, 268, 0,
-	0, 0, 0, 0, 368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 250, 0, 0, 0, 358, 0, 301, 283, 0,
-	0, 0, 299, 253, 327, 291, 333, 317, 341, 405,
-	292, 193, 318, 222, 264, 204, 206, 218, 225, 227,
-	229, 230, 273, 274, 286, 306, 320, 321, 322, 221,
-	214, 300, 215, 239, 216, 194, 308, 217, 196, 287,
-	325, 0, 235, 296, 260, 197, 259, 288, 324, 323,
-	205, 349, 355, 356, 360, 0, 361, 0, 0, 0,
-	369, 374, 375, 376, 0, 0, 0, 0, 0, 363,
-	0, 0, 0, 0, 0, 0, 354, 233, 190, 191,
-	337, 0, 279, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 353, 0, 0, 0, 0, 304, 0,
-	0, 0, 0, 0, 244, 285, 0, 305, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	312, 335, 347, 364, 367, 0, 0, 0, 195, 366,
-	0, 0, 0, 0, 0, 0, 406, 338, 0, 0,
-	0, 346, 0, 0, 0, 0, 0, 362, 269, 270,
-	271, 272, 236, 0, 212, 365, 294, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 232, 238, 373, 240, 211, 284,
-	234, 344, 247, 0, 370, 0, 0, 0, 0, 276,
-	243, 309, 248, 254, 297, 343, 282, 302, 209, 334,
-	310, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 0, 252, 0, 293, 231, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 162, 163, 164,
+	186, 0, 187, 188, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 337, 0, 0, 368,
+	369, 370, 390, 354, 0, 235, 0, 298, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 238, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 328, 278, 297, 329, 271, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
+	0, 0, 0, 0, 0, 219, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 222, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 210, 334, 350, 220, 324,
+	363, 225, 332, 215, 296, 320, 0, 0, 0, 0,
+	212, 348, 331, 275, 257, 258, 211, 0, 315, 236,
+	249, 232, 294, 0, 347, 375, 231, 366, 0, 358,
+	214, 0, 357, 293, 344, 349, 276, 269, 213, 346,
+	274, 268, 261, 240, 389, 253, 306, 267, 307, 254,
+	281, 280, 282, 0, 0, 0, 0, 0, 386, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 279, 0, 407, 0, 360, 0, 0, 0,
+	0, 0, 0, 333, 0, 0, 262, 0, 0, 0,
+	376, 0, 318, 300, 0, 0, 0, 316, 265, 345,
+	308, 351, 335, 359, 312, 309, 205, 336, 234, 277,
+	216, 218, 230, 237, 239, 241, 242, 289, 290, 303,
+	323, 338, 339, 340, 233, 226, 317, 227, 251, 228,
+	206, 325, 229, 208, 304, 343, 0, 247, 313, 273,
+	209, 272, 305, 342, 341, 217, 367, 373, 374, 378,
+	0, 379, 0, 0, 0, 387, 392, 393, 394, 395,
+	396, 397, 398, 399, 0, 0, 0, 0, 0, 381,
+	0, 0, 0, 0, 0, 0, 372, 245, 202, 203,
+	355, 0, 295, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 371, 0, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 256, 302, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	330, 353, 365, 382, 385, 0, 0, 0, 207, 384,
+	0, 0, 0, 0, 0, 0, 0, 356, 0, 0,
+	0, 364, 0, 0, 0, 0, 0, 380, 283, 284,
+	285, 286, 287, 288, 248, 0, 224, 383, 311, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 377, 244, 250, 391, 252,
+	223, 301, 246, 362, 259, 0, 388, 0, 0, 0,
+	0, 292, 255, 326, 260, 266, 314, 361, 299, 319,
+	221, 352, 327, 270, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 204, 0, 264, 0, 310, 243,
 	165, 166, 167, 168, 169, 170, 171, 172, 173, 174,
-	0, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 319, 0, 0, 350, 351,
-	352, 372, 336, 0, 223, 0, 281, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 226, 0, 0, 251, 0, 0, 0,
-	0, 0, 0, 311, 265, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 149, 0, 0, 0, 0, 0,
-	0, 207, 150, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 316, 332, 208, 307, 345, 213, 314, 203,
-	280, 303, 0, 0, 200, 330, 313, 262, 245, 246,
-	199, 0, 298, 224, 237, 220, 278, 0, 329, 357,
-	219, 348, 0, 340, 202, 0, 339, 277, 326, 331,
-	263, 257, 201, 328, 261, 256, 249, 228, 371, 241,
-	289, 255, 290, 242, 267, 266, 268, 0, 0, 0,
-	0, 0, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 384, 0, 342,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 250,
-	0, 0, 0, 358, 0, 301, 283, 0, 0, 0,
-	299, 253, 327, 291, 333, 317, 341, 295, 292, 193,
-	318, 222, 264, 204, 206, 218, 225, 227, 229, 230,
-	273, 274, 286, 306, 320, 321, 322, 221, 214, 300,
-	215, 239, 216, 194, 308, 217, 196, 287, 325, 0,
-	235, 296, 260, 197, 259, 288, 324, 323, 205, 349,
-	355, 356, 360, 0, 361, 0, 0, 0, 369, 374,
-	375, 376, 0, 0, 0, 0, 0, 363, 0, 0,
-	0, 0, 0, 0, 354, 233, 190, 191, 337, 0,
-	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 353, 0, 0, 0, 0, 304, 0, 0, 0,
-	0, 0, 244, 285, 0, 305, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 312, 335,
-	347, 364, 367, 0, 0, 0, 195, 366, 0, 0,
-	0, 0, 0, 0, 0, 338, 0, 0, 0, 346,
-	0, 0, 0, 0, 0, 362, 269, 270, 271, 272,
-	236, 0, 212, 365, 294, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 232, 238, 373, 240, 211, 284, 234, 344,
-	247, 0, 370, 0, 0, 0, 0, 276, 243, 309,
-	248, 254, 297, 343, 282, 302, 209, 334, 310, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 0, 252, 0, 293, 231, 153, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 0, 175,
-	176, 177, 178, 179, 180, 181, 182, 183, 184, 185,
-	186, 187, 188, 319, 0, 0, 350, 351, 352, 372,
-	336, 0, 223, 0, 281, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	146, 226, 0, 0, 251, 0, 0, 0, 0, 0,
-	0, 311, 265, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 149, 0, 0, 0, 0, 0, 0, 207,
-	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	316, 332, 208, 307, 345, 213, 314, 203, 280, 303,
-	0, 0, 200, 330, 313, 262, 245, 246, 199, 0,
-	298, 224, 237, 220, 278, 0, 329, 357, 219, 348,
-	0, 340, 202, 0, 339, 277, 326, 331, 263, 257,
-	201, 328, 261, 256, 249, 228, 371, 241, 289, 255,
-	290, 242, 267, 266, 268, 0, 0, 0, 0, 0,
-	368, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 342, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 250, 0, 0,
-	0, 358, 0, 301, 283, 0, 0, 0, 299, 253,
-	327, 291, 333, 317, 341, 295, 292, 193, 318, 222,
-	264, 204, 206, 218, 225, 227, 229, 230, 273, 274,
-	286, 306, 320, 321, 322, 221, 214, 300, 215, 239,
-	216, 194, 308, 217, 196, 287, 325, 0, 235, 296,
-	260, 197, 259, 288, 324, 323, 205, 349, 355, 356,
-	360, 0, 361, 0, 0, 0, 369, 374, 375, 376,
-	0, 0, 0, 0, 0, 363, 0, 0, 0, 0,
-	0, 0, 354, 233, 190, 191, 337, 0, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 353,
-	0, 0, 0, 0, 304, 0, 0, 0, 0, 0,
-	244, 285, 0, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 312, 335, 347, 364,
-	367, 0, 0, 0, 195, 366, 0, 0, 0, 0,
-	0, 0, 0, 338, 0, 0, 0, 346, 0, 0,
-	0, 0, 0, 362, 269, 270, 271, 272, 236, 0,
-	212, 365, 294, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	232, 238, 373, 240, 211, 284, 234, 344, 247, 0,
-	370, 0, 0, 0, 0, 276, 243, 309, 248, 254,
-	297, 343, 282, 302, 209, 334, 310, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 0,
-	252, 0, 293, 231, 153, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
-	169, 170, 171, 172, 173, 174, 0, 175, 176, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 187,
-	188, 319, 0, 0, 350, 351, 352, 372, 336, 0,
-	223, 0, 281, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
-	0, 0, 251, 0, 0, 0, 0, 0, 0, 311,
-	265, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	149, 0, 0, 0, 0, 0, 0, 207, 150, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 316, 332,
-	208, 307, 345, 213, 314, 203, 280, 303, 0, 0,
-	200, 330, 313, 262, 245, 246, 199, 0, 298, 224,
-	237, 220, 278, 0, 329, 357, 219, 348, 0, 340,
-	202, 0, 339, 277, 326, 331, 263, 257, 201, 328,
-	261, 256, 249, 228, 371, 241, 289, 255, 290, 242,
-	267, 266, 268, 0, 0, 0, 0, 0, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 342, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 250, 0, 0, 0, 358,
-	0, 301, 283, 0, 0, 0, 299, 253, 327, 291,
-	333, 317, 341, 295, 292, 193, 318, 222, 264, 204,
-	206, 218, 225, 227, 229, 230, 273, 274, 286, 306,
-	320, 321, 322, 221, 214, 300, 215, 239, 216, 194,
-	308, 217, 196, 287, 325, 0, 235, 296, 260, 197,
-	259, 288, 324, 323, 205, 349, 355, 356, 360, 0,
-	361, 0, 0, 0, 369, 374, 375, 376, 0, 0,
-	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
-	354, 233, 190, 191, 337, 0, 279, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 353, 0, 0,
-	0, 0, 304, 0, 0, 0, 0, 0, 244, 285,
-	0, 305, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 312, 335, 347, 364, 367, 0,
-	0, 0, 195, 366, 0, 0, 0, 0, 0, 0,
-	0, 338, 0, 0, 0, 346, 0, 0, 0, 0,
-	0, 362, 269, 270, 271, 272, 236, 0, 212, 365,
-	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 232, 238,
-	373, 240, 211, 284, 234, 344, 247, 0, 370, 0,
-	0, 0, 0, 276, 243, 309, 248, 254, 297, 343,
-	282, 302, 209, 334, 310, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 0, 252, 0,
-	293, 231, 153, 154, 155, 156, 157, 158, 159, 160,
-	161, 162, 163, 164, 165, 166, 167, 168, 169, 170,
-	171, 172, 173, 174, 0, 175, 176, 177, 178, 179,
-	180, 181, 182, 183, 184, 185, 186, 187, 188, 319,
-	0, 0, 350, 351, 352, 372, 336, 0, 223, 0,
-	281, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 226, 0, 0,
-	251, 0, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 149, 0,
-	0, 0, 0, 0, 0, 207, 150, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 316, 332, 208, 307,
-	345, 213, 314, 203, 280, 303, 0, 0, 200, 330,
-	313, 262, 245, 246, 199, 0, 298, 224, 237, 220,
-	278, 0, 329, 357, 219, 348, 0, 340, 202, 0,
-	339, 277, 326, 331, 263, 257, 201, 328, 261, 256,
-	249, 228, 371, 241, 289, 255, 290, 242, 267, 266,
-	268, 0, 0, 0, 0, 0, 368, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 250, 0, 0, 0, 358, 0, 301,
-	283, 0, 0, 0, 299, 253, 327, 291, 333, 317,
-	341, 295, 292, 193, 318, 222, 264, 204, 206, 446,
-	225, 227, 229, 230, 273, 274, 286, 306, 320, 321,
-	322, 221, 214, 300, 215, 239, 216, 194, 308, 217,
-	196, 287, 325, 0, 235, 296, 260, 197, 259, 288,
-	324, 323, 205, 349, 355, 356, 360, 0, 361, 0,
-	0, 0, 369, 374, 375, 376, 0, 0, 0, 0,
-	0, 363, 0, 0, 0, 0, 0, 0, 354, 233,
-	190, 191, 337, 0, 279, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 275, 353, 0, 0, 0, 0,
-	304, 0, 0, 0, 0, 0, 244, 285, 0, 305,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 312, 335, 347, 364, 367, 0, 0, 0,
-	195, 366, 0, 0, 0, 0, 0, 0, 0, 338,
-	0, 0, 0, 346, 0, 0, 0, 0, 0, 362,
-	269, 270, 271, 272, 236, 0, 212, 365, 294, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1395, 0, 0, 0, 359, 232, 238, 373, 240,
-	211, 284, 234, 344, 247, 909, 370, 0, 0, 0,
-	0, 276, 243, 309, 248, 254, 297, 343, 282, 302,
-	209, 334, 310, 258, 0, 1397, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 627, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1377, 0, 192, 0, 252, 0, 293, 231,
-	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 174, 0, 175, 176, 177, 178, 179, 180, 181,
-	182, 183, 184, 185, 186, 187, 188, 897, 661, 0,
-	350, 351, 352, 372, 336, 0, 223, 0, 0, 0,
-	0, 0, 0, 0, 0, 917, 921, 923, 925, 927,
-	928, 930, 0, 934, 931, 932, 933, 0, 0, 912,
-	913, 914, 915, 895, 896, 918, 0, 898, 0, 899,
-	900, 901, 902, 903, 904, 905, 906, 907, 908, 910,
-	916, 799, 800, 801, 798, 0, 0, 0, 920, 922,
-	924, 926, 929, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 663, 0,
-	0, 662, 1370, 0, 0, 1369, 0, 0, 0, 1381,
-	0, 0, 0, 0, 911, 0, 0, 0, 0, 0,
-	1385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1395, 648, 0, 0, 0, 0,
-	1374, 0, 0, 628, 1376, 1378, 1380, 1220, 1382, 1383,
-	1384, 1386, 1387, 1388, 1390, 1391, 1392, 1393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1397, 653,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1396, 0, 2692, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 647, 646, 0, 0,
-	1394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 645, 0, 1395, 0, 1373, 0, 0,
-	0, 0, 626, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 629, 656, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1389, 0, 0, 1397,
-	0, 0, 0, 1379, 0, 0, 0, 651, 0, 0,
-	0, 0, 0, 0, 0, 1216, 0, 0, 0, 1213,
-	1395, 0, 0, 1215, 1212, 1214, 1218, 1219, 0, 0,
-	0, 1217, 0, 0, 0, 0, 1377, 0, 0, 652,
-	657, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1397, 0, 642, 0, 644, 660,
-	0, 0, 1381, 641, 639, 638, 0, 643, 630, 631,
-	632, 633, 634, 1385, 658, 659, 0, 0, 0, 0,
-	0, 0, 919, 0, 0, 0, 654, 655, 0, 0,
-	0, 1377, 0, 1374, 0, 1703, 0, 1376, 1378, 1380,
-	0, 1382, 1383, 1384, 1386, 1387, 1388, 1390, 1391, 1392,
-	1393, 0, 425, 0, 424, 431, 421, 0, 0, 0,
-	0, 0, 0, 649, 0, 0, 428, 429, 0, 430,
-	434, 0, 0, 416, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 439, 0, 0, 1396, 0, 0, 0,
-	0, 0, 0, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
-	1208, 1209, 1210, 1211, 1223, 1224, 1225, 1226, 1227, 1228,
-	1221, 1222, 443, 1381, 425, 445, 424, 431, 421, 0,
-	444, 0, 0, 1394, 1385, 0, 0, 0, 428, 429,
-	0, 430, 434, 0, 0, 416, 0, 0, 0, 0,
-	1373, 0, 0, 0, 1374, 439, 0, 0, 1376, 1378,
-	1380, 0, 1382, 1383, 1384, 1386, 1387, 1388, 1390, 1391,
-	1392, 1393, 0, 0, 0, 0, 0, 0, 1381, 1389,
-	0, 0, 0, 0, 443, 0, 1379, 445, 0, 1385,
-	0, 0, 444, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1396, 0, 1374,
-	0, 0, 0, 1376, 1378, 1380, 0, 1382, 1383, 1384,
-	1386, 1387, 1388, 1390, 1391, 1392, 1393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 417, 419, 418,
-	0, 1373, 1396, 0, 0, 0, 423, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 427, 0,
-	0, 0, 0, 0, 0, 442, 0, 0, 0, 0,
-	1389, 425, 420, 424, 431, 421, 411, 1379, 0, 1394,
-	0, 0, 0, 0, 0, 428, 429, 0, 430, 434,
-	0, 0, 416, 0, 0, 0, 1373, 0, 0, 417,
-	419, 418, 439, 0, 0, 0, 0, 0, 423, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 1389, 0, 442, 0, 0,
-	0, 0, 1379, 0, 420, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 422, 426,
-	432, 0, 433, 435, 0, 0, 436, 437, 438, 0,
-	0, 440, 441, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	422, 426, 432, 0, 433, 435, 0, 0, 436, 437,
-	438, 0, 0, 440, 441, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 417, 419, 418, 0,
-	0, 0, 0, 0, 0, 423, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 442, 0, 0, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 422, 426, 432,
-	0, 433, 435, 0, 0, 436, 437, 438, 0, 0,
-	440, 441,
+	175, 176, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 0, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 337, 0, 0,
+	368, 369, 370, 390, 354, 0, 235, 0, 298, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 158, 238, 0, 0, 263, 0,
+	0, 0, 0, 0, 0, 328, 278, 297, 329, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 0, 0, 0, 0, 219, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 222, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 210, 334, 350, 220,
+	324, 363, 225, 332, 215, 296, 320, 0, 0, 0,
+	0, 212, 348, 331, 275, 257, 258, 211, 0, 315,
+	236, 249, 232, 294, 0, 347, 375, 231, 366, 0,
+	358, 214, 0, 357, 293, 344, 349, 276, 269, 213,
+	346, 274, 268, 261, 240, 389, 253, 306, 267, 307,
+	254, 281, 280, 282, 0, 0, 0, 0, 0, 386,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 279, 0, 0, 0, 360, 0, 0,
+	0, 0, 0, 0, 333, 0, 0, 262, 0, 0,
+	0, 376, 0, 318, 300, 0, 0, 0, 316, 265,
+	345, 308, 351, 335, 359, 312, 309, 205, 336, 234,
+	277, 216, 218, 230, 237, 239, 241, 242, 289, 290,
+	303, 323, 338, 339, 340, 233, 226, 317, 227, 251,
+	228, 206, 325, 229, 208, 304, 343, 0, 247, 313,
+	273, 209, 272, 305, 342, 341, 217, 367, 373, 374,
+	378, 0, 379, 0, 0, 0, 387, 392, 393, 394,
+	395, 396, 397, 398, 399, 0, 0, 0, 0, 0,
+	381, 0, 0, 0, 0, 0, 0, 372, 245, 202,
+	203, 355, 0, 295, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 371, 0, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 256, 302, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 330, 353, 365, 382, 385, 0, 0, 0, 207,
+	384, 0, 0, 0, 0, 0, 0, 0, 356, 0,
+	0, 0, 364, 0, 0, 0, 0, 0, 380, 283,
+	284, 285, 286, 287, 288, 248, 0, 224, 383, 311,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 377, 244, 250, 391,
+	252, 223, 301, 246, 362, 259, 0, 388, 0, 0,
+	0, 0, 292, 255, 326, 260, 266, 314, 361, 299,
+	319, 221, 352, 327, 270, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 204, 0, 264, 0, 310,
+	243, 165, 166, 167, 168, 169, 170, 171, 172, 173,
+	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
+	184, 185, 186, 0, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 337, 0,
+	0, 368, 369, 370, 390, 354, 0, 235, 0, 298,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 238, 0, 0, 263,
+	0, 0, 0, 0, 0, 0, 328, 278, 297, 329,
+	271, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	161, 0, 0, 0, 0, 0, 0, 219, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 222, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 210, 334, 350,
+	220, 324, 363, 225, 332, 215, 296, 320, 0, 0,
+	0, 0, 212, 348, 331, 275, 257, 258, 211, 0,
+	315, 236, 249, 232, 294, 0, 347, 375, 231, 366,
+	0, 358, 214, 0, 357, 293, 344, 349, 276, 269,
+	213, 346, 274, 268, 261, 240, 389, 253, 306, 267,
+	307, 254, 281, 280, 282, 0, 0, 0, 0, 0,
+	386, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 279, 0, 0, 0, 360, 0,
+	0, 0, 0, 0, 0, 333, 0, 0, 262, 0,
+	0, 0, 376, 0, 318, 300, 0, 0, 0, 316,
+	265, 345, 308, 351, 335, 359, 312, 309, 205, 336,
+	234, 277, 216, 218, 230, 237, 239, 241, 242, 289,
+	290, 303, 323, 338, 339, 340, 233, 226, 317, 227,
+	251, 228, 206, 325, 229, 208, 304, 343, 0, 247,
+	313, 273, 209, 272, 305, 342, 341, 217, 367, 373,
+	374, 378, 0, 379, 0, 0, 0, 387, 392, 393,
+	394, 395, 396, 397, 398, 399, 0, 0, 0, 0,
+	0, 381, 0, 0, 0, 0, 0, 0, 372, 245,
+	202, 203, 355, 0, 295, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 371, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 256, 302, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 330, 353, 365, 382, 385, 0, 0, 0,
+	207, 384, 0, 0, 0, 0, 0, 0, 0, 356,
+	0, 0, 0, 364, 0, 0, 0, 0, 0, 380,
+	283, 284, 285, 286, 287, 288, 248, 0, 224, 383,
+	311, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 377, 244, 250,
+	391, 252, 223, 301, 246, 362, 259, 0, 388, 0,
+	0, 0, 0, 292, 255, 326, 260, 266, 314, 361,
+	299, 319, 221, 352, 327, 270, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 204, 0, 264, 0,
+	310, 243, 165, 166, 167, 168, 169, 170, 171, 172,
+	173, 174, 175, 176, 177, 178, 179, 180, 181, 182,
+	183, 184, 185, 186, 0, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 337,
+	0, 0, 368, 369, 370, 390, 354, 0, 235, 0,
+	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 238, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 328, 278, 297,
+	329, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 161, 0, 0, 0, 0, 0, 0, 219, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 222,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 210, 334,
+	350, 220, 324, 363, 225, 332, 215, 296, 320, 0,
+	0, 0, 0, 212, 348, 331, 275, 257, 258, 211,
+	0, 315, 236, 249, 232, 294, 0, 347, 375, 231,
+	366, 0, 358, 214, 0, 357, 293, 344, 349, 276,
+	269, 213, 346, 274, 268, 261, 240, 389, 253, 306,
+	267, 307, 254, 281, 280, 282, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 279, 0, 0, 0, 360,
+	0, 0, 0, 0, 0, 0, 333, 0, 0, 262,
+	0, 0, 0, 376, 0, 318, 300, 0, 0, 0,
+	316, 265, 345, 308, 351, 335, 359, 312, 309, 205,
+	336, 234, 277, 216, 218, 469, 237, 239, 241, 242,
+	289, 290, 303, 323, 338, 339, 340, 233, 226, 317,
+	227, 251, 228, 206, 325, 229, 208, 304, 343, 0,
+	247, 313, 273, 209, 272, 305, 342, 341, 217, 367,
+	373, 374, 378, 0, 379, 0, 0, 0, 387, 392,
+	393, 394, 395, 396, 397, 398, 399, 0, 0, 0,
+	0, 0, 381, 0, 0, 0, 0, 0, 0, 372,
+	245, 202, 203, 355, 0, 295, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 371, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 256, 302, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 330, 353, 365, 382, 385, 0, 0,
+	0, 207, 384, 0, 0, 0, 0, 0, 0, 0,
+	356, 0, 0, 0, 364, 0, 0, 0, 0, 0,
+	380, 283, 284, 285, 286, 287, 288, 248, 0, 224,
+	383, 311, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 377, 244,
+	250, 391, 252, 223, 301, 246, 362, 259, 153, 388,
+	42, 139, 115, 0, 292, 255, 326, 260, 266, 314,
+	361, 299, 319, 221, 352, 327, 270, 0, 146, 0,
+	0, 938, 0, 0, 0, 132, 0, 0, 0, 147,
+	201, 0, 0, 0, 104, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 87, 0, 0, 0, 0, 204, 150, 264,
+	0, 310, 243, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 0, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	0, 0, 0, 368, 369, 370, 390, 354, 0, 235,
+	938, 0, 0, 0, 0, 0, 828, 829, 830, 827,
+	0, 0, 0, 0, 0, 0, 926, 0, 140, 141,
+	916, 142, 143, 0, 0, 0, 0, 0, 145, 0,
+	0, 144, 0, 653, 0, 0, 946, 950, 952, 954,
+	956, 957, 959, 0, 963, 960, 961, 962, 0, 0,
+	941, 942, 943, 944, 924, 925, 947, 0, 927, 0,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	939, 945, 0, 0, 0, 0, 0, 0, 0, 949,
+	951, 953, 955, 958, 1262, 0, 0, 0, 114, 138,
+	151, 0, 85, 0, 0, 0, 0, 0, 689, 0,
+	0, 0, 0, 0, 0, 926, 0, 0, 0, 0,
+	137, 131, 130, 0, 0, 0, 940, 48, 0, 0,
+	0, 0, 0, 0, 0, 946, 950, 952, 954, 956,
+	957, 959, 0, 963, 960, 961, 962, 0, 0, 941,
+	942, 943, 944, 924, 925, 947, 0, 927, 0, 928,
+	929, 930, 931, 932, 933, 934, 935, 936, 937, 939,
+	945, 0, 0, 0, 0, 0, 0, 0, 949, 951,
+	953, 955, 958, 0, 0, 133, 134, 135, 0, 0,
+	691, 0, 0, 690, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 148, 0, 940, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 676, 0, 0,
+	0, 99, 0, 0, 0, 136, 654, 100, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1258, 0,
+	0, 1447, 1255, 0, 0, 0, 1257, 1254, 1256, 1260,
+	1261, 0, 681, 0, 1259, 0, 0, 0, 0, 1447,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1449, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 41, 1449, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 673,
+	672, 0, 674, 675, 0, 1429, 0, 0, 0, 0,
+	0, 0, 0, 2661, 0, 0, 0, 0, 0, 1447,
+	0, 671, 0, 1429, 0, 0, 0, 43, 0, 0,
+	652, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 655, 684, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1449, 0, 0, 0, 0, 0, 0,
+	116, 948, 0, 0, 0, 679, 0, 0, 1243, 1244,
+	1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1265,
+	1266, 1267, 1268, 1269, 1270, 1263, 1264, 0, 0, 0,
+	0, 0, 0, 1429, 0, 0, 0, 0, 0, 680,
+	685, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	103, 107, 0, 0, 0, 0, 668, 0, 670, 688,
+	0, 0, 0, 667, 665, 664, 0, 669, 656, 657,
+	658, 659, 660, 0, 686, 687, 0, 1421, 1424, 1447,
+	1420, 0, 1423, 0, 0, 1433, 682, 683, 0, 0,
+	948, 0, 1741, 0, 0, 0, 1437, 0, 0, 0,
+	0, 0, 0, 1433, 1422, 0, 0, 0, 0, 0,
+	0, 0, 0, 1449, 1437, 0, 1426, 0, 0, 0,
+	1428, 1430, 1432, 677, 1434, 1435, 1436, 1438, 1439, 1440,
+	1442, 1443, 1444, 1445, 1426, 0, 0, 0, 1428, 1430,
+	1432, 0, 1434, 1435, 1436, 1438, 1439, 1440, 1442, 1443,
+	1444, 1445, 0, 1429, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 448, 0, 447, 454, 444, 0,
+	0, 0, 0, 1433, 1448, 0, 0, 0, 451, 452,
+	0, 453, 457, 0, 1437, 439, 0, 0, 0, 0,
+	0, 0, 1448, 0, 0, 462, 0, 0, 0, 0,
+	0, 0, 0, 0, 1426, 0, 0, 0, 1428, 1430,
+	1432, 1446, 1434, 1435, 1436, 1438, 1439, 1440, 1442, 1443,
+	1444, 1445, 0, 0, 0, 0, 0, 466, 1425, 1446,
+	468, 0, 0, 2313, 0, 467, 0, 448, 0, 447,
+	454, 444, 0, 0, 0, 0, 1425, 0, 0, 0,
+	0, 451, 452, 0, 453, 457, 0, 1441, 439, 0,
+	2324, 0, 1448, 0, 0, 0, 1431, 0, 462, 0,
+	0, 0, 0, 2316, 0, 1441, 0, 0, 0, 0,
+	2311, 0, 0, 0, 1431, 2327, 2328, 0, 0, 0,
+	0, 2312, 0, 1433, 0, 0, 0, 0, 0, 1446,
+	466, 0, 0, 468, 1437, 0, 0, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 1425, 0, 0, 0,
+	0, 0, 0, 0, 1426, 0, 0, 2317, 1428, 1430,
+	1432, 0, 1434, 1435, 1436, 1438, 1439, 1440, 1442, 1443,
+	1444, 1445, 0, 0, 0, 1441, 0, 0, 0, 0,
+	0, 0, 0, 0, 1431, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 440, 442, 441, 0, 0, 0,
+	0, 0, 0, 0, 446, 0, 0, 0, 0, 0,
+	0, 0, 1448, 0, 0, 0, 450, 0, 0, 0,
+	0, 0, 448, 465, 447, 454, 444, 0, 0, 0,
+	443, 0, 0, 0, 434, 0, 451, 452, 0, 453,
+	457, 0, 0, 439, 0, 0, 0, 0, 2326, 1446,
+	1751, 0, 0, 462, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1425, 440, 442, 441,
+	0, 0, 0, 0, 0, 2319, 0, 446, 0, 2320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 450,
+	0, 0, 0, 0, 0, 1441, 465, 2318, 2321, 0,
+	0, 0, 0, 443, 1431, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 445, 449, 455, 0, 456, 458, 0, 0, 459,
+	460, 461, 0, 0, 463, 464, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2329, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2314, 0, 0, 0,
+	0, 0, 2325, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 445, 449, 455, 0, 456, 458,
+	0, 0, 459, 460, 461, 0, 0, 463, 464, 0,
+	0, 0, 440, 442, 441, 0, 0, 0, 0, 0,
+	0, 0, 446, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	0, 465, 0, 0, 0, 0, 0, 0, 443, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	449, 455, 0, 456, 458, 0, 0, 459, 460, 461,
+	0, 0, 463, 464,
 }
 
 var yyPact = [...]int{
-	225, -1000, -310, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	36267, -1000, -312, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -219, 28912,
-	28912, -1000, -1000, 1577, -1000, 28404, 9597, 29420, 211, 199,
-	29420, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -220, 34805, 34805, -1000, -1000, 1804, -1000, 34284, 10307,
+	35326, 283, 282, 35326, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	415, -1000, 27896, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 380, 30812, 29928, 7554, 29420, -288, -1000, 2422,
-	-148, -1000, -1000, -1000, -1000, -1000, -1000, 1830, 428, 27388,
+	-1000, -1000, -1000, -1000, -1000, 601, -1000, 33763, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 491,
+	36913, 35847, 7691, 35326, -288, -1000, 2651, -152, -1000, -1000,
+	-1000, -1000, -1000, -1000, 3186, 614, 33242, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 30360, 110, 428,
-	531, 535, 773, 773, 11629, -38, -46, 2422, 259, 104,
-	-1000, 911, 225, 29420, 1501, 361, 29420, -1000, 1038, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 36396, 212, 614,
+	748, 744, 888, 864, 35326, 677, 13954, -74, -75, 2651,
+	268, 269, -1000, 2557, 36267, 35326, 1730, 466, 35326, -1000,
+	1244, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4619,292 +5266,311 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 361, -1000, -1000,
-	-1000, 2422, 2422, -1000, 29420, 29420, 19, 1132, -1000, 239,
-	277, 267, 1035, -1000, -1000, -1000, -1000, -1000, 2241, -1000,
-	29420, 29420, 1895, 29420, -1000, 1277, 321, 30874, 2073, 923,
-	474, 1931, -1000, -1000, 1877, -1000, 122, 114, 67, 315,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 116, -1000, 2097,
-	-1000, -1000, 111, -1000, -1000, 126, -1000, -1000, -1000, -48,
-	-1000, -1000, -1000, -1000, -1000, -1000, -161, -1000, -1000, 599,
-	1171, 7554, -1000, 1214, -1000, 2227, -1000, -1000, -1000, -1000,
-	5004, 9078, 9078, 9078, 9078, -1000, -1000, 1773, 7554, 1873,
-	1868, -1000, -1000, -1000, -1000, 1033, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1499, 8570,
-	-1000, 1867, 1866, 1860, 1859, 1858, 1849, 1848, 1847, 1845,
-	1843, 1647, 1731, 1842, 1498, 1496, 1836, 1834, 1828, 1495,
-	1647, 1647, 1816, 1814, 1812, 1810, 1808, 1805, 1804, 1803,
-	1799, 1797, 1796, 1794, 1793, 1792, 1791, 1789, 1784, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	466, -1000, -1000, -1000, 2651, 2651, -1000, 35326, 35326, -21,
+	1343, -1000, 247, 241, 257, 1241, -1000, -1000, -1000, -1000,
+	-1000, 2539, -1000, 35326, 35326, 2188, 35326, -1000, 1466, 416,
+	36986, 2342, 1191, 596, 2211, -1000, -1000, 2163, -1000, 142,
+	379, 149, 368, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	141, -1000, 2359, -1000, -1000, 124, -1000, -1000, 143, -1000,
+	-1000, -1000, -86, -1000, -1000, -1000, -1000, -1000, -1000, -156,
+	-1000, -1000, 814, 1403, 7691, -1000, 1733, -1000, 2050, -1000,
+	-1000, -1000, -1000, 5076, 9254, 9254, 9254, 9254, -1000, -1000,
+	2001, 7691, 2162, 2160, -1000, -1000, -1000, -1000, 1240, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1729, 8733, -1000, 2156, 2155, 2153, 2152, 2137, 2136,
+	2134, 2133, 2132, 2129, 1903, 1981, 2123, 1728, 1725, 2122,
+	2120, 2098, 1724, 1903, 1903, 2094, 2087, 2084, 2083, 2082,
+	2081, 2079, 2074, 2073, 2056, 2055, 2051, 2048, 2045, 2042,
+	2038, 2030, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 2619, -1000, 1027, 850, -1000, 1783, 2038, 2110,
-	1635, 2221, 2147, 2139, 2135, 2124, 1374, -1000, -1000, 29420,
-	29420, 373, 373, 373, 373, 373, 403, 373, 412, 407,
-	396, -1000, -1000, -1000, -1000, -1000, -1000, 492, -1000, -1000,
-	-1000, -1000, 905, 29420, -1000, 1703, 1076, 2166, 342, 341,
-	256, -1000, 1219, 1219, 1219, 1076, 291, 338, 2110, 2110,
-	-57, -87, 1076, 1076, -87, 1076, 1076, 1076, 115, -1000,
-	-1000, -1000, 1219, 335, 1219, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 2146, 2132, 380, 29420, 38, 29420, 380, 380,
-	385, -1000, -165, -1000, -1000, 516, -1000, 510, -1000, 1277,
-	319, 317, 950, 1234, -1000, 1102, 29420, 29420, 29420, 1102,
-	1102, 15188, 14680, -1000, 29420, -1000, 2110, 1635, -1000, 1623,
-	2413, 1614, 1635, 380, 380, 380, 380, 380, 380, 380,
-	29420, 29420, 380, 6528, 6528, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 313, 2058, 249, 1781, -1000, 29420, 248,
-	2110, 2038, 2110, -1000, -1000, 1021, 1365, 1494, -1000, -1000,
-	239, 1053, -1000, 752, -1000, -1000, -1000, -1000, 29420, 234,
-	-1000, -1000, 1487, 1780, -1000, 388, 627, 907, -1000, 70,
-	31041, 23824, 1277, 23824, 29420, -1000, -1000, -1000, -1000, -1000,
-	-1000, -49, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 118, -1000, 7554, 7554, 7554,
-	7554, 7554, -1000, 441, 8062, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 9078, 9078, 9078, 9078, 9078, 9078, 9078, 9078,
-	9078, 9078, 9078, 9078, 1757, 1247, 9078, 9078, 9078, 9078,
-	2413, 2317, 908, 162, -1000, -1000, -1000, -1000, -1000, 1143,
-	1171, 7554, 7554, 29420, -1000, 30410, 7554, 7554, 2508, 7554,
-	2108, 3479, 29420, 7554, -1000, 1613, 1611, -1000, -1000, 1290,
-	7554, 7554, -1000, -1000, 7554, 9078, 7554, -1000, -1000, -1000,
-	169, 2108, 2108, 7554, 7554, 2108, 2108, 2108, 1167, 2108,
-	2108, 2108, 2108, 2108, 2108, 2108, 2108, 29420, 2016, 100,
-	-1000, -1000, -1000, 1649, -1000, 1700, 1700, 1700, 1700, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1729, 1778,
-	-1000, -1000, 1697, 1697, 1697, 1649, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 36270, -1000, 1239, 1210, -1000,
+	2029, 2325, 2466, 1936, 2518, 2428, 2423, 2395, 2386, 1608,
+	-1000, -1000, 35326, 35326, 490, 490, 490, 490, 490, 267,
+	490, 490, 568, 490, 600, 598, 594, -1000, -1000, -1000,
+	-1000, -1000, -1000, 682, -1000, -1000, -1000, -1000, 1105, 35326,
+	-1000, 1950, 1295, 2462, 431, 420, 331, -1000, 1350, 1350,
+	1350, 1295, 265, 429, 2466, 2466, -68, -107, 1295, 1295,
+	-107, 1295, 1295, 1295, 1295, 215, 68, -1000, -1000, -1000,
+	1350, 401, 1350, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2431, 2414, 491, 35326, 76, 35326, 491, 491, 508, -1000,
+	-167, -1000, -1000, 796, -1000, 708, -1000, 554, -1000, -1000,
+	35326, 1466, 414, 394, 1112, 1362, -1000, 1277, 35326, 35326,
+	35326, 1277, 1277, 18125, 17604, -1000, 35326, -1000, 2466, 1936,
+	-1000, 1887, 2603, 1878, 1936, 491, 491, 491, 491, 491,
+	491, 491, 35326, 35326, 491, 491, 227, 491, 995, -1000,
+	-1000, 288, 2331, 260, 2028, -1000, 35326, 253, 2466, 2325,
+	2466, -1000, -1000, 1226, 1606, 32721, -1000, -1000, 1338, 247,
+	1242, -1000, 22293, -1000, -1000, -1000, -1000, 35326, 272, -1000,
+	-1000, 1708, 2025, -1000, 375, 1149, 1007, -1000, 132, 37141,
+	25419, 1466, 25419, 35326, -1000, -1000, -1000, -1000, -1000, -1000,
+	-88, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1725, 1725, 1726, 1725, 29420, 2110, -288, 6020, -1000,
-	-293, 2038, 7554, -1000, -1000, 7554, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1492, 2082, -1000, 1777, 1014, 29420,
-	1161, 29420, 23824, 29420, 1277, 29420, 29420, 373, 373, 373,
-	385, -1000, 29420, 905, 2079, 29420, 2186, 9078, 9078, 26880,
-	1219, 1076, -1000, 29420, -1000, -1000, -1000, 1219, 29420, 1219,
-	2186, 1219, -1000, -1000, -1000, 1076, 1076, -1000, -1000, -1000,
-	-1000, 1219, 1219, -1000, -1000, -51, 2186, 2186, -63, -1000,
-	-1000, -1000, 29420, 29420, 373, 29420, 29420, -1000, 29420, -1000,
-	-1000, 29420, 30325, 29420, 29420, 2129, -1000, 23824, 29420, 21792,
-	-1000, -84, 529, 519, 524, -1000, -1000, 387, 409, 13664,
-	340, 23824, 4495, -1000, -1000, 1102, 1102, 1102, 4495, 4495,
-	983, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 879, -1000,
-	86, 2038, -1000, -1000, -1000, -1000, -1000, 29420, 23824, 1277,
-	29420, 29420, 29420, 29420, -1000, 1776, 29420, 887, -1000, -1000,
-	11121, 1013, 887, 1491, 29420, 1490, 1927, -289, -1000, 12647,
-	29420, 29420, -1000, -1000, -289, -1000, 12138, 29420, 2038, -1000,
-	2038, 29420, -1000, 2165, -1000, 239, 202, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1010, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 868, -1000, 29420, -1000, -1000, 70, 23824,
-	24840, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 107, -1000,
-	-1000, 148, -1000, 395, 49, 1045, -1000, -1000, 51, 144,
-	405, 1171, -1000, 1150, 1150, 1165, -1000, 447, -1000, -1000,
-	-1000, -1000, 1773, -1000, -1000, -1000, 1944, 1310, -1000, 1145,
-	1145, 1049, 1049, 1049, 1049, 1049, 1105, 1105, -1000, -1000,
-	-1000, 5004, 1757, 9078, 9078, 9078, 9078, 367, 367, 3053,
-	3313, -1000, 7554, 1140, -1000, 7554, 1684, 864, 1007, -1000,
+	-1000, -1000, -1000, -1000, 89, -1000, 7691, 7691, 7691, 7691,
+	7691, -1000, 516, 8212, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 9254, 9254, 9254, 9254, 9254, 9254, 9254, 9254, 9254,
+	9254, 9254, 9254, 1988, 1289, 9254, 9254, 9254, 9254, 2603,
+	2480, 1109, 172, -1000, -1000, -1000, -1000, -1000, 1351, 1403,
+	7691, 7691, 35326, -1000, 36301, 7691, 7691, 75, 7691, 2375,
+	3512, 35326, 7691, -1000, 1866, 1864, -1000, -1000, 1472, 7691,
+	7691, -1000, -1000, 7691, 9254, 7691, -1000, -1000, -1000, 191,
+	2375, 2375, 7691, 7691, 2375, 2375, 2375, 1359, 2375, 2375,
+	2375, 2375, 2375, 2375, 2375, 2375, 35326, 2316, 64, -1000,
+	-1000, -1000, 1906, -1000, 1949, 1949, 1949, 1949, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1975, 2019, -1000,
+	-1000, 1944, 1944, 1944, 1906, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1964, 1964, 1967, 1964, 35326, 2466, -288, 6118, -1000, -293,
+	2325, 7691, -1000, -1000, 7691, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1723, 2371, -1000, 2018, 1225, 35326, 1373,
+	35326, 25419, 35326, 35326, 490, 35326, 1466, 35326, 35326, 490,
+	490, 490, 508, -1000, 35326, 1105, 2370, 35326, 2527, 9254,
+	9254, 32200, 1350, 1295, -1000, 35326, -1000, -1000, -1000, 1350,
+	35326, 1350, 2527, 1350, -1000, -1000, -1000, 1295, 1295, -1000,
+	-1000, -1000, -1000, 1350, 1350, -1000, -1000, -101, 2527, 2527,
+	-81, -1000, -1000, -1000, -1000, 1295, 35326, 35326, 490, 35326,
+	35326, -1000, 35326, -1000, -1000, 35326, 36624, 35326, 35326, 2404,
+	-1000, 25419, 35326, 28545, -1000, -149, 738, 718, 723, -1000,
+	674, -1000, -1000, 371, 509, 16562, 320, 25419, 4554, -1000,
+	-1000, 1277, 1277, 1277, 4554, 4554, 1173, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1104, -1000, 97, 2325, -1000, -1000,
+	-1000, -1000, -1000, 35326, 25419, 1466, 35326, 35326, 35326, 35326,
+	-1000, 2015, 35326, 35326, 491, 35326, 6639, 6639, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1721, 35326, 1714, 2209, -289,
+	-1000, 15519, 35326, 35326, -1000, -1000, -289, -1000, 14997, 35326,
+	2325, -1000, 2325, 35326, -1000, 2461, 247, 35326, -1000, 247,
+	178, -1000, -1000, -1000, -1000, 1224, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1103, -1000, 35326, -1000, -1000,
+	132, 25419, 26461, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	119, -1000, -1000, 155, -1000, 551, 32, 1233, -1000, -1000,
+	57, 150, 621, 1403, -1000, 1396, 1396, 1408, -1000, 480,
+	-1000, -1000, -1000, -1000, 2001, -1000, -1000, -1000, 1407, 1352,
+	-1000, 1313, 1313, 1222, 1222, 1222, 1222, 1222, 1526, 1526,
+	-1000, -1000, -1000, 5076, 1988, 9254, 9254, 9254, 9254, 444,
+	444, 2945, 2352, -1000, 7691, 1366, -1000, 7691, 2315, 1055,
+	1214, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1609,
-	599, 1607, 2035, 2240, 1604, 7554, -1000, -1000, 1378, 7046,
-	-1000, -1000, -1000, 1602, 1006, 1595, -1000, -1000, -1000, 1593,
-	1040, 730, 1592, 1458, 1591, 861, 7554, 7554, 1039, 989,
-	7554, 7554, 7554, 7554, 1589, 7554, 7554, 7554, 7554, 7554,
-	7554, 7554, 7554, -1000, 7554, 73, -1000, -1000, -1000, -1000,
-	1364, -1000, 1346, -1000, -1000, -1000, 1482, 1482, -1000, 1345,
-	-1000, -1000, -1000, -1000, 1342, -1000, -1000, 1329, -1000, -1000,
-	-1000, -1000, 981, -1000, 1171, -1000, 1477, -1000, 1158, 846,
-	-1000, 1426, -1000, -1000, 29420, 10105, 29420, 1703, 2066, 84,
-	-1000, 867, -1000, 49, -169, 679, 1892, 2239, 29420, 29420,
-	29420, 26372, -1000, 1741, 979, -1000, -1000, 7554, -1000, -1000,
-	1887, 29420, 29420, 2186, -1000, -1000, -1000, 29420, -1000, -1000,
-	-1000, 29420, 2186, 2186, 1076, 1219, 1219, -1000, -1000, 1219,
-	-1000, 1004, -1000, 29420, -1000, -1000, -1000, 1703, -1000, 10613,
-	685, 30669, -1000, 1106, 1106, 837, 1106, 1106, 1106, 1106,
-	299, 296, 1106, 1106, 1106, 1106, 1106, 1106, 1106, 1106,
-	1106, 1106, 1106, 1106, 1106, 1106, 1739, -1000, 69, 2114,
-	152, 867, 168, 2406, 709, -1000, -1000, -1000, -1000, 17220,
-	17220, 13156, 17220, -1000, 1056, -1000, -1000, 526, 504, 513,
-	-1000, -1000, 390, -1000, -1000, 679, -1000, -1000, -1000, 1735,
-	1120, -1000, -1000, 1731, -1000, 4495, 4495, 4495, -1000, -1000,
-	17728, 29420, -1000, -162, -1000, -131, -1000, -1000, 842, 679,
-	1923, 817, -1000, 817, -1000, 10105, -1000, 2186, 6528, -1000,
-	21792, -1000, -1000, 25856, -1000, 25348, 2186, -298, 686, -91,
-	-1000, 2019, 760, -1000, 1730, -1000, 970, 1979, -1000, 805,
-	-1000, 1119, 969, -1000, 760, 965, 1973, 805, -1000, -1000,
-	1003, 18, -1000, -1000, 29420, 1487, 964, 24840, 772, -1000,
-	389, 1002, 1001, -1000, 23824, 119, 23824, -1000, 23824, -1000,
-	-1000, 240, -1000, 29420, 2023, -1000, -1000, -1000, 1437, -320,
-	-1000, -1000, -1000, -1000, -1000, 963, -1000, 367, 367, 3053,
-	3245, -1000, 9078, -1000, 9078, 2312, 1135, -1000, 7554, 1291,
-	2563, 620, 16712, 29420, -1000, -1000, 7554, 7554, -1000, 2216,
-	-1000, 7554, 7554, 1394, -1000, 29420, -1000, -1000, -1000, -1000,
-	16712, -1000, 9078, -1000, 7554, 660, 2182, -32, -32, 2171,
-	2138, 2128, 959, -32, 2101, 2093, 2054, 2043, 2012, 2006,
-	1955, 1939, 1171, -1000, -1000, 1588, 1585, 958, -1000, 952,
-	1584, 944, 943, 6020, -1000, -91, 7554, 7554, 7554, 1991,
-	-1000, -1000, 90, 1580, 794, -1000, -1000, -1000, 30315, 1700,
-	1700, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1729, -1000, -1000, 1697, 1697, 1697, 1649, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1725, 1725, 1726, 1725,
-	-1000, 2078, -1000, -53, 1106, 392, 23824, 316, -1000, 29420,
-	1912, 270, 1998, 29420, 1720, 1718, 1717, 29420, 707, -1000,
-	1000, 225, -1000, 29420, 1171, -1000, 1277, -1000, 1076, -1000,
-	-1000, 2186, 968, -1000, -1000, 2186, 1076, 1076, 1219, 29420,
-	-1000, 2076, -1000, -1000, -1000, 30315, 587, -1000, 29420, 1198,
-	533, 373, 533, 1197, 1706, -1000, -1000, -1000, 29420, 29420,
-	29420, 1195, 1193, 30724, -1000, 1328, -1000, 1327, 1106, 1106,
-	1321, 1476, 1474, 1473, 1106, 1106, 1318, 1469, 24332, 1317,
-	1315, 1308, 1313, 1468, 608, 1311, 1309, 1299, 29420, 1705,
-	1421, -53, 1106, 145, 1118, 392, 1216, 14172, 29420, 21792,
-	21792, 21792, 21792, -1000, 1960, 1959, -1000, 1947, 1946, 1984,
-	29420, 21792, 1703, -1000, 24332, -1000, -1000, -1000, 2413, 939,
-	2389, 560, 7554, -1000, -1000, -1000, 496, 23824, 1467, 340,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 29420, 29420,
-	1575, 2228, -1000, 792, -1000, -1000, 998, -1000, 2228, -1000,
-	1307, 1459, -15, -2, 1190, -289, 6020, 257, 29420, -289,
-	29420, 6020, -1000, 29420, 254, -289, 29420, 1297, -1000, -1000,
-	-1000, 2229, 23824, 1277, 1063, 23316, -1000, 109, -1000, 105,
-	374, 1457, -1000, 391, 79, -1000, 1117, 1437, -1000, -1000,
-	-1000, 9078, -1000, -1000, -1000, -1000, 1171, 7554, 1572, -1000,
-	495, 495, 1571, -1000, 1700, 1700, -1000, 1649, 1697, 1649,
-	495, 495, 1566, -1000, 1445, 1917, -1000, 1665, 1655, 7554,
-	-1000, 1565, 2963, 854, -183, -32, -1000, 1687, -1000, -32,
-	-32, -32, -32, -1000, -32, -32, -32, -32, -32, -32,
-	-32, -32, -1000, -1000, -1000, 1453, -1000, -1000, -1000, 1295,
-	-1000, 1293, -1000, -15, 1171, 1171, -1000, -1000, 2034, 1450,
-	418, 10105, 2001, 226, 1279, -1000, -1000, 22808, 368, -1000,
-	-1000, -1000, 481, 135, 1292, 356, -1000, 29420, 165, 29420,
-	-1000, -1000, -1000, -1000, -1000, 1998, -1000, 764, 194, 10613,
-	10613, 10613, 361, 638, 987, 21792, 29420, -1000, 21284, 1564,
-	-1000, 679, 2186, -1000, 29420, -1000, 2186, 2186, 1076, -1000,
-	226, 2410, -1000, -1000, 29420, 29420, -1000, 29420, 29420, 373,
-	7554, -1000, -1000, -1000, 29420, -1000, -1000, -1000, -1000, 16712,
-	16712, -1000, -1000, -1000, -1000, 1449, 1448, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 306,
-	29420, 934, -1000, 1115, 1279, 22808, 1114, 1442, 368, -1000,
-	1438, -1000, 678, 29420, 29420, -1000, 825, -1000, 1110, 1883,
-	1905, 1883, -1000, -1000, -1000, -1000, 1950, -1000, 1943, -1000,
-	-1000, 825, -1000, -1000, -1000, -1000, -1000, 560, -1000, 2160,
-	533, 533, 533, 1562, -1000, 772, 1561, -1000, -1000, -1000,
-	-1000, -1000, 2225, 2207, 22300, 2225, -1000, -298, -295, 7,
-	2206, 2205, 2254, -1000, 1560, 762, -289, -1000, -1000, 760,
-	-1000, -1000, -1000, -289, -1000, 760, -1000, -1000, 1277, -1000,
-	98, -1000, -1000, -1000, -1000, -1000, -1000, 36, -1000, 29420,
-	-1000, 1437, 1433, 77, -1000, 1171, -1000, -1000, -1000, -1000,
+	-1000, 1860, 814, 1858, 2193, 2538, 1855, 7691, -1000, -1000,
+	1681, 7170, -1000, -1000, -1000, 1854, 1213, 1840, -1000, -1000,
+	-1000, 1839, 1231, 834, 1838, 1152, 1835, 1094, 7691, 7691,
+	1228, 1227, 7691, 7691, 7691, 7691, 1834, 7691, 7691, 7691,
+	7691, 7691, 7691, 7691, 7691, -1000, 7691, 60, -1000, -1000,
+	-1000, -1000, 1605, -1000, 1593, -1000, -1000, -1000, 1710, 1710,
+	-1000, 1590, -1000, -1000, -1000, -1000, 1579, -1000, -1000, 1559,
+	-1000, -1000, -1000, -1000, 1221, -1000, 1403, -1000, 1709, -1000,
+	1069, 1076, -1000, 1598, -1000, -1000, 35326, 11349, 35326, 1950,
+	2337, 58, -1000, 893, -1000, 32, -171, 2447, 35326, 2208,
+	817, 2187, 2537, 35326, 35326, 35326, 31679, -1000, 1986, 1215,
+	-1000, -1000, 7691, -1000, -1000, 2182, 35326, 35326, 2527, -1000,
+	-1000, -1000, 35326, -1000, -1000, -1000, 35326, 2527, 2527, 1295,
+	1350, 1350, -1000, -1000, 1350, -1000, -1000, 1212, -1000, 35326,
+	-1000, -1000, -1000, 1950, -1000, 1072, 36712, -1000, -1000, -1000,
+	9775, 13433, 470, 487, 721, 1290, 1290, 848, 1290, 1290,
+	1290, 1290, 376, 373, 1290, 1290, 1290, 1290, 1290, 1290,
+	1290, 1290, 1290, 1290, 1290, 1290, 1290, 1290, 1985, -1000,
+	55, 2400, 176, 893, 188, 2575, 928, -1000, -1000, -1000,
+	-1000, 20209, 20209, 16041, 21772, -1000, 1269, -1000, -1000, 733,
+	706, 741, 35326, -1000, -1000, -1000, 549, -1000, -1000, 817,
+	-1000, -1000, -1000, 1984, 1319, -1000, -1000, 1981, -1000, 4554,
+	4554, 4554, -1000, -1000, 21251, 35326, -1000, -157, -1000, -146,
+	2329, -1000, 785, 817, 2199, 1046, -1000, 1046, -1000, 11349,
+	-1000, -1000, 35326, 2192, 966, -1000, -1000, 12912, 1209, 966,
+	-300, 921, -111, -1000, 2321, 930, -1000, 1978, -1000, 1199,
+	2286, -1000, 1043, -1000, 1312, 1170, -1000, 930, 1169, 2285,
+	1043, 2329, -1000, 1205, -22, -1000, 247, -1000, -1000, 35326,
+	1708, 1166, 26461, 955, -1000, 545, 1204, 1203, -1000, 25419,
+	134, 25419, -1000, 25419, -1000, -1000, 244, -1000, 35326, 2323,
+	-1000, -1000, -1000, 1665, -322, -1000, -1000, -1000, -1000, -1000,
+	1164, -1000, 444, 444, 2945, 1793, -1000, 9254, -1000, 9254,
+	2469, 1346, -1000, 7691, 1363, 279, 1283, 19688, 35326, -61,
+	-1000, 7691, 7691, -1000, 2394, -1000, 7691, 7691, 1997, -1000,
+	35326, -1000, -1000, -1000, -1000, 19688, -1000, 9254, -1000, 7691,
+	896, 2351, -61, -61, 2344, 2300, 2271, 1151, -61, 2250,
+	2215, 2206, 2202, 2173, 2167, 2157, 2092, 1403, -1000, -1000,
+	1833, 1829, 1148, -1000, 1127, 1828, 1126, 1125, 6118, -1000,
+	-111, 7691, 7691, 7691, 2296, -1000, -1000, 100, 1827, 960,
+	-1000, -1000, -1000, 36359, 1949, 1949, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1975, -1000, -1000, 1944, 1944,
+	1944, 1906, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1964, 1964, 1967, 1964, -1000, 2364, -1000, -62, 1290,
+	362, 25419, 388, -1000, 35326, 584, 2444, 35326, 2190, 305,
+	2308, 35326, 1963, 1962, 1959, 35326, 945, -1000, 1202, 36267,
+	-1000, 35326, 1403, -1000, 1466, -1000, 1295, -1000, -1000, 2527,
+	1107, -1000, -1000, 2527, 1295, 1295, 1350, 35326, -1000, 2357,
+	599, 36832, -1000, 1958, -1000, 35326, -1000, -1000, 36359, 811,
+	-1000, 35326, 1420, 690, 490, 690, 1418, 1957, -1000, -1000,
+	35326, -1000, 35326, 35326, 35326, -1000, 1417, 1415, 35326, 35326,
+	-1000, 35326, 35326, -1000, -1000, 1558, -1000, 1556, 1290, 1290,
+	1554, 1705, 1703, 1698, 1290, 1290, 1545, 1697, 31150, 1544,
+	1540, 1535, 1592, 1691, 606, 1588, 1584, 1561, 35326, 1953,
+	1650, -62, 1290, 169, 1310, 362, 1468, 17083, 35326, 28545,
+	28545, 28545, 28545, -1000, 2238, 2227, -1000, 2252, 2223, 2264,
+	35326, 28545, 1950, -1000, 31150, -1000, -1000, -1000, 2603, 1115,
+	2671, 664, 20730, 7691, -1000, -1000, -1000, 686, -1000, 25419,
+	1690, 320, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2301, 1266, 35326, 35326, 1823, -1000, 35326, 2527, 6639,
+	-1000, 28545, -1000, -1000, 30629, -1000, 30108, 2527, -1000, 1534,
+	1689, -46, -34, 1414, -289, 6118, 252, 35326, -289, 35326,
+	6118, -1000, 35326, 242, -289, -1000, 35326, 1529, -1000, -1000,
+	-1000, -1000, 2529, 25419, 1466, 1254, 29587, -1000, 91, -1000,
+	117, 399, 1682, -1000, 583, 85, -1000, 1309, 1665, -1000,
+	-1000, -1000, 9254, -1000, -1000, -1000, -1000, 1403, 7691, 1813,
+	-1000, 673, 673, 1801, -1000, 1949, 1949, -1000, 1906, 1944,
+	1906, 673, 673, 1798, -1000, -1000, 1943, 1550, 2088, -1000,
+	2010, 1965, 7691, -1000, 1797, 3145, 1081, -182, -61, -1000,
+	-1000, -1000, -61, -61, -61, -61, -1000, -61, -61, -61,
+	-61, -61, -61, -61, -61, -1000, -1000, -1000, 1677, -1000,
+	-1000, -1000, 1527, -1000, 1525, -1000, -46, 1403, 1403, -1000,
+	-1000, 2283, 1673, 593, 11349, 2313, 238, 1528, -1000, -1000,
+	29066, 390, -1000, -1000, -1000, 451, 197, 1499, 336, -1000,
+	35326, 187, 35326, 26982, 2443, 35326, -1000, -1000, -1000, -1000,
+	-1000, 2308, -1000, 575, 236, 11870, 11870, 11870, 466, 906,
+	1180, 28545, 35326, -1000, 28024, 1792, -1000, 817, 2527, -1000,
+	35326, -1000, 2527, 2527, 1295, -1000, 238, -1000, 10828, 14475,
+	-1000, 448, -1000, 36952, -1000, -1000, 35326, 35326, -1000, 35326,
+	35326, 490, 7691, 959, -1000, -1000, -1000, 35326, -1000, 959,
+	-1000, 535, -1000, -1000, -1000, 19688, 19688, -1000, -1000, -1000,
+	-1000, 1672, 1671, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 7554, -1000, -1000, -1000, 1615, -1000, -1000, 7554, 1549,
-	1431, -1000, 357, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1538, 1537, -295, -1000,
-	-1000, -1000, 30315, -1000, 1366, -1000, -1000, 1429, 48, -1000,
-	-1000, -1000, 1428, 1427, 1289, -1000, -1000, 1286, 953, 58,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1216, 29420, 1686, -1000,
-	1106, 1106, 1106, 29420, 1535, 761, -1000, 1534, 1528, 17220,
-	21792, 21284, 804, -1000, 984, -1000, -1000, -1000, 2186, -1000,
-	-1000, 2186, -1000, -1000, 2410, -1000, -1000, 1205, 9078, -1000,
-	-1000, 1423, 16204, 545, 555, 1670, -1000, 269, 2253, -1000,
-	1185, 1184, -1000, 29420, -1000, 1669, 1832, 219, 1668, -1000,
-	1664, 1663, 29420, 1601, -1000, -1000, -1000, -1000, -1000, -1000,
-	308, 925, -1000, 1421, 1420, -1000, 48, 1419, -1000, -1000,
-	-1000, 29420, 678, 678, 2172, 29420, 6020, -1000, -1000, 7554,
-	1661, -1000, 7554, -1000, -1000, -1000, -1000, -1000, 1659, 2040,
-	-1000, -1000, -1000, -1000, -1000, -1000, 7554, 7554, -1000, -1000,
-	-1000, -298, 1416, -1000, -1000, 2204, 1407, 1382, 29420, -1000,
-	760, 760, 679, -1000, -1000, -63, -1000, -1000, -1000, 1594,
-	-1000, 1574, -32, -1000, 2172, -1000, 2197, -1000, -1000, -298,
-	-233, -1000, -1000, -1000, -1000, 222, -1000, -1000, 157, -1000,
-	-1000, 1256, 384, -1000, -1000, 678, 20268, 16712, 16204, 1403,
-	-1000, 30724, 10613, 118, 30724, 2186, 804, 984, -1000, -1000,
-	1055, -1000, -1000, -1000, -1000, 3053, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1267,
-	1658, -103, -1000, -1000, 1657, 20268, 265, 265, 20268, 20268,
-	20268, 1653, 450, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 2110, -1000, -1000, 1171, 29420, 1171, 20776, -1000, 2196,
-	2193, 1171, 599, -300, -1000, 1402, 1, -1000, -1000, 605,
-	-302, -17, -6, -1000, -1000, -1000, 66, 7554, -300, 29420,
-	371, 1400, -1000, -1000, 151, -1000, -1000, 924, -1000, 1649,
-	7554, -1000, -1000, -1000, 355, 30669, -1000, -1000, -63, 355,
-	2172, -1000, 1527, 7554, 1647, -214, 20268, 920, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 909, 903, 870, 20268, -1000,
-	-1000, -1000, 266, -1000, 865, 859, -1000, -1000, -1000, -1000,
-	-1000, 1645, -1000, 2191, -1000, 1392, 382, -13, -6, -1000,
-	2188, -1, 2187, 2185, 1521, -1000, 2971, -1000, -1000, -1000,
-	599, -1000, 2075, -1000, -1000, 31, -1000, -1000, -1000, 20268,
-	2047, 1544, 223, 2184, -1000, 223, 2110, -1000, 1460, -1000,
-	1903, -1000, 94, 855, -1000, -1000, -1000, -1000, 833, -1000,
-	-1000, -1000, 19760, 29420, 1382, -1000, 1644, 1266, 7, -5,
-	2180, -1000, 1382, 2179, 1382, 1382, -1000, -1000, 3987, -290,
-	-28, 280, 1385, -1000, 173, -1000, -1000, 2047, -1000, 2178,
-	260, -1000, -1000, 450, -1000, 1902, 1900, 2238, -1000, -1000,
-	-1000, -1000, 173, 173, 173, 173, 85, -1000, -1000, 813,
-	-1000, -1000, 2111, 15696, -21, -1000, -1000, -1000, 1384, -1000,
-	1382, -1000, -1000, 1164, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1106, 1381, 187, -1000, -1000, -1000, 19252, 227, 297,
-	244, -1000, 393, -1000, -1000, -1000, 2248, -1000, 2246, 548,
-	548, -1000, -1000, 29420, -1000, 29420, -1000, 788, -1000, -1000,
-	-1000, 954, -1000, -1000, -1000, -1000, 3987, 1262, -1000, 29420,
-	-1000, 29420, 247, 1249, 9078, 1643, 9078, 1637, 262, 1636,
-	-1000, -1000, -1000, 1252, 274, -1000, -1000, 544, -1000, 1101,
-	-1000, 18744, 29420, -1000, -1000, -1000, 749, 1631, 2177, -1000,
-	2802, 29420, 2779, 29420, 1629, 1097, 9078, -1000, -1000, -1000,
-	29420, 5512, -1000, 765, -1000, -1000, 352, 255, -1000, 748,
-	-1000, 732, 18236, 1222, 2738, -1000, -1000, 1171, 29420, 725,
-	-1000, 29420, 229, -1000, -1000, -1000, 715, -1000, -1000, -1000,
-	-1000, 352, 1676, -1000, 1218, -1000, -1000, 30558, 479, -1000,
-	-1000, 30558, 242, -1000, 343, 1493, -1000, -1000, 714, -1000,
-	29420, 527, 7554, -1000, 242, 30724, -1000, 7554, 669, -1000,
-	30724, 649, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 377, 35326, 1099, -1000, 1308,
+	1528, 29066, 1304, 1668, 390, -1000, 1666, -1000, 787, 35326,
+	35326, -1000, 1079, -1000, 1291, 2180, 2168, 2180, -1000, -1000,
+	-1000, -1000, 2226, -1000, 2204, -1000, -1000, 1079, -1000, -1000,
+	-1000, -1000, -1000, 664, -1000, 2440, 690, 690, 690, 645,
+	1784, -1000, 955, 1781, -1000, -1000, 2254, 2254, 2270, -1000,
+	-1000, -1000, -1000, 2483, -1000, 950, -1000, -1000, 1168, -1000,
+	2483, -1000, -300, -298, -28, 2516, 2511, 2546, -1000, 1779,
+	948, -289, -1000, -1000, 930, -1000, -1000, -1000, -289, -1000,
+	930, -1000, -1000, 1466, -1000, 107, -1000, -1000, -1000, -1000,
+	-1000, -1000, 8, -1000, 35326, -1000, 1665, 1664, 84, -1000,
+	1403, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 457, -1000, 7691, -1000, -1000,
+	-1000, 1914, -1000, -1000, 7691, 1765, 1663, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1764, 1762, -298, -1000, -1000, -1000, 36359, -1000, 994,
+	-1000, -219, 1660, 21, -1000, -1000, -1000, 1659, 1654, 1494,
+	-1000, -1000, 1518, 1211, 43, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1468, 35326, 2302, 850, -1000, -1000, -1000, 525, 2406,
+	1934, -1000, 1290, 1290, 1290, 35326, 1758, 933, -1000, 1752,
+	1748, 20209, 28545, 28024, 1049, -1000, 1131, -1000, -1000, -1000,
+	2527, -1000, -1000, 2527, -1000, 1089, -1000, 35326, -1000, 36952,
+	-1000, -1000, 1437, 9254, -1000, -1000, 1652, 19167, 590, 612,
+	1651, 1929, -1000, 344, 2544, -1000, 1413, 1411, -1000, 35326,
+	-1000, 1922, 2177, 274, 1921, -1000, 1919, 1918, 35326, 1731,
+	-1000, 35326, -1000, -1000, -1000, -1000, -1000, 400, 1088, -1000,
+	1650, 1649, -219, 21, 1647, -1000, -1000, -1000, 35326, 787,
+	787, 2520, 35326, 6118, -1000, -1000, 7691, 1913, -1000, 7691,
+	-1000, -1000, -1000, -1000, -1000, 1912, 2317, -1000, -1000, -1000,
+	7691, -1000, -1000, -1000, -1000, 2251, -1000, 2265, 2523, 2509,
+	27503, 2523, -1000, -300, 1646, -1000, -1000, 2506, 1642, 1622,
+	35326, -1000, 930, 930, 817, -1000, -1000, -81, -1000, -1000,
+	-1000, 2520, -1000, 2505, 1699, -1000, 1637, -61, -1000, -1000,
+	-1000, -300, -216, -1000, -1000, -1000, -3, -1000, -1000, 230,
+	-1000, -1000, 181, -1000, -1000, 1485, 381, -1000, -1000, 787,
+	1641, 186, 26982, 26461, 2174, 1466, -1000, -1000, -1000, -1000,
+	24898, 19688, 19167, 1636, -1000, 36832, 11870, 89, 36832, 2527,
+	1049, 1131, -1000, -1000, 1243, -1000, -1000, -1000, 448, 2280,
+	-1000, -1000, 2945, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1482, 1911, -118,
+	-1000, -1000, 1910, 24898, 481, 481, 24898, 24898, 24898, 1908,
+	526, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2466, -1000, -1000, 1403, 35326, 1403, 25940, -1000, 2503,
+	2489, 12391, -1000, -1000, -1000, 7691, 7691, -1000, -1000, -302,
+	-1000, 1634, -32, -1000, -1000, 863, -304, -64, -18, 90,
+	7691, -1000, -1000, -1000, -302, 35326, -3, -1000, 1480, 1478,
+	1475, 397, 1633, -1000, -1000, 180, -1000, -1000, -1000, 34,
+	35326, -1000, -1000, 1907, 817, 1074, -1000, 1906, 7691, -1000,
+	-1000, -1000, 449, 36712, -1000, -1000, -81, 449, 2520, -1000,
+	-1000, 36642, 567, 1745, 7691, 1903, -212, 24898, 1073, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1070, 1056, 1054, 24898,
+	-1000, -1000, -1000, 334, -1000, 1050, 1048, -1000, -1000, -1000,
+	-1000, 664, 1403, 814, -2, 1902, -1000, 2486, -1000, 1630,
+	494, -39, -18, -1000, 2485, -33, 2484, 2482, 1742, -1000,
+	2861, -1000, -1000, -1000, 814, -2, 2355, -1000, -1000, -1000,
+	-1000, -1000, -1000, 7, -1000, -1000, -1000, 25419, 787, 7691,
+	-1000, 24898, 2343, 1587, 291, 2481, -1000, 291, 2466, -1000,
+	36642, 309, -1000, 391, 1899, -1000, 1425, -1000, 2095, -1000,
+	70, 1040, -1000, -1000, -1000, -1000, 1022, -1000, -1000, -1000,
+	24377, -1000, 386, 1629, 35326, 1622, -1000, 1897, 1473, -28,
+	-44, 2479, -1000, 1622, 2475, 1622, 1622, -1000, -1000, 4033,
+	-290, -58, 287, 386, 1627, -1000, 785, 1394, 189, -1000,
+	-1000, 2343, -1000, 2474, 324, -1000, -1000, -1000, -1000, 1017,
+	-1000, 35326, 629, 7691, 526, -1000, 2093, 2091, 2534, -1000,
+	-1000, -1000, -1000, 189, 189, 189, 189, 81, -1000, -1000,
+	-1000, 1624, -1000, 1003, -1000, -1000, 2398, 18646, -51, -1000,
+	-1000, -1000, 1623, -1000, 1622, -1000, -1000, 1389, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1290, 1604, 205,
+	-1000, -1000, -1000, 23856, 315, 261, 258, -1000, 439, -1000,
+	-1000, 309, 36832, -1000, 7691, 985, -1000, -1000, 2543, -1000,
+	2535, 597, 597, -1000, -1000, -1000, 35326, -1000, 35326, -1000,
+	963, -1000, -1000, -1000, 1118, -1000, -1000, -1000, -1000, 4033,
+	1471, -1000, 35326, -1000, 35326, 303, 1469, 9254, 1894, 9254,
+	1890, 321, 1889, -1000, 36832, 947, -1000, -1000, -1000, -1000,
+	1487, 348, -1000, -1000, 768, -1000, 1281, -1000, 23335, 35326,
+	-1000, -1000, -1000, 934, 1805, 2472, -1000, 2844, 35326, 2090,
+	35326, 1643, 1252, 9254, -1000, -1000, -1000, -1000, 35326, 5597,
+	-1000, 865, -1000, -1000, 448, 326, -1000, 909, -1000, 880,
+	22814, 1462, 2009, -1000, -1000, 1403, 35326, 878, 294, -1000,
+	-1000, -1000, 877, -1000, -1000, -1000, -1000, -1000, 1444, -1000,
+	-1000,
 }
 
 var yyPgo = [...]int{
-	0, 132, 2818, 202, 153, 141, 201, 2817, 2811, 2094,
-	2088, 2810, 2809, 2808, 2807, 2790, 2782, 2781, 2767, 2766,
-	2765, 2764, 2761, 2754, 2753, 2752, 2749, 2736, 2734, 2726,
-	2725, 200, 2720, 2710, 2705, 2704, 2703, 2702, 2700, 2699,
-	2698, 2696, 2694, 2692, 2691, 2690, 2687, 2686, 2685, 2684,
-	2683, 2682, 2681, 2680, 2677, 2676, 2673, 133, 2670, 2051,
-	2668, 2667, 2666, 2662, 2661, 2659, 2658, 175, 2654, 2651,
-	2648, 2645, 2643, 2641, 2640, 2639, 2638, 2637, 2636, 2635,
-	2634, 2630, 2628, 2626, 2622, 161, 2621, 2618, 138, 2617,
-	2616, 2611, 2608, 196, 178, 43, 2601, 36, 2600, 2598,
-	2597, 2596, 2595, 69, 2592, 2591, 156, 158, 210, 1143,
-	216, 203, 150, 124, 66, 2590, 2089, 2588, 181, 186,
-	113, 20, 2587, 128, 2586, 108, 32, 35, 204, 112,
-	39, 109, 89, 2585, 179, 67, 2584, 80, 2583, 2582,
-	209, 151, 2581, 88, 2580, 2579, 2576, 2575, 169, 96,
-	2574, 52, 2573, 125, 74, 87, 84, 2572, 2571, 2570,
-	75, 2569, 2567, 2566, 2564, 136, 2563, 111, 63, 2562,
-	2561, 2560, 49, 188, 65, 2559, 54, 2553, 2552, 2551,
-	2550, 57, 2549, 2547, 12, 19, 21, 2546, 18, 2545,
-	2544, 1, 2543, 183, 45, 77, 107, 2542, 409, 2540,
-	2539, 2538, 118, 2537, 427, 2535, 2530, 2528, 2527, 11,
-	2526, 172, 38, 2525, 73, 100, 98, 167, 165, 2524,
-	2523, 2516, 442, 68, 50, 0, 2515, 123, 2514, 2512,
-	2511, 206, 2510, 190, 164, 189, 211, 218, 126, 2509,
-	2507, 64, 2506, 119, 70, 106, 10, 2502, 182, 2498,
-	352, 145, 2497, 171, 2496, 131, 3, 90, 2495, 2493,
-	33, 233, 2492, 2491, 2490, 103, 2486, 2485, 94, 91,
-	2484, 2483, 2482, 30, 2479, 28, 22, 2478, 93, 2477,
-	207, 2476, 192, 116, 146, 127, 122, 184, 187, 60,
-	58, 2475, 1104, 104, 72, 24, 2473, 185, 2472, 205,
-	199, 2470, 163, 2468, 198, 340, 173, 2467, 148, 8,
-	34, 27, 2456, 7, 2452, 102, 2451, 2440, 17, 2438,
-	26, 2435, 2434, 2433, 2426, 6, 2425, 2424, 2423, 2,
-	4, 2418, 5, 174, 37, 152, 2416, 140, 143, 2414,
-	2413, 71, 2412, 2399, 2398, 710, 2397, 2396, 2393, 2392,
-	2388, 2387, 2384, 2382, 2379, 76, 42, 2377, 2370, 2366,
-	2365, 55, 101, 2364, 2363, 2362, 2358, 29, 149, 2342,
-	16, 2338, 25, 23, 31, 2336, 92, 2335, 14, 159,
-	2333, 2332, 15, 2331, 2330, 13, 9, 2328, 2326, 85,
-	2321, 62, 44, 121, 78, 2320, 61, 180, 105, 2316,
-	2315, 197, 194, 168, 2314, 129, 191, 212, 2312, 177,
-	2311, 2305, 2304, 2303, 2300, 2299, 1124, 2298, 2297, 195,
-	53, 59, 86, 2296, 2294, 2292, 56, 117, 81, 82,
-	166, 2291, 160, 2289, 2287, 79, 2286, 2283, 2282, 2281,
-	2280, 155, 2278, 2272, 2270, 193, 137, 2268,
+	0, 148, 3103, 223, 164, 162, 216, 3098, 3096, 2356,
+	2338, 3095, 3092, 3091, 3089, 3088, 3087, 3085, 3084, 3082,
+	3081, 3078, 3064, 3063, 3062, 3061, 3060, 3038, 3024, 3016,
+	3015, 209, 3014, 3013, 3001, 3000, 2996, 2995, 2993, 2991,
+	2990, 2989, 2988, 2985, 2984, 2983, 2982, 2981, 2980, 2979,
+	2978, 2977, 2964, 2959, 2958, 2957, 2956, 143, 2955, 2336,
+	2954, 2952, 2945, 2941, 2940, 2934, 2933, 2931, 2930, 183,
+	2929, 2928, 2927, 2926, 2925, 2924, 2923, 2922, 2919, 2918,
+	2910, 2909, 2907, 2906, 2905, 2903, 2902, 166, 2900, 2899,
+	141, 2898, 2896, 2895, 2894, 207, 204, 175, 90, 59,
+	2893, 41, 2892, 2891, 2890, 2889, 2888, 2887, 2886, 2883,
+	2882, 2881, 2880, 2879, 2878, 80, 2876, 2869, 111, 179,
+	220, 1630, 212, 195, 120, 150, 82, 2868, 2358, 2864,
+	129, 197, 134, 21, 2862, 138, 2860, 125, 42, 31,
+	217, 119, 47, 128, 99, 135, 193, 46, 2859, 92,
+	2858, 2856, 222, 177, 2852, 106, 2850, 2848, 2846, 2844,
+	184, 32, 22, 107, 2843, 64, 2842, 139, 229, 97,
+	95, 133, 2841, 2838, 83, 2837, 2836, 2835, 2833, 161,
+	2832, 109, 79, 2831, 2830, 2828, 60, 199, 62, 2827,
+	70, 2826, 2825, 2824, 2818, 65, 2817, 2816, 11, 15,
+	19, 2812, 18, 2811, 2810, 2809, 140, 2808, 9, 2807,
+	170, 168, 66, 89, 2806, 387, 2805, 2800, 2799, 126,
+	2795, 523, 2794, 2793, 2791, 2790, 14, 2789, 186, 48,
+	2787, 85, 110, 101, 190, 182, 2786, 2785, 2784, 149,
+	52, 76, 0, 2783, 124, 2782, 2781, 2779, 215, 2778,
+	205, 202, 200, 240, 228, 171, 2776, 2775, 77, 2773,
+	137, 84, 113, 3, 2771, 174, 2770, 12, 173, 2768,
+	188, 2767, 130, 29, 123, 2766, 2765, 38, 241, 2764,
+	2763, 2761, 100, 2760, 2759, 154, 105, 2758, 2757, 2755,
+	37, 2752, 28, 23, 2751, 63, 2750, 219, 2749, 53,
+	114, 155, 172, 122, 36, 198, 67, 69, 2747, 900,
+	121, 86, 20, 2744, 196, 2742, 218, 213, 2741, 178,
+	2740, 210, 302, 191, 2739, 71, 4, 39, 30, 2738,
+	1, 2737, 115, 160, 2736, 2727, 10, 2725, 26, 2724,
+	2723, 2721, 2720, 56, 2719, 5, 2718, 13, 16, 2716,
+	25, 189, 40, 127, 2709, 158, 157, 2708, 2707, 81,
+	2706, 2705, 2704, 231, 2702, 2700, 2695, 2693, 2692, 2691,
+	2689, 2687, 2683, 87, 57, 2682, 2679, 2678, 2677, 72,
+	118, 2676, 2673, 2672, 2669, 33, 153, 2667, 17, 2665,
+	27, 24, 35, 2664, 108, 2663, 6, 169, 2655, 2654,
+	8, 2653, 2651, 2, 7, 2650, 2649, 96, 2647, 75,
+	55, 136, 103, 2645, 74, 194, 116, 2644, 2642, 208,
+	211, 187, 2641, 151, 206, 221, 2640, 185, 2631, 2630,
+	2627, 2626, 2622, 2620, 1262, 2619, 2616, 203, 58, 78,
+	94, 2615, 2609, 2605, 68, 131, 98, 73, 2604, 45,
+	61, 2603, 2601, 2599, 2598, 2596, 2595, 93, 181, 2594,
+	180, 2590, 2589, 91, 2587, 34, 2586, 2585, 2584, 176,
+	2582, 2580, 2579, 2573, 2572, 2571, 2569, 214, 146, 2568,
 }
 
-//line mysql_sql.y:8730
+//line mysql_sql.y:9223
 type yySymType struct {
 	union interface{}
 	id    int
@@ -4933,6 +5599,21 @@ func (st *yySymType) accountIdentifiedUnion() tree.AccountIdentified {
 	return v
 }
 
+func (st *yySymType) accountQuotaUnion() tree.AccountQuota {
+	v, _ := st.union.(tree.AccountQuota)
+	return v
+}
+
+func (st *yySymType) accountQuotaOptionUnion() tree.AccountQuotaOption {
+	v, _ := st.union.(tree.AccountQuotaOption)
+	return v
+}
+
+func (st *yySymType) accountQuotaOptionsUnion() []tree.AccountQuotaOption {
+	v, _ := st.union.([]tree.AccountQuotaOption)
+	return v
+}
+
 func (st *yySymType) accountRoleUnion() *tree.Role {
 	v, _ := st.union.(*tree.Role)
 	return v
@@ -4993,6 +5674,16 @@ func (st *yySymType) attributeReferenceUnion() *tree.AttributeReference {
 	return v
 }
 
+func (st *yySymType) auditEventUnion() tree.AuditEvent {
+	v, _ := st.union.(tree.AuditEvent)
+	return v
+}
+
+func (st *yySymType) auditEventsUnion() []tree.AuditEvent {
+	v, _ := st.union.([]tree.AuditEvent)
+	return v
+}
+
 func (st *yySymType) authRecordUnion() *tree.AuthRecord {
 	v, _ := st.union.(*tree.AuthRecord)
 	return v
@@ -5338,6 +6029,11 @@ func (st *yySymType) partitionsUnion() []*tree.Partition {
 	return v
 }
 
+func (st *yySymType) policyCommandUnion() tree.PolicyCommand {
+	v, _ := st.union.(tree.PolicyCommand)
+	return v
+}
+
 func (st *yySymType) privilegeUnion() *tree.Privilege {
 	v, _ := st.union.(*tree.Privilege)
 	return v
@@ -5428,6 +6124,16 @@ func (st *yySymType) selectExprsUnion() tree.SelectExprs {
 	return v
 }
 
+func (st *yySymType) selectLockInfoUnion() *tree.SelectLockInfo {
+	v, _ := st.union.(*tree.SelectLockInfo)
+	return v
+}
+
+func (st *yySymType) selectLockWaitPolicyUnion() tree.SelectLockWaitPolicy {
+	v, _ := st.union.(tree.SelectLockWaitPolicy)
+	return v
+}
+
 func (st *yySymType) selectStatementUnion() tree.SelectStatement {
 	v, _ := st.union.(tree.SelectStatement)
 	return v
@@ -5699,165 +6405,175 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 440, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 468, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 91, 438, 438, 438, 439, 439,
-	89, 89, 89, 78, 90, 388, 388, 387, 387, 386,
-	386, 342, 342, 385, 385, 385, 384, 384, 384, 383,
-	383, 382, 382, 381, 381, 379, 379, 380, 378, 377,
-	377, 377, 375, 375, 375, 371, 371, 373, 372, 372,
-	374, 366, 366, 369, 369, 367, 367, 367, 367, 370,
-	365, 365, 365, 364, 364, 77, 77, 77, 294, 294,
-	76, 76, 308, 308, 308, 308, 308, 306, 306, 306,
-	306, 306, 306, 305, 305, 304, 304, 309, 309, 307,
-	307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
-	307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
-	307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
-	307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
-	307, 307, 307, 307, 307, 307, 307, 307, 307, 68,
-	68, 68, 68, 71, 71, 71, 72, 303, 303, 303,
-	69, 70, 70, 293, 293, 298, 298, 297, 297, 297,
-	297, 297, 297, 297, 297, 297, 297, 297, 297, 302,
-	302, 302, 300, 300, 299, 299, 301, 301, 62, 62,
-	62, 65, 64, 292, 292, 292, 292, 292, 292, 292,
-	292, 292, 63, 63, 63, 63, 63, 63, 58, 58,
-	58, 58, 58, 57, 57, 59, 59, 290, 290, 289,
-	73, 73, 74, 442, 442, 441, 443, 443, 443, 443,
-	75, 81, 81, 81, 81, 81, 81, 81, 80, 80,
-	83, 83, 82, 84, 67, 67, 67, 67, 67, 66,
-	66, 66, 66, 66, 66, 66, 66, 66, 412, 412,
-	412, 414, 414, 229, 230, 444, 232, 228, 228, 228,
-	408, 408, 409, 410, 411, 411, 411, 79, 7, 7,
-	7, 7, 7, 7, 56, 61, 189, 189, 189, 190,
-	190, 190, 190, 190, 54, 60, 60, 425, 425, 55,
-	432, 432, 345, 345, 243, 243, 242, 242, 242, 242,
-	242, 242, 242, 242, 242, 242, 242, 242, 242, 242,
-	242, 242, 348, 349, 239, 31, 31, 31, 31, 31,
+	1, 1, 1, 1, 93, 466, 466, 466, 467, 467,
+	91, 91, 91, 80, 92, 406, 406, 405, 405, 404,
+	404, 360, 360, 403, 403, 403, 402, 402, 402, 401,
+	401, 400, 400, 399, 399, 397, 397, 398, 396, 395,
+	395, 395, 393, 393, 393, 389, 389, 391, 390, 390,
+	392, 384, 384, 387, 387, 385, 385, 385, 385, 388,
+	383, 383, 383, 382, 382, 79, 79, 79, 311, 311,
+	78, 78, 325, 325, 325, 325, 325, 323, 323, 323,
+	323, 323, 323, 322, 322, 321, 321, 326, 326, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 70,
+	70, 70, 70, 73, 73, 73, 74, 320, 320, 320,
+	71, 72, 72, 310, 310, 315, 315, 314, 314, 314,
+	314, 314, 314, 314, 314, 314, 314, 314, 314, 319,
+	319, 319, 317, 317, 316, 316, 318, 318, 62, 62,
+	62, 62, 62, 65, 65, 66, 67, 472, 472, 473,
+	473, 64, 309, 309, 309, 309, 309, 309, 309, 309,
+	309, 63, 63, 63, 63, 63, 63, 58, 58, 58,
+	58, 58, 57, 57, 59, 59, 307, 307, 306, 75,
+	75, 76, 470, 470, 469, 471, 471, 471, 471, 77,
+	83, 83, 83, 83, 83, 83, 83, 82, 82, 85,
+	85, 84, 86, 69, 69, 69, 69, 69, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 430,
+	430, 430, 246, 247, 474, 249, 245, 245, 245, 426,
+	426, 427, 428, 429, 429, 429, 81, 81, 7, 7,
+	7, 7, 7, 7, 56, 61, 203, 203, 203, 207,
+	207, 207, 204, 204, 206, 206, 206, 206, 206, 206,
+	475, 475, 476, 476, 476, 205, 205, 205, 205, 205,
+	205, 54, 60, 60, 443, 443, 55, 460, 460, 363,
+	363, 260, 260, 259, 259, 259, 259, 259, 259, 259,
+	259, 259, 259, 259, 259, 259, 259, 259, 259, 366,
+	367, 256, 31, 31, 31, 31, 31, 31, 31, 31,
 	31, 31, 31, 31, 31, 31, 31, 31, 31, 31,
-	31, 31, 31, 31, 31, 31, 31, 31, 38, 37,
-	37, 37, 279, 279, 36, 445, 445, 218, 218, 45,
-	46, 47, 48, 49, 50, 35, 44, 44, 44, 44,
-	44, 44, 44, 44, 44, 53, 53, 360, 360, 447,
-	447, 447, 51, 52, 344, 344, 344, 43, 42, 41,
-	40, 40, 34, 34, 33, 33, 39, 101, 102, 236,
-	236, 236, 238, 238, 234, 446, 446, 320, 320, 237,
-	237, 32, 32, 32, 32, 235, 235, 217, 233, 233,
-	233, 8, 8, 6, 11, 11, 11, 11, 11, 11,
-	11, 11, 11, 11, 18, 20, 287, 287, 284, 19,
-	14, 13, 16, 12, 15, 17, 5, 5, 5, 5,
-	9, 9, 10, 113, 113, 155, 155, 420, 420, 416,
-	416, 417, 417, 417, 418, 418, 419, 419, 85, 354,
-	354, 354, 354, 354, 354, 4, 136, 136, 135, 135,
-	353, 353, 353, 353, 353, 353, 291, 291, 397, 397,
-	397, 398, 134, 134, 129, 129, 355, 355, 257, 399,
-	399, 363, 363, 362, 362, 361, 361, 132, 132, 133,
-	133, 116, 116, 94, 94, 368, 368, 368, 368, 376,
-	376, 341, 341, 181, 181, 212, 212, 213, 213, 106,
-	106, 107, 107, 107, 107, 107, 107, 405, 405, 407,
-	407, 406, 131, 131, 127, 127, 128, 128, 128, 126,
-	126, 125, 124, 124, 123, 121, 121, 121, 122, 122,
-	122, 109, 109, 109, 108, 108, 108, 108, 108, 198,
-	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
-	198, 110, 110, 413, 413, 413, 346, 346, 346, 351,
-	351, 195, 195, 196, 196, 194, 194, 111, 111, 112,
-	112, 112, 112, 193, 193, 192, 114, 114, 120, 119,
-	119, 115, 115, 115, 115, 203, 203, 202, 202, 202,
-	202, 88, 92, 92, 93, 139, 139, 201, 200, 200,
-	200, 138, 138, 137, 137, 130, 130, 118, 118, 118,
-	118, 199, 117, 197, 437, 437, 436, 436, 435, 433,
-	433, 433, 434, 434, 434, 434, 390, 390, 390, 390,
-	390, 223, 223, 223, 227, 227, 226, 226, 226, 226,
-	226, 231, 3, 3, 3, 3, 3, 24, 24, 24,
-	24, 24, 24, 30, 147, 148, 29, 140, 140, 141,
-	141, 142, 142, 143, 144, 144, 144, 146, 145, 28,
-	21, 421, 424, 422, 422, 426, 426, 426, 427, 427,
-	427, 428, 428, 22, 98, 103, 103, 100, 105, 105,
-	105, 105, 105, 99, 423, 429, 429, 429, 288, 288,
-	285, 286, 286, 283, 282, 282, 282, 431, 431, 430,
-	430, 430, 224, 224, 23, 278, 278, 280, 281, 281,
-	281, 272, 272, 272, 272, 27, 276, 276, 277, 277,
-	277, 277, 277, 273, 273, 275, 275, 271, 271, 271,
-	271, 271, 26, 104, 104, 270, 270, 268, 268, 266,
-	266, 267, 267, 265, 265, 265, 269, 269, 25, 25,
-	25, 96, 95, 95, 95, 215, 215, 214, 214, 97,
-	347, 347, 310, 310, 311, 311, 311, 314, 314, 326,
-	326, 327, 327, 325, 325, 332, 332, 331, 331, 330,
-	330, 329, 329, 328, 328, 328, 328, 323, 323, 322,
-	322, 312, 312, 312, 312, 312, 313, 313, 313, 321,
-	321, 324, 324, 172, 172, 173, 173, 173, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 395, 395, 396,
-	175, 175, 175, 179, 179, 179, 179, 179, 179, 174,
-	174, 176, 176, 156, 156, 154, 154, 149, 149, 150,
-	150, 151, 151, 152, 152, 153, 153, 153, 153, 153,
-	153, 296, 296, 393, 393, 394, 394, 389, 389, 389,
-	392, 392, 392, 392, 392, 391, 391, 157, 210, 210,
-	210, 225, 225, 225, 225, 209, 209, 209, 171, 171,
-	170, 170, 168, 168, 168, 168, 168, 168, 168, 168,
-	168, 168, 168, 168, 168, 168, 168, 295, 295, 240,
-	240, 241, 241, 188, 187, 187, 187, 187, 187, 185,
-	186, 184, 184, 184, 184, 184, 183, 183, 182, 182,
-	182, 274, 274, 180, 180, 178, 178, 178, 177, 177,
-	177, 333, 246, 246, 246, 246, 246, 246, 246, 246,
-	246, 246, 246, 246, 246, 248, 248, 248, 248, 248,
-	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
-	248, 248, 248, 248, 248, 249, 249, 254, 254, 404,
-	404, 403, 158, 158, 158, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 167, 167, 167, 318, 318, 318,
-	318, 318, 319, 319, 319, 316, 316, 317, 317, 258,
-	259, 259, 352, 352, 315, 315, 208, 208, 208, 208,
+	31, 31, 31, 31, 31, 31, 31, 38, 37, 37,
+	37, 296, 296, 36, 477, 477, 235, 235, 45, 46,
+	47, 48, 49, 50, 35, 44, 44, 44, 44, 44,
+	44, 44, 44, 44, 53, 53, 378, 378, 479, 479,
+	479, 51, 52, 362, 362, 362, 43, 42, 41, 40,
+	40, 34, 34, 33, 33, 39, 105, 106, 109, 112,
+	253, 253, 253, 255, 255, 251, 478, 478, 338, 338,
+	254, 254, 32, 32, 32, 32, 252, 252, 234, 250,
+	250, 250, 8, 8, 6, 11, 11, 11, 11, 11,
+	11, 11, 11, 11, 11, 11, 11, 11, 18, 20,
+	304, 304, 301, 19, 14, 13, 16, 12, 15, 17,
+	5, 5, 5, 5, 9, 9, 10, 125, 125, 169,
+	169, 438, 438, 434, 434, 435, 435, 435, 436, 436,
+	437, 437, 87, 372, 372, 372, 372, 372, 372, 4,
+	148, 148, 147, 147, 371, 371, 371, 371, 371, 371,
+	308, 308, 415, 415, 415, 416, 146, 146, 141, 141,
+	373, 373, 274, 417, 417, 381, 381, 380, 380, 379,
+	379, 144, 144, 145, 145, 128, 128, 96, 96, 386,
+	386, 386, 386, 394, 394, 359, 359, 195, 195, 229,
+	229, 161, 161, 162, 162, 230, 230, 118, 118, 119,
+	119, 119, 119, 119, 119, 97, 97, 97, 97, 98,
+	98, 98, 423, 423, 425, 425, 424, 143, 143, 139,
+	139, 140, 140, 140, 138, 138, 137, 136, 136, 135,
+	133, 133, 133, 134, 134, 134, 121, 121, 121, 120,
+	120, 120, 120, 120, 215, 215, 215, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 122, 122, 432, 432,
+	431, 431, 431, 364, 364, 364, 369, 369, 212, 212,
+	213, 213, 211, 211, 123, 123, 124, 124, 124, 124,
+	210, 210, 209, 126, 126, 132, 131, 131, 127, 127,
+	127, 127, 220, 220, 219, 219, 219, 219, 90, 94,
+	94, 95, 151, 151, 218, 217, 217, 217, 150, 150,
+	149, 149, 142, 142, 130, 130, 130, 130, 216, 129,
+	214, 214, 465, 465, 464, 464, 463, 461, 461, 461,
+	462, 462, 462, 462, 408, 408, 408, 408, 408, 240,
+	240, 240, 244, 244, 243, 243, 243, 243, 243, 248,
+	3, 3, 3, 3, 3, 3, 3, 3, 24, 24,
+	24, 24, 24, 24, 30, 159, 160, 29, 152, 152,
+	153, 153, 154, 154, 155, 156, 156, 156, 158, 157,
+	28, 21, 439, 442, 440, 440, 444, 444, 444, 445,
+	445, 445, 446, 446, 447, 447, 448, 448, 449, 449,
+	449, 22, 102, 115, 115, 104, 117, 117, 117, 117,
+	117, 103, 107, 108, 110, 451, 451, 450, 450, 452,
+	452, 453, 453, 454, 454, 111, 113, 455, 455, 455,
+	455, 455, 456, 456, 114, 441, 457, 457, 457, 305,
+	305, 302, 303, 303, 300, 299, 299, 299, 459, 459,
+	458, 458, 458, 241, 241, 23, 295, 295, 297, 298,
+	298, 298, 289, 289, 289, 289, 27, 293, 293, 294,
+	294, 294, 294, 294, 290, 290, 292, 292, 288, 288,
+	288, 288, 288, 26, 116, 116, 287, 287, 285, 285,
+	283, 283, 284, 284, 282, 282, 282, 286, 286, 25,
+	25, 25, 100, 99, 99, 99, 232, 232, 231, 231,
+	101, 365, 365, 327, 327, 328, 328, 328, 331, 331,
+	344, 344, 345, 345, 343, 343, 350, 350, 349, 349,
+	348, 348, 347, 347, 346, 346, 346, 346, 341, 341,
+	340, 340, 329, 329, 329, 329, 329, 330, 330, 330,
+	339, 339, 342, 342, 186, 186, 187, 187, 187, 208,
+	208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
 	208, 208, 208, 208, 208, 208, 208, 208, 208, 208,
-	208, 208, 208, 359, 359, 359, 205, 205, 205, 205,
-	205, 205, 205, 205, 205, 205, 205, 205, 415, 415,
-	415, 400, 400, 400, 401, 401, 401, 401, 401, 401,
-	401, 401, 401, 401, 401, 401, 402, 402, 402, 402,
-	402, 402, 402, 402, 402, 402, 402, 402, 402, 402,
-	402, 402, 402, 207, 207, 207, 206, 206, 206, 206,
-	206, 206, 206, 206, 206, 206, 206, 206, 206, 260,
-	260, 261, 261, 356, 356, 356, 356, 356, 356, 357,
-	357, 358, 358, 358, 358, 350, 350, 350, 350, 350,
-	350, 350, 350, 350, 350, 350, 350, 350, 350, 350,
-	350, 350, 350, 350, 350, 350, 350, 350, 350, 350,
-	350, 350, 350, 350, 247, 204, 204, 204, 262, 255,
-	255, 256, 256, 250, 250, 250, 250, 250, 250, 250,
-	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
-	252, 245, 245, 245, 245, 245, 245, 245, 245, 245,
-	245, 245, 251, 251, 253, 253, 264, 264, 264, 263,
-	263, 263, 263, 263, 263, 263, 169, 169, 169, 169,
-	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
-	244, 160, 160, 160, 160, 164, 164, 166, 166, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 166, 166,
-	166, 165, 165, 165, 165, 163, 163, 163, 163, 163,
-	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
-	161, 161, 161, 161, 161, 161, 86, 87, 87, 162,
-	211, 211, 334, 334, 337, 337, 335, 335, 336, 338,
-	338, 338, 339, 339, 339, 340, 340, 340, 343, 343,
-	216, 216, 216, 222, 222, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
-	221, 221, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 220, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219,
+	208, 208, 208, 208, 208, 208, 208, 208, 413, 413,
+	414, 189, 189, 189, 193, 193, 193, 193, 193, 193,
+	188, 188, 190, 190, 170, 170, 168, 168, 163, 163,
+	164, 164, 165, 165, 166, 166, 167, 167, 167, 167,
+	167, 167, 313, 313, 411, 411, 412, 412, 407, 407,
+	407, 410, 410, 410, 410, 410, 409, 409, 171, 227,
+	227, 227, 242, 242, 242, 242, 226, 226, 226, 185,
+	185, 184, 184, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 312,
+	312, 257, 257, 258, 258, 202, 201, 201, 201, 201,
+	201, 199, 200, 198, 198, 198, 198, 198, 197, 197,
+	196, 196, 196, 291, 291, 194, 194, 192, 192, 192,
+	191, 191, 191, 351, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 265, 265, 265,
+	265, 265, 265, 265, 265, 265, 265, 265, 265, 265,
+	265, 265, 265, 265, 265, 265, 265, 266, 266, 271,
+	271, 422, 422, 421, 172, 172, 172, 173, 173, 173,
+	173, 173, 173, 173, 173, 173, 181, 181, 181, 336,
+	336, 336, 336, 336, 337, 337, 337, 334, 334, 335,
+	335, 275, 276, 276, 370, 370, 332, 332, 333, 225,
+	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
+	225, 225, 225, 225, 225, 225, 377, 377, 377, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 433, 433, 433, 418, 418, 418, 419, 419,
+	419, 419, 419, 419, 419, 419, 419, 419, 419, 419,
+	420, 420, 420, 420, 420, 420, 420, 420, 420, 420,
+	420, 420, 420, 420, 420, 420, 420, 224, 224, 224,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 277, 277, 278, 278, 374, 374, 374,
+	374, 374, 374, 375, 375, 376, 376, 376, 376, 368,
+	368, 368, 368, 368, 368, 368, 368, 368, 368, 368,
+	368, 368, 368, 368, 368, 368, 368, 368, 368, 368,
+	368, 368, 368, 368, 368, 368, 368, 368, 264, 221,
+	221, 221, 279, 272, 272, 273, 273, 267, 267, 267,
+	267, 267, 267, 267, 269, 269, 269, 269, 269, 269,
+	269, 269, 269, 269, 269, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 268, 268, 270, 270,
+	281, 281, 281, 280, 280, 280, 280, 280, 280, 280,
+	183, 183, 183, 183, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 261, 174, 174, 174, 174, 178,
+	178, 180, 180, 180, 180, 180, 180, 180, 180, 180,
+	180, 180, 180, 180, 180, 179, 179, 179, 179, 177,
+	177, 177, 177, 177, 175, 175, 175, 175, 175, 175,
+	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
+	88, 89, 89, 176, 228, 228, 352, 352, 355, 355,
+	353, 353, 354, 356, 356, 356, 357, 357, 357, 358,
+	358, 358, 361, 361, 233, 233, 233, 239, 239, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 238, 238, 238, 238, 237, 237, 237,
+	237, 237, 237, 237, 237, 237, 237, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
+	236, 236, 236, 236,
 }
 
 var yyR2 = [...]int{
@@ -5865,7 +6581,7 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 0, 4, 0, 1, 1, 0, 1,
-	6, 8, 10, 10, 2, 0, 2, 1, 3, 3,
+	6, 8, 12, 10, 2, 0, 2, 1, 3, 3,
 	3, 0, 2, 1, 3, 5, 0, 2, 3, 1,
 	3, 1, 1, 1, 3, 1, 1, 1, 1, 0,
 	3, 3, 0, 3, 3, 0, 1, 3, 0, 1,
@@ -5882,121 +6598,131 @@ var yyR2 = [...]int{
 	2, 4, 6, 1, 4, 1, 3, 3, 4, 4,
 	4, 3, 3, 2, 4, 4, 2, 2, 2, 1,
 	1, 1, 1, 1, 1, 3, 1, 3, 1, 1,
-	1, 2, 2, 0, 1, 4, 2, 4, 1, 5,
-	3, 2, 1, 2, 2, 4, 4, 5, 2, 1,
-	3, 4, 4, 1, 2, 9, 7, 1, 3, 3,
-	1, 1, 3, 1, 3, 2, 1, 2, 1, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 4, 4,
-	2, 4, 3, 3, 1, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
+	1, 1, 1, 2, 5, 2, 3, 0, 1, 0,
+	1, 2, 0, 1, 4, 2, 4, 1, 5, 3,
+	2, 1, 2, 2, 4, 4, 5, 2, 1, 3,
+	4, 4, 1, 2, 9, 7, 1, 3, 3, 1,
+	1, 3, 1, 3, 2, 1, 2, 1, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 4, 4, 2,
+	4, 3, 3, 1, 1, 1, 1, 1, 2, 3,
+	4, 7, 5, 2, 3, 3, 6, 4, 5, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 2, 1, 1, 1, 1, 6, 1, 1,
-	1, 1, 1, 1, 7, 4, 2, 2, 1, 2,
-	2, 2, 3, 2, 7, 7, 8, 0, 4, 7,
-	0, 3, 0, 2, 0, 1, 1, 1, 1, 4,
-	2, 2, 3, 3, 4, 5, 3, 4, 4, 2,
-	2, 2, 3, 0, 1, 1, 1, 1, 1, 1,
+	3, 2, 1, 1, 1, 1, 3, 6, 1, 1,
+	1, 1, 1, 1, 7, 4, 1, 1, 1, 5,
+	3, 3, 1, 3, 2, 3, 2, 3, 5, 3,
+	0, 1, 0, 1, 1, 2, 2, 2, 1, 3,
+	2, 8, 7, 8, 0, 4, 7, 0, 3, 0,
+	2, 0, 1, 1, 1, 1, 4, 2, 2, 3,
+	3, 4, 5, 3, 4, 4, 2, 2, 2, 3,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 2,
-	5, 5, 0, 2, 7, 0, 1, 0, 1, 5,
-	3, 2, 4, 4, 4, 4, 1, 1, 1, 3,
-	2, 3, 1, 1, 1, 6, 8, 0, 1, 1,
-	1, 1, 5, 5, 0, 1, 1, 3, 3, 3,
-	6, 7, 4, 4, 7, 8, 3, 3, 3, 0,
-	2, 2, 0, 2, 2, 1, 1, 1, 1, 0,
-	1, 4, 4, 5, 4, 1, 3, 1, 1, 3,
-	5, 2, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 4, 4, 1, 3, 1, 4,
-	6, 4, 4, 4, 3, 6, 1, 1, 2, 2,
-	11, 8, 9, 1, 3, 2, 4, 0, 2, 0,
-	1, 1, 1, 1, 0, 1, 0, 1, 4, 2,
-	1, 5, 4, 4, 2, 5, 0, 2, 1, 3,
-	2, 1, 5, 4, 4, 2, 0, 5, 0, 1,
-	3, 3, 1, 3, 1, 3, 1, 3, 4, 0,
-	1, 0, 1, 1, 3, 1, 1, 0, 4, 1,
-	3, 2, 1, 0, 8, 0, 4, 7, 4, 0,
-	2, 0, 2, 0, 2, 0, 4, 1, 3, 1,
-	1, 4, 3, 4, 5, 4, 5, 2, 3, 1,
-	3, 6, 0, 3, 0, 1, 2, 4, 4, 0,
-	1, 3, 1, 3, 3, 0, 1, 1, 0, 2,
-	2, 3, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 1, 2, 2, 1, 2, 2, 1, 2,
-	2, 7, 7, 1, 1, 1, 0, 1, 1, 1,
-	1, 0, 2, 0, 3, 0, 2, 1, 3, 1,
-	2, 3, 5, 0, 1, 2, 1, 3, 1, 1,
-	1, 4, 4, 4, 3, 2, 2, 2, 3, 2,
-	3, 4, 1, 3, 4, 0, 2, 1, 1, 2,
-	2, 0, 1, 2, 4, 1, 3, 1, 3, 2,
-	3, 1, 4, 3, 0, 1, 1, 2, 5, 2,
-	2, 2, 0, 2, 3, 3, 0, 1, 3, 1,
-	3, 0, 1, 2, 1, 1, 0, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 7, 1, 1, 12, 1, 3, 0,
-	1, 1, 3, 1, 1, 2, 4, 1, 1, 7,
-	7, 1, 4, 1, 1, 3, 4, 3, 0, 1,
-	1, 0, 2, 7, 8, 0, 2, 6, 0, 2,
-	2, 3, 3, 4, 1, 0, 2, 2, 1, 3,
-	2, 1, 3, 2, 1, 3, 2, 0, 1, 3,
-	4, 3, 1, 1, 4, 1, 3, 1, 1, 1,
-	1, 0, 1, 1, 1, 11, 0, 2, 3, 2,
-	3, 1, 1, 1, 3, 3, 4, 0, 2, 2,
-	2, 2, 6, 0, 4, 1, 1, 0, 3, 0,
-	1, 1, 2, 4, 4, 4, 0, 1, 11, 9,
-	11, 2, 2, 4, 5, 1, 3, 0, 3, 5,
-	0, 1, 0, 6, 0, 3, 5, 0, 4, 0,
-	3, 1, 3, 4, 5, 0, 3, 1, 3, 2,
-	3, 1, 2, 0, 4, 6, 5, 0, 2, 0,
-	2, 4, 5, 4, 5, 1, 5, 6, 5, 0,
-	3, 0, 1, 0, 1, 1, 3, 2, 3, 3,
-	4, 4, 3, 3, 3, 3, 4, 4, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 4, 2, 5,
+	5, 0, 2, 7, 0, 1, 0, 1, 5, 3,
+	2, 4, 4, 4, 4, 1, 1, 1, 3, 2,
+	3, 1, 1, 1, 6, 8, 0, 1, 1, 1,
+	1, 5, 5, 0, 1, 1, 3, 3, 3, 6,
+	7, 4, 4, 7, 8, 3, 3, 3, 3, 4,
+	0, 2, 2, 0, 2, 2, 1, 1, 1, 1,
+	0, 1, 4, 4, 5, 4, 1, 3, 1, 1,
+	3, 5, 2, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	1, 3, 1, 4, 6, 4, 4, 4, 3, 6,
+	1, 1, 2, 2, 11, 8, 9, 1, 3, 2,
+	4, 0, 2, 0, 1, 1, 1, 1, 0, 1,
+	0, 1, 4, 2, 1, 5, 4, 4, 2, 5,
+	0, 2, 1, 3, 2, 1, 5, 4, 4, 2,
+	0, 5, 0, 1, 3, 3, 1, 3, 1, 3,
+	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
+	1, 0, 4, 1, 3, 2, 1, 0, 10, 0,
+	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
+	4, 0, 2, 0, 2, 1, 3, 1, 1, 5,
+	3, 4, 6, 4, 5, 0, 3, 3, 4, 0,
+	1, 2, 2, 3, 1, 3, 6, 0, 3, 0,
+	1, 2, 4, 4, 0, 1, 3, 1, 3, 3,
+	0, 1, 1, 0, 2, 2, 3, 3, 3, 1,
+	3, 3, 3, 3, 1, 2, 2, 1, 2, 2,
+	1, 2, 2, 1, 2, 2, 8, 8, 0, 1,
+	1, 1, 1, 0, 1, 1, 1, 1, 0, 2,
+	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
+	0, 1, 2, 1, 3, 1, 1, 1, 4, 4,
+	4, 3, 2, 2, 2, 3, 2, 3, 4, 1,
+	3, 4, 0, 2, 1, 1, 2, 2, 0, 1,
+	2, 4, 1, 3, 1, 3, 2, 3, 1, 4,
+	3, 7, 0, 1, 1, 2, 5, 2, 2, 2,
+	0, 2, 3, 3, 0, 1, 3, 1, 3, 0,
+	1, 2, 1, 1, 0, 1, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 7, 1, 1, 12, 1, 3,
+	0, 1, 1, 3, 1, 1, 2, 4, 1, 1,
+	7, 8, 1, 4, 1, 1, 3, 4, 3, 0,
+	1, 1, 0, 2, 0, 2, 1, 2, 2, 2,
+	2, 7, 8, 0, 2, 6, 0, 2, 2, 3,
+	3, 4, 9, 4, 10, 1, 3, 1, 1, 0,
+	2, 0, 2, 0, 2, 5, 12, 0, 2, 2,
+	2, 2, 0, 2, 6, 1, 0, 2, 2, 1,
+	3, 2, 1, 3, 2, 1, 3, 2, 0, 1,
+	3, 4, 3, 1, 1, 4, 1, 3, 1, 1,
+	1, 1, 0, 1, 1, 1, 11, 0, 2, 3,
+	2, 3, 1, 1, 1, 3, 3, 4, 0, 2,
+	2, 2, 2, 6, 0, 4, 1, 1, 0, 3,
+	0, 1, 1, 2, 4, 4, 4, 0, 1, 11,
+	9, 11, 2, 2, 4, 5, 1, 3, 0, 3,
+	5, 0, 1, 0, 6, 0, 3, 5, 0, 4,
+	0, 3, 1, 3, 4, 5, 0, 3, 1, 3,
+	2, 3, 1, 2, 0, 4, 6, 5, 0, 2,
+	0, 2, 4, 5, 4, 5, 1, 5, 6, 5,
+	0, 3, 0, 1, 0, 1, 1, 3, 2, 3,
+	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 5, 4, 1, 3, 3,
-	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 1, 3, 0, 1, 1,
-	3, 1, 1, 2, 1, 7, 7, 7, 7, 8,
-	5, 0, 1, 0, 1, 1, 1, 1, 3, 3,
-	1, 1, 1, 1, 1, 0, 1, 3, 1, 3,
-	5, 1, 1, 1, 1, 1, 3, 5, 0, 1,
-	1, 2, 1, 2, 2, 1, 1, 2, 2, 2,
-	2, 2, 1, 5, 6, 4, 1, 1, 2, 0,
-	1, 1, 2, 5, 0, 1, 1, 2, 2, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 2, 2,
-	2, 0, 3, 0, 3, 1, 1, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 1, 1, 1, 1, 3, 5,
-	2, 2, 2, 2, 1, 1, 2, 5, 6, 6,
-	6, 1, 1, 1, 1, 0, 2, 0, 1, 1,
-	2, 4, 1, 2, 2, 1, 2, 2, 1, 2,
-	2, 2, 2, 2, 0, 1, 1, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 5, 0, 1, 3,
-	0, 1, 0, 2, 0, 6, 8, 6, 5, 5,
-	6, 6, 6, 6, 5, 6, 6, 6, 6, 6,
-	6, 6, 6, 1, 1, 1, 4, 4, 6, 8,
-	6, 4, 5, 4, 6, 6, 7, 4, 1, 1,
+	3, 3, 3, 3, 3, 4, 5, 4, 1, 3,
+	3, 0, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 3, 0, 1,
+	1, 3, 1, 1, 2, 1, 7, 7, 7, 7,
+	8, 5, 0, 1, 0, 1, 1, 1, 1, 3,
+	3, 1, 1, 1, 1, 1, 0, 1, 3, 1,
+	3, 5, 1, 1, 1, 1, 1, 3, 5, 0,
+	1, 1, 2, 1, 2, 2, 1, 1, 2, 2,
+	2, 2, 2, 2, 1, 5, 6, 4, 1, 1,
+	2, 0, 1, 1, 2, 5, 0, 1, 1, 2,
+	2, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	2, 2, 2, 0, 3, 0, 3, 1, 1, 1,
+	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 1, 1, 1, 1,
+	3, 5, 2, 2, 2, 2, 1, 1, 2, 5,
+	6, 6, 6, 1, 1, 1, 1, 0, 2, 0,
+	1, 1, 2, 4, 1, 2, 2, 1, 2, 2,
+	1, 2, 2, 2, 2, 2, 0, 1, 1, 2,
+	2, 2, 2, 2, 1, 1, 1, 2, 5, 0,
+	1, 3, 0, 1, 0, 2, 0, 1, 6, 8,
+	6, 5, 5, 6, 6, 6, 6, 5, 6, 6,
+	6, 6, 6, 6, 6, 6, 1, 1, 1, 4,
+	5, 4, 6, 8, 6, 4, 5, 4, 6, 6,
+	7, 4, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 8,
+	4, 2, 3, 2, 4, 4, 6, 2, 2, 4,
+	6, 4, 2, 0, 1, 2, 3, 1, 1, 1,
+	1, 1, 1, 0, 2, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 2, 8, 4, 2, 3, 2,
-	4, 4, 6, 2, 2, 4, 6, 4, 2, 0,
-	1, 2, 3, 1, 1, 1, 1, 1, 1, 0,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
+	1, 1, 3, 0, 1, 1, 3, 3, 3, 3,
+	3, 2, 1, 1, 3, 4, 3, 4, 3, 4,
+	3, 4, 3, 4, 1, 3, 4, 4, 5, 4,
+	5, 3, 4, 5, 6, 1, 0, 2, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 3, 1, 1, 1, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 0, 1, 1, 3, 0,
-	1, 1, 3, 3, 3, 3, 3, 2, 1, 1,
-	3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
-	1, 3, 4, 4, 5, 4, 5, 3, 4, 5,
-	6, 1, 0, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 4, 4, 1,
+	2, 3, 5, 1, 1, 3, 0, 1, 0, 3,
+	0, 3, 3, 0, 3, 5, 0, 3, 5, 0,
+	1, 1, 0, 1, 1, 2, 2, 0, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 3, 1, 1, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 1, 2, 2, 2, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 4, 4, 1, 2, 3, 5, 1,
-	1, 3, 0, 1, 0, 3, 0, 3, 3, 0,
-	3, 5, 0, 3, 5, 0, 1, 1, 0, 1,
-	1, 2, 2, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -6019,573 +6745,609 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -440, -2, -1, -3, -89, -4, -85, -5, -6,
-	-8, -66, -80, -82, -84, -83, -31, -7, -79, -57,
-	-58, -62, -68, -73, -76, -77, -78, -90, -86, -87,
-	-88, -106, -91, -24, -23, -22, -21, -98, 401, 12,
-	446, -9, -10, -405, -11, 233, -228, -229, -230, -232,
-	-444, -32, -33, -34, -40, -41, -42, -43, -51, -52,
-	-53, -35, -36, -37, -38, -45, -46, -47, -48, -49,
-	-50, -39, -101, -102, -55, -54, -60, -56, -61, -100,
-	209, -59, 61, -63, -64, -65, -69, -70, -71, -72,
-	-74, -75, 332, 338, 391, 528, 529, 46, -107, -109,
-	530, -25, -26, -27, -28, -29, -30, 205, 14, 479,
-	-12, -15, -13, -16, -14, -19, -20, -18, -17, -99,
-	228, 227, 37, 291, 292, 293, 336, 226, 206, 13,
-	137, 138, 140, 141, 30, 41, 314, -108, 67, 207,
-	-110, 10, 532, 439, 531, -116, 38, -154, -225, 70,
-	78, -219, -221, 482, 483, 484, 485, 486, 487, 488,
-	489, 490, 491, 492, 493, 494, 495, 496, 497, 498,
-	499, 500, 501, 502, 503, 505, 506, 507, 508, 509,
-	510, 511, 512, 513, 514, 515, 516, 517, 518, 449,
-	312, 313, 476, 245, 269, 362, 272, 279, 137, 156,
-	150, 178, 170, 145, 249, 284, 250, 77, 140, 432,
-	88, 412, 388, 143, 264, 266, 268, 271, 251, 166,
-	161, 263, 247, 528, 159, 252, 39, 253, 183, 254,
-	255, 481, 408, 311, 414, 276, 386, 160, 409, 267,
-	411, 185, 189, 424, 338, 154, 155, 416, 426, 182,
-	225, 42, 478, 237, 427, 187, 181, 177, 435, 280,
-	278, 180, 153, 176, 248, 50, 191, 190, 192, 382,
-	383, 384, 385, 256, 257, 326, 423, 173, 162, 316,
-	146, 22, 430, 232, 413, 339, 258, 273, 281, 186,
-	188, 239, 244, 480, 390, 243, 277, 428, 158, 236,
-	265, 231, 431, 147, 332, 341, 259, 141, 270, 425,
-	434, 49, 354, 152, 144, 222, 138, 241, 246, 11,
-	260, 261, 262, 283, 282, 274, 174, 238, 179, 164,
-	151, 175, 139, 240, 433, 355, 526, 314, 371, 172,
-	169, 242, 215, 429, 415, 142, 375, 356, 167, 285,
-	522, 523, 524, 327, 310, 286, 287, 165, 229, 407,
-	288, 290, 381, 303, 357, 389, 363, 358, 198, 294,
-	418, 184, 525, 410, 295, 296, 297, -116, -59, -10,
-	-9, -108, -109, -154, 213, -233, 23, 386, -67, 387,
-	209, 67, -225, -5, -4, -85, -57, -106, -231, -225,
-	291, 291, -231, 213, -225, 243, 370, -345, 218, -305,
-	-278, 244, -304, -280, -307, -281, 31, 205, 207, 206,
-	240, 14, 336, 214, 12, 10, 337, 226, 24, 25,
-	27, 13, 338, 340, 28, 341, 344, 345, 346, 41,
-	349, 350, 233, 70, 78, 73, 251, -148, -225, -256,
-	-250, 95, 234, -252, -245, -246, -248, -385, -379, -244,
-	67, 121, 122, 129, 96, -247, -333, 35, 98, 486,
-	447, -205, -206, -207, -208, -225, -380, -378, 73, 79,
-	82, 85, 86, 84, 83, 163, 81, 74, 133, 134,
-	-109, 70, -400, 494, -220, 515, 514, 46, -350, -358,
-	212, -356, 132, 168, 229, 164, 12, 127, 381, 165,
-	491, 511, 449, 495, 518, 488, 489, 483, 484, 485,
-	487, 496, 498, 510, -359, 506, 516, 517, 504, 72,
-	71, 509, 508, 497, 492, 493, 499, 482, 490, 500,
-	501, 507, 512, 513, 320, 88, 321, 322, 439, 315,
-	323, 218, 386, 55, 324, 325, 326, 327, 328, 446,
-	329, 56, 330, 319, 233, 371, 331, 167, 184, 451,
-	450, 452, 443, 440, 438, 441, 442, 444, 445, 502,
-	503, 505, -301, -299, -225, -92, -93, 520, -125, -126,
-	-198, 19, 6, 7, 8, 9, -438, 388, 480, 294,
-	333, 215, 312, 371, 295, 243, -272, -270, -347, 288,
-	284, 223, 222, 89, 439, 212, 347, -416, -417, 202,
-	203, 204, -407, 472, -406, -225, 322, 26, 213, 333,
-	418, 419, 420, 421, 422, -44, -360, -344, 415, 414,
-	-237, 413, 406, 417, 408, 313, 297, 296, 205, 473,
-	-216, 357, 389, 239, 436, 437, 334, 390, 424, 425,
-	409, 88, 171, 168, 215, 213, 312, 439, 371, 295,
-	-416, 142, 139, -292, 142, 94, 147, 146, -292, 243,
-	370, 40, -298, 381, -297, -299, 424, 425, 435, 71,
-	72, 423, -216, 88, 407, 407, -126, -198, -125, -107,
-	-109, -88, -405, 312, 371, 243, 214, 213, 215, 439,
-	291, 333, 295, -346, -413, 31, -351, 199, 200, 201,
-	32, 33, -1, -225, 73, -132, 235, -154, 135, -132,
-	-126, -125, -126, -154, -209, -225, 388, 104, -67, -67,
-	387, -408, -409, -410, -412, 209, 387, 386, 135, 15,
-	-231, -231, 65, -154, -280, 243, -305, -278, 35, 64,
-	136, 216, 136, 64, 67, 334, 312, 371, 335, 439,
-	213, 347, 215, 243, 348, 312, 371, 213, 215, 439,
-	243, 312, 213, 215, 371, 243, 348, 406, 407, 215,
-	26, 339, 342, 343, 407, -364, 435, 136, 94, 91,
-	92, 93, -250, 111, -263, 104, 105, 106, 107, 108,
-	109, 110, 118, 117, 128, 121, 122, 123, 124, 125,
-	126, 127, 119, 120, 114, 95, 112, 116, 113, 97,
-	-109, -250, -256, 46, -248, -248, -248, -248, -333, -254,
-	-250, 67, 67, 135, 73, -250, 67, 67, 67, 67,
-	67, 67, 67, 67, -357, 67, 67, -260, -261, 67,
-	67, 67, 73, 73, 67, 67, 67, 73, -261, -261,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 136, -160, -164,
-	-161, -163, -162, -166, -165, 168, 169, 132, 172, 174,
-	175, 176, 177, 178, 179, 180, 181, 182, 183, 30,
-	184, 229, 164, 165, 166, 167, 185, 150, 170, 477,
-	193, 151, 194, 152, 195, 153, 196, 154, 155, 197,
-	156, 159, 160, 161, 158, 135, -126, 136, 67, -94,
-	38, -128, 21, -110, -109, 20, 31, 32, 31, 32,
-	31, 32, 31, 32, 79, -147, -225, -140, -225, -268,
-	218, -268, -268, -268, -268, 214, -268, 213, 213, 213,
-	-418, 149, 136, -407, -131, 67, -236, 112, 116, 23,
-	241, 241, 278, -445, -446, 15, 114, -234, -446, -234,
-	-236, -447, 214, 416, 42, 242, 241, -127, -128, -127,
-	410, 407, -320, 411, 412, -237, -236, -236, -237, -236,
-	-236, -236, 213, 215, 439, 295, -445, 241, -445, 30,
-	30, -345, -154, -345, 473, -217, -225, -345, -345, -419,
-	217, 428, 479, 145, 146, 147, -280, 243, 243, 136,
-	104, 23, -300, 104, 115, -299, -299, -299, -300, -300,
-	-174, 40, -224, 132, -225, 73, -174, 40, -442, -441,
-	-154, -127, -110, -109, 68, 68, 68, -345, -345, -345,
-	-345, -345, -345, -345, -231, -140, -345, -111, -112, 123,
-	-250, -225, -111, 38, 407, 38, -353, 46, -106, 67,
-	30, 67, -225, -354, 46, -106, 67, 30, -127, -94,
-	-128, 135, 79, 73, -67, 68, 136, -411, 85, 86,
-	-414, 182, 174, -225, -81, 73, -3, -4, -5, -6,
-	-31, -57, -106, -381, -379, 67, 31, 380, 64, 15,
-	-306, 213, 439, 333, 239, 215, 312, -304, -287, -284,
-	-282, -224, -280, -283, -282, -309, -209, 407, -95, 392,
-	290, -250, -250, -250, -250, -250, 84, 95, 310, 85,
-	86, -245, -264, 31, 286, 287, -246, -246, -246, -246,
-	-246, -246, -246, -246, -246, -246, -246, -246, -253, -262,
-	-333, 67, 114, 112, 116, 113, 97, -248, -248, -246,
-	-246, 68, 136, -404, -403, 99, -250, -250, -225, -401,
-	-402, 453, 454, 455, 456, 457, 458, 459, 460, 461,
-	462, 463, 324, 319, 325, 323, 315, 331, 326, 327,
-	167, 470, 471, 464, 465, 466, 467, 468, 469, -255,
-	-256, -255, -250, -401, -255, -204, 32, 31, -250, -415,
-	308, 307, 309, -129, -225, -255, 68, 68, 68, 79,
-	-256, -256, -255, -246, -255, -402, -204, -204, -256, -256,
-	-204, -204, -204, -204, 123, -204, -204, -204, -204, -204,
-	-204, -204, -204, -299, 40, -340, 355, 354, -334, -336,
-	67, -335, 67, -335, -335, -335, 67, 67, -337, 67,
-	-337, -337, -334, -338, 67, -338, -339, 67, -338, -225,
-	-127, -93, -362, -361, -250, 40, 521, -94, -250, -124,
-	-123, -250, -439, 73, 34, 67, 135, -154, 95, -421,
-	-225, -288, -285, -282, -225, -278, -225, -225, -268, -268,
-	-268, -419, -406, 34, -130, -225, -194, 16, -248, -248,
-	-282, 243, -445, -236, -218, -217, -238, -446, -233, -238,
-	-194, -446, -236, -236, -238, -234, 407, -194, -194, -320,
-	-235, -225, -235, -268, -217, -218, -218, -154, -189, 210,
-	207, -173, -191, 352, 245, -269, 249, 77, 250, 388,
-	251, 214, 253, 254, 255, 225, 256, 257, 258, 381,
-	259, 260, 261, 262, 335, 6, 298, 40, -421, -421,
-	30, -288, -225, -119, -114, -118, -115, -120, -197, -199,
-	-117, 67, -154, -109, -225, 429, 430, 143, 146, 145,
-	31, 380, -303, 380, 31, -278, -297, -293, 73, 381,
-	-283, -302, 64, 132, -361, -300, -300, -300, -302, -302,
-	131, 136, -443, 428, 429, 202, -94, -421, -287, -278,
-	-225, -156, -154, -156, -225, 67, -225, -193, 136, -192,
-	15, -226, -225, 34, 73, 135, -193, 73, -156, 73,
-	-291, 64, -355, -257, -399, 520, -134, 68, -129, -397,
-	-398, -129, -133, -225, -355, -134, 68, -397, -94, -94,
-	-225, 23, -67, -409, 135, 136, -130, -306, -286, -283,
-	-308, 123, -225, -294, 136, 479, 533, 71, 216, -431,
-	-430, 372, 68, 136, -365, 217, 446, 73, 534, 198,
-	84, 310, 85, 86, -333, -256, -253, -248, -248, -246,
-	-246, -251, 230, -251, 94, -250, -249, -403, 101, -250,
-	34, 136, 65, 135, 68, 68, 15, 15, 68, -250,
-	68, 15, 15, -250, 68, 135, 68, 68, 68, 68,
-	65, 68, 136, 68, 136, -256, -250, 68, 68, -250,
-	-250, -250, -256, 68, -250, -250, -250, -250, -250, -250,
-	-250, -250, -250, -343, 356, 79, 79, -211, 73, -211,
-	79, 79, 79, 136, 68, 73, 136, 22, 136, -121,
-	36, 37, -148, -141, -142, -143, -144, -160, -209, 169,
-	172, 174, 175, 176, 177, 178, 179, 180, 181, 182,
-	183, 184, 229, 164, 165, 166, 167, 185, 150, 170,
-	151, 152, 153, 154, 155, 156, 159, 160, 161, 158,
-	-225, -131, 35, -424, 359, -432, 136, 40, -430, 439,
-	-271, 65, -104, 15, -154, -154, -154, 15, -113, -155,
-	-225, 67, 68, 136, -250, -279, 65, -225, -218, -194,
-	-225, -154, -225, -194, -194, -236, -238, -238, -234, 135,
-	-217, -131, -151, -157, -152, -209, -241, -153, 221, 219,
-	223, -394, 89, 224, 275, 90, 214, -190, 214, 90,
-	220, 224, 219, 136, -191, -222, 104, -222, -216, 131,
-	-222, -222, -222, -222, 252, 252, -222, -222, -222, -222,
-	-222, -222, -222, -222, -222, -222, -222, -222, -222, -222,
-	67, -425, 359, 30, 318, -432, -105, 312, 30, -200,
-	-201, -202, -203, 53, 57, 59, 54, 55, 56, 60,
-	30, 136, -223, -227, 34, -225, 73, -223, -109, -114,
-	-119, -223, 67, 144, 147, 147, 146, 216, 67, 104,
-	-302, -302, -302, 40, -224, -441, 435, 429, 64, 136,
-	-141, -194, -112, -114, -225, 73, -225, 123, -194, -181,
-	523, 38, -368, 411, 39, 136, 67, 68, 136, 46,
-	136, 104, 68, 136, 68, 46, 135, 388, -225, -379,
-	68, -308, 136, 216, 135, 135, -284, 338, -224, -286,
-	20, 479, -209, 38, -215, -214, 73, 534, 68, -251,
-	-251, 94, -248, -245, 68, 102, -250, 100, -158, -160,
-	354, 355, -159, -165, 132, 168, 229, 167, 166, 164,
-	354, 355, -174, -225, -250, -250, 68, -250, -250, 15,
-	-225, -174, -246, -250, -126, 68, -315, 402, -315, 68,
-	68, 68, 68, -315, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 136, 68, 68, 68, 136,
-	68, 136, -361, -368, -250, -250, -123, -122, 43, 346,
-	68, 136, -160, 34, -427, 413, 361, -222, -243, -242,
-	314, 41, -348, 381, 367, 368, -285, 243, -225, 64,
-	302, 303, 304, 305, -266, -267, -265, -269, -421, 67,
-	67, 67, -154, -113, -225, 15, 136, -420, 135, -1,
-	-225, -278, -236, -194, -446, -194, -236, -236, -238, -225,
-	34, -160, -153, -225, 90, -393, -394, -268, -393, 90,
-	67, -225, -225, -225, 90, 90, -191, 79, 79, -222,
-	-222, 79, 73, 73, 73, -222, -222, 79, 73, -227,
-	79, 79, 79, 79, 40, 73, -179, 40, 263, 267,
-	264, 265, 266, 79, 40, 79, 40, 79, 40, -225,
-	67, -395, -396, 73, -427, -222, 318, 104, -243, -103,
-	77, 31, -135, 210, 207, -421, -290, -289, -209, -118,
-	-118, -118, -118, 53, 53, 53, 58, 53, 58, 53,
-	-202, -290, -120, -131, -227, 68, -437, -436, -435, -433,
-	61, 217, 62, -255, 147, -286, -239, 73, -293, -154,
-	-154, 68, -196, 17, 135, -196, 79, 73, -376, 398,
-	393, 395, 90, -257, -363, -362, 46, -106, -129, -355,
-	-398, -361, -225, 46, -106, -355, -225, 79, 15, -283,
-	-278, 123, 123, -225, 339, -294, 73, 360, 73, 213,
-	535, 136, 104, -215, -245, -250, 68, -167, 155, 154,
-	-167, 68, -335, -335, -334, -337, -334, -167, -167, 68,
-	68, 23, 68, 68, 68, -250, 68, 68, 136, -352,
-	448, -315, 67, -315, -315, -315, -315, -315, -315, -315,
-	-315, -315, -315, -315, -315, 73, 79, 79, -376, 44,
-	45, 73, 211, -143, 40, -106, -428, 77, -422, 73,
-	-225, -429, 77, 362, 134, 316, 40, 363, 364, 378,
-	311, 79, 79, 369, -423, -225, -136, 312, -154, -265,
-	-216, 131, 254, 295, -149, -150, -151, -149, -149, -132,
-	65, 135, -114, -155, -225, 123, 68, -194, -225, -194,
-	-194, -236, -106, -171, -170, -168, 84, 95, 40, 352,
-	-169, 77, 131, 268, 246, 269, -188, -240, 64, 358,
-	219, 89, 90, 340, -241, -389, -391, -225, -391, -225,
-	-389, -389, -268, -250, -225, -174, -174, 73, 73, -175,
-	246, -156, 68, 136, 104, -428, -422, 104, 73, -429,
-	73, 136, -135, -135, -194, 136, 104, -138, -137, 64,
-	65, -139, 64, -137, 53, 53, -194, -435, -434, 23,
-	-394, -394, -394, 68, 68, -195, 18, 20, 123, -195,
-	-181, -341, 522, -372, -374, 393, 20, 20, 13, 68,
-	-355, -355, -278, -294, 381, -154, -214, 73, 535, -250,
-	68, -250, 68, 73, -259, -258, 235, 68, 68, -341,
-	-145, -160, -244, 73, -426, 372, 73, 73, 79, 40,
-	79, 134, 365, -349, -103, -135, 67, -222, -222, -222,
-	-225, 68, 136, 68, 68, -223, -114, -225, -194, -420,
-	135, -194, -194, -168, 84, -246, 73, -176, -224, 132,
-	-177, 40, 267, 263, -178, 40, 247, 248, -180, 67,
-	275, 13, 90, 90, -154, 67, 65, 285, 67, 67,
-	67, -391, 68, 247, 248, 68, -396, 73, -426, 73,
-	-421, -126, -289, -361, -250, 67, -250, 67, 53, 19,
-	17, -250, -256, -181, 73, 20, 73, -370, 73, -290,
-	-97, -366, -320, 68, 68, -315, -126, 20, -181, 478,
-	20, 479, 315, 40, 79, 40, 366, -273, -275, -209,
-	67, -174, -176, 73, -172, -173, -151, -96, -95, -172,
-	-194, -194, 79, 67, -356, -274, 67, -273, -392, 302,
-	303, 304, 306, 305, -392, -273, -273, -273, 67, -296,
-	-295, 276, 95, -127, -130, -390, -225, 219, 20, 20,
-	-212, 524, 73, 395, -342, 525, -375, 398, -369, -367,
-	393, 394, 395, 396, -317, -316, -319, 399, 277, 405,
-	-256, -212, -146, -225, 73, 360, 73, 315, 68, 136,
-	-334, -250, -310, 235, -97, -310, -126, 68, -250, -260,
-	-183, -182, 475, -273, 68, 68, 68, 68, -273, 276,
-	68, 68, 136, 67, 20, 73, -377, 217, -373, -374,
-	397, -367, 20, 395, 20, 20, 68, -318, 97, 365,
-	369, -250, 34, 381, -276, -275, -121, 68, -311, 284,
-	20, -311, -127, 68, -187, -185, -186, 64, 409, 273,
-	274, 68, -276, -276, -276, -276, 68, -225, 219, -213,
-	-225, -370, -384, 67, 79, -372, -371, -373, 20, -370,
-	20, -370, -370, -318, 520, 403, 404, 403, 404, 73,
-	-277, 225, 77, 479, 300, 301, -121, 20, -312, 277,
-	278, -313, -324, 280, -295, -186, 64, -185, 64, 14,
-	13, -188, 68, 136, -388, 30, 68, -383, -382, -210,
-	-378, -225, 398, 399, 73, -370, 94, -222, 73, 299,
-	-209, 67, -322, 281, 67, -320, 67, -320, 90, 303,
-	-184, 270, 271, 30, 146, -184, -225, -387, -386, -385,
-	68, 136, 135, -318, 79, -225, -309, -314, 282, 79,
-	-246, 67, -246, 67, -321, 279, 67, 84, 40, 272,
-	136, 104, -382, -225, 68, -326, 67, 20, 68, -309,
-	68, -309, 67, 104, -246, -386, 40, -250, 135, -327,
-	-325, 235, -313, 68, 68, 68, -309, 79, 68, -225,
-	68, 136, -225, -323, 283, 68, -325, -328, 46, 79,
-	-332, -329, 67, -191, 237, 114, -332, -191, -331, -330,
-	282, 238, 67, 68, 136, -225, 234, 67, -256, -330,
-	-329, -256, 68, 68,
+	-1000, -468, -2, -1, -3, -91, -4, -87, -5, -6,
+	-8, -68, -82, -84, -86, -85, -31, -7, -81, -57,
+	-58, -62, -70, -75, -78, -79, -80, -92, -88, -89,
+	-90, -118, -93, -24, -23, -22, -21, -102, -107, -110,
+	-113, 415, 13, 460, -9, -10, -423, -11, 240, -245,
+	-246, -247, -249, -474, -32, -33, -34, -40, -41, -42,
+	-43, -51, -52, -53, -35, -36, -37, -38, -45, -46,
+	-47, -48, -49, -50, -39, -105, -106, -109, -112, -55,
+	-54, -60, -56, -61, -104, 215, -59, 65, -63, -64,
+	-65, -66, -67, -71, -72, -73, -74, -76, -77, 344,
+	350, 405, 542, 543, 47, -119, -121, 544, -25, -26,
+	-27, -28, -29, -30, 211, 15, 493, -12, -15, -13,
+	-16, -14, -19, -20, -18, -17, -103, -108, -111, -114,
+	235, 234, 38, 298, 299, 300, 348, 233, 212, 14,
+	141, 142, 144, 145, 154, 151, 31, 42, 326, -120,
+	71, 213, -122, 11, 546, 453, 545, -128, 39, -168,
+	-242, 74, 82, -236, -238, 496, 497, 498, 499, 500,
+	501, 502, 503, 504, 505, 506, 507, 508, 509, 510,
+	511, 512, 513, 514, 515, 516, 517, 519, 520, 521,
+	522, 523, 524, 525, 526, 527, 528, 529, 530, 531,
+	532, 463, 324, 325, 490, 252, 276, 374, 279, 286,
+	141, 162, 156, 184, 176, 149, 256, 291, 257, 81,
+	144, 446, 92, 426, 402, 147, 271, 273, 275, 278,
+	258, 172, 167, 270, 254, 542, 165, 259, 40, 260,
+	189, 261, 262, 495, 422, 323, 428, 283, 400, 166,
+	423, 274, 425, 191, 195, 438, 350, 160, 161, 430,
+	440, 188, 232, 43, 492, 244, 441, 193, 187, 183,
+	449, 54, 287, 285, 186, 159, 182, 255, 51, 218,
+	197, 196, 198, 394, 395, 396, 397, 398, 399, 263,
+	264, 338, 437, 179, 168, 328, 150, 52, 23, 444,
+	239, 427, 351, 265, 280, 288, 192, 194, 246, 251,
+	494, 404, 250, 284, 442, 164, 243, 272, 238, 445,
+	151, 344, 353, 266, 145, 277, 439, 448, 50, 53,
+	366, 158, 148, 229, 142, 248, 253, 12, 267, 268,
+	269, 290, 289, 281, 180, 245, 185, 170, 157, 181,
+	143, 247, 447, 367, 540, 326, 383, 178, 175, 249,
+	222, 443, 429, 146, 387, 368, 173, 292, 536, 537,
+	538, 339, 322, 293, 294, 171, 236, 421, 295, 297,
+	393, 315, 369, 403, 375, 370, 204, 301, 432, 190,
+	539, 424, 302, 303, 304, 305, 306, 307, 308, 309,
+	-128, -59, -10, -9, -120, -121, -168, 220, -250, 24,
+	400, -69, 401, 215, 71, -242, -5, -4, -87, -57,
+	-118, -248, -242, 298, 298, -248, 220, -242, 250, 382,
+	-363, 225, -322, -295, 251, -321, -297, -324, -298, 32,
+	211, 213, 212, 247, 15, 348, 221, 13, 11, 349,
+	233, 25, 26, 28, 14, 350, 352, 29, 353, 356,
+	357, 358, 42, 361, 362, 240, 74, 82, 77, 258,
+	-160, -242, -273, -267, 99, 241, -269, -262, -263, -265,
+	-403, -397, -261, 71, 125, 126, 133, 100, -264, -351,
+	36, 102, 500, 461, -222, -223, -224, -225, -242, -398,
+	-396, 77, 83, 86, 89, 90, 88, 87, 169, 85,
+	78, 137, 138, -121, 74, -418, 508, -237, 529, 528,
+	47, -368, -376, 219, -374, 136, 174, 236, 170, 13,
+	131, 393, 171, 505, 525, 463, 509, 532, 502, 503,
+	497, 498, 499, 501, 510, 512, 524, -377, 520, 530,
+	531, 518, 76, 75, 523, 522, 511, 506, 507, 513,
+	496, 504, 514, 515, 521, 526, 527, 332, 92, 333,
+	334, 453, 327, 335, 225, 400, 59, 336, 337, 338,
+	339, 340, 460, 341, 60, 342, 331, 240, 383, 343,
+	173, 190, 465, 464, 466, 457, 454, 452, 455, 456,
+	458, 459, 516, 517, 519, -318, -316, -242, -94, -95,
+	534, -137, -138, -215, 20, 7, 8, 9, 10, -466,
+	402, 494, 301, 345, 222, 324, 383, 302, 305, 307,
+	308, 250, -289, -287, -365, 295, 291, 230, 229, 93,
+	453, 219, 359, -434, -435, 208, 209, 210, -425, 486,
+	-424, -242, 334, 27, 220, 345, 432, 433, 434, 435,
+	436, -44, -378, -362, 429, 428, -254, 427, 420, 431,
+	422, 325, 304, 303, 306, 307, 211, 487, -233, 369,
+	403, 246, 450, 451, 346, 404, 438, 439, 423, 92,
+	177, 174, 222, 220, 324, 453, 383, 302, -434, 146,
+	143, -309, 146, 98, 151, 150, -309, -472, 146, -242,
+	154, 250, 382, 41, -315, 393, -314, -316, 438, 439,
+	449, 75, 76, 437, -233, 92, 421, 421, -138, -215,
+	-137, -119, -121, -90, -423, 324, 383, 250, 221, 220,
+	222, 453, 298, 345, 302, 305, 307, 308, -432, 5,
+	-1, -242, 77, -144, 242, -168, 139, -144, -138, -137,
+	-138, -168, -226, -242, 402, 108, -69, -69, 400, 401,
+	-426, -427, -428, -430, 215, 401, 400, 139, 16, -248,
+	-248, 69, -168, -297, 250, -322, -295, 36, 68, 140,
+	223, 140, 68, 71, 346, 324, 383, 347, 453, 220,
+	359, 222, 250, 360, 324, 383, 220, 222, 453, 250,
+	324, 220, 222, 383, 250, 360, 420, 421, 222, 27,
+	351, 354, 355, 421, -382, 449, 140, 98, 95, 96,
+	97, -267, 115, -280, 108, 109, 110, 111, 112, 113,
+	114, 122, 121, 132, 125, 126, 127, 128, 129, 130,
+	131, 123, 124, 118, 99, 116, 120, 117, 101, -121,
+	-267, -273, 47, -265, -265, -265, -265, -351, -271, -267,
+	71, 71, 139, 77, -267, 71, 71, 71, 71, 71,
+	71, 71, 71, -375, 71, 71, -277, -278, 71, 71,
+	71, 77, 77, 71, 71, 71, 77, -278, -278, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 71, 71, 71, 71, 71, 140, -174, -178, -175,
+	-177, -176, -180, -179, 174, 175, 136, 178, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 31, 190,
+	236, 170, 171, 172, 173, 191, 156, 176, 491, 199,
+	157, 200, 158, 201, 159, 202, 160, 161, 203, 162,
+	165, 166, 167, 164, 139, -138, 140, 71, -96, 39,
+	-140, 22, -122, -121, 21, 32, 33, 32, 33, 32,
+	33, 32, 33, 83, -159, -242, -152, -242, -285, 225,
+	-285, -285, -285, -285, 308, -285, -285, 221, -285, 220,
+	220, 220, -436, 153, 140, -425, -143, 71, -253, 116,
+	120, 24, 248, 248, 285, -477, -478, 16, 118, -251,
+	-478, -251, -253, -479, 221, 430, 43, 249, 248, -139,
+	-140, -139, 424, 421, -338, 425, 426, -254, -253, -253,
+	-254, -253, -253, -253, -253, 309, 220, 222, 453, 302,
+	-477, 248, -477, 31, 31, -363, -168, -363, 487, -234,
+	-242, -363, -363, -437, 224, 442, 493, 149, 150, 151,
+	223, -242, -297, 250, 250, 140, 108, 24, -317, 108,
+	119, -316, -316, -316, -317, -317, -188, 41, -241, 136,
+	-242, 77, -188, 41, -470, -469, -168, -139, -122, -121,
+	72, 72, 72, -363, -363, -363, -363, -363, -363, -363,
+	-248, -152, -363, -363, 308, -363, -364, -431, 32, -369,
+	205, 206, 207, 33, 34, 39, 421, 39, -371, 47,
+	-118, 71, 31, 71, -242, -372, 47, -118, 71, 31,
+	-139, -96, -140, 139, 83, 77, -242, 108, -69, 72,
+	140, -429, 89, 90, -242, -242, -83, 77, -3, -4,
+	-5, -6, -31, -57, -118, -399, -397, 71, 32, 392,
+	68, 16, -323, 220, 453, 345, 246, 222, 324, -321,
+	-304, -301, -299, -241, -297, -300, -299, -326, -226, 421,
+	-99, 406, 297, -267, -267, -267, -267, -267, 88, 99,
+	322, 89, 90, -262, -281, 32, 293, 294, -263, -263,
+	-263, -263, -263, -263, -263, -263, -263, -263, -263, -263,
+	-270, -279, -351, 71, 118, 116, 120, 117, 101, -265,
+	-265, -263, -263, 72, 140, -422, -421, 103, -267, -267,
+	-242, -419, -420, 467, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 477, 336, 331, 337, 335, 327, 343,
+	338, 339, 173, 484, 485, 478, 479, 480, 481, 482,
+	483, -272, -273, -272, -267, -419, -272, -221, 33, 32,
+	-267, -433, 320, 319, 321, -141, -242, -272, 72, 72,
+	72, 83, -273, -273, -272, -263, -272, -420, -221, -221,
+	-273, -273, -221, -221, -221, -221, 127, -221, -221, -221,
+	-221, -221, -221, -221, -221, -316, 41, -358, 367, 366,
+	-352, -354, 71, -353, 71, -353, -353, -353, 71, 71,
+	-355, 71, -355, -355, -352, -356, 71, -356, -357, 71,
+	-356, -242, -139, -95, -380, -379, -267, 41, 535, -96,
+	-267, -136, -135, -267, -467, 77, 35, 71, 139, -168,
+	99, -439, -242, -305, -302, -299, -242, -242, -285, -242,
+	-295, -242, -242, -285, -285, -285, -437, -424, 35, -142,
+	-242, -211, 17, -265, -265, -299, 250, -477, -253, -235,
+	-234, -255, -478, -250, -255, -211, -478, -253, -253, -255,
+	-251, 421, -211, -211, -338, -253, -252, -242, -252, -285,
+	-234, -235, -235, -168, -203, -204, -187, -207, -206, -208,
+	216, 213, 240, 218, 214, 364, 252, -286, 256, 81,
+	257, 402, 258, 221, 260, 261, 262, 232, 263, 264,
+	265, 393, 266, 267, 268, 269, 347, 7, 310, 41,
+	-439, -439, 31, -305, -242, -131, -126, -130, -127, -132,
+	-214, -216, -129, 71, -168, -121, -242, 443, 444, 147,
+	150, 149, -473, 154, 32, 392, -320, 392, 32, -295,
+	-314, -310, 77, 393, -300, -319, 68, 136, -379, -317,
+	-317, -317, -319, -319, 135, 140, -471, 442, 443, 208,
+	-96, -439, -304, -295, -242, -170, -168, -170, -242, 71,
+	-242, -242, -363, -242, -123, -124, 127, -267, -242, -123,
+	77, -170, 77, -308, 68, -373, -274, -417, 534, -146,
+	72, -141, -415, -416, -141, -145, -242, -373, -146, 72,
+	-415, -96, -96, -242, 24, -69, -242, -69, -427, 139,
+	140, -142, -323, -303, -300, -325, 127, -242, -311, 140,
+	493, 547, 75, 223, -459, -458, 384, 72, 140, -383,
+	224, 460, 77, 548, 204, 88, 322, 89, 90, -351,
+	-273, -270, -265, -265, -263, -263, -268, 237, -268, 98,
+	-267, -266, -421, 105, -267, 35, 140, 69, 139, 72,
+	72, 16, 16, 72, -267, 72, 16, 16, -267, 72,
+	139, 72, 72, 72, 72, 69, 72, 140, 72, 140,
+	-273, -267, 72, 72, -267, -267, -267, -273, 72, -267,
+	-267, -267, -267, -267, -267, -267, -267, -267, -361, 368,
+	83, 83, -228, 77, -228, 83, 83, 83, 140, 72,
+	77, 140, 23, 140, -133, 37, 38, -160, -153, -154,
+	-155, -156, -174, -226, 175, 178, 180, 181, 182, 183,
+	184, 185, 186, 187, 188, 189, 190, 236, 170, 171,
+	172, 173, 191, 156, 176, 157, 158, 159, 160, 161,
+	162, 165, 166, 167, 164, -242, -143, 36, -442, 371,
+	-460, 140, 41, -458, 453, 24, -242, 68, -288, 69,
+	-116, 16, -168, -168, -168, 16, -125, -169, -242, 71,
+	72, 140, -267, -296, 69, -242, -235, -211, -242, -168,
+	-242, -211, -211, -253, -255, -255, -251, 139, -234, -143,
+	140, 140, -208, 242, -165, 227, -171, -166, -226, -258,
+	-167, 228, 226, 230, -412, 93, 231, 282, 94, 221,
+	242, -205, 221, 94, 227, -242, 231, 226, 242, -475,
+	227, 227, -476, 223, 35, -239, 108, -239, -233, 135,
+	-239, -239, -239, -239, 259, 259, -239, -239, -239, -239,
+	-239, -239, -239, -239, -239, -239, -239, -239, -239, -239,
+	71, -443, 371, 31, 330, -460, -117, 324, 31, -217,
+	-218, -219, -220, 57, 61, 63, 58, 59, 60, 64,
+	31, 140, -240, -244, 35, -242, 77, -240, -121, -126,
+	-131, -240, 35, 71, 148, 151, 151, 150, -242, 223,
+	71, 108, -319, -319, -319, 41, -241, -469, 449, 443,
+	-97, 24, 42, 68, 140, -153, -242, 68, -210, 140,
+	-209, 16, -243, -242, 35, 77, 139, -210, -195, 537,
+	39, -386, 425, 40, 140, 71, 72, 140, 47, 140,
+	108, 72, 140, 72, 47, -97, 139, 402, -69, -242,
+	-397, 72, -325, 140, 223, 139, 139, -301, 350, -241,
+	-303, 21, 493, -226, 39, -232, -231, 77, 548, 72,
+	-268, -268, 98, -265, -262, 72, 106, -267, 104, -172,
+	-174, 366, 367, -173, -179, 136, 174, 236, 173, 172,
+	170, 366, 367, -188, -242, -333, 416, -267, -267, 72,
+	-267, -267, 16, -242, -188, -263, -267, -138, 72, -332,
+	-333, -332, 72, 72, 72, 72, -332, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 140, 72,
+	72, 72, 140, 72, 140, -379, -386, -267, -267, -135,
+	-134, 44, 358, 72, 140, -174, 35, -445, 427, 373,
+	-239, -260, -259, 326, 42, -366, 393, 379, 380, -302,
+	250, -242, 220, 24, -168, 68, 314, 315, 316, 317,
+	-283, -284, -282, -286, -439, 71, 71, 71, -168, -125,
+	-242, 16, 140, -438, 139, -1, -242, -295, -253, -211,
+	-478, -211, -253, -253, -255, -242, 35, -206, 216, 213,
+	-208, 71, -171, -174, -167, -242, 94, -411, -412, -285,
+	-411, 94, 71, -145, -242, -242, -242, 94, 94, -145,
+	-171, -242, -168, 83, 83, -239, -239, 83, 77, 77,
+	77, -239, -239, 83, 77, -244, 83, 83, 83, 83,
+	41, 77, -193, 41, 270, 274, 271, 272, 273, 83,
+	41, 83, 41, 83, 41, -242, 71, -413, -414, 77,
+	-445, -239, 330, 108, -260, -115, 81, 32, -147, 216,
+	213, -439, -307, -306, -226, -130, -130, -130, -130, 57,
+	57, 57, 62, 57, 62, 57, -219, -307, -132, -143,
+	-244, 72, -465, -464, -463, -461, 65, 224, 66, 155,
+	-272, 151, -303, -256, 77, -310, 14, 50, 118, -168,
+	-168, 72, -168, -211, -124, -126, -242, 77, -242, 127,
+	-211, 83, 77, -394, 412, 407, 409, 94, -274, -381,
+	-380, 47, -118, -141, -373, -416, -379, -242, 47, -118,
+	-373, -242, 83, 16, -300, -295, 127, 127, -242, 351,
+	-311, 77, 372, 77, 220, 549, 140, 108, -232, -262,
+	-267, 72, -181, 161, 160, -181, 72, -353, -353, -352,
+	-355, -352, -181, -181, 72, 71, 72, 24, 72, 72,
+	72, -267, 72, 72, 140, -370, 462, -332, -332, -332,
+	-332, -332, -332, -332, -332, -332, -332, -332, -332, -332,
+	77, 83, 83, -394, 45, 46, 77, 217, -155, 41,
+	-118, -446, 81, -440, 77, -242, -457, 81, 374, 138,
+	328, 41, 375, 376, 390, 323, 83, 83, 381, -441,
+	-242, -148, 324, -168, -451, -450, 32, -242, -455, 24,
+	-168, -282, -233, 135, 261, 302, -163, -164, -165, -163,
+	-163, -144, 69, 139, -126, -169, -242, 127, 72, -211,
+	-242, -211, -211, -253, -118, -345, -343, 242, -185, -184,
+	-182, 88, 99, 41, 364, -183, 81, 135, 275, 253,
+	257, 276, -202, -257, 68, 370, 226, 93, 94, 352,
+	-258, -407, -409, -242, -409, -242, -407, -407, -285, -267,
+	-242, 223, -188, -188, 77, 77, -189, 253, -170, 72,
+	140, 108, -446, -440, 108, 77, -457, 77, 140, -147,
+	-147, -211, 140, 108, -150, -149, 68, 69, -151, 68,
+	-149, 57, 57, -211, -463, -462, 24, -412, -412, -412,
+	171, 72, 72, -98, 52, 53, -98, 50, -213, 18,
+	139, -213, -195, -359, 536, -390, -392, 407, 21, 21,
+	14, 72, -373, -373, -295, -311, 393, -168, -231, 77,
+	549, -276, -275, 242, -267, 72, -267, 72, 77, 72,
+	72, -359, -157, -174, -261, -447, 493, 77, -444, 384,
+	77, 77, 83, 41, 83, 138, 377, -367, -115, -147,
+	39, -452, 140, 68, -456, 223, 32, 11, 14, 15,
+	71, -239, -239, -239, -242, 72, 140, 72, 72, -240,
+	-126, -242, -211, -438, 139, -211, -211, 72, 140, -242,
+	-182, 88, -263, 77, -190, -241, 136, -191, 41, 274,
+	270, -192, 41, 254, 255, 77, -194, 71, 282, 14,
+	94, 94, -168, 71, 69, 292, 71, 71, 71, -409,
+	72, -242, 254, 255, 72, -414, 77, -447, -444, 77,
+	-439, -138, -306, -379, -267, 71, -267, 71, 57, 20,
+	18, -267, 54, 51, -212, 19, 21, 127, -212, -195,
+	77, 21, 77, -388, 77, -307, -101, -384, -338, -138,
+	21, 72, 72, -332, -195, 492, -448, -449, 398, 397,
+	399, 21, 493, 327, 41, 83, 41, 378, 77, -453,
+	324, -450, -325, 69, -295, -290, -292, -226, 71, -188,
+	-190, 77, -186, -187, -165, -100, -99, -186, -211, -211,
+	-343, -346, 47, 83, 71, -374, -291, 71, -290, -410,
+	314, 315, 316, 318, 317, -410, -290, -290, -290, 71,
+	-313, -312, 283, 99, -139, -142, -408, -242, 226, 21,
+	21, -240, -267, -273, -229, 538, 77, 409, -360, 539,
+	-393, 412, -387, -385, 407, 408, 409, 410, -335, -334,
+	-337, 413, 284, 419, -273, -229, -158, -242, -449, 83,
+	83, 83, 77, 372, 77, 327, -454, 383, -147, 71,
+	72, 140, -352, -267, -327, 242, -101, -327, -138, -350,
+	-347, 71, -208, 244, 118, 72, -267, -277, -197, -196,
+	489, -290, 72, 72, 72, 72, -290, 283, 72, 72,
+	140, -465, -161, 400, 71, 21, 77, -395, 224, -391,
+	-392, 411, -385, 21, 409, 21, 21, 72, -336, 101,
+	377, 381, -267, -161, 35, 393, -304, -267, -293, -292,
+	-133, 72, -328, 291, 21, -328, -139, -350, -208, -349,
+	-348, 289, 245, 71, 72, -201, -199, -200, 68, 423,
+	280, 281, 72, -293, -293, -293, -293, 72, -242, 226,
+	-162, 257, 77, -230, -242, -388, -402, 71, 83, -390,
+	-389, -391, 21, -388, 21, -388, -388, -336, 534, 417,
+	418, 417, 418, -162, 77, 72, -294, 232, 81, 493,
+	312, 313, -133, 21, -329, 284, 285, -330, -342, 287,
+	72, 140, -242, 241, 71, -273, -312, -200, 68, -199,
+	68, 15, 14, -202, 77, 72, 140, -406, 31, 72,
+	-401, -400, -227, -396, -242, 412, 413, 77, -388, 98,
+	-239, 77, 311, -226, 71, -340, 288, 71, -338, 71,
+	-338, 94, 315, -348, -347, -273, 72, -198, 277, 278,
+	31, 150, -198, -242, -405, -404, -403, 72, 140, 139,
+	-336, 83, -242, -326, -331, 289, 83, -263, 71, -263,
+	71, -339, 286, 71, 72, 88, 41, 279, 140, 108,
+	-400, -242, 72, -344, 71, 21, 72, -326, 72, -326,
+	71, 108, -263, -404, 41, -267, 139, -345, -330, 72,
+	72, 72, -326, 83, 72, -242, 72, -341, 290, 72,
+	83,
 }
 
 var yyDef = [...]int{
 	33, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
 	20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
-	30, 31, 32, 692, 693, 694, 695, 696, 0, 0,
-	0, 466, 467, 0, 443, 0, 0, 0, 0, 0,
-	0, 345, 346, 347, 348, 349, 350, 351, 352, 353,
-	354, 355, 356, 357, 358, 359, 360, 361, 362, 363,
-	364, 365, 366, 367, 298, 299, 300, 301, 302, 303,
-	0, 233, 229, 208, 209, 210, 169, 170, 171, 172,
-	240, 241, 322, 0, 0, 0, 0, 0, 549, -2,
-	35, 697, 698, 699, 700, 701, 702, -2, 479, 0,
-	444, 445, 446, 447, 448, 449, 450, 451, 452, 453,
-	287, 288, 289, 283, 284, 286, 285, -2, 0, 479,
-	222, 0, 213, 213, 0, 0, 0, 569, 0, 0,
-	584, 606, 33, 0, 0, 527, 0, 532, 915, 951,
-	952, 953, 954, 1552, 1553, 1554, 1555, 1556, 1557, 1558,
-	1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567, 1568,
-	1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577, 1578,
-	1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586, 1587, 1588,
-	1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364,
-	1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374,
+	30, 31, 32, 740, 741, 742, 743, 744, 745, 746,
+	747, 0, 0, 0, 500, 501, 0, 474, 0, 0,
+	0, 0, 0, 0, 372, 373, 374, 375, 376, 377,
+	378, 379, 380, 381, 382, 383, 384, 385, 386, 387,
+	388, 389, 390, 391, 392, 393, 394, 395, 396, 308,
+	309, 310, 311, 312, 313, 0, 242, 238, 208, 209,
+	210, 211, 212, 169, 170, 171, 172, 249, 250, 349,
+	0, 0, 0, 0, 0, 587, -2, 35, 748, 749,
+	750, 751, 752, 753, -2, 513, 0, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	296, 297, 298, 292, 293, 295, 294, -2, 0, 513,
+	231, 0, 222, -2, 0, 0, 0, 0, 0, 614,
+	0, 0, 629, 648, 33, 0, 0, 561, 0, 566,
+	996, 1032, 1033, 1034, 1035, 1647, 1648, 1649, 1650, 1651,
+	1652, 1653, 1654, 1655, 1656, 1657, 1658, 1659, 1660, 1661,
+	1662, 1663, 1664, 1665, 1666, 1667, 1668, 1669, 1670, 1671,
+	1672, 1673, 1674, 1675, 1676, 1677, 1678, 1679, 1680, 1681,
+	1682, 1683, 1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446,
+	1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456,
+	1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466,
+	1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476,
+	1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486,
+	1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496,
+	1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506,
+	1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515, 1516,
+	1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524, 1525, 1526,
+	1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534, 1535, 1536,
+	1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544, 1545, 1546,
+	1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554, 1555, 1556,
+	1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566,
+	1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576,
+	1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586,
+	1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595, 1596,
+	1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605, 1606,
+	1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614, 1615, 1616,
+	1617, 1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625, 1626,
+	1627, 1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635, 1636,
+	561, 243, 502, 503, 614, 614, 472, 0, 278, 0,
+	1485, 283, 0, 0, 0, 469, 273, 274, 275, 276,
+	277, 0, 739, 0, 0, 269, 0, 237, 1549, 0,
+	0, 0, 0, 0, 0, 113, 846, 115, 848, 119,
+	126, 0, 0, 131, 132, 135, 136, 137, 138, 139,
+	0, 143, 0, 145, 148, 0, 150, 151, 0, 154,
+	155, 156, 0, 166, 167, 168, 849, 850, 851, -2,
+	44, 756, 1410, 1305, 0, 1312, 1313, 1324, 1335, 1106,
+	1107, 1108, 1109, 0, 0, 0, 0, 0, 1116, 1117,
+	0, 1129, 1651, 0, 1123, 1124, 1125, 1126, 53, 65,
+	66, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
+	1363, 0, 1278, 1093, 1032, 0, 1659, 0, 1679, 1678,
+	0, 0, 1263, 0, 1253, 0, 0, -2, -2, 0,
+	0, 1617, -2, 1656, 1675, 1683, 1660, 1682, 1653, 1654,
+	1648, 1649, 1650, 1652, 1661, 1663, 1674, 0, 1670, 1680,
+	1681, 0, 67, 68, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, 1269, -2, 1271,
+	1272, 1274, 1275, 1276, 1277, -2, 1280, 1281, 1282, -2,
+	-2, 1285, 1286, 1287, 1288, 1289, 1290, 1293, -2, 1295,
+	-2, -2, 1265, 1266, 1267, 1268, 1257, 1258, 1259, 1260,
+	1261, 1262, -2, -2, -2, 0, 206, 204, 614, 689,
+	0, -2, 0, 0, 0, 634, 637, 640, 643, 0,
+	36, 37, 0, 0, 878, 878, 878, 878, 878, 0,
+	878, 878, 0, 878, 0, 0, 0, 853, 854, 855,
+	876, 877, 902, 518, 514, 515, 516, 517, 602, 0,
+	604, 607, 450, 398, 0, 0, 0, 410, 404, 0,
+	0, 450, 0, 0, 609, 609, 0, 460, 450, 450,
+	-2, 450, 450, 450, 450, 0, 0, 415, 416, 417,
+	404, 0, 404, 421, 422, 423, 434, 435, 461, 1434,
+	0, 0, 349, 0, 349, 0, 349, 349, 520, 232,
+	233, 221, 223, 0, 227, 0, 213, 0, -2, 215,
+	0, 1549, 0, 0, 180, 1617, 185, 0, 1492, 1563,
+	1507, 0, 0, 1529, 0, -2, 0, 259, 609, 0,
+	615, 0, 614, 0, 0, 349, 349, 349, 349, 349,
+	349, 349, 0, 0, 349, 349, 0, 349, 653, 649,
+	3, 0, 0, 0, 0, 565, 0, 0, 609, -2,
+	0, 473, 279, 1036, 0, 0, 284, 285, 0, 0,
+	0, 299, 0, 302, 289, 290, 291, 0, 0, 271,
+	272, 0, 306, 239, 0, 0, 0, 350, 0, 0,
+	0, 0, 0, 0, 123, 120, 127, 130, 140, 147,
+	0, 159, 161, 164, 121, 128, 133, 134, 141, 162,
+	122, 124, 125, 129, 163, 165, 142, 146, 160, 144,
+	149, 152, 153, 158, 0, 94, 0, 0, 0, 0,
+	0, 1311, 0, 0, 1343, 1344, 1345, 1346, 1347, 1348,
+	1349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, -2,
+	1305, 0, 0, 1112, 1113, 1114, 1115, 1118, 0, 1130,
+	0, 0, 0, 1364, 0, 1303, 1303, 0, 1303, 1299,
+	0, 0, 1303, 1241, 0, 0, 1243, 1254, 0, 0,
+	0, 1247, 1248, 1303, 0, 1303, 1252, 1237, 1238, 0,
+	1299, 1299, 0, 0, 1299, 1299, 1299, 1299, 1299, 1299,
+	1299, 1299, 1299, 1299, 1299, 1299, 0, 1411, 1429, 1366,
+	1367, 1368, 1416, 1370, 1420, 1420, 1420, 1420, 1398, 1399,
+	1400, 1401, 1402, 1403, 1404, 1405, 1406, 0, 0, 1409,
+	1389, 1418, 1418, 1418, 1416, 1413, 1371, 1372, 1373, 1374,
 	1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384,
-	1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394,
-	1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404,
-	1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414,
-	1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424,
-	1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434,
-	1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444,
-	1445, 1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454,
-	1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464,
-	1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474,
-	1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484,
-	1485, 1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494,
-	1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504,
-	1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514,
-	1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524,
-	1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534,
-	1535, 1536, 1537, 1538, 1539, 1540, 1541, 527, 234, 468,
-	469, 569, 569, 441, 0, 269, 0, 1401, 273, 0,
-	0, 0, 438, 264, 265, 266, 267, 268, 0, 691,
-	0, 0, 260, 0, 228, 1460, 0, 0, 0, 0,
-	0, 0, 113, 765, 115, 767, 119, 126, 0, 0,
-	131, 132, 135, 136, 137, 138, 139, 0, 143, 0,
-	145, 148, 0, 150, 151, 0, 154, 155, 156, 0,
-	166, 167, 168, 768, 769, 770, -2, 44, 705, 1326,
-	1221, 0, 1228, 1229, 1240, 1251, 1024, 1025, 1026, 1027,
-	0, 0, 0, 0, 0, 1034, 1035, 0, 1047, 1556,
-	0, 1041, 1042, 1043, 1044, 53, 65, 66, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 0, 1194,
-	1011, 951, 0, 1564, 0, 1584, 1583, 0, 0, 1179,
-	0, 1169, 0, 0, -2, -2, 0, 0, 1527, -2,
-	1561, 1580, 1588, 1565, 1587, 1558, 1559, 1553, 1554, 1555,
-	1557, 1566, 1568, 1579, 0, 1575, 1585, 1586, 0, 67,
-	68, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, 1185, -2, 1187, 1188, 1190, 1191,
-	1192, 1193, -2, 1196, 1197, 1198, -2, -2, 1201, 1202,
-	1203, 1204, 1205, 1206, 1209, -2, 1211, -2, -2, 1181,
-	1182, 1183, 1184, 1173, 1174, 1175, 1176, 1177, 1178, -2,
-	-2, -2, 0, 206, 204, 569, 642, 0, -2, 0,
-	0, 0, 589, 592, 595, 598, 0, 36, 37, 0,
-	0, 797, 797, 797, 797, 797, 0, 797, 0, 0,
-	0, 772, 773, 774, 795, 796, 821, 484, 480, 481,
-	482, 483, 557, 0, 559, 562, 419, 369, 0, 0,
-	0, 381, 375, 0, 0, 419, 0, 0, 564, 564,
-	0, 429, 419, 419, -2, 419, 419, 419, 0, 386,
-	387, 388, 375, 0, 375, 392, 393, 394, 405, 406,
-	430, 1350, 0, 0, 322, 0, 322, 0, 322, 322,
-	486, 223, 224, 212, 214, 0, 218, 0, 211, 1460,
-	0, 0, 180, 1527, 185, 0, 1408, 1474, 1423, 0,
-	0, 1441, 0, -2, 0, 250, 564, 0, 570, 0,
-	569, 0, 0, 322, 322, 322, 322, 322, 322, 322,
-	0, 0, 322, 0, 0, 607, 608, 603, 604, 605,
-	609, 610, 3, 0, 0, 0, 0, 531, 0, 0,
-	564, -2, 0, 442, 270, 955, 0, 0, 274, 275,
-	0, 0, 290, 0, 293, 278, 279, 280, 0, 0,
-	262, 263, 0, 0, 230, 0, 0, 0, 323, 0,
-	0, 0, 0, 0, 0, 123, 120, 127, 130, 140,
-	147, 0, 159, 161, 164, 121, 128, 133, 134, 141,
-	162, 122, 124, 125, 129, 163, 165, 142, 146, 160,
-	144, 149, 152, 153, 158, 0, 94, 0, 0, 0,
-	0, 0, 1227, 0, 0, 1259, 1260, 1261, 1262, 1263,
-	1264, 1265, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	-2, 1221, 0, 0, 1030, 1031, 1032, 1033, 1036, 0,
-	1048, 0, 0, 0, 1280, 0, 1219, 1219, 0, 1219,
-	1215, 0, 0, 1219, 1157, 0, 0, 1159, 1170, 0,
-	0, 0, 1163, 1164, 1219, 0, 1219, 1168, 1153, 1154,
-	0, 1215, 1215, 0, 0, 1215, 1215, 1215, 1215, 1215,
-	1215, 1215, 1215, 1215, 1215, 1215, 1215, 0, 1327, 1345,
-	1282, 1283, 1284, 1332, 1286, 1336, 1336, 1336, 1336, 1314,
-	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 0, 0,
-	1325, 1305, 1334, 1334, 1334, 1332, 1329, 1287, 1288, 1289,
-	1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299,
-	1300, 1339, 1339, 1342, 1339, 0, 564, 0, 0, 552,
-	0, 533, 0, 586, 588, 0, 590, 591, 593, 594,
-	596, 597, 599, 600, 38, 0, 704, 0, 707, 0,
-	0, 0, 0, 0, 0, 0, 0, 797, 797, 797,
-	486, 485, 0, 558, 0, 0, 615, 0, 0, 0,
-	375, 419, 380, 377, 376, 425, 426, 422, 0, 422,
-	615, 0, 399, 400, 401, 419, 419, 407, 565, 408,
-	409, 422, 0, 427, 428, 0, 615, 615, 0, 416,
-	417, 418, 0, 0, 797, 0, 377, 390, 377, 1351,
-	1352, 0, 806, 0, 0, 0, 437, 0, 0, 0,
-	487, 0, 0, 216, 0, 221, 173, 0, 0, 0,
-	0, 0, 0, 202, 203, 0, 0, 0, 0, 0,
-	193, 196, 909, 910, 762, 763, 197, 198, 242, 243,
-	0, 533, 585, 587, 581, 582, 583, 0, 0, 0,
-	0, 0, 0, 0, 464, 0, 0, 623, 617, 619,
-	686, 53, 623, 0, 0, 0, 506, 519, 501, 0,
-	508, 0, 916, 488, 519, 490, 0, 508, 533, 555,
-	533, 0, 271, 0, 276, 0, 0, 292, 294, 295,
-	296, 281, 282, 439, 258, 259, 251, 252, 253, 254,
-	255, 256, 257, 261, 63, 0, 231, 232, 0, 0,
-	0, 107, 108, 109, 110, 111, 112, 114, 98, 456,
-	458, 754, 766, 0, 757, 0, 117, 157, 90, 0,
-	0, 1222, 1223, 1224, 1225, 1226, 1230, 0, 1232, 1234,
-	1236, 1238, 0, 1256, -2, -2, 1012, 1013, 1014, 1015,
-	1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1241, 1254,
-	1255, 0, 0, 0, 0, 0, 0, 1252, 1252, 1247,
-	0, 1028, 0, 1045, 1049, 0, 0, 0, 54, 1214,
-	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 0,
-	1220, 0, 1221, 0, 0, 0, 1216, 1217, 0, 0,
-	1118, 1119, 1120, 0, 514, 0, 1180, 1158, 1171, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 207, 0, 1348, 1346, 1347, 1285, 1333,
-	0, 1310, 0, 1311, 1312, 1313, 0, 0, 1306, 0,
-	1307, 1308, 1309, 1301, 0, 1302, 1303, 0, 1304, 205,
-	641, 643, 0, 523, 525, 526, 0, 553, 566, 571,
-	572, 575, 34, 39, 0, 709, 0, 562, 0, 0,
-	721, 320, 748, 0, 0, 764, 787, 793, 0, 0,
-	0, 0, 560, 0, 0, 655, 368, 0, 420, 421,
-	372, 1460, 377, 615, 382, 378, 383, 0, 424, 384,
-	385, 0, 615, 615, 419, 422, 422, 412, 413, 0,
-	431, 435, 432, 0, 434, 389, 391, 562, 305, 0,
-	0, -2, 865, 1353, 1353, 0, 1353, 1353, 1353, 1353,
-	0, 0, 1353, 1353, 1353, 1353, 1353, 1353, 1353, 1353,
-	1353, 1353, 1353, 1353, 1353, 1353, 0, 807, 317, 0,
-	0, 320, 738, 628, 0, 629, 630, 626, 657, 681,
-	681, 0, 681, 661, 915, 225, 226, 0, 0, 220,
-	174, 175, 0, 177, 178, 179, 186, 181, 183, 0,
-	0, 187, 199, 200, 201, 0, 0, 0, 191, 192,
-	0, 0, 245, 246, 248, 0, 551, 454, 455, 459,
-	0, 461, 913, 462, 463, 709, 743, 615, 0, 624,
-	0, 620, 687, 0, 689, 0, 615, 543, 0, 535,
-	495, 0, 500, 516, 0, 520, 0, 0, 512, 505,
-	509, 0, 0, 529, 489, 0, 0, 494, 554, 556,
-	956, 0, 277, 291, 0, 0, 0, 0, 101, 751,
-	0, 102, 106, 96, 0, 0, 0, 756, 0, 753,
-	758, 0, 116, 0, 0, 91, 92, 812, 817, 0,
-	1231, 1233, 1235, 1237, 1239, 0, 1242, 1252, 1252, 1248,
-	0, 1243, 0, 1245, 0, 1222, 0, 1050, 0, 0,
-	0, 0, 0, 0, 1106, 1107, 0, 0, 1111, 0,
-	1113, 0, 0, 0, 1117, 0, 1156, 1172, 1160, 1161,
-	0, 1165, 0, 1167, 0, 569, 0, 1084, 1084, 0,
-	0, 0, 0, 1084, 0, 0, 0, 0, 0, 0,
-	0, 0, 1328, 1281, 1349, 0, 0, 0, 1330, 0,
-	0, 0, 0, 0, 644, 535, 0, 0, 0, 578,
-	576, 577, 0, 0, 710, 711, 713, 714, 0, -2,
+	1423, 1423, 1426, 1423, 0, 609, 0, 0, 590, 0,
+	567, 0, 631, 633, 0, 635, 636, 638, 639, 641,
+	642, 644, 645, 38, 0, 755, 0, 758, 0, 0,
+	0, 0, 0, 0, 878, 0, 0, 0, 0, 878,
+	878, 878, 520, 519, 0, 603, 0, 0, 662, 0,
+	0, 0, 404, 450, 409, 406, 405, 456, 457, 453,
+	0, 453, 662, 0, 428, 429, 430, 450, 450, 436,
+	610, 437, 438, 453, 0, 458, 459, 0, 662, 662,
+	0, 445, 446, 447, 448, 450, 0, 0, 878, 0,
+	406, 419, 406, 1435, 1436, 0, 887, 0, 0, 0,
+	468, 0, 0, 0, 521, 0, 0, 225, 0, 230,
+	219, 216, 173, 0, 0, 0, 0, 0, 0, 202,
+	203, 0, 0, 0, 0, 0, 193, 196, 990, 991,
+	843, 844, 197, 198, 251, 252, 0, 567, 630, 632,
+	626, 627, 628, 0, 0, 0, 0, 0, 0, 0,
+	498, 0, 0, 0, 349, 0, 0, 0, 654, 655,
+	650, 651, 652, 656, 657, 0, 0, 0, 540, 553,
+	535, 0, 542, 0, 997, 522, 553, 524, 0, 542,
+	567, 593, 567, 0, 280, 0, 0, 0, 287, 0,
+	0, 301, 303, 304, 305, 470, 267, 268, 260, 261,
+	262, 263, 264, 265, 266, 270, 63, 0, 240, 241,
+	0, 0, 0, 107, 108, 109, 110, 111, 112, 114,
+	98, 490, 492, 835, 847, 0, 838, 0, 117, 157,
+	90, 0, 0, 1306, 1307, 1308, 1309, 1310, 1314, 0,
+	1316, 1318, 1320, 1322, 0, 1340, -2, -2, 1094, 1095,
+	1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105,
+	1325, 1338, 1339, 0, 0, 0, 0, 0, 0, 1336,
+	1336, 1331, 0, 1110, 0, 1127, 1131, 0, 0, 0,
+	54, 1298, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
+	1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225,
+	1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235,
+	1236, 0, 1304, 0, 1305, 0, 0, 0, 1300, 1301,
+	0, 0, 1202, 1203, 1204, 0, 548, 0, 1264, 1242,
+	1255, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 207, 0, 1432, 1430, 1431,
+	1369, 1417, 0, 1394, 0, 1395, 1396, 1397, 0, 0,
+	1390, 0, 1391, 1392, 1393, 1385, 0, 1386, 1387, 0,
+	1388, 205, 688, 690, 0, 557, 559, 560, 0, 591,
+	611, 616, 617, 620, 34, 39, 0, 760, 0, 607,
+	0, 0, 772, 347, 829, 0, 0, 0, 0, 0,
+	845, 868, 874, 0, 0, 0, 0, 605, 0, 0,
+	702, 397, 0, 451, 452, 401, 1549, 406, 662, 411,
+	407, 412, 0, 455, 413, 414, 0, 662, 662, 450,
+	453, 453, 441, 442, 0, 449, 462, 466, 463, 0,
+	465, 418, 420, 607, 315, 316, -2, 318, 322, 946,
+	0, 0, 0, 330, 332, 1437, 1437, 0, 1437, 1437,
+	1437, 1437, 0, 0, 1437, 1437, 1437, 1437, 1437, 1437,
+	1437, 1437, 1437, 1437, 1437, 1437, 1437, 1437, 0, 888,
+	344, 0, 0, 347, 796, 675, 0, 676, 677, 673,
+	704, 729, 729, 0, 729, 708, 996, 234, 235, 0,
+	0, 229, 0, 220, 174, 175, 0, 177, 178, 179,
+	186, 181, 183, 0, 0, 187, 199, 200, 201, 0,
+	0, 0, 191, 192, 0, 0, 254, 255, 257, 0,
+	595, 488, 489, 493, 0, 495, 994, 496, 497, 760,
+	801, 803, 0, 0, 670, 664, 666, 734, 53, 670,
+	577, 0, 569, 529, 0, 534, 550, 0, 554, 0,
+	0, 546, 539, 543, 0, 0, 563, 523, 0, 0,
+	528, 595, 594, 1037, 0, 282, 0, 288, 300, 0,
+	0, 0, 0, 101, 832, 0, 102, 106, 96, 0,
+	0, 0, 837, 0, 834, 839, 0, 116, 0, 0,
+	91, 92, 893, 898, 0, 1315, 1317, 1319, 1321, 1323,
+	0, 1326, 1336, 1336, 1332, 0, 1327, 0, 1329, 0,
+	1306, 0, 1132, 0, 0, 0, 0, 0, 0, 1189,
+	1191, 0, 0, 1195, 0, 1197, 0, 0, 0, 1201,
+	0, 1240, 1256, 1244, 1245, 0, 1249, 0, 1251, 0,
+	614, 0, 1166, 1166, 0, 0, 0, 0, 1166, 0,
+	0, 0, 0, 0, 0, 0, 0, 1412, 1365, 1433,
+	0, 0, 0, 1414, 0, 0, 0, 0, 0, 691,
+	569, 0, 0, 0, 623, 621, 622, 0, 0, 761,
+	762, 764, 765, 0, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, 1477, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	1393, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	708, 0, 798, 728, 1353, 324, 0, 0, 750, 0,
-	0, 0, -2, 0, 0, 0, 0, 0, 0, 473,
-	477, 33, 563, 0, 616, 370, 0, 371, 419, 379,
-	423, 615, 915, 402, 403, 615, 419, 419, 422, 0,
-	433, 0, 306, 921, 922, 0, 0, 924, 981, 0,
-	933, 797, 933, 0, 0, 935, 936, 307, 0, 0,
-	0, 0, 0, 806, 867, 0, 1354, 0, 1353, 1353,
-	0, 0, 0, 0, 1353, 1353, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 728, 1353, 0, 0, 324, 735, 0, 0, 0,
-	0, 0, 0, 648, 0, 0, 647, 0, 0, 0,
-	0, 0, 562, 682, 0, 684, 685, 659, -2, 0,
-	628, 664, 1219, 227, 215, 217, 0, 0, 0, 0,
-	188, 189, 190, 194, 195, 244, 247, 249, 0, 0,
-	0, 613, 618, 625, 688, 690, 54, 621, 613, 40,
-	0, 0, 539, 0, 0, 519, 521, 0, 0, 519,
-	0, 0, 528, 0, 0, 519, 0, 0, 440, 64,
-	297, 0, 0, 0, 0, 0, 457, 0, 755, 98,
-	0, 0, 118, 0, 0, 815, 0, 817, 1218, 1244,
-	1246, 0, 1253, 1249, 1029, 1037, 1046, 0, 0, 1052,
-	1064, 1064, 0, 1055, 1336, 1336, 1058, 1332, 1334, 1332,
-	1064, 1064, 0, 55, 0, 0, 1112, 0, 0, 0,
-	515, 0, 0, 0, 1082, 1084, 1088, 0, 1089, 1084,
-	1084, 1084, 1084, 1094, 1084, 1084, 1084, 1084, 1084, 1084,
-	1084, 1084, 1338, 1337, 1323, 0, 1324, 1335, 1340, 0,
-	1343, 0, 524, 539, 567, 568, 573, 574, 0, 0,
-	0, 0, 715, 0, 731, 729, 730, 0, 745, 325,
-	326, 327, 328, 0, 0, 0, 749, 0, 496, 0,
-	788, 789, 790, 791, 792, -2, 801, 0, 0, 917,
-	917, 917, 527, 0, -2, 0, 0, 475, 0, 0,
-	656, 373, 615, 395, 0, 410, 615, 615, 419, 436,
-	0, -2, 923, 982, 945, 945, 934, 945, 945, 797,
-	0, 309, 310, 311, 0, 313, 866, 868, 869, 0,
-	0, 872, 873, 874, 875, 0, 0, 878, 879, 880,
-	881, 882, 883, 884, 885, 886, 887, 903, 904, 905,
-	906, 907, 908, 888, 889, 890, 891, 892, 893, 900,
-	0, 0, 897, 0, 731, 0, 0, 0, 745, 737,
-	0, 739, 740, 0, 0, 498, 615, 237, 0, 651,
-	645, 0, 634, 649, 650, 637, 0, 639, 0, 635,
-	636, 615, 627, 658, 683, 660, 663, 665, 666, 672,
-	0, 0, 0, 0, 219, 176, 0, 344, 182, 460,
-	914, 465, 611, 0, 0, 611, 544, 543, 541, 78,
-	0, 0, 0, 517, 0, 522, 519, 504, 513, 503,
-	510, 511, 530, 519, 493, 492, 957, 272, 0, 752,
-	98, 103, 104, 105, 99, 97, 759, 0, 761, 0,
-	813, 817, 0, 0, 1250, 1051, 1038, 1053, 1065, 1066,
-	1054, 1039, 1056, 1057, 1059, 1060, 1061, 1062, 1063, 1040,
-	1108, 0, 1110, 1114, 1115, 0, 1162, 1166, 0, 0,
-	0, 1087, 1080, 1090, 1091, 1092, 1093, 1095, 1096, 1097,
-	1098, 1099, 1100, 1101, 1102, 1331, 0, 0, 541, 579,
-	580, 703, 0, 712, 0, 719, 720, 0, 0, 723,
-	724, 733, 0, 0, 0, 330, 331, 0, 0, 0,
-	343, 339, 340, 341, 321, 744, 735, 0, 0, 802,
-	1353, 1353, 1353, 0, 0, 918, 919, 0, 0, 681,
-	0, 0, 615, 474, 477, 478, 561, 374, 615, 414,
-	411, 615, 304, 947, -2, 960, 962, 0, 0, 965,
-	966, 0, 0, 0, 0, 1003, 972, 0, 0, 976,
-	0, 1268, 1269, 0, 980, 0, 937, 946, 0, 946,
-	0, 0, 945, 0, 312, 870, 871, 876, 877, 894,
-	0, 0, 896, 0, 0, 314, 0, 0, 315, 319,
-	736, 0, 741, 742, 569, 0, 0, 631, 652, 0,
-	0, 632, 0, 633, 638, 640, 236, 667, 0, 0,
-	669, 670, 671, 662, 184, 601, 0, 0, 622, 602,
-	41, 543, 0, 540, 79, 0, 0, 0, 0, 518,
-	502, 491, 100, 95, 760, 81, 816, 818, 814, 0,
-	1116, 0, 1084, 1083, 569, 1081, 0, 1341, 1344, 543,
-	0, 718, 716, 732, 722, 0, 746, 747, 0, 332,
-	333, 0, 336, 342, 734, 497, 0, 0, 0, 0,
-	794, -2, 0, 0, -2, 615, 615, -2, 471, 476,
-	0, 396, 415, 961, 963, 964, 967, 968, 911, 912,
-	969, 1008, 1009, 1010, 970, 1005, 1006, 1007, 971, 0,
-	0, 0, 1266, 1267, 1001, 0, 0, 0, 0, 0,
-	0, 0, 931, 901, 902, 895, 898, 899, 318, 316,
-	499, 564, 238, 239, 653, 0, 646, 676, 673, 0,
-	0, 612, 614, 545, 542, 0, 536, 538, 89, 507,
-	51, 72, 0, 1109, 1155, 1086, 1077, 0, 545, 0,
-	0, 0, 329, 334, 0, 337, 338, 0, 783, 1332,
-	0, 803, 804, 805, 822, -2, 920, 809, 81, 822,
-	569, 472, 0, 0, 1169, 996, 0, 0, 938, 940,
-	941, 942, 943, 944, 939, 0, 0, 0, 0, 930,
-	932, 977, 0, 235, 0, 0, 677, 679, 674, 675,
-	42, 0, 80, 0, 43, 0, 69, 0, 82, 83,
-	0, 0, 0, 0, 0, 1078, 0, 1072, 1073, 1074,
-	1079, 534, 0, 717, 725, 0, 727, 335, 776, 0,
-	575, 0, 824, 0, 811, 824, 564, 1004, 0, 975,
-	984, 997, 0, 0, 776, 776, 776, 776, 0, 978,
-	654, 668, 0, 0, 0, 52, 56, 0, 78, 75,
-	0, 84, 0, 0, 0, 0, 1085, 1075, 0, 0,
-	0, 0, 0, 726, 775, 784, 785, 575, 808, 0,
-	861, 810, 470, 973, 983, 985, 986, 0, 998, 999,
-	1000, 1002, 925, 926, 927, 928, 0, 678, 680, 0,
-	547, 537, 45, 0, 0, 73, 74, 76, 0, 85,
-	0, 87, 88, 0, 1067, 1068, 1070, 1069, 1071, 706,
-	777, 1353, 0, 0, 781, 782, 786, 0, 849, 0,
-	0, 855, 0, 862, 974, 987, 0, 988, 0, 0,
-	0, 929, 546, 0, 819, 0, 57, 0, 59, 61,
-	62, 948, 70, 71, 77, 86, 0, 0, 779, 0,
-	825, 0, 827, 0, 0, 0, 0, 0, 859, 0,
-	989, 991, 992, 0, 0, 990, 548, 46, 47, 0,
-	58, 0, 0, 1076, 778, 780, 0, 829, 0, 850,
-	0, 0, 0, 0, 0, 0, 0, 993, 995, 994,
-	0, 0, 60, 949, 826, 823, 0, 861, 851, 0,
-	853, 0, 0, 0, 0, 48, 49, 50, 0, 0,
-	831, 0, 847, 852, 854, 856, 0, 860, 858, 950,
-	830, 0, 843, 828, 0, 857, 832, -2, 0, 848,
-	833, -2, 0, 841, 0, 0, 834, 842, 0, 837,
-	0, 0, 0, 836, 0, -2, 844, 0, 0, 838,
-	-2, 0, 846, 845,
+	-2, -2, -2, -2, -2, 759, 0, 879, 779, 1437,
+	351, 0, 0, 831, 0, 0, 0, 0, 0, 0,
+	-2, 0, 0, 0, 0, 0, 0, 507, 511, 33,
+	608, 0, 663, 399, 0, 400, 450, 408, 454, 662,
+	996, 431, 432, 662, 450, 450, 453, 0, 464, 0,
+	0, 887, 948, 0, 324, 0, 1002, 1003, 0, 0,
+	1005, 1063, 0, 1014, 878, 1014, 0, 0, 1016, 1017,
+	0, 326, 0, 0, 0, 338, 0, 0, 0, 0,
+	331, 0, 0, 333, 334, 0, 1438, 0, 1437, 1437,
+	0, 0, 0, 0, 1437, 1437, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 779, 1437, 0, 0, 351, 793, 0, 0, 0,
+	0, 0, 0, 695, 0, 0, 694, 0, 0, 0,
+	0, 0, 607, 730, 0, 732, 733, 706, -2, 0,
+	675, 712, 0, 1303, 236, 224, 226, 0, 214, 0,
+	0, 0, 188, 189, 190, 194, 195, 253, 256, 258,
+	589, 0, 0, 0, 0, 0, 815, 0, 662, 0,
+	671, 0, 667, 735, 0, 737, 0, 662, 40, 0,
+	0, 573, 0, 0, 553, 555, 0, 0, 553, 0,
+	0, 562, 0, 0, 553, 592, 0, 0, 286, 471,
+	64, 307, 0, 0, 0, 0, 0, 491, 0, 836,
+	98, 0, 0, 118, 0, 0, 896, 0, 898, 1302,
+	1328, 1330, 0, 1337, 1333, 1111, 1119, 1128, 0, 0,
+	1134, 1146, 1146, 0, 1137, 1420, 1420, 1140, 1416, 1418,
+	1416, 1146, 1146, 0, 55, 1190, 0, 0, 0, 1196,
+	0, 0, 0, 549, 0, 0, 0, 1164, 1166, 1171,
+	1167, 1172, 1166, 1166, 1166, 1166, 1177, 1166, 1166, 1166,
+	1166, 1166, 1166, 1166, 1166, 1422, 1421, 1407, 0, 1408,
+	1419, 1424, 0, 1427, 0, 558, 573, 612, 613, 618,
+	619, 0, 0, 0, 0, 766, 0, 782, 780, 781,
+	0, 826, 352, 353, 354, 355, 0, 0, 0, 830,
+	0, 530, 0, 0, 817, 0, 869, 870, 871, 872,
+	873, -2, 882, 0, 0, 998, 998, 998, 561, 0,
+	-2, 0, 0, 509, 0, 0, 703, 402, 662, 424,
+	0, 439, 662, 662, 450, 467, 0, 323, 0, 0,
+	947, 0, 325, -2, 1004, 1064, 1026, 1026, 1015, 1026,
+	1026, 878, 0, 320, 335, 336, 337, 0, 340, 321,
+	327, 0, 329, 949, 950, 0, 0, 953, 954, 955,
+	956, 0, 0, 959, 960, 961, 962, 963, 964, 965,
+	966, 967, 968, 984, 985, 986, 987, 988, 989, 969,
+	970, 971, 972, 973, 974, 981, 0, 0, 978, 0,
+	782, 0, 0, 0, 826, 795, 0, 797, 798, 0,
+	0, 532, 662, 246, 0, 698, 692, 0, 681, 696,
+	697, 684, 0, 686, 0, 682, 683, 662, 674, 705,
+	731, 707, 710, 713, 714, 720, 0, 0, 0, 0,
+	0, 228, 176, 0, 371, 182, 599, 599, 0, 494,
+	995, 499, 824, 660, 665, 672, 736, 738, 54, 668,
+	660, 578, 577, 575, 78, 0, 0, 0, 551, 0,
+	556, 553, 538, 547, 537, 544, 545, 564, 553, 527,
+	526, 1038, 281, 0, 833, 98, 103, 104, 105, 99,
+	97, 840, 0, 842, 0, 894, 898, 0, 0, 1334,
+	1133, 1120, 1135, 1147, 1148, 1136, 1121, 1138, 1139, 1141,
+	1142, 1143, 1144, 1145, 1122, 1162, 1192, 0, 1194, 1198,
+	1199, 0, 1246, 1250, 0, 0, 0, 1170, 1173, 1174,
+	1175, 1176, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1415, 0, 0, 575, 624, 625, 754, 0, 763, 0,
+	770, 784, 0, 0, 774, 775, 791, 0, 0, 0,
+	357, 358, 0, 0, 0, 370, 366, 367, 368, 348,
+	825, 793, 0, 0, 809, 805, 807, 808, 822, 0,
+	0, 883, 1437, 1437, 1437, 0, 0, 999, 1000, 0,
+	0, 729, 0, 0, 662, 508, 511, 512, 606, 403,
+	662, 443, 440, 662, 314, 0, 912, 0, 1028, -2,
+	1041, 1043, 0, 0, 1046, 1047, 0, 0, 0, 0,
+	0, 1085, 1054, 0, 0, 1058, 0, 1352, 1353, 0,
+	1062, 0, 1018, 1027, 0, 1027, 0, 0, 1026, 0,
+	339, 0, 951, 952, 957, 958, 975, 0, 0, 977,
+	0, 0, 784, 0, 0, 342, 346, 794, 0, 799,
+	800, 614, 0, 0, 678, 699, 0, 0, 679, 0,
+	680, 685, 687, 245, 715, 0, 0, 717, 718, 719,
+	0, 709, 184, 596, 600, 0, 597, 0, 658, 0,
+	0, 658, 41, 577, 0, 574, 79, 0, 0, 0,
+	0, 552, 536, 525, 100, 95, 841, 81, 897, 899,
+	895, 614, 1163, 0, 0, 1200, 0, 1166, 1165, 1425,
+	1428, 577, 0, 769, 767, 771, 0, 783, 773, 0,
+	827, 828, 0, 359, 360, 0, 363, 369, 792, 531,
+	0, 811, 0, 0, 0, 0, 818, 819, 820, 821,
+	0, 0, 0, 0, 875, -2, 0, 0, -2, 662,
+	662, -2, 505, 510, 0, 425, 444, 319, 0, 924,
+	1042, 1044, 1045, 1048, 1049, 992, 993, 1050, 1090, 1091,
+	1092, 1051, 1087, 1088, 1089, 1052, 1053, 0, 0, 0,
+	1350, 1351, 1083, 0, 0, 0, 0, 0, 0, 0,
+	1012, 328, 982, 983, 976, 979, 980, 341, 345, 343,
+	533, 609, 247, 248, 700, 0, 693, 724, 721, 0,
+	0, 729, 601, 598, 646, 0, 0, 669, 647, 579,
+	576, 0, 570, 572, 89, 541, 51, 72, 0, 1159,
+	0, 1193, 1239, 1169, 579, 0, 785, 786, 0, 0,
+	0, 0, 0, 356, 361, 0, 364, 365, 802, 813,
+	0, 806, 810, 0, 823, 0, 864, 1416, 0, 884,
+	885, 886, 903, -2, 1001, 890, 81, 903, 614, 506,
+	913, -2, 0, 0, 0, 1253, 1078, 0, 0, 1019,
+	1021, 1022, 1023, 1024, 1025, 1020, 0, 0, 0, 0,
+	1011, 1013, 1059, 0, 244, 0, 0, 725, 727, 722,
+	723, 712, 659, 661, 581, 0, 80, 0, 43, 0,
+	69, 0, 82, 83, 0, 0, 0, 0, 0, 1160,
+	0, 1154, 1155, 1156, 1161, 581, 0, 768, 787, 788,
+	789, 790, 776, 0, 778, 362, 804, 0, 812, 0,
+	857, 0, 620, 0, 905, 0, 892, 905, 609, 914,
+	-2, 0, 922, 0, 0, 1086, 0, 1057, 1066, 1079,
+	0, 0, 857, 857, 857, 857, 0, 1060, 701, 716,
+	0, 711, 583, 0, 0, 0, 52, 56, 0, 78,
+	75, 0, 84, 0, 0, 0, 0, 1168, 1157, 0,
+	0, 0, 0, 583, 0, 777, 814, 0, 856, 865,
+	866, 620, 889, 0, 942, 891, 504, 915, 923, 0,
+	918, 0, 0, 0, 1055, 1065, 1067, 1068, 0, 1080,
+	1081, 1082, 1084, 1006, 1007, 1008, 1009, 0, 726, 728,
+	42, 0, 582, 0, 585, 571, 45, 0, 0, 73,
+	74, 76, 0, 85, 0, 87, 88, 0, 1149, 1150,
+	1152, 1151, 1153, 568, 757, 816, 858, 1437, 0, 0,
+	862, 863, 867, 0, 930, 0, 0, 936, 0, 943,
+	917, 0, -2, 925, 0, 0, 1056, 1069, 0, 1070,
+	0, 0, 0, 1010, 584, 580, 0, 900, 0, 57,
+	0, 59, 61, 62, 1029, 70, 71, 77, 86, 0,
+	0, 860, 0, 906, 0, 908, 0, 0, 0, 0,
+	0, 940, 0, 919, -2, 0, 927, 1071, 1073, 1074,
+	0, 0, 1072, 586, 46, 47, 0, 58, 0, 0,
+	1158, 859, 861, 0, 910, 0, 931, 0, 0, 0,
+	0, 0, 0, 0, 926, 1075, 1077, 1076, 0, 0,
+	60, 1030, 907, 904, 0, 942, 932, 0, 934, 0,
+	0, 0, 0, 48, 49, 50, 0, 0, 928, 933,
+	935, 937, 0, 941, 939, 1031, 911, 909, 0, 938,
+	929,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 96, 3, 3, 3, 126, 118, 3,
-	67, 68, 123, 121, 136, 122, 135, 124, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 532,
-	105, 104, 106, 3, 533, 3, 3, 3, 3, 3,
+	3, 3, 3, 100, 3, 3, 3, 130, 122, 3,
+	71, 72, 127, 125, 140, 126, 139, 128, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 546,
+	109, 108, 110, 3, 547, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 128, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 132, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 534, 117, 535, 129,
+	3, 3, 3, 548, 121, 549, 133,
 }
 
 var yyTok2 = [...]int{
@@ -6595,13 +7357,13 @@ var yyTok2 = [...]int{
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
-	62, 63, 64, 65, 66, 69, 70, 71, 72, 73,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 73,
 	74, 75, 76, 77, 78, 79, 80, 81, 82, 83,
 	84, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 97, 98, 99, 100, 101, 102, 103, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 119,
-	120, 125, 127, 130, 131, 132, 133, 134, 137, 138,
-	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	94, 95, 96, 97, 98, 99, 101, 102, 103, 104,
+	105, 106, 107, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 123, 124, 129, 131, 134, 135, 136,
+	137, 138, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
@@ -6669,7 +7431,10 @@ var yyTok3 = [...]int{
 	57840, 515, 57841, 516, 57842, 517, 57843, 518, 57844, 519,
 	57845, 520, 57846, 521, 57847, 522, 57848, 523, 57849, 524,
 	57850, 525, 57851, 526, 57852, 527, 57853, 528, 57854, 529,
-	57855, 530, 57856, 531, 0,
+	57855, 530, 57856, 531, 57857, 532, 57858, 533, 57859, 534,
+	57860, 535, 57861, 536, 57862, 537, 57863, 538, 57864, 539,
+	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
+	57870, 545, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -7019,7 +7784,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:646
+//line mysql_sql.y:680
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -7027,7 +7792,7 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:652
+//line mysql_sql.y:686
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -7036,7 +7801,7 @@ yydefault:
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:687
+//line mysql_sql.y:721
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -7044,7 +7809,7 @@ yydefault:
 	case 33:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:692
+//line mysql_sql.y:726
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7052,7 +7817,7 @@ yydefault:
 	case 34:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:698
+//line mysql_sql.y:732
 		{
 			var connectionId uint64
 			switch v := yyDollar[3].item.(type) {
@@ -7075,7 +7840,7 @@ yydefault:
 	case 35:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:718
+//line mysql_sql.y:752
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: false,
@@ -7085,7 +7850,7 @@ yydefault:
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:724
+//line mysql_sql.y:758
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7096,7 +7861,7 @@ yydefault:
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:731
+//line mysql_sql.y:765
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7107,7 +7872,7 @@ yydefault:
 	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:739
+//line mysql_sql.y:773
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist: false,
@@ -7117,7 +7882,7 @@ yydefault:
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:745
+//line mysql_sql.y:779
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist:       true,
@@ -7128,7 +7893,7 @@ yydefault:
 	case 40:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:754
+//line mysql_sql.y:788
 		{
 			yyLOCAL = &tree.MoDump{
 				DumpDatabase: true,
@@ -7141,7 +7906,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:763
+//line mysql_sql.y:797
 		{
 			yyLOCAL = &tree.MoDump{
 				DumpDatabase: true,
@@ -7153,9 +7918,9 @@ yydefault:
 		}
 		yyVAL.union = yyLOCAL
 	case 42:
-		yyDollar = yyS[yypt-10 : yypt+1]
+		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:773
+//line mysql_sql.y:807
 		{
 			ep := &tree.ExportParam{
 				Outfile:     true,
@@ -7166,6 +7931,8 @@ yydefault:
 				Header:      yyDollar[8].unsignedOptUnion(),
 				MaxFileSize: uint64(yyDollar[9].int64ValUnion()) * 1024,
 				ForceQuote:  yyDollar[10].strsUnion(),
+				FileFormat:  yyDollar[11].str,
+				Compression: yyDollar[12].str,
 			}
 			yyLOCAL = &tree.MoDump{
 				DumpDatabase: false,
@@ -7176,7 +7943,7 @@ yydefault:
 	case 43:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:795
+//line mysql_sql.y:831
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -7191,7 +7958,7 @@ yydefault:
 	case 44:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:808
+//line mysql_sql.y:844
 		{
 			yyLOCAL = &tree.LoadExtension{
 				Name: tree.Identifier(yyDollar[2].str),
@@ -7201,7 +7968,7 @@ yydefault:
 	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:815
+//line mysql_sql.y:851
 		{
 			yyLOCAL = nil
 		}
@@ -7209,7 +7976,7 @@ yydefault:
 	case 46:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:819
+//line mysql_sql.y:855
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
@@ -7217,7 +7984,7 @@ yydefault:
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:825
+//line mysql_sql.y:861
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
@@ -7225,7 +7992,7 @@ yydefault:
 	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:829
+//line mysql_sql.y:865
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
@@ -7233,7 +8000,7 @@ yydefault:
 	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:835
+//line mysql_sql.y:871
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -7244,7 +8011,7 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:842
+//line mysql_sql.y:878
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -7255,7 +8022,7 @@ yydefault:
 	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:850
+//line mysql_sql.y:886
 		{
 			yyLOCAL = false
 		}
@@ -7263,7 +8030,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:854
+//line mysql_sql.y:890
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7279,7 +8046,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:868
+//line mysql_sql.y:904
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
@@ -7287,7 +8054,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:872
+//line mysql_sql.y:908
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
@@ -7295,7 +8062,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:876
+//line mysql_sql.y:912
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
@@ -7303,7 +8070,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:881
+//line mysql_sql.y:917
 		{
 			yyLOCAL = nil
 		}
@@ -7311,7 +8078,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:885
+//line mysql_sql.y:921
 		{
 			yyLOCAL = nil
 		}
@@ -7319,7 +8086,7 @@ yydefault:
 	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:889
+//line mysql_sql.y:925
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
@@ -7327,7 +8094,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:895
+//line mysql_sql.y:931
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -7340,7 +8107,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:904
+//line mysql_sql.y:940
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -7353,7 +8120,7 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:915
+//line mysql_sql.y:951
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
@@ -7361,7 +8128,7 @@ yydefault:
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:919
+//line mysql_sql.y:955
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -7369,7 +8136,7 @@ yydefault:
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:925
+//line mysql_sql.y:961
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
@@ -7377,7 +8144,7 @@ yydefault:
 	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:929
+//line mysql_sql.y:965
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
@@ -7385,7 +8152,7 @@ yydefault:
 	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:935
+//line mysql_sql.y:971
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -7393,7 +8160,7 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:939
+//line mysql_sql.y:975
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -7401,7 +8168,7 @@ yydefault:
 	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:945
+//line mysql_sql.y:981
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -7427,7 +8194,7 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:969
+//line mysql_sql.y:1005
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -7449,7 +8216,7 @@ yydefault:
 	case 69:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:988
+//line mysql_sql.y:1024
 		{
 			yyLOCAL = 0
 		}
@@ -7457,7 +8224,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:992
+//line mysql_sql.y:1028
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
@@ -7465,7 +8232,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:996
+//line mysql_sql.y:1032
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
@@ -7473,7 +8240,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1001
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = nil
 		}
@@ -7481,7 +8248,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1005
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -7492,7 +8259,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1012
+//line mysql_sql.y:1048
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[3].str,
@@ -7502,32 +8269,32 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 75:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1020
+//line mysql_sql.y:1056
 		{
 			yyVAL.str = ""
 		}
 	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1027
+//line mysql_sql.y:1063
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 78:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1032
+//line mysql_sql.y:1068
 		{
 			yyVAL.str = "\n"
 		}
 	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1039
+//line mysql_sql.y:1075
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1044
+//line mysql_sql.y:1080
 		{
 			yyLOCAL = nil
 		}
@@ -7535,7 +8302,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1048
+//line mysql_sql.y:1084
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -7561,7 +8328,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1072
+//line mysql_sql.y:1108
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
@@ -7569,7 +8336,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1076
+//line mysql_sql.y:1112
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
@@ -7577,7 +8344,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1082
+//line mysql_sql.y:1118
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
@@ -7587,7 +8354,7 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1088
+//line mysql_sql.y:1124
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7609,7 +8376,7 @@ yydefault:
 	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1106
+//line mysql_sql.y:1142
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -7630,7 +8397,7 @@ yydefault:
 	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1123
+//line mysql_sql.y:1159
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -7651,7 +8418,7 @@ yydefault:
 	case 90:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1146
+//line mysql_sql.y:1182
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
@@ -7659,7 +8426,7 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1150
+//line mysql_sql.y:1186
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
@@ -7667,7 +8434,7 @@ yydefault:
 	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1154
+//line mysql_sql.y:1190
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
@@ -7675,7 +8442,7 @@ yydefault:
 	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1159
+//line mysql_sql.y:1195
 		{
 			yyLOCAL = false
 		}
@@ -7683,7 +8450,7 @@ yydefault:
 	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1163
+//line mysql_sql.y:1199
 		{
 			yyLOCAL = true
 		}
@@ -7691,7 +8458,7 @@ yydefault:
 	case 95:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1169
+//line mysql_sql.y:1205
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -7708,7 +8475,7 @@ yydefault:
 	case 96:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1182
+//line mysql_sql.y:1218
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -7723,7 +8490,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1193
+//line mysql_sql.y:1229
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -7739,7 +8506,7 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1206
+//line mysql_sql.y:1242
 		{
 			yyLOCAL = false
 		}
@@ -7747,7 +8514,7 @@ yydefault:
 	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1210
+//line mysql_sql.y:1246
 		{
 			yyLOCAL = true
 		}
@@ -7755,7 +8522,7 @@ yydefault:
 	case 100:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1220
+//line mysql_sql.y:1256
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -7772,7 +8539,7 @@ yydefault:
 	case 101:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1233
+//line mysql_sql.y:1269
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -7787,7 +8554,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1246
+//line mysql_sql.y:1282
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
@@ -7797,7 +8564,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1252
+//line mysql_sql.y:1288
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
@@ -7807,7 +8574,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1258
+//line mysql_sql.y:1294
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -7818,7 +8585,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1265
+//line mysql_sql.y:1301
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -7830,7 +8597,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1273
+//line mysql_sql.y:1309
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -7841,7 +8608,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1282
+//line mysql_sql.y:1318
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
@@ -7849,7 +8616,7 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1286
+//line mysql_sql.y:1322
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
@@ -7857,7 +8624,7 @@ yydefault:
 	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1290
+//line mysql_sql.y:1326
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
@@ -7865,7 +8632,7 @@ yydefault:
 	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1294
+//line mysql_sql.y:1330
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
@@ -7873,7 +8640,7 @@ yydefault:
 	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1298
+//line mysql_sql.y:1334
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
@@ -7881,7 +8648,7 @@ yydefault:
 	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1302
+//line mysql_sql.y:1338
 		{
 			yyLOCAL = tree.OBJECT_TYPE_ACCOUNT
 		}
@@ -7889,7 +8656,7 @@ yydefault:
 	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1309
+//line mysql_sql.y:1345
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
@@ -7897,7 +8664,7 @@ yydefault:
 	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1313
+//line mysql_sql.y:1349
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
@@ -7905,7 +8672,7 @@ yydefault:
 	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1319
+//line mysql_sql.y:1355
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -7916,7 +8683,7 @@ yydefault:
 	case 116:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1326
+//line mysql_sql.y:1362
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -7927,7 +8694,7 @@ yydefault:
 	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1335
+//line mysql_sql.y:1371
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
@@ -7935,7 +8702,7 @@ yydefault:
 	case 118:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1339
+//line mysql_sql.y:1375
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
@@ -7943,7 +8710,7 @@ yydefault:
 	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1345
+//line mysql_sql.y:1381
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
@@ -7951,7 +8718,7 @@ yydefault:
 	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1349
+//line mysql_sql.y:1385
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ACCOUNT
 		}
@@ -7959,7 +8726,7 @@ yydefault:
 	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1353
+//line mysql_sql.y:1389
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ACCOUNT
 		}
@@ -7967,7 +8734,7 @@ yydefault:
 	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1357
+//line mysql_sql.y:1393
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ACCOUNT
 		}
@@ -7975,7 +8742,7 @@ yydefault:
 	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1361
+//line mysql_sql.y:1397
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
@@ -7983,7 +8750,7 @@ yydefault:
 	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1365
+//line mysql_sql.y:1401
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_TABLE
 		}
@@ -7991,7 +8758,7 @@ yydefault:
 	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1369
+//line mysql_sql.y:1405
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_VIEW
 		}
@@ -7999,7 +8766,7 @@ yydefault:
 	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1373
+//line mysql_sql.y:1409
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
@@ -8007,7 +8774,7 @@ yydefault:
 	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1377
+//line mysql_sql.y:1413
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
@@ -8015,7 +8782,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1381
+//line mysql_sql.y:1417
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_USER
 		}
@@ -8023,7 +8790,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1385
+//line mysql_sql.y:1421
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_USER
 		}
@@ -8031,7 +8798,7 @@ yydefault:
 	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1389
+//line mysql_sql.y:1425
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
@@ -8039,7 +8806,7 @@ yydefault:
 	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1393
+//line mysql_sql.y:1429
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
@@ -8047,7 +8814,7 @@ yydefault:
 	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1397
+//line mysql_sql.y:1433
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
@@ -8055,7 +8822,7 @@ yydefault:
 	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1401
+//line mysql_sql.y:1437
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_TABLE
 		}
@@ -8063,7 +8830,7 @@ yydefault:
 	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1405
+//line mysql_sql.y:1441
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_VIEW
 		}
@@ -8071,7 +8838,7 @@ yydefault:
 	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1409
+//line mysql_sql.y:1445
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
@@ -8079,7 +8846,7 @@ yydefault:
 	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1413
+//line mysql_sql.y:1449
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
@@ -8087,7 +8854,7 @@ yydefault:
 	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1417
+//line mysql_sql.y:1453
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
@@ -8095,7 +8862,7 @@ yydefault:
 	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1421
+//line mysql_sql.y:1457
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
@@ -8103,7 +8870,7 @@ yydefault:
 	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1425
+//line mysql_sql.y:1461
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
@@ -8111,7 +8878,7 @@ yydefault:
 	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1429
+//line mysql_sql.y:1465
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE
 		}
@@ -8119,7 +8886,7 @@ yydefault:
 	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1433
+//line mysql_sql.y:1469
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_DATABASE
 		}
@@ -8127,7 +8894,7 @@ yydefault:
 	case 142:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1437
+//line mysql_sql.y:1473
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
@@ -8135,7 +8902,7 @@ yydefault:
 	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1441
+//line mysql_sql.y:1477
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CONNECT
 		}
@@ -8143,7 +8910,7 @@ yydefault:
 	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1445
+//line mysql_sql.y:1481
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS
 		}
@@ -8151,7 +8918,7 @@ yydefault:
 	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1449
+//line mysql_sql.y:1485
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
 		}
@@ -8159,7 +8926,7 @@ yydefault:
 	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1453
+//line mysql_sql.y:1489
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
 		}
@@ -8167,7 +8934,7 @@ yydefault:
 	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1457
+//line mysql_sql.y:1493
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLE
 		}
@@ -8175,7 +8942,7 @@ yydefault:
 	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1461
+//line mysql_sql.y:1497
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
@@ -8183,7 +8950,7 @@ yydefault:
 	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1465
+//line mysql_sql.y:1501
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
@@ -8191,7 +8958,7 @@ yydefault:
 	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1469
+//line mysql_sql.y:1505
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
@@ -8199,7 +8966,7 @@ yydefault:
 	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1473
+//line mysql_sql.y:1509
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCE
 		}
@@ -8207,7 +8974,7 @@ yydefault:
 	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1477
+//line mysql_sql.y:1513
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
@@ -8215,7 +8982,7 @@ yydefault:
 	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1481
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
@@ -8223,7 +8990,7 @@ yydefault:
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1485
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
@@ -8231,7 +8998,7 @@ yydefault:
 	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1489
+//line mysql_sql.y:1525
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
@@ -8239,7 +9006,7 @@ yydefault:
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1493
+//line mysql_sql.y:1529
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
@@ -8247,7 +9014,7 @@ yydefault:
 	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1497
+//line mysql_sql.y:1533
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
@@ -8255,7 +9022,7 @@ yydefault:
 	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1501
+//line mysql_sql.y:1537
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
@@ -8263,7 +9030,7 @@ yydefault:
 	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1505
+//line mysql_sql.y:1541
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
@@ -8271,7 +9038,7 @@ yydefault:
 	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1509
+//line mysql_sql.y:1545
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
@@ -8279,7 +9046,7 @@ yydefault:
 	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1513
+//line mysql_sql.y:1549
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
@@ -8287,7 +9054,7 @@ yydefault:
 	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1517
+//line mysql_sql.y:1553
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
@@ -8295,7 +9062,7 @@ yydefault:
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1521
+//line mysql_sql.y:1557
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROLE
 		}
@@ -8303,7 +9070,7 @@ yydefault:
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1525
+//line mysql_sql.y:1561
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
@@ -8311,7 +9078,7 @@ yydefault:
 	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1529
+//line mysql_sql.y:1565
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
@@ -8319,7 +9086,7 @@ yydefault:
 	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1533
+//line mysql_sql.y:1569
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
@@ -8327,7 +9094,7 @@ yydefault:
 	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1537
+//line mysql_sql.y:1573
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
@@ -8335,7 +9102,7 @@ yydefault:
 	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1541
+//line mysql_sql.y:1577
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRUNCATE
 		}
@@ -8343,7 +9110,7 @@ yydefault:
 	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1553
+//line mysql_sql.y:1589
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole: false,
@@ -8354,7 +9121,7 @@ yydefault:
 	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1560
+//line mysql_sql.y:1596
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -8365,7 +9132,7 @@ yydefault:
 	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1567
+//line mysql_sql.y:1603
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -8376,7 +9143,7 @@ yydefault:
 	case 176:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1576
+//line mysql_sql.y:1612
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
@@ -8386,7 +9153,7 @@ yydefault:
 	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1606
+//line mysql_sql.y:1642
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
@@ -8394,7 +9161,7 @@ yydefault:
 	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1610
+//line mysql_sql.y:1646
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
@@ -8402,7 +9169,7 @@ yydefault:
 	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1614
+//line mysql_sql.y:1650
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
@@ -8410,7 +9177,7 @@ yydefault:
 	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1620
+//line mysql_sql.y:1656
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
@@ -8418,7 +9185,7 @@ yydefault:
 	case 181:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1626
+//line mysql_sql.y:1662
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
@@ -8426,21 +9193,21 @@ yydefault:
 	case 182:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1630
+//line mysql_sql.y:1666
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
 	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1637
+//line mysql_sql.y:1673
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1643
+//line mysql_sql.y:1679
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
@@ -8448,7 +9215,7 @@ yydefault:
 	case 186:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1647
+//line mysql_sql.y:1683
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
@@ -8456,7 +9223,7 @@ yydefault:
 	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1653
+//line mysql_sql.y:1689
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8468,7 +9235,7 @@ yydefault:
 	case 188:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1661
+//line mysql_sql.y:1697
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8481,7 +9248,7 @@ yydefault:
 	case 189:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1670
+//line mysql_sql.y:1706
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8493,7 +9260,7 @@ yydefault:
 	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1678
+//line mysql_sql.y:1714
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -8505,7 +9272,7 @@ yydefault:
 	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1686
+//line mysql_sql.y:1722
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8532,7 +9299,7 @@ yydefault:
 	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1709
+//line mysql_sql.y:1745
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8559,7 +9326,7 @@ yydefault:
 	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1732
+//line mysql_sql.y:1768
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8570,7 +9337,7 @@ yydefault:
 	case 194:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1739
+//line mysql_sql.y:1775
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8581,7 +9348,7 @@ yydefault:
 	case 195:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1746
+//line mysql_sql.y:1782
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     strings.ToLower(yyDollar[1].str),
@@ -8593,7 +9360,7 @@ yydefault:
 	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1754
+//line mysql_sql.y:1790
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8604,7 +9371,7 @@ yydefault:
 	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1761
+//line mysql_sql.y:1797
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8615,7 +9382,7 @@ yydefault:
 	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1768
+//line mysql_sql.y:1804
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -8626,7 +9393,7 @@ yydefault:
 	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1777
+//line mysql_sql.y:1813
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
@@ -8634,7 +9401,7 @@ yydefault:
 	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1781
+//line mysql_sql.y:1817
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
@@ -8642,39 +9409,39 @@ yydefault:
 	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1785
+//line mysql_sql.y:1821
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1791
+//line mysql_sql.y:1827
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1795
+//line mysql_sql.y:1831
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1801
+//line mysql_sql.y:1837
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
 	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1805
+//line mysql_sql.y:1841
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
 		}
 	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:1811
+//line mysql_sql.y:1847
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
@@ -8682,153 +9449,197 @@ yydefault:
 	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:1815
+//line mysql_sql.y:1851
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1826
+//line mysql_sql.y:1864
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 214:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1868
+		{
+			yyLOCAL = tree.NewRollbackToSavepoint(tree.Identifier(yyDollar[5].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 215:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1874
+		{
+			yyLOCAL = tree.NewSavepoint(tree.Identifier(yyDollar[2].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 216:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1880
+		{
+			yyLOCAL = tree.NewReleaseSavepoint(tree.Identifier(yyDollar[3].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 217:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1885
+		{
+		}
+	case 218:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1887
+		{
+		}
+	case 219:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1890
+		{
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1892
+		{
+		}
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1832
+//line mysql_sql.y:1896
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 222:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1837
+//line mysql_sql.y:1901
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1841
+//line mysql_sql.y:1905
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 224:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1845
+//line mysql_sql.y:1909
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1849
+//line mysql_sql.y:1913
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 226:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1853
+//line mysql_sql.y:1917
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1857
+//line mysql_sql.y:1921
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 228:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1861
+//line mysql_sql.y:1925
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1865
+//line mysql_sql.y:1929
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1869
+//line mysql_sql.y:1933
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1875
+//line mysql_sql.y:1939
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1879
+//line mysql_sql.y:1943
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1883
+//line mysql_sql.y:1947
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 234:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1887
+//line mysql_sql.y:1951
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 226:
+	case 235:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1892
+//line mysql_sql.y:1956
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 236:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1897
+//line mysql_sql.y:1961
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1903
+//line mysql_sql.y:1967
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -8836,20 +9647,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1910
+//line mysql_sql.y:1974
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 239:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1916
+//line mysql_sql.y:1980
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -8857,10 +9668,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 240:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1923
+//line mysql_sql.y:1987
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -8868,10 +9679,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 241:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1930
+//line mysql_sql.y:1994
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -8879,19 +9690,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1940
+//line mysql_sql.y:2004
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 244:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1947
+//line mysql_sql.y:2011
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -8903,10 +9714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 245:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1958
+//line mysql_sql.y:2022
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -8916,210 +9727,222 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1969
+//line mysql_sql.y:2033
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1973
+//line mysql_sql.y:2037
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1979
+//line mysql_sql.y:2043
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1989
+//line mysql_sql.y:2053
 		{
 			yyLOCAL = &tree.LockTableStmt{TableLocks: yyDollar[3].tableLocksUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:1995
+//line mysql_sql.y:2059
 		{
 			yyLOCAL = []tree.TableLock{yyDollar[1].tableLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:1999
+//line mysql_sql.y:2063
 		{
 			yyLOCAL = append(yyDollar[1].tableLocksUnion(), yyDollar[3].tableLockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLock
-//line mysql_sql.y:2005
+//line mysql_sql.y:2069
 		{
 			yyLOCAL = tree.TableLock{*yyDollar[1].tableNameUnion(), yyDollar[2].tableLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2011
+//line mysql_sql.y:2075
 		{
 			yyLOCAL = tree.TableLockRead
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2015
+//line mysql_sql.y:2079
 		{
 			yyLOCAL = tree.TableLockReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2019
+//line mysql_sql.y:2083
 		{
 			yyLOCAL = tree.TableLockWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2023
+//line mysql_sql.y:2087
 		{
 			yyLOCAL = tree.TableLockLowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 259:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2029
+//line mysql_sql.y:2093
 		{
 			yyLOCAL = &tree.UnLockTableStmt{}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2041
+//line mysql_sql.y:2105
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 267:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2047
+//line mysql_sql.y:2111
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2051
+//line mysql_sql.y:2115
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2057
+//line mysql_sql.y:2121
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 270:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2061
+//line mysql_sql.y:2125
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2067
+//line mysql_sql.y:2131
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2073
+//line mysql_sql.y:2137
 		{
 			yyLOCAL = tree.NewReset(tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2083
+//line mysql_sql.y:2147
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2089
+//line mysql_sql.y:2153
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2093
+//line mysql_sql.y:2157
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2097
+//line mysql_sql.y:2161
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 281:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2101
+//line mysql_sql.y:2165
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 282:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2169
+		{
+			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
+			optionElem := tree.MakeOptionElem("format", yyDollar[4].cstrUnion().Compare())
+			options := tree.MakeOptions(optionElem)
+			explainStmt.Options = options
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2105
+//line mysql_sql.y:2177
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2109
+//line mysql_sql.y:2181
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -9128,10 +9951,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2117
+//line mysql_sql.y:2189
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -9140,10 +9963,24 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 286:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2197
+		{
+			explainStmt := tree.NewExplainAnalyze(yyDollar[6].statementUnion(), "text")
+			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+			optionElem2 := tree.MakeOptionElem("format", yyDollar[5].cstrUnion().Compare())
+			options := tree.MakeOptions(optionElem1)
+			options = append(options, optionElem2)
+			explainStmt.Options = options
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2125
+//line mysql_sql.y:2207
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -9154,10 +9991,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 288:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2135
+//line mysql_sql.y:2217
 		{
 			if tree.IsContainAnalyze(yyDollar[3].epxlainOptionsUnion()) {
 				explainStmt := tree.NewExplainAnalyze(yyDollar[5].statementUnion(), "text")
@@ -9170,66 +10007,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2176
+//line mysql_sql.y:2254
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2180
+//line mysql_sql.y:2258
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 301:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:2186
+//line mysql_sql.y:2264
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2192
+//line mysql_sql.y:2270
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 294:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2197
+//line mysql_sql.y:2275
 		{
 			yyVAL.str = "true"
 		}
-	case 295:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2198
+//line mysql_sql.y:2276
 		{
 			yyVAL.str = "false"
 		}
-	case 296:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2199
+//line mysql_sql.y:2277
 		{
-			yyVAL.str = yyDollar[1].str
+			yyVAL.str = yyDollar[1].cstrUnion().Compare()
+		}
+	case 306:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2282
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
 		}
-	case 297:
+		yyVAL.union = yyLOCAL
+	case 307:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2204
+//line mysql_sql.y:2286
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 314:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2219
+//line mysql_sql.y:2301
 		{
 			yyLOCAL = &tree.AlterView{
 				Name:     yyDollar[4].tableNameUnion(),
@@ -9239,10 +10084,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2230
+//line mysql_sql.y:2312
 		{
 			yyLOCAL = &tree.AlterTable{
 				Table:   *yyDollar[3].tableNameUnion(),
@@ -9250,41 +10095,160 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 317:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2239
+//line mysql_sql.y:2322
 		{
-			opt := &tree.AlterOptionAdd{
-				Def: yyDollar[2].tableDefUnion(),
+			opts := make([]tree.AlterTableOption, len(yyDollar[1].tableOptionsUnion()))
+			for i, opt := range yyDollar[1].tableOptionsUnion() {
+				opts[i] = tree.AlterTableOption(opt)
 			}
-			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(opt)}
+			yyLOCAL = opts
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 318:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2246
+//line mysql_sql.y:2330
+		{
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 319:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2336
 		{
-			yyLOCAL = []tree.AlterTableOption{tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())}
+			yyLOCAL = &tree.AlterOptionAddPartition{
+				Partitions: yyDollar[4].partitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 320:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2342
+		{
+			yyLOCAL = &tree.AlterOptionDropPartition{
+				Names: yyDollar[3].identifierListUnion(),
+			}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2348
+		{
+			yyLOCAL = &tree.AlterOptionTruncatePartition{
+				Names: yyDollar[3].identifierListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2250
+//line mysql_sql.y:2356
 		{
-			opts := make([]tree.AlterTableOption, len(yyDollar[1].tableOptionsUnion()))
-			for i, opt := range yyDollar[1].tableOptionsUnion() {
-				opts[i] = tree.AlterTableOption(opt)
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2360
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 324:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2366
+		{
+			yyLOCAL = &tree.AlterOptionAdd{
+				Def: yyDollar[2].tableDefUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2372
+		{
+			yyLOCAL = &tree.AlterOptionAdd{
+				Def: yyDollar[3].columnTableDefUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 326:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2378
+		{
+			yyLOCAL = yyDollar[2].alterTableOptionUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 327:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2382
+		{
+			yyLOCAL = &tree.AlterOptionModifyColumn{
+				Column: yyDollar[3].columnTableDefUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 328:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2388
+		{
+			yyLOCAL = &tree.AlterOptionRenameColumn{
+				OldName: tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				NewName: tree.Identifier(yyDollar[5].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 329:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2395
+		{
+			yyLOCAL = &tree.AlterOptionRenameTable{
+				Name: *yyDollar[3].tableNameUnion(),
 			}
-			yyLOCAL = opts
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 330:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2402
+		{
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:2404
+		{
+		}
+	case 332:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2407
+		{
+		}
+	case 333:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:2409
+		{
+		}
+	case 334:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:2411
+		{
+		}
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2260
+//line mysql_sql.y:2415
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropIndex,
@@ -9292,10 +10256,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2267
+//line mysql_sql.y:2422
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropKey,
@@ -9303,10 +10267,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2274
+//line mysql_sql.y:2429
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropColumn,
@@ -9314,10 +10278,21 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2436
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropColumn,
+				Name: tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2281
+//line mysql_sql.y:2443
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropForeignKey,
@@ -9325,20 +10300,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2288
+//line mysql_sql.y:2450
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ: tree.AlterTableDropPrimaryKey,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 341:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2297
+//line mysql_sql.y:2459
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -9346,13 +10321,14 @@ yydefault:
 				AuthOption:   yyDollar[5].alterAccountAuthOptionUnion(),
 				StatusOption: yyDollar[6].accountStatusUnion(),
 				Comment:      yyDollar[7].accountCommentUnion(),
+				Quota:        yyDollar[8].accountQuotaUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 342:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2309
+//line mysql_sql.y:2472
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				DbName:         yyDollar[3].str,
@@ -9361,10 +10337,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 343:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2317
+//line mysql_sql.y:2480
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				AccountName:    yyDollar[4].str,
@@ -9373,20 +10349,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2326
+//line mysql_sql.y:2489
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 345:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2332
+//line mysql_sql.y:2495
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist:          true,
@@ -9396,10 +10372,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 346:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2343
+//line mysql_sql.y:2506
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -9410,222 +10386,222 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2354
+//line mysql_sql.y:2517
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2358
+//line mysql_sql.y:2521
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2363
+//line mysql_sql.y:2526
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2367
+//line mysql_sql.y:2530
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 351:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2372
+//line mysql_sql.y:2535
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2376
+//line mysql_sql.y:2539
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2392
+//line mysql_sql.y:2555
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2396
+//line mysql_sql.y:2559
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2400
+//line mysql_sql.y:2563
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 356:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2404
+//line mysql_sql.y:2567
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2408
+//line mysql_sql.y:2571
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2412
+//line mysql_sql.y:2575
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2416
+//line mysql_sql.y:2579
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2420
+//line mysql_sql.y:2583
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 361:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2424
+//line mysql_sql.y:2587
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 362:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2428
+//line mysql_sql.y:2591
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2432
+//line mysql_sql.y:2595
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2436
+//line mysql_sql.y:2599
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 365:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2440
+//line mysql_sql.y:2603
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2444
+//line mysql_sql.y:2607
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2448
+//line mysql_sql.y:2611
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2452
+//line mysql_sql.y:2615
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2458
+//line mysql_sql.y:2621
 		{
 			yyVAL.item = nil
 		}
-	case 343:
+	case 370:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2463
+//line mysql_sql.y:2626
 		{
 			yyVAL.item = nil
 		}
-	case 368:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2497
+//line mysql_sql.y:2662
 		{
 			yyLOCAL = &tree.ShowCollation{}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 398:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2502
+//line mysql_sql.y:2667
 		{
 			yyLOCAL = &tree.ShowGrants{ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 399:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2506
+//line mysql_sql.y:2671
 		{
 			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname, Roles: yyDollar[5].rolesUnion(), ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 400:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2510
+//line mysql_sql.y:2675
 		{
 			s := &tree.ShowGrants{}
 			roles := []*tree.Role{tree.NewRole(yyDollar[5].cstrUnion().Compare())}
@@ -9634,44 +10610,44 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2519
+//line mysql_sql.y:2684
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2523
+//line mysql_sql.y:2688
 		{
 			yyLOCAL = yyDollar[2].rolesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 403:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2529
+//line mysql_sql.y:2694
 		{
 			yyLOCAL = &tree.ShowTableStatus{DbName: yyDollar[5].str, Like: yyDollar[6].comparisionExprUnion(), Where: yyDollar[7].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2534
+//line mysql_sql.y:2699
 		{
 		}
-	case 377:
+	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2538
+//line mysql_sql.y:2703
 		{
 		}
-	case 379:
+	case 408:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2543
+//line mysql_sql.y:2708
 		{
 			yyLOCAL = &tree.ShowFunctionStatus{
 				Like:  yyDollar[4].comparisionExprUnion(),
@@ -9679,50 +10655,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2552
+//line mysql_sql.y:2717
 		{
 			yyLOCAL = &tree.ShowNodeList{}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2558
+//line mysql_sql.y:2723
 		{
 			yyLOCAL = &tree.ShowLocks{}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 411:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2564
+//line mysql_sql.y:2729
 		{
 			yyLOCAL = &tree.ShowTableNumber{DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 412:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2570
+//line mysql_sql.y:2735
 		{
 			yyLOCAL = &tree.ShowColumnNumber{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 413:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2576
+//line mysql_sql.y:2741
 		{
 			yyLOCAL = &tree.ShowTableValues{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 414:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2582
+//line mysql_sql.y:2747
 		{
 			s := yyDollar[2].statementUnion().(*tree.ShowTarget)
 			s.Like = yyDollar[3].comparisionExprUnion()
@@ -9730,82 +10706,82 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 415:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2591
+//line mysql_sql.y:2756
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowConfig}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2595
+//line mysql_sql.y:2760
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2599
+//line mysql_sql.y:2764
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowEngines}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2603
+//line mysql_sql.y:2768
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowTriggers}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2607
+//line mysql_sql.y:2772
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProcedureStatus}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2611
+//line mysql_sql.y:2776
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowEvents}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2615
+//line mysql_sql.y:2780
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPlugins}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2619
+//line mysql_sql.y:2784
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPrivileges}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2623
+//line mysql_sql.y:2788
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProfiles}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 424:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2629
+//line mysql_sql.y:2794
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[5].tableNameUnion(),
@@ -9813,10 +10789,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 425:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2636
+//line mysql_sql.y:2801
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[7].cstrUnion().Compare()), ExplicitSchema: true}
 			tbl := tree.NewTableName(tree.Identifier(yyDollar[5].cstrUnion().Compare()), prefix)
@@ -9826,20 +10802,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 426:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2646
+//line mysql_sql.y:2811
 		{
 		}
-	case 398:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2648
+//line mysql_sql.y:2813
 		{
 		}
-	case 402:
+	case 431:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2657
+//line mysql_sql.y:2822
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -9848,10 +10824,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 432:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2667
+//line mysql_sql.y:2832
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -9860,58 +10836,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 433:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2676
+//line mysql_sql.y:2841
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2680
+//line mysql_sql.y:2845
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2684
+//line mysql_sql.y:2849
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2690
+//line mysql_sql.y:2855
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2696
+//line mysql_sql.y:2861
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2702
+//line mysql_sql.y:2867
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 439:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2708
+//line mysql_sql.y:2873
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -9922,10 +10898,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 440:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2718
+//line mysql_sql.y:2883
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -9936,26 +10912,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2730
+//line mysql_sql.y:2895
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2734
+//line mysql_sql.y:2899
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 443:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2740
+//line mysql_sql.y:2905
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -9968,10 +10944,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 444:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2752
+//line mysql_sql.y:2917
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -9984,188 +10960,204 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2766
+//line mysql_sql.y:2931
 		{
 			yyLOCAL = &tree.ShowAccounts{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2772
+//line mysql_sql.y:2937
 		{
 			yyLOCAL = &tree.ShowPublications{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2779
+//line mysql_sql.y:2944
 		{
 			yyLOCAL = &tree.ShowSubscriptions{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 448:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2950
+		{
+			yyLOCAL = &tree.ShowChangefeeds{Like: yyDollar[3].comparisionExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 449:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2956
+		{
+			yyLOCAL = &tree.ShowAuditPolicies{Like: yyDollar[4].comparisionExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 450:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2784
+//line mysql_sql.y:2961
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2788
+//line mysql_sql.y:2965
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 452:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:2792
+//line mysql_sql.y:2969
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 453:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2797
+//line mysql_sql.y:2974
 		{
 			yyVAL.str = ""
 		}
-	case 423:
+	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2801
+//line mysql_sql.y:2978
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 424:
+	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2807
+//line mysql_sql.y:2984
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 460:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2820
+//line mysql_sql.y:2997
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2824
+//line mysql_sql.y:3001
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2830
+//line mysql_sql.y:3007
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 463:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2835
+//line mysql_sql.y:3012
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 464:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2839
+//line mysql_sql.y:3016
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2843
+//line mysql_sql.y:3020
 		{
 			yyLOCAL = &tree.ShowCreatePublications{Name: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2849
+//line mysql_sql.y:3026
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2853
+//line mysql_sql.y:3030
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2859
+//line mysql_sql.y:3036
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 438:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2865
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2869
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 471:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2873
+//line mysql_sql.y:3050
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2879
+//line mysql_sql.y:3056
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2883
+//line mysql_sql.y:3060
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 488:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2904
+//line mysql_sql.y:3084
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10173,10 +11165,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2913
+//line mysql_sql.y:3093
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10184,26 +11176,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2922
+//line mysql_sql.y:3102
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2926
+//line mysql_sql.y:3106
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2932
+//line mysql_sql.y:3112
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -10211,10 +11203,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 493:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2941
+//line mysql_sql.y:3121
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -10222,10 +11214,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 494:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2950
+//line mysql_sql.y:3130
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].cstrUnion().Compare()),
@@ -10234,42 +11226,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 495:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2960
+//line mysql_sql.y:3140
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 496:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2966
+//line mysql_sql.y:3146
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 497:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2972
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2978
+//line mysql_sql.y:3158
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 499:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2984
+//line mysql_sql.y:3164
 		{
 			yyLOCAL = &tree.DropFunction{
 				Name: yyDollar[3].functionNameUnion(),
@@ -10277,28 +11269,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2995
+//line mysql_sql.y:3175
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3000
+//line mysql_sql.y:3180
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 504:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3007
+//line mysql_sql.y:3187
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -10315,10 +11307,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 505:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3023
+//line mysql_sql.y:3203
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -10328,10 +11320,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 506:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3036
+//line mysql_sql.y:3216
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -10341,69 +11333,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3047
+//line mysql_sql.y:3227
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3051
+//line mysql_sql.y:3231
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3057
+//line mysql_sql.y:3237
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3062
+//line mysql_sql.y:3242
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3069
+//line mysql_sql.y:3249
 		{
 		}
-	case 478:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3071
+//line mysql_sql.y:3251
 		{
 		}
-	case 479:
+	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3074
+//line mysql_sql.y:3254
 		{
 		}
-	case 484:
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3083
+//line mysql_sql.y:3263
 		{
 		}
-	case 486:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3087
+//line mysql_sql.y:3267
 		{
 		}
-	case 488:
+	case 522:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3092
+//line mysql_sql.y:3272
 		{
 			rep := yyDollar[4].replaceUnion()
 			rep.Table = yyDollar[2].tableExprUnion()
@@ -10411,10 +11403,10 @@ yydefault:
 			yyLOCAL = rep
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3101
+//line mysql_sql.y:3281
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -10422,20 +11414,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3108
+//line mysql_sql.y:3288
 		{
 			yyLOCAL = &tree.Replace{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 525:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3114
+//line mysql_sql.y:3294
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -10444,10 +11436,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 526:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3122
+//line mysql_sql.y:3302
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -10455,10 +11447,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 527:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3129
+//line mysql_sql.y:3309
 		{
 			yyLOCAL = &tree.Replace{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -10466,10 +11458,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3136
+//line mysql_sql.y:3316
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of replace can not be empty")
@@ -10488,10 +11480,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 529:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3156
+//line mysql_sql.y:3336
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -10500,42 +11492,42 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3165
+//line mysql_sql.y:3345
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3169
+//line mysql_sql.y:3349
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3175
+//line mysql_sql.y:3355
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3179
+//line mysql_sql.y:3359
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3185
+//line mysql_sql.y:3365
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -10543,20 +11535,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3192
+//line mysql_sql.y:3372
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 536:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3198
+//line mysql_sql.y:3378
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -10565,10 +11557,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3206
+//line mysql_sql.y:3386
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -10576,10 +11568,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3213
+//line mysql_sql.y:3393
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -10587,10 +11579,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3220
+//line mysql_sql.y:3400
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -10609,50 +11601,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3239
+//line mysql_sql.y:3419
 		{
 			yyLOCAL = []*tree.UpdateExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 541:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3243
+//line mysql_sql.y:3423
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3248
+//line mysql_sql.y:3428
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3252
+//line mysql_sql.y:3432
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3256
+//line mysql_sql.y:3436
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:3262
+//line mysql_sql.y:3442
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -10660,155 +11652,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3271
+//line mysql_sql.y:3451
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3275
+//line mysql_sql.y:3455
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3281
+//line mysql_sql.y:3461
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 515:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3285
+//line mysql_sql.y:3465
 		{
 			yyVAL.str = yyDollar[3].cstrUnion().Compare()
 		}
-	case 516:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3291
+//line mysql_sql.y:3471
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3295
+//line mysql_sql.y:3475
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 552:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3301
+//line mysql_sql.y:3481
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3306
+//line mysql_sql.y:3486
 		{
 		}
-	case 521:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3310
+//line mysql_sql.y:3490
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3317
+//line mysql_sql.y:3497
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 558:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3321
+//line mysql_sql.y:3501
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3328
+//line mysql_sql.y:3508
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3333
+//line mysql_sql.y:3513
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3337
+//line mysql_sql.y:3517
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3343
+//line mysql_sql.y:3523
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 564:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3347
+//line mysql_sql.y:3527
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3353
+//line mysql_sql.y:3533
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3357
+//line mysql_sql.y:3537
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3362
+//line mysql_sql.y:3542
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 568:
+		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3366
+//line mysql_sql.y:3546
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -10818,13 +11810,15 @@ yydefault:
 				Header:      yyDollar[6].unsignedOptUnion(),
 				MaxFileSize: uint64(yyDollar[7].int64ValUnion()) * 1024,
 				ForceQuote:  yyDollar[8].strsUnion(),
+				FileFormat:  yyDollar[9].str,
+				Compression: yyDollar[10].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3379
+//line mysql_sql.y:3561
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -10832,10 +11826,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3386
+//line mysql_sql.y:3568
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -10843,10 +11837,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 571:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3393
+//line mysql_sql.y:3575
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -10865,10 +11859,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 572:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3411
+//line mysql_sql.y:3593
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -10887,38 +11881,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 573:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3430
+//line mysql_sql.y:3612
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3436
+//line mysql_sql.y:3618
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 575:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3443
+//line mysql_sql.y:3625
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3447
+//line mysql_sql.y:3629
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -10931,115 +11925,208 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 577:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3460
+//line mysql_sql.y:3642
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3464
+//line mysql_sql.y:3646
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3469
+//line mysql_sql.y:3651
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3473
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 581:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:3661
+		{
+			yyVAL.str = ""
+		}
+	case 582:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:3665
+		{
+			str := strings.ToLower(yyDollar[2].str)
+			if str != tree.CSV && str != tree.JSONLINE && str != tree.PARQUET {
+				yylex.Error("error export format")
+				return 1
+			}
+			yyVAL.str = str
+		}
+	case 583:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:3675
+		{
+			yyVAL.str = ""
+		}
+	case 584:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:3679
+		{
+			yyVAL.str = strings.ToLower(yyDollar[2].str)
+		}
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3480
+//line mysql_sql.y:3685
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3485
+//line mysql_sql.y:3690
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3492
+//line mysql_sql.y:3697
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 589:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3498
+//line mysql_sql.y:3703
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion(), Lock: yyDollar[5].selectLockInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 590:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3502
+//line mysql_sql.y:3707
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3506
+//line mysql_sql.y:3711
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 592:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3510
+//line mysql_sql.y:3715
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion(), Lock: yyDollar[6].selectLockInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 593:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3514
+//line mysql_sql.y:3719
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 594:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3518
+//line mysql_sql.y:3723
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 595:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:3728
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 596:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:3732
+		{
+			yyLOCAL = &tree.SelectLockInfo{
+				LockType:   tree.SelectLockForUpdate,
+				WaitPolicy: yyDollar[3].selectLockWaitPolicyUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 597:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:3739
+		{
+			yyLOCAL = &tree.SelectLockInfo{
+				LockType:   tree.SelectLockForShare,
+				WaitPolicy: yyDollar[3].selectLockWaitPolicyUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 598:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line mysql_sql.y:3746
+		{
+			yyLOCAL = &tree.SelectLockInfo{
+				LockType: tree.SelectLockForShare,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 599:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.SelectLockWaitPolicy
+//line mysql_sql.y:3753
+		{
+			yyLOCAL = tree.SelectLockWait
+		}
+		yyVAL.union = yyLOCAL
+	case 600:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectLockWaitPolicy
+//line mysql_sql.y:3757
+		{
+			yyLOCAL = tree.SelectLockNoWait
+		}
+		yyVAL.union = yyLOCAL
+	case 601:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SelectLockWaitPolicy
+//line mysql_sql.y:3761
+		{
+			yyLOCAL = tree.SelectLockSkipLocked
+		}
+		yyVAL.union = yyLOCAL
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3524
+//line mysql_sql.y:3767
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -11047,10 +12134,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3531
+//line mysql_sql.y:3774
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -11058,26 +12145,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3540
+//line mysql_sql.y:3783
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3544
+//line mysql_sql.y:3787
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 606:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:3550
+//line mysql_sql.y:3793
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
@@ -11085,178 +12172,178 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 607:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3558
+//line mysql_sql.y:3801
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3562
+//line mysql_sql.y:3805
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 609:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3567
+//line mysql_sql.y:3810
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3571
+//line mysql_sql.y:3814
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3577
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 612:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3581
+//line mysql_sql.y:3824
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3585
+//line mysql_sql.y:3828
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 614:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3590
+//line mysql_sql.y:3833
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3594
+//line mysql_sql.y:3837
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3600
+//line mysql_sql.y:3843
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3606
+//line mysql_sql.y:3849
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3610
+//line mysql_sql.y:3853
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:3616
+//line mysql_sql.y:3859
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3621
+//line mysql_sql.y:3864
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3625
+//line mysql_sql.y:3868
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3629
+//line mysql_sql.y:3872
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 623:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3634
+//line mysql_sql.y:3877
 		{
 			yyLOCAL = tree.DefaultNullsPosition
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3638
+//line mysql_sql.y:3881
 		{
 			yyLOCAL = tree.NullsFirst
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3642
+//line mysql_sql.y:3885
 		{
 			yyLOCAL = tree.NullsLast
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3648
+//line mysql_sql.y:3891
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3652
+//line mysql_sql.y:3895
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3656
+//line mysql_sql.y:3899
 		{
 			valuesStmt := yyDollar[2].statementUnion().(*tree.ValuesStatement)
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{
@@ -11269,18 +12356,18 @@ yydefault:
 			}}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3670
+//line mysql_sql.y:3913
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3674
+//line mysql_sql.y:3917
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11291,10 +12378,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3684
+//line mysql_sql.y:3927
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11305,10 +12392,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3694
+//line mysql_sql.y:3937
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11319,10 +12406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3704
+//line mysql_sql.y:3947
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -11333,10 +12420,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3716
+//line mysql_sql.y:3959
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -11345,10 +12432,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3724
+//line mysql_sql.y:3967
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -11357,10 +12444,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3732
+//line mysql_sql.y:3975
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -11369,10 +12456,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3741
+//line mysql_sql.y:3984
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -11381,10 +12468,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3749
+//line mysql_sql.y:3992
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -11393,10 +12480,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3757
+//line mysql_sql.y:4000
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -11405,10 +12492,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3765
+//line mysql_sql.y:4008
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -11417,10 +12504,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3773
+//line mysql_sql.y:4016
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -11429,10 +12516,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3781
+//line mysql_sql.y:4024
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -11441,10 +12528,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3789
+//line mysql_sql.y:4032
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -11453,10 +12540,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3797
+//line mysql_sql.y:4040
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -11465,10 +12552,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:3805
+//line mysql_sql.y:4048
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -11477,180 +12564,194 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 646:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3815
+//line mysql_sql.y:4058
 		{
 			yyLOCAL = &tree.SelectClause{
-				Distinct: yyDollar[2].boolValUnion(),
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
+				Hint:     yyDollar[2].str,
+				Distinct: yyDollar[3].boolValUnion(),
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				From:     yyDollar[5].fromUnion(),
+				Where:    yyDollar[6].whereUnion(),
+				GroupBy:  yyDollar[7].groupByUnion(),
+				Having:   yyDollar[8].whereUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 647:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3826
+//line mysql_sql.y:4070
 		{
 			yyLOCAL = &tree.SelectClause{
+				Hint:     yyDollar[2].str,
 				Distinct: false,
-				Exprs:    yyDollar[3].selectExprsUnion(),
-				From:     yyDollar[4].fromUnion(),
-				Where:    yyDollar[5].whereUnion(),
-				GroupBy:  yyDollar[6].groupByUnion(),
-				Having:   yyDollar[7].whereUnion(),
-				Option:   yyDollar[2].str,
+				Exprs:    yyDollar[4].selectExprsUnion(),
+				From:     yyDollar[5].fromUnion(),
+				Where:    yyDollar[6].whereUnion(),
+				GroupBy:  yyDollar[7].groupByUnion(),
+				Having:   yyDollar[8].whereUnion(),
+				Option:   yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 648:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:4084
+		{
+			yyVAL.str = ""
+		}
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3840
+//line mysql_sql.y:4088
 		{
-			yyVAL.str = strings.ToLower(yyDollar[1].str)
+			yyVAL.str = yyDollar[1].str
 		}
-	case 604:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3844
+//line mysql_sql.y:4094
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 605:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3848
+//line mysql_sql.y:4098
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 606:
-		yyDollar = yyS[yypt-0 : yypt+1]
+	case 652:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:4102
+		{
+			yyVAL.str = strings.ToLower(yyDollar[1].str)
+		}
+	case 653:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3853
+//line mysql_sql.y:4107
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3857
+//line mysql_sql.y:4111
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3861
+//line mysql_sql.y:4115
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 658:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3870
+//line mysql_sql.y:4124
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 659:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3874
+//line mysql_sql.y:4128
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 660:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3879
+//line mysql_sql.y:4133
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:3883
+//line mysql_sql.y:4137
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 662:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3888
+//line mysql_sql.y:4142
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 663:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:3892
+//line mysql_sql.y:4146
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3898
+//line mysql_sql.y:4152
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:3902
+//line mysql_sql.y:4156
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3908
+//line mysql_sql.y:4162
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3912
+//line mysql_sql.y:4166
 		{
 			yyDollar[2].cstrUnion().SetConfig(0)
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3917
+//line mysql_sql.y:4171
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 669:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:3921
+//line mysql_sql.y:4175
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 670:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3926
+//line mysql_sql.y:4180
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -11659,28 +12760,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3934
+//line mysql_sql.y:4188
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:3940
+//line mysql_sql.y:4194
 		{
 			yyLOCAL = &tree.From{
 				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3948
+//line mysql_sql.y:4202
 		{
 			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
 				yyLOCAL = t
@@ -11689,26 +12790,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3956
+//line mysql_sql.y:4210
 		{
 			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3966
+//line mysql_sql.y:4220
 		{
 			yyLOCAL = yyDollar[1].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 678:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3972
+//line mysql_sql.y:4226
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11718,10 +12819,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 679:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3981
+//line mysql_sql.y:4235
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11731,10 +12832,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 680:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3990
+//line mysql_sql.y:4244
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11744,10 +12845,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:3999
+//line mysql_sql.y:4253
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -11756,15 +12857,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4009
+//line mysql_sql.y:4263
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 636:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4013
+//line mysql_sql.y:4267
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -11772,34 +12873,34 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 637:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4023
+//line mysql_sql.y:4277
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 638:
+	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4027
+//line mysql_sql.y:4281
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 639:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4031
+//line mysql_sql.y:4285
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 640:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4035
+//line mysql_sql.y:4289
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 641:
+	case 688:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4041
+//line mysql_sql.y:4295
 		{
 			yyLOCAL = &tree.ValuesStatement{
 				Rows:    yyDollar[2].rowsExprsUnion(),
@@ -11808,130 +12909,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4051
+//line mysql_sql.y:4305
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4055
+//line mysql_sql.y:4309
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 691:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4061
+//line mysql_sql.y:4315
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 692:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4067
+//line mysql_sql.y:4321
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4071
+//line mysql_sql.y:4325
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4077
+//line mysql_sql.y:4331
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 648:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4083
+//line mysql_sql.y:4337
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 649:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4087
+//line mysql_sql.y:4341
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 650:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4091
+//line mysql_sql.y:4345
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 651:
+	case 698:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4097
+//line mysql_sql.y:4351
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4101
+//line mysql_sql.y:4355
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4107
+//line mysql_sql.y:4361
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 701:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4111
+//line mysql_sql.y:4365
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4117
+//line mysql_sql.y:4371
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4121
+//line mysql_sql.y:4375
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4127
+//line mysql_sql.y:4381
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4131
+//line mysql_sql.y:4385
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -11942,10 +13043,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 706:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4141
+//line mysql_sql.y:4395
 		{
 			if yyDollar[2].str != "" {
 				yyLOCAL = &tree.AliasedTableExpr{
@@ -11959,26 +13060,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4154
+//line mysql_sql.y:4408
 		{
 			yyLOCAL = yyDollar[2].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:4160
+//line mysql_sql.y:4414
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4166
+//line mysql_sql.y:4420
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].cstrUnion().Compare()))
 			yyLOCAL = &tree.TableFunction{
@@ -11990,10 +13091,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:4179
+//line mysql_sql.y:4433
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -12004,34 +13105,49 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 711:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:4443
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[6].str),
+				},
+				IndexHints:    yyDollar[7].indexHintListUnion(),
+				AsOfTimestamp: yyDollar[5].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4190
+//line mysql_sql.y:4455
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4197
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4201
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 716:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.IndexHint
-//line mysql_sql.y:4207
+//line mysql_sql.y:4472
 		{
 			yyLOCAL = &tree.IndexHint{
 				IndexNames: yyDollar[4].strsUnion(),
@@ -12040,176 +13156,176 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4217
+//line mysql_sql.y:4482
 		{
 			yyLOCAL = tree.HintUse
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4221
+//line mysql_sql.y:4486
 		{
 			yyLOCAL = tree.HintIgnore
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4225
+//line mysql_sql.y:4490
 		{
 			yyLOCAL = tree.HintForce
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 720:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4230
+//line mysql_sql.y:4495
 		{
 			yyLOCAL = tree.HintForScan
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4234
+//line mysql_sql.y:4499
 		{
 			yyLOCAL = tree.HintForJoin
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4238
+//line mysql_sql.y:4503
 		{
 			yyLOCAL = tree.HintForOrderBy
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 723:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4242
+//line mysql_sql.y:4507
 		{
 			yyLOCAL = tree.HintForGroupBy
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 724:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4247
+//line mysql_sql.y:4512
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4251
+//line mysql_sql.y:4516
 		{
 			yyLOCAL = []string{yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4255
+//line mysql_sql.y:4520
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4259
+//line mysql_sql.y:4524
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4263
+//line mysql_sql.y:4528
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4268
+//line mysql_sql.y:4533
 		{
 			yyVAL.str = ""
 		}
-	case 682:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4272
+//line mysql_sql.y:4537
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 683:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4276
+//line mysql_sql.y:4541
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 684:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4282
+//line mysql_sql.y:4547
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 686:
+	case 734:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4288
+//line mysql_sql.y:4553
 		{
 			yyLOCAL = tree.NewCStr("", yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4292
+//line mysql_sql.y:4557
 		{
 			yyLOCAL = yyDollar[1].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 736:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4296
+//line mysql_sql.y:4561
 		{
 			yyLOCAL = yyDollar[2].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4300
+//line mysql_sql.y:4565
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 738:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4304
+//line mysql_sql.y:4569
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[2].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4310
+//line mysql_sql.y:4575
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 703:
+	case 754:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4341
+//line mysql_sql.y:4609
 		{
 			yyLOCAL = &tree.CreateExtension{
 				Language: yyDollar[3].str,
@@ -12218,22 +13334,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4351
+//line mysql_sql.y:4619
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 705:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4357
+//line mysql_sql.y:4625
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 706:
+	case 757:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4364
+//line mysql_sql.y:4632
 		{
 			yyLOCAL = &tree.CreateFunction{
 				Name:       yyDollar[3].functionNameUnion(),
@@ -12244,98 +13360,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4376
+//line mysql_sql.y:4644
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4381
+//line mysql_sql.y:4649
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 760:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4387
+//line mysql_sql.y:4655
 		{
 			yyLOCAL = tree.FunctionArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4394
+//line mysql_sql.y:4662
 		{
 			yyLOCAL = tree.FunctionArgs{yyDollar[1].funcArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4398
+//line mysql_sql.y:4666
 		{
 			yyLOCAL = append(yyDollar[1].funcArgsUnion(), yyDollar[3].funcArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArg
-//line mysql_sql.y:4404
+//line mysql_sql.y:4672
 		{
 			yyLOCAL = tree.FunctionArg(yyDollar[1].funcArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4410
+//line mysql_sql.y:4678
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(nil, yyDollar[1].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 766:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4414
+//line mysql_sql.y:4682
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 767:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4418
+//line mysql_sql.y:4686
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4424
+//line mysql_sql.y:4692
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 718:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReturnType
-//line mysql_sql.y:4430
+//line mysql_sql.y:4698
 		{
 			yyLOCAL = tree.NewReturnType(yyDollar[1].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 770:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4436
+//line mysql_sql.y:4704
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[4].tableNameUnion(),
@@ -12345,10 +13461,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 771:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4447
+//line mysql_sql.y:4715
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -12356,19 +13472,20 @@ yydefault:
 				AuthOption:   yyDollar[5].accountAuthOptionUnion(),
 				StatusOption: yyDollar[6].accountStatusUnion(),
 				Comment:      yyDollar[7].accountCommentUnion(),
+				Quota:        yyDollar[8].accountQuotaUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4459
+//line mysql_sql.y:4728
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 722:
+	case 773:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:4465
+//line mysql_sql.y:4734
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -12377,22 +13494,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4475
+//line mysql_sql.y:4744
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 724:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4479
+//line mysql_sql.y:4748
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 725:
+	case 776:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4485
+//line mysql_sql.y:4754
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -12400,20 +13517,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 777:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4492
+//line mysql_sql.y:4761
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 778:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4498
+//line mysql_sql.y:4767
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -12421,20 +13538,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 779:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4506
+//line mysql_sql.y:4775
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4512
+//line mysql_sql.y:4781
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -12442,10 +13559,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4519
+//line mysql_sql.y:4788
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -12453,20 +13570,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 782:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4527
+//line mysql_sql.y:4796
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4533
+//line mysql_sql.y:4802
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -12474,24 +13591,94 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		var yyLOCAL tree.Statement
-//line mysql_sql.y:4542
+	case 784:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.AccountQuota
+//line mysql_sql.y:4810
 		{
-			yyLOCAL = &tree.CreateUser{
-				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
-				Users:              yyDollar[4].usersUnion(),
-				Role:               yyDollar[5].accountRoleUnion(),
-				MiscOpt:            yyDollar[6].userMiscOptionUnion(),
-				CommentOrAttribute: yyDollar[7].accountCommentOrAttributeUnion(),
+			yyLOCAL = tree.AccountQuota{
+				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 785:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AccountQuota
+//line mysql_sql.y:4816
+		{
+			yyLOCAL = tree.AccountQuota{
+				Exist:   true,
+				Options: yyDollar[2].accountQuotaOptionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 786:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AccountQuotaOption
+//line mysql_sql.y:4825
+		{
+			yyLOCAL = []tree.AccountQuotaOption{yyDollar[1].accountQuotaOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 787:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.AccountQuotaOption
+//line mysql_sql.y:4829
+		{
+			yyLOCAL = append(yyDollar[1].accountQuotaOptionsUnion(), yyDollar[2].accountQuotaOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 788:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AccountQuotaOption
+//line mysql_sql.y:4835
+		{
+			yyLOCAL = tree.AccountQuotaOption{
+				Typ:   tree.AccountQuotaMaxStorageSize,
+				Value: uint64(yyDollar[2].item.(int64)),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 789:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AccountQuotaOption
+//line mysql_sql.y:4842
+		{
+			yyLOCAL = tree.AccountQuotaOption{
+				Typ:   tree.AccountQuotaMaxConnections,
+				Value: uint64(yyDollar[2].item.(int64)),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 790:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AccountQuotaOption
+//line mysql_sql.y:4849
+		{
+			yyLOCAL = tree.AccountQuotaOption{
+				Typ:   tree.AccountQuotaMaxConcurrentQueries,
+				Value: uint64(yyDollar[2].item.(int64)),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 791:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:4858
+		{
+			yyLOCAL = &tree.CreateUser{
+				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
+				Users:              yyDollar[4].usersUnion(),
+				Role:               yyDollar[5].accountRoleUnion(),
+				MiscOpt:            yyDollar[6].userMiscOptionUnion(),
+				CommentOrAttribute: yyDollar[7].accountCommentOrAttributeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 792:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4554
+//line mysql_sql.y:4870
 		{
 			yyLOCAL = &tree.CreatePublication{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -12502,22 +13689,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 793:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4565
+//line mysql_sql.y:4881
 		{
 			yyVAL.str = ""
 		}
-	case 736:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4569
+//line mysql_sql.y:4885
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 737:
+	case 795:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4575
+//line mysql_sql.y:4891
 		{
 			yyLOCAL = &tree.AlterPublication{
 				IfExists:    yyDollar[3].boolValUnion(),
@@ -12527,58 +13714,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 796:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4585
+//line mysql_sql.y:4901
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4589
+//line mysql_sql.y:4905
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				All: true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4595
+//line mysql_sql.y:4911
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				SetAccounts: yyDollar[2].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 799:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4601
+//line mysql_sql.y:4917
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				AddAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 800:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4607
+//line mysql_sql.y:4923
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				DropAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 801:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4616
+//line mysql_sql.y:4932
 		{
 			yyLOCAL = &tree.DropPublication{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -12586,26 +13773,244 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 802:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:4941
+		{
+			yyLOCAL = &tree.CreateChangefeed{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:       yyDollar[7].tableNameUnion(),
+				SinkURI:     yyDollar[9].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 803:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:4952
+		{
+			yyLOCAL = &tree.DropChangefeed{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 804:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:4961
+		{
+			yyLOCAL = &tree.CreateAuditPolicy{
+				IfNotExists: yyDollar[4].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[5].cstrUnion().Compare()),
+				Events:      yyDollar[7].auditEventsUnion(),
+				Object:      yyDollar[8].privilegeLevelUnion(),
+				Accounts:    yyDollar[9].identifierListUnion(),
+				Users:       yyDollar[10].usersUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4625
+		var yyLOCAL []tree.AuditEvent
+//line mysql_sql.y:4974
+		{
+			yyLOCAL = []tree.AuditEvent{yyDollar[1].auditEventUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 806:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AuditEvent
+//line mysql_sql.y:4978
+		{
+			yyLOCAL = append(yyDollar[1].auditEventsUnion(), yyDollar[3].auditEventUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 807:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AuditEvent
+//line mysql_sql.y:4984
+		{
+			yyLOCAL = tree.AuditEventAll
+		}
+		yyVAL.union = yyLOCAL
+	case 808:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AuditEvent
+//line mysql_sql.y:4988
+		{
+			e, ok := tree.AuditEventOf(yyDollar[1].cstrUnion().Compare())
+			if !ok {
+				yylex.Error("unknown audit event")
+				return 1
+			}
+			yyLOCAL = e
+		}
+		yyVAL.union = yyLOCAL
+	case 809:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.PrivilegeLevel
+//line mysql_sql.y:4998
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 810:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.PrivilegeLevel
+//line mysql_sql.y:5002
+		{
+			yyLOCAL = yyDollar[2].privilegeLevelUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 811:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:5007
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line mysql_sql.y:5011
+		{
+			yyLOCAL = yyDollar[2].identifierListUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.User
+//line mysql_sql.y:5016
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 814:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.User
+//line mysql_sql.y:5020
+		{
+			yyLOCAL = yyDollar[2].usersUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 815:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5026
+		{
+			yyLOCAL = &tree.DropAuditPolicy{
+				IfExists: yyDollar[4].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[5].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 816:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5035
+		{
+			yyLOCAL = &tree.CreatePolicy{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:       yyDollar[6].tableNameUnion(),
+				Command:     yyDollar[7].policyCommandUnion(),
+				Roles:       yyDollar[8].rolesUnion(),
+				Using:       yyDollar[11].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 817:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:5047
+		{
+			yyLOCAL = tree.PolicyCommandAll
+		}
+		yyVAL.union = yyLOCAL
+	case 818:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:5051
+		{
+			yyLOCAL = tree.PolicyCommandAll
+		}
+		yyVAL.union = yyLOCAL
+	case 819:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:5055
+		{
+			yyLOCAL = tree.PolicyCommandSelect
+		}
+		yyVAL.union = yyLOCAL
+	case 820:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:5059
+		{
+			yyLOCAL = tree.PolicyCommandUpdate
+		}
+		yyVAL.union = yyLOCAL
+	case 821:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.PolicyCommand
+//line mysql_sql.y:5063
+		{
+			yyLOCAL = tree.PolicyCommandDelete
+		}
+		yyVAL.union = yyLOCAL
+	case 822:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.Role
+//line mysql_sql.y:5068
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 823:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.Role
+//line mysql_sql.y:5072
+		{
+			yyLOCAL = yyDollar[2].rolesUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 824:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5078
+		{
+			yyLOCAL = &tree.DropPolicy{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:    yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 825:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:5088
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 745:
+	case 826:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:4630
+//line mysql_sql.y:5093
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:4636
+//line mysql_sql.y:5099
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -12614,10 +14019,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:4644
+//line mysql_sql.y:5107
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -12626,26 +14031,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4750
+//line mysql_sql.y:5213
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4754
+//line mysql_sql.y:5217
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:4760
+//line mysql_sql.y:5223
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -12654,26 +14059,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4770
+//line mysql_sql.y:5233
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:4774
+//line mysql_sql.y:5237
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:4780
+//line mysql_sql.y:5243
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -12682,50 +14087,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:4790
+//line mysql_sql.y:5253
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:4794
+//line mysql_sql.y:5257
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:4798
+//line mysql_sql.y:5261
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 838:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4803
+//line mysql_sql.y:5266
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4807
+//line mysql_sql.y:5270
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4813
+//line mysql_sql.y:5276
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -12733,20 +14138,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 841:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4820
+//line mysql_sql.y:5283
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 842:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:4826
+//line mysql_sql.y:5289
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -12754,16 +14159,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4835
+//line mysql_sql.y:5298
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 764:
+	case 845:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4842
+//line mysql_sql.y:5305
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -12771,90 +14176,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:4851
+//line mysql_sql.y:5314
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:4855
+//line mysql_sql.y:5318
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:4861
+//line mysql_sql.y:5324
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4875
+//line mysql_sql.y:5338
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4879
+//line mysql_sql.y:5342
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4883
+//line mysql_sql.y:5346
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4888
+//line mysql_sql.y:5351
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4892
+//line mysql_sql.y:5355
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4896
+//line mysql_sql.y:5359
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:4900
+//line mysql_sql.y:5363
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 856:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4906
+//line mysql_sql.y:5369
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -12873,18 +14278,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 857:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4925
+//line mysql_sql.y:5388
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 858:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4929
+//line mysql_sql.y:5392
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -12905,123 +14310,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 859:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4951
+//line mysql_sql.y:5414
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4955
+//line mysql_sql.y:5418
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4959
+//line mysql_sql.y:5422
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4963
+//line mysql_sql.y:5426
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:4967
+//line mysql_sql.y:5430
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4973
+//line mysql_sql.y:5436
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 865:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4977
+//line mysql_sql.y:5440
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 866:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:4983
+//line mysql_sql.y:5446
 		{
 			// Order is parsed but just ignored as MySQL dtree.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 867:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:4988
+//line mysql_sql.y:5451
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:4993
+//line mysql_sql.y:5456
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 869:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:4997
+//line mysql_sql.y:5460
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5001
+//line mysql_sql.y:5464
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5005
+//line mysql_sql.y:5468
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5009
+//line mysql_sql.y:5472
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 873:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5015
+//line mysql_sql.y:5478
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -13031,114 +14436,114 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5026
+//line mysql_sql.y:5489
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 875:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5030
+//line mysql_sql.y:5493
 		{
 			yyLOCAL = &tree.SubscriptionOption{From: tree.Identifier(yyDollar[2].str), Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 878:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5040
+//line mysql_sql.y:5503
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5044
+//line mysql_sql.y:5507
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 880:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5049
+//line mysql_sql.y:5512
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5053
+//line mysql_sql.y:5516
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5059
+//line mysql_sql.y:5522
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5063
+//line mysql_sql.y:5526
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 884:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5069
+//line mysql_sql.y:5532
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 885:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5073
+//line mysql_sql.y:5536
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 886:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5077
+//line mysql_sql.y:5540
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 887:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5082
+//line mysql_sql.y:5545
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5086
+//line mysql_sql.y:5549
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 889:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5092
+//line mysql_sql.y:5555
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -13151,10 +14556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 890:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5104
+//line mysql_sql.y:5567
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -13164,10 +14569,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 891:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5113
+//line mysql_sql.y:5576
 		{
 			yyLOCAL = &tree.CreateTable{
 				IsClusterTable:  true,
@@ -13180,19 +14585,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 892:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5126
+//line mysql_sql.y:5589
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 893:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5133
+//line mysql_sql.y:5596
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -13203,10 +14608,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 894:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5143
+//line mysql_sql.y:5606
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -13215,10 +14620,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 895:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5151
+//line mysql_sql.y:5614
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -13228,43 +14633,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5162
+//line mysql_sql.y:5625
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5166
+//line mysql_sql.y:5629
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 898:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5171
+//line mysql_sql.y:5634
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 899:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5175
+//line mysql_sql.y:5638
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 900:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:5182
+//line mysql_sql.y:5645
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -13275,34 +14680,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5193
+//line mysql_sql.y:5656
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5197
+//line mysql_sql.y:5660
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 903:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5202
+//line mysql_sql.y:5665
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 904:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5206
+//line mysql_sql.y:5669
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -13312,18 +14717,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 905:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5216
+//line mysql_sql.y:5679
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 906:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5220
+//line mysql_sql.y:5683
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: []*tree.UnresolvedName{yyDollar[3].unresolvedNameUnion()},
@@ -13331,28 +14736,28 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 907:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5227
+//line mysql_sql.y:5690
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: yyDollar[4].unresolveNamesUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 908:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5234
+//line mysql_sql.y:5697
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 909:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5238
+//line mysql_sql.y:5701
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -13361,42 +14766,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 910:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5247
+//line mysql_sql.y:5710
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 911:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5251
+//line mysql_sql.y:5714
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5257
+//line mysql_sql.y:5720
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 913:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5261
+//line mysql_sql.y:5724
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 914:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5267
+//line mysql_sql.y:5730
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13406,10 +14811,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 915:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5276
+//line mysql_sql.y:5739
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13419,42 +14824,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5286
+//line mysql_sql.y:5749
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5290
+//line mysql_sql.y:5753
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5296
+//line mysql_sql.y:5759
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5300
+//line mysql_sql.y:5763
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 920:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5306
+//line mysql_sql.y:5769
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13462,10 +14867,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5313
+//line mysql_sql.y:5776
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -13473,67 +14878,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5322
+//line mysql_sql.y:5785
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5326
+//line mysql_sql.y:5789
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 924:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5331
+//line mysql_sql.y:5794
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 925:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5335
+//line mysql_sql.y:5798
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 926:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5340
+//line mysql_sql.y:5803
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 927:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5344
+//line mysql_sql.y:5807
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 928:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5349
+//line mysql_sql.y:5812
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 929:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5353
+//line mysql_sql.y:5816
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -13543,18 +14948,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 930:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5363
+//line mysql_sql.y:5826
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5367
+//line mysql_sql.y:5830
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -13564,10 +14969,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 932:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5378
+//line mysql_sql.y:5841
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -13576,10 +14981,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 933:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5386
+//line mysql_sql.y:5849
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -13588,10 +14993,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 934:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5394
+//line mysql_sql.y:5857
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -13600,10 +15005,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 935:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5402
+//line mysql_sql.y:5865
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -13612,10 +15017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 937:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5413
+//line mysql_sql.y:5876
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -13625,10 +15030,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 938:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5422
+//line mysql_sql.y:5885
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -13639,10 +15044,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 939:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5432
+//line mysql_sql.y:5895
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -13652,479 +15057,479 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 940:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5442
+//line mysql_sql.y:5905
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5446
+//line mysql_sql.y:5909
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5451
+//line mysql_sql.y:5914
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5455
+//line mysql_sql.y:5918
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 944:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5460
+//line mysql_sql.y:5923
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5464
+//line mysql_sql.y:5927
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5470
+//line mysql_sql.y:5933
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5474
+//line mysql_sql.y:5937
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 948:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5478
+//line mysql_sql.y:5941
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 949:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5484
+//line mysql_sql.y:5947
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 950:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5488
+//line mysql_sql.y:5951
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 951:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5492
+//line mysql_sql.y:5955
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 952:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5496
+//line mysql_sql.y:5959
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 953:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5500
+//line mysql_sql.y:5963
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 954:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5504
+//line mysql_sql.y:5967
 		{
 			str := util.DealCommentString(yyDollar[3].str)
 			yyLOCAL = tree.NewTableOptionComment(str)
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 955:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5509
+//line mysql_sql.y:5972
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 956:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5513
+//line mysql_sql.y:5976
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 957:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5517
+//line mysql_sql.y:5980
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 958:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5521
+//line mysql_sql.y:5984
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 959:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5525
+//line mysql_sql.y:5988
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 960:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5529
+//line mysql_sql.y:5992
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 961:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5533
+//line mysql_sql.y:5996
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5537
+//line mysql_sql.y:6000
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5541
+//line mysql_sql.y:6004
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5545
+//line mysql_sql.y:6008
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5549
+//line mysql_sql.y:6012
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 966:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5553
+//line mysql_sql.y:6016
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 967:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5557
+//line mysql_sql.y:6020
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 968:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5561
+//line mysql_sql.y:6024
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 969:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5565
+//line mysql_sql.y:6028
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 970:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5569
+//line mysql_sql.y:6032
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5573
+//line mysql_sql.y:6036
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 972:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5577
+//line mysql_sql.y:6040
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 973:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5581
+//line mysql_sql.y:6044
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 974:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5585
+//line mysql_sql.y:6048
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 975:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5589
+//line mysql_sql.y:6052
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].cstrUnion().Compare(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 976:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5593
+//line mysql_sql.y:6056
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 977:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:5597
+//line mysql_sql.y:6060
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:5604
+//line mysql_sql.y:6067
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 979:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:5608
+//line mysql_sql.y:6071
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 980:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:5614
+//line mysql_sql.y:6077
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5619
+//line mysql_sql.y:6082
 		{
 			yyVAL.str = ""
 		}
-	case 901:
+	case 982:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5623
+//line mysql_sql.y:6086
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 902:
+	case 983:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5627
+//line mysql_sql.y:6090
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 903:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5633
+//line mysql_sql.y:6096
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5637
+//line mysql_sql.y:6100
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5641
+//line mysql_sql.y:6104
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5645
+//line mysql_sql.y:6108
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5649
+//line mysql_sql.y:6112
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:5653
+//line mysql_sql.y:6116
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:5667
+//line mysql_sql.y:6130
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 995:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:5671
+//line mysql_sql.y:6134
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:5680
+//line mysql_sql.y:6143
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 997:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:5685
+//line mysql_sql.y:6148
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 998:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:5691
+//line mysql_sql.y:6154
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:5698
+//line mysql_sql.y:6161
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 1001:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:5702
+//line mysql_sql.y:6165
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5708
+//line mysql_sql.y:6171
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5712
+//line mysql_sql.y:6175
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 1004:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5718
+//line mysql_sql.y:6181
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -14137,18 +15542,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5730
+//line mysql_sql.y:6193
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 1006:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5736
+//line mysql_sql.y:6199
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -14158,10 +15563,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 1007:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5745
+//line mysql_sql.y:6208
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -14171,10 +15576,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 1008:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5754
+//line mysql_sql.y:6217
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -14198,10 +15603,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 1009:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5777
+//line mysql_sql.y:6240
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -14211,10 +15616,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 1010:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5786
+//line mysql_sql.y:6249
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14225,10 +15630,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 1011:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:5796
+//line mysql_sql.y:6259
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -14236,297 +15641,305 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 1012:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5804
+//line mysql_sql.y:6267
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 1014:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5810
+//line mysql_sql.y:6273
 		{
 			yyVAL.str = ""
 		}
-	case 934:
+	case 1015:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5814
+//line mysql_sql.y:6277
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 937:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5824
+//line mysql_sql.y:6287
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 1019:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5830
+//line mysql_sql.y:6293
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5836
+//line mysql_sql.y:6299
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].cstrUnion().Compare()
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1026:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5850
+//line mysql_sql.y:6313
 		{
 			yyVAL.str = ""
 		}
-	case 946:
+	case 1027:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5854
+//line mysql_sql.y:6317
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 947:
+	case 1028:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:5860
+//line mysql_sql.y:6323
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:5866
+//line mysql_sql.y:6329
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1030:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:5870
+//line mysql_sql.y:6333
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1031:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:5874
+//line mysql_sql.y:6337
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1032:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5880
+//line mysql_sql.y:6343
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5884
+//line mysql_sql.y:6347
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5888
+//line mysql_sql.y:6351
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5892
+//line mysql_sql.y:6355
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1036:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:5898
+//line mysql_sql.y:6361
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:5902
+//line mysql_sql.y:6365
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1038:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:5906
+//line mysql_sql.y:6369
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1039:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:5911
+//line mysql_sql.y:6374
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:5915
+//line mysql_sql.y:6378
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:5921
+//line mysql_sql.y:6384
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1042:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:5925
+//line mysql_sql.y:6388
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1043:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5931
+//line mysql_sql.y:6394
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1044:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5935
+//line mysql_sql.y:6398
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1045:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5939
+//line mysql_sql.y:6402
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1046:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5943
+//line mysql_sql.y:6406
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5947
+//line mysql_sql.y:6410
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1048:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5951
+//line mysql_sql.y:6414
 		{
 			str := util.DealCommentString(yyDollar[2].str)
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(str), str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1049:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5956
+//line mysql_sql.y:6419
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1050:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5960
+//line mysql_sql.y:6423
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1051:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5964
+//line mysql_sql.y:6427
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1052:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:6431
+		{
+			yyLOCAL = tree.NewAttributeCompression(yyDollar[2].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 1053:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5968
+//line mysql_sql.y:6435
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1054:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5972
+//line mysql_sql.y:6439
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1055:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5976
+//line mysql_sql.y:6443
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1056:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5980
+//line mysql_sql.y:6447
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1057:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5984
+//line mysql_sql.y:6451
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -14540,58 +15953,58 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1058:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5997
+//line mysql_sql.y:6464
 		{
 			yyLOCAL = tree.NewAttributeLowCardinality()
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1059:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6003
+//line mysql_sql.y:6470
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1060:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6007
+//line mysql_sql.y:6474
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1061:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6012
+//line mysql_sql.y:6479
 		{
 			yyVAL.str = ""
 		}
-	case 980:
+	case 1062:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6016
+//line mysql_sql.y:6483
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 981:
+	case 1063:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6022
+//line mysql_sql.y:6489
 		{
 			yyVAL.str = ""
 		}
-	case 982:
+	case 1064:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6026
+//line mysql_sql.y:6493
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 983:
+	case 1065:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:6032
+//line mysql_sql.y:6499
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -14602,10 +16015,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1066:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6044
+//line mysql_sql.y:6511
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -14613,10 +16026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1067:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6051
+//line mysql_sql.y:6518
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -14624,10 +16037,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1068:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6058
+//line mysql_sql.y:6525
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -14635,10 +16048,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1069:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6065
+//line mysql_sql.y:6532
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -14646,10 +16059,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1070:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6072
+//line mysql_sql.y:6539
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -14657,339 +16070,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1071:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6081
+//line mysql_sql.y:6548
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1072:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6087
+//line mysql_sql.y:6554
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6093
+//line mysql_sql.y:6560
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1074:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6097
+//line mysql_sql.y:6564
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1075:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6101
+//line mysql_sql.y:6568
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1076:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6105
+//line mysql_sql.y:6572
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1077:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6109
+//line mysql_sql.y:6576
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1078:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6114
+//line mysql_sql.y:6581
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1080:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6121
+//line mysql_sql.y:6588
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1081:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6125
+//line mysql_sql.y:6592
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1082:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6129
+//line mysql_sql.y:6596
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1083:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6134
+//line mysql_sql.y:6601
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1084:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6138
+//line mysql_sql.y:6605
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1085:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6143
+//line mysql_sql.y:6610
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1086:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6147
+//line mysql_sql.y:6614
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1093:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:6163
+//line mysql_sql.y:6630
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1094:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6169
+//line mysql_sql.y:6636
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1095:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6173
+//line mysql_sql.y:6640
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1096:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6177
+//line mysql_sql.y:6644
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1097:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6181
+//line mysql_sql.y:6648
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1098:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6185
+//line mysql_sql.y:6652
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1099:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6189
+//line mysql_sql.y:6656
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1100:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6193
+//line mysql_sql.y:6660
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6197
+//line mysql_sql.y:6664
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6201
+//line mysql_sql.y:6668
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6205
+//line mysql_sql.y:6672
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1104:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6209
+//line mysql_sql.y:6676
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1105:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6213
+//line mysql_sql.y:6680
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6217
+//line mysql_sql.y:6684
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6223
+//line mysql_sql.y:6690
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6227
+//line mysql_sql.y:6694
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6231
+//line mysql_sql.y:6698
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1110:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6235
+//line mysql_sql.y:6702
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1111:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6239
+//line mysql_sql.y:6706
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6243
+//line mysql_sql.y:6710
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6247
+//line mysql_sql.y:6714
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1032:
+	case 1114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6251
+//line mysql_sql.y:6718
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6255
+//line mysql_sql.y:6722
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6259
+//line mysql_sql.y:6726
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6263
+//line mysql_sql.y:6730
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6267
+//line mysql_sql.y:6734
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1119:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6272
+//line mysql_sql.y:6739
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -14998,26 +16411,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1120:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6280
+//line mysql_sql.y:6747
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1121:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6285
+//line mysql_sql.y:6752
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1122:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6289
+//line mysql_sql.y:6756
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -15027,90 +16440,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1041:
+	case 1123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6298
+//line mysql_sql.y:6765
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6302
+//line mysql_sql.y:6769
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6306
+//line mysql_sql.y:6773
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6310
+//line mysql_sql.y:6777
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1127:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6315
+//line mysql_sql.y:6782
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1128:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6319
+//line mysql_sql.y:6786
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1129:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6324
+//line mysql_sql.y:6791
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6328
+//line mysql_sql.y:6795
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6334
+//line mysql_sql.y:6801
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1132:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6338
+//line mysql_sql.y:6805
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1133:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:6344
+//line mysql_sql.y:6811
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -15118,10 +16531,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1135:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6354
+//line mysql_sql.y:6821
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -15139,10 +16552,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1136:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6371
+//line mysql_sql.y:6838
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15157,10 +16570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1138:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6388
+//line mysql_sql.y:6855
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15174,10 +16587,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1139:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6401
+//line mysql_sql.y:6868
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15191,10 +16604,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6414
+//line mysql_sql.y:6881
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15207,10 +16620,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1141:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6426
+//line mysql_sql.y:6893
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15225,10 +16638,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1142:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6440
+//line mysql_sql.y:6907
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15244,10 +16657,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6455
+//line mysql_sql.y:6922
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15263,10 +16676,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1144:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6470
+//line mysql_sql.y:6937
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -15284,10 +16697,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6487
+//line mysql_sql.y:6954
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -15302,83 +16715,83 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1146:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6502
+//line mysql_sql.y:6969
 		{
 		}
-	case 1067:
+	case 1149:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6508
+//line mysql_sql.y:6975
 		{
 			yyLOCAL = &tree.WindowFrameBoundCurrentRow{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1150:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6512
+//line mysql_sql.y:6979
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6516
+//line mysql_sql.y:6983
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6522
+//line mysql_sql.y:6989
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1153:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:6526
+//line mysql_sql.y:6993
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:6534
+//line mysql_sql.y:7001
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_ROWS
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:6538
+//line mysql_sql.y:7005
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_RANGE
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:6542
+//line mysql_sql.y:7009
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_GROUPS
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6548
+//line mysql_sql.y:7015
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -15386,10 +16799,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1158:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6555
+//line mysql_sql.y:7022
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -15398,70 +16811,78 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1159:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6564
+//line mysql_sql.y:7031
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:6568
+//line mysql_sql.y:7035
 		{
 			yyLOCAL = yyDollar[1].windowFrameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1161:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:6575
+//line mysql_sql.y:7042
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1162:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:6580
+//line mysql_sql.y:7047
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:6584
+//line mysql_sql.y:7051
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1164:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6589
+//line mysql_sql.y:7056
 		{
 			yyVAL.str = ","
 		}
-	case 1083:
+	case 1165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6593
+//line mysql_sql.y:7060
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1084:
+	case 1166:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:6598
+//line mysql_sql.y:7065
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1167:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:7069
+		{
+			yyLOCAL = yyDollar[1].windowSpecUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 1168:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:6602
+//line mysql_sql.y:7075
 		{
 			yyLOCAL = &tree.WindowSpec{
 				PartitionBy: yyDollar[3].exprsUnion(),
@@ -15470,10 +16891,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1169:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6612
+//line mysql_sql.y:7085
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15485,10 +16906,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1170:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6623
+//line mysql_sql.y:7096
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15499,10 +16920,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1171:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6633
+//line mysql_sql.y:7106
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15512,10 +16933,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1172:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6642
+//line mysql_sql.y:7115
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15525,10 +16946,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1173:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6651
+//line mysql_sql.y:7124
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15539,10 +16960,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1174:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6661
+//line mysql_sql.y:7134
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15553,10 +16974,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1175:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6671
+//line mysql_sql.y:7144
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15567,10 +16988,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1176:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6681
+//line mysql_sql.y:7154
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15581,10 +17002,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1177:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6691
+//line mysql_sql.y:7164
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -15595,10 +17016,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1178:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6701
+//line mysql_sql.y:7174
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15609,10 +17030,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1179:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6711
+//line mysql_sql.y:7184
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15623,10 +17044,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1180:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6721
+//line mysql_sql.y:7194
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15637,10 +17058,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1181:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6731
+//line mysql_sql.y:7204
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15651,10 +17072,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1182:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6741
+//line mysql_sql.y:7214
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15665,10 +17086,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1183:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6751
+//line mysql_sql.y:7224
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15679,10 +17100,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1184:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6761
+//line mysql_sql.y:7234
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15693,10 +17114,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1102:
+	case 1185:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6771
+//line mysql_sql.y:7244
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15707,10 +17128,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1189:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6788
+//line mysql_sql.y:7261
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15719,10 +17140,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1190:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:7269
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1191:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6796
+//line mysql_sql.y:7278
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15731,10 +17165,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1192:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6804
+//line mysql_sql.y:7286
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15743,10 +17177,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1193:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6812
+//line mysql_sql.y:7294
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15755,10 +17189,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1194:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6820
+//line mysql_sql.y:7302
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -15769,10 +17203,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1195:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6830
+//line mysql_sql.y:7312
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15781,10 +17215,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1196:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6838
+//line mysql_sql.y:7320
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15794,10 +17228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1197:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6847
+//line mysql_sql.y:7329
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)
@@ -15809,10 +17243,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1198:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6858
+//line mysql_sql.y:7340
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
@@ -15823,10 +17257,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1199:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6868
+//line mysql_sql.y:7350
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64)
@@ -15839,10 +17273,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1200:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6880
+//line mysql_sql.y:7362
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64)
@@ -15854,10 +17288,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1201:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6891
+//line mysql_sql.y:7373
 		{
 			column := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
@@ -15867,16 +17301,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6913
+//line mysql_sql.y:7395
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1153:
+	case 1237:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6949
+//line mysql_sql.y:7431
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -15889,10 +17323,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1154:
+	case 1238:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6961
+//line mysql_sql.y:7443
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -15905,10 +17339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1155:
+	case 1239:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6973
+//line mysql_sql.y:7455
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -15919,10 +17353,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1156:
+	case 1240:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6984
+//line mysql_sql.y:7466
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15931,10 +17365,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1157:
+	case 1241:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6992
+//line mysql_sql.y:7474
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15942,10 +17376,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1158:
+	case 1242:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6999
+//line mysql_sql.y:7481
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -15953,10 +17387,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1159:
+	case 1243:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7006
+//line mysql_sql.y:7488
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -15969,10 +17403,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1160:
+	case 1244:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7018
+//line mysql_sql.y:7500
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -15981,10 +17415,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1161:
+	case 1245:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7026
+//line mysql_sql.y:7508
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -15993,10 +17427,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1162:
+	case 1246:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7034
+//line mysql_sql.y:7516
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -16008,10 +17442,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1163:
+	case 1247:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7045
+//line mysql_sql.y:7527
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -16021,10 +17455,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1164:
+	case 1248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7054
+//line mysql_sql.y:7536
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -16034,10 +17468,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1165:
+	case 1249:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7063
+//line mysql_sql.y:7545
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -16046,10 +17480,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1166:
+	case 1250:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7071
+//line mysql_sql.y:7553
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -16060,10 +17494,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1167:
+	case 1251:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7081
+//line mysql_sql.y:7563
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -16072,10 +17506,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1168:
+	case 1252:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7089
+//line mysql_sql.y:7571
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -16085,34 +17519,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1169:
+	case 1253:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7099
+//line mysql_sql.y:7581
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1170:
+	case 1254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7103
+//line mysql_sql.y:7585
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1171:
+	case 1255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7109
+//line mysql_sql.y:7591
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1172:
+	case 1256:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7113
+//line mysql_sql.y:7595
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -16123,20 +17557,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1179:
+	case 1263:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7132
+//line mysql_sql.y:7614
 		{
 		}
-	case 1180:
+	case 1264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7134
+//line mysql_sql.y:7616
 		{
 		}
-	case 1214:
+	case 1298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7175
+//line mysql_sql.y:7657
 		{
 			name := tree.SetUnresolvedName("interval")
 			str := strings.ToLower(yyDollar[3].str)
@@ -16147,90 +17581,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1215:
+	case 1299:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7186
+//line mysql_sql.y:7668
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1216:
+	case 1300:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7190
+//line mysql_sql.y:7672
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1217:
+	case 1301:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7194
+//line mysql_sql.y:7676
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1218:
+	case 1302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:7200
+//line mysql_sql.y:7682
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1219:
+	case 1303:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7205
+//line mysql_sql.y:7687
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1220:
+	case 1304:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7209
+//line mysql_sql.y:7691
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1221:
+	case 1305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7215
+//line mysql_sql.y:7697
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1222:
+	case 1306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7219
+//line mysql_sql.y:7701
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1223:
+	case 1307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7226
+//line mysql_sql.y:7708
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1224:
+	case 1308:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7230
+//line mysql_sql.y:7712
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1225:
+	case 1309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7234
+//line mysql_sql.y:7716
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -16239,355 +17673,355 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1226:
+	case 1310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7242
+//line mysql_sql.y:7724
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1227:
+	case 1311:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7246
+//line mysql_sql.y:7728
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1228:
+	case 1312:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7250
+//line mysql_sql.y:7732
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1229:
+	case 1313:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7254
+//line mysql_sql.y:7736
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1230:
+	case 1314:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7260
+//line mysql_sql.y:7742
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1231:
+	case 1315:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7264
+//line mysql_sql.y:7746
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1232:
+	case 1316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7268
+//line mysql_sql.y:7750
 		{
 			yyLOCAL = tree.NewIsUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1233:
+	case 1317:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7272
+//line mysql_sql.y:7754
 		{
 			yyLOCAL = tree.NewIsNotUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1234:
+	case 1318:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7276
+//line mysql_sql.y:7758
 		{
 			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1235:
+	case 1319:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7280
+//line mysql_sql.y:7762
 		{
 			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1236:
+	case 1320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7284
+//line mysql_sql.y:7766
 		{
 			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1237:
+	case 1321:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7288
+//line mysql_sql.y:7770
 		{
 			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1238:
+	case 1322:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7292
+//line mysql_sql.y:7774
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1239:
+	case 1323:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7296
+//line mysql_sql.y:7778
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1241:
+	case 1325:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7304
+//line mysql_sql.y:7786
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1242:
+	case 1326:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7308
+//line mysql_sql.y:7790
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1243:
+	case 1327:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7312
+//line mysql_sql.y:7794
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1244:
+	case 1328:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7316
+//line mysql_sql.y:7798
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1245:
+	case 1329:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7320
+//line mysql_sql.y:7802
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1246:
+	case 1330:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7324
+//line mysql_sql.y:7806
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1247:
+	case 1331:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7328
+//line mysql_sql.y:7810
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1248:
+	case 1332:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7332
+//line mysql_sql.y:7814
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1249:
+	case 1333:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7336
+//line mysql_sql.y:7818
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1250:
+	case 1334:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7340
+//line mysql_sql.y:7822
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1252:
+	case 1336:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7346
+//line mysql_sql.y:7828
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1253:
+	case 1337:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7350
+//line mysql_sql.y:7832
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1254:
+	case 1338:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7356
+//line mysql_sql.y:7838
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1255:
+	case 1339:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7360
+//line mysql_sql.y:7842
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1256:
+	case 1340:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7367
+//line mysql_sql.y:7849
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1257:
+	case 1341:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7371
+//line mysql_sql.y:7853
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1258:
+	case 1342:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7375
+//line mysql_sql.y:7857
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1259:
+	case 1343:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7381
+//line mysql_sql.y:7863
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1260:
+	case 1344:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7385
+//line mysql_sql.y:7867
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1261:
+	case 1345:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7389
+//line mysql_sql.y:7871
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1262:
+	case 1346:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7393
+//line mysql_sql.y:7875
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1263:
+	case 1347:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7397
+//line mysql_sql.y:7879
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1264:
+	case 1348:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7401
+//line mysql_sql.y:7883
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1265:
+	case 1349:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:7405
+//line mysql_sql.y:7887
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1266:
+	case 1350:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7411
+//line mysql_sql.y:7893
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1267:
+	case 1351:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7415
+//line mysql_sql.y:7897
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1268:
+	case 1352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7419
+//line mysql_sql.y:7901
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1269:
+	case 1353:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7423
+//line mysql_sql.y:7905
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1270:
+	case 1354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7429
+//line mysql_sql.y:7911
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1271:
+	case 1355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7433
+//line mysql_sql.y:7915
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -16601,59 +18035,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1272:
+	case 1356:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7446
+//line mysql_sql.y:7928
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1273:
+	case 1357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7451
+//line mysql_sql.y:7933
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1274:
+	case 1358:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7455
+//line mysql_sql.y:7937
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1275:
+	case 1359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7459
+//line mysql_sql.y:7941
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1276:
+	case 1360:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7463
+//line mysql_sql.y:7945
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 1277:
+	case 1361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7467
+//line mysql_sql.y:7949
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1278:
+	case 1362:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7471
+//line mysql_sql.y:7953
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -16668,53 +18102,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1279:
+	case 1363:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7485
+//line mysql_sql.y:7967
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1280:
+	case 1364:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7489
+//line mysql_sql.y:7971
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_ScoreBinary)
 		}
 		yyVAL.union = yyLOCAL
-	case 1281:
+	case 1365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7496
+//line mysql_sql.y:7978
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1285:
+	case 1369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7507
+//line mysql_sql.y:7989
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1286:
+	case 1370:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7512
+//line mysql_sql.y:7994
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1287:
+	case 1371:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7518
+//line mysql_sql.y:8000
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16727,10 +18161,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1288:
+	case 1372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7530
+//line mysql_sql.y:8012
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16743,10 +18177,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1289:
+	case 1373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7542
+//line mysql_sql.y:8024
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16759,10 +18193,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1290:
+	case 1374:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7554
+//line mysql_sql.y:8036
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16776,10 +18210,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1291:
+	case 1375:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7567
+//line mysql_sql.y:8049
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16793,10 +18227,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1292:
+	case 1376:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7580
+//line mysql_sql.y:8062
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16810,10 +18244,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1293:
+	case 1377:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7593
+//line mysql_sql.y:8075
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16827,10 +18261,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1294:
+	case 1378:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7606
+//line mysql_sql.y:8088
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16844,10 +18278,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1295:
+	case 1379:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7619
+//line mysql_sql.y:8101
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16861,10 +18295,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1296:
+	case 1380:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7632
+//line mysql_sql.y:8114
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16878,10 +18312,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1297:
+	case 1381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7645
+//line mysql_sql.y:8127
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16895,10 +18329,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1298:
+	case 1382:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7658
+//line mysql_sql.y:8140
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16912,10 +18346,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1299:
+	case 1383:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7671
+//line mysql_sql.y:8153
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16929,10 +18363,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1300:
+	case 1384:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7684
+//line mysql_sql.y:8166
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16946,10 +18380,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1301:
+	case 1385:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7699
+//line mysql_sql.y:8181
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -16977,10 +18411,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1302:
+	case 1386:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7726
+//line mysql_sql.y:8208
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -17022,10 +18456,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1303:
+	case 1387:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7768
+//line mysql_sql.y:8250
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -17062,10 +18496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1304:
+	case 1388:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7816
+//line mysql_sql.y:8298
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17081,10 +18515,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1305:
+	case 1389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7833
+//line mysql_sql.y:8315
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17097,10 +18531,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1306:
+	case 1390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7845
+//line mysql_sql.y:8327
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -17121,10 +18555,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1307:
+	case 1391:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7865
+//line mysql_sql.y:8347
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -17145,10 +18579,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1308:
+	case 1392:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7885
+//line mysql_sql.y:8367
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -17169,10 +18603,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1309:
+	case 1393:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7905
+//line mysql_sql.y:8387
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17187,10 +18621,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1310:
+	case 1394:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7921
+//line mysql_sql.y:8403
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17204,10 +18638,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1311:
+	case 1395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7934
+//line mysql_sql.y:8416
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17221,10 +18655,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1312:
+	case 1396:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7947
+//line mysql_sql.y:8429
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17238,10 +18672,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1313:
+	case 1397:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7960
+//line mysql_sql.y:8442
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17255,10 +18689,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1314:
+	case 1398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7973
+//line mysql_sql.y:8455
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17271,10 +18705,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1315:
+	case 1399:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7985
+//line mysql_sql.y:8467
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17287,10 +18721,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1316:
+	case 1400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7997
+//line mysql_sql.y:8479
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17303,10 +18737,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1317:
+	case 1401:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8009
+//line mysql_sql.y:8491
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17319,10 +18753,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1318:
+	case 1402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8021
+//line mysql_sql.y:8503
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17335,10 +18769,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1319:
+	case 1403:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8033
+//line mysql_sql.y:8515
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17351,10 +18785,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1320:
+	case 1404:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8045
+//line mysql_sql.y:8527
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17367,10 +18801,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1321:
+	case 1405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8057
+//line mysql_sql.y:8539
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17383,10 +18817,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1322:
+	case 1406:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8069
+//line mysql_sql.y:8551
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17399,10 +18833,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1323:
+	case 1407:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8081
+//line mysql_sql.y:8563
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17416,10 +18850,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1324:
+	case 1408:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8094
+//line mysql_sql.y:8576
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17433,10 +18867,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1325:
+	case 1409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8107
+//line mysql_sql.y:8589
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17450,20 +18884,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1326:
+	case 1410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8122
+//line mysql_sql.y:8604
 		{
 			yyLOCAL = &tree.Do{
 				Exprs: yyDollar[2].exprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1327:
+	case 1411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8130
+//line mysql_sql.y:8612
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -17472,10 +18906,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1328:
+	case 1412:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8139
+//line mysql_sql.y:8621
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -17484,10 +18918,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1329:
+	case 1413:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8149
+//line mysql_sql.y:8631
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -17500,75 +18934,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1330:
+	case 1414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8172
+//line mysql_sql.y:8654
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1331:
+	case 1415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8177
+//line mysql_sql.y:8659
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1332:
+	case 1416:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8183
+//line mysql_sql.y:8665
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1334:
+	case 1418:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8190
+//line mysql_sql.y:8672
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1335:
+	case 1419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8194
+//line mysql_sql.y:8676
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1336:
+	case 1420:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8199
+//line mysql_sql.y:8681
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1337:
+	case 1421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8203
+//line mysql_sql.y:8685
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1338:
+	case 1422:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8209
+//line mysql_sql.y:8691
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1339:
+	case 1423:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8215
+//line mysql_sql.y:8697
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -17576,10 +19010,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1340:
+	case 1424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8222
+//line mysql_sql.y:8704
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -17587,10 +19021,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1341:
+	case 1425:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8229
+//line mysql_sql.y:8711
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -17598,10 +19032,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1342:
+	case 1426:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8238
+//line mysql_sql.y:8720
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 34, // this is the default scale for decimal
@@ -17609,10 +19043,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1343:
+	case 1427:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8245
+//line mysql_sql.y:8727
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -17620,10 +19054,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1344:
+	case 1428:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8252
+//line mysql_sql.y:8734
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -17631,52 +19065,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1345:
+	case 1429:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8261
+//line mysql_sql.y:8743
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1346:
+	case 1430:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8265
+//line mysql_sql.y:8747
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1347:
+	case 1431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8269
+//line mysql_sql.y:8751
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1348:
+	case 1432:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8275
+//line mysql_sql.y:8757
 		{
 		}
-	case 1349:
+	case 1433:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8277
+//line mysql_sql.y:8759
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1353:
+	case 1437:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8287
+//line mysql_sql.y:8769
 		{
 			yyVAL.str = ""
 		}
-	case 1354:
+	case 1438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:8291
+//line mysql_sql.y:8773
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
@@ -174,6 +174,8 @@ import (
     properties []tree.Property
     property tree.Property
     exportParm *tree.ExportParam
+    selectLockInfo *tree.SelectLockInfo
+    selectLockWaitPolicy tree.SelectLockWaitPolicy
 
     epxlainOptions []tree.OptionElem
     epxlainOption tree.OptionElem
@@ -188,6 +190,12 @@ import (
     accountIdentified tree.AccountIdentified
     accountStatus tree.AccountStatus
     accountComment tree.AccountComment
+    accountQuota tree.AccountQuota
+    accountQuotaOption tree.AccountQuotaOption
+    accountQuotaOptions []tree.AccountQuotaOption
+    auditEvent tree.AuditEvent
+    auditEvents []tree.AuditEvent
+    policyCommand tree.PolicyCommand
     accountCommentOrAttribute tree.AccountCommentOrAttribute
     userIdentified *tree.AccountIdentified
     accountRole *tree.Role
@@ -211,6 +219,7 @@ import (
 }
 
 %token LEX_ERROR
+%token <str> OPTIMIZER_HINT
 %nonassoc EMPTY
 %left <str> UNION EXCEPT INTERSECT MINUS
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR CONNECT MANAGE GRANTS OWNERSHIP REFERENCE
@@ -218,7 +227,7 @@ import (
 %nonassoc <str> SET
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST
 %token <str> VALUES
-%token <str> NEXT VALUE SHARE MODE
+%token <str> NEXT VALUE SHARE MODE NOWAIT SKIP LOCKED
 %token <str> SQL_NO_CACHE SQL_CACHE
 %left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
 %nonassoc LOWER_THAN_ON
@@ -255,7 +264,10 @@ import (
 
 // Transaction
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK WORK CONSISTENT SNAPSHOT
-%token <str> CHAIN NO RELEASE PRIORITY QUICK
+%token <str> CHAIN NO RELEASE PRIORITY QUICK SAVEPOINT
+
+// Time travel
+%token <str> OF
 
 // Type
 %token <str> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -272,7 +284,7 @@ import (
 %token <str> LOW_PRIORITY HIGH_PRIORITY DELAYED
 
 // Create Table
-%token <str> CREATE ALTER DROP RENAME ANALYZE ADD RETURNS
+%token <str> CREATE ALTER DROP RENAME ANALYZE ADD RETURNS MODIFY
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
@@ -289,6 +301,12 @@ import (
 // publication
 %token <str> PUBLICATION SUBSCRIPTIONS PUBLICATIONS
 
+// Changefeed
+%token <str> CHANGEFEED CHANGEFEEDS
+
+// Audit
+%token <str> AUDIT POLICY POLICIES
+
 
 // MO table option
 %token <str> PROPERTIES
@@ -317,6 +335,7 @@ import (
 // User
 %token <str> USER IDENTIFIED CIPHER ISSUER X509 SUBJECT SAN REQUIRE SSL NONE PASSWORD
 %token <str> MAX_QUERIES_PER_HOUR MAX_UPDATES_PER_HOUR MAX_CONNECTIONS_PER_HOUR MAX_USER_CONNECTIONS
+%token <str> MAX_STORAGE_SIZE MAX_CONCURRENT_QUERIES
 
 // Explain
 %token <str> FORMAT VERBOSE CONNECTION TRIGGERS PROFILES
@@ -393,7 +412,7 @@ import (
 %type <statement> show_table_num_stmt show_column_num_stmt show_table_values_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt
-%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
+%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt savepoint_stmt release_savepoint_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
 %type <statement> lock_stmt lock_table_stmt unlock_table_stmt
@@ -411,9 +430,14 @@ import (
 %type <rowsExprs> row_constructor_list
 %type <exprs>  row_constructor
 %type <exportParm> export_data_param_opt
+%type <selectLockInfo> select_lock_opt
+%type <selectLockWaitPolicy> select_lock_wait_opt
 %type <loadParam> load_param_opt load_param_opt_2
 %type <tailParam> tail_param_opt
 %type <statement>  create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
+%type <statement>  create_changefeed_stmt drop_changefeed_stmt show_changefeeds_stmt
+%type <statement>  create_audit_policy_stmt drop_audit_policy_stmt show_audit_policies_stmt
+%type <statement>  create_policy_stmt drop_policy_stmt
 %type <str> comment_opt
 %type <subscriptionOption> subcription_opt
 %type <accountsSetOption> alter_publication_accounts_opt
@@ -439,7 +463,7 @@ import (
 %type <funcArg> func_arg
 %type <funcArgDecl> func_arg_decl
 %type <funcReturn> func_return
-%type <str> func_lang extension_lang extension_name
+%type <str> func_lang extension_lang extension_name export_format_opt export_compression_opt
 
 %type <tableDefs> table_elem_list_opt table_elem_list
 %type <tableDef> table_elem constaint_def constraint_elem
@@ -459,8 +483,8 @@ import (
 %type <referenceOptionType> ref_opt on_delete on_update
 %type <referenceOnRecord> on_delete_update_opt
 %type <attributeReference> references_def
-%type <alterTableOptions> alter_options
-%type <alterTableOption> alter_table_drop
+%type <alterTableOptions> alter_options alter_option_list
+%type <alterTableOption> alter_table_drop alter_option alter_partition_option
 
 %type <tableOption> table_option
 %type <from> from_clause from_opt
@@ -545,7 +569,7 @@ import (
 %type <partitionOption> partition_by_opt
 %type <clusterByOption> cluster_by_opt
 %type <partitionBy> partition_method sub_partition_method sub_partition_opt
-%type <windowSpec> window_spec_opt
+%type <windowSpec> window_spec_opt window_spec
 %type <windowFrame> window_frame window_frame_opt
 %type <windowFrameBound> window_frame_bound
 %type <windowFrameUnit> window_frame_unit
@@ -607,8 +631,8 @@ import (
 %type <epxlainOptions> utility_option_list
 %type <epxlainOption> utility_option_elem
 %type <str> utility_option_name utility_option_arg
-%type <str> explain_option_key select_option_opt
-%type <str> explain_foramt_value trim_direction
+%type <str> explain_option_key select_option_opt select_hint_opt
+%type <str> trim_direction
 %type <str> priority_opt priority quick_opt ignore_opt wild_opt
 
 %type <str> account_name account_admin_name account_role_name
@@ -617,6 +641,16 @@ import (
 %type <accountIdentified> account_identified
 %type <accountStatus> account_status_option
 %type <accountComment> account_comment_opt
+%type <accountQuota> account_quota_opt
+%type <accountQuotaOptions> account_quota_list
+%type <accountQuotaOption> account_quota
+%type <auditEvent> audit_event
+%type <auditEvents> audit_event_list
+%type <privilegeLevel> audit_object_opt
+%type <identifierList> audit_accounts_opt
+%type <users> audit_users_opt
+%type <policyCommand> policy_command_opt
+%type <roles> policy_roles_opt
 %type <accountCommentOrAttribute> user_comment_or_attribute_opt
 %type <userIdentified> user_identified user_identified_opt
 %type <accountRole> default_role_opt
@@ -769,7 +803,7 @@ mo_dump_stmt:
 	    MaxFileSize: int64($8),
 	}
     }
-|   MODUMP QUERY_RESULT STRING INTO STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   MODUMP QUERY_RESULT STRING INTO STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt export_format_opt export_compression_opt
     {
         ep := &tree.ExportParam{
 		Outfile:    true,
@@ -780,6 +814,8 @@ mo_dump_stmt:
 		Header:     $8,
 		MaxFileSize:uint64($9)*1024,
 		ForceQuote: $10,
+		FileFormat: $11,
+		Compression: $12,
 	}
         $$ = &tree.MoDump{
             DumpDatabase: false,
@@ -1820,12 +1856,40 @@ transaction_stmt:
     begin_stmt
 |   commit_stmt
 |   rollback_stmt
+|   savepoint_stmt
+|   release_savepoint_stmt
 
 rollback_stmt:
     ROLLBACK completion_type
     {
         $$ = &tree.RollbackTransaction{Type: $2}
     }
+|   ROLLBACK work_opt TO savepoint_opt ident
+    {
+        $$ = tree.NewRollbackToSavepoint(tree.Identifier($5.Compare()))
+    }
+
+savepoint_stmt:
+    SAVEPOINT ident
+    {
+        $$ = tree.NewSavepoint(tree.Identifier($2.Compare()))
+    }
+
+release_savepoint_stmt:
+    RELEASE SAVEPOINT ident
+    {
+        $$ = tree.NewReleaseSavepoint(tree.Identifier($3.Compare()))
+    }
+
+work_opt:
+    {}
+|   WORK
+    {}
+
+savepoint_opt:
+    {}
+|   SAVEPOINT
+    {}
 
 commit_stmt:
     COMMIT completion_type
@@ -2101,6 +2165,14 @@ explain_stmt:
     {
         $$ = tree.NewExplainFor($4, uint64($7.(int64)))
     }
+|   explain_sym FORMAT '=' ident explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, "text")
+        optionElem := tree.MakeOptionElem("format", $4.Compare())
+        options := tree.MakeOptions(optionElem)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym explainable_stmt
     {
         $$ = tree.NewExplainStmt($2, "text")
@@ -2121,6 +2193,16 @@ explain_stmt:
     explainStmt.Options = options
     $$ = explainStmt
     }
+|   explain_sym ANALYZE FORMAT '=' ident explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($6, "text")
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("format", $5.Compare())
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym ANALYZE VERBOSE explainable_stmt
     {
         explainStmt := tree.NewExplainAnalyze($4, "text")
@@ -2149,10 +2231,6 @@ explain_option_key:
 |   VERBOSE
 |   FORMAT
 
-explain_foramt_value:
-    JSON
-|   TEXT
-
 
 prepare_sym:
     PREPARE
@@ -2196,11 +2274,15 @@ utility_option_name:
 utility_option_arg:
     TRUE                    { $$ = "true" }
 |   FALSE                        { $$ = "false" }
-|   explain_foramt_value                    { $$ = $1 }
+|   ident                        { $$ = $1.Compare() }
 
 
 analyze_stmt:
-    ANALYZE TABLE table_name '(' column_list ')'
+    ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
+|   ANALYZE TABLE table_name '(' column_list ')'
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
@@ -2235,26 +2317,99 @@ alter_table_stmt:
     }
 
 alter_options:
-ADD table_elem
+    alter_option_list
+|   table_option_list
     {
-        opt := &tree.AlterOptionAdd{
+        opts := make([]tree.AlterTableOption, len($1))
+        for i, opt := range $1 {
+            opts[i] = tree.AlterTableOption(opt)
+        }
+        $$ =  opts
+    }
+|   alter_partition_option
+    {
+        $$ = []tree.AlterTableOption{$1}
+    }
+
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = &tree.AlterOptionAddPartition{
+            Partitions: $4,
+        }
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = &tree.AlterOptionDropPartition{
+            Names: $3,
+        }
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = &tree.AlterOptionTruncatePartition{
+            Names: $3,
+        }
+    }
+
+alter_option_list:
+    alter_option
+    {
+        $$ = []tree.AlterTableOption{$1}
+    }
+|   alter_option_list ',' alter_option
+    {
+        $$ = append($1, $3)
+    }
+
+alter_option:
+    ADD table_elem
+    {
+        $$ = &tree.AlterOptionAdd{
             Def:  $2,
         }
-        $$ = []tree.AlterTableOption{tree.AlterTableOption(opt)}
+    }
+|   ADD COLUMN column_def
+    {
+        $$ = &tree.AlterOptionAdd{
+            Def:  $3,
+        }
     }
 |   DROP alter_table_drop
     {
-        $$ = []tree.AlterTableOption{tree.AlterTableOption($2)}
+        $$ = $2
     }
-| table_option_list
+|   MODIFY column_keyword_opt column_def
     {
-        opts := make([]tree.AlterTableOption, len($1))
-        for i, opt := range $1 {
-            opts[i] = tree.AlterTableOption(opt)
+        $$ = &tree.AlterOptionModifyColumn{
+            Column: $3,
+        }
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = &tree.AlterOptionRenameColumn{
+            OldName: tree.Identifier($3.Compare()),
+            NewName: tree.Identifier($5.Compare()),
+        }
+    }
+|   RENAME to_or_as_opt table_name
+    {
+        $$ = &tree.AlterOptionRenameTable{
+            Name: *$3,
         }
-        $$ =  opts
     }
 
+column_keyword_opt:
+    {}
+|   COLUMN
+    {}
+
+to_or_as_opt:
+    {}
+|   TO
+    {}
+|   AS
+    {}
+
 alter_table_drop:
     INDEX ident 
     {
@@ -2277,6 +2432,13 @@ alter_table_drop:
             Name: tree.Identifier($2.Compare()),
         }
     }
+|   ident
+    {
+        $$ = &tree.AlterOptionDrop{
+            Typ:  tree.AlterTableDropColumn,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
 |   FOREIGN KEY ident 
     {
         $$ = &tree.AlterOptionDrop{
@@ -2293,7 +2455,7 @@ alter_table_drop:
     
 
 alter_account_stmt:
-    ALTER ACCOUNT exists_opt account_name alter_account_auth_option account_status_option account_comment_opt
+    ALTER ACCOUNT exists_opt account_name alter_account_auth_option account_status_option account_comment_opt account_quota_opt
     {
         $$ = &tree.AlterAccount{
             IfExists:$3,
@@ -2301,6 +2463,7 @@ alter_account_stmt:
             AuthOption:$5,
             StatusOption:$6,
             Comment:$7,
+            Quota:$8,
         }
     }
 
@@ -2491,6 +2654,8 @@ show_stmt:
 |   show_accounts_stmt
 |   show_publications_stmt
 |   show_subscriptions_stmt
+|   show_changefeeds_stmt
+|   show_audit_policies_stmt
 
 show_collation_stmt:
     SHOW COLLATION like_opt where_expression_opt
@@ -2780,6 +2945,18 @@ show_subscriptions_stmt:
 	$$ = &tree.ShowSubscriptions{Like: $3}
     }
 
+show_changefeeds_stmt:
+    SHOW CHANGEFEEDS like_opt
+    {
+	$$ = &tree.ShowChangefeeds{Like: $3}
+    }
+
+show_audit_policies_stmt:
+    SHOW AUDIT POLICIES like_opt
+    {
+	$$ = &tree.ShowAuditPolicies{Like: $4}
+    }
+
 like_opt:
     {
         $$ = nil
@@ -2898,6 +3075,9 @@ drop_ddl_stmt:
 |   drop_account_stmt
 |   drop_function_stmt
 |   drop_publication_stmt
+|   drop_changefeed_stmt
+|   drop_audit_policy_stmt
+|   drop_policy_stmt
 
 drop_account_stmt:
     DROP ACCOUNT exists_opt account_name
@@ -3362,7 +3542,7 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt export_format_opt export_compression_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
@@ -3372,6 +3552,8 @@ export_data_param_opt:
             Header:     $6,
             MaxFileSize:uint64($7)*1024,
             ForceQuote: $8,
+            FileFormat: $9,
+            Compression: $10,
         }
     }
 
@@ -3475,6 +3657,29 @@ force_quote_opt:
     }
 
 
+export_format_opt:
+    {
+        $$ = ""
+    }
+|   FORMAT STRING
+    {
+        str := strings.ToLower($2)
+        if str != tree.CSV && str != tree.JSONLINE && str != tree.PARQUET {
+            yylex.Error("error export format")
+            return 1
+        }
+        $$ = str
+    }
+
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
 force_quote_list:
     ident
     {
@@ -3494,9 +3699,9 @@ select_stmt:
     }
 
 select_no_parens:
-    simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+    simple_select order_by_opt limit_opt export_data_param_opt select_lock_opt
     {
-        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
+        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4, Lock: $5}
     }
 |   select_with_parens order_by_clause export_data_param_opt
     {
@@ -3506,9 +3711,9 @@ select_no_parens:
     {
         $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
     }
-|    with_clause simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+|    with_clause simple_select order_by_opt limit_opt export_data_param_opt select_lock_opt
     {
-        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1}
+        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1, Lock: $6}
     }
 |   with_clause select_with_parens order_by_clause export_data_param_opt
     {
@@ -3519,6 +3724,44 @@ select_no_parens:
         $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1}
     }
 
+select_lock_opt:
+    {
+        $$ = nil
+    }
+|   FOR UPDATE select_lock_wait_opt
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType: tree.SelectLockForUpdate,
+            WaitPolicy: $3,
+        }
+    }
+|   FOR SHARE select_lock_wait_opt
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType: tree.SelectLockForShare,
+            WaitPolicy: $3,
+        }
+    }
+|   LOCK IN SHARE MODE
+    {
+        $$ = &tree.SelectLockInfo{
+            LockType: tree.SelectLockForShare,
+        }
+    }
+
+select_lock_wait_opt:
+    {
+        $$ = tree.SelectLockWait
+    }
+|   NOWAIT
+    {
+        $$ = tree.SelectLockNoWait
+    }
+|   SKIP LOCKED
+    {
+        $$ = tree.SelectLockSkipLocked
+    }
+
 with_clause:
     WITH cte_list
     {
@@ -3811,30 +4054,41 @@ union_op:
     }
 
 simple_select_clause:
-    SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    SELECT select_hint_opt distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
-            Distinct: $2,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
+            Hint: $2,
+            Distinct: $3,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
         }
     }
-|    SELECT select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+|    SELECT select_hint_opt select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
+            Hint: $2,
             Distinct: false,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
-            Option: $2,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
+            Option: $3,
         }
     }
 
+select_hint_opt:
+    {
+        $$ = ""
+    }
+|   OPTIMIZER_HINT
+    {
+        $$ = $1
+    }
+
 select_option_opt:
     SQL_SMALL_RESULT
     {
@@ -4185,6 +4439,17 @@ aliased_table_name:
             IndexHints: $3,
         }
     }
+|   table_name AS OF TIMESTAMP expression as_opt_id index_hint_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($6),
+            },
+            IndexHints: $7,
+            AsOfTimestamp: $5,
+        }
+    }
 
 index_hint_list_opt:
 	{
@@ -4327,6 +4592,9 @@ create_stmt:
 |   create_user_stmt
 |   create_account_stmt
 |   create_publication_stmt
+|   create_changefeed_stmt
+|   create_audit_policy_stmt
+|   create_policy_stmt
 
 create_ddl_stmt:
     create_table_stmt
@@ -4443,7 +4711,7 @@ create_view_stmt:
     }
 
 create_account_stmt:
-    CREATE ACCOUNT not_exists_opt account_name account_auth_option account_status_option account_comment_opt
+    CREATE ACCOUNT not_exists_opt account_name account_auth_option account_status_option account_comment_opt account_quota_opt
     {
    		$$ = &tree.CreateAccount{
         	IfNotExists:$3,
@@ -4451,6 +4719,7 @@ create_account_stmt:
             AuthOption:$5,
             StatusOption:$6,
             Comment:$7,
+            Quota:$8,
     	}
     }
 
@@ -4537,6 +4806,53 @@ account_comment_opt:
     }
     }
 
+account_quota_opt:
+    {
+        $$ = tree.AccountQuota{
+        Exist: false,
+    }
+    }
+|   WITH account_quota_list
+    {
+    $$ = tree.AccountQuota{
+        Exist: true,
+        Options: $2,
+    }
+    }
+
+account_quota_list:
+    account_quota
+    {
+        $$ = []tree.AccountQuotaOption{$1}
+    }
+|   account_quota_list account_quota
+    {
+        $$ = append($1, $2)
+    }
+
+account_quota:
+    MAX_STORAGE_SIZE INTEGRAL
+    {
+        $$ = tree.AccountQuotaOption{
+            Typ: tree.AccountQuotaMaxStorageSize,
+            Value: uint64($2.(int64)),
+        }
+    }
+|   MAX_USER_CONNECTIONS INTEGRAL
+    {
+        $$ = tree.AccountQuotaOption{
+            Typ: tree.AccountQuotaMaxConnections,
+            Value: uint64($2.(int64)),
+        }
+    }
+|   MAX_CONCURRENT_QUERIES INTEGRAL
+    {
+        $$ = tree.AccountQuotaOption{
+            Typ: tree.AccountQuotaMaxConcurrentQueries,
+            Value: uint64($2.(int64)),
+        }
+    }
+
 create_user_stmt:
     CREATE USER not_exists_opt user_spec_list_of_create_user default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
     {
@@ -4620,6 +4936,153 @@ DROP PUBLICATION exists_opt ident
 	}
     }
 
+create_changefeed_stmt:
+    CREATE CHANGEFEED not_exists_opt ident FOR TABLE table_name INTO STRING
+    {
+	$$ = &tree.CreateChangefeed{
+	    IfNotExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	    Table: $7,
+	    SinkURI: $9,
+	}
+    }
+
+drop_changefeed_stmt:
+    DROP CHANGEFEED exists_opt ident
+    {
+	$$ = &tree.DropChangefeed{
+	    IfExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	}
+    }
+
+create_audit_policy_stmt:
+    CREATE AUDIT POLICY not_exists_opt ident FOR audit_event_list audit_object_opt audit_accounts_opt audit_users_opt
+    {
+	$$ = &tree.CreateAuditPolicy{
+	    IfNotExists: $4,
+	    Name: tree.Identifier($5.Compare()),
+	    Events: $7,
+	    Object: $8,
+	    Accounts: $9,
+	    Users: $10,
+	}
+    }
+
+audit_event_list:
+    audit_event
+    {
+	$$ = []tree.AuditEvent{$1}
+    }
+|   audit_event_list ',' audit_event
+    {
+	$$ = append($1, $3)
+    }
+
+audit_event:
+    ALL
+    {
+	$$ = tree.AuditEventAll
+    }
+|   ident
+    {
+	e, ok := tree.AuditEventOf($1.Compare())
+	if !ok {
+	    yylex.Error("unknown audit event")
+	    return 1
+	}
+	$$ = e
+    }
+
+audit_object_opt:
+    {
+	$$ = nil
+    }
+|   ON priv_level
+    {
+	$$ = $2
+    }
+
+audit_accounts_opt:
+    {
+	$$ = nil
+    }
+|   ACCOUNT accounts_list
+    {
+	$$ = $2
+    }
+
+audit_users_opt:
+    {
+	$$ = nil
+    }
+|   USER drop_user_spec_list
+    {
+	$$ = $2
+    }
+
+drop_audit_policy_stmt:
+    DROP AUDIT POLICY exists_opt ident
+    {
+	$$ = &tree.DropAuditPolicy{
+	    IfExists: $4,
+	    Name: tree.Identifier($5.Compare()),
+	}
+    }
+
+create_policy_stmt:
+    CREATE POLICY not_exists_opt ident ON table_name policy_command_opt policy_roles_opt USING '(' expression ')'
+    {
+	$$ = &tree.CreatePolicy{
+	    IfNotExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	    Table: $6,
+	    Command: $7,
+	    Roles: $8,
+	    Using: $11,
+	}
+    }
+
+policy_command_opt:
+    {
+	$$ = tree.PolicyCommandAll
+    }
+|   FOR ALL
+    {
+	$$ = tree.PolicyCommandAll
+    }
+|   FOR SELECT
+    {
+	$$ = tree.PolicyCommandSelect
+    }
+|   FOR UPDATE
+    {
+	$$ = tree.PolicyCommandUpdate
+    }
+|   FOR DELETE
+    {
+	$$ = tree.PolicyCommandDelete
+    }
+
+policy_roles_opt:
+    {
+	$$ = nil
+    }
+|   TO role_spec_list
+    {
+	$$ = $2
+    }
+
+drop_policy_stmt:
+    DROP POLICY exists_opt ident ON table_name
+    {
+	$$ = &tree.DropPolicy{
+	    IfExists: $3,
+	    Name: tree.Identifier($4.Compare()),
+	    Table: $6,
+	}
+    }
+
 account_role_name:
     ident
     {
@@ -5964,6 +6427,10 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeStorage($2)
     }
+|   COMPRESSION STRING
+    {
+        $$ = tree.NewAttributeCompression($2)
+    }
 |   AUTO_RANDOM field_length_opt
     {
         $$ = tree.NewAttributeAutoRandom(int($2))
@@ -6598,7 +7065,13 @@ window_spec_opt:
     {
         $$ = nil
     }
-|   OVER '(' window_partition_by_opt order_by_opt window_frame_opt ')'
+|   window_spec
+    {
+        $$ = $1
+    }
+
+window_spec:
+    OVER '(' window_partition_by_opt order_by_opt window_frame_opt ')'
     {
         $$ = &tree.WindowSpec{
             PartitionBy: $3,
@@ -6792,6 +7265,15 @@ function_call_generic:
             Exprs: $3,
         }
     }
+|   ID '(' expression_list_opt ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
 |   substr_option '(' expression_list_opt ')'
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
@@ -8552,6 +9034,7 @@ non_reserved_keyword:
 |   LONGBLOB
 |   LONGTEXT
 |   LOCAL
+|   LOCKED
 |   LINEAR
 |   LIST
 |   MEDIUMBLOB
@@ -8559,6 +9042,7 @@ non_reserved_keyword:
 |   MEDIUMTEXT
 |   MEMORY
 |   MODE
+|   MODIFY
 |   MULTILINESTRING
 |   MULTIPOINT
 |   MULTIPOLYGON
@@ -8566,6 +9050,8 @@ non_reserved_keyword:
 |   MAX_UPDATES_PER_HOUR
 |   MAX_CONNECTIONS_PER_HOUR
 |   MAX_USER_CONNECTIONS
+|   MAX_STORAGE_SIZE
+|   MAX_CONCURRENT_QUERIES
 |   MAX_ROWS
 |   MIN_ROWS
 |   MONTH
@@ -8574,6 +9060,7 @@ non_reserved_keyword:
 |   NUMERIC
 |   NEVER
 |   NO
+|   NOWAIT
 |   OFFSET
 |   ONLY
 |   OPTIMIZE
@@ -8605,6 +9092,7 @@ non_reserved_keyword:
 |   SESSION
 |   SERIALIZABLE
 |   SHARE
+|   SKIP
 |   SIGNED
 |   SMALLINT
 |   SNAPSHOT
@@ -8670,6 +9158,11 @@ non_reserved_keyword:
 |   PUBLICATION
 |   SUBSCRIPTIONS
 |   PUBLICATIONS
+|   CHANGEFEED
+|   CHANGEFEEDS
+|   AUDIT
+|   POLICY
+|   POLICIES
 
 func_not_keyword:
     DATE_ADD
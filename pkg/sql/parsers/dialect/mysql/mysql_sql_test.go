@@ -457,6 +457,15 @@ var (
 		input: "select sql_small_result t2.id, avg(rating + 0.0e0) from t2 group by t2.id",
 	}, {
 		input: "select sql_small_result t2.id, avg(rating) from t2 group by t2.id",
+	}, {
+		input:  "select /*+ MAX_EXECUTION_TIME(1000) */ a from t",
+		output: "select /*+ MAX_EXECUTION_TIME(1000) */ a from t",
+	}, {
+		input:  "select /*+MAX_EXECUTION_TIME(10)*/ distinct a from t where b > (select /* comment */ max(b) from t)",
+		output: "select /*+ MAX_EXECUTION_TIME(10) */ distinct a from t where b > (select max(b) from t)",
+	}, {
+		input:  "select a /*+ MAX_EXECUTION_TIME(10) */ from t",
+		output: "select a from t",
 	}, {
 		input:  "select any_value(name), avg(value1), std(value1), variance(value1) from t1, t2 where t1.id = t2.id group by t1.id",
 		output: "select any_value(name), avg(value1), std(value1), variance(value1) from t1 cross join t2 where t1.id = t2.id group by t1.id",
@@ -542,6 +551,9 @@ var (
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input:  "WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 10) SELECT * FROM qn",
+		output: "with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 10) select * from qn",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
@@ -663,6 +675,9 @@ var (
 		output: "create table t (a int, b char, primary key p1 using none (a, b))",
 	}, {
 		input: "create table t (a int, b char, primary key idx (a, b))",
+	}, {
+		input:  "create table t (a int compression 'zstd', b char)",
+		output: "create table t (a int compression zstd, b char)",
 	}, {
 		input:  "create external table t (a int) infile 'data.txt'",
 		output: "create external table t (a int) infile 'data.txt'",
@@ -1187,6 +1202,25 @@ var (
 		}, {
 			input:  "rollback and chain no release",
 			output: "rollback",
+		}, {
+			input: "savepoint sp1",
+		}, {
+			input:  "rollback to sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input:  "rollback work to savepoint sp1",
+			output: "rollback to savepoint sp1",
+		}, {
+			input: "release savepoint sp1",
+		}, {
+			input:  "select * from t1 as of timestamp '2023-04-01 10:00:00'",
+			output: "select * from t1 as of timestamp 2023-04-01 10:00:00",
+		}, {
+			input:  "select a from db1.t1 as of timestamp '2023-04-01 10:00:00' as t where a > 1",
+			output: "select a from db1.t1 as of timestamp 2023-04-01 10:00:00 as t where a > 1",
+		}, {
+			input:  "select * from t1 as of timestamp date_sub(now(), interval 1 hour) t, t2 where t.a = t2.a",
+			output: "select * from t1 as of timestamp date_sub(now(), interval(1, hour)) as t cross join t2 where t.a = t2.a",
 		}, {
 			input:  "commit and chain no release",
 			output: "commit",
@@ -1228,6 +1262,15 @@ var (
 		}, {
 			input:  "explain analyze select * from t",
 			output: "explain (analyze) select * from t",
+		}, {
+			input:  "explain (format dot) select a from A",
+			output: "explain (format dot) select a from a",
+		}, {
+			input:  "explain format = json select a from A",
+			output: "explain (format json) select a from a",
+		}, {
+			input:  "explain analyze format = dot select * from t",
+			output: "explain (analyze,format dot) select * from t",
 		}, {
 			input:  "explain format = 'tree' for connection 10",
 			output: "explain format = tree for connection 10",
@@ -1463,6 +1506,27 @@ var (
 		}, {
 			input:  "analyze table part (a,b )",
 			output: "analyze table part(a, b)",
+		}, {
+			input:  "analyze table db.part",
+			output: "analyze table db.part",
+		}, {
+			input:  "select a from t where a = 1 for update",
+			output: "select a from t where a = 1 for update",
+		}, {
+			input:  "select a from t1, t2 where t1.a = t2.a order by a limit 1 for update nowait",
+			output: "select a from t1 cross join t2 where t1.a = t2.a order by a limit 1 for update nowait",
+		}, {
+			input:  "select * from t for share skip locked",
+			output: "select * from t for share skip locked",
+		}, {
+			input:  "with c as (select a from t) select * from c for share",
+			output: "with c as (select a from t) select * from c for share",
+		}, {
+			input:  "select * from t lock in share mode",
+			output: "select * from t for share",
+		}, {
+			input:  "select nowait, skip, locked from t",
+			output: "select nowait, skip, locked from t",
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test'",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -1484,6 +1548,12 @@ var (
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+		}, {
+			input:  "select a from t into outfile '/Users/tmp/test' FORMAT 'Parquet' COMPRESSION 'zstd'",
+			output: "select a from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true format parquet compression zstd",
+		}, {
+			input:  "select a from t into outfile '/Users/tmp/test' MAX_FILE_SIZE 100 FORMAT 'jsonline'",
+			output: "select a from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true max_file_size 102400 format jsonline",
 		}, {
 			input: "drop prepare stmt_name1",
 		}, {
@@ -1801,6 +1871,12 @@ var (
 		{
 			input: `select count(a) over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1`,
 		},
+		{
+			input: `select row_number() over (partition by col1 order by col2) from t1`,
+		},
+		{
+			input: `select lag(a, 2, 0) over (order by col2 desc) from t1`,
+		},
 		{
 			input: "alter account if exists abc",
 		},
@@ -1822,6 +1898,19 @@ var (
 		{
 			input: "alter account if exists abc admin_name 'root' identified by '111' comment 'str'",
 		},
+		{
+			input: "alter account if exists abc with max_storage_size 1073741824 max_user_connections 0",
+		},
+		{
+			input:  "alter account abc comment 'str' with MAX_CONCURRENT_QUERIES 8",
+			output: "alter account abc comment 'str' with max_concurrent_queries 8",
+		},
+		{
+			input: "create account abc admin_name 'root' identified by '111' with max_storage_size 1073741824 max_user_connections 100 max_concurrent_queries 10",
+		},
+		{
+			input: "create account if not exists abc admin_name 'root' identified by '111' open comment 'str' with max_user_connections 100",
+		},
 		{
 			input: `create cluster table a (a int)`,
 		},
@@ -1907,6 +1996,10 @@ var (
 			input:  "modump query_result '0adaxg' into '/Users/tmp/test'",
 			output: "modump query_result 0adaxg into /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
 		},
+		{
+			input:  "modump query_result '0adaxg' into '/Users/tmp/test' format 'jsonline' compression 'gzip'",
+			output: "modump query_result 0adaxg into /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true format jsonline compression gzip",
+		},
 		{
 			input:  `modump query_result "queryId" into '/Users/tmp/test' FIELDS TERMINATED BY ','`,
 			output: "modump query_result queryId into /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -2004,6 +2097,41 @@ var (
 			input:  "alter table tbl1 checksum = 0, COMMENT = 'asdf'",
 			output: "alter table tbl1 checksum = 0, comment = asdf",
 		},
+		{
+			input:  "alter table tbl1 drop col1",
+			output: "alter table tbl1 drop column col1",
+		},
+		{
+			input:  "alter table tbl1 add column col1 int not null default 1, add index idx1 (col1, col2)",
+			output: "alter table tbl1 add col1 int not null default 1, add index idx1 (col1, col2)",
+		},
+		{
+			input:  "alter table tbl1 modify col1 varchar(20), modify column col2 bigint",
+			output: "alter table tbl1 modify column col1 varchar(20), modify column col2 bigint",
+		},
+		{
+			input: "alter table tbl1 rename column col1 to col2, drop column col3",
+		},
+		{
+			input:  "alter table db1.tbl1 rename as db1.tbl2",
+			output: "alter table db1.tbl1 rename to db1.tbl2",
+		},
+		{
+			input:  "alter table tbl1 rename tbl2",
+			output: "alter table tbl1 rename to tbl2",
+		},
+		{
+			input: "alter table tbl1 add partition (partition p3 values less than (2024), partition p4 values less than (MAXVALUE))",
+		},
+		{
+			input: "alter table tbl1 add partition (partition p3 values in (7, 8))",
+		},
+		{
+			input: "alter table tbl1 drop partition p0, p1",
+		},
+		{
+			input: "alter table tbl1 truncate partition p2",
+		},
 		{
 			input: "create publication pub1 database db1",
 		},
@@ -2028,6 +2156,73 @@ var (
 		{
 			input: "drop publication if exists pub1",
 		},
+		{
+			input: "create changefeed feed1 for table t1 into 'file:///tmp/t1.json'",
+		},
+		{
+			input: "create changefeed if not exists feed1 for table db1.t1 into 'kafka://broker1:9092/t1'",
+		},
+		{
+			input: "drop changefeed feed1",
+		},
+		{
+			input: "drop changefeed if exists feed1",
+		},
+		{
+			input: "show changefeeds",
+		},
+		{
+			input:  "show changefeeds like 'feed%'",
+			output: "show changefeeds like feed%",
+		},
+		{
+			input: "create audit policy p1 for ddl, dcl",
+		},
+		{
+			input:  "create audit policy if not exists p1 for LOGIN, Dml on db1.* account acc1, acc2 user u1, u2",
+			output: "create audit policy if not exists p1 for login, dml on db1.* account acc1, acc2 user u1, u2",
+		},
+		{
+			input: "create audit policy p1 for all on db1.t1",
+		},
+		{
+			input: "drop audit policy p1",
+		},
+		{
+			input: "drop audit policy if exists p1",
+		},
+		{
+			input: "show audit policies",
+		},
+		{
+			input:  "show audit policies like 'p%'",
+			output: "show audit policies like p%",
+		},
+		{
+			input:  "create policy p1 on t1 using (owner = current_user_name())",
+			output: "create policy p1 on t1 for all using (owner = current_user_name())",
+		},
+		{
+			input:  "create policy if not exists p1 on db1.t1 for select to r1, r2 using (dept in ('a', 'b') and level < 3)",
+			output: "create policy if not exists p1 on db1.t1 for select to r1, r2 using (dept in (a, b) and level < 3)",
+		},
+		{
+			input: "create policy p1 on t1 for delete using (a > 1)",
+		},
+		{
+			input: "drop policy p1 on t1",
+		},
+		{
+			input: "drop policy if exists p1 on db1.t1",
+		},
+		{
+			input:  "grant select (a, b), update (b) on table db1.t1 to r1",
+			output: "grant select(a, b), update(b) on table db1.t1 to r1",
+		},
+		{
+			input:  "revoke update (b) on table t1 from r1",
+			output: "revoke update(b) on table t1 from r1",
+		},
 		{
 			input: "alter publication pub1 account all",
 		},
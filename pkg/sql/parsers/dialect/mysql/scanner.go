@@ -31,6 +31,8 @@ type Scanner struct {
 	posVarIndex         int
 	dialectType         dialect.DialectType
 	MysqlSpecialComment *Scanner
+	// the last token is the SELECT keyword, the optimizer hints follow it
+	afterSelect bool
 
 	Pos    int
 	Line   int
@@ -128,6 +130,8 @@ func (s *Scanner) Scan() (int, string) {
 			case s.cur() == '!' && s.dialectType == dialect.MYSQL:
 				// TODO: ExtractMysqlComment
 				return s.scanMySQLSpecificComment()
+			case s.cur() == '+' && s.afterSelect:
+				return s.scanOptimizerHint()
 			default:
 				id, str := s.scanCommentTypeBlock()
 				if id == LEX_ERROR {
@@ -365,6 +369,16 @@ func (s *Scanner) scanCommentTypeBlock() (int, string) {
 	return COMMENT, s.buf[start:s.Pos]
 }
 
+// scanOptimizerHint scans a '/*+' delimited comment following the SELECT keyword,
+// and returns the hints in it; assumes the opening '/*' has already been scanned
+func (s *Scanner) scanOptimizerHint() (int, string) {
+	id, str := s.scanCommentTypeBlock()
+	if id == LEX_ERROR {
+		return id, str
+	}
+	return OPTIMIZER_HINT, strings.TrimSpace(str[3 : len(str)-2])
+}
+
 // scanMySQLSpecificComment scans a MySQL comment pragma, which always starts with '//*`
 func (s *Scanner) scanMySQLSpecificComment() (int, string) {
 	start := s.Pos - 3
@@ -92,6 +92,8 @@ type AlterAccount struct {
 	StatusOption AccountStatus
 	//comment or not
 	Comment AccountComment
+	//quota or not
+	Quota AccountQuota
 }
 
 func (ca *AlterAccount) Format(ctx *FmtCtx) {
@@ -103,6 +105,7 @@ func (ca *AlterAccount) Format(ctx *FmtCtx) {
 	ca.AuthOption.Format(ctx)
 	ca.StatusOption.Format(ctx)
 	ca.Comment.Format(ctx)
+	ca.Quota.Format(ctx)
 }
 
 func (ca *AlterAccount) GetStatementType() string { return "Alter Account" }
@@ -190,6 +193,9 @@ func (node *AlterTable) Format(ctx *FmtCtx) {
 	}
 }
 
+func (node *AlterTable) GetStatementType() string { return "Alter Table" }
+func (node *AlterTable) GetQueryType() string     { return QueryTypeDDL }
+
 type AlterTableOptions = []AlterTableOption
 
 type AlterTableOption interface {
@@ -246,6 +252,75 @@ func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
 	}
 }
 
+type AlterOptionModifyColumn struct {
+	alterOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterOptionModifyColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("modify column ")
+	node.Column.Format(ctx)
+}
+
+type AlterOptionRenameColumn struct {
+	alterOptionImpl
+	OldName Identifier
+	NewName Identifier
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	node.OldName.Format(ctx)
+	ctx.WriteString(" to ")
+	node.NewName.Format(ctx)
+}
+
+type AlterOptionRenameTable struct {
+	alterOptionImpl
+	Name TableName
+}
+
+func (node *AlterOptionRenameTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename to ")
+	node.Name.Format(ctx)
+}
+
+type AlterOptionAddPartition struct {
+	alterOptionImpl
+	Partitions []*Partition
+}
+
+func (node *AlterOptionAddPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("add partition (")
+	prefix := ""
+	for _, p := range node.Partitions {
+		ctx.WriteString(prefix)
+		p.Format(ctx)
+		prefix = ", "
+	}
+	ctx.WriteByte(')')
+}
+
+type AlterOptionDropPartition struct {
+	alterOptionImpl
+	Names IdentifierList
+}
+
+func (node *AlterOptionDropPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop partition ")
+	node.Names.Format(ctx)
+}
+
+type AlterOptionTruncatePartition struct {
+	alterOptionImpl
+	Names IdentifierList
+}
+
+func (node *AlterOptionTruncatePartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("truncate partition ")
+	node.Names.Format(ctx)
+}
+
 type AccountsSetOption struct {
 	All          bool
 	SetAccounts  IdentifierList
@@ -483,6 +483,22 @@ func NewAttributeStorage(s string) *AttributeStorage {
 	}
 }
 
+type AttributeCompression struct {
+	columnAttributeImpl
+	Compression string
+}
+
+func (node *AttributeCompression) Format(ctx *FmtCtx) {
+	ctx.WriteString("compression ")
+	ctx.WriteString(node.Compression)
+}
+
+func NewAttributeCompression(c string) *AttributeCompression {
+	return &AttributeCompression{
+		Compression: c,
+	}
+}
+
 type AttributeCheckConstraint struct {
 	columnAttributeImpl
 	Name     string
@@ -2378,6 +2394,8 @@ type CreateAccount struct {
 	StatusOption AccountStatus
 	//comment or not
 	Comment AccountComment
+	//quota or not
+	Quota AccountQuota
 }
 
 func (ca *CreateAccount) Format(ctx *FmtCtx) {
@@ -2389,6 +2407,7 @@ func (ca *CreateAccount) Format(ctx *FmtCtx) {
 	ca.AuthOption.Format(ctx)
 	ca.StatusOption.Format(ctx)
 	ca.Comment.Format(ctx)
+	ca.Quota.Format(ctx)
 }
 
 func (ca *CreateAccount) GetStatementType() string { return "Create Account" }
@@ -2482,6 +2501,50 @@ func (node *AccountComment) Format(ctx *FmtCtx) {
 	}
 }
 
+type AccountQuotaType int
+
+const (
+	// AccountQuotaMaxStorageSize limits the storage size of the account in the cluster
+	AccountQuotaMaxStorageSize AccountQuotaType = iota
+	// AccountQuotaMaxConnections limits the connections of the account in the cluster
+	AccountQuotaMaxConnections
+	// AccountQuotaMaxConcurrentQueries limits the running statements of the account in the cluster
+	AccountQuotaMaxConcurrentQueries
+)
+
+func (t AccountQuotaType) String() string {
+	switch t {
+	case AccountQuotaMaxStorageSize:
+		return "max_storage_size"
+	case AccountQuotaMaxConnections:
+		return "max_user_connections"
+	case AccountQuotaMaxConcurrentQueries:
+		return "max_concurrent_queries"
+	default:
+		return "unknown"
+	}
+}
+
+// AccountQuotaOption is one limit of an account, 0 means unlimited
+type AccountQuotaOption struct {
+	Typ   AccountQuotaType
+	Value uint64
+}
+
+type AccountQuota struct {
+	Exist   bool
+	Options []AccountQuotaOption
+}
+
+func (node *AccountQuota) Format(ctx *FmtCtx) {
+	if node.Exist {
+		ctx.WriteString(" with")
+		for _, opt := range node.Options {
+			ctx.WriteString(fmt.Sprintf(" %s %d", opt.Typ, opt.Value))
+		}
+	}
+}
+
 type AccountCommentOrAttribute struct {
 	Exist     bool
 	IsComment bool
@@ -2533,3 +2596,177 @@ func (node *CreatePublication) Format(ctx *FmtCtx) {
 
 func (node *CreatePublication) GetStatementType() string { return "Create Publication" }
 func (node *CreatePublication) GetQueryType() string     { return QueryTypeDCL }
+
+// CreateChangefeed captures the changes of the table and sends them to the sink
+type CreateChangefeed struct {
+	statementImpl
+	IfNotExists bool
+	Name        Identifier
+	Table       *TableName
+	SinkURI     string
+}
+
+func (node *CreateChangefeed) Format(ctx *FmtCtx) {
+	ctx.WriteString("create changefeed ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" for table ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" into ")
+	ctx.WriteString(fmt.Sprintf("'%s'", node.SinkURI))
+}
+
+func (node *CreateChangefeed) GetStatementType() string { return "Create Changefeed" }
+func (node *CreateChangefeed) GetQueryType() string     { return QueryTypeOth }
+
+// AuditEvent is the kind of the events recorded by an audit policy
+type AuditEvent int
+
+const (
+	AuditEventLogin AuditEvent = iota
+	AuditEventDDL
+	AuditEventDCL
+	AuditEventDML
+	AuditEventDQL
+	AuditEventAll
+)
+
+func (e AuditEvent) String() string {
+	switch e {
+	case AuditEventLogin:
+		return "login"
+	case AuditEventDDL:
+		return "ddl"
+	case AuditEventDCL:
+		return "dcl"
+	case AuditEventDML:
+		return "dml"
+	case AuditEventDQL:
+		return "dql"
+	case AuditEventAll:
+		return "all"
+	}
+	return "unknown"
+}
+
+// AuditEventOf returns the audit event named s
+func AuditEventOf(s string) (AuditEvent, bool) {
+	for e := AuditEventLogin; e <= AuditEventAll; e++ {
+		if strings.EqualFold(e.String(), s) {
+			return e, true
+		}
+	}
+	return 0, false
+}
+
+// CreateAuditPolicy records the events of the accounts and the users
+// that touch the object into the audit log
+type CreateAuditPolicy struct {
+	statementImpl
+	IfNotExists bool
+	Name        Identifier
+	Events      []AuditEvent
+	Object      *PrivilegeLevel
+	Accounts    IdentifierList
+	Users       []*User
+}
+
+func (node *CreateAuditPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("create audit policy ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" for")
+	prefix := " "
+	for _, e := range node.Events {
+		ctx.WriteString(prefix)
+		ctx.WriteString(e.String())
+		prefix = ", "
+	}
+	if node.Object != nil {
+		ctx.WriteString(" on ")
+		node.Object.Format(ctx)
+	}
+	if len(node.Accounts) != 0 {
+		ctx.WriteString(" account ")
+		node.Accounts.Format(ctx)
+	}
+	if len(node.Users) != 0 {
+		ctx.WriteString(" user")
+		prefix = " "
+		for _, u := range node.Users {
+			ctx.WriteString(prefix)
+			u.Format(ctx)
+			prefix = ", "
+		}
+	}
+}
+
+func (node *CreateAuditPolicy) GetStatementType() string { return "Create Audit Policy" }
+func (node *CreateAuditPolicy) GetQueryType() string     { return QueryTypeDCL }
+
+// PolicyCommand is the kind of the statements a row level security policy applies to
+type PolicyCommand int
+
+const (
+	PolicyCommandAll PolicyCommand = iota
+	PolicyCommandSelect
+	PolicyCommandUpdate
+	PolicyCommandDelete
+)
+
+func (c PolicyCommand) String() string {
+	switch c {
+	case PolicyCommandAll:
+		return "all"
+	case PolicyCommandSelect:
+		return "select"
+	case PolicyCommandUpdate:
+		return "update"
+	case PolicyCommandDelete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// CreatePolicy restricts the rows of the table the roles can access
+// to the rows satisfying the filter
+type CreatePolicy struct {
+	statementImpl
+	IfNotExists bool
+	Name        Identifier
+	Table       *TableName
+	Command     PolicyCommand
+	Roles       []*Role
+	Using       Expr
+}
+
+func (node *CreatePolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("create policy ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	ctx.WriteString(" for ")
+	ctx.WriteString(node.Command.String())
+	if len(node.Roles) != 0 {
+		ctx.WriteString(" to")
+		prefix := " "
+		for _, r := range node.Roles {
+			ctx.WriteString(prefix)
+			r.Format(ctx)
+			prefix = ", "
+		}
+	}
+	ctx.WriteString(" using (")
+	node.Using.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+func (node *CreatePolicy) GetStatementType() string { return "Create Policy" }
+func (node *CreatePolicy) GetQueryType() string     { return QueryTypeDCL }
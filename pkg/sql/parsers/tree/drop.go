@@ -217,3 +217,60 @@ func (node *DropPublication) Format(ctx *FmtCtx) {
 	ctx.WriteByte(' ')
 	node.Name.Format(ctx)
 }
+
+type DropChangefeed struct {
+	statementImpl
+	Name     Identifier
+	IfExists bool
+}
+
+func (node *DropChangefeed) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop changefeed")
+	if node.IfExists {
+		ctx.WriteString(" if exists")
+	}
+	ctx.WriteByte(' ')
+	node.Name.Format(ctx)
+}
+
+func (node *DropChangefeed) GetStatementType() string { return "Drop Changefeed" }
+func (node *DropChangefeed) GetQueryType() string     { return QueryTypeOth }
+
+type DropAuditPolicy struct {
+	statementImpl
+	Name     Identifier
+	IfExists bool
+}
+
+func (node *DropAuditPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop audit policy")
+	if node.IfExists {
+		ctx.WriteString(" if exists")
+	}
+	ctx.WriteByte(' ')
+	node.Name.Format(ctx)
+}
+
+func (node *DropAuditPolicy) GetStatementType() string { return "Drop Audit Policy" }
+func (node *DropAuditPolicy) GetQueryType() string     { return QueryTypeDCL }
+
+type DropPolicy struct {
+	statementImpl
+	Name     Identifier
+	Table    *TableName
+	IfExists bool
+}
+
+func (node *DropPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop policy")
+	if node.IfExists {
+		ctx.WriteString(" if exists")
+	}
+	ctx.WriteByte(' ')
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+}
+
+func (node *DropPolicy) GetStatementType() string { return "Drop Policy" }
+func (node *DropPolicy) GetQueryType() string     { return QueryTypeDCL }
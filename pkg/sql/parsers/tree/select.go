@@ -31,6 +31,7 @@ type Select struct {
 	Limit   *Limit
 	With    *With
 	Ep      *ExportParam
+	Lock    *SelectLockInfo
 }
 
 func (node *Select) Format(ctx *FmtCtx) {
@@ -51,6 +52,10 @@ func (node *Select) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Ep.Format(ctx)
 	}
+	if node.Lock != nil {
+		ctx.WriteByte(' ')
+		node.Lock.Format(ctx)
+	}
 }
 
 func (node *Select) GetStatementType() string { return "Select" }
@@ -64,6 +69,49 @@ func NewSelect(s SelectStatement, o OrderBy, l *Limit) *Select {
 	}
 }
 
+// SelectLockType is the type of the locking read.
+type SelectLockType int32
+
+const (
+	// SelectLockForUpdate locks the rows read exclusively
+	SelectLockForUpdate SelectLockType = iota
+	// SelectLockForShare locks the rows read in shared mode
+	SelectLockForShare
+)
+
+// SelectLockWaitPolicy is how a locking read handles the rows locked by others.
+type SelectLockWaitPolicy int32
+
+const (
+	// SelectLockWait waits for the locks to be released
+	SelectLockWait SelectLockWaitPolicy = iota
+	// SelectLockNoWait returns an error if any row is locked by others
+	SelectLockNoWait
+	// SelectLockSkipLocked skips the rows locked by others
+	SelectLockSkipLocked
+)
+
+// SelectLockInfo represents the FOR UPDATE or FOR SHARE clause.
+type SelectLockInfo struct {
+	LockType   SelectLockType
+	WaitPolicy SelectLockWaitPolicy
+}
+
+func (node *SelectLockInfo) Format(ctx *FmtCtx) {
+	switch node.LockType {
+	case SelectLockForUpdate:
+		ctx.WriteString("for update")
+	case SelectLockForShare:
+		ctx.WriteString("for share")
+	}
+	switch node.WaitPolicy {
+	case SelectLockNoWait:
+		ctx.WriteString(" nowait")
+	case SelectLockSkipLocked:
+		ctx.WriteString(" skip locked")
+	}
+}
+
 // OrderBy represents an ORDER BY clause.
 type OrderBy []*Order
 
@@ -193,6 +241,8 @@ func (node *ParenSelect) Format(ctx *FmtCtx) {
 // SelectClause represents a SELECT statement.
 type SelectClause struct {
 	SelectStatement
+	// Hint is the optimizer hints following the SELECT keyword, such as MAX_EXECUTION_TIME(1000)
+	Hint     string
 	Distinct bool
 	Exprs    SelectExprs
 	From     *From
@@ -204,6 +254,11 @@ type SelectClause struct {
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("select ")
+	if node.Hint != "" {
+		ctx.WriteString("/*+ ")
+		ctx.WriteString(node.Hint)
+		ctx.WriteString(" */ ")
+	}
 	if node.Distinct {
 		ctx.WriteString("distinct ")
 	}
@@ -453,10 +508,17 @@ type AliasedTableExpr struct {
 	Expr       TableExpr
 	As         AliasClause
 	IndexHints []*IndexHint
+	// AsOfTimestamp is the point in time at which the table is read,
+	// nil means the snapshot of the current transaction.
+	AsOfTimestamp Expr
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
+	if node.AsOfTimestamp != nil {
+		ctx.WriteString(" as of timestamp ")
+		node.AsOfTimestamp.Format(ctx)
+	}
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
@@ -703,6 +703,38 @@ func (node *ShowSubscriptions) Format(ctx *FmtCtx) {
 func (node *ShowSubscriptions) GetStatementType() string { return "Show Subscriptions" }
 func (node *ShowSubscriptions) GetQueryType() string     { return QueryTypeOth }
 
+type ShowChangefeeds struct {
+	showImpl
+	Like *ComparisonExpr
+}
+
+func (node *ShowChangefeeds) Format(ctx *FmtCtx) {
+	ctx.WriteString("show changefeeds")
+	if node.Like != nil {
+		ctx.WriteByte(' ')
+		node.Like.Format(ctx)
+	}
+}
+
+func (node *ShowChangefeeds) GetStatementType() string { return "Show Changefeeds" }
+func (node *ShowChangefeeds) GetQueryType() string     { return QueryTypeOth }
+
+type ShowAuditPolicies struct {
+	showImpl
+	Like *ComparisonExpr
+}
+
+func (node *ShowAuditPolicies) Format(ctx *FmtCtx) {
+	ctx.WriteString("show audit policies")
+	if node.Like != nil {
+		ctx.WriteByte(' ')
+		node.Like.Format(ctx)
+	}
+}
+
+func (node *ShowAuditPolicies) GetStatementType() string { return "Show Audit Policies" }
+func (node *ShowAuditPolicies) GetQueryType() string     { return QueryTypeOth }
+
 type ShowCreatePublications struct {
 	showImpl
 	Name string
@@ -45,7 +45,7 @@ const (
 	// statement: Grant, Revoke
 	// CreateAccount, CreateUser, CreateRole, AlterAccount, AlterUser, DropAccount, DropUser, DropRole
 	QueryTypeDCL = "DCL"
-	// QueryTypeTCL (Transaction Control Language): BeginTransaction, RollbackTransaction, CommitTransaction, Savepoint(Not Support)
+	// QueryTypeTCL (Transaction Control Language): BeginTransaction, RollbackTransaction, CommitTransaction, Savepoint, RollbackToSavepoint, ReleaseSavepoint
 	QueryTypeTCL = "TCL"
 	// QueryTypeOth (Other.)
 	// statement: AnalyzeStmt(Not Support), ExplainStmt, ExplainAnalyze, ExplainFor,
@@ -122,6 +122,7 @@ const (
 const (
 	CSV      = "csv"
 	JSONLINE = "jsonline"
+	PARQUET  = "parquet"
 )
 
 // if $format is jsonline
@@ -412,6 +413,10 @@ type ExportParam struct {
 	// header flag
 	Header     bool
 	ForceQuote []string
+	// the format of the output file, csv by default
+	FileFormat string
+	// the compression of the output file
+	Compression string
 }
 
 func (ep *ExportParam) Format(ctx *FmtCtx) {
@@ -455,6 +460,14 @@ func (ep *ExportParam) format(ctx *FmtCtx, withOutfile bool) {
 			prefix = ", "
 		}
 	}
+	if ep.FileFormat != "" {
+		ctx.WriteString(" format ")
+		ctx.WriteString(ep.FileFormat)
+	}
+	if ep.Compression != "" {
+		ctx.WriteString(" compression ")
+		ctx.WriteString(ep.Compression)
+	}
 }
 
 var _ LoadColumn = &UnresolvedName{}
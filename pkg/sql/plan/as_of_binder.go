@@ -0,0 +1,72 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func NewAsOfBinder(builder *QueryBuilder, ctx *BindContext) *AsOfBinder {
+	b := &AsOfBinder{}
+	b.sysCtx = builder.GetContext()
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+	return b
+}
+
+func (b *AsOfBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	switch astExpr.(type) {
+	case *tree.VarExpr, *tree.UnqualifiedStar:
+		return nil, moerr.NewSyntaxError(b.GetContext(), "unsupported expr in as of timestamp clause")
+	}
+
+	expr, err := b.baseBindExpr(astExpr, depth, isRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if expr.Typ.Id != int32(types.T_timestamp) {
+		// as of timestamp '2023-04-01 10:00:00'
+		// the string or datetime should be cast to timestamp
+		targetType := types.T_timestamp.ToType()
+		targetType.Scale = 6
+		expr, err = appendCastBeforeExpr(b.GetContext(), expr, makePlan2Type(&targetType))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return expr, nil
+}
+
+func (b *AsOfBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "column not allowed in as of timestamp clause")
+}
+
+func (b *AsOfBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "aggregate function not allowed in as of timestamp clause")
+}
+
+func (b *AsOfBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "window function not allowed in as of timestamp clause")
+}
+
+func (b *AsOfBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(b.GetContext(), "subquery not allowed in as of timestamp clause")
+}
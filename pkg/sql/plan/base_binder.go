@@ -288,6 +288,9 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	}
 
 	if colPos != NotFound {
+		if e := b.builder.checkColumnPrivilege(b.ctx.bindingByTag[relPos], colPos, astExpr); e != nil {
+			return nil, e
+		}
 		b.boundCols = append(b.boundCols, table+"."+col)
 
 		expr = &plan.Expr{
@@ -654,6 +657,10 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	}
 	funcName := funcRef.Parts[0]
 
+	if astExpr.WindowSpec != nil {
+		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
+	}
+
 	if function.GetFunctionIsAggregateByName(funcName) {
 		return b.impl.BindAggFunc(funcName, astExpr, depth, isRoot)
 	} else if function.GetFunctionIsWinfunByName(funcName) {
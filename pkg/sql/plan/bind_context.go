@@ -27,6 +27,7 @@ func NewBindContext(builder *QueryBuilder, parent *BindContext) *BindContext {
 	bc := &BindContext{
 		groupByAst:     make(map[string]int32),
 		aggregateByAst: make(map[string]int32),
+		windowByAst:    make(map[string]int32),
 		projectByExpr:  make(map[string]int32),
 		aliasMap:       make(map[string]int32),
 		bindingByTag:   make(map[int32]*Binding),
@@ -69,6 +70,16 @@ func (bc *BindContext) findCTE(name string) *CTERef {
 	return nil
 }
 
+func (bc *BindContext) findRecursiveCTE(name string) *RecursiveCTERef {
+	for ; bc != nil; bc = bc.parent {
+		if bc.recursiveCTE != nil && bc.recursiveCTE.name == name {
+			return bc.recursiveCTE
+		}
+	}
+
+	return nil
+}
+
 func (bc *BindContext) mergeContexts(ctx context.Context, left, right *BindContext) error {
 	left.parent = bc
 	right.parent = bc
@@ -307,6 +318,20 @@ func (bc *BindContext) qualifyColumnNames(astExpr tree.Expr, selectList tree.Sel
 				return nil, err
 			}
 		}
+		if exprImpl.WindowSpec != nil {
+			for i := range exprImpl.WindowSpec.PartitionBy {
+				exprImpl.WindowSpec.PartitionBy[i], err = bc.qualifyColumnNames(exprImpl.WindowSpec.PartitionBy[i], selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+			for _, order := range exprImpl.WindowSpec.OrderBy {
+				order.Expr, err = bc.qualifyColumnNames(order.Expr, selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
 
 	case *tree.RangeCond:
 		exprImpl.Left, err = bc.qualifyColumnNames(exprImpl.Left, selectList, expandAlias)
@@ -40,6 +40,14 @@ type dmlSelectInfo struct {
 
 	onDuplicateIdx  []int32
 	onDuplicateExpr map[string]*Expr
+	// isReplace is set for REPLACE, which is rewritten as an insert updating
+	// all the columns of the conflicting rows to the inserted values
+	isReplace bool
+	// onDuplicateTag is the binding tag of the table scan which finds the
+	// rows conflicting with the inserted rows
+	onDuplicateTag int32
+	// rowIdx is the position of the column numbering the new rows of REPLACE
+	rowIdx int32
 
 	onIdx    []int32 //remove these row
 	onIdxTbl []*ObjectRef
@@ -176,7 +184,7 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 				newTblInfo.haveConstraint = true
 			} else {
 				for _, indexdef := range tblDef.Indexes {
-					if indexdef.Unique {
+					if indexdef.TableExist {
 						newTblInfo.haveConstraint = true
 						break
 					}
@@ -260,7 +268,7 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 			tblInfo.haveConstraint = true
 		} else {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if indexdef.TableExist {
 					tblInfo.haveConstraint = true
 					break
 				}
@@ -321,6 +329,7 @@ func updateToSelect(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Upda
 	columnsSize := 0
 	for alias, i := range tableInfo.alias {
 		e, _ := tree.NewUnresolvedNameWithStar(builder.GetContext(), alias)
+		builder.trustColRef(e)
 		columnsSize += len(tableInfo.tableDefs[i].Cols)
 		selectList[i] = tree.SelectExpr{
 			Expr: e,
@@ -560,7 +569,12 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 	// insert into t1 values (1,1,3),(2,2,3) on duplicate key update a=a+1, b=b-2;
 	// rewrite to : select _t.*, t1.a, t1.b，c, t1.row_id from
 	//				(select * from values (1,1,3),(2,2,3)) _t(a,b,c) left join t1 on _t.a=t1.a or _t.b=t1.b
-	if len(stmt.OnDuplicateUpdate) > 0 {
+	// replace into t1 values (1,1,3) is rewritten as the insert updating all the
+	// columns by values(), so the conflicting row is deleted and the new row is inserted.
+	// a new row of replace may conflict with several rows on different unique keys, the
+	// join outputs it once for each of them and all of them are deleted. the new rows are
+	// numbered by uuid() before the join, so the rows of the join for a new row are found
+	if len(stmt.OnDuplicateUpdate) > 0 || info.isReplace {
 
 		rightTableDef := DeepCopyTableDef(tableDef)
 		rightObjRef := DeepCopyObjectRef(tableObjRef)
@@ -574,7 +588,7 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 
 		// if table have unique columns, we do the rewrite. if not, do nothing(do not throw error)
 		if len(uniqueCols) > 0 {
-			if len(uniqueCols) > 1 {
+			if len(uniqueCols) > 1 && !info.isReplace {
 				return moerr.NewNYI(builder.GetContext(), "one unique constraint supported for on duplicate key clause now.")
 			}
 
@@ -596,13 +610,45 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 				updateCols[col] = updateExpr.Expr
 			}
 
+			// the conflicting rows are updated, all the columns of them are updated by replace,
+			// and the rows invisible to the update policies are not updated
+			checkCols := updateCols
+			if info.isReplace {
+				checkCols = make(map[string]tree.Expr, len(tableDef.Cols))
+				for _, col := range tableDef.Cols {
+					if !col.Hidden {
+						checkCols[col.Name] = nil
+					}
+				}
+			}
+			if err = builder.checkUpdateColumns(rightObjRef, checkCols); err != nil {
+				return err
+			}
+			if err = builder.applyRowPoliciesOfCommand(rightId, tree.AliasClause{}, tree.PolicyCommandUpdate); err != nil {
+				return err
+			}
+
 			var defExpr *Expr
 			idxs := make([]int32, len(rightTableDef.Cols))
 			updateExprs := make(map[string]*Expr)
 			for i, col := range rightTableDef.Cols {
 				info.idx = info.idx + 1
 				idxs[i] = info.idx
-				if updateExpr, exists := updateCols[col.Name]; exists {
+				if info.isReplace && i < len(tableDef.Cols) {
+					valueExpr, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "values", []*Expr{{
+						Typ: col.Typ,
+						Expr: &plan.Expr_Col{
+							Col: &plan.ColRef{
+								RelPos: 0,
+								ColPos: int32(i),
+							},
+						},
+					}})
+					if err != nil {
+						return err
+					}
+					updateExprs[col.Name] = valueExpr
+				} else if updateExpr, exists := updateCols[col.Name]; exists {
 					binder := NewUpdateBinder(builder.GetContext(), nil, nil, rightTableDef.Cols)
 					if _, ok := updateExpr.(*tree.DefaultVal); ok {
 						defExpr, err = getDefaultExpr(builder.GetContext(), col)
@@ -632,6 +678,26 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 				})
 			}
 
+			if info.isReplace {
+				baseNode := builder.qry.Nodes[info.rootId]
+				rowNumExpr, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "uuid", nil)
+				if err != nil {
+					return err
+				}
+				baseNode.ProjectList = append(baseNode.ProjectList, rowNumExpr)
+				info.rowIdx = int32(len(info.projectList))
+				info.projectList = append(info.projectList, &plan.Expr{
+					Typ: rowNumExpr.Typ,
+					Expr: &plan.Expr_Col{
+						Col: &plan.ColRef{
+							RelPos: baseNodeTag,
+							ColPos: int32(len(baseNode.ProjectList) - 1),
+						},
+					},
+				})
+				info.idx = info.idx + 1
+			}
+
 			// get join condition
 			var joinConds *Expr
 			joinIdx := 0
@@ -700,6 +766,7 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 			info.rootId = newRootId
 			info.onDuplicateIdx = idxs
 			info.onDuplicateExpr = updateExprs
+			info.onDuplicateTag = rightTag
 		}
 
 	}
@@ -715,6 +782,7 @@ func deleteToSelect(builder *QueryBuilder, bindCtx *BindContext, node *tree.Dele
 		var ret *tree.UnresolvedName
 		if haveConstraint {
 			ret, _ = tree.NewUnresolvedNameWithStar(builder.GetContext(), tblName)
+			builder.trustColRef(ret)
 		} else {
 			ret, _ = tree.NewUnresolvedName(builder.GetContext(), tblName, catalog.Row_ID)
 		}
@@ -972,6 +1040,43 @@ func initUpdateStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 	return nil
 }
 
+// appendPartitionKeys projects the old values of the partition key columns of the
+// partitioned tables, which locate the partitions of the rows deleted or updated.
+// It returns the positions of the key columns for each table, or nil if no table
+// is partitioned.
+func appendPartitionKeys(builder *QueryBuilder, info *dmlSelectInfo) []*plan.ColPosMap {
+	var partitionIdx []*plan.ColPosMap
+	tag := builder.qry.Nodes[info.derivedTableId].BindingTags[0]
+	for i, tableDef := range info.tblInfo.tableDefs {
+		if tableDef.Partition == nil {
+			continue
+		}
+		if partitionIdx == nil {
+			partitionIdx = make([]*plan.ColPosMap, len(info.tblInfo.tableDefs))
+			for j := range partitionIdx {
+				partitionIdx[j] = &plan.ColPosMap{Map: make(map[string]int32)}
+			}
+		}
+		colTyp := make(map[string]*plan.Type)
+		for _, col := range tableDef.Cols {
+			colTyp[col.Name] = col.Typ
+		}
+		for _, name := range GetPartitionKeyColumns(tableDef.Partition) {
+			partitionIdx[i].Map[name] = int32(len(info.projectList))
+			info.projectList = append(info.projectList, &plan.Expr{
+				Typ: colTyp[name],
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: tag,
+						ColPos: int32(info.tblInfo.oldColPosMap[i][name]),
+					},
+				},
+			})
+		}
+	}
+	return partitionIdx
+}
+
 func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelectInfo, tableDef *TableDef, baseNodeId int32, rewriteIdx int) error {
 	// posMap := make(map[string]int32)
 	typMap := make(map[string]*plan.Type)
@@ -1000,11 +1105,20 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 		}
 	}
 
-	// rewrite index, to get rows of unique table to delete
+	// rewrite index, to get rows of unique and secondary index tables to delete
 	if info.typ != "insert" || (info.typ == "insert" && len(info.onDuplicateIdx) > 0) {
+		// the index rows to delete on duplicate key are those of the conflicting
+		// rows, which are matched by the keys of the conflicting rows
+		var conflictColPos map[string]int
+		if len(info.onDuplicateIdx) > 0 {
+			conflictColPos = make(map[string]int)
+			for idx, col := range tableDef.Cols {
+				conflictColPos[col.Name] = idx
+			}
+		}
 		if tableDef.Indexes != nil {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if indexdef.TableExist {
 					idxRef := &plan.ObjectRef{
 						SchemaName: builder.compCtx.DefaultDatabase(),
 						ObjName:    indexdef.IndexTableName,
@@ -1021,6 +1135,10 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 					}
 					rightTag := builder.qry.Nodes[rightId].BindingTags[0]
 					baseTag := builder.qry.Nodes[baseNodeId].BindingTags[0]
+					keyColPos := oldColPosMap
+					if conflictColPos != nil {
+						baseTag, keyColPos = info.onDuplicateTag, conflictColPos
+					}
 					rightTableDef := builder.qry.Nodes[rightId].TableDef
 
 					if info.typ == "insert" {
@@ -1065,7 +1183,7 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 							Expr: &plan.Expr_Col{
 								Col: &plan.ColRef{
 									RelPos: baseTag,
-									ColPos: int32(oldColPosMap[orginIndexColumnName]),
+									ColPos: int32(keyColPos[orginIndexColumnName]),
 								},
 							},
 						}
@@ -1078,7 +1196,7 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 								Expr: &plan.Expr_Col{
 									Col: &plan.ColRef{
 										RelPos: baseTag,
-										ColPos: int32(oldColPosMap[column]),
+										ColPos: int32(keyColPos[column]),
 									},
 								},
 							}
@@ -1095,6 +1213,37 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 					}
 					joinConds = []*Expr{condExpr}
 
+					// several rows may have the same key in a secondary index table, the
+					// row of the index table is matched by the primary key too
+					if !indexdef.Unique && tableDef.Pkey != nil {
+						pkName := tableDef.Pkey.PkeyColName
+						rightPriPos := rightTableDef.Name2ColIndex[catalog.IndexTablePrimaryColName]
+						condExpr, err = bindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{
+							{
+								Typ: typMap[pkName],
+								Expr: &plan.Expr_Col{
+									Col: &plan.ColRef{
+										RelPos: baseTag,
+										ColPos: int32(keyColPos[pkName]),
+									},
+								},
+							},
+							{
+								Typ: rightTableDef.Cols[rightPriPos].Typ,
+								Expr: &plan.Expr_Col{
+									Col: &plan.ColRef{
+										RelPos: rightTag,
+										ColPos: rightPriPos,
+									},
+								},
+							},
+						})
+						if err != nil {
+							return err
+						}
+						joinConds = append(joinConds, condExpr)
+					}
+
 					leftCtx := builder.ctxByNode[info.rootId]
 					err = joinCtx.mergeContexts(builder.GetContext(), leftCtx, rightCtx)
 					if err != nil {
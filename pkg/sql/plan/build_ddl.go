@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -195,10 +196,22 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionCompression:
+			alg, err := getCompressType(ctx.GetContext(), opt.Compression)
+			if err != nil {
+				return nil, err
+			}
+			// the compression of the column overrides the one of the table
+			compressedCols := getCompressedColumns(stmt)
+			for _, col := range createTable.TableDef.Cols {
+				if !compressedCols[col.Name] {
+					col.Alg = alg
+				}
+			}
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
-		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum, *tree.TableOptionCompression,
+		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum,
 		// 	*tree.TableOptionConnection, *tree.TableOptionPassword, *tree.TableOptionKeyBlockSize,
 		// 	*tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionDelayKeyWrite,
 		// 	*tree.TableOptionRowFormat, *tree.TableOptionStatsPersistent, *tree.TableOptionStatsAutoRecalc,
@@ -270,25 +283,15 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			}})
 	}
 
-	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
-	bindContext := NewBindContext(builder, nil)
-
-	// set partition(unsupport now)
+	// set partition
 	if stmt.PartitionOption != nil {
-		nodeID := builder.appendNode(&plan.Node{
-			NodeType:    plan.Node_TABLE_SCAN,
-			Stats:       nil,
-			ObjRef:      nil,
-			TableDef:    createTable.TableDef,
-			BindingTags: []int32{builder.genNewTag()},
-		}, bindContext)
-
-		err = builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
-		if err != nil {
-			return nil, err
+		if stmt.Temporary {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "partitioned temporary table")
 		}
-		partitionBinder := NewPartitionBinder(builder, bindContext)
-		err = buildPartitionByClause(partitionBinder, stmt, createTable.TableDef)
+		if len(createTable.TableDef.Fkeys) > 0 {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "foreign key on partitioned table")
+		}
+		err = buildTablePartition(ctx, stmt, createTable.TableDef)
 		if err != nil {
 			return nil, err
 		}
@@ -344,6 +347,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			var pks []string
 			var comment string
 			var auto_incr bool
+			alg := plan.CompressType_Lz4
 			for _, attr := range def.Attributes {
 				switch attribute := attr.(type) {
 				case *tree.AttributePrimaryKey:
@@ -362,6 +366,10 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					if getNumOfCharacters(comment) > maxLengthOfColumnComment {
 						return moerr.NewInvalidInput(ctx.GetContext(), "comment for column '%s' is too long", def.Name.Parts[0])
 					}
+				case *tree.AttributeCompression:
+					if alg, err = getCompressType(ctx.GetContext(), attribute.Compression); err != nil {
+						return err
+					}
 				case *tree.AttributeAutoIncrement:
 					auto_incr = true
 					if !operator.IsInteger(types.T(colType.GetId())) {
@@ -405,7 +413,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			colType.AutoIncr = auto_incr
 			col := &ColDef{
 				Name:     def.Name.Parts[0],
-				Alg:      plan.CompressType_Lz4,
+				Alg:      alg,
 				Typ:      colType,
 				Default:  defaultValue,
 				OnUpdate: onUpdateExpr,
@@ -489,6 +497,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			if tableRef == nil {
 				return moerr.NewNoSuchTable(ctx.GetContext(), ctx.DefaultDatabase(), fkTableName)
 			}
+			if tableRef.Partition != nil {
+				return moerr.NewNotSupported(ctx.GetContext(), "foreign key referencing partitioned table")
+			}
 
 			fkDef.ForeignTbl = tableRef.TblId
 			columnIdPos := make(map[uint64]int)
@@ -706,7 +717,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		}
 	}
 	if len(secondaryIndexInfos) != 0 {
-		err := buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, ctx)
+		err := buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, pkeyName, ctx)
 		if err != nil {
 			return err
 		}
@@ -849,7 +860,10 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 	return nil
 }
 
-func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, ctx CompilerContext) error {
+// buildSecondaryIndexDef builds the secondary indexes, the index table of a secondary
+// index maps the values of the index columns to the primary keys of the rows, so it's
+// only built for the table with a primary key, otherwise only the index is recorded.
+func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
 	nameCount := make(map[string]int)
 
 	for _, indexInfo := range indexInfos {
@@ -887,9 +901,19 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 		} else {
 			indexDef.IndexName = indexInfo.Name
 		}
-		indexDef.IndexTableName = ""
 		indexDef.Parts = indexParts
-		indexDef.TableExist = false
+		if pkeyName != "" {
+			indexTableName, err := util.BuildIndexTableName(ctx.GetContext(), false)
+			if err != nil {
+				return err
+			}
+			createTable.IndexTables = append(createTable.IndexTables, buildSecondaryIndexTable(indexTableName, indexParts, colMap, pkeyName))
+			indexDef.IndexTableName = indexTableName
+			indexDef.TableExist = true
+		} else {
+			indexDef.IndexTableName = ""
+			indexDef.TableExist = false
+		}
 		if indexInfo.IndexOption != nil {
 			indexDef.Comment = indexInfo.IndexOption.Comment
 		} else {
@@ -900,6 +924,51 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 	return nil
 }
 
+// buildSecondaryIndexTable builds the index table of a secondary index, the key column
+// holds the value of the only index column or the serialized values of the index columns,
+// it has no primary key because several rows may have the same key.
+func buildSecondaryIndexTable(indexTableName string, indexParts []string, colMap map[string]*ColDef, pkeyName string) *TableDef {
+	keyType := &Type{
+		Id:    int32(types.T_varchar),
+		Size:  types.VarlenaSize,
+		Width: types.MaxVarcharLen,
+	}
+	if len(indexParts) == 1 {
+		typ := colMap[indexParts[0]].Typ
+		keyType = &Type{
+			Id:    typ.Id,
+			Size:  typ.Size,
+			Width: typ.Width,
+			Scale: typ.Scale,
+		}
+	}
+	return &TableDef{
+		Name: indexTableName,
+		Cols: []*ColDef{
+			{
+				Name: catalog.IndexTableIndexColName,
+				Alg:  plan.CompressType_Lz4,
+				Typ:  keyType,
+				Default: &plan.Default{
+					NullAbility:  false,
+					Expr:         nil,
+					OriginString: "",
+				},
+			},
+			{
+				Name: catalog.IndexTablePrimaryColName,
+				Alg:  plan.CompressType_Lz4,
+				Typ:  colMap[pkeyName].Typ,
+				Default: &plan.Default{
+					NullAbility:  false,
+					Expr:         nil,
+					OriginString: "",
+				},
+			},
+		},
+	}
+}
+
 func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, error) {
 	truncateTable := &plan.TruncateTable{}
 
@@ -944,6 +1013,7 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 				}
 			}
 		}
+		truncateTable.PartitionTableNames = getPartitionTableNames(tableDef.Partition)
 	}
 
 	return &Plan{
@@ -1015,6 +1085,7 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 				}
 			}
 		}
+		dropTable.PartitionTableNames = getPartitionTableNames(tableDef.Partition)
 	}
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
@@ -1104,26 +1175,20 @@ func buildDropDatabase(stmt *tree.DropDatabase, ctx CompilerContext) (*Plan, err
 }
 
 func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error) {
-	createIndex := &plan.CreateIndex{}
+	var database string
 	if len(stmt.Table.SchemaName) == 0 {
-		createIndex.Database = ctx.DefaultDatabase()
+		database = ctx.DefaultDatabase()
 	} else {
-		createIndex.Database = string(stmt.Table.SchemaName)
+		database = string(stmt.Table.SchemaName)
 	}
 	// check table
 	tableName := string(stmt.Table.ObjectName)
-	_, tableDef := ctx.Resolve(createIndex.Database, tableName)
+	_, tableDef := ctx.Resolve(database, tableName)
 	if tableDef == nil {
-		return nil, moerr.NewNoSuchTable(ctx.GetContext(), createIndex.Database, tableName)
-	}
-	// check index
-	indexName := string(stmt.Name)
-	for _, def := range tableDef.Indexes {
-		if def.IndexName == indexName {
-			return nil, moerr.NewDuplicateKey(ctx.GetContext(), indexName)
-		}
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), database, tableName)
 	}
 	// build index
+	indexName := string(stmt.Name)
 	var uIdx *tree.UniqueIndex
 	var sIdx *tree.Index
 	switch stmt.IndexCat {
@@ -1146,6 +1211,31 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	for _, col := range tableDef.Cols {
 		colMap[col.Name] = col
 	}
+	createIndex, err := buildIndexOfTable(database, tableName, tableDef, uIdx, sIdx, colMap, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_INDEX,
+				Definition: &plan.DataDefinition_CreateIndex{
+					CreateIndex: createIndex,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildIndexOfTable builds the unique index uIdx or the secondary index sIdx
+// on the columns in colMap of the table.
+func buildIndexOfTable(database, tableName string, tableDef *TableDef, uIdx *tree.UniqueIndex, sIdx *tree.Index,
+	colMap map[string]*ColDef, ctx CompilerContext) (*plan.CreateIndex, error) {
+	createIndex := &plan.CreateIndex{
+		Database: database,
+		Table:    tableName,
+	}
 	// index.TableDef.Defs store info of index need to be modified
 	// index.IndexTables store index table need to be created
 	oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
@@ -1153,51 +1243,79 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 
 	index := &plan.CreateTable{TableDef: &TableDef{}}
 	if uIdx != nil {
+		// the rows of the partitioned table are not read to fill the index table
+		if tableDef.Partition != nil {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "add unique index to partitioned table '%s'", tableName)
+		}
 		if err := buildUniqueIndexTable(index, []*tree.UniqueIndex{uIdx}, colMap, oriPriKeyName, ctx); err != nil {
 			return nil, err
 		}
 		createIndex.TableExist = true
 	}
 	if sIdx != nil {
-		if err := buildSecondaryIndexDef(index, []*tree.Index{sIdx}, colMap, ctx); err != nil {
+		// the index table of the partitioned table is not filled as above, so only
+		// the index is recorded
+		pkeyName := oriPriKeyName
+		if tableDef.Partition != nil {
+			pkeyName = ""
+		}
+		if err := buildSecondaryIndexDef(index, []*tree.Index{sIdx}, colMap, pkeyName, ctx); err != nil {
 			return nil, err
 		}
-		createIndex.TableExist = false
+		createIndex.TableExist = index.TableDef.Indexes[0].TableExist
+	}
+	// check index
+	indexName := index.TableDef.Indexes[0].IndexName
+	for _, def := range tableDef.Indexes {
+		if def.IndexName == indexName {
+			return nil, moerr.NewDuplicateKey(ctx.GetContext(), indexName)
+		}
 	}
 	createIndex.Index = index
-	createIndex.Table = tableName
+	return createIndex, nil
+}
+
+func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
+	var database string
+	if len(stmt.TableName.SchemaName) == 0 {
+		database = ctx.DefaultDatabase()
+	} else {
+		database = string(stmt.TableName.SchemaName)
+	}
+
+	// check table
+	tableName := string(stmt.TableName.ObjectName)
+	_, tableDef := ctx.Resolve(database, tableName)
+	if tableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), database, tableName)
+	}
+
+	dropIndex, err := buildDropIndexOfTable(database, tableName, tableDef, string(stmt.Name), ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
 			Ddl: &plan.DataDefinition{
-				DdlType: plan.DataDefinition_CREATE_INDEX,
-				Definition: &plan.DataDefinition_CreateIndex{
-					CreateIndex: createIndex,
+				DdlType: plan.DataDefinition_DROP_INDEX,
+				Definition: &plan.DataDefinition_DropIndex{
+					DropIndex: dropIndex,
 				},
 			},
 		},
 	}, nil
 }
 
-func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
-	dropIndex := &plan.DropIndex{}
-	if len(stmt.TableName.SchemaName) == 0 {
-		dropIndex.Database = ctx.DefaultDatabase()
-	} else {
-		dropIndex.Database = string(stmt.TableName.SchemaName)
-	}
-
-	// check table
-	dropIndex.Table = string(stmt.TableName.ObjectName)
-	_, tableDef := ctx.Resolve(dropIndex.Database, dropIndex.Table)
-	if tableDef == nil {
-		return nil, moerr.NewNoSuchTable(ctx.GetContext(), dropIndex.Database, dropIndex.Table)
+func buildDropIndexOfTable(database, tableName string, tableDef *TableDef, indexName string, ctx CompilerContext) (*plan.DropIndex, error) {
+	dropIndex := &plan.DropIndex{
+		Database:  database,
+		Table:     tableName,
+		IndexName: indexName,
 	}
 
 	// check index
-	dropIndex.IndexName = string(stmt.Name)
 	found := false
-
 	for _, indexdef := range tableDef.Indexes {
 		if dropIndex.IndexName == indexdef.IndexName {
 			dropIndex.IndexTableName = indexdef.IndexTableName
@@ -1209,17 +1327,7 @@ func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
 	if !found {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "not found index: %s", dropIndex.IndexName)
 	}
-
-	return &Plan{
-		Plan: &plan.Plan_Ddl{
-			Ddl: &plan.DataDefinition{
-				DdlType: plan.DataDefinition_DROP_INDEX,
-				Definition: &plan.DataDefinition_DropIndex{
-					DropIndex: dropIndex,
-				},
-			},
-		},
-	}, nil
+	return dropIndex, nil
 }
 
 // Get tabledef(col, viewsql, properties) for alterview.
@@ -1284,6 +1392,444 @@ func buildAlterView(stmt *tree.AlterView, ctx CompilerContext) (*Plan, error) {
 	}, nil
 }
 
+// buildAlterTable checks the ALTER TABLE actions against the table and builds
+// the definition of the table after all the actions are applied in order.
+func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error) {
+	alterTable := &plan.AlterTable{
+		Table: string(stmt.Table.ObjectName),
+	}
+	if len(stmt.Table.SchemaName) == 0 {
+		alterTable.Database = ctx.DefaultDatabase()
+	} else {
+		alterTable.Database = string(stmt.Table.SchemaName)
+	}
+
+	_, oldTableDef := ctx.Resolve(alterTable.Database, alterTable.Table)
+	if oldTableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), alterTable.Database, alterTable.Table)
+	}
+	if oldTableDef.TableType != catalog.SystemOrdinaryRel {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table '%s' of type %s", alterTable.Table, oldTableDef.TableType)
+	}
+	tableDef := DeepCopyTableDef(oldTableDef)
+	alterTable.TableDef = tableDef
+
+	colMap := make(map[string]*ColDef)
+	// the columns an index can be built on, which are not changed by
+	// the former actions because the index is built on the data written
+	indexColMap := make(map[string]*ColDef)
+	for _, col := range tableDef.Cols {
+		colMap[col.Name] = col
+		indexColMap[col.Name] = col
+	}
+	keyCols := getAlterTableKeyColumns(tableDef)
+
+	for _, option := range stmt.Options {
+		action := &plan.AlterTableAction{}
+		switch opt := option.(type) {
+		case *tree.AlterOptionAdd:
+			switch def := opt.Def.(type) {
+			case *tree.ColumnTableDef:
+				col, err := buildAlterTableColDef(def, ctx)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := colMap[col.Name]; ok {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "duplicate column name '%s'", col.Name)
+				}
+				// rows written before read null from the new column
+				if !col.Default.NullAbility {
+					return nil, moerr.NewNotSupported(ctx.GetContext(), "add not null column '%s'", col.Name)
+				}
+				colMap[col.Name] = col
+				tableDef.Cols = append(tableDef.Cols, col)
+				action.Action = &plan.AlterTableAction_AddColumn{
+					AddColumn: &plan.AlterTableAddColumn{Column: col},
+				}
+			case *tree.Index, *tree.UniqueIndex:
+				var uIdx *tree.UniqueIndex
+				var sIdx *tree.Index
+				if idx, ok := def.(*tree.UniqueIndex); ok {
+					uIdx = idx
+				} else {
+					sIdx = def.(*tree.Index)
+				}
+				createIndex, err := buildIndexOfTable(alterTable.Database, alterTable.Table, tableDef, uIdx, sIdx, indexColMap, ctx)
+				if err != nil {
+					return nil, err
+				}
+				indexDef := createIndex.Index.TableDef.Indexes[0]
+				for _, part := range indexDef.Parts {
+					keyCols[part] = indexDef.IndexName
+				}
+				tableDef.Indexes = append(tableDef.Indexes, indexDef)
+				action.Action = &plan.AlterTableAction_AddIndex{
+					AddIndex: createIndex,
+				}
+			default:
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table option '%s'", tree.String(opt, dialect.MYSQL))
+			}
+		case *tree.AlterOptionDrop:
+			name := string(opt.Name)
+			switch opt.Typ {
+			case tree.AlterTableDropColumn:
+				if err := checkAlterTableColumn(name, colMap, keyCols, alterTable.Table, ctx); err != nil {
+					return nil, err
+				}
+				if len(tableDef.Cols) == 1 {
+					return nil, moerr.NewInvalidInput(ctx.GetContext(), "can't drop all columns of table '%s'", alterTable.Table)
+				}
+				for i, col := range tableDef.Cols {
+					if col.Name == name {
+						tableDef.Cols = append(tableDef.Cols[:i], tableDef.Cols[i+1:]...)
+						break
+					}
+				}
+				delete(colMap, name)
+				delete(indexColMap, name)
+				action.Action = &plan.AlterTableAction_DropColumn{
+					DropColumn: &plan.AlterTableDropColumn{Name: name},
+				}
+			case tree.AlterTableDropIndex, tree.AlterTableDropKey:
+				dropIndex, err := buildDropIndexOfTable(alterTable.Database, alterTable.Table, tableDef, name, ctx)
+				if err != nil {
+					return nil, err
+				}
+				for i, indexDef := range tableDef.Indexes {
+					if indexDef.IndexName == name {
+						tableDef.Indexes = append(tableDef.Indexes[:i], tableDef.Indexes[i+1:]...)
+						break
+					}
+				}
+				keyCols = getAlterTableKeyColumns(tableDef)
+				action.Action = &plan.AlterTableAction_DropIndex{
+					DropIndex: dropIndex,
+				}
+			default:
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table option '%s'", tree.String(opt, dialect.MYSQL))
+			}
+		case *tree.AlterOptionModifyColumn:
+			col, err := buildAlterTableColDef(opt.Column, ctx)
+			if err != nil {
+				return nil, err
+			}
+			if err = checkAlterTableColumn(col.Name, colMap, keyCols, alterTable.Table, ctx); err != nil {
+				return nil, err
+			}
+			oldCol := colMap[col.Name]
+			if !catalog.IsAlterColumnTypeCompatible(makeTypeByPlan2Type(oldCol.Typ), makeTypeByPlan2Type(col.Typ)) {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "modify column '%s' from %s to %s",
+					col.Name, types.T(oldCol.Typ.Id).String(), types.T(col.Typ.Id).String())
+			}
+			if oldCol.Default.GetNullAbility() && !col.Default.NullAbility {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "modify nullable column '%s' to not null", col.Name)
+			}
+			col.ColId = oldCol.ColId
+			for i := range tableDef.Cols {
+				if tableDef.Cols[i].Name == col.Name {
+					tableDef.Cols[i] = col
+					break
+				}
+			}
+			colMap[col.Name] = col
+			delete(indexColMap, col.Name)
+			action.Action = &plan.AlterTableAction_ModifyColumn{
+				ModifyColumn: &plan.AlterTableModifyColumn{Column: col},
+			}
+		case *tree.AlterOptionRenameColumn:
+			oldName, newName := string(opt.OldName), string(opt.NewName)
+			if err := checkAlterTableColumn(oldName, colMap, keyCols, alterTable.Table, ctx); err != nil {
+				return nil, err
+			}
+			if _, ok := colMap[newName]; ok {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "duplicate column name '%s'", newName)
+			}
+			if !checkTableColumnNameValid(newName) {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", newName)
+			}
+			col := colMap[oldName]
+			col.Name = newName
+			delete(colMap, oldName)
+			delete(indexColMap, oldName)
+			colMap[newName] = col
+			action.Action = &plan.AlterTableAction_RenameColumn{
+				RenameColumn: &plan.AlterTableRenameColumn{OldName: oldName, NewName: newName},
+			}
+		case *tree.AlterOptionRenameTable:
+			newName := string(opt.Name.ObjectName)
+			if len(opt.Name.SchemaName) != 0 && string(opt.Name.SchemaName) != alterTable.Database {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "rename table '%s' to another database", tableDef.Name)
+			}
+			if _, def := ctx.Resolve(alterTable.Database, newName); def != nil {
+				return nil, moerr.NewTableAlreadyExists(ctx.GetContext(), newName)
+			}
+			action.Action = &plan.AlterTableAction_RenameTable{
+				RenameTable: &plan.AlterTableRenameTable{OldName: tableDef.Name, NewName: newName},
+			}
+			tableDef.Name = newName
+		case *tree.AlterOptionAddPartition:
+			if err := checkAlterTablePartition(tableDef, true, ctx); err != nil {
+				return nil, err
+			}
+			partition, names, err := buildAlterTablePartition(ctx, tableDef, func(defs []*tree.Partition) ([]*tree.Partition, error) {
+				return append(defs, opt.Partitions...), nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			tableDef.Partition = partition
+			action.Action = &plan.AlterTableAction_AddPartition{
+				AddPartition: &plan.AlterTableAddPartition{
+					Partition:           partition,
+					PartitionTableNames: names,
+				},
+			}
+		case *tree.AlterOptionDropPartition:
+			if err := checkAlterTablePartition(tableDef, true, ctx); err != nil {
+				return nil, err
+			}
+			names, err := getAlterPartitionTableNames(tableDef, opt.Names, ctx)
+			if err != nil {
+				return nil, err
+			}
+			if len(names) == len(tableDef.Partition.Partitions) {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "cannot remove all partitions, use DROP TABLE instead")
+			}
+			partition, _, err := buildAlterTablePartition(ctx, tableDef, func(defs []*tree.Partition) ([]*tree.Partition, error) {
+				var kept []*tree.Partition
+				for _, def := range defs {
+					if _, ok := names[string(def.Name)]; !ok {
+						kept = append(kept, def)
+					}
+				}
+				return kept, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			action.Action = &plan.AlterTableAction_DropPartition{
+				DropPartition: &plan.AlterTableDropPartition{
+					Partition:           partition,
+					PartitionTableNames: getPartitionTableNamesInOrder(tableDef.Partition, names),
+				},
+			}
+			tableDef.Partition = partition
+		case *tree.AlterOptionTruncatePartition:
+			if err := checkAlterTablePartition(tableDef, false, ctx); err != nil {
+				return nil, err
+			}
+			names, err := getAlterPartitionTableNames(tableDef, opt.Names, ctx)
+			if err != nil {
+				return nil, err
+			}
+			action.Action = &plan.AlterTableAction_TruncatePartition{
+				TruncatePartition: &plan.AlterTableTruncatePartition{
+					PartitionTableNames: getPartitionTableNamesInOrder(tableDef.Partition, names),
+				},
+			}
+		default:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table option '%s'", tree.String(opt, dialect.MYSQL))
+		}
+		alterTable.Actions = append(alterTable.Actions, action)
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_ALTER_TABLE,
+				Definition: &plan.DataDefinition_AlterTable{
+					AlterTable: alterTable,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildAlterTableColDef builds the definition of a column added or modified by
+// ALTER TABLE, the key and auto increment attributes are not supported.
+func buildAlterTableColDef(def *tree.ColumnTableDef, ctx CompilerContext) (*ColDef, error) {
+	name := def.Name.Parts[0]
+	colType, err := getTypeFromAst(ctx.GetContext(), def.Type)
+	if err != nil {
+		return nil, err
+	}
+	if colType.Id == int32(types.T_char) || colType.Id == int32(types.T_varchar) ||
+		colType.Id == int32(types.T_binary) || colType.Id == int32(types.T_varbinary) {
+		if colType.GetWidth() > types.MaxStringSize {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "string width (%d) is too long", colType.GetWidth())
+		}
+	}
+	var comment string
+	alg := plan.CompressType_Lz4
+	for _, attr := range def.Attributes {
+		switch attribute := attr.(type) {
+		case *tree.AttributeCompression:
+			if alg, err = getCompressType(ctx.GetContext(), attribute.Compression); err != nil {
+				return nil, err
+			}
+		case *tree.AttributePrimaryKey, *tree.AttributeUnique, *tree.AttributeUniqueKey:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table with key column '%s'", name)
+		case *tree.AttributeAutoIncrement:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table with auto_increment column '%s'", name)
+		case *tree.AttributeComment:
+			comment = attribute.CMT.String()
+			if getNumOfCharacters(comment) > maxLengthOfColumnComment {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "comment for column '%s' is too long", name)
+			}
+		}
+	}
+	if !checkTableColumnNameValid(name) {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", name)
+	}
+	defaultValue, err := buildDefaultExpr(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	onUpdateExpr, err := buildOnUpdate(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	return &ColDef{
+		Name:     name,
+		Alg:      alg,
+		Typ:      colType,
+		Default:  defaultValue,
+		OnUpdate: onUpdateExpr,
+		Comment:  comment,
+	}, nil
+}
+
+// getAlterTableKeyColumns returns the columns of the primary key, cluster by
+// key and indexes of the table, which can not be changed by ALTER TABLE.
+// The value is the name of the key.
+func getAlterTableKeyColumns(tableDef *TableDef) map[string]string {
+	keyCols := make(map[string]string)
+	for _, col := range tableDef.Cols {
+		if col.Primary {
+			keyCols[col.Name] = "PRIMARY"
+		}
+		if col.Typ.AutoIncr {
+			keyCols[col.Name] = "auto_increment"
+		}
+	}
+	if tableDef.Pkey != nil {
+		for _, name := range tableDef.Pkey.Names {
+			keyCols[name] = "PRIMARY"
+		}
+	}
+	if tableDef.ClusterBy != nil {
+		if util.JudgeIsCompositeClusterByColumn(tableDef.ClusterBy.Name) {
+			for _, name := range util.SplitCompositeClusterByColumnName(tableDef.ClusterBy.Name) {
+				keyCols[name] = "cluster by"
+			}
+		} else {
+			keyCols[tableDef.ClusterBy.Name] = "cluster by"
+		}
+	}
+	for _, fk := range tableDef.Fkeys {
+		for _, colId := range fk.Cols {
+			for _, col := range tableDef.Cols {
+				if col.ColId == colId {
+					keyCols[col.Name] = fk.Name
+				}
+			}
+		}
+	}
+	for _, indexDef := range tableDef.Indexes {
+		for _, part := range indexDef.Parts {
+			keyCols[part] = indexDef.IndexName
+		}
+	}
+	for _, name := range GetPartitionKeyColumns(tableDef.Partition) {
+		keyCols[name] = "partition"
+	}
+	return keyCols
+}
+
+// checkAlterTablePartition checks the partitions of the table can be managed, only the
+// partitions of RANGE and LIST partitioned tables can be added or dropped.
+func checkAlterTablePartition(tableDef *TableDef, addOrDrop bool, ctx CompilerContext) error {
+	if tableDef.Partition == nil {
+		return moerr.NewInvalidInput(ctx.GetContext(), "partition management on a not partitioned table is not possible")
+	}
+	if !addOrDrop {
+		return nil
+	}
+	switch tableDef.Partition.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS, plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+		return nil
+	}
+	return moerr.NewNotSupported(ctx.GetContext(), "add or drop partitions of %s partitioned table", tableDef.Partition.Type.String())
+}
+
+// getAlterPartitionTableNames returns the tables of the partitions by their names
+func getAlterPartitionTableNames(tableDef *TableDef, names tree.IdentifierList, ctx CompilerContext) (map[string]string, error) {
+	tableNames := make(map[string]string, len(names))
+	for _, name := range names {
+		found := false
+		for _, item := range tableDef.Partition.Partitions {
+			if item.PartitionName == string(name) {
+				tableNames[item.PartitionName] = item.PartitionTableName
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "partition '%s' of table '%s' doesn't exist", string(name), tableDef.Name)
+		}
+	}
+	return tableNames, nil
+}
+
+// getPartitionTableNamesInOrder returns the tables of the partitions in the order of
+// the table's partitions
+func getPartitionTableNamesInOrder(partition *plan.PartitionByDef, names map[string]string) []string {
+	var tableNames []string
+	for _, item := range partition.Partitions {
+		if name, ok := names[item.PartitionName]; ok {
+			tableNames = append(tableNames, name)
+		}
+	}
+	return tableNames
+}
+
+func checkAlterTableColumn(name string, colMap map[string]*ColDef, keyCols map[string]string, tableName string, ctx CompilerContext) error {
+	if _, ok := colMap[name]; !ok {
+		return moerr.NewBadFieldError(ctx.GetContext(), name, tableName)
+	}
+	if key, ok := keyCols[name]; ok {
+		return moerr.NewNotSupported(ctx.GetContext(), "alter column '%s' used by key '%s'", name, key)
+	}
+	return nil
+}
+
+// getCompressedColumns returns the columns with the COMPRESSION attribute
+func getCompressedColumns(stmt *tree.CreateTable) map[string]bool {
+	cols := make(map[string]bool)
+	for _, item := range stmt.Defs {
+		def, ok := item.(*tree.ColumnTableDef)
+		if !ok {
+			continue
+		}
+		for _, attr := range def.Attributes {
+			if _, ok := attr.(*tree.AttributeCompression); ok {
+				cols[def.Name.Parts[0]] = true
+			}
+		}
+	}
+	return cols
+}
+
+// getCompressType returns the compression algorithm of the COMPRESSION option
+func getCompressType(ctx context.Context, name string) (plan.CompressType, error) {
+	for typ, value := range plan.CompressType_value {
+		if strings.EqualFold(typ, name) {
+			return plan.CompressType(value), nil
+		}
+	}
+	return plan.CompressType_None, moerr.NewNotSupported(ctx, "compression algorithm '%s'", name)
+}
+
 func buildLockTables(stmt *tree.LockTableStmt, ctx CompilerContext) (*Plan, error) {
 	lockTables := make([]*plan.TableLockInfo, 0, len(stmt.TableLocks))
 	uniqueTableName := make(map[string]bool)
@@ -29,6 +29,7 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		return nil, err
 	}
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	builder.setPolicyCommand(tblInfo.objRef, tree.PolicyCommandDelete)
 	bindCtx := NewBindContext(builder, nil)
 
 	rewriteInfo := &dmlSelectInfo{
@@ -37,7 +38,15 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		tblInfo: tblInfo,
 	}
 
+	// the partition keys of the deleted rows are selected to locate their partitions
+	for _, tableDef := range tblInfo.tableDefs {
+		if tableDef.Partition != nil {
+			tblInfo.haveConstraint = true
+		}
+	}
+
 	canTruncate := false
+	var partitionIdx []*plan.ColPosMap
 	if tblInfo.haveConstraint {
 		bindCtx.groupTag = builder.genNewTag()
 		bindCtx.aggregateTag = builder.genNewTag()
@@ -55,6 +64,7 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 				return nil, err
 			}
 		}
+		partitionIdx = appendPartitionKeys(builder, rewriteInfo)
 
 		// append ProjectNode
 		rewriteInfo.rootId = builder.appendNode(&plan.Node{
@@ -77,6 +87,7 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		}
 	}
 
+	setDmlLockTarget(builder, rewriteInfo.rootId, tblInfo.tableDefs)
 	builder.qry.Steps = append(builder.qry.Steps, rewriteInfo.rootId)
 	query, err := builder.createQuery()
 	if err != nil {
@@ -100,6 +111,8 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		OnSetIdx:       make([]*plan.IdList, len(rewriteInfo.onSet)),
 		OnSetDef:       rewriteInfo.onSetTableDef,
 		OnSetUpdateCol: make([]*plan.ColPosMap, len(rewriteInfo.onSetUpdateCol)),
+
+		PartitionIdx: partitionIdx,
 	}
 	for i, idxList := range rewriteInfo.onCascade {
 		deleteCtx.OnCascadeIdx[i] = int32(idxList[0])
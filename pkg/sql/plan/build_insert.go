@@ -21,18 +21,15 @@ import (
 )
 
 func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Plan, err error) {
-	if isReplace {
-		return nil, moerr.NewNotSupported(ctx.GetContext(), "Not support replace statement")
-	}
-
 	tblInfo, err := getDmlTableInfo(ctx, tree.TableExprs{stmt.Table}, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 	rewriteInfo := &dmlSelectInfo{
-		typ:     "insert",
-		rootId:  -1,
-		tblInfo: tblInfo,
+		typ:       "insert",
+		rootId:    -1,
+		tblInfo:   tblInfo,
+		isReplace: isReplace,
 	}
 	tblDef := tblInfo.tableDefs[0]
 	clusterTable, err := getAccountInfoOfClusterTable(ctx, stmt.Accounts, tblDef, tblInfo.isClusterTable[0])
@@ -43,6 +40,9 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 	if len(stmt.OnDuplicateUpdate) > 0 && clusterTable.IsClusterTable {
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "INSERT ... ON DUPLICATE KEY UPDATE ... for cluster table")
 	}
+	if isReplace && clusterTable.IsClusterTable {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "REPLACE for cluster table")
+	}
 
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
 	bindCtx := NewBindContext(builder, nil)
@@ -89,6 +89,8 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 
 		OnDuplicateIdx:  rewriteInfo.onDuplicateIdx,
 		OnDuplicateExpr: rewriteInfo.onDuplicateExpr,
+		IsReplace:       isReplace,
+		RowIdx:          rewriteInfo.rowIdx,
 	}
 	if len(rewriteInfo.parentIdx) == 1 {
 		insertCtx.ParentIdx = rewriteInfo.parentIdx[0]
@@ -22,6 +22,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -595,6 +597,35 @@ func TestCTESqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestRecursiveCTE(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// should pass
+	sqls := []string{
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 10) SELECT * FROM qn",
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION SELECT n + 1 FROM qn WHERE n < 10) SELECT n FROM qn WHERE n > 5",
+		"WITH RECURSIVE qn AS (SELECT 'a' AS s UNION ALL SELECT concat(s, 'a') FROM qn WHERE length(s) < 5) SELECT s FROM qn",
+		`WITH RECURSIVE qn(k, r) AS (SELECT N_NATIONKEY, N_REGIONKEY FROM NATION WHERE N_NATIONKEY = 0
+		UNION ALL SELECT N_NATIONKEY, N_REGIONKEY FROM NATION, qn WHERE N_REGIONKEY = qn.k)
+		SELECT count(*) FROM qn`,
+		"WITH RECURSIVE qn AS (SELECT 1 AS a UNION ALL SELECT 2) SELECT * FROM qn",
+		"WITH RECURSIVE qn AS (SELECT 1 AS a) SELECT * FROM qn",
+		"SELECT * FROM NATION WHERE N_NATIONKEY IN (WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 3) SELECT n FROM qn)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"WITH qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 10) SELECT * FROM qn",                  // RECURSIVE is required
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT a.n + 1 FROM qn a, qn b) SELECT * FROM qn",           // referenced twice
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT max(n) + 1 FROM qn) SELECT * FROM qn",                // aggregation
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1, n FROM qn) SELECT * FROM qn",                  // different number of columns
+		"WITH RECURSIVE qn(n, m) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn) SELECT * FROM qn",                  // too many column names
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn ORDER BY n LIMIT 10) SELECT * FROM qn", // order by on recursive CTE
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestInsert(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
@@ -621,6 +652,90 @@ func TestInsert(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+// addTableFromSql adds the table created by the sql to the mock catalog
+func addTableFromSql(t *testing.T, opt *MockOptimizer, name string, sql string) *TableDef {
+	logicPlan, err := runOneStmt(opt, t, sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	addTableDef(opt, name, tableDef)
+	return tableDef
+}
+
+// addTableDef registers the table definition in the mock catalog
+func addTableDef(opt *MockOptimizer, name string, tableDef *TableDef) {
+	tableDef.TblId = uint64(len(opt.ctxt.tables) + 1000)
+	tableDef.TableType = catalog.SystemOrdinaryRel
+	opt.ctxt.tables[name] = tableDef
+	opt.ctxt.objects[name] = &ObjectRef{
+		Obj:        int64(tableDef.TblId),
+		SchemaName: "tpch",
+		ObjName:    name,
+	}
+	opt.ctxt.id2name[tableDef.TblId] = name
+	opt.ctxt.stats[name] = DefaultStats()
+}
+
+func TestReplace(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"REPLACE INTO NATION VALUES (1, 'NAME1',21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
+		"REPLACE INTO NATION (N_NATIONKEY, N_NAME, N_REGIONKEY) VALUES (1, 'NAME1', 21)",
+		"REPLACE INTO NATION SELECT * FROM NATION2",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the conflicting rows are replaced by all the columns of the new rows
+	addTableFromSql(t, mock, "replace_t", "create table replace_t (a int primary key, b int, c varchar(10))")
+	addTableWithIndexes(t, mock, "replace_u", "create table replace_u (a int primary key, b int unique key)")
+	logicPlan, err := runOneStmt(mock, t, "REPLACE INTO replace_t (a, c) VALUES (1, 'c1'), (1, 'c2')")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry := logicPlan.GetQuery()
+	insertCtx := qry.Nodes[qry.Steps[len(qry.Steps)-1]].InsertCtx
+	if len(insertCtx.OnDuplicateIdx) == 0 {
+		t.Fatalf("replace should be planned as insert on duplicate key")
+	}
+	for _, col := range insertCtx.TableDef.Cols {
+		expr, ok := insertCtx.OnDuplicateExpr[col.Name]
+		if !ok || expr.GetF() == nil || expr.GetF().Func.ObjName != "values" {
+			t.Fatalf("column %s is not replaced by the new value", col.Name)
+		}
+	}
+
+	// the rows conflicting on the primary key and the unique key are all deleted,
+	// with the rows of the unique index table matched by their keys
+	logicPlan, err = runOneStmt(mock, t, "REPLACE INTO replace_u VALUES (1, 1)")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry = logicPlan.GetQuery()
+	insertCtx = qry.Nodes[qry.Steps[len(qry.Steps)-1]].InsertCtx
+	if !insertCtx.IsReplace || len(insertCtx.OnDuplicateIdx) == 0 {
+		t.Fatalf("replace should be planned as insert deleting the conflicting rows")
+	}
+	if len(insertCtx.IdxIdx) != 1 {
+		t.Fatalf("the rows of the unique index table should be deleted")
+	}
+	// the rows of the join for a new row are found by the number of the new row
+	projectList := qry.Nodes[qry.Nodes[qry.Steps[len(qry.Steps)-1]].Children[0]].ProjectList
+	if projectList[insertCtx.RowIdx].Typ.Id != int32(types.T_uuid) {
+		t.Fatalf("the new rows of replace should be numbered")
+	}
+
+	// should error
+	sqls = []string{
+		"REPLACE INTO NATION VALUES (1, 'NAME1',21, 'COMMENT1'), ('NAME2', 22, 'COMMENT2')", // doesn't match value count
+		"REPLACE INTO NATION333 VALUES (1, 'NAME1',21, 'COMMENT1')",                         // table not exist
+		"REPLACE INTO NATION (N_NATIONKEY, N_NAME2222) VALUES (1, 'NAME1')",                 // column not exist
+		"INSERT INTO replace_u VALUES (1, 1) ON DUPLICATE KEY UPDATE b = 2",                 // more than one unique constraint
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestUpdate(t *testing.T) {
 	mock := NewMockOptimizer(true)
 	// should pass
@@ -704,6 +819,41 @@ func TestSubQuery(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestWindowFunction(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	// should pass
+	sqls := []string{
+		"SELECT N_NAME, row_number() over (partition by N_REGIONKEY order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, rank() over (order by N_REGIONKEY desc), dense_rank() over (order by N_REGIONKEY desc) FROM NATION",
+		"SELECT N_NAME, lag(N_NAME) over (order by N_NATIONKEY), lead(N_NATIONKEY, 2, 0) over (order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, sum(N_NATIONKEY) over (partition by N_REGIONKEY) FROM NATION",
+		"SELECT N_NAME, count(*) over (partition by N_REGIONKEY order by N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, avg(N_NATIONKEY) over (order by N_NATIONKEY rows between 1 preceding and 1 following) FROM NATION",
+		"SELECT N_NAME, max(N_NATIONKEY) over (order by N_NATIONKEY range between 2 preceding and current row) FROM NATION",
+		"SELECT N_NAME, first_value(N_NAME) over (partition by N_REGIONKEY order by N_NATIONKEY rows unbounded preceding) FROM NATION",
+		"SELECT N_NAME, last_value(N_NAME) over (partition by N_REGIONKEY order by N_NATIONKEY rows between current row and unbounded following) FROM NATION",
+		"SELECT N_REGIONKEY, sum(N_NATIONKEY), rank() over (order by sum(N_NATIONKEY)) FROM NATION group by N_REGIONKEY",
+		"SELECT N_NAME, row_number() over (order by N_NATIONKEY) as rn FROM NATION order by rn desc limit 5",
+		"SELECT * FROM (SELECT N_NAME, row_number() over (partition by N_REGIONKEY order by N_NATIONKEY) as rn FROM NATION) t where rn = 1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT row_number() FROM NATION",                                                                      // over clause is required
+		"SELECT N_NAME FROM NATION where row_number() over (order by N_NATIONKEY) > 1",                         // not allowed in where clause
+		"SELECT N_REGIONKEY FROM NATION group by row_number() over (order by N_REGIONKEY)",                     // not allowed in group by clause
+		"SELECT sum(row_number() over (order by N_NATIONKEY)) FROM NATION",                                     // nested in aggregate function
+		"SELECT row_number(N_NAME) over (order by N_NATIONKEY) FROM NATION",                                    // wrong arguments
+		"SELECT lag(N_NAME, -1) over (order by N_NATIONKEY) FROM NATION",                                       // negative offset
+		"SELECT lag(N_NAME, N_NATIONKEY) over (order by N_NATIONKEY) FROM NATION",                              // non-constant offset
+		"SELECT sum(N_NATIONKEY) over (order by N_NAME range between 1 preceding and current row) FROM NATION", // non-numeric order key
+		"SELECT sum(N_NATIONKEY) over (rows between unbounded following and current row) FROM NATION",          // invalid frame start
+		"SELECT sum(N_NATIONKEY) over (rows between current row and 1 preceding) FROM NATION",                  // invalid frame end
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestMysqlCompatibilityMode(t *testing.T) {
 	mock := NewMockOptimizer(false)
 
@@ -769,6 +919,17 @@ func TestDdl(t *testing.T) {
 		"lock tables nation read",
 		"lock tables nation write, supplier read",
 		"unlock tables",
+		"alter table nation add column n_extra int",
+		"alter table nation add column n_extra varchar(20) default 'a' comment 'extra', drop column n_comment",
+		"alter table nation modify n_comment varchar(200)",
+		"alter table nation rename column n_comment to n_note",
+		"alter table nation rename to nation_new",
+		"alter table tpch.nation rename to tpch.nation_new",
+		"alter table nation add index idx_name(n_name)",
+		"alter table nation add unique index idx_name(n_name)",
+		"alter table test_idx drop index idx1",
+		"create table t3 (a int, b varchar(20)) compression = 'zstd'",
+		"create table t3 (a int, b varchar(20)) compression = 'SNAPPY'",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -785,10 +946,57 @@ func TestDdl(t *testing.T) {
 		"lock tables t3 read",
 		"lock tables t1 read, t1 write",
 		"lock tables nation read, nation write",
+		"alter table tbl_not_exist add column a int",
+		"create table t3 (a int) compression = 'zlib'",
+		"alter table v1 add column a int",
+		"alter table nation add column n_name int",
+		"alter table nation add column n_extra int not null",
+		"alter table nation add column n_extra int primary key",
+		"alter table nation drop column col_not_exist",
+		"alter table nation drop column n_nationkey",
+		"alter table nation modify n_comment int",
+		"alter table nation modify n_comment varchar(10)",
+		"alter table nation rename column n_name to n_comment",
+		"alter table nation rename to region",
+		"alter table nation rename to db_not_exist.nation_new",
+		"alter table nation drop column n_name, add index idx_name(n_name)",
+		"alter table test_idx drop index idx_not_exist",
+		"alter table test_idx add unique index idx1(n_name)",
 	}
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestCreateTableCompression(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	logicPlan, err := runOneStmt(mock, t, "create table t3 (a int primary key, b varchar(20)) compression = 'zstd'")
+	assert.NoError(t, err)
+	cols := logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols()
+	assert.NotEmpty(t, cols)
+	for _, col := range cols {
+		assert.Equal(t, plan.CompressType_Zstd, col.Alg)
+	}
+
+	logicPlan, err = runOneStmt(mock, t, "create table t3 (a int primary key, b varchar(20))")
+	assert.NoError(t, err)
+	for _, col := range logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols() {
+		assert.Equal(t, plan.CompressType_Lz4, col.Alg)
+	}
+
+	// the compression of the column overrides the one of the table
+	logicPlan, err = runOneStmt(mock, t, "create table t3 (a int primary key, b varchar(20) compression 'none') compression = 'snappy'")
+	assert.NoError(t, err)
+	for _, col := range logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols() {
+		if col.Name == "b" {
+			assert.Equal(t, plan.CompressType_None, col.Alg)
+		} else {
+			assert.Equal(t, plan.CompressType_Snappy, col.Alg)
+		}
+	}
+
+	_, err = runOneStmt(mock, t, "create table t3 (a int compression 'zlib')")
+	assert.Error(t, err)
+}
+
 func TestShow(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
@@ -30,6 +30,12 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		tblInfo: tblInfo,
 	}
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	builder.setPolicyCommand(tblInfo.objRef, tree.PolicyCommandUpdate)
+	for i, ref := range tblInfo.objRef {
+		if err = builder.checkUpdateColumns(ref, tblInfo.updateKeys[i]); err != nil {
+			return nil, err
+		}
+	}
 	bindCtx := NewBindContext(builder, nil)
 	bindCtx.groupTag = builder.genNewTag()
 	bindCtx.aggregateTag = builder.genNewTag()
@@ -49,6 +55,8 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		}
 	}
 
+	partitionIdx := appendPartitionKeys(builder, rewriteInfo)
+
 	// append ProjectNode
 	rewriteInfo.rootId = builder.appendNode(&plan.Node{
 		NodeType:    plan.Node_PROJECT,
@@ -58,6 +66,7 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 	}, bindCtx)
 	bindCtx.results = rewriteInfo.projectList
 
+	setDmlLockTarget(builder, rewriteInfo.rootId, tblInfo.tableDefs)
 	builder.qry.Steps = append(builder.qry.Steps, rewriteInfo.rootId)
 	query, err := builder.createQuery()
 	if err != nil {
@@ -88,6 +97,8 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		OnSetUpdateCol: make([]*plan.ColPosMap, len(rewriteInfo.onSetUpdateCol)),
 
 		ParentIdx: make([]*plan.ColPosMap, len(rewriteInfo.parentIdx)),
+
+		PartitionIdx: partitionIdx,
 	}
 	idx := int64(0)
 	for i, tableDef := range rewriteInfo.tblInfo.tableDefs {
@@ -0,0 +1,555 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	// DefaultHistogramBuckets is the number of the buckets of the histogram built by analyze table
+	DefaultHistogramBuckets = 64
+	// DefaultTopNValues is the number of the most frequent values kept by analyze table
+	DefaultTopNValues = 16
+)
+
+// TableColumnStats is the statistics of a table collected by analyze table
+type TableColumnStats struct {
+	// RowCount is the number of the rows when the statistics are collected
+	RowCount float64
+	// Columns is the statistics of the analyzed columns, keyed by the column name
+	Columns map[string]*ColumnStats
+}
+
+// ColumnStats is the statistics of a column
+type ColumnStats struct {
+	NDV       float64
+	NullCount float64
+	// Histogram is an equi-depth histogram of the non-null values,
+	// only the columns of the numeric and date/time types have it.
+	Histogram []HistogramBucket
+	// TopN is the most frequent values, ordered by the count descending.
+	TopN []TopNValue
+}
+
+// HistogramBucket is a bucket of the equi-depth histogram, the values
+// of the column are mapped to float64 the same way as the constants
+// of the filters.
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count float64 `json:"count"`
+	NDV   float64 `json:"ndv"`
+}
+
+// TopNValue is a frequent value of the column, Num is used if the column
+// has the histogram, otherwise Str.
+type TopNValue struct {
+	Str   string  `json:"str,omitempty"`
+	Num   float64 `json:"num,omitempty"`
+	Count float64 `json:"count"`
+}
+
+// ColumnStatsBuilder builds the statistics of a column from its distinct values,
+// the values of a numeric column must be added in ascending order.
+type ColumnStatsBuilder struct {
+	numeric   bool
+	nums      []float64
+	strs      []string
+	counts    []float64
+	nullCount float64
+}
+
+func NewColumnStatsBuilder(numeric bool) *ColumnStatsBuilder {
+	return &ColumnStatsBuilder{numeric: numeric}
+}
+
+func (b *ColumnStatsBuilder) AddNull(count float64) {
+	b.nullCount += count
+}
+
+func (b *ColumnStatsBuilder) AddNum(v float64, count float64) {
+	b.nums = append(b.nums, v)
+	b.counts = append(b.counts, count)
+}
+
+func (b *ColumnStatsBuilder) AddStr(v string, count float64) {
+	b.strs = append(b.strs, v)
+	b.counts = append(b.counts, count)
+}
+
+// Build returns the statistics with at most buckets histogram buckets and topN frequent values
+func (b *ColumnStatsBuilder) Build(buckets, topN int) *ColumnStats {
+	s := &ColumnStats{
+		NDV:       float64(len(b.counts)),
+		NullCount: b.nullCount,
+	}
+	if b.numeric {
+		s.Histogram = b.buildHistogram(buckets)
+	}
+	s.TopN = b.buildTopN(topN)
+	return s
+}
+
+func (b *ColumnStatsBuilder) buildHistogram(buckets int) []HistogramBucket {
+	if len(b.nums) == 0 || buckets <= 0 {
+		return nil
+	}
+	var total float64
+	for _, cnt := range b.counts {
+		total += cnt
+	}
+	depth := total / float64(buckets)
+	hist := make([]HistogramBucket, 0, buckets)
+	var cur *HistogramBucket
+	var acc float64
+	for i, v := range b.nums {
+		if cur == nil {
+			hist = append(hist, HistogramBucket{Lower: v})
+			cur = &hist[len(hist)-1]
+		}
+		cur.Upper = v
+		cur.Count += b.counts[i]
+		cur.NDV++
+		acc += b.counts[i]
+		// close the bucket once the accumulated count reaches its boundary
+		if acc >= depth*float64(len(hist)) {
+			cur = nil
+		}
+	}
+	return hist
+}
+
+func (b *ColumnStatsBuilder) buildTopN(topN int) []TopNValue {
+	if topN <= 0 {
+		return nil
+	}
+	idx := make([]int, 0, len(b.counts))
+	for i, cnt := range b.counts {
+		// the value appears only once is not frequent at all
+		if cnt > 1 {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return b.counts[idx[i]] > b.counts[idx[j]]
+	})
+	if len(idx) > topN {
+		idx = idx[:topN]
+	}
+	if len(idx) == 0 {
+		return nil
+	}
+	vals := make([]TopNValue, len(idx))
+	for i, k := range idx {
+		vals[i].Count = b.counts[k]
+		if b.numeric {
+			vals[i].Num = b.nums[k]
+		} else {
+			vals[i].Str = b.strs[k]
+		}
+	}
+	return vals
+}
+
+func (s *ColumnStats) isNumeric() bool {
+	return len(s.Histogram) > 0
+}
+
+func (s *ColumnStats) nonNullCount(rowCount float64) float64 {
+	return math.Max(rowCount-s.NullCount, 0)
+}
+
+func (s *ColumnStats) topNCount() float64 {
+	var cnt float64
+	for i := range s.TopN {
+		cnt += s.TopN[i].Count
+	}
+	return cnt
+}
+
+// equalSelectivity estimates the selectivity of col = v
+func (s *ColumnStats) equalSelectivity(rowCount float64, num float64, str string, isNum bool) float64 {
+	if rowCount <= 0 {
+		return 1
+	}
+	if isNum != s.isNumeric() {
+		// can not compare the value with the statistics, take it as an unique key
+		return 1 / rowCount
+	}
+	for i := range s.TopN {
+		if (isNum && s.TopN[i].Num == num) || (!isNum && s.TopN[i].Str == str) {
+			return s.TopN[i].Count / rowCount
+		}
+	}
+	if isNum && (num < s.Histogram[0].Lower || num > s.Histogram[len(s.Histogram)-1].Upper) {
+		return 0
+	}
+	ndv := s.NDV - float64(len(s.TopN))
+	if ndv < 1 {
+		return 0
+	}
+	return math.Max(s.nonNullCount(rowCount)-s.topNCount(), 0) / ndv / rowCount
+}
+
+// lessCount estimates the number of the rows less than (or equal to) v by the histogram
+func (s *ColumnStats) lessCount(v float64, inclusive bool) float64 {
+	var cnt float64
+	for _, b := range s.Histogram {
+		if v > b.Upper || (inclusive && v == b.Upper) {
+			cnt += b.Count
+			continue
+		}
+		if v < b.Lower || (!inclusive && v == b.Lower) {
+			break
+		}
+		// the value falls into the bucket, assume the values are uniformly distributed
+		if b.Upper > b.Lower {
+			cnt += b.Count * (v - b.Lower) / (b.Upper - b.Lower)
+		}
+		if inclusive && b.NDV > 0 {
+			cnt += b.Count / b.NDV
+		}
+		break
+	}
+	return cnt
+}
+
+// rangeSelectivity estimates the selectivity of col op v, op is one of <, <=, > and >=
+func (s *ColumnStats) rangeSelectivity(rowCount float64, op string, v float64) float64 {
+	if rowCount <= 0 {
+		return 1
+	}
+	var cnt float64
+	switch op {
+	case "<":
+		cnt = s.lessCount(v, false)
+	case "<=":
+		cnt = s.lessCount(v, true)
+	case ">":
+		cnt = s.nonNullCount(rowCount) - s.lessCount(v, true)
+	case ">=":
+		cnt = s.nonNullCount(rowCount) - s.lessCount(v, false)
+	default:
+		return 1
+	}
+	return math.Min(math.Max(cnt, 0)/rowCount, 1)
+}
+
+func (s *ColumnStats) nullSelectivity(rowCount float64) float64 {
+	if rowCount <= 0 {
+		return 1
+	}
+	return math.Min(s.NullCount/rowCount, 1)
+}
+
+// reverseCompareOp returns the operator after swapping the operands
+func reverseCompareOp(op string) string {
+	switch op {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return op
+}
+
+// getConstValueForStats maps the constant to the value used by the column statistics
+func getConstValueForStats(c *plan.Const) (num float64, str string, isNum bool, ok bool) {
+	if c == nil || c.Isnull {
+		return
+	}
+	isNum, ok = true, true
+	switch v := c.Value.(type) {
+	case *plan.Const_I8Val:
+		num = float64(v.I8Val)
+	case *plan.Const_I16Val:
+		num = float64(v.I16Val)
+	case *plan.Const_I32Val:
+		num = float64(v.I32Val)
+	case *plan.Const_I64Val:
+		num = float64(v.I64Val)
+	case *plan.Const_U8Val:
+		num = float64(v.U8Val)
+	case *plan.Const_U16Val:
+		num = float64(v.U16Val)
+	case *plan.Const_U32Val:
+		num = float64(v.U32Val)
+	case *plan.Const_U64Val:
+		num = float64(v.U64Val)
+	case *plan.Const_Fval:
+		num = float64(v.Fval)
+	case *plan.Const_Dval:
+		num = v.Dval
+	case *plan.Const_Dateval:
+		num = float64(v.Dateval)
+	case *plan.Const_Timeval:
+		num = float64(v.Timeval)
+	case *plan.Const_Datetimeval:
+		num = float64(v.Datetimeval)
+	case *plan.Const_Decimal64Val:
+		num = types.Decimal64FromInt64Raw(v.Decimal64Val.A).ToFloat64()
+	case *plan.Const_Decimal128Val:
+		num = types.Decimal128FromInt64Raw(v.Decimal128Val.A, v.Decimal128Val.B).ToFloat64()
+	case *plan.Const_Sval:
+		str, isNum = v.Sval, false
+	case *plan.Const_Bval:
+		str, isNum = strconv.FormatBool(v.Bval), false
+	default:
+		return 0, "", false, false
+	}
+	return
+}
+
+// getColumnStatsOfScan returns the statistics of the column if the expr
+// is a column of the table scan node
+func getColumnStatsOfScan(expr *plan.Expr, node *plan.Node, stats *TableColumnStats) *ColumnStats {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok || len(node.BindingTags) == 0 || col.Col.RelPos != node.BindingTags[0] {
+		return nil
+	}
+	if node.TableDef == nil || int(col.Col.ColPos) >= len(node.TableDef.Cols) {
+		return nil
+	}
+	return stats.Columns[node.TableDef.Cols[col.Col.ColPos].Name]
+}
+
+// estimateSelectivityByColumnStats estimates the selectivity of a filter of the table
+// scan node by the column statistics, ok is false if the filter can't be estimated.
+func estimateSelectivityByColumnStats(expr *plan.Expr, node *plan.Node, stats *TableColumnStats) (float64, bool) {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return 1, false
+	}
+	args := f.F.Args
+	rowCount := stats.RowCount
+	switch op := f.F.Func.GetObjName(); op {
+	case "and":
+		sel1, ok1 := estimateSelectivityByColumnStats(args[0], node, stats)
+		sel2, ok2 := estimateSelectivityByColumnStats(args[1], node, stats)
+		if !ok1 || !ok2 {
+			return 1, false
+		}
+		return sel1 * sel2, true
+
+	case "or":
+		sel1, ok1 := estimateSelectivityByColumnStats(args[0], node, stats)
+		sel2, ok2 := estimateSelectivityByColumnStats(args[1], node, stats)
+		if !ok1 || !ok2 {
+			return 1, false
+		}
+		return sel1 + sel2 - sel1*sel2, true
+
+	case "isnull", "is_null", "isnotnull", "is_not_null":
+		s := getColumnStatsOfScan(args[0], node, stats)
+		if s == nil {
+			return 1, false
+		}
+		sel := s.nullSelectivity(rowCount)
+		if op == "isnotnull" || op == "is_not_null" {
+			sel = 1 - sel
+		}
+		return sel, true
+
+	case "in":
+		s := getColumnStatsOfScan(args[0], node, stats)
+		list, isList := args[1].Expr.(*plan.Expr_List)
+		if s == nil || !isList {
+			return 1, false
+		}
+		var sel float64
+		for _, e := range list.List.List {
+			c, isConst := e.Expr.(*plan.Expr_C)
+			if !isConst {
+				return 1, false
+			}
+			num, str, isNum, ok := getConstValueForStats(c.C)
+			if !ok {
+				return 1, false
+			}
+			sel += s.equalSelectivity(rowCount, num, str, isNum)
+		}
+		return math.Min(sel, 1), true
+
+	case "=", "<", "<=", ">", ">=":
+		colExpr, constExpr := args[0], args[1]
+		if _, isCol := colExpr.Expr.(*plan.Expr_Col); !isCol {
+			colExpr, constExpr = constExpr, colExpr
+			op = reverseCompareOp(op)
+		}
+		s := getColumnStatsOfScan(colExpr, node, stats)
+		c, isConst := constExpr.Expr.(*plan.Expr_C)
+		if s == nil || !isConst {
+			return 1, false
+		}
+		num, str, isNum, ok := getConstValueForStats(c.C)
+		if !ok {
+			return 1, false
+		}
+		if op == "=" {
+			return s.equalSelectivity(rowCount, num, str, isNum), true
+		}
+		if !isNum || !s.isNumeric() {
+			return 1, false
+		}
+		return s.rangeSelectivity(rowCount, op, num), true
+	}
+	return 1, false
+}
+
+// getScanColumnStats returns the column statistics of the table scan node, it's
+// loaded from the compiler context only once for a node.
+func (builder *QueryBuilder) getScanColumnStats(node *plan.Node) *TableColumnStats {
+	if node.ObjRef == nil || len(node.BindingTags) == 0 {
+		return nil
+	}
+	tag := node.BindingTags[0]
+	if scan, ok := builder.colStatsByTag[tag]; ok {
+		return scan.stats
+	}
+	stats := builder.compCtx.ColumnStats(node.ObjRef)
+	if stats != nil && stats.RowCount <= 0 {
+		stats = nil
+	}
+	if builder.colStatsByTag == nil {
+		builder.colStatsByTag = make(map[int32]*scanColumnStats)
+	}
+	builder.colStatsByTag[tag] = &scanColumnStats{
+		node:  node,
+		stats: stats,
+	}
+	return stats
+}
+
+// applyColumnStats refines the stats of the table scan node by the column statistics
+func (builder *QueryBuilder) applyColumnStats(node *plan.Node) {
+	// the statistics are loaded even if there is no filter, they are used by the joins
+	stats := builder.getScanColumnStats(node)
+	if stats == nil || len(node.FilterList) == 0 || node.Stats == nil || node.Stats.TableCnt <= 0 {
+		return
+	}
+	sel := 1.0
+	estimated, allEstimated := false, true
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	for _, filter := range node.FilterList {
+		// fold the casts of the constants, so that they can be compared with the statistics
+		if expr, err := ConstantFold(bat, DeepCopyExpr(filter), builder.compCtx.GetProcess()); err == nil && expr != nil {
+			filter = expr
+		}
+		if s, ok := estimateSelectivityByColumnStats(filter, node, stats); ok {
+			sel *= s
+			estimated = true
+		} else {
+			allEstimated = false
+		}
+	}
+	if !estimated {
+		return
+	}
+	outcnt := node.Stats.TableCnt * sel
+	if !allEstimated {
+		// the filters not covered by the statistics are estimated by the zonemap
+		outcnt = math.Min(outcnt, node.Stats.Outcnt)
+	}
+	if outcnt < 1 {
+		outcnt = 1
+	}
+	node.Stats.Outcnt = outcnt
+	node.Stats.Selectivity = outcnt / node.Stats.TableCnt
+}
+
+// getColumnNdv returns the ndv of the column, which is no more than the output of
+// the table scan, -1 is returned if the column has no statistics.
+func (builder *QueryBuilder) getColumnNdv(expr *plan.Expr) float64 {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok {
+		return -1
+	}
+	scan := builder.colStatsByTag[col.Col.RelPos]
+	if scan == nil || scan.stats == nil {
+		return -1
+	}
+	s := getColumnStatsOfScan(expr, scan.node, scan.stats)
+	if s == nil || s.NDV <= 0 {
+		return -1
+	}
+	ndv := s.NDV
+	if scan.node.Stats != nil && scan.node.Stats.Outcnt < ndv {
+		ndv = math.Max(scan.node.Stats.Outcnt, 1)
+	}
+	return ndv
+}
+
+// estimateJoinNdv estimates the ndv of the equi-join conditions by the column statistics,
+// ok is false if no column of the conditions has statistics.
+func (builder *QueryBuilder) estimateJoinNdv(conds []*plan.Expr, leftTags map[int32]bool, leftOutcnt, rightOutcnt float64) (float64, bool) {
+	var ndv float64
+	found := false
+	for _, cond := range conds {
+		f, ok := cond.Expr.(*plan.Expr_F)
+		if !ok || f.F.Func.GetObjName() != "=" {
+			continue
+		}
+		l, lok := f.F.Args[0].Expr.(*plan.Expr_Col)
+		r, rok := f.F.Args[1].Expr.(*plan.Expr_Col)
+		if !lok || !rok {
+			continue
+		}
+		leftExpr, rightExpr := f.F.Args[0], f.F.Args[1]
+		if !leftTags[l.Col.RelPos] {
+			if !leftTags[r.Col.RelPos] {
+				continue
+			}
+			leftExpr, rightExpr = rightExpr, leftExpr
+		}
+		lndv, rndv := builder.getColumnNdv(leftExpr), builder.getColumnNdv(rightExpr)
+		if lndv < 0 && rndv < 0 {
+			continue
+		}
+		// take the column without statistics as a key of its side
+		if lndv < 0 || lndv > leftOutcnt {
+			lndv = leftOutcnt
+		}
+		if rndv < 0 || rndv > rightOutcnt {
+			rndv = rightOutcnt
+		}
+		ndv = math.Max(ndv, math.Max(lndv, rndv))
+		found = true
+	}
+	if !found || ndv < 1 {
+		return 1, false
+	}
+	return ndv, true
+}
+
+func (builder *QueryBuilder) hasColumnStats() bool {
+	for _, scan := range builder.colStatsByTag {
+		if scan.stats != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnStatsBuilder(t *testing.T) {
+	b := NewColumnStatsBuilder(true)
+	b.AddNull(10)
+	for i := 0; i < 100; i++ {
+		cnt := 1.0
+		if i == 50 {
+			cnt = 101
+		}
+		b.AddNum(float64(i), cnt)
+	}
+	s := b.Build(10, 4)
+	require.Equal(t, float64(100), s.NDV)
+	require.Equal(t, float64(10), s.NullCount)
+	require.Equal(t, 1, len(s.TopN))
+	require.Equal(t, float64(50), s.TopN[0].Num)
+	require.Equal(t, float64(101), s.TopN[0].Count)
+
+	var total float64
+	for i, bucket := range s.Histogram {
+		require.LessOrEqual(t, bucket.Lower, bucket.Upper)
+		if i > 0 {
+			require.Less(t, s.Histogram[i-1].Upper, bucket.Lower)
+		}
+		total += bucket.Count
+	}
+	require.Equal(t, float64(200), total)
+	require.Equal(t, float64(0), s.Histogram[0].Lower)
+	require.Equal(t, float64(99), s.Histogram[len(s.Histogram)-1].Upper)
+
+	// the statistics are persisted as json
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	var s2 ColumnStats
+	require.NoError(t, json.Unmarshal(data, &s2))
+	require.Equal(t, *s, s2)
+}
+
+func TestColumnStatsSelectivity(t *testing.T) {
+	b := NewColumnStatsBuilder(true)
+	for i := 0; i < 100; i++ {
+		b.AddNum(float64(i), 1)
+	}
+	b.AddNum(100, 100)
+	s := b.Build(DefaultHistogramBuckets, DefaultTopNValues)
+	rowCount := float64(200)
+
+	// the frequent value
+	require.Equal(t, 0.5, s.equalSelectivity(rowCount, 100, "", true))
+	// the other values are uniformly distributed
+	require.InDelta(t, 1/rowCount, s.equalSelectivity(rowCount, 10, "", true), 1e-9)
+	// out of the range
+	require.Equal(t, float64(0), s.equalSelectivity(rowCount, 1000, "", true))
+
+	require.InDelta(t, 0.25, s.rangeSelectivity(rowCount, "<", 50), 0.02)
+	require.InDelta(t, 0.75, s.rangeSelectivity(rowCount, ">=", 50), 0.02)
+	require.InDelta(t, 0.5, s.rangeSelectivity(rowCount, ">", 99), 0.01)
+	require.Equal(t, float64(0), s.rangeSelectivity(rowCount, "<", 0))
+
+	b = NewColumnStatsBuilder(false)
+	b.AddStr("a", 90)
+	b.AddStr("b", 5)
+	b.AddStr("c", 5)
+	b.AddNull(100)
+	s = b.Build(DefaultHistogramBuckets, DefaultTopNValues)
+	require.Nil(t, s.Histogram)
+	require.Equal(t, 0.45, s.equalSelectivity(rowCount, 0, "a", false))
+	require.Equal(t, 0.5, s.nullSelectivity(rowCount))
+}
+
+func findScanNode(qry *plan.Query, tableName string) *plan.Node {
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.ObjRef != nil && node.ObjRef.ObjName == tableName {
+			return node
+		}
+	}
+	return nil
+}
+
+func newColumnStatsTestOptimizer() *MockOptimizer {
+	opt := NewMockOptimizer(false)
+	for name, cnt := range map[string]float64{"nation": 25, "region": 5, "supplier": 10000, "customer": 150000} {
+		opt.ctxt.stats[name] = &plan.Stats{
+			TableCnt:    cnt,
+			Outcnt:      cnt,
+			Cost:        cnt,
+			Selectivity: 1,
+			BlockNum:    1,
+		}
+	}
+
+	nationKey := NewColumnStatsBuilder(true)
+	for i := 0; i < 25; i++ {
+		nationKey.AddNum(float64(i), 1)
+	}
+	regionKey := NewColumnStatsBuilder(true)
+	for i := 0; i < 5; i++ {
+		cnt := 1.0
+		if i == 1 {
+			cnt = 21
+		}
+		regionKey.AddNum(float64(i), cnt)
+	}
+	opt.ctxt.columnStats = map[string]*TableColumnStats{
+		"nation": {
+			RowCount: 25,
+			Columns: map[string]*ColumnStats{
+				"n_nationkey": nationKey.Build(DefaultHistogramBuckets, DefaultTopNValues),
+				"n_regionkey": regionKey.Build(DefaultHistogramBuckets, DefaultTopNValues),
+			},
+		},
+		"supplier": {
+			RowCount: 10000,
+			Columns: map[string]*ColumnStats{
+				"s_nationkey": {NDV: 25},
+			},
+		},
+		"customer": {
+			RowCount: 150000,
+			Columns: map[string]*ColumnStats{
+				"c_nationkey": {NDV: 25},
+			},
+		},
+	}
+	return opt
+}
+
+func TestScanStatsByColumnStats(t *testing.T) {
+	opt := newColumnStatsTestOptimizer()
+	logicPlan, err := runOneStmt(opt, t, "select * from nation where n_regionkey = 1")
+	require.NoError(t, err)
+	node := findScanNode(logicPlan.GetQuery(), "nation")
+	require.NotNil(t, node)
+	require.Equal(t, float64(21), node.Stats.Outcnt)
+
+	logicPlan, err = runOneStmt(opt, t, "select * from nation where n_nationkey < 5")
+	require.NoError(t, err)
+	node = findScanNode(logicPlan.GetQuery(), "nation")
+	require.NotNil(t, node)
+	require.InDelta(t, 5, node.Stats.Outcnt, 1)
+}
+
+func TestJoinStatsByColumnStats(t *testing.T) {
+	opt := newColumnStatsTestOptimizer()
+	logicPlan, err := runOneStmt(opt, t, "select * from supplier, nation where s_nationkey = n_nationkey")
+	require.NoError(t, err)
+	qry := logicPlan.GetQuery()
+	var join *plan.Node
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_JOIN {
+			join = node
+		}
+	}
+	require.NotNil(t, join)
+	// each supplier matches one nation
+	require.InDelta(t, 10000, join.Stats.Outcnt, 1)
+}
+
+func TestJoinOrderByColumnStats(t *testing.T) {
+	opt := newColumnStatsTestOptimizer()
+	opt.ctxt.columnStats["supplier"].Columns["s_name"] = &ColumnStats{NDV: 25}
+	opt.ctxt.columnStats["customer"].Columns["c_name"] = &ColumnStats{NDV: 150000}
+	// nation joining customer on the unique name produces much less rows than joining
+	// supplier, so customer is joined first although it's larger.
+	sql := "select * from nation, supplier, customer where n_name = s_name and n_name = c_name and n_regionkey = 1"
+	logicPlan, err := runOneStmt(opt, t, sql)
+	require.NoError(t, err)
+	qry := logicPlan.GetQuery()
+
+	var joinTables func(nodeID int32) []string
+	joinTables = func(nodeID int32) []string {
+		node := qry.Nodes[nodeID]
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			return []string{node.ObjRef.ObjName}
+		}
+		var tables []string
+		for _, child := range node.Children {
+			tables = append(tables, joinTables(child)...)
+		}
+		return tables
+	}
+	var lowest *plan.Node
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_JOIN && len(joinTables(node.NodeId)) == 2 {
+			lowest = node
+		}
+	}
+	require.NotNil(t, lowest)
+	require.ElementsMatch(t, []string{"customer", "nation"}, joinTables(lowest.NodeId))
+}
@@ -24,6 +24,20 @@ func DeepCopyExprList(list []*Expr) []*Expr {
 	return newList
 }
 
+func DeepCopyRuntimeFilterSpecs(specs []*plan.RuntimeFilterSpec) []*plan.RuntimeFilterSpec {
+	if specs == nil {
+		return nil
+	}
+	newSpecs := make([]*plan.RuntimeFilterSpec, len(specs))
+	for i, spec := range specs {
+		newSpecs[i] = &plan.RuntimeFilterSpec{
+			Tag:  spec.Tag,
+			Expr: DeepCopyExpr(spec.Expr),
+		}
+	}
+	return newSpecs
+}
+
 func DeepCopyOrderBy(orderBy *plan.OrderBySpec) *plan.OrderBySpec {
 	if orderBy == nil {
 		return nil
@@ -35,6 +49,39 @@ func DeepCopyOrderBy(orderBy *plan.OrderBySpec) *plan.OrderBySpec {
 	}
 }
 
+func DeepCopyFrameBound(bound *plan.FrameBound) *plan.FrameBound {
+	if bound == nil {
+		return nil
+	}
+	return &plan.FrameBound{
+		Type:      bound.Type,
+		Unbounded: bound.Unbounded,
+		Val:       DeepCopyExpr(bound.Val),
+	}
+}
+
+func DeepCopyWindowSpec(spec *plan.WindowSpec) *plan.WindowSpec {
+	if spec == nil {
+		return nil
+	}
+	newSpec := &plan.WindowSpec{
+		WindowFunc:  DeepCopyExpr(spec.WindowFunc),
+		PartitionBy: DeepCopyExprList(spec.PartitionBy),
+		OrderBy:     make([]*plan.OrderBySpec, len(spec.OrderBy)),
+	}
+	for idx, orderBy := range spec.OrderBy {
+		newSpec.OrderBy[idx] = DeepCopyOrderBy(orderBy)
+	}
+	if spec.Frame != nil {
+		newSpec.Frame = &plan.FrameClause{
+			Type:  spec.Frame.Type,
+			Start: DeepCopyFrameBound(spec.Frame.Start),
+			End:   DeepCopyFrameBound(spec.Frame.End),
+		}
+	}
+	return newSpec
+}
+
 func DeepCopyObjectRef(ref *plan.ObjectRef) *plan.ObjectRef {
 	if ref == nil {
 		return nil
@@ -64,6 +111,8 @@ func DeepCopyInsertCtx(ctx *plan.InsertCtx) *plan.InsertCtx {
 		IdxRef: make([]*plan.ObjectRef, len(ctx.IdxRef)),
 
 		ClusterTable: DeepCopyClusterTable(ctx.ClusterTable),
+		IsReplace:    ctx.IsReplace,
+		RowIdx:       ctx.RowIdx,
 	}
 
 	copy(newCtx.OnDuplicateIdx, ctx.OnDuplicateIdx)
@@ -149,9 +198,25 @@ func DeepCopyDeleteCtx(ctx *plan.DeleteCtx) *plan.DeleteCtx {
 		}
 		newCtx.OnSetUpdateCol[i] = &plan.ColPosMap{Map: newMap}
 	}
+	newCtx.PartitionIdx = deepCopyColPosMaps(ctx.PartitionIdx)
 	return newCtx
 }
 
+func deepCopyColPosMaps(maps []*plan.ColPosMap) []*plan.ColPosMap {
+	if maps == nil {
+		return nil
+	}
+	newMaps := make([]*plan.ColPosMap, len(maps))
+	for i, m := range maps {
+		newMap := make(map[string]int32)
+		for k, v := range m.Map {
+			newMap[k] = v
+		}
+		newMaps[i] = &plan.ColPosMap{Map: newMap}
+	}
+	return newMaps
+}
+
 func DeepCopyUpdateCtx(ctx *plan.UpdateCtx) *plan.UpdateCtx {
 	if ctx == nil {
 		return nil
@@ -264,33 +329,67 @@ func DeepCopyUpdateCtx(ctx *plan.UpdateCtx) *plan.UpdateCtx {
 		}
 		newCtx.ParentIdx[i] = &plan.ColPosMap{Map: newMap}
 	}
+	newCtx.PartitionIdx = deepCopyColPosMaps(ctx.PartitionIdx)
 	return newCtx
 }
 
 func DeepCopyNode(node *plan.Node) *plan.Node {
 	newNode := &Node{
-		NodeType:        node.NodeType,
-		NodeId:          node.NodeId,
-		ExtraOptions:    node.ExtraOptions,
-		Children:        make([]int32, len(node.Children)),
-		JoinType:        node.JoinType,
-		BindingTags:     make([]int32, len(node.BindingTags)),
-		Limit:           DeepCopyExpr(node.Limit),
-		Offset:          DeepCopyExpr(node.Offset),
-		ProjectList:     make([]*plan.Expr, len(node.ProjectList)),
-		OnList:          make([]*plan.Expr, len(node.OnList)),
-		FilterList:      make([]*plan.Expr, len(node.FilterList)),
-		GroupBy:         make([]*plan.Expr, len(node.GroupBy)),
-		GroupingSet:     make([]*plan.Expr, len(node.GroupingSet)),
-		AggList:         make([]*plan.Expr, len(node.AggList)),
-		OrderBy:         make([]*plan.OrderBySpec, len(node.OrderBy)),
-		DeleteCtx:       DeepCopyDeleteCtx(node.DeleteCtx),
-		UpdateCtx:       DeepCopyUpdateCtx(node.UpdateCtx),
-		TableDefVec:     make([]*plan.TableDef, len(node.TableDefVec)),
-		TblFuncExprList: make([]*plan.Expr, len(node.TblFuncExprList)),
-		ClusterTable:    DeepCopyClusterTable(node.GetClusterTable()),
-		InsertCtx:       DeepCopyInsertCtx(node.InsertCtx),
+		NodeType:          node.NodeType,
+		NodeId:            node.NodeId,
+		ExtraOptions:      node.ExtraOptions,
+		Children:          make([]int32, len(node.Children)),
+		JoinType:          node.JoinType,
+		BindingTags:       make([]int32, len(node.BindingTags)),
+		Limit:             DeepCopyExpr(node.Limit),
+		Offset:            DeepCopyExpr(node.Offset),
+		ProjectList:       make([]*plan.Expr, len(node.ProjectList)),
+		OnList:            make([]*plan.Expr, len(node.OnList)),
+		FilterList:        make([]*plan.Expr, len(node.FilterList)),
+		GroupBy:           make([]*plan.Expr, len(node.GroupBy)),
+		GroupingSet:       make([]*plan.Expr, len(node.GroupingSet)),
+		AggList:           make([]*plan.Expr, len(node.AggList)),
+		OrderBy:           make([]*plan.OrderBySpec, len(node.OrderBy)),
+		DeleteCtx:         DeepCopyDeleteCtx(node.DeleteCtx),
+		UpdateCtx:         DeepCopyUpdateCtx(node.UpdateCtx),
+		TableDefVec:       make([]*plan.TableDef, len(node.TableDefVec)),
+		TblFuncExprList:   make([]*plan.Expr, len(node.TblFuncExprList)),
+		ClusterTable:      DeepCopyClusterTable(node.GetClusterTable()),
+		InsertCtx:         DeepCopyInsertCtx(node.InsertCtx),
+		WindowIdx:         node.WindowIdx,
+		UnionAll:          node.UnionAll,
+		MaxRecursionDepth: node.MaxRecursionDepth,
+	}
+	if node.PartitionPrune != nil {
+		newNode.PartitionPrune = &plan.PartitionPrune{
+			Partitions: make([]int32, len(node.PartitionPrune.Partitions)),
+		}
+		copy(newNode.PartitionPrune.Partitions, node.PartitionPrune.Partitions)
+	}
+	if node.LockTarget != nil {
+		newNode.LockTarget = &plan.LockTarget{
+			TableId:       node.LockTarget.TableId,
+			Mode:          node.LockTarget.Mode,
+			WaitPolicy:    node.LockTarget.WaitPolicy,
+			PrimaryKeyPos: node.LockTarget.PrimaryKeyPos,
+		}
+		if node.LockTarget.CompositeKeyPos != nil {
+			newNode.LockTarget.CompositeKeyPos = make([]int32, len(node.LockTarget.CompositeKeyPos))
+			copy(newNode.LockTarget.CompositeKeyPos, node.LockTarget.CompositeKeyPos)
+		}
+	}
+	if node.ScanTs != nil {
+		ts := *node.ScanTs
+		newNode.ScanTs = &ts
+	}
+	if node.IndexScan != nil {
+		newNode.IndexScan = &plan.IndexScan{
+			IndexName: node.IndexScan.IndexName,
+			TableName: node.IndexScan.TableName,
+		}
 	}
+	newNode.RuntimeFilterProbeList = DeepCopyRuntimeFilterSpecs(node.RuntimeFilterProbeList)
+	newNode.RuntimeFilterBuildList = DeepCopyRuntimeFilterSpecs(node.RuntimeFilterBuildList)
 
 	copy(newNode.Children, node.Children)
 	copy(newNode.BindingTags, node.BindingTags)
@@ -339,20 +438,7 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 
 	newNode.ObjRef = DeepCopyObjectRef(node.ObjRef)
 
-	if node.WinSpec != nil {
-		newNode.WinSpec = &plan.WindowSpec{
-			PartitionBy: make([]*plan.Expr, len(node.WinSpec.PartitionBy)),
-			OrderBy:     make([]*plan.OrderBySpec, len(node.WinSpec.OrderBy)),
-			Lead:        node.WinSpec.Lead,
-			Lag:         node.WinSpec.Lag,
-		}
-		for idx, pb := range node.WinSpec.PartitionBy {
-			newNode.WinSpec.PartitionBy[idx] = DeepCopyExpr(pb)
-		}
-		for idx, orderBy := range node.WinSpec.OrderBy {
-			newNode.WinSpec.OrderBy[idx] = DeepCopyOrderBy(orderBy)
-		}
-	}
+	newNode.WinSpec = DeepCopyWindowSpec(node.WinSpec)
 
 	if node.TableDef != nil {
 		newNode.TableDef = DeepCopyTableDef(node.TableDef)
@@ -490,12 +576,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		copy(newTable.TblFunc.Param, table.TblFunc.Param)
 	}
 
-	if table.Pkey != nil {
-		newTable.Pkey = &plan.PrimaryKeyDef{
-			Names: make([]string, len(table.Pkey.Names)),
-		}
-		copy(newTable.Pkey.Names, table.Pkey.Names)
-	}
+	newTable.Pkey = DeepCopyPrimaryKeyDef(table.Pkey)
 
 	if table.CompositePkey != nil {
 		newTable.CompositePkey = DeepCopyColDef(table.CompositePkey)
@@ -516,52 +597,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		}
 	}
 
-	if table.Partition != nil {
-		partitionDef := &plan.PartitionByDef{
-			Type:                table.Partition.GetType(),
-			PartitionExpression: table.Partition.GetPartitionExpression(),
-			PartitionNum:        table.Partition.GetPartitionNum(),
-			Partitions:          make([]*plan.PartitionItem, len(table.Partition.Partitions)),
-			Algorithm:           table.Partition.GetAlgorithm(),
-			IsSubPartition:      table.Partition.GetIsSubPartition(),
-			PartitionMsg:        table.Partition.GetPartitionMsg(),
-		}
-		if table.Partition.PartitionExpr != nil {
-			partitionDef.PartitionExpr = &plan.PartitionExpr{
-				Expr:    DeepCopyExpr(table.Partition.PartitionExpr.Expr),
-				ExprStr: table.Partition.PartitionExpr.GetExprStr(),
-			}
-		}
-
-		if table.Partition.PartitionColumns != nil {
-			partitionDef.PartitionColumns = &plan.PartitionColumns{
-				Columns:          make([]*plan.Expr, len(table.Partition.PartitionColumns.Columns)),
-				PartitionColumns: make([]string, len(table.Partition.PartitionColumns.PartitionColumns)),
-			}
-			for i, e := range table.Partition.PartitionColumns.Columns {
-				partitionDef.PartitionColumns.Columns[i] = DeepCopyExpr(e)
-			}
-			copy(partitionDef.PartitionColumns.PartitionColumns, table.Partition.PartitionColumns.PartitionColumns)
-		}
-
-		for i, e := range table.Partition.Partitions {
-			partitionDef.Partitions[i] = &plan.PartitionItem{
-				PartitionName:   e.PartitionName,
-				OrdinalPosition: e.OrdinalPosition,
-				Description:     e.Description,
-				Comment:         e.Comment,
-				LessThan:        make([]*plan.Expr, len(e.LessThan)),
-				InValues:        make([]*plan.Expr, len(e.InValues)),
-			}
-			for j, ee := range e.LessThan {
-				partitionDef.Partitions[i].LessThan[j] = DeepCopyExpr(ee)
-			}
-			for j, ee := range e.InValues {
-				partitionDef.Partitions[i].InValues[j] = DeepCopyExpr(ee)
-			}
-		}
-		newTable.Partition = partitionDef
-	}
+	newTable.Partition = DeepCopyPartitionByDef(table.Partition)
 
 	if table.Indexes != nil {
 		for i, indexdef := range table.Indexes {
@@ -690,6 +726,8 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 			AlterTable: &plan.AlterTable{
 				Table:    df.AlterTable.Table,
 				TableDef: DeepCopyTableDef(df.AlterTable.TableDef),
+				Database: df.AlterTable.Database,
+				Actions:  DeepCopyAlterTableActions(df.AlterTable.Actions),
 			},
 		}
 
@@ -743,6 +781,10 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 			IndexTableNames: make([]string, len(df.TruncateTable.IndexTableNames)),
 		}
 		copy(truncateTable.IndexTableNames, df.TruncateTable.IndexTableNames)
+		if df.TruncateTable.PartitionTableNames != nil {
+			truncateTable.PartitionTableNames = make([]string, len(df.TruncateTable.PartitionTableNames))
+			copy(truncateTable.PartitionTableNames, df.TruncateTable.PartitionTableNames)
+		}
 		newDf.Definition = &plan.DataDefinition_TruncateTable{
 			TruncateTable: truncateTable,
 		}
@@ -921,6 +963,57 @@ func DeepCopyExpr(expr *Expr) *Expr {
 	return newExpr
 }
 
+func DeepCopyPartitionByDef(partition *plan.PartitionByDef) *plan.PartitionByDef {
+	if partition == nil {
+		return nil
+	}
+	partitionDef := &plan.PartitionByDef{
+		Type:                partition.GetType(),
+		PartitionExpression: DeepCopyExpr(partition.GetPartitionExpression()),
+		PartitionNum:        partition.GetPartitionNum(),
+		Partitions:          make([]*plan.PartitionItem, len(partition.Partitions)),
+		Algorithm:           partition.GetAlgorithm(),
+		IsSubPartition:      partition.GetIsSubPartition(),
+		PartitionMsg:        partition.GetPartitionMsg(),
+	}
+	if partition.PartitionExpr != nil {
+		partitionDef.PartitionExpr = &plan.PartitionExpr{
+			Expr:    DeepCopyExpr(partition.PartitionExpr.Expr),
+			ExprStr: partition.PartitionExpr.GetExprStr(),
+		}
+	}
+
+	if partition.PartitionColumns != nil {
+		partitionDef.PartitionColumns = &plan.PartitionColumns{
+			Columns:          make([]*plan.Expr, len(partition.PartitionColumns.Columns)),
+			PartitionColumns: make([]string, len(partition.PartitionColumns.PartitionColumns)),
+		}
+		for i, e := range partition.PartitionColumns.Columns {
+			partitionDef.PartitionColumns.Columns[i] = DeepCopyExpr(e)
+		}
+		copy(partitionDef.PartitionColumns.PartitionColumns, partition.PartitionColumns.PartitionColumns)
+	}
+
+	for i, e := range partition.Partitions {
+		partitionDef.Partitions[i] = &plan.PartitionItem{
+			PartitionName:      e.PartitionName,
+			OrdinalPosition:    e.OrdinalPosition,
+			Description:        e.Description,
+			Comment:            e.Comment,
+			LessThan:           make([]*plan.Expr, len(e.LessThan)),
+			InValues:           make([]*plan.Expr, len(e.InValues)),
+			PartitionTableName: e.PartitionTableName,
+		}
+		for j, ee := range e.LessThan {
+			partitionDef.Partitions[i].LessThan[j] = DeepCopyExpr(ee)
+		}
+		for j, ee := range e.InValues {
+			partitionDef.Partitions[i].InValues[j] = DeepCopyExpr(ee)
+		}
+	}
+	return partitionDef
+}
+
 func DeepCopyClusterTable(cluster *plan.ClusterTable) *plan.ClusterTable {
 	if cluster == nil {
 		return nil
@@ -936,6 +1029,96 @@ func DeepCopyClusterTable(cluster *plan.ClusterTable) *plan.ClusterTable {
 	return newClusterTable
 }
 
+func DeepCopyAlterTableActions(actions []*plan.AlterTableAction) []*plan.AlterTableAction {
+	if actions == nil {
+		return nil
+	}
+
+	newActions := make([]*plan.AlterTableAction, len(actions))
+	for i, action := range actions {
+		newAction := &plan.AlterTableAction{}
+		switch act := action.Action.(type) {
+		case *plan.AlterTableAction_AddColumn:
+			newAction.Action = &plan.AlterTableAction_AddColumn{
+				AddColumn: &plan.AlterTableAddColumn{
+					Column: DeepCopyColDef(act.AddColumn.Column),
+				},
+			}
+		case *plan.AlterTableAction_DropColumn:
+			newAction.Action = &plan.AlterTableAction_DropColumn{
+				DropColumn: &plan.AlterTableDropColumn{
+					Name: act.DropColumn.Name,
+				},
+			}
+		case *plan.AlterTableAction_ModifyColumn:
+			newAction.Action = &plan.AlterTableAction_ModifyColumn{
+				ModifyColumn: &plan.AlterTableModifyColumn{
+					Column: DeepCopyColDef(act.ModifyColumn.Column),
+				},
+			}
+		case *plan.AlterTableAction_RenameColumn:
+			newAction.Action = &plan.AlterTableAction_RenameColumn{
+				RenameColumn: &plan.AlterTableRenameColumn{
+					OldName: act.RenameColumn.OldName,
+					NewName: act.RenameColumn.NewName,
+				},
+			}
+		case *plan.AlterTableAction_RenameTable:
+			newAction.Action = &plan.AlterTableAction_RenameTable{
+				RenameTable: &plan.AlterTableRenameTable{
+					OldName: act.RenameTable.OldName,
+					NewName: act.RenameTable.NewName,
+				},
+			}
+		case *plan.AlterTableAction_AddIndex:
+			newAction.Action = &plan.AlterTableAction_AddIndex{
+				AddIndex: &plan.CreateIndex{
+					Database: act.AddIndex.Database,
+					Table:    act.AddIndex.Table,
+					Index: &plan.CreateTable{
+						IfNotExists: act.AddIndex.Index.IfNotExists,
+						Temporary:   act.AddIndex.Index.Temporary,
+						Database:    act.AddIndex.Index.Database,
+						TableDef:    DeepCopyTableDef(act.AddIndex.Index.TableDef),
+					},
+					OriginTablePrimaryKey: act.AddIndex.OriginTablePrimaryKey,
+				},
+			}
+		case *plan.AlterTableAction_DropIndex:
+			newAction.Action = &plan.AlterTableAction_DropIndex{
+				DropIndex: &plan.DropIndex{
+					Database:       act.DropIndex.Database,
+					Table:          act.DropIndex.Table,
+					IndexName:      act.DropIndex.IndexName,
+					IndexTableName: act.DropIndex.IndexTableName,
+				},
+			}
+		case *plan.AlterTableAction_AddPartition:
+			newAction.Action = &plan.AlterTableAction_AddPartition{
+				AddPartition: &plan.AlterTableAddPartition{
+					Partition:           DeepCopyPartitionByDef(act.AddPartition.Partition),
+					PartitionTableNames: append([]string(nil), act.AddPartition.PartitionTableNames...),
+				},
+			}
+		case *plan.AlterTableAction_DropPartition:
+			newAction.Action = &plan.AlterTableAction_DropPartition{
+				DropPartition: &plan.AlterTableDropPartition{
+					Partition:           DeepCopyPartitionByDef(act.DropPartition.Partition),
+					PartitionTableNames: append([]string(nil), act.DropPartition.PartitionTableNames...),
+				},
+			}
+		case *plan.AlterTableAction_TruncatePartition:
+			newAction.Action = &plan.AlterTableAction_TruncatePartition{
+				TruncatePartition: &plan.AlterTableTruncatePartition{
+					PartitionTableNames: append([]string(nil), act.TruncatePartition.PartitionTableNames...),
+				},
+			}
+		}
+		newActions[i] = newAction
+	}
+	return newActions
+}
+
 func DeepCopyAnalyzeInfo(analyzeinfo *plan.AnalyzeInfo) *plan.AnalyzeInfo {
 	if analyzeinfo == nil {
 		return nil
@@ -0,0 +1,95 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarshalDot renders the plan as a Graphviz digraph. Every step is drawn as a
+// cluster, the edges follow the data flow from the child node to its parent,
+// and the runtime statistics of the nodes are shown if analyze is set.
+func (d *ExplainData) MarshalDot(analyze bool) string {
+	var buf strings.Builder
+	buf.WriteString("digraph plan {\n")
+	buf.WriteString("  rankdir=BT;\n")
+	buf.WriteString("  node [shape=box, fontname=\"Helvetica\"];\n")
+	for _, step := range d.Steps {
+		fmt.Fprintf(&buf, "  subgraph cluster_step%d {\n", step.Step)
+		fmt.Fprintf(&buf, "    label=\"Step %d\";\n", step.Step)
+		for i := range step.GraphData.Nodes {
+			node := &step.GraphData.Nodes[i]
+			fmt.Fprintf(&buf, "    n%s [label=\"%s\"];\n", node.NodeId, dotNodeLabel(node, analyze))
+		}
+		for _, edge := range step.GraphData.Edges {
+			if analyze {
+				fmt.Fprintf(&buf, "    n%s -> n%s [label=\"%d rows\"];\n", edge.Src, edge.Dst, edge.Output)
+			} else {
+				fmt.Fprintf(&buf, "    n%s -> n%s;\n", edge.Src, edge.Dst)
+			}
+		}
+		buf.WriteString("  }\n")
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// dotNodeLabel builds the left-justified multi-line label of a node.
+func dotNodeLabel(node *Node, analyze bool) string {
+	lines := []string{node.Name}
+	if node.Title != "" {
+		lines = append(lines, node.Title)
+	}
+	for _, label := range node.Labels {
+		lines = append(lines, label.Name+": "+dotLabelValue(label.Value))
+	}
+	if analyze {
+		stats := node.Statistics
+		for _, values := range [][]StatisticValue{stats.Time, stats.Throughput, stats.Memory, stats.IO, stats.Network} {
+			for _, v := range values {
+				lines = append(lines, fmt.Sprintf("%s: %d %s", v.Name, v.Value, v.Unit))
+			}
+		}
+	}
+	var buf strings.Builder
+	for _, line := range lines {
+		buf.WriteString(dotEscape(line))
+		buf.WriteString("\\l")
+	}
+	return buf.String()
+}
+
+func dotLabelValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+var dotReplacer = strings.NewReplacer(
+	"\\", "\\\\",
+	"\"", "\\\"",
+	"\n", "\\n",
+)
+
+// dotEscape escapes the characters which are special in a dot label.
+func dotEscape(s string) string {
+	return dotReplacer.Replace(s)
+}
@@ -17,6 +17,7 @@ package explain
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -119,7 +120,8 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 		switch ndesc.Node.NodeType {
 		case plan.Node_VALUE_SCAN:
 			result += " \"*VALUES*\" "
-		case plan.Node_TABLE_SCAN, plan.Node_FUNCTION_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_INSERT:
+		case plan.Node_TABLE_SCAN, plan.Node_FUNCTION_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_INSERT,
+			plan.Node_RECURSIVE_CTE, plan.Node_SINK_SCAN:
 			result += " on "
 			if ndesc.Node.ObjRef != nil {
 				result += ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.ObjRef.GetObjName()
@@ -262,6 +264,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, aggListInfo)
 	}
 
+	// Get Window function info
+	if ndesc.Node.NodeType == plan.Node_WINDOW && ndesc.Node.WinSpec != nil {
+		winSpecInfo, err := ndesc.GetWindowFunctionInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, winSpecInfo)
+	}
+
 	// Get Filter list info
 	if len(ndesc.Node.FilterList) > 0 {
 		filterInfo, err := ndesc.GetFilterConditionInfo(ctx, options)
@@ -271,6 +282,32 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, filterInfo)
 	}
 
+	// Get the partitions read by the scan of a partitioned table
+	if ndesc.Node.NodeType == plan.Node_TABLE_SCAN && ndesc.Node.PartitionPrune != nil && ndesc.Node.TableDef.Partition != nil {
+		lines = append(lines, ndesc.GetPartitionPruneInfo(ctx, options))
+	}
+
+	// Get the index looked up by the scan of an index table
+	if ndesc.Node.NodeType == plan.Node_TABLE_SCAN && ndesc.Node.IndexScan != nil {
+		lines = append(lines, ndesc.GetIndexScanInfo(ctx, options))
+	}
+
+	// Get the runtime filters generated by the join and evaluated by the scan
+	if options.Verbose && len(ndesc.Node.RuntimeFilterBuildList) > 0 {
+		filterInfo, err := ndesc.GetRuntimeFilterInfo(ctx, "Runtime Filter Build: ", ndesc.Node.RuntimeFilterBuildList, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, filterInfo)
+	}
+	if options.Verbose && len(ndesc.Node.RuntimeFilterProbeList) > 0 {
+		filterInfo, err := ndesc.GetRuntimeFilterInfo(ctx, "Runtime Filter Probe: ", ndesc.Node.RuntimeFilterProbeList, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, filterInfo)
+	}
+
 	// Get Limit And Offset info
 	if ndesc.Node.Limit != nil {
 		var temp string
@@ -302,6 +339,36 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 	return lines, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetPartitionPruneInfo(ctx context.Context, options *ExplainOptions) string {
+	partitions := ndesc.Node.TableDef.Partition.Partitions
+	names := make([]string, len(ndesc.Node.PartitionPrune.Partitions))
+	for i, idx := range ndesc.Node.PartitionPrune.Partitions {
+		names[i] = partitions[idx].PartitionName
+	}
+	if len(names) == 0 {
+		return "Partitions: none"
+	}
+	return "Partitions: " + strings.Join(names, ", ")
+}
+
+func (ndesc *NodeDescribeImpl) GetIndexScanInfo(ctx context.Context, options *ExplainOptions) string {
+	return "Index: " + ndesc.Node.IndexScan.IndexName + " on " + ndesc.Node.ObjRef.GetSchemaName() + "." + ndesc.Node.IndexScan.TableName
+}
+
+func (ndesc *NodeDescribeImpl) GetRuntimeFilterInfo(ctx context.Context, result string, specs []*plan.RuntimeFilterSpec, options *ExplainOptions) (string, error) {
+	for i, spec := range specs {
+		if i > 0 {
+			result += ", "
+		}
+		descV, err := describeExpr(ctx, spec.Expr, options)
+		if err != nil {
+			return result, err
+		}
+		result += "#" + strconv.Itoa(int(spec.Tag)) + " " + descV
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetProjectListInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	result := "Output: "
 	exprs := NewExprListDescribeImpl(ndesc.Node.ProjectList)
@@ -398,6 +465,16 @@ func (ndesc *NodeDescribeImpl) GetAggregationInfo(ctx context.Context, options *
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetWindowFunctionInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+	result := "Window Function: "
+	describe, err := NewWinSpecDescribeImpl(ndesc.Node.WinSpec).GetDescription(ctx, options)
+	if err != nil {
+		return result, err
+	}
+	result += describe
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetOrderByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	var result string
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -544,9 +621,76 @@ type WinSpecDescribeImpl struct {
 	WinSpec *plan.WindowSpec
 }
 
+func NewWinSpecDescribeImpl(winSpec *plan.WindowSpec) *WinSpecDescribeImpl {
+	return &WinSpecDescribeImpl{
+		WinSpec: winSpec,
+	}
+}
+
 func (w *WinSpecDescribeImpl) GetDescription(ctx context.Context, options *ExplainOptions) (string, error) {
-	// TODO implement me
-	panic("implement me")
+	var result string
+	if options.Format == EXPLAIN_FORMAT_TEXT || options.Format == EXPLAIN_FORMAT_JSON {
+		funcDesc, err := describeExpr(ctx, w.WinSpec.WindowFunc, options)
+		if err != nil {
+			return result, err
+		}
+		result += funcDesc + " OVER ("
+
+		if len(w.WinSpec.PartitionBy) > 0 {
+			result += "PARTITION BY "
+			first := true
+			for _, v := range w.WinSpec.PartitionBy {
+				if !first {
+					result += ", "
+				}
+				first = false
+				descV, err := describeExpr(ctx, v, options)
+				if err != nil {
+					return result, err
+				}
+				result += descV
+			}
+			result += " "
+		}
+
+		if len(w.WinSpec.OrderBy) > 0 {
+			orderByDesc, err := NewOrderByDescribeImpl(w.WinSpec.OrderBy).GetDescription(ctx, options)
+			if err != nil {
+				return result, err
+			}
+			result += "ORDER BY " + orderByDesc + " "
+		}
+
+		if frame := w.WinSpec.Frame; frame != nil {
+			startDesc, err := describeFrameBound(ctx, frame.Start, options)
+			if err != nil {
+				return result, err
+			}
+			endDesc, err := describeFrameBound(ctx, frame.End, options)
+			if err != nil {
+				return result, err
+			}
+			result += frame.Type.String() + " BETWEEN " + startDesc + " AND " + endDesc
+		}
+		result += ")"
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return result, moerr.NewNYI(ctx, "explain format dot")
+	}
+	return result, nil
+}
+
+func describeFrameBound(ctx context.Context, bound *plan.FrameBound, options *ExplainOptions) (string, error) {
+	if bound.Type == plan.FrameBound_CURRENT_ROW {
+		return "CURRENT ROW", nil
+	}
+	if bound.Unbounded {
+		return "UNBOUNDED " + bound.Type.String(), nil
+	}
+	offset, err := describeExpr(ctx, bound.Val, options)
+	if err != nil {
+		return "", err
+	}
+	return offset + " " + bound.Type.String(), nil
 }
 
 type RowsetDataDescribeImpl struct {
@@ -15,7 +15,10 @@
 package explain
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -36,6 +39,12 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	switch options.Format {
+	case EXPLAIN_FORMAT_JSON:
+		return e.explainPlanJson(ctx, buffer, options)
+	case EXPLAIN_FORMAT_DOT:
+		return e.explainPlanDot(ctx, buffer, options)
+	}
 	nodes := e.QueryPlan.Nodes
 	for index, rootNodeID := range e.QueryPlan.Steps {
 		logutil.Infof("------------------------------------Query Plan-%v ---------------------------------------------", index)
@@ -54,31 +63,27 @@ func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataB
 }
 
 func (e *ExplainQueryImpl) BuildJsonPlan(ctx context.Context, uuid uuid.UUID, options *ExplainOptions) *ExplainData {
+	expdata, err := e.buildExplainData(ctx, uuid, options)
+	if err != nil {
+		if moErr, ok := err.(*moerr.Error); ok {
+			return NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
+		}
+		newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
+		return NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
+	}
+	return expdata
+}
+
+func (e *ExplainQueryImpl) buildExplainData(ctx context.Context, uuid uuid.UUID, options *ExplainOptions) (*ExplainData, error) {
 	nodes := e.QueryPlan.Nodes
 	expdata := NewExplainData(uuid)
 	for index, rootNodeId := range e.QueryPlan.Steps {
 		graphData := NewGraphData()
-		err := PreOrderPlan(ctx, nodes[rootNodeId], nodes, graphData, options)
-		if err != nil {
-			var errdata *ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
+		if err := PreOrderPlan(ctx, nodes[rootNodeId], nodes, graphData, options); err != nil {
+			return nil, err
 		}
-		err = graphData.StatisticsGlobalResource(ctx)
-		if err != nil {
-			var errdata *ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
+		if err := graphData.StatisticsGlobalResource(ctx); err != nil {
+			return nil, err
 		}
 
 		step := NewStep(index)
@@ -86,7 +91,43 @@ func (e *ExplainQueryImpl) BuildJsonPlan(ctx context.Context, uuid uuid.UUID, op
 
 		expdata.Steps = append(expdata.Steps, *step)
 	}
-	return expdata
+	return expdata, nil
+}
+
+// explainPlanJson writes the plan serialized by BuildJsonPlan into a single line
+// of the buffer, the runtime statistics are included if options.Analyze is set.
+func (e *ExplainQueryImpl) explainPlanJson(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	expdata, err := e.buildExplainData(ctx, uuid.Nil, textOptions(options))
+	if err != nil {
+		return err
+	}
+	data := &bytes.Buffer{}
+	encoder := json.NewEncoder(data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(expdata); err != nil {
+		return moerr.NewInternalError(ctx, "serialize plan to json error: %s", err.Error())
+	}
+	buffer.PushNewLine(strings.TrimSuffix(data.String(), "\n"), true, 0)
+	return nil
+}
+
+// explainPlanDot writes the plan as a Graphviz digraph into a single line of the buffer.
+func (e *ExplainQueryImpl) explainPlanDot(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	expdata, err := e.buildExplainData(ctx, uuid.Nil, textOptions(options))
+	if err != nil {
+		return err
+	}
+	buffer.PushNewLine(expdata.MarshalDot(options.Analyze), true, 0)
+	return nil
+}
+
+// textOptions returns a copy of options whose expressions are described as text,
+// which is how the labels of the json and dot plans are rendered.
+func textOptions(options *ExplainOptions) *ExplainOptions {
+	opts := *options
+	opts.Format = EXPLAIN_FORMAT_TEXT
+	return &opts
 }
 
 func explainStep(ctx context.Context, step *plan.Node, settings *FormatSettings, options *ExplainOptions) error {
@@ -16,14 +16,17 @@ package explain
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	plan2 "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSingleSql(t *testing.T) {
@@ -177,6 +180,29 @@ func TestDerivedTableQuery(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+// Window function query
+func TestWindowQuery(t *testing.T) {
+	sqls := []string{
+		"explain select n_name, row_number() over (partition by n_regionkey order by n_nationkey) from nation",
+		"explain verbose select n_name, rank() over (order by n_regionkey desc) from nation",
+		"explain verbose select n_name, sum(n_nationkey) over (partition by n_regionkey order by n_nationkey rows between 1 preceding and 1 following) from nation",
+		"explain verbose select n_name, lag(n_name, 1, 'none') over (order by n_nationkey) from nation",
+		"explain verbose select * from (select n_name, row_number() over (order by n_nationkey) rn from nation) a where rn < 3",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestRecursiveCTEQuery(t *testing.T) {
+	sqls := []string{
+		"explain with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 10) select * from qn",
+		"explain verbose with recursive qn(n) as (select 1 union select n + 1 from qn where n < 10) select n from qn where n > 5",
+		"explain verbose with recursive qn(k, r) as (select n_nationkey, n_regionkey from nation where n_nationkey = 0 union all select n_nationkey, n_regionkey from nation, qn where n_regionkey = qn.k) select count(*) from qn",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
 // Collection query
 func TestCollectionQuery(t *testing.T) {
 	sqls := []string{
@@ -310,6 +336,59 @@ func TestMultiTableDeleteSQL(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestExplainFormat(t *testing.T) {
+	sqls := []string{
+		"explain (format json) SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_REGIONKEY > 0 ORDER BY N_NAME DESC LIMIT 10",
+		"explain format = json SELECT N_NAME,N_REGIONKEY FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY",
+		"explain (format dot) SELECT N_NAME, count(distinct N_REGIONKEY) FROM NATION group by N_NAME",
+		"explain format = dot select n_name from nation minus select n_name from nation2",
+		"explain (format json) with recursive c as (select 1 a union all select a+1 from c where a < 10) select * from c",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestExplainJsonAndDot(t *testing.T) {
+	mock := plan.NewMockOptimizer(false)
+	sql := "SELECT N_NAME, R_NAME FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY WHERE N_NAME != 'A\"B'"
+	logicPlan, err := runSingleSql(mock, t, sql)
+	require.NoError(t, err)
+	query := logicPlan.GetQuery()
+	for _, node := range query.Nodes {
+		node.AnalyzeInfo = &plan2.AnalyzeInfo{
+			InputRows:    12,
+			OutputRows:   7,
+			TimeConsumed: 5,
+		}
+	}
+	options := &ExplainOptions{Verbose: true, Analyze: true, Format: EXPLAIN_FORMAT_JSON}
+
+	buffer := NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(context.TODO(), buffer, options))
+	require.Equal(t, 1, len(buffer.Lines))
+	data := new(ExplainData)
+	require.NoError(t, json.Unmarshal([]byte(buffer.Lines[0]), data))
+	require.True(t, data.Success)
+	require.Equal(t, 1, len(data.Steps))
+	graph := data.Steps[0].GraphData
+	require.Equal(t, len(graph.Nodes)-1, len(graph.Edges))
+	require.Equal(t, int64(7), graph.Edges[0].Output)
+	require.Equal(t, int64(5), graph.Nodes[0].Statistics.Time[0].Value)
+
+	options.Format = EXPLAIN_FORMAT_DOT
+	buffer = NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(context.TODO(), buffer, options))
+	require.Equal(t, 1, len(buffer.Lines))
+	dot := buffer.Lines[0]
+	require.True(t, strings.HasPrefix(dot, "digraph plan {"))
+	for _, edge := range graph.Edges {
+		require.Contains(t, dot, "n"+edge.Src+" -> n"+edge.Dst+" [label=\"7 rows\"];")
+	}
+	require.Contains(t, dot, "Join")
+	require.Contains(t, dot, "Time Consumed: 5 ns")
+	require.Contains(t, dot, `A\"B`)
+}
+
 func runTestShouldPass(opt plan.Optimizer, t *testing.T, sqls []string) {
 	for _, sql := range sqls {
 		err := runOneStmt(opt, t, sql)
@@ -353,6 +432,8 @@ func runOneStmt(opt plan.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return moerr.NewInvalidInput(ctx, "explain format %v", v.Value)
 				}
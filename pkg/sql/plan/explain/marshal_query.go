@@ -239,8 +239,13 @@ func (m MarshalNodeImpl) GetNodeTitle(ctx context.Context, options *ExplainOptio
 		if err != nil {
 			return result, err
 		}
+	case plan.Node_WINDOW:
+		result, err = describeExpr(ctx, m.node.WinSpec.WindowFunc, options)
+		if err != nil {
+			return result, err
+		}
 	default:
-		return "", moerr.NewInternalError(ctx, "Unsupported node type when plan is serialized to json")
+		// nodes such as Distinct, Sink or Recursive CTE have no title
 	}
 	return strings.TrimSpace(result), nil
 }
@@ -438,6 +443,15 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 			Name:  "List of values",
 			Value: value,
 		})
+	case plan.Node_WINDOW:
+		value, err := NewWinSpecDescribeImpl(m.node.WinSpec).GetDescription(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, Label{
+			Name:  "Window function",
+			Value: value,
+		})
 	case plan.Node_UNION:
 		value, err := GetExprsLabelValue(ctx, m.node.ProjectList, options)
 		if err != nil {
@@ -484,7 +498,7 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 			Value: value,
 		})
 	default:
-		return nil, moerr.NewInternalError(ctx, "Unsupported node type when plan is serialized to json")
+		// the other nodes only carry the common labels below
 	}
 
 	if m.node.NodeType != plan.Node_FILTER && m.node.FilterList != nil {
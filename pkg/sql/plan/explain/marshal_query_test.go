@@ -200,6 +200,15 @@ func TestCollectionQueryToJson(t *testing.T) {
 	buildPlanMarshalTest(mock, t, sqls)
 }
 
+func TestWindowQueryToJson(t *testing.T) {
+	sqls := []string{
+		"select n_name, row_number() over (partition by n_regionkey order by n_nationkey) from nation",
+		"select n_name, max(n_nationkey) over (order by n_nationkey range between 2 preceding and current row) from nation",
+	}
+	mock := plan.NewMockOptimizer(false)
+	buildPlanMarshalTest(mock, t, sqls)
+}
+
 func TestDerivedTableQueryToJson(t *testing.T) {
 	// should pass
 	sqls := []string{
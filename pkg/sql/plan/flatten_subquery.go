@@ -432,6 +432,11 @@ func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindC
 			builder.pullupThroughProj(ctx, node, projectTag, pred)
 		}
 
+	case plan.Node_WINDOW:
+		if len(preds) > 0 {
+			return 0, nil, moerr.NewNYI(builder.GetContext(), "correlated columns below window function")
+		}
+
 	case plan.Node_FILTER:
 		var newFilterList []*plan.Expr
 		for _, cond := range node.FilterList {
@@ -25,12 +25,14 @@ import (
 // aggregates,	see initAggregateFunction
 // builtins,	see initBuiltIns
 // operators,	see initOperators
+// windows,	see initWindowFunction
 func init() {
 	initRelatedStructure()
 
 	initOperators()
 	initBuiltIns()
 	initAggregateFunction()
+	initWindowFunction()
 
 	initTypeCheckRelated()
 }
@@ -0,0 +1,179 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func initWindowFunction() {
+	var err error
+
+	for fid, fs := range windows {
+		err = appendFunction(context.Background(), fid, fs)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// windows contains the pure window functions indexed by function id.
+// aggregate functions can be used as window functions as well,
+// they are registered at aggregates.
+// window functions are computed by the window operator, so none of them
+// has a Fn, and all of them are volatile to avoid being folded.
+var windows = map[int]Functions{
+	ROW_NUMBER: {
+		Id:          ROW_NUMBER,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: windowTypeCheckWithoutArgs,
+		Overloads: []Function{
+			{
+				Index:             0,
+				Volatile:          true,
+				ResultWillNotNull: true,
+				ReturnTyp:         types.T_int64,
+			},
+		},
+	},
+	RANK: {
+		Id:          RANK,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: windowTypeCheckWithoutArgs,
+		Overloads: []Function{
+			{
+				Index:             0,
+				Volatile:          true,
+				ResultWillNotNull: true,
+				ReturnTyp:         types.T_int64,
+			},
+		},
+	},
+	DENSE_RANK: {
+		Id:          DENSE_RANK,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: windowTypeCheckWithoutArgs,
+		Overloads: []Function{
+			{
+				Index:             0,
+				Volatile:          true,
+				ResultWillNotNull: true,
+				ReturnTyp:         types.T_int64,
+			},
+		},
+	},
+	LAG: {
+		Id:          LAG,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: windowTypeCheckForLagLead,
+		Overloads: []Function{
+			{
+				Index:              0,
+				Volatile:           true,
+				FlexibleReturnType: windowReturnFirstArgType,
+			},
+		},
+	},
+	LEAD: {
+		Id:          LEAD,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: windowTypeCheckForLagLead,
+		Overloads: []Function{
+			{
+				Index:              0,
+				Volatile:           true,
+				FlexibleReturnType: windowReturnFirstArgType,
+			},
+		},
+	},
+	FIRST_VALUE: {
+		Id:          FIRST_VALUE,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: windowTypeCheckForValue,
+		Overloads: []Function{
+			{
+				Index:              0,
+				Volatile:           true,
+				FlexibleReturnType: windowReturnFirstArgType,
+			},
+		},
+	},
+	LAST_VALUE: {
+		Id:          LAST_VALUE,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: windowTypeCheckForValue,
+		Overloads: []Function{
+			{
+				Index:              0,
+				Volatile:           true,
+				FlexibleReturnType: windowReturnFirstArgType,
+			},
+		},
+	},
+}
+
+func windowReturnFirstArgType(parameters []types.Type) types.Type {
+	return parameters[0]
+}
+
+func windowTypeCheckWithoutArgs(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 0 {
+		return 0, nil
+	}
+	return wrongFunctionParameters, nil
+}
+
+func windowTypeCheckForValue(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 1 {
+		return 0, nil
+	}
+	return wrongFunctionParameters, nil
+}
+
+// windowTypeCheckForLagLead accepts lag(expr [, offset [, default]]),
+// the offset will be cast to int64 and the default value will be cast to the type of expr.
+func windowTypeCheckForLagLead(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) < 1 || len(inputs) > 3 {
+		return wrongFunctionParameters, nil
+	}
+	if len(inputs) == 1 {
+		return 0, nil
+	}
+	if inputs[1] != ScalarNull && !inputs[1].ToType().IsIntOrUint() {
+		return wrongFunctionParameters, nil
+	}
+	ts = make([]types.T, len(inputs))
+	copy(ts, inputs)
+	ts[1] = types.T_int64
+	if len(inputs) == 3 && inputs[0] != ScalarNull {
+		if inputs[2] != ScalarNull && inputs[2] != inputs[0] {
+			if !castTable[inputs[2]][inputs[0]] {
+				return wrongFunctionParameters, nil
+			}
+		}
+		ts[2] = inputs[0]
+	}
+	return 0, ts
+}
@@ -0,0 +1,376 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+)
+
+const (
+	// the index is used to look up the rows of the table if the filters on the
+	// index columns select no more than this ratio of the rows
+	indexScanSelectivity = 0.1
+	// the selectivity of the equality filters on the columns of a secondary index
+	// if they can't be estimated by the column statistics
+	defaultIndexEqualSelectivity = 0.01
+)
+
+// indexScanCandidate is an index which can be used to look up the rows of a table scan
+type indexScanCandidate struct {
+	indexDef   *IndexDef
+	indexRef   *ObjectRef
+	indexTable *TableDef
+	keyPos     int32
+	priPos     int32
+	// filters are the positions of the filters of the table scan which are
+	// evaluated on the index table instead
+	filters []int
+	// keyValues are the values of the index columns compared by the equality
+	// filters, they are only used by the index on several columns
+	keyValues   []*Expr
+	selectivity float64
+}
+
+// applyIndices replaces the scans of the tables which have selective filters on the
+// columns of an index by the scans of the index tables joined with the tables on the
+// primary keys, so the rows are looked up by the index instead of the filters.
+func (builder *QueryBuilder) applyIndices(nodeID int32) int32 {
+	node := builder.qry.Nodes[nodeID]
+	for i, childID := range node.Children {
+		node.Children[i] = builder.applyIndices(childID)
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || !canScanByIndex(node) {
+		return nodeID
+	}
+	var best *indexScanCandidate
+	for _, indexDef := range node.TableDef.Indexes {
+		candidate := builder.getIndexScanCandidate(node, indexDef)
+		if candidate != nil && (best == nil || candidate.selectivity < best.selectivity) {
+			best = candidate
+		}
+	}
+	if best == nil {
+		return nodeID
+	}
+	return builder.buildIndexScan(nodeID, best)
+}
+
+func canScanByIndex(node *plan.Node) bool {
+	tableDef := node.TableDef
+	if node.ObjRef == nil || tableDef == nil || tableDef.Pkey == nil || len(tableDef.Indexes) == 0 || len(node.FilterList) == 0 {
+		return false
+	}
+	// the rows locked or read at a timestamp are read from the table itself
+	if node.LockTarget != nil || node.ScanTs != nil || node.IndexScan != nil {
+		return false
+	}
+	_, ok := tableDef.Name2ColIndex[tableDef.Pkey.PkeyColName]
+	return ok
+}
+
+// getIndexScanCandidate returns the candidate if the index can be used by the table scan
+// and its filters on the index columns are selective enough, otherwise nil.
+func (builder *QueryBuilder) getIndexScanCandidate(node *plan.Node, indexDef *IndexDef) *indexScanCandidate {
+	if !indexDef.TableExist || indexDef.IndexTableName == "" || len(indexDef.Parts) == 0 {
+		return nil
+	}
+	indexRef, indexTable := builder.compCtx.Resolve(node.ObjRef.SchemaName, indexDef.IndexTableName)
+	if indexTable == nil {
+		return nil
+	}
+	candidate := &indexScanCandidate{
+		indexDef:   indexDef,
+		indexRef:   indexRef,
+		indexTable: indexTable,
+		keyPos:     -1,
+		priPos:     -1,
+	}
+	for i, col := range indexTable.Cols {
+		switch col.Name {
+		case catalog.IndexTableIndexColName:
+			candidate.keyPos = int32(i)
+		case catalog.IndexTablePrimaryColName:
+			candidate.priPos = int32(i)
+		}
+	}
+	if candidate.keyPos < 0 || candidate.priPos < 0 {
+		return nil
+	}
+
+	tag := node.BindingTags[0]
+	partPos := make([]int32, len(indexDef.Parts))
+	for i, part := range indexDef.Parts {
+		pos, ok := node.TableDef.Name2ColIndex[part]
+		if !ok {
+			return nil
+		}
+		partPos[i] = pos
+	}
+
+	hasEqual := false
+	if len(partPos) == 1 {
+		// the key of the index table is the value of the column, the filters
+		// on the column can be evaluated on the key directly
+		for i, filter := range node.FilterList {
+			if equal, ok := isIndexKeyFilter(filter, tag, partPos[0]); ok {
+				candidate.filters = append(candidate.filters, i)
+				hasEqual = hasEqual || equal
+			}
+		}
+	} else {
+		// the key of the index table is the serialized values of the columns,
+		// only the equality filters on all the columns can be evaluated on it
+		candidate.keyValues = make([]*Expr, len(partPos))
+		for i, filter := range node.FilterList {
+			for j, pos := range partPos {
+				if candidate.keyValues[j] != nil {
+					continue
+				}
+				if value := getEqualValueOfColumn(filter, tag, pos); value != nil {
+					candidate.keyValues[j] = value
+					candidate.filters = append(candidate.filters, i)
+					break
+				}
+			}
+		}
+		for _, value := range candidate.keyValues {
+			if value == nil {
+				return nil
+			}
+		}
+		hasEqual = true
+	}
+	if len(candidate.filters) == 0 {
+		return nil
+	}
+
+	if sel, ok := builder.estimateIndexFilterSelectivity(node, candidate.filters); ok {
+		candidate.selectivity = sel
+	} else if !hasEqual {
+		// the selectivity of the range filters is unknown without the statistics
+		return nil
+	} else if indexDef.Unique {
+		candidate.selectivity = 0
+	} else {
+		candidate.selectivity = defaultIndexEqualSelectivity
+	}
+	if candidate.selectivity > indexScanSelectivity {
+		return nil
+	}
+	return candidate
+}
+
+// isIndexKeyFilter returns true if the filter compares the column with constants,
+// equal is true if the filter is = or in.
+func isIndexKeyFilter(filter *Expr, tag int32, colPos int32) (equal bool, ok bool) {
+	f, isFunc := filter.Expr.(*plan.Expr_F)
+	if !isFunc || len(f.F.Args) != 2 {
+		return false, false
+	}
+	args := f.F.Args
+	switch op := f.F.Func.GetObjName(); op {
+	case "=", "<", "<=", ">", ">=":
+		if isColumnOfScan(args[0], tag, colPos) && rule.IsConstant(args[1]) ||
+			isColumnOfScan(args[1], tag, colPos) && rule.IsConstant(args[0]) {
+			return op == "=", true
+		}
+	case "in":
+		list, isList := args[1].Expr.(*plan.Expr_List)
+		if !isList || !isColumnOfScan(args[0], tag, colPos) {
+			return false, false
+		}
+		for _, e := range list.List.List {
+			if !rule.IsConstant(e) {
+				return false, false
+			}
+		}
+		return true, true
+	}
+	return false, false
+}
+
+// getEqualValueOfColumn returns the constant if the filter is col = constant
+func getEqualValueOfColumn(filter *Expr, tag int32, colPos int32) *Expr {
+	f, isFunc := filter.Expr.(*plan.Expr_F)
+	if !isFunc || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+		return nil
+	}
+	args := f.F.Args
+	if isColumnOfScan(args[0], tag, colPos) && rule.IsConstant(args[1]) {
+		return args[1]
+	}
+	if isColumnOfScan(args[1], tag, colPos) && rule.IsConstant(args[0]) {
+		return args[0]
+	}
+	return nil
+}
+
+func isColumnOfScan(expr *Expr, tag int32, colPos int32) bool {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	return ok && col.Col.RelPos == tag && col.Col.ColPos == colPos
+}
+
+// estimateIndexFilterSelectivity estimates the selectivity of the filters by the column
+// statistics, ok is false if any of them can't be estimated.
+func (builder *QueryBuilder) estimateIndexFilterSelectivity(node *plan.Node, filters []int) (float64, bool) {
+	stats := builder.getScanColumnStats(node)
+	if stats == nil {
+		return 1, false
+	}
+	sel := 1.0
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	for _, i := range filters {
+		filter := node.FilterList[i]
+		if expr, err := ConstantFold(bat, DeepCopyExpr(filter), builder.compCtx.GetProcess()); err == nil && expr != nil {
+			filter = expr
+		}
+		s, ok := estimateSelectivityByColumnStats(filter, node, stats)
+		if !ok {
+			return 1, false
+		}
+		sel *= s
+	}
+	return sel, true
+}
+
+// buildIndexScan builds the scan of the index table by the filters of the table scan
+// on the index columns, and joins it with the table scan on the primary key.
+func (builder *QueryBuilder) buildIndexScan(nodeID int32, candidate *indexScanCandidate) int32 {
+	node := builder.qry.Nodes[nodeID]
+	ctx := builder.ctxByNode[nodeID]
+	tag := node.BindingTags[0]
+	indexTag := builder.genNewTag()
+	indexTable := candidate.indexTable
+
+	keyCol := &plan.ColRef{
+		RelPos: indexTag,
+		ColPos: candidate.keyPos,
+		Name:   indexTable.Name + "." + catalog.IndexTableIndexColName,
+	}
+	var keyFilters []*Expr
+	if candidate.keyValues == nil {
+		// the key has the same values as the column, so it's compared as the column
+		projects := make([]*Expr, len(node.TableDef.Cols))
+		partPos := node.TableDef.Name2ColIndex[candidate.indexDef.Parts[0]]
+		projects[partPos] = &plan.Expr{
+			Typ:  node.TableDef.Cols[partPos].Typ,
+			Expr: &plan.Expr_Col{Col: keyCol},
+		}
+		for _, i := range candidate.filters {
+			keyFilters = append(keyFilters, replaceColRefs(DeepCopyExpr(node.FilterList[i]), tag, projects))
+		}
+	} else {
+		args := make([]*Expr, len(candidate.keyValues))
+		for i, value := range candidate.keyValues {
+			// the values are serialized as the columns
+			colTyp := node.TableDef.Cols[node.TableDef.Name2ColIndex[candidate.indexDef.Parts[i]]].Typ
+			arg := DeepCopyExpr(value)
+			if arg.Typ.Id != colTyp.Id {
+				var err error
+				if arg, err = appendCastBeforeExpr(builder.GetContext(), arg, colTyp); err != nil {
+					return nodeID
+				}
+			}
+			args[i] = arg
+		}
+		serialExpr, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "serial", args)
+		if err != nil {
+			return nodeID
+		}
+		keyFilter, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{
+			{
+				Typ:  indexTable.Cols[candidate.keyPos].Typ,
+				Expr: &plan.Expr_Col{Col: keyCol},
+			},
+			serialExpr,
+		})
+		if err != nil {
+			return nodeID
+		}
+		keyFilters = append(keyFilters, keyFilter)
+	}
+
+	pkPos := node.TableDef.Name2ColIndex[node.TableDef.Pkey.PkeyColName]
+	joinCond, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{
+		{
+			Typ: node.TableDef.Cols[pkPos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: pkPos,
+					Name:   builder.nameByColRef[[2]int32{tag, pkPos}],
+				},
+			},
+		},
+		{
+			Typ: indexTable.Cols[candidate.priPos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: indexTag,
+					ColPos: candidate.priPos,
+					Name:   indexTable.Name + "." + catalog.IndexTablePrimaryColName,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nodeID
+	}
+
+	indexTable.Name2ColIndex = make(map[string]int32)
+	for i, col := range indexTable.Cols {
+		indexTable.Name2ColIndex[col.Name] = int32(i)
+		builder.nameByColRef[[2]int32{indexTag, int32(i)}] = indexTable.Name + "." + col.Name
+	}
+	indexScanID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		ObjRef:      candidate.indexRef,
+		TableDef:    indexTable,
+		FilterList:  keyFilters,
+		BindingTags: []int32{indexTag},
+		IndexScan: &plan.IndexScan{
+			IndexName: candidate.indexDef.IndexName,
+			TableName: node.TableDef.Name,
+		},
+	}, ctx)
+	if builder.qry.Nodes[indexScanID].Stats == nil {
+		builder.qry.Nodes[indexScanID].Stats = DefaultStats()
+	}
+
+	// the filters evaluated on the index table are removed from the table scan
+	evaluated := make(map[int]bool, len(candidate.filters))
+	for _, i := range candidate.filters {
+		evaluated[i] = true
+	}
+	filters := make([]*Expr, 0, len(node.FilterList)-len(evaluated))
+	for i, filter := range node.FilterList {
+		if !evaluated[i] {
+			filters = append(filters, filter)
+		}
+	}
+	node.FilterList = filters
+	ReCalcNodeStats(nodeID, builder, false)
+
+	return builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		JoinType: plan.Node_INNER,
+		Children: []int32{nodeID, indexScanID},
+		OnList:   []*Expr{joinCond},
+	}, ctx)
+}
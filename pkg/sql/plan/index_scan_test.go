@@ -0,0 +1,199 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// addTableWithIndexes adds the table created by the sql and its index tables to the mock catalog
+func addTableWithIndexes(t *testing.T, opt *MockOptimizer, name string, sql string) *TableDef {
+	logicPlan, err := runOneStmt(opt, t, sql)
+	require.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	addTableDef(opt, name, createTable.GetTableDef())
+	for _, indexTable := range createTable.GetIndexTables() {
+		addTableDef(opt, indexTable.Name, indexTable)
+	}
+	return createTable.GetTableDef()
+}
+
+func newIndexScanTestOptimizer(t *testing.T) *MockOptimizer {
+	opt := NewMockOptimizer(false)
+	addTableWithIndexes(t, opt, "t_idx", `create table t_idx (a int primary key, b int, c varchar(20), d int, e int,
+		key idx_b (b), key idx_cd (c, d))`)
+	return opt
+}
+
+func findIndexScanNode(qry *plan.Query) *plan.Node {
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.IndexScan != nil {
+			return node
+		}
+	}
+	return nil
+}
+
+func TestSecondaryIndexTable(t *testing.T) {
+	opt := NewMockOptimizer(false)
+	logicPlan, err := runOneStmt(opt, t, "create table t1 (a int primary key, b int, c varchar(20), key(b), key(b, c))")
+	require.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	indexes := createTable.GetTableDef().Indexes
+	require.Equal(t, 2, len(indexes))
+	require.Equal(t, 2, len(createTable.GetIndexTables()))
+	for i, indexDef := range indexes {
+		require.False(t, indexDef.Unique)
+		require.True(t, indexDef.TableExist)
+		require.True(t, strings.HasPrefix(indexDef.IndexTableName, catalog.PrefixIndexTableName+"secondary_"))
+
+		indexTable := createTable.GetIndexTables()[i]
+		require.Equal(t, indexDef.IndexTableName, indexTable.Name)
+		require.Equal(t, 2, len(indexTable.Cols))
+		require.Equal(t, catalog.IndexTableIndexColName, indexTable.Cols[0].Name)
+		require.Equal(t, catalog.IndexTablePrimaryColName, indexTable.Cols[1].Name)
+		// several rows may share the same key
+		require.Nil(t, indexTable.Pkey)
+	}
+
+	// the index of a table without primary key only records the metadata
+	logicPlan, err = runOneStmt(opt, t, "create table t2 (a int, b int, key(b))")
+	require.NoError(t, err)
+	createTable = logicPlan.GetDdl().GetCreateTable()
+	require.Empty(t, createTable.GetIndexTables())
+	require.False(t, createTable.GetTableDef().Indexes[0].TableExist)
+	require.Empty(t, createTable.GetTableDef().Indexes[0].IndexTableName)
+}
+
+func TestIndexScan(t *testing.T) {
+	opt := newIndexScanTestOptimizer(t)
+	tableDef := opt.ctxt.tables["t_idx"]
+
+	cases := map[string]string{
+		"select * from t_idx where b = 1":                  "idx_b",
+		"select * from t_idx where b in (1, 2, 3)":         "idx_b",
+		"select * from t_idx where 1 = b and e > 1":        "idx_b",
+		"select * from t_idx where c = 'x' and d = 1":      "idx_cd",
+		"select a from t_idx where d = 1 and c = 'x' or 1": "",
+		"select * from t_idx where c = 'x'":                "",
+		"select * from t_idx where b > 1":                  "",
+		"select * from t_idx where b + 1 = 2":              "",
+		"select * from t_idx where e = 1":                  "",
+		"select * from t_idx":                              "",
+		"select * from t_idx where b = 1 for update":       "",
+	}
+	for sql, indexName := range cases {
+		logicPlan, err := runOneStmt(opt, t, sql)
+		require.NoError(t, err, sql)
+		qry := logicPlan.GetQuery()
+		node := findIndexScanNode(qry)
+		if indexName == "" {
+			require.Nil(t, node, sql)
+			continue
+		}
+		require.NotNil(t, node, sql)
+		require.Equal(t, indexName, node.IndexScan.IndexName, sql)
+		require.Equal(t, "t_idx", node.IndexScan.TableName, sql)
+		require.NotEmpty(t, node.FilterList, sql)
+
+		var indexTableName string
+		for _, indexDef := range tableDef.Indexes {
+			if indexDef.IndexName == indexName {
+				indexTableName = indexDef.IndexTableName
+			}
+		}
+		require.Equal(t, indexTableName, node.ObjRef.ObjName, sql)
+
+		// the rows of the table are looked up by the primary keys from the index
+		var join *plan.Node
+		for _, n := range qry.Nodes {
+			if n.NodeType == plan.Node_JOIN {
+				for _, child := range n.Children {
+					if child == node.NodeId {
+						join = n
+					}
+				}
+			}
+		}
+		require.NotNil(t, join, sql)
+		require.Equal(t, 1, len(join.OnList), sql)
+
+		// the filters on the index columns are moved to the index scan
+		scan := findScanNode(qry, "t_idx")
+		require.NotNil(t, scan, sql)
+		for _, filter := range scan.FilterList {
+			for _, col := range getIndexScanTestColNames(filter) {
+				require.NotEqual(t, "b", col, sql)
+			}
+		}
+	}
+
+	// the multi-part key is matched by the serialized values
+	logicPlan, err := runOneStmt(opt, t, "select * from t_idx where c = 'x' and d = 1")
+	require.NoError(t, err)
+	node := findIndexScanNode(logicPlan.GetQuery())
+	require.NotNil(t, node)
+	require.Equal(t, 1, len(node.FilterList))
+	require.Contains(t, node.FilterList[0].String(), "serial")
+}
+
+func getIndexScanTestColNames(expr *Expr) []string {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return []string{e.Col.Name}
+	case *plan.Expr_F:
+		var names []string
+		for _, arg := range e.F.Args {
+			names = append(names, getIndexScanTestColNames(arg)...)
+		}
+		return names
+	}
+	return nil
+}
+
+func TestIndexScanByColumnStats(t *testing.T) {
+	opt := newIndexScanTestOptimizer(t)
+	b := NewColumnStatsBuilder(true)
+	for i := 0; i < 1000; i++ {
+		b.AddNum(float64(i), 1)
+	}
+	opt.ctxt.columnStats = map[string]*TableColumnStats{
+		"t_idx": {
+			RowCount: 1000,
+			Columns: map[string]*ColumnStats{
+				"b": b.Build(DefaultHistogramBuckets, DefaultTopNValues),
+				"c": {NDV: 2},
+			},
+		},
+	}
+
+	// the selective range uses the index while the others scan the table
+	cases := map[string]bool{
+		"select * from t_idx where b < 10":            true,
+		"select * from t_idx where b > 500":           false,
+		"select * from t_idx where b = 1":             true,
+		"select * from t_idx where c = 'x' and d = 1": true,
+	}
+	for sql, useIndex := range cases {
+		logicPlan, err := runOneStmt(opt, t, sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, useIndex, findIndexScanNode(logicPlan.GetQuery()) != nil, sql)
+	}
+}
@@ -304,13 +304,33 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 
 		eligible := adjMat[firstConnected*nLeaf : (firstConnected+1)*nLeaf]
 
+		// with the column statistics, join the leaf producing the least rows first
+		costBased := builder.hasColumnStats()
+		var joinedTags map[int32]bool
+		var joinedOutcnt float64
+		if costBased {
+			joinedTags = make(map[int32]bool)
+			for _, tag := range builder.enumerateTags(nodeID) {
+				joinedTags[tag] = true
+			}
+			joinedOutcnt = subTrees[firstConnected].Stats.Outcnt
+		}
+
 		for {
 			nextSibling := nLeaf
+			var nextOutcnt float64
 			for i := range eligible {
-				if !visited[i] && eligible[i] {
+				if visited[i] || !eligible[i] {
+					continue
+				}
+				if !costBased {
 					nextSibling = int32(i)
 					break
 				}
+				outcnt := builder.estimateJoinOutcnt(conds, joinedTags, joinedOutcnt, subTrees[i])
+				if nextSibling == nLeaf || outcnt < nextOutcnt {
+					nextSibling, nextOutcnt = int32(i), outcnt
+				}
 			}
 
 			if nextSibling == nLeaf {
@@ -318,6 +338,12 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 			}
 
 			visited[nextSibling] = true
+			if costBased {
+				for _, tag := range builder.enumerateTags(subTrees[nextSibling].NodeId) {
+					joinedTags[tag] = true
+				}
+				joinedOutcnt = nextOutcnt
+			}
 
 			children := []int32{nodeID, subTrees[nextSibling].NodeId}
 			children, _ = builder.swapJoinOrderByStatsUsedForInner(children, plan.Node_INNER)
@@ -362,6 +388,38 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 	return nodeID
 }
 
+// estimateJoinOutcnt estimates the output of joining the leaf with the joined leaves
+func (builder *QueryBuilder) estimateJoinOutcnt(conds []*plan.Expr, joinedTags map[int32]bool, joinedOutcnt float64, leaf *plan.Node) float64 {
+	leafOutcnt := leaf.Stats.Outcnt
+	leafTags := make(map[int32]bool)
+	for _, tag := range builder.enumerateTags(leaf.NodeId) {
+		leafTags[tag] = true
+	}
+
+	var edges []*plan.Expr
+	for _, cond := range conds {
+		f, ok := cond.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 2 {
+			continue
+		}
+		l, lok := f.F.Args[0].Expr.(*plan.Expr_Col)
+		r, rok := f.F.Args[1].Expr.(*plan.Expr_Col)
+		if !lok || !rok {
+			continue
+		}
+		if (joinedTags[l.Col.RelPos] && leafTags[r.Col.RelPos]) || (joinedTags[r.Col.RelPos] && leafTags[l.Col.RelPos]) {
+			edges = append(edges, cond)
+		}
+	}
+
+	ndv, ok := builder.estimateJoinNdv(edges, joinedTags, joinedOutcnt, leafOutcnt)
+	if !ok {
+		// take the join as a join on the key of one side
+		ndv = math.Max(math.Max(joinedOutcnt, leafOutcnt), 1)
+	}
+	return joinedOutcnt * leafOutcnt / ndv
+}
+
 func (builder *QueryBuilder) gatherJoinLeavesAndConds(joinNode *plan.Node, leaves []*plan.Node, conds []*plan.Expr) ([]*plan.Node, []*plan.Expr) {
 	if joinNode.NodeType != plan.Node_JOIN || joinNode.JoinType != plan.Node_INNER {
 		nodeID := builder.determineJoinOrder(joinNode.NodeId)
@@ -0,0 +1,132 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// setLockTarget marks the table scans under the node to lock the rows they read. If
+// tables is not nil, only the scans of the tables in it are marked. The rows are locked
+// by their primary key, so the tables without a primary key are not locked. FOR SHARE
+// locks the rows in the Shared mode, which only conflicts with the Exclusive locks.
+func (builder *QueryBuilder) setLockTarget(nodeID int32, tables map[uint64]struct{}, lock *tree.SelectLockInfo) {
+	node := builder.qry.Nodes[nodeID]
+	for _, child := range node.Children {
+		builder.setLockTarget(child, tables, lock)
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil {
+		return
+	}
+	// the history read by AS OF TIMESTAMP can not be modified, no need to lock it
+	if node.ScanTs != nil {
+		return
+	}
+	if tables != nil {
+		if _, ok := tables[node.TableDef.TblId]; !ok {
+			return
+		}
+	}
+	pos := getLockKeyColPos(node.TableDef)
+	if len(pos) == 0 {
+		return
+	}
+
+	// the positions of the primary key in TableDef.Cols, which are remapped to the
+	// positions in the output of the scan by remapAllColRefs.
+	target := &plan.LockTarget{
+		TableId: node.TableDef.TblId,
+	}
+	if len(pos) > 1 {
+		target.CompositeKeyPos = pos
+	} else {
+		target.PrimaryKeyPos = pos[0]
+	}
+	if lock.LockType == tree.SelectLockForShare {
+		target.Mode = plan.LockTarget_SHARED
+	}
+	switch lock.WaitPolicy {
+	case tree.SelectLockNoWait:
+		target.WaitPolicy = plan.LockTarget_NO_WAIT
+	case tree.SelectLockSkipLocked:
+		target.WaitPolicy = plan.LockTarget_SKIP_LOCKED
+	}
+	node.LockTarget = target
+}
+
+// getLockKeyColPos returns the positions of the primary key columns used as the lock key,
+// the columns of a composite primary key are in the order of the key. nil returns if the
+// table has no primary key.
+func getLockKeyColPos(tableDef *TableDef) []int32 {
+	if tableDef.Pkey != nil && len(tableDef.Pkey.Names) > 1 {
+		pos := make([]int32, 0, len(tableDef.Pkey.Names))
+		for _, name := range tableDef.Pkey.Names {
+			i := findColPos(tableDef, name)
+			if i == -1 {
+				return nil
+			}
+			pos = append(pos, i)
+		}
+		return pos
+	}
+	if tableDef.CompositePkey != nil {
+		return nil
+	}
+	var pos []int32
+	for i, col := range tableDef.Cols {
+		if col.Primary && !col.Hidden {
+			if pos != nil {
+				return nil
+			}
+			pos = []int32{int32(i)}
+		}
+	}
+	return pos
+}
+
+func findColPos(tableDef *TableDef, name string) int32 {
+	for i, col := range tableDef.Cols {
+		if col.Name == name {
+			return int32(i)
+		}
+	}
+	return -1
+}
+
+// isPessimisticTxn returns true if the statement runs in a pessimistic txn, whose
+// writes lock the rows to be modified.
+func isPessimisticTxn(ctx CompilerContext) bool {
+	proc := ctx.GetProcess()
+	return proc != nil &&
+		proc.TxnOperator != nil &&
+		proc.TxnOperator.Txn().IsPessimistic()
+}
+
+// setDmlLockTarget marks the scans of the tables modified by the statement to lock the
+// rows before they are modified if the txn is pessimistic.
+func setDmlLockTarget(builder *QueryBuilder, rootID int32, tableDefs []*TableDef) {
+	if !isPessimisticTxn(builder.compCtx) {
+		return
+	}
+	tables := make(map[uint64]struct{}, len(tableDefs))
+	for _, tableDef := range tableDefs {
+		tables[tableDef.TblId] = struct{}{}
+	}
+	builder.setLockTarget(rootID, tables, &tree.SelectLockInfo{
+		LockType:   tree.SelectLockForUpdate,
+		WaitPolicy: tree.SelectLockWait,
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectForUpdateLockTarget(t *testing.T) {
+	opt := NewMockOptimizer(false)
+
+	cases := []struct {
+		sql    string
+		mode   plan.LockTarget_LockMode
+		policy plan.LockTarget_WaitPolicy
+	}{
+		{"select n_name from nation where n_regionkey = 1 for update", plan.LockTarget_EXCLUSIVE, plan.LockTarget_WAIT},
+		{"select n_name from nation for update nowait", plan.LockTarget_EXCLUSIVE, plan.LockTarget_NO_WAIT},
+		{"select n_name from nation for update skip locked", plan.LockTarget_EXCLUSIVE, plan.LockTarget_SKIP_LOCKED},
+		{"select n_name from nation for share", plan.LockTarget_SHARED, plan.LockTarget_WAIT},
+		{"select n_name from nation for share skip locked", plan.LockTarget_SHARED, plan.LockTarget_SKIP_LOCKED},
+	}
+	for _, c := range cases {
+		pn, err := runOneStmt(opt, t, c.sql)
+		require.NoError(t, err, c.sql)
+		node := findScanNode(pn.GetQuery(), "nation")
+		require.NotNil(t, node, c.sql)
+
+		target := node.LockTarget
+		require.NotNil(t, target, c.sql)
+		require.Equal(t, node.TableDef.TblId, target.TableId)
+		require.Equal(t, c.mode, target.Mode, c.sql)
+		require.Equal(t, c.policy, target.WaitPolicy, c.sql)
+		// the primary key is not selected, but it is kept in the output of the scan
+		// as the lock key.
+		col := node.ProjectList[target.PrimaryKeyPos].GetCol()
+		require.Equal(t, "n_nationkey", node.TableDef.Cols[col.ColPos].Name, c.sql)
+	}
+
+	pn, err := runOneStmt(opt, t, "select n_name from nation")
+	require.NoError(t, err)
+	require.Nil(t, findScanNode(pn.GetQuery(), "nation").LockTarget)
+}
+
+func TestSelectForUpdateCompositeKey(t *testing.T) {
+	opt := NewMockOptimizer(false)
+	addTableFromSql(t, opt, "lock_cpk", "create table lock_cpk (a int, b varchar(10), c int, primary key (b, a))")
+
+	pn, err := runOneStmt(opt, t, "select c from lock_cpk where c > 1 for update")
+	require.NoError(t, err)
+	node := findScanNode(pn.GetQuery(), "lock_cpk")
+	require.NotNil(t, node)
+	target := node.LockTarget
+	require.NotNil(t, target)
+	// the columns of the composite primary key are kept in the output of the scan
+	// in the order of the key
+	require.Equal(t, 2, len(target.CompositeKeyPos))
+	for i, name := range []string{"b", "a"} {
+		col := node.ProjectList[target.CompositeKeyPos[i]].GetCol()
+		require.Equal(t, name, node.TableDef.Cols[col.ColPos].Name)
+	}
+}
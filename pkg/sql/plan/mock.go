@@ -33,6 +33,8 @@ type MockCompilerContext struct {
 	objects         map[string]*ObjectRef
 	tables          map[string]*TableDef
 	stats           map[string]*Stats
+	columnStats     map[string]*TableColumnStats
+	security        map[string]*TableSecurity
 	pks             map[string][]int
 	id2name         map[uint64]string
 	isDml           bool
@@ -59,6 +61,7 @@ func (m *MockCompilerContext) ResolveVariable(varName string, isSystemVar, isGlo
 	dec, _ := types.ParseStringToDecimal128("200.001", 2, 2, false)
 	vars["decimal_var"] = dec
 	vars["null_var"] = nil
+	vars["cte_max_recursion_depth"] = int64(1000)
 
 	if m.mysqlCompatible {
 		vars["sql_mode"] = ""
@@ -748,6 +751,14 @@ func (m *MockCompilerContext) Stats(obj *ObjectRef, e *Expr) *Stats {
 	return m.stats[obj.ObjName]
 }
 
+func (m *MockCompilerContext) ColumnStats(obj *ObjectRef) *TableColumnStats {
+	return m.columnStats[obj.ObjName]
+}
+
+func (m *MockCompilerContext) TableSecurity(obj *ObjectRef) (*TableSecurity, error) {
+	return m.security[obj.ObjName], nil
+}
+
 func (m *MockCompilerContext) GetAccountId() uint32 {
 	return 0
 }
@@ -24,8 +24,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 )
 
 const (
@@ -33,6 +35,177 @@ const (
 	PartitionNumberLimit = 8192
 )
 
+// buildTablePartition builds the partition info of the table by the partition clause,
+// the rows of each partition are stored in a hidden table named here.
+func buildTablePartition(ctx CompilerContext, stmt *tree.CreateTable, tableDef *TableDef) error {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       nil,
+		ObjRef:      nil,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+
+	err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
+	if err != nil {
+		return err
+	}
+	partitionBinder := NewPartitionBinder(builder, bindContext)
+	err = buildPartitionByClause(partitionBinder, stmt, tableDef)
+	if err != nil {
+		return err
+	}
+
+	// the partition expressions are evaluated on the batches written to the
+	// table, where the columns are found by their names
+	partition := tableDef.Partition
+	setPartitionColName(partition.PartitionExpression, tableDef)
+	if partition.PartitionExpr != nil {
+		setPartitionColName(partition.PartitionExpr.Expr, tableDef)
+	}
+	if partition.PartitionColumns != nil {
+		for _, expr := range partition.PartitionColumns.Columns {
+			setPartitionColName(expr, tableDef)
+		}
+	}
+	for _, item := range partition.Partitions {
+		item.PartitionTableName, err = util.BuildPartitionTableName(ctx.GetContext())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildAlterTablePartition rebuilds the partition info of the table for ALTER TABLE,
+// alter changes the partition definitions of the partition clause the table is
+// created with. The partitions kept store their rows in the same tables, and the
+// tables of the new partitions are returned.
+func buildAlterTablePartition(ctx CompilerContext, tableDef *TableDef,
+	alter func(defs []*tree.Partition) ([]*tree.Partition, error)) (*plan.PartitionByDef, []string, error) {
+	// the partition clause is parsed from the message of the partition info
+	sql := fmt.Sprintf("create table `%s` (a int) %s", tableDef.Name, tableDef.Partition.PartitionMsg)
+	stmt, err := mysql.ParseOne(ctx.GetContext(), sql, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	partitionOp := stmt.(*tree.CreateTable).PartitionOption
+	partitionOp.Partitions, err = alter(partitionOp.Partitions)
+	if err != nil {
+		return nil, nil, err
+	}
+	partitionOp.PartBy.Num = uint64(len(partitionOp.Partitions))
+
+	newTableDef := DeepCopyTableDef(tableDef)
+	newTableDef.Partition = nil
+	err = buildTablePartition(ctx, &tree.CreateTable{PartitionOption: partitionOp}, newTableDef)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	oldNames := make(map[string]string, len(tableDef.Partition.Partitions))
+	for _, item := range tableDef.Partition.Partitions {
+		oldNames[item.PartitionName] = item.PartitionTableName
+	}
+	var newNames []string
+	for _, item := range newTableDef.Partition.Partitions {
+		if name, ok := oldNames[item.PartitionName]; ok {
+			item.PartitionTableName = name
+		} else {
+			newNames = append(newNames, item.PartitionTableName)
+		}
+	}
+	return newTableDef.Partition, newNames, nil
+}
+
+// getPartitionTableNames returns the hidden tables storing the partitions of the table
+func getPartitionTableNames(partition *plan.PartitionByDef) []string {
+	if partition == nil {
+		return nil
+	}
+	names := make([]string, len(partition.Partitions))
+	for i, item := range partition.Partitions {
+		names[i] = item.PartitionTableName
+	}
+	return names
+}
+
+// GetPartitionKeyColumns returns the columns used by the partition expression,
+// whose values decide the partitions of the rows.
+func GetPartitionKeyColumns(partition *plan.PartitionByDef) []string {
+	if partition == nil || partition.PartitionExpression == nil {
+		return nil
+	}
+	return collectPartitionKeyColumns(partition.PartitionExpression, nil)
+}
+
+func collectPartitionKeyColumns(expr *Expr, keys []string) []string {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		for _, key := range keys {
+			if key == exprImpl.Col.Name {
+				return keys
+			}
+		}
+		keys = append(keys, exprImpl.Col.Name)
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			keys = collectPartitionKeyColumns(arg, keys)
+		}
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			keys = collectPartitionKeyColumns(arg, keys)
+		}
+	}
+	return keys
+}
+
+// BuildPartitionRouteExpr returns the partition expression evaluated on a batch
+// made of the key columns, in the order of GetPartitionKeyColumns.
+func BuildPartitionRouteExpr(partition *plan.PartitionByDef) *Expr {
+	keys := GetPartitionKeyColumns(partition)
+	expr := DeepCopyExpr(partition.PartitionExpression)
+	resetPartitionColPos(expr, keys)
+	return expr
+}
+
+func resetPartitionColPos(expr *Expr, keys []string) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		exprImpl.Col.RelPos = 0
+		for i, key := range keys {
+			if key == exprImpl.Col.Name {
+				exprImpl.Col.ColPos = int32(i)
+			}
+		}
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			resetPartitionColPos(arg, keys)
+		}
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			resetPartitionColPos(arg, keys)
+		}
+	}
+}
+
+func setPartitionColName(expr *Expr, tableDef *TableDef) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		exprImpl.Col.Name = tableDef.Cols[exprImpl.Col.ColPos].Name
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			setPartitionColName(arg, tableDef)
+		}
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			setPartitionColName(arg, tableDef)
+		}
+	}
+}
+
 // buildHashPartition handle Hash Partitioning
 func buildHashPartition(partitionBinder *PartitionBinder, stmt *tree.CreateTable, tableDef *TableDef) error {
 	partitionOp := stmt.PartitionOption
@@ -0,0 +1,391 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// the max number of key value combinations evaluated to prune the partitions
+	// of the table partitioned by several columns
+	maxPartitionPruneValues = 256
+)
+
+// the functions which are monotonic non-decreasing on their only argument, so
+// the range partitions by them can be pruned by the range predicates
+var monotonicPartitionFuncs = map[string]bool{
+	"year":           true,
+	"to_days":        true,
+	"to_seconds":     true,
+	"unix_timestamp": true,
+	"date":           true,
+}
+
+// prunePartitions decides the partitions to read for the scans of the partitioned
+// tables by their filters, the partitions whose rows can't satisfy the filters are
+// not read.
+func (builder *QueryBuilder) prunePartitions(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.prunePartitions(childID)
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || node.TableDef.Partition == nil {
+		return
+	}
+	if len(node.FilterList) == 0 {
+		return
+	}
+	pruner := newPartitionPruner(builder.compCtx.GetProcess(), node.TableDef)
+	if pruner == nil {
+		return
+	}
+	partitions, ok := pruner.prune(node.FilterList)
+	if !ok {
+		return
+	}
+	prune := &plan.PartitionPrune{}
+	for i, selected := range partitions {
+		if selected {
+			prune.Partitions = append(prune.Partitions, int32(i))
+		}
+	}
+	node.PartitionPrune = prune
+}
+
+type partitionPruner struct {
+	proc      *process.Process
+	partition *plan.PartitionByDef
+	tableDef  *TableDef
+	keys      []string
+	// monotonic is set if the partitions are ranges of a monotonic expression
+	// of the only key column
+	monotonic bool
+}
+
+func newPartitionPruner(proc *process.Process, tableDef *TableDef) *partitionPruner {
+	partition := tableDef.Partition
+	keys := GetPartitionKeyColumns(partition)
+	if proc == nil || len(keys) == 0 || len(partition.Partitions) == 0 {
+		return nil
+	}
+	p := &partitionPruner{
+		proc:      proc,
+		partition: partition,
+		tableDef:  tableDef,
+		keys:      keys,
+	}
+	if len(keys) == 1 {
+		switch partition.Type {
+		case plan.PartitionType_RANGE:
+			if partition.PartitionExpr != nil {
+				switch expr := partition.PartitionExpr.Expr.Expr.(type) {
+				case *plan.Expr_Col:
+					p.monotonic = true
+				case *plan.Expr_F:
+					if len(expr.F.Args) == 1 && monotonicPartitionFuncs[expr.F.Func.ObjName] {
+						_, p.monotonic = expr.F.Args[0].Expr.(*plan.Expr_Col)
+					}
+				}
+			}
+		case plan.PartitionType_RANGE_COLUMNS:
+			p.monotonic = true
+		}
+	}
+	return p
+}
+
+// prune returns the partitions which may hold the rows satisfying the filters,
+// and false if no partition is pruned by them.
+func (p *partitionPruner) prune(filters []*Expr) ([]bool, bool) {
+	if len(p.keys) > 1 {
+		return p.pruneByKeyValues(filters)
+	}
+	var result []bool
+	for _, filter := range filters {
+		partitions, ok := p.pruneByExpr(filter)
+		if !ok {
+			continue
+		}
+		result = intersectPartitions(result, partitions)
+	}
+	return result, result != nil
+}
+
+func (p *partitionPruner) pruneByExpr(expr *Expr) ([]bool, bool) {
+	fn := expr.GetF()
+	if fn == nil {
+		return nil, false
+	}
+	args := fn.Args
+	switch name := fn.Func.ObjName; name {
+	case "and":
+		left, lok := p.pruneByExpr(args[0])
+		right, rok := p.pruneByExpr(args[1])
+		if !lok {
+			return right, rok
+		}
+		if !rok {
+			return left, lok
+		}
+		return intersectPartitions(left, right), true
+	case "or":
+		left, ok := p.pruneByExpr(args[0])
+		if !ok {
+			return nil, false
+		}
+		right, ok := p.pruneByExpr(args[1])
+		if !ok {
+			return nil, false
+		}
+		for i := range left {
+			left[i] = left[i] || right[i]
+		}
+		return left, true
+	case "in":
+		if !p.isKeyColumn(args[0]) {
+			return nil, false
+		}
+		list := args[1].GetList()
+		if list == nil {
+			return nil, false
+		}
+		partitions := make([]bool, len(p.partition.Partitions))
+		for _, value := range list.List {
+			idx, ok := p.locate([]*Expr{value})
+			if !ok {
+				return nil, false
+			}
+			if idx >= 0 {
+				partitions[idx] = true
+			}
+		}
+		return partitions, true
+	case "=", "<", "<=", ">", ">=":
+		col, value := args[0], args[1]
+		if !p.isKeyColumn(col) {
+			col, value = value, col
+			name = reverseComparison(name)
+		}
+		if !p.isKeyColumn(col) {
+			return nil, false
+		}
+		if name != "=" && !p.monotonic {
+			return nil, false
+		}
+		idx, ok := p.locate([]*Expr{value})
+		if !ok {
+			return nil, false
+		}
+		partitions := make([]bool, len(p.partition.Partitions))
+		switch name {
+		case "=":
+			if idx >= 0 {
+				partitions[idx] = true
+			}
+		case "<", "<=":
+			// the value is beyond the ranges of all the partitions
+			if idx < 0 {
+				return nil, false
+			}
+			for i := 0; i <= int(idx); i++ {
+				partitions[i] = true
+			}
+		case ">", ">=":
+			if idx >= 0 {
+				for i := int(idx); i < len(partitions); i++ {
+					partitions[i] = true
+				}
+			}
+		}
+		return partitions, true
+	}
+	return nil, false
+}
+
+// pruneByKeyValues prunes the partitions of the table partitioned by several
+// columns, whose values must be all given by the equal or in predicates.
+func (p *partitionPruner) pruneByKeyValues(filters []*Expr) ([]bool, bool) {
+	values := make([][]*Expr, len(p.keys))
+	for _, filter := range filters {
+		for _, expr := range splitPlanConjunction(filter) {
+			fn := expr.GetF()
+			if fn == nil {
+				continue
+			}
+			switch fn.Func.ObjName {
+			case "=":
+				col, value := fn.Args[0], fn.Args[1]
+				if !p.isKeyColumn(col) {
+					col, value = value, col
+				}
+				if i := p.keyIndex(col); i >= 0 && values[i] == nil {
+					values[i] = []*Expr{value}
+				}
+			case "in":
+				if i := p.keyIndex(fn.Args[0]); i >= 0 && values[i] == nil {
+					if list := fn.Args[1].GetList(); list != nil {
+						values[i] = list.List
+					}
+				}
+			}
+		}
+	}
+	cnt := 1
+	for _, vals := range values {
+		if len(vals) == 0 {
+			return nil, false
+		}
+		cnt *= len(vals)
+		if cnt > maxPartitionPruneValues {
+			return nil, false
+		}
+	}
+
+	partitions := make([]bool, len(p.partition.Partitions))
+	row := make([]*Expr, len(p.keys))
+	var walk func(i int) bool
+	walk = func(i int) bool {
+		if i == len(p.keys) {
+			idx, ok := p.locate(row)
+			if ok && idx >= 0 {
+				partitions[idx] = true
+			}
+			return ok
+		}
+		for _, value := range values[i] {
+			row[i] = value
+			if !walk(i + 1) {
+				return false
+			}
+		}
+		return true
+	}
+	if !walk(0) {
+		return nil, false
+	}
+	return partitions, true
+}
+
+func (p *partitionPruner) keyIndex(expr *Expr) int {
+	col := expr.GetCol()
+	if col == nil || int(col.ColPos) >= len(p.tableDef.Cols) {
+		return -1
+	}
+	name := p.tableDef.Cols[col.ColPos].Name
+	for i, key := range p.keys {
+		if key == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *partitionPruner) isKeyColumn(expr *Expr) bool {
+	return p.keyIndex(expr) >= 0
+}
+
+// locate evaluates the partition expression on the values of the key columns, it
+// returns -1 if no partition holds the values, and false if they are not constants.
+func (p *partitionPruner) locate(values []*Expr) (int32, bool) {
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	consts := make(map[string]*Expr, len(values))
+	for i, value := range values {
+		value, err := ConstantFold(bat, DeepCopyExpr(value), p.proc)
+		if err != nil || value.GetC() == nil || value.GetC().Isnull {
+			return 0, false
+		}
+		consts[p.keys[i]] = value
+	}
+
+	// the expression is evaluated rather than folded, as the case function
+	// building the partition expression is never folded
+	expr := DeepCopyExpr(p.partition.PartitionExpression)
+	if !replacePartitionKeys(expr, consts) {
+		return 0, false
+	}
+	vec, err := colexec.EvalExpr(bat, p.proc, expr)
+	if err != nil {
+		return 0, false
+	}
+	defer vec.Free(p.proc.Mp())
+	if vec.GetType().Oid != types.T_int64 || vec.Length() != 1 || vec.GetNulls().Contains(0) {
+		return 0, false
+	}
+	idx := vector.MustFixedCol[int64](vec)[0]
+	if idx >= int64(len(p.partition.Partitions)) {
+		return 0, false
+	}
+	if idx < 0 {
+		return -1, true
+	}
+	return int32(idx), true
+}
+
+// replacePartitionKeys replaces the key columns in the partition expression with
+// the constants of the same types.
+func replacePartitionKeys(expr *Expr, consts map[string]*Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		value, ok := consts[exprImpl.Col.Name]
+		if !ok || value.Typ.Id != expr.Typ.Id {
+			return false
+		}
+		expr.Expr = value.Expr
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if !replacePartitionKeys(arg, consts) {
+				return false
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			if !replacePartitionKeys(arg, consts) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func intersectPartitions(left, right []bool) []bool {
+	if left == nil {
+		return right
+	}
+	for i := range left {
+		left[i] = left[i] && right[i]
+	}
+	return left
+}
+
+func reverseComparison(name string) string {
+	switch name {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return name
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// addPartitionedTable adds the table created by the sql to the mock catalog
+func addPartitionedTable(t *testing.T, opt *MockOptimizer, name string, sql string) {
+	logicPlan, err := runOneStmt(opt, t, sql)
+	require.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().GetTableDef()
+	require.NotNil(t, tableDef.Partition)
+	for _, item := range tableDef.Partition.Partitions {
+		require.NotEmpty(t, item.PartitionTableName)
+	}
+	tableDef.TblId = uint64(len(opt.ctxt.tables) + 1000)
+	tableDef.TableType = catalog.SystemOrdinaryRel
+	opt.ctxt.tables[name] = tableDef
+	opt.ctxt.objects[name] = &ObjectRef{
+		Obj:        int64(tableDef.TblId),
+		SchemaName: "tpch",
+		ObjName:    name,
+	}
+	opt.ctxt.id2name[tableDef.TblId] = name
+	opt.ctxt.stats[name] = DefaultStats()
+}
+
+func newPartitionTestOptimizer(t *testing.T) *MockOptimizer {
+	opt := NewMockOptimizer(false)
+	addPartitionedTable(t, opt, "pt_range", `create table pt_range (a int, b int)
+		partition by range (a) (
+			partition p0 values less than (10),
+			partition p1 values less than (20),
+			partition p2 values less than (30))`)
+	addPartitionedTable(t, opt, "pt_hash", `create table pt_hash (a int, b int)
+		partition by hash (a) partitions 4`)
+	addPartitionedTable(t, opt, "pt_list", `create table pt_list (a int, b int)
+		partition by list columns (a, b) (
+			partition p0 values in ((1, 1), (2, 2)),
+			partition p1 values in ((3, 3), (4, 4)))`)
+	return opt
+}
+
+func getPrunedPartitions(t *testing.T, opt *MockOptimizer, sql string, tableName string) *plan.PartitionPrune {
+	logicPlan, err := runOneStmt(opt, t, sql)
+	require.NoError(t, err, sql)
+	node := findScanNode(logicPlan.GetQuery(), tableName)
+	require.NotNil(t, node, sql)
+	return node.PartitionPrune
+}
+
+func TestPartitionPrune(t *testing.T) {
+	opt := newPartitionTestOptimizer(t)
+
+	cases := []struct {
+		sql        string
+		table      string
+		partitions []int32
+	}{
+		{"select * from pt_range where a = 15", "pt_range", []int32{1}},
+		{"select * from pt_range where a < 15", "pt_range", []int32{0, 1}},
+		{"select * from pt_range where 15 <= a", "pt_range", []int32{1, 2}},
+		{"select * from pt_range where a > 15 and a < 25", "pt_range", []int32{1, 2}},
+		{"select * from pt_range where a in (1, 25)", "pt_range", []int32{0, 2}},
+		{"select * from pt_range where a = 1 or a = 25", "pt_range", []int32{0, 2}},
+		{"select * from pt_range where a = 1 and b = 2", "pt_range", []int32{0}},
+		{"select * from pt_range where a = 100", "pt_range", nil},
+		{"select count(*) from pt_range where a < 5 or a > 25", "pt_range", []int32{0, 2}},
+		{"select * from pt_list where a = 3 and b = 3", "pt_list", []int32{1}},
+		{"select * from pt_list where a in (1, 4) and b = 4", "pt_list", []int32{1}},
+		{"select * from pt_list where a = 5 and b in (1, 2)", "pt_list", nil},
+	}
+	for _, c := range cases {
+		prune := getPrunedPartitions(t, opt, c.sql, c.table)
+		require.NotNil(t, prune, c.sql)
+		require.Equal(t, c.partitions, prune.Partitions, c.sql)
+	}
+
+	// the partitions are not pruned if the filters don't restrict the keys
+	notPruned := map[string]string{
+		"select * from pt_range":                      "pt_range",
+		"select * from pt_range where b = 1":          "pt_range",
+		"select * from pt_range where a = 1 or b = 1": "pt_range",
+		"select * from pt_range where a + 1 = 2":      "pt_range",
+		"select * from pt_hash where a > 1":           "pt_hash",
+		"select * from pt_list where a = 1":           "pt_list",
+	}
+	for sql, tableName := range notPruned {
+		require.Nil(t, getPrunedPartitions(t, opt, sql, tableName), sql)
+	}
+
+	prune := getPrunedPartitions(t, opt, "select * from pt_hash where a = 5", "pt_hash")
+	require.NotNil(t, prune)
+	require.Equal(t, 1, len(prune.Partitions))
+}
+
+func TestAlterTablePartition(t *testing.T) {
+	opt := newPartitionTestOptimizer(t)
+	oldPartition := opt.ctxt.tables["pt_range"].Partition
+
+	logicPlan, err := runOneStmt(opt, t, "alter table pt_range add partition (partition p3 values less than (40))")
+	require.NoError(t, err)
+	actions := logicPlan.GetDdl().GetAlterTable().GetActions()
+	require.Equal(t, 1, len(actions))
+	add := actions[0].GetAddPartition()
+	require.NotNil(t, add)
+	require.Equal(t, 4, len(add.Partition.Partitions))
+	require.Equal(t, 1, len(add.PartitionTableNames))
+	for i, item := range oldPartition.Partitions {
+		require.Equal(t, item.PartitionTableName, add.Partition.Partitions[i].PartitionTableName)
+	}
+	require.Equal(t, add.PartitionTableNames[0], add.Partition.Partitions[3].PartitionTableName)
+
+	logicPlan, err = runOneStmt(opt, t, "alter table pt_range drop partition p0, p2")
+	require.NoError(t, err)
+	drop := logicPlan.GetDdl().GetAlterTable().GetActions()[0].GetDropPartition()
+	require.NotNil(t, drop)
+	require.Equal(t, 1, len(drop.Partition.Partitions))
+	require.Equal(t, "p1", drop.Partition.Partitions[0].PartitionName)
+	require.Equal(t, oldPartition.Partitions[1].PartitionTableName, drop.Partition.Partitions[0].PartitionTableName)
+	require.Equal(t, []string{oldPartition.Partitions[0].PartitionTableName, oldPartition.Partitions[2].PartitionTableName},
+		drop.PartitionTableNames)
+
+	logicPlan, err = runOneStmt(opt, t, "alter table pt_range truncate partition p1")
+	require.NoError(t, err)
+	truncate := logicPlan.GetDdl().GetAlterTable().GetActions()[0].GetTruncatePartition()
+	require.NotNil(t, truncate)
+	require.Equal(t, []string{oldPartition.Partitions[1].PartitionTableName}, truncate.PartitionTableNames)
+
+	sqls := []string{
+		"alter table pt_range drop partition p0, p1, p2",
+		"alter table pt_range drop partition p4",
+		"alter table pt_range truncate partition p4",
+		"alter table pt_range add partition (partition p1 values less than (40))",
+		"alter table pt_hash add partition (partition p4)",
+		"alter table pt_range drop column a",
+		"alter table emp add partition (partition p0 values less than (10))",
+	}
+	for _, sql := range sqls {
+		_, err = runOneStmt(opt, t, sql)
+		require.Error(t, err, sql)
+	}
+}
@@ -15,10 +15,15 @@
 package plan
 
 import (
+	"go/constant"
+	"strconv"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
 
 func NewProjectionBinder(builder *QueryBuilder, ctx *BindContext, havingBinder *HavingBinder) *ProjectionBinder {
@@ -60,6 +65,18 @@ func (b *ProjectionBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool)
 		}, nil
 	}
 
+	if colPos, ok := b.ctx.windowByAst[astStr]; ok {
+		return &plan.Expr{
+			Typ: b.ctx.windows[colPos].WindowFunc.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: b.ctx.windowTag,
+					ColPos: colPos,
+				},
+			},
+		}, nil
+	}
+
 	return b.baseBindExpr(astExpr, depth, isRoot)
 }
 
@@ -72,7 +89,219 @@ func (b *ProjectionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr,
 }
 
 func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
-	return nil, moerr.NewNYI(b.GetContext(), "window functions")
+	if b.ctx.windowTag == 0 {
+		return nil, moerr.NewNYI(b.GetContext(), "window function %s in this context", funcName)
+	}
+	if astExpr.WindowSpec == nil {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function %s requires an OVER clause", funcName)
+	}
+	if b.insideWin {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function %s calls cannot be nested", funcName)
+	}
+
+	isAgg := function.GetFunctionIsAggregateByName(funcName)
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT && !isAgg {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "DISTINCT is not allowed in window function %s", funcName)
+	}
+
+	b.insideWin = true
+	defer func() {
+		b.insideWin = false
+	}()
+
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	if err != nil {
+		return nil, err
+	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+		expr.GetF().Func.Obj = int64(int64(uint64(expr.GetF().Func.Obj) | function.Distinct))
+	}
+
+	if funcName == "lag" || funcName == "lead" {
+		args := expr.GetF().Args
+		if len(args) > 1 {
+			if c, ok := args[1].Expr.(*plan.Expr_C); !ok || c.C.Isnull || c.C.GetI64Val() < 0 {
+				return nil, moerr.NewInvalidInput(b.GetContext(), "the offset of window function %s must be a non-negative integer constant", funcName)
+			}
+		}
+	}
+
+	ws := astExpr.WindowSpec
+	spec := &plan.WindowSpec{
+		WindowFunc:  expr,
+		PartitionBy: make([]*plan.Expr, 0, len(ws.PartitionBy)),
+		OrderBy:     make([]*plan.OrderBySpec, 0, len(ws.OrderBy)),
+	}
+
+	for _, partition := range ws.PartitionBy {
+		partitionExpr, err := b.BindExpr(partition, depth, false)
+		if err != nil {
+			return nil, err
+		}
+		spec.PartitionBy = append(spec.PartitionBy, partitionExpr)
+	}
+
+	for _, order := range ws.OrderBy {
+		orderExpr, err := b.BindExpr(order.Expr, depth, false)
+		if err != nil {
+			return nil, err
+		}
+
+		orderBy := &plan.OrderBySpec{
+			Expr: orderExpr,
+			Flag: plan.OrderBySpec_INTERNAL,
+		}
+
+		switch order.Direction {
+		case tree.Ascending:
+			orderBy.Flag |= plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy.Flag |= plan.OrderBySpec_DESC
+		}
+
+		switch order.NullsPosition {
+		case tree.NullsFirst:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_FIRST
+		case tree.NullsLast:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_LAST
+		}
+
+		spec.OrderBy = append(spec.OrderBy, orderBy)
+	}
+
+	spec.Frame, err = b.bindWindowFrame(ws.WindowFrame, spec.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	colPos := int32(len(b.ctx.windows))
+	astStr := tree.String(astExpr, dialect.MYSQL)
+	b.ctx.windowByAst[astStr] = colPos
+	b.ctx.windows = append(b.ctx.windows, spec)
+
+	return &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: b.ctx.windowTag,
+				ColPos: colPos,
+			},
+		},
+	}, nil
+}
+
+// bindWindowFrame binds the frame clause of a window.
+// If the frame clause is absent, the frame is the whole partition without ORDER BY,
+// or RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW with ORDER BY.
+func (b *ProjectionBinder) bindWindowFrame(frame *tree.WindowFrame, orderBy []*plan.OrderBySpec) (*plan.FrameClause, error) {
+	if frame == nil {
+		if len(orderBy) == 0 {
+			return &plan.FrameClause{
+				Type:  plan.FrameClause_ROWS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+				End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Unbounded: true},
+			}, nil
+		}
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Unbounded: true},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}, nil
+	}
+
+	clause := &plan.FrameClause{}
+	switch frame.Unit {
+	case tree.WIN_FRAME_UNIT_ROWS:
+		clause.Type = plan.FrameClause_ROWS
+	case tree.WIN_FRAME_UNIT_RANGE:
+		clause.Type = plan.FrameClause_RANGE
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "window frame unit GROUPS")
+	}
+
+	var err error
+	if clause.Start, err = b.bindWindowFrameBound(frame.StartBound, clause.Type, orderBy); err != nil {
+		return nil, err
+	}
+	if frame.EndBound == nil {
+		clause.End = &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW}
+	} else if clause.End, err = b.bindWindowFrameBound(frame.EndBound, clause.Type, orderBy); err != nil {
+		return nil, err
+	}
+
+	if clause.Start.Unbounded && clause.Start.Type == plan.FrameBound_FOLLOWING {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame cannot start from UNBOUNDED FOLLOWING")
+	}
+	if clause.End.Unbounded && clause.End.Type == plan.FrameBound_PRECEDING {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame cannot end with UNBOUNDED PRECEDING")
+	}
+	if clause.Start.Type > clause.End.Type {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame starting from %s cannot end with %s", clause.Start.Type, clause.End.Type)
+	}
+
+	return clause, nil
+}
+
+// bindWindowFrameBound binds a bound of window frame. the offset of a bound must be
+// a non-negative integer for ROWS, and a non-negative number for RANGE, which also requires
+// exactly one numeric ORDER BY expression.
+func (b *ProjectionBinder) bindWindowFrameBound(bound tree.WindowFrameBound, unit plan.FrameClause_FrameType, orderBy []*plan.OrderBySpec) (*plan.FrameBound, error) {
+	var offset tree.Expr
+	fb := &plan.FrameBound{}
+	switch bd := bound.(type) {
+	case *tree.WindowFrameBoundCurrentRow:
+		fb.Type = plan.FrameBound_CURRENT_ROW
+		return fb, nil
+	case *tree.WindowFrameBoundPreceding:
+		fb.Type = plan.FrameBound_PRECEDING
+		offset = bd.Expr
+	case *tree.WindowFrameBoundFollowing:
+		fb.Type = plan.FrameBound_FOLLOWING
+		offset = bd.Expr
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "window frame bound '%v'", bound)
+	}
+
+	if offset == nil {
+		fb.Unbounded = true
+		return fb, nil
+	}
+
+	numVal, ok := offset.(*tree.NumVal)
+	if !ok {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset must be a non-negative numeric constant")
+	}
+
+	if unit == plan.FrameClause_ROWS {
+		if numVal.ValType != tree.P_int64 {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset of ROWS must be a non-negative integer")
+		}
+		val, _ := constant.Int64Val(numVal.Value)
+		if val < 0 {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset of ROWS must be a non-negative integer")
+		}
+		fb.Val = makePlan2Int64ConstExprWithType(val)
+		return fb, nil
+	}
+
+	if len(orderBy) != 1 {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame of RANGE with offset requires exactly one ORDER BY expression")
+	}
+	typ := makeTypeByPlan2Expr(orderBy[0].Expr)
+	if !typ.IsIntOrUint() && !typ.IsFloat() && typ.Oid != types.T_decimal64 && typ.Oid != types.T_decimal128 {
+		return nil, moerr.NewNYI(b.GetContext(), "window frame of RANGE with offset on ORDER BY expression of type %s", typ.String())
+	}
+	switch numVal.ValType {
+	case tree.P_int64, tree.P_uint64, tree.P_float64, tree.P_decimal:
+	default:
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset of RANGE must be a non-negative number")
+	}
+	val, err := strconv.ParseFloat(numVal.String(), 64)
+	if err != nil || val < 0 {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame offset of RANGE must be a non-negative number")
+	}
+	fb.Val = makePlan2Float64ConstExprWithType(val)
+	return fb, nil
 }
 
 func (b *ProjectionBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
@@ -103,12 +103,30 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 		for _, expr := range node.FilterList {
 			increaseRefCnt(expr, colRefCnt)
 		}
+		for _, spec := range node.RuntimeFilterProbeList {
+			increaseRefCnt(spec.Expr, colRefCnt)
+		}
 
 		internalRemapping := &ColRefRemapping{
 			globalToLocal: make(map[[2]int32][2]int32),
 		}
 
 		tag := node.BindingTags[0]
+		// the primary key is the lock key of the rows, it must be in the output of the scan
+		var lockKeyRefs [][2]int32
+		if node.LockTarget != nil {
+			if len(node.LockTarget.CompositeKeyPos) > 0 {
+				for _, pos := range node.LockTarget.CompositeKeyPos {
+					lockKeyRefs = append(lockKeyRefs, [2]int32{tag, pos})
+				}
+			} else {
+				lockKeyRefs = append(lockKeyRefs, [2]int32{tag, node.LockTarget.PrimaryKeyPos})
+			}
+			for _, ref := range lockKeyRefs {
+				colRefCnt[ref]++
+			}
+		}
+
 		newTableDef := &plan.TableDef{
 			Name:          node.TableDef.Name,
 			Defs:          node.TableDef.Defs,
@@ -118,6 +136,7 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			TableType:     node.TableDef.TableType,
 			CompositePkey: node.TableDef.CompositePkey,
 			OriginCols:    node.TableDef.OriginCols,
+			Partition:     node.TableDef.Partition,
 		}
 
 		for i, col := range node.TableDef.Cols {
@@ -146,6 +165,14 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+		for _, spec := range node.RuntimeFilterProbeList {
+			decreaseRefCnt(spec.Expr, colRefCnt)
+			err := builder.remapExpr(spec.Expr, internalRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		for i, col := range node.TableDef.Cols {
 			if colRefCnt[internalRemapping.localToGlobal[i]] == 0 {
 				continue
@@ -195,6 +222,17 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+		if node.LockTarget != nil {
+			for i, ref := range lockKeyRefs {
+				colRefCnt[ref]--
+				if len(node.LockTarget.CompositeKeyPos) > 0 {
+					node.LockTarget.CompositeKeyPos[i] = remapping.globalToLocal[ref][1]
+				} else {
+					node.LockTarget.PrimaryKeyPos = remapping.globalToLocal[ref][1]
+				}
+			}
+		}
+
 		if node.NodeType == plan.Node_FUNCTION_SCAN {
 			childId := node.Children[0]
 			childNode := builder.qry.Nodes[childId]
@@ -209,7 +247,8 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 
 	case plan.Node_INTERSECT, plan.Node_INTERSECT_ALL,
 		plan.Node_UNION, plan.Node_UNION_ALL,
-		plan.Node_MINUS, plan.Node_MINUS_ALL:
+		plan.Node_MINUS, plan.Node_MINUS_ALL,
+		plan.Node_RECURSIVE_CTE:
 
 		thisTag := node.BindingTags[0]
 		leftID := node.Children[0]
@@ -261,6 +300,9 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 		for _, expr := range node.OnList {
 			increaseRefCnt(expr, colRefCnt)
 		}
+		for _, spec := range node.RuntimeFilterBuildList {
+			increaseRefCnt(spec.Expr, colRefCnt)
+		}
 
 		internalMap := make(map[[2]int32][2]int32)
 
@@ -292,6 +334,14 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+		for _, spec := range node.RuntimeFilterBuildList {
+			decreaseRefCnt(spec.Expr, colRefCnt)
+			err := builder.remapExpr(spec.Expr, internalMap)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		childProjList := builder.qry.Nodes[leftID].ProjectList
 		for i, globalRef := range leftRemapping.localToGlobal {
 			if colRefCnt[globalRef] == 0 {
@@ -524,6 +574,78 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			})
 		}
 
+	case plan.Node_WINDOW:
+		spec := node.WinSpec
+		increaseRefCnt(spec.WindowFunc, colRefCnt)
+		for _, expr := range spec.PartitionBy {
+			increaseRefCnt(expr, colRefCnt)
+		}
+		for _, orderBy := range spec.OrderBy {
+			increaseRefCnt(orderBy.Expr, colRefCnt)
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		decreaseRefCnt(spec.WindowFunc, colRefCnt)
+		err = builder.remapExpr(spec.WindowFunc, childRemapping.globalToLocal)
+		if err != nil {
+			return nil, err
+		}
+		for _, expr := range spec.PartitionBy {
+			decreaseRefCnt(expr, colRefCnt)
+			err = builder.remapExpr(expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, orderBy := range spec.OrderBy {
+			decreaseRefCnt(orderBy.Expr, colRefCnt)
+			err = builder.remapExpr(orderBy.Expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		// the window operator appends its result after all the columns of child
+		globalRef := [2]int32{node.BindingTags[0], node.WindowIdx}
+		if colRefCnt[globalRef] > 0 || len(node.ProjectList) == 0 {
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: spec.WindowFunc.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: -1,
+						ColPos: int32(len(childProjList)),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_FILTER:
 		for _, expr := range node.FilterList {
 			increaseRefCnt(expr, colRefCnt)
@@ -656,6 +778,45 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 
 		remapping = childRemapping
 
+	case plan.Node_SINK_SCAN:
+		// the working table is passed to SINK_SCAN as a whole, so the columns are never pruned
+		tag := node.BindingTags[0]
+		for i, col := range node.TableDef.Cols {
+			globalRef := [2]int32{tag, int32(i)}
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: col.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		if len(node.ProjectList) == 0 {
+			globalRef := [2]int32{tag, 0}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: node.TableDef.Cols[0].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: 0,
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_VALUE_SCAN:
 		// VALUE_SCAN always have one column now
 		if node.TableDef == nil { // like select 1,2
@@ -709,10 +870,13 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 func (builder *QueryBuilder) createQuery() (*Query, error) {
 	for i, rootId := range builder.qry.Steps {
 		rootId, _ = builder.pushdownFilters(rootId, nil)
+		builder.prunePartitions(rootId)
+		rootId = builder.applyIndices(rootId)
 		ReCalcNodeStats(rootId, builder, true)
 		rootId = builder.determineJoinOrder(rootId)
 		SortFilterListByStats(builder.GetContext(), rootId, builder)
 		rootId = builder.pushdownSemiAntiJoins(rootId)
+		builder.generateRuntimeFilters(rootId)
 		builder.qry.Steps[i] = rootId
 
 		colRefCnt := make(map[[2]int32]int)
@@ -1089,23 +1253,25 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			maskedNames = append(maskedNames, name)
 
 			ctx.cteByName[name] = &CTERef{
-				ast:        cte,
-				maskedCTEs: maskedCTEs,
+				ast:         cte,
+				isRecursive: stmt.With.IsRecursive,
+				maskedCTEs:  maskedCTEs,
 			}
 		}
 
 		// Try to do binding for CTE at declaration
 		for _, cte := range stmt.With.CTEs {
+			cteRef := ctx.cteByName[string(cte.Name.Alias)]
 			subCtx := NewBindContext(builder, ctx)
-			subCtx.maskedCTEs = ctx.cteByName[string(cte.Name.Alias)].maskedCTEs
+			subCtx.maskedCTEs = cteRef.maskedCTEs
 
 			var err error
 			switch stmt := cte.Stmt.(type) {
 			case *tree.Select:
-				_, err = builder.buildSelect(stmt, subCtx, false)
+				_, err = builder.buildCTE(cteRef, stmt, subCtx)
 
 			case *tree.ParenSelect:
-				_, err = builder.buildSelect(stmt.Select, subCtx, false)
+				_, err = builder.buildCTE(cteRef, stmt.Select, subCtx)
 
 			default:
 				err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
@@ -1123,6 +1289,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	var err error
 	astOrderBy := stmt.OrderBy
 	astLimit := stmt.Limit
+	astLock := stmt.Lock
 
 	// strip parentheses
 	// ((select a from t1)) order by b  [ is equal ] select a from t1 order by b
@@ -1144,6 +1311,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 				}
 				astLimit = selectClause.Select.Limit
 			}
+			if selectClause.Select.Lock != nil {
+				astLock = selectClause.Select.Lock
+			}
 			stmt = selectClause.Select
 		} else {
 			break
@@ -1264,6 +1434,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		if err != nil {
 			return 0, err
 		}
+		if astLock != nil {
+			builder.setLockTarget(nodeID, nil, astLock)
+		}
 
 		ctx.binder = NewWhereBinder(builder, ctx)
 		// unfold stars and generate headings
@@ -1283,6 +1456,11 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 					if err != nil {
 						return 0, err
 					}
+					if builder.isTrustedColRef(expr) {
+						for _, col := range cols {
+							builder.trustColRef(col.Expr.(*tree.UnresolvedName))
+						}
+					}
 					selectList = append(selectList, cols...)
 					ctx.headings = append(ctx.headings, names...)
 				} else {
@@ -1377,6 +1555,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 
 		ctx.groupTag = builder.genNewTag()
 		ctx.aggregateTag = builder.genNewTag()
+		ctx.windowTag = builder.genNewTag()
 		ctx.projectTag = builder.genNewTag()
 
 		// bind GROUP BY clause
@@ -1507,6 +1686,15 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		for i, proj := range ctx.projects {
 			ctx.projects[i] = builder.wrapBareColRefsInAnyValue(proj, ctx)
 		}
+		for _, spec := range ctx.windows {
+			spec.WindowFunc = builder.wrapBareColRefsInAnyValue(spec.WindowFunc, ctx)
+			for i, expr := range spec.PartitionBy {
+				spec.PartitionBy[i] = builder.wrapBareColRefsInAnyValue(expr, ctx)
+			}
+			for _, orderBy := range spec.OrderBy {
+				orderBy.Expr = builder.wrapBareColRefsInAnyValue(orderBy.Expr, ctx)
+			}
+		}
 	}
 
 	// FIXME: delete this when SINGLE join is ready
@@ -1553,6 +1741,38 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 	}
 
+	// append WINDOW node, one for each window function
+	for i, spec := range ctx.windows {
+		nodeID, spec.WindowFunc, err = builder.flattenSubqueries(nodeID, spec.WindowFunc, ctx)
+		if err != nil {
+			return 0, err
+		}
+		for j, expr := range spec.PartitionBy {
+			nodeID, spec.PartitionBy[j], err = builder.flattenSubqueries(nodeID, expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+		}
+		for _, orderBy := range spec.OrderBy {
+			nodeID, orderBy.Expr, err = builder.flattenSubqueries(nodeID, orderBy.Expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:    plan.Node_WINDOW,
+			Children:    []int32{nodeID},
+			WinSpec:     spec,
+			WindowIdx:   int32(i),
+			BindingTags: []int32{ctx.windowTag},
+		}, ctx)
+	}
+
+	for name, id := range ctx.windowByAst {
+		builder.nameByColRef[[2]int32{ctx.windowTag, id}] = name
+	}
+
 	// append PROJECT node
 	for i, proj := range ctx.projects {
 		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx)
@@ -1720,10 +1940,10 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 
 				switch stmt := cteRef.ast.Stmt.(type) {
 				case *tree.Select:
-					nodeID, err = builder.buildSelect(stmt, subCtx, false)
+					nodeID, err = builder.buildCTE(cteRef, stmt, subCtx)
 
 				case *tree.ParenSelect:
-					nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
+					nodeID, err = builder.buildCTE(cteRef, stmt.Select, subCtx)
 
 				default:
 					err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
@@ -1749,6 +1969,11 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 
 				break
 			}
+
+			if recursiveCTE := ctx.findRecursiveCTE(table); recursiveCTE != nil {
+				nodeID = builder.buildSinkScan(recursiveCTE, ctx)
+				break
+			}
 			schema = ctx.defaultDatabase
 		}
 
@@ -1883,6 +2108,17 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 
 		err = builder.addBinding(nodeID, tbl.As, ctx)
 
+		if err == nil && tbl.AsOfTimestamp != nil {
+			scanNode := builder.qry.Nodes[nodeID]
+			if scanNode.NodeType != plan.Node_TABLE_SCAN {
+				return 0, moerr.NewNotSupported(builder.GetContext(), "as of timestamp on %s", tree.String(tbl.Expr, dialect.MYSQL))
+			}
+			scanNode.ScanTs, err = builder.buildAsOfTimestamp(tbl.AsOfTimestamp, ctx)
+			if err != nil {
+				return
+			}
+		}
+
 		//tableDef := builder.qry.Nodes[nodeID].GetTableDef()
 		midNode := builder.qry.Nodes[nodeID]
 		//if it is the non-sys account and reads the cluster table,
@@ -1939,6 +2175,9 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 				builder.qry.Nodes[nodeID].FilterList = accountFilterExprs
 			}
 		}
+		if err == nil {
+			err = builder.applyRowPolicies(nodeID, tbl.As)
+		}
 		return
 	case *tree.StatementSource:
 		return 0, moerr.NewParseError(builder.GetContext(), "unsupport table expr: %T", stmt)
@@ -1964,7 +2203,7 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 	var binding *Binding
 	var table string
 
-	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN || node.NodeType == plan.Node_VALUE_SCAN {
+	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_EXTERNAL_SCAN || node.NodeType == plan.Node_FUNCTION_SCAN || node.NodeType == plan.Node_VALUE_SCAN || node.NodeType == plan.Node_SINK_SCAN {
 		if node.NodeType == plan.Node_VALUE_SCAN && node.TableDef == nil {
 			return nil
 		}
@@ -2003,6 +2242,11 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 		}
 
 		binding = NewBinding(tag, nodeID, table, cols, types, util.TableIsClusterTable(node.TableDef.TableType))
+		deniedCols, err := builder.deniedColumns(node)
+		if err != nil {
+			return err
+		}
+		binding.deniedCols = deniedCols
 	} else {
 		// Subquery
 		subCtx := builder.ctxByNode[nodeID]
@@ -2474,6 +2718,41 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr)
 
 		node.Children[0] = childID
 
+	case plan.Node_WINDOW:
+		// filters above a window can change the rows in each partition, never push them down
+		cantPushdown = filters
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], nil)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{node.Children[0]},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[0] = childID
+
+	case plan.Node_RECURSIVE_CTE:
+		// the recursive member reads the output of the previous iteration,
+		// filters above a recursive CTE can't be pushed into either side.
+		cantPushdown = filters
+
+		for i, child := range node.Children {
+			childID, cantPushdownChild := builder.pushdownFilters(child, nil)
+
+			if len(cantPushdownChild) > 0 {
+				childID = builder.appendNode(&plan.Node{
+					NodeType:   plan.Node_FILTER,
+					Children:   []int32{child},
+					FilterList: cantPushdownChild,
+				}, nil)
+			}
+
+			node.Children[i] = childID
+		}
+
 	case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN:
 		node.FilterList = append(node.FilterList, filters...)
 	case plan.Node_FUNCTION_SCAN:
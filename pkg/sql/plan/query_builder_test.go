@@ -77,6 +77,8 @@ func TestBuildTable_AlterView(t *testing.T) {
 	ctx.EXPECT().GetContext().Return(context.Background()).AnyTimes()
 	ctx.EXPECT().GetProcess().Return(nil).AnyTimes()
 	ctx.EXPECT().Stats(gomock.Any(), gomock.Any()).Return(&plan.Stats{}).AnyTimes()
+	ctx.EXPECT().ColumnStats(gomock.Any()).Return(nil).AnyTimes()
+	ctx.EXPECT().TableSecurity(gomock.Any()).Return(nil, nil).AnyTimes()
 	ctx.EXPECT().GetBuildingAlterView().Return(true, "db", "v").AnyTimes()
 
 	qb := NewQueryBuilder(plan.Query_SELECT, ctx)
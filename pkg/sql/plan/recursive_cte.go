@@ -0,0 +1,252 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// defaultMaxRecursionDepth is the default value of cte_max_recursion_depth
+const defaultMaxRecursionDepth = 1000
+
+// buildCTE builds the statement of a CTE. a CTE declared by WITH RECURSIVE is
+// built as a recursive CTE if it is a UNION [ALL] whose right-most select is
+// the recursive member, otherwise it is built as an ordinary select.
+func (builder *QueryBuilder) buildCTE(cteRef *CTERef, stmt *tree.Select, ctx *BindContext) (int32, error) {
+	if cteRef.isRecursive {
+		// strip parentheses
+		for {
+			if paren, ok := stmt.Select.(*tree.ParenSelect); ok && stmt.OrderBy == nil && stmt.Limit == nil {
+				stmt = paren.Select
+			} else {
+				break
+			}
+		}
+
+		if union, ok := stmt.Select.(*tree.UnionClause); ok && union.Type == tree.UNION {
+			if stmt.OrderBy != nil || stmt.Limit != nil {
+				return 0, moerr.NewNYI(builder.GetContext(), "ORDER BY or LIMIT in recursive CTE")
+			}
+			return builder.buildRecursiveCTE(cteRef, union, ctx)
+		}
+	}
+
+	return builder.buildSelect(stmt, ctx, false)
+}
+
+// buildRecursiveCTE builds a RECURSIVE_CTE node, the left child is the anchor
+// and the right child is the recursive member, which reads the output of the
+// previous iteration through a SINK_SCAN node.
+func (builder *QueryBuilder) buildRecursiveCTE(cteRef *CTERef, stmt *tree.UnionClause, ctx *BindContext) (int32, error) {
+	name := string(cteRef.ast.Name.Alias)
+	// the anchor must not see the CTE itself
+	ctx.cteName = name
+
+	anchorCtx := NewBindContext(builder, ctx)
+	anchorID, err := builder.buildSelect(&tree.Select{Select: stmt.Left}, anchorCtx, false)
+	if err != nil {
+		return 0, err
+	}
+	anchorNode := builder.qry.Nodes[anchorID]
+
+	// the column types of a recursive CTE are determined by the anchor only
+	headings := make([]string, len(anchorCtx.headings))
+	copy(headings, anchorCtx.headings)
+	cols := cteRef.ast.Name.Cols
+	if len(cols) > len(headings) {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "table %q has %d columns available but %d columns specified", name, len(headings), len(cols))
+	}
+	for i, col := range cols {
+		headings[i] = string(col)
+	}
+
+	typs := make([]*plan.Type, len(anchorNode.ProjectList))
+	for i, expr := range anchorNode.ProjectList {
+		typ := makeTypeByPlan2Expr(expr)
+		// the strings may grow in the iterations, don't limit their length.
+		switch typ.Oid {
+		case types.T_any:
+			typ = types.T_int64.ToType()
+		case types.T_char, types.T_varchar:
+			typ = types.T_text.ToType()
+		case types.T_binary, types.T_varbinary:
+			typ = types.T_blob.ToType()
+		}
+		typs[i] = makePlan2Type(&typ)
+		if err = builder.castProjection(anchorNode, i, typs[i]); err != nil {
+			return 0, err
+		}
+	}
+
+	recursiveCtx := NewBindContext(builder, ctx)
+	recursiveCtx.recursiveCTE = &RecursiveCTERef{
+		name:     name,
+		headings: headings,
+		types:    typs,
+	}
+	var recursiveStmt tree.SelectStatement
+	switch right := stmt.Right.(type) {
+	case *tree.ParenSelect:
+		recursiveStmt = right.Select.Select
+		if right.Select.OrderBy != nil || right.Select.Limit != nil {
+			return 0, moerr.NewNYI(builder.GetContext(), "ORDER BY or LIMIT in recursive member of CTE %q", name)
+		}
+	default:
+		recursiveStmt = right
+	}
+	recursiveID, err := builder.buildSelect(&tree.Select{Select: recursiveStmt}, recursiveCtx, false)
+	if err != nil {
+		return 0, err
+	}
+	recursiveNode := builder.qry.Nodes[recursiveID]
+
+	if len(recursiveNode.ProjectList) != len(typs) {
+		return 0, moerr.NewParseError(builder.GetContext(), "SELECT statements have different number of columns")
+	}
+	if recursiveCtx.recursiveCTE.refCnt > 1 {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "recursive CTE %q must be referenced only once in its recursive member", name)
+	}
+	if len(recursiveCtx.groups) > 0 || len(recursiveCtx.aggregates) > 0 || len(recursiveCtx.windows) > 0 || recursiveCtx.isDistinct {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "recursive member of CTE %q can contain neither aggregation nor window functions, nor GROUP BY or DISTINCT", name)
+	}
+	for i, typ := range typs {
+		if err = builder.castProjection(recursiveNode, i, typ); err != nil {
+			return 0, err
+		}
+	}
+
+	ctx.headings = append(ctx.headings, headings...)
+	lastTag := builder.genNewTag()
+	anchorTag := anchorNode.BindingTags[0]
+	projectList := make([]*plan.Expr, len(typs))
+	for i, typ := range typs {
+		projectList[i] = &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: anchorTag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		builder.nameByColRef[[2]int32{lastTag, int32(i)}] = headings[i]
+	}
+
+	node := &plan.Node{
+		NodeType:    plan.Node_RECURSIVE_CTE,
+		Children:    []int32{anchorID, recursiveID},
+		BindingTags: []int32{lastTag},
+		ProjectList: projectList,
+		// the name is used to match the SINK_SCAN nodes in the recursive member
+		TableDef: &plan.TableDef{
+			Name: name,
+		},
+		UnionAll: stmt.All,
+	}
+	if recursiveCtx.recursiveCTE.refCnt == 0 {
+		// the recursive member doesn't reference the CTE, it's just a union.
+		node.NodeType = plan.Node_UNION
+		if stmt.All {
+			node.NodeType = plan.Node_UNION_ALL
+		}
+		node.TableDef = nil
+		node.UnionAll = false
+	} else {
+		node.MaxRecursionDepth = builder.getMaxRecursionDepth()
+	}
+	lastNodeID := builder.appendNode(node, ctx)
+
+	ctx.groupTag = builder.genNewTag()
+	ctx.aggregateTag = builder.genNewTag()
+	ctx.projectTag = builder.genNewTag()
+	for i, v := range ctx.headings {
+		ctx.aliasMap[v] = int32(i)
+		builder.nameByColRef[[2]int32{ctx.projectTag, int32(i)}] = v
+	}
+	for i, typ := range typs {
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: lastTag,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	ctx.results = ctx.projects
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: ctx.projects,
+		Children:    []int32{lastNodeID},
+		BindingTags: []int32{ctx.projectTag},
+	}, ctx), nil
+}
+
+// buildSinkScan builds the reference of the working table in the recursive member.
+func (builder *QueryBuilder) buildSinkScan(recursiveCTE *RecursiveCTERef, ctx *BindContext) int32 {
+	recursiveCTE.refCnt++
+
+	cols := make([]*plan.ColDef, len(recursiveCTE.headings))
+	for i, heading := range recursiveCTE.headings {
+		cols[i] = &plan.ColDef{
+			Name: heading,
+			Typ:  recursiveCTE.types[i],
+		}
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType: plan.Node_SINK_SCAN,
+		TableDef: &plan.TableDef{
+			Name: recursiveCTE.name,
+			Cols: cols,
+		},
+		BindingTags: []int32{builder.genNewTag()},
+	}, ctx)
+}
+
+// castProjection casts the i-th projection of a select to typ.
+func (builder *QueryBuilder) castProjection(node *plan.Node, i int, typ *plan.Type) error {
+	expr := node.ProjectList[i]
+	if makeTypeByPlan2Expr(expr).Eq(makeTypeByPlan2Type(typ)) {
+		return nil
+	}
+
+	if expr.Typ.Id == int32(types.T_any) {
+		expr.Typ = typ
+		return nil
+	}
+
+	var err error
+	node.ProjectList[i], err = appendCastBeforeExpr(builder.GetContext(), expr, typ)
+	return err
+}
+
+func (builder *QueryBuilder) getMaxRecursionDepth() int64 {
+	val, err := builder.compCtx.ResolveVariable("cte_max_recursion_depth", true, false)
+	if err == nil {
+		switch v := val.(type) {
+		case int64:
+			return v
+		case uint64:
+			return int64(v)
+		}
+	}
+	return defaultMaxRecursionDepth
+}
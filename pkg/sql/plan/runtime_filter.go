@@ -0,0 +1,113 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// generateRuntimeFilters marks the joins whose build sides generate the runtime filters
+// after the hash maps are built, and the table scans on the probe sides which skip the
+// blocks and the rows never matching the joins by the filters.
+func (builder *QueryBuilder) generateRuntimeFilters(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.generateRuntimeFilters(childID)
+	}
+	if node.NodeType != plan.Node_JOIN || len(node.Children) != 2 {
+		return
+	}
+	// the rows of the probe side not matching the build side are dropped by the join
+	if node.JoinType != plan.Node_INNER && node.JoinType != plan.Node_SEMI {
+		return
+	}
+	buildNode := builder.qry.Nodes[node.Children[1]]
+	if buildNode.Stats == nil {
+		return
+	}
+
+	leftTags := make(map[int32]*Binding)
+	for _, tag := range builder.enumerateTags(node.Children[0]) {
+		leftTags[tag] = nil
+	}
+	rightTags := make(map[int32]*Binding)
+	for _, tag := range builder.enumerateTags(node.Children[1]) {
+		rightTags[tag] = nil
+	}
+
+	for _, expr := range node.OnList {
+		fn, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || !SupportedJoinCondition(fn.F.Func.GetObj()) || len(fn.F.Args) != 2 {
+			continue
+		}
+		probeExpr, buildExpr := fn.F.Args[0], fn.F.Args[1]
+		if getJoinSide(probeExpr, leftTags, rightTags, 0) != JoinSideLeft {
+			probeExpr, buildExpr = buildExpr, probeExpr
+		}
+		if getJoinSide(probeExpr, leftTags, rightTags, 0) != JoinSideLeft ||
+			getJoinSide(buildExpr, leftTags, rightTags, 0) != JoinSideRight {
+			continue
+		}
+		col, ok := probeExpr.Expr.(*plan.Expr_Col)
+		// the keys are compared with the zonemaps of the probe column as they are
+		if !ok || probeExpr.Typ.Id != buildExpr.Typ.Id || probeExpr.Typ.Scale != buildExpr.Typ.Scale {
+			continue
+		}
+		scan := builder.findRuntimeFilterProbeScan(node.Children[0], col.Col.RelPos)
+		if scan == nil || scan.Stats == nil || scan.Stats.TableCnt <= buildNode.Stats.Outcnt {
+			continue
+		}
+
+		tag := builder.genNewTag()
+		node.RuntimeFilterBuildList = append(node.RuntimeFilterBuildList, &plan.RuntimeFilterSpec{
+			Tag:  tag,
+			Expr: DeepCopyExpr(buildExpr),
+		})
+		scan.RuntimeFilterProbeList = append(scan.RuntimeFilterProbeList, &plan.RuntimeFilterSpec{
+			Tag:  tag,
+			Expr: DeepCopyExpr(probeExpr),
+		})
+	}
+}
+
+// findRuntimeFilterProbeScan returns the table scan bound to the tag if the rows of the
+// scan not matching the join above the node never appear in the result of the join.
+func (builder *QueryBuilder) findRuntimeFilterProbeScan(nodeID int32, tag int32) *plan.Node {
+	node := builder.qry.Nodes[nodeID]
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		// the rows locked are read from the table as they are
+		if node.BindingTags[0] != tag || node.LockTarget != nil || node.ObjRef == nil {
+			return nil
+		}
+		return node
+
+	case plan.Node_FILTER:
+		return builder.findRuntimeFilterProbeScan(node.Children[0], tag)
+
+	case plan.Node_JOIN:
+		children := node.Children
+		if node.JoinType != plan.Node_INNER {
+			// the other joins don't simply drop the unmatched rows of the right side
+			children = children[:1]
+		}
+		for _, childID := range children {
+			if scan := builder.findRuntimeFilterProbeScan(childID, tag); scan != nil {
+				return scan
+			}
+		}
+	}
+	return nil
+}
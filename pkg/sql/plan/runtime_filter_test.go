@@ -0,0 +1,67 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// findJoinNode returns the first join reachable from the root of the query
+func findJoinNode(qry *plan.Query) *plan.Node {
+	var find func(nodeID int32) *plan.Node
+	find = func(nodeID int32) *plan.Node {
+		node := qry.Nodes[nodeID]
+		if node.NodeType == plan.Node_JOIN {
+			return node
+		}
+		for _, childID := range node.Children {
+			if join := find(childID); join != nil {
+				return join
+			}
+		}
+		return nil
+	}
+	return find(qry.Steps[len(qry.Steps)-1])
+}
+
+func TestRuntimeFilter(t *testing.T) {
+	opt := newColumnStatsTestOptimizer()
+
+	// the keys of nation are pushed into the scan of customer
+	logicPlan, err := runOneStmt(opt, t, "select c_name from customer join nation on c_nationkey = n_nationkey where n_name = 'CHINA'")
+	require.NoError(t, err)
+	qry := logicPlan.GetQuery()
+	join := findJoinNode(qry)
+	require.NotNil(t, join)
+	scan := findScanNode(qry, "customer")
+	require.NotNil(t, scan)
+	require.Equal(t, 1, len(join.RuntimeFilterBuildList))
+	require.Equal(t, 1, len(scan.RuntimeFilterProbeList))
+	require.Equal(t, join.RuntimeFilterBuildList[0].Tag, scan.RuntimeFilterProbeList[0].Tag)
+	col := scan.RuntimeFilterProbeList[0].Expr.GetCol()
+	require.NotNil(t, col)
+	require.Equal(t, "c_nationkey", scan.TableDef.Cols[col.ColPos].Name)
+	require.Empty(t, findScanNode(qry, "nation").RuntimeFilterProbeList)
+
+	// the unmatched rows of customer are kept by the left join
+	logicPlan, err = runOneStmt(opt, t, "select c_name from customer left join nation on c_nationkey = n_nationkey")
+	require.NoError(t, err)
+	qry = logicPlan.GetQuery()
+	require.Empty(t, findJoinNode(qry).RuntimeFilterBuildList)
+	require.Empty(t, findScanNode(qry, "customer").RuntimeFilterProbeList)
+}
@@ -112,6 +112,19 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool) {
 		isCrossJoin := false
 		selectivity := math.Pow(rightStats.Selectivity, math.Pow(leftStats.Selectivity, 0.5))
 
+		if builder.hasColumnStats() && len(node.OnList) > 0 {
+			leftTags := make(map[int32]bool)
+			for _, tag := range builder.enumerateTags(node.Children[0]) {
+				leftTags[tag] = true
+			}
+			if joinNdv, ok := builder.estimateJoinNdv(node.OnList, leftTags, leftStats.Outcnt, rightStats.Outcnt); ok {
+				node.Stats = estimateJoinStatsByNdv(node.JoinType, leftStats, rightStats, joinNdv, selectivity)
+				if node.Stats != nil {
+					break
+				}
+			}
+		}
+
 		switch node.JoinType {
 		case plan.Node_INNER:
 			outcnt := leftStats.Outcnt * rightStats.Outcnt / ndv
@@ -210,6 +223,12 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool) {
 			Cost:        leftStats.Outcnt + rightStats.Outcnt,
 			Selectivity: 1,
 		}
+	case plan.Node_RECURSIVE_CTE:
+		node.Stats = &plan.Stats{
+			Outcnt:      leftStats.Outcnt + rightStats.Outcnt,
+			Cost:        leftStats.Cost + rightStats.Cost,
+			Selectivity: 1,
+		}
 	case plan.Node_INTERSECT:
 		node.Stats = &plan.Stats{
 			Outcnt:      math.Min(leftStats.Outcnt, rightStats.Outcnt) * 0.5,
@@ -250,6 +269,7 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool) {
 				node.Stats.Selectivity *= 0.15
 				node.Stats.Outcnt *= 0.15
 			}
+			builder.applyColumnStats(node)
 		}
 
 	default:
@@ -265,6 +285,37 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool) {
 	}
 }
 
+// estimateJoinStatsByNdv estimates the stats of the join by the ndv of the join
+// conditions, nil is returned if the join type is not supported.
+func estimateJoinStatsByNdv(joinType plan.Node_JoinFlag, leftStats, rightStats *plan.Stats, ndv, selectivity float64) *plan.Stats {
+	inner := leftStats.Outcnt * rightStats.Outcnt / ndv
+	var outcnt float64
+	switch joinType {
+	case plan.Node_INNER:
+		outcnt = inner
+	case plan.Node_LEFT:
+		outcnt = math.Max(inner, leftStats.Outcnt)
+	case plan.Node_RIGHT:
+		outcnt = math.Max(inner, rightStats.Outcnt)
+	case plan.Node_OUTER:
+		outcnt = math.Max(inner, math.Max(leftStats.Outcnt, rightStats.Outcnt))
+	case plan.Node_SEMI:
+		// each matched row of the left side is output only once
+		outcnt = math.Min(inner, leftStats.Outcnt)
+	default:
+		return nil
+	}
+	if outcnt < 1 {
+		outcnt = 1
+	}
+	return &plan.Stats{
+		Outcnt:      outcnt,
+		Cost:        leftStats.Cost + rightStats.Cost,
+		HashmapSize: rightStats.Outcnt,
+		Selectivity: selectivity,
+	}
+}
+
 func DefaultStats() *plan.Stats {
 	stats := new(Stats)
 	stats.TableCnt = 1000
@@ -0,0 +1,234 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// TableSecurity is the column privileges and the row level security policies
+// of a table applied to the current user
+type TableSecurity struct {
+	// SelectColumns is the columns the user can select, nil if all the columns can be selected
+	SelectColumns map[string]struct{}
+	// UpdateColumns is the columns the user can update, nil if all the columns can be updated
+	UpdateColumns map[string]struct{}
+	// HasPolicy is true if the table has any row level security policy, the rows
+	// of such a table are invisible to the user unless a policy of the user allows them.
+	HasPolicy bool
+	// Policies is the row level security policies of the table applied to the roles of the user
+	Policies []*RowPolicy
+}
+
+// RowPolicy is a row level security policy, the rows satisfying the filter
+// are visible to the statements of the command
+type RowPolicy struct {
+	Name    string
+	Command tree.PolicyCommand
+	// Using is the sql text of the filter
+	Using string
+}
+
+func (s *TableSecurity) canSelect(col string) bool {
+	if s == nil || s.SelectColumns == nil {
+		return true
+	}
+	_, ok := s.SelectColumns[col]
+	return ok
+}
+
+func (s *TableSecurity) canUpdate(col string) bool {
+	if s == nil || s.UpdateColumns == nil {
+		return true
+	}
+	_, ok := s.UpdateColumns[col]
+	return ok
+}
+
+// rowFilter returns the sql text of the filter on the rows scanned for the
+// command, the filters of the policies are ORed. It returns "" if the rows
+// are not filtered.
+func (s *TableSecurity) rowFilter(cmd tree.PolicyCommand) string {
+	if s == nil || !s.HasPolicy {
+		return ""
+	}
+	var conds []string
+	for _, p := range s.Policies {
+		if p.Command == tree.PolicyCommandAll || p.Command == cmd {
+			conds = append(conds, "("+p.Using+")")
+		}
+	}
+	if len(conds) == 0 {
+		return "false"
+	}
+	return strings.Join(conds, " or ")
+}
+
+func qualifiedTableName(obj *ObjectRef) string {
+	return obj.SchemaName + "." + obj.ObjName
+}
+
+// getTableSecurity returns the column privileges and the row level security
+// policies of the table, they are resolved once for a statement.
+func (builder *QueryBuilder) getTableSecurity(obj *ObjectRef) (*TableSecurity, error) {
+	key := qualifiedTableName(obj)
+	if security, ok := builder.securityByTable[key]; ok {
+		return security, nil
+	}
+	security, err := builder.compCtx.TableSecurity(obj)
+	if err != nil {
+		return nil, err
+	}
+	if builder.securityByTable == nil {
+		builder.securityByTable = make(map[string]*TableSecurity)
+	}
+	builder.securityByTable[key] = security
+	return security, nil
+}
+
+// setPolicyCommand makes the scans of the tables filtered by the policies of the command
+func (builder *QueryBuilder) setPolicyCommand(objs []*ObjectRef, cmd tree.PolicyCommand) {
+	if builder.policyCommands == nil {
+		builder.policyCommands = make(map[string]tree.PolicyCommand)
+	}
+	for _, obj := range objs {
+		builder.policyCommands[qualifiedTableName(obj)] = cmd
+	}
+}
+
+// checkUpdateColumns checks the user can update the columns of the table
+func (builder *QueryBuilder) checkUpdateColumns(obj *ObjectRef, cols map[string]tree.Expr) error {
+	security, err := builder.getTableSecurity(obj)
+	if err != nil {
+		return err
+	}
+	for col := range cols {
+		if !security.canUpdate(col) {
+			return moerr.NewInternalError(builder.GetContext(), "do not have privilege to update the column %s of the table %s", col, obj.ObjName)
+		}
+	}
+	return nil
+}
+
+// deniedColumns returns the positions of the columns of the table scan node
+// the user can not select, nil if all the columns can be selected
+func (builder *QueryBuilder) deniedColumns(node *plan.Node) (map[int32]struct{}, error) {
+	if node.NodeType != plan.Node_TABLE_SCAN || node.ObjRef == nil {
+		return nil, nil
+	}
+	security, err := builder.getTableSecurity(node.ObjRef)
+	if err != nil || security == nil || security.SelectColumns == nil {
+		return nil, err
+	}
+	var denied map[int32]struct{}
+	for i, col := range node.TableDef.Cols {
+		if col.Hidden || security.canSelect(col.Name) {
+			continue
+		}
+		if denied == nil {
+			denied = make(map[int32]struct{})
+		}
+		denied[int32(i)] = struct{}{}
+	}
+	return denied, nil
+}
+
+// trustColRef marks the column reference made up by the planner,
+// the columns of a trusted star are trusted too.
+func (builder *QueryBuilder) trustColRef(name *tree.UnresolvedName) {
+	if builder.trustedColRefs == nil {
+		builder.trustedColRefs = make(map[*tree.UnresolvedName]struct{})
+	}
+	builder.trustedColRefs[name] = struct{}{}
+}
+
+func (builder *QueryBuilder) isTrustedColRef(name *tree.UnresolvedName) bool {
+	_, ok := builder.trustedColRefs[name]
+	return ok
+}
+
+// checkColumnPrivilege checks the user can select the column bound
+func (builder *QueryBuilder) checkColumnPrivilege(binding *Binding, colPos int32, astExpr *tree.UnresolvedName) error {
+	if builder == nil || binding == nil || binding.deniedCols == nil {
+		return nil
+	}
+	if _, ok := binding.deniedCols[colPos]; !ok || builder.isTrustedColRef(astExpr) {
+		return nil
+	}
+	return moerr.NewInternalError(builder.GetContext(), "do not have privilege to select the column %s of the table %s", binding.cols[colPos], binding.table)
+}
+
+// applyRowPolicies filters the rows of the table scan node by the row level
+// security policies of the table.
+func (builder *QueryBuilder) applyRowPolicies(nodeID int32, alias tree.AliasClause) error {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.ObjRef == nil {
+		return nil
+	}
+	cmd, ok := builder.policyCommands[qualifiedTableName(node.ObjRef)]
+	if !ok {
+		cmd = tree.PolicyCommandSelect
+	}
+	return builder.applyRowPoliciesOfCommand(nodeID, alias, cmd)
+}
+
+// applyRowPoliciesOfCommand filters the rows of the table scan node by the row
+// level security policies of the command.
+func (builder *QueryBuilder) applyRowPoliciesOfCommand(nodeID int32, alias tree.AliasClause, cmd tree.PolicyCommand) error {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.ObjRef == nil {
+		return nil
+	}
+	security, err := builder.getTableSecurity(node.ObjRef)
+	if err != nil {
+		return err
+	}
+	filter := security.rowFilter(cmd)
+	if filter == "" {
+		return nil
+	}
+
+	stmt, err := mysql.ParseOne(builder.GetContext(), "select * from t where "+filter, 1)
+	if err != nil {
+		return err
+	}
+	where := stmt.(*tree.Select).Select.(*tree.SelectClause).Where
+
+	// the filters see the columns of the table only, by their own names
+	// and regardless of the column privileges of the user
+	policyCtx := NewBindContext(builder, nil)
+	err = builder.addBinding(nodeID, tree.AliasClause{Alias: alias.Alias}, policyCtx)
+	if err != nil {
+		return err
+	}
+	policyCtx.bindings[0].deniedCols = nil
+	policyCtx.binder = NewWhereBinder(builder, policyCtx)
+	exprs, err := splitAndBindCondition(where.Expr, policyCtx)
+	if err != nil {
+		return err
+	}
+	for _, expr := range exprs {
+		if hasSubquery(expr) {
+			return moerr.NewNotSupported(builder.GetContext(), "subquery in the row level security policy of the table %s", node.ObjRef.ObjName)
+		}
+	}
+	node.FilterList = append(node.FilterList, exprs...)
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func columnSet(cols ...string) map[string]struct{} {
+	s := make(map[string]struct{}, len(cols))
+	for _, col := range cols {
+		s[col] = struct{}{}
+	}
+	return s
+}
+
+func TestColumnPrivilege(t *testing.T) {
+	opt := NewMockOptimizer(false)
+	opt.ctxt.security = map[string]*TableSecurity{
+		"nation": {SelectColumns: columnSet("n_nationkey", "n_name")},
+	}
+	runTestShouldPass(opt, t, []string{
+		"select n_nationkey, n_name from nation where n_nationkey > 1 order by n_name",
+		"select count(*) from nation",
+		"select r_comment from region",
+	}, false, false)
+
+	for _, sql := range []string{
+		"select * from nation",
+		"select n_comment from nation",
+		"select n_name from nation where n_regionkey = 1",
+		"select a.n_name from nation a join region b on a.n_regionkey = b.r_regionkey",
+		"select n_name from nation where n_nationkey in (select n_regionkey from nation)",
+	} {
+		_, err := runOneStmt(opt, t, sql)
+		require.Error(t, err, sql)
+		require.Contains(t, err.Error(), "do not have privilege to select the column", sql)
+	}
+}
+
+func TestColumnPrivilegeOfUpdate(t *testing.T) {
+	opt := NewMockOptimizer(true)
+	opt.ctxt.security = map[string]*TableSecurity{
+		"nation": {
+			SelectColumns: columnSet("n_nationkey"),
+			UpdateColumns: columnSet("n_name"),
+		},
+	}
+	// all the columns are read to write the rows, but only the columns
+	// of the statement are checked
+	runTestShouldPass(opt, t, []string{
+		"update nation set n_name = 'a' where n_nationkey = 1",
+	}, false, false)
+
+	_, err := runOneStmt(opt, t, "update nation set n_comment = 'a' where n_nationkey = 1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "do not have privilege to update the column n_comment")
+
+	_, err = runOneStmt(opt, t, "update nation set n_name = n_comment where n_nationkey = 1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "do not have privilege to select the column n_comment")
+}
+
+func scanFilters(t *testing.T, p *Plan, table string) []*plan.Expr {
+	for _, node := range p.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef.Name == table {
+			return node.FilterList
+		}
+	}
+	t.Fatalf("no scan of the table %s", table)
+	return nil
+}
+
+func TestRowPolicy(t *testing.T) {
+	opt := NewMockOptimizer(true)
+	security := &TableSecurity{
+		HasPolicy: true,
+		Policies: []*RowPolicy{
+			{Name: "p1", Command: tree.PolicyCommandSelect, Using: "n_regionkey = 1"},
+			{Name: "p2", Command: tree.PolicyCommandDelete, Using: "n_nationkey > 10"},
+		},
+	}
+	opt.ctxt.security = map[string]*TableSecurity{"nation": security}
+
+	p, err := runOneStmt(opt, t, "select n_name from nation")
+	require.NoError(t, err)
+	filters := scanFilters(t, p, "nation")
+	require.Equal(t, 1, len(filters))
+	require.Contains(t, filters[0].String(), "=")
+
+	// the filters of the policies are bound to the columns of the table by
+	// their own names, whatever the alias is
+	p, err = runOneStmt(opt, t, "select a.n_name from nation a join region n_regionkey on a.n_regionkey = n_regionkey.r_regionkey")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(scanFilters(t, p, "nation")))
+
+	p, err = runOneStmt(opt, t, "delete from nation where n_name = 'a'")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(scanFilters(t, p, "nation")))
+
+	// no policy allows the rows to be updated
+	require.Equal(t, "false", security.rowFilter(tree.PolicyCommandUpdate))
+	require.Equal(t, "(n_regionkey = 1)", security.rowFilter(tree.PolicyCommandSelect))
+	require.Equal(t, "", (*TableSecurity)(nil).rowFilter(tree.PolicyCommandSelect))
+
+	security.Policies = []*RowPolicy{
+		{Name: "p3", Using: "n_nationkey in (select r_regionkey from region)"},
+	}
+	_, err = runOneStmt(opt, t, "select n_name from nation")
+	require.Error(t, err)
+}
+
+func TestSecurityOfOnDuplicateKeyUpdate(t *testing.T) {
+	opt := NewMockOptimizer(true)
+	addTableFromSql(t, opt, "odku_t", "create table odku_t (a int primary key, b int, c varchar(10))")
+	security := &TableSecurity{
+		UpdateColumns: columnSet("b"),
+		HasPolicy:     true,
+		Policies: []*RowPolicy{
+			{Name: "p1", Command: tree.PolicyCommandUpdate, Using: "b > 1"},
+		},
+	}
+	opt.ctxt.security = map[string]*TableSecurity{"odku_t": security}
+
+	// the conflicting rows are filtered by the update policies
+	p, err := runOneStmt(opt, t, "insert into odku_t values (1, 1, 'a') on duplicate key update b = 2")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(scanFilters(t, p, "odku_t")))
+
+	_, err = runOneStmt(opt, t, "insert into odku_t values (1, 1, 'a') on duplicate key update c = 'b'")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "do not have privilege to update the column c")
+
+	// replace updates all the columns of the conflicting rows
+	_, err = runOneStmt(opt, t, "replace into odku_t values (1, 1, 'a')")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "do not have privilege to update the column")
+
+	security.UpdateColumns = nil
+	p, err = runOneStmt(opt, t, "replace into odku_t values (1, 1, 'a')")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(scanFilters(t, p, "odku_t")))
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildAsOfTimestamp evaluates the expression of the AS OF TIMESTAMP clause to
+// the timestamp at which the table is read.
+func (builder *QueryBuilder) buildAsOfTimestamp(astExpr tree.Expr, ctx *BindContext) (*timestamp.Timestamp, error) {
+	expr, err := NewAsOfBinder(builder, ctx).BindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+
+	proc := builder.compCtx.GetProcess()
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	vec, err := colexec.EvalExpr(bat, proc, expr)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Free(proc.Mp())
+	if vec.IsConstNull() || nulls.Contains(vec.GetNulls(), 0) {
+		return nil, moerr.NewInvalidInput(builder.GetContext(), "as of timestamp can not be null")
+	}
+	ts := TimestampToTxnTS(vector.GetFixedAt[types.Timestamp](vec, 0))
+	return &ts, nil
+}
+
+// TimestampToTxnTS converts a TIMESTAMP value to the timestamp of txn.
+func TimestampToTxnTS(ts types.Timestamp) timestamp.Timestamp {
+	return timestamp.Timestamp{
+		PhysicalTime: (int64(ts) - types.GetUnixEpochSecs()) * int64(time.Microsecond),
+	}
+}
+
+// CheckSnapshotTS returns an error if the data at ts can not be read, that is ts is
+// later than now or the history at ts may have been collected by the gc of TAE.
+func CheckSnapshotTS(ctx context.Context, ts timestamp.Timestamp) error {
+	now := time.Now()
+	tsString := types.Timestamp(ts.PhysicalTime/int64(time.Microsecond) + types.GetUnixEpochSecs()).String()
+	if ts.PhysicalTime > now.UnixNano() {
+		return moerr.NewInvalidInput(ctx, "snapshot %s is later than now", tsString)
+	}
+	rt := runtime.ProcessLevelRuntime()
+	if rt == nil {
+		return nil
+	}
+	v, ok := rt.GetGlobalVariables(runtime.SnapshotRetention)
+	if !ok {
+		return nil
+	}
+	retention := v.(time.Duration)
+	if retention > 0 && ts.PhysicalTime < now.Add(-retention).UnixNano() {
+		return moerr.NewSnapshotTooOld(ctx, tsString, retention.String())
+	}
+	return nil
+}
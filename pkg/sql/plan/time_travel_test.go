@@ -0,0 +1,67 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsOfTimestamp(t *testing.T) {
+	opt := NewMockOptimizer(false)
+
+	pn, err := runOneStmt(opt, t, "select n_name from nation as of timestamp '2023-04-01 10:00:00' as n where n.n_regionkey = 1")
+	require.NoError(t, err)
+	node := findScanNode(pn.GetQuery(), "nation")
+	require.NotNil(t, node)
+	require.NotNil(t, node.ScanTs)
+	ts, err := types.ParseTimestamp(time.Local, "2023-04-01 10:00:00", 6)
+	require.NoError(t, err)
+	require.Equal(t, TimestampToTxnTS(ts), *node.ScanTs)
+
+	pn, err = runOneStmt(opt, t, "select n_name from nation")
+	require.NoError(t, err)
+	require.Nil(t, findScanNode(pn.GetQuery(), "nation").ScanTs)
+
+	// the history is not locked
+	pn, err = runOneStmt(opt, t, "select n_name from nation as of timestamp '2023-04-01 10:00:00' for update")
+	require.NoError(t, err)
+	require.Nil(t, findScanNode(pn.GetQuery(), "nation").LockTarget)
+
+	_, err = runOneStmt(opt, t, "select n_name from nation as of timestamp n_name")
+	require.Error(t, err)
+	_, err = runOneStmt(opt, t, "select n_name from nation as of timestamp null")
+	require.Error(t, err)
+}
+
+func TestCheckSnapshotTS(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.SnapshotRetention, time.Hour)
+
+	require.NoError(t, CheckSnapshotTS(ctx, timestamp.Timestamp{PhysicalTime: now.Add(-time.Minute).UnixNano()}))
+	err := CheckSnapshotTS(ctx, timestamp.Timestamp{PhysicalTime: now.Add(-2 * time.Hour).UnixNano()})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSnapshotTooOld))
+	err = CheckSnapshotTS(ctx, timestamp.Timestamp{PhysicalTime: now.Add(time.Hour).UnixNano()})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
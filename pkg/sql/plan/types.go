@@ -60,6 +60,7 @@ type ForeignKeyDef = plan.ForeignKeyDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
+type RuntimeFilterSpec = plan.RuntimeFilterSpec
 
 type CompilerContext interface {
 	// Default database/schema in context
@@ -82,6 +83,11 @@ type CompilerContext interface {
 	GetHideKeyDef(dbName string, tableName string) *ColDef
 	// get estimated stats by table & expr
 	Stats(obj *ObjectRef, e *Expr) *Stats
+	// get the column statistics collected by analyze table, nil if the table is not analyzed
+	ColumnStats(obj *ObjectRef) *TableColumnStats
+	// get the column privileges and the row level security policies of the table applied to
+	// the current user, nil if the user is not restricted on the table
+	TableSecurity(obj *ObjectRef) (*TableSecurity, error)
 	// get origin sql string of the root
 	GetRootSql() string
 	// get username of current session
@@ -149,14 +155,42 @@ type QueryBuilder struct {
 	nextTag int32
 
 	mysqlCompatible bool
+
+	// column statistics of the table scan nodes, keyed by the binding tag
+	colStatsByTag map[int32]*scanColumnStats
+
+	// the column privileges and the row level security policies of the tables,
+	// keyed by the qualified table name
+	securityByTable map[string]*TableSecurity
+	// the statements the tables are scanned for, keyed by the qualified table
+	// name, the tables not in it are scanned for select
+	policyCommands map[string]tree.PolicyCommand
+	// the column references made up by the planner itself, which are not
+	// checked against the column privileges
+	trustedColRefs map[*tree.UnresolvedName]struct{}
+}
+
+type scanColumnStats struct {
+	node  *plan.Node
+	stats *TableColumnStats
 }
 
 type CTERef struct {
 	defaultDatabase string
+	isRecursive     bool
 	ast             *tree.CTE
 	maskedCTEs      map[string]any
 }
 
+// RecursiveCTERef is the working table of a recursive CTE, it is
+// referenced by the recursive member of the CTE as a SINK_SCAN node.
+type RecursiveCTERef struct {
+	name     string
+	headings []string
+	types    []*plan.Type
+	refCnt   int
+}
+
 type BindContext struct {
 	binder Binder
 
@@ -166,18 +200,24 @@ type BindContext struct {
 	cteName  string
 	headings []string
 
+	// recursiveCTE is set when the context is the recursive member of a recursive CTE
+	recursiveCTE *RecursiveCTERef
+
 	groupTag     int32
 	aggregateTag int32
+	windowTag    int32
 	projectTag   int32
 	resultTag    int32
 
 	groups     []*plan.Expr
 	aggregates []*plan.Expr
+	windows    []*plan.WindowSpec
 	projects   []*plan.Expr
 	results    []*plan.Expr
 
 	groupByAst     map[string]int32
 	aggregateByAst map[string]int32
+	windowByAst    map[string]int32
 	projectByExpr  map[string]int32
 
 	aliasMap map[string]int32
@@ -263,6 +303,7 @@ type HavingBinder struct {
 type ProjectionBinder struct {
 	baseBinder
 	havingBinder *HavingBinder
+	insideWin    bool
 }
 
 type OrderBinder struct {
@@ -274,6 +315,10 @@ type LimitBinder struct {
 	baseBinder
 }
 
+type AsOfBinder struct {
+	baseBinder
+}
+
 type PartitionBinder struct {
 	baseBinder
 }
@@ -301,6 +346,8 @@ type Binding struct {
 	refCnts        []uint
 	colIdByName    map[string]int32
 	isClusterTable bool
+	// the columns can not be selected by the current user
+	deniedCols map[int32]struct{}
 }
 
 const (
@@ -51,6 +51,35 @@ func (m *MockCompilerContext2) EXPECT() *MockCompilerContext2MockRecorder {
 	return m.recorder
 }
 
+// ColumnStats mocks base method.
+func (m *MockCompilerContext2) ColumnStats(obj *plan.ObjectRef) *TableColumnStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ColumnStats", obj)
+	ret0, _ := ret[0].(*TableColumnStats)
+	return ret0
+}
+
+// ColumnStats indicates an expected call of ColumnStats.
+func (mr *MockCompilerContext2MockRecorder) ColumnStats(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColumnStats", reflect.TypeOf((*MockCompilerContext2)(nil).ColumnStats), obj)
+}
+
+// TableSecurity mocks base method.
+func (m *MockCompilerContext2) TableSecurity(obj *plan.ObjectRef) (*TableSecurity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TableSecurity", obj)
+	ret0, _ := ret[0].(*TableSecurity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TableSecurity indicates an expected call of TableSecurity.
+func (mr *MockCompilerContext2MockRecorder) TableSecurity(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TableSecurity", reflect.TypeOf((*MockCompilerContext2)(nil).TableSecurity), obj)
+}
+
 // DatabaseExists mocks base method.
 func (m *MockCompilerContext2) DatabaseExists(name string) bool {
 	m.ctrl.T.Helper()
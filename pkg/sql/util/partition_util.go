@@ -0,0 +1,32 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// BuildPartitionTableName generates the name of the hidden table storing a partition
+func BuildPartitionTableName(ctx context.Context) (string, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return "", moerr.NewInternalError(ctx, "newuuid failed")
+	}
+	return catalog.PrefixPartitionTableName + id.String(), nil
+}
@@ -163,6 +163,7 @@ func buildDNConfig(
 		ListenAddress: address.getDnListenAddress(index),
 	}
 	cfg.LogtailServer.ListenAddress = address.getDnLogtailAddress(index)
+	cfg.LockService.ListenAddress = address.getDnLockAddress(index)
 	cfg.DataDir = filepath.Join(opt.rootDataDir, cfg.UUID)
 	cfg.HAKeeper.ClientConfig.ServiceAddresses = address.listHAKeeperListenAddresses()
 	cfg.HAKeeper.HeatbeatInterval.Duration = opt.heartbeat.dn
@@ -113,6 +113,16 @@ func (a serviceAddresses) getDnLogtailAddress(index int) string {
 	return a.dnAddresses[index].logtailAddr
 }
 
+// getDnLockAddress gets lock server address by its index.
+func (a serviceAddresses) getDnLockAddress(index int) string {
+	a.assertDNService()
+
+	if index >= len(a.dnAddresses) || index < 0 {
+		return ""
+	}
+	return a.dnAddresses[index].lockAddr
+}
+
 // getLogListenAddress gets log service address by its index.
 func (a serviceAddresses) getLogListenAddress(index int) string {
 	a.assertLogService()
@@ -270,22 +280,24 @@ func (la logServiceAddress) listAddresses() []string {
 type dnServiceAddress struct {
 	listenAddr  string
 	logtailAddr string
+	lockAddr    string
 }
 
 func newDNServiceAddress(host string) (dnServiceAddress, error) {
-	addrs, err := tests.GetAddressBatch(host, 2)
+	addrs, err := tests.GetAddressBatch(host, 3)
 	if err != nil {
 		return dnServiceAddress{}, err
 	}
 	return dnServiceAddress{
 		listenAddr:  addrs[0],
 		logtailAddr: addrs[1],
+		lockAddr:    addrs[2],
 	}, nil
 }
 
 // listAddresses returns all addresses for single dn service.
 func (da dnServiceAddress) listAddresses() []string {
-	return []string{da.listenAddr, da.logtailAddr}
+	return []string{da.listenAddr, da.logtailAddr, da.lockAddr}
 }
 
 type cnServiceAddress struct {
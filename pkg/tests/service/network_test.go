@@ -32,8 +32,8 @@ func TestServiceAddress(t *testing.T) {
 
 	for i := 0; i < dnServiceNum; i++ {
 		addrList := address.listDnServiceAddresses(i)
-		// 1 address for every dn service now
-		require.Equal(t, 2, len(addrList))
+		// 3 addresses for every dn service now
+		require.Equal(t, 3, len(addrList))
 	}
 	// valid dn index: 0, 1
 	// invalid dn index: 2
@@ -78,9 +78,9 @@ func TestServiceAddress(t *testing.T) {
 	// there are 2 address sets corresponding with 2 partitions
 	require.Equal(t, 2, len(addrSets))
 	// in partition 1, there are 1 dn service, 1 log service and 1 cn service.
-	require.Equal(t, 3+2+1, len(addrSets[0]))
+	require.Equal(t, 3+3+1, len(addrSets[0]))
 	// in partition 2, there are 1 dn service, 1 cn service and 2 log service.
-	require.Equal(t, 3*2+2+1, len(addrSets[1]))
+	require.Equal(t, 3*2+3+1, len(addrSets[1]))
 
 	// the first address set should contain the following addresses.
 	dnListenAddr := address.getDnListenAddress(int(dnIndex))
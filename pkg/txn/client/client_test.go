@@ -68,6 +68,24 @@ func TestNewTxnWithSnapshotTS(t *testing.T) {
 	assert.Equal(t, txn.TxnStatus_Active, txnMeta.Status)
 }
 
+func TestNewTxnWithTxnMode(t *testing.T) {
+	rt := runtime.NewRuntime(metadata.ServiceType_CN, "",
+		logutil.GetPanicLogger(),
+		runtime.WithClock(clock.NewHLCClock(func() int64 {
+			return 1
+		}, 0)))
+	c := NewTxnClient(rt, newTestTxnSender())
+	tx, err := c.New()
+	assert.Nil(t, err)
+	assert.Equal(t, txn.TxnMode_Optimistic, tx.Txn().Mode)
+	assert.False(t, tx.Txn().IsPessimistic())
+
+	tx, err = c.New(WithTxnMode(txn.TxnMode_Pessimistic))
+	assert.Nil(t, err)
+	assert.Equal(t, txn.TxnMode_Pessimistic, tx.Txn().Mode)
+	assert.True(t, tx.Txn().IsPessimistic())
+}
+
 func newTestTxnSender() *testTxnSender {
 	return &testTxnSender{auto: true}
 }
@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
@@ -106,6 +107,14 @@ func WithSnapshotTS(ts timestamp.Timestamp) TxnOption {
 	}
 }
 
+// WithTxnMode set the txn mode, the txn is optimistic by default. A pessimistic txn
+// use the lockservice to lock the modified rows before they are written.
+func WithTxnMode(mode txn.TxnMode) TxnOption {
+	return func(tc *txnOperator) {
+		tc.mu.txn.Mode = mode
+	}
+}
+
 type txnOperator struct {
 	rt     runtime.Runtime
 	sender rpc.TxnSender
@@ -237,6 +246,23 @@ func (tc *txnOperator) ApplySnapshot(data []byte) error {
 	return nil
 }
 
+func (tc *txnOperator) UpdateSnapshot(ctx context.Context, ts timestamp.Timestamp) error {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if err := tc.checkStatus(true); err != nil {
+		return err
+	}
+	if ts.IsEmpty() {
+		ts, _ = tc.rt.Clock().Now()
+	}
+	if tc.mu.txn.SnapshotTS.Less(ts) {
+		tc.mu.txn.SnapshotTS = ts
+		util.LogTxnUpdated(tc.rt.Logger(), tc.mu.txn)
+	}
+	return nil
+}
+
 func (tc *txnOperator) Read(ctx context.Context, requests []txn.TxnRequest) (*rpc.SendResult, error) {
 	util.LogTxnRead(tc.rt.Logger(), tc.getTxnMeta(false))
 
@@ -264,6 +290,7 @@ func (tc *txnOperator) WriteAndCommit(ctx context.Context, requests []txn.TxnReq
 
 func (tc *txnOperator) Commit(ctx context.Context) error {
 	util.LogTxnCommit(tc.rt.Logger(), tc.getTxnMeta(false))
+	defer tc.unlock()
 
 	if tc.option.readyOnly {
 		return nil
@@ -281,6 +308,7 @@ func (tc *txnOperator) Commit(ctx context.Context) error {
 
 func (tc *txnOperator) Rollback(ctx context.Context) error {
 	util.LogTxnRollback(tc.rt.Logger(), tc.getTxnMeta(false))
+	defer tc.unlock()
 
 	tc.mu.Lock()
 	defer func() {
@@ -308,6 +336,20 @@ func (tc *txnOperator) Rollback(ctx context.Context) error {
 	return nil
 }
 
+// unlock releases all the locks held by the txn in the lockservice, the locks are
+// released after the txn committed or aborted.
+func (tc *txnOperator) unlock() {
+	v, ok := tc.rt.GetGlobalVariables(runtime.LockService)
+	if !ok {
+		return
+	}
+	if err := v.(lockservice.LockService).Unlock(tc.txnID); err != nil {
+		tc.rt.Logger().Error("failed to unlock txn",
+			util.TxnIDFieldWithID(tc.txnID),
+			zap.Error(err))
+	}
+}
+
 func (tc *txnOperator) Debug(ctx context.Context, requests []txn.TxnRequest) (*rpc.SendResult, error) {
 	for idx := range requests {
 		requests[idx].Method = txn.TxnMethod_DEBUG
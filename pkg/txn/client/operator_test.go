@@ -352,6 +352,24 @@ func TestApplySnapshotTxnOperator(t *testing.T) {
 	})
 }
 
+func TestUpdateSnapshot(t *testing.T) {
+	runOperatorTests(t, func(ctx context.Context, tc *txnOperator, _ *testTxnSender) {
+		ts := tc.Txn().SnapshotTS
+		assert.NoError(t, tc.UpdateSnapshot(ctx, ts.Next()))
+		assert.Equal(t, ts.Next(), tc.Txn().SnapshotTS)
+
+		// the snapshot never moves backward
+		assert.NoError(t, tc.UpdateSnapshot(ctx, ts))
+		assert.Equal(t, ts.Next(), tc.Txn().SnapshotTS)
+
+		assert.NoError(t, tc.UpdateSnapshot(ctx, timestamp.Timestamp{}))
+		assert.True(t, ts.Next().LessEq(tc.Txn().SnapshotTS))
+
+		assert.NoError(t, tc.Rollback(ctx))
+		assert.Error(t, tc.UpdateSnapshot(ctx, ts.Next().Next()))
+	})
+}
+
 func TestDebugTxnOperator(t *testing.T) {
 	runOperatorTests(t, func(ctx context.Context, tc *txnOperator, _ *testTxnSender) {
 		responses, err := tc.Debug(ctx,
@@ -17,6 +17,7 @@ package client
 import (
 	"context"
 
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
 )
@@ -59,6 +60,10 @@ type TxnOperator interface {
 	// ApplySnapshot CN coordinator applies a snapshot of the non-coordinator's transaction
 	// operation information.
 	ApplySnapshot(data []byte) error
+	// UpdateSnapshot move the snapshot timestamp of the txn forward to ts, the current
+	// time of the clock is used if ts is empty. The pessimistic txn updates its snapshot
+	// after waiting for the locks held by other txns, to see the changes made by them.
+	UpdateSnapshot(ctx context.Context, ts timestamp.Timestamp) error
 	// Read transaction read operation, the operator routes the message based
 	// on the given DN node information and waits for the read data synchronously.
 	// The transaction has been aborted if ErrTxnAborted returned.
@@ -340,6 +340,8 @@ func (a *AttributeRow) AttrByName(handler *MemHandler, tx *Transaction, name str
 		ret.Value = a.ID.ToRowID()
 	case catalog.SystemColAttr_IsClusterBy:
 		ret.Value = boolToInt8(a.ClusterBy)
+	case catalog.SystemColAttr_Compression:
+		ret.Value = int8(a.Alg)
 	default:
 		panic(fmt.Sprintf("fixme: %s", name))
 	}
@@ -134,6 +134,17 @@ func (t *Table[K, V, R]) DisableHistory() {
 	t.disableHistory.Store(true)
 }
 
+// Copy returns a new table with the committed state of t.
+// The history is not copied, and writes to either table are not visible to the other
+func (t *Table[K, V, R]) Copy() *Table[K, V, R] {
+	ret := &Table[K, V, R]{
+		id: atomic.AddInt64(&nextTableID, 1),
+	}
+	ret.state.Store(t.state.Load().clone())
+	ret.disableHistory.Store(t.disableHistory.Load())
+	return ret
+}
+
 // Insert inserts a row to the table
 func (t *Table[K, V, R]) Insert(
 	tx *Transaction,
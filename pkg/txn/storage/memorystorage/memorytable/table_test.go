@@ -246,3 +246,31 @@ func TestTableHistory(t *testing.T) {
 	assert.Contains(t, err.Error(), "begin time too old")
 
 }
+
+func TestTableCopy(t *testing.T) {
+	table := NewTable[Int, int, TestRow]()
+	tx := NewTransaction(ts(1))
+	err := table.Insert(tx, TestRow{key: 1, value: 1})
+	assert.Nil(t, err)
+	err = tx.Commit(ts(1))
+	assert.Nil(t, err)
+
+	copied := table.Copy()
+
+	tx = NewTransaction(ts(2))
+	err = table.Insert(tx, TestRow{key: 2, value: 2})
+	assert.Nil(t, err)
+	err = table.Delete(tx, Int(1))
+	assert.Nil(t, err)
+	err = tx.Commit(ts(2))
+	assert.Nil(t, err)
+
+	tx = NewTransaction(ts(3))
+	v, err := copied.Get(tx, Int(1))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, v)
+	_, err = copied.Get(tx, Int(2))
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = table.Get(tx, Int(1))
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
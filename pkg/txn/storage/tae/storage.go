@@ -49,6 +49,7 @@ func NewTAEStorage(
 	fs fileservice.FileService,
 	rt runtime.Runtime,
 	ckpCfg *options.CheckpointCfg,
+	gcCfg *options.GCCfg,
 	logtailServerAddr string,
 	logtailServerCfg *options.LogtailServerCfg,
 	logStore options.LogstoreType,
@@ -59,6 +60,7 @@ func NewTAEStorage(
 		Lc:            logservicedriver.LogServiceClientFactory(factory),
 		Shard:         shard,
 		CheckpointCfg: ckpCfg,
+		GCCfg:         gcCfg,
 		LogStoreT:     logStore,
 	}
 
@@ -36,6 +36,7 @@ const (
 	MOLogType       = "log"
 	MOErrorType     = "error"
 	MORawLogType    = "rawlog"
+	MOAuditType     = "audit_log"
 )
 
 // tracerProviderConfig.
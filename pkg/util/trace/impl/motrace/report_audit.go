@@ -0,0 +1,238 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
+)
+
+// AuditRecord implement export.IBuffer2SqlItem and export.CsvFields
+//
+// The records generated by a node are linked into a hash chain: the Hash of a record
+// covers all its fields and the Hash of the previous one. Modifying, deleting or
+// inserting any record breaks the chain, see VerifyAuditChain. The chain of the
+// node is continued after the last record written when the node restarts, see
+// ResumeAuditChain, or a new segment of the chain is started if the last record
+// can not be read, see StartAuditSegment.
+type AuditRecord struct {
+	AuditID       [16]byte  `json:"audit_id"`
+	Seq           uint64    `json:"seq"`
+	Timestamp     time.Time `json:"timestamp"`
+	NodeUUID      string    `json:"node_uuid"`
+	SessionID     [16]byte  `json:"session_id"`
+	StatementID   [16]byte  `json:"statement_id"`
+	Account       string    `json:"account"`
+	User          string    `json:"user"`
+	Host          string    `json:"host"`
+	Role          string    `json:"role"`
+	Event         string    `json:"event"`
+	StatementType string    `json:"statement_type"`
+	Digest        string    `json:"statement_digest"`
+	Objects       []string  `json:"objects"`
+	Policies      []string  `json:"policy"`
+	ResultCode    uint16    `json:"result_code"`
+	Error         string    `json:"error"`
+	PrevHash      string    `json:"prev_hash"`
+	Hash          string    `json:"hash"`
+}
+
+func (r *AuditRecord) GetName() string {
+	return AuditLogTable.GetName()
+}
+
+func (r *AuditRecord) Size() int64 {
+	size := int64(unsafe.Sizeof(*r)) + int64(
+		len(r.NodeUUID)+len(r.Account)+len(r.User)+len(r.Host)+len(r.Role)+len(r.Event)+
+			len(r.StatementType)+len(r.Digest)+len(r.Error)+len(r.PrevHash)+len(r.Hash),
+	)
+	for _, o := range r.Objects {
+		size += int64(len(o))
+	}
+	for _, p := range r.Policies {
+		size += int64(len(p))
+	}
+	return size
+}
+
+func (r *AuditRecord) Free() {
+	r.Objects = nil
+	r.Policies = nil
+	r.Error = ""
+}
+
+func (r *AuditRecord) GetTable() *table.Table { return AuditLogTable }
+
+func (r *AuditRecord) FillRow(ctx context.Context, row *table.Row) {
+	row.Reset()
+	row.SetColumnVal(auditIDCol, uuid.UUID(r.AuditID).String())
+	row.SetColumnVal(auditSeqCol, r.Seq)
+	row.SetColumnVal(timestampCol, r.Timestamp)
+	row.SetColumnVal(nodeUUIDCol, r.NodeUUID)
+	row.SetColumnVal(sesIDCol, uuid.UUID(r.SessionID).String())
+	row.SetColumnVal(stmtIDCol, uuid.UUID(r.StatementID).String())
+	row.SetColumnVal(accountCol, r.Account)
+	row.SetColumnVal(userCol, r.User)
+	row.SetColumnVal(hostCol, r.Host)
+	row.SetColumnVal(roleCol, r.Role)
+	row.SetColumnVal(auditEventCol, r.Event)
+	row.SetColumnVal(stmtTypeCol, r.StatementType)
+	row.SetColumnVal(auditDigestCol, r.Digest)
+	row.SetColumnVal(auditObjectsCol, strings.Join(r.Objects, ","))
+	row.SetColumnVal(auditPolicyCol, strings.Join(r.Policies, ","))
+	row.SetColumnVal(resultCodeCol, int64(r.ResultCode))
+	row.SetColumnVal(errorCol, r.Error)
+	row.SetColumnVal(prevHashCol, r.PrevHash)
+	row.SetColumnVal(hashCol, r.Hash)
+}
+
+// ComputeHash returns the sha256 of the fields of the record and the PrevHash.
+// The fields are hashed as they are written into the audit_log table.
+func (r *AuditRecord) ComputeHash() string {
+	h := sha256.New()
+	var lenBuf [4]byte
+	write := func(s string) {
+		// the length prefix keeps the boundaries of the fields
+		binary.BigEndian.PutUint32(lenBuf[:], uint32(len(s)))
+		h.Write(lenBuf[:])
+		h.Write([]byte(s))
+	}
+	write(uuid.UUID(r.AuditID).String())
+	write(strconv.FormatUint(r.Seq, 10))
+	write(table.Time2DatetimeString(r.Timestamp))
+	write(r.NodeUUID)
+	write(uuid.UUID(r.SessionID).String())
+	write(uuid.UUID(r.StatementID).String())
+	write(r.Account)
+	write(r.User)
+	write(r.Host)
+	write(r.Role)
+	write(r.Event)
+	write(r.StatementType)
+	write(r.Digest)
+	write(strings.Join(r.Objects, ","))
+	write(strings.Join(r.Policies, ","))
+	write(strconv.FormatUint(uint64(r.ResultCode), 10))
+	write(r.Error)
+	write(r.PrevHash)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AuditEventNewSegment is the event of the record beginning a new segment of the
+// hash chain of the node, the Error of the record is why the chain is not resumed.
+const AuditEventNewSegment = "new_segment"
+
+// auditChain links the audit records generated by the node
+type auditChain struct {
+	sync.Mutex
+	seq  uint64
+	last string
+}
+
+var gAuditChain auditChain
+
+// resume continues the chain after the record seq with the hash
+func (c *auditChain) resume(seq uint64, hash string) {
+	c.Lock()
+	defer c.Unlock()
+	c.seq = seq
+	c.last = hash
+}
+
+// ResumeAuditChain continues the hash chain of the node after the last record
+// written to the audit log before the node restarts, it must be called before
+// any record is reported.
+func ResumeAuditChain(seq uint64, hash string) {
+	gAuditChain.resume(seq, hash)
+}
+
+// restart begins a new segment of the chain, the first record of the segment is
+// seq and links to no record.
+func (c *auditChain) restart(seq uint64) {
+	c.Lock()
+	defer c.Unlock()
+	c.seq = seq - 1
+	c.last = ""
+}
+
+// StartAuditSegment begins a new segment of the hash chain of the node if the
+// chain can not be resumed after the last record written before the node restarts.
+// The segment begins with a record of the event AuditEventNewSegment holding the
+// reason, and its Seq is the unix time in nanoseconds, which is larger than the Seq
+// of all the records written before. It must be called before any record is reported.
+func StartAuditSegment(ctx context.Context, reason string) error {
+	gAuditChain.restart(uint64(time.Now().UnixNano()))
+	return ReportAudit(ctx, &AuditRecord{Event: AuditEventNewSegment, Error: reason})
+}
+
+// link appends the record into the chain
+func (c *auditChain) link(r *AuditRecord) {
+	c.Lock()
+	defer c.Unlock()
+	c.seq++
+	r.Seq = c.seq
+	r.PrevHash = c.last
+	r.Hash = r.ComputeHash()
+	c.last = r.Hash
+}
+
+// VerifyAuditChain checks that the records, which are generated by one node and
+// ordered by Seq, are neither modified nor lost. The records of a segment begun by
+// StartAuditSegment do not link to the records before the segment.
+func VerifyAuditChain(ctx context.Context, records []*AuditRecord) error {
+	for i, r := range records {
+		if i > 0 {
+			prev := records[i-1]
+			if r.Event == AuditEventNewSegment && r.PrevHash == "" {
+				if r.Seq <= prev.Seq {
+					return moerr.NewInternalError(ctx, "audit record %d begins a segment before the record %d", r.Seq, prev.Seq)
+				}
+			} else if r.Seq != prev.Seq+1 {
+				return moerr.NewInternalError(ctx, "audit record %d is missing after %d", prev.Seq+1, prev.Seq)
+			} else if r.PrevHash != prev.Hash {
+				return moerr.NewInternalError(ctx, "audit record %d does not follow the record %d", r.Seq, prev.Seq)
+			}
+		}
+		if r.ComputeHash() != r.Hash {
+			return moerr.NewInternalError(ctx, "audit record %d has been modified", r.Seq)
+		}
+	}
+	return nil
+}
+
+// ReportAudit links the record into the hash chain of the node and sends it to BatchProcessor.
+// The audit log is written even if the trace is disabled.
+var ReportAudit = func(ctx context.Context, r *AuditRecord) error {
+	if r.AuditID == [16]byte{} {
+		r.AuditID = uuid.New()
+	}
+	if r.Timestamp.IsZero() {
+		r.Timestamp = time.Now()
+	}
+	r.NodeUUID = GetNodeResource().NodeUuid
+	gAuditChain.link(r)
+	return GetGlobalBatchProcessor().Collect(ctx, r)
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuditRecords(chain *auditChain, n int) []*AuditRecord {
+	records := make([]*AuditRecord, 0, n)
+	for i := 0; i < n; i++ {
+		r := &AuditRecord{
+			AuditID:       [16]byte{byte(i + 1)},
+			Timestamp:     time.Unix(int64(i), 0),
+			NodeUUID:      "node_uuid",
+			Account:       "acc1",
+			User:          "u1",
+			Host:          "127.0.0.1",
+			Role:          "r1",
+			Event:         "ddl",
+			StatementType: "Create Table",
+			Digest:        "digest",
+			Objects:       []string{"db1.t1"},
+			Policies:      []string{"p1"},
+		}
+		chain.link(r)
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestAuditChain(t *testing.T) {
+	ctx := context.Background()
+
+	records := newAuditRecords(&auditChain{}, 4)
+	require.Equal(t, uint64(1), records[0].Seq)
+	require.Equal(t, "", records[0].PrevHash)
+	require.Equal(t, records[0].Hash, records[1].PrevHash)
+	require.NoError(t, VerifyAuditChain(ctx, records))
+
+	// modified
+	records = newAuditRecords(&auditChain{}, 4)
+	records[2].User = "u2"
+	require.Error(t, VerifyAuditChain(ctx, records))
+
+	// deleted
+	records = newAuditRecords(&auditChain{}, 4)
+	records = append(records[:1], records[2:]...)
+	require.Error(t, VerifyAuditChain(ctx, records))
+
+	// rehashed after modified
+	records = newAuditRecords(&auditChain{}, 4)
+	records[1].ResultCode = 1
+	records[1].Hash = records[1].ComputeHash()
+	require.Error(t, VerifyAuditChain(ctx, records))
+}
+
+func TestAuditChainResume(t *testing.T) {
+	ctx := context.Background()
+	chain := &auditChain{}
+	records := newAuditRecords(chain, 4)
+
+	// the node restarts after the record 2 is written
+	resumed := &auditChain{}
+	resumed.resume(records[1].Seq, records[1].Hash)
+	records = append(records[:2], newAuditRecords(resumed, 2)...)
+	require.Equal(t, uint64(3), records[2].Seq)
+	require.NoError(t, VerifyAuditChain(ctx, records))
+
+	// the chain restarts from the beginning
+	records = append(records[:2], newAuditRecords(&auditChain{}, 2)...)
+	require.Error(t, VerifyAuditChain(ctx, records))
+}
+
+func TestAuditChainSegment(t *testing.T) {
+	ctx := context.Background()
+	records := newAuditRecords(&auditChain{}, 2)
+
+	// the node restarts and fails to resume the chain
+	chain := &auditChain{}
+	chain.restart(100)
+	segment := &AuditRecord{Event: AuditEventNewSegment, Error: "resume failed"}
+	chain.link(segment)
+	require.Equal(t, uint64(100), segment.Seq)
+	require.Equal(t, "", segment.PrevHash)
+	records = append(records, segment)
+	records = append(records, newAuditRecords(chain, 2)...)
+	require.Equal(t, uint64(101), records[3].Seq)
+	require.NoError(t, VerifyAuditChain(ctx, records))
+
+	// only the records of the event begin a segment
+	records[2].Event = "ddl"
+	records[2].Hash = records[2].ComputeHash()
+	require.Error(t, VerifyAuditChain(ctx, records))
+
+	// the segment begins after the records written before
+	records = newAuditRecords(&auditChain{}, 2)
+	chain = &auditChain{}
+	chain.restart(2)
+	segment = &AuditRecord{Event: AuditEventNewSegment}
+	chain.link(segment)
+	require.Error(t, VerifyAuditChain(ctx, append(records, segment)))
+}
+
+func TestAuditRecord_FillRow(t *testing.T) {
+	ctx := context.Background()
+	r := newAuditRecords(&auditChain{}, 1)[0]
+	row := AuditLogTable.GetRow(ctx)
+	defer row.Free()
+	r.FillRow(ctx, row)
+
+	vals := make(map[string]string)
+	for i, val := range row.ToStrings() {
+		vals[AuditLogTable.Columns[i].Name] = val
+	}
+	require.Equal(t, "acc1", row.GetAccount())
+	require.Equal(t, "1", vals["seq"])
+	require.Equal(t, table.Time2DatetimeString(r.Timestamp), vals["timestamp"])
+	require.Equal(t, "db1.t1", vals["objects"])
+	require.Equal(t, "0", vals["result_code"])
+	require.Equal(t, r.Hash, vals["hash"])
+	require.Equal(t, "", vals["prev_hash"])
+}
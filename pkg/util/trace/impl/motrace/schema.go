@@ -33,6 +33,7 @@ const (
 	// statementInfoTbl is an EXTERNAL table
 	statementInfoTbl = "statement_info"
 	rawLogTbl        = "rawlog"
+	auditLogTbl      = "audit_log"
 
 	// spanInfoTbl is a view
 	spanInfoTbl  = "span_info"
@@ -162,6 +163,51 @@ var (
 		SupportUserAccess: false,
 	}
 
+	auditIDCol      = table.UuidStringColumn("audit_id", "audit record uniq id")
+	auditSeqCol     = table.UInt64Column("seq", "sequence of the record in the hash chain of the node")
+	auditEventCol   = table.StringColumn("event", "audit event, enum: login, ddl, dcl, dml, dql")
+	auditDigestCol  = table.StringColumn("statement_digest", "sha256 of the sql statement")
+	auditObjectsCol = table.TextColumn("objects", "objects touched by the statement")
+	auditPolicyCol  = table.StringColumn("policy", "audit policies that matched the event")
+	roleCol         = table.StringColumn("role", "role name")
+	resultCodeCol   = table.Int64Column("result_code", "error code of the event, 0 means success")
+	prevHashCol     = table.StringColumn("prev_hash", "hash of the previous record in the hash chain of the node")
+	hashCol         = table.StringColumn("hash", "sha256 of the record and prev_hash")
+
+	AuditLogTable = &table.Table{
+		Account:  table.AccountAll,
+		Database: StatsDatabase,
+		Table:    auditLogTbl,
+		Columns: []table.Column{
+			auditIDCol,
+			auditSeqCol,
+			timestampCol,
+			nodeUUIDCol,
+			sesIDCol,
+			stmtIDCol,
+			accountCol,
+			userCol,
+			hostCol,
+			roleCol,
+			auditEventCol,
+			stmtTypeCol,
+			auditDigestCol,
+			auditObjectsCol,
+			auditPolicyCol,
+			resultCodeCol,
+			errorCol,
+			prevHashCol,
+			hashCol,
+		},
+		PrimaryKeyColumn: []table.Column{auditIDCol},
+		Engine:           table.ExternalTableEngine,
+		Comment:          "tamper-evident audit trail of the events matched by audit policies",
+		PathBuilder:      table.NewAccountDatePathBuilder(),
+		AccountColumn:    &accountCol,
+		// the audited accounts can not read or rewrite the audit trail
+		SupportUserAccess: false,
+	}
+
 	logView = &table.View{
 		Database:    StatsDatabase,
 		Table:       logInfoTbl,
@@ -226,11 +272,21 @@ const (
 	sqlCreateDBConst = `create database if not exists ` + StatsDatabase
 )
 
-var tables = []*table.Table{SingleStatementTable, SingleRowLogTable}
+var tables = []*table.Table{SingleStatementTable, SingleRowLogTable, AuditLogTable}
 var views = []*table.View{logView, errorView, spanView}
 
 // InitSchemaByInnerExecutor init schema, which can access db by io.InternalExecutor on any Node.
 func InitSchemaByInnerExecutor(ctx context.Context, ieFactory func() ie.InternalExecutor) error {
+	return initSchemaByInnerExecutor(ctx, ieFactory, tables, views)
+}
+
+// InitAuditSchemaByInnerExecutor init the schema of the audit log only, which is
+// written even if the trace is disabled.
+func InitAuditSchemaByInnerExecutor(ctx context.Context, ieFactory func() ie.InternalExecutor) error {
+	return initSchemaByInnerExecutor(ctx, ieFactory, []*table.Table{AuditLogTable}, nil)
+}
+
+func initSchemaByInnerExecutor(ctx context.Context, ieFactory func() ie.InternalExecutor, tables []*table.Table, views []*table.View) error {
 	exec := ieFactory()
 	if exec == nil {
 		return nil
@@ -62,6 +62,23 @@ func InitWithConfig(ctx context.Context, SV *config.ObservabilityParameters, opt
 	return Init(ctx, opts...)
 }
 
+// InitAuditWithConfig exports the audit log only, instead of InitWithConfig if the
+// trace is disabled, since the audit log is written even if the trace is disabled.
+func InitAuditWithConfig(ctx context.Context, SV *config.ObservabilityParameters, opts ...TracerProviderOption) error {
+	// fix multi-init in standalone
+	if !atomic.CompareAndSwapUint32(&inited, 0, 1) {
+		return nil
+	}
+	opts = append(opts,
+		withMOVersion(SV.MoVersion),
+		EnableTracer(false),
+		WithBatchProcessMode(SV.BatchProcessor),
+		WithExportInterval(SV.TraceExportInterval),
+	)
+	SetTracerProvider(newMOTracerProvider(opts...))
+	return initAuditExporter(ctx, &GetTracerProvider().tracerProviderConfig)
+}
+
 func Init(ctx context.Context, opts ...TracerProviderOption) error {
 	// fix multi-init in standalone
 	if !atomic.CompareAndSwapUint32(&inited, 0, 1) {
@@ -123,6 +140,7 @@ func initExporter(ctx context.Context, config *tracerProviderConfig) error {
 		p.Register(&MOZapLog{}, NewBufferPipe2CSVWorker(defaultOptions...))
 		p.Register(&StatementInfo{}, NewBufferPipe2CSVWorker(defaultOptions...))
 		p.Register(&MOErrorHolder{}, NewBufferPipe2CSVWorker(defaultOptions...))
+		p.Register(&AuditRecord{}, NewBufferPipe2CSVWorker(defaultOptions...))
 	default:
 		return moerr.NewInternalError(ctx, "unknown batchProcessMode: %s", config.batchProcessMode)
 	}
@@ -135,12 +153,31 @@ func initExporter(ctx context.Context, config *tracerProviderConfig) error {
 	return nil
 }
 
+// initAuditExporter exports the audit log only, see InitAuditWithConfig.
+func initAuditExporter(ctx context.Context, config *tracerProviderConfig) error {
+	if config.batchProcessMode != FileService {
+		return nil
+	}
+	defaultReminder := batchpipe.NewConstantClock(config.exportInterval)
+	p := config.batchProcessor
+	p.Register(&AuditRecord{}, NewBufferPipe2CSVWorker(BufferWithReminder(defaultReminder)))
+	logutil.Info("init GlobalBatchProcessor for the audit log")
+	if !p.Start() {
+		return moerr.NewInternalError(ctx, "trace exporter already started")
+	}
+	return nil
+}
+
 // InitSchema
 // PS: only in standalone or CN node can init schema
 func InitSchema(ctx context.Context, sqlExecutor func() ie.InternalExecutor) error {
 	config := &GetTracerProvider().tracerProviderConfig
 	switch config.batchProcessMode {
 	case InternalExecutor, FileService:
+		if !config.IsEnable() {
+			// only the audit log is written if the trace is disabled
+			return InitAuditSchemaByInnerExecutor(ctx, sqlExecutor)
+		}
 		if err := InitSchemaByInnerExecutor(ctx, sqlExecutor); err != nil {
 			return err
 		}
@@ -152,7 +189,8 @@ func InitSchema(ctx context.Context, sqlExecutor func() ie.InternalExecutor) err
 
 func Shutdown(ctx context.Context) error {
 	if !GetTracerProvider().IsEnable() {
-		return nil
+		// flush the audit log
+		return GetGlobalBatchProcessor().Stop(true)
 	}
 	GetTracerProvider().SetEnable(false)
 
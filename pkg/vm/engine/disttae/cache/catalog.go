@@ -121,10 +121,14 @@ func (cc *CatalogCache) GetTableById(databaseId, tblId uint64) *TableItem {
 		DatabaseId: databaseId,
 	}
 	// If account is much, the performance is very bad.
+	// A renamed table has items of several names, the latest one is returned.
 	cc.tables.data.Ascend(key, func(item *TableItem) bool {
-		if item.Id == tblId {
+		if item.Id != tblId {
+			return true
+		}
+		if rel == nil || item.Ts.Greater(rel.Ts) ||
+			(item.Ts.Equal(rel.Ts) && rel.deleted && !item.deleted) {
 			rel = item
-			return false
 		}
 		return true
 	})
@@ -271,6 +275,18 @@ func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
 		item.PrimaryIdx = -1
 		item.ClusterByIdx = -1
 		copy(item.Rowid[:], rowids[i][:])
+		// the table is renamed by ALTER TABLE, the old name is deleted
+		if prev, ok := cc.tables.rowidIndex.Get(item); ok && prev.Name != item.Name {
+			cc.tables.data.Set(&TableItem{
+				deleted:    true,
+				Id:         prev.Id,
+				Name:       prev.Name,
+				Rowid:      prev.Rowid,
+				AccountId:  prev.AccountId,
+				DatabaseId: prev.DatabaseId,
+				Ts:         item.Ts,
+			})
+		}
 		cc.tables.data.Set(item)
 		cc.tables.rowidIndex.Set(item)
 	}
@@ -299,6 +315,7 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 	updateExprs := vector.MustBytesCol(bat.GetVector(catalog.MO_COLUMNS_ATT_UPDATE_IDX + MO_OFF))
 	nums := vector.MustFixedCol[int32](bat.GetVector(catalog.MO_COLUMNS_ATTNUM_IDX + MO_OFF))
 	clusters := vector.MustFixedCol[int8](bat.GetVector(catalog.MO_COLUMNS_ATT_IS_CLUSTERBY + MO_OFF))
+	compressions := vector.MustFixedCol[int8](bat.GetVector(catalog.MO_COLUMNS_ATT_COMPRESSION_IDX + MO_OFF))
 	for i, account := range accounts {
 		key.AccountId = account
 		key.Name = tableNames[i]
@@ -321,6 +338,7 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 				hasUpdate:       hasUpdates[i],
 				constraintType:  constraintTypes[i],
 				isClusterBy:     clusters[i],
+				compression:     compressions[i],
 			}
 			col.typ = append(col.typ, typs[i]...)
 			col.updateExpr = append(col.updateExpr, updateExprs[i]...)
@@ -384,7 +402,7 @@ func genTableDefOfColumn(col column) engine.TableDef {
 
 	attr.Name = col.name
 	attr.ID = uint64(col.num)
-	attr.Alg = compress.Lz4
+	attr.Alg = compress.T(col.compression)
 	attr.Comment = col.comment
 	attr.IsHidden = col.isHidden == 1
 	attr.ClusterBy = col.isClusterBy == 1
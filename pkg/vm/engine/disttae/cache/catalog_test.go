@@ -15,6 +15,7 @@
 package cache
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -235,6 +236,54 @@ func TestTableInsert(t *testing.T) {
 	require.Equal(t, int64(0), mp.CurrNB())
 }
 
+func TestTableRename(t *testing.T) {
+	mp := mpool.MustNewZero()
+	cc := NewCatalog()
+	bat := newTestTableBatch(mp)
+	cc.InsertTable(bat)
+	// the renamed rows have the same rowid and id with a later timestamp
+	renamed := newTestTableBatch(mp)
+	newNames := make([]string, Rows)
+	for i := range newNames {
+		newNames[i] = fmt.Sprintf("renamed_%d", i)
+	}
+	nameVec := renamed.Vecs[catalog.MO_TABLES_REL_NAME_IDX+MO_OFF]
+	renamed.Vecs[catalog.MO_TABLES_REL_NAME_IDX+MO_OFF] = testutil.NewStringVector(
+		Rows, *nameVec.GetType(), mp, false, newNames)
+	nameVec.Free(mp)
+	timestamps := vector.MustFixedCol[types.TS](renamed.GetVector(MO_TIMESTAMP_IDX))
+	for i := range timestamps {
+		timestamps[i] = types.BuildTS(timestamps[i].Physical()+10, timestamps[i].Logical())
+	}
+	cc.InsertTable(renamed)
+
+	accounts := vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_TABLES_ACCOUNT_ID_IDX + MO_OFF))
+	names := vector.MustStrCol(bat.GetVector(catalog.MO_TABLES_REL_NAME_IDX + MO_OFF))
+	ids := vector.MustFixedCol[uint64](bat.GetVector(catalog.MO_TABLES_REL_ID_IDX + MO_OFF))
+	databaseIds := vector.MustFixedCol[uint64](bat.GetVector(catalog.MO_TABLES_RELDATABASE_ID_IDX + MO_OFF))
+	oldTimestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+	for i, account := range accounts {
+		key := &TableItem{
+			Name:       names[i],
+			AccountId:  account,
+			DatabaseId: databaseIds[i],
+			Ts:         oldTimestamps[i].ToTimestamp(),
+		}
+		require.True(t, cc.GetTable(key))
+		key.Ts = types.BuildTS(timestamps[i].Physical()+1, timestamps[i].Logical()).ToTimestamp()
+		require.False(t, cc.GetTable(key))
+		key.Name = newNames[i]
+		require.True(t, cc.GetTable(key))
+		require.Equal(t, ids[i], key.Id)
+		item := cc.GetTableById(databaseIds[i], ids[i])
+		require.NotNil(t, item)
+		require.Equal(t, newNames[i], item.Name)
+	}
+	bat.Clean(mp)
+	renamed.Clean(mp)
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
 func newTestTableBatch(mp *mpool.MPool) *batch.Batch {
 	var typs []types.Type
 
@@ -126,6 +126,7 @@ type column struct {
 	hasUpdate       int8
 	updateExpr      []byte
 	isClusterBy     int8
+	compression     int8
 }
 
 type columns []column
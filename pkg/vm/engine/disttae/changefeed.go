@@ -0,0 +1,565 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/cache"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/blockio"
+	taeLogtail "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"go.uber.org/zap"
+)
+
+// changeHandler receives the changes of the columns of a table consumed from the logtail.
+type changeHandler struct {
+	attrs []string
+	// from is the time the changes are captured since, the changes committed no
+	// later than it are not read from the checkpoints of the dn.
+	from    timestamp.Timestamp
+	handler engine.ChangeHandler
+}
+
+// the size of the queue of the logtails whose changes are captured, the logtail is
+// applied after the queue has room for it.
+var changeQueueSize = 1024
+
+// loadCheckpointEntries loads the entries of a table in the checkpoints of the dn
+var loadCheckpointEntries = taeLogtail.LoadCheckpointEntries
+
+// changeHandlers records the change handlers of the tables subscribed.
+type changeHandlers struct {
+	sync.RWMutex
+	m map[subscribeID][]*changeHandler
+
+	once sync.Once
+	// queue is the logtails whose changes are captured in order, the rows deleted
+	// are looked up and the handlers are called out of the routines applying the
+	// logtails.
+	queue chan *tableChanges
+}
+
+func (hs *changeHandlers) add(id subscribeID, h *changeHandler) {
+	hs.once.Do(func() {
+		hs.queue = make(chan *tableChanges, changeQueueSize)
+		go hs.run()
+	})
+	hs.Lock()
+	defer hs.Unlock()
+	if hs.m == nil {
+		hs.m = make(map[subscribeID][]*changeHandler)
+	}
+	hs.m[id] = append(hs.m[id], h)
+}
+
+func (hs *changeHandlers) run() {
+	for c := range hs.queue {
+		c.handle()
+	}
+}
+
+func (hs *changeHandlers) remove(id subscribeID, h *changeHandler) {
+	hs.Lock()
+	defer hs.Unlock()
+	handlers := hs.m[id]
+	for i := range handlers {
+		if handlers[i] == h {
+			handlers = append(handlers[:i:i], handlers[i+1:]...)
+			break
+		}
+	}
+	if len(handlers) == 0 {
+		delete(hs.m, id)
+	} else {
+		hs.m[id] = handlers
+	}
+}
+
+func (hs *changeHandlers) get(id subscribeID) []*changeHandler {
+	hs.RLock()
+	defer hs.RUnlock()
+	return hs.m[id]
+}
+
+func (hs *changeHandlers) tables() []subscribeID {
+	hs.RLock()
+	defer hs.RUnlock()
+	ids := make([]subscribeID, 0, len(hs.m))
+	for id := range hs.m {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// SubscribeChanges subscribes the logtail of the table and calls the handler with the
+// rows changed, the changes are captured only with the logtail push model.
+func (e *Engine) SubscribeChanges(ctx context.Context, databaseId, tableId uint64, attrs []string,
+	from timestamp.Timestamp, handler engine.ChangeHandler) (func(), error) {
+	if !e.usePushModel {
+		return nil, moerr.NewNotSupported(ctx, "capture changes without the logtail push model")
+	}
+	id := subscribeID{db: databaseId, tbl: tableId}
+	h := &changeHandler{attrs: attrs, from: from, handler: handler}
+	e.changeHandlers.add(id, h)
+	if err := e.tryToGetTableLogTail(ctx, databaseId, tableId); err != nil {
+		e.changeHandlers.remove(id, h)
+		return nil, err
+	}
+	return func() { e.changeHandlers.remove(id, h) }, nil
+}
+
+// resubscribeChangeTables subscribes the tables whose changes are captured again after
+// the logtail client reconnects, the changes since the last checkpoint of the dn are
+// received again with the subscribe response.
+func (e *Engine) resubscribeChangeTables(ctx context.Context) {
+	for _, id := range e.changeHandlers.tables() {
+		if err := e.tryToGetTableLogTail(ctx, id.db, id.tbl); err != nil {
+			logutil.Error("resubscribe the table of changes failed",
+				zap.Uint64("databaseId", id.db), zap.Uint64("tableId", id.tbl), zap.Error(err))
+		}
+	}
+}
+
+// tableChanges collects the rows changed by a logtail of a table. The rows deleted
+// are looked up in the partition state before the logtail is applied.
+type tableChanges struct {
+	ctx      context.Context
+	e        *Engine
+	state    *PartitionState
+	table    *cache.TableItem
+	tl       *logtail.TableLogtail
+	ts       timestamp.Timestamp
+	handlers []*changeHandler
+
+	// rows inserted by the logtail
+	inserts map[types.Rowid]rowRef
+	// ckpBlocks are the blocks in the checkpoint of the dn of the logtail
+	ckpBlocks map[uint64]BlockEntry
+	// blocks read to look up the rows deleted
+	blocks map[uint64]*changeBlock
+}
+
+type rowRef struct {
+	bat *batch.Batch
+	row int
+}
+
+// rowChange is a row changed before it is projected to the columns of the handlers.
+type rowChange struct {
+	typ      engine.ChangeType
+	commitTs timestamp.Timestamp
+	ref      rowRef
+}
+
+// changeBlock is a block read to capture the changes.
+type changeBlock struct {
+	bat *batch.Batch
+	// commits are the commit timestamps of the rows of an appendable block, the
+	// timestamps of the rows aborted are empty.
+	commits []types.TS
+	// offsets are the rows in the batch of the rows in an appendable block, -1 if
+	// the row is not read. The rows of a non-appendable block are at the offsets.
+	offsets []int
+}
+
+// row returns the row in the batch of the offset in the block, -1 if not found.
+func (b *changeBlock) row(offset int) int {
+	if b.offsets == nil {
+		if offset >= b.bat.Length() {
+			return -1
+		}
+		return offset
+	}
+	if offset >= len(b.offsets) {
+		return -1
+	}
+	return b.offsets[offset]
+}
+
+func newTableChanges(ctx context.Context, e *Engine, state *PartitionState, table *cache.TableItem,
+	tl *logtail.TableLogtail, handlers []*changeHandler) *tableChanges {
+	return &tableChanges{
+		ctx:      ctx,
+		e:        e,
+		state:    state,
+		table:    table,
+		tl:       tl,
+		ts:       *tl.Ts,
+		handlers: handlers,
+		inserts:  make(map[types.Rowid]rowRef),
+		blocks:   make(map[uint64]*changeBlock),
+	}
+}
+
+func (c *tableChanges) collect() ([][]engine.Change, error) {
+	tl, handlers := c.tl, c.handlers
+	var start timestamp.Timestamp
+	var rows []rowChange
+	if tl.CkpLocation != "" {
+		var err error
+		if start, rows, err = c.checkpointChanges(); err != nil {
+			return nil, err
+		}
+	}
+	for i := range tl.Commands {
+		entry := &tl.Commands[i]
+		if isMetaTable(entry.TableName) {
+			continue
+		}
+		bat, err := batch.ProtoBatchToBatch(entry.Bat)
+		if err != nil {
+			return nil, err
+		}
+		rowIDs := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+		commitTs := vector.MustFixedCol[types.TS](bat.Vecs[1])
+		for row, rowID := range rowIDs {
+			ref := rowRef{bat: bat, row: row}
+			typ := engine.ChangeInsert
+			if entry.EntryType == api.Entry_Insert {
+				c.inserts[rowID] = ref
+			} else {
+				typ = engine.ChangeDelete
+				if ref, err = c.lookup(rowID); err != nil {
+					return nil, err
+				}
+			}
+			rows = append(rows, rowChange{typ: typ, commitTs: commitTs[row].ToTimestamp(), ref: ref})
+		}
+	}
+
+	changes := make([][]engine.Change, len(handlers))
+	for j, h := range handlers {
+		if tl.CkpLocation != "" {
+			changes[j] = append(changes[j], engine.Change{
+				Typ:      engine.ChangeCheckpoint,
+				CommitTs: start,
+			})
+		}
+		for _, r := range rows {
+			changes[j] = append(changes[j], engine.Change{
+				Typ:      r.typ,
+				CommitTs: r.commitTs,
+				Vecs:     projectChangeVectors(r.ref.bat, h.attrs),
+				Row:      r.ref.row,
+			})
+		}
+	}
+	return changes, nil
+}
+
+// checkpointChanges reads the changes committed after the handlers capture the
+// changes from in the blocks of the checkpoint of the dn. The rows of the
+// appendable blocks and the deletes are read with their commit timestamps, but
+// the rows of the non-appendable blocks, which are written by the cns or
+// compacted by the dn, are not. The changes committed before the last of these
+// blocks is created may be folded into the checkpoint, the time is returned as
+// the start of the checkpoint.
+func (c *tableChanges) checkpointChanges() (timestamp.Timestamp, []rowChange, error) {
+	from := c.from()
+	var name string
+	if c.table != nil {
+		name = c.table.Name
+	}
+	entries, err := loadCheckpointEntries(c.ctx, c.tl.CkpLocation,
+		c.tl.Table.GetTbId(), name, c.tl.Table.GetDbId(), "", c.e.fs)
+	if err != nil {
+		return timestamp.Timestamp{}, nil, err
+	}
+	c.ckpBlocks = checkpointBlocks(entries)
+	blockIDs := make([]uint64, 0, len(c.ckpBlocks))
+	for id := range c.ckpBlocks {
+		blockIDs = append(blockIDs, id)
+	}
+	sort.Slice(blockIDs, func(i, j int) bool { return blockIDs[i] < blockIDs[j] })
+
+	start := from
+	var rows []rowChange
+	for _, id := range blockIDs {
+		entry := c.ckpBlocks[id]
+		// the rows of the blocks deleted are in the blocks compacted from them
+		if !entry.DeleteTime.IsEmpty() || entry.CommitTs.LessEq(from) {
+			continue
+		}
+		if !entry.EntryState {
+			if entry.CreateTime.Greater(start) {
+				start = entry.CreateTime
+			}
+		} else if entry.MetaLoc != "" {
+			block, err := c.readBlock(id)
+			if err != nil {
+				return timestamp.Timestamp{}, nil, err
+			}
+			if block != nil {
+				for offset, ts := range block.commits {
+					row := block.row(offset)
+					if ts.IsEmpty() || ts.LessEq(from) || row < 0 {
+						continue
+					}
+					rows = append(rows, rowChange{
+						typ:      engine.ChangeInsert,
+						commitTs: ts.ToTimestamp(),
+						ref:      rowRef{bat: block.bat, row: row},
+					})
+				}
+			}
+		}
+		if entry.DeltaLoc == "" {
+			continue
+		}
+		rowIDs, commits, err := blockio.BlockReadDeletes(c.ctx, entry.DeltaLoc, c.e.fs)
+		if err != nil {
+			return timestamp.Timestamp{}, nil, err
+		}
+		for i, rowID := range rowIDs {
+			if commits[i].LessEq(from) {
+				continue
+			}
+			ref, err := c.lookup(rowID)
+			if err != nil {
+				return timestamp.Timestamp{}, nil, err
+			}
+			rows = append(rows, rowChange{
+				typ:      engine.ChangeDelete,
+				commitTs: commits[i].ToTimestamp(),
+				ref:      ref,
+			})
+		}
+	}
+	return start.ToTimestamp(), rows, nil
+}
+
+// from returns the earliest time the handlers capture the changes from.
+func (c *tableChanges) from() types.TS {
+	from := types.TimestampToTS(c.handlers[0].from)
+	for _, h := range c.handlers[1:] {
+		if ts := types.TimestampToTS(h.from); ts.Less(from) {
+			from = ts
+		}
+	}
+	return from
+}
+
+// checkpointBlocks returns the blocks of the metadata entries of the checkpoint,
+// the later entries of a block override the earlier ones.
+func checkpointBlocks(entries []*api.Entry) map[uint64]BlockEntry {
+	blocks := make(map[uint64]BlockEntry)
+	for _, entry := range entries {
+		if !isMetaTable(entry.TableName) {
+			continue
+		}
+		input := entry.Bat
+		if entry.EntryType == api.Entry_Delete {
+			rowIDVector := vector.MustFixedCol[types.Rowid](mustVectorFromProto(input.Vecs[0]))
+			deleteTimeVector := vector.MustFixedCol[types.TS](mustVectorFromProto(input.Vecs[1]))
+			for i, rowID := range rowIDVector {
+				blockID := types.DecodeUint64(rowID[:8])
+				block := blocks[blockID]
+				block.BlockID = blockID
+				block.DeleteTime = deleteTimeVector[i]
+				blocks[blockID] = block
+			}
+			continue
+		}
+		createTimeVector := vector.MustFixedCol[types.TS](mustVectorFromProto(input.Vecs[1]))
+		blockIDVector := vector.MustFixedCol[uint64](mustVectorFromProto(input.Vecs[2]))
+		entryStateVector := vector.MustFixedCol[bool](mustVectorFromProto(input.Vecs[3]))
+		metaLocationVector := vector.MustStrCol(mustVectorFromProto(input.Vecs[5]))
+		deltaLocationVector := vector.MustStrCol(mustVectorFromProto(input.Vecs[6]))
+		commitTimeVector := vector.MustFixedCol[types.TS](mustVectorFromProto(input.Vecs[7]))
+		segmentIDVector := vector.MustFixedCol[uint64](mustVectorFromProto(input.Vecs[8]))
+		for i, blockID := range blockIDVector {
+			block := blocks[blockID]
+			block.BlockID = blockID
+			if location := metaLocationVector[i]; location != "" {
+				block.MetaLoc = location
+			}
+			if location := deltaLocationVector[i]; location != "" {
+				block.DeltaLoc = location
+			}
+			if id := segmentIDVector[i]; id > 0 {
+				block.SegmentID = id
+			}
+			if t := createTimeVector[i]; !t.IsEmpty() {
+				block.CreateTime = t
+			}
+			if t := commitTimeVector[i]; !t.IsEmpty() {
+				block.CommitTs = t
+			}
+			block.EntryState = entryStateVector[i]
+			blocks[blockID] = block
+		}
+	}
+	return blocks
+}
+
+// lookup finds the row deleted in the logtail, the partition state, or the block of
+// the row written to the object storage.
+func (c *tableChanges) lookup(rowID types.Rowid) (rowRef, error) {
+	if ref, ok := c.inserts[rowID]; ok {
+		return ref, nil
+	}
+	blockID := blockIDFromRowID(rowID)
+	iter := c.state.Rows.Iter()
+	for ok := iter.Seek(RowEntry{BlockID: blockID, RowID: rowID, Time: types.MaxTs()}); ok; ok = iter.Next() {
+		entry := iter.Item()
+		if entry.BlockID != blockID || entry.RowID != rowID {
+			break
+		}
+		if !entry.Deleted && entry.Batch != nil {
+			iter.Release()
+			return rowRef{bat: entry.Batch, row: int(entry.Offset)}, nil
+		}
+	}
+	iter.Release()
+
+	block, err := c.readBlock(blockID)
+	if err != nil || block == nil {
+		return rowRef{}, err
+	}
+	_, _, offset := model.DecodePhyAddrKey(rowID)
+	row := block.row(int(offset))
+	if row < 0 {
+		return rowRef{}, nil
+	}
+	return rowRef{bat: block.bat, row: row}, nil
+}
+
+// readBlock reads all the rows of the block including the rows deleted, so that
+// the offsets of the row ids are kept. The rows aborted of an appendable block
+// are not read, the offsets of the rows read are recorded.
+func (c *tableChanges) readBlock(blockID uint64) (*changeBlock, error) {
+	if block, ok := c.blocks[blockID]; ok {
+		return block, nil
+	}
+	c.blocks[blockID] = nil
+	entry, ok := c.ckpBlocks[blockID]
+	if !ok {
+		entry, ok = c.state.Blocks.Get(BlockEntry{BlockInfo: catalog.BlockInfo{BlockID: blockID}})
+	}
+	if !ok || entry.MetaLoc == "" || c.table == nil || c.table.TableDef == nil {
+		return nil, nil
+	}
+	info := entry.BlockInfo
+	info.DeltaLoc = ""
+	tableDef := c.table.TableDef
+	attrs := make([]string, 0, len(tableDef.Cols))
+	colIdxs := make([]uint16, 0, len(tableDef.Cols))
+	colTypes := make([]types.Type, 0, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		attrs = append(attrs, col.Name)
+		colIdxs = append(colIdxs, uint16(i))
+		colTypes = append(colTypes, types.T(col.Typ.Id).ToType())
+	}
+	bat, err := blockio.BlockRead(c.ctx, &info, colIdxs, colTypes, c.ts, c.e.fs, c.e.mp)
+	if err != nil {
+		return nil, err
+	}
+	bat.Attrs = attrs
+	block := &changeBlock{bat: bat}
+	c.blocks[blockID] = block
+	if !info.EntryState {
+		return block, nil
+	}
+	if block.commits, err = blockio.BlockReadCommits(c.ctx, &info, c.e.fs, c.e.mp); err != nil {
+		return nil, err
+	}
+	ts := types.TimestampToTS(c.ts)
+	block.offsets = make([]int, len(block.commits))
+	row := 0
+	for i, commit := range block.commits {
+		block.offsets[i] = -1
+		if !commit.IsEmpty() && commit.LessEq(ts) {
+			block.offsets[i] = row
+			row++
+		}
+	}
+	return block, nil
+}
+
+func (c *tableChanges) free() {
+	for _, block := range c.blocks {
+		if block != nil {
+			block.bat.Clean(c.e.mp)
+		}
+	}
+	c.blocks = nil
+	c.inserts = nil
+}
+
+func projectChangeVectors(bat *batch.Batch, attrs []string) []*vector.Vector {
+	if bat == nil {
+		return nil
+	}
+	vecs := make([]*vector.Vector, len(attrs))
+	for i, attr := range attrs {
+		for j, name := range bat.Attrs {
+			if name == attr && j < len(bat.Vecs) {
+				vecs[i] = bat.Vecs[j]
+				break
+			}
+		}
+	}
+	return vecs
+}
+
+// handle collects the changes and calls the handlers with them.
+func (c *tableChanges) handle() {
+	defer c.free()
+	tblId := c.tl.Table.GetTbId()
+	changes, err := c.collect()
+	if err != nil {
+		logutil.Error("capture the changes of the table failed",
+			zap.Uint64("tableId", tblId), zap.Error(err))
+		return
+	}
+	for i, h := range c.handlers {
+		if err := h.handler(c.ctx, changes[i], c.ts); err != nil {
+			logutil.Error("handle the changes of the table failed",
+				zap.Uint64("tableId", tblId), zap.Error(err))
+		}
+	}
+}
+
+// captureChanges queues the logtail for the change handlers of the table, the state
+// is the partition state before the logtail is applied, which is not changed any more.
+func (e *Engine) captureChanges(ctx context.Context, state *PartitionState, table *cache.TableItem,
+	tl *logtail.TableLogtail) error {
+	handlers := e.changeHandlers.get(subscribeID{db: tl.Table.GetDbId(), tbl: tl.Table.GetTbId()})
+	if len(handlers) == 0 {
+		return nil
+	}
+	select {
+	case e.changeHandlers.queue <- newTableChanges(ctx, e, state, table, tl, handlers):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
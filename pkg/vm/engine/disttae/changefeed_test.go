@@ -0,0 +1,175 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/stretchr/testify/require"
+)
+
+func makeChangeEntryForTest(t *testing.T, typ api.Entry_EntryType, rowIDs []types.Rowid, ts types.TS, keys []int64) api.Entry {
+	mp := testutil.TestUtilMp
+	bat := batch.NewWithSize(2)
+	bat.Attrs = []string{"rowid", "commit_time"}
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	for _, rowID := range rowIDs {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], rowID, false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], ts, false, mp))
+	}
+	if keys != nil {
+		bat.Attrs = append(bat.Attrs, "a")
+		vec := vector.NewVec(types.T_int64.ToType())
+		for _, key := range keys {
+			require.NoError(t, vector.AppendFixed(vec, key, false, mp))
+		}
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	pbat, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	return api.Entry{EntryType: typ, TableName: "t1", Bat: pbat}
+}
+
+func TestCaptureChanges(t *testing.T) {
+	ctx := context.Background()
+	e := &Engine{}
+	state := NewPartitionState()
+	rowIDs := []types.Rowid{
+		model.EncodePhyAddrKey(1, 1, 0),
+		model.EncodePhyAddrKey(1, 1, 1),
+		model.EncodePhyAddrKey(1, 1, 2),
+	}
+
+	// the rows inserted before are in the partition state
+	entry := makeChangeEntryForTest(t, api.Entry_Insert, rowIDs[:2], types.BuildTS(1, 0), []int64{10, 20})
+	state.HandleLogtailEntry(ctx, &entry, -1, nil)
+
+	ts := types.BuildTS(2, 0).ToTimestamp()
+	tl := &logtail.TableLogtail{
+		Ts: &ts,
+		Commands: []api.Entry{
+			makeChangeEntryForTest(t, api.Entry_Insert, rowIDs[2:], types.BuildTS(2, 0), []int64{30}),
+			makeChangeEntryForTest(t, api.Entry_Delete, rowIDs[1:], types.BuildTS(2, 0), nil),
+		},
+	}
+	handlers := []*changeHandler{{attrs: []string{"a", "b"}}}
+	c := newTableChanges(ctx, e, state, nil, tl, handlers)
+	defer c.free()
+	changes, err := c.collect()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+
+	expected := []struct {
+		typ engine.ChangeType
+		key int64
+	}{
+		{engine.ChangeInsert, 30},
+		{engine.ChangeDelete, 20},
+		{engine.ChangeDelete, 30},
+	}
+	require.Equal(t, len(expected), len(changes[0]))
+	for i, change := range changes[0] {
+		require.Equal(t, expected[i].typ, change.Typ)
+		require.Equal(t, types.BuildTS(2, 0).ToTimestamp(), change.CommitTs)
+		require.Equal(t, 2, len(change.Vecs))
+		require.Nil(t, change.Vecs[1])
+		require.Equal(t, expected[i].key, vector.MustFixedCol[int64](change.Vecs[0])[change.Row])
+	}
+
+	// the changes of the logtail with the checkpoint of the dn, the non-appendable
+	// block created after the handler captures the changes from is the start
+	defer func(fn func(context.Context, string, uint64, string, uint64, string,
+		fileservice.FileService) ([]*api.Entry, error)) {
+		loadCheckpointEntries = fn
+	}(loadCheckpointEntries)
+	loadCheckpointEntries = func(context.Context, string, uint64, string, uint64, string,
+		fileservice.FileService) ([]*api.Entry, error) {
+		return []*api.Entry{
+			makeBlockMetaEntryForTest(t, []uint64{2, 3, 4}, []bool{false, false, true},
+				[]types.TS{types.BuildTS(1, 5), types.BuildTS(0, 5), types.BuildTS(1, 5)}),
+			makeBlockDeleteEntryForTest(t, []uint64{4}, types.BuildTS(1, 6)),
+		}, nil
+	}
+	handlers[0].from = types.BuildTS(1, 0).ToTimestamp()
+	tl.CkpLocation = "checkpoint"
+	c = newTableChanges(ctx, e, state, nil, tl, handlers)
+	defer c.free()
+	changes, err = c.collect()
+	require.NoError(t, err)
+	require.Equal(t, len(expected)+1, len(changes[0]))
+	require.Equal(t, engine.ChangeCheckpoint, changes[0][0].Typ)
+	require.Equal(t, types.BuildTS(1, 5).ToTimestamp(), changes[0][0].CommitTs)
+	require.Equal(t, 3, len(c.ckpBlocks))
+	require.False(t, c.ckpBlocks[4].DeleteTime.IsEmpty())
+}
+
+func makeBlockMetaEntryForTest(t *testing.T, blockIDs []uint64, appendable []bool, ts []types.TS) *api.Entry {
+	mp := testutil.TestUtilMp
+	vecs := []*vector.Vector{
+		vector.NewVec(types.T_Rowid.ToType()),
+		vector.NewVec(types.T_TS.ToType()),
+		vector.NewVec(types.T_uint64.ToType()),
+		vector.NewVec(types.T_bool.ToType()),
+		vector.NewVec(types.T_bool.ToType()),
+		vector.NewVec(types.T_varchar.ToType()),
+		vector.NewVec(types.T_varchar.ToType()),
+		vector.NewVec(types.T_TS.ToType()),
+		vector.NewVec(types.T_uint64.ToType()),
+	}
+	for i, id := range blockIDs {
+		require.NoError(t, vector.AppendFixed(vecs[0], model.EncodePhyAddrKey(0, id, 0), false, mp))
+		require.NoError(t, vector.AppendFixed(vecs[1], ts[i], false, mp))
+		require.NoError(t, vector.AppendFixed(vecs[2], id, false, mp))
+		require.NoError(t, vector.AppendFixed(vecs[3], appendable[i], false, mp))
+		require.NoError(t, vector.AppendFixed(vecs[4], false, false, mp))
+		// the rows of the blocks are not read without the locations
+		require.NoError(t, vector.AppendBytes(vecs[5], nil, false, mp))
+		require.NoError(t, vector.AppendBytes(vecs[6], nil, false, mp))
+		require.NoError(t, vector.AppendFixed(vecs[7], ts[i], false, mp))
+		require.NoError(t, vector.AppendFixed(vecs[8], uint64(1), false, mp))
+	}
+	bat := batch.NewWithSize(len(vecs))
+	bat.Vecs = vecs
+	pbat, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	return &api.Entry{EntryType: api.Entry_Insert, TableName: "_1_meta", Bat: pbat}
+}
+
+func makeBlockDeleteEntryForTest(t *testing.T, blockIDs []uint64, ts types.TS) *api.Entry {
+	mp := testutil.TestUtilMp
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	for _, id := range blockIDs {
+		var rowID types.Rowid
+		copy(rowID[:8], types.EncodeUint64(&id))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], rowID, false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], ts, false, mp))
+	}
+	pbat, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	return &api.Entry{EntryType: api.Entry_Delete, TableName: "_1_meta", Bat: pbat}
+}
@@ -39,6 +39,7 @@ import (
 )
 
 var _ engine.Engine = new(Engine)
+var _ engine.SavepointEngine = new(Engine)
 
 func New(
 	ctx context.Context,
@@ -126,6 +127,9 @@ func (e *Engine) Database(ctx context.Context, name string,
 	if txn == nil {
 		return nil, moerr.NewTxnClosedNoCtx(op.Txn().ID)
 	}
+	if err := e.updateSnapshot(ctx, txn); err != nil {
+		return nil, err
+	}
 	if v, ok := txn.databaseMap.Load(genDatabaseKey(ctx, name)); ok {
 		return v.(*txnDatabase), nil
 	}
@@ -373,9 +377,17 @@ func (e *Engine) New(ctx context.Context, op client.TxnOperator) error {
 	txn.writes = append(txn.writes, make([]Entry, 0, 1))
 	e.newTransaction(op, txn)
 
+	if err := e.updateCatalog(ctx, txn); err != nil {
+		e.delTransaction(txn)
+		return err
+	}
+	return nil
+}
+
+// updateCatalog makes the catalog cache readable at the snapshot of the txn.
+func (e *Engine) updateCatalog(ctx context.Context, txn *Transaction) error {
 	if e.UsePushModelOrNot() {
 		if err := e.receiveLogTailTime.blockUntilTxnTimeIsLegal(ctx, txn.meta.SnapshotTS); err != nil {
-			e.delTransaction(txn)
 			return err
 		}
 	} else {
@@ -390,25 +402,22 @@ func (e *Engine) New(ctx context.Context, op client.TxnOperator) error {
 		}
 		table.tableId = catalog.MO_DATABASE_ID
 		table.tableName = catalog.MO_DATABASE
-		if err := e.UpdateOfPull(ctx, txn.dnStores[:1], table, op, catalog.MO_TABLES_REL_ID_IDX,
+		if err := e.UpdateOfPull(ctx, txn.dnStores[:1], table, txn.op, catalog.MO_TABLES_REL_ID_IDX,
 			catalog.MO_CATALOG_ID, catalog.MO_DATABASE_ID, txn.meta.SnapshotTS); err != nil {
-			e.delTransaction(txn)
 			return err
 		}
 
 		table.tableId = catalog.MO_TABLES_ID
 		table.tableName = catalog.MO_TABLES
-		if err := e.UpdateOfPull(ctx, txn.dnStores[:1], table, op, catalog.MO_TABLES_REL_ID_IDX,
+		if err := e.UpdateOfPull(ctx, txn.dnStores[:1], table, txn.op, catalog.MO_TABLES_REL_ID_IDX,
 			catalog.MO_CATALOG_ID, catalog.MO_TABLES_ID, txn.meta.SnapshotTS); err != nil {
-			e.delTransaction(txn)
 			return err
 		}
 
 		table.tableId = catalog.MO_COLUMNS_ID
 		table.tableName = catalog.MO_COLUMNS
-		if err := e.UpdateOfPull(ctx, txn.dnStores[:1], table, op, catalog.MO_TABLES_REL_ID_IDX,
+		if err := e.UpdateOfPull(ctx, txn.dnStores[:1], table, txn.op, catalog.MO_TABLES_REL_ID_IDX,
 			catalog.MO_CATALOG_ID, catalog.MO_COLUMNS_ID, txn.meta.SnapshotTS); err != nil {
-			e.delTransaction(txn)
 			return err
 		}
 	}
@@ -416,6 +425,25 @@ func (e *Engine) New(ctx context.Context, op client.TxnOperator) error {
 	return nil
 }
 
+// updateSnapshot moves the snapshot of the txn forward if the snapshot of the txn
+// operator is updated, which happens after a pessimistic txn waited for the locks
+// held by other txns. The tables opened by the txn are refreshed at the new snapshot.
+func (e *Engine) updateSnapshot(ctx context.Context, txn *Transaction) error {
+	ts := txn.op.Txn().SnapshotTS
+	if !txn.meta.SnapshotTS.Less(ts) {
+		return nil
+	}
+	txn.meta.SnapshotTS = ts
+	if err := e.updateCatalog(ctx, txn); err != nil {
+		return err
+	}
+	txn.tableMap.Range(func(_, v any) bool {
+		v.(*txnTable).updated = false
+		return true
+	})
+	return nil
+}
+
 func (e *Engine) Commit(ctx context.Context, op client.TxnOperator) error {
 	txn := e.getTransaction(op)
 	if txn == nil {
@@ -449,6 +477,31 @@ func (e *Engine) Rollback(ctx context.Context, op client.TxnOperator) error {
 	return nil
 }
 
+func (e *Engine) Savepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	txn := e.getTransaction(op)
+	if txn == nil {
+		return moerr.NewTxnClosedNoCtx(op.Txn().ID)
+	}
+	txn.Savepoint(name)
+	return nil
+}
+
+func (e *Engine) RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	txn := e.getTransaction(op)
+	if txn == nil {
+		return moerr.NewTxnClosedNoCtx(op.Txn().ID)
+	}
+	return txn.RollbackToSavepoint(ctx, name)
+}
+
+func (e *Engine) ReleaseSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	txn := e.getTransaction(op)
+	if txn == nil {
+		return moerr.NewTxnClosedNoCtx(op.Txn().ID)
+	}
+	return txn.ReleaseSavepoint(ctx, name)
+}
+
 func (e *Engine) Nodes() (engine.Nodes, error) {
 	var nodes engine.Nodes
 	cluster := clusterservice.GetMOCluster()
@@ -418,6 +418,7 @@ func (e *Engine) ParallelToReceiveTableLogTail() {
 				}
 				if err := e.firstTimeConnectToLogTailServer(ctx); err == nil {
 					logutil.Info("reconnect to dn log tail server succeed.")
+					go e.resubscribeChangeTables(ctx)
 					break
 				}
 				logutil.Error("reconnect to dn log tail server failed.")
@@ -605,6 +606,8 @@ func updatePartitionOfPush(
 		return ctx.Err()
 	}
 
+	// the rows deleted are looked up in the state before the logtail is applied
+	prevState := partition.state.Load()
 	state, doneMutate := partition.MutateState()
 
 	key := e.catalog.GetTableById(dbId, tblId)
@@ -632,7 +635,7 @@ func updatePartitionOfPush(
 
 	doneMutate()
 
-	return nil
+	return e.captureChanges(ctx, prevState, key, tl)
 }
 
 func consumeLogTailOfPushWithLazyLoad(
@@ -38,7 +38,10 @@ type PartitionReader struct {
 	deletes              map[types.Rowid]uint8
 	skipBlocks           map[uint64]uint8
 	iter                 partitionStateIter
+	sourceBatch          *batch.Batch
 	sourceBatchNameIndex map[string]int
+	// used to get the position of a column in the rows of the partition
+	colPosMp map[string]int
 
 	// the following attributes are used to support cn2s3
 	procMPool       *mpool.MPool
@@ -179,6 +182,32 @@ func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *pla
 		}
 	}
 
+	// the type of a column can be modified after its rows are written, the
+	// column of a source batch is converted once in a read
+	var converted map[*vector.Vector]*vector.Vector
+	defer func() {
+		for _, vec := range converted {
+			vec.Free(mp)
+		}
+	}()
+	convert := func(vec *vector.Vector, typ types.Type) (*vector.Vector, error) {
+		if vec.GetType().Eq(typ) {
+			return vec, nil
+		}
+		if cvec, ok := converted[vec]; ok {
+			return cvec, nil
+		}
+		cvec, err := catalog.ConvertAlteredColumn(vec, typ, mp)
+		if err != nil {
+			return nil, err
+		}
+		if converted == nil {
+			converted = make(map[*vector.Vector]*vector.Vector)
+		}
+		converted[vec] = cvec
+		return cvec, nil
+	}
+
 	for p.iter.Next() {
 		entry := p.iter.Entry()
 
@@ -192,7 +221,8 @@ func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *pla
 			}
 		}
 
-		if p.sourceBatchNameIndex == nil {
+		if p.colPosMp == nil && entry.Batch != p.sourceBatch {
+			p.sourceBatch = entry.Batch
 			p.sourceBatchNameIndex = make(map[string]int)
 			for i, name := range entry.Batch.Attrs {
 				p.sourceBatchNameIndex[name] = i
@@ -204,13 +234,32 @@ func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *pla
 				if err := vector.AppendFixed(b.Vecs[i], entry.RowID, false, mp); err != nil {
 					return nil, err
 				}
+				continue
+			}
+			var pos int
+			if p.colPosMp != nil {
+				// the position of a column holds across versions of the
+				// table, while its name can be changed by ALTER TABLE
+				pos = p.colPosMp[name]
 			} else {
-				appendFuncs[i](
-					b.Vecs[i],
-					entry.Batch.Vecs[p.sourceBatchNameIndex[name]],
-					entry.Offset,
-				)
+				pos = p.sourceBatchNameIndex[name]
+			}
+			if pos >= len(entry.Batch.Vecs) {
+				// the column is added after the row is written
+				if err := b.Vecs[i].UnionNull(mp); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			vec, err := convert(entry.Batch.Vecs[pos], *b.Vecs[i].GetType())
+			if err != nil {
+				return nil, err
 			}
+			appendFuncs[i](
+				b.Vecs[i],
+				vec,
+				entry.Offset,
+			)
 		}
 
 		rows++
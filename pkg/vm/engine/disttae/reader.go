@@ -40,6 +40,9 @@ func (r *blockReader) Close() error {
 }
 
 func (r *blockReader) Read(ctx context.Context, cols []string, _ *plan.Expr, m *mpool.MPool) (*batch.Batch, error) {
+	for len(r.blks) > 0 && !mayContainKeys(r.ctx, r.fs, r.runtimeFilters, &r.blks[0].Info) {
+		r.blks = r.blks[1:]
+	}
 	if len(r.blks) == 0 {
 		return nil, nil
 	}
@@ -84,6 +87,10 @@ func (r *blockReader) Read(ctx context.Context, cols []string, _ *plan.Expr, m *
 	if err != nil {
 		return nil, err
 	}
+	if err = filterRows(r.runtimeFilters, r.colIdxs, bat); err != nil {
+		bat.Clean(m)
+		return nil, err
+	}
 
 	// if it's not sorted, just return
 	if !r.blks[0].Info.Sorted || r.pkidxInColIdxs == -1 || r.expr == nil {
@@ -111,6 +118,9 @@ func (r *blockMergeReader) Close() error {
 }
 
 func (r *blockMergeReader) Read(ctx context.Context, cols []string, expr *plan.Expr, m *mpool.MPool) (*batch.Batch, error) {
+	for len(r.blks) > 0 && !mayContainKeys(r.ctx, r.fs, r.runtimeFilters, &r.blks[0].meta.Info) {
+		r.blks = r.blks[1:]
+	}
 	if len(r.blks) == 0 {
 		r.sels = nil
 		return nil, nil
@@ -162,6 +172,10 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string, expr *plan.E
 		r.sels = append(r.sels, int64(i))
 	}
 	bat.Shrink(r.sels)
+	if err = filterRows(r.runtimeFilters, r.colIdxs, bat); err != nil {
+		bat.Clean(m)
+		return nil, err
+	}
 	return bat, nil
 }
 
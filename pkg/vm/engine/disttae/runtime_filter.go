@@ -0,0 +1,231 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+// runtimeFilter is a runtime filter pushed into the reader by the hash build of a join.
+type runtimeFilter struct {
+	typ     engine.RuntimeFilterType
+	colIdx  int
+	colTyp  types.Type
+	primary bool
+	// keys are the distinct keys of the IN filter
+	keys     containers.Vector
+	min, max any
+	bloom    index.StaticFilter
+}
+
+// newRuntimeFilters decodes the runtime filters for the table, the filters which
+// can't be decoded are ignored. It returns true if no rows are able to match.
+func newRuntimeFilters(tableDef *plan.TableDef, filters []*engine.RuntimeFilter) ([]*runtimeFilter, bool) {
+	rfs := make([]*runtimeFilter, 0, len(filters))
+	for _, f := range filters {
+		if f.Typ == engine.RuntimeFilterDrop {
+			return nil, true
+		}
+		if f.Typ == engine.RuntimeFilterPass || tableDef == nil {
+			continue
+		}
+		idx, ok := tableDef.Name2ColIndex[f.Col]
+		if !ok || int(idx) >= len(tableDef.Cols) {
+			continue
+		}
+		colDef := tableDef.Cols[idx]
+		vec := new(vector.Vector)
+		if err := vec.UnmarshalBinary(f.Keys); err != nil || vec.Length() == 0 {
+			continue
+		}
+		rf := &runtimeFilter{
+			typ:     f.Typ,
+			colIdx:  int(idx),
+			colTyp:  types.T(colDef.Typ.Id).ToType(),
+			primary: colDef.Primary,
+			keys:    containers.NewVectorWithSharedMemory(vec, false),
+		}
+		if rf.colTyp.Oid != vec.GetType().Oid {
+			continue
+		}
+		if f.Typ != engine.RuntimeFilterIn {
+			if vec.Length() != 2 {
+				continue
+			}
+			rf.min, rf.max = rf.keys.Get(0), rf.keys.Get(1)
+		}
+		if f.Typ == engine.RuntimeFilterBloom {
+			bloom, err := index.NewBinaryFuseFilterFromSource(f.Bloom)
+			if err != nil {
+				rf.typ = engine.RuntimeFilterMinMax
+			} else {
+				rf.bloom = bloom
+			}
+		}
+		rfs = append(rfs, rf)
+	}
+	return rfs, false
+}
+
+// mayContainBlock returns false if the zonemap of the block shows that no rows
+// of the block are able to pass the filter.
+func (rf *runtimeFilter) mayContainBlock(meta *BlockMeta) bool {
+	if rf.colIdx >= len(meta.Zonemap) {
+		return true
+	}
+	zm := index.NewZoneMap(rf.colTyp)
+	if err := zm.Unmarshal(meta.Zonemap[rf.colIdx][:]); err != nil {
+		return true
+	}
+	// the zonemap of a column added after the block was written is empty
+	if zm.GetMin() == nil || zm.GetMax() == nil {
+		return true
+	}
+	if rf.typ == engine.RuntimeFilterIn {
+		return zm.FastContainsAny(rf.keys)
+	}
+	if zm.Contains(rf.min) {
+		return true
+	}
+	return compute.CompareGeneric(zm.GetMin(), rf.min, rf.colTyp) >= 0 &&
+		compute.CompareGeneric(zm.GetMin(), rf.max, rf.colTyp) <= 0
+}
+
+// mayContainKeys checks the keys of the IN filter with the bloom filter of the
+// primary key written with the block, any error just keeps the block.
+func (rf *runtimeFilter) mayContainKeys(ctx context.Context, fs fileservice.FileService, info *catalog.BlockInfo) bool {
+	if rf.typ != engine.RuntimeFilterIn || !rf.primary || info.MetaLoc == "" {
+		return true
+	}
+	_, _, extent, _, err := blockio.DecodeLocation(info.MetaLoc)
+	if err != nil {
+		return true
+	}
+	reader, err := blockio.NewObjectReader(fs, info.MetaLoc)
+	if err != nil {
+		return true
+	}
+	bfs, err := reader.LoadBloomFilter(ctx, uint16(rf.colIdx), []uint32{extent.Id()}, nil)
+	if err != nil || len(bfs) == 0 || bfs[0] == nil {
+		return true
+	}
+	ok, _, err := bfs[0].MayContainsAnyKeys(rf.keys, nil)
+	return err != nil || ok
+}
+
+// mayContainKeys returns false if the bloom filters of the block show that
+// none of the keys of the IN filters are in the block.
+func mayContainKeys(ctx context.Context, fs fileservice.FileService, rfs []*runtimeFilter, info *catalog.BlockInfo) bool {
+	for _, rf := range rfs {
+		if !rf.mayContainKeys(ctx, fs, info) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterRows removes the rows of the batch which can't pass the bloom filters.
+func filterRows(rfs []*runtimeFilter, colIdxs []uint16, bat *batch.Batch) error {
+	for _, rf := range rfs {
+		if rf.bloom == nil || bat.Length() == 0 {
+			continue
+		}
+		for i, idx := range colIdxs {
+			if int(idx) != rf.colIdx {
+				continue
+			}
+			vec := bat.GetVector(int32(i))
+			if vec.IsConst() || vec.GetType().Oid != rf.colTyp.Oid {
+				break
+			}
+			_, positive, err := rf.bloom.MayContainsAnyKeys(containers.NewVectorWithSharedMemory(vec, true), nil)
+			if err != nil {
+				return err
+			}
+			if int(positive.GetCardinality()) == bat.Length() {
+				break
+			}
+			sels := make([]int64, 0, positive.GetCardinality())
+			itr := positive.Iterator()
+			for itr.HasNext() {
+				sels = append(sels, int64(itr.Next()))
+			}
+			bat.Shrink(sels)
+			break
+		}
+	}
+	return nil
+}
+
+func (r *blockReader) SetRuntimeFilters(filters []*engine.RuntimeFilter) {
+	rfs, drop := newRuntimeFilters(r.tableDef, filters)
+	if drop {
+		r.blks = nil
+		return
+	}
+	r.runtimeFilters = rfs
+	blks := r.blks[:0]
+	for i := range r.blks {
+		if mayContainBlockByZonemap(rfs, &r.blks[i]) {
+			blks = append(blks, r.blks[i])
+		}
+	}
+	r.blks = blks
+}
+
+func (r *blockMergeReader) SetRuntimeFilters(filters []*engine.RuntimeFilter) {
+	rfs, drop := newRuntimeFilters(r.tableDef, filters)
+	if drop {
+		r.blks = nil
+		return
+	}
+	r.runtimeFilters = rfs
+	blks := r.blks[:0]
+	for i := range r.blks {
+		if mayContainBlockByZonemap(rfs, &r.blks[i].meta) {
+			blks = append(blks, r.blks[i])
+		}
+	}
+	r.blks = blks
+}
+
+func (r *mergeReader) SetRuntimeFilters(filters []*engine.RuntimeFilter) {
+	for _, rd := range r.rds {
+		if rd, ok := rd.(engine.RuntimeFilterReader); ok {
+			rd.SetRuntimeFilters(filters)
+		}
+	}
+}
+
+func mayContainBlockByZonemap(rfs []*runtimeFilter, meta *BlockMeta) bool {
+	for _, rf := range rfs {
+		if !rf.mayContainBlock(meta) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func makeRuntimeFilterForTest(t *testing.T, typ engine.RuntimeFilterType, col string, keys ...int64) *engine.RuntimeFilter {
+	mp := testutil.TestUtilMp
+	vec := vector.NewVec(types.T_int64.ToType())
+	defer vec.Free(mp)
+	for _, key := range keys {
+		require.NoError(t, vector.AppendFixed(vec, key, false, mp))
+	}
+	data, err := vec.MarshalBinary()
+	require.NoError(t, err)
+	return &engine.RuntimeFilter{Typ: typ, Col: col, Keys: data}
+}
+
+func TestRuntimeFilterPruneBlocks(t *testing.T) {
+	tableDef := makeTableDefForTest([]string{"a", "b", "c", "d"})
+	testCases := []struct {
+		filter *engine.RuntimeFilter
+		read   bool
+	}{
+		{makeRuntimeFilterForTest(t, engine.RuntimeFilterIn, "a", 5, 50), true},
+		{makeRuntimeFilterForTest(t, engine.RuntimeFilterIn, "a", 5, 500), false},
+		{makeRuntimeFilterForTest(t, engine.RuntimeFilterMinMax, "d", 0, 2), true},
+		{makeRuntimeFilterForTest(t, engine.RuntimeFilterMinMax, "d", 0, 20), true},
+		{makeRuntimeFilterForTest(t, engine.RuntimeFilterMinMax, "d", 9, 20), false},
+		{makeRuntimeFilterForTest(t, engine.RuntimeFilterMinMax, "e", 9, 20), true},
+		{&engine.RuntimeFilter{Typ: engine.RuntimeFilterPass, Col: "a"}, true},
+		{&engine.RuntimeFilter{Typ: engine.RuntimeFilterDrop, Col: "a"}, false},
+	}
+	for i, tc := range testCases {
+		r := &blockReader{
+			tableDef: tableDef,
+			blks:     []BlockMeta{makeBlockMetaForTest()},
+		}
+		r.SetRuntimeFilters([]*engine.RuntimeFilter{tc.filter})
+		require.Equal(t, tc.read, len(r.blks) == 1, "case %d", i)
+	}
+
+	// the block without zonemaps is always read
+	r := &blockMergeReader{
+		tableDef: tableDef,
+		blks:     []ModifyBlockMeta{{}},
+	}
+	r.SetRuntimeFilters([]*engine.RuntimeFilter{testCases[1].filter})
+	require.Equal(t, 1, len(r.blks))
+}
@@ -111,9 +111,23 @@ func genDropDatabaseTuple(id uint64, name string, m *mpool.MPool) (*batch.Batch,
 
 func genTableConstraintTuple(tblId, dbId uint64, tblName, dbName string, constraint []byte,
 	m *mpool.MPool) (*batch.Batch, error) {
+	return genTableUpdateTuple(tblId, dbId, tblName, dbName,
+		catalog.SystemRelAttr_Constraint, catalog.MO_TABLES_UPDATE_CONSTRAINT, constraint, m)
+}
+
+func genTableAlterTuple(tblId, dbId uint64, tblName, dbName string, req []byte,
+	m *mpool.MPool) (*batch.Batch, error) {
+	return genTableUpdateTuple(tblId, dbId, tblName, dbName,
+		catalog.SystemRelAttr_AlterTable, catalog.MO_TABLES_ALTER_TABLE, req, m)
+}
+
+// genTableUpdateTuple generates a tuple which identifies the table and
+// carries data as its attr at idx
+func genTableUpdateTuple(tblId, dbId uint64, tblName, dbName string,
+	attr string, dataIdx int, data []byte, m *mpool.MPool) (*batch.Batch, error) {
 	bat := batch.NewWithSize(5)
 	bat.Attrs = append(bat.Attrs, catalog.MoTablesSchema[:4]...)
-	bat.Attrs = append(bat.Attrs, catalog.SystemRelAttr_Constraint)
+	bat.Attrs = append(bat.Attrs, attr)
 	bat.SetZs(1, m)
 
 	{
@@ -137,9 +151,9 @@ func genTableConstraintTuple(tblId, dbId uint64, tblName, dbName string, constra
 		if err := vector.AppendFixed(bat.Vecs[idx], dbId, false, m); err != nil {
 			return nil, err
 		}
-		idx = catalog.MO_TABLES_UPDATE_CONSTRAINT
-		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[catalog.MO_TABLES_CONSTRAINT_IDX]) // constraint
-		if err := vector.AppendBytes(bat.Vecs[idx], constraint, false, m); err != nil {
+		idx = dataIdx
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[catalog.MO_TABLES_CONSTRAINT_IDX]) // constraint or alter table
+		if err := vector.AppendBytes(bat.Vecs[idx], data, false, m); err != nil {
 			return nil, err
 		}
 	}
@@ -348,6 +362,11 @@ func genCreateColumnTuple(col column, m *mpool.MPool) (*batch.Batch, error) {
 		if err := vector.AppendFixed(bat.Vecs[idx], col.isClusterBy, false, m); err != nil {
 			return nil, err
 		}
+		idx = catalog.MO_COLUMNS_ATT_COMPRESSION_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // att_compression
+		if err := vector.AppendFixed(bat.Vecs[idx], col.compression, false, m); err != nil {
+			return nil, err
+		}
 
 	}
 	return bat, nil
@@ -712,6 +731,8 @@ func toPBEntry(e Entry) (*api.Entry, error) {
 		typ = api.Entry_Delete
 	} else if e.typ == UPDATE {
 		typ = api.Entry_Update
+	} else if e.typ == ALTER {
+		typ = api.Entry_Alter
 	}
 	bat, err := toPBBatch(ebat)
 	if err != nil {
@@ -897,6 +918,7 @@ func genColumns(accountId uint32, tableName, databaseName string,
 		if attrDef.Attr.ClusterBy {
 			col.isClusterBy = 1
 		}
+		col.compression = int8(attrDef.Attr.Alg)
 
 		cols = append(cols, col)
 		num++
@@ -1029,10 +1051,20 @@ func isMetaTable(name string) bool {
 	return metaTableMatchRegexp.MatchString(name)
 }
 
+// zonemapColumnTypes returns the types of the columns of the table to fetch
+// zonemaps of, row_id has no zonemap
+func zonemapColumnTypes(tableDef *plan.TableDef) []types.T {
+	colTypes := make([]types.T, len(tableDef.Cols)-1)
+	for i := range colTypes {
+		colTypes[i] = types.T(tableDef.Cols[i].Typ.Id)
+	}
+	return colTypes
+}
+
 func genBlockMetas(
 	ctx context.Context,
 	blockInfos []catalog.BlockInfo,
-	columnLength int,
+	colTypes []types.T,
 	fs fileservice.FileService,
 	m *mpool.MPool, prefetch bool) ([]BlockMeta, error) {
 	{
@@ -1054,13 +1086,13 @@ func genBlockMetas(
 
 	metas := make([]BlockMeta, len(blockInfos))
 
-	idxs := make([]uint16, columnLength)
-	for i := 0; i < columnLength; i++ {
+	idxs := make([]uint16, len(colTypes))
+	for i := range colTypes {
 		idxs[i] = uint16(i)
 	}
 
 	for i, blockInfo := range blockInfos {
-		zm, rows, err := fetchZonemapAndRowsFromBlockInfo(ctx, idxs, blockInfo, fs, m)
+		zm, rows, err := fetchZonemapAndRowsFromBlockInfo(ctx, idxs, colTypes, blockInfo, fs, m)
 		if err != nil {
 			if prefetch {
 				continue
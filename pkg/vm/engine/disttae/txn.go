@@ -42,7 +42,7 @@ func (txn *Transaction) getTableMeta(
 	databaseId uint64,
 	tableId uint64,
 	needUpdated bool,
-	columnLength int,
+	colTypes []types.T,
 	prefetch bool,
 ) (*tableMeta, error) {
 	blocks := make([][]BlockMeta, len(txn.dnStores))
@@ -71,7 +71,7 @@ func (txn *Transaction) getTableMeta(
 			iter.Release()
 
 			var err error
-			blocks[i], err = genBlockMetas(ctx, blockInfos, columnLength, txn.proc.FileService,
+			blocks[i], err = genBlockMetas(ctx, blockInfos, colTypes, txn.proc.FileService,
 				txn.proc.GetMPool(), prefetch)
 			if err != nil {
 				return nil, moerr.NewInternalError(ctx, "disttae: getTableMeta err: %v, table: %v", err.Error(), name)
@@ -250,7 +250,7 @@ func (txn *Transaction) WriteFile(typ int, databaseId, tableId uint64,
 }
 
 func (txn *Transaction) deleteBatch(bat *batch.Batch,
-	databaseId, tableId uint64) *batch.Batch {
+	databaseId, tableId uint64) (*batch.Batch, error) {
 
 	// tx for workspace operations
 	t := txn.nextLocalTS()
@@ -273,6 +273,9 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 	}
 
 	sels := txn.proc.Mp().GetSels()
+	defer func() {
+		txn.proc.Mp().PutSels(sels)
+	}()
 	for i := range txn.writes {
 		for j, e := range txn.writes[i] {
 			sels = sels[:0]
@@ -286,7 +289,9 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 					}
 				}
 				if len(sels) != len(vs) {
-					txn.writes[i][j].bat.Shrink(sels)
+					if err := txn.shrinkEntry(i, j, sels); err != nil {
+						return nil, err
+					}
 				}
 			}
 		}
@@ -298,8 +303,139 @@ func (txn *Transaction) deleteBatch(bat *batch.Batch,
 		}
 	}
 	bat.Shrink(sels)
-	txn.proc.Mp().PutSels(sels)
-	return bat
+	return bat, nil
+}
+
+// shrinkEntry shrinks the batch of the write entry, if the entry is written
+// before the latest savepoint, the batch is copied before being shrunk so that
+// the original one can be restored when rolling back to the savepoint.
+func (txn *Transaction) shrinkEntry(i, j int, sels []int64) error {
+	if len(txn.savepoints) == 0 {
+		txn.writes[i][j].bat.Shrink(sels)
+		return nil
+	}
+	sp := txn.savepoints[len(txn.savepoints)-1]
+	if uint64(i) > sp.statementId || (uint64(i) == sp.statementId && j >= sp.offset) {
+		txn.writes[i][j].bat.Shrink(sels)
+		return nil
+	}
+	bat, err := dupBatch(txn.writes[i][j].bat, txn.proc.Mp())
+	if err != nil {
+		return err
+	}
+	bat.Shrink(sels)
+	sp.shrunk = append(sp.shrunk, shrunkEntry{
+		statementId: i,
+		offset:      j,
+		bat:         txn.writes[i][j].bat,
+	})
+	txn.writes[i][j].bat = bat
+	return nil
+}
+
+// Savepoint sets a savepoint with the name, the existing savepoint with
+// the same name is replaced.
+func (txn *Transaction) Savepoint(name string) {
+	txn.Lock()
+	defer txn.Unlock()
+	if i := txn.findSavepoint(name); i >= 0 {
+		txn.removeSavepoint(i)
+	}
+	statementId := uint64(len(txn.writes) - 1)
+	txn.savepoints = append(txn.savepoints, &savepoint{
+		name:        name,
+		statementId: statementId,
+		offset:      len(txn.writes[statementId]),
+		workspace:   txn.workspace.Copy(),
+		tableMap:    copySyncMap(txn.tableMap),
+		databaseMap: copySyncMap(txn.databaseMap),
+		createMap:   copySyncMap(txn.createMap),
+	})
+}
+
+// RollbackToSavepoint discards the writes after the savepoint with the name,
+// the savepoint is kept and the savepoints set after it are removed.
+func (txn *Transaction) RollbackToSavepoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+	i := txn.findSavepoint(name)
+	if i < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	mp := txn.proc.Mp()
+	// restore the shrunk batches from the latest savepoint
+	for k := len(txn.savepoints) - 1; k >= i; k-- {
+		shrunk := txn.savepoints[k].shrunk
+		for n := len(shrunk) - 1; n >= 0; n-- {
+			e := shrunk[n]
+			txn.writes[e.statementId][e.offset].bat.Clean(mp)
+			txn.writes[e.statementId][e.offset].bat = e.bat
+		}
+	}
+	sp := txn.savepoints[i]
+	for k := sp.statementId; k < uint64(len(txn.writes)); k++ {
+		start := 0
+		if k == sp.statementId {
+			start = sp.offset
+		}
+		for _, e := range txn.writes[k][start:] {
+			e.bat.Clean(mp)
+		}
+	}
+	txn.writes[sp.statementId] = txn.writes[sp.statementId][:sp.offset]
+	txn.writes = txn.writes[:sp.statementId+1]
+	txn.statementId = sp.statementId
+
+	// the savepoint may be rolled back to again, so the copies are kept
+	txn.workspace = sp.workspace.Copy()
+	txn.tableMap = copySyncMap(sp.tableMap)
+	txn.databaseMap = copySyncMap(sp.databaseMap)
+	txn.createMap = copySyncMap(sp.createMap)
+	sp.shrunk = nil
+	txn.savepoints = txn.savepoints[:i+1]
+	return nil
+}
+
+// ReleaseSavepoint removes the savepoint with the name and the savepoints
+// set after it, the writes are kept.
+func (txn *Transaction) ReleaseSavepoint(ctx context.Context, name string) error {
+	txn.Lock()
+	defer txn.Unlock()
+	i := txn.findSavepoint(name)
+	if i < 0 {
+		return moerr.NewSavepointNotExist(ctx, name)
+	}
+	for k := len(txn.savepoints) - 1; k >= i; k-- {
+		txn.removeSavepoint(k)
+	}
+	return nil
+}
+
+// removeSavepoint removes the i-th savepoint, the original batches recorded
+// by it are handed over to the previous savepoint if they are still needed.
+func (txn *Transaction) removeSavepoint(i int) {
+	mp := txn.proc.Mp()
+	for _, e := range txn.savepoints[i].shrunk {
+		if i > 0 {
+			prev := txn.savepoints[i-1]
+			if uint64(e.statementId) < prev.statementId ||
+				(uint64(e.statementId) == prev.statementId && e.offset < prev.offset) {
+				prev.shrunk = append(prev.shrunk, e)
+				continue
+			}
+		}
+		e.bat.Clean(mp)
+	}
+	txn.savepoints = append(txn.savepoints[:i], txn.savepoints[i+1:]...)
+}
+
+func (txn *Transaction) findSavepoint(name string) int {
+	for i := len(txn.savepoints) - 1; i >= 0; i-- {
+		if strings.EqualFold(txn.savepoints[i].name, name) {
+			return i
+		}
+	}
+	return -1
 }
 
 func (txn *Transaction) allocateID(ctx context.Context) (uint64, error) {
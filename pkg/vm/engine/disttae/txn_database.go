@@ -122,9 +122,9 @@ func (db *txnDatabase) Relation(ctx context.Context, name string) (engine.Relati
 		constraint:   item.Constraint,
 		parts:        db.txn.engine.getPartitions(db.databaseId, item.Id).Snapshot(),
 	}
-	columnLength := len(item.TableDef.Cols) - 1 // we use this data to fetch zonemap, but row_id has no zonemap
+	colTypes := zonemapColumnTypes(item.TableDef)
 	meta, err := db.txn.getTableMeta(ctx, db.databaseId, item.Id,
-		true, columnLength, true)
+		true, colTypes, true)
 	if err != nil {
 		return nil, err
 	}
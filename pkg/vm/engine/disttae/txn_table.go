@@ -24,7 +24,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/lockservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage/memorytable"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -33,6 +36,23 @@ import (
 
 var _ engine.Relation = new(txnTable)
 
+// copy returns a copy of the table which is not affected by the later
+// modifications of the table, it is used by the savepoints.
+func (tbl *txnTable) copy() *txnTable {
+	ret := *tbl
+	ret.dnList = append([]int(nil), tbl.dnList...)
+	ret.parts = append([]*PartitionState(nil), tbl.parts...)
+	ret.defs = append([]engine.TableDef(nil), tbl.defs...)
+	ret.constraint = append([]byte(nil), tbl.constraint...)
+	if tbl.skipBlocks != nil {
+		ret.skipBlocks = make(map[uint64]uint8, len(tbl.skipBlocks))
+		for k, v := range tbl.skipBlocks {
+			ret.skipBlocks[k] = v
+		}
+	}
+	return &ret
+}
+
 func (tbl *txnTable) Stats(ctx context.Context, expr *plan.Expr) (*plan.Stats, error) {
 	switch tbl.tableId {
 	case catalog.MO_DATABASE_ID, catalog.MO_TABLES_ID, catalog.MO_COLUMNS_ID:
@@ -284,6 +304,7 @@ func (tbl *txnTable) getTableDef() *plan.TableDef {
 					OnUpdate:  attr.Attr.OnUpdate,
 					Comment:   attr.Attr.Comment,
 					ClusterBy: attr.Attr.ClusterBy,
+					Alg:       plan.CompressType(attr.Attr.Alg),
 				})
 				i++
 			}
@@ -326,8 +347,17 @@ func (tbl *txnTable) TableDefs(ctx context.Context) ([]engine.TableDef, error) {
 		}
 		defs = append(defs, c)
 	}
+	var dropped []catalog.DroppedColumn
 	for i, def := range tbl.defs {
 		if attr, ok := def.(*engine.AttributeDef); ok {
+			// the dropped columns are only kept for the storage layout
+			if catalog.IsDroppedColName(attr.Attr.Name) {
+				dropped = append(dropped, catalog.DroppedColumn{
+					Pos: int(attr.Attr.ID) - 1,
+					Typ: attr.Attr.Type.Oid,
+				})
+				continue
+			}
 			if attr.Attr.Name != catalog.Row_ID {
 				defs = append(defs, tbl.defs[i])
 			}
@@ -338,6 +368,12 @@ func (tbl *txnTable) TableDefs(ctx context.Context) ([]engine.TableDef, error) {
 		Key:   catalog.SystemRelAttr_Kind,
 		Value: string(tbl.relKind),
 	})
+	if len(dropped) > 0 {
+		pro.Properties = append(pro.Properties, engine.Property{
+			Key:   catalog.PropDroppedColumns,
+			Value: catalog.EncodeDroppedColumns(dropped),
+		})
+	}
 	if tbl.createSql != "" {
 		pro.Properties = append(pro.Properties, engine.Property{
 			Key:   catalog.SystemRelAttr_CreateSQL,
@@ -366,6 +402,35 @@ func (tbl *txnTable) UpdateConstraint(ctx context.Context, c *engine.ConstraintD
 	return nil
 }
 
+func (tbl *txnTable) AlterTable(ctx context.Context, reqs []*api.AlterTableReq) error {
+	for _, req := range reqs {
+		data, err := req.Marshal()
+		if err != nil {
+			return err
+		}
+		bat, err := genTableAlterTuple(tbl.tableId, tbl.db.databaseId, tbl.tableName, tbl.db.databaseName, data, tbl.db.txn.proc.Mp())
+		if err != nil {
+			return err
+		}
+		if err = tbl.db.txn.WriteBatch(ALTER, catalog.MO_CATALOG_ID, catalog.MO_TABLES_ID,
+			catalog.MO_CATALOG, catalog.MO_TABLES, bat, tbl.db.txn.dnStores[0], -1); err != nil {
+			return err
+		}
+		if rename := req.GetRenameTable(); rename != nil {
+			// the table is looked up by its new name after commit
+			tbl.db.txn.tableMap.Delete(genTableKey(ctx, tbl.tableName, tbl.db.databaseId))
+			tbl.tableName = rename.NewName
+		}
+		if partition := req.GetReplacePartition(); partition != nil {
+			if data, err = partition.MarshalPartitionInfo(); err != nil {
+				return err
+			}
+			tbl.partition = string(data)
+		}
+	}
+	return nil
+}
+
 func (tbl *txnTable) TableColumns(ctx context.Context) ([]*engine.Attribute, error) {
 	var attrs []*engine.Attribute
 	for _, def := range tbl.defs {
@@ -408,6 +473,23 @@ func (tbl *txnTable) GetHideKeys(ctx context.Context) ([]*engine.Attribute, erro
 	return attrs, nil
 }
 
+// lockRows locks the primary keys of the rows written by a pessimistic txn, so that
+// the txns writing the same rows are serialized by the lockservice instead of failing
+// with write-write conflicts at commit. The key of a composite primary key is the value
+// of the hidden composite key column. The locks are held by the lockservice of the CN,
+// the txns writing the same rows from other CNs still conflict at commit.
+func (tbl *txnTable) lockRows(ctx context.Context, bat *batch.Batch) error {
+	if !tbl.db.txn.meta.IsPessimistic() || tbl.primaryIdx < 0 {
+		return nil
+	}
+	ls, ok := lockop.GetLockService()
+	if !ok {
+		return nil
+	}
+	keys := lockop.GetLockKeys(bat.GetVector(int32(tbl.primaryIdx)))
+	return lockop.LockRows(ctx, ls, tbl.tableId, keys, tbl.db.txn.meta.ID, lockservice.LockOptions{})
+}
+
 func (tbl *txnTable) Write(ctx context.Context, bat *batch.Batch) error {
 	if bat == nil {
 		return nil
@@ -426,6 +508,9 @@ func (tbl *txnTable) Write(ctx context.Context, bat *batch.Batch) error {
 		i := rand.Int() % len(tbl.db.txn.dnStores)
 		return tbl.db.txn.WriteFile(INSERT, tbl.db.databaseId, tbl.tableId, tbl.db.databaseName, tbl.tableName, fileName, ibat, tbl.db.txn.dnStores[i])
 	}
+	if err := tbl.lockRows(ctx, bat); err != nil {
+		return err
+	}
 	if tbl.insertExpr == nil {
 		ibat := batch.New(true, bat.Attrs)
 		for j := range bat.Vecs {
@@ -460,7 +545,10 @@ func (tbl *txnTable) Update(ctx context.Context, bat *batch.Batch) error {
 
 func (tbl *txnTable) Delete(ctx context.Context, bat *batch.Batch, name string) error {
 	bat.SetAttributes([]string{catalog.Row_ID})
-	bat = tbl.db.txn.deleteBatch(bat, tbl.db.databaseId, tbl.tableId)
+	bat, err := tbl.db.txn.deleteBatch(bat, tbl.db.databaseId, tbl.tableId)
+	if err != nil {
+		return err
+	}
 	if bat.Length() == 0 {
 		return nil
 	}
@@ -680,6 +768,15 @@ func (tbl *txnTable) newReader(
 		}
 	}
 
+	// columns of a user table are located by position in the rows of the
+	// partition, which lead by the row id and commit ts columns
+	var colPosMp map[string]int
+	switch tbl.tableId {
+	case catalog.MO_DATABASE_ID, catalog.MO_TABLES_ID, catalog.MO_COLUMNS_ID:
+	default:
+		colPosMp = make(map[string]int)
+	}
+
 	mp[catalog.Row_ID] = types.New(types.T_Rowid, 0, 0)
 	for _, def := range tbl.defs {
 		attr, ok := def.(*engine.AttributeDef)
@@ -687,6 +784,9 @@ func (tbl *txnTable) newReader(
 			continue
 		}
 		mp[attr.Attr.Name] = attr.Attr.Type
+		if colPosMp != nil {
+			colPosMp[attr.Attr.Name] = int(attr.Attr.ID) + 1
+		}
 	}
 
 	var iter partitionStateIter
@@ -710,6 +810,7 @@ func (tbl *txnTable) newReader(
 		skipBlocks:      tbl.skipBlocks,
 		iter:            iter,
 		colIdxMp:        colIdxMp,
+		colPosMp:        colPosMp,
 		extendId2s3File: make(map[string]int),
 		s3FileService:   fs,
 		procMPool:       txn.proc.GetMPool(),
@@ -66,8 +66,8 @@ func (tbl *txnTable) updateMeta(ctx context.Context, expr *plan.Expr) error {
 			}
 		}
 
-		columnLength := len(tbl.tableDef.Cols) - 1 //we use this data to fetch zonemap, but row_id has no zonemap
-		meta, err := tbl.db.txn.getTableMeta(ctx, tbl.db.databaseId, tbl.tableId, true, columnLength, false)
+		colTypes := zonemapColumnTypes(tbl.tableDef)
+		meta, err := tbl.db.txn.getTableMeta(ctx, tbl.db.databaseId, tbl.tableId, true, colTypes, false)
 		if err != nil {
 			return err
 		}
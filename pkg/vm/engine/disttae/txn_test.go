@@ -0,0 +1,116 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage/memorytable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavepoint(t *testing.T) {
+	ctx := context.TODO()
+	txn := newTestTransaction()
+
+	bat := newTestInsertBatch(t, txn, 1, 2, 3)
+	require.NoError(t, txn.WriteBatch(INSERT, 0, 1, "db", "t", bat, DNStore{}, 0))
+	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(0))
+
+	txn.Savepoint("sp1")
+	require.NoError(t, txn.WriteBatch(INSERT, 0, 1, "db", "t",
+		newTestInsertBatch(t, txn, 4), DNStore{}, 0))
+	_, err := txn.deleteBatch(newTestDeleteBatch(t, txn, rowids[0]), 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, txn.writes[0][0].bat.Length())
+	err = txn.WriteBatch(INSERT, 0, 1, "db", "t", newTestInsertBatch(t, txn, 4), DNStore{}, 0)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry))
+
+	// the writes after the savepoint are discarded
+	require.NoError(t, txn.RollbackToSavepoint(ctx, "sp1"))
+	require.Equal(t, 1, len(txn.writes[0]))
+	require.Equal(t, 3, txn.writes[0][0].bat.Length())
+	require.NoError(t, txn.WriteBatch(INSERT, 0, 1, "db", "t",
+		newTestInsertBatch(t, txn, 4), DNStore{}, 0))
+
+	// the savepoint is kept after rolling back to it
+	txn.Savepoint("sp2")
+	_, err = txn.deleteBatch(newTestDeleteBatch(t, txn, rowids[1]), 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, txn.writes[0][0].bat.Length())
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "sp2"))
+	require.NoError(t, txn.RollbackToSavepoint(ctx, "sp1"))
+	require.Equal(t, 1, len(txn.writes[0]))
+	require.Equal(t, 3, txn.writes[0][0].bat.Length())
+
+	require.NoError(t, txn.ReleaseSavepoint(ctx, "sp1"))
+	err = txn.RollbackToSavepoint(ctx, "sp1")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+	err = txn.ReleaseSavepoint(ctx, "sp2")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrSavepointNotExist))
+}
+
+func TestSavepointCachedTable(t *testing.T) {
+	ctx := context.TODO()
+	txn := newTestTransaction()
+
+	tbl := &txnTable{tableId: 1, comment: "c1"}
+	txn.tableMap.Store("t", tbl)
+	txn.Savepoint("sp1")
+	tbl.comment = "c2"
+	require.NoError(t, txn.RollbackToSavepoint(ctx, "sp1"))
+	v, ok := txn.tableMap.Load("t")
+	require.True(t, ok)
+	require.Equal(t, "c1", v.(*txnTable).comment)
+}
+
+func newTestTransaction() *Transaction {
+	workspace := memorytable.NewTable[RowID, *workspaceRow, *workspaceRow]()
+	workspace.DisableHistory()
+	txn := &Transaction{
+		proc:        testutil.NewProcess(),
+		readOnly:    true,
+		workspace:   workspace,
+		tableMap:    new(sync.Map),
+		databaseMap: new(sync.Map),
+		createMap:   new(sync.Map),
+	}
+	txn.writes = append(txn.writes, make([]Entry, 0, 1))
+	return txn
+}
+
+func newTestInsertBatch(t *testing.T, txn *Transaction, vs ...int64) *batch.Batch {
+	bat := batch.New(true, []string{"a"})
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(bat.Vecs[0], vs, nil, txn.proc.Mp()))
+	bat.SetZs(len(vs), txn.proc.Mp())
+	return bat
+}
+
+func newTestDeleteBatch(t *testing.T, txn *Transaction, rowids ...types.Rowid) *batch.Batch {
+	bat := batch.New(true, []string{catalog.Row_ID})
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	require.NoError(t, vector.AppendFixedList(bat.Vecs[0], rowids, nil, txn.proc.Mp()))
+	bat.SetZs(len(rowids), txn.proc.Mp())
+	return bat
+}
@@ -39,6 +39,7 @@ const (
 	INSERT = iota
 	DELETE
 	UPDATE
+	ALTER
 )
 
 const (
@@ -76,6 +77,7 @@ type Engine struct {
 	subscriber         *logTailSubscriber
 	receiveLogTailTime syncLogTailTimestamp
 	subscribed         subscribedTable
+	changeHandlers     changeHandlers
 }
 
 type Partitions []*Partition
@@ -127,6 +129,33 @@ type Transaction struct {
 	databaseMap *sync.Map
 	// use to cache created table
 	createMap *sync.Map
+
+	// savepoints of the transaction, the latest one is at the end
+	savepoints []*savepoint
+}
+
+// savepoint records the write set of a transaction when the savepoint is set,
+// the transaction can be rolled back to it without being aborted.
+type savepoint struct {
+	name string
+	// position of txn.writes when the savepoint is set
+	statementId uint64
+	offset      int
+
+	workspace   *memorytable.Table[RowID, *workspaceRow, *workspaceRow]
+	tableMap    *sync.Map
+	databaseMap *sync.Map
+	createMap   *sync.Map
+
+	// original batches of the entries written before the savepoint
+	// and shrunk by the deletes after it
+	shrunk []shrunkEntry
+}
+
+type shrunkEntry struct {
+	statementId int
+	offset      int
+	bat         *batch.Batch
 }
 
 // Entry represents a delete/insert
@@ -214,6 +243,7 @@ type column struct {
 	defaultExpr     []byte
 	constraintType  string
 	isClusterBy     int8
+	compression     int8
 	isHidden        int8
 	isAutoIncrement int8
 	hasUpdate       int8
@@ -235,6 +265,8 @@ type blockReader struct {
 	colNulls       []bool
 	pkidxInColIdxs int
 	pkName         string
+
+	runtimeFilters []*runtimeFilter
 }
 
 type blockMergeReader struct {
@@ -249,6 +281,8 @@ type blockMergeReader struct {
 	colIdxs  []uint16
 	colTypes []types.Type
 	colNulls []bool
+
+	runtimeFilters []*runtimeFilter
 }
 
 type mergeReader struct {
@@ -20,6 +20,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
@@ -46,6 +47,7 @@ const (
 func fetchZonemapAndRowsFromBlockInfo(
 	ctx context.Context,
 	idxs []uint16,
+	colTypes []types.T,
 	blockInfo catalog.BlockInfo,
 	fs fileservice.FileService,
 	m *mpool.MPool) ([][64]byte, uint32, error) {
@@ -61,17 +63,40 @@ func fetchZonemapAndRowsFromBlockInfo(
 		return nil, 0, err
 	}
 
-	obs, err := reader.LoadZoneMaps(ctx, idxs, []uint32{extent.Id()}, m)
+	// columns added to the table after the block was written and columns
+	// whose type is modified since keep an empty zonemap, which never filters
+	// the block out
+	blkTypes, err := blockio.DataColumnTypes(ctx, reader, extent, blockInfo.EntryState, m)
 	if err != nil {
 		return nil, 0, err
 	}
+	hasZonemap := func(i int) bool {
+		idx := int(idxs[i])
+		return idx < len(blkTypes) && blkTypes[idx] == colTypes[i]
+	}
+	loadIdxs := make([]uint16, 0, len(idxs))
+	for i, idx := range idxs {
+		if hasZonemap(i) {
+			loadIdxs = append(loadIdxs, idx)
+		}
+	}
+	if len(loadIdxs) == 0 {
+		return zonemapList, rows, nil
+	}
 
+	obs, err := reader.LoadZoneMaps(ctx, loadIdxs, []uint32{extent.Id()}, m)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	j := 0
 	for i := range idxs {
-		bytes := obs[0][i].GetBuf()
-		if err != nil {
-			return nil, 0, err
+		if !hasZonemap(i) {
+			continue
 		}
+		bytes := obs[0][j].GetBuf()
 		copy(zonemapList[i][:], bytes[:])
+		j++
 	}
 
 	return zonemapList, rows, nil
@@ -773,3 +798,33 @@ func mustVectorToProto(v *vector.Vector) *api.Vector {
 	}
 	return ret
 }
+
+// dupBatch returns a deep copy of the batch
+func dupBatch(bat *batch.Batch, mp *mpool.MPool) (*batch.Batch, error) {
+	ret := batch.New(bat.Ro, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		v, err := vec.Dup(mp)
+		if err != nil {
+			ret.Clean(mp)
+			return nil, err
+		}
+		ret.Vecs[i] = v
+	}
+	ret.Zs = append(mp.GetSels(), bat.Zs...)
+	ret.Cnt = 1
+	return ret, nil
+}
+
+// copySyncMap copies the cache of a transaction, the cached tables are
+// copied too since they are modified after being cached.
+func copySyncMap(m *sync.Map) *sync.Map {
+	ret := new(sync.Map)
+	m.Range(func(k, v any) bool {
+		if tbl, ok := v.(*txnTable); ok {
+			v = tbl.copy()
+		}
+		ret.Store(k, v)
+		return true
+	})
+	return ret
+}
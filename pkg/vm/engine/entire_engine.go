@@ -53,6 +53,32 @@ func (e *EntireEngine) Rollback(ctx context.Context, op client.TxnOperator) erro
 	return err
 }
 
+// Savepoint sets a savepoint in the original engine, the writes to the
+// temporary tables are not covered by savepoints.
+func (e *EntireEngine) Savepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	se, ok := e.Engine.(SavepointEngine)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "savepoint")
+	}
+	return se.Savepoint(ctx, op, name)
+}
+
+func (e *EntireEngine) RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	se, ok := e.Engine.(SavepointEngine)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "rollback to savepoint")
+	}
+	return se.RollbackToSavepoint(ctx, op, name)
+}
+
+func (e *EntireEngine) ReleaseSavepoint(ctx context.Context, op client.TxnOperator, name string) error {
+	se, ok := e.Engine.(SavepointEngine)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "release savepoint")
+	}
+	return se.ReleaseSavepoint(ctx, op, name)
+}
+
 func (e *EntireEngine) Delete(ctx context.Context, databaseName string, op client.TxnOperator) error {
 	return e.Engine.Delete(ctx, databaseName, op)
 }
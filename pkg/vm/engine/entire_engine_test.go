@@ -314,6 +314,10 @@ func (o *testOperator) ApplySnapshot(data []byte) error {
 	return nil
 }
 
+func (o *testOperator) UpdateSnapshot(ctx context.Context, ts timestamp.Timestamp) error {
+	return nil
+}
+
 func (o *testOperator) WriteAndCommit(ctx context.Context, ops []txn.TxnRequest) (*rpc.SendResult, error) {
 	return nil, nil
 }
@@ -60,6 +60,14 @@ func (*CompilerContext) Stats(obj *plan.ObjectRef, e *plan.Expr) *plan.Stats {
 	return &plan.Stats{}
 }
 
+func (*CompilerContext) ColumnStats(obj *plan.ObjectRef) *plan.TableColumnStats {
+	return nil
+}
+
+func (*CompilerContext) TableSecurity(obj *plan.ObjectRef) (*plan.TableSecurity, error) {
+	return nil, nil
+}
+
 func (c *CompilerContext) GetProcess() *process.Process {
 	proc := testutil.NewProcess()
 	proc.Ctx = context.Background()
@@ -37,6 +37,8 @@ type BlockEntry struct {
 	segment *SegmentEntry
 	state   EntryState
 	blkData data.Block
+	// schema is the table schema the block is written with
+	schema *Schema
 }
 
 func NewReplayBlockEntry() *BlockEntry {
@@ -51,6 +53,7 @@ func NewBlockEntry(segment *SegmentEntry, txn txnif.AsyncTxn, state EntryState,
 		MetaBaseEntry: NewMetaBaseEntry(id),
 		segment:       segment,
 		state:         state,
+		schema:        segment.GetTable().GetVisibleSchema(txn),
 	}
 	if dataFactory != nil {
 		e.blkData = dataFactory(e)
@@ -71,6 +74,7 @@ func NewBlockEntryWithMeta(
 		MetaBaseEntry: NewMetaBaseEntry(id),
 		segment:       segment,
 		state:         state,
+		schema:        segment.GetTable().GetVisibleSchema(txn),
 	}
 	e.MetaBaseEntry.CreateWithTxnAndMeta(txn, metaLoc, deltaLoc)
 	if dataFactory != nil {
@@ -187,7 +191,20 @@ func (entry *BlockEntry) InitData(factory DataFactory) {
 	entry.blkData = dataFactory(entry)
 }
 func (entry *BlockEntry) GetBlockData() data.Block { return entry.blkData }
-func (entry *BlockEntry) GetSchema() *Schema       { return entry.GetSegment().GetTable().GetSchema() }
+
+// SetSchema sets the schema the block is written with, it is used by the
+// blocks of a txn's local segment
+func (entry *BlockEntry) SetSchema(schema *Schema) { entry.schema = schema }
+
+// GetSchema returns the schema the block is written with, which may be
+// older than the latest schema of the table after ALTER TABLE
+func (entry *BlockEntry) GetSchema() *Schema {
+	if entry.schema != nil {
+		return entry.schema
+	}
+	return entry.GetSegment().GetTable().GetSchema()
+}
+
 func (entry *BlockEntry) PrepareRollback() (err error) {
 	var empty bool
 	empty, err = entry.MetaBaseEntry.PrepareRollback()
@@ -50,6 +50,7 @@ const (
 	SegmentAttr_Sorted           = "sorted"
 	SnapshotAttr_BlockMaxRow     = "block_max_row"
 	SnapshotAttr_SegmentMaxBlock = "segment_max_block"
+	SnapshotAttr_SchemaVersion   = "schema_version"
 )
 
 type DataFactory interface {
@@ -362,6 +363,7 @@ func (catalog *Catalog) onReplayUpdateTable(cmd *EntryCommand, dataFactory DataF
 	tblun := tbl.SearchNode(un)
 	if tblun == nil {
 		tbl.Insert(un) //TODO isvalid
+		catalog.onReplayTableSchema(tbl, un.Schema)
 	}
 
 }
@@ -387,6 +389,10 @@ func (catalog *Catalog) OnReplayTableBatch(ins, insTxn, insCol, del, delTxn *con
 		schema.AcInfo.TenantID = ins.GetVectorByName(pkgcatalog.SystemRelAttr_AccID).Get(i).(uint32)
 		schema.BlockMaxRows = insTxn.GetVectorByName(SnapshotAttr_BlockMaxRow).Get(i).(uint32)
 		schema.SegmentMaxBlocks = insTxn.GetVectorByName(SnapshotAttr_SegmentMaxBlock).Get(i).(uint16)
+		// checkpoints written before ALTER TABLE is supported have no version
+		if vec := insTxn.GetVectorByName(SnapshotAttr_SchemaVersion); !vec.IsNull(i) {
+			schema.Version = vec.Get(i).(uint32)
+		}
 		txnNode := txnbase.ReadTuple(insTxn, i)
 		catalog.onReplayCreateTable(dbid, tid, schema, txnNode, dataFactory)
 	}
@@ -418,9 +424,10 @@ func (catalog *Catalog) onReplayCreateTable(dbid, tid uint64, schema *Schema, tx
 			},
 			TxnMVCCNode:       txnNode,
 			SchemaConstraints: string(schema.Constraint),
+			Schema:            schema,
 		}
 		tbl.Insert(un)
-
+		catalog.onReplayTableSchema(tbl, schema)
 		return
 	}
 	tbl = NewReplayTableEntry()
@@ -435,9 +442,22 @@ func (catalog *Catalog) onReplayCreateTable(dbid, tid uint64, schema *Schema, tx
 		},
 		TxnMVCCNode:       txnNode,
 		SchemaConstraints: string(schema.Constraint),
+		Schema:            schema,
 	}
 	tbl.Insert(un)
 }
+
+// onReplayTableSchema makes the schema replayed from an ALTER TABLE the
+// latest schema of the table
+func (catalog *Catalog) onReplayTableSchema(tbl *TableEntry, schema *Schema) {
+	if schema == nil || schema.Version <= tbl.schema.Version {
+		return
+	}
+	tbl.schema = schema
+	if err := tbl.db.RenameTableEntry(tbl, schema, nil); err != nil {
+		panic(err)
+	}
+}
 func (catalog *Catalog) onReplayDeleteTable(dbid, tid uint64, txnNode *txnbase.TxnMVCCNode) {
 	catalog.OnReplayTableID(tid)
 	db, err := catalog.GetDatabaseByID(dbid)
@@ -464,6 +484,7 @@ func (catalog *Catalog) onReplayDeleteTable(dbid, tid uint64, txnNode *txnbase.T
 			DeletedAt: txnNode.End,
 		},
 		TxnMVCCNode: txnNode,
+		Schema:      prev.Schema,
 	}
 	tbl.Insert(un)
 
@@ -638,6 +659,8 @@ func (catalog *Catalog) onReplayUpdateBlock(cmd *EntryCommand,
 	}
 	cmd.Block.RWMutex = new(sync.RWMutex)
 	cmd.Block.segment = seg
+	// the schema staged by the txn creating the block is visible to it
+	cmd.Block.schema = tbl.GetVisibleSchemaByTS(un.Start)
 	cmd.Block.blkData = dataFactory.MakeBlockFactory()(cmd.Block)
 	if observer != nil {
 		observer.OnTimeStamp(prepareTS)
@@ -701,6 +724,7 @@ func (catalog *Catalog) onReplayCreateBlock(
 		blk.segment = seg
 		blk.ID = blkid
 		blk.state = state
+		blk.schema = rel.GetVisibleSchemaByTS(txnNode.End)
 		blk.blkData = dataFactory.MakeBlockFactory()(blk)
 		seg.AddEntryLocked(blk)
 		un = &MetadataMVCCNode{
@@ -15,12 +15,15 @@
 package catalog
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -372,3 +375,78 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg1.String())
 	t.Log(tb.String())
 }
+
+func TestSchemaEncoding(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	schema := MockSchema(3, 0)
+	schema.BlockMaxRows = 20
+	schema.ColDefs[2].Dropped = true
+	schema.ColDefs[1].Alg = compress.Zstd
+	schema.Version = 3
+
+	buf, err := schema.Marshal()
+	assert.NoError(t, err)
+	decoded := NewEmptySchema("")
+	n, err := decoded.ReadFrom(bytes.NewReader(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(buf)), n)
+	assert.Equal(t, uint32(20), decoded.BlockMaxRows)
+	assert.Equal(t, uint32(3), decoded.Version)
+	assert.True(t, decoded.ColDefs[2].IsDropped())
+	assert.Equal(t, compress.T(compress.Zstd), decoded.ColDefs[1].GetAlg())
+
+	// a schema encoded before the encoding is versioned is still readable
+	legacy := marshalLegacySchema(t, schema)
+	decoded = NewEmptySchema("")
+	n, err = decoded.ReadFrom(bytes.NewReader(legacy))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(legacy)), n)
+	assert.Equal(t, schema.Name, decoded.Name)
+	assert.Equal(t, uint32(20), decoded.BlockMaxRows)
+	assert.Equal(t, uint32(0), decoded.Version)
+	assert.Equal(t, len(schema.ColDefs), len(decoded.ColDefs))
+	for i, def := range schema.ColDefs {
+		assert.Equal(t, def.Name, decoded.ColDefs[i].Name)
+		assert.Equal(t, def.Type, decoded.ColDefs[i].Type)
+		assert.False(t, decoded.ColDefs[i].IsDropped())
+		assert.Equal(t, compress.T(compress.Lz4), decoded.ColDefs[i].GetAlg())
+	}
+}
+
+// marshalLegacySchema encodes schema without the encoding version and the
+// fields added since
+func marshalLegacySchema(t *testing.T, s *Schema) []byte {
+	var w bytes.Buffer
+	write := func(v any) { assert.NoError(t, binary.Write(&w, binary.BigEndian, v)) }
+	writeString := func(v string) {
+		_, err := common.WriteString(v, &w)
+		assert.NoError(t, err)
+	}
+	write(s.BlockMaxRows)
+	write(s.SegmentMaxBlocks)
+	_, err := s.AcInfo.WriteTo(&w)
+	assert.NoError(t, err)
+	for _, v := range []string{s.Name, s.Comment, s.Partition, s.Relkind, s.Createsql, s.View} {
+		writeString(v)
+	}
+	_, err = common.WriteBytes(s.Constraint, &w)
+	assert.NoError(t, err)
+	write(uint16(len(s.ColDefs)))
+	for _, def := range s.ColDefs {
+		w.Write(types.EncodeType(&def.Type))
+		writeString(def.Name)
+		writeString(def.Comment)
+		for _, v := range []bool{def.NullAbility, def.Hidden, def.PhyAddr, def.AutoIncrement} {
+			write(v)
+		}
+		write(def.SortIdx)
+		for _, v := range []bool{def.Primary, def.SortKey, def.ClusterBy} {
+			write(v)
+		}
+		write(uint64(len(def.Default)))
+		w.Write(def.Default)
+		write(uint64(len(def.OnUpdate)))
+		w.Write(def.OnUpdate)
+	}
+	return w.Bytes()
+}
@@ -291,7 +291,7 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		}
 		n += sn
 		var schemaBuf []byte
-		if schemaBuf, err = cmd.Table.schema.Marshal(); err != nil {
+		if schemaBuf, err = cmd.Table.getLatestSchemaLocked().Marshal(); err != nil {
 			return
 		}
 		if _, err = w.Write(schemaBuf); err != nil {
@@ -401,6 +401,7 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		cmd.entry.GetLatestNodeLocked().(*TableMVCCNode).Schema = cmd.Table.schema
 	case CmdUpdateSegment:
 		entry := NewReplayMetaBaseEntry()
 		if err = binary.Read(r, binary.BigEndian, &entry.ID); err != nil {
@@ -400,6 +400,41 @@ func (e *DBEntry) RemoveEntry(table *TableEntry) (err error) {
 	return
 }
 
+// RenameTableEntry moves the table to the name of schema.
+// A table with the new name must be dropped or invisible to txn.
+func (e *DBEntry) RenameTableEntry(table *TableEntry, schema *Schema, txn txnif.TxnReader) (err error) {
+	e.Lock()
+	defer e.Unlock()
+	oldName := table.GetFullName()
+	newName := genTblFullName(schema.AcInfo.TenantID, schema.Name)
+	if oldName == newName {
+		return
+	}
+	nn := e.nameNodes[newName]
+	if nn != nil && txn != nil {
+		record := nn.GetNode().GetPayload()
+		if err = record.PrepareAdd(txn); err != nil {
+			return
+		}
+	}
+	if old := e.nameNodes[oldName]; old != nil {
+		old.DeleteNode(table.GetID())
+		if old.Length() == 0 {
+			delete(e.nameNodes, oldName)
+		}
+	}
+	if nn == nil {
+		nn = newNodeList(e.GetItemNodeByIDLocked,
+			tableVisibilityFn[*TableEntry],
+			&e.nodesMu,
+			newName)
+		e.nameNodes[newName] = nn
+	}
+	nn.CreateNode(table.GetID())
+	table.fullName = newName
+	return
+}
+
 // Catalog entry is created in following steps:
 // 1. Locate the record. Creating always gets the latest DBEntry.
 // 2.1 If there doesn't exist a DBEntry, add new entry and return.
@@ -27,6 +27,8 @@ const (
 	PhyAddrColumnName    = catalog.Row_ID
 	PhyAddrColumnComment = "Physical address"
 	SortKeyNamePrefx     = "_SORT_"
+	// DroppedColNamePrefix renames a dropped column so that its name can be reused
+	DroppedColNamePrefix = catalog.PrefixDroppedColName
 
 	AttrRowID    = PhyAddrColumnName
 	AttrCommitTs = "commit_time"
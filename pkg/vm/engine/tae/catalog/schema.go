@@ -20,13 +20,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"sort"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
@@ -54,6 +57,8 @@ type ColDef struct {
 	ClusterBy     bool
 	Default       []byte
 	OnUpdate      []byte
+	Dropped       bool       // Dropped Column keeps its position so that old blocks remain readable
+	Alg           compress.T // compression algorithm of the column data
 }
 
 func (def *ColDef) GetName() string     { return def.Name }
@@ -66,6 +71,8 @@ func (def *ColDef) IsPrimary() bool       { return def.Primary }
 func (def *ColDef) IsAutoIncrement() bool { return def.AutoIncrement }
 func (def *ColDef) IsSortKey() bool       { return def.SortKey }
 func (def *ColDef) IsClusterBy() bool     { return def.ClusterBy }
+func (def *ColDef) IsDropped() bool       { return def.Dropped }
+func (def *ColDef) GetAlg() compress.T    { return def.Alg }
 
 type SortKey struct {
 	Defs      []*ColDef
@@ -100,6 +107,19 @@ func (cpk *SortKey) GetDef(pos int) *ColDef         { return cpk.Defs[pos] }
 func (cpk *SortKey) HasColumn(idx int) (found bool) { _, found = cpk.search[idx]; return }
 func (cpk *SortKey) GetSingleIdx() int              { return cpk.Defs[0].Idx }
 
+// The encoding of a schema starts with schemaEncodingMagic and the encoding
+// version, a schema encoded before versioning starts with BlockMaxRows
+const (
+	schemaEncodingMagic = uint32(math.MaxUint32)
+
+	// schemaEncodingV1 adds the dropped flag of columns and the schema version
+	schemaEncodingV1 = uint16(1)
+	// schemaEncodingV2 adds the compression of columns
+	schemaEncodingV2 = uint16(2)
+
+	schemaEncodingVersion = schemaEncodingV2
+)
+
 type Schema struct {
 	AcInfo           accessInfo
 	Name             string
@@ -116,6 +136,9 @@ type Schema struct {
 	SecondaryIndex   string
 	Constraint       []byte
 
+	// Version is increased by every ALTER TABLE
+	Version uint32
+
 	SortKey    *SortKey
 	PhyAddrKey *ColDef
 }
@@ -150,13 +173,24 @@ func (s *Schema) GetSingleSortKeyIdx() int         { return s.SortKey.Defs[0].Id
 func (s *Schema) GetSingleSortKeyType() types.Type { return s.GetSingleSortKey().Type }
 
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
+	encVersion := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
 		return
 	}
+	n = 4
+	if s.BlockMaxRows == schemaEncodingMagic {
+		if err = binary.Read(r, binary.BigEndian, &encVersion); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+			return
+		}
+		n += 2 + 4
+	}
 	if err = binary.Read(r, binary.BigEndian, &s.SegmentMaxBlocks); err != nil {
 		return
 	}
-	n = 4 + 4
+	n += 2
 	var sn int64
 	if sn, err = s.AcInfo.ReadFrom(r); err != nil {
 		return
@@ -251,7 +285,7 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		n += 8
 		def.Default = make([]byte, length)
 		var sn2 int
-		if sn2, err = r.Read(def.Default); err != nil {
+		if sn2, err = io.ReadFull(r, def.Default); err != nil {
 			return
 		}
 		n += int64(sn2)
@@ -262,20 +296,46 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		}
 		n += 8
 		def.OnUpdate = make([]byte, length)
-		if sn2, err = r.Read(def.OnUpdate); err != nil {
+		if sn2, err = io.ReadFull(r, def.OnUpdate); err != nil {
 			return
 		}
 		n += int64(sn2)
+		if encVersion >= schemaEncodingV1 {
+			if err = binary.Read(r, binary.BigEndian, &def.Dropped); err != nil {
+				return
+			}
+			n += 1
+		}
+		// the columns encoded before are compressed by lz4
+		def.Alg = compress.Lz4
+		if encVersion >= schemaEncodingV2 {
+			if err = binary.Read(r, binary.BigEndian, &def.Alg); err != nil {
+				return
+			}
+			n += 1
+		}
 		if err = s.AppendColDef(def); err != nil {
 			return
 		}
 	}
+	if encVersion >= schemaEncodingV1 {
+		if err = binary.Read(r, binary.BigEndian, &s.Version); err != nil {
+			return
+		}
+		n += 4
+	}
 	err = s.Finalize(true)
 	return
 }
 
 func (s *Schema) Marshal() (buf []byte, err error) {
 	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, schemaEncodingMagic); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, schemaEncodingVersion); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxRows); err != nil {
 		return
 	}
@@ -357,6 +417,15 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = w.Write(def.OnUpdate); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, def.Dropped); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, def.Alg); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.Version); err != nil {
+		return
 	}
 	buf = w.Bytes()
 	return
@@ -388,6 +457,9 @@ func (s *Schema) ReadFromBatch(bat *containers.Batch, offset int, targetTid uint
 		def.ClusterBy = i82bool(isClusterBy)
 		isAutoIncrement := bat.GetVectorByName((pkgcatalog.SystemColAttr_IsAutoIncrement)).Get(offset).(int8)
 		def.AutoIncrement = i82bool(isAutoIncrement)
+		isDropped := bat.GetVectorByName((pkgcatalog.SystemColAttr_IsDropped)).Get(offset).(int8)
+		def.Dropped = i82bool(isDropped)
+		def.Alg = compress.T(bat.GetVectorByName((pkgcatalog.SystemColAttr_Compression)).Get(offset).(int8))
 		def.Comment = string(bat.GetVectorByName((pkgcatalog.SystemColAttr_Comment)).Get(offset).([]byte))
 		def.OnUpdate = bat.GetVectorByName((pkgcatalog.SystemColAttr_Update)).Get(offset).([]byte)
 		def.Default = bat.GetVectorByName((pkgcatalog.SystemColAttr_DefaultExpr)).Get(offset).([]byte)
@@ -427,6 +499,7 @@ func (s *Schema) AppendCol(name string, typ types.Type) error {
 		Type:        typ,
 		SortIdx:     -1,
 		NullAbility: true,
+		Alg:         compress.Lz4,
 	}
 	return s.AppendColDef(def)
 }
@@ -437,6 +510,7 @@ func (s *Schema) AppendSortKey(name string, typ types.Type, idx int, isPrimary b
 		Type:    typ,
 		SortIdx: int8(idx),
 		SortKey: true,
+		Alg:     compress.Lz4,
 	}
 	def.Primary = isPrimary
 	return s.AppendColDef(def)
@@ -450,6 +524,7 @@ func (s *Schema) AppendPKCol(name string, typ types.Type, idx int) error {
 		SortKey:     true,
 		Primary:     true,
 		NullAbility: false,
+		Alg:         compress.Lz4,
 	}
 	return s.AppendColDef(def)
 }
@@ -481,6 +556,7 @@ func ColDefFromAttribute(attr engine.Attribute) (*ColDef, error) {
 		ClusterBy:     attr.ClusterBy,
 		Default:       []byte(""),
 		OnUpdate:      []byte(""),
+		Alg:           attr.Alg,
 	}
 	if attr.Default != nil {
 		def.NullAbility = attr.Default.NullAbility
@@ -504,6 +580,185 @@ func (s *Schema) AppendColWithAttribute(attr engine.Attribute) error {
 	return s.AppendColDef(def)
 }
 
+// ApplyAlterTable applies an ALTER TABLE operation on the schema and bumps
+// its version. Column positions never change: a dropped column is kept as a
+// hidden placeholder and a new column is inserted right before the physical
+// address column, so the position of a column is the same in every version
+// and blocks written with an older version can be read with a newer one.
+func (s *Schema) ApplyAlterTable(req *api.AlterTableReq) (err error) {
+	switch op := req.Operation.(type) {
+	case *api.AlterTableReq_AddColumn:
+		err = s.addColumn(op.AddColumn.Column)
+	case *api.AlterTableReq_DropColumn:
+		err = s.dropColumn(op.DropColumn.Name)
+	case *api.AlterTableReq_ModifyColumn:
+		err = s.modifyColumn(op.ModifyColumn.Column)
+	case *api.AlterTableReq_RenameColumn:
+		err = s.renameColumn(op.RenameColumn.OldName, op.RenameColumn.NewName)
+	case *api.AlterTableReq_RenameTable:
+		if op.RenameTable.OldName != s.Name {
+			return moerr.NewInternalErrorNoCtx("rename table %s, but its name is %s", op.RenameTable.OldName, s.Name)
+		}
+		s.Name = op.RenameTable.NewName
+	case *api.AlterTableReq_ReplacePartition:
+		var data []byte
+		if data, err = op.ReplacePartition.MarshalPartitionInfo(); err != nil {
+			return
+		}
+		s.Partition = string(data)
+	default:
+		err = moerr.NewNYINoCtx("alter table operation %T", req.Operation)
+	}
+	if err != nil {
+		return
+	}
+	s.Version++
+	return
+}
+
+func (s *Schema) getAlterableColDef(name string) (*ColDef, error) {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return nil, moerr.NewBadFieldErrorNoCtx(name, s.Name)
+	}
+	def := s.ColDefs[idx]
+	if def.IsPhyAddr() || def.IsDropped() {
+		return nil, moerr.NewBadFieldErrorNoCtx(name, s.Name)
+	}
+	return def, nil
+}
+
+func colDefFromPlan(col *plan.ColDef) (*ColDef, error) {
+	typ := col.GetTyp()
+	return ColDefFromAttribute(engine.Attribute{
+		Name:          col.Name,
+		Type:          types.New(types.T(typ.GetId()), typ.GetWidth(), typ.GetScale()),
+		Default:       col.GetDefault(),
+		OnUpdate:      col.GetOnUpdate(),
+		Comment:       col.GetComment(),
+		AutoIncrement: typ.GetAutoIncr(),
+		ClusterBy:     col.GetClusterBy(),
+		IsHidden:      col.GetHidden(),
+		Alg:           compress.T(col.GetAlg()),
+	})
+}
+
+func (s *Schema) addColumn(col *plan.ColDef) (err error) {
+	if _, ok := s.NameIndex[col.Name]; ok {
+		return moerr.NewInvalidInputNoCtx("duplicate column name '%s'", col.Name)
+	}
+	def, err := colDefFromPlan(col)
+	if err != nil {
+		return
+	}
+	// rows written before read null from the new column
+	if !def.NullAbility {
+		return moerr.NewNotSupportedNoCtx("add not null column '%s'", col.Name)
+	}
+	// keep the physical address column as the last column
+	phyAddr := s.PhyAddrKey
+	def.Idx = phyAddr.Idx
+	phyAddr.Idx++
+	s.ColDefs = append(s.ColDefs[:def.Idx], def, phyAddr)
+	s.NameIndex[def.Name] = def.Idx
+	s.NameIndex[phyAddr.Name] = phyAddr.Idx
+	return
+}
+
+func (s *Schema) dropColumn(name string) (err error) {
+	def, err := s.getAlterableColDef(name)
+	if err != nil {
+		return
+	}
+	if def.IsSortKey() || def.IsPrimary() || def.IsClusterBy() {
+		return moerr.NewNotSupportedNoCtx("drop key column '%s'", name)
+	}
+	delete(s.NameIndex, def.Name)
+	def.Name = fmt.Sprintf("%s%d", DroppedColNamePrefix, def.Idx)
+	def.Dropped = true
+	def.Hidden = true
+	def.NullAbility = true
+	def.AutoIncrement = false
+	def.Default = []byte("")
+	def.OnUpdate = []byte("")
+	s.NameIndex[def.Name] = def.Idx
+	return
+}
+
+// ConvertColumn converts vec, the data of a column written before the type of
+// the column is modified by ALTER TABLE, into typ. vec is returned if its type
+// is typ, otherwise it is left to the caller to close.
+func ConvertColumn(vec containers.Vector, typ types.Type) (containers.Vector, error) {
+	if vec.GetType().Eq(typ) {
+		return vec, nil
+	}
+	mov, err := pkgcatalog.ConvertAlteredColumn(containers.UnmarshalToMoVec(vec), typ, common.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	defer mov.Free(common.DefaultAllocator)
+	converted := containers.MakeVector(typ, vec.Nullable())
+	_ = containers.ForEachValue(mov, false, func(v any, _ uint32) error {
+		converted.Append(v)
+		return nil
+	})
+	return converted, nil
+}
+
+func (s *Schema) modifyColumn(col *plan.ColDef) (err error) {
+	def, err := s.getAlterableColDef(col.Name)
+	if err != nil {
+		return
+	}
+	if def.IsSortKey() || def.IsPrimary() || def.IsClusterBy() {
+		return moerr.NewNotSupportedNoCtx("modify key column '%s'", col.Name)
+	}
+	modified, err := colDefFromPlan(col)
+	if err != nil {
+		return
+	}
+	if !pkgcatalog.IsAlterColumnTypeCompatible(def.Type, modified.Type) {
+		return moerr.NewNotSupportedNoCtx("modify column '%s' from %s to %s", col.Name, def.Type.String(), modified.Type.String())
+	}
+	if def.NullAbility && !modified.NullAbility {
+		return moerr.NewNotSupportedNoCtx("modify nullable column '%s' to not null", col.Name)
+	}
+	def.Type = modified.Type
+	def.NullAbility = modified.NullAbility
+	def.AutoIncrement = modified.AutoIncrement
+	def.Comment = modified.Comment
+	def.Default = modified.Default
+	def.OnUpdate = modified.OnUpdate
+	return
+}
+
+func (s *Schema) renameColumn(oldName, newName string) (err error) {
+	def, err := s.getAlterableColDef(oldName)
+	if err != nil {
+		return
+	}
+	if _, ok := s.NameIndex[newName]; ok {
+		return moerr.NewInvalidInputNoCtx("duplicate column name '%s'", newName)
+	}
+	delete(s.NameIndex, oldName)
+	def.Name = newName
+	s.NameIndex[newName] = def.Idx
+	return
+}
+
+// GetColIdxOf returns the index in s of the column at position idx of
+// schema from, or -1 if s does not have that column. Both schemas must be
+// versions of the same table.
+func (s *Schema) GetColIdxOf(from *Schema, idx int) int {
+	if from.ColDefs[idx].IsPhyAddr() {
+		return s.PhyAddrKey.Idx
+	}
+	if idx >= len(s.ColDefs) || s.ColDefs[idx].IsPhyAddr() {
+		return -1
+	}
+	return idx
+}
+
 func (s *Schema) String() string {
 	buf, _ := json.Marshal(s)
 	return string(buf)
@@ -598,6 +853,7 @@ func (s *Schema) Finalize(withoutPhyAddr bool) (err error) {
 			Hidden:      true,
 			NullAbility: false,
 			PhyAddr:     true,
+			Alg:         compress.Lz4,
 		}
 		if err = s.AppendColDef(phyAddrDef); err != nil {
 			return
@@ -24,9 +24,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 type TableDataFactory = func(meta *TableEntry) data.Table
@@ -202,6 +204,104 @@ func (entry *TableEntry) GetSchema() *Schema {
 	return entry.schema
 }
 
+// GetVisibleSchema returns the schema of the table visible to txn
+func (entry *TableEntry) GetVisibleSchema(txn txnif.TxnReader) *Schema {
+	if txn == nil {
+		return entry.GetSchema()
+	}
+	return entry.GetVisibleSchemaByTS(txn.GetStartTS())
+}
+
+// GetVisibleSchemaByTS returns the schema of the table visible at ts. It
+// falls back to the latest schema if no table version is visible at ts
+func (entry *TableEntry) GetVisibleSchemaByTS(ts types.TS) *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	un := entry.GetVisibleNode(ts)
+	if un == nil {
+		return entry.schema
+	}
+	if node := un.(*TableMVCCNode); node.Schema != nil {
+		return node.Schema
+	}
+	return entry.schema
+}
+
+// GetSchemaBefore returns the schema of the latest table version committed
+// before ts, or nil if there is none
+func (entry *TableEntry) GetSchemaBefore(ts types.TS) (schema *Schema) {
+	entry.RLock()
+	defer entry.RUnlock()
+	entry.LoopChain(func(un txnif.MVCCNode) bool {
+		node := un.(*TableMVCCNode)
+		if node.IsAborted() || !node.IsCommitted() || !node.GetEnd().Less(ts) {
+			return true
+		}
+		schema = node.Schema
+		return false
+	})
+	return
+}
+
+// AlterTable applies req to a copy of the latest schema in txn. The new
+// schema is staged on the table version of txn and becomes the latest schema
+// of the table when txn commits, blocks created before keep the schema they
+// were written with.
+func (entry *TableEntry) AlterTable(txn txnif.TxnReader, req *api.AlterTableReq) (isNewNode bool, newSchema *Schema, err error) {
+	entry.Lock()
+	needWait, txnToWait := entry.NeedWaitCommitting(txn.GetStartTS())
+	if needWait {
+		entry.Unlock()
+		txnToWait.GetTxnState(true)
+		entry.Lock()
+	}
+	if err = entry.CheckConflict(txn); err != nil {
+		entry.Unlock()
+		return
+	}
+	newSchema = entry.getLatestSchemaLocked().Clone()
+	if err = newSchema.ApplyAlterTable(req); err != nil {
+		entry.Unlock()
+		return
+	}
+	var node *TableMVCCNode
+	isNewNode, node = entry.getOrSetUpdateNode(txn)
+	node.Schema = newSchema
+	entry.Unlock()
+
+	// the db entry is always locked before the table entry
+	if req.GetRenameTable() != nil {
+		err = entry.GetDB().RenameTableEntry(entry, newSchema, txn)
+	}
+	return
+}
+
+// getLatestSchemaLocked returns the schema of the latest table version, it
+// is the schema staged by ALTER TABLE if the version is not committed yet
+func (entry *TableEntry) getLatestSchemaLocked() *Schema {
+	if node := entry.GetLatestNodeLocked(); node != nil {
+		if schema := node.(*TableMVCCNode).Schema; schema != nil {
+			return schema
+		}
+	}
+	return entry.schema
+}
+
+// ApplyCommit commits the latest table version and publishes the schema
+// staged on it as the latest schema of the table
+func (entry *TableEntry) ApplyCommit(index *wal.Index) (err error) {
+	entry.Lock()
+	defer entry.Unlock()
+	node := entry.GetLatestNodeLocked().(*TableMVCCNode)
+	if err = node.ApplyCommit(index); err != nil {
+		return
+	}
+	if node.Schema != nil {
+		entry.schema = node.Schema
+	}
+	return
+}
+
 func (entry *TableEntry) GetColDefs() []*ColDef {
 	colDefs := entry.schema.ColDefs
 	colDefs = append(colDefs, entry.schema.PhyAddrKey)
@@ -373,7 +473,10 @@ func (entry *TableEntry) PrepareRollback() (err error) {
 		if err != nil {
 			return
 		}
+		return
 	}
+	// restore the name changed by the rollbacked txn
+	err = entry.GetDB().RenameTableEntry(entry, entry.GetSchema(), nil)
 	return
 }
 
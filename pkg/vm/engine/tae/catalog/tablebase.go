@@ -90,6 +90,7 @@ func (be *TableBaseEntry) CreateWithTxn(txn txnif.AsyncTxn, schema *Schema) {
 		},
 		TxnMVCCNode:       txnbase.NewTxnMVCCNodeWithTxn(txn),
 		SchemaConstraints: string(schema.Constraint),
+		Schema:            schema,
 	}
 	be.Insert(node)
 }
@@ -30,6 +30,9 @@ type TableMVCCNode struct {
 	*EntryMVCCNode
 	*txnbase.TxnMVCCNode
 	SchemaConstraints string // store as immutable, bytes actually
+	// Schema is the schema of this version, it is shared by the following
+	// versions until an ALTER TABLE creates a new one
+	Schema *Schema
 }
 
 func NewEmptyTableMVCCNode() txnif.MVCCNode {
@@ -48,6 +51,7 @@ func (e *TableMVCCNode) CloneAll() txnif.MVCCNode {
 	node.EntryMVCCNode = e.EntryMVCCNode.Clone()
 	node.TxnMVCCNode = e.TxnMVCCNode.CloneAll()
 	node.SchemaConstraints = e.SchemaConstraints
+	node.Schema = e.Schema
 	return node
 }
 
@@ -56,6 +60,7 @@ func (e *TableMVCCNode) CloneData() txnif.MVCCNode {
 		EntryMVCCNode:     e.EntryMVCCNode.CloneData(),
 		TxnMVCCNode:       &txnbase.TxnMVCCNode{},
 		SchemaConstraints: e.SchemaConstraints,
+		Schema:            e.Schema,
 	}
 }
 
@@ -73,6 +78,7 @@ func (e *TableMVCCNode) Update(vun txnif.MVCCNode) {
 	e.CreatedAt = un.CreatedAt
 	e.DeletedAt = un.DeletedAt
 	e.SchemaConstraints = un.SchemaConstraints
+	e.Schema = un.Schema
 }
 
 func (e *TableMVCCNode) ApplyCommit(index *wal.Index) (err error) {
@@ -39,6 +39,15 @@ func ApplyUpdates(vec Vector, mask *roaring.Bitmap, vals map[uint32]any) {
 	}
 }
 
+// MakeNullVector returns a nullable vector of rows nulls
+func MakeNullVector(typ types.Type, rows int) Vector {
+	vec := MakeVector(typ, true)
+	for i := 0; i < rows; i++ {
+		vec.Append(types.Null{})
+	}
+	return vec
+}
+
 func FillBufferWithBytes(bs *Bytes, buffer *bytes.Buffer) *Bytes {
 	buffer.Reset()
 	size := bs.Size()
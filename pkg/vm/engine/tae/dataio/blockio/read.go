@@ -24,12 +24,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
@@ -165,23 +167,44 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 		}()
 	}
 
-	loadBlock := func(idxes []uint16) ([]*batch.Batch, error) {
-		if len(idxes) == 0 && ok {
-			// only read rowid column on non appendable block, return early
-			bat.AddVector(catalog.AttrRowID, rowIdVec)
-			return nil, nil
+	dataCols, err := DataColumnCount(ctx, reader, extent, info.EntryState, m)
+	if err != nil {
+		return nil, err
+	}
+	// commit ts and aborted are the last two columns of an appendable block
+	colCount := dataCols + appendableExtraCols
+	// columns added by ALTER TABLE after the block was written are not in it
+	// and are read as nulls
+	loadIdxes := make([]uint16, 0, len(idxes))
+	for _, idx := range idxes {
+		if idx < dataCols {
+			loadIdxes = append(loadIdxes, idx)
 		}
-		bats, err := reader.LoadColumns(ctx, idxes, []uint32{id}, m)
-		if err != nil {
-			return nil, err
+	}
+
+	loadBlock := func(idxes []uint16) ([]*batch.Batch, error) {
+		var bats []*batch.Batch
+		var entry []*vector.Vector
+		if len(idxes) > 0 {
+			if bats, err = reader.LoadColumns(ctx, idxes, []uint32{id}, m); err != nil {
+				return nil, err
+			}
+			entry = bats[0].Vecs
 		}
-		entry := bats[0].Vecs
 		for i, typ := range colTypes {
 			if typ.Oid == types.T_Rowid {
 				bat.AddVector(fmt.Sprintf("%d", i), rowIdVec)
+			} else if colIndexes[i] >= dataCols {
+				bat.AddVector(fmt.Sprintf("%d", i), containers.MakeNullVector(typ, int(rows)))
 			} else {
+				// the type of a column can be modified after the block is
+				// written, its data is converted to the type read
+				vec, err := pkgcatalog.ConvertAlteredColumn(entry[0], typ, m)
+				if err != nil {
+					return nil, err
+				}
 				bat.AddVector(fmt.Sprintf("%d", i),
-					containers.NewVectorWithSharedMemory(entry[0], true))
+					containers.NewVectorWithSharedMemory(vec, true))
 				entry = entry[1:]
 			}
 		}
@@ -190,14 +213,8 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 
 	loadAppendBlock := func() error {
 		// appendable block should be filtered by committs
-		blocks, err := reader.(*BlockReader).reader.ReadMeta(ctx, []objectio.Extent{extent}, m, LoadZoneMapFunc)
-		if err != nil {
-			return err
-		}
-
-		colCount := blocks[0].GetColumnCount()
-		idxes = append(idxes, colCount-2) // committs
-		idxes = append(idxes, colCount-1) // aborted
+		idxes := append(loadIdxes, colCount-2) // committs
+		idxes = append(idxes, colCount-1)      // aborted
 		bats, err := loadBlock(idxes)
 		if err != nil {
 			return err
@@ -227,7 +244,7 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 	if info.EntryState {
 		err = loadAppendBlock()
 	} else {
-		_, err = loadBlock(idxes)
+		_, err = loadBlock(loadIdxes)
 	}
 
 	if err != nil {
@@ -236,6 +253,57 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 	return bat, nil
 }
 
+// appendableExtraCols is the number of columns an appendable block persists
+// after the table columns: the physical address, commit ts and aborted
+const appendableExtraCols = 3
+
+// DataColumnCount returns the number of table columns the block is persisted
+// with. Columns added to the table afterwards are not in the block.
+func DataColumnCount(
+	ctx context.Context,
+	reader dataio.Reader,
+	extent objectio.Extent,
+	appendable bool,
+	m *mpool.MPool) (uint16, error) {
+	blocks, err := reader.(*BlockReader).reader.ReadMeta(ctx, []objectio.Extent{extent}, m, LoadZoneMapFunc)
+	if err != nil {
+		return 0, err
+	}
+	count := blocks[0].GetColumnCount()
+	if appendable {
+		count -= appendableExtraCols
+	}
+	return count, nil
+}
+
+// DataColumnTypes returns the types of the table columns the block is
+// persisted with. The type of a column can be modified by ALTER TABLE after
+// the block is written.
+func DataColumnTypes(
+	ctx context.Context,
+	reader dataio.Reader,
+	extent objectio.Extent,
+	appendable bool,
+	m *mpool.MPool) ([]types.T, error) {
+	blocks, err := reader.(*BlockReader).reader.ReadMeta(ctx, []objectio.Extent{extent}, m, LoadZoneMapFunc)
+	if err != nil {
+		return nil, err
+	}
+	count := blocks[0].GetColumnCount()
+	if appendable {
+		count -= appendableExtraCols
+	}
+	colTypes := make([]types.T, count)
+	for i := range colTypes {
+		col, err := blocks[0].GetColumn(uint16(i))
+		if err != nil {
+			return nil, err
+		}
+		colTypes[i] = types.T(col.GetMeta().GetType())
+	}
+	return colTypes, nil
+}
+
 func readBlockDelete(ctx context.Context, deltaloc string, fs fileservice.FileService) (*containers.Batch, error) {
 	bat := containers.NewBatch()
 	colNames := []string{catalog.PhyAddrColumnName, catalog.AttrCommitTs, catalog.AttrAborted}
@@ -280,3 +348,63 @@ func recordDeletes(columnBatch *containers.Batch, deleteBatch *containers.Batch,
 		columnBatch.Deletes.Add(row)
 	}
 }
+
+// BlockReadCommits reads the commit timestamps of the rows of an appendable
+// block, the timestamps of the rows aborted are empty.
+func BlockReadCommits(
+	ctx context.Context,
+	info *pkgcatalog.BlockInfo,
+	fs fileservice.FileService,
+	m *mpool.MPool) ([]types.TS, error) {
+	_, id, extent, _, err := DecodeLocation(info.MetaLoc)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := NewObjectReader(fs, info.MetaLoc)
+	if err != nil {
+		return nil, err
+	}
+	dataCols, err := DataColumnCount(ctx, reader, extent, true, m)
+	if err != nil {
+		return nil, err
+	}
+	colCount := dataCols + appendableExtraCols
+	bats, err := reader.LoadColumns(ctx, []uint16{colCount - 2, colCount - 1}, []uint32{id}, m)
+	if err != nil {
+		return nil, err
+	}
+	commits := containers.NewVectorWithSharedMemory(bats[0].Vecs[0], false)
+	defer commits.Close()
+	aborts := containers.NewVectorWithSharedMemory(bats[0].Vecs[1], false)
+	defer aborts.Close()
+	ts := make([]types.TS, commits.Length())
+	for i := range ts {
+		if !aborts.Get(i).(bool) {
+			ts[i] = commits.Get(i).(types.TS)
+		}
+	}
+	return ts, nil
+}
+
+// BlockReadDeletes reads the rows deleted in the delta location and the commit
+// timestamps of the deletes, the deletes aborted are skipped.
+func BlockReadDeletes(
+	ctx context.Context,
+	deltaloc string,
+	fs fileservice.FileService) ([]types.Rowid, []types.TS, error) {
+	deleteBatch, err := readBlockDelete(ctx, deltaloc, fs)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer deleteBatch.Close()
+	var rowids []types.Rowid
+	var commits []types.TS
+	for i := 0; i < deleteBatch.Length(); i++ {
+		if deleteBatch.GetVectorByName(catalog.AttrAborted).Get(i).(bool) {
+			continue
+		}
+		rowids = append(rowids, deleteBatch.GetVectorByName(catalog.PhyAddrColumnName).Get(i).(types.Rowid))
+		commits = append(commits, deleteBatch.GetVectorByName(catalog.AttrCommitTs).Get(i).(types.TS))
+	}
+	return rowids, commits, nil
+}
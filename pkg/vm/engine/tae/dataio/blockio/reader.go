@@ -226,7 +226,7 @@ func LoadZoneMapFunc(buf []byte, typ types.Type) (any, error) {
 	return zm, err
 }
 
-func LoadBloomFilterFunc(size int64) objectio.ToObjectFunc {
+func LoadBloomFilterFunc(size int64, alg uint8) objectio.ToObjectFunc {
 	return func(reader io.Reader, data []byte) (any, int64, error) {
 		// decompress
 		var err error
@@ -237,7 +237,7 @@ func LoadBloomFilterFunc(size int64) objectio.ToObjectFunc {
 			}
 		}
 		decompressed := make([]byte, size)
-		decompressed, err = compress.Decompress(data, decompressed, compress.Lz4)
+		decompressed, err = compress.Decompress(data, decompressed, int(alg))
 		if err != nil {
 			return nil, 0, err
 		}
@@ -249,7 +249,7 @@ func LoadBloomFilterFunc(size int64) objectio.ToObjectFunc {
 	}
 }
 
-func LoadColumnFunc(size int64) objectio.ToObjectFunc {
+func LoadColumnFunc(size int64, alg uint8) objectio.ToObjectFunc {
 	return func(reader io.Reader, data []byte) (any, int64, error) {
 		// decompress
 		var err error
@@ -260,7 +260,7 @@ func LoadColumnFunc(size int64) objectio.ToObjectFunc {
 			}
 		}
 		decompressed := make([]byte, size)
-		decompressed, err = compress.Decompress(data, decompressed, compress.Lz4)
+		decompressed, err = compress.Decompress(data, decompressed, int(alg))
 		if err != nil {
 			return nil, 0, err
 		}
@@ -47,6 +47,10 @@ func (w *BlockWriter) SetPrimaryKey(idx uint16) {
 	w.pk = idx
 }
 
+func (w *BlockWriter) SetCompression(idx uint16, alg uint8) {
+	w.writer.SetCompression(idx, alg)
+}
+
 func (w *BlockWriter) WriteBlock(columns *containers.Batch) (block objectio.BlockObject, err error) {
 	bat := batch.New(true, columns.Attrs)
 	bat.Vecs = containers.UnmarshalToMoVecs(columns.Vecs)
@@ -66,6 +66,10 @@ type Writer interface {
 	// data needs to generate BloomFilter according to the primary key
 	SetPrimaryKey(idx uint16)
 
+	// SetCompression sets the compression algorithm of the column idx,
+	// columns are compressed with lz4 by default
+	SetCompression(idx uint16, alg uint8)
+
 	// WriteBatch writes a batch into the buffer, and at the same time
 	// generates a ZoneMap for each column in the batch, and generates
 	// a BloomFilter for the primary key if there is a primary key, and
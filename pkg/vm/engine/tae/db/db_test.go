@@ -28,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
@@ -329,7 +330,7 @@ func TestTableHandle(t *testing.T) {
 	t.Log(tableMeta.String())
 	table := tableMeta.GetTableData()
 
-	handle := table.GetHandle()
+	handle := table.GetHandle(schema.Version)
 	appender, err := handle.GetAppender()
 	assert.Nil(t, appender)
 	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrAppendableSegmentNotFound))
@@ -5011,6 +5012,254 @@ func TestUpdateCstr(t *testing.T) {
 	assert.Equal(t, api.Entry_Delete, resp.Commands[1].EntryType)
 }
 
+func TestAlterTable(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, -1)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 15)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+
+	alter := func(req *api.AlterTableReq) error {
+		txn, rel := tae.getRelation()
+		if err := rel.AlterTable(req); err != nil {
+			_ = txn.Rollback()
+			return err
+		}
+		return txn.Commit()
+	}
+
+	// a txn started before the table is altered cannot append
+	staleTxn, staleRel := tae.getRelation()
+
+	err := alter(&api.AlterTableReq{Operation: &api.AlterTableReq_AddColumn{
+		AddColumn: &plan.AlterTableAddColumn{Column: &plan.ColDef{
+			Name:    "added",
+			Typ:     &plan.Type{Id: int32(types.T_int64)},
+			Default: &plan.Default{NullAbility: true},
+		}},
+	}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, staleRel.Append(bat))
+	assert.Error(t, staleTxn.Commit())
+
+	txn, rel := tae.getRelation()
+	newSchema := rel.Schema().(*catalog.Schema)
+	assert.Equal(t, uint32(1), newSchema.Version)
+	assert.Equal(t, 3, newSchema.GetColIdx("added"))
+	assert.True(t, newSchema.ColDefs[len(newSchema.ColDefs)-1].IsPhyAddr())
+	checkAllColRowsByScan(t, rel, 15, false)
+	forEachColumnView(rel, newSchema.GetColIdx("added"), func(view *model.ColumnView) error {
+		assert.Equal(t, uint64(view.Length()), view.GetData().NullMask().GetCardinality())
+		return nil
+	})
+	newBat := catalog.MockBatch(newSchema, 10)
+	defer newBat.Close()
+	assert.NoError(t, rel.Append(newBat))
+	dbID, tblID := rel.GetMeta().(*catalog.TableEntry).GetDB().ID, rel.ID()
+	assert.NoError(t, txn.Commit())
+	tae.checkRowsByScan(25, false)
+
+	// the logtail of blocks of the old schema is aligned to the new one
+	tots := func(ts types.TS) *timestamp.Timestamp {
+		return &timestamp.Timestamp{PhysicalTime: types.DecodeInt64(ts[4:12]), LogicalTime: types.DecodeUint32(ts[:4])}
+	}
+	resp, err := logtail.HandleSyncLogTailReq(context.Background(), new(dummyCpkGetter), tae.LogtailMgr, tae.Catalog, api.SyncLogTailReq{
+		CnHave: tots(types.BuildTS(0, 0)),
+		CnWant: tots(types.MaxTs()),
+		Table:  &api.TableID{DbId: dbID, TbId: tblID},
+	}, true)
+	assert.NoError(t, err)
+	insBat, err := batch.ProtoBatchToBatch(resp.Commands[0].Bat)
+	assert.NoError(t, err)
+	assert.Equal(t, 2+len(newSchema.Attrs()), len(insBat.Vecs))
+	for _, vec := range insBat.Vecs {
+		assert.Equal(t, 25, vec.Length())
+	}
+
+	// blocks of the old schema are converted when compacted and merged
+	tae.compactBlocks(false)
+	tae.mergeBlocks(false)
+	tae.checkRowsByScan(25, false)
+
+	assert.Error(t, alter(&api.AlterTableReq{Operation: &api.AlterTableReq_DropColumn{
+		DropColumn: &plan.AlterTableDropColumn{Name: "missing"},
+	}}))
+	assert.NoError(t, alter(&api.AlterTableReq{Operation: &api.AlterTableReq_DropColumn{
+		DropColumn: &plan.AlterTableDropColumn{Name: "mock_1"},
+	}}))
+	assert.NoError(t, alter(&api.AlterTableReq{Operation: &api.AlterTableReq_RenameColumn{
+		RenameColumn: &plan.AlterTableRenameColumn{OldName: "mock_0", NewName: "renamed"},
+	}}))
+	assert.NoError(t, alter(&api.AlterTableReq{Operation: &api.AlterTableReq_ReplacePartition{
+		ReplacePartition: &plan.PartitionByDef{
+			Type:       plan.PartitionType_HASH,
+			Partitions: []*plan.PartitionItem{{PartitionName: "p0", PartitionTableName: "__mo_partition_p0"}},
+		},
+	}}))
+	assert.NoError(t, alter(&api.AlterTableReq{Operation: &api.AlterTableReq_RenameTable{
+		RenameTable: &plan.AlterTableRenameTable{OldName: schema.Name, NewName: "renamed_table"},
+	}}))
+
+	check := func() {
+		txn, db := tae.getTestDB()
+		_, err := db.GetRelationByName(schema.Name)
+		assert.True(t, moerr.IsMoErrCode(err, moerr.OkExpectedEOB))
+		rel, err := db.GetRelationByName("renamed_table")
+		assert.NoError(t, err)
+		schema := rel.Schema().(*catalog.Schema)
+		assert.Equal(t, uint32(5), schema.Version)
+		assert.True(t, schema.ColDefs[1].IsDropped())
+		assert.Equal(t, 0, schema.GetColIdx("renamed"))
+		partition := &plan.PartitionByDef{}
+		assert.NoError(t, partition.UnMarshalPartitionInfo([]byte(schema.Partition)))
+		assert.Equal(t, "__mo_partition_p0", partition.Partitions[0].PartitionTableName)
+		assert.Equal(t, 25, getColumnRowsByScan(t, rel, 0, false))
+		assert.NoError(t, txn.Commit())
+	}
+	check()
+	tae.restart()
+	check()
+}
+
+func TestAlterTableUncommitted(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, -1)
+	schema.BlockMaxRows = 10
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 5)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+
+	addColumn := func(name string) *api.AlterTableReq {
+		return &api.AlterTableReq{Operation: &api.AlterTableReq_AddColumn{
+			AddColumn: &plan.AlterTableAddColumn{Column: &plan.ColDef{
+				Name:    name,
+				Typ:     &plan.Type{Id: int32(types.T_int64)},
+				Default: &plan.Default{NullAbility: true},
+			}},
+		}}
+	}
+
+	txn, rel := tae.getRelation()
+	entry := rel.GetMeta().(*catalog.TableEntry)
+	assert.NoError(t, rel.AlterTable(addColumn("a1")))
+	assert.NoError(t, rel.AlterTable(addColumn("a2")))
+	altered := rel.Schema().(*catalog.Schema)
+	assert.Equal(t, uint32(2), altered.Version)
+
+	// the altered schema is staged until the txn commits
+	assert.Equal(t, uint32(0), entry.GetSchema().Version)
+	otherTxn, otherRel := tae.getRelation()
+	assert.Equal(t, uint32(0), otherRel.Schema().(*catalog.Schema).Version)
+	assert.NoError(t, otherTxn.Commit())
+
+	// rows appended after altering the table in the same txn are committed
+	newBat := catalog.MockBatch(altered, 5)
+	defer newBat.Close()
+	assert.NoError(t, rel.Append(newBat))
+	assert.NoError(t, txn.Commit())
+	assert.Equal(t, altered, entry.GetSchema())
+	tae.checkRowsByScan(10, false)
+
+	// the schema of a rollbacked txn is never published
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.AlterTable(addColumn("a3")))
+	assert.NoError(t, txn.Rollback())
+	assert.Equal(t, altered, entry.GetSchema())
+
+	tae.restart()
+	txn, rel = tae.getRelation()
+	assert.Equal(t, uint32(2), rel.Schema().(*catalog.Schema).Version)
+	assert.NoError(t, txn.Commit())
+}
+
+func TestAlterTableModifyColumnType(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, -1)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 25)
+	defer bat.Close()
+	bats := bat.Split(5)
+	// the blocks of the first rows are persisted and the others are in memory
+	tae.createRelAndAppend(bats[0], true)
+	tae.DoAppend(bats[1])
+	tae.DoAppend(bats[2])
+	tae.compactBlocks(false)
+	tae.DoAppend(bats[3])
+
+	expected := make(map[int64]int)
+	for i := 0; i < 20; i++ {
+		expected[int64(bat.Vecs[2].Get(i).(int32))]++
+	}
+
+	txn, rel := tae.getRelation()
+	assert.Error(t, rel.AlterTable(&api.AlterTableReq{Operation: &api.AlterTableReq_ModifyColumn{
+		ModifyColumn: &plan.AlterTableModifyColumn{Column: &plan.ColDef{
+			Name:    "mock_2",
+			Typ:     &plan.Type{Id: int32(types.T_int16)},
+			Default: &plan.Default{NullAbility: true},
+		}},
+	}}))
+	assert.NoError(t, rel.AlterTable(&api.AlterTableReq{Operation: &api.AlterTableReq_ModifyColumn{
+		ModifyColumn: &plan.AlterTableModifyColumn{Column: &plan.ColDef{
+			Name:    "mock_2",
+			Typ:     &plan.Type{Id: int32(types.T_int64)},
+			Default: &plan.Default{NullAbility: true},
+		}},
+	}}))
+	assert.NoError(t, txn.Commit())
+
+	// rows written before are read as int64 and the rows of int64 are
+	// appended after
+	txn, rel = tae.getRelation()
+	newSchema := rel.Schema().(*catalog.Schema)
+	newBat := catalog.MockBatch(newSchema, 5)
+	defer newBat.Close()
+	for i := 0; i < newBat.Length(); i++ {
+		expected[newBat.Vecs[2].Get(i).(int64)]++
+	}
+	assert.NoError(t, rel.Append(newBat))
+	assert.NoError(t, txn.Commit())
+
+	check := func() {
+		txn, rel := tae.getRelation()
+		actual := make(map[int64]int)
+		forEachColumnView(rel, 2, func(view *model.ColumnView) error {
+			assert.Equal(t, types.T_int64, view.GetData().GetType().Oid)
+			for i := 0; i < view.Length(); i++ {
+				actual[view.GetData().Get(i).(int64)]++
+			}
+			return nil
+		})
+		assert.Equal(t, expected, actual)
+		assert.NoError(t, txn.Commit())
+	}
+	check()
+	tae.compactBlocks(false)
+	tae.mergeBlocks(false)
+	check()
+	tae.restart()
+	check()
+}
+
 func TestGlobalCheckpoint1(t *testing.T) {
 	defer testutils.AfterTest(t)()
 	testutils.EnsureNoLeak(t)
@@ -164,10 +164,19 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	// TODO: WithGCInterval requires configuration parameters
 	db.DiskCleaner = gc2.NewDiskCleaner(db.Fs, db.BGCheckpointRunner, db.Catalog)
 	db.DiskCleaner.Start()
+	// the history within the gc ttl can be read by the queries at a past
+	// timestamp, nothing later than retainedTS is truncated
+	retainedTS := func(ts types.TS) types.TS {
+		retained := types.BuildTS(time.Now().UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
+		if ts.Less(retained) {
+			return ts
+		}
+		return retained
+	}
 	db.DiskCleaner.AddChecker(
 		func(item any) bool {
 			checkpoint := item.(*checkpoint.CheckpointEntry)
-			ts := types.BuildTS(time.Now().UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
+			ts := retainedTS(types.MaxTs())
 			return !checkpoint.GetEnd().GreaterEq(ts)
 		})
 	// Init gc manager at last
@@ -199,7 +208,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				return db.BGCheckpointRunner.GCByTS(ctx, consumed.GetEnd())
+				return db.BGCheckpointRunner.GCByTS(ctx, retainedTS(consumed.GetEnd()))
 			}),
 		gc.WithCronJob(
 			"catalog-gc",
@@ -212,7 +221,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				db.Catalog.GCByTS(ctx, consumed.GetEnd())
+				db.Catalog.GCByTS(ctx, retainedTS(consumed.GetEnd()))
 				return nil
 			}),
 		gc.WithCronJob(
@@ -221,7 +230,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 			func(ctx context.Context) error {
 				global := db.BGCheckpointRunner.MaxGlobalCheckpoint()
 				if global != nil {
-					db.LogtailMgr.GCByTS(ctx, global.GetEnd())
+					db.LogtailMgr.GCByTS(ctx, retainedTS(global.GetEnd()))
 				}
 				return nil
 			},
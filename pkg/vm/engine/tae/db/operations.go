@@ -74,6 +74,7 @@ type Request interface {
 		CreateRelationReq |
 		DropOrTruncateRelationReq |
 		UpdateConstraintReq |
+		AlterTableReq |
 		WriteReq |
 		apipb.SyncLogTailReq
 }
@@ -84,6 +85,7 @@ type Response interface {
 		CreateRelationResp |
 		DropOrTruncateRelationResp |
 		UpdateConstraintResp |
+		AlterTableResp |
 		WriteResp |
 		apipb.SyncLogTailResp
 }
@@ -157,6 +159,16 @@ type UpdateConstraintReq struct {
 
 type UpdateConstraintResp struct{}
 
+type AlterTableReq struct {
+	TableId      uint64
+	TableName    string
+	DatabaseId   uint64
+	DatabaseName string
+	Req          *apipb.AlterTableReq
+}
+
+type AlterTableResp struct{}
+
 type CreateRelationResp struct {
 	ID uint64
 }
@@ -50,7 +50,7 @@ func TestTables1(t *testing.T) {
 	dataFactory := tables.NewDataFactory(db.Fs, db.MTBufMgr, db.Scheduler, db.Dir)
 	tableFactory := dataFactory.MakeTableFactory()
 	table := tableFactory(tableMeta)
-	handle := table.GetHandle()
+	handle := table.GetHandle(schema.Version)
 	_, err := handle.GetAppender()
 	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrAppendableSegmentNotFound))
 	seg, _ := rel.CreateSegment(false)
@@ -30,6 +30,8 @@ type TableHandle interface {
 }
 
 type Table interface {
-	GetHandle() TableHandle
+	// GetHandle returns a handle appending rows written with the schema
+	// version to the table
+	GetHandle(schemaVersion uint32) TableHandle
 	ApplyHandle(TableHandle)
 }
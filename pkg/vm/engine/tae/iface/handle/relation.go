@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio"
 	"io"
 
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 )
@@ -31,6 +32,7 @@ type Relation interface {
 	GetCardinality(attr string) int64
 	Schema() any
 	UpdateConstraint([]byte) error
+	AlterTable(req *api.AlterTableReq) error
 	MakeSegmentIt() SegmentIt
 	MakeBlockIt() BlockIt
 
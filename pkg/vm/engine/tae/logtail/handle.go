@@ -223,13 +223,14 @@ func (b *CatalogLogtailRespBuilder) VisitTbl(entry *catalog.TableEntry) error {
 			continue
 		}
 		tblNode := node.(*catalog.TableMVCCNode)
+		schema := tableNodeSchema(entry, tblNode)
 		if b.scope == ScopeColumns {
 			var dstBatch *containers.Batch
 			if !tblNode.HasDropCommitted() {
 				dstBatch = b.insBatch
 				// fill unique syscol fields if inserting
 				for _, syscol := range catalog.SystemColumnSchema.ColDefs {
-					txnimpl.FillColumnRow(entry, syscol.Name, b.insBatch.GetVectorByName(syscol.Name))
+					txnimpl.FillColumnRow(entry, schema, syscol.Name, b.insBatch.GetVectorByName(syscol.Name))
 				}
 			} else {
 				dstBatch = b.delBatch
@@ -240,21 +241,54 @@ func (b *CatalogLogtailRespBuilder) VisitTbl(entry *catalog.TableEntry) error {
 			commitVec := dstBatch.GetVectorByName(catalog.AttrCommitTs)
 			tableID := entry.GetID()
 			commitTs := tblNode.GetEnd()
-			for _, usercol := range entry.GetSchema().ColDefs {
+			for _, usercol := range schema.ColDefs {
 				rowidVec.Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", tableID, usercol.Name))))
 				commitVec.Append(commitTs)
 			}
+			if dstBatch == b.insBatch {
+				// columns renamed by ALTER TABLE are deleted by their old names
+				fillAlteredColumnDeletes(b.delBatch, entry, schema, commitTs)
+			}
 		} else {
+			fillTableRow := func(e *catalog.TableEntry, attr string, col containers.Vector, ts types.TS) {
+				txnimpl.FillTableRow(e, schema, attr, col, ts)
+			}
 			if tblNode.HasDropCommitted() {
-				catalogEntry2Batch(b.delBatch, entry, DelSchema, txnimpl.FillTableRow, u64ToRowID(entry.GetID()), tblNode.GetEnd())
+				catalogEntry2Batch(b.delBatch, entry, DelSchema, fillTableRow, u64ToRowID(entry.GetID()), tblNode.GetEnd())
 			} else {
-				catalogEntry2Batch(b.insBatch, entry, catalog.SystemTableSchema, txnimpl.FillTableRow, u64ToRowID(entry.GetID()), tblNode.GetEnd())
+				catalogEntry2Batch(b.insBatch, entry, catalog.SystemTableSchema, fillTableRow, u64ToRowID(entry.GetID()), tblNode.GetEnd())
 			}
 		}
 	}
 	return nil
 }
 
+// tableNodeSchema returns the schema of the table version
+func tableNodeSchema(entry *catalog.TableEntry, node *catalog.TableMVCCNode) *catalog.Schema {
+	if node.Schema != nil {
+		return node.Schema
+	}
+	return entry.GetSchema()
+}
+
+// fillAlteredColumnDeletes appends the rowid of the columns of the previous
+// table version that don't exist in schema anymore
+func fillAlteredColumnDeletes(dstBatch *containers.Batch, entry *catalog.TableEntry, schema *catalog.Schema, commitTs types.TS) {
+	prev := entry.GetSchemaBefore(commitTs)
+	if prev == nil || prev.Version == schema.Version {
+		return
+	}
+	rowidVec := dstBatch.GetVectorByName(catalog.AttrRowID)
+	commitVec := dstBatch.GetVectorByName(catalog.AttrCommitTs)
+	for _, col := range prev.ColDefs {
+		if _, ok := schema.NameIndex[col.Name]; ok {
+			continue
+		}
+		rowidVec.Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", entry.GetID(), col.Name))))
+		commitVec.Append(commitTs)
+	}
+}
+
 func (b *CatalogLogtailRespBuilder) BuildResp() (api.SyncLogTailResp, error) {
 	entries := make([]*api.Entry, 0)
 	var tblID uint64
@@ -376,6 +410,7 @@ type TableLogtailRespBuilder struct {
 	start, end      types.TS
 	did, tid        uint64
 	dname, tname    string
+	schema          *catalog.Schema
 	checkpoint      string
 	blkMetaInsBatch *containers.Batch
 	blkMetaDelBatch *containers.Batch
@@ -398,6 +433,7 @@ func NewTableLogtailRespBuilder(ckp string, start, end types.TS, tbl *catalog.Ta
 	b.tid = tbl.ID
 	b.dname = tbl.GetDB().GetName()
 	b.tname = schema.Name
+	b.schema = schema
 
 	b.dataInsBatch = makeRespBatchFromSchema(schema)
 	b.dataDelBatch = makeRespBatchFromSchema(DelSchema)
@@ -498,6 +534,9 @@ func (b *TableLogtailRespBuilder) visitBlkData(e *catalog.BlockEntry) (err error
 		return
 	}
 	if insBatch != nil && insBatch.Length() > 0 {
+		if insBatch, err = alignBatchToSchema(insBatch, e.GetSchema(), b.schema); err != nil {
+			return
+		}
 		b.dataInsBatch.Extend(insBatch)
 		// insBatch is freed, don't use anymore
 	}
@@ -512,6 +551,58 @@ func (b *TableLogtailRespBuilder) visitBlkData(e *catalog.BlockEntry) (err error
 	return nil
 }
 
+// alignBatchToSchema converts a batch collected from a block written with
+// schema from to the layout of schema to. Columns are matched by position,
+// columns added after the block was written are filled with nulls and those
+// of a modified type are converted.
+func alignBatchToSchema(bat *containers.Batch, from, to *catalog.Schema) (*containers.Batch, error) {
+	if from == to || from.Version == to.Version {
+		return bat, nil
+	}
+	aligned := containers.NewBatch()
+	used := make(map[string]bool)
+	var created []containers.Vector
+	for i, attr := range bat.Attrs {
+		// the physical address column is also the row id of the response
+		if _, ok := from.NameIndex[attr]; !ok || attr == from.PhyAddrKey.Name {
+			aligned.AddVector(attr, bat.Vecs[i])
+			used[attr] = true
+		}
+	}
+	for i, def := range to.ColDefs {
+		if def.IsPhyAddr() {
+			continue
+		}
+		if idx := from.GetColIdxOf(to, i); idx >= 0 {
+			name := from.ColDefs[idx].Name
+			vec, err := catalog.ConvertColumn(bat.GetVectorByName(name), def.Type)
+			if err != nil {
+				for _, vec := range created {
+					vec.Close()
+				}
+				bat.Close()
+				return nil, err
+			}
+			aligned.AddVector(def.Name, vec)
+			if vec == bat.GetVectorByName(name) {
+				used[name] = true
+			} else {
+				created = append(created, vec)
+			}
+			continue
+		}
+		vec := containers.MakeNullVector(def.Type, bat.Length())
+		aligned.AddVector(def.Name, vec)
+		created = append(created, vec)
+	}
+	for i, attr := range bat.Attrs {
+		if !used[attr] {
+			bat.Vecs[i].Close()
+		}
+	}
+	return aligned, nil
+}
+
 func (b *TableLogtailRespBuilder) VisitBlk(entry *catalog.BlockEntry) error {
 	if b.visitBlkMeta(entry) {
 		// data has been flushed, no need to collect data
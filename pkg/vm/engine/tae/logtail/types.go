@@ -44,6 +44,7 @@ const (
 	SegmentAttr_Sorted                     = catalog.SegmentAttr_Sorted
 	SnapshotAttr_BlockMaxRow               = catalog.SnapshotAttr_BlockMaxRow
 	SnapshotAttr_SegmentMaxBlock           = catalog.SnapshotAttr_SegmentMaxBlock
+	SnapshotAttr_SchemaVersion             = catalog.SnapshotAttr_SchemaVersion
 	SnapshotMetaAttr_Tid                   = "table_id"
 	SnapshotMetaAttr_BlockInsertBatchStart = "block_insert_batch_start"
 	SnapshotMetaAttr_BlockInsertBatchEnd   = "block_insert_batch_end"
@@ -124,6 +125,7 @@ var (
 		SnapshotAttr_TID,
 		SnapshotAttr_BlockMaxRow,
 		SnapshotAttr_SegmentMaxBlock,
+		SnapshotAttr_SchemaVersion,
 	}
 	TblDNSchemaType = []types.Type{
 		types.New(types.T_uint64, 0, 0),
@@ -136,6 +138,7 @@ var (
 		types.New(types.T_uint64, 0, 0),
 		types.New(types.T_uint32, 0, 0),
 		types.New(types.T_uint16, 0, 0),
+		types.New(types.T_uint32, 0, 0),
 	}
 	SegmentDNSchemaAttr = []string{
 		txnbase.SnapshotAttr_LogIndex_LSN,
@@ -466,8 +466,14 @@ func LoadBlkColumnsByMeta(
 	if block.GetExtent().End() == 0 {
 		return bat, nil
 	}
-	idxs := make([]uint16, len(colNames))
-	for i := range colNames {
+	// columns appended to the layout after the checkpoint is written are
+	// not in the block and are read as nulls
+	colCnt := len(colNames)
+	if cnt := int(block.GetColumnCount()); cnt < colCnt {
+		colCnt = cnt
+	}
+	idxs := make([]uint16, colCnt)
+	for i := range idxs {
 		idxs[i] = uint16(i)
 	}
 	ioResult, err := reader.LoadColumns(cxt, idxs, []uint32{block.GetID()}, nil)
@@ -487,6 +493,9 @@ func LoadBlkColumnsByMeta(
 		bat.Vecs[i] = vec
 
 	}
+	for i := colCnt; i < len(colNames); i++ {
+		bat.AddVector(colNames[i], containers.MakeNullVector(colTypes[i], bat.Length()))
+	}
 	return bat, nil
 }
 
@@ -700,31 +709,43 @@ func (collector *BaseCollector) VisitTable(entry *catalog.TableEntry) (err error
 			continue
 		}
 		tblNode := node.(*catalog.TableMVCCNode)
+		schema := tableNodeSchema(entry, tblNode)
+		fillTableRow := func(e *catalog.TableEntry, attr string, col containers.Vector, ts types.TS) {
+			txnimpl.FillTableRow(e, schema, attr, col, ts)
+		}
 		if !tblNode.HasDropCommitted() {
 			for _, syscol := range catalog.SystemColumnSchema.ColDefs {
 				txnimpl.FillColumnRow(
 					entry,
+					schema,
 					syscol.Name,
 					collector.data.bats[TBLColInsertIDX].GetVectorByName(syscol.Name),
 				)
 			}
 			rowidVec := collector.data.bats[TBLColInsertIDX].GetVectorByName(catalog.AttrRowID)
 			commitVec := collector.data.bats[TBLColInsertIDX].GetVectorByName(catalog.AttrCommitTs)
-			for _, usercol := range entry.GetSchema().ColDefs {
+			for _, usercol := range schema.ColDefs {
 				rowidVec.Append(bytesToRowID([]byte(fmt.Sprintf("%d-%s", entry.GetID(), usercol.Name))))
 				commitVec.Append(tblNode.GetEnd())
 			}
 
 			collector.data.bats[TBLInsertTxnIDX].GetVectorByName(
-				SnapshotAttr_BlockMaxRow).Append(entry.GetSchema().BlockMaxRows)
+				SnapshotAttr_BlockMaxRow).Append(schema.BlockMaxRows)
 			collector.data.bats[TBLInsertTxnIDX].GetVectorByName(
-				SnapshotAttr_SegmentMaxBlock).Append(entry.GetSchema().SegmentMaxBlocks)
+				SnapshotAttr_SegmentMaxBlock).Append(schema.SegmentMaxBlocks)
+			collector.data.bats[TBLInsertTxnIDX].GetVectorByName(
+				SnapshotAttr_SchemaVersion).Append(schema.Version)
+			fillAlteredColumnDeletes(
+				collector.data.bats[TBLColDeleteIDX],
+				entry,
+				schema,
+				tblNode.GetEnd())
 
 			catalogEntry2Batch(
 				collector.data.bats[TBLInsertIDX],
 				entry,
 				catalog.SystemTableSchema,
-				txnimpl.FillTableRow,
+				fillTableRow,
 				u64ToRowID(entry.GetID()),
 				tblNode.GetEnd(),
 			)
@@ -738,7 +759,7 @@ func (collector *BaseCollector) VisitTable(entry *catalog.TableEntry) (err error
 
 			rowidVec := collector.data.bats[TBLColDeleteIDX].GetVectorByName(catalog.AttrRowID)
 			commitVec := collector.data.bats[TBLColDeleteIDX].GetVectorByName(catalog.AttrCommitTs)
-			for _, usercol := range entry.GetSchema().ColDefs {
+			for _, usercol := range schema.ColDefs {
 				rowidVec.Append(
 					bytesToRowID([]byte(fmt.Sprintf("%d-%s", entry.GetID(), usercol.Name))),
 				)
@@ -748,7 +769,7 @@ func (collector *BaseCollector) VisitTable(entry *catalog.TableEntry) (err error
 			catalogEntry2Batch(
 				collector.data.bats[TBLDeleteIDX],
 				entry, DelSchema,
-				txnimpl.FillTableRow,
+				fillTableRow,
 				u64ToRowID(entry.GetID()),
 				tblNode.GetEnd(),
 			)
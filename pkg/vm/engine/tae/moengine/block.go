@@ -41,7 +41,9 @@ func (blk *txnBlock) Read(attrs []string, compressed []*bytes.Buffer, deCompress
 		return nil, err
 	}
 	view.ApplyDeletes()
-	nameIdx := blk.handle.GetMeta().(*catalog.BlockEntry).GetSchema().NameIndex
+	// view columns are indexed by the schema visible to the txn, which can
+	// differ from the one the block was written with
+	nameIdx := blk.handle.GetSegment().GetRelation().Schema().(*catalog.Schema).NameIndex
 	for i, attr := range attrs {
 		colIdx := nameIdx[attr]
 		vec := view.GetColumnData(colIdx)
@@ -79,6 +79,7 @@ func AttrFromColDef(col *catalog.ColDef) (attrs *engine.Attribute, err error) {
 		OnUpdate:      onUpdate,
 		AutoIncrement: col.IsAutoIncrement(),
 		ClusterBy:     col.IsClusterBy(),
+		Alg:           col.GetAlg(),
 	}
 	return attr, nil
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -53,7 +54,7 @@ func (*baseRelation) DelTableDef(_ context.Context, def engine.TableDef) error {
 }
 
 func (rel *baseRelation) TableDefs(_ context.Context) ([]engine.TableDef, error) {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	defs, _ := SchemaToDefs(schema)
 	return defs, nil
 }
@@ -70,6 +71,15 @@ func (rel *baseRelation) UpdateConstraintWithBin(_ context.Context, bin []byte)
 	return rel.handle.UpdateConstraint(bin)
 }
 
+func (rel *baseRelation) AlterTable(_ context.Context, reqs []*api.AlterTableReq) error {
+	for _, req := range reqs {
+		if err := rel.handle.AlterTable(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (rel *baseRelation) TableColumns(_ context.Context) ([]*engine.Attribute, error) {
 	colDefs := rel.handle.GetMeta().(*catalog.TableEntry).GetColDefs()
 	cols, _ := ColDefsToAttrs(colDefs)
@@ -87,11 +97,11 @@ func (rel *baseRelation) Rows(c context.Context) (int64, error) {
 }
 
 func (rel *baseRelation) GetSchema(_ context.Context) *catalog.Schema {
-	return rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	return rel.handle.Schema().(*catalog.Schema)
 }
 
 func (rel *baseRelation) GetPrimaryKeys(_ context.Context) ([]*engine.Attribute, error) {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	if !schema.HasPK() {
 		return nil, nil
 	}
@@ -111,7 +121,7 @@ func (rel *baseRelation) GetPrimaryKeys(_ context.Context) ([]*engine.Attribute,
 // Might that can be done in the future
 
 func (rel *baseRelation) GetHideKeys(_ context.Context) ([]*engine.Attribute, error) {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	if schema.PhyAddrKey == nil {
 		return nil, moerr.NewNotSupportedNoCtx("system table has no rowid")
 	}
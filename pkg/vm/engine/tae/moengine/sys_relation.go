@@ -19,6 +19,7 @@ import (
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio"
@@ -88,8 +89,12 @@ func (s *sysRelation) UpdateConstraintWithBin(context.Context, []byte) error {
 	return ErrReadOnly
 }
 
+func (s *sysRelation) AlterTable(context.Context, []*api.AlterTableReq) error {
+	return ErrReadOnly
+}
+
 func (s *sysRelation) TableColumns(_ context.Context) ([]*engine.Attribute, error) {
-	colDefs := s.handle.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs
+	colDefs := s.handle.Schema().(*catalog.Schema).ColDefs
 	cols, _ := ColDefsToAttrs(colDefs)
 	return cols, nil
 }
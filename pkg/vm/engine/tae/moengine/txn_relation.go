@@ -43,14 +43,31 @@ func newRelation(h handle.Relation) *txnRelation {
 }
 
 func (rel *txnRelation) Write(ctx context.Context, bat *batch.Batch) error {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
-	allNullables := schema.AllNullables()
+	schema := rel.handle.Schema().(*catalog.Schema)
+	positions := make(map[string]int, len(bat.Attrs))
+	for i, attr := range bat.Attrs {
+		if _, ok := schema.NameIndex[attr]; !ok {
+			return moerr.NewBadFieldErrorNoCtx(attr, schema.Name)
+		}
+		positions[attr] = i
+	}
 	taeBatch := containers.NewEmptyBatch()
 	defer taeBatch.Close()
-	for i, vec := range bat.Vecs {
-		v := containers.NewVectorWithSharedMemory(vec, allNullables[i])
-		//v := MOToVector(vec, allNullables[i])
-		taeBatch.AddVector(bat.Attrs[i], v)
+	// the batch is laid out by the columns the CN knows of. Arrange it by the
+	// schema and fill the columns it does not carry, such as the dropped
+	// ones, with nulls
+	for _, def := range schema.ColDefs {
+		if def.IsPhyAddr() {
+			continue
+		}
+		if pos, ok := positions[def.Name]; ok {
+			taeBatch.AddVector(def.Name, containers.NewVectorWithSharedMemory(bat.Vecs[pos], def.NullAbility))
+			continue
+		}
+		if !def.IsDropped() && !def.NullAbility {
+			return moerr.NewInternalErrorNoCtx("tae moengine: column %s of %s is missing", def.Name, schema.Name)
+		}
+		taeBatch.AddVector(def.Name, containers.MakeNullVector(def.Type, bat.Length()))
 	}
 	return rel.handle.Append(taeBatch)
 }
@@ -75,7 +92,7 @@ func (rel *txnRelation) DeleteByPhyAddrKeys(_ context.Context, keys *vector.Vect
 
 func (rel *txnRelation) Delete(_ context.Context, bat *batch.Batch, col string) error {
 	data := bat.Vecs[0]
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.Schema().(*catalog.Schema)
 	logutil.Debugf("Delete col: %v", col)
 	allNullables := schema.AllNullables()
 	idx := catalog.GetAttrIdx(schema.AllNames(), col)
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
@@ -73,6 +74,10 @@ func (w *wrappedTx) ApplySnapshot(data []byte) error {
 	panic("should not call")
 }
 
+func (w *wrappedTx) UpdateSnapshot(ctx context.Context, ts timestamp.Timestamp) error {
+	panic("should not call")
+}
+
 func (w *wrappedTx) Commit(ctx context.Context) error {
 	return w.tx.Commit()
 }
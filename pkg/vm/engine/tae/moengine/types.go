@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -58,6 +59,8 @@ type Relation interface {
 
 	UpdateConstraintWithBin(context.Context, []byte) error
 
+	AlterTable(context.Context, []*api.AlterTableReq) error
+
 	//Write just append data into txn's workspace, instead of applying data into state machine.
 	Write(context.Context, *batch.Batch) error
 
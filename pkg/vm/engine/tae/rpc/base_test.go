@@ -235,6 +235,7 @@ type column struct {
 	hasUpdate       int8
 	updateExpr      []byte
 	clusterBy       int8
+	compression     int8
 }
 
 func genColumns(accountId uint32, tableName, databaseName string,
@@ -313,6 +314,7 @@ func genColumns(accountId uint32, tableName, databaseName string,
 		} else {
 			col.constraintType = catalog.SystemColNoConstraint
 		}
+		col.compression = int8(attrDef.Attr.Alg)
 		cols = append(cols, col)
 		num++
 	}
@@ -527,6 +529,11 @@ func genCreateColumnTuple(
 		if err := vector.AppendFixed(bat.Vecs[idx], col.clusterBy, false, m); err != nil {
 			return nil, err
 		}
+		idx = catalog.MO_COLUMNS_ATT_COMPRESSION_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // att_compression
+		if err := vector.AppendFixed(bat.Vecs[idx], col.compression, false, m); err != nil {
+			return nil, err
+		}
 
 	}
 	return bat, nil
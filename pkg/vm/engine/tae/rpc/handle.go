@@ -139,6 +139,13 @@ func (h *Handle) HandleCommit(
 					req,
 					&db.UpdateConstraintResp{},
 				)
+			case *db.AlterTableReq:
+				err = h.HandleAlterTable(
+					ctx,
+					meta,
+					req,
+					&db.AlterTableResp{},
+				)
 			case *db.WriteReq:
 				err = h.HandleWrite(
 					ctx,
@@ -254,6 +261,13 @@ func (h *Handle) HandlePrepare(
 					req,
 					&db.UpdateConstraintResp{},
 				)
+			case *db.AlterTableReq:
+				err = h.HandleAlterTable(
+					ctx,
+					meta,
+					req,
+					&db.AlterTableResp{},
+				)
 			case *db.WriteReq:
 				err = h.HandleWrite(
 					ctx,
@@ -603,6 +617,20 @@ func (h *Handle) HandlePreCommitWrite(
 					return err
 				}
 			}
+		case []catalog.AlterTable:
+			for _, cmd := range cmds {
+				req := &db.AlterTableReq{
+					TableName:    cmd.TableName,
+					TableId:      cmd.TableId,
+					DatabaseName: cmd.DatabaseName,
+					DatabaseId:   cmd.DatabaseId,
+					Req:          cmd.Req,
+				}
+				if err = h.CacheTxnRequest(ctx, meta, req,
+					new(db.AlterTableResp)); err != nil {
+					return err
+				}
+			}
 		case []catalog.DropDatabase:
 			for _, cmd := range cmds {
 				req := &db.DropDatabaseReq{
@@ -868,6 +896,32 @@ func (h *Handle) HandleWrite(
 	return
 }
 
+func (h *Handle) HandleAlterTable(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req *db.AlterTableReq,
+	resp *db.AlterTableResp) (err error) {
+	txn, err := h.eng.GetOrCreateTxnWithMeta(nil, meta.GetID(),
+		types.TimestampToTS(meta.GetSnapshotTS()))
+	if err != nil {
+		return err
+	}
+
+	logutil.Infof("[precommit] alter table: %+v\n txn: %s\n", req, txn.String())
+
+	dbase, err := h.eng.GetDatabaseByID(ctx, req.DatabaseId, txn)
+	if err != nil {
+		return
+	}
+
+	tbl, err := dbase.GetRelationByID(ctx, req.TableId)
+	if err != nil {
+		return
+	}
+
+	return tbl.AlterTable(ctx, []*apipb.AlterTableReq{req.Req})
+}
+
 func (h *Handle) HandleUpdateConstraint(
 	ctx context.Context,
 	meta txn.TxnMeta,
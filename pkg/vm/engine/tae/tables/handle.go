@@ -24,12 +24,15 @@ type tableHandle struct {
 	table    *dataTable
 	block    *ablock
 	appender data.BlockAppender
+	// schemaVersion is the version of the schema the rows are written with
+	schemaVersion uint32
 }
 
-func newHandle(table *dataTable, block *ablock) *tableHandle {
+func newHandle(table *dataTable, block *ablock, schemaVersion uint32) *tableHandle {
 	h := &tableHandle{
-		table: table,
-		block: block,
+		table:         table,
+		block:         block,
+		schemaVersion: schemaVersion,
 	}
 	if block != nil {
 		h.appender, _ = block.MakeAppender()
@@ -85,6 +88,11 @@ func (h *tableHandle) GetAppender() (appender data.BlockAppender, err error) {
 	if !h.appender.IsAppendable() || !h.block.IsAppendable() || dropped {
 		return h.ThrowAppenderAndErr()
 	}
+	// An appendable block only takes rows of the schema it was created with,
+	// a new one is needed after the table is altered
+	if h.block.meta.GetSchema().Version != h.schemaVersion {
+		return h.ThrowAppenderAndErr()
+	}
 	h.block.Ref()
 	// Similar to optimistic locking
 	dropped = h.block.meta.HasDropCommitted()
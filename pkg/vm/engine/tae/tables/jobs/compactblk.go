@@ -91,7 +91,9 @@ func (task *compactBlockTask) PrepareData() (preparer *model.PreparedCompactedBl
 	preparer = model.NewPreparedCompactedBlockData()
 	preparer.Columns = containers.NewBatch()
 
-	schema := task.meta.GetSchema()
+	// the block is rewritten with the schema visible to the txn, the columns
+	// of an older block schema are converted when read
+	schema := task.compacted.GetSegment().GetRelation().Schema().(*catalog.Schema)
 	var view *model.ColumnView
 	for _, def := range schema.ColDefs {
 		if def.IsPhyAddr() {
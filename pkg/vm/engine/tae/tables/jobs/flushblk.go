@@ -65,6 +65,7 @@ func (task *flushBlkTask) Execute() error {
 	if task.meta.GetSchema().HasSortKey() {
 		writer.SetPrimaryKey(uint16(task.meta.GetSchema().GetSingleSortKeyIdx()))
 	}
+	setColumnCompression(writer, task.meta.GetSchema())
 	_, err = writer.WriteBlock(task.data)
 	if err != nil {
 		return err
@@ -78,3 +79,13 @@ func (task *flushBlkTask) Execute() error {
 	task.blocks, _, err = writer.Sync(context.Background())
 	return err
 }
+
+// setColumnCompression sets compression algorithms of columns from the schema
+func setColumnCompression(writer *blockio.BlockWriter, schema *catalog.Schema) {
+	for _, def := range schema.ColDefs {
+		if def.IsPhyAddr() {
+			continue
+		}
+		writer.SetCompression(uint16(def.Idx), uint8(def.GetAlg()))
+	}
+}
@@ -194,7 +194,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
-	schema := task.mergedBlks[0].GetSchema()
+	// merged blocks can be written with different schema versions, they are
+	// all read and rewritten with the one visible to the txn
+	schema := task.rel.Schema().(*catalog.Schema)
 	var view *model.ColumnView
 	sortVecs := make([]containers.Vector, 0)
 	rows := make([]uint32, 0)
@@ -322,6 +324,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		pkIdx := schema.GetSingleSortKeyIdx()
 		writer.SetPrimaryKey(uint16(pkIdx))
 	}
+	setColumnCompression(writer, schema)
 	for _, bat := range batchs {
 		_, err = writer.WriteBlock(bat)
 		if err != nil {
@@ -33,8 +33,8 @@ func newTable(meta *catalog.TableEntry, bufMgr base.INodeManager) *dataTable {
 	}
 }
 
-func (table *dataTable) GetHandle() data.TableHandle {
-	return newHandle(table, table.aBlk)
+func (table *dataTable) GetHandle(schemaVersion uint32) data.TableHandle {
+	return newHandle(table, table.aBlk, schemaVersion)
 }
 
 func (table *dataTable) ApplyHandle(h data.TableHandle) {
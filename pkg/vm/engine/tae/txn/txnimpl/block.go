@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -204,16 +205,19 @@ func (blk *txnBlock) GetColumnDataByIds(colIdxes []int, buffers []*bytes.Buffer)
 	if blk.isUncommitted {
 		return blk.table.localSegment.GetColumnDataByIds(blk.entry, colIdxes, buffers)
 	}
+	if blk.isSchemaAltered() {
+		return blk.getAlteredColumnDataByIds(colIdxes, buffers)
+	}
 	return blk.entry.GetBlockData().GetColumnDataByIds(blk.Txn, colIdxes, buffers)
 }
 
 func (blk *txnBlock) GetColumnDataByNames(attrs []string, buffers []*bytes.Buffer) (*model.BlockView, error) {
-	if blk.isUncommitted {
+	if blk.isUncommitted || blk.isSchemaAltered() {
 		attrIds := make([]int, len(attrs))
 		for i, attr := range attrs {
-			attrIds[i] = blk.table.entry.GetSchema().GetColIdx(attr)
+			attrIds[i] = blk.table.GetSchema().GetColIdx(attr)
 		}
-		return blk.table.localSegment.GetColumnDataByIds(blk.entry, attrIds, buffers)
+		return blk.GetColumnDataByIds(attrIds, buffers)
 	}
 	return blk.entry.GetBlockData().GetColumnDataByNames(blk.Txn, attrs, buffers)
 }
@@ -222,16 +226,101 @@ func (blk *txnBlock) GetColumnDataById(colIdx int, buffer *bytes.Buffer) (*model
 	if blk.isUncommitted {
 		return blk.table.localSegment.GetColumnDataById(blk.entry, colIdx, buffer)
 	}
+	if blk.isSchemaAltered() {
+		view, err := blk.getAlteredColumnDataByIds([]int{colIdx}, []*bytes.Buffer{buffer})
+		if err != nil {
+			return nil, err
+		}
+		col := view.Columns[colIdx]
+		col.DeleteMask = view.DeleteMask
+		return col, nil
+	}
 	return blk.entry.GetBlockData().GetColumnDataById(blk.Txn, colIdx, buffer)
 }
 func (blk *txnBlock) GetColumnDataByName(attr string, buffer *bytes.Buffer) (*model.ColumnView, error) {
-	if blk.isUncommitted {
-		attrId := blk.table.entry.GetSchema().GetColIdx(attr)
-		return blk.table.localSegment.GetColumnDataById(blk.entry, attrId, buffer)
+	if blk.isUncommitted || blk.isSchemaAltered() {
+		attrId := blk.table.GetSchema().GetColIdx(attr)
+		return blk.GetColumnDataById(attrId, buffer)
 	}
 	return blk.entry.GetBlockData().GetColumnDataByName(blk.Txn, attr, buffer)
 }
 
+// isSchemaAltered reports whether the block is written with a schema other
+// than the one of the table visible to the txn
+func (blk *txnBlock) isSchemaAltered() bool {
+	return blk.entry.GetSchema().Version != blk.table.GetSchema().Version
+}
+
+// getAlteredColumnDataByIds reads the columns of the txn schema from a block
+// written with another schema version. Columns are matched by position, those
+// missing in the block are read as nulls and those of a modified type are
+// converted.
+func (blk *txnBlock) getAlteredColumnDataByIds(colIdxes []int, buffers []*bytes.Buffer) (view *model.BlockView, err error) {
+	schema := blk.table.GetSchema()
+	blkSchema := blk.entry.GetSchema()
+	blkIdxes := make([]int, 0, len(colIdxes))
+	var blkBuffers []*bytes.Buffer
+	if len(buffers) == len(colIdxes) {
+		blkBuffers = make([]*bytes.Buffer, 0, len(colIdxes))
+	}
+	for i, idx := range colIdxes {
+		if blkIdx := blkSchema.GetColIdxOf(schema, idx); blkIdx >= 0 {
+			blkIdxes = append(blkIdxes, blkIdx)
+			if blkBuffers != nil {
+				blkBuffers = append(blkBuffers, buffers[i])
+			}
+		}
+	}
+	// at least one column is read to know the number of rows
+	probe := len(blkIdxes) == 0
+	if probe {
+		blkIdxes = append(blkIdxes, 0)
+		blkBuffers = nil
+	}
+	if view, err = blk.entry.GetBlockData().GetColumnDataByIds(blk.Txn, blkIdxes, blkBuffers); err != nil {
+		return
+	}
+	rows := view.Columns[blkIdxes[0]].Length()
+	columns := make(map[int]*model.ColumnView, len(colIdxes))
+	for _, idx := range colIdxes {
+		if blkIdx := blkSchema.GetColIdxOf(schema, idx); blkIdx >= 0 {
+			col := view.Columns[blkIdx]
+			col.ColIdx = idx
+			columns[idx] = col
+			continue
+		}
+		col := model.NewColumnView(view.Ts, idx)
+		col.SetData(containers.MakeNullVector(schema.ColDefs[idx].Type, rows))
+		columns[idx] = col
+	}
+	if probe {
+		view.Columns[blkIdxes[0]].Close()
+	}
+	view.Columns = columns
+	for idx, col := range columns {
+		if err = convertColumnView(col, schema.ColDefs[idx].Type); err != nil {
+			view.Close()
+			return nil, err
+		}
+	}
+	return
+}
+
+// convertColumnView converts the data of col into typ if the type of the
+// column is modified after the block is written
+func convertColumnView(col *model.ColumnView, typ types.Type) error {
+	data := col.GetData()
+	converted, err := catalog.ConvertColumn(data, typ)
+	if err != nil {
+		return err
+	}
+	if converted != data {
+		data.Close()
+		col.SetData(converted)
+	}
+	return nil
+}
+
 func (blk *txnBlock) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return blk.Txn.GetStore().LogTxnEntry(blk.getDBID(), blk.entry.GetSegment().GetTable().GetID(), entry, readed)
 }
@@ -97,6 +97,7 @@ func (seg *localSegment) registerNode(metaLoc string, deltaLoc string, zm dataio
 		seg.table.store.txn.GetStartTS(),
 		metaLoc,
 		deltaLoc)
+	meta.SetSchema(seg.table.GetSchema())
 	seg.entry.AddEntryLocked(meta)
 	n := NewNode(
 		seg.table,
@@ -115,6 +116,7 @@ func (seg *localSegment) registerANode() {
 		seg.entry,
 		uint64(len(seg.nodes)),
 		seg.table.store.txn.GetStartTS())
+	meta.SetSchema(seg.table.GetSchema())
 	seg.entry.AddEntryLocked(meta)
 	n := NewANode(
 		seg.table,
@@ -178,7 +180,7 @@ func (seg *localSegment) prepareApplyNode(node InsertNode) (err error) {
 	if !node.IsPersisted() {
 		tableData := seg.table.entry.GetTableData()
 		if seg.tableHandle == nil {
-			seg.tableHandle = tableData.GetHandle()
+			seg.tableHandle = tableData.GetHandle(seg.table.GetSchema().Version)
 		}
 		appended := uint32(0)
 		for appended < node.RowsWithoutDeletes() {
@@ -439,12 +441,12 @@ func (seg *localSegment) GetByFilter(filter *handle.Filter) (id *common.ID, offs
 }
 
 func (seg *localSegment) GetPKColumn() containers.Vector {
-	schema := seg.table.entry.GetSchema()
+	schema := seg.table.GetSchema()
 	return seg.index.KeyToVector(schema.GetSingleSortKeyType())
 }
 
 func (seg *localSegment) GetPKVecs() []containers.Vector {
-	schema := seg.table.entry.GetSchema()
+	schema := seg.table.GetSchema()
 	return seg.index.KeyToVectors(schema.GetSingleSortKeyType())
 }
 
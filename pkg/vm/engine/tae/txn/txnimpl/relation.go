@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -138,15 +139,15 @@ func (h *txnRelation) SimplePPString(level common.PPLevel) string {
 
 func (h *txnRelation) Close() error { return nil }
 func (h *txnRelation) GetMeta() any { return h.table.entry }
-func (h *txnRelation) Schema() any  { return h.table.entry.GetSchema() }
+func (h *txnRelation) Schema() any  { return h.table.GetSchema() }
 
 func (h *txnRelation) Rows() int64 {
 	if h.table.entry.GetDB().IsSystemDB() && h.table.entry.IsVirtual() {
-		if h.table.entry.GetSchema().Name == pkgcatalog.MO_DATABASE {
+		if h.table.GetSchema().Name == pkgcatalog.MO_DATABASE {
 			return int64(h.table.entry.GetCatalog().CoarseDBCnt())
-		} else if h.table.entry.GetSchema().Name == pkgcatalog.MO_TABLES {
+		} else if h.table.GetSchema().Name == pkgcatalog.MO_TABLES {
 			return int64(h.table.entry.GetCatalog().CoarseTableCnt())
-		} else if h.table.entry.GetSchema().Name == pkgcatalog.MO_COLUMNS {
+		} else if h.table.GetSchema().Name == pkgcatalog.MO_COLUMNS {
 			return int64(h.table.entry.GetCatalog().CoarseColumnCnt())
 		}
 		panic("logic error")
@@ -220,7 +221,7 @@ func (h *txnRelation) UpdateByFilter(filter *handle.Filter, col uint16, v any) (
 	if err != nil {
 		return
 	}
-	schema := h.table.entry.GetSchema()
+	schema := h.table.GetSchema()
 	bat := containers.NewBatch()
 	defer bat.Close()
 	for _, def := range schema.ColDefs {
@@ -309,3 +310,7 @@ func (h *txnRelation) GetDB() (handle.Database, error) {
 func (h *txnRelation) UpdateConstraint(cstr []byte) (err error) {
 	return h.table.UpdateConstraint(cstr)
 }
+
+func (h *txnRelation) AlterTable(req *api.AlterTableReq) (err error) {
+	return h.table.AlterTable(req)
+}
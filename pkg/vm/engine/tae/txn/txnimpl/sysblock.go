@@ -135,7 +135,7 @@ func (blk *txnSysBlock) processTable(entry *catalog.DBEntry, fn func(*catalog.Ta
 func (blk *txnSysBlock) columnRows() int {
 	rows := 0
 	fn := func(table *catalog.TableEntry) error {
-		rows += len(table.GetSchema().ColDefs)
+		rows += len(table.GetVisibleSchema(blk.Txn).ColDefs)
 		return nil
 	}
 	dbFn := func(db *catalog.DBEntry) error {
@@ -161,10 +161,9 @@ func (blk *txnSysBlock) Rows() int {
 	}
 }
 
-func FillColumnRow(table *catalog.TableEntry, attr string, colData containers.Vector) {
-	schema := table.GetSchema()
+func FillColumnRow(table *catalog.TableEntry, schema *catalog.Schema, attr string, colData containers.Vector) {
 	tableID := table.GetID()
-	for i, colDef := range table.GetSchema().ColDefs {
+	for i, colDef := range schema.ColDefs {
 		switch attr {
 		case pkgcatalog.SystemColAttr_UniqName:
 			colData.Append([]byte(fmt.Sprintf("%d-%s", tableID, colDef.Name)))
@@ -185,7 +184,7 @@ func FillColumnRow(table *catalog.TableEntry, attr string, colData containers.Ve
 		case pkgcatalog.SystemColAttr_RelID:
 			colData.Append(tableID)
 		case pkgcatalog.SystemColAttr_RelName:
-			colData.Append([]byte(table.GetSchema().Name))
+			colData.Append([]byte(schema.Name))
 		case pkgcatalog.SystemColAttr_ConstraintType:
 			if colDef.Primary {
 				colData.Append([]byte(pkgcatalog.SystemColPKConstraint))
@@ -201,7 +200,7 @@ func FillColumnRow(table *catalog.TableEntry, attr string, colData containers.Ve
 		case pkgcatalog.SystemColAttr_DefaultExpr:
 			colData.Append(colDef.Default)
 		case pkgcatalog.SystemColAttr_IsDropped:
-			colData.Append(int8(0))
+			colData.Append(bool2i8(colDef.IsDropped()))
 		case pkgcatalog.SystemColAttr_IsHidden:
 			colData.Append(bool2i8(colDef.Hidden))
 		case pkgcatalog.SystemColAttr_IsUnsigned:
@@ -219,6 +218,8 @@ func FillColumnRow(table *catalog.TableEntry, attr string, colData containers.Ve
 			colData.Append(bool2i8(len(colDef.OnUpdate) > 0))
 		case pkgcatalog.SystemColAttr_IsClusterBy:
 			colData.Append(bool2i8(colDef.IsClusterBy()))
+		case pkgcatalog.SystemColAttr_Compression:
+			colData.Append(int8(colDef.GetAlg()))
 		case pkgcatalog.SystemColAttr_Update:
 			colData.Append(colDef.OnUpdate)
 		default:
@@ -230,7 +231,7 @@ func (blk *txnSysBlock) getColumnTableVec(colIdx int) (colData containers.Vector
 	col := catalog.SystemColumnSchema.ColDefs[colIdx]
 	colData = containers.MakeVector(col.Type, col.Nullable())
 	tableFn := func(table *catalog.TableEntry) error {
-		FillColumnRow(table, col.Name, colData)
+		FillColumnRow(table, table.GetVisibleSchema(blk.Txn), col.Name, colData)
 		return nil
 	}
 	dbFn := func(db *catalog.DBEntry) error {
@@ -249,8 +250,7 @@ func (blk *txnSysBlock) getColumnTableData(colIdx int) (view *model.ColumnView,
 	return
 }
 
-func FillTableRow(table *catalog.TableEntry, attr string, colData containers.Vector, ts types.TS) {
-	schema := table.GetSchema()
+func FillTableRow(table *catalog.TableEntry, schema *catalog.Schema, attr string, colData containers.Vector, ts types.TS) {
 	switch attr {
 	case pkgcatalog.SystemRelAttr_ID:
 		colData.Append(table.GetID())
@@ -261,15 +261,15 @@ func FillTableRow(table *catalog.TableEntry, attr string, colData containers.Vec
 	case pkgcatalog.SystemRelAttr_DBID:
 		colData.Append(table.GetDB().GetID())
 	case pkgcatalog.SystemRelAttr_Comment:
-		colData.Append([]byte(table.GetSchema().Comment))
+		colData.Append([]byte(schema.Comment))
 	case pkgcatalog.SystemRelAttr_Partition:
-		colData.Append([]byte(table.GetSchema().Partition))
+		colData.Append([]byte(schema.Partition))
 	case pkgcatalog.SystemRelAttr_Persistence:
 		colData.Append([]byte(pkgcatalog.SystemPersistRel))
 	case pkgcatalog.SystemRelAttr_Kind:
-		colData.Append([]byte(table.GetSchema().Relkind))
+		colData.Append([]byte(schema.Relkind))
 	case pkgcatalog.SystemRelAttr_CreateSQL:
-		colData.Append([]byte(table.GetSchema().Createsql))
+		colData.Append([]byte(schema.Createsql))
 	case pkgcatalog.SystemRelAttr_ViewDef:
 		colData.Append([]byte(schema.View))
 	case pkgcatalog.SystemRelAttr_Owner:
@@ -297,7 +297,7 @@ func (blk *txnSysBlock) getRelTableVec(ts types.TS, colIdx int) (colData contain
 	colDef := catalog.SystemTableSchema.ColDefs[colIdx]
 	colData = containers.MakeVector(colDef.Type, colDef.Nullable())
 	tableFn := func(table *catalog.TableEntry) error {
-		FillTableRow(table, colDef.Name, colData, ts)
+		FillTableRow(table, table.GetVisibleSchemaByTS(ts), colDef.Name, colData, ts)
 		return nil
 	}
 	dbFn := func(db *catalog.DBEntry) error {
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -96,9 +97,11 @@ type txnTable struct {
 	deleteNodes  map[common.ID]*deleteNode
 	entry        *catalog.TableEntry
 	schema       *catalog.Schema
-	logs         []wal.LogEntry
-	maxSegId     uint64
-	maxBlkId     uint64
+	// baseSchema is the committed schema visible when the txn starts
+	baseSchema *catalog.Schema
+	logs       []wal.LogEntry
+	maxSegId   uint64
+	maxBlkId   uint64
 
 	txnEntries *txnEntries
 	csnStart   uint32
@@ -107,10 +110,12 @@ type txnTable struct {
 }
 
 func newTxnTable(store *txnStore, entry *catalog.TableEntry) *txnTable {
+	schema := entry.GetVisibleSchema(store.txn)
 	tbl := &txnTable{
 		store:       store,
 		entry:       entry,
-		schema:      entry.GetSchema(),
+		schema:      schema,
+		baseSchema:  schema,
 		deleteNodes: make(map[common.ID]*deleteNode),
 		logs:        make([]wal.LogEntry, 0),
 		txnEntries:  newTxnEntries(),
@@ -790,6 +795,10 @@ func (tbl *txnTable) GetValue(id *common.ID, row uint32, col uint16) (v any, err
 	if err != nil {
 		panic(err)
 	}
+	// the column may be added after the block is written
+	if meta.GetSchema().GetColIdxOf(tbl.schema, int(col)) < 0 {
+		return types.Null{}, nil
+	}
 	block := meta.GetBlockData()
 	return block.GetValue(tbl.store.txn, int(row), int(col))
 }
@@ -842,6 +851,23 @@ func (tbl *txnTable) UpdateConstraint(cstr []byte) (err error) {
 	return
 }
 
+func (tbl *txnTable) AlterTable(req *api.AlterTableReq) (err error) {
+	if tbl.localSegment != nil {
+		return moerr.NewNotSupportedNoCtx("alter table %s after writing it in the same txn", tbl.schema.Name)
+	}
+	tbl.store.IncreateWriteCnt()
+	tbl.store.txn.GetMemo().AddCatalogChange()
+	isNewNode, newSchema, err := tbl.entry.AlterTable(tbl.store.txn, req)
+	if isNewNode {
+		tbl.txnEntries.Append(tbl.entry)
+	}
+	if err != nil {
+		return
+	}
+	tbl.schema = newSchema
+	return
+}
+
 func (tbl *txnTable) UncommittedRows() uint32 {
 	if tbl.localSegment == nil {
 		return 0
@@ -1214,6 +1240,10 @@ func (tbl *txnTable) PrePrepare() (err error) {
 }
 
 func (tbl *txnTable) PrepareCommit() (err error) {
+	// rows appended with a schema altered by another txn can't be applied
+	if tbl.localSegment != nil && tbl.entry.GetSchema() != tbl.baseSchema {
+		return moerr.NewTxnWWConflictNoCtx()
+	}
 	for idx, node := range tbl.txnEntries.entries {
 		if tbl.txnEntries.IsDeleted(idx) {
 			continue
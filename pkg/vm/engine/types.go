@@ -24,6 +24,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
@@ -351,6 +353,9 @@ type Relation interface {
 	// only ConstraintDef can be modified
 	UpdateConstraint(context.Context, *ConstraintDef) error
 
+	// AlterTable changes the columns or the name of the relation
+	AlterTable(context.Context, []*api.AlterTableReq) error
+
 	GetTableID(context.Context) uint64
 
 	// second argument is the number of reader, third argument is the filter extend, foruth parameter is the payload required by the engine
@@ -367,6 +372,83 @@ type Reader interface {
 	Read(context.Context, []string, *plan.Expr, *mpool.MPool) (*batch.Batch, error)
 }
 
+// RuntimeFilterReader is a Reader able to skip the blocks and the rows which never match
+// the joins above the scan by the runtime filters generated from the build sides.
+type RuntimeFilterReader interface {
+	Reader
+	SetRuntimeFilters([]*RuntimeFilter)
+}
+
+type RuntimeFilterType int
+
+const (
+	// RuntimeFilterPass means the filter passes all the rows, it's sent when the
+	// keys of the build side are unknown.
+	RuntimeFilterPass RuntimeFilterType = iota
+	// RuntimeFilterDrop means the build side is empty and no row passes the filter.
+	RuntimeFilterDrop
+	// RuntimeFilterIn passes the rows whose keys are in the keys of the build side.
+	RuntimeFilterIn
+	// RuntimeFilterMinMax passes the rows whose keys are in the range of the keys.
+	RuntimeFilterMinMax
+	// RuntimeFilterBloom passes the rows whose keys are in the range of the keys
+	// and may be contained by the bloom filter of the keys.
+	RuntimeFilterBloom
+)
+
+// RuntimeFilter is generated from the keys of the build side of a join after the hash
+// map is built, the rows of the probe side filtered out by it never match the join.
+type RuntimeFilter struct {
+	Typ RuntimeFilterType
+	// Col is the name of the column of the table the filter is evaluated on.
+	Col string
+	// Keys is the marshaled vector of the distinct keys of the build side for the IN
+	// filter, or of the smallest and the largest keys for the other filters.
+	Keys []byte
+	// Bloom is the marshaled bloom filter of the keys for the BLOOM filter.
+	Bloom []byte
+}
+
+// ChangeType is the type of a row change committed to a table
+type ChangeType int
+
+const (
+	// ChangeInsert is a row inserted
+	ChangeInsert ChangeType = iota
+	// ChangeDelete is a row deleted, an update is a delete and an insert
+	// of the same primary key committed at the same time.
+	ChangeDelete
+	// ChangeCheckpoint is received before the changes of a logtail with the
+	// checkpoint of the dn, which are followed by the changes read from the
+	// checkpoint. CommitTs is the start of the checkpoint, the changes committed
+	// before it may be folded into the checkpoint and not captured.
+	ChangeCheckpoint
+)
+
+// Change is a row change committed to a table.
+type Change struct {
+	Typ      ChangeType
+	CommitTs timestamp.Timestamp
+	// Vecs are the columns subscribed, the row changed is at Row. The vector of a
+	// column missing in the change is nil, and Vecs is nil if the row deleted is
+	// not found. The vectors are only valid in the ChangeHandler.
+	Vecs []*vector.Vector
+	Row  int
+}
+
+// ChangeHandler receives the changes of a table received at ts, the changes of
+// a transaction are always received in one call.
+type ChangeHandler func(ctx context.Context, changes []Change, ts timestamp.Timestamp) error
+
+// ChangeSubscriber is implemented by the engines capturing the changes of tables.
+type ChangeSubscriber interface {
+	// SubscribeChanges calls the handler with the changes of the columns of the
+	// table until the cancel function returned is called. The changes committed
+	// no later than from are not read from the checkpoints.
+	SubscribeChanges(ctx context.Context, databaseId, tableId uint64, attrs []string,
+		from timestamp.Timestamp, handler ChangeHandler) (cancel func(), err error)
+}
+
 type Database interface {
 	Relations(context.Context) ([]string, error)
 	Relation(context.Context, string) (Relation, error)
@@ -413,6 +495,19 @@ type Engine interface {
 	GetRelationById(ctx context.Context, op client.TxnOperator, tableId uint64) (dbName string, tblName string, rel Relation, err error)
 }
 
+// SavepointEngine is implemented by the engines that can roll back the
+// writes of a transaction to a savepoint without aborting the transaction
+type SavepointEngine interface {
+	// Savepoint sets a savepoint with the name, the existing savepoint
+	// with the same name is replaced
+	Savepoint(ctx context.Context, op client.TxnOperator, name string) error
+	// RollbackToSavepoint discards the writes after the savepoint and the
+	// savepoints set after it
+	RollbackToSavepoint(ctx context.Context, op client.TxnOperator, name string) error
+	// ReleaseSavepoint removes the savepoint and the savepoints set after it
+	ReleaseSavepoint(ctx context.Context, op client.TxnOperator, name string) error
+}
+
 type Hints struct {
 	CommitOrRollbackTimeout time.Duration
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
@@ -52,6 +53,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
@@ -59,6 +61,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -110,6 +113,12 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 	HashBuild: hashbuild.String,
 
 	TableFunction: table_function.String,
+
+	Window: window.String,
+
+	RecursiveCte: recursivecte.String,
+
+	LockOp: lockop.String,
 }
 
 var prepareFunc = [...]func(*process.Process, any) error{
@@ -160,6 +169,12 @@ var prepareFunc = [...]func(*process.Process, any) error{
 	HashBuild: hashbuild.Prepare,
 
 	TableFunction: table_function.Prepare,
+
+	Window: window.Prepare,
+
+	RecursiveCte: recursivecte.Prepare,
+
+	LockOp: lockop.Prepare,
 }
 
 var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
@@ -211,4 +226,10 @@ var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
 	HashBuild: hashbuild.Call,
 
 	TableFunction: table_function.Call,
+
+	Window: window.Call,
+
+	RecursiveCte: recursivecte.Call,
+
+	LockOp: lockop.Call,
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
@@ -68,6 +69,7 @@ func NewFromProc(p *Process, ctx context.Context, regNumber int) *Process {
 	proc.FileService = p.FileService
 	proc.UnixTime = p.UnixTime
 	proc.LastInsertID = p.LastInsertID
+	proc.limiter = p.limiter
 
 	// reg and cancel
 	proc.Ctx = newctx
@@ -132,6 +134,149 @@ func (proc *Process) OperatorOutofMemory(size int64) bool {
 	return proc.Mp().Cap() < size
 }
 
+// ExceedSpillSize returns true if size, the memory held by an operator, has
+// exceeded the spill threshold.
+func (proc *Process) ExceedSpillSize(size int64) bool {
+	return proc.Lim.SpillSize > 0 && size > proc.Lim.SpillSize
+}
+
+// StartQueryLimiter returns the context the pipelines of the query should be
+// built on, which is canceled once the query runs longer than Lim.MaxExecutionTime
+// or allocates more memory than Lim.QueryMemLimit. The deadline of the query is
+// Lim.QueryDeadline if it is set by the cn sending the scope, otherwise it is
+// set from now. The limiter started before is stopped.
+func (proc *Process) StartQueryLimiter(ctx context.Context) context.Context {
+	proc.StopQueryLimiter()
+	if proc.Lim.QueryDeadline <= 0 && proc.Lim.MaxExecutionTime > 0 {
+		proc.Lim.QueryDeadline = time.Now().Add(time.Duration(proc.Lim.MaxExecutionTime)).UnixNano()
+	}
+	if proc.Lim.QueryDeadline <= 0 && proc.Lim.QueryMemLimit <= 0 {
+		return ctx
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	l := &queryLimiter{
+		cancel:   cancel,
+		mp:       proc.Mp(),
+		memBase:  proc.Mp().CurrNB(),
+		memLimit: proc.Lim.QueryMemLimit,
+	}
+	if proc.Lim.QueryDeadline > 0 {
+		l.timer = time.AfterFunc(time.Until(time.Unix(0, proc.Lim.QueryDeadline)), func() {
+			l.abort(moerr.NewQueryTimeoutNoCtx())
+		})
+	}
+	proc.limiter = l
+	return ctx
+}
+
+// StopQueryLimiter stops the limiter of the query, and releases the context
+// returned by StartQueryLimiter.
+func (proc *Process) StopQueryLimiter() {
+	l := proc.limiter
+	if l == nil {
+		return
+	}
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	l.cancel()
+	proc.limiter = nil
+}
+
+// CheckQueryLimits is called by the pipelines between the batches, it cancels
+// the query if the query allocates more memory than its limit. It returns the
+// error the query is canceled for.
+func (proc *Process) CheckQueryLimits() error {
+	l := proc.limiter
+	if l == nil {
+		return nil
+	}
+	l.checkMem()
+	return proc.QueryLimitError()
+}
+
+// SetQueryMemReporter sets the function the memory allocated by the query is
+// reported by, it's called once the memory changes by QueryMemReportGranularity
+// of the limit. It is set by the cn running the scopes sent by another cn.
+func (proc *Process) SetQueryMemReporter(report func(used int64)) {
+	if l := proc.limiter; l != nil && l.memLimit > 0 {
+		l.report = report
+	}
+}
+
+// SetRemoteQueryMem records the memory allocated by the scopes of the query
+// running on another cn reported by the stream id, and cancels the query if
+// the memory allocated on all the cns exceeds the limit.
+func (proc *Process) SetRemoteQueryMem(id uint64, used int64) {
+	l := proc.limiter
+	if l == nil || l.memLimit <= 0 {
+		return
+	}
+	l.remoteMem.Lock()
+	if l.remoteMem.used == nil {
+		l.remoteMem.used = make(map[uint64]int64)
+	}
+	l.remoteMem.total.Add(used - l.remoteMem.used[id])
+	if used == 0 {
+		delete(l.remoteMem.used, id)
+	} else {
+		l.remoteMem.used[id] = used
+	}
+	l.remoteMem.Unlock()
+	l.checkMem()
+}
+
+// QueryMemReportGranularity is the fraction of the memory limit of a query, the
+// memory allocated on a cn is reported to the cn sending the scopes once it
+// changes by that much.
+var QueryMemReportGranularity int64 = 16
+
+// checkMem cancels the query if the memory allocated on this cn and the other
+// cns exceeds the limit, or reports the memory allocated if it changes much.
+func (l *queryLimiter) checkMem() {
+	if l.memLimit <= 0 {
+		return
+	}
+	used := l.mp.CurrNB() - l.memBase + l.remoteMem.total.Load()
+	if used > l.memLimit {
+		l.abort(moerr.NewQueryMemLimitExceededNoCtx(used, l.memLimit))
+		return
+	}
+	if l.report == nil {
+		return
+	}
+	reported := l.reported.Load()
+	diff := used - reported
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff < l.memLimit/QueryMemReportGranularity {
+		return
+	}
+	if l.reported.CompareAndSwap(reported, used) {
+		l.report(used)
+	}
+}
+
+// QueryLimitError returns the error the query is canceled for by its limiter,
+// nil if the query is not canceled by it.
+func (proc *Process) QueryLimitError() error {
+	if proc.limiter == nil {
+		return nil
+	}
+	if err := proc.limiter.err.Load(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// abort records the first error the query is canceled for and cancels all the pipelines of it.
+func (l *queryLimiter) abort(err *moerr.Error) {
+	if l.err.CompareAndSwap(nil, err) {
+		l.cancel()
+	}
+}
+
 func (proc *Process) SetInputBatch(bat *batch.Batch) {
 	proc.Reg.InputBatch = bat
 }
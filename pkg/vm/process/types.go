@@ -17,12 +17,15 @@ package process
 import (
 	"context"
 	"io"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -79,6 +82,24 @@ type Limitation struct {
 	ReaderSize int64
 	// MaxMessageSize max size for read messages from dn
 	MaxMsgSize uint64
+	// SpillSize, memory threshold of an operator, an operator holding a large
+	// intermediate state spills it to disk once the state exceeds the
+	// threshold. A value that is not positive disables spilling, the config
+	// turns 0 into the default of 4GiB.
+	SpillSize int64
+	// MaxExecutionTime, the time in nanoseconds a query can run before it is
+	// canceled. 0 means no limit.
+	MaxExecutionTime int64
+	// QueryMemLimit, the max memory a query can allocate before it is canceled.
+	// 0 means no limit. The cns running the scopes of the query report the memory
+	// allocated from their mpools to the cn sending the scopes, which cancels the
+	// query once the memory allocated on all the cns exceeds it.
+	QueryMemLimit int64
+	// QueryDeadline, the unix time in nanoseconds the query is canceled at. It is
+	// set from MaxExecutionTime when the query starts on the cn receiving it, and
+	// sent with the scopes to the other cns so they cancel them at the same time.
+	// 0 means no deadline.
+	QueryDeadline int64
 }
 
 // SessionInfo session information
@@ -169,6 +190,35 @@ type Process struct {
 	LoadLocalReader *io.PipeReader
 
 	DispatchNotifyCh chan WrapCs
+
+	// limiter cancels the query once it exceeds the limits of Lim,
+	// it is shared by all the processes of the query.
+	limiter *queryLimiter
+}
+
+// queryLimiter cancels all the pipelines of a query once the query runs longer
+// than Limitation.MaxExecutionTime or allocates more memory than
+// Limitation.QueryMemLimit, and records the error the query is canceled for.
+type queryLimiter struct {
+	cancel context.CancelFunc
+	timer  *time.Timer
+	mp     *mpool.MPool
+	// memory allocated from mp before the query began
+	memBase  int64
+	memLimit int64
+	err      atomic.Pointer[moerr.Error]
+
+	// remoteMem is the memory allocated by the scopes on the other cns, reported
+	// by the streams running them.
+	remoteMem struct {
+		sync.Mutex
+		used  map[uint64]int64
+		total atomic.Int64
+	}
+	// report sends the memory allocated by the query on this cn and the cns it
+	// sends the scopes to, to the cn sending the query to this cn.
+	report   func(used int64)
+	reported atomic.Int64
 }
 
 type WrapCs struct {
@@ -67,6 +67,14 @@ const (
 	Right
 	OnDuplicateKey
 	PreInsert
+	// Window computes a window function over all the rows it received.
+	Window
+	// RecursiveCte runs the recursive member of a recursive CTE repeatedly
+	// until it produces no new row.
+	RecursiveCte
+	// LockOp locks the rows read by SELECT ... FOR UPDATE and the rows to be
+	// modified by the pessimistic txn.
+	LockOp
 
 	// LastInstructionOp is not a true operator and must set at last.
 	// It was used by unit testing to ensure that
@@ -108,6 +116,8 @@ func (ins *Instruction) IsBrokenNode() bool {
 		return true
 	case Top, MergeTop:
 		return true
+	case Window:
+		return true
 	}
 	return false
 }
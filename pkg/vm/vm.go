@@ -57,6 +57,10 @@ func Run(ins Instructions, proc *process.Process) (end bool, err error) {
 			err = moerr.ConvertPanicError(proc.Ctx, e)
 		}
 	}()
+	// the query may have exceeded its limits, quit before doing more work
+	if err = proc.CheckQueryLimits(); err != nil {
+		return false, err
+	}
 	for _, in := range ins {
 		if ok, err = execFunc[in.Op](in.Idx, proc, in.Arg, in.IsFirst, in.IsLast); err != nil {
 			return ok || end, err